@@ -110,742 +110,810 @@ var file_types_query_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
 	0x73, 0x77, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa4, 0x47, 0x0a,
-	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x64, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x67, 0x0a, 0x08,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x62, 0x61,
-	0x6e, 0x6b, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x59, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x59, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70,
-	0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x55, 0x0a, 0x05, 0x50,
-	0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f,
-	0x6c, 0x73, 0x12, 0x6f, 0x0a, 0x0b, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f,
-	0x6c, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
-	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
-	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x64, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x7d, 0x12, 0x6b, 0x0a, 0x0c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f,
-	0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x64, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
-	0x12, 0x9d, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x73, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x12, 0x97, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x81,
+	0x4e, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x64, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x67,
+	0x0a, 0x08, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x59, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x12, 0x10, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x59, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
+	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x55, 0x0a,
+	0x05, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x6f, 0x0a, 0x0b, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x64, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x6b, 0x0a, 0x0c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x12, 0x10, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x64, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x6c, 0x0a, 0x05, 0x53, 0x61,
-	0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
-	0x12, 0x26, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c,
-	0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x66, 0x0a, 0x06, 0x53, 0x61, 0x76, 0x65,
-	0x72, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x61, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f,
-	0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x73,
-	0x12, 0x78, 0x0a, 0x08, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
-	0x29, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
-	0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72,
-	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x72, 0x0a, 0x09, 0x42, 0x6f,
-	0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
+	0x12, 0x2a, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0xba, 0x01, 0x0a,
+	0x18, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x73,
 	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x7d, 0x2f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x6e,
-	0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x12, 0x1c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x6a,
-	0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55,
-	0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x7d, 0x0a, 0x0c, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x7e, 0x0a, 0x0d, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x73, 0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x05, 0x53, 0x61, 0x76,
+	0x65, 0x72, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x61, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12,
+	0x26, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
+	0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x66, 0x0a, 0x06, 0x53, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x61, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x12, 0x1d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x73, 0x12,
+	0x89, 0x01, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61,
+	0x76, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x61, 0x76, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x78, 0x0a, 0x08, 0x42,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x7d, 0x2f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x72, 0x0a, 0x09, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
 	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x6c, 0x79, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x79, 0x0a, 0x0c, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x7b, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x7d, 0x12, 0x75, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x6c, 0x79, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f,
+	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x6e, 0x0a, 0x09, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x75, 0x6e, 0x69,
+	0x74, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x6a, 0x0a, 0x0a, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15,
+	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x7d, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x12, 0x21, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0x7e, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x65, 0x64, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x04, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x55, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
-	0x66, 0x0a, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x1b, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
-	0x16, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x6c, 0x69, 0x70, 0x2f,
-	0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x61, 0x0a, 0x09, 0x50, 0x6f, 0x6f, 0x6c, 0x53,
-	0x6c, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x7d, 0x12, 0x79, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
+	0x1e, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x64, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12,
+	0x75, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x12, 0x55, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x4f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46,
-	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46,
-	0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6f,
-	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x2f, 0x7b, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x7d, 0x12, 0x72, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46,
-	0x65, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x08, 0x50, 0x6f,
+	0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x6c, 0x69, 0x70, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x7d, 0x12, 0x61, 0x0a, 0x09, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x12,
+	0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53,
+	0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
+	0x73, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e,
 	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53,
-	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
-	0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2f,
-	0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x12, 0x4f, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x56, 0x6f,
-	0x74, 0x65, 0x72, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x62, 0x61, 0x6e, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x7d, 0x12, 0x61, 0x0a, 0x08, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b,
-	0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61,
-	0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x67, 0x6e, 0x61,
-	0x72, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x72,
-	0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x12, 0x69, 0x0a, 0x0a, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x70, 0x6f,
-	0x6f, 0x6c, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x7e, 0x0a, 0x0f, 0x53, 0x57, 0x49,
-	0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x74,
+	0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x72,
+	0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65,
+	0x65, 0x73, 0x12, 0x7a, 0x0a, 0x0e, 0x44, 0x65, 0x78, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x78, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x78, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x64,
+	0x65, 0x78, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x7e,
+	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12,
+	0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7a,
+	0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
+	0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x4f, 0x0a, 0x03, 0x42, 0x61,
+	0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x61, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x62, 0x61,
+	0x6e, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x61, 0x0a, 0x08, 0x52,
+	0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x72, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x12, 0x69,
+	0x0a, 0x0a, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x53, 0x57,
+	0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57,
-	0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x67, 0x0a, 0x0b, 0x4d, 0x69, 0x6d,
-	0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d,
-	0x69, 0x72, 0x12, 0x74, 0x0a, 0x0c, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74, 0x68, 0x4b,
-	0x65, 0x79, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6b,
-	0x65, 0x79, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x7c, 0x0a, 0x10, 0x4d, 0x69, 0x6d, 0x69,
-	0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
-	0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
-	0x15, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x13, 0x4d, 0x69, 0x6d, 0x69, 0x72,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x26,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69,
-	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61,
-	0x6c, 0x6c, 0x12, 0x7c, 0x0a, 0x10, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x49,
+	0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0x7e, 0x0a, 0x0f, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x67, 0x0a, 0x0b, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d,
+	0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d,
+	0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x74, 0x0a, 0x0c, 0x4d, 0x69, 0x6d,
+	0x69, 0x72, 0x57, 0x69, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74, 0x68,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
+	0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6b, 0x65, 0x79, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12,
+	0x7c, 0x0a, 0x10, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
+	0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x89, 0x01,
+	0x0a, 0x13, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
+	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x7c, 0x0a, 0x10, 0x4d, 0x69, 0x6d,
+	0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69,
+	0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0f, 0x4d, 0x69, 0x6d, 0x69,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x82, 0x01, 0x0a, 0x0f, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
-	0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x07, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x78, 0x0a, 0x0c, 0x53, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
-	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x12, 0x6c, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
-	0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
-	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
-	0x2f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68,
-	0x7d, 0x12, 0x69, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12,
-	0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76,
-	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x07,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x6c, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x7d, 0x0a, 0x0d, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x66, 0x0a, 0x09, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d,
+	0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x82, 0x01, 0x0a,
+	0x10, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x5d, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x78, 0x0a, 0x0c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e,
+	0x61, 0x6d, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x6c, 0x0a, 0x09, 0x49, 0x6e,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x73, 0x77,
-	0x61, 0x70, 0x12, 0x87, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65,
-	0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x7d, 0x12, 0x69, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
+	0x11, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x7d, 0x0a, 0x0d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x12, 0x1f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x12, 0x66, 0x0a, 0x09, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1c,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x7e, 0x0a, 0x0e, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f,
 	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x73,
-	0x61, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x8b, 0x01, 0x0a,
-	0x12, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x12, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x79, 0x70,
+	0x77, 0x61, 0x70, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x87, 0x01, 0x0a, 0x11, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x8b, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76,
+	0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x25, 0x2e, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76,
-	0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x73, 0x61, 0x76, 0x65,
-	0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x77, 0x0a, 0x0d, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f,
-	0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65,
-	0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x6f,
-	0x70, 0x65, 0x6e, 0x12, 0x7b, 0x0a, 0x0e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
-	0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65,
-	0x12, 0x74, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x15, 0x12, 0x13, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x68,
-	0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79,
+	0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x77, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70,
+	0x65, 0x6e, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
+	0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x7b, 0x0a, 0x0e, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c,
+	0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x6c, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x66, 0x0a,
+	0x09, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x7e, 0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x77, 0x61, 0x70, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x77, 0x61, 0x70, 0x42,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x95, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x42, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
+	0x79, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x68, 0x0a,
+	0x0a, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6c,
-	0x61, 0x73, 0x74, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x7a, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x4c,
-	0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x6c, 0x79, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x7d, 0x12, 0x5f, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x70, 0x75, 0x62,
-	0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x72, 0x0a, 0x0c, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6c, 0x61,
+	0x73, 0x74, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x7a, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x4c, 0x61,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
+	0x79, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x7d, 0x12, 0x5f, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f,
+	0x6b, 0x65, 0x79, 0x7d, 0x12, 0x72, 0x0a, 0x0c, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x12, 0x17, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x73, 0x2f, 0x61, 0x73, 0x67, 0x61, 0x72, 0x64, 0x12, 0x76, 0x0a, 0x0d, 0x56, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x6c, 0x79, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x73, 0x12, 0x6a, 0x0a, 0x08, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
-	0x12, 0x1b, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a,
-	0x08, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x51, 0x0a, 0x02, 0x54, 0x78, 0x12,
-	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x08,
-	0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x56, 0x6f, 0x74,
-	0x65, 0x72, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0b, 0x54, 0x78, 0x56, 0x6f,
-	0x74, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
+	0x17, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x73, 0x2f, 0x61, 0x73, 0x67, 0x61, 0x72, 0x64, 0x12, 0x76, 0x0a, 0x0d, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
+	0x79, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73,
+	0x12, 0x6a, 0x0a, 0x08, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x08,
+	0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x51, 0x0a, 0x02, 0x54, 0x78, 0x12, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
+	0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x08, 0x54,
+	0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65,
 	0x72, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x65, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x75, 0x74,
-	0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x74, 0x2f,
-	0x73, 0x77, 0x61, 0x70, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x55,
-	0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x7a, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x24, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x12, 0x75, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f,
-	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x55, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x85, 0x01, 0x0a, 0x0f, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x70,
-	0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x63, 0x0a, 0x09, 0x54, 0x73, 0x73, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x66, 0x0a, 0x07,
-	0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2f,
+	0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0b, 0x54, 0x78, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x6c, 0x79, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x7d, 0x12, 0x7c, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x50,
-	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x73,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x2f,
-	0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65,
-	0x79, 0x7d, 0x12, 0x6c, 0x0a, 0x06, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x12, 0x19, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x73, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d,
-	0x12, 0x82, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x6c, 0x79, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x6c, 0x79, 0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x65, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x12,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x43, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x74, 0x2f, 0x73,
+	0x77, 0x61, 0x70, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x55, 0x0a,
+	0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x7a, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
+	0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x12, 0x75, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x6f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x55, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x85,
+	0x01, 0x0a, 0x0f, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x63, 0x0a, 0x09, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x6c, 0x79, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x66, 0x0a, 0x07, 0x4b,
+	0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x6c, 0x79, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x7d, 0x12, 0x7c, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x2f, 0x7b,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0x7d, 0x12, 0x6c, 0x0a, 0x06, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x12, 0x19, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12,
+	0x82, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x79, 0x0a,
-	0x0c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x6c, 0x79, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x73, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x6c, 0x79, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x79, 0x0a, 0x0c,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x6c, 0x79, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65,
-	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x74, 0x0a, 0x0a, 0x53, 0x57, 0x43, 0x59,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x63, 0x79, 0x5f, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6e,
-	0x0a, 0x0b, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x2f, 0x73, 0x77, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x78,
-	0x0a, 0x0b, 0x53, 0x57, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x1e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x43,
-	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x43,
-	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x2f, 0x73, 0x77, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x72, 0x0a, 0x0c, 0x53, 0x57, 0x43, 0x59,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x6c, 0x79, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x74, 0x0a, 0x0a, 0x53, 0x57, 0x43, 0x59, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6e, 0x0a,
+	0x0b, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
+	0x2f, 0x73, 0x77, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x78, 0x0a,
+	0x0b, 0x53, 0x57, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
+	0x2f, 0x73, 0x77, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x72, 0x0a, 0x0c, 0x53, 0x57, 0x43, 0x59, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x57, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73,
-	0x77, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x05,
-	0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x63, 0x6f,
-	0x64, 0x65, 0x73, 0x42, 0x86, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xc8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x73, 0x77,
+	0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x05, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x2f, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x42, 0x86, 0x01, 0xc8, 0xe2, 0x1e, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa,
+	0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2,
+	0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var file_types_query_proto_goTypes = []interface{}{
-	(*QueryAccountRequest)(nil),              // 0: types.QueryAccountRequest
-	(*QueryBalancesRequest)(nil),             // 1: types.QueryBalancesRequest
-	(*QueryExportRequest)(nil),               // 2: types.QueryExportRequest
-	(*QueryPoolRequest)(nil),                 // 3: types.QueryPoolRequest
-	(*QueryPoolsRequest)(nil),                // 4: types.QueryPoolsRequest
-	(*QueryDerivedPoolRequest)(nil),          // 5: types.QueryDerivedPoolRequest
-	(*QueryDerivedPoolsRequest)(nil),         // 6: types.QueryDerivedPoolsRequest
-	(*QueryLiquidityProviderRequest)(nil),    // 7: types.QueryLiquidityProviderRequest
-	(*QueryLiquidityProvidersRequest)(nil),   // 8: types.QueryLiquidityProvidersRequest
-	(*QuerySaverRequest)(nil),                // 9: types.QuerySaverRequest
-	(*QuerySaversRequest)(nil),               // 10: types.QuerySaversRequest
-	(*QueryBorrowerRequest)(nil),             // 11: types.QueryBorrowerRequest
-	(*QueryBorrowersRequest)(nil),            // 12: types.QueryBorrowersRequest
-	(*QueryTradeUnitRequest)(nil),            // 13: types.QueryTradeUnitRequest
-	(*QueryTradeUnitsRequest)(nil),           // 14: types.QueryTradeUnitsRequest
-	(*QueryTradeAccountRequest)(nil),         // 15: types.QueryTradeAccountRequest
-	(*QueryTradeAccountsRequest)(nil),        // 16: types.QueryTradeAccountsRequest
-	(*QuerySecuredAssetRequest)(nil),         // 17: types.QuerySecuredAssetRequest
-	(*QuerySecuredAssetsRequest)(nil),        // 18: types.QuerySecuredAssetsRequest
-	(*QueryNodeRequest)(nil),                 // 19: types.QueryNodeRequest
-	(*QueryNodesRequest)(nil),                // 20: types.QueryNodesRequest
-	(*QueryPoolSlipRequest)(nil),             // 21: types.QueryPoolSlipRequest
-	(*QueryPoolSlipsRequest)(nil),            // 22: types.QueryPoolSlipsRequest
-	(*QueryOutboundFeeRequest)(nil),          // 23: types.QueryOutboundFeeRequest
-	(*QueryOutboundFeesRequest)(nil),         // 24: types.QueryOutboundFeesRequest
-	(*QueryStreamingSwapRequest)(nil),        // 25: types.QueryStreamingSwapRequest
-	(*QueryStreamingSwapsRequest)(nil),       // 26: types.QueryStreamingSwapsRequest
-	(*QueryBanRequest)(nil),                  // 27: types.QueryBanRequest
-	(*QueryRagnarokRequest)(nil),             // 28: types.QueryRagnarokRequest
-	(*QuerySwitchPoolRequest)(nil),           // 29: types.QuerySwitchPoolRequest
-	(*QuerySWITCHProviderRequest)(nil),       // 30: types.QuerySWITCHProviderRequest
-	(*QuerySWITCHProvidersRequest)(nil),      // 31: types.QuerySWITCHProvidersRequest
-	(*QueryMimirValuesRequest)(nil),          // 32: types.QueryMimirValuesRequest
-	(*QueryMimirWithKeyRequest)(nil),         // 33: types.QueryMimirWithKeyRequest
-	(*QueryMimirAdminValuesRequest)(nil),     // 34: types.QueryMimirAdminValuesRequest
-	(*QueryMimirNodesAllValuesRequest)(nil),  // 35: types.QueryMimirNodesAllValuesRequest
-	(*QueryMimirNodesValuesRequest)(nil),     // 36: types.QueryMimirNodesValuesRequest
-	(*QueryMimirNodeValuesRequest)(nil),      // 37: types.QueryMimirNodeValuesRequest
-	(*QueryInboundAddressesRequest)(nil),     // 38: types.QueryInboundAddressesRequest
-	(*QueryVersionRequest)(nil),              // 39: types.QueryVersionRequest
-	(*QuerySwitchlynameRequest)(nil),         // 40: types.QuerySwitchlynameRequest
-	(*QueryInvariantRequest)(nil),            // 41: types.QueryInvariantRequest
-	(*QueryInvariantsRequest)(nil),           // 42: types.QueryInvariantsRequest
-	(*QueryNetworkRequest)(nil),              // 43: types.QueryNetworkRequest
-	(*QueryBalanceModuleRequest)(nil),        // 44: types.QueryBalanceModuleRequest
-	(*QueryQuoteSwapRequest)(nil),            // 45: types.QueryQuoteSwapRequest
-	(*QueryQuoteSaverDepositRequest)(nil),    // 46: types.QueryQuoteSaverDepositRequest
-	(*QueryQuoteSaverWithdrawRequest)(nil),   // 47: types.QueryQuoteSaverWithdrawRequest
-	(*QueryQuoteLoanOpenRequest)(nil),        // 48: types.QueryQuoteLoanOpenRequest
-	(*QueryQuoteLoanCloseRequest)(nil),       // 49: types.QueryQuoteLoanCloseRequest
-	(*QueryConstantValuesRequest)(nil),       // 50: types.QueryConstantValuesRequest
-	(*QuerySwapQueueRequest)(nil),            // 51: types.QuerySwapQueueRequest
-	(*QueryLastBlocksRequest)(nil),           // 52: types.QueryLastBlocksRequest
-	(*QueryChainsLastBlockRequest)(nil),      // 53: types.QueryChainsLastBlockRequest
-	(*QueryVaultRequest)(nil),                // 54: types.QueryVaultRequest
-	(*QueryAsgardVaultsRequest)(nil),         // 55: types.QueryAsgardVaultsRequest
-	(*QueryVaultsPubkeysRequest)(nil),        // 56: types.QueryVaultsPubkeysRequest
-	(*QueryTxStagesRequest)(nil),             // 57: types.QueryTxStagesRequest
-	(*QueryTxStatusRequest)(nil),             // 58: types.QueryTxStatusRequest
-	(*QueryTxRequest)(nil),                   // 59: types.QueryTxRequest
-	(*QueryTxVotersRequest)(nil),             // 60: types.QueryTxVotersRequest
-	(*QuerySwapperCloutRequest)(nil),         // 61: types.QuerySwapperCloutRequest
-	(*QueryQueueRequest)(nil),                // 62: types.QueryQueueRequest
-	(*QueryScheduledOutboundRequest)(nil),    // 63: types.QueryScheduledOutboundRequest
-	(*QueryPendingOutboundRequest)(nil),      // 64: types.QueryPendingOutboundRequest
-	(*QueryBlockRequest)(nil),                // 65: types.QueryBlockRequest
-	(*QueryTssKeygenMetricRequest)(nil),      // 66: types.QueryTssKeygenMetricRequest
-	(*QueryTssMetricRequest)(nil),            // 67: types.QueryTssMetricRequest
-	(*QueryKeysignRequest)(nil),              // 68: types.QueryKeysignRequest
-	(*QueryKeysignPubkeyRequest)(nil),        // 69: types.QueryKeysignPubkeyRequest
-	(*QueryKeygenRequest)(nil),               // 70: types.QueryKeygenRequest
-	(*QueryUpgradeProposalsRequest)(nil),     // 71: types.QueryUpgradeProposalsRequest
-	(*QueryUpgradeProposalRequest)(nil),      // 72: types.QueryUpgradeProposalRequest
-	(*QueryUpgradeVotesRequest)(nil),         // 73: types.QueryUpgradeVotesRequest
-	(*QuerySWCYStakerRequest)(nil),           // 74: types.QuerySWCYStakerRequest
-	(*QuerySWCYStakersRequest)(nil),          // 75: types.QuerySWCYStakersRequest
-	(*QuerySWCYClaimerRequest)(nil),          // 76: types.QuerySWCYClaimerRequest
-	(*QuerySWCYClaimersRequest)(nil),         // 77: types.QuerySWCYClaimersRequest
-	(*QueryCodesRequest)(nil),                // 78: types.QueryCodesRequest
-	(*QueryAccountResponse)(nil),             // 79: types.QueryAccountResponse
-	(*QueryBalancesResponse)(nil),            // 80: types.QueryBalancesResponse
-	(*QueryExportResponse)(nil),              // 81: types.QueryExportResponse
-	(*QueryPoolResponse)(nil),                // 82: types.QueryPoolResponse
-	(*QueryPoolsResponse)(nil),               // 83: types.QueryPoolsResponse
-	(*QueryDerivedPoolResponse)(nil),         // 84: types.QueryDerivedPoolResponse
-	(*QueryDerivedPoolsResponse)(nil),        // 85: types.QueryDerivedPoolsResponse
-	(*QueryLiquidityProviderResponse)(nil),   // 86: types.QueryLiquidityProviderResponse
-	(*QueryLiquidityProvidersResponse)(nil),  // 87: types.QueryLiquidityProvidersResponse
-	(*QuerySaverResponse)(nil),               // 88: types.QuerySaverResponse
-	(*QuerySaversResponse)(nil),              // 89: types.QuerySaversResponse
-	(*QueryBorrowerResponse)(nil),            // 90: types.QueryBorrowerResponse
-	(*QueryBorrowersResponse)(nil),           // 91: types.QueryBorrowersResponse
-	(*QueryTradeUnitResponse)(nil),           // 92: types.QueryTradeUnitResponse
-	(*QueryTradeUnitsResponse)(nil),          // 93: types.QueryTradeUnitsResponse
-	(*QueryTradeAccountsResponse)(nil),       // 94: types.QueryTradeAccountsResponse
-	(*QuerySecuredAssetResponse)(nil),        // 95: types.QuerySecuredAssetResponse
-	(*QuerySecuredAssetsResponse)(nil),       // 96: types.QuerySecuredAssetsResponse
-	(*QueryNodeResponse)(nil),                // 97: types.QueryNodeResponse
-	(*QueryNodesResponse)(nil),               // 98: types.QueryNodesResponse
-	(*QueryPoolSlipsResponse)(nil),           // 99: types.QueryPoolSlipsResponse
-	(*QueryOutboundFeesResponse)(nil),        // 100: types.QueryOutboundFeesResponse
-	(*QueryStreamingSwapResponse)(nil),       // 101: types.QueryStreamingSwapResponse
-	(*QueryStreamingSwapsResponse)(nil),      // 102: types.QueryStreamingSwapsResponse
-	(*BanVoter)(nil),                         // 103: types.BanVoter
-	(*QueryRagnarokResponse)(nil),            // 104: types.QueryRagnarokResponse
-	(*QuerySwitchPoolResponse)(nil),          // 105: types.QuerySwitchPoolResponse
-	(*QuerySWITCHProviderResponse)(nil),      // 106: types.QuerySWITCHProviderResponse
-	(*QuerySWITCHProvidersResponse)(nil),     // 107: types.QuerySWITCHProvidersResponse
-	(*QueryMimirValuesResponse)(nil),         // 108: types.QueryMimirValuesResponse
-	(*QueryMimirWithKeyResponse)(nil),        // 109: types.QueryMimirWithKeyResponse
-	(*QueryMimirAdminValuesResponse)(nil),    // 110: types.QueryMimirAdminValuesResponse
-	(*QueryMimirNodesAllValuesResponse)(nil), // 111: types.QueryMimirNodesAllValuesResponse
-	(*QueryMimirNodesValuesResponse)(nil),    // 112: types.QueryMimirNodesValuesResponse
-	(*QueryMimirNodeValuesResponse)(nil),     // 113: types.QueryMimirNodeValuesResponse
-	(*QueryInboundAddressesResponse)(nil),    // 114: types.QueryInboundAddressesResponse
-	(*QueryVersionResponse)(nil),             // 115: types.QueryVersionResponse
-	(*QuerySwitchlynameResponse)(nil),        // 116: types.QuerySwitchlynameResponse
-	(*QueryInvariantResponse)(nil),           // 117: types.QueryInvariantResponse
-	(*QueryInvariantsResponse)(nil),          // 118: types.QueryInvariantsResponse
-	(*QueryNetworkResponse)(nil),             // 119: types.QueryNetworkResponse
-	(*QueryBalanceModuleResponse)(nil),       // 120: types.QueryBalanceModuleResponse
-	(*QueryQuoteSwapResponse)(nil),           // 121: types.QueryQuoteSwapResponse
-	(*QueryQuoteSaverDepositResponse)(nil),   // 122: types.QueryQuoteSaverDepositResponse
-	(*QueryQuoteSaverWithdrawResponse)(nil),  // 123: types.QueryQuoteSaverWithdrawResponse
-	(*QueryQuoteLoanOpenResponse)(nil),       // 124: types.QueryQuoteLoanOpenResponse
-	(*QueryQuoteLoanCloseResponse)(nil),      // 125: types.QueryQuoteLoanCloseResponse
-	(*QueryConstantValuesResponse)(nil),      // 126: types.QueryConstantValuesResponse
-	(*QuerySwapQueueResponse)(nil),           // 127: types.QuerySwapQueueResponse
-	(*QueryLastBlocksResponse)(nil),          // 128: types.QueryLastBlocksResponse
-	(*QueryVaultResponse)(nil),               // 129: types.QueryVaultResponse
-	(*QueryAsgardVaultsResponse)(nil),        // 130: types.QueryAsgardVaultsResponse
-	(*QueryVaultsPubkeysResponse)(nil),       // 131: types.QueryVaultsPubkeysResponse
-	(*QueryTxStagesResponse)(nil),            // 132: types.QueryTxStagesResponse
-	(*QueryTxStatusResponse)(nil),            // 133: types.QueryTxStatusResponse
-	(*QueryTxResponse)(nil),                  // 134: types.QueryTxResponse
-	(*QueryObservedTxVoter)(nil),             // 135: types.QueryObservedTxVoter
-	(*SwapperClout)(nil),                     // 136: types.SwapperClout
-	(*QueryQueueResponse)(nil),               // 137: types.QueryQueueResponse
-	(*QueryOutboundResponse)(nil),            // 138: types.QueryOutboundResponse
-	(*QueryBlockResponse)(nil),               // 139: types.QueryBlockResponse
-	(*QueryTssKeygenMetricResponse)(nil),     // 140: types.QueryTssKeygenMetricResponse
-	(*QueryTssMetricResponse)(nil),           // 141: types.QueryTssMetricResponse
-	(*QueryKeysignResponse)(nil),             // 142: types.QueryKeysignResponse
-	(*QueryKeygenResponse)(nil),              // 143: types.QueryKeygenResponse
-	(*QueryUpgradeProposalsResponse)(nil),    // 144: types.QueryUpgradeProposalsResponse
-	(*QueryUpgradeProposalResponse)(nil),     // 145: types.QueryUpgradeProposalResponse
-	(*QueryUpgradeVotesResponse)(nil),        // 146: types.QueryUpgradeVotesResponse
-	(*QuerySWCYStakerResponse)(nil),          // 147: types.QuerySWCYStakerResponse
-	(*QuerySWCYStakersResponse)(nil),         // 148: types.QuerySWCYStakersResponse
-	(*QuerySWCYClaimerResponse)(nil),         // 149: types.QuerySWCYClaimerResponse
-	(*QuerySWCYClaimersResponse)(nil),        // 150: types.QuerySWCYClaimersResponse
-	(*QueryCodesResponse)(nil),               // 151: types.QueryCodesResponse
+	(*QueryAccountRequest)(nil),                   // 0: types.QueryAccountRequest
+	(*QueryBalancesRequest)(nil),                  // 1: types.QueryBalancesRequest
+	(*QueryExportRequest)(nil),                    // 2: types.QueryExportRequest
+	(*QueryPoolRequest)(nil),                      // 3: types.QueryPoolRequest
+	(*QueryPoolsRequest)(nil),                     // 4: types.QueryPoolsRequest
+	(*QueryDerivedPoolRequest)(nil),               // 5: types.QueryDerivedPoolRequest
+	(*QueryDerivedPoolsRequest)(nil),              // 6: types.QueryDerivedPoolsRequest
+	(*QueryLiquidityProviderRequest)(nil),         // 7: types.QueryLiquidityProviderRequest
+	(*QueryLiquidityProvidersRequest)(nil),        // 8: types.QueryLiquidityProvidersRequest
+	(*QueryLiquidityProviderHistoryRequest)(nil),  // 9: types.QueryLiquidityProviderHistoryRequest
+	(*QuerySaverRequest)(nil),                     // 10: types.QuerySaverRequest
+	(*QuerySaversRequest)(nil),                    // 11: types.QuerySaversRequest
+	(*QuerySaverHistoryRequest)(nil),              // 12: types.QuerySaverHistoryRequest
+	(*QueryBorrowerRequest)(nil),                  // 13: types.QueryBorrowerRequest
+	(*QueryBorrowersRequest)(nil),                 // 14: types.QueryBorrowersRequest
+	(*QueryTradeUnitRequest)(nil),                 // 15: types.QueryTradeUnitRequest
+	(*QueryTradeUnitsRequest)(nil),                // 16: types.QueryTradeUnitsRequest
+	(*QueryTradeAccountRequest)(nil),              // 17: types.QueryTradeAccountRequest
+	(*QueryTradeAccountsRequest)(nil),             // 18: types.QueryTradeAccountsRequest
+	(*QuerySecuredAssetRequest)(nil),              // 19: types.QuerySecuredAssetRequest
+	(*QuerySecuredAssetsRequest)(nil),             // 20: types.QuerySecuredAssetsRequest
+	(*QueryNodeRequest)(nil),                      // 21: types.QueryNodeRequest
+	(*QueryNodesRequest)(nil),                     // 22: types.QueryNodesRequest
+	(*QueryPoolSlipRequest)(nil),                  // 23: types.QueryPoolSlipRequest
+	(*QueryPoolSlipsRequest)(nil),                 // 24: types.QueryPoolSlipsRequest
+	(*QueryOutboundFeeRequest)(nil),               // 25: types.QueryOutboundFeeRequest
+	(*QueryOutboundFeesRequest)(nil),              // 26: types.QueryOutboundFeesRequest
+	(*QueryDexAggregatorsRequest)(nil),            // 27: types.QueryDexAggregatorsRequest
+	(*QueryStreamingSwapRequest)(nil),             // 28: types.QueryStreamingSwapRequest
+	(*QueryStreamingSwapsRequest)(nil),            // 29: types.QueryStreamingSwapsRequest
+	(*QueryBanRequest)(nil),                       // 30: types.QueryBanRequest
+	(*QueryRagnarokRequest)(nil),                  // 31: types.QueryRagnarokRequest
+	(*QuerySwitchPoolRequest)(nil),                // 32: types.QuerySwitchPoolRequest
+	(*QuerySWITCHProviderRequest)(nil),            // 33: types.QuerySWITCHProviderRequest
+	(*QuerySWITCHProvidersRequest)(nil),           // 34: types.QuerySWITCHProvidersRequest
+	(*QueryMimirValuesRequest)(nil),               // 35: types.QueryMimirValuesRequest
+	(*QueryMimirWithKeyRequest)(nil),              // 36: types.QueryMimirWithKeyRequest
+	(*QueryMimirAdminValuesRequest)(nil),          // 37: types.QueryMimirAdminValuesRequest
+	(*QueryMimirNodesAllValuesRequest)(nil),       // 38: types.QueryMimirNodesAllValuesRequest
+	(*QueryMimirNodesValuesRequest)(nil),          // 39: types.QueryMimirNodesValuesRequest
+	(*QueryMimirNodeValuesRequest)(nil),           // 40: types.QueryMimirNodeValuesRequest
+	(*QueryInboundAddressesRequest)(nil),          // 41: types.QueryInboundAddressesRequest
+	(*QueryVersionRequest)(nil),                   // 42: types.QueryVersionRequest
+	(*QuerySwitchlynameRequest)(nil),              // 43: types.QuerySwitchlynameRequest
+	(*QueryInvariantRequest)(nil),                 // 44: types.QueryInvariantRequest
+	(*QueryInvariantsRequest)(nil),                // 45: types.QueryInvariantsRequest
+	(*QueryNetworkRequest)(nil),                   // 46: types.QueryNetworkRequest
+	(*QueryBalanceModuleRequest)(nil),             // 47: types.QueryBalanceModuleRequest
+	(*QueryQuoteSwapRequest)(nil),                 // 48: types.QueryQuoteSwapRequest
+	(*QueryQuoteSwapBatchRequest)(nil),            // 49: types.QueryQuoteSwapBatchRequest
+	(*QueryQuoteSaverDepositRequest)(nil),         // 50: types.QueryQuoteSaverDepositRequest
+	(*QueryQuoteSaverWithdrawRequest)(nil),        // 51: types.QueryQuoteSaverWithdrawRequest
+	(*QueryQuoteLoanOpenRequest)(nil),             // 52: types.QueryQuoteLoanOpenRequest
+	(*QueryQuoteLoanCloseRequest)(nil),            // 53: types.QueryQuoteLoanCloseRequest
+	(*QueryConstantValuesRequest)(nil),            // 54: types.QueryConstantValuesRequest
+	(*QuerySwapQueueRequest)(nil),                 // 55: types.QuerySwapQueueRequest
+	(*QueryLimitSwapBookRequest)(nil),             // 56: types.QueryLimitSwapBookRequest
+	(*QueryLimitSwapsByAddressRequest)(nil),       // 57: types.QueryLimitSwapsByAddressRequest
+	(*QueryLastBlocksRequest)(nil),                // 58: types.QueryLastBlocksRequest
+	(*QueryChainsLastBlockRequest)(nil),           // 59: types.QueryChainsLastBlockRequest
+	(*QueryVaultRequest)(nil),                     // 60: types.QueryVaultRequest
+	(*QueryAsgardVaultsRequest)(nil),              // 61: types.QueryAsgardVaultsRequest
+	(*QueryVaultsPubkeysRequest)(nil),             // 62: types.QueryVaultsPubkeysRequest
+	(*QueryTxStagesRequest)(nil),                  // 63: types.QueryTxStagesRequest
+	(*QueryTxStatusRequest)(nil),                  // 64: types.QueryTxStatusRequest
+	(*QueryTxRequest)(nil),                        // 65: types.QueryTxRequest
+	(*QueryTxVotersRequest)(nil),                  // 66: types.QueryTxVotersRequest
+	(*QuerySwapperCloutRequest)(nil),              // 67: types.QuerySwapperCloutRequest
+	(*QueryQueueRequest)(nil),                     // 68: types.QueryQueueRequest
+	(*QueryScheduledOutboundRequest)(nil),         // 69: types.QueryScheduledOutboundRequest
+	(*QueryPendingOutboundRequest)(nil),           // 70: types.QueryPendingOutboundRequest
+	(*QueryBlockRequest)(nil),                     // 71: types.QueryBlockRequest
+	(*QueryTssKeygenMetricRequest)(nil),           // 72: types.QueryTssKeygenMetricRequest
+	(*QueryTssMetricRequest)(nil),                 // 73: types.QueryTssMetricRequest
+	(*QueryKeysignRequest)(nil),                   // 74: types.QueryKeysignRequest
+	(*QueryKeysignPubkeyRequest)(nil),             // 75: types.QueryKeysignPubkeyRequest
+	(*QueryKeygenRequest)(nil),                    // 76: types.QueryKeygenRequest
+	(*QueryUpgradeProposalsRequest)(nil),          // 77: types.QueryUpgradeProposalsRequest
+	(*QueryUpgradeProposalRequest)(nil),           // 78: types.QueryUpgradeProposalRequest
+	(*QueryUpgradeVotesRequest)(nil),              // 79: types.QueryUpgradeVotesRequest
+	(*QuerySWCYStakerRequest)(nil),                // 80: types.QuerySWCYStakerRequest
+	(*QuerySWCYStakersRequest)(nil),               // 81: types.QuerySWCYStakersRequest
+	(*QuerySWCYClaimerRequest)(nil),               // 82: types.QuerySWCYClaimerRequest
+	(*QuerySWCYClaimersRequest)(nil),              // 83: types.QuerySWCYClaimersRequest
+	(*QueryCodesRequest)(nil),                     // 84: types.QueryCodesRequest
+	(*QueryAccountResponse)(nil),                  // 85: types.QueryAccountResponse
+	(*QueryBalancesResponse)(nil),                 // 86: types.QueryBalancesResponse
+	(*QueryExportResponse)(nil),                   // 87: types.QueryExportResponse
+	(*QueryPoolResponse)(nil),                     // 88: types.QueryPoolResponse
+	(*QueryPoolsResponse)(nil),                    // 89: types.QueryPoolsResponse
+	(*QueryDerivedPoolResponse)(nil),              // 90: types.QueryDerivedPoolResponse
+	(*QueryDerivedPoolsResponse)(nil),             // 91: types.QueryDerivedPoolsResponse
+	(*QueryLiquidityProviderResponse)(nil),        // 92: types.QueryLiquidityProviderResponse
+	(*QueryLiquidityProvidersResponse)(nil),       // 93: types.QueryLiquidityProvidersResponse
+	(*QueryLiquidityProviderHistoryResponse)(nil), // 94: types.QueryLiquidityProviderHistoryResponse
+	(*QuerySaverResponse)(nil),                    // 95: types.QuerySaverResponse
+	(*QuerySaversResponse)(nil),                   // 96: types.QuerySaversResponse
+	(*QuerySaverHistoryResponse)(nil),             // 97: types.QuerySaverHistoryResponse
+	(*QueryBorrowerResponse)(nil),                 // 98: types.QueryBorrowerResponse
+	(*QueryBorrowersResponse)(nil),                // 99: types.QueryBorrowersResponse
+	(*QueryTradeUnitResponse)(nil),                // 100: types.QueryTradeUnitResponse
+	(*QueryTradeUnitsResponse)(nil),               // 101: types.QueryTradeUnitsResponse
+	(*QueryTradeAccountsResponse)(nil),            // 102: types.QueryTradeAccountsResponse
+	(*QuerySecuredAssetResponse)(nil),             // 103: types.QuerySecuredAssetResponse
+	(*QuerySecuredAssetsResponse)(nil),            // 104: types.QuerySecuredAssetsResponse
+	(*QueryNodeResponse)(nil),                     // 105: types.QueryNodeResponse
+	(*QueryNodesResponse)(nil),                    // 106: types.QueryNodesResponse
+	(*QueryPoolSlipsResponse)(nil),                // 107: types.QueryPoolSlipsResponse
+	(*QueryOutboundFeesResponse)(nil),             // 108: types.QueryOutboundFeesResponse
+	(*QueryDexAggregatorsResponse)(nil),           // 109: types.QueryDexAggregatorsResponse
+	(*QueryStreamingSwapResponse)(nil),            // 110: types.QueryStreamingSwapResponse
+	(*QueryStreamingSwapsResponse)(nil),           // 111: types.QueryStreamingSwapsResponse
+	(*BanVoter)(nil),                              // 112: types.BanVoter
+	(*QueryRagnarokResponse)(nil),                 // 113: types.QueryRagnarokResponse
+	(*QuerySwitchPoolResponse)(nil),               // 114: types.QuerySwitchPoolResponse
+	(*QuerySWITCHProviderResponse)(nil),           // 115: types.QuerySWITCHProviderResponse
+	(*QuerySWITCHProvidersResponse)(nil),          // 116: types.QuerySWITCHProvidersResponse
+	(*QueryMimirValuesResponse)(nil),              // 117: types.QueryMimirValuesResponse
+	(*QueryMimirWithKeyResponse)(nil),             // 118: types.QueryMimirWithKeyResponse
+	(*QueryMimirAdminValuesResponse)(nil),         // 119: types.QueryMimirAdminValuesResponse
+	(*QueryMimirNodesAllValuesResponse)(nil),      // 120: types.QueryMimirNodesAllValuesResponse
+	(*QueryMimirNodesValuesResponse)(nil),         // 121: types.QueryMimirNodesValuesResponse
+	(*QueryMimirNodeValuesResponse)(nil),          // 122: types.QueryMimirNodeValuesResponse
+	(*QueryInboundAddressesResponse)(nil),         // 123: types.QueryInboundAddressesResponse
+	(*QueryVersionResponse)(nil),                  // 124: types.QueryVersionResponse
+	(*QuerySwitchlynameResponse)(nil),             // 125: types.QuerySwitchlynameResponse
+	(*QueryInvariantResponse)(nil),                // 126: types.QueryInvariantResponse
+	(*QueryInvariantsResponse)(nil),               // 127: types.QueryInvariantsResponse
+	(*QueryNetworkResponse)(nil),                  // 128: types.QueryNetworkResponse
+	(*QueryBalanceModuleResponse)(nil),            // 129: types.QueryBalanceModuleResponse
+	(*QueryQuoteSwapResponse)(nil),                // 130: types.QueryQuoteSwapResponse
+	(*QueryQuoteSwapBatchResponse)(nil),           // 131: types.QueryQuoteSwapBatchResponse
+	(*QueryQuoteSaverDepositResponse)(nil),        // 132: types.QueryQuoteSaverDepositResponse
+	(*QueryQuoteSaverWithdrawResponse)(nil),       // 133: types.QueryQuoteSaverWithdrawResponse
+	(*QueryQuoteLoanOpenResponse)(nil),            // 134: types.QueryQuoteLoanOpenResponse
+	(*QueryQuoteLoanCloseResponse)(nil),           // 135: types.QueryQuoteLoanCloseResponse
+	(*QueryConstantValuesResponse)(nil),           // 136: types.QueryConstantValuesResponse
+	(*QuerySwapQueueResponse)(nil),                // 137: types.QuerySwapQueueResponse
+	(*QueryLimitSwapBookResponse)(nil),            // 138: types.QueryLimitSwapBookResponse
+	(*QueryLimitSwapsByAddressResponse)(nil),      // 139: types.QueryLimitSwapsByAddressResponse
+	(*QueryLastBlocksResponse)(nil),               // 140: types.QueryLastBlocksResponse
+	(*QueryVaultResponse)(nil),                    // 141: types.QueryVaultResponse
+	(*QueryAsgardVaultsResponse)(nil),             // 142: types.QueryAsgardVaultsResponse
+	(*QueryVaultsPubkeysResponse)(nil),            // 143: types.QueryVaultsPubkeysResponse
+	(*QueryTxStagesResponse)(nil),                 // 144: types.QueryTxStagesResponse
+	(*QueryTxStatusResponse)(nil),                 // 145: types.QueryTxStatusResponse
+	(*QueryTxResponse)(nil),                       // 146: types.QueryTxResponse
+	(*QueryObservedTxVoter)(nil),                  // 147: types.QueryObservedTxVoter
+	(*SwapperClout)(nil),                          // 148: types.SwapperClout
+	(*QueryQueueResponse)(nil),                    // 149: types.QueryQueueResponse
+	(*QueryOutboundResponse)(nil),                 // 150: types.QueryOutboundResponse
+	(*QueryBlockResponse)(nil),                    // 151: types.QueryBlockResponse
+	(*QueryTssKeygenMetricResponse)(nil),          // 152: types.QueryTssKeygenMetricResponse
+	(*QueryTssMetricResponse)(nil),                // 153: types.QueryTssMetricResponse
+	(*QueryKeysignResponse)(nil),                  // 154: types.QueryKeysignResponse
+	(*QueryKeygenResponse)(nil),                   // 155: types.QueryKeygenResponse
+	(*QueryUpgradeProposalsResponse)(nil),         // 156: types.QueryUpgradeProposalsResponse
+	(*QueryUpgradeProposalResponse)(nil),          // 157: types.QueryUpgradeProposalResponse
+	(*QueryUpgradeVotesResponse)(nil),             // 158: types.QueryUpgradeVotesResponse
+	(*QuerySWCYStakerResponse)(nil),               // 159: types.QuerySWCYStakerResponse
+	(*QuerySWCYStakersResponse)(nil),              // 160: types.QuerySWCYStakersResponse
+	(*QuerySWCYClaimerResponse)(nil),              // 161: types.QuerySWCYClaimerResponse
+	(*QuerySWCYClaimersResponse)(nil),             // 162: types.QuerySWCYClaimersResponse
+	(*QueryCodesResponse)(nil),                    // 163: types.QueryCodesResponse
 }
 var file_types_query_proto_depIdxs = []int32{
 	0,   // 0: types.Query.Account:input_type -> types.QueryAccountRequest
@@ -857,159 +925,171 @@ var file_types_query_proto_depIdxs = []int32{
 	6,   // 6: types.Query.DerivedPools:input_type -> types.QueryDerivedPoolsRequest
 	7,   // 7: types.Query.LiquidityProvider:input_type -> types.QueryLiquidityProviderRequest
 	8,   // 8: types.Query.LiquidityProviders:input_type -> types.QueryLiquidityProvidersRequest
-	9,   // 9: types.Query.Saver:input_type -> types.QuerySaverRequest
-	10,  // 10: types.Query.Savers:input_type -> types.QuerySaversRequest
-	11,  // 11: types.Query.Borrower:input_type -> types.QueryBorrowerRequest
-	12,  // 12: types.Query.Borrowers:input_type -> types.QueryBorrowersRequest
-	13,  // 13: types.Query.TradeUnit:input_type -> types.QueryTradeUnitRequest
-	14,  // 14: types.Query.TradeUnits:input_type -> types.QueryTradeUnitsRequest
-	15,  // 15: types.Query.TradeAccount:input_type -> types.QueryTradeAccountRequest
-	16,  // 16: types.Query.TradeAccounts:input_type -> types.QueryTradeAccountsRequest
-	17,  // 17: types.Query.SecuredAsset:input_type -> types.QuerySecuredAssetRequest
-	18,  // 18: types.Query.SecuredAssets:input_type -> types.QuerySecuredAssetsRequest
-	19,  // 19: types.Query.Node:input_type -> types.QueryNodeRequest
-	20,  // 20: types.Query.Nodes:input_type -> types.QueryNodesRequest
-	21,  // 21: types.Query.PoolSlip:input_type -> types.QueryPoolSlipRequest
-	22,  // 22: types.Query.PoolSlips:input_type -> types.QueryPoolSlipsRequest
-	23,  // 23: types.Query.OutboundFee:input_type -> types.QueryOutboundFeeRequest
-	24,  // 24: types.Query.OutboundFees:input_type -> types.QueryOutboundFeesRequest
-	25,  // 25: types.Query.StreamingSwap:input_type -> types.QueryStreamingSwapRequest
-	26,  // 26: types.Query.StreamingSwaps:input_type -> types.QueryStreamingSwapsRequest
-	27,  // 27: types.Query.Ban:input_type -> types.QueryBanRequest
-	28,  // 28: types.Query.Ragnarok:input_type -> types.QueryRagnarokRequest
-	29,  // 29: types.Query.SwitchPool:input_type -> types.QuerySwitchPoolRequest
-	30,  // 30: types.Query.SWITCHProvider:input_type -> types.QuerySWITCHProviderRequest
-	31,  // 31: types.Query.SWITCHProviders:input_type -> types.QuerySWITCHProvidersRequest
-	32,  // 32: types.Query.MimirValues:input_type -> types.QueryMimirValuesRequest
-	33,  // 33: types.Query.MimirWithKey:input_type -> types.QueryMimirWithKeyRequest
-	34,  // 34: types.Query.MimirAdminValues:input_type -> types.QueryMimirAdminValuesRequest
-	35,  // 35: types.Query.MimirNodesAllValues:input_type -> types.QueryMimirNodesAllValuesRequest
-	36,  // 36: types.Query.MimirNodesValues:input_type -> types.QueryMimirNodesValuesRequest
-	37,  // 37: types.Query.MimirNodeValues:input_type -> types.QueryMimirNodeValuesRequest
-	38,  // 38: types.Query.InboundAddresses:input_type -> types.QueryInboundAddressesRequest
-	39,  // 39: types.Query.Version:input_type -> types.QueryVersionRequest
-	40,  // 40: types.Query.Switchlyname:input_type -> types.QuerySwitchlynameRequest
-	41,  // 41: types.Query.Invariant:input_type -> types.QueryInvariantRequest
-	42,  // 42: types.Query.Invariants:input_type -> types.QueryInvariantsRequest
-	43,  // 43: types.Query.Network:input_type -> types.QueryNetworkRequest
-	44,  // 44: types.Query.BalanceModule:input_type -> types.QueryBalanceModuleRequest
-	45,  // 45: types.Query.QuoteSwap:input_type -> types.QueryQuoteSwapRequest
-	46,  // 46: types.Query.QuoteSaverDeposit:input_type -> types.QueryQuoteSaverDepositRequest
-	47,  // 47: types.Query.QuoteSaverWithdraw:input_type -> types.QueryQuoteSaverWithdrawRequest
-	48,  // 48: types.Query.QuoteLoanOpen:input_type -> types.QueryQuoteLoanOpenRequest
-	49,  // 49: types.Query.QuoteLoanClose:input_type -> types.QueryQuoteLoanCloseRequest
-	50,  // 50: types.Query.ConstantValues:input_type -> types.QueryConstantValuesRequest
-	51,  // 51: types.Query.SwapQueue:input_type -> types.QuerySwapQueueRequest
-	52,  // 52: types.Query.LastBlocks:input_type -> types.QueryLastBlocksRequest
-	53,  // 53: types.Query.ChainsLastBlock:input_type -> types.QueryChainsLastBlockRequest
-	54,  // 54: types.Query.Vault:input_type -> types.QueryVaultRequest
-	55,  // 55: types.Query.AsgardVaults:input_type -> types.QueryAsgardVaultsRequest
-	56,  // 56: types.Query.VaultsPubkeys:input_type -> types.QueryVaultsPubkeysRequest
-	57,  // 57: types.Query.TxStages:input_type -> types.QueryTxStagesRequest
-	58,  // 58: types.Query.TxStatus:input_type -> types.QueryTxStatusRequest
-	59,  // 59: types.Query.Tx:input_type -> types.QueryTxRequest
-	60,  // 60: types.Query.TxVoters:input_type -> types.QueryTxVotersRequest
-	60,  // 61: types.Query.TxVotersOld:input_type -> types.QueryTxVotersRequest
-	61,  // 62: types.Query.Clout:input_type -> types.QuerySwapperCloutRequest
-	62,  // 63: types.Query.Queue:input_type -> types.QueryQueueRequest
-	63,  // 64: types.Query.ScheduledOutbound:input_type -> types.QueryScheduledOutboundRequest
-	64,  // 65: types.Query.PendingOutbound:input_type -> types.QueryPendingOutboundRequest
-	65,  // 66: types.Query.Block:input_type -> types.QueryBlockRequest
-	66,  // 67: types.Query.TssKeygenMetric:input_type -> types.QueryTssKeygenMetricRequest
-	67,  // 68: types.Query.TssMetric:input_type -> types.QueryTssMetricRequest
-	68,  // 69: types.Query.Keysign:input_type -> types.QueryKeysignRequest
-	69,  // 70: types.Query.KeysignPubkey:input_type -> types.QueryKeysignPubkeyRequest
-	70,  // 71: types.Query.Keygen:input_type -> types.QueryKeygenRequest
-	71,  // 72: types.Query.UpgradeProposals:input_type -> types.QueryUpgradeProposalsRequest
-	72,  // 73: types.Query.UpgradeProposal:input_type -> types.QueryUpgradeProposalRequest
-	73,  // 74: types.Query.UpgradeVotes:input_type -> types.QueryUpgradeVotesRequest
-	74,  // 75: types.Query.SWCYStaker:input_type -> types.QuerySWCYStakerRequest
-	75,  // 76: types.Query.SWCYStakers:input_type -> types.QuerySWCYStakersRequest
-	76,  // 77: types.Query.SWCYClaimer:input_type -> types.QuerySWCYClaimerRequest
-	77,  // 78: types.Query.SWCYClaimers:input_type -> types.QuerySWCYClaimersRequest
-	78,  // 79: types.Query.Codes:input_type -> types.QueryCodesRequest
-	79,  // 80: types.Query.Account:output_type -> types.QueryAccountResponse
-	80,  // 81: types.Query.Balances:output_type -> types.QueryBalancesResponse
-	81,  // 82: types.Query.Export:output_type -> types.QueryExportResponse
-	82,  // 83: types.Query.Pool:output_type -> types.QueryPoolResponse
-	83,  // 84: types.Query.Pools:output_type -> types.QueryPoolsResponse
-	84,  // 85: types.Query.DerivedPool:output_type -> types.QueryDerivedPoolResponse
-	85,  // 86: types.Query.DerivedPools:output_type -> types.QueryDerivedPoolsResponse
-	86,  // 87: types.Query.LiquidityProvider:output_type -> types.QueryLiquidityProviderResponse
-	87,  // 88: types.Query.LiquidityProviders:output_type -> types.QueryLiquidityProvidersResponse
-	88,  // 89: types.Query.Saver:output_type -> types.QuerySaverResponse
-	89,  // 90: types.Query.Savers:output_type -> types.QuerySaversResponse
-	90,  // 91: types.Query.Borrower:output_type -> types.QueryBorrowerResponse
-	91,  // 92: types.Query.Borrowers:output_type -> types.QueryBorrowersResponse
-	92,  // 93: types.Query.TradeUnit:output_type -> types.QueryTradeUnitResponse
-	93,  // 94: types.Query.TradeUnits:output_type -> types.QueryTradeUnitsResponse
-	94,  // 95: types.Query.TradeAccount:output_type -> types.QueryTradeAccountsResponse
-	94,  // 96: types.Query.TradeAccounts:output_type -> types.QueryTradeAccountsResponse
-	95,  // 97: types.Query.SecuredAsset:output_type -> types.QuerySecuredAssetResponse
-	96,  // 98: types.Query.SecuredAssets:output_type -> types.QuerySecuredAssetsResponse
-	97,  // 99: types.Query.Node:output_type -> types.QueryNodeResponse
-	98,  // 100: types.Query.Nodes:output_type -> types.QueryNodesResponse
-	99,  // 101: types.Query.PoolSlip:output_type -> types.QueryPoolSlipsResponse
-	99,  // 102: types.Query.PoolSlips:output_type -> types.QueryPoolSlipsResponse
-	100, // 103: types.Query.OutboundFee:output_type -> types.QueryOutboundFeesResponse
-	100, // 104: types.Query.OutboundFees:output_type -> types.QueryOutboundFeesResponse
-	101, // 105: types.Query.StreamingSwap:output_type -> types.QueryStreamingSwapResponse
-	102, // 106: types.Query.StreamingSwaps:output_type -> types.QueryStreamingSwapsResponse
-	103, // 107: types.Query.Ban:output_type -> types.BanVoter
-	104, // 108: types.Query.Ragnarok:output_type -> types.QueryRagnarokResponse
-	105, // 109: types.Query.SwitchPool:output_type -> types.QuerySwitchPoolResponse
-	106, // 110: types.Query.SWITCHProvider:output_type -> types.QuerySWITCHProviderResponse
-	107, // 111: types.Query.SWITCHProviders:output_type -> types.QuerySWITCHProvidersResponse
-	108, // 112: types.Query.MimirValues:output_type -> types.QueryMimirValuesResponse
-	109, // 113: types.Query.MimirWithKey:output_type -> types.QueryMimirWithKeyResponse
-	110, // 114: types.Query.MimirAdminValues:output_type -> types.QueryMimirAdminValuesResponse
-	111, // 115: types.Query.MimirNodesAllValues:output_type -> types.QueryMimirNodesAllValuesResponse
-	112, // 116: types.Query.MimirNodesValues:output_type -> types.QueryMimirNodesValuesResponse
-	113, // 117: types.Query.MimirNodeValues:output_type -> types.QueryMimirNodeValuesResponse
-	114, // 118: types.Query.InboundAddresses:output_type -> types.QueryInboundAddressesResponse
-	115, // 119: types.Query.Version:output_type -> types.QueryVersionResponse
-	116, // 120: types.Query.Switchlyname:output_type -> types.QuerySwitchlynameResponse
-	117, // 121: types.Query.Invariant:output_type -> types.QueryInvariantResponse
-	118, // 122: types.Query.Invariants:output_type -> types.QueryInvariantsResponse
-	119, // 123: types.Query.Network:output_type -> types.QueryNetworkResponse
-	120, // 124: types.Query.BalanceModule:output_type -> types.QueryBalanceModuleResponse
-	121, // 125: types.Query.QuoteSwap:output_type -> types.QueryQuoteSwapResponse
-	122, // 126: types.Query.QuoteSaverDeposit:output_type -> types.QueryQuoteSaverDepositResponse
-	123, // 127: types.Query.QuoteSaverWithdraw:output_type -> types.QueryQuoteSaverWithdrawResponse
-	124, // 128: types.Query.QuoteLoanOpen:output_type -> types.QueryQuoteLoanOpenResponse
-	125, // 129: types.Query.QuoteLoanClose:output_type -> types.QueryQuoteLoanCloseResponse
-	126, // 130: types.Query.ConstantValues:output_type -> types.QueryConstantValuesResponse
-	127, // 131: types.Query.SwapQueue:output_type -> types.QuerySwapQueueResponse
-	128, // 132: types.Query.LastBlocks:output_type -> types.QueryLastBlocksResponse
-	128, // 133: types.Query.ChainsLastBlock:output_type -> types.QueryLastBlocksResponse
-	129, // 134: types.Query.Vault:output_type -> types.QueryVaultResponse
-	130, // 135: types.Query.AsgardVaults:output_type -> types.QueryAsgardVaultsResponse
-	131, // 136: types.Query.VaultsPubkeys:output_type -> types.QueryVaultsPubkeysResponse
-	132, // 137: types.Query.TxStages:output_type -> types.QueryTxStagesResponse
-	133, // 138: types.Query.TxStatus:output_type -> types.QueryTxStatusResponse
-	134, // 139: types.Query.Tx:output_type -> types.QueryTxResponse
-	135, // 140: types.Query.TxVoters:output_type -> types.QueryObservedTxVoter
-	135, // 141: types.Query.TxVotersOld:output_type -> types.QueryObservedTxVoter
-	136, // 142: types.Query.Clout:output_type -> types.SwapperClout
-	137, // 143: types.Query.Queue:output_type -> types.QueryQueueResponse
-	138, // 144: types.Query.ScheduledOutbound:output_type -> types.QueryOutboundResponse
-	138, // 145: types.Query.PendingOutbound:output_type -> types.QueryOutboundResponse
-	139, // 146: types.Query.Block:output_type -> types.QueryBlockResponse
-	140, // 147: types.Query.TssKeygenMetric:output_type -> types.QueryTssKeygenMetricResponse
-	141, // 148: types.Query.TssMetric:output_type -> types.QueryTssMetricResponse
-	142, // 149: types.Query.Keysign:output_type -> types.QueryKeysignResponse
-	142, // 150: types.Query.KeysignPubkey:output_type -> types.QueryKeysignResponse
-	143, // 151: types.Query.Keygen:output_type -> types.QueryKeygenResponse
-	144, // 152: types.Query.UpgradeProposals:output_type -> types.QueryUpgradeProposalsResponse
-	145, // 153: types.Query.UpgradeProposal:output_type -> types.QueryUpgradeProposalResponse
-	146, // 154: types.Query.UpgradeVotes:output_type -> types.QueryUpgradeVotesResponse
-	147, // 155: types.Query.SWCYStaker:output_type -> types.QuerySWCYStakerResponse
-	148, // 156: types.Query.SWCYStakers:output_type -> types.QuerySWCYStakersResponse
-	149, // 157: types.Query.SWCYClaimer:output_type -> types.QuerySWCYClaimerResponse
-	150, // 158: types.Query.SWCYClaimers:output_type -> types.QuerySWCYClaimersResponse
-	151, // 159: types.Query.Codes:output_type -> types.QueryCodesResponse
-	80,  // [80:160] is the sub-list for method output_type
-	0,   // [0:80] is the sub-list for method input_type
+	9,   // 9: types.Query.LiquidityProviderHistory:input_type -> types.QueryLiquidityProviderHistoryRequest
+	10,  // 10: types.Query.Saver:input_type -> types.QuerySaverRequest
+	11,  // 11: types.Query.Savers:input_type -> types.QuerySaversRequest
+	12,  // 12: types.Query.SaverHistory:input_type -> types.QuerySaverHistoryRequest
+	13,  // 13: types.Query.Borrower:input_type -> types.QueryBorrowerRequest
+	14,  // 14: types.Query.Borrowers:input_type -> types.QueryBorrowersRequest
+	15,  // 15: types.Query.TradeUnit:input_type -> types.QueryTradeUnitRequest
+	16,  // 16: types.Query.TradeUnits:input_type -> types.QueryTradeUnitsRequest
+	17,  // 17: types.Query.TradeAccount:input_type -> types.QueryTradeAccountRequest
+	18,  // 18: types.Query.TradeAccounts:input_type -> types.QueryTradeAccountsRequest
+	19,  // 19: types.Query.SecuredAsset:input_type -> types.QuerySecuredAssetRequest
+	20,  // 20: types.Query.SecuredAssets:input_type -> types.QuerySecuredAssetsRequest
+	21,  // 21: types.Query.Node:input_type -> types.QueryNodeRequest
+	22,  // 22: types.Query.Nodes:input_type -> types.QueryNodesRequest
+	23,  // 23: types.Query.PoolSlip:input_type -> types.QueryPoolSlipRequest
+	24,  // 24: types.Query.PoolSlips:input_type -> types.QueryPoolSlipsRequest
+	25,  // 25: types.Query.OutboundFee:input_type -> types.QueryOutboundFeeRequest
+	26,  // 26: types.Query.OutboundFees:input_type -> types.QueryOutboundFeesRequest
+	27,  // 27: types.Query.DexAggregators:input_type -> types.QueryDexAggregatorsRequest
+	28,  // 28: types.Query.StreamingSwap:input_type -> types.QueryStreamingSwapRequest
+	29,  // 29: types.Query.StreamingSwaps:input_type -> types.QueryStreamingSwapsRequest
+	30,  // 30: types.Query.Ban:input_type -> types.QueryBanRequest
+	31,  // 31: types.Query.Ragnarok:input_type -> types.QueryRagnarokRequest
+	32,  // 32: types.Query.SwitchPool:input_type -> types.QuerySwitchPoolRequest
+	33,  // 33: types.Query.SWITCHProvider:input_type -> types.QuerySWITCHProviderRequest
+	34,  // 34: types.Query.SWITCHProviders:input_type -> types.QuerySWITCHProvidersRequest
+	35,  // 35: types.Query.MimirValues:input_type -> types.QueryMimirValuesRequest
+	36,  // 36: types.Query.MimirWithKey:input_type -> types.QueryMimirWithKeyRequest
+	37,  // 37: types.Query.MimirAdminValues:input_type -> types.QueryMimirAdminValuesRequest
+	38,  // 38: types.Query.MimirNodesAllValues:input_type -> types.QueryMimirNodesAllValuesRequest
+	39,  // 39: types.Query.MimirNodesValues:input_type -> types.QueryMimirNodesValuesRequest
+	40,  // 40: types.Query.MimirNodeValues:input_type -> types.QueryMimirNodeValuesRequest
+	41,  // 41: types.Query.InboundAddresses:input_type -> types.QueryInboundAddressesRequest
+	42,  // 42: types.Query.Version:input_type -> types.QueryVersionRequest
+	43,  // 43: types.Query.Switchlyname:input_type -> types.QuerySwitchlynameRequest
+	44,  // 44: types.Query.Invariant:input_type -> types.QueryInvariantRequest
+	45,  // 45: types.Query.Invariants:input_type -> types.QueryInvariantsRequest
+	46,  // 46: types.Query.Network:input_type -> types.QueryNetworkRequest
+	47,  // 47: types.Query.BalanceModule:input_type -> types.QueryBalanceModuleRequest
+	48,  // 48: types.Query.QuoteSwap:input_type -> types.QueryQuoteSwapRequest
+	49,  // 49: types.Query.QuoteSwapBatch:input_type -> types.QueryQuoteSwapBatchRequest
+	50,  // 50: types.Query.QuoteSaverDeposit:input_type -> types.QueryQuoteSaverDepositRequest
+	51,  // 51: types.Query.QuoteSaverWithdraw:input_type -> types.QueryQuoteSaverWithdrawRequest
+	52,  // 52: types.Query.QuoteLoanOpen:input_type -> types.QueryQuoteLoanOpenRequest
+	53,  // 53: types.Query.QuoteLoanClose:input_type -> types.QueryQuoteLoanCloseRequest
+	54,  // 54: types.Query.ConstantValues:input_type -> types.QueryConstantValuesRequest
+	55,  // 55: types.Query.SwapQueue:input_type -> types.QuerySwapQueueRequest
+	56,  // 56: types.Query.LimitSwapBook:input_type -> types.QueryLimitSwapBookRequest
+	57,  // 57: types.Query.LimitSwapsByAddress:input_type -> types.QueryLimitSwapsByAddressRequest
+	58,  // 58: types.Query.LastBlocks:input_type -> types.QueryLastBlocksRequest
+	59,  // 59: types.Query.ChainsLastBlock:input_type -> types.QueryChainsLastBlockRequest
+	60,  // 60: types.Query.Vault:input_type -> types.QueryVaultRequest
+	61,  // 61: types.Query.AsgardVaults:input_type -> types.QueryAsgardVaultsRequest
+	62,  // 62: types.Query.VaultsPubkeys:input_type -> types.QueryVaultsPubkeysRequest
+	63,  // 63: types.Query.TxStages:input_type -> types.QueryTxStagesRequest
+	64,  // 64: types.Query.TxStatus:input_type -> types.QueryTxStatusRequest
+	65,  // 65: types.Query.Tx:input_type -> types.QueryTxRequest
+	66,  // 66: types.Query.TxVoters:input_type -> types.QueryTxVotersRequest
+	66,  // 67: types.Query.TxVotersOld:input_type -> types.QueryTxVotersRequest
+	67,  // 68: types.Query.Clout:input_type -> types.QuerySwapperCloutRequest
+	68,  // 69: types.Query.Queue:input_type -> types.QueryQueueRequest
+	69,  // 70: types.Query.ScheduledOutbound:input_type -> types.QueryScheduledOutboundRequest
+	70,  // 71: types.Query.PendingOutbound:input_type -> types.QueryPendingOutboundRequest
+	71,  // 72: types.Query.Block:input_type -> types.QueryBlockRequest
+	72,  // 73: types.Query.TssKeygenMetric:input_type -> types.QueryTssKeygenMetricRequest
+	73,  // 74: types.Query.TssMetric:input_type -> types.QueryTssMetricRequest
+	74,  // 75: types.Query.Keysign:input_type -> types.QueryKeysignRequest
+	75,  // 76: types.Query.KeysignPubkey:input_type -> types.QueryKeysignPubkeyRequest
+	76,  // 77: types.Query.Keygen:input_type -> types.QueryKeygenRequest
+	77,  // 78: types.Query.UpgradeProposals:input_type -> types.QueryUpgradeProposalsRequest
+	78,  // 79: types.Query.UpgradeProposal:input_type -> types.QueryUpgradeProposalRequest
+	79,  // 80: types.Query.UpgradeVotes:input_type -> types.QueryUpgradeVotesRequest
+	80,  // 81: types.Query.SWCYStaker:input_type -> types.QuerySWCYStakerRequest
+	81,  // 82: types.Query.SWCYStakers:input_type -> types.QuerySWCYStakersRequest
+	82,  // 83: types.Query.SWCYClaimer:input_type -> types.QuerySWCYClaimerRequest
+	83,  // 84: types.Query.SWCYClaimers:input_type -> types.QuerySWCYClaimersRequest
+	84,  // 85: types.Query.Codes:input_type -> types.QueryCodesRequest
+	85,  // 86: types.Query.Account:output_type -> types.QueryAccountResponse
+	86,  // 87: types.Query.Balances:output_type -> types.QueryBalancesResponse
+	87,  // 88: types.Query.Export:output_type -> types.QueryExportResponse
+	88,  // 89: types.Query.Pool:output_type -> types.QueryPoolResponse
+	89,  // 90: types.Query.Pools:output_type -> types.QueryPoolsResponse
+	90,  // 91: types.Query.DerivedPool:output_type -> types.QueryDerivedPoolResponse
+	91,  // 92: types.Query.DerivedPools:output_type -> types.QueryDerivedPoolsResponse
+	92,  // 93: types.Query.LiquidityProvider:output_type -> types.QueryLiquidityProviderResponse
+	93,  // 94: types.Query.LiquidityProviders:output_type -> types.QueryLiquidityProvidersResponse
+	94,  // 95: types.Query.LiquidityProviderHistory:output_type -> types.QueryLiquidityProviderHistoryResponse
+	95,  // 96: types.Query.Saver:output_type -> types.QuerySaverResponse
+	96,  // 97: types.Query.Savers:output_type -> types.QuerySaversResponse
+	97,  // 98: types.Query.SaverHistory:output_type -> types.QuerySaverHistoryResponse
+	98,  // 99: types.Query.Borrower:output_type -> types.QueryBorrowerResponse
+	99,  // 100: types.Query.Borrowers:output_type -> types.QueryBorrowersResponse
+	100, // 101: types.Query.TradeUnit:output_type -> types.QueryTradeUnitResponse
+	101, // 102: types.Query.TradeUnits:output_type -> types.QueryTradeUnitsResponse
+	102, // 103: types.Query.TradeAccount:output_type -> types.QueryTradeAccountsResponse
+	102, // 104: types.Query.TradeAccounts:output_type -> types.QueryTradeAccountsResponse
+	103, // 105: types.Query.SecuredAsset:output_type -> types.QuerySecuredAssetResponse
+	104, // 106: types.Query.SecuredAssets:output_type -> types.QuerySecuredAssetsResponse
+	105, // 107: types.Query.Node:output_type -> types.QueryNodeResponse
+	106, // 108: types.Query.Nodes:output_type -> types.QueryNodesResponse
+	107, // 109: types.Query.PoolSlip:output_type -> types.QueryPoolSlipsResponse
+	107, // 110: types.Query.PoolSlips:output_type -> types.QueryPoolSlipsResponse
+	108, // 111: types.Query.OutboundFee:output_type -> types.QueryOutboundFeesResponse
+	108, // 112: types.Query.OutboundFees:output_type -> types.QueryOutboundFeesResponse
+	109, // 113: types.Query.DexAggregators:output_type -> types.QueryDexAggregatorsResponse
+	110, // 114: types.Query.StreamingSwap:output_type -> types.QueryStreamingSwapResponse
+	111, // 115: types.Query.StreamingSwaps:output_type -> types.QueryStreamingSwapsResponse
+	112, // 116: types.Query.Ban:output_type -> types.BanVoter
+	113, // 117: types.Query.Ragnarok:output_type -> types.QueryRagnarokResponse
+	114, // 118: types.Query.SwitchPool:output_type -> types.QuerySwitchPoolResponse
+	115, // 119: types.Query.SWITCHProvider:output_type -> types.QuerySWITCHProviderResponse
+	116, // 120: types.Query.SWITCHProviders:output_type -> types.QuerySWITCHProvidersResponse
+	117, // 121: types.Query.MimirValues:output_type -> types.QueryMimirValuesResponse
+	118, // 122: types.Query.MimirWithKey:output_type -> types.QueryMimirWithKeyResponse
+	119, // 123: types.Query.MimirAdminValues:output_type -> types.QueryMimirAdminValuesResponse
+	120, // 124: types.Query.MimirNodesAllValues:output_type -> types.QueryMimirNodesAllValuesResponse
+	121, // 125: types.Query.MimirNodesValues:output_type -> types.QueryMimirNodesValuesResponse
+	122, // 126: types.Query.MimirNodeValues:output_type -> types.QueryMimirNodeValuesResponse
+	123, // 127: types.Query.InboundAddresses:output_type -> types.QueryInboundAddressesResponse
+	124, // 128: types.Query.Version:output_type -> types.QueryVersionResponse
+	125, // 129: types.Query.Switchlyname:output_type -> types.QuerySwitchlynameResponse
+	126, // 130: types.Query.Invariant:output_type -> types.QueryInvariantResponse
+	127, // 131: types.Query.Invariants:output_type -> types.QueryInvariantsResponse
+	128, // 132: types.Query.Network:output_type -> types.QueryNetworkResponse
+	129, // 133: types.Query.BalanceModule:output_type -> types.QueryBalanceModuleResponse
+	130, // 134: types.Query.QuoteSwap:output_type -> types.QueryQuoteSwapResponse
+	131, // 135: types.Query.QuoteSwapBatch:output_type -> types.QueryQuoteSwapBatchResponse
+	132, // 136: types.Query.QuoteSaverDeposit:output_type -> types.QueryQuoteSaverDepositResponse
+	133, // 137: types.Query.QuoteSaverWithdraw:output_type -> types.QueryQuoteSaverWithdrawResponse
+	134, // 138: types.Query.QuoteLoanOpen:output_type -> types.QueryQuoteLoanOpenResponse
+	135, // 139: types.Query.QuoteLoanClose:output_type -> types.QueryQuoteLoanCloseResponse
+	136, // 140: types.Query.ConstantValues:output_type -> types.QueryConstantValuesResponse
+	137, // 141: types.Query.SwapQueue:output_type -> types.QuerySwapQueueResponse
+	138, // 142: types.Query.LimitSwapBook:output_type -> types.QueryLimitSwapBookResponse
+	139, // 143: types.Query.LimitSwapsByAddress:output_type -> types.QueryLimitSwapsByAddressResponse
+	140, // 144: types.Query.LastBlocks:output_type -> types.QueryLastBlocksResponse
+	140, // 145: types.Query.ChainsLastBlock:output_type -> types.QueryLastBlocksResponse
+	141, // 146: types.Query.Vault:output_type -> types.QueryVaultResponse
+	142, // 147: types.Query.AsgardVaults:output_type -> types.QueryAsgardVaultsResponse
+	143, // 148: types.Query.VaultsPubkeys:output_type -> types.QueryVaultsPubkeysResponse
+	144, // 149: types.Query.TxStages:output_type -> types.QueryTxStagesResponse
+	145, // 150: types.Query.TxStatus:output_type -> types.QueryTxStatusResponse
+	146, // 151: types.Query.Tx:output_type -> types.QueryTxResponse
+	147, // 152: types.Query.TxVoters:output_type -> types.QueryObservedTxVoter
+	147, // 153: types.Query.TxVotersOld:output_type -> types.QueryObservedTxVoter
+	148, // 154: types.Query.Clout:output_type -> types.SwapperClout
+	149, // 155: types.Query.Queue:output_type -> types.QueryQueueResponse
+	150, // 156: types.Query.ScheduledOutbound:output_type -> types.QueryOutboundResponse
+	150, // 157: types.Query.PendingOutbound:output_type -> types.QueryOutboundResponse
+	151, // 158: types.Query.Block:output_type -> types.QueryBlockResponse
+	152, // 159: types.Query.TssKeygenMetric:output_type -> types.QueryTssKeygenMetricResponse
+	153, // 160: types.Query.TssMetric:output_type -> types.QueryTssMetricResponse
+	154, // 161: types.Query.Keysign:output_type -> types.QueryKeysignResponse
+	154, // 162: types.Query.KeysignPubkey:output_type -> types.QueryKeysignResponse
+	155, // 163: types.Query.Keygen:output_type -> types.QueryKeygenResponse
+	156, // 164: types.Query.UpgradeProposals:output_type -> types.QueryUpgradeProposalsResponse
+	157, // 165: types.Query.UpgradeProposal:output_type -> types.QueryUpgradeProposalResponse
+	158, // 166: types.Query.UpgradeVotes:output_type -> types.QueryUpgradeVotesResponse
+	159, // 167: types.Query.SWCYStaker:output_type -> types.QuerySWCYStakerResponse
+	160, // 168: types.Query.SWCYStakers:output_type -> types.QuerySWCYStakersResponse
+	161, // 169: types.Query.SWCYClaimer:output_type -> types.QuerySWCYClaimerResponse
+	162, // 170: types.Query.SWCYClaimers:output_type -> types.QuerySWCYClaimersResponse
+	163, // 171: types.Query.Codes:output_type -> types.QueryCodesResponse
+	86,  // [86:172] is the sub-list for method output_type
+	0,   // [0:86] is the sub-list for method input_type
 	0,   // [0:0] is the sub-list for extension type_name
 	0,   // [0:0] is the sub-list for extension extendee
 	0,   // [0:0] is the sub-list for field type_name
@@ -1065,6 +1145,7 @@ func file_types_query_proto_init() {
 	file_types_query_swcy_stakers_proto_init()
 	file_types_query_swcy_claimers_proto_init()
 	file_types_query_codes_proto_init()
+	file_types_query_dex_aggregator_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
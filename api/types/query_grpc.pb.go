@@ -19,86 +19,92 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Account_FullMethodName             = "/types.Query/Account"
-	Query_Balances_FullMethodName            = "/types.Query/Balances"
-	Query_Export_FullMethodName              = "/types.Query/Export"
-	Query_Pool_FullMethodName                = "/types.Query/Pool"
-	Query_Pools_FullMethodName               = "/types.Query/Pools"
-	Query_DerivedPool_FullMethodName         = "/types.Query/DerivedPool"
-	Query_DerivedPools_FullMethodName        = "/types.Query/DerivedPools"
-	Query_LiquidityProvider_FullMethodName   = "/types.Query/LiquidityProvider"
-	Query_LiquidityProviders_FullMethodName  = "/types.Query/LiquidityProviders"
-	Query_Saver_FullMethodName               = "/types.Query/Saver"
-	Query_Savers_FullMethodName              = "/types.Query/Savers"
-	Query_Borrower_FullMethodName            = "/types.Query/Borrower"
-	Query_Borrowers_FullMethodName           = "/types.Query/Borrowers"
-	Query_TradeUnit_FullMethodName           = "/types.Query/TradeUnit"
-	Query_TradeUnits_FullMethodName          = "/types.Query/TradeUnits"
-	Query_TradeAccount_FullMethodName        = "/types.Query/TradeAccount"
-	Query_TradeAccounts_FullMethodName       = "/types.Query/TradeAccounts"
-	Query_SecuredAsset_FullMethodName        = "/types.Query/SecuredAsset"
-	Query_SecuredAssets_FullMethodName       = "/types.Query/SecuredAssets"
-	Query_Node_FullMethodName                = "/types.Query/Node"
-	Query_Nodes_FullMethodName               = "/types.Query/Nodes"
-	Query_PoolSlip_FullMethodName            = "/types.Query/PoolSlip"
-	Query_PoolSlips_FullMethodName           = "/types.Query/PoolSlips"
-	Query_OutboundFee_FullMethodName         = "/types.Query/OutboundFee"
-	Query_OutboundFees_FullMethodName        = "/types.Query/OutboundFees"
-	Query_StreamingSwap_FullMethodName       = "/types.Query/StreamingSwap"
-	Query_StreamingSwaps_FullMethodName      = "/types.Query/StreamingSwaps"
-	Query_Ban_FullMethodName                 = "/types.Query/Ban"
-	Query_Ragnarok_FullMethodName            = "/types.Query/Ragnarok"
-	Query_SwitchPool_FullMethodName          = "/types.Query/SwitchPool"
-	Query_SWITCHProvider_FullMethodName      = "/types.Query/SWITCHProvider"
-	Query_SWITCHProviders_FullMethodName     = "/types.Query/SWITCHProviders"
-	Query_MimirValues_FullMethodName         = "/types.Query/MimirValues"
-	Query_MimirWithKey_FullMethodName        = "/types.Query/MimirWithKey"
-	Query_MimirAdminValues_FullMethodName    = "/types.Query/MimirAdminValues"
-	Query_MimirNodesAllValues_FullMethodName = "/types.Query/MimirNodesAllValues"
-	Query_MimirNodesValues_FullMethodName    = "/types.Query/MimirNodesValues"
-	Query_MimirNodeValues_FullMethodName     = "/types.Query/MimirNodeValues"
-	Query_InboundAddresses_FullMethodName    = "/types.Query/InboundAddresses"
-	Query_Version_FullMethodName             = "/types.Query/Version"
-	Query_Switchlyname_FullMethodName        = "/types.Query/Switchlyname"
-	Query_Invariant_FullMethodName           = "/types.Query/Invariant"
-	Query_Invariants_FullMethodName          = "/types.Query/Invariants"
-	Query_Network_FullMethodName             = "/types.Query/Network"
-	Query_BalanceModule_FullMethodName       = "/types.Query/BalanceModule"
-	Query_QuoteSwap_FullMethodName           = "/types.Query/QuoteSwap"
-	Query_QuoteSaverDeposit_FullMethodName   = "/types.Query/QuoteSaverDeposit"
-	Query_QuoteSaverWithdraw_FullMethodName  = "/types.Query/QuoteSaverWithdraw"
-	Query_QuoteLoanOpen_FullMethodName       = "/types.Query/QuoteLoanOpen"
-	Query_QuoteLoanClose_FullMethodName      = "/types.Query/QuoteLoanClose"
-	Query_ConstantValues_FullMethodName      = "/types.Query/ConstantValues"
-	Query_SwapQueue_FullMethodName           = "/types.Query/SwapQueue"
-	Query_LastBlocks_FullMethodName          = "/types.Query/LastBlocks"
-	Query_ChainsLastBlock_FullMethodName     = "/types.Query/ChainsLastBlock"
-	Query_Vault_FullMethodName               = "/types.Query/Vault"
-	Query_AsgardVaults_FullMethodName        = "/types.Query/AsgardVaults"
-	Query_VaultsPubkeys_FullMethodName       = "/types.Query/VaultsPubkeys"
-	Query_TxStages_FullMethodName            = "/types.Query/TxStages"
-	Query_TxStatus_FullMethodName            = "/types.Query/TxStatus"
-	Query_Tx_FullMethodName                  = "/types.Query/Tx"
-	Query_TxVoters_FullMethodName            = "/types.Query/TxVoters"
-	Query_TxVotersOld_FullMethodName         = "/types.Query/TxVotersOld"
-	Query_Clout_FullMethodName               = "/types.Query/Clout"
-	Query_Queue_FullMethodName               = "/types.Query/Queue"
-	Query_ScheduledOutbound_FullMethodName   = "/types.Query/ScheduledOutbound"
-	Query_PendingOutbound_FullMethodName     = "/types.Query/PendingOutbound"
-	Query_Block_FullMethodName               = "/types.Query/Block"
-	Query_TssKeygenMetric_FullMethodName     = "/types.Query/TssKeygenMetric"
-	Query_TssMetric_FullMethodName           = "/types.Query/TssMetric"
-	Query_Keysign_FullMethodName             = "/types.Query/Keysign"
-	Query_KeysignPubkey_FullMethodName       = "/types.Query/KeysignPubkey"
-	Query_Keygen_FullMethodName              = "/types.Query/Keygen"
-	Query_UpgradeProposals_FullMethodName    = "/types.Query/UpgradeProposals"
-	Query_UpgradeProposal_FullMethodName     = "/types.Query/UpgradeProposal"
-	Query_UpgradeVotes_FullMethodName        = "/types.Query/UpgradeVotes"
-	Query_SWCYStaker_FullMethodName          = "/types.Query/SWCYStaker"
-	Query_SWCYStakers_FullMethodName         = "/types.Query/SWCYStakers"
-	Query_SWCYClaimer_FullMethodName         = "/types.Query/SWCYClaimer"
-	Query_SWCYClaimers_FullMethodName        = "/types.Query/SWCYClaimers"
-	Query_Codes_FullMethodName               = "/types.Query/Codes"
+	Query_Account_FullMethodName                  = "/types.Query/Account"
+	Query_Balances_FullMethodName                 = "/types.Query/Balances"
+	Query_Export_FullMethodName                   = "/types.Query/Export"
+	Query_Pool_FullMethodName                     = "/types.Query/Pool"
+	Query_Pools_FullMethodName                    = "/types.Query/Pools"
+	Query_DerivedPool_FullMethodName              = "/types.Query/DerivedPool"
+	Query_DerivedPools_FullMethodName             = "/types.Query/DerivedPools"
+	Query_LiquidityProvider_FullMethodName        = "/types.Query/LiquidityProvider"
+	Query_LiquidityProviders_FullMethodName       = "/types.Query/LiquidityProviders"
+	Query_LiquidityProviderHistory_FullMethodName = "/types.Query/LiquidityProviderHistory"
+	Query_Saver_FullMethodName                    = "/types.Query/Saver"
+	Query_Savers_FullMethodName                   = "/types.Query/Savers"
+	Query_SaverHistory_FullMethodName             = "/types.Query/SaverHistory"
+	Query_Borrower_FullMethodName                 = "/types.Query/Borrower"
+	Query_Borrowers_FullMethodName                = "/types.Query/Borrowers"
+	Query_TradeUnit_FullMethodName                = "/types.Query/TradeUnit"
+	Query_TradeUnits_FullMethodName               = "/types.Query/TradeUnits"
+	Query_TradeAccount_FullMethodName             = "/types.Query/TradeAccount"
+	Query_TradeAccounts_FullMethodName            = "/types.Query/TradeAccounts"
+	Query_SecuredAsset_FullMethodName             = "/types.Query/SecuredAsset"
+	Query_SecuredAssets_FullMethodName            = "/types.Query/SecuredAssets"
+	Query_Node_FullMethodName                     = "/types.Query/Node"
+	Query_Nodes_FullMethodName                    = "/types.Query/Nodes"
+	Query_PoolSlip_FullMethodName                 = "/types.Query/PoolSlip"
+	Query_PoolSlips_FullMethodName                = "/types.Query/PoolSlips"
+	Query_OutboundFee_FullMethodName              = "/types.Query/OutboundFee"
+	Query_OutboundFees_FullMethodName             = "/types.Query/OutboundFees"
+	Query_DexAggregators_FullMethodName           = "/types.Query/DexAggregators"
+	Query_StreamingSwap_FullMethodName            = "/types.Query/StreamingSwap"
+	Query_StreamingSwaps_FullMethodName           = "/types.Query/StreamingSwaps"
+	Query_Ban_FullMethodName                      = "/types.Query/Ban"
+	Query_Ragnarok_FullMethodName                 = "/types.Query/Ragnarok"
+	Query_SwitchPool_FullMethodName               = "/types.Query/SwitchPool"
+	Query_SWITCHProvider_FullMethodName           = "/types.Query/SWITCHProvider"
+	Query_SWITCHProviders_FullMethodName          = "/types.Query/SWITCHProviders"
+	Query_MimirValues_FullMethodName              = "/types.Query/MimirValues"
+	Query_MimirWithKey_FullMethodName             = "/types.Query/MimirWithKey"
+	Query_MimirAdminValues_FullMethodName         = "/types.Query/MimirAdminValues"
+	Query_MimirNodesAllValues_FullMethodName      = "/types.Query/MimirNodesAllValues"
+	Query_MimirNodesValues_FullMethodName         = "/types.Query/MimirNodesValues"
+	Query_MimirNodeValues_FullMethodName          = "/types.Query/MimirNodeValues"
+	Query_InboundAddresses_FullMethodName         = "/types.Query/InboundAddresses"
+	Query_Version_FullMethodName                  = "/types.Query/Version"
+	Query_Switchlyname_FullMethodName             = "/types.Query/Switchlyname"
+	Query_Invariant_FullMethodName                = "/types.Query/Invariant"
+	Query_Invariants_FullMethodName               = "/types.Query/Invariants"
+	Query_Network_FullMethodName                  = "/types.Query/Network"
+	Query_BalanceModule_FullMethodName            = "/types.Query/BalanceModule"
+	Query_QuoteSwap_FullMethodName                = "/types.Query/QuoteSwap"
+	Query_QuoteSwapBatch_FullMethodName           = "/types.Query/QuoteSwapBatch"
+	Query_QuoteSaverDeposit_FullMethodName        = "/types.Query/QuoteSaverDeposit"
+	Query_QuoteSaverWithdraw_FullMethodName       = "/types.Query/QuoteSaverWithdraw"
+	Query_QuoteLoanOpen_FullMethodName            = "/types.Query/QuoteLoanOpen"
+	Query_QuoteLoanClose_FullMethodName           = "/types.Query/QuoteLoanClose"
+	Query_ConstantValues_FullMethodName           = "/types.Query/ConstantValues"
+	Query_SwapQueue_FullMethodName                = "/types.Query/SwapQueue"
+	Query_LimitSwapBook_FullMethodName            = "/types.Query/LimitSwapBook"
+	Query_LimitSwapsByAddress_FullMethodName      = "/types.Query/LimitSwapsByAddress"
+	Query_LastBlocks_FullMethodName               = "/types.Query/LastBlocks"
+	Query_ChainsLastBlock_FullMethodName          = "/types.Query/ChainsLastBlock"
+	Query_Vault_FullMethodName                    = "/types.Query/Vault"
+	Query_AsgardVaults_FullMethodName             = "/types.Query/AsgardVaults"
+	Query_VaultsPubkeys_FullMethodName            = "/types.Query/VaultsPubkeys"
+	Query_TxStages_FullMethodName                 = "/types.Query/TxStages"
+	Query_TxStatus_FullMethodName                 = "/types.Query/TxStatus"
+	Query_Tx_FullMethodName                       = "/types.Query/Tx"
+	Query_TxVoters_FullMethodName                 = "/types.Query/TxVoters"
+	Query_TxVotersOld_FullMethodName              = "/types.Query/TxVotersOld"
+	Query_Clout_FullMethodName                    = "/types.Query/Clout"
+	Query_Queue_FullMethodName                    = "/types.Query/Queue"
+	Query_ScheduledOutbound_FullMethodName        = "/types.Query/ScheduledOutbound"
+	Query_PendingOutbound_FullMethodName          = "/types.Query/PendingOutbound"
+	Query_Block_FullMethodName                    = "/types.Query/Block"
+	Query_TssKeygenMetric_FullMethodName          = "/types.Query/TssKeygenMetric"
+	Query_TssMetric_FullMethodName                = "/types.Query/TssMetric"
+	Query_Keysign_FullMethodName                  = "/types.Query/Keysign"
+	Query_KeysignPubkey_FullMethodName            = "/types.Query/KeysignPubkey"
+	Query_Keygen_FullMethodName                   = "/types.Query/Keygen"
+	Query_UpgradeProposals_FullMethodName         = "/types.Query/UpgradeProposals"
+	Query_UpgradeProposal_FullMethodName          = "/types.Query/UpgradeProposal"
+	Query_UpgradeVotes_FullMethodName             = "/types.Query/UpgradeVotes"
+	Query_SWCYStaker_FullMethodName               = "/types.Query/SWCYStaker"
+	Query_SWCYStakers_FullMethodName              = "/types.Query/SWCYStakers"
+	Query_SWCYClaimer_FullMethodName              = "/types.Query/SWCYClaimer"
+	Query_SWCYClaimers_FullMethodName             = "/types.Query/SWCYClaimers"
+	Query_Codes_FullMethodName                    = "/types.Query/Codes"
 )
 
 // QueryClient is the client API for Query service.
@@ -123,8 +129,10 @@ type QueryClient interface {
 	DerivedPools(ctx context.Context, in *QueryDerivedPoolsRequest, opts ...grpc.CallOption) (*QueryDerivedPoolsResponse, error)
 	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error)
+	LiquidityProviderHistory(ctx context.Context, in *QueryLiquidityProviderHistoryRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderHistoryResponse, error)
 	Saver(ctx context.Context, in *QuerySaverRequest, opts ...grpc.CallOption) (*QuerySaverResponse, error)
 	Savers(ctx context.Context, in *QuerySaversRequest, opts ...grpc.CallOption) (*QuerySaversResponse, error)
+	SaverHistory(ctx context.Context, in *QuerySaverHistoryRequest, opts ...grpc.CallOption) (*QuerySaverHistoryResponse, error)
 	Borrower(ctx context.Context, in *QueryBorrowerRequest, opts ...grpc.CallOption) (*QueryBorrowerResponse, error)
 	Borrowers(ctx context.Context, in *QueryBorrowersRequest, opts ...grpc.CallOption) (*QueryBorrowersResponse, error)
 	TradeUnit(ctx context.Context, in *QueryTradeUnitRequest, opts ...grpc.CallOption) (*QueryTradeUnitResponse, error)
@@ -139,6 +147,7 @@ type QueryClient interface {
 	PoolSlips(ctx context.Context, in *QueryPoolSlipsRequest, opts ...grpc.CallOption) (*QueryPoolSlipsResponse, error)
 	OutboundFee(ctx context.Context, in *QueryOutboundFeeRequest, opts ...grpc.CallOption) (*QueryOutboundFeesResponse, error)
 	OutboundFees(ctx context.Context, in *QueryOutboundFeesRequest, opts ...grpc.CallOption) (*QueryOutboundFeesResponse, error)
+	DexAggregators(ctx context.Context, in *QueryDexAggregatorsRequest, opts ...grpc.CallOption) (*QueryDexAggregatorsResponse, error)
 	StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (*QueryStreamingSwapsResponse, error)
 	Ban(ctx context.Context, in *QueryBanRequest, opts ...grpc.CallOption) (*BanVoter, error)
@@ -160,12 +169,15 @@ type QueryClient interface {
 	Network(ctx context.Context, in *QueryNetworkRequest, opts ...grpc.CallOption) (*QueryNetworkResponse, error)
 	BalanceModule(ctx context.Context, in *QueryBalanceModuleRequest, opts ...grpc.CallOption) (*QueryBalanceModuleResponse, error)
 	QuoteSwap(ctx context.Context, in *QueryQuoteSwapRequest, opts ...grpc.CallOption) (*QueryQuoteSwapResponse, error)
+	QuoteSwapBatch(ctx context.Context, in *QueryQuoteSwapBatchRequest, opts ...grpc.CallOption) (*QueryQuoteSwapBatchResponse, error)
 	QuoteSaverDeposit(ctx context.Context, in *QueryQuoteSaverDepositRequest, opts ...grpc.CallOption) (*QueryQuoteSaverDepositResponse, error)
 	QuoteSaverWithdraw(ctx context.Context, in *QueryQuoteSaverWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteSaverWithdrawResponse, error)
 	QuoteLoanOpen(ctx context.Context, in *QueryQuoteLoanOpenRequest, opts ...grpc.CallOption) (*QueryQuoteLoanOpenResponse, error)
 	QuoteLoanClose(ctx context.Context, in *QueryQuoteLoanCloseRequest, opts ...grpc.CallOption) (*QueryQuoteLoanCloseResponse, error)
 	ConstantValues(ctx context.Context, in *QueryConstantValuesRequest, opts ...grpc.CallOption) (*QueryConstantValuesResponse, error)
 	SwapQueue(ctx context.Context, in *QuerySwapQueueRequest, opts ...grpc.CallOption) (*QuerySwapQueueResponse, error)
+	LimitSwapBook(ctx context.Context, in *QueryLimitSwapBookRequest, opts ...grpc.CallOption) (*QueryLimitSwapBookResponse, error)
+	LimitSwapsByAddress(ctx context.Context, in *QueryLimitSwapsByAddressRequest, opts ...grpc.CallOption) (*QueryLimitSwapsByAddressResponse, error)
 	LastBlocks(ctx context.Context, in *QueryLastBlocksRequest, opts ...grpc.CallOption) (*QueryLastBlocksResponse, error)
 	ChainsLastBlock(ctx context.Context, in *QueryChainsLastBlockRequest, opts ...grpc.CallOption) (*QueryLastBlocksResponse, error)
 	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
@@ -285,6 +297,15 @@ func (c *queryClient) LiquidityProviders(ctx context.Context, in *QueryLiquidity
 	return out, nil
 }
 
+func (c *queryClient) LiquidityProviderHistory(ctx context.Context, in *QueryLiquidityProviderHistoryRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderHistoryResponse, error) {
+	out := new(QueryLiquidityProviderHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_LiquidityProviderHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Saver(ctx context.Context, in *QuerySaverRequest, opts ...grpc.CallOption) (*QuerySaverResponse, error) {
 	out := new(QuerySaverResponse)
 	err := c.cc.Invoke(ctx, Query_Saver_FullMethodName, in, out, opts...)
@@ -303,6 +324,15 @@ func (c *queryClient) Savers(ctx context.Context, in *QuerySaversRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SaverHistory(ctx context.Context, in *QuerySaverHistoryRequest, opts ...grpc.CallOption) (*QuerySaverHistoryResponse, error) {
+	out := new(QuerySaverHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_SaverHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Borrower(ctx context.Context, in *QueryBorrowerRequest, opts ...grpc.CallOption) (*QueryBorrowerResponse, error) {
 	out := new(QueryBorrowerResponse)
 	err := c.cc.Invoke(ctx, Query_Borrower_FullMethodName, in, out, opts...)
@@ -429,6 +459,15 @@ func (c *queryClient) OutboundFees(ctx context.Context, in *QueryOutboundFeesReq
 	return out, nil
 }
 
+func (c *queryClient) DexAggregators(ctx context.Context, in *QueryDexAggregatorsRequest, opts ...grpc.CallOption) (*QueryDexAggregatorsResponse, error) {
+	out := new(QueryDexAggregatorsResponse)
+	err := c.cc.Invoke(ctx, Query_DexAggregators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error) {
 	out := new(QueryStreamingSwapResponse)
 	err := c.cc.Invoke(ctx, Query_StreamingSwap_FullMethodName, in, out, opts...)
@@ -618,6 +657,15 @@ func (c *queryClient) QuoteSwap(ctx context.Context, in *QueryQuoteSwapRequest,
 	return out, nil
 }
 
+func (c *queryClient) QuoteSwapBatch(ctx context.Context, in *QueryQuoteSwapBatchRequest, opts ...grpc.CallOption) (*QueryQuoteSwapBatchResponse, error) {
+	out := new(QueryQuoteSwapBatchResponse)
+	err := c.cc.Invoke(ctx, Query_QuoteSwapBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QuoteSaverDeposit(ctx context.Context, in *QueryQuoteSaverDepositRequest, opts ...grpc.CallOption) (*QueryQuoteSaverDepositResponse, error) {
 	out := new(QueryQuoteSaverDepositResponse)
 	err := c.cc.Invoke(ctx, Query_QuoteSaverDeposit_FullMethodName, in, out, opts...)
@@ -672,6 +720,24 @@ func (c *queryClient) SwapQueue(ctx context.Context, in *QuerySwapQueueRequest,
 	return out, nil
 }
 
+func (c *queryClient) LimitSwapBook(ctx context.Context, in *QueryLimitSwapBookRequest, opts ...grpc.CallOption) (*QueryLimitSwapBookResponse, error) {
+	out := new(QueryLimitSwapBookResponse)
+	err := c.cc.Invoke(ctx, Query_LimitSwapBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitSwapsByAddress(ctx context.Context, in *QueryLimitSwapsByAddressRequest, opts ...grpc.CallOption) (*QueryLimitSwapsByAddressResponse, error) {
+	out := new(QueryLimitSwapsByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_LimitSwapsByAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LastBlocks(ctx context.Context, in *QueryLastBlocksRequest, opts ...grpc.CallOption) (*QueryLastBlocksResponse, error) {
 	out := new(QueryLastBlocksResponse)
 	err := c.cc.Invoke(ctx, Query_LastBlocks_FullMethodName, in, out, opts...)
@@ -946,8 +1012,10 @@ type QueryServer interface {
 	DerivedPools(context.Context, *QueryDerivedPoolsRequest) (*QueryDerivedPoolsResponse, error)
 	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error)
+	LiquidityProviderHistory(context.Context, *QueryLiquidityProviderHistoryRequest) (*QueryLiquidityProviderHistoryResponse, error)
 	Saver(context.Context, *QuerySaverRequest) (*QuerySaverResponse, error)
 	Savers(context.Context, *QuerySaversRequest) (*QuerySaversResponse, error)
+	SaverHistory(context.Context, *QuerySaverHistoryRequest) (*QuerySaverHistoryResponse, error)
 	Borrower(context.Context, *QueryBorrowerRequest) (*QueryBorrowerResponse, error)
 	Borrowers(context.Context, *QueryBorrowersRequest) (*QueryBorrowersResponse, error)
 	TradeUnit(context.Context, *QueryTradeUnitRequest) (*QueryTradeUnitResponse, error)
@@ -962,6 +1030,7 @@ type QueryServer interface {
 	PoolSlips(context.Context, *QueryPoolSlipsRequest) (*QueryPoolSlipsResponse, error)
 	OutboundFee(context.Context, *QueryOutboundFeeRequest) (*QueryOutboundFeesResponse, error)
 	OutboundFees(context.Context, *QueryOutboundFeesRequest) (*QueryOutboundFeesResponse, error)
+	DexAggregators(context.Context, *QueryDexAggregatorsRequest) (*QueryDexAggregatorsResponse, error)
 	StreamingSwap(context.Context, *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(context.Context, *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error)
 	Ban(context.Context, *QueryBanRequest) (*BanVoter, error)
@@ -983,12 +1052,15 @@ type QueryServer interface {
 	Network(context.Context, *QueryNetworkRequest) (*QueryNetworkResponse, error)
 	BalanceModule(context.Context, *QueryBalanceModuleRequest) (*QueryBalanceModuleResponse, error)
 	QuoteSwap(context.Context, *QueryQuoteSwapRequest) (*QueryQuoteSwapResponse, error)
+	QuoteSwapBatch(context.Context, *QueryQuoteSwapBatchRequest) (*QueryQuoteSwapBatchResponse, error)
 	QuoteSaverDeposit(context.Context, *QueryQuoteSaverDepositRequest) (*QueryQuoteSaverDepositResponse, error)
 	QuoteSaverWithdraw(context.Context, *QueryQuoteSaverWithdrawRequest) (*QueryQuoteSaverWithdrawResponse, error)
 	QuoteLoanOpen(context.Context, *QueryQuoteLoanOpenRequest) (*QueryQuoteLoanOpenResponse, error)
 	QuoteLoanClose(context.Context, *QueryQuoteLoanCloseRequest) (*QueryQuoteLoanCloseResponse, error)
 	ConstantValues(context.Context, *QueryConstantValuesRequest) (*QueryConstantValuesResponse, error)
 	SwapQueue(context.Context, *QuerySwapQueueRequest) (*QuerySwapQueueResponse, error)
+	LimitSwapBook(context.Context, *QueryLimitSwapBookRequest) (*QueryLimitSwapBookResponse, error)
+	LimitSwapsByAddress(context.Context, *QueryLimitSwapsByAddressRequest) (*QueryLimitSwapsByAddressResponse, error)
 	LastBlocks(context.Context, *QueryLastBlocksRequest) (*QueryLastBlocksResponse, error)
 	ChainsLastBlock(context.Context, *QueryChainsLastBlockRequest) (*QueryLastBlocksResponse, error)
 	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
@@ -1051,12 +1123,18 @@ func (UnimplementedQueryServer) LiquidityProvider(context.Context, *QueryLiquidi
 func (UnimplementedQueryServer) LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviders not implemented")
 }
+func (UnimplementedQueryServer) LiquidityProviderHistory(context.Context, *QueryLiquidityProviderHistoryRequest) (*QueryLiquidityProviderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviderHistory not implemented")
+}
 func (UnimplementedQueryServer) Saver(context.Context, *QuerySaverRequest) (*QuerySaverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Saver not implemented")
 }
 func (UnimplementedQueryServer) Savers(context.Context, *QuerySaversRequest) (*QuerySaversResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Savers not implemented")
 }
+func (UnimplementedQueryServer) SaverHistory(context.Context, *QuerySaverHistoryRequest) (*QuerySaverHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaverHistory not implemented")
+}
 func (UnimplementedQueryServer) Borrower(context.Context, *QueryBorrowerRequest) (*QueryBorrowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Borrower not implemented")
 }
@@ -1099,6 +1177,9 @@ func (UnimplementedQueryServer) OutboundFee(context.Context, *QueryOutboundFeeRe
 func (UnimplementedQueryServer) OutboundFees(context.Context, *QueryOutboundFeesRequest) (*QueryOutboundFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutboundFees not implemented")
 }
+func (UnimplementedQueryServer) DexAggregators(context.Context, *QueryDexAggregatorsRequest) (*QueryDexAggregatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DexAggregators not implemented")
+}
 func (UnimplementedQueryServer) StreamingSwap(context.Context, *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StreamingSwap not implemented")
 }
@@ -1162,6 +1243,9 @@ func (UnimplementedQueryServer) BalanceModule(context.Context, *QueryBalanceModu
 func (UnimplementedQueryServer) QuoteSwap(context.Context, *QueryQuoteSwapRequest) (*QueryQuoteSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteSwap not implemented")
 }
+func (UnimplementedQueryServer) QuoteSwapBatch(context.Context, *QueryQuoteSwapBatchRequest) (*QueryQuoteSwapBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSwapBatch not implemented")
+}
 func (UnimplementedQueryServer) QuoteSaverDeposit(context.Context, *QueryQuoteSaverDepositRequest) (*QueryQuoteSaverDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteSaverDeposit not implemented")
 }
@@ -1180,6 +1264,12 @@ func (UnimplementedQueryServer) ConstantValues(context.Context, *QueryConstantVa
 func (UnimplementedQueryServer) SwapQueue(context.Context, *QuerySwapQueueRequest) (*QuerySwapQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapQueue not implemented")
 }
+func (UnimplementedQueryServer) LimitSwapBook(context.Context, *QueryLimitSwapBookRequest) (*QueryLimitSwapBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitSwapBook not implemented")
+}
+func (UnimplementedQueryServer) LimitSwapsByAddress(context.Context, *QueryLimitSwapsByAddressRequest) (*QueryLimitSwapsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitSwapsByAddress not implemented")
+}
 func (UnimplementedQueryServer) LastBlocks(context.Context, *QueryLastBlocksRequest) (*QueryLastBlocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastBlocks not implemented")
 }
@@ -1439,6 +1529,24 @@ func _Query_LiquidityProviders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LiquidityProviderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProviderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProviderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiquidityProviderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProviderHistory(ctx, req.(*QueryLiquidityProviderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Saver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySaverRequest)
 	if err := dec(in); err != nil {
@@ -1475,6 +1583,24 @@ func _Query_Savers_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SaverHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySaverHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SaverHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SaverHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SaverHistory(ctx, req.(*QuerySaverHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Borrower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBorrowerRequest)
 	if err := dec(in); err != nil {
@@ -1727,6 +1853,24 @@ func _Query_OutboundFees_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DexAggregators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDexAggregatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DexAggregators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DexAggregators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DexAggregators(ctx, req.(*QueryDexAggregatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_StreamingSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryStreamingSwapRequest)
 	if err := dec(in); err != nil {
@@ -2105,6 +2249,24 @@ func _Query_QuoteSwap_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QuoteSwapBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSwapBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSwapBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuoteSwapBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSwapBatch(ctx, req.(*QueryQuoteSwapBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QuoteSaverDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryQuoteSaverDepositRequest)
 	if err := dec(in); err != nil {
@@ -2213,6 +2375,42 @@ func _Query_SwapQueue_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LimitSwapBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitSwapBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitSwapBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LimitSwapBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitSwapBook(ctx, req.(*QueryLimitSwapBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitSwapsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitSwapsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitSwapsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LimitSwapsByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitSwapsByAddress(ctx, req.(*QueryLimitSwapsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LastBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLastBlocksRequest)
 	if err := dec(in); err != nil {
@@ -2760,6 +2958,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidityProviders",
 			Handler:    _Query_LiquidityProviders_Handler,
 		},
+		{
+			MethodName: "LiquidityProviderHistory",
+			Handler:    _Query_LiquidityProviderHistory_Handler,
+		},
 		{
 			MethodName: "Saver",
 			Handler:    _Query_Saver_Handler,
@@ -2768,6 +2970,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Savers",
 			Handler:    _Query_Savers_Handler,
 		},
+		{
+			MethodName: "SaverHistory",
+			Handler:    _Query_SaverHistory_Handler,
+		},
 		{
 			MethodName: "Borrower",
 			Handler:    _Query_Borrower_Handler,
@@ -2824,6 +3030,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OutboundFees",
 			Handler:    _Query_OutboundFees_Handler,
 		},
+		{
+			MethodName: "DexAggregators",
+			Handler:    _Query_DexAggregators_Handler,
+		},
 		{
 			MethodName: "StreamingSwap",
 			Handler:    _Query_StreamingSwap_Handler,
@@ -2908,6 +3118,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QuoteSwap",
 			Handler:    _Query_QuoteSwap_Handler,
 		},
+		{
+			MethodName: "QuoteSwapBatch",
+			Handler:    _Query_QuoteSwapBatch_Handler,
+		},
 		{
 			MethodName: "QuoteSaverDeposit",
 			Handler:    _Query_QuoteSaverDeposit_Handler,
@@ -2932,6 +3146,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapQueue",
 			Handler:    _Query_SwapQueue_Handler,
 		},
+		{
+			MethodName: "LimitSwapBook",
+			Handler:    _Query_LimitSwapBook_Handler,
+		},
+		{
+			MethodName: "LimitSwapsByAddress",
+			Handler:    _Query_LimitSwapsByAddress_Handler,
+		},
 		{
 			MethodName: "LastBlocks",
 			Handler:    _Query_LastBlocks_Handler,
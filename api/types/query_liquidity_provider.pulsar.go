@@ -2,6 +2,7 @@
 package types
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1912,9 +1913,12 @@ func (x *fastReflection_QueryLiquidityProviderResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_QueryLiquidityProvidersRequest        protoreflect.MessageDescriptor
-	fd_QueryLiquidityProvidersRequest_asset  protoreflect.FieldDescriptor
-	fd_QueryLiquidityProvidersRequest_height protoreflect.FieldDescriptor
+	md_QueryLiquidityProvidersRequest                protoreflect.MessageDescriptor
+	fd_QueryLiquidityProvidersRequest_asset          protoreflect.FieldDescriptor
+	fd_QueryLiquidityProvidersRequest_height         protoreflect.FieldDescriptor
+	fd_QueryLiquidityProvidersRequest_pagination     protoreflect.FieldDescriptor
+	fd_QueryLiquidityProvidersRequest_address_prefix protoreflect.FieldDescriptor
+	fd_QueryLiquidityProvidersRequest_min_units      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1922,6 +1926,9 @@ func init() {
 	md_QueryLiquidityProvidersRequest = File_types_query_liquidity_provider_proto.Messages().ByName("QueryLiquidityProvidersRequest")
 	fd_QueryLiquidityProvidersRequest_asset = md_QueryLiquidityProvidersRequest.Fields().ByName("asset")
 	fd_QueryLiquidityProvidersRequest_height = md_QueryLiquidityProvidersRequest.Fields().ByName("height")
+	fd_QueryLiquidityProvidersRequest_pagination = md_QueryLiquidityProvidersRequest.Fields().ByName("pagination")
+	fd_QueryLiquidityProvidersRequest_address_prefix = md_QueryLiquidityProvidersRequest.Fields().ByName("address_prefix")
+	fd_QueryLiquidityProvidersRequest_min_units = md_QueryLiquidityProvidersRequest.Fields().ByName("min_units")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryLiquidityProvidersRequest)(nil)
@@ -2001,6 +2008,24 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) Range(f func(protoreflec
 			return
 		}
 	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryLiquidityProvidersRequest_pagination, value) {
+			return
+		}
+	}
+	if x.AddressPrefix != "" {
+		value := protoreflect.ValueOfString(x.AddressPrefix)
+		if !f(fd_QueryLiquidityProvidersRequest_address_prefix, value) {
+			return
+		}
+	}
+	if x.MinUnits != "" {
+		value := protoreflect.ValueOfString(x.MinUnits)
+		if !f(fd_QueryLiquidityProvidersRequest_min_units, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2020,6 +2045,12 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) Has(fd protoreflect.Fiel
 		return x.Asset != ""
 	case "types.QueryLiquidityProvidersRequest.height":
 		return x.Height != ""
+	case "types.QueryLiquidityProvidersRequest.pagination":
+		return x.Pagination != nil
+	case "types.QueryLiquidityProvidersRequest.address_prefix":
+		return x.AddressPrefix != ""
+	case "types.QueryLiquidityProvidersRequest.min_units":
+		return x.MinUnits != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersRequest"))
@@ -2040,6 +2071,12 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) Clear(fd protoreflect.Fi
 		x.Asset = ""
 	case "types.QueryLiquidityProvidersRequest.height":
 		x.Height = ""
+	case "types.QueryLiquidityProvidersRequest.pagination":
+		x.Pagination = nil
+	case "types.QueryLiquidityProvidersRequest.address_prefix":
+		x.AddressPrefix = ""
+	case "types.QueryLiquidityProvidersRequest.min_units":
+		x.MinUnits = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersRequest"))
@@ -2062,6 +2099,15 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) Get(descriptor protorefl
 	case "types.QueryLiquidityProvidersRequest.height":
 		value := x.Height
 		return protoreflect.ValueOfString(value)
+	case "types.QueryLiquidityProvidersRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.QueryLiquidityProvidersRequest.address_prefix":
+		value := x.AddressPrefix
+		return protoreflect.ValueOfString(value)
+	case "types.QueryLiquidityProvidersRequest.min_units":
+		value := x.MinUnits
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersRequest"))
@@ -2086,6 +2132,12 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) Set(fd protoreflect.Fiel
 		x.Asset = value.Interface().(string)
 	case "types.QueryLiquidityProvidersRequest.height":
 		x.Height = value.Interface().(string)
+	case "types.QueryLiquidityProvidersRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "types.QueryLiquidityProvidersRequest.address_prefix":
+		x.AddressPrefix = value.Interface().(string)
+	case "types.QueryLiquidityProvidersRequest.min_units":
+		x.MinUnits = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersRequest"))
@@ -2106,10 +2158,19 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) Set(fd protoreflect.Fiel
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_QueryLiquidityProvidersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "types.QueryLiquidityProvidersRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	case "types.QueryLiquidityProvidersRequest.asset":
 		panic(fmt.Errorf("field asset of message types.QueryLiquidityProvidersRequest is not mutable"))
 	case "types.QueryLiquidityProvidersRequest.height":
 		panic(fmt.Errorf("field height of message types.QueryLiquidityProvidersRequest is not mutable"))
+	case "types.QueryLiquidityProvidersRequest.address_prefix":
+		panic(fmt.Errorf("field address_prefix of message types.QueryLiquidityProvidersRequest is not mutable"))
+	case "types.QueryLiquidityProvidersRequest.min_units":
+		panic(fmt.Errorf("field min_units of message types.QueryLiquidityProvidersRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersRequest"))
@@ -2127,6 +2188,13 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) NewField(fd protoreflect
 		return protoreflect.ValueOfString("")
 	case "types.QueryLiquidityProvidersRequest.height":
 		return protoreflect.ValueOfString("")
+	case "types.QueryLiquidityProvidersRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.QueryLiquidityProvidersRequest.address_prefix":
+		return protoreflect.ValueOfString("")
+	case "types.QueryLiquidityProvidersRequest.min_units":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersRequest"))
@@ -2204,6 +2272,18 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) ProtoMethods() *protoifa
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AddressPrefix)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinUnits)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2233,6 +2313,34 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MinUnits) > 0 {
+			i -= len(x.MinUnits)
+			copy(dAtA[i:], x.MinUnits)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinUnits)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AddressPrefix) > 0 {
+			i -= len(x.AddressPrefix)
+			copy(dAtA[i:], x.AddressPrefix)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressPrefix)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Height) > 0 {
 			i -= len(x.Height)
 			copy(dAtA[i:], x.Height)
@@ -2360,6 +2468,106 @@ func (x *fastReflection_QueryLiquidityProvidersRequest) ProtoMethods() *protoifa
 				}
 				x.Height = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressPrefix = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinUnits", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinUnits = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2449,12 +2657,14 @@ func (x *_QueryLiquidityProvidersResponse_1_list) IsValid() bool {
 var (
 	md_QueryLiquidityProvidersResponse                     protoreflect.MessageDescriptor
 	fd_QueryLiquidityProvidersResponse_liquidity_providers protoreflect.FieldDescriptor
+	fd_QueryLiquidityProvidersResponse_pagination          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_liquidity_provider_proto_init()
 	md_QueryLiquidityProvidersResponse = File_types_query_liquidity_provider_proto.Messages().ByName("QueryLiquidityProvidersResponse")
 	fd_QueryLiquidityProvidersResponse_liquidity_providers = md_QueryLiquidityProvidersResponse.Fields().ByName("liquidity_providers")
+	fd_QueryLiquidityProvidersResponse_pagination = md_QueryLiquidityProvidersResponse.Fields().ByName("pagination")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryLiquidityProvidersResponse)(nil)
@@ -2528,6 +2738,12 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) Range(f func(protorefle
 			return
 		}
 	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryLiquidityProvidersResponse_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2545,6 +2761,8 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) Has(fd protoreflect.Fie
 	switch fd.FullName() {
 	case "types.QueryLiquidityProvidersResponse.liquidity_providers":
 		return len(x.LiquidityProviders) != 0
+	case "types.QueryLiquidityProvidersResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersResponse"))
@@ -2563,6 +2781,8 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) Clear(fd protoreflect.F
 	switch fd.FullName() {
 	case "types.QueryLiquidityProvidersResponse.liquidity_providers":
 		x.LiquidityProviders = nil
+	case "types.QueryLiquidityProvidersResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersResponse"))
@@ -2585,6 +2805,9 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) Get(descriptor protoref
 		}
 		listValue := &_QueryLiquidityProvidersResponse_1_list{list: &x.LiquidityProviders}
 		return protoreflect.ValueOfList(listValue)
+	case "types.QueryLiquidityProvidersResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersResponse"))
@@ -2609,6 +2832,8 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) Set(fd protoreflect.Fie
 		lv := value.List()
 		clv := lv.(*_QueryLiquidityProvidersResponse_1_list)
 		x.LiquidityProviders = *clv.list
+	case "types.QueryLiquidityProvidersResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersResponse"))
@@ -2635,6 +2860,11 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) Mutable(fd protoreflect
 		}
 		value := &_QueryLiquidityProvidersResponse_1_list{list: &x.LiquidityProviders}
 		return protoreflect.ValueOfList(value)
+	case "types.QueryLiquidityProvidersResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersResponse"))
@@ -2651,6 +2881,9 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) NewField(fd protoreflec
 	case "types.QueryLiquidityProvidersResponse.liquidity_providers":
 		list := []*QueryLiquidityProviderResponse{}
 		return protoreflect.ValueOfList(&_QueryLiquidityProvidersResponse_1_list{list: &list})
+	case "types.QueryLiquidityProvidersResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProvidersResponse"))
@@ -2726,6 +2959,10 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) ProtoMethods() *protoif
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2755,6 +2992,20 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.LiquidityProviders) > 0 {
 			for iNdEx := len(x.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.LiquidityProviders[iNdEx])
@@ -2854,6 +3105,42 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) ProtoMethods() *protoif
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2889,98 +3176,2248 @@ func (x *fastReflection_QueryLiquidityProvidersResponse) ProtoMethods() *protoif
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: types/query_liquidity_provider.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryLiquidityProviderHistoryRequest             protoreflect.MessageDescriptor
+	fd_QueryLiquidityProviderHistoryRequest_asset       protoreflect.FieldDescriptor
+	fd_QueryLiquidityProviderHistoryRequest_address     protoreflect.FieldDescriptor
+	fd_QueryLiquidityProviderHistoryRequest_from_height protoreflect.FieldDescriptor
+	fd_QueryLiquidityProviderHistoryRequest_to_height   protoreflect.FieldDescriptor
+	fd_QueryLiquidityProviderHistoryRequest_limit       protoreflect.FieldDescriptor
 )
 
-type QueryLiquidityProviderRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_types_query_liquidity_provider_proto_init()
+	md_QueryLiquidityProviderHistoryRequest = File_types_query_liquidity_provider_proto.Messages().ByName("QueryLiquidityProviderHistoryRequest")
+	fd_QueryLiquidityProviderHistoryRequest_asset = md_QueryLiquidityProviderHistoryRequest.Fields().ByName("asset")
+	fd_QueryLiquidityProviderHistoryRequest_address = md_QueryLiquidityProviderHistoryRequest.Fields().ByName("address")
+	fd_QueryLiquidityProviderHistoryRequest_from_height = md_QueryLiquidityProviderHistoryRequest.Fields().ByName("from_height")
+	fd_QueryLiquidityProviderHistoryRequest_to_height = md_QueryLiquidityProviderHistoryRequest.Fields().ByName("to_height")
+	fd_QueryLiquidityProviderHistoryRequest_limit = md_QueryLiquidityProviderHistoryRequest.Fields().ByName("limit")
+}
 
-	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Height  string `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+var _ protoreflect.Message = (*fastReflection_QueryLiquidityProviderHistoryRequest)(nil)
+
+type fastReflection_QueryLiquidityProviderHistoryRequest QueryLiquidityProviderHistoryRequest
+
+func (x *QueryLiquidityProviderHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLiquidityProviderHistoryRequest)(x)
 }
 
-func (x *QueryLiquidityProviderRequest) Reset() {
-	*x = QueryLiquidityProviderRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_liquidity_provider_proto_msgTypes[0]
+func (x *QueryLiquidityProviderHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_liquidity_provider_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryLiquidityProviderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_QueryLiquidityProviderHistoryRequest_messageType fastReflection_QueryLiquidityProviderHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLiquidityProviderHistoryRequest_messageType{}
 
-func (*QueryLiquidityProviderRequest) ProtoMessage() {}
+type fastReflection_QueryLiquidityProviderHistoryRequest_messageType struct{}
 
-// Deprecated: Use QueryLiquidityProviderRequest.ProtoReflect.Descriptor instead.
-func (*QueryLiquidityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_types_query_liquidity_provider_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_QueryLiquidityProviderHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLiquidityProviderHistoryRequest)(nil)
 }
-
-func (x *QueryLiquidityProviderRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+func (x fastReflection_QueryLiquidityProviderHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLiquidityProviderHistoryRequest)
+}
+func (x fastReflection_QueryLiquidityProviderHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLiquidityProviderHistoryRequest
 }
 
-func (x *QueryLiquidityProviderRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLiquidityProviderHistoryRequest
 }
 
-func (x *QueryLiquidityProviderRequest) GetHeight() string {
-	if x != nil {
-		return x.Height
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLiquidityProviderHistoryRequest_messageType
 }
 
-// Can we replace this with LiquidityProvider proto? Check json fields...
-type QueryLiquidityProviderResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLiquidityProviderHistoryRequest)
+}
 
-	Asset              string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	SwitchAddress      string `protobuf:"bytes,2,opt,name=switch_address,json=switchAddress,proto3" json:"switch_address,omitempty"`
-	AssetAddress       string `protobuf:"bytes,3,opt,name=asset_address,json=assetAddress,proto3" json:"asset_address,omitempty"`
-	LastAddHeight      int64  `protobuf:"varint,4,opt,name=last_add_height,json=lastAddHeight,proto3" json:"last_add_height,omitempty"`
-	LastWithdrawHeight int64  `protobuf:"varint,5,opt,name=last_withdraw_height,json=lastWithdrawHeight,proto3" json:"last_withdraw_height,omitempty"`
-	Units              string `protobuf:"bytes,6,opt,name=units,proto3" json:"units,omitempty"`
-	PendingSwitch      string `protobuf:"bytes,7,opt,name=pending_switch,json=pendingSwitch,proto3" json:"pending_switch,omitempty"`
-	PendingAsset       string `protobuf:"bytes,8,opt,name=pending_asset,json=pendingAsset,proto3" json:"pending_asset,omitempty"`
-	PendingTxId        string `protobuf:"bytes,9,opt,name=pending_tx_id,json=pendingTxId,proto3" json:"pending_tx_id,omitempty"`
-	SwitchDepositValue string `protobuf:"bytes,10,opt,name=switch_deposit_value,json=switchDepositValue,proto3" json:"switch_deposit_value,omitempty"`
-	AssetDepositValue  string `protobuf:"bytes,11,opt,name=asset_deposit_value,json=assetDepositValue,proto3" json:"asset_deposit_value,omitempty"`
-	SwitchRedeemValue  string `protobuf:"bytes,12,opt,name=switch_redeem_value,json=switchRedeemValue,proto3" json:"switch_redeem_value,omitempty"`
-	AssetRedeemValue   string `protobuf:"bytes,13,opt,name=asset_redeem_value,json=assetRedeemValue,proto3" json:"asset_redeem_value,omitempty"`
-	LuviDepositValue   string `protobuf:"bytes,14,opt,name=luvi_deposit_value,json=luviDepositValue,proto3" json:"luvi_deposit_value,omitempty"`
-	LuviRedeemValue    string `protobuf:"bytes,15,opt,name=luvi_redeem_value,json=luviRedeemValue,proto3" json:"luvi_redeem_value,omitempty"`
-	LuviGrowthPct      string `protobuf:"bytes,16,opt,name=luvi_growth_pct,json=luviGrowthPct,proto3" json:"luvi_growth_pct,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLiquidityProviderHistoryRequest)(x)
 }
 
-func (x *QueryLiquidityProviderResponse) Reset() {
-	*x = QueryLiquidityProviderResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_liquidity_provider_proto_msgTypes[1]
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != "" {
+		value := protoreflect.ValueOfString(x.Asset)
+		if !f(fd_QueryLiquidityProviderHistoryRequest_asset, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryLiquidityProviderHistoryRequest_address, value) {
+			return
+		}
+	}
+	if x.FromHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FromHeight)
+		if !f(fd_QueryLiquidityProviderHistoryRequest_from_height, value) {
+			return
+		}
+	}
+	if x.ToHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ToHeight)
+		if !f(fd_QueryLiquidityProviderHistoryRequest_to_height, value) {
+			return
+		}
+	}
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_QueryLiquidityProviderHistoryRequest_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryRequest.asset":
+		return x.Asset != ""
+	case "types.QueryLiquidityProviderHistoryRequest.address":
+		return x.Address != ""
+	case "types.QueryLiquidityProviderHistoryRequest.from_height":
+		return x.FromHeight != int64(0)
+	case "types.QueryLiquidityProviderHistoryRequest.to_height":
+		return x.ToHeight != int64(0)
+	case "types.QueryLiquidityProviderHistoryRequest.limit":
+		return x.Limit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryRequest.asset":
+		x.Asset = ""
+	case "types.QueryLiquidityProviderHistoryRequest.address":
+		x.Address = ""
+	case "types.QueryLiquidityProviderHistoryRequest.from_height":
+		x.FromHeight = int64(0)
+	case "types.QueryLiquidityProviderHistoryRequest.to_height":
+		x.ToHeight = int64(0)
+	case "types.QueryLiquidityProviderHistoryRequest.limit":
+		x.Limit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryLiquidityProviderHistoryRequest.asset":
+		value := x.Asset
+		return protoreflect.ValueOfString(value)
+	case "types.QueryLiquidityProviderHistoryRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "types.QueryLiquidityProviderHistoryRequest.from_height":
+		value := x.FromHeight
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryLiquidityProviderHistoryRequest.to_height":
+		value := x.ToHeight
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryLiquidityProviderHistoryRequest.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryRequest.asset":
+		x.Asset = value.Interface().(string)
+	case "types.QueryLiquidityProviderHistoryRequest.address":
+		x.Address = value.Interface().(string)
+	case "types.QueryLiquidityProviderHistoryRequest.from_height":
+		x.FromHeight = value.Int()
+	case "types.QueryLiquidityProviderHistoryRequest.to_height":
+		x.ToHeight = value.Int()
+	case "types.QueryLiquidityProviderHistoryRequest.limit":
+		x.Limit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryRequest.asset":
+		panic(fmt.Errorf("field asset of message types.QueryLiquidityProviderHistoryRequest is not mutable"))
+	case "types.QueryLiquidityProviderHistoryRequest.address":
+		panic(fmt.Errorf("field address of message types.QueryLiquidityProviderHistoryRequest is not mutable"))
+	case "types.QueryLiquidityProviderHistoryRequest.from_height":
+		panic(fmt.Errorf("field from_height of message types.QueryLiquidityProviderHistoryRequest is not mutable"))
+	case "types.QueryLiquidityProviderHistoryRequest.to_height":
+		panic(fmt.Errorf("field to_height of message types.QueryLiquidityProviderHistoryRequest is not mutable"))
+	case "types.QueryLiquidityProviderHistoryRequest.limit":
+		panic(fmt.Errorf("field limit of message types.QueryLiquidityProviderHistoryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryRequest.asset":
+		return protoreflect.ValueOfString("")
+	case "types.QueryLiquidityProviderHistoryRequest.address":
+		return protoreflect.ValueOfString("")
+	case "types.QueryLiquidityProviderHistoryRequest.from_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryLiquidityProviderHistoryRequest.to_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryLiquidityProviderHistoryRequest.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryLiquidityProviderHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLiquidityProviderHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLiquidityProviderHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Asset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FromHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromHeight))
+		}
+		if x.ToHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToHeight))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLiquidityProviderHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ToHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.FromHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Asset) > 0 {
+			i -= len(x.Asset)
+			copy(dAtA[i:], x.Asset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLiquidityProviderHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLiquidityProviderHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLiquidityProviderHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Asset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+				}
+				x.FromHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+				}
+				x.ToHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LiquidityProviderPosition                      protoreflect.MessageDescriptor
+	fd_LiquidityProviderPosition_height               protoreflect.FieldDescriptor
+	fd_LiquidityProviderPosition_units                protoreflect.FieldDescriptor
+	fd_LiquidityProviderPosition_pool_units           protoreflect.FieldDescriptor
+	fd_LiquidityProviderPosition_switch_redeem_value  protoreflect.FieldDescriptor
+	fd_LiquidityProviderPosition_asset_redeem_value   protoreflect.FieldDescriptor
+	fd_LiquidityProviderPosition_switch_deposit_value protoreflect.FieldDescriptor
+	fd_LiquidityProviderPosition_asset_deposit_value  protoreflect.FieldDescriptor
+	fd_LiquidityProviderPosition_accrued_fees         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_liquidity_provider_proto_init()
+	md_LiquidityProviderPosition = File_types_query_liquidity_provider_proto.Messages().ByName("LiquidityProviderPosition")
+	fd_LiquidityProviderPosition_height = md_LiquidityProviderPosition.Fields().ByName("height")
+	fd_LiquidityProviderPosition_units = md_LiquidityProviderPosition.Fields().ByName("units")
+	fd_LiquidityProviderPosition_pool_units = md_LiquidityProviderPosition.Fields().ByName("pool_units")
+	fd_LiquidityProviderPosition_switch_redeem_value = md_LiquidityProviderPosition.Fields().ByName("switch_redeem_value")
+	fd_LiquidityProviderPosition_asset_redeem_value = md_LiquidityProviderPosition.Fields().ByName("asset_redeem_value")
+	fd_LiquidityProviderPosition_switch_deposit_value = md_LiquidityProviderPosition.Fields().ByName("switch_deposit_value")
+	fd_LiquidityProviderPosition_asset_deposit_value = md_LiquidityProviderPosition.Fields().ByName("asset_deposit_value")
+	fd_LiquidityProviderPosition_accrued_fees = md_LiquidityProviderPosition.Fields().ByName("accrued_fees")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidityProviderPosition)(nil)
+
+type fastReflection_LiquidityProviderPosition LiquidityProviderPosition
+
+func (x *LiquidityProviderPosition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LiquidityProviderPosition)(x)
+}
+
+func (x *LiquidityProviderPosition) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_liquidity_provider_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LiquidityProviderPosition_messageType fastReflection_LiquidityProviderPosition_messageType
+var _ protoreflect.MessageType = fastReflection_LiquidityProviderPosition_messageType{}
+
+type fastReflection_LiquidityProviderPosition_messageType struct{}
+
+func (x fastReflection_LiquidityProviderPosition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LiquidityProviderPosition)(nil)
+}
+func (x fastReflection_LiquidityProviderPosition_messageType) New() protoreflect.Message {
+	return new(fastReflection_LiquidityProviderPosition)
+}
+func (x fastReflection_LiquidityProviderPosition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityProviderPosition
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LiquidityProviderPosition) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityProviderPosition
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LiquidityProviderPosition) Type() protoreflect.MessageType {
+	return _fastReflection_LiquidityProviderPosition_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LiquidityProviderPosition) New() protoreflect.Message {
+	return new(fastReflection_LiquidityProviderPosition)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LiquidityProviderPosition) Interface() protoreflect.ProtoMessage {
+	return (*LiquidityProviderPosition)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LiquidityProviderPosition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_LiquidityProviderPosition_height, value) {
+			return
+		}
+	}
+	if x.Units != "" {
+		value := protoreflect.ValueOfString(x.Units)
+		if !f(fd_LiquidityProviderPosition_units, value) {
+			return
+		}
+	}
+	if x.PoolUnits != "" {
+		value := protoreflect.ValueOfString(x.PoolUnits)
+		if !f(fd_LiquidityProviderPosition_pool_units, value) {
+			return
+		}
+	}
+	if x.SwitchRedeemValue != "" {
+		value := protoreflect.ValueOfString(x.SwitchRedeemValue)
+		if !f(fd_LiquidityProviderPosition_switch_redeem_value, value) {
+			return
+		}
+	}
+	if x.AssetRedeemValue != "" {
+		value := protoreflect.ValueOfString(x.AssetRedeemValue)
+		if !f(fd_LiquidityProviderPosition_asset_redeem_value, value) {
+			return
+		}
+	}
+	if x.SwitchDepositValue != "" {
+		value := protoreflect.ValueOfString(x.SwitchDepositValue)
+		if !f(fd_LiquidityProviderPosition_switch_deposit_value, value) {
+			return
+		}
+	}
+	if x.AssetDepositValue != "" {
+		value := protoreflect.ValueOfString(x.AssetDepositValue)
+		if !f(fd_LiquidityProviderPosition_asset_deposit_value, value) {
+			return
+		}
+	}
+	if x.AccruedFees != "" {
+		value := protoreflect.ValueOfString(x.AccruedFees)
+		if !f(fd_LiquidityProviderPosition_accrued_fees, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LiquidityProviderPosition) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.LiquidityProviderPosition.height":
+		return x.Height != int64(0)
+	case "types.LiquidityProviderPosition.units":
+		return x.Units != ""
+	case "types.LiquidityProviderPosition.pool_units":
+		return x.PoolUnits != ""
+	case "types.LiquidityProviderPosition.switch_redeem_value":
+		return x.SwitchRedeemValue != ""
+	case "types.LiquidityProviderPosition.asset_redeem_value":
+		return x.AssetRedeemValue != ""
+	case "types.LiquidityProviderPosition.switch_deposit_value":
+		return x.SwitchDepositValue != ""
+	case "types.LiquidityProviderPosition.asset_deposit_value":
+		return x.AssetDepositValue != ""
+	case "types.LiquidityProviderPosition.accrued_fees":
+		return x.AccruedFees != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.LiquidityProviderPosition"))
+		}
+		panic(fmt.Errorf("message types.LiquidityProviderPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityProviderPosition) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.LiquidityProviderPosition.height":
+		x.Height = int64(0)
+	case "types.LiquidityProviderPosition.units":
+		x.Units = ""
+	case "types.LiquidityProviderPosition.pool_units":
+		x.PoolUnits = ""
+	case "types.LiquidityProviderPosition.switch_redeem_value":
+		x.SwitchRedeemValue = ""
+	case "types.LiquidityProviderPosition.asset_redeem_value":
+		x.AssetRedeemValue = ""
+	case "types.LiquidityProviderPosition.switch_deposit_value":
+		x.SwitchDepositValue = ""
+	case "types.LiquidityProviderPosition.asset_deposit_value":
+		x.AssetDepositValue = ""
+	case "types.LiquidityProviderPosition.accrued_fees":
+		x.AccruedFees = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.LiquidityProviderPosition"))
+		}
+		panic(fmt.Errorf("message types.LiquidityProviderPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LiquidityProviderPosition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.LiquidityProviderPosition.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "types.LiquidityProviderPosition.units":
+		value := x.Units
+		return protoreflect.ValueOfString(value)
+	case "types.LiquidityProviderPosition.pool_units":
+		value := x.PoolUnits
+		return protoreflect.ValueOfString(value)
+	case "types.LiquidityProviderPosition.switch_redeem_value":
+		value := x.SwitchRedeemValue
+		return protoreflect.ValueOfString(value)
+	case "types.LiquidityProviderPosition.asset_redeem_value":
+		value := x.AssetRedeemValue
+		return protoreflect.ValueOfString(value)
+	case "types.LiquidityProviderPosition.switch_deposit_value":
+		value := x.SwitchDepositValue
+		return protoreflect.ValueOfString(value)
+	case "types.LiquidityProviderPosition.asset_deposit_value":
+		value := x.AssetDepositValue
+		return protoreflect.ValueOfString(value)
+	case "types.LiquidityProviderPosition.accrued_fees":
+		value := x.AccruedFees
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.LiquidityProviderPosition"))
+		}
+		panic(fmt.Errorf("message types.LiquidityProviderPosition does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityProviderPosition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.LiquidityProviderPosition.height":
+		x.Height = value.Int()
+	case "types.LiquidityProviderPosition.units":
+		x.Units = value.Interface().(string)
+	case "types.LiquidityProviderPosition.pool_units":
+		x.PoolUnits = value.Interface().(string)
+	case "types.LiquidityProviderPosition.switch_redeem_value":
+		x.SwitchRedeemValue = value.Interface().(string)
+	case "types.LiquidityProviderPosition.asset_redeem_value":
+		x.AssetRedeemValue = value.Interface().(string)
+	case "types.LiquidityProviderPosition.switch_deposit_value":
+		x.SwitchDepositValue = value.Interface().(string)
+	case "types.LiquidityProviderPosition.asset_deposit_value":
+		x.AssetDepositValue = value.Interface().(string)
+	case "types.LiquidityProviderPosition.accrued_fees":
+		x.AccruedFees = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.LiquidityProviderPosition"))
+		}
+		panic(fmt.Errorf("message types.LiquidityProviderPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityProviderPosition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.LiquidityProviderPosition.height":
+		panic(fmt.Errorf("field height of message types.LiquidityProviderPosition is not mutable"))
+	case "types.LiquidityProviderPosition.units":
+		panic(fmt.Errorf("field units of message types.LiquidityProviderPosition is not mutable"))
+	case "types.LiquidityProviderPosition.pool_units":
+		panic(fmt.Errorf("field pool_units of message types.LiquidityProviderPosition is not mutable"))
+	case "types.LiquidityProviderPosition.switch_redeem_value":
+		panic(fmt.Errorf("field switch_redeem_value of message types.LiquidityProviderPosition is not mutable"))
+	case "types.LiquidityProviderPosition.asset_redeem_value":
+		panic(fmt.Errorf("field asset_redeem_value of message types.LiquidityProviderPosition is not mutable"))
+	case "types.LiquidityProviderPosition.switch_deposit_value":
+		panic(fmt.Errorf("field switch_deposit_value of message types.LiquidityProviderPosition is not mutable"))
+	case "types.LiquidityProviderPosition.asset_deposit_value":
+		panic(fmt.Errorf("field asset_deposit_value of message types.LiquidityProviderPosition is not mutable"))
+	case "types.LiquidityProviderPosition.accrued_fees":
+		panic(fmt.Errorf("field accrued_fees of message types.LiquidityProviderPosition is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.LiquidityProviderPosition"))
+		}
+		panic(fmt.Errorf("message types.LiquidityProviderPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LiquidityProviderPosition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.LiquidityProviderPosition.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.LiquidityProviderPosition.units":
+		return protoreflect.ValueOfString("")
+	case "types.LiquidityProviderPosition.pool_units":
+		return protoreflect.ValueOfString("")
+	case "types.LiquidityProviderPosition.switch_redeem_value":
+		return protoreflect.ValueOfString("")
+	case "types.LiquidityProviderPosition.asset_redeem_value":
+		return protoreflect.ValueOfString("")
+	case "types.LiquidityProviderPosition.switch_deposit_value":
+		return protoreflect.ValueOfString("")
+	case "types.LiquidityProviderPosition.asset_deposit_value":
+		return protoreflect.ValueOfString("")
+	case "types.LiquidityProviderPosition.accrued_fees":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.LiquidityProviderPosition"))
+		}
+		panic(fmt.Errorf("message types.LiquidityProviderPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LiquidityProviderPosition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.LiquidityProviderPosition", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LiquidityProviderPosition) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityProviderPosition) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LiquidityProviderPosition) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LiquidityProviderPosition) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LiquidityProviderPosition)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Units)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PoolUnits)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SwitchRedeemValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AssetRedeemValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SwitchDepositValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AssetDepositValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AccruedFees)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityProviderPosition)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AccruedFees) > 0 {
+			i -= len(x.AccruedFees)
+			copy(dAtA[i:], x.AccruedFees)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccruedFees)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.AssetDepositValue) > 0 {
+			i -= len(x.AssetDepositValue)
+			copy(dAtA[i:], x.AssetDepositValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssetDepositValue)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.SwitchDepositValue) > 0 {
+			i -= len(x.SwitchDepositValue)
+			copy(dAtA[i:], x.SwitchDepositValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SwitchDepositValue)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.AssetRedeemValue) > 0 {
+			i -= len(x.AssetRedeemValue)
+			copy(dAtA[i:], x.AssetRedeemValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssetRedeemValue)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SwitchRedeemValue) > 0 {
+			i -= len(x.SwitchRedeemValue)
+			copy(dAtA[i:], x.SwitchRedeemValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SwitchRedeemValue)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PoolUnits) > 0 {
+			i -= len(x.PoolUnits)
+			copy(dAtA[i:], x.PoolUnits)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PoolUnits)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Units) > 0 {
+			i -= len(x.Units)
+			copy(dAtA[i:], x.Units)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Units)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityProviderPosition)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityProviderPosition: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityProviderPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Units = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolUnits = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwitchRedeemValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SwitchRedeemValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetRedeemValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssetRedeemValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwitchDepositValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SwitchDepositValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetDepositValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssetDepositValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccruedFees = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryLiquidityProviderHistoryResponse_3_list)(nil)
+
+type _QueryLiquidityProviderHistoryResponse_3_list struct {
+	list *[]*LiquidityProviderPosition
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LiquidityProviderPosition)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LiquidityProviderPosition)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(LiquidityProviderPosition)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) NewElement() protoreflect.Value {
+	v := new(LiquidityProviderPosition)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLiquidityProviderHistoryResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryLiquidityProviderHistoryResponse             protoreflect.MessageDescriptor
+	fd_QueryLiquidityProviderHistoryResponse_asset       protoreflect.FieldDescriptor
+	fd_QueryLiquidityProviderHistoryResponse_address     protoreflect.FieldDescriptor
+	fd_QueryLiquidityProviderHistoryResponse_positions   protoreflect.FieldDescriptor
+	fd_QueryLiquidityProviderHistoryResponse_next_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_liquidity_provider_proto_init()
+	md_QueryLiquidityProviderHistoryResponse = File_types_query_liquidity_provider_proto.Messages().ByName("QueryLiquidityProviderHistoryResponse")
+	fd_QueryLiquidityProviderHistoryResponse_asset = md_QueryLiquidityProviderHistoryResponse.Fields().ByName("asset")
+	fd_QueryLiquidityProviderHistoryResponse_address = md_QueryLiquidityProviderHistoryResponse.Fields().ByName("address")
+	fd_QueryLiquidityProviderHistoryResponse_positions = md_QueryLiquidityProviderHistoryResponse.Fields().ByName("positions")
+	fd_QueryLiquidityProviderHistoryResponse_next_height = md_QueryLiquidityProviderHistoryResponse.Fields().ByName("next_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLiquidityProviderHistoryResponse)(nil)
+
+type fastReflection_QueryLiquidityProviderHistoryResponse QueryLiquidityProviderHistoryResponse
+
+func (x *QueryLiquidityProviderHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLiquidityProviderHistoryResponse)(x)
+}
+
+func (x *QueryLiquidityProviderHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_liquidity_provider_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLiquidityProviderHistoryResponse_messageType fastReflection_QueryLiquidityProviderHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLiquidityProviderHistoryResponse_messageType{}
+
+type fastReflection_QueryLiquidityProviderHistoryResponse_messageType struct{}
+
+func (x fastReflection_QueryLiquidityProviderHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLiquidityProviderHistoryResponse)(nil)
+}
+func (x fastReflection_QueryLiquidityProviderHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLiquidityProviderHistoryResponse)
+}
+func (x fastReflection_QueryLiquidityProviderHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLiquidityProviderHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLiquidityProviderHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLiquidityProviderHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLiquidityProviderHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLiquidityProviderHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != "" {
+		value := protoreflect.ValueOfString(x.Asset)
+		if !f(fd_QueryLiquidityProviderHistoryResponse_asset, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryLiquidityProviderHistoryResponse_address, value) {
+			return
+		}
+	}
+	if len(x.Positions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLiquidityProviderHistoryResponse_3_list{list: &x.Positions})
+		if !f(fd_QueryLiquidityProviderHistoryResponse_positions, value) {
+			return
+		}
+	}
+	if x.NextHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextHeight)
+		if !f(fd_QueryLiquidityProviderHistoryResponse_next_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryResponse.asset":
+		return x.Asset != ""
+	case "types.QueryLiquidityProviderHistoryResponse.address":
+		return x.Address != ""
+	case "types.QueryLiquidityProviderHistoryResponse.positions":
+		return len(x.Positions) != 0
+	case "types.QueryLiquidityProviderHistoryResponse.next_height":
+		return x.NextHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryResponse.asset":
+		x.Asset = ""
+	case "types.QueryLiquidityProviderHistoryResponse.address":
+		x.Address = ""
+	case "types.QueryLiquidityProviderHistoryResponse.positions":
+		x.Positions = nil
+	case "types.QueryLiquidityProviderHistoryResponse.next_height":
+		x.NextHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryLiquidityProviderHistoryResponse.asset":
+		value := x.Asset
+		return protoreflect.ValueOfString(value)
+	case "types.QueryLiquidityProviderHistoryResponse.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "types.QueryLiquidityProviderHistoryResponse.positions":
+		if len(x.Positions) == 0 {
+			return protoreflect.ValueOfList(&_QueryLiquidityProviderHistoryResponse_3_list{})
+		}
+		listValue := &_QueryLiquidityProviderHistoryResponse_3_list{list: &x.Positions}
+		return protoreflect.ValueOfList(listValue)
+	case "types.QueryLiquidityProviderHistoryResponse.next_height":
+		value := x.NextHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryResponse.asset":
+		x.Asset = value.Interface().(string)
+	case "types.QueryLiquidityProviderHistoryResponse.address":
+		x.Address = value.Interface().(string)
+	case "types.QueryLiquidityProviderHistoryResponse.positions":
+		lv := value.List()
+		clv := lv.(*_QueryLiquidityProviderHistoryResponse_3_list)
+		x.Positions = *clv.list
+	case "types.QueryLiquidityProviderHistoryResponse.next_height":
+		x.NextHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryResponse.positions":
+		if x.Positions == nil {
+			x.Positions = []*LiquidityProviderPosition{}
+		}
+		value := &_QueryLiquidityProviderHistoryResponse_3_list{list: &x.Positions}
+		return protoreflect.ValueOfList(value)
+	case "types.QueryLiquidityProviderHistoryResponse.asset":
+		panic(fmt.Errorf("field asset of message types.QueryLiquidityProviderHistoryResponse is not mutable"))
+	case "types.QueryLiquidityProviderHistoryResponse.address":
+		panic(fmt.Errorf("field address of message types.QueryLiquidityProviderHistoryResponse is not mutable"))
+	case "types.QueryLiquidityProviderHistoryResponse.next_height":
+		panic(fmt.Errorf("field next_height of message types.QueryLiquidityProviderHistoryResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryLiquidityProviderHistoryResponse.asset":
+		return protoreflect.ValueOfString("")
+	case "types.QueryLiquidityProviderHistoryResponse.address":
+		return protoreflect.ValueOfString("")
+	case "types.QueryLiquidityProviderHistoryResponse.positions":
+		list := []*LiquidityProviderPosition{}
+		return protoreflect.ValueOfList(&_QueryLiquidityProviderHistoryResponse_3_list{list: &list})
+	case "types.QueryLiquidityProviderHistoryResponse.next_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryLiquidityProviderHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryLiquidityProviderHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryLiquidityProviderHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLiquidityProviderHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLiquidityProviderHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Asset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Positions) > 0 {
+			for _, e := range x.Positions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLiquidityProviderHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Positions) > 0 {
+			for iNdEx := len(x.Positions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Positions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Asset) > 0 {
+			i -= len(x.Asset)
+			copy(dAtA[i:], x.Asset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLiquidityProviderHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLiquidityProviderHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLiquidityProviderHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Asset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Positions = append(x.Positions, &LiquidityProviderPosition{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Positions[len(x.Positions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+				}
+				x.NextHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: types/query_liquidity_provider.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QueryLiquidityProviderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Height  string `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryLiquidityProviderRequest) Reset() {
+	*x = QueryLiquidityProviderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_liquidity_provider_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLiquidityProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLiquidityProviderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLiquidityProviderRequest.ProtoReflect.Descriptor instead.
+func (*QueryLiquidityProviderRequest) Descriptor() ([]byte, []int) {
+	return file_types_query_liquidity_provider_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryLiquidityProviderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *QueryLiquidityProviderRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryLiquidityProviderRequest) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+// Can we replace this with LiquidityProvider proto? Check json fields...
+type QueryLiquidityProviderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset              string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	SwitchAddress      string `protobuf:"bytes,2,opt,name=switch_address,json=switchAddress,proto3" json:"switch_address,omitempty"`
+	AssetAddress       string `protobuf:"bytes,3,opt,name=asset_address,json=assetAddress,proto3" json:"asset_address,omitempty"`
+	LastAddHeight      int64  `protobuf:"varint,4,opt,name=last_add_height,json=lastAddHeight,proto3" json:"last_add_height,omitempty"`
+	LastWithdrawHeight int64  `protobuf:"varint,5,opt,name=last_withdraw_height,json=lastWithdrawHeight,proto3" json:"last_withdraw_height,omitempty"`
+	Units              string `protobuf:"bytes,6,opt,name=units,proto3" json:"units,omitempty"`
+	PendingSwitch      string `protobuf:"bytes,7,opt,name=pending_switch,json=pendingSwitch,proto3" json:"pending_switch,omitempty"`
+	PendingAsset       string `protobuf:"bytes,8,opt,name=pending_asset,json=pendingAsset,proto3" json:"pending_asset,omitempty"`
+	PendingTxId        string `protobuf:"bytes,9,opt,name=pending_tx_id,json=pendingTxId,proto3" json:"pending_tx_id,omitempty"`
+	SwitchDepositValue string `protobuf:"bytes,10,opt,name=switch_deposit_value,json=switchDepositValue,proto3" json:"switch_deposit_value,omitempty"`
+	AssetDepositValue  string `protobuf:"bytes,11,opt,name=asset_deposit_value,json=assetDepositValue,proto3" json:"asset_deposit_value,omitempty"`
+	SwitchRedeemValue  string `protobuf:"bytes,12,opt,name=switch_redeem_value,json=switchRedeemValue,proto3" json:"switch_redeem_value,omitempty"`
+	AssetRedeemValue   string `protobuf:"bytes,13,opt,name=asset_redeem_value,json=assetRedeemValue,proto3" json:"asset_redeem_value,omitempty"`
+	LuviDepositValue   string `protobuf:"bytes,14,opt,name=luvi_deposit_value,json=luviDepositValue,proto3" json:"luvi_deposit_value,omitempty"`
+	LuviRedeemValue    string `protobuf:"bytes,15,opt,name=luvi_redeem_value,json=luviRedeemValue,proto3" json:"luvi_redeem_value,omitempty"`
+	LuviGrowthPct      string `protobuf:"bytes,16,opt,name=luvi_growth_pct,json=luviGrowthPct,proto3" json:"luvi_growth_pct,omitempty"`
+}
+
+func (x *QueryLiquidityProviderResponse) Reset() {
+	*x = QueryLiquidityProviderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_liquidity_provider_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3114,8 +5551,13 @@ type QueryLiquidityProvidersRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset      string               `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Height     string               `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return liquidity providers with a switch or asset address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,4,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// only return liquidity providers with at least this many pool units
+	MinUnits string `protobuf:"bytes,5,opt,name=min_units,json=minUnits,proto3" json:"min_units,omitempty"`
 }
 
 func (x *QueryLiquidityProvidersRequest) Reset() {
@@ -3152,12 +5594,34 @@ func (x *QueryLiquidityProvidersRequest) GetHeight() string {
 	return ""
 }
 
+func (x *QueryLiquidityProvidersRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryLiquidityProvidersRequest) GetAddressPrefix() string {
+	if x != nil {
+		return x.AddressPrefix
+	}
+	return ""
+}
+
+func (x *QueryLiquidityProvidersRequest) GetMinUnits() string {
+	if x != nil {
+		return x.MinUnits
+	}
+	return ""
+}
+
 type QueryLiquidityProvidersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	LiquidityProviders []*QueryLiquidityProviderResponse `protobuf:"bytes,1,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers,omitempty"`
+	Pagination         *v1beta1.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryLiquidityProvidersResponse) Reset() {
@@ -3187,6 +5651,235 @@ func (x *QueryLiquidityProvidersResponse) GetLiquidityProviders() []*QueryLiquid
 	return nil
 }
 
+func (x *QueryLiquidityProvidersResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryLiquidityProviderHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// first height of the range, the position at this height is always included
+	FromHeight int64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// last height of the range, defaults to the last height recorded
+	ToHeight int64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// maximum number of positions returned
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *QueryLiquidityProviderHistoryRequest) Reset() {
+	*x = QueryLiquidityProviderHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_liquidity_provider_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLiquidityProviderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLiquidityProviderHistoryRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLiquidityProviderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryLiquidityProviderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_types_query_liquidity_provider_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryLiquidityProviderHistoryRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *QueryLiquidityProviderHistoryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryLiquidityProviderHistoryRequest) GetFromHeight() int64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *QueryLiquidityProviderHistoryRequest) GetToHeight() int64 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+func (x *QueryLiquidityProviderHistoryRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LiquidityProviderPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height             int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Units              string `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"`
+	PoolUnits          string `protobuf:"bytes,3,opt,name=pool_units,json=poolUnits,proto3" json:"pool_units,omitempty"`
+	SwitchRedeemValue  string `protobuf:"bytes,4,opt,name=switch_redeem_value,json=switchRedeemValue,proto3" json:"switch_redeem_value,omitempty"`
+	AssetRedeemValue   string `protobuf:"bytes,5,opt,name=asset_redeem_value,json=assetRedeemValue,proto3" json:"asset_redeem_value,omitempty"`
+	SwitchDepositValue string `protobuf:"bytes,6,opt,name=switch_deposit_value,json=switchDepositValue,proto3" json:"switch_deposit_value,omitempty"`
+	AssetDepositValue  string `protobuf:"bytes,7,opt,name=asset_deposit_value,json=assetDepositValue,proto3" json:"asset_deposit_value,omitempty"`
+	// liquidity fees in SWITCH earned by the position up to the height
+	AccruedFees string `protobuf:"bytes,8,opt,name=accrued_fees,json=accruedFees,proto3" json:"accrued_fees,omitempty"`
+}
+
+func (x *LiquidityProviderPosition) Reset() {
+	*x = LiquidityProviderPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_liquidity_provider_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityProviderPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityProviderPosition) ProtoMessage() {}
+
+// Deprecated: Use LiquidityProviderPosition.ProtoReflect.Descriptor instead.
+func (*LiquidityProviderPosition) Descriptor() ([]byte, []int) {
+	return file_types_query_liquidity_provider_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LiquidityProviderPosition) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *LiquidityProviderPosition) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *LiquidityProviderPosition) GetPoolUnits() string {
+	if x != nil {
+		return x.PoolUnits
+	}
+	return ""
+}
+
+func (x *LiquidityProviderPosition) GetSwitchRedeemValue() string {
+	if x != nil {
+		return x.SwitchRedeemValue
+	}
+	return ""
+}
+
+func (x *LiquidityProviderPosition) GetAssetRedeemValue() string {
+	if x != nil {
+		return x.AssetRedeemValue
+	}
+	return ""
+}
+
+func (x *LiquidityProviderPosition) GetSwitchDepositValue() string {
+	if x != nil {
+		return x.SwitchDepositValue
+	}
+	return ""
+}
+
+func (x *LiquidityProviderPosition) GetAssetDepositValue() string {
+	if x != nil {
+		return x.AssetDepositValue
+	}
+	return ""
+}
+
+func (x *LiquidityProviderPosition) GetAccruedFees() string {
+	if x != nil {
+		return x.AccruedFees
+	}
+	return ""
+}
+
+type QueryLiquidityProviderHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset     string                       `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address   string                       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Positions []*LiquidityProviderPosition `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions,omitempty"`
+	// height to continue the range from when it was truncated by the limit
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (x *QueryLiquidityProviderHistoryResponse) Reset() {
+	*x = QueryLiquidityProviderHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_liquidity_provider_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLiquidityProviderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLiquidityProviderHistoryResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryLiquidityProviderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryLiquidityProviderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_types_query_liquidity_provider_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryLiquidityProviderHistoryResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *QueryLiquidityProviderHistoryResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryLiquidityProviderHistoryResponse) GetPositions() []*LiquidityProviderPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *QueryLiquidityProviderHistoryResponse) GetNextHeight() int64 {
+	if x != nil {
+		return x.NextHeight
+	}
+	return 0
+}
+
 var File_types_query_liquidity_provider_proto protoreflect.FileDescriptor
 
 var file_types_query_liquidity_provider_proto_rawDesc = []byte{
@@ -3194,85 +5887,157 @@ var file_types_query_liquidity_provider_proto_rawDesc = []byte{
 	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x94, 0x06, 0x0a,
-	0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xea, 0xde, 0x1f, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
-	0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x12, 0xea, 0xde, 0x1f, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xea, 0xde, 0x1f, 0x0d, 0x70,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0c, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x49, 0x64, 0x12, 0x4a,
-	0x0a, 0x14, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde,
-	0x1f, 0x14, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x61, 0x73,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x67, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x94, 0x06, 0x0a, 0x1e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x64, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x05, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f,
+	0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
+	0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x36,
+	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xea, 0xde, 0x1f, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x14, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x12, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x2e, 0x0a, 0x13, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x6c, 0x75, 0x76, 0x69, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x75, 0x76, 0x69, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6c,
+	0x75, 0x76, 0x69, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x75, 0x76, 0x69, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x75, 0x76, 0x69, 0x5f,
+	0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x75, 0x76, 0x69, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x50, 0x63, 0x74, 0x22,
+	0xda, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0xc2, 0x01, 0x0a,
+	0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x56, 0x0a, 0x13, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x74, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe8,
+	0x03, 0x0a, 0x19, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea, 0xde,
+	0x1f, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1f, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xea, 0xde, 0x1f, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xea, 0xde, 0x1f, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x12, 0x47, 0x0a, 0x13, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65,
+	0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea,
+	0xde, 0x1f, 0x13, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x12, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x4a, 0x0a, 0x14, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea,
+	0xde, 0x1f, 0x14, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x61, 0x73,
 	0x73, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65,
-	0x64, 0x65, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x64,
-	0x65, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x75, 0x76, 0x69, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c,
-	0x75, 0x76, 0x69, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x2a, 0x0a, 0x11, 0x6c, 0x75, 0x76, 0x69, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x75, 0x76, 0x69,
-	0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
-	0x75, 0x76, 0x69, 0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x75, 0x76, 0x69, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68,
-	0x50, 0x63, 0x74, 0x22, 0x4e, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x79, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x6c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0x97,
-	0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x1b, 0x51, 0x75,
+	0x65, 0x52, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x5f,
+	0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c,
+	0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x25, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0xc8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x97, 0x01, 0xc8, 0xe2,
+	0x1e, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x1b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
+	0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3287,20 +6052,28 @@ func file_types_query_liquidity_provider_proto_rawDescGZIP() []byte {
 	return file_types_query_liquidity_provider_proto_rawDescData
 }
 
-var file_types_query_liquidity_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_types_query_liquidity_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_types_query_liquidity_provider_proto_goTypes = []interface{}{
-	(*QueryLiquidityProviderRequest)(nil),   // 0: types.QueryLiquidityProviderRequest
-	(*QueryLiquidityProviderResponse)(nil),  // 1: types.QueryLiquidityProviderResponse
-	(*QueryLiquidityProvidersRequest)(nil),  // 2: types.QueryLiquidityProvidersRequest
-	(*QueryLiquidityProvidersResponse)(nil), // 3: types.QueryLiquidityProvidersResponse
+	(*QueryLiquidityProviderRequest)(nil),         // 0: types.QueryLiquidityProviderRequest
+	(*QueryLiquidityProviderResponse)(nil),        // 1: types.QueryLiquidityProviderResponse
+	(*QueryLiquidityProvidersRequest)(nil),        // 2: types.QueryLiquidityProvidersRequest
+	(*QueryLiquidityProvidersResponse)(nil),       // 3: types.QueryLiquidityProvidersResponse
+	(*QueryLiquidityProviderHistoryRequest)(nil),  // 4: types.QueryLiquidityProviderHistoryRequest
+	(*LiquidityProviderPosition)(nil),             // 5: types.LiquidityProviderPosition
+	(*QueryLiquidityProviderHistoryResponse)(nil), // 6: types.QueryLiquidityProviderHistoryResponse
+	(*v1beta1.PageRequest)(nil),                   // 7: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                  // 8: cosmos.base.query.v1beta1.PageResponse
 }
 var file_types_query_liquidity_provider_proto_depIdxs = []int32{
-	1, // 0: types.QueryLiquidityProvidersResponse.liquidity_providers:type_name -> types.QueryLiquidityProviderResponse
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7, // 0: types.QueryLiquidityProvidersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1, // 1: types.QueryLiquidityProvidersResponse.liquidity_providers:type_name -> types.QueryLiquidityProviderResponse
+	8, // 2: types.QueryLiquidityProvidersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	5, // 3: types.QueryLiquidityProviderHistoryResponse.positions:type_name -> types.LiquidityProviderPosition
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_types_query_liquidity_provider_proto_init() }
@@ -3357,6 +6130,42 @@ func file_types_query_liquidity_provider_proto_init() {
 				return nil
 			}
 		}
+		file_types_query_liquidity_provider_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLiquidityProviderHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_liquidity_provider_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityProviderPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_liquidity_provider_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLiquidityProviderHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3364,7 +6173,7 @@ func file_types_query_liquidity_provider_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_query_liquidity_provider_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
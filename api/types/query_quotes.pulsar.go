@@ -120,6 +120,8 @@ var (
 	fd_QueryQuoteSwapRequest_height                  protoreflect.FieldDescriptor
 	fd_QueryQuoteSwapRequest_liquidity_tolerance_bps protoreflect.FieldDescriptor
 	fd_QueryQuoteSwapRequest_extended                protoreflect.FieldDescriptor
+	fd_QueryQuoteSwapRequest_optimize_streaming      protoreflect.FieldDescriptor
+	fd_QueryQuoteSwapRequest_max_streaming_seconds   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -138,6 +140,8 @@ func init() {
 	fd_QueryQuoteSwapRequest_height = md_QueryQuoteSwapRequest.Fields().ByName("height")
 	fd_QueryQuoteSwapRequest_liquidity_tolerance_bps = md_QueryQuoteSwapRequest.Fields().ByName("liquidity_tolerance_bps")
 	fd_QueryQuoteSwapRequest_extended = md_QueryQuoteSwapRequest.Fields().ByName("extended")
+	fd_QueryQuoteSwapRequest_optimize_streaming = md_QueryQuoteSwapRequest.Fields().ByName("optimize_streaming")
+	fd_QueryQuoteSwapRequest_max_streaming_seconds = md_QueryQuoteSwapRequest.Fields().ByName("max_streaming_seconds")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryQuoteSwapRequest)(nil)
@@ -283,6 +287,18 @@ func (x *fastReflection_QueryQuoteSwapRequest) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if x.OptimizeStreaming != false {
+		value := protoreflect.ValueOfBool(x.OptimizeStreaming)
+		if !f(fd_QueryQuoteSwapRequest_optimize_streaming, value) {
+			return
+		}
+	}
+	if x.MaxStreamingSeconds != "" {
+		value := protoreflect.ValueOfString(x.MaxStreamingSeconds)
+		if !f(fd_QueryQuoteSwapRequest_max_streaming_seconds, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -324,6 +340,10 @@ func (x *fastReflection_QueryQuoteSwapRequest) Has(fd protoreflect.FieldDescript
 		return x.LiquidityToleranceBps != ""
 	case "types.QueryQuoteSwapRequest.extended":
 		return x.Extended != false
+	case "types.QueryQuoteSwapRequest.optimize_streaming":
+		return x.OptimizeStreaming != false
+	case "types.QueryQuoteSwapRequest.max_streaming_seconds":
+		return x.MaxStreamingSeconds != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapRequest"))
@@ -366,6 +386,10 @@ func (x *fastReflection_QueryQuoteSwapRequest) Clear(fd protoreflect.FieldDescri
 		x.LiquidityToleranceBps = ""
 	case "types.QueryQuoteSwapRequest.extended":
 		x.Extended = false
+	case "types.QueryQuoteSwapRequest.optimize_streaming":
+		x.OptimizeStreaming = false
+	case "types.QueryQuoteSwapRequest.max_streaming_seconds":
+		x.MaxStreamingSeconds = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapRequest"))
@@ -427,6 +451,12 @@ func (x *fastReflection_QueryQuoteSwapRequest) Get(descriptor protoreflect.Field
 	case "types.QueryQuoteSwapRequest.extended":
 		value := x.Extended
 		return protoreflect.ValueOfBool(value)
+	case "types.QueryQuoteSwapRequest.optimize_streaming":
+		value := x.OptimizeStreaming
+		return protoreflect.ValueOfBool(value)
+	case "types.QueryQuoteSwapRequest.max_streaming_seconds":
+		value := x.MaxStreamingSeconds
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapRequest"))
@@ -477,6 +507,10 @@ func (x *fastReflection_QueryQuoteSwapRequest) Set(fd protoreflect.FieldDescript
 		x.LiquidityToleranceBps = value.Interface().(string)
 	case "types.QueryQuoteSwapRequest.extended":
 		x.Extended = value.Bool()
+	case "types.QueryQuoteSwapRequest.optimize_streaming":
+		x.OptimizeStreaming = value.Bool()
+	case "types.QueryQuoteSwapRequest.max_streaming_seconds":
+		x.MaxStreamingSeconds = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapRequest"))
@@ -531,6 +565,10 @@ func (x *fastReflection_QueryQuoteSwapRequest) Mutable(fd protoreflect.FieldDesc
 		panic(fmt.Errorf("field liquidity_tolerance_bps of message types.QueryQuoteSwapRequest is not mutable"))
 	case "types.QueryQuoteSwapRequest.extended":
 		panic(fmt.Errorf("field extended of message types.QueryQuoteSwapRequest is not mutable"))
+	case "types.QueryQuoteSwapRequest.optimize_streaming":
+		panic(fmt.Errorf("field optimize_streaming of message types.QueryQuoteSwapRequest is not mutable"))
+	case "types.QueryQuoteSwapRequest.max_streaming_seconds":
+		panic(fmt.Errorf("field max_streaming_seconds of message types.QueryQuoteSwapRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapRequest"))
@@ -572,6 +610,10 @@ func (x *fastReflection_QueryQuoteSwapRequest) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfString("")
 	case "types.QueryQuoteSwapRequest.extended":
 		return protoreflect.ValueOfBool(false)
+	case "types.QueryQuoteSwapRequest.optimize_streaming":
+		return protoreflect.ValueOfBool(false)
+	case "types.QueryQuoteSwapRequest.max_streaming_seconds":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapRequest"))
@@ -696,6 +738,13 @@ func (x *fastReflection_QueryQuoteSwapRequest) ProtoMethods() *protoiface.Method
 		if x.Extended {
 			n += 2
 		}
+		if x.OptimizeStreaming {
+			n += 2
+		}
+		l = len(x.MaxStreamingSeconds)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -725,6 +774,23 @@ func (x *fastReflection_QueryQuoteSwapRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxStreamingSeconds) > 0 {
+			i -= len(x.MaxStreamingSeconds)
+			copy(dAtA[i:], x.MaxStreamingSeconds)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxStreamingSeconds)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.OptimizeStreaming {
+			i--
+			if x.OptimizeStreaming {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
 		if x.Extended {
 			i--
 			if x.Extended {
@@ -1276,6 +1342,58 @@ func (x *fastReflection_QueryQuoteSwapRequest) ProtoMethods() *protoiface.Method
 					}
 				}
 				x.Extended = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptimizeStreaming", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OptimizeStreaming = bool(v != 0)
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStreamingSeconds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxStreamingSeconds = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1362,6 +1480,57 @@ func (x *_QueryQuoteSwapResponse_21_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_QueryQuoteSwapResponse_22_list)(nil)
+
+type _QueryQuoteSwapResponse_22_list struct {
+	list *[]*StreamingQuotePoint
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamingQuotePoint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamingQuotePoint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) AppendMutable() protoreflect.Value {
+	v := new(StreamingQuotePoint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) NewElement() protoreflect.Value {
+	v := new(StreamingQuotePoint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryQuoteSwapResponse_22_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_QueryQuoteSwapResponse                              protoreflect.MessageDescriptor
 	fd_QueryQuoteSwapResponse_inbound_address              protoreflect.FieldDescriptor
@@ -1385,6 +1554,7 @@ var (
 	fd_QueryQuoteSwapResponse_streaming_swap_seconds       protoreflect.FieldDescriptor
 	fd_QueryQuoteSwapResponse_total_swap_seconds           protoreflect.FieldDescriptor
 	fd_QueryQuoteSwapResponse_vout                         protoreflect.FieldDescriptor
+	fd_QueryQuoteSwapResponse_streaming_curve              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1411,6 +1581,7 @@ func init() {
 	fd_QueryQuoteSwapResponse_streaming_swap_seconds = md_QueryQuoteSwapResponse.Fields().ByName("streaming_swap_seconds")
 	fd_QueryQuoteSwapResponse_total_swap_seconds = md_QueryQuoteSwapResponse.Fields().ByName("total_swap_seconds")
 	fd_QueryQuoteSwapResponse_vout = md_QueryQuoteSwapResponse.Fields().ByName("vout")
+	fd_QueryQuoteSwapResponse_streaming_curve = md_QueryQuoteSwapResponse.Fields().ByName("streaming_curve")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryQuoteSwapResponse)(nil)
@@ -1604,6 +1775,12 @@ func (x *fastReflection_QueryQuoteSwapResponse) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if len(x.StreamingCurve) != 0 {
+		value := protoreflect.ValueOfList(&_QueryQuoteSwapResponse_22_list{list: &x.StreamingCurve})
+		if !f(fd_QueryQuoteSwapResponse_streaming_curve, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1661,6 +1838,8 @@ func (x *fastReflection_QueryQuoteSwapResponse) Has(fd protoreflect.FieldDescrip
 		return x.TotalSwapSeconds != int64(0)
 	case "types.QueryQuoteSwapResponse.vout":
 		return len(x.Vout) != 0
+	case "types.QueryQuoteSwapResponse.streaming_curve":
+		return len(x.StreamingCurve) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapResponse"))
@@ -1719,6 +1898,8 @@ func (x *fastReflection_QueryQuoteSwapResponse) Clear(fd protoreflect.FieldDescr
 		x.TotalSwapSeconds = int64(0)
 	case "types.QueryQuoteSwapResponse.vout":
 		x.Vout = nil
+	case "types.QueryQuoteSwapResponse.streaming_curve":
+		x.StreamingCurve = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapResponse"))
@@ -1801,6 +1982,12 @@ func (x *fastReflection_QueryQuoteSwapResponse) Get(descriptor protoreflect.Fiel
 		}
 		listValue := &_QueryQuoteSwapResponse_21_list{list: &x.Vout}
 		return protoreflect.ValueOfList(listValue)
+	case "types.QueryQuoteSwapResponse.streaming_curve":
+		if len(x.StreamingCurve) == 0 {
+			return protoreflect.ValueOfList(&_QueryQuoteSwapResponse_22_list{})
+		}
+		listValue := &_QueryQuoteSwapResponse_22_list{list: &x.StreamingCurve}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapResponse"))
@@ -1865,6 +2052,10 @@ func (x *fastReflection_QueryQuoteSwapResponse) Set(fd protoreflect.FieldDescrip
 		lv := value.List()
 		clv := lv.(*_QueryQuoteSwapResponse_21_list)
 		x.Vout = *clv.list
+	case "types.QueryQuoteSwapResponse.streaming_curve":
+		lv := value.List()
+		clv := lv.(*_QueryQuoteSwapResponse_22_list)
+		x.StreamingCurve = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapResponse"))
@@ -1896,6 +2087,12 @@ func (x *fastReflection_QueryQuoteSwapResponse) Mutable(fd protoreflect.FieldDes
 		}
 		value := &_QueryQuoteSwapResponse_21_list{list: &x.Vout}
 		return protoreflect.ValueOfList(value)
+	case "types.QueryQuoteSwapResponse.streaming_curve":
+		if x.StreamingCurve == nil {
+			x.StreamingCurve = []*StreamingQuotePoint{}
+		}
+		value := &_QueryQuoteSwapResponse_22_list{list: &x.StreamingCurve}
+		return protoreflect.ValueOfList(value)
 	case "types.QueryQuoteSwapResponse.inbound_address":
 		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteSwapResponse is not mutable"))
 	case "types.QueryQuoteSwapResponse.inbound_confirmation_blocks":
@@ -1991,6 +2188,9 @@ func (x *fastReflection_QueryQuoteSwapResponse) NewField(fd protoreflect.FieldDe
 	case "types.QueryQuoteSwapResponse.vout":
 		list := []*Vout{}
 		return protoreflect.ValueOfList(&_QueryQuoteSwapResponse_21_list{list: &list})
+	case "types.QueryQuoteSwapResponse.streaming_curve":
+		list := []*StreamingQuotePoint{}
+		return protoreflect.ValueOfList(&_QueryQuoteSwapResponse_22_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapResponse"))
@@ -2137,6 +2337,12 @@ func (x *fastReflection_QueryQuoteSwapResponse) ProtoMethods() *protoiface.Metho
 				n += 2 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.StreamingCurve) > 0 {
+			for _, e := range x.StreamingCurve {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2166,6 +2372,24 @@ func (x *fastReflection_QueryQuoteSwapResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.StreamingCurve) > 0 {
+			for iNdEx := len(x.StreamingCurve) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StreamingCurve[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
 		if len(x.Vout) > 0 {
 			for iNdEx := len(x.Vout) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Vout[iNdEx])
@@ -2933,6 +3157,40 @@ func (x *fastReflection_QueryQuoteSwapResponse) ProtoMethods() *protoiface.Metho
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingCurve", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StreamingCurve = append(x.StreamingCurve, &StreamingQuotePoint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StreamingCurve[len(x.StreamingCurve)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2968,126 +3226,79 @@ func (x *fastReflection_QueryQuoteSwapResponse) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_QueryQuoteSaverDepositRequest_3_list)(nil)
+var _ protoreflect.List = (*_QueryQuoteSwapBatchRequest_1_list)(nil)
 
-type _QueryQuoteSaverDepositRequest_3_list struct {
-	list *[]string
+type _QueryQuoteSwapBatchRequest_1_list struct {
+	list *[]*QueryQuoteSwapRequest
 }
 
-func (x *_QueryQuoteSaverDepositRequest_3_list) Len() int {
+func (x *_QueryQuoteSwapBatchRequest_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryQuoteSaverDepositRequest_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_QueryQuoteSwapBatchRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryQuoteSaverDepositRequest_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryQuoteSwapBatchRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryQuoteSwapRequest)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryQuoteSaverDepositRequest_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryQuoteSwapBatchRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryQuoteSwapRequest)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryQuoteSaverDepositRequest_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteSaverDepositRequest at list field Affiliate as it is not of Message kind"))
-}
-
-func (x *_QueryQuoteSaverDepositRequest_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryQuoteSaverDepositRequest_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryQuoteSaverDepositRequest_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_QueryQuoteSaverDepositRequest_4_list)(nil)
-
-type _QueryQuoteSaverDepositRequest_4_list struct {
-	list *[]string
+func (x *_QueryQuoteSwapBatchRequest_1_list) AppendMutable() protoreflect.Value {
+	v := new(QueryQuoteSwapRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryQuoteSaverDepositRequest_4_list) Len() int {
-	if x.list == nil {
-		return 0
+func (x *_QueryQuoteSwapBatchRequest_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return len(*x.list)
-}
-
-func (x *_QueryQuoteSaverDepositRequest_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_QueryQuoteSaverDepositRequest_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryQuoteSaverDepositRequest_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryQuoteSaverDepositRequest_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteSaverDepositRequest at list field AffiliateBps as it is not of Message kind"))
-}
-
-func (x *_QueryQuoteSaverDepositRequest_4_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryQuoteSaverDepositRequest_4_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_QueryQuoteSwapBatchRequest_1_list) NewElement() protoreflect.Value {
+	v := new(QueryQuoteSwapRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryQuoteSaverDepositRequest_4_list) IsValid() bool {
+func (x *_QueryQuoteSwapBatchRequest_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryQuoteSaverDepositRequest               protoreflect.MessageDescriptor
-	fd_QueryQuoteSaverDepositRequest_asset         protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositRequest_amount        protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositRequest_affiliate     protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositRequest_affiliate_bps protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositRequest_height        protoreflect.FieldDescriptor
+	md_QueryQuoteSwapBatchRequest        protoreflect.MessageDescriptor
+	fd_QueryQuoteSwapBatchRequest_quotes protoreflect.FieldDescriptor
+	fd_QueryQuoteSwapBatchRequest_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_quotes_proto_init()
-	md_QueryQuoteSaverDepositRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverDepositRequest")
-	fd_QueryQuoteSaverDepositRequest_asset = md_QueryQuoteSaverDepositRequest.Fields().ByName("asset")
-	fd_QueryQuoteSaverDepositRequest_amount = md_QueryQuoteSaverDepositRequest.Fields().ByName("amount")
-	fd_QueryQuoteSaverDepositRequest_affiliate = md_QueryQuoteSaverDepositRequest.Fields().ByName("affiliate")
-	fd_QueryQuoteSaverDepositRequest_affiliate_bps = md_QueryQuoteSaverDepositRequest.Fields().ByName("affiliate_bps")
-	fd_QueryQuoteSaverDepositRequest_height = md_QueryQuoteSaverDepositRequest.Fields().ByName("height")
+	md_QueryQuoteSwapBatchRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSwapBatchRequest")
+	fd_QueryQuoteSwapBatchRequest_quotes = md_QueryQuoteSwapBatchRequest.Fields().ByName("quotes")
+	fd_QueryQuoteSwapBatchRequest_height = md_QueryQuoteSwapBatchRequest.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverDepositRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryQuoteSwapBatchRequest)(nil)
 
-type fastReflection_QueryQuoteSaverDepositRequest QueryQuoteSaverDepositRequest
+type fastReflection_QueryQuoteSwapBatchRequest QueryQuoteSwapBatchRequest
 
-func (x *QueryQuoteSaverDepositRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverDepositRequest)(x)
+func (x *QueryQuoteSwapBatchRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSwapBatchRequest)(x)
 }
 
-func (x *QueryQuoteSaverDepositRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryQuoteSwapBatchRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_types_query_quotes_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3099,43 +3310,43 @@ func (x *QueryQuoteSaverDepositRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryQuoteSaverDepositRequest_messageType fastReflection_QueryQuoteSaverDepositRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverDepositRequest_messageType{}
+var _fastReflection_QueryQuoteSwapBatchRequest_messageType fastReflection_QueryQuoteSwapBatchRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteSwapBatchRequest_messageType{}
 
-type fastReflection_QueryQuoteSaverDepositRequest_messageType struct{}
+type fastReflection_QueryQuoteSwapBatchRequest_messageType struct{}
 
-func (x fastReflection_QueryQuoteSaverDepositRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverDepositRequest)(nil)
+func (x fastReflection_QueryQuoteSwapBatchRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSwapBatchRequest)(nil)
 }
-func (x fastReflection_QueryQuoteSaverDepositRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverDepositRequest)
+func (x fastReflection_QueryQuoteSwapBatchRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSwapBatchRequest)
 }
-func (x fastReflection_QueryQuoteSaverDepositRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverDepositRequest
+func (x fastReflection_QueryQuoteSwapBatchRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSwapBatchRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverDepositRequest
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSwapBatchRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteSaverDepositRequest_messageType
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteSwapBatchRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverDepositRequest)
+func (x *fastReflection_QueryQuoteSwapBatchRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSwapBatchRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteSaverDepositRequest)(x)
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteSwapBatchRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3143,34 +3354,16 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Asset != "" {
-		value := protoreflect.ValueOfString(x.Asset)
-		if !f(fd_QueryQuoteSaverDepositRequest_asset, value) {
-			return
-		}
-	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_QueryQuoteSaverDepositRequest_amount, value) {
-			return
-		}
-	}
-	if len(x.Affiliate) != 0 {
-		value := protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_3_list{list: &x.Affiliate})
-		if !f(fd_QueryQuoteSaverDepositRequest_affiliate, value) {
-			return
-		}
-	}
-	if len(x.AffiliateBps) != 0 {
-		value := protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_4_list{list: &x.AffiliateBps})
-		if !f(fd_QueryQuoteSaverDepositRequest_affiliate_bps, value) {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Quotes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryQuoteSwapBatchRequest_1_list{list: &x.Quotes})
+		if !f(fd_QueryQuoteSwapBatchRequest_quotes, value) {
 			return
 		}
 	}
 	if x.Height != "" {
 		value := protoreflect.ValueOfString(x.Height)
-		if !f(fd_QueryQuoteSaverDepositRequest_height, value) {
+		if !f(fd_QueryQuoteSwapBatchRequest_height, value) {
 			return
 		}
 	}
@@ -3187,23 +3380,17 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositRequest.asset":
-		return x.Asset != ""
-	case "types.QueryQuoteSaverDepositRequest.amount":
-		return x.Amount != ""
-	case "types.QueryQuoteSaverDepositRequest.affiliate":
-		return len(x.Affiliate) != 0
-	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
-		return len(x.AffiliateBps) != 0
-	case "types.QueryQuoteSaverDepositRequest.height":
+	case "types.QueryQuoteSwapBatchRequest.quotes":
+		return len(x.Quotes) != 0
+	case "types.QueryQuoteSwapBatchRequest.height":
 		return x.Height != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3213,23 +3400,17 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositRequest.asset":
-		x.Asset = ""
-	case "types.QueryQuoteSaverDepositRequest.amount":
-		x.Amount = ""
-	case "types.QueryQuoteSaverDepositRequest.affiliate":
-		x.Affiliate = nil
-	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
-		x.AffiliateBps = nil
-	case "types.QueryQuoteSaverDepositRequest.height":
+	case "types.QueryQuoteSwapBatchRequest.quotes":
+		x.Quotes = nil
+	case "types.QueryQuoteSwapBatchRequest.height":
 		x.Height = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3239,34 +3420,22 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteSaverDepositRequest.asset":
-		value := x.Asset
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositRequest.amount":
-		value := x.Amount
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositRequest.affiliate":
-		if len(x.Affiliate) == 0 {
-			return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_3_list{})
-		}
-		listValue := &_QueryQuoteSaverDepositRequest_3_list{list: &x.Affiliate}
-		return protoreflect.ValueOfList(listValue)
-	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
-		if len(x.AffiliateBps) == 0 {
-			return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_4_list{})
+	case "types.QueryQuoteSwapBatchRequest.quotes":
+		if len(x.Quotes) == 0 {
+			return protoreflect.ValueOfList(&_QueryQuoteSwapBatchRequest_1_list{})
 		}
-		listValue := &_QueryQuoteSaverDepositRequest_4_list{list: &x.AffiliateBps}
+		listValue := &_QueryQuoteSwapBatchRequest_1_list{list: &x.Quotes}
 		return protoreflect.ValueOfList(listValue)
-	case "types.QueryQuoteSaverDepositRequest.height":
+	case "types.QueryQuoteSwapBatchRequest.height":
 		value := x.Height
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3280,27 +3449,19 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositRequest.asset":
-		x.Asset = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositRequest.amount":
-		x.Amount = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositRequest.affiliate":
-		lv := value.List()
-		clv := lv.(*_QueryQuoteSaverDepositRequest_3_list)
-		x.Affiliate = *clv.list
-	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
+	case "types.QueryQuoteSwapBatchRequest.quotes":
 		lv := value.List()
-		clv := lv.(*_QueryQuoteSaverDepositRequest_4_list)
-		x.AffiliateBps = *clv.list
-	case "types.QueryQuoteSaverDepositRequest.height":
+		clv := lv.(*_QueryQuoteSwapBatchRequest_1_list)
+		x.Quotes = *clv.list
+	case "types.QueryQuoteSwapBatchRequest.height":
 		x.Height = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3314,66 +3475,49 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositRequest.affiliate":
-		if x.Affiliate == nil {
-			x.Affiliate = []string{}
-		}
-		value := &_QueryQuoteSaverDepositRequest_3_list{list: &x.Affiliate}
-		return protoreflect.ValueOfList(value)
-	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
-		if x.AffiliateBps == nil {
-			x.AffiliateBps = []string{}
+	case "types.QueryQuoteSwapBatchRequest.quotes":
+		if x.Quotes == nil {
+			x.Quotes = []*QueryQuoteSwapRequest{}
 		}
-		value := &_QueryQuoteSaverDepositRequest_4_list{list: &x.AffiliateBps}
+		value := &_QueryQuoteSwapBatchRequest_1_list{list: &x.Quotes}
 		return protoreflect.ValueOfList(value)
-	case "types.QueryQuoteSaverDepositRequest.asset":
-		panic(fmt.Errorf("field asset of message types.QueryQuoteSaverDepositRequest is not mutable"))
-	case "types.QueryQuoteSaverDepositRequest.amount":
-		panic(fmt.Errorf("field amount of message types.QueryQuoteSaverDepositRequest is not mutable"))
-	case "types.QueryQuoteSaverDepositRequest.height":
-		panic(fmt.Errorf("field height of message types.QueryQuoteSaverDepositRequest is not mutable"))
+	case "types.QueryQuoteSwapBatchRequest.height":
+		panic(fmt.Errorf("field height of message types.QueryQuoteSwapBatchRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositRequest.asset":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositRequest.amount":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositRequest.affiliate":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_3_list{list: &list})
-	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_4_list{list: &list})
-	case "types.QueryQuoteSaverDepositRequest.height":
+	case "types.QueryQuoteSwapBatchRequest.quotes":
+		list := []*QueryQuoteSwapRequest{}
+		return protoreflect.ValueOfList(&_QueryQuoteSwapBatchRequest_1_list{list: &list})
+	case "types.QueryQuoteSwapBatchRequest.height":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverDepositRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSwapBatchRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3381,7 +3525,7 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3392,7 +3536,7 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3404,7 +3548,7 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) IsValid() bool {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3414,9 +3558,9 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryQuoteSwapBatchRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteSaverDepositRequest)
+		x := input.Message.Interface().(*QueryQuoteSwapBatchRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3428,23 +3572,9 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		l = len(x.Asset)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Amount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Affiliate) > 0 {
-			for _, s := range x.Affiliate {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.AffiliateBps) > 0 {
-			for _, s := range x.AffiliateBps {
-				l = len(s)
+		if len(x.Quotes) > 0 {
+			for _, e := range x.Quotes {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -3462,7 +3592,7 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverDepositRequest)
+		x := input.Message.Interface().(*QueryQuoteSwapBatchRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3486,40 +3616,24 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 			copy(dAtA[i:], x.Height)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
 			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.AffiliateBps) > 0 {
-			for iNdEx := len(x.AffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AffiliateBps[iNdEx])
-				copy(dAtA[i:], x.AffiliateBps[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AffiliateBps[iNdEx])))
-				i--
-				dAtA[i] = 0x22
-			}
+			dAtA[i] = 0x12
 		}
-		if len(x.Affiliate) > 0 {
-			for iNdEx := len(x.Affiliate) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Affiliate[iNdEx])
-				copy(dAtA[i:], x.Affiliate[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Affiliate[iNdEx])))
+		if len(x.Quotes) > 0 {
+			for iNdEx := len(x.Quotes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Quotes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Asset) > 0 {
-			i -= len(x.Asset)
-			copy(dAtA[i:], x.Asset)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3531,7 +3645,7 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverDepositRequest)
+		x := input.Message.Interface().(*QueryQuoteSwapBatchRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3563,17 +3677,17 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSwapBatchRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSwapBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quotes", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3583,27 +3697,29 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Asset = string(dAtA[iNdEx:postIndex])
+				x.Quotes = append(x.Quotes, &QueryQuoteSwapRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Quotes[len(x.Quotes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3631,118 +3747,22 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Height = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Affiliate = append(x.Affiliate, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AffiliateBps = append(x.AffiliateBps, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Height = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -3765,57 +3785,27 @@ func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryQuoteSaverDepositResponse                              protoreflect.MessageDescriptor
-	fd_QueryQuoteSaverDepositResponse_inbound_address              protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_blocks  protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_seconds protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_outbound_delay_blocks        protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_outbound_delay_seconds       protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_fees                         protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_router                       protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_expiry                       protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_warning                      protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_notes                        protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_dust_threshold               protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_recommended_min_amount_in    protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_recommended_gas_rate         protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_gas_rate_units               protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_memo                         protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_expected_amount_out          protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverDepositResponse_expected_amount_deposit      protoreflect.FieldDescriptor
+	md_QuoteSwapBatchResult       protoreflect.MessageDescriptor
+	fd_QuoteSwapBatchResult_quote protoreflect.FieldDescriptor
+	fd_QuoteSwapBatchResult_error protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_quotes_proto_init()
-	md_QueryQuoteSaverDepositResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverDepositResponse")
-	fd_QueryQuoteSaverDepositResponse_inbound_address = md_QueryQuoteSaverDepositResponse.Fields().ByName("inbound_address")
-	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_blocks = md_QueryQuoteSaverDepositResponse.Fields().ByName("inbound_confirmation_blocks")
-	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_seconds = md_QueryQuoteSaverDepositResponse.Fields().ByName("inbound_confirmation_seconds")
-	fd_QueryQuoteSaverDepositResponse_outbound_delay_blocks = md_QueryQuoteSaverDepositResponse.Fields().ByName("outbound_delay_blocks")
-	fd_QueryQuoteSaverDepositResponse_outbound_delay_seconds = md_QueryQuoteSaverDepositResponse.Fields().ByName("outbound_delay_seconds")
-	fd_QueryQuoteSaverDepositResponse_fees = md_QueryQuoteSaverDepositResponse.Fields().ByName("fees")
-	fd_QueryQuoteSaverDepositResponse_router = md_QueryQuoteSaverDepositResponse.Fields().ByName("router")
-	fd_QueryQuoteSaverDepositResponse_expiry = md_QueryQuoteSaverDepositResponse.Fields().ByName("expiry")
-	fd_QueryQuoteSaverDepositResponse_warning = md_QueryQuoteSaverDepositResponse.Fields().ByName("warning")
-	fd_QueryQuoteSaverDepositResponse_notes = md_QueryQuoteSaverDepositResponse.Fields().ByName("notes")
-	fd_QueryQuoteSaverDepositResponse_dust_threshold = md_QueryQuoteSaverDepositResponse.Fields().ByName("dust_threshold")
-	fd_QueryQuoteSaverDepositResponse_recommended_min_amount_in = md_QueryQuoteSaverDepositResponse.Fields().ByName("recommended_min_amount_in")
-	fd_QueryQuoteSaverDepositResponse_recommended_gas_rate = md_QueryQuoteSaverDepositResponse.Fields().ByName("recommended_gas_rate")
-	fd_QueryQuoteSaverDepositResponse_gas_rate_units = md_QueryQuoteSaverDepositResponse.Fields().ByName("gas_rate_units")
-	fd_QueryQuoteSaverDepositResponse_memo = md_QueryQuoteSaverDepositResponse.Fields().ByName("memo")
-	fd_QueryQuoteSaverDepositResponse_expected_amount_out = md_QueryQuoteSaverDepositResponse.Fields().ByName("expected_amount_out")
-	fd_QueryQuoteSaverDepositResponse_expected_amount_deposit = md_QueryQuoteSaverDepositResponse.Fields().ByName("expected_amount_deposit")
+	md_QuoteSwapBatchResult = File_types_query_quotes_proto.Messages().ByName("QuoteSwapBatchResult")
+	fd_QuoteSwapBatchResult_quote = md_QuoteSwapBatchResult.Fields().ByName("quote")
+	fd_QuoteSwapBatchResult_error = md_QuoteSwapBatchResult.Fields().ByName("error")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverDepositResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QuoteSwapBatchResult)(nil)
 
-type fastReflection_QueryQuoteSaverDepositResponse QueryQuoteSaverDepositResponse
+type fastReflection_QuoteSwapBatchResult QuoteSwapBatchResult
 
-func (x *QueryQuoteSaverDepositResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverDepositResponse)(x)
+func (x *QuoteSwapBatchResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuoteSwapBatchResult)(x)
 }
 
-func (x *QueryQuoteSaverDepositResponse) slowProtoReflect() protoreflect.Message {
+func (x *QuoteSwapBatchResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_types_query_quotes_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3827,43 +3817,43 @@ func (x *QueryQuoteSaverDepositResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryQuoteSaverDepositResponse_messageType fastReflection_QueryQuoteSaverDepositResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverDepositResponse_messageType{}
+var _fastReflection_QuoteSwapBatchResult_messageType fastReflection_QuoteSwapBatchResult_messageType
+var _ protoreflect.MessageType = fastReflection_QuoteSwapBatchResult_messageType{}
 
-type fastReflection_QueryQuoteSaverDepositResponse_messageType struct{}
+type fastReflection_QuoteSwapBatchResult_messageType struct{}
 
-func (x fastReflection_QueryQuoteSaverDepositResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverDepositResponse)(nil)
+func (x fastReflection_QuoteSwapBatchResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuoteSwapBatchResult)(nil)
 }
-func (x fastReflection_QueryQuoteSaverDepositResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverDepositResponse)
+func (x fastReflection_QuoteSwapBatchResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuoteSwapBatchResult)
 }
-func (x fastReflection_QueryQuoteSaverDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverDepositResponse
+func (x fastReflection_QuoteSwapBatchResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuoteSwapBatchResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverDepositResponse
+func (x *fastReflection_QuoteSwapBatchResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuoteSwapBatchResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteSaverDepositResponse_messageType
+func (x *fastReflection_QuoteSwapBatchResult) Type() protoreflect.MessageType {
+	return _fastReflection_QuoteSwapBatchResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverDepositResponse)
+func (x *fastReflection_QuoteSwapBatchResult) New() protoreflect.Message {
+	return new(fastReflection_QuoteSwapBatchResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteSaverDepositResponse)(x)
+func (x *fastReflection_QuoteSwapBatchResult) Interface() protoreflect.ProtoMessage {
+	return (*QuoteSwapBatchResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3871,106 +3861,16 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.InboundAddress != "" {
-		value := protoreflect.ValueOfString(x.InboundAddress)
-		if !f(fd_QueryQuoteSaverDepositResponse_inbound_address, value) {
-			return
-		}
-	}
-	if x.InboundConfirmationBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
-		if !f(fd_QueryQuoteSaverDepositResponse_inbound_confirmation_blocks, value) {
-			return
-		}
-	}
-	if x.InboundConfirmationSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
-		if !f(fd_QueryQuoteSaverDepositResponse_inbound_confirmation_seconds, value) {
-			return
-		}
-	}
-	if x.OutboundDelayBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
-		if !f(fd_QueryQuoteSaverDepositResponse_outbound_delay_blocks, value) {
-			return
-		}
-	}
-	if x.OutboundDelaySeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
-		if !f(fd_QueryQuoteSaverDepositResponse_outbound_delay_seconds, value) {
-			return
-		}
-	}
-	if x.Fees != nil {
-		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-		if !f(fd_QueryQuoteSaverDepositResponse_fees, value) {
-			return
-		}
-	}
-	if x.Router != "" {
-		value := protoreflect.ValueOfString(x.Router)
-		if !f(fd_QueryQuoteSaverDepositResponse_router, value) {
-			return
-		}
-	}
-	if x.Expiry != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Expiry)
-		if !f(fd_QueryQuoteSaverDepositResponse_expiry, value) {
-			return
-		}
-	}
-	if x.Warning != "" {
-		value := protoreflect.ValueOfString(x.Warning)
-		if !f(fd_QueryQuoteSaverDepositResponse_warning, value) {
-			return
-		}
-	}
-	if x.Notes != "" {
-		value := protoreflect.ValueOfString(x.Notes)
-		if !f(fd_QueryQuoteSaverDepositResponse_notes, value) {
-			return
-		}
-	}
-	if x.DustThreshold != "" {
-		value := protoreflect.ValueOfString(x.DustThreshold)
-		if !f(fd_QueryQuoteSaverDepositResponse_dust_threshold, value) {
-			return
-		}
-	}
-	if x.RecommendedMinAmountIn != "" {
-		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
-		if !f(fd_QueryQuoteSaverDepositResponse_recommended_min_amount_in, value) {
-			return
-		}
-	}
-	if x.RecommendedGasRate != "" {
-		value := protoreflect.ValueOfString(x.RecommendedGasRate)
-		if !f(fd_QueryQuoteSaverDepositResponse_recommended_gas_rate, value) {
-			return
-		}
-	}
-	if x.GasRateUnits != "" {
-		value := protoreflect.ValueOfString(x.GasRateUnits)
-		if !f(fd_QueryQuoteSaverDepositResponse_gas_rate_units, value) {
-			return
-		}
-	}
-	if x.Memo != "" {
-		value := protoreflect.ValueOfString(x.Memo)
-		if !f(fd_QueryQuoteSaverDepositResponse_memo, value) {
-			return
-		}
-	}
-	if x.ExpectedAmountOut != "" {
-		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
-		if !f(fd_QueryQuoteSaverDepositResponse_expected_amount_out, value) {
+func (x *fastReflection_QuoteSwapBatchResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Quote != nil {
+		value := protoreflect.ValueOfMessage(x.Quote.ProtoReflect())
+		if !f(fd_QuoteSwapBatchResult_quote, value) {
 			return
 		}
 	}
-	if x.ExpectedAmountDeposit != "" {
-		value := protoreflect.ValueOfString(x.ExpectedAmountDeposit)
-		if !f(fd_QueryQuoteSaverDepositResponse_expected_amount_deposit, value) {
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_QuoteSwapBatchResult_error, value) {
 			return
 		}
 	}
@@ -3987,47 +3887,17 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuoteSwapBatchResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositResponse.inbound_address":
-		return x.InboundAddress != ""
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
-		return x.InboundConfirmationBlocks != int64(0)
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
-		return x.InboundConfirmationSeconds != int64(0)
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
-		return x.OutboundDelayBlocks != int64(0)
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
-		return x.OutboundDelaySeconds != int64(0)
-	case "types.QueryQuoteSaverDepositResponse.fees":
-		return x.Fees != nil
-	case "types.QueryQuoteSaverDepositResponse.router":
-		return x.Router != ""
-	case "types.QueryQuoteSaverDepositResponse.expiry":
-		return x.Expiry != int64(0)
-	case "types.QueryQuoteSaverDepositResponse.warning":
-		return x.Warning != ""
-	case "types.QueryQuoteSaverDepositResponse.notes":
-		return x.Notes != ""
-	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
-		return x.DustThreshold != ""
-	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
-		return x.RecommendedMinAmountIn != ""
-	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
-		return x.RecommendedGasRate != ""
-	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
-		return x.GasRateUnits != ""
-	case "types.QueryQuoteSaverDepositResponse.memo":
-		return x.Memo != ""
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
-		return x.ExpectedAmountOut != ""
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
-		return x.ExpectedAmountDeposit != ""
+	case "types.QuoteSwapBatchResult.quote":
+		return x.Quote != nil
+	case "types.QuoteSwapBatchResult.error":
+		return x.Error != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteSwapBatchResult"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QuoteSwapBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4037,47 +3907,17 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuoteSwapBatchResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositResponse.inbound_address":
-		x.InboundAddress = ""
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = int64(0)
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = int64(0)
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = int64(0)
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = int64(0)
-	case "types.QueryQuoteSaverDepositResponse.fees":
-		x.Fees = nil
-	case "types.QueryQuoteSaverDepositResponse.router":
-		x.Router = ""
-	case "types.QueryQuoteSaverDepositResponse.expiry":
-		x.Expiry = int64(0)
-	case "types.QueryQuoteSaverDepositResponse.warning":
-		x.Warning = ""
-	case "types.QueryQuoteSaverDepositResponse.notes":
-		x.Notes = ""
-	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
-		x.DustThreshold = ""
-	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = ""
-	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
-		x.RecommendedGasRate = ""
-	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
-		x.GasRateUnits = ""
-	case "types.QueryQuoteSaverDepositResponse.memo":
-		x.Memo = ""
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
-		x.ExpectedAmountOut = ""
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
-		x.ExpectedAmountDeposit = ""
+	case "types.QuoteSwapBatchResult.quote":
+		x.Quote = nil
+	case "types.QuoteSwapBatchResult.error":
+		x.Error = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteSwapBatchResult"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QuoteSwapBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4087,64 +3927,19 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuoteSwapBatchResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteSaverDepositResponse.inbound_address":
-		value := x.InboundAddress
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
-		value := x.InboundConfirmationBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
-		value := x.InboundConfirmationSeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
-		value := x.OutboundDelayBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
-		value := x.OutboundDelaySeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverDepositResponse.fees":
-		value := x.Fees
+	case "types.QuoteSwapBatchResult.quote":
+		value := x.Quote
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "types.QueryQuoteSaverDepositResponse.router":
-		value := x.Router
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.expiry":
-		value := x.Expiry
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverDepositResponse.warning":
-		value := x.Warning
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.notes":
-		value := x.Notes
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
-		value := x.DustThreshold
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
-		value := x.RecommendedMinAmountIn
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
-		value := x.RecommendedGasRate
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
-		value := x.GasRateUnits
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.memo":
-		value := x.Memo
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
-		value := x.ExpectedAmountOut
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
-		value := x.ExpectedAmountDeposit
+	case "types.QuoteSwapBatchResult.error":
+		value := x.Error
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteSwapBatchResult"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QuoteSwapBatchResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4158,47 +3953,17 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuoteSwapBatchResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositResponse.inbound_address":
-		x.InboundAddress = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = value.Int()
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = value.Int()
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = value.Int()
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = value.Int()
-	case "types.QueryQuoteSaverDepositResponse.fees":
-		x.Fees = value.Message().Interface().(*QuoteFees)
-	case "types.QueryQuoteSaverDepositResponse.router":
-		x.Router = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.expiry":
-		x.Expiry = value.Int()
-	case "types.QueryQuoteSaverDepositResponse.warning":
-		x.Warning = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.notes":
-		x.Notes = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
-		x.DustThreshold = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
-		x.RecommendedGasRate = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
-		x.GasRateUnits = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.memo":
-		x.Memo = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
-		x.ExpectedAmountOut = value.Interface().(string)
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
-		x.ExpectedAmountDeposit = value.Interface().(string)
+	case "types.QuoteSwapBatchResult.quote":
+		x.Quote = value.Message().Interface().(*QueryQuoteSwapResponse)
+	case "types.QuoteSwapBatchResult.error":
+		x.Error = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteSwapBatchResult"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QuoteSwapBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4212,108 +3977,48 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuoteSwapBatchResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositResponse.fees":
-		if x.Fees == nil {
-			x.Fees = new(QuoteFees)
+	case "types.QuoteSwapBatchResult.quote":
+		if x.Quote == nil {
+			x.Quote = new(QueryQuoteSwapResponse)
 		}
-		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-	case "types.QueryQuoteSaverDepositResponse.inbound_address":
-		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
-		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
-		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
-		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
-		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.router":
-		panic(fmt.Errorf("field router of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.expiry":
-		panic(fmt.Errorf("field expiry of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.warning":
-		panic(fmt.Errorf("field warning of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.notes":
-		panic(fmt.Errorf("field notes of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
-		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
-		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
-		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
-		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.memo":
-		panic(fmt.Errorf("field memo of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
-		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteSaverDepositResponse is not mutable"))
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
-		panic(fmt.Errorf("field expected_amount_deposit of message types.QueryQuoteSaverDepositResponse is not mutable"))
+		return protoreflect.ValueOfMessage(x.Quote.ProtoReflect())
+	case "types.QuoteSwapBatchResult.error":
+		panic(fmt.Errorf("field error of message types.QuoteSwapBatchResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteSwapBatchResult"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QuoteSwapBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuoteSwapBatchResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverDepositResponse.inbound_address":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverDepositResponse.fees":
-		m := new(QuoteFees)
+	case "types.QuoteSwapBatchResult.quote":
+		m := new(QueryQuoteSwapResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "types.QueryQuoteSaverDepositResponse.router":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.expiry":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverDepositResponse.warning":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.notes":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.memo":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
+	case "types.QuoteSwapBatchResult.error":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteSwapBatchResult"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QuoteSwapBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuoteSwapBatchResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverDepositResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QuoteSwapBatchResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4321,7 +4026,7 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuoteSwapBatchResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4332,7 +4037,7 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuoteSwapBatchResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4344,7 +4049,7 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) IsValid() bool {
+func (x *fastReflection_QuoteSwapBatchResult) IsValid() bool {
 	return x != nil
 }
 
@@ -4354,9 +4059,9 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuoteSwapBatchResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteSaverDepositResponse)
+		x := input.Message.Interface().(*QuoteSwapBatchResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4368,80 +4073,25 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		l = len(x.InboundAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.InboundConfirmationBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
-		}
-		if x.InboundConfirmationSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
-		}
-		if x.OutboundDelayBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
-		}
-		if x.OutboundDelaySeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
-		}
-		if x.Fees != nil {
-			l = options.Size(x.Fees)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Router)
-		if l > 0 {
+		if x.Quote != nil {
+			l = options.Size(x.Quote)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Expiry != 0 {
-			n += 1 + runtime.Sov(uint64(x.Expiry))
-		}
-		l = len(x.Warning)
+		l = len(x.Error)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Notes)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
-		l = len(x.DustThreshold)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RecommendedMinAmountIn)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RecommendedGasRate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.GasRateUnits)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Memo)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedAmountOut)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedAmountDeposit)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
 		}
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverDepositResponse)
+		x := input.Message.Interface().(*QuoteSwapBatchResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4460,87 +4110,15 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ExpectedAmountDeposit) > 0 {
-			i -= len(x.ExpectedAmountDeposit)
-			copy(dAtA[i:], x.ExpectedAmountDeposit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountDeposit)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.ExpectedAmountOut) > 0 {
-			i -= len(x.ExpectedAmountOut)
-			copy(dAtA[i:], x.ExpectedAmountOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-		if len(x.Memo) > 0 {
-			i -= len(x.Memo)
-			copy(dAtA[i:], x.Memo)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
-			i--
-			dAtA[i] = 0x7a
-		}
-		if len(x.GasRateUnits) > 0 {
-			i -= len(x.GasRateUnits)
-			copy(dAtA[i:], x.GasRateUnits)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
-			i--
-			dAtA[i] = 0x72
-		}
-		if len(x.RecommendedGasRate) > 0 {
-			i -= len(x.RecommendedGasRate)
-			copy(dAtA[i:], x.RecommendedGasRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.RecommendedMinAmountIn) > 0 {
-			i -= len(x.RecommendedMinAmountIn)
-			copy(dAtA[i:], x.RecommendedMinAmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.DustThreshold) > 0 {
-			i -= len(x.DustThreshold)
-			copy(dAtA[i:], x.DustThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.Notes) > 0 {
-			i -= len(x.Notes)
-			copy(dAtA[i:], x.Notes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.Warning) > 0 {
-			i -= len(x.Warning)
-			copy(dAtA[i:], x.Warning)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
 			i--
-			dAtA[i] = 0x4a
-		}
-		if x.Expiry != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
-			i--
-			dAtA[i] = 0x40
-		}
-		if len(x.Router) > 0 {
-			i -= len(x.Router)
-			copy(dAtA[i:], x.Router)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
-			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x12
 		}
-		if x.Fees != nil {
-			encoded, err := options.Marshal(x.Fees)
+		if x.Quote != nil {
+			encoded, err := options.Marshal(x.Quote)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4551,33 +4129,6 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x32
-		}
-		if x.OutboundDelaySeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.OutboundDelayBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.InboundConfirmationSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.InboundConfirmationBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.InboundAddress) > 0 {
-			i -= len(x.InboundAddress)
-			copy(dAtA[i:], x.InboundAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -4591,7 +4142,7 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverDepositResponse)
+		x := input.Message.Interface().(*QuoteSwapBatchResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4623,17 +4174,17 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteSwapBatchResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteSwapBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4643,105 +4194,33 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InboundAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
-				}
-				x.InboundConfirmationBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
-				}
-				x.InboundConfirmationSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
-				}
-				x.OutboundDelayBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+				if x.Quote == nil {
+					x.Quote = &QueryQuoteSwapResponse{}
 				}
-				x.OutboundDelaySeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Quote); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 6:
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4751,381 +4230,38 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Fees == nil {
-					x.Fees = &QuoteFees{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Error = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Router = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-				}
-				x.Expiry = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Expiry |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Warning = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Notes = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DustThreshold = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 13:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 14:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 15:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Memo = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 16:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 17:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountDeposit", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpectedAmountDeposit = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5147,32 +4283,77 @@ func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoifa
 	}
 }
 
+var _ protoreflect.List = (*_QueryQuoteSwapBatchResponse_1_list)(nil)
+
+type _QueryQuoteSwapBatchResponse_1_list struct {
+	list *[]*QuoteSwapBatchResult
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuoteSwapBatchResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuoteSwapBatchResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(QuoteSwapBatchResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) NewElement() protoreflect.Value {
+	v := new(QuoteSwapBatchResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryQuoteSwapBatchResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryQuoteSaverWithdrawRequest              protoreflect.MessageDescriptor
-	fd_QueryQuoteSaverWithdrawRequest_asset        protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawRequest_address      protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawRequest_withdraw_bps protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawRequest_height       protoreflect.FieldDescriptor
+	md_QueryQuoteSwapBatchResponse         protoreflect.MessageDescriptor
+	fd_QueryQuoteSwapBatchResponse_results protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_quotes_proto_init()
-	md_QueryQuoteSaverWithdrawRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverWithdrawRequest")
-	fd_QueryQuoteSaverWithdrawRequest_asset = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("asset")
-	fd_QueryQuoteSaverWithdrawRequest_address = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("address")
-	fd_QueryQuoteSaverWithdrawRequest_withdraw_bps = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("withdraw_bps")
-	fd_QueryQuoteSaverWithdrawRequest_height = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("height")
+	md_QueryQuoteSwapBatchResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSwapBatchResponse")
+	fd_QueryQuoteSwapBatchResponse_results = md_QueryQuoteSwapBatchResponse.Fields().ByName("results")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverWithdrawRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryQuoteSwapBatchResponse)(nil)
 
-type fastReflection_QueryQuoteSaverWithdrawRequest QueryQuoteSaverWithdrawRequest
+type fastReflection_QueryQuoteSwapBatchResponse QueryQuoteSwapBatchResponse
 
-func (x *QueryQuoteSaverWithdrawRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverWithdrawRequest)(x)
+func (x *QueryQuoteSwapBatchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSwapBatchResponse)(x)
 }
 
-func (x *QueryQuoteSaverWithdrawRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryQuoteSwapBatchResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_types_query_quotes_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5184,43 +4365,43 @@ func (x *QueryQuoteSaverWithdrawRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryQuoteSaverWithdrawRequest_messageType fastReflection_QueryQuoteSaverWithdrawRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverWithdrawRequest_messageType{}
+var _fastReflection_QueryQuoteSwapBatchResponse_messageType fastReflection_QueryQuoteSwapBatchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteSwapBatchResponse_messageType{}
 
-type fastReflection_QueryQuoteSaverWithdrawRequest_messageType struct{}
+type fastReflection_QueryQuoteSwapBatchResponse_messageType struct{}
 
-func (x fastReflection_QueryQuoteSaverWithdrawRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverWithdrawRequest)(nil)
+func (x fastReflection_QueryQuoteSwapBatchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSwapBatchResponse)(nil)
 }
-func (x fastReflection_QueryQuoteSaverWithdrawRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverWithdrawRequest)
+func (x fastReflection_QueryQuoteSwapBatchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSwapBatchResponse)
 }
-func (x fastReflection_QueryQuoteSaverWithdrawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverWithdrawRequest
+func (x fastReflection_QueryQuoteSwapBatchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSwapBatchResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverWithdrawRequest
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSwapBatchResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteSaverWithdrawRequest_messageType
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteSwapBatchResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverWithdrawRequest)
+func (x *fastReflection_QueryQuoteSwapBatchResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSwapBatchResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteSaverWithdrawRequest)(x)
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteSwapBatchResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5228,28 +4409,10 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Asset != "" {
-		value := protoreflect.ValueOfString(x.Asset)
-		if !f(fd_QueryQuoteSaverWithdrawRequest_asset, value) {
-			return
-		}
-	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryQuoteSaverWithdrawRequest_address, value) {
-			return
-		}
-	}
-	if x.WithdrawBps != "" {
-		value := protoreflect.ValueOfString(x.WithdrawBps)
-		if !f(fd_QueryQuoteSaverWithdrawRequest_withdraw_bps, value) {
-			return
-		}
-	}
-	if x.Height != "" {
-		value := protoreflect.ValueOfString(x.Height)
-		if !f(fd_QueryQuoteSaverWithdrawRequest_height, value) {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_QueryQuoteSwapBatchResponse_1_list{list: &x.Results})
+		if !f(fd_QueryQuoteSwapBatchResponse_results, value) {
 			return
 		}
 	}
@@ -5266,21 +4429,15 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawRequest.asset":
-		return x.Asset != ""
-	case "types.QueryQuoteSaverWithdrawRequest.address":
-		return x.Address != ""
-	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
-		return x.WithdrawBps != ""
-	case "types.QueryQuoteSaverWithdrawRequest.height":
-		return x.Height != ""
+	case "types.QueryQuoteSwapBatchResponse.results":
+		return len(x.Results) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5290,21 +4447,15 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawRequest.asset":
-		x.Asset = ""
-	case "types.QueryQuoteSaverWithdrawRequest.address":
-		x.Address = ""
-	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
-		x.WithdrawBps = ""
-	case "types.QueryQuoteSaverWithdrawRequest.height":
-		x.Height = ""
+	case "types.QueryQuoteSwapBatchResponse.results":
+		x.Results = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5314,25 +4465,19 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteSaverWithdrawRequest.asset":
-		value := x.Asset
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
-		value := x.WithdrawBps
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawRequest.height":
-		value := x.Height
-		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSwapBatchResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_QueryQuoteSwapBatchResponse_1_list{})
+		}
+		listValue := &_QueryQuoteSwapBatchResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5346,21 +4491,17 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawRequest.asset":
-		x.Asset = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawRequest.address":
-		x.Address = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
-		x.WithdrawBps = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawRequest.height":
-		x.Height = value.Interface().(string)
+	case "types.QueryQuoteSwapBatchResponse.results":
+		lv := value.List()
+		clv := lv.(*_QueryQuoteSwapBatchResponse_1_list)
+		x.Results = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5374,52 +4515,45 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawRequest.asset":
-		panic(fmt.Errorf("field asset of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
-	case "types.QueryQuoteSaverWithdrawRequest.address":
-		panic(fmt.Errorf("field address of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
-	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
-		panic(fmt.Errorf("field withdraw_bps of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
-	case "types.QueryQuoteSaverWithdrawRequest.height":
-		panic(fmt.Errorf("field height of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
+	case "types.QueryQuoteSwapBatchResponse.results":
+		if x.Results == nil {
+			x.Results = []*QuoteSwapBatchResult{}
+		}
+		value := &_QueryQuoteSwapBatchResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawRequest.asset":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawRequest.address":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawRequest.height":
-		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSwapBatchResponse.results":
+		list := []*QuoteSwapBatchResult{}
+		return protoreflect.ValueOfList(&_QueryQuoteSwapBatchResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSwapBatchResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSwapBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverWithdrawRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSwapBatchResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5427,7 +4561,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5438,7 +4572,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5450,7 +4584,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) IsValid() bool {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5460,9 +4594,9 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryQuoteSwapBatchResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteSaverWithdrawRequest)
+		x := input.Message.Interface().(*QueryQuoteSwapBatchResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5474,21 +4608,11 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		l = len(x.Asset)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.WithdrawBps)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Height)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5500,7 +4624,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverWithdrawRequest)
+		x := input.Message.Interface().(*QueryQuoteSwapBatchResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5519,33 +4643,21 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Height) > 0 {
-			i -= len(x.Height)
-			copy(dAtA[i:], x.Height)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.WithdrawBps) > 0 {
-			i -= len(x.WithdrawBps)
-			copy(dAtA[i:], x.WithdrawBps)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawBps)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Asset) > 0 {
-			i -= len(x.Asset)
-			copy(dAtA[i:], x.Asset)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5558,7 +4670,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverWithdrawRequest)
+		x := input.Message.Interface().(*QueryQuoteSwapBatchResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5590,81 +4702,17 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSwapBatchResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSwapBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Asset = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawBps", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5674,55 +4722,25 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WithdrawBps = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Results = append(x.Results, &QuoteSwapBatchResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Height = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5759,106 +4777,174 @@ func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoifa
 	}
 }
 
-var (
-	md_QueryQuoteSaverWithdrawResponse                              protoreflect.MessageDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_inbound_address              protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_blocks  protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_seconds protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_blocks        protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_seconds       protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_fees                         protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_router                       protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_expiry                       protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_warning                      protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_notes                        protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_dust_threshold               protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_recommended_min_amount_in    protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_recommended_gas_rate         protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_gas_rate_units               protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_memo                         protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_dust_amount                  protoreflect.FieldDescriptor
-	fd_QueryQuoteSaverWithdrawResponse_expected_amount_out          protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryQuoteSaverDepositRequest_3_list)(nil)
 
-func init() {
-	file_types_query_quotes_proto_init()
-	md_QueryQuoteSaverWithdrawResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverWithdrawResponse")
-	fd_QueryQuoteSaverWithdrawResponse_inbound_address = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("inbound_address")
-	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_blocks = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("inbound_confirmation_blocks")
-	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_seconds = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("inbound_confirmation_seconds")
-	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_blocks = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("outbound_delay_blocks")
-	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_seconds = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("outbound_delay_seconds")
-	fd_QueryQuoteSaverWithdrawResponse_fees = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("fees")
-	fd_QueryQuoteSaverWithdrawResponse_router = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("router")
-	fd_QueryQuoteSaverWithdrawResponse_expiry = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("expiry")
-	fd_QueryQuoteSaverWithdrawResponse_warning = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("warning")
-	fd_QueryQuoteSaverWithdrawResponse_notes = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("notes")
-	fd_QueryQuoteSaverWithdrawResponse_dust_threshold = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("dust_threshold")
-	fd_QueryQuoteSaverWithdrawResponse_recommended_min_amount_in = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("recommended_min_amount_in")
-	fd_QueryQuoteSaverWithdrawResponse_recommended_gas_rate = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("recommended_gas_rate")
-	fd_QueryQuoteSaverWithdrawResponse_gas_rate_units = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("gas_rate_units")
-	fd_QueryQuoteSaverWithdrawResponse_memo = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("memo")
-	fd_QueryQuoteSaverWithdrawResponse_dust_amount = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("dust_amount")
-	fd_QueryQuoteSaverWithdrawResponse_expected_amount_out = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("expected_amount_out")
+type _QueryQuoteSaverDepositRequest_3_list struct {
+	list *[]string
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverWithdrawResponse)(nil)
+func (x *_QueryQuoteSaverDepositRequest_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_QueryQuoteSaverWithdrawResponse QueryQuoteSaverWithdrawResponse
+func (x *_QueryQuoteSaverDepositRequest_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
 
-func (x *QueryQuoteSaverWithdrawResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverWithdrawResponse)(x)
+func (x *_QueryQuoteSaverDepositRequest_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *QueryQuoteSaverWithdrawResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_query_quotes_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_QueryQuoteSaverDepositRequest_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_QueryQuoteSaverWithdrawResponse_messageType fastReflection_QueryQuoteSaverWithdrawResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverWithdrawResponse_messageType{}
+func (x *_QueryQuoteSaverDepositRequest_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteSaverDepositRequest at list field Affiliate as it is not of Message kind"))
+}
 
-type fastReflection_QueryQuoteSaverWithdrawResponse_messageType struct{}
+func (x *_QueryQuoteSaverDepositRequest_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_QueryQuoteSaverWithdrawResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteSaverWithdrawResponse)(nil)
+func (x *_QueryQuoteSaverDepositRequest_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
-func (x fastReflection_QueryQuoteSaverWithdrawResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverWithdrawResponse)
+
+func (x *_QueryQuoteSaverDepositRequest_3_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_QueryQuoteSaverWithdrawResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverWithdrawResponse
+
+var _ protoreflect.List = (*_QueryQuoteSaverDepositRequest_4_list)(nil)
+
+type _QueryQuoteSaverDepositRequest_4_list struct {
+	list *[]string
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteSaverDepositRequest at list field AffiliateBps as it is not of Message kind"))
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryQuoteSaverDepositRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryQuoteSaverDepositRequest               protoreflect.MessageDescriptor
+	fd_QueryQuoteSaverDepositRequest_asset         protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositRequest_amount        protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositRequest_affiliate     protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositRequest_affiliate_bps protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositRequest_height        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_quotes_proto_init()
+	md_QueryQuoteSaverDepositRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverDepositRequest")
+	fd_QueryQuoteSaverDepositRequest_asset = md_QueryQuoteSaverDepositRequest.Fields().ByName("asset")
+	fd_QueryQuoteSaverDepositRequest_amount = md_QueryQuoteSaverDepositRequest.Fields().ByName("amount")
+	fd_QueryQuoteSaverDepositRequest_affiliate = md_QueryQuoteSaverDepositRequest.Fields().ByName("affiliate")
+	fd_QueryQuoteSaverDepositRequest_affiliate_bps = md_QueryQuoteSaverDepositRequest.Fields().ByName("affiliate_bps")
+	fd_QueryQuoteSaverDepositRequest_height = md_QueryQuoteSaverDepositRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverDepositRequest)(nil)
+
+type fastReflection_QueryQuoteSaverDepositRequest QueryQuoteSaverDepositRequest
+
+func (x *QueryQuoteSaverDepositRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverDepositRequest)(x)
+}
+
+func (x *QueryQuoteSaverDepositRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryQuoteSaverDepositRequest_messageType fastReflection_QueryQuoteSaverDepositRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverDepositRequest_messageType{}
+
+type fastReflection_QueryQuoteSaverDepositRequest_messageType struct{}
+
+func (x fastReflection_QueryQuoteSaverDepositRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverDepositRequest)(nil)
+}
+func (x fastReflection_QueryQuoteSaverDepositRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverDepositRequest)
+}
+func (x fastReflection_QueryQuoteSaverDepositRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverDepositRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteSaverWithdrawResponse
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverDepositRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteSaverWithdrawResponse_messageType
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteSaverDepositRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteSaverWithdrawResponse)
+func (x *fastReflection_QueryQuoteSaverDepositRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverDepositRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteSaverWithdrawResponse)(x)
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteSaverDepositRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5866,106 +4952,34 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.InboundAddress != "" {
-		value := protoreflect.ValueOfString(x.InboundAddress)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_inbound_address, value) {
-			return
-		}
-	}
-	if x.InboundConfirmationBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_blocks, value) {
-			return
-		}
-	}
-	if x.InboundConfirmationSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_seconds, value) {
-			return
-		}
-	}
-	if x.OutboundDelayBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_outbound_delay_blocks, value) {
-			return
-		}
-	}
-	if x.OutboundDelaySeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_outbound_delay_seconds, value) {
-			return
-		}
-	}
-	if x.Fees != nil {
-		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-		if !f(fd_QueryQuoteSaverWithdrawResponse_fees, value) {
-			return
-		}
-	}
-	if x.Router != "" {
-		value := protoreflect.ValueOfString(x.Router)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_router, value) {
-			return
-		}
-	}
-	if x.Expiry != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Expiry)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_expiry, value) {
-			return
-		}
-	}
-	if x.Warning != "" {
-		value := protoreflect.ValueOfString(x.Warning)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_warning, value) {
-			return
-		}
-	}
-	if x.Notes != "" {
-		value := protoreflect.ValueOfString(x.Notes)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_notes, value) {
-			return
-		}
-	}
-	if x.DustThreshold != "" {
-		value := protoreflect.ValueOfString(x.DustThreshold)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_dust_threshold, value) {
-			return
-		}
-	}
-	if x.RecommendedMinAmountIn != "" {
-		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_recommended_min_amount_in, value) {
-			return
-		}
-	}
-	if x.RecommendedGasRate != "" {
-		value := protoreflect.ValueOfString(x.RecommendedGasRate)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_recommended_gas_rate, value) {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != "" {
+		value := protoreflect.ValueOfString(x.Asset)
+		if !f(fd_QueryQuoteSaverDepositRequest_asset, value) {
 			return
 		}
 	}
-	if x.GasRateUnits != "" {
-		value := protoreflect.ValueOfString(x.GasRateUnits)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_gas_rate_units, value) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_QueryQuoteSaverDepositRequest_amount, value) {
 			return
 		}
 	}
-	if x.Memo != "" {
-		value := protoreflect.ValueOfString(x.Memo)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_memo, value) {
+	if len(x.Affiliate) != 0 {
+		value := protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_3_list{list: &x.Affiliate})
+		if !f(fd_QueryQuoteSaverDepositRequest_affiliate, value) {
 			return
 		}
 	}
-	if x.DustAmount != "" {
-		value := protoreflect.ValueOfString(x.DustAmount)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_dust_amount, value) {
+	if len(x.AffiliateBps) != 0 {
+		value := protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_4_list{list: &x.AffiliateBps})
+		if !f(fd_QueryQuoteSaverDepositRequest_affiliate_bps, value) {
 			return
 		}
 	}
-	if x.ExpectedAmountOut != "" {
-		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
-		if !f(fd_QueryQuoteSaverWithdrawResponse_expected_amount_out, value) {
+	if x.Height != "" {
+		value := protoreflect.ValueOfString(x.Height)
+		if !f(fd_QueryQuoteSaverDepositRequest_height, value) {
 			return
 		}
 	}
@@ -5982,47 +4996,23 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
-		return x.InboundAddress != ""
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
-		return x.InboundConfirmationBlocks != int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
-		return x.InboundConfirmationSeconds != int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
-		return x.OutboundDelayBlocks != int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
-		return x.OutboundDelaySeconds != int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.fees":
-		return x.Fees != nil
-	case "types.QueryQuoteSaverWithdrawResponse.router":
-		return x.Router != ""
-	case "types.QueryQuoteSaverWithdrawResponse.expiry":
-		return x.Expiry != int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.warning":
-		return x.Warning != ""
-	case "types.QueryQuoteSaverWithdrawResponse.notes":
-		return x.Notes != ""
-	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
-		return x.DustThreshold != ""
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
-		return x.RecommendedMinAmountIn != ""
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
-		return x.RecommendedGasRate != ""
-	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
-		return x.GasRateUnits != ""
-	case "types.QueryQuoteSaverWithdrawResponse.memo":
-		return x.Memo != ""
-	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
-		return x.DustAmount != ""
-	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
-		return x.ExpectedAmountOut != ""
+	case "types.QueryQuoteSaverDepositRequest.asset":
+		return x.Asset != ""
+	case "types.QueryQuoteSaverDepositRequest.amount":
+		return x.Amount != ""
+	case "types.QueryQuoteSaverDepositRequest.affiliate":
+		return len(x.Affiliate) != 0
+	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
+		return len(x.AffiliateBps) != 0
+	case "types.QueryQuoteSaverDepositRequest.height":
+		return x.Height != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6032,47 +5022,23 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
-		x.InboundAddress = ""
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.fees":
-		x.Fees = nil
-	case "types.QueryQuoteSaverWithdrawResponse.router":
-		x.Router = ""
-	case "types.QueryQuoteSaverWithdrawResponse.expiry":
-		x.Expiry = int64(0)
-	case "types.QueryQuoteSaverWithdrawResponse.warning":
-		x.Warning = ""
-	case "types.QueryQuoteSaverWithdrawResponse.notes":
-		x.Notes = ""
-	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
-		x.DustThreshold = ""
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = ""
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
-		x.RecommendedGasRate = ""
-	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
-		x.GasRateUnits = ""
-	case "types.QueryQuoteSaverWithdrawResponse.memo":
-		x.Memo = ""
-	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
-		x.DustAmount = ""
-	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
-		x.ExpectedAmountOut = ""
+	case "types.QueryQuoteSaverDepositRequest.asset":
+		x.Asset = ""
+	case "types.QueryQuoteSaverDepositRequest.amount":
+		x.Amount = ""
+	case "types.QueryQuoteSaverDepositRequest.affiliate":
+		x.Affiliate = nil
+	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
+		x.AffiliateBps = nil
+	case "types.QueryQuoteSaverDepositRequest.height":
+		x.Height = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6082,64 +5048,34 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
-		value := x.InboundAddress
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
-		value := x.InboundConfirmationBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
-		value := x.InboundConfirmationSeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
-		value := x.OutboundDelayBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
-		value := x.OutboundDelaySeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverWithdrawResponse.fees":
-		value := x.Fees
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "types.QueryQuoteSaverWithdrawResponse.router":
-		value := x.Router
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.expiry":
-		value := x.Expiry
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteSaverWithdrawResponse.warning":
-		value := x.Warning
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.notes":
-		value := x.Notes
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
-		value := x.DustThreshold
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
-		value := x.RecommendedMinAmountIn
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
-		value := x.RecommendedGasRate
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
-		value := x.GasRateUnits
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.memo":
-		value := x.Memo
+	case "types.QueryQuoteSaverDepositRequest.asset":
+		value := x.Asset
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
-		value := x.DustAmount
+	case "types.QueryQuoteSaverDepositRequest.amount":
+		value := x.Amount
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
-		value := x.ExpectedAmountOut
+	case "types.QueryQuoteSaverDepositRequest.affiliate":
+		if len(x.Affiliate) == 0 {
+			return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_3_list{})
+		}
+		listValue := &_QueryQuoteSaverDepositRequest_3_list{list: &x.Affiliate}
+		return protoreflect.ValueOfList(listValue)
+	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
+		if len(x.AffiliateBps) == 0 {
+			return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_4_list{})
+		}
+		listValue := &_QueryQuoteSaverDepositRequest_4_list{list: &x.AffiliateBps}
+		return protoreflect.ValueOfList(listValue)
+	case "types.QueryQuoteSaverDepositRequest.height":
+		value := x.Height
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6153,47 +5089,27 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
-		x.InboundAddress = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = value.Int()
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = value.Int()
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = value.Int()
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = value.Int()
-	case "types.QueryQuoteSaverWithdrawResponse.fees":
-		x.Fees = value.Message().Interface().(*QuoteFees)
-	case "types.QueryQuoteSaverWithdrawResponse.router":
-		x.Router = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.expiry":
-		x.Expiry = value.Int()
-	case "types.QueryQuoteSaverWithdrawResponse.warning":
-		x.Warning = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.notes":
-		x.Notes = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
-		x.DustThreshold = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
-		x.RecommendedGasRate = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
-		x.GasRateUnits = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.memo":
-		x.Memo = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
-		x.DustAmount = value.Interface().(string)
-	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
-		x.ExpectedAmountOut = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositRequest.asset":
+		x.Asset = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositRequest.amount":
+		x.Amount = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositRequest.affiliate":
+		lv := value.List()
+		clv := lv.(*_QueryQuoteSaverDepositRequest_3_list)
+		x.Affiliate = *clv.list
+	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
+		lv := value.List()
+		clv := lv.(*_QueryQuoteSaverDepositRequest_4_list)
+		x.AffiliateBps = *clv.list
+	case "types.QueryQuoteSaverDepositRequest.height":
+		x.Height = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6207,108 +5123,66 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawResponse.fees":
-		if x.Fees == nil {
-			x.Fees = new(QuoteFees)
+	case "types.QueryQuoteSaverDepositRequest.affiliate":
+		if x.Affiliate == nil {
+			x.Affiliate = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
-		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
-		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
-		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
-		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
-		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.router":
-		panic(fmt.Errorf("field router of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.expiry":
-		panic(fmt.Errorf("field expiry of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.warning":
-		panic(fmt.Errorf("field warning of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.notes":
-		panic(fmt.Errorf("field notes of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
-		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
-		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
-		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
-		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.memo":
-		panic(fmt.Errorf("field memo of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
-		panic(fmt.Errorf("field dust_amount of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
-		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
+		value := &_QueryQuoteSaverDepositRequest_3_list{list: &x.Affiliate}
+		return protoreflect.ValueOfList(value)
+	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
+		if x.AffiliateBps == nil {
+			x.AffiliateBps = []string{}
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
-	}
-}
+		value := &_QueryQuoteSaverDepositRequest_4_list{list: &x.AffiliateBps}
+		return protoreflect.ValueOfList(value)
+	case "types.QueryQuoteSaverDepositRequest.asset":
+		panic(fmt.Errorf("field asset of message types.QueryQuoteSaverDepositRequest is not mutable"))
+	case "types.QueryQuoteSaverDepositRequest.amount":
+		panic(fmt.Errorf("field amount of message types.QueryQuoteSaverDepositRequest is not mutable"))
+	case "types.QueryQuoteSaverDepositRequest.height":
+		panic(fmt.Errorf("field height of message types.QueryQuoteSaverDepositRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
+	}
+}
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverWithdrawResponse.fees":
-		m := new(QuoteFees)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "types.QueryQuoteSaverWithdrawResponse.router":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.expiry":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteSaverWithdrawResponse.warning":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.notes":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.memo":
+	case "types.QueryQuoteSaverDepositRequest.asset":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
+	case "types.QueryQuoteSaverDepositRequest.amount":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
+	case "types.QueryQuoteSaverDepositRequest.affiliate":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_3_list{list: &list})
+	case "types.QueryQuoteSaverDepositRequest.affiliate_bps":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryQuoteSaverDepositRequest_4_list{list: &list})
+	case "types.QueryQuoteSaverDepositRequest.height":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverWithdrawResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverDepositRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6316,7 +5190,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6327,7 +5201,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6339,7 +5213,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) IsValid() bool {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6349,9 +5223,9 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryQuoteSaverDepositRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteSaverWithdrawResponse)
+		x := input.Message.Interface().(*QueryQuoteSaverDepositRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6363,69 +5237,30 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.InboundAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.InboundConfirmationBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
-		}
-		if x.InboundConfirmationSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
-		}
-		if x.OutboundDelayBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
-		}
-		if x.OutboundDelaySeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
-		}
-		if x.Fees != nil {
-			l = options.Size(x.Fees)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Router)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Expiry != 0 {
-			n += 1 + runtime.Sov(uint64(x.Expiry))
-		}
-		l = len(x.Warning)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Notes)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DustThreshold)
+		l = len(x.Asset)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RecommendedMinAmountIn)
+		l = len(x.Amount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RecommendedGasRate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Affiliate) > 0 {
+			for _, s := range x.Affiliate {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.GasRateUnits)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.AffiliateBps) > 0 {
+			for _, s := range x.AffiliateBps {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Memo)
+		l = len(x.Height)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DustAmount)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedAmountOut)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6436,7 +5271,7 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverWithdrawResponse)
+		x := input.Message.Interface().(*QueryQuoteSaverDepositRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6455,143 +5290,62 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ExpectedAmountOut) > 0 {
-			i -= len(x.ExpectedAmountOut)
-			copy(dAtA[i:], x.ExpectedAmountOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.DustAmount) > 0 {
-			i -= len(x.DustAmount)
-			copy(dAtA[i:], x.DustAmount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustAmount)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-		if len(x.Memo) > 0 {
-			i -= len(x.Memo)
-			copy(dAtA[i:], x.Memo)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
-			i--
-			dAtA[i] = 0x7a
-		}
-		if len(x.GasRateUnits) > 0 {
-			i -= len(x.GasRateUnits)
-			copy(dAtA[i:], x.GasRateUnits)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
+		if len(x.Height) > 0 {
+			i -= len(x.Height)
+			copy(dAtA[i:], x.Height)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0x2a
 		}
-		if len(x.RecommendedGasRate) > 0 {
-			i -= len(x.RecommendedGasRate)
-			copy(dAtA[i:], x.RecommendedGasRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
-			i--
-			dAtA[i] = 0x6a
+		if len(x.AffiliateBps) > 0 {
+			for iNdEx := len(x.AffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AffiliateBps[iNdEx])
+				copy(dAtA[i:], x.AffiliateBps[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AffiliateBps[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if len(x.RecommendedMinAmountIn) > 0 {
-			i -= len(x.RecommendedMinAmountIn)
-			copy(dAtA[i:], x.RecommendedMinAmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
-			i--
-			dAtA[i] = 0x62
+		if len(x.Affiliate) > 0 {
+			for iNdEx := len(x.Affiliate) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Affiliate[iNdEx])
+				copy(dAtA[i:], x.Affiliate[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Affiliate[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if len(x.DustThreshold) > 0 {
-			i -= len(x.DustThreshold)
-			copy(dAtA[i:], x.DustThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x12
 		}
-		if len(x.Notes) > 0 {
-			i -= len(x.Notes)
-			copy(dAtA[i:], x.Notes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
+		if len(x.Asset) > 0 {
+			i -= len(x.Asset)
+			copy(dAtA[i:], x.Asset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0xa
 		}
-		if len(x.Warning) > 0 {
-			i -= len(x.Warning)
-			copy(dAtA[i:], x.Warning)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
-			i--
-			dAtA[i] = 0x4a
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if x.Expiry != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
-			i--
-			dAtA[i] = 0x40
-		}
-		if len(x.Router) > 0 {
-			i -= len(x.Router)
-			copy(dAtA[i:], x.Router)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.Fees != nil {
-			encoded, err := options.Marshal(x.Fees)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.OutboundDelaySeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.OutboundDelayBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.InboundConfirmationSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.InboundConfirmationBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.InboundAddress) > 0 {
-			i -= len(x.InboundAddress)
-			copy(dAtA[i:], x.InboundAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteSaverWithdrawResponse)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteSaverDepositRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -6618,15 +5372,15 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6654,123 +5408,11 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InboundAddress = string(dAtA[iNdEx:postIndex])
+				x.Asset = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
-				}
-				x.InboundConfirmationBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
-				}
-				x.InboundConfirmationSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
-				}
-				x.OutboundDelayBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
-				}
-				x.OutboundDelaySeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Fees == nil {
-					x.Fees = &QuoteFees{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6798,30 +5440,11 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Router = string(dAtA[iNdEx:postIndex])
+				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-				}
-				x.Expiry = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Expiry |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6849,11 +5472,11 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Warning = string(dAtA[iNdEx:postIndex])
+				x.Affiliate = append(x.Affiliate, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 10:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6881,11 +5504,11 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Notes = string(dAtA[iNdEx:postIndex])
+				x.AffiliateBps = append(x.AffiliateBps, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 11:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6913,210 +5536,18 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DustThreshold = string(dAtA[iNdEx:postIndex])
+				x.Height = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 13:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 14:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 15:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Memo = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 16:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustAmount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DustAmount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 17:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -7142,180 +5573,106 @@ func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoif
 	}
 }
 
-var _ protoreflect.List = (*_QueryQuoteLoanOpenRequest_6_list)(nil)
+var (
+	md_QueryQuoteSaverDepositResponse                              protoreflect.MessageDescriptor
+	fd_QueryQuoteSaverDepositResponse_inbound_address              protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_blocks  protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_seconds protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_outbound_delay_blocks        protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_outbound_delay_seconds       protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_fees                         protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_router                       protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_expiry                       protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_warning                      protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_notes                        protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_dust_threshold               protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_recommended_min_amount_in    protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_recommended_gas_rate         protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_gas_rate_units               protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_memo                         protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_expected_amount_out          protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverDepositResponse_expected_amount_deposit      protoreflect.FieldDescriptor
+)
 
-type _QueryQuoteLoanOpenRequest_6_list struct {
-	list *[]string
+func init() {
+	file_types_query_quotes_proto_init()
+	md_QueryQuoteSaverDepositResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverDepositResponse")
+	fd_QueryQuoteSaverDepositResponse_inbound_address = md_QueryQuoteSaverDepositResponse.Fields().ByName("inbound_address")
+	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_blocks = md_QueryQuoteSaverDepositResponse.Fields().ByName("inbound_confirmation_blocks")
+	fd_QueryQuoteSaverDepositResponse_inbound_confirmation_seconds = md_QueryQuoteSaverDepositResponse.Fields().ByName("inbound_confirmation_seconds")
+	fd_QueryQuoteSaverDepositResponse_outbound_delay_blocks = md_QueryQuoteSaverDepositResponse.Fields().ByName("outbound_delay_blocks")
+	fd_QueryQuoteSaverDepositResponse_outbound_delay_seconds = md_QueryQuoteSaverDepositResponse.Fields().ByName("outbound_delay_seconds")
+	fd_QueryQuoteSaverDepositResponse_fees = md_QueryQuoteSaverDepositResponse.Fields().ByName("fees")
+	fd_QueryQuoteSaverDepositResponse_router = md_QueryQuoteSaverDepositResponse.Fields().ByName("router")
+	fd_QueryQuoteSaverDepositResponse_expiry = md_QueryQuoteSaverDepositResponse.Fields().ByName("expiry")
+	fd_QueryQuoteSaverDepositResponse_warning = md_QueryQuoteSaverDepositResponse.Fields().ByName("warning")
+	fd_QueryQuoteSaverDepositResponse_notes = md_QueryQuoteSaverDepositResponse.Fields().ByName("notes")
+	fd_QueryQuoteSaverDepositResponse_dust_threshold = md_QueryQuoteSaverDepositResponse.Fields().ByName("dust_threshold")
+	fd_QueryQuoteSaverDepositResponse_recommended_min_amount_in = md_QueryQuoteSaverDepositResponse.Fields().ByName("recommended_min_amount_in")
+	fd_QueryQuoteSaverDepositResponse_recommended_gas_rate = md_QueryQuoteSaverDepositResponse.Fields().ByName("recommended_gas_rate")
+	fd_QueryQuoteSaverDepositResponse_gas_rate_units = md_QueryQuoteSaverDepositResponse.Fields().ByName("gas_rate_units")
+	fd_QueryQuoteSaverDepositResponse_memo = md_QueryQuoteSaverDepositResponse.Fields().ByName("memo")
+	fd_QueryQuoteSaverDepositResponse_expected_amount_out = md_QueryQuoteSaverDepositResponse.Fields().ByName("expected_amount_out")
+	fd_QueryQuoteSaverDepositResponse_expected_amount_deposit = md_QueryQuoteSaverDepositResponse.Fields().ByName("expected_amount_deposit")
 }
 
-func (x *_QueryQuoteLoanOpenRequest_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverDepositResponse)(nil)
 
-func (x *_QueryQuoteLoanOpenRequest_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_QueryQuoteSaverDepositResponse QueryQuoteSaverDepositResponse
 
-func (x *_QueryQuoteLoanOpenRequest_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *QueryQuoteSaverDepositResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverDepositResponse)(x)
 }
 
-func (x *_QueryQuoteLoanOpenRequest_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *QueryQuoteSaverDepositResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryQuoteLoanOpenRequest_6_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteLoanOpenRequest at list field Affiliate as it is not of Message kind"))
-}
+var _fastReflection_QueryQuoteSaverDepositResponse_messageType fastReflection_QueryQuoteSaverDepositResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverDepositResponse_messageType{}
 
-func (x *_QueryQuoteLoanOpenRequest_6_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_QueryQuoteSaverDepositResponse_messageType struct{}
 
-func (x *_QueryQuoteLoanOpenRequest_6_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryQuoteLoanOpenRequest_6_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_QueryQuoteLoanOpenRequest_7_list)(nil)
-
-type _QueryQuoteLoanOpenRequest_7_list struct {
-	list *[]string
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteLoanOpenRequest at list field AffiliateBps as it is not of Message kind"))
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_QueryQuoteLoanOpenRequest_7_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryQuoteLoanOpenRequest               protoreflect.MessageDescriptor
-	fd_QueryQuoteLoanOpenRequest_from_asset    protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenRequest_to_asset      protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenRequest_amount        protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenRequest_min_out       protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenRequest_destination   protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenRequest_affiliate     protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenRequest_affiliate_bps protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenRequest_height        protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_types_query_quotes_proto_init()
-	md_QueryQuoteLoanOpenRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanOpenRequest")
-	fd_QueryQuoteLoanOpenRequest_from_asset = md_QueryQuoteLoanOpenRequest.Fields().ByName("from_asset")
-	fd_QueryQuoteLoanOpenRequest_to_asset = md_QueryQuoteLoanOpenRequest.Fields().ByName("to_asset")
-	fd_QueryQuoteLoanOpenRequest_amount = md_QueryQuoteLoanOpenRequest.Fields().ByName("amount")
-	fd_QueryQuoteLoanOpenRequest_min_out = md_QueryQuoteLoanOpenRequest.Fields().ByName("min_out")
-	fd_QueryQuoteLoanOpenRequest_destination = md_QueryQuoteLoanOpenRequest.Fields().ByName("destination")
-	fd_QueryQuoteLoanOpenRequest_affiliate = md_QueryQuoteLoanOpenRequest.Fields().ByName("affiliate")
-	fd_QueryQuoteLoanOpenRequest_affiliate_bps = md_QueryQuoteLoanOpenRequest.Fields().ByName("affiliate_bps")
-	fd_QueryQuoteLoanOpenRequest_height = md_QueryQuoteLoanOpenRequest.Fields().ByName("height")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanOpenRequest)(nil)
-
-type fastReflection_QueryQuoteLoanOpenRequest QueryQuoteLoanOpenRequest
-
-func (x *QueryQuoteLoanOpenRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanOpenRequest)(x)
-}
-
-func (x *QueryQuoteLoanOpenRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_query_quotes_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryQuoteLoanOpenRequest_messageType fastReflection_QueryQuoteLoanOpenRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanOpenRequest_messageType{}
-
-type fastReflection_QueryQuoteLoanOpenRequest_messageType struct{}
-
-func (x fastReflection_QueryQuoteLoanOpenRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanOpenRequest)(nil)
+func (x fastReflection_QueryQuoteSaverDepositResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverDepositResponse)(nil)
 }
-func (x fastReflection_QueryQuoteLoanOpenRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanOpenRequest)
+func (x fastReflection_QueryQuoteSaverDepositResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverDepositResponse)
 }
-func (x fastReflection_QueryQuoteLoanOpenRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanOpenRequest
+func (x fastReflection_QueryQuoteSaverDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverDepositResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanOpenRequest
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverDepositResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteLoanOpenRequest_messageType
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteSaverDepositResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanOpenRequest)
+func (x *fastReflection_QueryQuoteSaverDepositResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverDepositResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteLoanOpenRequest)(x)
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteSaverDepositResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7323,52 +5680,106 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.FromAsset != "" {
-		value := protoreflect.ValueOfString(x.FromAsset)
-		if !f(fd_QueryQuoteLoanOpenRequest_from_asset, value) {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InboundAddress != "" {
+		value := protoreflect.ValueOfString(x.InboundAddress)
+		if !f(fd_QueryQuoteSaverDepositResponse_inbound_address, value) {
 			return
 		}
 	}
-	if x.ToAsset != "" {
-		value := protoreflect.ValueOfString(x.ToAsset)
-		if !f(fd_QueryQuoteLoanOpenRequest_to_asset, value) {
+	if x.InboundConfirmationBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
+		if !f(fd_QueryQuoteSaverDepositResponse_inbound_confirmation_blocks, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_QueryQuoteLoanOpenRequest_amount, value) {
+	if x.InboundConfirmationSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
+		if !f(fd_QueryQuoteSaverDepositResponse_inbound_confirmation_seconds, value) {
 			return
 		}
 	}
-	if x.MinOut != "" {
-		value := protoreflect.ValueOfString(x.MinOut)
-		if !f(fd_QueryQuoteLoanOpenRequest_min_out, value) {
+	if x.OutboundDelayBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
+		if !f(fd_QueryQuoteSaverDepositResponse_outbound_delay_blocks, value) {
 			return
 		}
 	}
-	if x.Destination != "" {
-		value := protoreflect.ValueOfString(x.Destination)
-		if !f(fd_QueryQuoteLoanOpenRequest_destination, value) {
+	if x.OutboundDelaySeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
+		if !f(fd_QueryQuoteSaverDepositResponse_outbound_delay_seconds, value) {
 			return
 		}
 	}
-	if len(x.Affiliate) != 0 {
-		value := protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_6_list{list: &x.Affiliate})
-		if !f(fd_QueryQuoteLoanOpenRequest_affiliate, value) {
+	if x.Fees != nil {
+		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+		if !f(fd_QueryQuoteSaverDepositResponse_fees, value) {
 			return
 		}
 	}
-	if len(x.AffiliateBps) != 0 {
-		value := protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_7_list{list: &x.AffiliateBps})
-		if !f(fd_QueryQuoteLoanOpenRequest_affiliate_bps, value) {
+	if x.Router != "" {
+		value := protoreflect.ValueOfString(x.Router)
+		if !f(fd_QueryQuoteSaverDepositResponse_router, value) {
 			return
 		}
 	}
-	if x.Height != "" {
-		value := protoreflect.ValueOfString(x.Height)
-		if !f(fd_QueryQuoteLoanOpenRequest_height, value) {
+	if x.Expiry != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiry)
+		if !f(fd_QueryQuoteSaverDepositResponse_expiry, value) {
+			return
+		}
+	}
+	if x.Warning != "" {
+		value := protoreflect.ValueOfString(x.Warning)
+		if !f(fd_QueryQuoteSaverDepositResponse_warning, value) {
+			return
+		}
+	}
+	if x.Notes != "" {
+		value := protoreflect.ValueOfString(x.Notes)
+		if !f(fd_QueryQuoteSaverDepositResponse_notes, value) {
+			return
+		}
+	}
+	if x.DustThreshold != "" {
+		value := protoreflect.ValueOfString(x.DustThreshold)
+		if !f(fd_QueryQuoteSaverDepositResponse_dust_threshold, value) {
+			return
+		}
+	}
+	if x.RecommendedMinAmountIn != "" {
+		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
+		if !f(fd_QueryQuoteSaverDepositResponse_recommended_min_amount_in, value) {
+			return
+		}
+	}
+	if x.RecommendedGasRate != "" {
+		value := protoreflect.ValueOfString(x.RecommendedGasRate)
+		if !f(fd_QueryQuoteSaverDepositResponse_recommended_gas_rate, value) {
+			return
+		}
+	}
+	if x.GasRateUnits != "" {
+		value := protoreflect.ValueOfString(x.GasRateUnits)
+		if !f(fd_QueryQuoteSaverDepositResponse_gas_rate_units, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_QueryQuoteSaverDepositResponse_memo, value) {
+			return
+		}
+	}
+	if x.ExpectedAmountOut != "" {
+		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
+		if !f(fd_QueryQuoteSaverDepositResponse_expected_amount_out, value) {
+			return
+		}
+	}
+	if x.ExpectedAmountDeposit != "" {
+		value := protoreflect.ValueOfString(x.ExpectedAmountDeposit)
+		if !f(fd_QueryQuoteSaverDepositResponse_expected_amount_deposit, value) {
 			return
 		}
 	}
@@ -7385,29 +5796,47 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenRequest.from_asset":
-		return x.FromAsset != ""
-	case "types.QueryQuoteLoanOpenRequest.to_asset":
-		return x.ToAsset != ""
-	case "types.QueryQuoteLoanOpenRequest.amount":
-		return x.Amount != ""
-	case "types.QueryQuoteLoanOpenRequest.min_out":
-		return x.MinOut != ""
-	case "types.QueryQuoteLoanOpenRequest.destination":
-		return x.Destination != ""
-	case "types.QueryQuoteLoanOpenRequest.affiliate":
-		return len(x.Affiliate) != 0
-	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
-		return len(x.AffiliateBps) != 0
-	case "types.QueryQuoteLoanOpenRequest.height":
-		return x.Height != ""
-	default:
+	case "types.QueryQuoteSaverDepositResponse.inbound_address":
+		return x.InboundAddress != ""
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
+		return x.InboundConfirmationBlocks != int64(0)
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
+		return x.InboundConfirmationSeconds != int64(0)
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
+		return x.OutboundDelayBlocks != int64(0)
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
+		return x.OutboundDelaySeconds != int64(0)
+	case "types.QueryQuoteSaverDepositResponse.fees":
+		return x.Fees != nil
+	case "types.QueryQuoteSaverDepositResponse.router":
+		return x.Router != ""
+	case "types.QueryQuoteSaverDepositResponse.expiry":
+		return x.Expiry != int64(0)
+	case "types.QueryQuoteSaverDepositResponse.warning":
+		return x.Warning != ""
+	case "types.QueryQuoteSaverDepositResponse.notes":
+		return x.Notes != ""
+	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
+		return x.DustThreshold != ""
+	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
+		return x.RecommendedMinAmountIn != ""
+	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
+		return x.RecommendedGasRate != ""
+	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
+		return x.GasRateUnits != ""
+	case "types.QueryQuoteSaverDepositResponse.memo":
+		return x.Memo != ""
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
+		return x.ExpectedAmountOut != ""
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
+		return x.ExpectedAmountDeposit != ""
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7417,29 +5846,47 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenRequest.from_asset":
-		x.FromAsset = ""
-	case "types.QueryQuoteLoanOpenRequest.to_asset":
-		x.ToAsset = ""
-	case "types.QueryQuoteLoanOpenRequest.amount":
-		x.Amount = ""
-	case "types.QueryQuoteLoanOpenRequest.min_out":
-		x.MinOut = ""
-	case "types.QueryQuoteLoanOpenRequest.destination":
-		x.Destination = ""
-	case "types.QueryQuoteLoanOpenRequest.affiliate":
-		x.Affiliate = nil
-	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
-		x.AffiliateBps = nil
-	case "types.QueryQuoteLoanOpenRequest.height":
-		x.Height = ""
+	case "types.QueryQuoteSaverDepositResponse.inbound_address":
+		x.InboundAddress = ""
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = int64(0)
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = int64(0)
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = int64(0)
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = int64(0)
+	case "types.QueryQuoteSaverDepositResponse.fees":
+		x.Fees = nil
+	case "types.QueryQuoteSaverDepositResponse.router":
+		x.Router = ""
+	case "types.QueryQuoteSaverDepositResponse.expiry":
+		x.Expiry = int64(0)
+	case "types.QueryQuoteSaverDepositResponse.warning":
+		x.Warning = ""
+	case "types.QueryQuoteSaverDepositResponse.notes":
+		x.Notes = ""
+	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
+		x.DustThreshold = ""
+	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = ""
+	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
+		x.RecommendedGasRate = ""
+	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
+		x.GasRateUnits = ""
+	case "types.QueryQuoteSaverDepositResponse.memo":
+		x.Memo = ""
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
+		x.ExpectedAmountOut = ""
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
+		x.ExpectedAmountDeposit = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7449,43 +5896,64 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteLoanOpenRequest.from_asset":
-		value := x.FromAsset
+	case "types.QueryQuoteSaverDepositResponse.inbound_address":
+		value := x.InboundAddress
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenRequest.to_asset":
-		value := x.ToAsset
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
+		value := x.InboundConfirmationBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
+		value := x.InboundConfirmationSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
+		value := x.OutboundDelayBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
+		value := x.OutboundDelaySeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverDepositResponse.fees":
+		value := x.Fees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.QueryQuoteSaverDepositResponse.router":
+		value := x.Router
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenRequest.amount":
-		value := x.Amount
+	case "types.QueryQuoteSaverDepositResponse.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverDepositResponse.warning":
+		value := x.Warning
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenRequest.min_out":
-		value := x.MinOut
+	case "types.QueryQuoteSaverDepositResponse.notes":
+		value := x.Notes
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenRequest.destination":
-		value := x.Destination
+	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
+		value := x.DustThreshold
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenRequest.affiliate":
-		if len(x.Affiliate) == 0 {
-			return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_6_list{})
-		}
-		listValue := &_QueryQuoteLoanOpenRequest_6_list{list: &x.Affiliate}
-		return protoreflect.ValueOfList(listValue)
-	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
-		if len(x.AffiliateBps) == 0 {
-			return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_7_list{})
-		}
-		listValue := &_QueryQuoteLoanOpenRequest_7_list{list: &x.AffiliateBps}
-		return protoreflect.ValueOfList(listValue)
-	case "types.QueryQuoteLoanOpenRequest.height":
-		value := x.Height
+	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
+		value := x.RecommendedMinAmountIn
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
+		value := x.RecommendedGasRate
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
+		value := x.GasRateUnits
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverDepositResponse.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
+		value := x.ExpectedAmountOut
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
+		value := x.ExpectedAmountDeposit
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7499,33 +5967,47 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenRequest.from_asset":
-		x.FromAsset = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenRequest.to_asset":
-		x.ToAsset = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenRequest.amount":
-		x.Amount = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenRequest.min_out":
-		x.MinOut = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenRequest.destination":
-		x.Destination = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenRequest.affiliate":
-		lv := value.List()
-		clv := lv.(*_QueryQuoteLoanOpenRequest_6_list)
-		x.Affiliate = *clv.list
-	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
-		lv := value.List()
-		clv := lv.(*_QueryQuoteLoanOpenRequest_7_list)
-		x.AffiliateBps = *clv.list
-	case "types.QueryQuoteLoanOpenRequest.height":
-		x.Height = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.inbound_address":
+		x.InboundAddress = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = value.Int()
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = value.Int()
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = value.Int()
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = value.Int()
+	case "types.QueryQuoteSaverDepositResponse.fees":
+		x.Fees = value.Message().Interface().(*QuoteFees)
+	case "types.QueryQuoteSaverDepositResponse.router":
+		x.Router = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.expiry":
+		x.Expiry = value.Int()
+	case "types.QueryQuoteSaverDepositResponse.warning":
+		x.Warning = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.notes":
+		x.Notes = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
+		x.DustThreshold = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
+		x.RecommendedGasRate = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
+		x.GasRateUnits = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.memo":
+		x.Memo = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
+		x.ExpectedAmountOut = value.Interface().(string)
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
+		x.ExpectedAmountDeposit = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7539,78 +6021,108 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenRequest.affiliate":
-		if x.Affiliate == nil {
-			x.Affiliate = []string{}
-		}
-		value := &_QueryQuoteLoanOpenRequest_6_list{list: &x.Affiliate}
-		return protoreflect.ValueOfList(value)
-	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
-		if x.AffiliateBps == nil {
-			x.AffiliateBps = []string{}
+	case "types.QueryQuoteSaverDepositResponse.fees":
+		if x.Fees == nil {
+			x.Fees = new(QuoteFees)
 		}
-		value := &_QueryQuoteLoanOpenRequest_7_list{list: &x.AffiliateBps}
-		return protoreflect.ValueOfList(value)
-	case "types.QueryQuoteLoanOpenRequest.from_asset":
-		panic(fmt.Errorf("field from_asset of message types.QueryQuoteLoanOpenRequest is not mutable"))
-	case "types.QueryQuoteLoanOpenRequest.to_asset":
-		panic(fmt.Errorf("field to_asset of message types.QueryQuoteLoanOpenRequest is not mutable"))
-	case "types.QueryQuoteLoanOpenRequest.amount":
-		panic(fmt.Errorf("field amount of message types.QueryQuoteLoanOpenRequest is not mutable"))
-	case "types.QueryQuoteLoanOpenRequest.min_out":
-		panic(fmt.Errorf("field min_out of message types.QueryQuoteLoanOpenRequest is not mutable"))
-	case "types.QueryQuoteLoanOpenRequest.destination":
-		panic(fmt.Errorf("field destination of message types.QueryQuoteLoanOpenRequest is not mutable"))
-	case "types.QueryQuoteLoanOpenRequest.height":
-		panic(fmt.Errorf("field height of message types.QueryQuoteLoanOpenRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+	case "types.QueryQuoteSaverDepositResponse.inbound_address":
+		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
+		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
+		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
+		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
+		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.router":
+		panic(fmt.Errorf("field router of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.expiry":
+		panic(fmt.Errorf("field expiry of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.warning":
+		panic(fmt.Errorf("field warning of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.notes":
+		panic(fmt.Errorf("field notes of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
+		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
+		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
+		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
+		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.memo":
+		panic(fmt.Errorf("field memo of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
+		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteSaverDepositResponse is not mutable"))
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
+		panic(fmt.Errorf("field expected_amount_deposit of message types.QueryQuoteSaverDepositResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenRequest.from_asset":
+	case "types.QueryQuoteSaverDepositResponse.inbound_address":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenRequest.to_asset":
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverDepositResponse.inbound_confirmation_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverDepositResponse.outbound_delay_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverDepositResponse.fees":
+		m := new(QuoteFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.QueryQuoteSaverDepositResponse.router":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenRequest.amount":
+	case "types.QueryQuoteSaverDepositResponse.expiry":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverDepositResponse.warning":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenRequest.min_out":
+	case "types.QueryQuoteSaverDepositResponse.notes":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenRequest.destination":
+	case "types.QueryQuoteSaverDepositResponse.dust_threshold":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenRequest.affiliate":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_6_list{list: &list})
-	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_7_list{list: &list})
-	case "types.QueryQuoteLoanOpenRequest.height":
+	case "types.QueryQuoteSaverDepositResponse.recommended_min_amount_in":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverDepositResponse.recommended_gas_rate":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverDepositResponse.gas_rate_units":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverDepositResponse.memo":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_out":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverDepositResponse.expected_amount_deposit":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverDepositResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverDepositResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanOpenRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverDepositResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7618,7 +6130,7 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7629,7 +6141,7 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7641,7 +6153,7 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) IsValid() bool {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7651,9 +6163,9 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryQuoteSaverDepositResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteLoanOpenRequest)
+		x := input.Message.Interface().(*QueryQuoteSaverDepositResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7665,42 +6177,69 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.FromAsset)
+		l = len(x.InboundAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ToAsset)
+		if x.InboundConfirmationBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
+		}
+		if x.InboundConfirmationSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
+		}
+		if x.OutboundDelayBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
+		}
+		if x.OutboundDelaySeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
+		}
+		if x.Fees != nil {
+			l = options.Size(x.Fees)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Router)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
+		if x.Expiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiry))
+		}
+		l = len(x.Warning)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinOut)
+		l = len(x.Notes)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Destination)
+		l = len(x.DustThreshold)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Affiliate) > 0 {
-			for _, s := range x.Affiliate {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.RecommendedMinAmountIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.AffiliateBps) > 0 {
-			for _, s := range x.AffiliateBps {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.RecommendedGasRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Height)
+		l = len(x.GasRateUnits)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Memo)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.ExpectedAmountOut)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedAmountDeposit)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7711,7 +6250,7 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanOpenRequest)
+		x := input.Message.Interface().(*QueryQuoteSaverDepositResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7730,69 +6269,129 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Height) > 0 {
-			i -= len(x.Height)
-			copy(dAtA[i:], x.Height)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
+		if len(x.ExpectedAmountDeposit) > 0 {
+			i -= len(x.ExpectedAmountDeposit)
+			copy(dAtA[i:], x.ExpectedAmountDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountDeposit)))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
-		if len(x.AffiliateBps) > 0 {
-			for iNdEx := len(x.AffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AffiliateBps[iNdEx])
-				copy(dAtA[i:], x.AffiliateBps[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AffiliateBps[iNdEx])))
-				i--
-				dAtA[i] = 0x3a
-			}
+		if len(x.ExpectedAmountOut) > 0 {
+			i -= len(x.ExpectedAmountOut)
+			copy(dAtA[i:], x.ExpectedAmountOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
-		if len(x.Affiliate) > 0 {
-			for iNdEx := len(x.Affiliate) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Affiliate[iNdEx])
-				copy(dAtA[i:], x.Affiliate[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Affiliate[iNdEx])))
-				i--
-				dAtA[i] = 0x32
-			}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x7a
 		}
-		if len(x.Destination) > 0 {
-			i -= len(x.Destination)
-			copy(dAtA[i:], x.Destination)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
+		if len(x.GasRateUnits) > 0 {
+			i -= len(x.GasRateUnits)
+			copy(dAtA[i:], x.GasRateUnits)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x72
 		}
-		if len(x.MinOut) > 0 {
-			i -= len(x.MinOut)
-			copy(dAtA[i:], x.MinOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinOut)))
+		if len(x.RecommendedGasRate) > 0 {
+			i -= len(x.RecommendedGasRate)
+			copy(dAtA[i:], x.RecommendedGasRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x6a
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		if len(x.RecommendedMinAmountIn) > 0 {
+			i -= len(x.RecommendedMinAmountIn)
+			copy(dAtA[i:], x.RecommendedMinAmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x62
 		}
-		if len(x.ToAsset) > 0 {
-			i -= len(x.ToAsset)
-			copy(dAtA[i:], x.ToAsset)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAsset)))
+		if len(x.DustThreshold) > 0 {
+			i -= len(x.DustThreshold)
+			copy(dAtA[i:], x.DustThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x5a
 		}
-		if len(x.FromAsset) > 0 {
-			i -= len(x.FromAsset)
-			copy(dAtA[i:], x.FromAsset)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAsset)))
+		if len(x.Notes) > 0 {
+			i -= len(x.Notes)
+			copy(dAtA[i:], x.Notes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x52
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
+		if len(x.Warning) > 0 {
+			i -= len(x.Warning)
+			copy(dAtA[i:], x.Warning)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Expiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Router) > 0 {
+			i -= len(x.Router)
+			copy(dAtA[i:], x.Router)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Fees != nil {
+			encoded, err := options.Marshal(x.Fees)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.OutboundDelaySeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.OutboundDelayBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.InboundConfirmationSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.InboundConfirmationBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.InboundAddress) > 0 {
+			i -= len(x.InboundAddress)
+			copy(dAtA[i:], x.InboundAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
 			input.Buf = dAtA
 		}
 		return protoiface.MarshalOutput{
@@ -7801,7 +6400,7 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanOpenRequest)
+		x := input.Message.Interface().(*QueryQuoteSaverDepositResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7833,15 +6432,15 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAsset", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7869,11 +6468,123 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FromAsset = string(dAtA[iNdEx:postIndex])
+				x.InboundAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+				}
+				x.InboundConfirmationBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+				}
+				x.InboundConfirmationSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+				}
+				x.OutboundDelayBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+				}
+				x.OutboundDelaySeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAsset", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fees == nil {
+					x.Fees = &QuoteFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7901,11 +6612,30 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ToAsset = string(dAtA[iNdEx:postIndex])
+				x.Router = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				x.Expiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiry |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7933,11 +6663,11 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Warning = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinOut", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7965,11 +6695,11 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinOut = string(dAtA[iNdEx:postIndex])
+				x.Notes = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7997,11 +6727,11 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Destination = string(dAtA[iNdEx:postIndex])
+				x.DustThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8029,11 +6759,11 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Affiliate = append(x.Affiliate, string(dAtA[iNdEx:postIndex]))
+				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8061,11 +6791,11 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AffiliateBps = append(x.AffiliateBps, string(dAtA[iNdEx:postIndex]))
+				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8093,18 +6823,114 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Height = string(dAtA[iNdEx:postIndex])
+				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 				}
-				if (iNdEx + skippy) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedAmountDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -8131,67 +6957,31 @@ func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryQuoteLoanOpenResponse                                  protoreflect.MessageDescriptor
-	fd_QueryQuoteLoanOpenResponse_inbound_address                  protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_blocks      protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_seconds     protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_outbound_delay_blocks            protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_outbound_delay_seconds           protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_fees                             protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_router                           protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_expiry                           protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_warning                          protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_notes                            protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_dust_threshold                   protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_recommended_min_amount_in        protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_recommended_gas_rate             protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_gas_rate_units                   protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_memo                             protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_expected_amount_out              protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_expected_collateralization_ratio protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_expected_collateral_deposited    protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_expected_debt_issued             protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_streaming_swap_blocks            protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_streaming_swap_seconds           protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanOpenResponse_total_open_loan_seconds          protoreflect.FieldDescriptor
+	md_QueryQuoteSaverWithdrawRequest              protoreflect.MessageDescriptor
+	fd_QueryQuoteSaverWithdrawRequest_asset        protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawRequest_address      protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawRequest_withdraw_bps protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawRequest_height       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_quotes_proto_init()
-	md_QueryQuoteLoanOpenResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanOpenResponse")
-	fd_QueryQuoteLoanOpenResponse_inbound_address = md_QueryQuoteLoanOpenResponse.Fields().ByName("inbound_address")
-	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_blocks = md_QueryQuoteLoanOpenResponse.Fields().ByName("inbound_confirmation_blocks")
-	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("inbound_confirmation_seconds")
-	fd_QueryQuoteLoanOpenResponse_outbound_delay_blocks = md_QueryQuoteLoanOpenResponse.Fields().ByName("outbound_delay_blocks")
-	fd_QueryQuoteLoanOpenResponse_outbound_delay_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("outbound_delay_seconds")
-	fd_QueryQuoteLoanOpenResponse_fees = md_QueryQuoteLoanOpenResponse.Fields().ByName("fees")
-	fd_QueryQuoteLoanOpenResponse_router = md_QueryQuoteLoanOpenResponse.Fields().ByName("router")
-	fd_QueryQuoteLoanOpenResponse_expiry = md_QueryQuoteLoanOpenResponse.Fields().ByName("expiry")
-	fd_QueryQuoteLoanOpenResponse_warning = md_QueryQuoteLoanOpenResponse.Fields().ByName("warning")
-	fd_QueryQuoteLoanOpenResponse_notes = md_QueryQuoteLoanOpenResponse.Fields().ByName("notes")
-	fd_QueryQuoteLoanOpenResponse_dust_threshold = md_QueryQuoteLoanOpenResponse.Fields().ByName("dust_threshold")
-	fd_QueryQuoteLoanOpenResponse_recommended_min_amount_in = md_QueryQuoteLoanOpenResponse.Fields().ByName("recommended_min_amount_in")
-	fd_QueryQuoteLoanOpenResponse_recommended_gas_rate = md_QueryQuoteLoanOpenResponse.Fields().ByName("recommended_gas_rate")
-	fd_QueryQuoteLoanOpenResponse_gas_rate_units = md_QueryQuoteLoanOpenResponse.Fields().ByName("gas_rate_units")
-	fd_QueryQuoteLoanOpenResponse_memo = md_QueryQuoteLoanOpenResponse.Fields().ByName("memo")
-	fd_QueryQuoteLoanOpenResponse_expected_amount_out = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_amount_out")
-	fd_QueryQuoteLoanOpenResponse_expected_collateralization_ratio = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_collateralization_ratio")
-	fd_QueryQuoteLoanOpenResponse_expected_collateral_deposited = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_collateral_deposited")
-	fd_QueryQuoteLoanOpenResponse_expected_debt_issued = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_debt_issued")
-	fd_QueryQuoteLoanOpenResponse_streaming_swap_blocks = md_QueryQuoteLoanOpenResponse.Fields().ByName("streaming_swap_blocks")
-	fd_QueryQuoteLoanOpenResponse_streaming_swap_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("streaming_swap_seconds")
-	fd_QueryQuoteLoanOpenResponse_total_open_loan_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("total_open_loan_seconds")
+	md_QueryQuoteSaverWithdrawRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverWithdrawRequest")
+	fd_QueryQuoteSaverWithdrawRequest_asset = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("asset")
+	fd_QueryQuoteSaverWithdrawRequest_address = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("address")
+	fd_QueryQuoteSaverWithdrawRequest_withdraw_bps = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("withdraw_bps")
+	fd_QueryQuoteSaverWithdrawRequest_height = md_QueryQuoteSaverWithdrawRequest.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanOpenResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverWithdrawRequest)(nil)
 
-type fastReflection_QueryQuoteLoanOpenResponse QueryQuoteLoanOpenResponse
+type fastReflection_QueryQuoteSaverWithdrawRequest QueryQuoteSaverWithdrawRequest
 
-func (x *QueryQuoteLoanOpenResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanOpenResponse)(x)
+func (x *QueryQuoteSaverWithdrawRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverWithdrawRequest)(x)
 }
 
-func (x *QueryQuoteLoanOpenResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryQuoteSaverWithdrawRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_types_query_quotes_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8203,43 +6993,43 @@ func (x *QueryQuoteLoanOpenResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryQuoteLoanOpenResponse_messageType fastReflection_QueryQuoteLoanOpenResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanOpenResponse_messageType{}
+var _fastReflection_QueryQuoteSaverWithdrawRequest_messageType fastReflection_QueryQuoteSaverWithdrawRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverWithdrawRequest_messageType{}
 
-type fastReflection_QueryQuoteLoanOpenResponse_messageType struct{}
+type fastReflection_QueryQuoteSaverWithdrawRequest_messageType struct{}
 
-func (x fastReflection_QueryQuoteLoanOpenResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanOpenResponse)(nil)
+func (x fastReflection_QueryQuoteSaverWithdrawRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverWithdrawRequest)(nil)
 }
-func (x fastReflection_QueryQuoteLoanOpenResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanOpenResponse)
+func (x fastReflection_QueryQuoteSaverWithdrawRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverWithdrawRequest)
 }
-func (x fastReflection_QueryQuoteLoanOpenResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanOpenResponse
+func (x fastReflection_QueryQuoteSaverWithdrawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverWithdrawRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanOpenResponse
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverWithdrawRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteLoanOpenResponse_messageType
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteSaverWithdrawRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanOpenResponse)
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverWithdrawRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteLoanOpenResponse)(x)
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteSaverWithdrawRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8247,136 +7037,28 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.InboundAddress != "" {
-		value := protoreflect.ValueOfString(x.InboundAddress)
-		if !f(fd_QueryQuoteLoanOpenResponse_inbound_address, value) {
-			return
-		}
-	}
-	if x.InboundConfirmationBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
-		if !f(fd_QueryQuoteLoanOpenResponse_inbound_confirmation_blocks, value) {
-			return
-		}
-	}
-	if x.InboundConfirmationSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
-		if !f(fd_QueryQuoteLoanOpenResponse_inbound_confirmation_seconds, value) {
-			return
-		}
-	}
-	if x.OutboundDelayBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
-		if !f(fd_QueryQuoteLoanOpenResponse_outbound_delay_blocks, value) {
-			return
-		}
-	}
-	if x.OutboundDelaySeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
-		if !f(fd_QueryQuoteLoanOpenResponse_outbound_delay_seconds, value) {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != "" {
+		value := protoreflect.ValueOfString(x.Asset)
+		if !f(fd_QueryQuoteSaverWithdrawRequest_asset, value) {
 			return
 		}
 	}
-	if x.Fees != nil {
-		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-		if !f(fd_QueryQuoteLoanOpenResponse_fees, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryQuoteSaverWithdrawRequest_address, value) {
 			return
 		}
 	}
-	if x.Router != "" {
-		value := protoreflect.ValueOfString(x.Router)
-		if !f(fd_QueryQuoteLoanOpenResponse_router, value) {
+	if x.WithdrawBps != "" {
+		value := protoreflect.ValueOfString(x.WithdrawBps)
+		if !f(fd_QueryQuoteSaverWithdrawRequest_withdraw_bps, value) {
 			return
 		}
 	}
-	if x.Expiry != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Expiry)
-		if !f(fd_QueryQuoteLoanOpenResponse_expiry, value) {
-			return
-		}
-	}
-	if x.Warning != "" {
-		value := protoreflect.ValueOfString(x.Warning)
-		if !f(fd_QueryQuoteLoanOpenResponse_warning, value) {
-			return
-		}
-	}
-	if x.Notes != "" {
-		value := protoreflect.ValueOfString(x.Notes)
-		if !f(fd_QueryQuoteLoanOpenResponse_notes, value) {
-			return
-		}
-	}
-	if x.DustThreshold != "" {
-		value := protoreflect.ValueOfString(x.DustThreshold)
-		if !f(fd_QueryQuoteLoanOpenResponse_dust_threshold, value) {
-			return
-		}
-	}
-	if x.RecommendedMinAmountIn != "" {
-		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
-		if !f(fd_QueryQuoteLoanOpenResponse_recommended_min_amount_in, value) {
-			return
-		}
-	}
-	if x.RecommendedGasRate != "" {
-		value := protoreflect.ValueOfString(x.RecommendedGasRate)
-		if !f(fd_QueryQuoteLoanOpenResponse_recommended_gas_rate, value) {
-			return
-		}
-	}
-	if x.GasRateUnits != "" {
-		value := protoreflect.ValueOfString(x.GasRateUnits)
-		if !f(fd_QueryQuoteLoanOpenResponse_gas_rate_units, value) {
-			return
-		}
-	}
-	if x.Memo != "" {
-		value := protoreflect.ValueOfString(x.Memo)
-		if !f(fd_QueryQuoteLoanOpenResponse_memo, value) {
-			return
-		}
-	}
-	if x.ExpectedAmountOut != "" {
-		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
-		if !f(fd_QueryQuoteLoanOpenResponse_expected_amount_out, value) {
-			return
-		}
-	}
-	if x.ExpectedCollateralizationRatio != "" {
-		value := protoreflect.ValueOfString(x.ExpectedCollateralizationRatio)
-		if !f(fd_QueryQuoteLoanOpenResponse_expected_collateralization_ratio, value) {
-			return
-		}
-	}
-	if x.ExpectedCollateralDeposited != "" {
-		value := protoreflect.ValueOfString(x.ExpectedCollateralDeposited)
-		if !f(fd_QueryQuoteLoanOpenResponse_expected_collateral_deposited, value) {
-			return
-		}
-	}
-	if x.ExpectedDebtIssued != "" {
-		value := protoreflect.ValueOfString(x.ExpectedDebtIssued)
-		if !f(fd_QueryQuoteLoanOpenResponse_expected_debt_issued, value) {
-			return
-		}
-	}
-	if x.StreamingSwapBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.StreamingSwapBlocks)
-		if !f(fd_QueryQuoteLoanOpenResponse_streaming_swap_blocks, value) {
-			return
-		}
-	}
-	if x.StreamingSwapSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.StreamingSwapSeconds)
-		if !f(fd_QueryQuoteLoanOpenResponse_streaming_swap_seconds, value) {
-			return
-		}
-	}
-	if x.TotalOpenLoanSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TotalOpenLoanSeconds)
-		if !f(fd_QueryQuoteLoanOpenResponse_total_open_loan_seconds, value) {
+	if x.Height != "" {
+		value := protoreflect.ValueOfString(x.Height)
+		if !f(fd_QueryQuoteSaverWithdrawRequest_height, value) {
 			return
 		}
 	}
@@ -8393,57 +7075,21 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenResponse.inbound_address":
-		return x.InboundAddress != ""
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
-		return x.InboundConfirmationBlocks != int64(0)
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
-		return x.InboundConfirmationSeconds != int64(0)
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
-		return x.OutboundDelayBlocks != int64(0)
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
-		return x.OutboundDelaySeconds != int64(0)
-	case "types.QueryQuoteLoanOpenResponse.fees":
-		return x.Fees != nil
-	case "types.QueryQuoteLoanOpenResponse.router":
-		return x.Router != ""
-	case "types.QueryQuoteLoanOpenResponse.expiry":
-		return x.Expiry != int64(0)
-	case "types.QueryQuoteLoanOpenResponse.warning":
-		return x.Warning != ""
-	case "types.QueryQuoteLoanOpenResponse.notes":
-		return x.Notes != ""
-	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
-		return x.DustThreshold != ""
-	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
-		return x.RecommendedMinAmountIn != ""
-	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
-		return x.RecommendedGasRate != ""
-	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
-		return x.GasRateUnits != ""
-	case "types.QueryQuoteLoanOpenResponse.memo":
-		return x.Memo != ""
-	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
-		return x.ExpectedAmountOut != ""
-	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
-		return x.ExpectedCollateralizationRatio != ""
-	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
-		return x.ExpectedCollateralDeposited != ""
-	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
-		return x.ExpectedDebtIssued != ""
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
-		return x.StreamingSwapBlocks != int64(0)
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
-		return x.StreamingSwapSeconds != int64(0)
-	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
-		return x.TotalOpenLoanSeconds != int64(0)
+	case "types.QueryQuoteSaverWithdrawRequest.asset":
+		return x.Asset != ""
+	case "types.QueryQuoteSaverWithdrawRequest.address":
+		return x.Address != ""
+	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
+		return x.WithdrawBps != ""
+	case "types.QueryQuoteSaverWithdrawRequest.height":
+		return x.Height != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8453,57 +7099,21 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenResponse.inbound_address":
-		x.InboundAddress = ""
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = int64(0)
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = int64(0)
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = int64(0)
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = int64(0)
-	case "types.QueryQuoteLoanOpenResponse.fees":
-		x.Fees = nil
-	case "types.QueryQuoteLoanOpenResponse.router":
-		x.Router = ""
-	case "types.QueryQuoteLoanOpenResponse.expiry":
-		x.Expiry = int64(0)
-	case "types.QueryQuoteLoanOpenResponse.warning":
-		x.Warning = ""
-	case "types.QueryQuoteLoanOpenResponse.notes":
-		x.Notes = ""
-	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
-		x.DustThreshold = ""
-	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = ""
-	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
-		x.RecommendedGasRate = ""
-	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
-		x.GasRateUnits = ""
-	case "types.QueryQuoteLoanOpenResponse.memo":
-		x.Memo = ""
-	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
-		x.ExpectedAmountOut = ""
-	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
-		x.ExpectedCollateralizationRatio = ""
-	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
-		x.ExpectedCollateralDeposited = ""
-	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
-		x.ExpectedDebtIssued = ""
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
-		x.StreamingSwapBlocks = int64(0)
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
-		x.StreamingSwapSeconds = int64(0)
-	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
-		x.TotalOpenLoanSeconds = int64(0)
+	case "types.QueryQuoteSaverWithdrawRequest.asset":
+		x.Asset = ""
+	case "types.QueryQuoteSaverWithdrawRequest.address":
+		x.Address = ""
+	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
+		x.WithdrawBps = ""
+	case "types.QueryQuoteSaverWithdrawRequest.height":
+		x.Height = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8513,79 +7123,25 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteLoanOpenResponse.inbound_address":
-		value := x.InboundAddress
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
-		value := x.InboundConfirmationBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
-		value := x.InboundConfirmationSeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
-		value := x.OutboundDelayBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
-		value := x.OutboundDelaySeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanOpenResponse.fees":
-		value := x.Fees
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "types.QueryQuoteLoanOpenResponse.router":
-		value := x.Router
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.expiry":
-		value := x.Expiry
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanOpenResponse.warning":
-		value := x.Warning
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.notes":
-		value := x.Notes
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
-		value := x.DustThreshold
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
-		value := x.RecommendedMinAmountIn
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
-		value := x.RecommendedGasRate
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
-		value := x.GasRateUnits
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.memo":
-		value := x.Memo
+	case "types.QueryQuoteSaverWithdrawRequest.asset":
+		value := x.Asset
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
-		value := x.ExpectedAmountOut
+	case "types.QueryQuoteSaverWithdrawRequest.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
-		value := x.ExpectedCollateralizationRatio
+	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
+		value := x.WithdrawBps
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
-		value := x.ExpectedCollateralDeposited
+	case "types.QueryQuoteSaverWithdrawRequest.height":
+		value := x.Height
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
-		value := x.ExpectedDebtIssued
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
-		value := x.StreamingSwapBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
-		value := x.StreamingSwapSeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
-		value := x.TotalOpenLoanSeconds
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8599,57 +7155,21 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenResponse.inbound_address":
-		x.InboundAddress = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = value.Int()
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = value.Int()
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = value.Int()
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = value.Int()
-	case "types.QueryQuoteLoanOpenResponse.fees":
-		x.Fees = value.Message().Interface().(*QuoteFees)
-	case "types.QueryQuoteLoanOpenResponse.router":
-		x.Router = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.expiry":
-		x.Expiry = value.Int()
-	case "types.QueryQuoteLoanOpenResponse.warning":
-		x.Warning = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.notes":
-		x.Notes = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
-		x.DustThreshold = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
-		x.RecommendedGasRate = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
-		x.GasRateUnits = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.memo":
-		x.Memo = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
-		x.ExpectedAmountOut = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
-		x.ExpectedCollateralizationRatio = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
-		x.ExpectedCollateralDeposited = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
-		x.ExpectedDebtIssued = value.Interface().(string)
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
-		x.StreamingSwapBlocks = value.Int()
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
-		x.StreamingSwapSeconds = value.Int()
-	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
-		x.TotalOpenLoanSeconds = value.Int()
+	case "types.QueryQuoteSaverWithdrawRequest.asset":
+		x.Asset = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawRequest.address":
+		x.Address = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
+		x.WithdrawBps = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawRequest.height":
+		x.Height = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8663,128 +7183,52 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenResponse.fees":
-		if x.Fees == nil {
-			x.Fees = new(QuoteFees)
-		}
-		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-	case "types.QueryQuoteLoanOpenResponse.inbound_address":
-		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
-		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
-		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
-		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
-		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.router":
-		panic(fmt.Errorf("field router of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.expiry":
-		panic(fmt.Errorf("field expiry of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.warning":
-		panic(fmt.Errorf("field warning of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.notes":
-		panic(fmt.Errorf("field notes of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
-		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
-		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
-		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
-		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.memo":
-		panic(fmt.Errorf("field memo of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
-		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
-		panic(fmt.Errorf("field expected_collateralization_ratio of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
-		panic(fmt.Errorf("field expected_collateral_deposited of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
-		panic(fmt.Errorf("field expected_debt_issued of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
-		panic(fmt.Errorf("field streaming_swap_blocks of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
-		panic(fmt.Errorf("field streaming_swap_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
-	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
-		panic(fmt.Errorf("field total_open_loan_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawRequest.asset":
+		panic(fmt.Errorf("field asset of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
+	case "types.QueryQuoteSaverWithdrawRequest.address":
+		panic(fmt.Errorf("field address of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
+	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
+		panic(fmt.Errorf("field withdraw_bps of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
+	case "types.QueryQuoteSaverWithdrawRequest.height":
+		panic(fmt.Errorf("field height of message types.QueryQuoteSaverWithdrawRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanOpenResponse.inbound_address":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanOpenResponse.fees":
-		m := new(QuoteFees)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "types.QueryQuoteLoanOpenResponse.router":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.expiry":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanOpenResponse.warning":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.notes":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.memo":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
+	case "types.QueryQuoteSaverWithdrawRequest.asset":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
+	case "types.QueryQuoteSaverWithdrawRequest.address":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
+	case "types.QueryQuoteSaverWithdrawRequest.withdraw_bps":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
+	case "types.QueryQuoteSaverWithdrawRequest.height":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanOpenResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverWithdrawRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8792,7 +7236,7 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8803,7 +7247,7 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8815,7 +7259,7 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) IsValid() bool {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8825,9 +7269,9 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryQuoteSaverWithdrawRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteLoanOpenResponse)
+		x := input.Message.Interface().(*QueryQuoteSaverWithdrawRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8839,102 +7283,38 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.InboundAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.InboundConfirmationBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
-		}
-		if x.InboundConfirmationSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
-		}
-		if x.OutboundDelayBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
-		}
-		if x.OutboundDelaySeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
-		}
-		if x.Fees != nil {
-			l = options.Size(x.Fees)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Router)
+		l = len(x.Asset)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Expiry != 0 {
-			n += 1 + runtime.Sov(uint64(x.Expiry))
-		}
-		l = len(x.Warning)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Notes)
+		l = len(x.WithdrawBps)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DustThreshold)
+		l = len(x.Height)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RecommendedMinAmountIn)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
-		l = len(x.RecommendedGasRate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
 		}
-		l = len(x.GasRateUnits)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Memo)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedAmountOut)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedCollateralizationRatio)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedCollateralDeposited)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedDebtIssued)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.StreamingSwapBlocks != 0 {
-			n += 2 + runtime.Sov(uint64(x.StreamingSwapBlocks))
-		}
-		if x.StreamingSwapSeconds != 0 {
-			n += 2 + runtime.Sov(uint64(x.StreamingSwapSeconds))
-		}
-		if x.TotalOpenLoanSeconds != 0 {
-			n += 2 + runtime.Sov(uint64(x.TotalOpenLoanSeconds))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanOpenResponse)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteSaverWithdrawRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
 		}
 		options := runtime.MarshalInputToOptions(input)
 		_ = options
@@ -8948,162 +7328,31 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TotalOpenLoanSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalOpenLoanSeconds))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xb0
-		}
-		if x.StreamingSwapSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapSeconds))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa8
-		}
-		if x.StreamingSwapBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapBlocks))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa0
-		}
-		if len(x.ExpectedDebtIssued) > 0 {
-			i -= len(x.ExpectedDebtIssued)
-			copy(dAtA[i:], x.ExpectedDebtIssued)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedDebtIssued)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
-		if len(x.ExpectedCollateralDeposited) > 0 {
-			i -= len(x.ExpectedCollateralDeposited)
-			copy(dAtA[i:], x.ExpectedCollateralDeposited)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedCollateralDeposited)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.ExpectedCollateralizationRatio) > 0 {
-			i -= len(x.ExpectedCollateralizationRatio)
-			copy(dAtA[i:], x.ExpectedCollateralizationRatio)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedCollateralizationRatio)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.ExpectedAmountOut) > 0 {
-			i -= len(x.ExpectedAmountOut)
-			copy(dAtA[i:], x.ExpectedAmountOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-		if len(x.Memo) > 0 {
-			i -= len(x.Memo)
-			copy(dAtA[i:], x.Memo)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
-			i--
-			dAtA[i] = 0x7a
-		}
-		if len(x.GasRateUnits) > 0 {
-			i -= len(x.GasRateUnits)
-			copy(dAtA[i:], x.GasRateUnits)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
-			i--
-			dAtA[i] = 0x72
-		}
-		if len(x.RecommendedGasRate) > 0 {
-			i -= len(x.RecommendedGasRate)
-			copy(dAtA[i:], x.RecommendedGasRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.RecommendedMinAmountIn) > 0 {
-			i -= len(x.RecommendedMinAmountIn)
-			copy(dAtA[i:], x.RecommendedMinAmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.DustThreshold) > 0 {
-			i -= len(x.DustThreshold)
-			copy(dAtA[i:], x.DustThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.Notes) > 0 {
-			i -= len(x.Notes)
-			copy(dAtA[i:], x.Notes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.Warning) > 0 {
-			i -= len(x.Warning)
-			copy(dAtA[i:], x.Warning)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if x.Expiry != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
-			i--
-			dAtA[i] = 0x40
-		}
-		if len(x.Router) > 0 {
-			i -= len(x.Router)
-			copy(dAtA[i:], x.Router)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.Fees != nil {
-			encoded, err := options.Marshal(x.Fees)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.OutboundDelaySeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.OutboundDelayBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
+		if len(x.Height) > 0 {
+			i -= len(x.Height)
+			copy(dAtA[i:], x.Height)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x22
 		}
-		if x.InboundConfirmationSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
+		if len(x.WithdrawBps) > 0 {
+			i -= len(x.WithdrawBps)
+			copy(dAtA[i:], x.WithdrawBps)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WithdrawBps)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if x.InboundConfirmationBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.InboundAddress) > 0 {
-			i -= len(x.InboundAddress)
-			copy(dAtA[i:], x.InboundAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
+		if len(x.Asset) > 0 {
+			i -= len(x.Asset)
+			copy(dAtA[i:], x.Asset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9118,7 +7367,7 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanOpenResponse)
+		x := input.Message.Interface().(*QueryQuoteSaverWithdrawRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9150,15 +7399,15 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9186,13 +7435,13 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InboundAddress = string(dAtA[iNdEx:postIndex])
+				x.Asset = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				x.InboundConfirmationBlocks = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9202,16 +7451,29 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawBps", wireType)
 				}
-				x.InboundConfirmationSeconds = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9221,215 +7483,7 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
-				}
-				x.OutboundDelayBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
-				}
-				x.OutboundDelaySeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Fees == nil {
-					x.Fees = &QuoteFees{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Router = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-				}
-				x.Expiry = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Expiry |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Warning = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Notes = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -9445,11 +7499,11 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DustThreshold = string(dAtA[iNdEx:postIndex])
+				x.WithdrawBps = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 12:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9477,303 +7531,22 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+				x.Height = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 13:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 14:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 15:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Memo = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 16:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 17:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedCollateralizationRatio", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpectedCollateralizationRatio = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 18:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedCollateralDeposited", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpectedCollateralDeposited = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 19:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedDebtIssued", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpectedDebtIssued = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 20:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapBlocks", wireType)
-				}
-				x.StreamingSwapBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.StreamingSwapBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 21:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
-				}
-				x.StreamingSwapSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.StreamingSwapSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 22:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalOpenLoanSeconds", wireType)
-				}
-				x.TotalOpenLoanSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TotalOpenLoanSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -9796,35 +7569,57 @@ func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryQuoteLoanCloseRequest            protoreflect.MessageDescriptor
-	fd_QueryQuoteLoanCloseRequest_from_asset protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseRequest_to_asset   protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseRequest_min_out    protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseRequest_repay_bps  protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseRequest_loan_owner protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseRequest_height     protoreflect.FieldDescriptor
+	md_QueryQuoteSaverWithdrawResponse                              protoreflect.MessageDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_inbound_address              protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_blocks  protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_seconds protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_blocks        protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_seconds       protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_fees                         protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_router                       protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_expiry                       protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_warning                      protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_notes                        protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_dust_threshold               protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_recommended_min_amount_in    protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_recommended_gas_rate         protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_gas_rate_units               protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_memo                         protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_dust_amount                  protoreflect.FieldDescriptor
+	fd_QueryQuoteSaverWithdrawResponse_expected_amount_out          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_quotes_proto_init()
-	md_QueryQuoteLoanCloseRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanCloseRequest")
-	fd_QueryQuoteLoanCloseRequest_from_asset = md_QueryQuoteLoanCloseRequest.Fields().ByName("from_asset")
-	fd_QueryQuoteLoanCloseRequest_to_asset = md_QueryQuoteLoanCloseRequest.Fields().ByName("to_asset")
-	fd_QueryQuoteLoanCloseRequest_min_out = md_QueryQuoteLoanCloseRequest.Fields().ByName("min_out")
-	fd_QueryQuoteLoanCloseRequest_repay_bps = md_QueryQuoteLoanCloseRequest.Fields().ByName("repay_bps")
-	fd_QueryQuoteLoanCloseRequest_loan_owner = md_QueryQuoteLoanCloseRequest.Fields().ByName("loan_owner")
-	fd_QueryQuoteLoanCloseRequest_height = md_QueryQuoteLoanCloseRequest.Fields().ByName("height")
+	md_QueryQuoteSaverWithdrawResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteSaverWithdrawResponse")
+	fd_QueryQuoteSaverWithdrawResponse_inbound_address = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("inbound_address")
+	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_blocks = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("inbound_confirmation_blocks")
+	fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_seconds = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("inbound_confirmation_seconds")
+	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_blocks = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("outbound_delay_blocks")
+	fd_QueryQuoteSaverWithdrawResponse_outbound_delay_seconds = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("outbound_delay_seconds")
+	fd_QueryQuoteSaverWithdrawResponse_fees = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("fees")
+	fd_QueryQuoteSaverWithdrawResponse_router = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("router")
+	fd_QueryQuoteSaverWithdrawResponse_expiry = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("expiry")
+	fd_QueryQuoteSaverWithdrawResponse_warning = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("warning")
+	fd_QueryQuoteSaverWithdrawResponse_notes = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("notes")
+	fd_QueryQuoteSaverWithdrawResponse_dust_threshold = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("dust_threshold")
+	fd_QueryQuoteSaverWithdrawResponse_recommended_min_amount_in = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("recommended_min_amount_in")
+	fd_QueryQuoteSaverWithdrawResponse_recommended_gas_rate = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("recommended_gas_rate")
+	fd_QueryQuoteSaverWithdrawResponse_gas_rate_units = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("gas_rate_units")
+	fd_QueryQuoteSaverWithdrawResponse_memo = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("memo")
+	fd_QueryQuoteSaverWithdrawResponse_dust_amount = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("dust_amount")
+	fd_QueryQuoteSaverWithdrawResponse_expected_amount_out = md_QueryQuoteSaverWithdrawResponse.Fields().ByName("expected_amount_out")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanCloseRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryQuoteSaverWithdrawResponse)(nil)
 
-type fastReflection_QueryQuoteLoanCloseRequest QueryQuoteLoanCloseRequest
+type fastReflection_QueryQuoteSaverWithdrawResponse QueryQuoteSaverWithdrawResponse
 
-func (x *QueryQuoteLoanCloseRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanCloseRequest)(x)
+func (x *QueryQuoteSaverWithdrawResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverWithdrawResponse)(x)
 }
 
-func (x *QueryQuoteLoanCloseRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryQuoteSaverWithdrawResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_types_query_quotes_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9836,43 +7631,43 @@ func (x *QueryQuoteLoanCloseRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryQuoteLoanCloseRequest_messageType fastReflection_QueryQuoteLoanCloseRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanCloseRequest_messageType{}
+var _fastReflection_QueryQuoteSaverWithdrawResponse_messageType fastReflection_QueryQuoteSaverWithdrawResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteSaverWithdrawResponse_messageType{}
 
-type fastReflection_QueryQuoteLoanCloseRequest_messageType struct{}
+type fastReflection_QueryQuoteSaverWithdrawResponse_messageType struct{}
 
-func (x fastReflection_QueryQuoteLoanCloseRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanCloseRequest)(nil)
+func (x fastReflection_QueryQuoteSaverWithdrawResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteSaverWithdrawResponse)(nil)
 }
-func (x fastReflection_QueryQuoteLoanCloseRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanCloseRequest)
+func (x fastReflection_QueryQuoteSaverWithdrawResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverWithdrawResponse)
 }
-func (x fastReflection_QueryQuoteLoanCloseRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanCloseRequest
+func (x fastReflection_QueryQuoteSaverWithdrawResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverWithdrawResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanCloseRequest
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteSaverWithdrawResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteLoanCloseRequest_messageType
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteSaverWithdrawResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanCloseRequest)
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteSaverWithdrawResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteLoanCloseRequest)(x)
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteSaverWithdrawResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9880,40 +7675,106 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.FromAsset != "" {
-		value := protoreflect.ValueOfString(x.FromAsset)
-		if !f(fd_QueryQuoteLoanCloseRequest_from_asset, value) {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InboundAddress != "" {
+		value := protoreflect.ValueOfString(x.InboundAddress)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_inbound_address, value) {
 			return
 		}
 	}
-	if x.ToAsset != "" {
-		value := protoreflect.ValueOfString(x.ToAsset)
-		if !f(fd_QueryQuoteLoanCloseRequest_to_asset, value) {
+	if x.InboundConfirmationBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_blocks, value) {
 			return
 		}
 	}
-	if x.MinOut != "" {
-		value := protoreflect.ValueOfString(x.MinOut)
-		if !f(fd_QueryQuoteLoanCloseRequest_min_out, value) {
+	if x.InboundConfirmationSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_inbound_confirmation_seconds, value) {
 			return
 		}
 	}
-	if x.RepayBps != "" {
-		value := protoreflect.ValueOfString(x.RepayBps)
-		if !f(fd_QueryQuoteLoanCloseRequest_repay_bps, value) {
+	if x.OutboundDelayBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_outbound_delay_blocks, value) {
 			return
 		}
 	}
-	if x.LoanOwner != "" {
-		value := protoreflect.ValueOfString(x.LoanOwner)
-		if !f(fd_QueryQuoteLoanCloseRequest_loan_owner, value) {
+	if x.OutboundDelaySeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_outbound_delay_seconds, value) {
 			return
 		}
 	}
-	if x.Height != "" {
-		value := protoreflect.ValueOfString(x.Height)
-		if !f(fd_QueryQuoteLoanCloseRequest_height, value) {
+	if x.Fees != nil {
+		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+		if !f(fd_QueryQuoteSaverWithdrawResponse_fees, value) {
+			return
+		}
+	}
+	if x.Router != "" {
+		value := protoreflect.ValueOfString(x.Router)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_router, value) {
+			return
+		}
+	}
+	if x.Expiry != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiry)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_expiry, value) {
+			return
+		}
+	}
+	if x.Warning != "" {
+		value := protoreflect.ValueOfString(x.Warning)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_warning, value) {
+			return
+		}
+	}
+	if x.Notes != "" {
+		value := protoreflect.ValueOfString(x.Notes)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_notes, value) {
+			return
+		}
+	}
+	if x.DustThreshold != "" {
+		value := protoreflect.ValueOfString(x.DustThreshold)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_dust_threshold, value) {
+			return
+		}
+	}
+	if x.RecommendedMinAmountIn != "" {
+		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_recommended_min_amount_in, value) {
+			return
+		}
+	}
+	if x.RecommendedGasRate != "" {
+		value := protoreflect.ValueOfString(x.RecommendedGasRate)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_recommended_gas_rate, value) {
+			return
+		}
+	}
+	if x.GasRateUnits != "" {
+		value := protoreflect.ValueOfString(x.GasRateUnits)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_gas_rate_units, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_memo, value) {
+			return
+		}
+	}
+	if x.DustAmount != "" {
+		value := protoreflect.ValueOfString(x.DustAmount)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_dust_amount, value) {
+			return
+		}
+	}
+	if x.ExpectedAmountOut != "" {
+		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
+		if !f(fd_QueryQuoteSaverWithdrawResponse_expected_amount_out, value) {
 			return
 		}
 	}
@@ -9930,25 +7791,47 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseRequest.from_asset":
-		return x.FromAsset != ""
-	case "types.QueryQuoteLoanCloseRequest.to_asset":
-		return x.ToAsset != ""
-	case "types.QueryQuoteLoanCloseRequest.min_out":
-		return x.MinOut != ""
-	case "types.QueryQuoteLoanCloseRequest.repay_bps":
-		return x.RepayBps != ""
-	case "types.QueryQuoteLoanCloseRequest.loan_owner":
-		return x.LoanOwner != ""
-	case "types.QueryQuoteLoanCloseRequest.height":
-		return x.Height != ""
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
+		return x.InboundAddress != ""
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
+		return x.InboundConfirmationBlocks != int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
+		return x.InboundConfirmationSeconds != int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
+		return x.OutboundDelayBlocks != int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
+		return x.OutboundDelaySeconds != int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.fees":
+		return x.Fees != nil
+	case "types.QueryQuoteSaverWithdrawResponse.router":
+		return x.Router != ""
+	case "types.QueryQuoteSaverWithdrawResponse.expiry":
+		return x.Expiry != int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.warning":
+		return x.Warning != ""
+	case "types.QueryQuoteSaverWithdrawResponse.notes":
+		return x.Notes != ""
+	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
+		return x.DustThreshold != ""
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
+		return x.RecommendedMinAmountIn != ""
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
+		return x.RecommendedGasRate != ""
+	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
+		return x.GasRateUnits != ""
+	case "types.QueryQuoteSaverWithdrawResponse.memo":
+		return x.Memo != ""
+	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
+		return x.DustAmount != ""
+	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
+		return x.ExpectedAmountOut != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9958,25 +7841,47 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseRequest.from_asset":
-		x.FromAsset = ""
-	case "types.QueryQuoteLoanCloseRequest.to_asset":
-		x.ToAsset = ""
-	case "types.QueryQuoteLoanCloseRequest.min_out":
-		x.MinOut = ""
-	case "types.QueryQuoteLoanCloseRequest.repay_bps":
-		x.RepayBps = ""
-	case "types.QueryQuoteLoanCloseRequest.loan_owner":
-		x.LoanOwner = ""
-	case "types.QueryQuoteLoanCloseRequest.height":
-		x.Height = ""
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
+		x.InboundAddress = ""
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.fees":
+		x.Fees = nil
+	case "types.QueryQuoteSaverWithdrawResponse.router":
+		x.Router = ""
+	case "types.QueryQuoteSaverWithdrawResponse.expiry":
+		x.Expiry = int64(0)
+	case "types.QueryQuoteSaverWithdrawResponse.warning":
+		x.Warning = ""
+	case "types.QueryQuoteSaverWithdrawResponse.notes":
+		x.Notes = ""
+	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
+		x.DustThreshold = ""
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = ""
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
+		x.RecommendedGasRate = ""
+	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
+		x.GasRateUnits = ""
+	case "types.QueryQuoteSaverWithdrawResponse.memo":
+		x.Memo = ""
+	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
+		x.DustAmount = ""
+	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
+		x.ExpectedAmountOut = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9986,31 +7891,64 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteLoanCloseRequest.from_asset":
-		value := x.FromAsset
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
+		value := x.InboundAddress
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseRequest.to_asset":
-		value := x.ToAsset
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
+		value := x.InboundConfirmationBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
+		value := x.InboundConfirmationSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
+		value := x.OutboundDelayBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
+		value := x.OutboundDelaySeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverWithdrawResponse.fees":
+		value := x.Fees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.QueryQuoteSaverWithdrawResponse.router":
+		value := x.Router
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseRequest.min_out":
-		value := x.MinOut
+	case "types.QueryQuoteSaverWithdrawResponse.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteSaverWithdrawResponse.warning":
+		value := x.Warning
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseRequest.repay_bps":
-		value := x.RepayBps
+	case "types.QueryQuoteSaverWithdrawResponse.notes":
+		value := x.Notes
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseRequest.loan_owner":
-		value := x.LoanOwner
+	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
+		value := x.DustThreshold
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseRequest.height":
-		value := x.Height
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
+		value := x.RecommendedMinAmountIn
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
+		value := x.RecommendedGasRate
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
+		value := x.GasRateUnits
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverWithdrawResponse.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
+		value := x.DustAmount
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
+		value := x.ExpectedAmountOut
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10024,25 +7962,47 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseRequest.from_asset":
-		x.FromAsset = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseRequest.to_asset":
-		x.ToAsset = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseRequest.min_out":
-		x.MinOut = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseRequest.repay_bps":
-		x.RepayBps = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseRequest.loan_owner":
-		x.LoanOwner = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseRequest.height":
-		x.Height = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
+		x.InboundAddress = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = value.Int()
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = value.Int()
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = value.Int()
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = value.Int()
+	case "types.QueryQuoteSaverWithdrawResponse.fees":
+		x.Fees = value.Message().Interface().(*QuoteFees)
+	case "types.QueryQuoteSaverWithdrawResponse.router":
+		x.Router = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.expiry":
+		x.Expiry = value.Int()
+	case "types.QueryQuoteSaverWithdrawResponse.warning":
+		x.Warning = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.notes":
+		x.Notes = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
+		x.DustThreshold = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
+		x.RecommendedGasRate = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
+		x.GasRateUnits = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.memo":
+		x.Memo = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
+		x.DustAmount = value.Interface().(string)
+	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
+		x.ExpectedAmountOut = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10056,60 +8016,108 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseRequest.from_asset":
-		panic(fmt.Errorf("field from_asset of message types.QueryQuoteLoanCloseRequest is not mutable"))
-	case "types.QueryQuoteLoanCloseRequest.to_asset":
-		panic(fmt.Errorf("field to_asset of message types.QueryQuoteLoanCloseRequest is not mutable"))
-	case "types.QueryQuoteLoanCloseRequest.min_out":
-		panic(fmt.Errorf("field min_out of message types.QueryQuoteLoanCloseRequest is not mutable"))
-	case "types.QueryQuoteLoanCloseRequest.repay_bps":
-		panic(fmt.Errorf("field repay_bps of message types.QueryQuoteLoanCloseRequest is not mutable"))
-	case "types.QueryQuoteLoanCloseRequest.loan_owner":
-		panic(fmt.Errorf("field loan_owner of message types.QueryQuoteLoanCloseRequest is not mutable"))
-	case "types.QueryQuoteLoanCloseRequest.height":
-		panic(fmt.Errorf("field height of message types.QueryQuoteLoanCloseRequest is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.fees":
+		if x.Fees == nil {
+			x.Fees = new(QuoteFees)
+		}
+		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
+		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
+		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
+		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
+		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
+		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.router":
+		panic(fmt.Errorf("field router of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.expiry":
+		panic(fmt.Errorf("field expiry of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.warning":
+		panic(fmt.Errorf("field warning of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.notes":
+		panic(fmt.Errorf("field notes of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
+		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
+		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
+		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
+		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.memo":
+		panic(fmt.Errorf("field memo of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
+		panic(fmt.Errorf("field dust_amount of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
+	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
+		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteSaverWithdrawResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseRequest.from_asset":
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_address":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseRequest.to_asset":
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverWithdrawResponse.inbound_confirmation_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverWithdrawResponse.outbound_delay_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverWithdrawResponse.fees":
+		m := new(QuoteFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.QueryQuoteSaverWithdrawResponse.router":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseRequest.min_out":
+	case "types.QueryQuoteSaverWithdrawResponse.expiry":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteSaverWithdrawResponse.warning":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseRequest.repay_bps":
+	case "types.QueryQuoteSaverWithdrawResponse.notes":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseRequest.loan_owner":
+	case "types.QueryQuoteSaverWithdrawResponse.dust_threshold":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseRequest.height":
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_min_amount_in":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverWithdrawResponse.recommended_gas_rate":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverWithdrawResponse.gas_rate_units":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverWithdrawResponse.memo":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverWithdrawResponse.dust_amount":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteSaverWithdrawResponse.expected_amount_out":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteSaverWithdrawResponse"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteSaverWithdrawResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanCloseRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteSaverWithdrawResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10117,7 +8125,7 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10128,7 +8136,7 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10140,7 +8148,7 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) IsValid() bool {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10150,9 +8158,9 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryQuoteSaverWithdrawResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteLoanCloseRequest)
+		x := input.Message.Interface().(*QueryQuoteSaverWithdrawResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10164,30 +8172,69 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.FromAsset)
+		l = len(x.InboundAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ToAsset)
+		if x.InboundConfirmationBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
+		}
+		if x.InboundConfirmationSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
+		}
+		if x.OutboundDelayBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
+		}
+		if x.OutboundDelaySeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
+		}
+		if x.Fees != nil {
+			l = options.Size(x.Fees)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Router)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinOut)
+		if x.Expiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiry))
+		}
+		l = len(x.Warning)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RepayBps)
+		l = len(x.Notes)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.LoanOwner)
+		l = len(x.DustThreshold)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Height)
+		l = len(x.RecommendedMinAmountIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecommendedGasRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GasRateUnits)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Memo)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.DustAmount)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedAmountOut)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10198,7 +8245,7 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanCloseRequest)
+		x := input.Message.Interface().(*QueryQuoteSaverWithdrawResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10217,45 +8264,123 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Height) > 0 {
-			i -= len(x.Height)
-			copy(dAtA[i:], x.Height)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
+		if len(x.ExpectedAmountOut) > 0 {
+			i -= len(x.ExpectedAmountOut)
+			copy(dAtA[i:], x.ExpectedAmountOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.DustAmount) > 0 {
+			i -= len(x.DustAmount)
+			copy(dAtA[i:], x.DustAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustAmount)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.GasRateUnits) > 0 {
+			i -= len(x.GasRateUnits)
+			copy(dAtA[i:], x.GasRateUnits)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.RecommendedGasRate) > 0 {
+			i -= len(x.RecommendedGasRate)
+			copy(dAtA[i:], x.RecommendedGasRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.RecommendedMinAmountIn) > 0 {
+			i -= len(x.RecommendedMinAmountIn)
+			copy(dAtA[i:], x.RecommendedMinAmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.DustThreshold) > 0 {
+			i -= len(x.DustThreshold)
+			copy(dAtA[i:], x.DustThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Notes) > 0 {
+			i -= len(x.Notes)
+			copy(dAtA[i:], x.Notes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Warning) > 0 {
+			i -= len(x.Warning)
+			copy(dAtA[i:], x.Warning)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Expiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Router) > 0 {
+			i -= len(x.Router)
+			copy(dAtA[i:], x.Router)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Fees != nil {
+			encoded, err := options.Marshal(x.Fees)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x32
 		}
-		if len(x.LoanOwner) > 0 {
-			i -= len(x.LoanOwner)
-			copy(dAtA[i:], x.LoanOwner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LoanOwner)))
+		if x.OutboundDelaySeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x28
 		}
-		if len(x.RepayBps) > 0 {
-			i -= len(x.RepayBps)
-			copy(dAtA[i:], x.RepayBps)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepayBps)))
+		if x.OutboundDelayBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x20
 		}
-		if len(x.MinOut) > 0 {
-			i -= len(x.MinOut)
-			copy(dAtA[i:], x.MinOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinOut)))
+		if x.InboundConfirmationSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.ToAsset) > 0 {
-			i -= len(x.ToAsset)
-			copy(dAtA[i:], x.ToAsset)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAsset)))
+		if x.InboundConfirmationBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.FromAsset) > 0 {
-			i -= len(x.FromAsset)
-			copy(dAtA[i:], x.FromAsset)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAsset)))
+		if len(x.InboundAddress) > 0 {
+			i -= len(x.InboundAddress)
+			copy(dAtA[i:], x.InboundAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -10270,7 +8395,7 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanCloseRequest)
+		x := input.Message.Interface().(*QueryQuoteSaverWithdrawResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10302,15 +8427,15 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteSaverWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAsset", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10338,13 +8463,13 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FromAsset = string(dAtA[iNdEx:postIndex])
+				x.InboundAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAsset", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
 				}
-				var stringLen uint64
+				x.InboundConfirmationBlocks = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10354,29 +8479,16 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ToAsset = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinOut", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
 				}
-				var stringLen uint64
+				x.InboundConfirmationSeconds = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10386,28 +8498,89 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MinOut = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepayBps", wireType)
-				}
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+				}
+				x.OutboundDelayBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+				}
+				x.OutboundDelaySeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fees == nil {
+					x.Fees = &QuoteFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
@@ -10434,11 +8607,30 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RepayBps = string(dAtA[iNdEx:postIndex])
+				x.Router = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				x.Expiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiry |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LoanOwner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10466,11 +8658,11 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LoanOwner = string(dAtA[iNdEx:postIndex])
+				x.Warning = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10498,357 +8690,533 @@ func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Height = string(dAtA[iNdEx:postIndex])
+				x.Notes = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.DustThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_QueryQuoteLoanCloseResponse                               protoreflect.MessageDescriptor
-	fd_QueryQuoteLoanCloseResponse_inbound_address               protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_blocks   protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_seconds  protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_outbound_delay_blocks         protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_outbound_delay_seconds        protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_fees                          protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_router                        protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_expiry                        protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_warning                       protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_notes                         protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_dust_threshold                protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_recommended_min_amount_in     protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_recommended_gas_rate          protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_gas_rate_units                protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_memo                          protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_expected_amount_out           protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_expected_amount_in            protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_expected_collateral_withdrawn protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_expected_debt_repaid          protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_streaming_swap_blocks         protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_streaming_swap_seconds        protoreflect.FieldDescriptor
-	fd_QueryQuoteLoanCloseResponse_total_repay_seconds           protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_types_query_quotes_proto_init()
-	md_QueryQuoteLoanCloseResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanCloseResponse")
-	fd_QueryQuoteLoanCloseResponse_inbound_address = md_QueryQuoteLoanCloseResponse.Fields().ByName("inbound_address")
-	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_blocks = md_QueryQuoteLoanCloseResponse.Fields().ByName("inbound_confirmation_blocks")
-	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("inbound_confirmation_seconds")
-	fd_QueryQuoteLoanCloseResponse_outbound_delay_blocks = md_QueryQuoteLoanCloseResponse.Fields().ByName("outbound_delay_blocks")
-	fd_QueryQuoteLoanCloseResponse_outbound_delay_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("outbound_delay_seconds")
-	fd_QueryQuoteLoanCloseResponse_fees = md_QueryQuoteLoanCloseResponse.Fields().ByName("fees")
-	fd_QueryQuoteLoanCloseResponse_router = md_QueryQuoteLoanCloseResponse.Fields().ByName("router")
-	fd_QueryQuoteLoanCloseResponse_expiry = md_QueryQuoteLoanCloseResponse.Fields().ByName("expiry")
-	fd_QueryQuoteLoanCloseResponse_warning = md_QueryQuoteLoanCloseResponse.Fields().ByName("warning")
-	fd_QueryQuoteLoanCloseResponse_notes = md_QueryQuoteLoanCloseResponse.Fields().ByName("notes")
-	fd_QueryQuoteLoanCloseResponse_dust_threshold = md_QueryQuoteLoanCloseResponse.Fields().ByName("dust_threshold")
-	fd_QueryQuoteLoanCloseResponse_recommended_min_amount_in = md_QueryQuoteLoanCloseResponse.Fields().ByName("recommended_min_amount_in")
-	fd_QueryQuoteLoanCloseResponse_recommended_gas_rate = md_QueryQuoteLoanCloseResponse.Fields().ByName("recommended_gas_rate")
-	fd_QueryQuoteLoanCloseResponse_gas_rate_units = md_QueryQuoteLoanCloseResponse.Fields().ByName("gas_rate_units")
-	fd_QueryQuoteLoanCloseResponse_memo = md_QueryQuoteLoanCloseResponse.Fields().ByName("memo")
-	fd_QueryQuoteLoanCloseResponse_expected_amount_out = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_amount_out")
-	fd_QueryQuoteLoanCloseResponse_expected_amount_in = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_amount_in")
-	fd_QueryQuoteLoanCloseResponse_expected_collateral_withdrawn = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_collateral_withdrawn")
-	fd_QueryQuoteLoanCloseResponse_expected_debt_repaid = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_debt_repaid")
-	fd_QueryQuoteLoanCloseResponse_streaming_swap_blocks = md_QueryQuoteLoanCloseResponse.Fields().ByName("streaming_swap_blocks")
-	fd_QueryQuoteLoanCloseResponse_streaming_swap_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("streaming_swap_seconds")
-	fd_QueryQuoteLoanCloseResponse_total_repay_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("total_repay_seconds")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanCloseResponse)(nil)
-
-type fastReflection_QueryQuoteLoanCloseResponse QueryQuoteLoanCloseResponse
-
-func (x *QueryQuoteLoanCloseResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanCloseResponse)(x)
-}
-
-func (x *QueryQuoteLoanCloseResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_query_quotes_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryQuoteLoanCloseResponse_messageType fastReflection_QueryQuoteLoanCloseResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanCloseResponse_messageType{}
-
-type fastReflection_QueryQuoteLoanCloseResponse_messageType struct{}
-
-func (x fastReflection_QueryQuoteLoanCloseResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryQuoteLoanCloseResponse)(nil)
-}
-func (x fastReflection_QueryQuoteLoanCloseResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanCloseResponse)
-}
-func (x fastReflection_QueryQuoteLoanCloseResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanCloseResponse
-}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DustAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryQuoteLoanCloseResponse
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryQuoteLoanCloseResponse_messageType
+var _ protoreflect.List = (*_QueryQuoteLoanOpenRequest_6_list)(nil)
+
+type _QueryQuoteLoanOpenRequest_6_list struct {
+	list *[]string
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryQuoteLoanCloseResponse)
+func (x *_QueryQuoteLoanOpenRequest_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryQuoteLoanCloseResponse)(x)
+func (x *_QueryQuoteLoanOpenRequest_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.InboundAddress != "" {
-		value := protoreflect.ValueOfString(x.InboundAddress)
-		if !f(fd_QueryQuoteLoanCloseResponse_inbound_address, value) {
-			return
-		}
+func (x *_QueryQuoteLoanOpenRequest_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryQuoteLoanOpenRequest_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryQuoteLoanOpenRequest_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteLoanOpenRequest at list field Affiliate as it is not of Message kind"))
+}
+
+func (x *_QueryQuoteLoanOpenRequest_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryQuoteLoanOpenRequest_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryQuoteLoanOpenRequest_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryQuoteLoanOpenRequest_7_list)(nil)
+
+type _QueryQuoteLoanOpenRequest_7_list struct {
+	list *[]string
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if x.InboundConfirmationBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
-		if !f(fd_QueryQuoteLoanCloseResponse_inbound_confirmation_blocks, value) {
-			return
+	return len(*x.list)
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryQuoteLoanOpenRequest at list field AffiliateBps as it is not of Message kind"))
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryQuoteLoanOpenRequest_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryQuoteLoanOpenRequest               protoreflect.MessageDescriptor
+	fd_QueryQuoteLoanOpenRequest_from_asset    protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenRequest_to_asset      protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenRequest_amount        protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenRequest_min_out       protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenRequest_destination   protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenRequest_affiliate     protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenRequest_affiliate_bps protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenRequest_height        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_quotes_proto_init()
+	md_QueryQuoteLoanOpenRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanOpenRequest")
+	fd_QueryQuoteLoanOpenRequest_from_asset = md_QueryQuoteLoanOpenRequest.Fields().ByName("from_asset")
+	fd_QueryQuoteLoanOpenRequest_to_asset = md_QueryQuoteLoanOpenRequest.Fields().ByName("to_asset")
+	fd_QueryQuoteLoanOpenRequest_amount = md_QueryQuoteLoanOpenRequest.Fields().ByName("amount")
+	fd_QueryQuoteLoanOpenRequest_min_out = md_QueryQuoteLoanOpenRequest.Fields().ByName("min_out")
+	fd_QueryQuoteLoanOpenRequest_destination = md_QueryQuoteLoanOpenRequest.Fields().ByName("destination")
+	fd_QueryQuoteLoanOpenRequest_affiliate = md_QueryQuoteLoanOpenRequest.Fields().ByName("affiliate")
+	fd_QueryQuoteLoanOpenRequest_affiliate_bps = md_QueryQuoteLoanOpenRequest.Fields().ByName("affiliate_bps")
+	fd_QueryQuoteLoanOpenRequest_height = md_QueryQuoteLoanOpenRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanOpenRequest)(nil)
+
+type fastReflection_QueryQuoteLoanOpenRequest QueryQuoteLoanOpenRequest
+
+func (x *QueryQuoteLoanOpenRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanOpenRequest)(x)
+}
+
+func (x *QueryQuoteLoanOpenRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	if x.InboundConfirmationSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
-		if !f(fd_QueryQuoteLoanCloseResponse_inbound_confirmation_seconds, value) {
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryQuoteLoanOpenRequest_messageType fastReflection_QueryQuoteLoanOpenRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanOpenRequest_messageType{}
+
+type fastReflection_QueryQuoteLoanOpenRequest_messageType struct{}
+
+func (x fastReflection_QueryQuoteLoanOpenRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanOpenRequest)(nil)
+}
+func (x fastReflection_QueryQuoteLoanOpenRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanOpenRequest)
+}
+func (x fastReflection_QueryQuoteLoanOpenRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanOpenRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanOpenRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteLoanOpenRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryQuoteLoanOpenRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanOpenRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteLoanOpenRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromAsset != "" {
+		value := protoreflect.ValueOfString(x.FromAsset)
+		if !f(fd_QueryQuoteLoanOpenRequest_from_asset, value) {
 			return
 		}
 	}
-	if x.OutboundDelayBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
-		if !f(fd_QueryQuoteLoanCloseResponse_outbound_delay_blocks, value) {
+	if x.ToAsset != "" {
+		value := protoreflect.ValueOfString(x.ToAsset)
+		if !f(fd_QueryQuoteLoanOpenRequest_to_asset, value) {
 			return
 		}
 	}
-	if x.OutboundDelaySeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
-		if !f(fd_QueryQuoteLoanCloseResponse_outbound_delay_seconds, value) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_QueryQuoteLoanOpenRequest_amount, value) {
 			return
 		}
 	}
-	if x.Fees != nil {
-		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-		if !f(fd_QueryQuoteLoanCloseResponse_fees, value) {
+	if x.MinOut != "" {
+		value := protoreflect.ValueOfString(x.MinOut)
+		if !f(fd_QueryQuoteLoanOpenRequest_min_out, value) {
 			return
 		}
 	}
-	if x.Router != "" {
-		value := protoreflect.ValueOfString(x.Router)
-		if !f(fd_QueryQuoteLoanCloseResponse_router, value) {
+	if x.Destination != "" {
+		value := protoreflect.ValueOfString(x.Destination)
+		if !f(fd_QueryQuoteLoanOpenRequest_destination, value) {
 			return
 		}
 	}
-	if x.Expiry != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Expiry)
-		if !f(fd_QueryQuoteLoanCloseResponse_expiry, value) {
+	if len(x.Affiliate) != 0 {
+		value := protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_6_list{list: &x.Affiliate})
+		if !f(fd_QueryQuoteLoanOpenRequest_affiliate, value) {
 			return
 		}
 	}
-	if x.Warning != "" {
-		value := protoreflect.ValueOfString(x.Warning)
-		if !f(fd_QueryQuoteLoanCloseResponse_warning, value) {
+	if len(x.AffiliateBps) != 0 {
+		value := protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_7_list{list: &x.AffiliateBps})
+		if !f(fd_QueryQuoteLoanOpenRequest_affiliate_bps, value) {
 			return
 		}
 	}
-	if x.Notes != "" {
-		value := protoreflect.ValueOfString(x.Notes)
-		if !f(fd_QueryQuoteLoanCloseResponse_notes, value) {
+	if x.Height != "" {
+		value := protoreflect.ValueOfString(x.Height)
+		if !f(fd_QueryQuoteLoanOpenRequest_height, value) {
 			return
 		}
 	}
-	if x.DustThreshold != "" {
-		value := protoreflect.ValueOfString(x.DustThreshold)
-		if !f(fd_QueryQuoteLoanCloseResponse_dust_threshold, value) {
-			return
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanOpenRequest.from_asset":
+		return x.FromAsset != ""
+	case "types.QueryQuoteLoanOpenRequest.to_asset":
+		return x.ToAsset != ""
+	case "types.QueryQuoteLoanOpenRequest.amount":
+		return x.Amount != ""
+	case "types.QueryQuoteLoanOpenRequest.min_out":
+		return x.MinOut != ""
+	case "types.QueryQuoteLoanOpenRequest.destination":
+		return x.Destination != ""
+	case "types.QueryQuoteLoanOpenRequest.affiliate":
+		return len(x.Affiliate) != 0
+	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
+		return len(x.AffiliateBps) != 0
+	case "types.QueryQuoteLoanOpenRequest.height":
+		return x.Height != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
 		}
-	}
-	if x.RecommendedMinAmountIn != "" {
-		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
-		if !f(fd_QueryQuoteLoanCloseResponse_recommended_min_amount_in, value) {
-			return
-		}
-	}
-	if x.RecommendedGasRate != "" {
-		value := protoreflect.ValueOfString(x.RecommendedGasRate)
-		if !f(fd_QueryQuoteLoanCloseResponse_recommended_gas_rate, value) {
-			return
-		}
-	}
-	if x.GasRateUnits != "" {
-		value := protoreflect.ValueOfString(x.GasRateUnits)
-		if !f(fd_QueryQuoteLoanCloseResponse_gas_rate_units, value) {
-			return
-		}
-	}
-	if x.Memo != "" {
-		value := protoreflect.ValueOfString(x.Memo)
-		if !f(fd_QueryQuoteLoanCloseResponse_memo, value) {
-			return
-		}
-	}
-	if x.ExpectedAmountOut != "" {
-		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
-		if !f(fd_QueryQuoteLoanCloseResponse_expected_amount_out, value) {
-			return
-		}
-	}
-	if x.ExpectedAmountIn != "" {
-		value := protoreflect.ValueOfString(x.ExpectedAmountIn)
-		if !f(fd_QueryQuoteLoanCloseResponse_expected_amount_in, value) {
-			return
-		}
-	}
-	if x.ExpectedCollateralWithdrawn != "" {
-		value := protoreflect.ValueOfString(x.ExpectedCollateralWithdrawn)
-		if !f(fd_QueryQuoteLoanCloseResponse_expected_collateral_withdrawn, value) {
-			return
-		}
-	}
-	if x.ExpectedDebtRepaid != "" {
-		value := protoreflect.ValueOfString(x.ExpectedDebtRepaid)
-		if !f(fd_QueryQuoteLoanCloseResponse_expected_debt_repaid, value) {
-			return
-		}
-	}
-	if x.StreamingSwapBlocks != int64(0) {
-		value := protoreflect.ValueOfInt64(x.StreamingSwapBlocks)
-		if !f(fd_QueryQuoteLoanCloseResponse_streaming_swap_blocks, value) {
-			return
-		}
-	}
-	if x.StreamingSwapSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.StreamingSwapSeconds)
-		if !f(fd_QueryQuoteLoanCloseResponse_streaming_swap_seconds, value) {
-			return
-		}
-	}
-	if x.TotalRepaySeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TotalRepaySeconds)
-		if !f(fd_QueryQuoteLoanCloseResponse_total_repay_seconds, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseResponse.inbound_address":
-		return x.InboundAddress != ""
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
-		return x.InboundConfirmationBlocks != int64(0)
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
-		return x.InboundConfirmationSeconds != int64(0)
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
-		return x.OutboundDelayBlocks != int64(0)
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
-		return x.OutboundDelaySeconds != int64(0)
-	case "types.QueryQuoteLoanCloseResponse.fees":
-		return x.Fees != nil
-	case "types.QueryQuoteLoanCloseResponse.router":
-		return x.Router != ""
-	case "types.QueryQuoteLoanCloseResponse.expiry":
-		return x.Expiry != int64(0)
-	case "types.QueryQuoteLoanCloseResponse.warning":
-		return x.Warning != ""
-	case "types.QueryQuoteLoanCloseResponse.notes":
-		return x.Notes != ""
-	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
-		return x.DustThreshold != ""
-	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
-		return x.RecommendedMinAmountIn != ""
-	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
-		return x.RecommendedGasRate != ""
-	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
-		return x.GasRateUnits != ""
-	case "types.QueryQuoteLoanCloseResponse.memo":
-		return x.Memo != ""
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
-		return x.ExpectedAmountOut != ""
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
-		return x.ExpectedAmountIn != ""
-	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
-		return x.ExpectedCollateralWithdrawn != ""
-	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
-		return x.ExpectedDebtRepaid != ""
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
-		return x.StreamingSwapBlocks != int64(0)
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
-		return x.StreamingSwapSeconds != int64(0)
-	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
-		return x.TotalRepaySeconds != int64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
-		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10858,57 +9226,29 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseResponse.inbound_address":
-		x.InboundAddress = ""
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = int64(0)
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = int64(0)
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = int64(0)
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = int64(0)
-	case "types.QueryQuoteLoanCloseResponse.fees":
-		x.Fees = nil
-	case "types.QueryQuoteLoanCloseResponse.router":
-		x.Router = ""
-	case "types.QueryQuoteLoanCloseResponse.expiry":
-		x.Expiry = int64(0)
-	case "types.QueryQuoteLoanCloseResponse.warning":
-		x.Warning = ""
-	case "types.QueryQuoteLoanCloseResponse.notes":
-		x.Notes = ""
-	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
-		x.DustThreshold = ""
-	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = ""
-	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
-		x.RecommendedGasRate = ""
-	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
-		x.GasRateUnits = ""
-	case "types.QueryQuoteLoanCloseResponse.memo":
-		x.Memo = ""
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
-		x.ExpectedAmountOut = ""
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
-		x.ExpectedAmountIn = ""
-	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
-		x.ExpectedCollateralWithdrawn = ""
-	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
-		x.ExpectedDebtRepaid = ""
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
-		x.StreamingSwapBlocks = int64(0)
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
-		x.StreamingSwapSeconds = int64(0)
-	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
-		x.TotalRepaySeconds = int64(0)
+	case "types.QueryQuoteLoanOpenRequest.from_asset":
+		x.FromAsset = ""
+	case "types.QueryQuoteLoanOpenRequest.to_asset":
+		x.ToAsset = ""
+	case "types.QueryQuoteLoanOpenRequest.amount":
+		x.Amount = ""
+	case "types.QueryQuoteLoanOpenRequest.min_out":
+		x.MinOut = ""
+	case "types.QueryQuoteLoanOpenRequest.destination":
+		x.Destination = ""
+	case "types.QueryQuoteLoanOpenRequest.affiliate":
+		x.Affiliate = nil
+	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
+		x.AffiliateBps = nil
+	case "types.QueryQuoteLoanOpenRequest.height":
+		x.Height = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10918,79 +9258,43 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QueryQuoteLoanCloseResponse.inbound_address":
-		value := x.InboundAddress
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
-		value := x.InboundConfirmationBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
-		value := x.InboundConfirmationSeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
-		value := x.OutboundDelayBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
-		value := x.OutboundDelaySeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanCloseResponse.fees":
-		value := x.Fees
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "types.QueryQuoteLoanCloseResponse.router":
-		value := x.Router
+	case "types.QueryQuoteLoanOpenRequest.from_asset":
+		value := x.FromAsset
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.expiry":
-		value := x.Expiry
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanCloseResponse.warning":
-		value := x.Warning
+	case "types.QueryQuoteLoanOpenRequest.to_asset":
+		value := x.ToAsset
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.notes":
-		value := x.Notes
+	case "types.QueryQuoteLoanOpenRequest.amount":
+		value := x.Amount
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
-		value := x.DustThreshold
+	case "types.QueryQuoteLoanOpenRequest.min_out":
+		value := x.MinOut
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
-		value := x.RecommendedMinAmountIn
+	case "types.QueryQuoteLoanOpenRequest.destination":
+		value := x.Destination
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
-		value := x.RecommendedGasRate
+	case "types.QueryQuoteLoanOpenRequest.affiliate":
+		if len(x.Affiliate) == 0 {
+			return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_6_list{})
+		}
+		listValue := &_QueryQuoteLoanOpenRequest_6_list{list: &x.Affiliate}
+		return protoreflect.ValueOfList(listValue)
+	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
+		if len(x.AffiliateBps) == 0 {
+			return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_7_list{})
+		}
+		listValue := &_QueryQuoteLoanOpenRequest_7_list{list: &x.AffiliateBps}
+		return protoreflect.ValueOfList(listValue)
+	case "types.QueryQuoteLoanOpenRequest.height":
+		value := x.Height
 		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
-		value := x.GasRateUnits
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.memo":
-		value := x.Memo
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
-		value := x.ExpectedAmountOut
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
-		value := x.ExpectedAmountIn
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
-		value := x.ExpectedCollateralWithdrawn
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
-		value := x.ExpectedDebtRepaid
-		return protoreflect.ValueOfString(value)
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
-		value := x.StreamingSwapBlocks
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
-		value := x.StreamingSwapSeconds
-		return protoreflect.ValueOfInt64(value)
-	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
-		value := x.TotalRepaySeconds
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11004,57 +9308,33 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseResponse.inbound_address":
-		x.InboundAddress = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
-		x.InboundConfirmationBlocks = value.Int()
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
-		x.InboundConfirmationSeconds = value.Int()
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
-		x.OutboundDelayBlocks = value.Int()
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
-		x.OutboundDelaySeconds = value.Int()
-	case "types.QueryQuoteLoanCloseResponse.fees":
-		x.Fees = value.Message().Interface().(*QuoteFees)
-	case "types.QueryQuoteLoanCloseResponse.router":
-		x.Router = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.expiry":
-		x.Expiry = value.Int()
-	case "types.QueryQuoteLoanCloseResponse.warning":
-		x.Warning = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.notes":
-		x.Notes = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
-		x.DustThreshold = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
-		x.RecommendedMinAmountIn = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
-		x.RecommendedGasRate = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
-		x.GasRateUnits = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.memo":
-		x.Memo = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
-		x.ExpectedAmountOut = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
-		x.ExpectedAmountIn = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
-		x.ExpectedCollateralWithdrawn = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
-		x.ExpectedDebtRepaid = value.Interface().(string)
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
-		x.StreamingSwapBlocks = value.Int()
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
-		x.StreamingSwapSeconds = value.Int()
-	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
-		x.TotalRepaySeconds = value.Int()
+	case "types.QueryQuoteLoanOpenRequest.from_asset":
+		x.FromAsset = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenRequest.to_asset":
+		x.ToAsset = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenRequest.amount":
+		x.Amount = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenRequest.min_out":
+		x.MinOut = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenRequest.destination":
+		x.Destination = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenRequest.affiliate":
+		lv := value.List()
+		clv := lv.(*_QueryQuoteLoanOpenRequest_6_list)
+		x.Affiliate = *clv.list
+	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
+		lv := value.List()
+		clv := lv.(*_QueryQuoteLoanOpenRequest_7_list)
+		x.AffiliateBps = *clv.list
+	case "types.QueryQuoteLoanOpenRequest.height":
+		x.Height = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11068,128 +9348,78 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseResponse.fees":
-		if x.Fees == nil {
-			x.Fees = new(QuoteFees)
+	case "types.QueryQuoteLoanOpenRequest.affiliate":
+		if x.Affiliate == nil {
+			x.Affiliate = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
-	case "types.QueryQuoteLoanCloseResponse.inbound_address":
-		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
-		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
-		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
-		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
-		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.router":
-		panic(fmt.Errorf("field router of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.expiry":
-		panic(fmt.Errorf("field expiry of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.warning":
-		panic(fmt.Errorf("field warning of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.notes":
-		panic(fmt.Errorf("field notes of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
-		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
-		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
-		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
-		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.memo":
-		panic(fmt.Errorf("field memo of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
-		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
-		panic(fmt.Errorf("field expected_amount_in of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
-		panic(fmt.Errorf("field expected_collateral_withdrawn of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
-		panic(fmt.Errorf("field expected_debt_repaid of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
-		panic(fmt.Errorf("field streaming_swap_blocks of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
-		panic(fmt.Errorf("field streaming_swap_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
-	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
-		panic(fmt.Errorf("field total_repay_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
+		value := &_QueryQuoteLoanOpenRequest_6_list{list: &x.Affiliate}
+		return protoreflect.ValueOfList(value)
+	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
+		if x.AffiliateBps == nil {
+			x.AffiliateBps = []string{}
+		}
+		value := &_QueryQuoteLoanOpenRequest_7_list{list: &x.AffiliateBps}
+		return protoreflect.ValueOfList(value)
+	case "types.QueryQuoteLoanOpenRequest.from_asset":
+		panic(fmt.Errorf("field from_asset of message types.QueryQuoteLoanOpenRequest is not mutable"))
+	case "types.QueryQuoteLoanOpenRequest.to_asset":
+		panic(fmt.Errorf("field to_asset of message types.QueryQuoteLoanOpenRequest is not mutable"))
+	case "types.QueryQuoteLoanOpenRequest.amount":
+		panic(fmt.Errorf("field amount of message types.QueryQuoteLoanOpenRequest is not mutable"))
+	case "types.QueryQuoteLoanOpenRequest.min_out":
+		panic(fmt.Errorf("field min_out of message types.QueryQuoteLoanOpenRequest is not mutable"))
+	case "types.QueryQuoteLoanOpenRequest.destination":
+		panic(fmt.Errorf("field destination of message types.QueryQuoteLoanOpenRequest is not mutable"))
+	case "types.QueryQuoteLoanOpenRequest.height":
+		panic(fmt.Errorf("field height of message types.QueryQuoteLoanOpenRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QueryQuoteLoanCloseResponse.inbound_address":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanCloseResponse.fees":
-		m := new(QuoteFees)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "types.QueryQuoteLoanCloseResponse.router":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.expiry":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanCloseResponse.warning":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.notes":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
-		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
+	case "types.QueryQuoteLoanOpenRequest.from_asset":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.memo":
+	case "types.QueryQuoteLoanOpenRequest.to_asset":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
+	case "types.QueryQuoteLoanOpenRequest.amount":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
+	case "types.QueryQuoteLoanOpenRequest.min_out":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
+	case "types.QueryQuoteLoanOpenRequest.destination":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
+	case "types.QueryQuoteLoanOpenRequest.affiliate":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_6_list{list: &list})
+	case "types.QueryQuoteLoanOpenRequest.affiliate_bps":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryQuoteLoanOpenRequest_7_list{list: &list})
+	case "types.QueryQuoteLoanOpenRequest.height":
 		return protoreflect.ValueOfString("")
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenRequest"))
 		}
-		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanCloseResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanOpenRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11197,7 +9427,7 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11208,7 +9438,7 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11220,7 +9450,7 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) IsValid() bool {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -11230,9 +9460,9 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryQuoteLoanOpenRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryQuoteLoanCloseResponse)
+		x := input.Message.Interface().(*QueryQuoteLoanOpenRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11244,86 +9474,42 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		l = len(x.InboundAddress)
+		l = len(x.FromAsset)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.InboundConfirmationBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
-		}
-		if x.InboundConfirmationSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
+		l = len(x.ToAsset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.OutboundDelayBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
-		}
-		if x.OutboundDelaySeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
-		}
-		if x.Fees != nil {
-			l = options.Size(x.Fees)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Router)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Expiry != 0 {
-			n += 1 + runtime.Sov(uint64(x.Expiry))
-		}
-		l = len(x.Warning)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Notes)
+		l = len(x.Amount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DustThreshold)
+		l = len(x.MinOut)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RecommendedMinAmountIn)
+		l = len(x.Destination)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RecommendedGasRate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Affiliate) > 0 {
+			for _, s := range x.Affiliate {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.GasRateUnits)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.AffiliateBps) > 0 {
+			for _, s := range x.AffiliateBps {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Memo)
+		l = len(x.Height)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ExpectedAmountOut)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedAmountIn)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedCollateralWithdrawn)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpectedDebtRepaid)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.StreamingSwapBlocks != 0 {
-			n += 2 + runtime.Sov(uint64(x.StreamingSwapBlocks))
-		}
-		if x.StreamingSwapSeconds != 0 {
-			n += 2 + runtime.Sov(uint64(x.StreamingSwapSeconds))
-		}
-		if x.TotalRepaySeconds != 0 {
-			n += 2 + runtime.Sov(uint64(x.TotalRepaySeconds))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11334,7 +9520,7 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanCloseResponse)
+		x := input.Message.Interface().(*QueryQuoteLoanOpenRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11353,182 +9539,83 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TotalRepaySeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalRepaySeconds))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xb0
-		}
-		if x.StreamingSwapSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapSeconds))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa8
-		}
-		if x.StreamingSwapBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapBlocks))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa0
-		}
-		if len(x.ExpectedDebtRepaid) > 0 {
-			i -= len(x.ExpectedDebtRepaid)
-			copy(dAtA[i:], x.ExpectedDebtRepaid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedDebtRepaid)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
-		if len(x.ExpectedCollateralWithdrawn) > 0 {
-			i -= len(x.ExpectedCollateralWithdrawn)
-			copy(dAtA[i:], x.ExpectedCollateralWithdrawn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedCollateralWithdrawn)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.ExpectedAmountIn) > 0 {
-			i -= len(x.ExpectedAmountIn)
-			copy(dAtA[i:], x.ExpectedAmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountIn)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.ExpectedAmountOut) > 0 {
-			i -= len(x.ExpectedAmountOut)
-			copy(dAtA[i:], x.ExpectedAmountOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-		if len(x.Memo) > 0 {
-			i -= len(x.Memo)
-			copy(dAtA[i:], x.Memo)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
-			i--
-			dAtA[i] = 0x7a
-		}
-		if len(x.GasRateUnits) > 0 {
-			i -= len(x.GasRateUnits)
-			copy(dAtA[i:], x.GasRateUnits)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
+		if len(x.Height) > 0 {
+			i -= len(x.Height)
+			copy(dAtA[i:], x.Height)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0x42
 		}
-		if len(x.RecommendedGasRate) > 0 {
-			i -= len(x.RecommendedGasRate)
-			copy(dAtA[i:], x.RecommendedGasRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
-			i--
-			dAtA[i] = 0x6a
+		if len(x.AffiliateBps) > 0 {
+			for iNdEx := len(x.AffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AffiliateBps[iNdEx])
+				copy(dAtA[i:], x.AffiliateBps[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AffiliateBps[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
 		}
-		if len(x.RecommendedMinAmountIn) > 0 {
-			i -= len(x.RecommendedMinAmountIn)
-			copy(dAtA[i:], x.RecommendedMinAmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
-			i--
-			dAtA[i] = 0x62
+		if len(x.Affiliate) > 0 {
+			for iNdEx := len(x.Affiliate) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Affiliate[iNdEx])
+				copy(dAtA[i:], x.Affiliate[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Affiliate[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
 		}
-		if len(x.DustThreshold) > 0 {
-			i -= len(x.DustThreshold)
-			copy(dAtA[i:], x.DustThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
+		if len(x.Destination) > 0 {
+			i -= len(x.Destination)
+			copy(dAtA[i:], x.Destination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x2a
 		}
-		if len(x.Notes) > 0 {
-			i -= len(x.Notes)
-			copy(dAtA[i:], x.Notes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
+		if len(x.MinOut) > 0 {
+			i -= len(x.MinOut)
+			copy(dAtA[i:], x.MinOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinOut)))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x22
 		}
-		if len(x.Warning) > 0 {
-			i -= len(x.Warning)
-			copy(dAtA[i:], x.Warning)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1a
 		}
-		if x.Expiry != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+		if len(x.ToAsset) > 0 {
+			i -= len(x.ToAsset)
+			copy(dAtA[i:], x.ToAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAsset)))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x12
 		}
-		if len(x.Router) > 0 {
-			i -= len(x.Router)
-			copy(dAtA[i:], x.Router)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
+		if len(x.FromAsset) > 0 {
+			i -= len(x.FromAsset)
+			copy(dAtA[i:], x.FromAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAsset)))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0xa
 		}
-		if x.Fees != nil {
-			encoded, err := options.Marshal(x.Fees)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if x.OutboundDelaySeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.OutboundDelayBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.InboundConfirmationSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.InboundConfirmationBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.InboundAddress) > 0 {
-			i -= len(x.InboundAddress)
-			copy(dAtA[i:], x.InboundAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryQuoteLoanCloseResponse)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteLoanOpenRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -11555,15 +9642,15 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAsset", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11591,89 +9678,13 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InboundAddress = string(dAtA[iNdEx:postIndex])
+				x.FromAsset = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
-				}
-				x.InboundConfirmationBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
-				}
-				x.InboundConfirmationSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
-				}
-				x.OutboundDelayBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
-				}
-				x.OutboundDelaySeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAsset", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11683,31 +9694,27 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Fees == nil {
-					x.Fees = &QuoteFees{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ToAsset = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11735,30 +9742,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Router = string(dAtA[iNdEx:postIndex])
+				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-				}
-				x.Expiry = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Expiry |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinOut", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11786,11 +9774,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Warning = string(dAtA[iNdEx:postIndex])
+				x.MinOut = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 10:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11818,11 +9806,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Notes = string(dAtA[iNdEx:postIndex])
+				x.Destination = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11850,11 +9838,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DustThreshold = string(dAtA[iNdEx:postIndex])
+				x.Affiliate = append(x.Affiliate, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 12:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11882,11 +9870,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+				x.AffiliateBps = append(x.AffiliateBps, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 13:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11911,46 +9899,4658 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Height = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryQuoteLoanOpenResponse                                  protoreflect.MessageDescriptor
+	fd_QueryQuoteLoanOpenResponse_inbound_address                  protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_blocks      protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_seconds     protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_outbound_delay_blocks            protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_outbound_delay_seconds           protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_fees                             protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_router                           protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_expiry                           protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_warning                          protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_notes                            protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_dust_threshold                   protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_recommended_min_amount_in        protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_recommended_gas_rate             protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_gas_rate_units                   protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_memo                             protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_expected_amount_out              protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_expected_collateralization_ratio protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_expected_collateral_deposited    protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_expected_debt_issued             protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_streaming_swap_blocks            protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_streaming_swap_seconds           protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanOpenResponse_total_open_loan_seconds          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_quotes_proto_init()
+	md_QueryQuoteLoanOpenResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanOpenResponse")
+	fd_QueryQuoteLoanOpenResponse_inbound_address = md_QueryQuoteLoanOpenResponse.Fields().ByName("inbound_address")
+	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_blocks = md_QueryQuoteLoanOpenResponse.Fields().ByName("inbound_confirmation_blocks")
+	fd_QueryQuoteLoanOpenResponse_inbound_confirmation_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("inbound_confirmation_seconds")
+	fd_QueryQuoteLoanOpenResponse_outbound_delay_blocks = md_QueryQuoteLoanOpenResponse.Fields().ByName("outbound_delay_blocks")
+	fd_QueryQuoteLoanOpenResponse_outbound_delay_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("outbound_delay_seconds")
+	fd_QueryQuoteLoanOpenResponse_fees = md_QueryQuoteLoanOpenResponse.Fields().ByName("fees")
+	fd_QueryQuoteLoanOpenResponse_router = md_QueryQuoteLoanOpenResponse.Fields().ByName("router")
+	fd_QueryQuoteLoanOpenResponse_expiry = md_QueryQuoteLoanOpenResponse.Fields().ByName("expiry")
+	fd_QueryQuoteLoanOpenResponse_warning = md_QueryQuoteLoanOpenResponse.Fields().ByName("warning")
+	fd_QueryQuoteLoanOpenResponse_notes = md_QueryQuoteLoanOpenResponse.Fields().ByName("notes")
+	fd_QueryQuoteLoanOpenResponse_dust_threshold = md_QueryQuoteLoanOpenResponse.Fields().ByName("dust_threshold")
+	fd_QueryQuoteLoanOpenResponse_recommended_min_amount_in = md_QueryQuoteLoanOpenResponse.Fields().ByName("recommended_min_amount_in")
+	fd_QueryQuoteLoanOpenResponse_recommended_gas_rate = md_QueryQuoteLoanOpenResponse.Fields().ByName("recommended_gas_rate")
+	fd_QueryQuoteLoanOpenResponse_gas_rate_units = md_QueryQuoteLoanOpenResponse.Fields().ByName("gas_rate_units")
+	fd_QueryQuoteLoanOpenResponse_memo = md_QueryQuoteLoanOpenResponse.Fields().ByName("memo")
+	fd_QueryQuoteLoanOpenResponse_expected_amount_out = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_amount_out")
+	fd_QueryQuoteLoanOpenResponse_expected_collateralization_ratio = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_collateralization_ratio")
+	fd_QueryQuoteLoanOpenResponse_expected_collateral_deposited = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_collateral_deposited")
+	fd_QueryQuoteLoanOpenResponse_expected_debt_issued = md_QueryQuoteLoanOpenResponse.Fields().ByName("expected_debt_issued")
+	fd_QueryQuoteLoanOpenResponse_streaming_swap_blocks = md_QueryQuoteLoanOpenResponse.Fields().ByName("streaming_swap_blocks")
+	fd_QueryQuoteLoanOpenResponse_streaming_swap_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("streaming_swap_seconds")
+	fd_QueryQuoteLoanOpenResponse_total_open_loan_seconds = md_QueryQuoteLoanOpenResponse.Fields().ByName("total_open_loan_seconds")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanOpenResponse)(nil)
+
+type fastReflection_QueryQuoteLoanOpenResponse QueryQuoteLoanOpenResponse
+
+func (x *QueryQuoteLoanOpenResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanOpenResponse)(x)
+}
+
+func (x *QueryQuoteLoanOpenResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryQuoteLoanOpenResponse_messageType fastReflection_QueryQuoteLoanOpenResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanOpenResponse_messageType{}
+
+type fastReflection_QueryQuoteLoanOpenResponse_messageType struct{}
+
+func (x fastReflection_QueryQuoteLoanOpenResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanOpenResponse)(nil)
+}
+func (x fastReflection_QueryQuoteLoanOpenResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanOpenResponse)
+}
+func (x fastReflection_QueryQuoteLoanOpenResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanOpenResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanOpenResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteLoanOpenResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanOpenResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteLoanOpenResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InboundAddress != "" {
+		value := protoreflect.ValueOfString(x.InboundAddress)
+		if !f(fd_QueryQuoteLoanOpenResponse_inbound_address, value) {
+			return
+		}
+	}
+	if x.InboundConfirmationBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
+		if !f(fd_QueryQuoteLoanOpenResponse_inbound_confirmation_blocks, value) {
+			return
+		}
+	}
+	if x.InboundConfirmationSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
+		if !f(fd_QueryQuoteLoanOpenResponse_inbound_confirmation_seconds, value) {
+			return
+		}
+	}
+	if x.OutboundDelayBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
+		if !f(fd_QueryQuoteLoanOpenResponse_outbound_delay_blocks, value) {
+			return
+		}
+	}
+	if x.OutboundDelaySeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
+		if !f(fd_QueryQuoteLoanOpenResponse_outbound_delay_seconds, value) {
+			return
+		}
+	}
+	if x.Fees != nil {
+		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+		if !f(fd_QueryQuoteLoanOpenResponse_fees, value) {
+			return
+		}
+	}
+	if x.Router != "" {
+		value := protoreflect.ValueOfString(x.Router)
+		if !f(fd_QueryQuoteLoanOpenResponse_router, value) {
+			return
+		}
+	}
+	if x.Expiry != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiry)
+		if !f(fd_QueryQuoteLoanOpenResponse_expiry, value) {
+			return
+		}
+	}
+	if x.Warning != "" {
+		value := protoreflect.ValueOfString(x.Warning)
+		if !f(fd_QueryQuoteLoanOpenResponse_warning, value) {
+			return
+		}
+	}
+	if x.Notes != "" {
+		value := protoreflect.ValueOfString(x.Notes)
+		if !f(fd_QueryQuoteLoanOpenResponse_notes, value) {
+			return
+		}
+	}
+	if x.DustThreshold != "" {
+		value := protoreflect.ValueOfString(x.DustThreshold)
+		if !f(fd_QueryQuoteLoanOpenResponse_dust_threshold, value) {
+			return
+		}
+	}
+	if x.RecommendedMinAmountIn != "" {
+		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
+		if !f(fd_QueryQuoteLoanOpenResponse_recommended_min_amount_in, value) {
+			return
+		}
+	}
+	if x.RecommendedGasRate != "" {
+		value := protoreflect.ValueOfString(x.RecommendedGasRate)
+		if !f(fd_QueryQuoteLoanOpenResponse_recommended_gas_rate, value) {
+			return
+		}
+	}
+	if x.GasRateUnits != "" {
+		value := protoreflect.ValueOfString(x.GasRateUnits)
+		if !f(fd_QueryQuoteLoanOpenResponse_gas_rate_units, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_QueryQuoteLoanOpenResponse_memo, value) {
+			return
+		}
+	}
+	if x.ExpectedAmountOut != "" {
+		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
+		if !f(fd_QueryQuoteLoanOpenResponse_expected_amount_out, value) {
+			return
+		}
+	}
+	if x.ExpectedCollateralizationRatio != "" {
+		value := protoreflect.ValueOfString(x.ExpectedCollateralizationRatio)
+		if !f(fd_QueryQuoteLoanOpenResponse_expected_collateralization_ratio, value) {
+			return
+		}
+	}
+	if x.ExpectedCollateralDeposited != "" {
+		value := protoreflect.ValueOfString(x.ExpectedCollateralDeposited)
+		if !f(fd_QueryQuoteLoanOpenResponse_expected_collateral_deposited, value) {
+			return
+		}
+	}
+	if x.ExpectedDebtIssued != "" {
+		value := protoreflect.ValueOfString(x.ExpectedDebtIssued)
+		if !f(fd_QueryQuoteLoanOpenResponse_expected_debt_issued, value) {
+			return
+		}
+	}
+	if x.StreamingSwapBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StreamingSwapBlocks)
+		if !f(fd_QueryQuoteLoanOpenResponse_streaming_swap_blocks, value) {
+			return
+		}
+	}
+	if x.StreamingSwapSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StreamingSwapSeconds)
+		if !f(fd_QueryQuoteLoanOpenResponse_streaming_swap_seconds, value) {
+			return
+		}
+	}
+	if x.TotalOpenLoanSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalOpenLoanSeconds)
+		if !f(fd_QueryQuoteLoanOpenResponse_total_open_loan_seconds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanOpenResponse.inbound_address":
+		return x.InboundAddress != ""
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
+		return x.InboundConfirmationBlocks != int64(0)
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
+		return x.InboundConfirmationSeconds != int64(0)
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
+		return x.OutboundDelayBlocks != int64(0)
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
+		return x.OutboundDelaySeconds != int64(0)
+	case "types.QueryQuoteLoanOpenResponse.fees":
+		return x.Fees != nil
+	case "types.QueryQuoteLoanOpenResponse.router":
+		return x.Router != ""
+	case "types.QueryQuoteLoanOpenResponse.expiry":
+		return x.Expiry != int64(0)
+	case "types.QueryQuoteLoanOpenResponse.warning":
+		return x.Warning != ""
+	case "types.QueryQuoteLoanOpenResponse.notes":
+		return x.Notes != ""
+	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
+		return x.DustThreshold != ""
+	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
+		return x.RecommendedMinAmountIn != ""
+	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
+		return x.RecommendedGasRate != ""
+	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
+		return x.GasRateUnits != ""
+	case "types.QueryQuoteLoanOpenResponse.memo":
+		return x.Memo != ""
+	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
+		return x.ExpectedAmountOut != ""
+	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
+		return x.ExpectedCollateralizationRatio != ""
+	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
+		return x.ExpectedCollateralDeposited != ""
+	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
+		return x.ExpectedDebtIssued != ""
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
+		return x.StreamingSwapBlocks != int64(0)
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
+		return x.StreamingSwapSeconds != int64(0)
+	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
+		return x.TotalOpenLoanSeconds != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanOpenResponse.inbound_address":
+		x.InboundAddress = ""
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = int64(0)
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = int64(0)
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = int64(0)
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = int64(0)
+	case "types.QueryQuoteLoanOpenResponse.fees":
+		x.Fees = nil
+	case "types.QueryQuoteLoanOpenResponse.router":
+		x.Router = ""
+	case "types.QueryQuoteLoanOpenResponse.expiry":
+		x.Expiry = int64(0)
+	case "types.QueryQuoteLoanOpenResponse.warning":
+		x.Warning = ""
+	case "types.QueryQuoteLoanOpenResponse.notes":
+		x.Notes = ""
+	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
+		x.DustThreshold = ""
+	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = ""
+	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
+		x.RecommendedGasRate = ""
+	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
+		x.GasRateUnits = ""
+	case "types.QueryQuoteLoanOpenResponse.memo":
+		x.Memo = ""
+	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
+		x.ExpectedAmountOut = ""
+	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
+		x.ExpectedCollateralizationRatio = ""
+	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
+		x.ExpectedCollateralDeposited = ""
+	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
+		x.ExpectedDebtIssued = ""
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
+		x.StreamingSwapBlocks = int64(0)
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
+		x.StreamingSwapSeconds = int64(0)
+	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
+		x.TotalOpenLoanSeconds = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryQuoteLoanOpenResponse.inbound_address":
+		value := x.InboundAddress
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
+		value := x.InboundConfirmationBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
+		value := x.InboundConfirmationSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
+		value := x.OutboundDelayBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
+		value := x.OutboundDelaySeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanOpenResponse.fees":
+		value := x.Fees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.QueryQuoteLoanOpenResponse.router":
+		value := x.Router
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanOpenResponse.warning":
+		value := x.Warning
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.notes":
+		value := x.Notes
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
+		value := x.DustThreshold
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
+		value := x.RecommendedMinAmountIn
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
+		value := x.RecommendedGasRate
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
+		value := x.GasRateUnits
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
+		value := x.ExpectedAmountOut
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
+		value := x.ExpectedCollateralizationRatio
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
+		value := x.ExpectedCollateralDeposited
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
+		value := x.ExpectedDebtIssued
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
+		value := x.StreamingSwapBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
+		value := x.StreamingSwapSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
+		value := x.TotalOpenLoanSeconds
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanOpenResponse.inbound_address":
+		x.InboundAddress = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = value.Int()
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = value.Int()
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = value.Int()
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = value.Int()
+	case "types.QueryQuoteLoanOpenResponse.fees":
+		x.Fees = value.Message().Interface().(*QuoteFees)
+	case "types.QueryQuoteLoanOpenResponse.router":
+		x.Router = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.expiry":
+		x.Expiry = value.Int()
+	case "types.QueryQuoteLoanOpenResponse.warning":
+		x.Warning = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.notes":
+		x.Notes = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
+		x.DustThreshold = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
+		x.RecommendedGasRate = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
+		x.GasRateUnits = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.memo":
+		x.Memo = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
+		x.ExpectedAmountOut = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
+		x.ExpectedCollateralizationRatio = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
+		x.ExpectedCollateralDeposited = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
+		x.ExpectedDebtIssued = value.Interface().(string)
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
+		x.StreamingSwapBlocks = value.Int()
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
+		x.StreamingSwapSeconds = value.Int()
+	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
+		x.TotalOpenLoanSeconds = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanOpenResponse.fees":
+		if x.Fees == nil {
+			x.Fees = new(QuoteFees)
+		}
+		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+	case "types.QueryQuoteLoanOpenResponse.inbound_address":
+		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
+		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
+		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
+		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
+		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.router":
+		panic(fmt.Errorf("field router of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.expiry":
+		panic(fmt.Errorf("field expiry of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.warning":
+		panic(fmt.Errorf("field warning of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.notes":
+		panic(fmt.Errorf("field notes of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
+		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
+		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
+		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
+		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.memo":
+		panic(fmt.Errorf("field memo of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
+		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
+		panic(fmt.Errorf("field expected_collateralization_ratio of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
+		panic(fmt.Errorf("field expected_collateral_deposited of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
+		panic(fmt.Errorf("field expected_debt_issued of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
+		panic(fmt.Errorf("field streaming_swap_blocks of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
+		panic(fmt.Errorf("field streaming_swap_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
+		panic(fmt.Errorf("field total_open_loan_seconds of message types.QueryQuoteLoanOpenResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanOpenResponse.inbound_address":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanOpenResponse.inbound_confirmation_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanOpenResponse.outbound_delay_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanOpenResponse.fees":
+		m := new(QuoteFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.QueryQuoteLoanOpenResponse.router":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.expiry":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanOpenResponse.warning":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.notes":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.dust_threshold":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.recommended_min_amount_in":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.recommended_gas_rate":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.gas_rate_units":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.memo":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.expected_amount_out":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.expected_collateralization_ratio":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.expected_collateral_deposited":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.expected_debt_issued":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanOpenResponse.streaming_swap_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanOpenResponse.total_open_loan_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanOpenResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanOpenResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanOpenResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryQuoteLoanOpenResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryQuoteLoanOpenResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InboundAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InboundConfirmationBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
+		}
+		if x.InboundConfirmationSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
+		}
+		if x.OutboundDelayBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
+		}
+		if x.OutboundDelaySeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
+		}
+		if x.Fees != nil {
+			l = options.Size(x.Fees)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Router)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiry))
+		}
+		l = len(x.Warning)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Notes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DustThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecommendedMinAmountIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecommendedGasRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GasRateUnits)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Memo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedAmountOut)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedCollateralizationRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedCollateralDeposited)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedDebtIssued)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.StreamingSwapBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.StreamingSwapBlocks))
+		}
+		if x.StreamingSwapSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.StreamingSwapSeconds))
+		}
+		if x.TotalOpenLoanSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.TotalOpenLoanSeconds))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteLoanOpenResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalOpenLoanSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalOpenLoanSeconds))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.StreamingSwapSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapSeconds))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.StreamingSwapBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.ExpectedDebtIssued) > 0 {
+			i -= len(x.ExpectedDebtIssued)
+			copy(dAtA[i:], x.ExpectedDebtIssued)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedDebtIssued)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.ExpectedCollateralDeposited) > 0 {
+			i -= len(x.ExpectedCollateralDeposited)
+			copy(dAtA[i:], x.ExpectedCollateralDeposited)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedCollateralDeposited)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.ExpectedCollateralizationRatio) > 0 {
+			i -= len(x.ExpectedCollateralizationRatio)
+			copy(dAtA[i:], x.ExpectedCollateralizationRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedCollateralizationRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.ExpectedAmountOut) > 0 {
+			i -= len(x.ExpectedAmountOut)
+			copy(dAtA[i:], x.ExpectedAmountOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.GasRateUnits) > 0 {
+			i -= len(x.GasRateUnits)
+			copy(dAtA[i:], x.GasRateUnits)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.RecommendedGasRate) > 0 {
+			i -= len(x.RecommendedGasRate)
+			copy(dAtA[i:], x.RecommendedGasRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.RecommendedMinAmountIn) > 0 {
+			i -= len(x.RecommendedMinAmountIn)
+			copy(dAtA[i:], x.RecommendedMinAmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.DustThreshold) > 0 {
+			i -= len(x.DustThreshold)
+			copy(dAtA[i:], x.DustThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Notes) > 0 {
+			i -= len(x.Notes)
+			copy(dAtA[i:], x.Notes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Warning) > 0 {
+			i -= len(x.Warning)
+			copy(dAtA[i:], x.Warning)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Expiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Router) > 0 {
+			i -= len(x.Router)
+			copy(dAtA[i:], x.Router)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Fees != nil {
+			encoded, err := options.Marshal(x.Fees)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.OutboundDelaySeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.OutboundDelayBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.InboundConfirmationSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.InboundConfirmationBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.InboundAddress) > 0 {
+			i -= len(x.InboundAddress)
+			copy(dAtA[i:], x.InboundAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteLoanOpenResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InboundAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+				}
+				x.InboundConfirmationBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+				}
+				x.InboundConfirmationSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+				}
+				x.OutboundDelayBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+				}
+				x.OutboundDelaySeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fees == nil {
+					x.Fees = &QuoteFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Router = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				x.Expiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiry |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Warning = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Notes = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DustThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedCollateralizationRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedCollateralizationRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedCollateralDeposited", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedCollateralDeposited = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedDebtIssued", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedDebtIssued = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapBlocks", wireType)
+				}
+				x.StreamingSwapBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamingSwapBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
+				}
+				x.StreamingSwapSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamingSwapSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalOpenLoanSeconds", wireType)
+				}
+				x.TotalOpenLoanSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalOpenLoanSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryQuoteLoanCloseRequest            protoreflect.MessageDescriptor
+	fd_QueryQuoteLoanCloseRequest_from_asset protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseRequest_to_asset   protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseRequest_min_out    protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseRequest_repay_bps  protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseRequest_loan_owner protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseRequest_height     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_quotes_proto_init()
+	md_QueryQuoteLoanCloseRequest = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanCloseRequest")
+	fd_QueryQuoteLoanCloseRequest_from_asset = md_QueryQuoteLoanCloseRequest.Fields().ByName("from_asset")
+	fd_QueryQuoteLoanCloseRequest_to_asset = md_QueryQuoteLoanCloseRequest.Fields().ByName("to_asset")
+	fd_QueryQuoteLoanCloseRequest_min_out = md_QueryQuoteLoanCloseRequest.Fields().ByName("min_out")
+	fd_QueryQuoteLoanCloseRequest_repay_bps = md_QueryQuoteLoanCloseRequest.Fields().ByName("repay_bps")
+	fd_QueryQuoteLoanCloseRequest_loan_owner = md_QueryQuoteLoanCloseRequest.Fields().ByName("loan_owner")
+	fd_QueryQuoteLoanCloseRequest_height = md_QueryQuoteLoanCloseRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanCloseRequest)(nil)
+
+type fastReflection_QueryQuoteLoanCloseRequest QueryQuoteLoanCloseRequest
+
+func (x *QueryQuoteLoanCloseRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanCloseRequest)(x)
+}
+
+func (x *QueryQuoteLoanCloseRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryQuoteLoanCloseRequest_messageType fastReflection_QueryQuoteLoanCloseRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanCloseRequest_messageType{}
+
+type fastReflection_QueryQuoteLoanCloseRequest_messageType struct{}
+
+func (x fastReflection_QueryQuoteLoanCloseRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanCloseRequest)(nil)
+}
+func (x fastReflection_QueryQuoteLoanCloseRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanCloseRequest)
+}
+func (x fastReflection_QueryQuoteLoanCloseRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanCloseRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanCloseRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteLoanCloseRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanCloseRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteLoanCloseRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromAsset != "" {
+		value := protoreflect.ValueOfString(x.FromAsset)
+		if !f(fd_QueryQuoteLoanCloseRequest_from_asset, value) {
+			return
+		}
+	}
+	if x.ToAsset != "" {
+		value := protoreflect.ValueOfString(x.ToAsset)
+		if !f(fd_QueryQuoteLoanCloseRequest_to_asset, value) {
+			return
+		}
+	}
+	if x.MinOut != "" {
+		value := protoreflect.ValueOfString(x.MinOut)
+		if !f(fd_QueryQuoteLoanCloseRequest_min_out, value) {
+			return
+		}
+	}
+	if x.RepayBps != "" {
+		value := protoreflect.ValueOfString(x.RepayBps)
+		if !f(fd_QueryQuoteLoanCloseRequest_repay_bps, value) {
+			return
+		}
+	}
+	if x.LoanOwner != "" {
+		value := protoreflect.ValueOfString(x.LoanOwner)
+		if !f(fd_QueryQuoteLoanCloseRequest_loan_owner, value) {
+			return
+		}
+	}
+	if x.Height != "" {
+		value := protoreflect.ValueOfString(x.Height)
+		if !f(fd_QueryQuoteLoanCloseRequest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseRequest.from_asset":
+		return x.FromAsset != ""
+	case "types.QueryQuoteLoanCloseRequest.to_asset":
+		return x.ToAsset != ""
+	case "types.QueryQuoteLoanCloseRequest.min_out":
+		return x.MinOut != ""
+	case "types.QueryQuoteLoanCloseRequest.repay_bps":
+		return x.RepayBps != ""
+	case "types.QueryQuoteLoanCloseRequest.loan_owner":
+		return x.LoanOwner != ""
+	case "types.QueryQuoteLoanCloseRequest.height":
+		return x.Height != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseRequest.from_asset":
+		x.FromAsset = ""
+	case "types.QueryQuoteLoanCloseRequest.to_asset":
+		x.ToAsset = ""
+	case "types.QueryQuoteLoanCloseRequest.min_out":
+		x.MinOut = ""
+	case "types.QueryQuoteLoanCloseRequest.repay_bps":
+		x.RepayBps = ""
+	case "types.QueryQuoteLoanCloseRequest.loan_owner":
+		x.LoanOwner = ""
+	case "types.QueryQuoteLoanCloseRequest.height":
+		x.Height = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryQuoteLoanCloseRequest.from_asset":
+		value := x.FromAsset
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseRequest.to_asset":
+		value := x.ToAsset
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseRequest.min_out":
+		value := x.MinOut
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseRequest.repay_bps":
+		value := x.RepayBps
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseRequest.loan_owner":
+		value := x.LoanOwner
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseRequest.from_asset":
+		x.FromAsset = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseRequest.to_asset":
+		x.ToAsset = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseRequest.min_out":
+		x.MinOut = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseRequest.repay_bps":
+		x.RepayBps = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseRequest.loan_owner":
+		x.LoanOwner = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseRequest.height":
+		x.Height = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseRequest.from_asset":
+		panic(fmt.Errorf("field from_asset of message types.QueryQuoteLoanCloseRequest is not mutable"))
+	case "types.QueryQuoteLoanCloseRequest.to_asset":
+		panic(fmt.Errorf("field to_asset of message types.QueryQuoteLoanCloseRequest is not mutable"))
+	case "types.QueryQuoteLoanCloseRequest.min_out":
+		panic(fmt.Errorf("field min_out of message types.QueryQuoteLoanCloseRequest is not mutable"))
+	case "types.QueryQuoteLoanCloseRequest.repay_bps":
+		panic(fmt.Errorf("field repay_bps of message types.QueryQuoteLoanCloseRequest is not mutable"))
+	case "types.QueryQuoteLoanCloseRequest.loan_owner":
+		panic(fmt.Errorf("field loan_owner of message types.QueryQuoteLoanCloseRequest is not mutable"))
+	case "types.QueryQuoteLoanCloseRequest.height":
+		panic(fmt.Errorf("field height of message types.QueryQuoteLoanCloseRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseRequest.from_asset":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseRequest.to_asset":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseRequest.min_out":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseRequest.repay_bps":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseRequest.loan_owner":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseRequest.height":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanCloseRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryQuoteLoanCloseRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryQuoteLoanCloseRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.FromAsset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToAsset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinOut)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RepayBps)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LoanOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Height)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteLoanCloseRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Height) > 0 {
+			i -= len(x.Height)
+			copy(dAtA[i:], x.Height)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.LoanOwner) > 0 {
+			i -= len(x.LoanOwner)
+			copy(dAtA[i:], x.LoanOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LoanOwner)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RepayBps) > 0 {
+			i -= len(x.RepayBps)
+			copy(dAtA[i:], x.RepayBps)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepayBps)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MinOut) > 0 {
+			i -= len(x.MinOut)
+			copy(dAtA[i:], x.MinOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinOut)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ToAsset) > 0 {
+			i -= len(x.ToAsset)
+			copy(dAtA[i:], x.ToAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAsset)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FromAsset) > 0 {
+			i -= len(x.FromAsset)
+			copy(dAtA[i:], x.FromAsset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAsset)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteLoanCloseRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAsset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FromAsset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAsset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAsset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepayBps", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RepayBps = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LoanOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LoanOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Height = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryQuoteLoanCloseResponse                               protoreflect.MessageDescriptor
+	fd_QueryQuoteLoanCloseResponse_inbound_address               protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_blocks   protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_seconds  protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_outbound_delay_blocks         protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_outbound_delay_seconds        protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_fees                          protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_router                        protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_expiry                        protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_warning                       protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_notes                         protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_dust_threshold                protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_recommended_min_amount_in     protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_recommended_gas_rate          protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_gas_rate_units                protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_memo                          protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_expected_amount_out           protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_expected_amount_in            protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_expected_collateral_withdrawn protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_expected_debt_repaid          protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_streaming_swap_blocks         protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_streaming_swap_seconds        protoreflect.FieldDescriptor
+	fd_QueryQuoteLoanCloseResponse_total_repay_seconds           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_quotes_proto_init()
+	md_QueryQuoteLoanCloseResponse = File_types_query_quotes_proto.Messages().ByName("QueryQuoteLoanCloseResponse")
+	fd_QueryQuoteLoanCloseResponse_inbound_address = md_QueryQuoteLoanCloseResponse.Fields().ByName("inbound_address")
+	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_blocks = md_QueryQuoteLoanCloseResponse.Fields().ByName("inbound_confirmation_blocks")
+	fd_QueryQuoteLoanCloseResponse_inbound_confirmation_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("inbound_confirmation_seconds")
+	fd_QueryQuoteLoanCloseResponse_outbound_delay_blocks = md_QueryQuoteLoanCloseResponse.Fields().ByName("outbound_delay_blocks")
+	fd_QueryQuoteLoanCloseResponse_outbound_delay_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("outbound_delay_seconds")
+	fd_QueryQuoteLoanCloseResponse_fees = md_QueryQuoteLoanCloseResponse.Fields().ByName("fees")
+	fd_QueryQuoteLoanCloseResponse_router = md_QueryQuoteLoanCloseResponse.Fields().ByName("router")
+	fd_QueryQuoteLoanCloseResponse_expiry = md_QueryQuoteLoanCloseResponse.Fields().ByName("expiry")
+	fd_QueryQuoteLoanCloseResponse_warning = md_QueryQuoteLoanCloseResponse.Fields().ByName("warning")
+	fd_QueryQuoteLoanCloseResponse_notes = md_QueryQuoteLoanCloseResponse.Fields().ByName("notes")
+	fd_QueryQuoteLoanCloseResponse_dust_threshold = md_QueryQuoteLoanCloseResponse.Fields().ByName("dust_threshold")
+	fd_QueryQuoteLoanCloseResponse_recommended_min_amount_in = md_QueryQuoteLoanCloseResponse.Fields().ByName("recommended_min_amount_in")
+	fd_QueryQuoteLoanCloseResponse_recommended_gas_rate = md_QueryQuoteLoanCloseResponse.Fields().ByName("recommended_gas_rate")
+	fd_QueryQuoteLoanCloseResponse_gas_rate_units = md_QueryQuoteLoanCloseResponse.Fields().ByName("gas_rate_units")
+	fd_QueryQuoteLoanCloseResponse_memo = md_QueryQuoteLoanCloseResponse.Fields().ByName("memo")
+	fd_QueryQuoteLoanCloseResponse_expected_amount_out = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_amount_out")
+	fd_QueryQuoteLoanCloseResponse_expected_amount_in = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_amount_in")
+	fd_QueryQuoteLoanCloseResponse_expected_collateral_withdrawn = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_collateral_withdrawn")
+	fd_QueryQuoteLoanCloseResponse_expected_debt_repaid = md_QueryQuoteLoanCloseResponse.Fields().ByName("expected_debt_repaid")
+	fd_QueryQuoteLoanCloseResponse_streaming_swap_blocks = md_QueryQuoteLoanCloseResponse.Fields().ByName("streaming_swap_blocks")
+	fd_QueryQuoteLoanCloseResponse_streaming_swap_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("streaming_swap_seconds")
+	fd_QueryQuoteLoanCloseResponse_total_repay_seconds = md_QueryQuoteLoanCloseResponse.Fields().ByName("total_repay_seconds")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryQuoteLoanCloseResponse)(nil)
+
+type fastReflection_QueryQuoteLoanCloseResponse QueryQuoteLoanCloseResponse
+
+func (x *QueryQuoteLoanCloseResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanCloseResponse)(x)
+}
+
+func (x *QueryQuoteLoanCloseResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryQuoteLoanCloseResponse_messageType fastReflection_QueryQuoteLoanCloseResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryQuoteLoanCloseResponse_messageType{}
+
+type fastReflection_QueryQuoteLoanCloseResponse_messageType struct{}
+
+func (x fastReflection_QueryQuoteLoanCloseResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryQuoteLoanCloseResponse)(nil)
+}
+func (x fastReflection_QueryQuoteLoanCloseResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanCloseResponse)
+}
+func (x fastReflection_QueryQuoteLoanCloseResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanCloseResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryQuoteLoanCloseResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryQuoteLoanCloseResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryQuoteLoanCloseResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryQuoteLoanCloseResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InboundAddress != "" {
+		value := protoreflect.ValueOfString(x.InboundAddress)
+		if !f(fd_QueryQuoteLoanCloseResponse_inbound_address, value) {
+			return
+		}
+	}
+	if x.InboundConfirmationBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationBlocks)
+		if !f(fd_QueryQuoteLoanCloseResponse_inbound_confirmation_blocks, value) {
+			return
+		}
+	}
+	if x.InboundConfirmationSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InboundConfirmationSeconds)
+		if !f(fd_QueryQuoteLoanCloseResponse_inbound_confirmation_seconds, value) {
+			return
+		}
+	}
+	if x.OutboundDelayBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelayBlocks)
+		if !f(fd_QueryQuoteLoanCloseResponse_outbound_delay_blocks, value) {
+			return
+		}
+	}
+	if x.OutboundDelaySeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OutboundDelaySeconds)
+		if !f(fd_QueryQuoteLoanCloseResponse_outbound_delay_seconds, value) {
+			return
+		}
+	}
+	if x.Fees != nil {
+		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+		if !f(fd_QueryQuoteLoanCloseResponse_fees, value) {
+			return
+		}
+	}
+	if x.Router != "" {
+		value := protoreflect.ValueOfString(x.Router)
+		if !f(fd_QueryQuoteLoanCloseResponse_router, value) {
+			return
+		}
+	}
+	if x.Expiry != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiry)
+		if !f(fd_QueryQuoteLoanCloseResponse_expiry, value) {
+			return
+		}
+	}
+	if x.Warning != "" {
+		value := protoreflect.ValueOfString(x.Warning)
+		if !f(fd_QueryQuoteLoanCloseResponse_warning, value) {
+			return
+		}
+	}
+	if x.Notes != "" {
+		value := protoreflect.ValueOfString(x.Notes)
+		if !f(fd_QueryQuoteLoanCloseResponse_notes, value) {
+			return
+		}
+	}
+	if x.DustThreshold != "" {
+		value := protoreflect.ValueOfString(x.DustThreshold)
+		if !f(fd_QueryQuoteLoanCloseResponse_dust_threshold, value) {
+			return
+		}
+	}
+	if x.RecommendedMinAmountIn != "" {
+		value := protoreflect.ValueOfString(x.RecommendedMinAmountIn)
+		if !f(fd_QueryQuoteLoanCloseResponse_recommended_min_amount_in, value) {
+			return
+		}
+	}
+	if x.RecommendedGasRate != "" {
+		value := protoreflect.ValueOfString(x.RecommendedGasRate)
+		if !f(fd_QueryQuoteLoanCloseResponse_recommended_gas_rate, value) {
+			return
+		}
+	}
+	if x.GasRateUnits != "" {
+		value := protoreflect.ValueOfString(x.GasRateUnits)
+		if !f(fd_QueryQuoteLoanCloseResponse_gas_rate_units, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_QueryQuoteLoanCloseResponse_memo, value) {
+			return
+		}
+	}
+	if x.ExpectedAmountOut != "" {
+		value := protoreflect.ValueOfString(x.ExpectedAmountOut)
+		if !f(fd_QueryQuoteLoanCloseResponse_expected_amount_out, value) {
+			return
+		}
+	}
+	if x.ExpectedAmountIn != "" {
+		value := protoreflect.ValueOfString(x.ExpectedAmountIn)
+		if !f(fd_QueryQuoteLoanCloseResponse_expected_amount_in, value) {
+			return
+		}
+	}
+	if x.ExpectedCollateralWithdrawn != "" {
+		value := protoreflect.ValueOfString(x.ExpectedCollateralWithdrawn)
+		if !f(fd_QueryQuoteLoanCloseResponse_expected_collateral_withdrawn, value) {
+			return
+		}
+	}
+	if x.ExpectedDebtRepaid != "" {
+		value := protoreflect.ValueOfString(x.ExpectedDebtRepaid)
+		if !f(fd_QueryQuoteLoanCloseResponse_expected_debt_repaid, value) {
+			return
+		}
+	}
+	if x.StreamingSwapBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StreamingSwapBlocks)
+		if !f(fd_QueryQuoteLoanCloseResponse_streaming_swap_blocks, value) {
+			return
+		}
+	}
+	if x.StreamingSwapSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StreamingSwapSeconds)
+		if !f(fd_QueryQuoteLoanCloseResponse_streaming_swap_seconds, value) {
+			return
+		}
+	}
+	if x.TotalRepaySeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalRepaySeconds)
+		if !f(fd_QueryQuoteLoanCloseResponse_total_repay_seconds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseResponse.inbound_address":
+		return x.InboundAddress != ""
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
+		return x.InboundConfirmationBlocks != int64(0)
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
+		return x.InboundConfirmationSeconds != int64(0)
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
+		return x.OutboundDelayBlocks != int64(0)
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
+		return x.OutboundDelaySeconds != int64(0)
+	case "types.QueryQuoteLoanCloseResponse.fees":
+		return x.Fees != nil
+	case "types.QueryQuoteLoanCloseResponse.router":
+		return x.Router != ""
+	case "types.QueryQuoteLoanCloseResponse.expiry":
+		return x.Expiry != int64(0)
+	case "types.QueryQuoteLoanCloseResponse.warning":
+		return x.Warning != ""
+	case "types.QueryQuoteLoanCloseResponse.notes":
+		return x.Notes != ""
+	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
+		return x.DustThreshold != ""
+	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
+		return x.RecommendedMinAmountIn != ""
+	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
+		return x.RecommendedGasRate != ""
+	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
+		return x.GasRateUnits != ""
+	case "types.QueryQuoteLoanCloseResponse.memo":
+		return x.Memo != ""
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
+		return x.ExpectedAmountOut != ""
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
+		return x.ExpectedAmountIn != ""
+	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
+		return x.ExpectedCollateralWithdrawn != ""
+	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
+		return x.ExpectedDebtRepaid != ""
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
+		return x.StreamingSwapBlocks != int64(0)
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
+		return x.StreamingSwapSeconds != int64(0)
+	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
+		return x.TotalRepaySeconds != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseResponse.inbound_address":
+		x.InboundAddress = ""
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = int64(0)
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = int64(0)
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = int64(0)
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = int64(0)
+	case "types.QueryQuoteLoanCloseResponse.fees":
+		x.Fees = nil
+	case "types.QueryQuoteLoanCloseResponse.router":
+		x.Router = ""
+	case "types.QueryQuoteLoanCloseResponse.expiry":
+		x.Expiry = int64(0)
+	case "types.QueryQuoteLoanCloseResponse.warning":
+		x.Warning = ""
+	case "types.QueryQuoteLoanCloseResponse.notes":
+		x.Notes = ""
+	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
+		x.DustThreshold = ""
+	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = ""
+	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
+		x.RecommendedGasRate = ""
+	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
+		x.GasRateUnits = ""
+	case "types.QueryQuoteLoanCloseResponse.memo":
+		x.Memo = ""
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
+		x.ExpectedAmountOut = ""
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
+		x.ExpectedAmountIn = ""
+	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
+		x.ExpectedCollateralWithdrawn = ""
+	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
+		x.ExpectedDebtRepaid = ""
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
+		x.StreamingSwapBlocks = int64(0)
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
+		x.StreamingSwapSeconds = int64(0)
+	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
+		x.TotalRepaySeconds = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryQuoteLoanCloseResponse.inbound_address":
+		value := x.InboundAddress
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
+		value := x.InboundConfirmationBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
+		value := x.InboundConfirmationSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
+		value := x.OutboundDelayBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
+		value := x.OutboundDelaySeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanCloseResponse.fees":
+		value := x.Fees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.QueryQuoteLoanCloseResponse.router":
+		value := x.Router
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanCloseResponse.warning":
+		value := x.Warning
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.notes":
+		value := x.Notes
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
+		value := x.DustThreshold
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
+		value := x.RecommendedMinAmountIn
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
+		value := x.RecommendedGasRate
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
+		value := x.GasRateUnits
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
+		value := x.ExpectedAmountOut
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
+		value := x.ExpectedAmountIn
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
+		value := x.ExpectedCollateralWithdrawn
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
+		value := x.ExpectedDebtRepaid
+		return protoreflect.ValueOfString(value)
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
+		value := x.StreamingSwapBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
+		value := x.StreamingSwapSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
+		value := x.TotalRepaySeconds
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseResponse.inbound_address":
+		x.InboundAddress = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
+		x.InboundConfirmationBlocks = value.Int()
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
+		x.InboundConfirmationSeconds = value.Int()
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
+		x.OutboundDelayBlocks = value.Int()
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
+		x.OutboundDelaySeconds = value.Int()
+	case "types.QueryQuoteLoanCloseResponse.fees":
+		x.Fees = value.Message().Interface().(*QuoteFees)
+	case "types.QueryQuoteLoanCloseResponse.router":
+		x.Router = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.expiry":
+		x.Expiry = value.Int()
+	case "types.QueryQuoteLoanCloseResponse.warning":
+		x.Warning = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.notes":
+		x.Notes = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
+		x.DustThreshold = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
+		x.RecommendedMinAmountIn = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
+		x.RecommendedGasRate = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
+		x.GasRateUnits = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.memo":
+		x.Memo = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
+		x.ExpectedAmountOut = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
+		x.ExpectedAmountIn = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
+		x.ExpectedCollateralWithdrawn = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
+		x.ExpectedDebtRepaid = value.Interface().(string)
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
+		x.StreamingSwapBlocks = value.Int()
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
+		x.StreamingSwapSeconds = value.Int()
+	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
+		x.TotalRepaySeconds = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseResponse.fees":
+		if x.Fees == nil {
+			x.Fees = new(QuoteFees)
+		}
+		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+	case "types.QueryQuoteLoanCloseResponse.inbound_address":
+		panic(fmt.Errorf("field inbound_address of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
+		panic(fmt.Errorf("field inbound_confirmation_blocks of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
+		panic(fmt.Errorf("field inbound_confirmation_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
+		panic(fmt.Errorf("field outbound_delay_blocks of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
+		panic(fmt.Errorf("field outbound_delay_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.router":
+		panic(fmt.Errorf("field router of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.expiry":
+		panic(fmt.Errorf("field expiry of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.warning":
+		panic(fmt.Errorf("field warning of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.notes":
+		panic(fmt.Errorf("field notes of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
+		panic(fmt.Errorf("field dust_threshold of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
+		panic(fmt.Errorf("field recommended_min_amount_in of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
+		panic(fmt.Errorf("field recommended_gas_rate of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
+		panic(fmt.Errorf("field gas_rate_units of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.memo":
+		panic(fmt.Errorf("field memo of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
+		panic(fmt.Errorf("field expected_amount_out of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
+		panic(fmt.Errorf("field expected_amount_in of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
+		panic(fmt.Errorf("field expected_collateral_withdrawn of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
+		panic(fmt.Errorf("field expected_debt_repaid of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
+		panic(fmt.Errorf("field streaming_swap_blocks of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
+		panic(fmt.Errorf("field streaming_swap_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
+		panic(fmt.Errorf("field total_repay_seconds of message types.QueryQuoteLoanCloseResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryQuoteLoanCloseResponse.inbound_address":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanCloseResponse.inbound_confirmation_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanCloseResponse.outbound_delay_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanCloseResponse.fees":
+		m := new(QuoteFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.QueryQuoteLoanCloseResponse.router":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.expiry":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanCloseResponse.warning":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.notes":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.dust_threshold":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.recommended_min_amount_in":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.recommended_gas_rate":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.gas_rate_units":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.memo":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_out":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.expected_amount_in":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.expected_collateral_withdrawn":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.expected_debt_repaid":
+		return protoreflect.ValueOfString("")
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanCloseResponse.streaming_swap_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryQuoteLoanCloseResponse.total_repay_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryQuoteLoanCloseResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryQuoteLoanCloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryQuoteLoanCloseResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryQuoteLoanCloseResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InboundAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InboundConfirmationBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationBlocks))
+		}
+		if x.InboundConfirmationSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.InboundConfirmationSeconds))
+		}
+		if x.OutboundDelayBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelayBlocks))
+		}
+		if x.OutboundDelaySeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.OutboundDelaySeconds))
+		}
+		if x.Fees != nil {
+			l = options.Size(x.Fees)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Router)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiry))
+		}
+		l = len(x.Warning)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Notes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DustThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecommendedMinAmountIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecommendedGasRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GasRateUnits)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Memo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedAmountOut)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedAmountIn)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedCollateralWithdrawn)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedDebtRepaid)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.StreamingSwapBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.StreamingSwapBlocks))
+		}
+		if x.StreamingSwapSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.StreamingSwapSeconds))
+		}
+		if x.TotalRepaySeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.TotalRepaySeconds))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteLoanCloseResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalRepaySeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalRepaySeconds))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.StreamingSwapSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapSeconds))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.StreamingSwapBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamingSwapBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.ExpectedDebtRepaid) > 0 {
+			i -= len(x.ExpectedDebtRepaid)
+			copy(dAtA[i:], x.ExpectedDebtRepaid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedDebtRepaid)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.ExpectedCollateralWithdrawn) > 0 {
+			i -= len(x.ExpectedCollateralWithdrawn)
+			copy(dAtA[i:], x.ExpectedCollateralWithdrawn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedCollateralWithdrawn)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.ExpectedAmountIn) > 0 {
+			i -= len(x.ExpectedAmountIn)
+			copy(dAtA[i:], x.ExpectedAmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountIn)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.ExpectedAmountOut) > 0 {
+			i -= len(x.ExpectedAmountOut)
+			copy(dAtA[i:], x.ExpectedAmountOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedAmountOut)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.GasRateUnits) > 0 {
+			i -= len(x.GasRateUnits)
+			copy(dAtA[i:], x.GasRateUnits)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasRateUnits)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.RecommendedGasRate) > 0 {
+			i -= len(x.RecommendedGasRate)
+			copy(dAtA[i:], x.RecommendedGasRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedGasRate)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.RecommendedMinAmountIn) > 0 {
+			i -= len(x.RecommendedMinAmountIn)
+			copy(dAtA[i:], x.RecommendedMinAmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecommendedMinAmountIn)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.DustThreshold) > 0 {
+			i -= len(x.DustThreshold)
+			copy(dAtA[i:], x.DustThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DustThreshold)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Notes) > 0 {
+			i -= len(x.Notes)
+			copy(dAtA[i:], x.Notes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Notes)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Warning) > 0 {
+			i -= len(x.Warning)
+			copy(dAtA[i:], x.Warning)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Expiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Router) > 0 {
+			i -= len(x.Router)
+			copy(dAtA[i:], x.Router)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Router)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Fees != nil {
+			encoded, err := options.Marshal(x.Fees)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.OutboundDelaySeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelaySeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.OutboundDelayBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutboundDelayBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.InboundConfirmationSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.InboundConfirmationBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InboundConfirmationBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.InboundAddress) > 0 {
+			i -= len(x.InboundAddress)
+			copy(dAtA[i:], x.InboundAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InboundAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryQuoteLoanCloseResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryQuoteLoanCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InboundAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+				}
+				x.InboundConfirmationBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+				}
+				x.InboundConfirmationSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+				}
+				x.OutboundDelayBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelayBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+				}
+				x.OutboundDelaySeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutboundDelaySeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fees == nil {
+					x.Fees = &QuoteFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Router = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				x.Expiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiry |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Warning = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Notes = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DustThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedAmountIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedCollateralWithdrawn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedCollateralWithdrawn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedDebtRepaid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedDebtRepaid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapBlocks", wireType)
+				}
+				x.StreamingSwapBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamingSwapBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
+				}
+				x.StreamingSwapSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamingSwapSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalRepaySeconds", wireType)
+				}
+				x.TotalRepaySeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalRepaySeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuoteFees              protoreflect.MessageDescriptor
+	fd_QuoteFees_asset        protoreflect.FieldDescriptor
+	fd_QuoteFees_affiliate    protoreflect.FieldDescriptor
+	fd_QuoteFees_outbound     protoreflect.FieldDescriptor
+	fd_QuoteFees_liquidity    protoreflect.FieldDescriptor
+	fd_QuoteFees_total        protoreflect.FieldDescriptor
+	fd_QuoteFees_slippage_bps protoreflect.FieldDescriptor
+	fd_QuoteFees_total_bps    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_quotes_proto_init()
+	md_QuoteFees = File_types_query_quotes_proto.Messages().ByName("QuoteFees")
+	fd_QuoteFees_asset = md_QuoteFees.Fields().ByName("asset")
+	fd_QuoteFees_affiliate = md_QuoteFees.Fields().ByName("affiliate")
+	fd_QuoteFees_outbound = md_QuoteFees.Fields().ByName("outbound")
+	fd_QuoteFees_liquidity = md_QuoteFees.Fields().ByName("liquidity")
+	fd_QuoteFees_total = md_QuoteFees.Fields().ByName("total")
+	fd_QuoteFees_slippage_bps = md_QuoteFees.Fields().ByName("slippage_bps")
+	fd_QuoteFees_total_bps = md_QuoteFees.Fields().ByName("total_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuoteFees)(nil)
+
+type fastReflection_QuoteFees QuoteFees
+
+func (x *QuoteFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuoteFees)(x)
+}
+
+func (x *QuoteFees) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuoteFees_messageType fastReflection_QuoteFees_messageType
+var _ protoreflect.MessageType = fastReflection_QuoteFees_messageType{}
+
+type fastReflection_QuoteFees_messageType struct{}
+
+func (x fastReflection_QuoteFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuoteFees)(nil)
+}
+func (x fastReflection_QuoteFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuoteFees)
+}
+func (x fastReflection_QuoteFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuoteFees
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuoteFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuoteFees
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuoteFees) Type() protoreflect.MessageType {
+	return _fastReflection_QuoteFees_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuoteFees) New() protoreflect.Message {
+	return new(fastReflection_QuoteFees)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuoteFees) Interface() protoreflect.ProtoMessage {
+	return (*QuoteFees)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuoteFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != "" {
+		value := protoreflect.ValueOfString(x.Asset)
+		if !f(fd_QuoteFees_asset, value) {
+			return
+		}
+	}
+	if x.Affiliate != "" {
+		value := protoreflect.ValueOfString(x.Affiliate)
+		if !f(fd_QuoteFees_affiliate, value) {
+			return
+		}
+	}
+	if x.Outbound != "" {
+		value := protoreflect.ValueOfString(x.Outbound)
+		if !f(fd_QuoteFees_outbound, value) {
+			return
+		}
+	}
+	if x.Liquidity != "" {
+		value := protoreflect.ValueOfString(x.Liquidity)
+		if !f(fd_QuoteFees_liquidity, value) {
+			return
+		}
+	}
+	if x.Total != "" {
+		value := protoreflect.ValueOfString(x.Total)
+		if !f(fd_QuoteFees_total, value) {
+			return
+		}
+	}
+	if x.SlippageBps != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlippageBps)
+		if !f(fd_QuoteFees_slippage_bps, value) {
+			return
+		}
+	}
+	if x.TotalBps != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalBps)
+		if !f(fd_QuoteFees_total_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuoteFees) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QuoteFees.asset":
+		return x.Asset != ""
+	case "types.QuoteFees.affiliate":
+		return x.Affiliate != ""
+	case "types.QuoteFees.outbound":
+		return x.Outbound != ""
+	case "types.QuoteFees.liquidity":
+		return x.Liquidity != ""
+	case "types.QuoteFees.total":
+		return x.Total != ""
+	case "types.QuoteFees.slippage_bps":
+		return x.SlippageBps != int64(0)
+	case "types.QuoteFees.total_bps":
+		return x.TotalBps != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+		}
+		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteFees) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QuoteFees.asset":
+		x.Asset = ""
+	case "types.QuoteFees.affiliate":
+		x.Affiliate = ""
+	case "types.QuoteFees.outbound":
+		x.Outbound = ""
+	case "types.QuoteFees.liquidity":
+		x.Liquidity = ""
+	case "types.QuoteFees.total":
+		x.Total = ""
+	case "types.QuoteFees.slippage_bps":
+		x.SlippageBps = int64(0)
+	case "types.QuoteFees.total_bps":
+		x.TotalBps = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+		}
+		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuoteFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QuoteFees.asset":
+		value := x.Asset
+		return protoreflect.ValueOfString(value)
+	case "types.QuoteFees.affiliate":
+		value := x.Affiliate
+		return protoreflect.ValueOfString(value)
+	case "types.QuoteFees.outbound":
+		value := x.Outbound
+		return protoreflect.ValueOfString(value)
+	case "types.QuoteFees.liquidity":
+		value := x.Liquidity
+		return protoreflect.ValueOfString(value)
+	case "types.QuoteFees.total":
+		value := x.Total
+		return protoreflect.ValueOfString(value)
+	case "types.QuoteFees.slippage_bps":
+		value := x.SlippageBps
+		return protoreflect.ValueOfInt64(value)
+	case "types.QuoteFees.total_bps":
+		value := x.TotalBps
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+		}
+		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QuoteFees.asset":
+		x.Asset = value.Interface().(string)
+	case "types.QuoteFees.affiliate":
+		x.Affiliate = value.Interface().(string)
+	case "types.QuoteFees.outbound":
+		x.Outbound = value.Interface().(string)
+	case "types.QuoteFees.liquidity":
+		x.Liquidity = value.Interface().(string)
+	case "types.QuoteFees.total":
+		x.Total = value.Interface().(string)
+	case "types.QuoteFees.slippage_bps":
+		x.SlippageBps = value.Int()
+	case "types.QuoteFees.total_bps":
+		x.TotalBps = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+		}
+		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QuoteFees.asset":
+		panic(fmt.Errorf("field asset of message types.QuoteFees is not mutable"))
+	case "types.QuoteFees.affiliate":
+		panic(fmt.Errorf("field affiliate of message types.QuoteFees is not mutable"))
+	case "types.QuoteFees.outbound":
+		panic(fmt.Errorf("field outbound of message types.QuoteFees is not mutable"))
+	case "types.QuoteFees.liquidity":
+		panic(fmt.Errorf("field liquidity of message types.QuoteFees is not mutable"))
+	case "types.QuoteFees.total":
+		panic(fmt.Errorf("field total of message types.QuoteFees is not mutable"))
+	case "types.QuoteFees.slippage_bps":
+		panic(fmt.Errorf("field slippage_bps of message types.QuoteFees is not mutable"))
+	case "types.QuoteFees.total_bps":
+		panic(fmt.Errorf("field total_bps of message types.QuoteFees is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+		}
+		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuoteFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QuoteFees.asset":
+		return protoreflect.ValueOfString("")
+	case "types.QuoteFees.affiliate":
+		return protoreflect.ValueOfString("")
+	case "types.QuoteFees.outbound":
+		return protoreflect.ValueOfString("")
+	case "types.QuoteFees.liquidity":
+		return protoreflect.ValueOfString("")
+	case "types.QuoteFees.total":
+		return protoreflect.ValueOfString("")
+	case "types.QuoteFees.slippage_bps":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QuoteFees.total_bps":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+		}
+		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuoteFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QuoteFees", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuoteFees) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteFees) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuoteFees) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuoteFees)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Asset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Affiliate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Outbound)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Liquidity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Total)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlippageBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlippageBps))
+		}
+		if x.TotalBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuoteFees)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalBps))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SlippageBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlippageBps))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Total) > 0 {
+			i -= len(x.Total)
+			copy(dAtA[i:], x.Total)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Total)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Liquidity) > 0 {
+			i -= len(x.Liquidity)
+			copy(dAtA[i:], x.Liquidity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Liquidity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Outbound) > 0 {
+			i -= len(x.Outbound)
+			copy(dAtA[i:], x.Outbound)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Outbound)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Affiliate) > 0 {
+			i -= len(x.Affiliate)
+			copy(dAtA[i:], x.Affiliate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Affiliate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Asset) > 0 {
+			i -= len(x.Asset)
+			copy(dAtA[i:], x.Asset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuoteFees)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 14:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.GasRateUnits = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 15:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteFees: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11978,11 +14578,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Memo = string(dAtA[iNdEx:postIndex])
+				x.Asset = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 16:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12010,11 +14610,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+				x.Affiliate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 17:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountIn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12042,11 +14642,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpectedAmountIn = string(dAtA[iNdEx:postIndex])
+				x.Outbound = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 18:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedCollateralWithdrawn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12074,11 +14674,11 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpectedCollateralWithdrawn = string(dAtA[iNdEx:postIndex])
+				x.Liquidity = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 19:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedDebtRepaid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12106,32 +14706,13 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpectedDebtRepaid = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 20:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapBlocks", wireType)
-				}
-				x.StreamingSwapBlocks = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.StreamingSwapBlocks |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 21:
+				x.Total = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlippageBps", wireType)
 				}
-				x.StreamingSwapSeconds = 0
+				x.SlippageBps = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12141,16 +14722,16 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.StreamingSwapSeconds |= int64(b&0x7F) << shift
+					x.SlippageBps |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 22:
+			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalRepaySeconds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBps", wireType)
 				}
-				x.TotalRepaySeconds = 0
+				x.TotalBps = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12160,7 +14741,7 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TotalRepaySeconds |= int64(b&0x7F) << shift
+					x.TotalBps |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -12201,38 +14782,30 @@ func (x *fastReflection_QueryQuoteLoanCloseResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_QuoteFees              protoreflect.MessageDescriptor
-	fd_QuoteFees_asset        protoreflect.FieldDescriptor
-	fd_QuoteFees_affiliate    protoreflect.FieldDescriptor
-	fd_QuoteFees_outbound     protoreflect.FieldDescriptor
-	fd_QuoteFees_liquidity    protoreflect.FieldDescriptor
-	fd_QuoteFees_total        protoreflect.FieldDescriptor
-	fd_QuoteFees_slippage_bps protoreflect.FieldDescriptor
-	fd_QuoteFees_total_bps    protoreflect.FieldDescriptor
+	md_Vout        protoreflect.MessageDescriptor
+	fd_Vout_type   protoreflect.FieldDescriptor
+	fd_Vout_data   protoreflect.FieldDescriptor
+	fd_Vout_amount protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_quotes_proto_init()
-	md_QuoteFees = File_types_query_quotes_proto.Messages().ByName("QuoteFees")
-	fd_QuoteFees_asset = md_QuoteFees.Fields().ByName("asset")
-	fd_QuoteFees_affiliate = md_QuoteFees.Fields().ByName("affiliate")
-	fd_QuoteFees_outbound = md_QuoteFees.Fields().ByName("outbound")
-	fd_QuoteFees_liquidity = md_QuoteFees.Fields().ByName("liquidity")
-	fd_QuoteFees_total = md_QuoteFees.Fields().ByName("total")
-	fd_QuoteFees_slippage_bps = md_QuoteFees.Fields().ByName("slippage_bps")
-	fd_QuoteFees_total_bps = md_QuoteFees.Fields().ByName("total_bps")
+	md_Vout = File_types_query_quotes_proto.Messages().ByName("Vout")
+	fd_Vout_type = md_Vout.Fields().ByName("type")
+	fd_Vout_data = md_Vout.Fields().ByName("data")
+	fd_Vout_amount = md_Vout.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuoteFees)(nil)
+var _ protoreflect.Message = (*fastReflection_Vout)(nil)
 
-type fastReflection_QuoteFees QuoteFees
+type fastReflection_Vout Vout
 
-func (x *QuoteFees) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuoteFees)(x)
+func (x *Vout) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Vout)(x)
 }
 
-func (x *QuoteFees) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_query_quotes_proto_msgTypes[10]
+func (x *Vout) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12243,43 +14816,43 @@ func (x *QuoteFees) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuoteFees_messageType fastReflection_QuoteFees_messageType
-var _ protoreflect.MessageType = fastReflection_QuoteFees_messageType{}
+var _fastReflection_Vout_messageType fastReflection_Vout_messageType
+var _ protoreflect.MessageType = fastReflection_Vout_messageType{}
 
-type fastReflection_QuoteFees_messageType struct{}
+type fastReflection_Vout_messageType struct{}
 
-func (x fastReflection_QuoteFees_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuoteFees)(nil)
+func (x fastReflection_Vout_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Vout)(nil)
 }
-func (x fastReflection_QuoteFees_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuoteFees)
+func (x fastReflection_Vout_messageType) New() protoreflect.Message {
+	return new(fastReflection_Vout)
 }
-func (x fastReflection_QuoteFees_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuoteFees
+func (x fastReflection_Vout_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vout
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuoteFees) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuoteFees
+func (x *fastReflection_Vout) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vout
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuoteFees) Type() protoreflect.MessageType {
-	return _fastReflection_QuoteFees_messageType
+func (x *fastReflection_Vout) Type() protoreflect.MessageType {
+	return _fastReflection_Vout_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuoteFees) New() protoreflect.Message {
-	return new(fastReflection_QuoteFees)
+func (x *fastReflection_Vout) New() protoreflect.Message {
+	return new(fastReflection_Vout)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuoteFees) Interface() protoreflect.ProtoMessage {
-	return (*QuoteFees)(x)
+func (x *fastReflection_Vout) Interface() protoreflect.ProtoMessage {
+	return (*Vout)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12287,46 +14860,22 @@ func (x *fastReflection_QuoteFees) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuoteFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Asset != "" {
-		value := protoreflect.ValueOfString(x.Asset)
-		if !f(fd_QuoteFees_asset, value) {
-			return
-		}
-	}
-	if x.Affiliate != "" {
-		value := protoreflect.ValueOfString(x.Affiliate)
-		if !f(fd_QuoteFees_affiliate, value) {
-			return
-		}
-	}
-	if x.Outbound != "" {
-		value := protoreflect.ValueOfString(x.Outbound)
-		if !f(fd_QuoteFees_outbound, value) {
-			return
-		}
-	}
-	if x.Liquidity != "" {
-		value := protoreflect.ValueOfString(x.Liquidity)
-		if !f(fd_QuoteFees_liquidity, value) {
-			return
-		}
-	}
-	if x.Total != "" {
-		value := protoreflect.ValueOfString(x.Total)
-		if !f(fd_QuoteFees_total, value) {
+func (x *fastReflection_Vout) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_Vout_type, value) {
 			return
 		}
 	}
-	if x.SlippageBps != int64(0) {
-		value := protoreflect.ValueOfInt64(x.SlippageBps)
-		if !f(fd_QuoteFees_slippage_bps, value) {
+	if x.Data != "" {
+		value := protoreflect.ValueOfString(x.Data)
+		if !f(fd_Vout_data, value) {
 			return
 		}
 	}
-	if x.TotalBps != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TotalBps)
-		if !f(fd_QuoteFees_total_bps, value) {
+	if x.Amount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Amount)
+		if !f(fd_Vout_amount, value) {
 			return
 		}
 	}
@@ -12343,27 +14892,19 @@ func (x *fastReflection_QuoteFees) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuoteFees) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Vout) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.QuoteFees.asset":
-		return x.Asset != ""
-	case "types.QuoteFees.affiliate":
-		return x.Affiliate != ""
-	case "types.QuoteFees.outbound":
-		return x.Outbound != ""
-	case "types.QuoteFees.liquidity":
-		return x.Liquidity != ""
-	case "types.QuoteFees.total":
-		return x.Total != ""
-	case "types.QuoteFees.slippage_bps":
-		return x.SlippageBps != int64(0)
-	case "types.QuoteFees.total_bps":
-		return x.TotalBps != int64(0)
+	case "types.Vout.type":
+		return x.Type_ != ""
+	case "types.Vout.data":
+		return x.Data != ""
+	case "types.Vout.amount":
+		return x.Amount != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
 		}
-		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12373,27 +14914,19 @@ func (x *fastReflection_QuoteFees) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuoteFees) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Vout) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.QuoteFees.asset":
-		x.Asset = ""
-	case "types.QuoteFees.affiliate":
-		x.Affiliate = ""
-	case "types.QuoteFees.outbound":
-		x.Outbound = ""
-	case "types.QuoteFees.liquidity":
-		x.Liquidity = ""
-	case "types.QuoteFees.total":
-		x.Total = ""
-	case "types.QuoteFees.slippage_bps":
-		x.SlippageBps = int64(0)
-	case "types.QuoteFees.total_bps":
-		x.TotalBps = int64(0)
+	case "types.Vout.type":
+		x.Type_ = ""
+	case "types.Vout.data":
+		x.Data = ""
+	case "types.Vout.amount":
+		x.Amount = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
 		}
-		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12403,34 +14936,22 @@ func (x *fastReflection_QuoteFees) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuoteFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vout) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.QuoteFees.asset":
-		value := x.Asset
-		return protoreflect.ValueOfString(value)
-	case "types.QuoteFees.affiliate":
-		value := x.Affiliate
-		return protoreflect.ValueOfString(value)
-	case "types.QuoteFees.outbound":
-		value := x.Outbound
-		return protoreflect.ValueOfString(value)
-	case "types.QuoteFees.liquidity":
-		value := x.Liquidity
+	case "types.Vout.type":
+		value := x.Type_
 		return protoreflect.ValueOfString(value)
-	case "types.QuoteFees.total":
-		value := x.Total
+	case "types.Vout.data":
+		value := x.Data
 		return protoreflect.ValueOfString(value)
-	case "types.QuoteFees.slippage_bps":
-		value := x.SlippageBps
-		return protoreflect.ValueOfInt64(value)
-	case "types.QuoteFees.total_bps":
-		value := x.TotalBps
+	case "types.Vout.amount":
+		value := x.Amount
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
 		}
-		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.Vout does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12444,27 +14965,19 @@ func (x *fastReflection_QuoteFees) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuoteFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Vout) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.QuoteFees.asset":
-		x.Asset = value.Interface().(string)
-	case "types.QuoteFees.affiliate":
-		x.Affiliate = value.Interface().(string)
-	case "types.QuoteFees.outbound":
-		x.Outbound = value.Interface().(string)
-	case "types.QuoteFees.liquidity":
-		x.Liquidity = value.Interface().(string)
-	case "types.QuoteFees.total":
-		x.Total = value.Interface().(string)
-	case "types.QuoteFees.slippage_bps":
-		x.SlippageBps = value.Int()
-	case "types.QuoteFees.total_bps":
-		x.TotalBps = value.Int()
+	case "types.Vout.type":
+		x.Type_ = value.Interface().(string)
+	case "types.Vout.data":
+		x.Data = value.Interface().(string)
+	case "types.Vout.amount":
+		x.Amount = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
 		}
-		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12476,66 +14989,50 @@ func (x *fastReflection_QuoteFees) Set(fd protoreflect.FieldDescriptor, value pr
 // For extension fields, it implicitly stores the provided ExtensionType
 // if not already stored.
 // It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuoteFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "types.QuoteFees.asset":
-		panic(fmt.Errorf("field asset of message types.QuoteFees is not mutable"))
-	case "types.QuoteFees.affiliate":
-		panic(fmt.Errorf("field affiliate of message types.QuoteFees is not mutable"))
-	case "types.QuoteFees.outbound":
-		panic(fmt.Errorf("field outbound of message types.QuoteFees is not mutable"))
-	case "types.QuoteFees.liquidity":
-		panic(fmt.Errorf("field liquidity of message types.QuoteFees is not mutable"))
-	case "types.QuoteFees.total":
-		panic(fmt.Errorf("field total of message types.QuoteFees is not mutable"))
-	case "types.QuoteFees.slippage_bps":
-		panic(fmt.Errorf("field slippage_bps of message types.QuoteFees is not mutable"))
-	case "types.QuoteFees.total_bps":
-		panic(fmt.Errorf("field total_bps of message types.QuoteFees is not mutable"))
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vout) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.Vout.type":
+		panic(fmt.Errorf("field type of message types.Vout is not mutable"))
+	case "types.Vout.data":
+		panic(fmt.Errorf("field data of message types.Vout is not mutable"))
+	case "types.Vout.amount":
+		panic(fmt.Errorf("field amount of message types.Vout is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
 		}
-		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuoteFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vout) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.QuoteFees.asset":
-		return protoreflect.ValueOfString("")
-	case "types.QuoteFees.affiliate":
-		return protoreflect.ValueOfString("")
-	case "types.QuoteFees.outbound":
-		return protoreflect.ValueOfString("")
-	case "types.QuoteFees.liquidity":
+	case "types.Vout.type":
 		return protoreflect.ValueOfString("")
-	case "types.QuoteFees.total":
+	case "types.Vout.data":
 		return protoreflect.ValueOfString("")
-	case "types.QuoteFees.slippage_bps":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.QuoteFees.total_bps":
+	case "types.Vout.amount":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QuoteFees"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
 		}
-		panic(fmt.Errorf("message types.QuoteFees does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuoteFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Vout) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.QuoteFees", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.Vout", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12543,7 +15040,7 @@ func (x *fastReflection_QuoteFees) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuoteFees) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Vout) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12554,7 +15051,7 @@ func (x *fastReflection_QuoteFees) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuoteFees) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Vout) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12566,7 +15063,7 @@ func (x *fastReflection_QuoteFees) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuoteFees) IsValid() bool {
+func (x *fastReflection_Vout) IsValid() bool {
 	return x != nil
 }
 
@@ -12576,9 +15073,9 @@ func (x *fastReflection_QuoteFees) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuoteFees)
+		x := input.Message.Interface().(*Vout)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12590,31 +15087,16 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Asset)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Affiliate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Outbound)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Liquidity)
+		l = len(x.Type_)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Total)
+		l = len(x.Data)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SlippageBps != 0 {
-			n += 1 + runtime.Sov(uint64(x.SlippageBps))
-		}
-		if x.TotalBps != 0 {
-			n += 1 + runtime.Sov(uint64(x.TotalBps))
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -12626,7 +15108,7 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuoteFees)
+		x := input.Message.Interface().(*Vout)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12645,48 +15127,22 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TotalBps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalBps))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.SlippageBps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlippageBps))
-			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.Total) > 0 {
-			i -= len(x.Total)
-			copy(dAtA[i:], x.Total)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Total)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Liquidity) > 0 {
-			i -= len(x.Liquidity)
-			copy(dAtA[i:], x.Liquidity)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Liquidity)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Outbound) > 0 {
-			i -= len(x.Outbound)
-			copy(dAtA[i:], x.Outbound)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Outbound)))
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.Affiliate) > 0 {
-			i -= len(x.Affiliate)
-			copy(dAtA[i:], x.Affiliate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Affiliate)))
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Asset) > 0 {
-			i -= len(x.Asset)
-			copy(dAtA[i:], x.Asset)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -12701,7 +15157,7 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuoteFees)
+		x := input.Message.Interface().(*Vout)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12733,143 +15189,15 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteFees: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vout: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteFees: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vout: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Asset = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Affiliate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Outbound = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Liquidity = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12897,13 +15225,13 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Total = string(dAtA[iNdEx:postIndex])
+				x.Type_ = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlippageBps", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 				}
-				x.SlippageBps = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12913,16 +15241,29 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SlippageBps |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 7:
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBps", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				x.TotalBps = 0
+				x.Amount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12932,7 +15273,7 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TotalBps |= int64(b&0x7F) << shift
+					x.Amount |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -12973,30 +15314,34 @@ func (x *fastReflection_QuoteFees) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Vout        protoreflect.MessageDescriptor
-	fd_Vout_type   protoreflect.FieldDescriptor
-	fd_Vout_data   protoreflect.FieldDescriptor
-	fd_Vout_amount protoreflect.FieldDescriptor
+	md_StreamingQuotePoint                      protoreflect.MessageDescriptor
+	fd_StreamingQuotePoint_interval             protoreflect.FieldDescriptor
+	fd_StreamingQuotePoint_quantity             protoreflect.FieldDescriptor
+	fd_StreamingQuotePoint_blocks               protoreflect.FieldDescriptor
+	fd_StreamingQuotePoint_expected_swap_output protoreflect.FieldDescriptor
+	fd_StreamingQuotePoint_slippage_bps         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_query_quotes_proto_init()
-	md_Vout = File_types_query_quotes_proto.Messages().ByName("Vout")
-	fd_Vout_type = md_Vout.Fields().ByName("type")
-	fd_Vout_data = md_Vout.Fields().ByName("data")
-	fd_Vout_amount = md_Vout.Fields().ByName("amount")
+	md_StreamingQuotePoint = File_types_query_quotes_proto.Messages().ByName("StreamingQuotePoint")
+	fd_StreamingQuotePoint_interval = md_StreamingQuotePoint.Fields().ByName("interval")
+	fd_StreamingQuotePoint_quantity = md_StreamingQuotePoint.Fields().ByName("quantity")
+	fd_StreamingQuotePoint_blocks = md_StreamingQuotePoint.Fields().ByName("blocks")
+	fd_StreamingQuotePoint_expected_swap_output = md_StreamingQuotePoint.Fields().ByName("expected_swap_output")
+	fd_StreamingQuotePoint_slippage_bps = md_StreamingQuotePoint.Fields().ByName("slippage_bps")
 }
 
-var _ protoreflect.Message = (*fastReflection_Vout)(nil)
+var _ protoreflect.Message = (*fastReflection_StreamingQuotePoint)(nil)
 
-type fastReflection_Vout Vout
+type fastReflection_StreamingQuotePoint StreamingQuotePoint
 
-func (x *Vout) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Vout)(x)
+func (x *StreamingQuotePoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StreamingQuotePoint)(x)
 }
 
-func (x *Vout) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_query_quotes_proto_msgTypes[11]
+func (x *StreamingQuotePoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_quotes_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13007,43 +15352,43 @@ func (x *Vout) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Vout_messageType fastReflection_Vout_messageType
-var _ protoreflect.MessageType = fastReflection_Vout_messageType{}
+var _fastReflection_StreamingQuotePoint_messageType fastReflection_StreamingQuotePoint_messageType
+var _ protoreflect.MessageType = fastReflection_StreamingQuotePoint_messageType{}
 
-type fastReflection_Vout_messageType struct{}
+type fastReflection_StreamingQuotePoint_messageType struct{}
 
-func (x fastReflection_Vout_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Vout)(nil)
+func (x fastReflection_StreamingQuotePoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StreamingQuotePoint)(nil)
 }
-func (x fastReflection_Vout_messageType) New() protoreflect.Message {
-	return new(fastReflection_Vout)
+func (x fastReflection_StreamingQuotePoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_StreamingQuotePoint)
 }
-func (x fastReflection_Vout_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vout
+func (x fastReflection_StreamingQuotePoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamingQuotePoint
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Vout) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vout
+func (x *fastReflection_StreamingQuotePoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamingQuotePoint
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Vout) Type() protoreflect.MessageType {
-	return _fastReflection_Vout_messageType
+func (x *fastReflection_StreamingQuotePoint) Type() protoreflect.MessageType {
+	return _fastReflection_StreamingQuotePoint_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Vout) New() protoreflect.Message {
-	return new(fastReflection_Vout)
+func (x *fastReflection_StreamingQuotePoint) New() protoreflect.Message {
+	return new(fastReflection_StreamingQuotePoint)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Vout) Interface() protoreflect.ProtoMessage {
-	return (*Vout)(x)
+func (x *fastReflection_StreamingQuotePoint) Interface() protoreflect.ProtoMessage {
+	return (*StreamingQuotePoint)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -13051,22 +15396,34 @@ func (x *fastReflection_Vout) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Vout) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Type_ != "" {
-		value := protoreflect.ValueOfString(x.Type_)
-		if !f(fd_Vout_type, value) {
+func (x *fastReflection_StreamingQuotePoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Interval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Interval)
+		if !f(fd_StreamingQuotePoint_interval, value) {
 			return
 		}
 	}
-	if x.Data != "" {
-		value := protoreflect.ValueOfString(x.Data)
-		if !f(fd_Vout_data, value) {
+	if x.Quantity != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Quantity)
+		if !f(fd_StreamingQuotePoint_quantity, value) {
 			return
 		}
 	}
-	if x.Amount != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Amount)
-		if !f(fd_Vout_amount, value) {
+	if x.Blocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Blocks)
+		if !f(fd_StreamingQuotePoint_blocks, value) {
+			return
+		}
+	}
+	if x.ExpectedSwapOutput != "" {
+		value := protoreflect.ValueOfString(x.ExpectedSwapOutput)
+		if !f(fd_StreamingQuotePoint_expected_swap_output, value) {
+			return
+		}
+	}
+	if x.SlippageBps != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlippageBps)
+		if !f(fd_StreamingQuotePoint_slippage_bps, value) {
 			return
 		}
 	}
@@ -13083,19 +15440,23 @@ func (x *fastReflection_Vout) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Vout) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StreamingQuotePoint) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.Vout.type":
-		return x.Type_ != ""
-	case "types.Vout.data":
-		return x.Data != ""
-	case "types.Vout.amount":
-		return x.Amount != int64(0)
+	case "types.StreamingQuotePoint.interval":
+		return x.Interval != int64(0)
+	case "types.StreamingQuotePoint.quantity":
+		return x.Quantity != int64(0)
+	case "types.StreamingQuotePoint.blocks":
+		return x.Blocks != int64(0)
+	case "types.StreamingQuotePoint.expected_swap_output":
+		return x.ExpectedSwapOutput != ""
+	case "types.StreamingQuotePoint.slippage_bps":
+		return x.SlippageBps != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.StreamingQuotePoint"))
 		}
-		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.StreamingQuotePoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13105,19 +15466,23 @@ func (x *fastReflection_Vout) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vout) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StreamingQuotePoint) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.Vout.type":
-		x.Type_ = ""
-	case "types.Vout.data":
-		x.Data = ""
-	case "types.Vout.amount":
-		x.Amount = int64(0)
+	case "types.StreamingQuotePoint.interval":
+		x.Interval = int64(0)
+	case "types.StreamingQuotePoint.quantity":
+		x.Quantity = int64(0)
+	case "types.StreamingQuotePoint.blocks":
+		x.Blocks = int64(0)
+	case "types.StreamingQuotePoint.expected_swap_output":
+		x.ExpectedSwapOutput = ""
+	case "types.StreamingQuotePoint.slippage_bps":
+		x.SlippageBps = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.StreamingQuotePoint"))
 		}
-		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.StreamingQuotePoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13127,22 +15492,28 @@ func (x *fastReflection_Vout) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Vout) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamingQuotePoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.Vout.type":
-		value := x.Type_
-		return protoreflect.ValueOfString(value)
-	case "types.Vout.data":
-		value := x.Data
+	case "types.StreamingQuotePoint.interval":
+		value := x.Interval
+		return protoreflect.ValueOfInt64(value)
+	case "types.StreamingQuotePoint.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfInt64(value)
+	case "types.StreamingQuotePoint.blocks":
+		value := x.Blocks
+		return protoreflect.ValueOfInt64(value)
+	case "types.StreamingQuotePoint.expected_swap_output":
+		value := x.ExpectedSwapOutput
 		return protoreflect.ValueOfString(value)
-	case "types.Vout.amount":
-		value := x.Amount
+	case "types.StreamingQuotePoint.slippage_bps":
+		value := x.SlippageBps
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.StreamingQuotePoint"))
 		}
-		panic(fmt.Errorf("message types.Vout does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.StreamingQuotePoint does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -13156,19 +15527,23 @@ func (x *fastReflection_Vout) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vout) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StreamingQuotePoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.Vout.type":
-		x.Type_ = value.Interface().(string)
-	case "types.Vout.data":
-		x.Data = value.Interface().(string)
-	case "types.Vout.amount":
-		x.Amount = value.Int()
+	case "types.StreamingQuotePoint.interval":
+		x.Interval = value.Int()
+	case "types.StreamingQuotePoint.quantity":
+		x.Quantity = value.Int()
+	case "types.StreamingQuotePoint.blocks":
+		x.Blocks = value.Int()
+	case "types.StreamingQuotePoint.expected_swap_output":
+		x.ExpectedSwapOutput = value.Interface().(string)
+	case "types.StreamingQuotePoint.slippage_bps":
+		x.SlippageBps = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.StreamingQuotePoint"))
 		}
-		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.StreamingQuotePoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13182,48 +15557,56 @@ func (x *fastReflection_Vout) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vout) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamingQuotePoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.Vout.type":
-		panic(fmt.Errorf("field type of message types.Vout is not mutable"))
-	case "types.Vout.data":
-		panic(fmt.Errorf("field data of message types.Vout is not mutable"))
-	case "types.Vout.amount":
-		panic(fmt.Errorf("field amount of message types.Vout is not mutable"))
+	case "types.StreamingQuotePoint.interval":
+		panic(fmt.Errorf("field interval of message types.StreamingQuotePoint is not mutable"))
+	case "types.StreamingQuotePoint.quantity":
+		panic(fmt.Errorf("field quantity of message types.StreamingQuotePoint is not mutable"))
+	case "types.StreamingQuotePoint.blocks":
+		panic(fmt.Errorf("field blocks of message types.StreamingQuotePoint is not mutable"))
+	case "types.StreamingQuotePoint.expected_swap_output":
+		panic(fmt.Errorf("field expected_swap_output of message types.StreamingQuotePoint is not mutable"))
+	case "types.StreamingQuotePoint.slippage_bps":
+		panic(fmt.Errorf("field slippage_bps of message types.StreamingQuotePoint is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.StreamingQuotePoint"))
 		}
-		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.StreamingQuotePoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Vout) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamingQuotePoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.Vout.type":
-		return protoreflect.ValueOfString("")
-	case "types.Vout.data":
+	case "types.StreamingQuotePoint.interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.StreamingQuotePoint.quantity":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.StreamingQuotePoint.blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.StreamingQuotePoint.expected_swap_output":
 		return protoreflect.ValueOfString("")
-	case "types.Vout.amount":
+	case "types.StreamingQuotePoint.slippage_bps":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.StreamingQuotePoint"))
 		}
-		panic(fmt.Errorf("message types.Vout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.StreamingQuotePoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Vout) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StreamingQuotePoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.Vout", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.StreamingQuotePoint", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13231,7 +15614,7 @@ func (x *fastReflection_Vout) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Vout) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StreamingQuotePoint) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13242,7 +15625,7 @@ func (x *fastReflection_Vout) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vout) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StreamingQuotePoint) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13254,7 +15637,7 @@ func (x *fastReflection_Vout) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Vout) IsValid() bool {
+func (x *fastReflection_StreamingQuotePoint) IsValid() bool {
 	return x != nil
 }
 
@@ -13264,9 +15647,9 @@ func (x *fastReflection_Vout) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StreamingQuotePoint) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Vout)
+		x := input.Message.Interface().(*StreamingQuotePoint)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13278,16 +15661,21 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Type_)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
 		}
-		l = len(x.Data)
+		if x.Quantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quantity))
+		}
+		if x.Blocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Blocks))
+		}
+		l = len(x.ExpectedSwapOutput)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Amount != 0 {
-			n += 1 + runtime.Sov(uint64(x.Amount))
+		if x.SlippageBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlippageBps))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -13299,7 +15687,7 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Vout)
+		x := input.Message.Interface().(*StreamingQuotePoint)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13318,24 +15706,32 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+		if x.SlippageBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlippageBps))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ExpectedSwapOutput) > 0 {
+			i -= len(x.ExpectedSwapOutput)
+			copy(dAtA[i:], x.ExpectedSwapOutput)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedSwapOutput)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Blocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Blocks))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if x.Quantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quantity))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Type_) > 0 {
-			i -= len(x.Type_)
-			copy(dAtA[i:], x.Type_)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -13348,7 +15744,7 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Vout)
+		x := input.Message.Interface().(*StreamingQuotePoint)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13380,17 +15776,17 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vout: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamingQuotePoint: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vout: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamingQuotePoint: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 				}
-				var stringLen uint64
+				x.Interval = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13400,27 +15796,52 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Interval |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.Quantity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Quantity |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 				}
-				x.Type_ = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
+				x.Blocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Blocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedSwapOutput", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -13448,13 +15869,13 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = string(dAtA[iNdEx:postIndex])
+				x.ExpectedSwapOutput = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlippageBps", wireType)
 				}
-				x.Amount = 0
+				x.SlippageBps = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13464,7 +15885,7 @@ func (x *fastReflection_Vout) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Amount |= int64(b&0x7F) << shift
+					x.SlippageBps |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -13535,6 +15956,10 @@ type QueryQuoteSwapRequest struct {
 	Height                string   `protobuf:"bytes,11,opt,name=height,proto3" json:"height,omitempty"`
 	LiquidityToleranceBps string   `protobuf:"bytes,12,opt,name=liquidity_tolerance_bps,json=liquidityToleranceBps,proto3" json:"liquidity_tolerance_bps,omitempty"`
 	Extended              bool     `protobuf:"varint,13,opt,name=extended,proto3" json:"extended,omitempty"`
+	// search the streaming interval and quantity maximising the output, streaming_quantity must not be set
+	OptimizeStreaming bool `protobuf:"varint,14,opt,name=optimize_streaming,json=optimizeStreaming,proto3" json:"optimize_streaming,omitempty"`
+	// the maximum duration of the searched streaming swap in seconds, unbounded if not set
+	MaxStreamingSeconds string `protobuf:"bytes,15,opt,name=max_streaming_seconds,json=maxStreamingSeconds,proto3" json:"max_streaming_seconds,omitempty"`
 }
 
 func (x *QueryQuoteSwapRequest) Reset() {
@@ -13638,14 +16063,28 @@ func (x *QueryQuoteSwapRequest) GetLiquidityToleranceBps() string {
 	if x != nil {
 		return x.LiquidityToleranceBps
 	}
-	return ""
+	return ""
+}
+
+func (x *QueryQuoteSwapRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *QueryQuoteSwapRequest) GetOptimizeStreaming() bool {
+	if x != nil {
+		return x.OptimizeStreaming
+	}
+	return false
 }
 
-func (x *QueryQuoteSwapRequest) GetExtended() bool {
+func (x *QueryQuoteSwapRequest) GetMaxStreamingSeconds() string {
 	if x != nil {
-		return x.Extended
+		return x.MaxStreamingSeconds
 	}
-	return false
+	return ""
 }
 
 type QueryQuoteSwapResponse struct {
@@ -13694,6 +16133,8 @@ type QueryQuoteSwapResponse struct {
 	TotalSwapSeconds int64 `protobuf:"varint,20,opt,name=total_swap_seconds,json=totalSwapSeconds,proto3" json:"total_swap_seconds,omitempty"`
 	// List of outputs needed (additional to deposit and change return). Meant for wallets to easily construct transactions with more than 80bytes
 	Vout []*Vout `protobuf:"bytes,21,rep,name=vout,proto3" json:"vout,omitempty"`
+	// the expected output of the searched streaming quantities, set if optimize_streaming was requested
+	StreamingCurve []*StreamingQuotePoint `protobuf:"bytes,22,rep,name=streaming_curve,json=streamingCurve,proto3" json:"streaming_curve,omitempty"`
 }
 
 func (x *QueryQuoteSwapResponse) Reset() {
@@ -13863,6 +16304,139 @@ func (x *QueryQuoteSwapResponse) GetVout() []*Vout {
 	return nil
 }
 
+func (x *QueryQuoteSwapResponse) GetStreamingCurve() []*StreamingQuotePoint {
+	if x != nil {
+		return x.StreamingCurve
+	}
+	return nil
+}
+
+type QueryQuoteSwapBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the swap quotes to evaluate, each with its own route and streaming parameters
+	Quotes []*QueryQuoteSwapRequest `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	// the height all quotes are evaluated at
+	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryQuoteSwapBatchRequest) Reset() {
+	*x = QueryQuoteSwapBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_quotes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryQuoteSwapBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryQuoteSwapBatchRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryQuoteSwapBatchRequest.ProtoReflect.Descriptor instead.
+func (*QueryQuoteSwapBatchRequest) Descriptor() ([]byte, []int) {
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryQuoteSwapBatchRequest) GetQuotes() []*QueryQuoteSwapRequest {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+func (x *QueryQuoteSwapBatchRequest) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+type QuoteSwapBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the quote, empty if the entry failed
+	Quote *QueryQuoteSwapResponse `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	// the reason the entry failed, empty on success
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *QuoteSwapBatchResult) Reset() {
+	*x = QuoteSwapBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_quotes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteSwapBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSwapBatchResult) ProtoMessage() {}
+
+// Deprecated: Use QuoteSwapBatchResult.ProtoReflect.Descriptor instead.
+func (*QuoteSwapBatchResult) Descriptor() ([]byte, []int) {
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuoteSwapBatchResult) GetQuote() *QueryQuoteSwapResponse {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *QuoteSwapBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type QueryQuoteSwapBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the result of each quote, in the order of the request
+	Results []*QuoteSwapBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *QueryQuoteSwapBatchResponse) Reset() {
+	*x = QueryQuoteSwapBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_quotes_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryQuoteSwapBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryQuoteSwapBatchResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryQuoteSwapBatchResponse.ProtoReflect.Descriptor instead.
+func (*QueryQuoteSwapBatchResponse) Descriptor() ([]byte, []int) {
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryQuoteSwapBatchResponse) GetResults() []*QuoteSwapBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type QueryQuoteSaverDepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -13878,7 +16452,7 @@ type QueryQuoteSaverDepositRequest struct {
 func (x *QueryQuoteSaverDepositRequest) Reset() {
 	*x = QueryQuoteSaverDepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[2]
+		mi := &file_types_query_quotes_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13892,7 +16466,7 @@ func (*QueryQuoteSaverDepositRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteSaverDepositRequest.ProtoReflect.Descriptor instead.
 func (*QueryQuoteSaverDepositRequest) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{2}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *QueryQuoteSaverDepositRequest) GetAsset() string {
@@ -13973,7 +16547,7 @@ type QueryQuoteSaverDepositResponse struct {
 func (x *QueryQuoteSaverDepositResponse) Reset() {
 	*x = QueryQuoteSaverDepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[3]
+		mi := &file_types_query_quotes_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13987,7 +16561,7 @@ func (*QueryQuoteSaverDepositResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteSaverDepositResponse.ProtoReflect.Descriptor instead.
 func (*QueryQuoteSaverDepositResponse) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{3}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryQuoteSaverDepositResponse) GetInboundAddress() string {
@@ -14123,7 +16697,7 @@ type QueryQuoteSaverWithdrawRequest struct {
 func (x *QueryQuoteSaverWithdrawRequest) Reset() {
 	*x = QueryQuoteSaverWithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[4]
+		mi := &file_types_query_quotes_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14137,7 +16711,7 @@ func (*QueryQuoteSaverWithdrawRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteSaverWithdrawRequest.ProtoReflect.Descriptor instead.
 func (*QueryQuoteSaverWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{4}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryQuoteSaverWithdrawRequest) GetAsset() string {
@@ -14211,7 +16785,7 @@ type QueryQuoteSaverWithdrawResponse struct {
 func (x *QueryQuoteSaverWithdrawResponse) Reset() {
 	*x = QueryQuoteSaverWithdrawResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[5]
+		mi := &file_types_query_quotes_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14225,7 +16799,7 @@ func (*QueryQuoteSaverWithdrawResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteSaverWithdrawResponse.ProtoReflect.Descriptor instead.
 func (*QueryQuoteSaverWithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{5}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryQuoteSaverWithdrawResponse) GetInboundAddress() string {
@@ -14365,7 +16939,7 @@ type QueryQuoteLoanOpenRequest struct {
 func (x *QueryQuoteLoanOpenRequest) Reset() {
 	*x = QueryQuoteLoanOpenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[6]
+		mi := &file_types_query_quotes_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14379,7 +16953,7 @@ func (*QueryQuoteLoanOpenRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteLoanOpenRequest.ProtoReflect.Descriptor instead.
 func (*QueryQuoteLoanOpenRequest) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{6}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryQuoteLoanOpenRequest) GetFromAsset() string {
@@ -14491,7 +17065,7 @@ type QueryQuoteLoanOpenResponse struct {
 func (x *QueryQuoteLoanOpenResponse) Reset() {
 	*x = QueryQuoteLoanOpenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[7]
+		mi := &file_types_query_quotes_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14505,7 +17079,7 @@ func (*QueryQuoteLoanOpenResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteLoanOpenResponse.ProtoReflect.Descriptor instead.
 func (*QueryQuoteLoanOpenResponse) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{7}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryQuoteLoanOpenResponse) GetInboundAddress() string {
@@ -14678,7 +17252,7 @@ type QueryQuoteLoanCloseRequest struct {
 func (x *QueryQuoteLoanCloseRequest) Reset() {
 	*x = QueryQuoteLoanCloseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[8]
+		mi := &file_types_query_quotes_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14692,7 +17266,7 @@ func (*QueryQuoteLoanCloseRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteLoanCloseRequest.ProtoReflect.Descriptor instead.
 func (*QueryQuoteLoanCloseRequest) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{8}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryQuoteLoanCloseRequest) GetFromAsset() string {
@@ -14790,7 +17364,7 @@ type QueryQuoteLoanCloseResponse struct {
 func (x *QueryQuoteLoanCloseResponse) Reset() {
 	*x = QueryQuoteLoanCloseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[9]
+		mi := &file_types_query_quotes_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14804,7 +17378,7 @@ func (*QueryQuoteLoanCloseResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryQuoteLoanCloseResponse.ProtoReflect.Descriptor instead.
 func (*QueryQuoteLoanCloseResponse) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{9}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryQuoteLoanCloseResponse) GetInboundAddress() string {
@@ -14985,7 +17559,7 @@ type QuoteFees struct {
 func (x *QuoteFees) Reset() {
 	*x = QuoteFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[10]
+		mi := &file_types_query_quotes_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14999,7 +17573,7 @@ func (*QuoteFees) ProtoMessage() {}
 
 // Deprecated: Use QuoteFees.ProtoReflect.Descriptor instead.
 func (*QuoteFees) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{10}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QuoteFees) GetAsset() string {
@@ -15067,7 +17641,7 @@ type Vout struct {
 func (x *Vout) Reset() {
 	*x = Vout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_query_quotes_proto_msgTypes[11]
+		mi := &file_types_query_quotes_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15081,7 +17655,7 @@ func (*Vout) ProtoMessage() {}
 
 // Deprecated: Use Vout.ProtoReflect.Descriptor instead.
 func (*Vout) Descriptor() ([]byte, []int) {
-	return file_types_query_quotes_proto_rawDescGZIP(), []int{11}
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Vout) GetType_() string {
@@ -15105,13 +17679,85 @@ func (x *Vout) GetAmount() int64 {
 	return 0
 }
 
+type StreamingQuotePoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the streaming interval in blocks
+	Interval int64 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// the number of sub-swaps
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the number of blocks the streaming swap will execute over
+	Blocks int64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// the expected amount of the target asset out of the pools, before the outbound and affiliate fees
+	ExpectedSwapOutput string `protobuf:"bytes,4,opt,name=expected_swap_output,json=expectedSwapOutput,proto3" json:"expected_swap_output,omitempty"`
+	// the swap slippage in basis points
+	SlippageBps int64 `protobuf:"varint,5,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+}
+
+func (x *StreamingQuotePoint) Reset() {
+	*x = StreamingQuotePoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_quotes_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingQuotePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingQuotePoint) ProtoMessage() {}
+
+// Deprecated: Use StreamingQuotePoint.ProtoReflect.Descriptor instead.
+func (*StreamingQuotePoint) Descriptor() ([]byte, []int) {
+	return file_types_query_quotes_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StreamingQuotePoint) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *StreamingQuotePoint) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StreamingQuotePoint) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *StreamingQuotePoint) GetExpectedSwapOutput() string {
+	if x != nil {
+		return x.ExpectedSwapOutput
+	}
+	return ""
+}
+
+func (x *StreamingQuotePoint) GetSlippageBps() int64 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
 var File_types_query_quotes_proto protoreflect.FileDescriptor
 
 var file_types_query_quotes_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x71, 0x75,
 	0x6f, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x03, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x04, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74,
@@ -15141,158 +17787,262 @@ var file_types_query_quotes_proto_rawDesc = []byte{
 	0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x69, 0x71,
 	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x42,
 	0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xd5,
-	0x08, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x13,
-	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52,
-	0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x66, 0x65, 0x65, 0x73, 0x52,
-	0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a,
-	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea,
-	0xde, 0x1f, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x79, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75, 0x73,
-	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
-	0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x47, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x52, 0x11, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74,
-	0x12, 0x50, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x14, 0x6d, 0x61,
-	0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x4d, 0x0a, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
-	0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x13, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x15, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x75, 0x74,
-	0x52, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c,
-	0x69, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74,
-	0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x66, 0x66,
-	0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x89, 0x07, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65,
-	0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea,
-	0xde, 0x1f, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a,
+	0x15, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x61,
+	0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x9a, 0x09, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x69, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6f, 0x75, 0x74, 0x62,
 	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e,
-	0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0x08,
-	0xea, 0xde, 0x1f, 0x04, 0x66, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f,
-	0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-	0x67, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x6e, 0x12, 0x4a, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x52, 0x12, 0x72, 0x65,
+	0x64, 0x73, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x66, 0x65,
+	0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x12, 0x22, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x75, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x30,
+	0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65,
 	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x38, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69,
-	0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x67, 0x61,
-	0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c, 0x67, 0x61,
-	0x73, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65,
-	0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x6d, 0x65,
-	0x6d, 0x6f, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x53, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x8b, 0x01,
-	0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65,
-	0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x70, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x42, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb7, 0x07, 0x0a, 0x1f,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3c, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x69, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x69,
+	0x12, 0x24, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x47, 0x0a, 0x13, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75,
+	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74,
+	0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x12, 0x50, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x14, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74,
+	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56,
+	0x6f, 0x75, 0x74, 0x52, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x16, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x76, 0x65, 0x22, 0x6a,
+	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x61, 0x0a, 0x14, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x61, 0x0a,
+	0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0xa8, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53,
+	0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65,
+	0x42, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x89, 0x07, 0x0a, 0x1e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x69, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x69, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x19, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x1c,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x1a, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x66, 0x65,
+	0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x12, 0x22, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x75, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x4a,
+	0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde,
+	0x1f, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x67, 0x61,
+	0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x55,
+	0x6e, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x52, 0x04, 0x6d, 0x65,
+	0x6d, 0x6f, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x12, 0x53, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x70, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb7, 0x07, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0f, 0x69, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x15, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04,
+	0x66, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde,
+	0x1f, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x12, 0x4a, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xea, 0xde, 0x1f, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0e,
+	0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x52, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x64,
+	0x75, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x75, 0x73, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x52, 0x11, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22,
+	0x83, 0x02, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f,
+	0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x6f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x66,
+	0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x66, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf9, 0x0a, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
 	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a,
 	0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01,
@@ -15337,249 +18087,192 @@ var file_types_query_quotes_proto_rawDesc = []byte{
 	0x74, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75,
 	0x6e, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e,
 	0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c,
-	0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x04,
-	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04,
-	0x6d, 0x65, 0x6d, 0x6f, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x64, 0x75,
-	0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x0a, 0x64, 0x75, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x13,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x6f, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f,
-	0x75, 0x74, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65,
-	0x42, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf9, 0x0a, 0x0a, 0x1a,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70,
-	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x69, 0x6e, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
-	0x13, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x52, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x66, 0x65, 0x65, 0x73,
-	0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x22,
-	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a,
-	0xea, 0xde, 0x1f, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75,
-	0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x4a, 0x0a, 0x14,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75,
-	0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69,
-	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x47, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x52, 0x11, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12,
-	0x6e, 0x0a, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xea, 0xde, 0x1f, 0x20, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x52,
-	0x1e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
-	0x65, 0x0a, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x1d, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52, 0x1b, 0x65, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x52, 0x12,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65, 0x62, 0x74, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x64, 0x12, 0x4d, 0x0a, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
-	0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x13, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x12, 0x50, 0x0a, 0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x14, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x12, 0x52, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x6f, 0x61, 0x6e,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72,
+	0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x12, 0x47, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea,
+	0xde, 0x1f, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x6e, 0x0a, 0x20, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x24, 0xea, 0xde, 0x1f, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x52, 0x1e, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x65, 0x0a, 0x1d, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0xea, 0xde, 0x1f, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x65, 0x64, 0x52, 0x1b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64,
+	0x12, 0x4a, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x62,
+	0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xea, 0xde, 0x1f, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x62,
+	0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x44, 0x65, 0x62, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x15,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f,
+	0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x53, 0x77, 0x61, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f,
+	0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x52, 0x0a,
+	0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1b,
+	0xea, 0xde, 0x1f, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x14, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0xc3, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x6f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x62, 0x70, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x79, 0x42, 0x70, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa1, 0x0a, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70,
-	0x61, 0x79, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x61, 0x79, 0x42, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x61, 0x6e,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa1, 0x0a,
-	0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x69, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x6f, 0x75, 0x74,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61,
-	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6f, 0x75, 0x74,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x66,
-	0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x12, 0x22, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x77, 0x61, 0x72, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f,
-	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a,
-	0x0e, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
-	0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12,
-	0x30, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67,
-	0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74,
-	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e,
-	0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x52, 0x61,
-	0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x52,
-	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x47, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x52, 0x11, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x44,
-	0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x69, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x6e, 0x12, 0x65, 0x0a, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xea, 0xde, 0x1f,
-	0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x52, 0x1b,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x4a, 0x0a, 0x14, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x72, 0x65, 0x70,
-	0x61, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x72, 0x65, 0x70,
-	0x61, 0x69, 0x64, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65, 0x62,
-	0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x52, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61,
-	0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x11,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x22, 0x95, 0x02, 0x0a, 0x09, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12,
-	0x1f, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xea, 0xde, 0x1f, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xea,
-	0xde, 0x1f, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x09, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x6c, 0x69, 0x70,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10,
-	0xea, 0xde, 0x1f, 0x0c, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73,
-	0x52, 0x0b, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x42, 0x70, 0x73, 0x12, 0x2a, 0x0a,
-	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x70, 0x73, 0x52,
-	0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x70, 0x73, 0x22, 0x66, 0x0a, 0x04, 0x56, 0x6f, 0x75,
-	0x74, 0x12, 0x1c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xea, 0xde, 0x1f, 0x04, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xea,
-	0xde, 0x1f, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea,
-	0xde, 0x1f, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x8c, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42,
-	0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58,
-	0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xc8, 0xe2, 0x1e, 0x01,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x4d, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x13, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x50, 0x0a, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x14, 0x6f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x46,
+	0x65, 0x65, 0x73, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x66, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66,
+	0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea, 0xde, 0x1f,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12,
+	0x25, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75, 0x73, 0x74, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x75, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x39,
+	0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x69,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x47, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x67,
+	0x61, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xea, 0xde, 0x1f, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12,
+	0x47, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xea, 0xde,
+	0x1f, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x44, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x65,
+	0x0a, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x52, 0x1b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x4a, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x52, 0x12, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65, 0x62, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x64, 0x12, 0x4d, 0x0a, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x19, 0xea, 0xde, 0x1f, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x13, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x50, 0x0a, 0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x14, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x47, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x17, 0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x65, 0x70, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x09,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x66,
+	0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x73, 0x6c,
+	0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x52, 0x0b, 0x73, 0x6c, 0x69, 0x70,
+	0x70, 0x61, 0x67, 0x65, 0x42, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x70, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x42, 0x70, 0x73, 0x22, 0x66, 0x0a, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8e, 0x02, 0x0a, 0x13,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0c, 0xea, 0xde, 0x1f, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x4a, 0x0a, 0x14, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61,
+	0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x6c, 0x69, 0x70, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0xea,
+	0xde, 0x1f, 0x0c, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x52,
+	0x0b, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x42, 0x70, 0x73, 0x42, 0x8c, 0x01, 0xc8,
+	0xe2, 0x1e, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x10,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa,
+	0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2,
+	0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -15594,33 +18287,41 @@ func file_types_query_quotes_proto_rawDescGZIP() []byte {
 	return file_types_query_quotes_proto_rawDescData
 }
 
-var file_types_query_quotes_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_types_query_quotes_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_types_query_quotes_proto_goTypes = []interface{}{
 	(*QueryQuoteSwapRequest)(nil),           // 0: types.QueryQuoteSwapRequest
 	(*QueryQuoteSwapResponse)(nil),          // 1: types.QueryQuoteSwapResponse
-	(*QueryQuoteSaverDepositRequest)(nil),   // 2: types.QueryQuoteSaverDepositRequest
-	(*QueryQuoteSaverDepositResponse)(nil),  // 3: types.QueryQuoteSaverDepositResponse
-	(*QueryQuoteSaverWithdrawRequest)(nil),  // 4: types.QueryQuoteSaverWithdrawRequest
-	(*QueryQuoteSaverWithdrawResponse)(nil), // 5: types.QueryQuoteSaverWithdrawResponse
-	(*QueryQuoteLoanOpenRequest)(nil),       // 6: types.QueryQuoteLoanOpenRequest
-	(*QueryQuoteLoanOpenResponse)(nil),      // 7: types.QueryQuoteLoanOpenResponse
-	(*QueryQuoteLoanCloseRequest)(nil),      // 8: types.QueryQuoteLoanCloseRequest
-	(*QueryQuoteLoanCloseResponse)(nil),     // 9: types.QueryQuoteLoanCloseResponse
-	(*QuoteFees)(nil),                       // 10: types.QuoteFees
-	(*Vout)(nil),                            // 11: types.Vout
+	(*QueryQuoteSwapBatchRequest)(nil),      // 2: types.QueryQuoteSwapBatchRequest
+	(*QuoteSwapBatchResult)(nil),            // 3: types.QuoteSwapBatchResult
+	(*QueryQuoteSwapBatchResponse)(nil),     // 4: types.QueryQuoteSwapBatchResponse
+	(*QueryQuoteSaverDepositRequest)(nil),   // 5: types.QueryQuoteSaverDepositRequest
+	(*QueryQuoteSaverDepositResponse)(nil),  // 6: types.QueryQuoteSaverDepositResponse
+	(*QueryQuoteSaverWithdrawRequest)(nil),  // 7: types.QueryQuoteSaverWithdrawRequest
+	(*QueryQuoteSaverWithdrawResponse)(nil), // 8: types.QueryQuoteSaverWithdrawResponse
+	(*QueryQuoteLoanOpenRequest)(nil),       // 9: types.QueryQuoteLoanOpenRequest
+	(*QueryQuoteLoanOpenResponse)(nil),      // 10: types.QueryQuoteLoanOpenResponse
+	(*QueryQuoteLoanCloseRequest)(nil),      // 11: types.QueryQuoteLoanCloseRequest
+	(*QueryQuoteLoanCloseResponse)(nil),     // 12: types.QueryQuoteLoanCloseResponse
+	(*QuoteFees)(nil),                       // 13: types.QuoteFees
+	(*Vout)(nil),                            // 14: types.Vout
+	(*StreamingQuotePoint)(nil),             // 15: types.StreamingQuotePoint
 }
 var file_types_query_quotes_proto_depIdxs = []int32{
-	10, // 0: types.QueryQuoteSwapResponse.fees:type_name -> types.QuoteFees
-	11, // 1: types.QueryQuoteSwapResponse.vout:type_name -> types.Vout
-	10, // 2: types.QueryQuoteSaverDepositResponse.fees:type_name -> types.QuoteFees
-	10, // 3: types.QueryQuoteSaverWithdrawResponse.fees:type_name -> types.QuoteFees
-	10, // 4: types.QueryQuoteLoanOpenResponse.fees:type_name -> types.QuoteFees
-	10, // 5: types.QueryQuoteLoanCloseResponse.fees:type_name -> types.QuoteFees
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	13, // 0: types.QueryQuoteSwapResponse.fees:type_name -> types.QuoteFees
+	14, // 1: types.QueryQuoteSwapResponse.vout:type_name -> types.Vout
+	15, // 2: types.QueryQuoteSwapResponse.streaming_curve:type_name -> types.StreamingQuotePoint
+	0,  // 3: types.QueryQuoteSwapBatchRequest.quotes:type_name -> types.QueryQuoteSwapRequest
+	1,  // 4: types.QuoteSwapBatchResult.quote:type_name -> types.QueryQuoteSwapResponse
+	3,  // 5: types.QueryQuoteSwapBatchResponse.results:type_name -> types.QuoteSwapBatchResult
+	13, // 6: types.QueryQuoteSaverDepositResponse.fees:type_name -> types.QuoteFees
+	13, // 7: types.QueryQuoteSaverWithdrawResponse.fees:type_name -> types.QuoteFees
+	13, // 8: types.QueryQuoteLoanOpenResponse.fees:type_name -> types.QuoteFees
+	13, // 9: types.QueryQuoteLoanCloseResponse.fees:type_name -> types.QuoteFees
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_types_query_quotes_proto_init() }
@@ -15654,7 +18355,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteSaverDepositRequest); i {
+			switch v := v.(*QueryQuoteSwapBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15666,7 +18367,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteSaverDepositResponse); i {
+			switch v := v.(*QuoteSwapBatchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15678,7 +18379,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteSaverWithdrawRequest); i {
+			switch v := v.(*QueryQuoteSwapBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15690,7 +18391,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteSaverWithdrawResponse); i {
+			switch v := v.(*QueryQuoteSaverDepositRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15702,7 +18403,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteLoanOpenRequest); i {
+			switch v := v.(*QueryQuoteSaverDepositResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15714,7 +18415,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteLoanOpenResponse); i {
+			switch v := v.(*QueryQuoteSaverWithdrawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15726,7 +18427,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteLoanCloseRequest); i {
+			switch v := v.(*QueryQuoteSaverWithdrawResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15738,7 +18439,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryQuoteLoanCloseResponse); i {
+			switch v := v.(*QueryQuoteLoanOpenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15750,7 +18451,7 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteFees); i {
+			switch v := v.(*QueryQuoteLoanOpenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15762,6 +18463,42 @@ func file_types_query_quotes_proto_init() {
 			}
 		}
 		file_types_query_quotes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryQuoteLoanCloseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_quotes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryQuoteLoanCloseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_quotes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_quotes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Vout); i {
 			case 0:
 				return &v.state
@@ -15773,6 +18510,18 @@ func file_types_query_quotes_proto_init() {
 				return nil
 			}
 		}
+		file_types_query_quotes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamingQuotePoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -15780,7 +18529,7 @@ func file_types_query_quotes_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_query_quotes_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
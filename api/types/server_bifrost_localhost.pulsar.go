@@ -415,15 +415,115 @@ func (x *_SubscribeRequest_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_SubscribeRequest_2_list)(nil)
+
+type _SubscribeRequest_2_list struct {
+	list *[]string
+}
+
+func (x *_SubscribeRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SubscribeRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SubscribeRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SubscribeRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SubscribeRequest_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SubscribeRequest at list field Chains as it is not of Message kind"))
+}
+
+func (x *_SubscribeRequest_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SubscribeRequest_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SubscribeRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SubscribeRequest_3_list)(nil)
+
+type _SubscribeRequest_3_list struct {
+	list *[]string
+}
+
+func (x *_SubscribeRequest_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SubscribeRequest_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SubscribeRequest_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SubscribeRequest_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SubscribeRequest_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SubscribeRequest at list field PubKeys as it is not of Message kind"))
+}
+
+func (x *_SubscribeRequest_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SubscribeRequest_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SubscribeRequest_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SubscribeRequest             protoreflect.MessageDescriptor
-	fd_SubscribeRequest_event_types protoreflect.FieldDescriptor
+	md_SubscribeRequest               protoreflect.MessageDescriptor
+	fd_SubscribeRequest_event_types   protoreflect.FieldDescriptor
+	fd_SubscribeRequest_chains        protoreflect.FieldDescriptor
+	fd_SubscribeRequest_pub_keys      protoreflect.FieldDescriptor
+	fd_SubscribeRequest_from_sequence protoreflect.FieldDescriptor
+	fd_SubscribeRequest_boot_id       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_server_bifrost_localhost_proto_init()
 	md_SubscribeRequest = File_types_server_bifrost_localhost_proto.Messages().ByName("SubscribeRequest")
 	fd_SubscribeRequest_event_types = md_SubscribeRequest.Fields().ByName("event_types")
+	fd_SubscribeRequest_chains = md_SubscribeRequest.Fields().ByName("chains")
+	fd_SubscribeRequest_pub_keys = md_SubscribeRequest.Fields().ByName("pub_keys")
+	fd_SubscribeRequest_from_sequence = md_SubscribeRequest.Fields().ByName("from_sequence")
+	fd_SubscribeRequest_boot_id = md_SubscribeRequest.Fields().ByName("boot_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_SubscribeRequest)(nil)
@@ -497,6 +597,30 @@ func (x *fastReflection_SubscribeRequest) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if len(x.Chains) != 0 {
+		value := protoreflect.ValueOfList(&_SubscribeRequest_2_list{list: &x.Chains})
+		if !f(fd_SubscribeRequest_chains, value) {
+			return
+		}
+	}
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_SubscribeRequest_3_list{list: &x.PubKeys})
+		if !f(fd_SubscribeRequest_pub_keys, value) {
+			return
+		}
+	}
+	if x.FromSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromSequence)
+		if !f(fd_SubscribeRequest_from_sequence, value) {
+			return
+		}
+	}
+	if x.BootId != "" {
+		value := protoreflect.ValueOfString(x.BootId)
+		if !f(fd_SubscribeRequest_boot_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -514,6 +638,14 @@ func (x *fastReflection_SubscribeRequest) Has(fd protoreflect.FieldDescriptor) b
 	switch fd.FullName() {
 	case "types.SubscribeRequest.event_types":
 		return len(x.EventTypes) != 0
+	case "types.SubscribeRequest.chains":
+		return len(x.Chains) != 0
+	case "types.SubscribeRequest.pub_keys":
+		return len(x.PubKeys) != 0
+	case "types.SubscribeRequest.from_sequence":
+		return x.FromSequence != uint64(0)
+	case "types.SubscribeRequest.boot_id":
+		return x.BootId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.SubscribeRequest"))
@@ -532,6 +664,14 @@ func (x *fastReflection_SubscribeRequest) Clear(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "types.SubscribeRequest.event_types":
 		x.EventTypes = nil
+	case "types.SubscribeRequest.chains":
+		x.Chains = nil
+	case "types.SubscribeRequest.pub_keys":
+		x.PubKeys = nil
+	case "types.SubscribeRequest.from_sequence":
+		x.FromSequence = uint64(0)
+	case "types.SubscribeRequest.boot_id":
+		x.BootId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.SubscribeRequest"))
@@ -554,6 +694,24 @@ func (x *fastReflection_SubscribeRequest) Get(descriptor protoreflect.FieldDescr
 		}
 		listValue := &_SubscribeRequest_1_list{list: &x.EventTypes}
 		return protoreflect.ValueOfList(listValue)
+	case "types.SubscribeRequest.chains":
+		if len(x.Chains) == 0 {
+			return protoreflect.ValueOfList(&_SubscribeRequest_2_list{})
+		}
+		listValue := &_SubscribeRequest_2_list{list: &x.Chains}
+		return protoreflect.ValueOfList(listValue)
+	case "types.SubscribeRequest.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_SubscribeRequest_3_list{})
+		}
+		listValue := &_SubscribeRequest_3_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "types.SubscribeRequest.from_sequence":
+		value := x.FromSequence
+		return protoreflect.ValueOfUint64(value)
+	case "types.SubscribeRequest.boot_id":
+		value := x.BootId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.SubscribeRequest"))
@@ -578,6 +736,18 @@ func (x *fastReflection_SubscribeRequest) Set(fd protoreflect.FieldDescriptor, v
 		lv := value.List()
 		clv := lv.(*_SubscribeRequest_1_list)
 		x.EventTypes = *clv.list
+	case "types.SubscribeRequest.chains":
+		lv := value.List()
+		clv := lv.(*_SubscribeRequest_2_list)
+		x.Chains = *clv.list
+	case "types.SubscribeRequest.pub_keys":
+		lv := value.List()
+		clv := lv.(*_SubscribeRequest_3_list)
+		x.PubKeys = *clv.list
+	case "types.SubscribeRequest.from_sequence":
+		x.FromSequence = value.Uint()
+	case "types.SubscribeRequest.boot_id":
+		x.BootId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.SubscribeRequest"))
@@ -604,6 +774,22 @@ func (x *fastReflection_SubscribeRequest) Mutable(fd protoreflect.FieldDescripto
 		}
 		value := &_SubscribeRequest_1_list{list: &x.EventTypes}
 		return protoreflect.ValueOfList(value)
+	case "types.SubscribeRequest.chains":
+		if x.Chains == nil {
+			x.Chains = []string{}
+		}
+		value := &_SubscribeRequest_2_list{list: &x.Chains}
+		return protoreflect.ValueOfList(value)
+	case "types.SubscribeRequest.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = []string{}
+		}
+		value := &_SubscribeRequest_3_list{list: &x.PubKeys}
+		return protoreflect.ValueOfList(value)
+	case "types.SubscribeRequest.from_sequence":
+		panic(fmt.Errorf("field from_sequence of message types.SubscribeRequest is not mutable"))
+	case "types.SubscribeRequest.boot_id":
+		panic(fmt.Errorf("field boot_id of message types.SubscribeRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.SubscribeRequest"))
@@ -620,6 +806,16 @@ func (x *fastReflection_SubscribeRequest) NewField(fd protoreflect.FieldDescript
 	case "types.SubscribeRequest.event_types":
 		list := []string{}
 		return protoreflect.ValueOfList(&_SubscribeRequest_1_list{list: &list})
+	case "types.SubscribeRequest.chains":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SubscribeRequest_2_list{list: &list})
+	case "types.SubscribeRequest.pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SubscribeRequest_3_list{list: &list})
+	case "types.SubscribeRequest.from_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "types.SubscribeRequest.boot_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.SubscribeRequest"))
@@ -695,6 +891,25 @@ func (x *fastReflection_SubscribeRequest) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Chains) > 0 {
+			for _, s := range x.Chains {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PubKeys) > 0 {
+			for _, s := range x.PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FromSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromSequence))
+		}
+		l = len(x.BootId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -724,6 +939,36 @@ func (x *fastReflection_SubscribeRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BootId) > 0 {
+			i -= len(x.BootId)
+			copy(dAtA[i:], x.BootId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BootId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.FromSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromSequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Chains) > 0 {
+			for iNdEx := len(x.Chains) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Chains[iNdEx])
+				copy(dAtA[i:], x.Chains[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chains[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.EventTypes) > 0 {
 			for iNdEx := len(x.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.EventTypes[iNdEx])
@@ -814,6 +1059,121 @@ func (x *fastReflection_SubscribeRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.EventTypes = append(x.EventTypes, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chains = append(x.Chains, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeys = append(x.PubKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+				}
+				x.FromSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BootId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BootId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -854,6 +1214,10 @@ var (
 	fd_EventNotification_event_type protoreflect.FieldDescriptor
 	fd_EventNotification_payload    protoreflect.FieldDescriptor
 	fd_EventNotification_timestamp  protoreflect.FieldDescriptor
+	fd_EventNotification_sequence   protoreflect.FieldDescriptor
+	fd_EventNotification_chain      protoreflect.FieldDescriptor
+	fd_EventNotification_pub_key    protoreflect.FieldDescriptor
+	fd_EventNotification_boot_id    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -862,6 +1226,10 @@ func init() {
 	fd_EventNotification_event_type = md_EventNotification.Fields().ByName("event_type")
 	fd_EventNotification_payload = md_EventNotification.Fields().ByName("payload")
 	fd_EventNotification_timestamp = md_EventNotification.Fields().ByName("timestamp")
+	fd_EventNotification_sequence = md_EventNotification.Fields().ByName("sequence")
+	fd_EventNotification_chain = md_EventNotification.Fields().ByName("chain")
+	fd_EventNotification_pub_key = md_EventNotification.Fields().ByName("pub_key")
+	fd_EventNotification_boot_id = md_EventNotification.Fields().ByName("boot_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventNotification)(nil)
@@ -947,6 +1315,30 @@ func (x *fastReflection_EventNotification) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_EventNotification_sequence, value) {
+			return
+		}
+	}
+	if x.Chain != "" {
+		value := protoreflect.ValueOfString(x.Chain)
+		if !f(fd_EventNotification_chain, value) {
+			return
+		}
+	}
+	if x.PubKey != "" {
+		value := protoreflect.ValueOfString(x.PubKey)
+		if !f(fd_EventNotification_pub_key, value) {
+			return
+		}
+	}
+	if x.BootId != "" {
+		value := protoreflect.ValueOfString(x.BootId)
+		if !f(fd_EventNotification_boot_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -968,6 +1360,14 @@ func (x *fastReflection_EventNotification) Has(fd protoreflect.FieldDescriptor)
 		return len(x.Payload) != 0
 	case "types.EventNotification.timestamp":
 		return x.Timestamp != int64(0)
+	case "types.EventNotification.sequence":
+		return x.Sequence != uint64(0)
+	case "types.EventNotification.chain":
+		return x.Chain != ""
+	case "types.EventNotification.pub_key":
+		return x.PubKey != ""
+	case "types.EventNotification.boot_id":
+		return x.BootId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventNotification"))
@@ -990,6 +1390,14 @@ func (x *fastReflection_EventNotification) Clear(fd protoreflect.FieldDescriptor
 		x.Payload = nil
 	case "types.EventNotification.timestamp":
 		x.Timestamp = int64(0)
+	case "types.EventNotification.sequence":
+		x.Sequence = uint64(0)
+	case "types.EventNotification.chain":
+		x.Chain = ""
+	case "types.EventNotification.pub_key":
+		x.PubKey = ""
+	case "types.EventNotification.boot_id":
+		x.BootId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventNotification"))
@@ -1015,6 +1423,18 @@ func (x *fastReflection_EventNotification) Get(descriptor protoreflect.FieldDesc
 	case "types.EventNotification.timestamp":
 		value := x.Timestamp
 		return protoreflect.ValueOfInt64(value)
+	case "types.EventNotification.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "types.EventNotification.chain":
+		value := x.Chain
+		return protoreflect.ValueOfString(value)
+	case "types.EventNotification.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfString(value)
+	case "types.EventNotification.boot_id":
+		value := x.BootId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventNotification"))
@@ -1041,6 +1461,14 @@ func (x *fastReflection_EventNotification) Set(fd protoreflect.FieldDescriptor,
 		x.Payload = value.Bytes()
 	case "types.EventNotification.timestamp":
 		x.Timestamp = value.Int()
+	case "types.EventNotification.sequence":
+		x.Sequence = value.Uint()
+	case "types.EventNotification.chain":
+		x.Chain = value.Interface().(string)
+	case "types.EventNotification.pub_key":
+		x.PubKey = value.Interface().(string)
+	case "types.EventNotification.boot_id":
+		x.BootId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventNotification"))
@@ -1067,6 +1495,14 @@ func (x *fastReflection_EventNotification) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field payload of message types.EventNotification is not mutable"))
 	case "types.EventNotification.timestamp":
 		panic(fmt.Errorf("field timestamp of message types.EventNotification is not mutable"))
+	case "types.EventNotification.sequence":
+		panic(fmt.Errorf("field sequence of message types.EventNotification is not mutable"))
+	case "types.EventNotification.chain":
+		panic(fmt.Errorf("field chain of message types.EventNotification is not mutable"))
+	case "types.EventNotification.pub_key":
+		panic(fmt.Errorf("field pub_key of message types.EventNotification is not mutable"))
+	case "types.EventNotification.boot_id":
+		panic(fmt.Errorf("field boot_id of message types.EventNotification is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventNotification"))
@@ -1086,6 +1522,14 @@ func (x *fastReflection_EventNotification) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfBytes(nil)
 	case "types.EventNotification.timestamp":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "types.EventNotification.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "types.EventNotification.chain":
+		return protoreflect.ValueOfString("")
+	case "types.EventNotification.pub_key":
+		return protoreflect.ValueOfString("")
+	case "types.EventNotification.boot_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventNotification"))
@@ -1166,6 +1610,21 @@ func (x *fastReflection_EventNotification) ProtoMethods() *protoiface.Methods {
 		if x.Timestamp != 0 {
 			n += 1 + runtime.Sov(uint64(x.Timestamp))
 		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Chain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BootId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1195,14 +1654,40 @@ func (x *fastReflection_EventNotification) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Timestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+		if len(x.BootId) > 0 {
+			i -= len(x.BootId)
+			copy(dAtA[i:], x.BootId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BootId)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x3a
 		}
-		if len(x.Payload) > 0 {
-			i -= len(x.Payload)
-			copy(dAtA[i:], x.Payload)
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Chain) > 0 {
+			i -= len(x.Chain)
+			copy(dAtA[i:], x.Chain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chain)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
 			i--
 			dAtA[i] = 0x12
@@ -1348,6 +1833,121 @@ func (x *fastReflection_EventNotification) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BootId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BootId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2451,155 +3051,2085 @@ func (x *fastReflection_SendQuorumErrataTxResult) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: types/server_bifrost_localhost.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.List = (*_PendingItemsRequest_1_list)(nil)
 
-// SendQuorumTxResult is the empty return type
-type SendQuorumTxResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type _PendingItemsRequest_1_list struct {
+	list *[]string
 }
 
-func (x *SendQuorumTxResult) Reset() {
-	*x = SendQuorumTxResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_types_server_bifrost_localhost_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_PendingItemsRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *SendQuorumTxResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_PendingItemsRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (*SendQuorumTxResult) ProtoMessage() {}
+func (x *_PendingItemsRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use SendQuorumTxResult.ProtoReflect.Descriptor instead.
-func (*SendQuorumTxResult) Descriptor() ([]byte, []int) {
-	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{0}
+func (x *_PendingItemsRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-type SubscribeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_PendingItemsRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PendingItemsRequest at list field ItemTypes as it is not of Message kind"))
+}
 
-	// Optional fields to filter what events to subscribe to
-	EventTypes []string `protobuf:"bytes,1,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+func (x *_PendingItemsRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *SubscribeRequest) Reset() {
-	*x = SubscribeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_types_server_bifrost_localhost_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_PendingItemsRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *SubscribeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_PendingItemsRequest_1_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (*SubscribeRequest) ProtoMessage() {}
+var (
+	md_PendingItemsRequest            protoreflect.MessageDescriptor
+	fd_PendingItemsRequest_item_types protoreflect.FieldDescriptor
+)
 
-// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{1}
+func init() {
+	file_types_server_bifrost_localhost_proto_init()
+	md_PendingItemsRequest = File_types_server_bifrost_localhost_proto.Messages().ByName("PendingItemsRequest")
+	fd_PendingItemsRequest_item_types = md_PendingItemsRequest.Fields().ByName("item_types")
 }
 
-func (x *SubscribeRequest) GetEventTypes() []string {
-	if x != nil {
-		return x.EventTypes
-	}
-	return nil
-}
+var _ protoreflect.Message = (*fastReflection_PendingItemsRequest)(nil)
 
-type EventNotification struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_PendingItemsRequest PendingItemsRequest
 
-	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (x *PendingItemsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingItemsRequest)(x)
 }
 
-func (x *EventNotification) Reset() {
-	*x = EventNotification{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_types_server_bifrost_localhost_proto_msgTypes[2]
+func (x *PendingItemsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_server_bifrost_localhost_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EventNotification) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_PendingItemsRequest_messageType fastReflection_PendingItemsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_PendingItemsRequest_messageType{}
 
-func (*EventNotification) ProtoMessage() {}
+type fastReflection_PendingItemsRequest_messageType struct{}
 
-// Deprecated: Use EventNotification.ProtoReflect.Descriptor instead.
-func (*EventNotification) Descriptor() ([]byte, []int) {
-	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{2}
+func (x fastReflection_PendingItemsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingItemsRequest)(nil)
 }
-
-func (x *EventNotification) GetEventType() string {
-	if x != nil {
-		return x.EventType
-	}
-	return ""
+func (x fastReflection_PendingItemsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingItemsRequest)
 }
-
-func (x *EventNotification) GetPayload() []byte {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
+func (x fastReflection_PendingItemsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingItemsRequest
 }
 
-func (x *EventNotification) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingItemsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingItemsRequest
 }
 
-// SendQuorumNetworkFeeResult is the empty return type
-type SendQuorumNetworkFeeResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingItemsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_PendingItemsRequest_messageType
 }
 
-func (x *SendQuorumNetworkFeeResult) Reset() {
-	*x = SendQuorumNetworkFeeResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_types_server_bifrost_localhost_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingItemsRequest) New() protoreflect.Message {
+	return new(fastReflection_PendingItemsRequest)
 }
 
-func (x *SendQuorumNetworkFeeResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingItemsRequest) Interface() protoreflect.ProtoMessage {
+	return (*PendingItemsRequest)(x)
 }
 
-func (*SendQuorumNetworkFeeResult) ProtoMessage() {}
-
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingItemsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ItemTypes) != 0 {
+		value := protoreflect.ValueOfList(&_PendingItemsRequest_1_list{list: &x.ItemTypes})
+		if !f(fd_PendingItemsRequest_item_types, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingItemsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.PendingItemsRequest.item_types":
+		return len(x.ItemTypes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsRequest"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.PendingItemsRequest.item_types":
+		x.ItemTypes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsRequest"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingItemsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.PendingItemsRequest.item_types":
+		if len(x.ItemTypes) == 0 {
+			return protoreflect.ValueOfList(&_PendingItemsRequest_1_list{})
+		}
+		listValue := &_PendingItemsRequest_1_list{list: &x.ItemTypes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsRequest"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.PendingItemsRequest.item_types":
+		lv := value.List()
+		clv := lv.(*_PendingItemsRequest_1_list)
+		x.ItemTypes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsRequest"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.PendingItemsRequest.item_types":
+		if x.ItemTypes == nil {
+			x.ItemTypes = []string{}
+		}
+		value := &_PendingItemsRequest_1_list{list: &x.ItemTypes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsRequest"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingItemsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.PendingItemsRequest.item_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PendingItemsRequest_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsRequest"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingItemsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.PendingItemsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingItemsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingItemsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingItemsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingItemsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ItemTypes) > 0 {
+			for _, s := range x.ItemTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingItemsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ItemTypes) > 0 {
+			for iNdEx := len(x.ItemTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ItemTypes[iNdEx])
+				copy(dAtA[i:], x.ItemTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ItemTypes[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingItemsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingItemsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ItemTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ItemTypes = append(x.ItemTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PendingItem_4_list)(nil)
+
+type _PendingItem_4_list struct {
+	list *[]string
+}
+
+func (x *_PendingItem_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingItem_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PendingItem_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingItem_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingItem_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PendingItem at list field Attesters as it is not of Message kind"))
+}
+
+func (x *_PendingItem_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingItem_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PendingItem_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PendingItem               protoreflect.MessageDescriptor
+	fd_PendingItem_item_type     protoreflect.FieldDescriptor
+	fd_PendingItem_chain         protoreflect.FieldDescriptor
+	fd_PendingItem_id            protoreflect.FieldDescriptor
+	fd_PendingItem_attesters     protoreflect.FieldDescriptor
+	fd_PendingItem_updated       protoreflect.FieldDescriptor
+	fd_PendingItem_expires_in    protoreflect.FieldDescriptor
+	fd_PendingItem_failed_height protoreflect.FieldDescriptor
+	fd_PendingItem_reason        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_server_bifrost_localhost_proto_init()
+	md_PendingItem = File_types_server_bifrost_localhost_proto.Messages().ByName("PendingItem")
+	fd_PendingItem_item_type = md_PendingItem.Fields().ByName("item_type")
+	fd_PendingItem_chain = md_PendingItem.Fields().ByName("chain")
+	fd_PendingItem_id = md_PendingItem.Fields().ByName("id")
+	fd_PendingItem_attesters = md_PendingItem.Fields().ByName("attesters")
+	fd_PendingItem_updated = md_PendingItem.Fields().ByName("updated")
+	fd_PendingItem_expires_in = md_PendingItem.Fields().ByName("expires_in")
+	fd_PendingItem_failed_height = md_PendingItem.Fields().ByName("failed_height")
+	fd_PendingItem_reason = md_PendingItem.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingItem)(nil)
+
+type fastReflection_PendingItem PendingItem
+
+func (x *PendingItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingItem)(x)
+}
+
+func (x *PendingItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_server_bifrost_localhost_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingItem_messageType fastReflection_PendingItem_messageType
+var _ protoreflect.MessageType = fastReflection_PendingItem_messageType{}
+
+type fastReflection_PendingItem_messageType struct{}
+
+func (x fastReflection_PendingItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingItem)(nil)
+}
+func (x fastReflection_PendingItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingItem)
+}
+func (x fastReflection_PendingItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingItem) Type() protoreflect.MessageType {
+	return _fastReflection_PendingItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingItem) New() protoreflect.Message {
+	return new(fastReflection_PendingItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingItem) Interface() protoreflect.ProtoMessage {
+	return (*PendingItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ItemType != "" {
+		value := protoreflect.ValueOfString(x.ItemType)
+		if !f(fd_PendingItem_item_type, value) {
+			return
+		}
+	}
+	if x.Chain != "" {
+		value := protoreflect.ValueOfString(x.Chain)
+		if !f(fd_PendingItem_chain, value) {
+			return
+		}
+	}
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_PendingItem_id, value) {
+			return
+		}
+	}
+	if len(x.Attesters) != 0 {
+		value := protoreflect.ValueOfList(&_PendingItem_4_list{list: &x.Attesters})
+		if !f(fd_PendingItem_attesters, value) {
+			return
+		}
+	}
+	if x.Updated != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Updated)
+		if !f(fd_PendingItem_updated, value) {
+			return
+		}
+	}
+	if x.ExpiresIn != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresIn)
+		if !f(fd_PendingItem_expires_in, value) {
+			return
+		}
+	}
+	if x.FailedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FailedHeight)
+		if !f(fd_PendingItem_failed_height, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_PendingItem_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.PendingItem.item_type":
+		return x.ItemType != ""
+	case "types.PendingItem.chain":
+		return x.Chain != ""
+	case "types.PendingItem.id":
+		return x.Id != ""
+	case "types.PendingItem.attesters":
+		return len(x.Attesters) != 0
+	case "types.PendingItem.updated":
+		return x.Updated != int64(0)
+	case "types.PendingItem.expires_in":
+		return x.ExpiresIn != int64(0)
+	case "types.PendingItem.failed_height":
+		return x.FailedHeight != int64(0)
+	case "types.PendingItem.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItem"))
+		}
+		panic(fmt.Errorf("message types.PendingItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.PendingItem.item_type":
+		x.ItemType = ""
+	case "types.PendingItem.chain":
+		x.Chain = ""
+	case "types.PendingItem.id":
+		x.Id = ""
+	case "types.PendingItem.attesters":
+		x.Attesters = nil
+	case "types.PendingItem.updated":
+		x.Updated = int64(0)
+	case "types.PendingItem.expires_in":
+		x.ExpiresIn = int64(0)
+	case "types.PendingItem.failed_height":
+		x.FailedHeight = int64(0)
+	case "types.PendingItem.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItem"))
+		}
+		panic(fmt.Errorf("message types.PendingItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.PendingItem.item_type":
+		value := x.ItemType
+		return protoreflect.ValueOfString(value)
+	case "types.PendingItem.chain":
+		value := x.Chain
+		return protoreflect.ValueOfString(value)
+	case "types.PendingItem.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "types.PendingItem.attesters":
+		if len(x.Attesters) == 0 {
+			return protoreflect.ValueOfList(&_PendingItem_4_list{})
+		}
+		listValue := &_PendingItem_4_list{list: &x.Attesters}
+		return protoreflect.ValueOfList(listValue)
+	case "types.PendingItem.updated":
+		value := x.Updated
+		return protoreflect.ValueOfInt64(value)
+	case "types.PendingItem.expires_in":
+		value := x.ExpiresIn
+		return protoreflect.ValueOfInt64(value)
+	case "types.PendingItem.failed_height":
+		value := x.FailedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "types.PendingItem.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItem"))
+		}
+		panic(fmt.Errorf("message types.PendingItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.PendingItem.item_type":
+		x.ItemType = value.Interface().(string)
+	case "types.PendingItem.chain":
+		x.Chain = value.Interface().(string)
+	case "types.PendingItem.id":
+		x.Id = value.Interface().(string)
+	case "types.PendingItem.attesters":
+		lv := value.List()
+		clv := lv.(*_PendingItem_4_list)
+		x.Attesters = *clv.list
+	case "types.PendingItem.updated":
+		x.Updated = value.Int()
+	case "types.PendingItem.expires_in":
+		x.ExpiresIn = value.Int()
+	case "types.PendingItem.failed_height":
+		x.FailedHeight = value.Int()
+	case "types.PendingItem.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItem"))
+		}
+		panic(fmt.Errorf("message types.PendingItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.PendingItem.attesters":
+		if x.Attesters == nil {
+			x.Attesters = []string{}
+		}
+		value := &_PendingItem_4_list{list: &x.Attesters}
+		return protoreflect.ValueOfList(value)
+	case "types.PendingItem.item_type":
+		panic(fmt.Errorf("field item_type of message types.PendingItem is not mutable"))
+	case "types.PendingItem.chain":
+		panic(fmt.Errorf("field chain of message types.PendingItem is not mutable"))
+	case "types.PendingItem.id":
+		panic(fmt.Errorf("field id of message types.PendingItem is not mutable"))
+	case "types.PendingItem.updated":
+		panic(fmt.Errorf("field updated of message types.PendingItem is not mutable"))
+	case "types.PendingItem.expires_in":
+		panic(fmt.Errorf("field expires_in of message types.PendingItem is not mutable"))
+	case "types.PendingItem.failed_height":
+		panic(fmt.Errorf("field failed_height of message types.PendingItem is not mutable"))
+	case "types.PendingItem.reason":
+		panic(fmt.Errorf("field reason of message types.PendingItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItem"))
+		}
+		panic(fmt.Errorf("message types.PendingItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.PendingItem.item_type":
+		return protoreflect.ValueOfString("")
+	case "types.PendingItem.chain":
+		return protoreflect.ValueOfString("")
+	case "types.PendingItem.id":
+		return protoreflect.ValueOfString("")
+	case "types.PendingItem.attesters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PendingItem_4_list{list: &list})
+	case "types.PendingItem.updated":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.PendingItem.expires_in":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.PendingItem.failed_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.PendingItem.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItem"))
+		}
+		panic(fmt.Errorf("message types.PendingItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.PendingItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ItemType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Chain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Attesters) > 0 {
+			for _, s := range x.Attesters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Updated != 0 {
+			n += 1 + runtime.Sov(uint64(x.Updated))
+		}
+		if x.ExpiresIn != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresIn))
+		}
+		if x.FailedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailedHeight))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.FailedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailedHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ExpiresIn != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresIn))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Updated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Updated))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Attesters) > 0 {
+			for iNdEx := len(x.Attesters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Attesters[iNdEx])
+				copy(dAtA[i:], x.Attesters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attesters[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Chain) > 0 {
+			i -= len(x.Chain)
+			copy(dAtA[i:], x.Chain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chain)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ItemType) > 0 {
+			i -= len(x.ItemType)
+			copy(dAtA[i:], x.ItemType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ItemType)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ItemType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ItemType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attesters = append(x.Attesters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				}
+				x.Updated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Updated |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+				}
+				x.ExpiresIn = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresIn |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedHeight", wireType)
+				}
+				x.FailedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PendingItemsResponse_1_list)(nil)
+
+type _PendingItemsResponse_1_list struct {
+	list *[]*PendingItem
+}
+
+func (x *_PendingItemsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingItemsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PendingItemsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingItem)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingItemsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingItem)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingItemsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PendingItem)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingItemsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingItemsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PendingItem)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingItemsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PendingItemsResponse       protoreflect.MessageDescriptor
+	fd_PendingItemsResponse_items protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_server_bifrost_localhost_proto_init()
+	md_PendingItemsResponse = File_types_server_bifrost_localhost_proto.Messages().ByName("PendingItemsResponse")
+	fd_PendingItemsResponse_items = md_PendingItemsResponse.Fields().ByName("items")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingItemsResponse)(nil)
+
+type fastReflection_PendingItemsResponse PendingItemsResponse
+
+func (x *PendingItemsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingItemsResponse)(x)
+}
+
+func (x *PendingItemsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_server_bifrost_localhost_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingItemsResponse_messageType fastReflection_PendingItemsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_PendingItemsResponse_messageType{}
+
+type fastReflection_PendingItemsResponse_messageType struct{}
+
+func (x fastReflection_PendingItemsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingItemsResponse)(nil)
+}
+func (x fastReflection_PendingItemsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingItemsResponse)
+}
+func (x fastReflection_PendingItemsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingItemsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingItemsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingItemsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingItemsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_PendingItemsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingItemsResponse) New() protoreflect.Message {
+	return new(fastReflection_PendingItemsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingItemsResponse) Interface() protoreflect.ProtoMessage {
+	return (*PendingItemsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingItemsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Items) != 0 {
+		value := protoreflect.ValueOfList(&_PendingItemsResponse_1_list{list: &x.Items})
+		if !f(fd_PendingItemsResponse_items, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingItemsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.PendingItemsResponse.items":
+		return len(x.Items) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsResponse"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.PendingItemsResponse.items":
+		x.Items = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsResponse"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingItemsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.PendingItemsResponse.items":
+		if len(x.Items) == 0 {
+			return protoreflect.ValueOfList(&_PendingItemsResponse_1_list{})
+		}
+		listValue := &_PendingItemsResponse_1_list{list: &x.Items}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsResponse"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.PendingItemsResponse.items":
+		lv := value.List()
+		clv := lv.(*_PendingItemsResponse_1_list)
+		x.Items = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsResponse"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.PendingItemsResponse.items":
+		if x.Items == nil {
+			x.Items = []*PendingItem{}
+		}
+		value := &_PendingItemsResponse_1_list{list: &x.Items}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsResponse"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingItemsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.PendingItemsResponse.items":
+		list := []*PendingItem{}
+		return protoreflect.ValueOfList(&_PendingItemsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.PendingItemsResponse"))
+		}
+		panic(fmt.Errorf("message types.PendingItemsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingItemsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.PendingItemsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingItemsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingItemsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingItemsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingItemsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingItemsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Items) > 0 {
+			for _, e := range x.Items {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingItemsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Items) > 0 {
+			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Items[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingItemsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingItemsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Items = append(x.Items, &PendingItem{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Items[len(x.Items)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: types/server_bifrost_localhost.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SendQuorumTxResult is the empty return type
+type SendQuorumTxResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendQuorumTxResult) Reset() {
+	*x = SendQuorumTxResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_server_bifrost_localhost_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendQuorumTxResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendQuorumTxResult) ProtoMessage() {}
+
+// Deprecated: Use SendQuorumTxResult.ProtoReflect.Descriptor instead.
+func (*SendQuorumTxResult) Descriptor() ([]byte, []int) {
+	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{0}
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional fields to filter what events to subscribe to, all events if empty
+	EventTypes []string `protobuf:"bytes,1,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Optional chains to filter events by, all chains if empty
+	Chains []string `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+	// Optional vault pubkeys to filter events by, events without a vault are
+	// filtered out when set
+	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// Resume the subscription after the given sequence, replaying the buffered
+	// events that were emitted since. Zero to only receive new events.
+	FromSequence uint64 `protobuf:"varint,4,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Boot id of the stream from_sequence was received from. When it doesn't
+	// match the current one switchlynode restarted since, and all the buffered
+	// events are replayed.
+	BootId string `protobuf:"bytes,5,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_server_bifrost_localhost_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscribeRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetChains() []string {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetPubKeys() []string {
+	if x != nil {
+		return x.PubKeys
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetBootId() string {
+	if x != nil {
+		return x.BootId
+	}
+	return ""
+}
+
+type EventNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// monotonically increasing sequence of the event, restarts from 1 when
+	// switchlynode restarts
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Chain    string `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
+	// vault the event relates to, empty if none
+	PubKey string `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// random id of the switchlynode process the sequence was assigned by
+	BootId string `protobuf:"bytes,7,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`
+}
+
+func (x *EventNotification) Reset() {
+	*x = EventNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_server_bifrost_localhost_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventNotification) ProtoMessage() {}
+
+// Deprecated: Use EventNotification.ProtoReflect.Descriptor instead.
+func (*EventNotification) Descriptor() ([]byte, []int) {
+	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventNotification) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EventNotification) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EventNotification) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *EventNotification) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *EventNotification) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *EventNotification) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *EventNotification) GetBootId() string {
+	if x != nil {
+		return x.BootId
+	}
+	return ""
+}
+
+// SendQuorumNetworkFeeResult is the empty return type
+type SendQuorumNetworkFeeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendQuorumNetworkFeeResult) Reset() {
+	*x = SendQuorumNetworkFeeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_server_bifrost_localhost_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendQuorumNetworkFeeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendQuorumNetworkFeeResult) ProtoMessage() {}
+
 // Deprecated: Use SendQuorumNetworkFeeResult.ProtoReflect.Descriptor instead.
 func (*SendQuorumNetworkFeeResult) Descriptor() ([]byte, []int) {
 	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{3}
@@ -2659,6 +5189,175 @@ func (*SendQuorumErrataTxResult) Descriptor() ([]byte, []int) {
 	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{5}
 }
 
+type PendingItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional fields to filter what item types to list
+	ItemTypes []string `protobuf:"bytes,1,rep,name=item_types,json=itemTypes,proto3" json:"item_types,omitempty"`
+}
+
+func (x *PendingItemsRequest) Reset() {
+	*x = PendingItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_server_bifrost_localhost_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingItemsRequest) ProtoMessage() {}
+
+// Deprecated: Use PendingItemsRequest.ProtoReflect.Descriptor instead.
+func (*PendingItemsRequest) Descriptor() ([]byte, []int) {
+	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PendingItemsRequest) GetItemTypes() []string {
+	if x != nil {
+		return x.ItemTypes
+	}
+	return nil
+}
+
+type PendingItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// quorum_tx, quorum_network_fee, quorum_solvency or quorum_errata_tx
+	ItemType string `protobuf:"bytes,1,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Chain    string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	// tx hash, network fee / solvency height or errata tx id
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// public keys of the nodes whose attestations haven't been committed yet
+	Attesters []string `protobuf:"bytes,4,rep,name=attesters,proto3" json:"attesters,omitempty"`
+	// unix time the item was last updated
+	Updated int64 `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	// seconds until the item is pruned, 0 if items don't expire
+	ExpiresIn int64 `protobuf:"varint,6,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// height of the last block in which the injected tx carrying the item failed
+	FailedHeight int64 `protobuf:"varint,7,opt,name=failed_height,json=failedHeight,proto3" json:"failed_height,omitempty"`
+	// why the item has not been committed yet
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PendingItem) Reset() {
+	*x = PendingItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_server_bifrost_localhost_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingItem) ProtoMessage() {}
+
+// Deprecated: Use PendingItem.ProtoReflect.Descriptor instead.
+func (*PendingItem) Descriptor() ([]byte, []int) {
+	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PendingItem) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *PendingItem) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *PendingItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingItem) GetAttesters() []string {
+	if x != nil {
+		return x.Attesters
+	}
+	return nil
+}
+
+func (x *PendingItem) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *PendingItem) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *PendingItem) GetFailedHeight() int64 {
+	if x != nil {
+		return x.FailedHeight
+	}
+	return 0
+}
+
+func (x *PendingItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PendingItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*PendingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *PendingItemsResponse) Reset() {
+	*x = PendingItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_server_bifrost_localhost_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingItemsResponse) ProtoMessage() {}
+
+// Deprecated: Use PendingItemsResponse.ProtoReflect.Descriptor instead.
+func (*PendingItemsResponse) Descriptor() ([]byte, []int) {
+	return file_types_server_bifrost_localhost_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PendingItemsResponse) GetItems() []*PendingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_types_server_bifrost_localhost_proto protoreflect.FileDescriptor
 
 var file_types_server_bifrost_localhost_proto_rawDesc = []byte{
@@ -2667,57 +5366,97 @@ var file_types_server_bifrost_localhost_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x13, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
-	0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x6a, 0x0a,
-	0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x6e,
-	0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x51,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75,
-	0x6d, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32,
-	0x8c, 0x03, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x66,
-	0x72, 0x6f, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72,
-	0x75, 0x6d, 0x54, 0x78, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75,
-	0x6f, 0x72, 0x75, 0x6d, 0x54, 0x78, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x53, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x46, 0x65, 0x65, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75,
-	0x6f, 0x72, 0x75, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x6f, 0x6c, 0x76,
-	0x65, 0x6e, 0x63, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x12, 0x16, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x61, 0x74,
-	0x61, 0x54, 0x78, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x54, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x42, 0x93,
-	0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x1b, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x42, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x68, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x22,
+	0xce, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x74, 0x49, 0x64,
+	0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1a,
+	0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x6f, 0x6c, 0x76,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65,
+	0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x34, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xe4, 0x01, 0x0a,
+	0x0b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x32, 0xd8, 0x03, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x68,
+	0x6f, 0x73, 0x74, 0x42, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x53, 0x65,
+	0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x54, 0x78, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x54, 0x78, 0x1a, 0x19, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x53, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x51,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x12,
+	0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a, 0x12,
+	0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x53,
+	0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54,
+	0x78, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x61,
+	0x74, 0x61, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x93, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x1b,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2732,7 +5471,7 @@ func file_types_server_bifrost_localhost_proto_rawDescGZIP() []byte {
 	return file_types_server_bifrost_localhost_proto_rawDescData
 }
 
-var file_types_server_bifrost_localhost_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_types_server_bifrost_localhost_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_types_server_bifrost_localhost_proto_goTypes = []interface{}{
 	(*SendQuorumTxResult)(nil),         // 0: types.SendQuorumTxResult
 	(*SubscribeRequest)(nil),           // 1: types.SubscribeRequest
@@ -2740,27 +5479,33 @@ var file_types_server_bifrost_localhost_proto_goTypes = []interface{}{
 	(*SendQuorumNetworkFeeResult)(nil), // 3: types.SendQuorumNetworkFeeResult
 	(*SendQuorumSolvencyResult)(nil),   // 4: types.SendQuorumSolvencyResult
 	(*SendQuorumErrataTxResult)(nil),   // 5: types.SendQuorumErrataTxResult
-	(*common.QuorumTx)(nil),            // 6: common.QuorumTx
-	(*common.QuorumNetworkFee)(nil),    // 7: common.QuorumNetworkFee
-	(*common.QuorumSolvency)(nil),      // 8: common.QuorumSolvency
-	(*common.QuorumErrataTx)(nil),      // 9: common.QuorumErrataTx
+	(*PendingItemsRequest)(nil),        // 6: types.PendingItemsRequest
+	(*PendingItem)(nil),                // 7: types.PendingItem
+	(*PendingItemsResponse)(nil),       // 8: types.PendingItemsResponse
+	(*common.QuorumTx)(nil),            // 9: common.QuorumTx
+	(*common.QuorumNetworkFee)(nil),    // 10: common.QuorumNetworkFee
+	(*common.QuorumSolvency)(nil),      // 11: common.QuorumSolvency
+	(*common.QuorumErrataTx)(nil),      // 12: common.QuorumErrataTx
 }
 var file_types_server_bifrost_localhost_proto_depIdxs = []int32{
-	6, // 0: types.LocalhostBifrost.SendQuorumTx:input_type -> common.QuorumTx
-	7, // 1: types.LocalhostBifrost.SendQuorumNetworkFee:input_type -> common.QuorumNetworkFee
-	8, // 2: types.LocalhostBifrost.SendQuorumSolvency:input_type -> common.QuorumSolvency
-	9, // 3: types.LocalhostBifrost.SendQuorumErrataTx:input_type -> common.QuorumErrataTx
-	1, // 4: types.LocalhostBifrost.SubscribeToEvents:input_type -> types.SubscribeRequest
-	0, // 5: types.LocalhostBifrost.SendQuorumTx:output_type -> types.SendQuorumTxResult
-	3, // 6: types.LocalhostBifrost.SendQuorumNetworkFee:output_type -> types.SendQuorumNetworkFeeResult
-	4, // 7: types.LocalhostBifrost.SendQuorumSolvency:output_type -> types.SendQuorumSolvencyResult
-	5, // 8: types.LocalhostBifrost.SendQuorumErrataTx:output_type -> types.SendQuorumErrataTxResult
-	2, // 9: types.LocalhostBifrost.SubscribeToEvents:output_type -> types.EventNotification
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	7,  // 0: types.PendingItemsResponse.items:type_name -> types.PendingItem
+	9,  // 1: types.LocalhostBifrost.SendQuorumTx:input_type -> common.QuorumTx
+	10, // 2: types.LocalhostBifrost.SendQuorumNetworkFee:input_type -> common.QuorumNetworkFee
+	11, // 3: types.LocalhostBifrost.SendQuorumSolvency:input_type -> common.QuorumSolvency
+	12, // 4: types.LocalhostBifrost.SendQuorumErrataTx:input_type -> common.QuorumErrataTx
+	1,  // 5: types.LocalhostBifrost.SubscribeToEvents:input_type -> types.SubscribeRequest
+	6,  // 6: types.LocalhostBifrost.GetPendingItems:input_type -> types.PendingItemsRequest
+	0,  // 7: types.LocalhostBifrost.SendQuorumTx:output_type -> types.SendQuorumTxResult
+	3,  // 8: types.LocalhostBifrost.SendQuorumNetworkFee:output_type -> types.SendQuorumNetworkFeeResult
+	4,  // 9: types.LocalhostBifrost.SendQuorumSolvency:output_type -> types.SendQuorumSolvencyResult
+	5,  // 10: types.LocalhostBifrost.SendQuorumErrataTx:output_type -> types.SendQuorumErrataTxResult
+	2,  // 11: types.LocalhostBifrost.SubscribeToEvents:output_type -> types.EventNotification
+	8,  // 12: types.LocalhostBifrost.GetPendingItems:output_type -> types.PendingItemsResponse
+	7,  // [7:13] is the sub-list for method output_type
+	1,  // [1:7] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_types_server_bifrost_localhost_proto_init() }
@@ -2841,6 +5586,42 @@ func file_types_server_bifrost_localhost_proto_init() {
 				return nil
 			}
 		}
+		file_types_server_bifrost_localhost_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_server_bifrost_localhost_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_server_bifrost_localhost_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingItemsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2848,7 +5629,7 @@ func file_types_server_bifrost_localhost_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_server_bifrost_localhost_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,7 @@ const (
 	LocalhostBifrost_SendQuorumSolvency_FullMethodName   = "/types.LocalhostBifrost/SendQuorumSolvency"
 	LocalhostBifrost_SendQuorumErrataTx_FullMethodName   = "/types.LocalhostBifrost/SendQuorumErrataTx"
 	LocalhostBifrost_SubscribeToEvents_FullMethodName    = "/types.LocalhostBifrost/SubscribeToEvents"
+	LocalhostBifrost_GetPendingItems_FullMethodName      = "/types.LocalhostBifrost/GetPendingItems"
 )
 
 // LocalhostBifrostClient is the client API for LocalhostBifrost service.
@@ -37,6 +38,8 @@ type LocalhostBifrostClient interface {
 	SendQuorumErrataTx(ctx context.Context, in *common.QuorumErrataTx, opts ...grpc.CallOption) (*SendQuorumErrataTxResult, error)
 	// Server streaming for notifications
 	SubscribeToEvents(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (LocalhostBifrost_SubscribeToEventsClient, error)
+	// Lists the items waiting in the inject caches and why they haven't been committed yet
+	GetPendingItems(ctx context.Context, in *PendingItemsRequest, opts ...grpc.CallOption) (*PendingItemsResponse, error)
 }
 
 type localhostBifrostClient struct {
@@ -115,6 +118,15 @@ func (x *localhostBifrostSubscribeToEventsClient) Recv() (*EventNotification, er
 	return m, nil
 }
 
+func (c *localhostBifrostClient) GetPendingItems(ctx context.Context, in *PendingItemsRequest, opts ...grpc.CallOption) (*PendingItemsResponse, error) {
+	out := new(PendingItemsResponse)
+	err := c.cc.Invoke(ctx, LocalhostBifrost_GetPendingItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LocalhostBifrostServer is the server API for LocalhostBifrost service.
 // All implementations must embed UnimplementedLocalhostBifrostServer
 // for forward compatibility
@@ -125,6 +137,8 @@ type LocalhostBifrostServer interface {
 	SendQuorumErrataTx(context.Context, *common.QuorumErrataTx) (*SendQuorumErrataTxResult, error)
 	// Server streaming for notifications
 	SubscribeToEvents(*SubscribeRequest, LocalhostBifrost_SubscribeToEventsServer) error
+	// Lists the items waiting in the inject caches and why they haven't been committed yet
+	GetPendingItems(context.Context, *PendingItemsRequest) (*PendingItemsResponse, error)
 	mustEmbedUnimplementedLocalhostBifrostServer()
 }
 
@@ -147,6 +161,9 @@ func (UnimplementedLocalhostBifrostServer) SendQuorumErrataTx(context.Context, *
 func (UnimplementedLocalhostBifrostServer) SubscribeToEvents(*SubscribeRequest, LocalhostBifrost_SubscribeToEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToEvents not implemented")
 }
+func (UnimplementedLocalhostBifrostServer) GetPendingItems(context.Context, *PendingItemsRequest) (*PendingItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingItems not implemented")
+}
 func (UnimplementedLocalhostBifrostServer) mustEmbedUnimplementedLocalhostBifrostServer() {}
 
 // UnsafeLocalhostBifrostServer may be embedded to opt out of forward compatibility for this service.
@@ -253,6 +270,24 @@ func (x *localhostBifrostSubscribeToEventsServer) Send(m *EventNotification) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _LocalhostBifrost_GetPendingItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalhostBifrostServer).GetPendingItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocalhostBifrost_GetPendingItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalhostBifrostServer).GetPendingItems(ctx, req.(*PendingItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LocalhostBifrost_ServiceDesc is the grpc.ServiceDesc for LocalhostBifrost service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -276,6 +311,10 @@ var LocalhostBifrost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendQuorumErrataTx",
 			Handler:    _LocalhostBifrost_SendQuorumErrataTx_Handler,
 		},
+		{
+			MethodName: "GetPendingItems",
+			Handler:    _LocalhostBifrost_GetPendingItems_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
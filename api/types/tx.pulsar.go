@@ -418,7 +418,9 @@ var file_types_tx_proto_rawDesc = []byte{
 	0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x13, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x62, 0x61, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67,
-	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x65, 0x64, 0x64, 0x73, 0x61, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x61, 0x74, 0x61,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73,
 	0x67, 0x5f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74,
@@ -442,149 +444,159 @@ var file_types_tx_proto_rawDesc = []byte{
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x73, 0x73, 0x5f, 0x6b, 0x65,
 	0x79, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x73, 0x73, 0x5f,
-	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x75,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x77, 0x61,
-	0x73, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0a,
-	0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xe1, 0x0f, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x12, 0x25, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x72, 0x72, 0x61,
-	0x74, 0x61, 0x54, 0x78, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x45, 0x72, 0x72,
-	0x61, 0x74, 0x61, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x51,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x12,
-	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6d, 0x69, 0x72,
-	0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x33, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x12,
-	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x46, 0x65, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x46, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65,
-	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x54, 0x78, 0x49, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
-	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a,
-	0x0d, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x12, 0x17,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x2e, 0x74,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73,
+	0x67, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x15, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
+	0x2f, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x0a, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xdd, 0x10,
+	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x25, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x0d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x07,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x45,
+	0x64, 0x44, 0x53, 0x41, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x17, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x44, 0x53, 0x41, 0x42, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61,
+	0x54, 0x78, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
+	0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x45, 0x72, 0x72, 0x61, 0x74,
+	0x61, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x51, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x1a, 0x0f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x33, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x46, 0x65, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46,
+	0x65, 0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x51, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78,
+	0x49, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x2e, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x08, 0x54, 0x68, 0x6f,
-	0x72, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x49, 0x50, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x53, 0x65, 0x74, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x0f,
+	0x54, 0x78, 0x4f, 0x75, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78,
+	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x08, 0x54, 0x68, 0x6f, 0x72, 0x53,
+	0x65, 0x6e, 0x64, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x6e, 0x64, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x49, 0x50, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x0f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a,
+	0x0b, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x15, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4b,
+	0x65, 0x79, 0x73, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6f, 0x6c, 0x76,
+	0x65, 0x6e, 0x63, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63,
+	0x79, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e,
+	0x46, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x1a, 0x0f,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x35, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x15,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e,
-	0x63, 0x79, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6f,
-	0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x6f, 0x6c, 0x76, 0x65,
-	0x6e, 0x63, 0x79, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69,
-	0x67, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x46, 0x61, 0x69, 0x6c,
-	0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x2d, 0x0a, 0x07, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x11, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x1a,
-	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x0f,
+	0x2d, 0x0a, 0x07, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x0f,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x39, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x09, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x71, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73,
-	0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x32, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
+	0x33, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39,
+	0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x09, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71,
+	0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
 	0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x65, 0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77,
-	0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d,
-	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x53, 0x75, 0x64, 0x6f, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73,
-	0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x64,
-	0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a,
+	0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x74, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x75, 0x64, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77,
-	0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
-	0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x56, 0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1f, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x32, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e,
+	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
+	0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61,
+	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x53, 0x75, 0x64, 0x6f, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
+	0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x64, 0x6f,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77,
+	0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x64, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61,
+	0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e,
+	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1f, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x27, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x27,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x7f,
-	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x07, 0x54, 0x78, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03,
-	0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x7f, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
+	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54,
+	0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -604,106 +616,112 @@ var file_types_tx_proto_goTypes = []interface{}{
 	(*MsgEmpty)(nil),                              // 0: types.MsgEmpty
 	(*MsgBan)(nil),                                // 1: types.MsgBan
 	(*MsgDeposit)(nil),                            // 2: types.MsgDeposit
-	(*MsgErrataTx)(nil),                           // 3: types.MsgErrataTx
-	(*MsgErrataTxQuorum)(nil),                     // 4: types.MsgErrataTxQuorum
-	(*MsgMimir)(nil),                              // 5: types.MsgMimir
-	(*MsgNetworkFee)(nil),                         // 6: types.MsgNetworkFee
-	(*MsgNetworkFeeQuorum)(nil),                   // 7: types.MsgNetworkFeeQuorum
-	(*MsgNodePauseChain)(nil),                     // 8: types.MsgNodePauseChain
-	(*MsgObservedTxIn)(nil),                       // 9: types.MsgObservedTxIn
-	(*MsgObservedTxOut)(nil),                      // 10: types.MsgObservedTxOut
-	(*MsgObservedTxQuorum)(nil),                   // 11: types.MsgObservedTxQuorum
-	(*MsgSend)(nil),                               // 12: types.MsgSend
-	(*MsgSetIPAddress)(nil),                       // 13: types.MsgSetIPAddress
-	(*MsgSetNodeKeys)(nil),                        // 14: types.MsgSetNodeKeys
-	(*MsgSolvency)(nil),                           // 15: types.MsgSolvency
-	(*MsgSolvencyQuorum)(nil),                     // 16: types.MsgSolvencyQuorum
-	(*MsgTssKeysignFail)(nil),                     // 17: types.MsgTssKeysignFail
-	(*MsgTssPool)(nil),                            // 18: types.MsgTssPool
-	(*MsgSetVersion)(nil),                         // 19: types.MsgSetVersion
-	(*MsgProposeUpgrade)(nil),                     // 20: types.MsgProposeUpgrade
-	(*MsgApproveUpgrade)(nil),                     // 21: types.MsgApproveUpgrade
-	(*MsgRejectUpgrade)(nil),                      // 22: types.MsgRejectUpgrade
-	(*types.MsgStoreCode)(nil),                    // 23: cosmwasm.wasm.v1.MsgStoreCode
-	(*types.MsgInstantiateContract)(nil),          // 24: cosmwasm.wasm.v1.MsgInstantiateContract
-	(*types.MsgInstantiateContract2)(nil),         // 25: cosmwasm.wasm.v1.MsgInstantiateContract2
-	(*types.MsgExecuteContract)(nil),              // 26: cosmwasm.wasm.v1.MsgExecuteContract
-	(*types.MsgMigrateContract)(nil),              // 27: cosmwasm.wasm.v1.MsgMigrateContract
-	(*types.MsgSudoContract)(nil),                 // 28: cosmwasm.wasm.v1.MsgSudoContract
-	(*types.MsgUpdateAdmin)(nil),                  // 29: cosmwasm.wasm.v1.MsgUpdateAdmin
-	(*types.MsgClearAdmin)(nil),                   // 30: cosmwasm.wasm.v1.MsgClearAdmin
-	(*types.MsgStoreCodeResponse)(nil),            // 31: cosmwasm.wasm.v1.MsgStoreCodeResponse
-	(*types.MsgInstantiateContractResponse)(nil),  // 32: cosmwasm.wasm.v1.MsgInstantiateContractResponse
-	(*types.MsgInstantiateContract2Response)(nil), // 33: cosmwasm.wasm.v1.MsgInstantiateContract2Response
-	(*types.MsgExecuteContractResponse)(nil),      // 34: cosmwasm.wasm.v1.MsgExecuteContractResponse
-	(*types.MsgMigrateContractResponse)(nil),      // 35: cosmwasm.wasm.v1.MsgMigrateContractResponse
-	(*types.MsgSudoContractResponse)(nil),         // 36: cosmwasm.wasm.v1.MsgSudoContractResponse
-	(*types.MsgUpdateAdminResponse)(nil),          // 37: cosmwasm.wasm.v1.MsgUpdateAdminResponse
-	(*types.MsgClearAdminResponse)(nil),           // 38: cosmwasm.wasm.v1.MsgClearAdminResponse
+	(*MsgEdDSABackfill)(nil),                      // 3: types.MsgEdDSABackfill
+	(*MsgErrataTx)(nil),                           // 4: types.MsgErrataTx
+	(*MsgErrataTxQuorum)(nil),                     // 5: types.MsgErrataTxQuorum
+	(*MsgMimir)(nil),                              // 6: types.MsgMimir
+	(*MsgNetworkFee)(nil),                         // 7: types.MsgNetworkFee
+	(*MsgNetworkFeeQuorum)(nil),                   // 8: types.MsgNetworkFeeQuorum
+	(*MsgNodePauseChain)(nil),                     // 9: types.MsgNodePauseChain
+	(*MsgObservedTxIn)(nil),                       // 10: types.MsgObservedTxIn
+	(*MsgObservedTxOut)(nil),                      // 11: types.MsgObservedTxOut
+	(*MsgObservedTxQuorum)(nil),                   // 12: types.MsgObservedTxQuorum
+	(*MsgSend)(nil),                               // 13: types.MsgSend
+	(*MsgSetIPAddress)(nil),                       // 14: types.MsgSetIPAddress
+	(*MsgSetNodeKeys)(nil),                        // 15: types.MsgSetNodeKeys
+	(*MsgSolvency)(nil),                           // 16: types.MsgSolvency
+	(*MsgSolvencyQuorum)(nil),                     // 17: types.MsgSolvencyQuorum
+	(*MsgTssKeysignFail)(nil),                     // 18: types.MsgTssKeysignFail
+	(*MsgTssPool)(nil),                            // 19: types.MsgTssPool
+	(*MsgUpdateAggregator)(nil),                   // 20: types.MsgUpdateAggregator
+	(*MsgSetVersion)(nil),                         // 21: types.MsgSetVersion
+	(*MsgProposeUpgrade)(nil),                     // 22: types.MsgProposeUpgrade
+	(*MsgApproveUpgrade)(nil),                     // 23: types.MsgApproveUpgrade
+	(*MsgRejectUpgrade)(nil),                      // 24: types.MsgRejectUpgrade
+	(*types.MsgStoreCode)(nil),                    // 25: cosmwasm.wasm.v1.MsgStoreCode
+	(*types.MsgInstantiateContract)(nil),          // 26: cosmwasm.wasm.v1.MsgInstantiateContract
+	(*types.MsgInstantiateContract2)(nil),         // 27: cosmwasm.wasm.v1.MsgInstantiateContract2
+	(*types.MsgExecuteContract)(nil),              // 28: cosmwasm.wasm.v1.MsgExecuteContract
+	(*types.MsgMigrateContract)(nil),              // 29: cosmwasm.wasm.v1.MsgMigrateContract
+	(*types.MsgSudoContract)(nil),                 // 30: cosmwasm.wasm.v1.MsgSudoContract
+	(*types.MsgUpdateAdmin)(nil),                  // 31: cosmwasm.wasm.v1.MsgUpdateAdmin
+	(*types.MsgClearAdmin)(nil),                   // 32: cosmwasm.wasm.v1.MsgClearAdmin
+	(*types.MsgStoreCodeResponse)(nil),            // 33: cosmwasm.wasm.v1.MsgStoreCodeResponse
+	(*types.MsgInstantiateContractResponse)(nil),  // 34: cosmwasm.wasm.v1.MsgInstantiateContractResponse
+	(*types.MsgInstantiateContract2Response)(nil), // 35: cosmwasm.wasm.v1.MsgInstantiateContract2Response
+	(*types.MsgExecuteContractResponse)(nil),      // 36: cosmwasm.wasm.v1.MsgExecuteContractResponse
+	(*types.MsgMigrateContractResponse)(nil),      // 37: cosmwasm.wasm.v1.MsgMigrateContractResponse
+	(*types.MsgSudoContractResponse)(nil),         // 38: cosmwasm.wasm.v1.MsgSudoContractResponse
+	(*types.MsgUpdateAdminResponse)(nil),          // 39: cosmwasm.wasm.v1.MsgUpdateAdminResponse
+	(*types.MsgClearAdminResponse)(nil),           // 40: cosmwasm.wasm.v1.MsgClearAdminResponse
 }
 var file_types_tx_proto_depIdxs = []int32{
 	1,  // 0: types.Msg.Ban:input_type -> types.MsgBan
 	2,  // 1: types.Msg.Deposit:input_type -> types.MsgDeposit
-	3,  // 2: types.Msg.ErrataTx:input_type -> types.MsgErrataTx
-	4,  // 3: types.Msg.ErrataTxQuorum:input_type -> types.MsgErrataTxQuorum
-	5,  // 4: types.Msg.Mimir:input_type -> types.MsgMimir
-	6,  // 5: types.Msg.NetworkFee:input_type -> types.MsgNetworkFee
-	7,  // 6: types.Msg.NetworkFeeQuorum:input_type -> types.MsgNetworkFeeQuorum
-	8,  // 7: types.Msg.NodePauseChain:input_type -> types.MsgNodePauseChain
-	9,  // 8: types.Msg.ObservedTxIn:input_type -> types.MsgObservedTxIn
-	10, // 9: types.Msg.ObservedTxOut:input_type -> types.MsgObservedTxOut
-	11, // 10: types.Msg.ObservedTxQuorum:input_type -> types.MsgObservedTxQuorum
-	12, // 11: types.Msg.ThorSend:input_type -> types.MsgSend
-	13, // 12: types.Msg.SetIPAddress:input_type -> types.MsgSetIPAddress
-	14, // 13: types.Msg.SetNodeKeys:input_type -> types.MsgSetNodeKeys
-	15, // 14: types.Msg.Solvency:input_type -> types.MsgSolvency
-	16, // 15: types.Msg.SolvencyQuorum:input_type -> types.MsgSolvencyQuorum
-	17, // 16: types.Msg.TssKeysignFail:input_type -> types.MsgTssKeysignFail
-	18, // 17: types.Msg.TssPool:input_type -> types.MsgTssPool
-	19, // 18: types.Msg.SetVersion:input_type -> types.MsgSetVersion
-	20, // 19: types.Msg.ProposeUpgrade:input_type -> types.MsgProposeUpgrade
-	21, // 20: types.Msg.ApproveUpgrade:input_type -> types.MsgApproveUpgrade
-	22, // 21: types.Msg.RejectUpgrade:input_type -> types.MsgRejectUpgrade
-	23, // 22: types.Msg.StoreCode:input_type -> cosmwasm.wasm.v1.MsgStoreCode
-	24, // 23: types.Msg.InstantiateContract:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract
-	25, // 24: types.Msg.InstantiateContract2:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract2
-	26, // 25: types.Msg.ExecuteContract:input_type -> cosmwasm.wasm.v1.MsgExecuteContract
-	27, // 26: types.Msg.MigrateContract:input_type -> cosmwasm.wasm.v1.MsgMigrateContract
-	28, // 27: types.Msg.SudoContract:input_type -> cosmwasm.wasm.v1.MsgSudoContract
-	29, // 28: types.Msg.UpdateAdmin:input_type -> cosmwasm.wasm.v1.MsgUpdateAdmin
-	30, // 29: types.Msg.ClearAdmin:input_type -> cosmwasm.wasm.v1.MsgClearAdmin
-	0,  // 30: types.Msg.Ban:output_type -> types.MsgEmpty
-	0,  // 31: types.Msg.Deposit:output_type -> types.MsgEmpty
-	0,  // 32: types.Msg.ErrataTx:output_type -> types.MsgEmpty
-	0,  // 33: types.Msg.ErrataTxQuorum:output_type -> types.MsgEmpty
-	0,  // 34: types.Msg.Mimir:output_type -> types.MsgEmpty
-	0,  // 35: types.Msg.NetworkFee:output_type -> types.MsgEmpty
-	0,  // 36: types.Msg.NetworkFeeQuorum:output_type -> types.MsgEmpty
-	0,  // 37: types.Msg.NodePauseChain:output_type -> types.MsgEmpty
-	0,  // 38: types.Msg.ObservedTxIn:output_type -> types.MsgEmpty
-	0,  // 39: types.Msg.ObservedTxOut:output_type -> types.MsgEmpty
-	0,  // 40: types.Msg.ObservedTxQuorum:output_type -> types.MsgEmpty
-	0,  // 41: types.Msg.ThorSend:output_type -> types.MsgEmpty
-	0,  // 42: types.Msg.SetIPAddress:output_type -> types.MsgEmpty
-	0,  // 43: types.Msg.SetNodeKeys:output_type -> types.MsgEmpty
-	0,  // 44: types.Msg.Solvency:output_type -> types.MsgEmpty
-	0,  // 45: types.Msg.SolvencyQuorum:output_type -> types.MsgEmpty
-	0,  // 46: types.Msg.TssKeysignFail:output_type -> types.MsgEmpty
-	0,  // 47: types.Msg.TssPool:output_type -> types.MsgEmpty
-	0,  // 48: types.Msg.SetVersion:output_type -> types.MsgEmpty
-	0,  // 49: types.Msg.ProposeUpgrade:output_type -> types.MsgEmpty
-	0,  // 50: types.Msg.ApproveUpgrade:output_type -> types.MsgEmpty
-	0,  // 51: types.Msg.RejectUpgrade:output_type -> types.MsgEmpty
-	31, // 52: types.Msg.StoreCode:output_type -> cosmwasm.wasm.v1.MsgStoreCodeResponse
-	32, // 53: types.Msg.InstantiateContract:output_type -> cosmwasm.wasm.v1.MsgInstantiateContractResponse
-	33, // 54: types.Msg.InstantiateContract2:output_type -> cosmwasm.wasm.v1.MsgInstantiateContract2Response
-	34, // 55: types.Msg.ExecuteContract:output_type -> cosmwasm.wasm.v1.MsgExecuteContractResponse
-	35, // 56: types.Msg.MigrateContract:output_type -> cosmwasm.wasm.v1.MsgMigrateContractResponse
-	36, // 57: types.Msg.SudoContract:output_type -> cosmwasm.wasm.v1.MsgSudoContractResponse
-	37, // 58: types.Msg.UpdateAdmin:output_type -> cosmwasm.wasm.v1.MsgUpdateAdminResponse
-	38, // 59: types.Msg.ClearAdmin:output_type -> cosmwasm.wasm.v1.MsgClearAdminResponse
-	30, // [30:60] is the sub-list for method output_type
-	0,  // [0:30] is the sub-list for method input_type
+	3,  // 2: types.Msg.EdDSABackfill:input_type -> types.MsgEdDSABackfill
+	4,  // 3: types.Msg.ErrataTx:input_type -> types.MsgErrataTx
+	5,  // 4: types.Msg.ErrataTxQuorum:input_type -> types.MsgErrataTxQuorum
+	6,  // 5: types.Msg.Mimir:input_type -> types.MsgMimir
+	7,  // 6: types.Msg.NetworkFee:input_type -> types.MsgNetworkFee
+	8,  // 7: types.Msg.NetworkFeeQuorum:input_type -> types.MsgNetworkFeeQuorum
+	9,  // 8: types.Msg.NodePauseChain:input_type -> types.MsgNodePauseChain
+	10, // 9: types.Msg.ObservedTxIn:input_type -> types.MsgObservedTxIn
+	11, // 10: types.Msg.ObservedTxOut:input_type -> types.MsgObservedTxOut
+	12, // 11: types.Msg.ObservedTxQuorum:input_type -> types.MsgObservedTxQuorum
+	13, // 12: types.Msg.ThorSend:input_type -> types.MsgSend
+	14, // 13: types.Msg.SetIPAddress:input_type -> types.MsgSetIPAddress
+	15, // 14: types.Msg.SetNodeKeys:input_type -> types.MsgSetNodeKeys
+	16, // 15: types.Msg.Solvency:input_type -> types.MsgSolvency
+	17, // 16: types.Msg.SolvencyQuorum:input_type -> types.MsgSolvencyQuorum
+	18, // 17: types.Msg.TssKeysignFail:input_type -> types.MsgTssKeysignFail
+	19, // 18: types.Msg.TssPool:input_type -> types.MsgTssPool
+	20, // 19: types.Msg.UpdateAggregator:input_type -> types.MsgUpdateAggregator
+	21, // 20: types.Msg.SetVersion:input_type -> types.MsgSetVersion
+	22, // 21: types.Msg.ProposeUpgrade:input_type -> types.MsgProposeUpgrade
+	23, // 22: types.Msg.ApproveUpgrade:input_type -> types.MsgApproveUpgrade
+	24, // 23: types.Msg.RejectUpgrade:input_type -> types.MsgRejectUpgrade
+	25, // 24: types.Msg.StoreCode:input_type -> cosmwasm.wasm.v1.MsgStoreCode
+	26, // 25: types.Msg.InstantiateContract:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract
+	27, // 26: types.Msg.InstantiateContract2:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract2
+	28, // 27: types.Msg.ExecuteContract:input_type -> cosmwasm.wasm.v1.MsgExecuteContract
+	29, // 28: types.Msg.MigrateContract:input_type -> cosmwasm.wasm.v1.MsgMigrateContract
+	30, // 29: types.Msg.SudoContract:input_type -> cosmwasm.wasm.v1.MsgSudoContract
+	31, // 30: types.Msg.UpdateAdmin:input_type -> cosmwasm.wasm.v1.MsgUpdateAdmin
+	32, // 31: types.Msg.ClearAdmin:input_type -> cosmwasm.wasm.v1.MsgClearAdmin
+	0,  // 32: types.Msg.Ban:output_type -> types.MsgEmpty
+	0,  // 33: types.Msg.Deposit:output_type -> types.MsgEmpty
+	0,  // 34: types.Msg.EdDSABackfill:output_type -> types.MsgEmpty
+	0,  // 35: types.Msg.ErrataTx:output_type -> types.MsgEmpty
+	0,  // 36: types.Msg.ErrataTxQuorum:output_type -> types.MsgEmpty
+	0,  // 37: types.Msg.Mimir:output_type -> types.MsgEmpty
+	0,  // 38: types.Msg.NetworkFee:output_type -> types.MsgEmpty
+	0,  // 39: types.Msg.NetworkFeeQuorum:output_type -> types.MsgEmpty
+	0,  // 40: types.Msg.NodePauseChain:output_type -> types.MsgEmpty
+	0,  // 41: types.Msg.ObservedTxIn:output_type -> types.MsgEmpty
+	0,  // 42: types.Msg.ObservedTxOut:output_type -> types.MsgEmpty
+	0,  // 43: types.Msg.ObservedTxQuorum:output_type -> types.MsgEmpty
+	0,  // 44: types.Msg.ThorSend:output_type -> types.MsgEmpty
+	0,  // 45: types.Msg.SetIPAddress:output_type -> types.MsgEmpty
+	0,  // 46: types.Msg.SetNodeKeys:output_type -> types.MsgEmpty
+	0,  // 47: types.Msg.Solvency:output_type -> types.MsgEmpty
+	0,  // 48: types.Msg.SolvencyQuorum:output_type -> types.MsgEmpty
+	0,  // 49: types.Msg.TssKeysignFail:output_type -> types.MsgEmpty
+	0,  // 50: types.Msg.TssPool:output_type -> types.MsgEmpty
+	0,  // 51: types.Msg.UpdateAggregator:output_type -> types.MsgEmpty
+	0,  // 52: types.Msg.SetVersion:output_type -> types.MsgEmpty
+	0,  // 53: types.Msg.ProposeUpgrade:output_type -> types.MsgEmpty
+	0,  // 54: types.Msg.ApproveUpgrade:output_type -> types.MsgEmpty
+	0,  // 55: types.Msg.RejectUpgrade:output_type -> types.MsgEmpty
+	33, // 56: types.Msg.StoreCode:output_type -> cosmwasm.wasm.v1.MsgStoreCodeResponse
+	34, // 57: types.Msg.InstantiateContract:output_type -> cosmwasm.wasm.v1.MsgInstantiateContractResponse
+	35, // 58: types.Msg.InstantiateContract2:output_type -> cosmwasm.wasm.v1.MsgInstantiateContract2Response
+	36, // 59: types.Msg.ExecuteContract:output_type -> cosmwasm.wasm.v1.MsgExecuteContractResponse
+	37, // 60: types.Msg.MigrateContract:output_type -> cosmwasm.wasm.v1.MsgMigrateContractResponse
+	38, // 61: types.Msg.SudoContract:output_type -> cosmwasm.wasm.v1.MsgSudoContractResponse
+	39, // 62: types.Msg.UpdateAdmin:output_type -> cosmwasm.wasm.v1.MsgUpdateAdminResponse
+	40, // 63: types.Msg.ClearAdmin:output_type -> cosmwasm.wasm.v1.MsgClearAdminResponse
+	32, // [32:64] is the sub-list for method output_type
+	0,  // [0:32] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -716,6 +734,7 @@ func file_types_tx_proto_init() {
 	}
 	file_types_msg_ban_proto_init()
 	file_types_msg_deposit_proto_init()
+	file_types_msg_eddsa_backfill_proto_init()
 	file_types_msg_errata_proto_init()
 	file_types_msg_mimir_proto_init()
 	file_types_msg_network_fee_proto_init()
@@ -729,6 +748,7 @@ func file_types_tx_proto_init() {
 	file_types_msg_solvency_proto_init()
 	file_types_msg_tss_keysign_fail_proto_init()
 	file_types_msg_tss_pool_proto_init()
+	file_types_msg_update_aggregator_proto_init()
 	file_types_msg_version_proto_init()
 	file_types_msg_upgrade_proto_init()
 	file_types_msg_maint_proto_init()
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_Ban_FullMethodName                  = "/types.Msg/Ban"
 	Msg_Deposit_FullMethodName              = "/types.Msg/Deposit"
+	Msg_EdDSABackfill_FullMethodName        = "/types.Msg/EdDSABackfill"
 	Msg_ErrataTx_FullMethodName             = "/types.Msg/ErrataTx"
 	Msg_ErrataTxQuorum_FullMethodName       = "/types.Msg/ErrataTxQuorum"
 	Msg_Mimir_FullMethodName                = "/types.Msg/Mimir"
@@ -38,6 +39,7 @@ const (
 	Msg_SolvencyQuorum_FullMethodName       = "/types.Msg/SolvencyQuorum"
 	Msg_TssKeysignFail_FullMethodName       = "/types.Msg/TssKeysignFail"
 	Msg_TssPool_FullMethodName              = "/types.Msg/TssPool"
+	Msg_UpdateAggregator_FullMethodName     = "/types.Msg/UpdateAggregator"
 	Msg_SetVersion_FullMethodName           = "/types.Msg/SetVersion"
 	Msg_ProposeUpgrade_FullMethodName       = "/types.Msg/ProposeUpgrade"
 	Msg_ApproveUpgrade_FullMethodName       = "/types.Msg/ApproveUpgrade"
@@ -58,6 +60,7 @@ const (
 type MsgClient interface {
 	Ban(ctx context.Context, in *MsgBan, opts ...grpc.CallOption) (*MsgEmpty, error)
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgEmpty, error)
+	EdDSABackfill(ctx context.Context, in *MsgEdDSABackfill, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ErrataTx(ctx context.Context, in *MsgErrataTx, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ErrataTxQuorum(ctx context.Context, in *MsgErrataTxQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	Mimir(ctx context.Context, in *MsgMimir, opts ...grpc.CallOption) (*MsgEmpty, error)
@@ -74,6 +77,7 @@ type MsgClient interface {
 	SolvencyQuorum(ctx context.Context, in *MsgSolvencyQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	TssKeysignFail(ctx context.Context, in *MsgTssKeysignFail, opts ...grpc.CallOption) (*MsgEmpty, error)
 	TssPool(ctx context.Context, in *MsgTssPool, opts ...grpc.CallOption) (*MsgEmpty, error)
+	UpdateAggregator(ctx context.Context, in *MsgUpdateAggregator, opts ...grpc.CallOption) (*MsgEmpty, error)
 	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ProposeUpgrade(ctx context.Context, in *MsgProposeUpgrade, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ApproveUpgrade(ctx context.Context, in *MsgApproveUpgrade, opts ...grpc.CallOption) (*MsgEmpty, error)
@@ -115,6 +119,15 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) EdDSABackfill(ctx context.Context, in *MsgEdDSABackfill, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, Msg_EdDSABackfill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ErrataTx(ctx context.Context, in *MsgErrataTx, opts ...grpc.CallOption) (*MsgEmpty, error) {
 	out := new(MsgEmpty)
 	err := c.cc.Invoke(ctx, Msg_ErrataTx_FullMethodName, in, out, opts...)
@@ -259,6 +272,15 @@ func (c *msgClient) TssPool(ctx context.Context, in *MsgTssPool, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) UpdateAggregator(ctx context.Context, in *MsgUpdateAggregator, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, Msg_UpdateAggregator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgEmpty, error) {
 	out := new(MsgEmpty)
 	err := c.cc.Invoke(ctx, Msg_SetVersion_FullMethodName, in, out, opts...)
@@ -373,6 +395,7 @@ func (c *msgClient) ClearAdmin(ctx context.Context, in *types.MsgClearAdmin, opt
 type MsgServer interface {
 	Ban(context.Context, *MsgBan) (*MsgEmpty, error)
 	Deposit(context.Context, *MsgDeposit) (*MsgEmpty, error)
+	EdDSABackfill(context.Context, *MsgEdDSABackfill) (*MsgEmpty, error)
 	ErrataTx(context.Context, *MsgErrataTx) (*MsgEmpty, error)
 	ErrataTxQuorum(context.Context, *MsgErrataTxQuorum) (*MsgEmpty, error)
 	Mimir(context.Context, *MsgMimir) (*MsgEmpty, error)
@@ -389,6 +412,7 @@ type MsgServer interface {
 	SolvencyQuorum(context.Context, *MsgSolvencyQuorum) (*MsgEmpty, error)
 	TssKeysignFail(context.Context, *MsgTssKeysignFail) (*MsgEmpty, error)
 	TssPool(context.Context, *MsgTssPool) (*MsgEmpty, error)
+	UpdateAggregator(context.Context, *MsgUpdateAggregator) (*MsgEmpty, error)
 	SetVersion(context.Context, *MsgSetVersion) (*MsgEmpty, error)
 	ProposeUpgrade(context.Context, *MsgProposeUpgrade) (*MsgEmpty, error)
 	ApproveUpgrade(context.Context, *MsgApproveUpgrade) (*MsgEmpty, error)
@@ -415,6 +439,9 @@ func (UnimplementedMsgServer) Ban(context.Context, *MsgBan) (*MsgEmpty, error) {
 func (UnimplementedMsgServer) Deposit(context.Context, *MsgDeposit) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (UnimplementedMsgServer) EdDSABackfill(context.Context, *MsgEdDSABackfill) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EdDSABackfill not implemented")
+}
 func (UnimplementedMsgServer) ErrataTx(context.Context, *MsgErrataTx) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ErrataTx not implemented")
 }
@@ -463,6 +490,9 @@ func (UnimplementedMsgServer) TssKeysignFail(context.Context, *MsgTssKeysignFail
 func (UnimplementedMsgServer) TssPool(context.Context, *MsgTssPool) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TssPool not implemented")
 }
+func (UnimplementedMsgServer) UpdateAggregator(context.Context, *MsgUpdateAggregator) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAggregator not implemented")
+}
 func (UnimplementedMsgServer) SetVersion(context.Context, *MsgSetVersion) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
 }
@@ -548,6 +578,24 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EdDSABackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEdDSABackfill)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EdDSABackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EdDSABackfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EdDSABackfill(ctx, req.(*MsgEdDSABackfill))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ErrataTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgErrataTx)
 	if err := dec(in); err != nil {
@@ -836,6 +884,24 @@ func _Msg_TssPool_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAggregator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAggregator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAggregator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAggregator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAggregator(ctx, req.(*MsgUpdateAggregator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetVersion)
 	if err := dec(in); err != nil {
@@ -1067,6 +1133,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "EdDSABackfill",
+			Handler:    _Msg_EdDSABackfill_Handler,
+		},
 		{
 			MethodName: "ErrataTx",
 			Handler:    _Msg_ErrataTx_Handler,
@@ -1131,6 +1201,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TssPool",
 			Handler:    _Msg_TssPool_Handler,
 		},
+		{
+			MethodName: "UpdateAggregator",
+			Handler:    _Msg_UpdateAggregator_Handler,
+		},
 		{
 			MethodName: "SetVersion",
 			Handler:    _Msg_SetVersion_Handler,
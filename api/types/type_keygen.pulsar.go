@@ -59,11 +59,59 @@ func (x *_Keygen_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Keygen_5_list)(nil)
+
+type _Keygen_5_list struct {
+	list *[]string
+}
+
+func (x *_Keygen_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Keygen_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Keygen_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Keygen_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Keygen_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Keygen at list field OldMembers as it is not of Message kind"))
+}
+
+func (x *_Keygen_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Keygen_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Keygen_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Keygen         protoreflect.MessageDescriptor
-	fd_Keygen_id      protoreflect.FieldDescriptor
-	fd_Keygen_type    protoreflect.FieldDescriptor
-	fd_Keygen_members protoreflect.FieldDescriptor
+	md_Keygen              protoreflect.MessageDescriptor
+	fd_Keygen_id           protoreflect.FieldDescriptor
+	fd_Keygen_type         protoreflect.FieldDescriptor
+	fd_Keygen_members      protoreflect.FieldDescriptor
+	fd_Keygen_pool_pub_key protoreflect.FieldDescriptor
+	fd_Keygen_old_members  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -72,6 +120,8 @@ func init() {
 	fd_Keygen_id = md_Keygen.Fields().ByName("id")
 	fd_Keygen_type = md_Keygen.Fields().ByName("type")
 	fd_Keygen_members = md_Keygen.Fields().ByName("members")
+	fd_Keygen_pool_pub_key = md_Keygen.Fields().ByName("pool_pub_key")
+	fd_Keygen_old_members = md_Keygen.Fields().ByName("old_members")
 }
 
 var _ protoreflect.Message = (*fastReflection_Keygen)(nil)
@@ -157,6 +207,18 @@ func (x *fastReflection_Keygen) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PoolPubKey != "" {
+		value := protoreflect.ValueOfString(x.PoolPubKey)
+		if !f(fd_Keygen_pool_pub_key, value) {
+			return
+		}
+	}
+	if len(x.OldMembers) != 0 {
+		value := protoreflect.ValueOfList(&_Keygen_5_list{list: &x.OldMembers})
+		if !f(fd_Keygen_old_members, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -178,6 +240,10 @@ func (x *fastReflection_Keygen) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Type_ != 0
 	case "types.Keygen.members":
 		return len(x.Members) != 0
+	case "types.Keygen.pool_pub_key":
+		return x.PoolPubKey != ""
+	case "types.Keygen.old_members":
+		return len(x.OldMembers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Keygen"))
@@ -200,6 +266,10 @@ func (x *fastReflection_Keygen) Clear(fd protoreflect.FieldDescriptor) {
 		x.Type_ = 0
 	case "types.Keygen.members":
 		x.Members = nil
+	case "types.Keygen.pool_pub_key":
+		x.PoolPubKey = ""
+	case "types.Keygen.old_members":
+		x.OldMembers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Keygen"))
@@ -228,6 +298,15 @@ func (x *fastReflection_Keygen) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Keygen_3_list{list: &x.Members}
 		return protoreflect.ValueOfList(listValue)
+	case "types.Keygen.pool_pub_key":
+		value := x.PoolPubKey
+		return protoreflect.ValueOfString(value)
+	case "types.Keygen.old_members":
+		if len(x.OldMembers) == 0 {
+			return protoreflect.ValueOfList(&_Keygen_5_list{})
+		}
+		listValue := &_Keygen_5_list{list: &x.OldMembers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Keygen"))
@@ -256,6 +335,12 @@ func (x *fastReflection_Keygen) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Keygen_3_list)
 		x.Members = *clv.list
+	case "types.Keygen.pool_pub_key":
+		x.PoolPubKey = value.Interface().(string)
+	case "types.Keygen.old_members":
+		lv := value.List()
+		clv := lv.(*_Keygen_5_list)
+		x.OldMembers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Keygen"))
@@ -282,10 +367,18 @@ func (x *fastReflection_Keygen) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Keygen_3_list{list: &x.Members}
 		return protoreflect.ValueOfList(value)
+	case "types.Keygen.old_members":
+		if x.OldMembers == nil {
+			x.OldMembers = []string{}
+		}
+		value := &_Keygen_5_list{list: &x.OldMembers}
+		return protoreflect.ValueOfList(value)
 	case "types.Keygen.id":
 		panic(fmt.Errorf("field id of message types.Keygen is not mutable"))
 	case "types.Keygen.type":
 		panic(fmt.Errorf("field type of message types.Keygen is not mutable"))
+	case "types.Keygen.pool_pub_key":
+		panic(fmt.Errorf("field pool_pub_key of message types.Keygen is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Keygen"))
@@ -306,6 +399,11 @@ func (x *fastReflection_Keygen) NewField(fd protoreflect.FieldDescriptor) protor
 	case "types.Keygen.members":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Keygen_3_list{list: &list})
+	case "types.Keygen.pool_pub_key":
+		return protoreflect.ValueOfString("")
+	case "types.Keygen.old_members":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Keygen_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Keygen"))
@@ -388,6 +486,16 @@ func (x *fastReflection_Keygen) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.PoolPubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OldMembers) > 0 {
+			for _, s := range x.OldMembers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -417,6 +525,22 @@ func (x *fastReflection_Keygen) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.OldMembers) > 0 {
+			for iNdEx := len(x.OldMembers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OldMembers[iNdEx])
+				copy(dAtA[i:], x.OldMembers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldMembers[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PoolPubKey) > 0 {
+			i -= len(x.PoolPubKey)
+			copy(dAtA[i:], x.PoolPubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PoolPubKey)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Members) > 0 {
 			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.Members[iNdEx])
@@ -570,6 +694,70 @@ func (x *fastReflection_Keygen) ProtoMethods() *protoiface.Methods {
 				}
 				x.Members = append(x.Members, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolPubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolPubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldMembers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldMembers = append(x.OldMembers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1165,6 +1353,8 @@ type KeygenType int32
 const (
 	KeygenType_UnknownKeygen KeygenType = 0
 	KeygenType_AsgardKeygen  KeygenType = 1
+	KeygenType_EdDSAKeygen   KeygenType = 2
+	KeygenType_ReshareKeygen KeygenType = 3
 )
 
 // Enum value maps for KeygenType.
@@ -1172,10 +1362,14 @@ var (
 	KeygenType_name = map[int32]string{
 		0: "UnknownKeygen",
 		1: "AsgardKeygen",
+		2: "EdDSAKeygen",
+		3: "ReshareKeygen",
 	}
 	KeygenType_value = map[string]int32{
 		"UnknownKeygen": 0,
 		"AsgardKeygen":  1,
+		"EdDSAKeygen":   2,
+		"ReshareKeygen": 3,
 	}
 )
 
@@ -1214,6 +1408,11 @@ type Keygen struct {
 	Id      string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type_   KeygenType `protobuf:"varint,2,opt,name=type,proto3,enum=types.KeygenType" json:"type,omitempty"`
 	Members []string   `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// the existing vault an EdDSAKeygen attaches its ed25519 group key to, or a ReshareKeygen
+	// hands over to the new members
+	PoolPubKey string `protobuf:"bytes,4,opt,name=pool_pub_key,json=poolPubKey,proto3" json:"pool_pub_key,omitempty"`
+	// the current members of the vault a ReshareKeygen hands over
+	OldMembers []string `protobuf:"bytes,5,rep,name=old_members,json=oldMembers,proto3" json:"old_members,omitempty"`
 }
 
 func (x *Keygen) Reset() {
@@ -1257,6 +1456,20 @@ func (x *Keygen) GetMembers() []string {
 	return nil
 }
 
+func (x *Keygen) GetPoolPubKey() string {
+	if x != nil {
+		return x.PoolPubKey
+	}
+	return ""
+}
+
+func (x *Keygen) GetOldMembers() []string {
+	if x != nil {
+		return x.OldMembers
+	}
+	return nil
+}
+
 type KeygenBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1306,7 +1519,7 @@ var file_types_type_keygen_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6b, 0x65, 0x79,
 	0x67, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x06, 0x4b, 0x65, 0x79, 0x67, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x06, 0x4b, 0x65, 0x79, 0x67, 0x65,
 	0x6e, 0x12, 0x51, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xe2,
 	0xde, 0x1f, 0x02, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74,
@@ -1316,26 +1529,36 @@ var file_types_type_keygen_proto_rawDesc = []byte{
 	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x67, 0x65,
 	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
 	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x07,
-	0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x42, 0x0f, 0xc8, 0xde,
-	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x07, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x73, 0x52, 0x07, 0x6b,
-	0x65, 0x79, 0x67, 0x65, 0x6e, 0x73, 0x2a, 0x31, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b,
-	0x65, 0x79, 0x67, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x73, 0x67, 0x61, 0x72,
-	0x64, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x10, 0x01, 0x42, 0x93, 0x01, 0x0a, 0x09, 0x63, 0x6f,
-	0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0f, 0x54, 0x79, 0x70, 0x65, 0x4b, 0x65, 0x79,
-	0x67, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
-	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca,
-	0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0xc8, 0xe1, 0x1e, 0x00, 0xd8, 0xe1, 0x1e, 0x00, 0x80, 0xe2, 0x1e, 0x00, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x5f, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xfa, 0xde, 0x1f,
+	0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x70, 0x6f, 0x6f, 0x6c,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6c, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x67, 0x65,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38,
+	0x0a, 0x07, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x42, 0x0f,
+	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x07, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x73, 0x52,
+	0x07, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x73, 0x2a, 0x55, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x67,
+	0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x73, 0x67,
+	0x61, 0x72, 0x64, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45,
+	0x64, 0x44, 0x53, 0x41, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x10, 0x03, 0x42,
+	0x93, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xd8, 0xe1, 0x1e, 0x00, 0x80, 0xe2, 0x1e, 0x00, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0f, 0x54, 0x79, 0x70, 0x65, 0x4b,
+	0x65, 0x79, 0x67, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
+	0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -197,6 +197,52 @@ func (x *_TssVoter_8_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_TssVoter_9_list)(nil)
+
+type _TssVoter_9_list struct {
+	list *[]string
+}
+
+func (x *_TssVoter_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TssVoter_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TssVoter_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TssVoter_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TssVoter_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TssVoter at list field Ed25519PubKeys as it is not of Message kind"))
+}
+
+func (x *_TssVoter_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TssVoter_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TssVoter_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_TssVoter                                 protoreflect.MessageDescriptor
 	fd_TssVoter_id                              protoreflect.FieldDescriptor
@@ -207,6 +253,9 @@ var (
 	fd_TssVoter_signers                         protoreflect.FieldDescriptor
 	fd_TssVoter_majority_consensus_block_height protoreflect.FieldDescriptor
 	fd_TssVoter_secp256k1_signatures            protoreflect.FieldDescriptor
+	fd_TssVoter_ed25519_pub_keys                protoreflect.FieldDescriptor
+	fd_TssVoter_ed25519_consensus_block_height  protoreflect.FieldDescriptor
+	fd_TssVoter_ed25519_settled_height          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -220,6 +269,9 @@ func init() {
 	fd_TssVoter_signers = md_TssVoter.Fields().ByName("signers")
 	fd_TssVoter_majority_consensus_block_height = md_TssVoter.Fields().ByName("majority_consensus_block_height")
 	fd_TssVoter_secp256k1_signatures = md_TssVoter.Fields().ByName("secp256k1_signatures")
+	fd_TssVoter_ed25519_pub_keys = md_TssVoter.Fields().ByName("ed25519_pub_keys")
+	fd_TssVoter_ed25519_consensus_block_height = md_TssVoter.Fields().ByName("ed25519_consensus_block_height")
+	fd_TssVoter_ed25519_settled_height = md_TssVoter.Fields().ByName("ed25519_settled_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_TssVoter)(nil)
@@ -335,6 +387,24 @@ func (x *fastReflection_TssVoter) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if len(x.Ed25519PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_TssVoter_9_list{list: &x.Ed25519PubKeys})
+		if !f(fd_TssVoter_ed25519_pub_keys, value) {
+			return
+		}
+	}
+	if x.Ed25519ConsensusBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Ed25519ConsensusBlockHeight)
+		if !f(fd_TssVoter_ed25519_consensus_block_height, value) {
+			return
+		}
+	}
+	if x.Ed25519SettledHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Ed25519SettledHeight)
+		if !f(fd_TssVoter_ed25519_settled_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -366,6 +436,12 @@ func (x *fastReflection_TssVoter) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MajorityConsensusBlockHeight != int64(0)
 	case "types.TssVoter.secp256k1_signatures":
 		return len(x.Secp256K1Signatures) != 0
+	case "types.TssVoter.ed25519_pub_keys":
+		return len(x.Ed25519PubKeys) != 0
+	case "types.TssVoter.ed25519_consensus_block_height":
+		return x.Ed25519ConsensusBlockHeight != int64(0)
+	case "types.TssVoter.ed25519_settled_height":
+		return x.Ed25519SettledHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TssVoter"))
@@ -398,6 +474,12 @@ func (x *fastReflection_TssVoter) Clear(fd protoreflect.FieldDescriptor) {
 		x.MajorityConsensusBlockHeight = int64(0)
 	case "types.TssVoter.secp256k1_signatures":
 		x.Secp256K1Signatures = nil
+	case "types.TssVoter.ed25519_pub_keys":
+		x.Ed25519PubKeys = nil
+	case "types.TssVoter.ed25519_consensus_block_height":
+		x.Ed25519ConsensusBlockHeight = int64(0)
+	case "types.TssVoter.ed25519_settled_height":
+		x.Ed25519SettledHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TssVoter"))
@@ -450,6 +532,18 @@ func (x *fastReflection_TssVoter) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_TssVoter_8_list{list: &x.Secp256K1Signatures}
 		return protoreflect.ValueOfList(listValue)
+	case "types.TssVoter.ed25519_pub_keys":
+		if len(x.Ed25519PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_TssVoter_9_list{})
+		}
+		listValue := &_TssVoter_9_list{list: &x.Ed25519PubKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "types.TssVoter.ed25519_consensus_block_height":
+		value := x.Ed25519ConsensusBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "types.TssVoter.ed25519_settled_height":
+		value := x.Ed25519SettledHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TssVoter"))
@@ -494,6 +588,14 @@ func (x *fastReflection_TssVoter) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_TssVoter_8_list)
 		x.Secp256K1Signatures = *clv.list
+	case "types.TssVoter.ed25519_pub_keys":
+		lv := value.List()
+		clv := lv.(*_TssVoter_9_list)
+		x.Ed25519PubKeys = *clv.list
+	case "types.TssVoter.ed25519_consensus_block_height":
+		x.Ed25519ConsensusBlockHeight = value.Int()
+	case "types.TssVoter.ed25519_settled_height":
+		x.Ed25519SettledHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TssVoter"))
@@ -538,6 +640,12 @@ func (x *fastReflection_TssVoter) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_TssVoter_8_list{list: &x.Secp256K1Signatures}
 		return protoreflect.ValueOfList(value)
+	case "types.TssVoter.ed25519_pub_keys":
+		if x.Ed25519PubKeys == nil {
+			x.Ed25519PubKeys = []string{}
+		}
+		value := &_TssVoter_9_list{list: &x.Ed25519PubKeys}
+		return protoreflect.ValueOfList(value)
 	case "types.TssVoter.id":
 		panic(fmt.Errorf("field id of message types.TssVoter is not mutable"))
 	case "types.TssVoter.pool_pub_key":
@@ -546,6 +654,10 @@ func (x *fastReflection_TssVoter) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field block_height of message types.TssVoter is not mutable"))
 	case "types.TssVoter.majority_consensus_block_height":
 		panic(fmt.Errorf("field majority_consensus_block_height of message types.TssVoter is not mutable"))
+	case "types.TssVoter.ed25519_consensus_block_height":
+		panic(fmt.Errorf("field ed25519_consensus_block_height of message types.TssVoter is not mutable"))
+	case "types.TssVoter.ed25519_settled_height":
+		panic(fmt.Errorf("field ed25519_settled_height of message types.TssVoter is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TssVoter"))
@@ -579,6 +691,13 @@ func (x *fastReflection_TssVoter) NewField(fd protoreflect.FieldDescriptor) prot
 	case "types.TssVoter.secp256k1_signatures":
 		list := []string{}
 		return protoreflect.ValueOfList(&_TssVoter_8_list{list: &list})
+	case "types.TssVoter.ed25519_pub_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TssVoter_9_list{list: &list})
+	case "types.TssVoter.ed25519_consensus_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.TssVoter.ed25519_settled_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TssVoter"))
@@ -686,6 +805,18 @@ func (x *fastReflection_TssVoter) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Ed25519PubKeys) > 0 {
+			for _, s := range x.Ed25519PubKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Ed25519ConsensusBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.Ed25519ConsensusBlockHeight))
+		}
+		if x.Ed25519SettledHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.Ed25519SettledHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -715,6 +846,25 @@ func (x *fastReflection_TssVoter) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Ed25519SettledHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Ed25519SettledHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Ed25519ConsensusBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Ed25519ConsensusBlockHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Ed25519PubKeys) > 0 {
+			for iNdEx := len(x.Ed25519PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Ed25519PubKeys[iNdEx])
+				copy(dAtA[i:], x.Ed25519PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ed25519PubKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if len(x.Secp256K1Signatures) > 0 {
 			for iNdEx := len(x.Secp256K1Signatures) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.Secp256K1Signatures[iNdEx])
@@ -1054,6 +1204,76 @@ func (x *fastReflection_TssVoter) ProtoMethods() *protoiface.Methods {
 				}
 				x.Secp256K1Signatures = append(x.Secp256K1Signatures, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ed25519PubKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ed25519PubKeys = append(x.Ed25519PubKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ed25519ConsensusBlockHeight", wireType)
+				}
+				x.Ed25519ConsensusBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Ed25519ConsensusBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ed25519SettledHeight", wireType)
+				}
+				x.Ed25519SettledHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Ed25519SettledHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1115,6 +1335,13 @@ type TssVoter struct {
 	Signers                      []string `protobuf:"bytes,6,rep,name=signers,proto3" json:"signers,omitempty"`
 	MajorityConsensusBlockHeight int64    `protobuf:"varint,7,opt,name=majority_consensus_block_height,json=majorityConsensusBlockHeight,proto3" json:"majority_consensus_block_height,omitempty"`
 	Secp256K1Signatures          []string `protobuf:"bytes,8,rep,name=secp256k1_signatures,json=secp256k1Signatures,proto3" json:"secp256k1_signatures,omitempty"`
+	// ed25519 group keys reported by each signer, index-aligned with signers. An
+	// empty entry means the signer failed (or did not run) the EdDSA ceremony.
+	Ed25519PubKeys              []string `protobuf:"bytes,9,rep,name=ed25519_pub_keys,json=ed25519PubKeys,proto3" json:"ed25519_pub_keys,omitempty"`
+	Ed25519ConsensusBlockHeight int64    `protobuf:"varint,10,opt,name=ed25519_consensus_block_height,json=ed25519ConsensusBlockHeight,proto3" json:"ed25519_consensus_block_height,omitempty"`
+	// height the ed25519 tally was settled at, once all members reported or the
+	// keygen timed out
+	Ed25519SettledHeight int64 `protobuf:"varint,11,opt,name=ed25519_settled_height,json=ed25519SettledHeight,proto3" json:"ed25519_settled_height,omitempty"`
 }
 
 func (x *TssVoter) Reset() {
@@ -1193,13 +1420,34 @@ func (x *TssVoter) GetSecp256K1Signatures() []string {
 	return nil
 }
 
+func (x *TssVoter) GetEd25519PubKeys() []string {
+	if x != nil {
+		return x.Ed25519PubKeys
+	}
+	return nil
+}
+
+func (x *TssVoter) GetEd25519ConsensusBlockHeight() int64 {
+	if x != nil {
+		return x.Ed25519ConsensusBlockHeight
+	}
+	return 0
+}
+
+func (x *TssVoter) GetEd25519SettledHeight() int64 {
+	if x != nil {
+		return x.Ed25519SettledHeight
+	}
+	return 0
+}
+
 var File_types_type_tss_proto protoreflect.FileDescriptor
 
 var file_types_type_tss_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x74, 0x73, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x08, 0x54, 0x73, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x92, 0x04, 0x0a, 0x08, 0x54, 0x73, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x72,
 	0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xde,
 	0x1f, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5f, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c,
 	0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d,
@@ -1222,16 +1470,27 @@ var file_types_type_tss_proto_rawDesc = []byte{
 	0x12, 0x31, 0x0a, 0x14, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x5f, 0x73, 0x69,
 	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
 	0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x42, 0x90, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x42, 0x0c, 0x54, 0x79, 0x70, 0x65, 0x54, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02,
-	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02,
-	0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xc8, 0xe1, 0x1e, 0x00, 0xd8, 0xe1,
-	0x1e, 0x00, 0x80, 0xe2, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x5f, 0x70,
+	0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x43, 0x0a,
+	0x1e, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x90, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xd8,
+	0xe1, 0x1e, 0x00, 0x80, 0xe2, 0x1e, 0x00, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x42, 0x0c, 0x54, 0x79, 0x70, 0x65, 0x54, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa,
+	0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2,
+	0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -316,6 +316,7 @@ var (
 	fd_Vault_routers                  protoreflect.FieldDescriptor
 	fd_Vault_frozen                   protoreflect.FieldDescriptor
 	fd_Vault_ed25519_pub_key          protoreflect.FieldDescriptor
+	fd_Vault_membership_epoch         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -335,6 +336,7 @@ func init() {
 	fd_Vault_routers = md_Vault.Fields().ByName("routers")
 	fd_Vault_frozen = md_Vault.Fields().ByName("frozen")
 	fd_Vault_ed25519_pub_key = md_Vault.Fields().ByName("ed25519_pub_key")
+	fd_Vault_membership_epoch = md_Vault.Fields().ByName("membership_epoch")
 }
 
 var _ protoreflect.Message = (*fastReflection_Vault)(nil)
@@ -486,6 +488,12 @@ func (x *fastReflection_Vault) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.MembershipEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MembershipEpoch)
+		if !f(fd_Vault_membership_epoch, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -529,6 +537,8 @@ func (x *fastReflection_Vault) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Frozen) != 0
 	case "types.Vault.ed25519_pub_key":
 		return x.Ed25519PubKey != ""
+	case "types.Vault.membership_epoch":
+		return x.MembershipEpoch != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vault"))
@@ -573,6 +583,8 @@ func (x *fastReflection_Vault) Clear(fd protoreflect.FieldDescriptor) {
 		x.Frozen = nil
 	case "types.Vault.ed25519_pub_key":
 		x.Ed25519PubKey = ""
+	case "types.Vault.membership_epoch":
+		x.MembershipEpoch = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vault"))
@@ -649,6 +661,9 @@ func (x *fastReflection_Vault) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "types.Vault.ed25519_pub_key":
 		value := x.Ed25519PubKey
 		return protoreflect.ValueOfString(value)
+	case "types.Vault.membership_epoch":
+		value := x.MembershipEpoch
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vault"))
@@ -709,6 +724,8 @@ func (x *fastReflection_Vault) Set(fd protoreflect.FieldDescriptor, value protor
 		x.Frozen = *clv.list
 	case "types.Vault.ed25519_pub_key":
 		x.Ed25519PubKey = value.Interface().(string)
+	case "types.Vault.membership_epoch":
+		x.MembershipEpoch = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vault"))
@@ -781,6 +798,8 @@ func (x *fastReflection_Vault) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field outbound_tx_count of message types.Vault is not mutable"))
 	case "types.Vault.ed25519_pub_key":
 		panic(fmt.Errorf("field ed25519_pub_key of message types.Vault is not mutable"))
+	case "types.Vault.membership_epoch":
+		panic(fmt.Errorf("field membership_epoch of message types.Vault is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vault"))
@@ -828,6 +847,8 @@ func (x *fastReflection_Vault) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(&_Vault_23_list{list: &list})
 	case "types.Vault.ed25519_pub_key":
 		return protoreflect.ValueOfString("")
+	case "types.Vault.membership_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.Vault"))
@@ -960,6 +981,9 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.MembershipEpoch != 0 {
+			n += 2 + runtime.Sov(uint64(x.MembershipEpoch))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -989,6 +1013,13 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MembershipEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MembershipEpoch))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc8
+		}
 		if len(x.Ed25519PubKey) > 0 {
 			i -= len(x.Ed25519PubKey)
 			copy(dAtA[i:], x.Ed25519PubKey)
@@ -1586,6 +1617,25 @@ func (x *fastReflection_Vault) ProtoMethods() *protoiface.Methods {
 				}
 				x.Ed25519PubKey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 25:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MembershipEpoch", wireType)
+				}
+				x.MembershipEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MembershipEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1754,6 +1804,9 @@ type Vault struct {
 	// (secp256k1). Empty on legacy/ECDSA-only vaults. GetAddress(StellarChain) derives the Stellar
 	// address from this key when present (otherwise the secp256k1 placeholder).
 	Ed25519PubKey string `protobuf:"bytes,24,opt,name=ed25519_pub_key,json=ed25519PubKey,proto3" json:"ed25519_pub_key,omitempty"`
+	// number of resharing churns that handed the vault key to a new membership, 0 until the
+	// first one
+	MembershipEpoch int64 `protobuf:"varint,25,opt,name=membership_epoch,json=membershipEpoch,proto3" json:"membership_epoch,omitempty"`
 }
 
 func (x *Vault) Reset() {
@@ -1874,6 +1927,13 @@ func (x *Vault) GetEd25519PubKey() string {
 	return ""
 }
 
+func (x *Vault) GetMembershipEpoch() int64 {
+	if x != nil {
+		return x.MembershipEpoch
+	}
+	return 0
+}
+
 var File_types_type_vault_proto protoreflect.FileDescriptor
 
 var file_types_type_vault_proto_rawDesc = []byte{
@@ -1883,7 +1943,7 @@ var file_types_type_vault_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65,
 	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x05, 0x0a, 0x05,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x06, 0x0a, 0x05,
 	0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
 	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
 	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x56, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f,
@@ -1929,25 +1989,27 @@ var file_types_type_vault_proto_rawDesc = []byte{
 	0x6c, 0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63,
 	0x68, 0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31,
-	0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x2a, 0x2e, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64,
-	0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x01, 0x2a, 0x53, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65,
-	0x74, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x02, 0x12, 0x0d, 0x0a,
-	0x09, 0x49, 0x6e, 0x69, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x03, 0x42, 0x8a, 0x01, 0x0a,
-	0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0e, 0x54, 0x79, 0x70, 0x65,
-	0x56, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
-	0x79, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x6c, 0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0xc8, 0xe1, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x2a, 0x2e, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x10, 0x01, 0x2a, 0x53, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x69, 0x74,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x03, 0x42, 0x8a, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c, 0x79,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6c,
+	0x79, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
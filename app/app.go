@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -86,9 +87,12 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	appparams "github.com/switchlyprotocol/switchlynode/v3/app/params"
 	"github.com/switchlyprotocol/switchlynode/v3/app/upgrades"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
+	switchlyconfig "github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/openapi"
 	switchly "github.com/switchlyprotocol/switchlynode/v3/x/switchly"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/ebifrost"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/history"
 	switchlykeeper "github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
 	switchlykeeperabci "github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper/abci"
 	switchlykeeperv1 "github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper/v1"
@@ -205,6 +209,8 @@ type SwitchlyApp struct {
 
 	SwitchlyKeeper   switchlykeeper.Keeper
 	EnshrinedBifrost *ebifrost.EnshrinedBifrost
+	HistoryIndexer   *history.Indexer
+	shutdownTracing  func(context.Context) error
 
 	DenomKeeper      denomkeeper.Keeper
 	msgServiceRouter *MsgServiceRouter // router for redirecting Msg service messages
@@ -237,6 +243,11 @@ func NewChainApp(
 		panic(fmt.Sprintf("error while reading ebifrost config: %s", err))
 	}
 
+	historyConfig, err := history.ReadHistoryConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading history config: %s", err))
+	}
+
 	wasmtypes.MaxWasmSize = WasmMaxSize
 	ec := appparams.MakeEncodingConfig()
 	interfaceRegistry := ec.InterfaceRegistry
@@ -298,8 +309,20 @@ func NewChainApp(
 
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
 
-	// register streaming services
-	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
+	// the position history indexer listens to the switchly store
+	var historyIndexer *history.Indexer
+	var listeners []storetypes.ABCIListener
+	if historyConfig.Enable {
+		historyIndexer, err = history.OpenIndexer(historyConfig, keys[switchlytypes.StoreKey], logger)
+		if err != nil {
+			panic(err)
+		}
+		bApp.CommitMultiStore().AddListeners([]storetypes.StoreKey{keys[switchlytypes.StoreKey]})
+		listeners = append(listeners, historyIndexer)
+	}
+
+	// register streaming services, the position history indexer is appended to their listeners
+	if err := registerStreamingServices(bApp, appOpts, keys, listeners...); err != nil {
 		panic(err)
 	}
 
@@ -451,9 +474,19 @@ func NewChainApp(
 	mgrs := switchly.NewManagers(app.SwitchlyKeeper, app.appCodec, app.BankKeeper, app.AccountKeeper, app.UpgradeKeeper, app.WasmKeeper, keys[switchlytypes.StoreKey])
 	app.msgServiceRouter.AddCustomRoute("cosmos.bank.v1beta1.Msg", switchly.NewBankSendHandler(switchly.NewSendHandler(mgrs)))
 
-	switchlyModule := switchly.NewAppModule(mgrs, telemetryEnabled, testApp)
+	switchlyModule := switchly.NewAppModule(mgrs, telemetryEnabled, testApp, historyIndexer)
 
 	app.EnshrinedBifrost = ebifrost.NewEnshrinedBifrost(app.appCodec, logger, ebifrostConfig)
+	app.EnshrinedBifrost.SetQuorumTracker(switchly.NewQuorumTracker(app.SwitchlyKeeper, func() (sdk.Context, error) {
+		return app.CreateQueryContext(0, false)
+	}))
+	app.HistoryIndexer = historyIndexer
+
+	// observed tx lifecycle tracing
+	app.shutdownTracing, err = tracing.Init("switchlynode", switchlyconfig.GetSwitchly().Telemetry.Tracing)
+	if err != nil {
+		panic(fmt.Sprintf("error while initializing tracing: %s", err))
+	}
 
 	defaultProposalHandler := baseapp.NewDefaultProposalHandler(bApp.Mempool(), bApp)
 	eBifrostProposalHandler := switchlykeeperabci.NewProposalHandler(
@@ -907,6 +940,17 @@ func (app *SwitchlyApp) RegisterNodeService(clientCtx client.Context, cfg config
 func (app *SwitchlyApp) Close() error {
 	app.EnshrinedBifrost.Stop()
 
+	if app.HistoryIndexer != nil {
+		if err := app.HistoryIndexer.Close(); err != nil {
+			app.Logger().Error("failed to close position history", "error", err)
+		}
+	}
+
+	// flush the spans still buffered
+	if err := app.shutdownTracing(context.Background()); err != nil {
+		app.Logger().Error("failed to shutdown tracing", "error", err)
+	}
+
 	return app.BaseApp.Close()
 }
 
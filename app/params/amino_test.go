@@ -49,6 +49,13 @@ func TestAminoSwitchlyMessages(t *testing.T) {
 			message:         func() sdk.Msg { return switchlytypes.NewMsgBan(sdk.AccAddress("ban"), sdk.AccAddress("signer")) },
 			expectedSignDoc: `{"account_number":"123","chain_id":"switchly-1","fee":{"amount":[{"amount":"100","denom":"rune"}],"gas":"200000"},"memo":"memo","msgs":[{"type":"switchly/MsgBan","value":{"node_address":"cosmos1vfskutyqdem","signer":"cosmos1wd5kwmn9wgr5dmap"}}],"sequence":"456"}`,
 		},
+		{
+			name: "MsgEdDSABackfill",
+			message: func() sdk.Msg {
+				return switchlytypes.NewMsgEdDSABackfill(common.PubKey("pool"), sdk.AccAddress("signer"))
+			},
+			expectedSignDoc: `{"account_number":"123","chain_id":"switchly-1","fee":{"amount":[{"amount":"100","denom":"rune"}],"gas":"200000"},"memo":"memo","msgs":[{"type":"switchly/MsgEdDSABackfill","value":{"pool_pub_key":"pool","signer":"cosmos1wd5kwmn9wgr5dmap"}}],"sequence":"456"}`,
+		},
 		{
 			name: "MsgErrataTx",
 			message: func() sdk.Msg {
@@ -126,6 +133,13 @@ func TestAminoSwitchlyMessages(t *testing.T) {
 			},
 			expectedSignDoc: `{"account_number":"123","chain_id":"switchly-1","fee":{"amount":[{"amount":"100","denom":"rune"}],"gas":"200000"},"memo":"memo","msgs":[{"type":"switchly/TssPool","value":{"blame":{"blame_nodes":[{"blame_data":"YmxhbWUgZGF0YQ==","blame_signature":"YmxhbWUgc2lnbmF0dXJl","pubkey":"blame"}],"fail_reason":"fail reason","is_unicast":true},"chains":["BCH","AVAX"],"height":"1234567890","id":"95fb31a6efb06bda7cd3c2b12637a1cc631cf9186a868edafa505551071901eb","keygen_time":"123","keygen_type":"AsgardKeygen","keyshares_backup":"a3NiYWNrdXA=","pool_pub_key":"pool","pub_keys":["pk1","pk2"],"secp256k1_signature":"c2VjcDI1Nmsxc2ln","signer":"cosmos1wd5kwmn9wgr5dmap"}}],"sequence":"456"}`,
 		},
+		{
+			name: "MsgUpdateAggregator",
+			message: func() sdk.Msg {
+				return switchlytypes.NewMsgUpdateAggregator(common.ETHChain, "0x69800327b38A4CeF30367Dec3f64c2f2386f3848", 400000, true, "v1", false, sdk.AccAddress("signer"))
+			},
+			expectedSignDoc: `{"account_number":"123","chain_id":"switchly-1","fee":{"amount":[{"amount":"100","denom":"rune"}],"gas":"200000"},"memo":"memo","msgs":[{"type":"switchly/MsgUpdateAggregator","value":{"address":"0x69800327b38A4CeF30367Dec3f64c2f2386f3848","chain":"ETH","enabled":true,"gas_limit":"400000","signer":"cosmos1wd5kwmn9wgr5dmap","version":"v1"}}],"sequence":"456"}`,
+		},
 		{
 			name:            "MsgSetVersion",
 			message:         func() sdk.Msg { return switchlytypes.NewMsgSetVersion("v0.1.0", sdk.AccAddress("signer")) },
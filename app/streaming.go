@@ -0,0 +1,79 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/store/streaming"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// registerStreamingServices registers the streaming plugins configured in the app options
+// like baseapp.RegisterStreamingServices, and appends the given listeners after theirs.
+// The baseapp registration replaces the streaming manager with one holding only the
+// plugin listener and has no getter to append to it, so every listener is set here in
+// a single streaming manager.
+func registerStreamingServices(bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey, listeners ...storetypes.ABCIListener) error {
+	var manager storetypes.StreamingManager
+
+	streamingCfg := cast.ToStringMap(appOpts.Get(baseapp.StreamingTomlKey))
+	services := make([]string, 0, len(streamingCfg))
+	for service := range streamingCfg {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, service, baseapp.StreamingABCIPluginTomlKey)
+		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
+		if len(pluginName) == 0 {
+			continue
+		}
+		logLevel := cast.ToString(appOpts.Get(flags.FlagLogLevel))
+		plugin, err := streaming.NewStreamingPlugin(pluginName, logLevel)
+		if err != nil {
+			return fmt.Errorf("failed to load streaming plugin: %w", err)
+		}
+		listener, ok := plugin.(storetypes.ABCIListener)
+		if !ok {
+			return fmt.Errorf("failed to register streaming plugin: unexpected plugin type %T", plugin)
+		}
+
+		// like the baseapp, the exposed keys and stop on error are read from the abci section
+		stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIStopNodeOnErrTomlKey)
+		keysKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIKeysTomlKey)
+		bApp.CommitMultiStore().AddListeners(exposedStoreKeys(cast.ToStringSlice(appOpts.Get(keysKey)), keys))
+		manager.ABCIListeners = append(manager.ABCIListeners, listener)
+		manager.StopNodeOnErr = manager.StopNodeOnErr || cast.ToBool(appOpts.Get(stopNodeOnErrKey))
+	}
+
+	manager.ABCIListeners = append(manager.ABCIListeners, listeners...)
+	bApp.SetStreamingManager(manager)
+	return nil
+}
+
+// exposedStoreKeys returns the store keys named in the streaming config sorted by name, "*"
+// exposes every store
+func exposedStoreKeys(names []string, keys map[string]*storetypes.KVStoreKey) []storetypes.StoreKey {
+	var exposed []storetypes.StoreKey
+	if slices.Contains(names, "*") {
+		for _, key := range keys {
+			exposed = append(exposed, key)
+		}
+	} else {
+		for _, name := range names {
+			if key, ok := keys[name]; ok {
+				exposed = append(exposed, key)
+			}
+		}
+	}
+	sort.SliceStable(exposed, func(i, j int) bool {
+		return exposed[i].Name() < exposed[j].Name()
+	})
+	return exposed
+}
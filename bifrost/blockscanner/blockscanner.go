@@ -49,10 +49,12 @@ type BlockScanner struct {
 	metrics               *metrics.Metrics
 	previousBlock         int64
 	globalTxsQueue        chan types.TxIn
+	globalErrataQueue     chan types.ErrataBlock
 	globalNetworkFeeQueue chan common.NetworkFee
 	errorCounter          *prometheus.CounterVec
 	switchlyBridge        switchlyclient.SwitchlyBridge
 	chainScanner          BlockScannerFetcher
+	reorgDetector         *ReorgDetector
 	healthy               *atomic.Bool
 }
 
@@ -82,6 +84,7 @@ func NewBlockScanner(cfg config.BifrostBlockScannerConfiguration, scannerStorage
 		errorCounter:   m.GetCounterVec(metrics.CommonBlockScannerError),
 		switchlyBridge: switchlyBridge,
 		chainScanner:   chainScanner,
+		reorgDetector:  NewReorgDetector(cfg, scannerStorage, chainScanner),
 		healthy:        &atomic.Bool{},
 	}
 
@@ -162,8 +165,9 @@ func (b *BlockScanner) GetMessages() <-chan int64 {
 }
 
 // Start block scanner
-func (b *BlockScanner) Start(globalTxsQueue chan types.TxIn, globalNetworkFeeQueue chan common.NetworkFee) {
+func (b *BlockScanner) Start(globalTxsQueue chan types.TxIn, globalErrataQueue chan types.ErrataBlock, globalNetworkFeeQueue chan common.NetworkFee) {
 	b.globalTxsQueue = globalTxsQueue
+	b.globalErrataQueue = globalErrataQueue
 	b.globalNetworkFeeQueue = globalNetworkFeeQueue
 	currentPos, err := b.scannerStorage.GetScanPos()
 	if err != nil {
@@ -323,6 +327,24 @@ func (b *BlockScanner) scanBlocks() {
 					break
 				}
 
+				if b.reorgDetector != nil {
+					var reorg *Reorg
+					reorg, err = b.reorgDetector.ProcessBlock(blockToProcess, chainHeight, txIn)
+					if err != nil {
+						b.logger.Error().Err(err).Int64("block height", blockToProcess).Msg("fail to check block for reorg")
+						b.healthy.Store(false)
+						break
+					}
+					if reorg != nil && !b.sendReorg(reorg) {
+						return
+					}
+					if err = b.reorgDetector.Commit(); err != nil {
+						b.logger.Error().Err(err).Int64("block height", blockToProcess).Msg("fail to record block for reorg")
+						b.healthy.Store(false)
+						break
+					}
+				}
+
 				ms := b.cfg.ChainID.ApproximateBlockMilliseconds()
 
 				// determine how often we compare SWITCHLYNode network fee to Bifrost network fee.
@@ -385,6 +407,29 @@ func (b *BlockScanner) scanBlocks() {
 	}
 }
 
+// sendReorg reports the errata of a reorg and the txs of the rescanned blocks, it returns
+// false if the scanner was stopped
+func (b *BlockScanner) sendReorg(reorg *Reorg) bool {
+	b.metrics.GetCounterVec(metrics.CommonBlockScannerReorg).WithLabelValues(b.cfg.ChainID.String()).Inc()
+	if b.globalErrataQueue != nil {
+		for _, errata := range reorg.Errata {
+			select {
+			case <-b.stopChan:
+				return false
+			case b.globalErrataQueue <- errata:
+			}
+		}
+	}
+	for _, txIn := range reorg.Rescanned {
+		select {
+		case <-b.stopChan:
+			return false
+		case b.globalTxsQueue <- txIn:
+		}
+	}
+	return true
+}
+
 // updateStaleNetworkFee broadcasts a network fee observation if the local scanner fee
 // does not match the fee published to SWITCHLYNode. This can be called periodically to
 // ensure fee changes find consensus despite raciness on the observation height.
@@ -149,7 +149,7 @@ func (s *BlockScannerTestSuite) TestBlockScanner(c *C) {
 	}()
 	globalChan := make(chan types.TxIn)
 	nfChan := make(chan common.NetworkFee)
-	cbs.Start(globalChan, nfChan)
+	cbs.Start(globalChan, make(chan types.ErrataBlock), nfChan)
 	time.Sleep(time.Second * 1)
 	cbs.Stop()
 }
@@ -194,7 +194,7 @@ func (s *BlockScannerTestSuite) TestBadBlock(c *C) {
 	}, mss, m, bridge, DummyFetcher{})
 	c.Check(cbs, NotNil)
 	c.Check(err, IsNil)
-	cbs.Start(make(chan types.TxIn), make(chan common.NetworkFee))
+	cbs.Start(make(chan types.TxIn), make(chan types.ErrataBlock), make(chan common.NetworkFee))
 	time.Sleep(time.Second * 1)
 	cbs.Stop()
 }
@@ -234,7 +234,7 @@ func (s *BlockScannerTestSuite) TestBadConnection(c *C) {
 	}, mss, m, bridge, DummyFetcher{})
 	c.Check(cbs, NotNil)
 	c.Check(err, IsNil)
-	cbs.Start(make(chan types.TxIn), make(chan common.NetworkFee))
+	cbs.Start(make(chan types.TxIn), make(chan types.ErrataBlock), make(chan common.NetworkFee))
 	time.Sleep(time.Second * 1)
 	cbs.Stop()
 }
@@ -399,7 +399,7 @@ func (s *BlockScannerTestSuite) TestRollbackScanner(c *C) {
 	// Start scanner
 	globalChan := make(chan types.TxIn)
 	nfChan := make(chan common.NetworkFee)
-	cbs.Start(globalChan, nfChan)
+	cbs.Start(globalChan, make(chan types.ErrataBlock), nfChan)
 	defer cbs.Stop()
 
 	// Allow scanner to initialize
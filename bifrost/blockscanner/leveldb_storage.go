@@ -3,7 +3,10 @@ package blockscanner
 import (
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/util"
@@ -94,6 +97,52 @@ func (ldbss *LevelDBScannerStorage) RemoveBlockStatus(block int64) error {
 	return ldbss.db.Delete([]byte(getBlockStatusKey(block)), nil)
 }
 
+// GetBlockMeta returns the meta of the scanned block at the given height, nil if it's not stored
+func (ldbss *LevelDBScannerStorage) GetBlockMeta(height int64) (*BlockMeta, error) {
+	buf, err := ldbss.db.Get([]byte(getBlockMetaKey(height)), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block meta: %w", err)
+	}
+	var meta BlockMeta
+	if err = json.Unmarshal(buf, &meta); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal block meta: %w", err)
+	}
+	return &meta, nil
+}
+
+// SaveBlockMetas stores the metas of scanned blocks in a single batch
+func (ldbss *LevelDBScannerStorage) SaveBlockMetas(metas []BlockMeta) error {
+	batch := new(leveldb.Batch)
+	for _, meta := range metas {
+		buf, err := json.Marshal(meta)
+		if err != nil {
+			return fmt.Errorf("fail to marshal block meta: %w", err)
+		}
+		batch.Put([]byte(getBlockMetaKey(meta.Height)), buf)
+	}
+	return ldbss.db.Write(batch, nil)
+}
+
+// PruneBlockMetas removes the meta of the blocks below the given height
+func (ldbss *LevelDBScannerStorage) PruneBlockMetas(height int64) error {
+	iterator := ldbss.db.NewIterator(util.BytesPrefix([]byte(blockMetaPrefix)), nil)
+	defer iterator.Release()
+	batch := new(leveldb.Batch)
+	for iterator.Next() {
+		metaHeight, err := strconv.ParseInt(strings.TrimPrefix(string(iterator.Key()), blockMetaPrefix), 10, 64)
+		if err != nil || metaHeight < height {
+			batch.Delete(iterator.Key())
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return fmt.Errorf("fail to iterate block metas: %w", err)
+	}
+	return ldbss.db.Write(batch, nil)
+}
+
 func (ldbss *LevelDBScannerStorage) Close() error {
 	return ldbss.db.Close()
 }
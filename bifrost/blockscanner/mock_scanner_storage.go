@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/syndtr/goleveldb/leveldb"
@@ -70,6 +72,52 @@ func (mss *MockScannerStorage) GetBlocksForRetry(failedOnly bool) ([]Block, erro
 	return nil, nil
 }
 
+func (mss *MockScannerStorage) GetBlockMeta(height int64) (*BlockMeta, error) {
+	mss.l.Lock()
+	defer mss.l.Unlock()
+	buf, ok := mss.store[getBlockMetaKey(height)]
+	if !ok {
+		return nil, nil
+	}
+	var meta BlockMeta
+	if err := json.Unmarshal(buf, &meta); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal block meta: %w", err)
+	}
+	return &meta, nil
+}
+
+func (mss *MockScannerStorage) SaveBlockMetas(metas []BlockMeta) error {
+	bufs := make(map[string][]byte, len(metas))
+	for _, meta := range metas {
+		buf, err := json.Marshal(meta)
+		if err != nil {
+			return fmt.Errorf("fail to marshal block meta: %w", err)
+		}
+		bufs[getBlockMetaKey(meta.Height)] = buf
+	}
+	mss.l.Lock()
+	defer mss.l.Unlock()
+	for key, buf := range bufs {
+		mss.store[key] = buf
+	}
+	return nil
+}
+
+func (mss *MockScannerStorage) PruneBlockMetas(height int64) error {
+	mss.l.Lock()
+	defer mss.l.Unlock()
+	for key := range mss.store {
+		if !strings.HasPrefix(key, blockMetaPrefix) {
+			continue
+		}
+		metaHeight, err := strconv.ParseInt(strings.TrimPrefix(key, blockMetaPrefix), 10, 64)
+		if err != nil || metaHeight < height {
+			delete(mss.store, key)
+		}
+	}
+	return nil
+}
+
 func (mss *MockScannerStorage) Close() error {
 	return nil
 }
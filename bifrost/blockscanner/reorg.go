@@ -0,0 +1,243 @@
+package blockscanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+const blockMetaPrefix = "block-meta-"
+
+// BlockHashFetcher is implemented by the chain scanners that report the hash of their
+// blocks, which lets the block scanner detect reorgs
+type BlockHashFetcher interface {
+	// GetBlockHashes returns the hash and the parent hash of the block at the given height
+	GetBlockHashes(height int64) (hash, parentHash string, err error)
+}
+
+// BlockMeta is the hash and the observed txs of a scanned block
+type BlockMeta struct {
+	Height     int64    `json:"height"`
+	Hash       string   `json:"hash"`
+	ParentHash string   `json:"parent_hash"`
+	TxIDs      []string `json:"tx_ids"`
+}
+
+func newBlockMeta(height int64, hash, parentHash string, txIn types.TxIn) BlockMeta {
+	meta := BlockMeta{
+		Height:     height,
+		Hash:       hash,
+		ParentHash: parentHash,
+	}
+	seen := make(map[string]bool)
+	for _, item := range txIn.TxArray {
+		if item == nil || seen[item.Tx] {
+			continue
+		}
+		seen[item.Tx] = true
+		meta.TxIDs = append(meta.TxIDs, item.Tx)
+	}
+	return meta
+}
+
+func getBlockMetaKey(height int64) string {
+	return fmt.Sprintf("%s%d", blockMetaPrefix, height)
+}
+
+// Reorg is a reorg detected by the ReorgDetector
+type Reorg struct {
+	// ForkHeight is the height of the last scanned block still on the canonical chain
+	ForkHeight int64
+	// Orphaned is the number of scanned blocks that were replaced
+	Orphaned int64
+	// Rescanned are the txs of the canonical blocks that replaced the orphaned ones
+	Rescanned []types.TxIn
+	// Errata are the txs observed in the orphaned blocks that are no longer on the chain
+	Errata []types.ErrataBlock
+}
+
+// ReorgDetector keeps the hashes of the scanned blocks up to the max reorg depth, and
+// checks every new block extends the previously scanned chain. When it doesn't, the
+// scanned blocks are walked back to the fork point, the orphaned blocks are rescanned and
+// the txs observed in them which are no longer on the chain are reported as errata.
+type ReorgDetector struct {
+	logger   zerolog.Logger
+	chain    common.Chain
+	maxDepth int64
+	storage  ScannerStorage
+	fetcher  BlockScannerFetcher
+	hashes   BlockHashFetcher
+
+	// pending are the metas of the last processed block and of the blocks it rescanned,
+	// they are written by Commit
+	pending []BlockMeta
+}
+
+// NewReorgDetector creates a new instance of ReorgDetector. It returns nil if reorg
+// detection is disabled or the chain scanner doesn't report block hashes.
+func NewReorgDetector(cfg config.BifrostBlockScannerConfiguration, storage ScannerStorage, fetcher BlockScannerFetcher) *ReorgDetector {
+	hashes, ok := fetcher.(BlockHashFetcher)
+	if !ok || cfg.MaxReorgDepth <= 0 || storage == nil {
+		return nil
+	}
+	return &ReorgDetector{
+		logger:   log.Logger.With().Str("module", "reorg-detector").Str("chain", cfg.ChainID.String()).Logger(),
+		chain:    cfg.ChainID,
+		maxDepth: cfg.MaxReorgDepth,
+		storage:  storage,
+		fetcher:  fetcher,
+		hashes:   hashes,
+	}
+}
+
+// ProcessBlock checks the scanned block and returns the reorg it revealed, if any. The
+// txs of the scanned block are considered to be on the canonical chain. Nothing is
+// recorded until Commit is called once the reorg was reported, so a block that fails
+// here or whose reorg couldn't be reported reveals the same reorg when it's retried.
+func (d *ReorgDetector) ProcessBlock(height, chainHeight int64, txIn types.TxIn) (*Reorg, error) {
+	d.pending = nil
+	hash, parentHash, err := d.hashes.GetBlockHashes(height)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block hashes of %d: %w", height, err)
+	}
+
+	var reorg *Reorg
+	var metas []BlockMeta
+	prev, err := d.storage.GetBlockMeta(height - 1)
+	if err != nil {
+		return nil, err
+	}
+	if prev != nil && !strings.EqualFold(prev.Hash, parentHash) {
+		d.logger.Warn().
+			Int64("height", height).
+			Str("parent_hash", parentHash).
+			Str("scanned_hash", prev.Hash).
+			Msg("block doesn't extend the scanned chain, reorg detected")
+		reorg, metas, err = d.handleReorg(height, chainHeight, txIn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.pending = append(metas, newBlockMeta(height, hash, parentHash, txIn))
+	return reorg, nil
+}
+
+// Commit records the block passed to the last ProcessBlock call, along with the canonical
+// blocks that replaced the orphaned ones, in a single write.
+func (d *ReorgDetector) Commit() error {
+	if len(d.pending) == 0 {
+		return nil
+	}
+	height := d.pending[len(d.pending)-1].Height
+	if err := d.storage.SaveBlockMetas(d.pending); err != nil {
+		return fmt.Errorf("fail to save block metas of %d: %w", height, err)
+	}
+	d.pending = nil
+
+	if height%d.maxDepth == 0 {
+		if err := d.storage.PruneBlockMetas(height - d.maxDepth); err != nil {
+			d.logger.Err(err).Int64("height", height).Msg("fail to prune block metas")
+		}
+	}
+	return nil
+}
+
+// handleReorg walks back to the fork point and rescans the orphaned blocks. It returns
+// the reorg and the metas of the canonical blocks, which are only written once the whole
+// reorg is known.
+func (d *ReorgDetector) handleReorg(height, chainHeight int64, txIn types.TxIn) (*Reorg, []BlockMeta, error) {
+	// walk back the scanned blocks to the last one still on the canonical chain
+	var orphaned []*BlockMeta
+	earliest := max(height-d.maxDepth, 1)
+	forkHeight := height - 1
+	for ; forkHeight >= earliest; forkHeight-- {
+		meta, err := d.storage.GetBlockMeta(forkHeight)
+		if err != nil {
+			return nil, nil, err
+		}
+		if meta == nil {
+			// nothing is known before this block
+			break
+		}
+		hash, _, err := d.hashes.GetBlockHashes(forkHeight)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to get block hashes of %d: %w", forkHeight, err)
+		}
+		if strings.EqualFold(meta.Hash, hash) {
+			break
+		}
+		orphaned = append(orphaned, meta)
+	}
+	if forkHeight < earliest && earliest > 1 {
+		d.logger.Error().
+			Int64("height", height).
+			Int64("max_depth", d.maxDepth).
+			Msg("reorg is deeper than the max reorg depth, only the blocks within it are rescanned")
+	}
+
+	// rescan the canonical blocks, oldest first
+	reorg := &Reorg{
+		ForkHeight: forkHeight,
+		Orphaned:   int64(len(orphaned)),
+	}
+	canonical := make(map[string]bool)
+	for _, item := range txIn.TxArray {
+		canonical[item.Tx] = true
+	}
+	var metas []BlockMeta
+	for i := len(orphaned) - 1; i >= 0; i-- {
+		blockHeight := orphaned[i].Height
+		rescanned, err := d.fetcher.FetchTxs(blockHeight, chainHeight)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to rescan block %d: %w", blockHeight, err)
+		}
+		hash, parentHash, err := d.hashes.GetBlockHashes(blockHeight)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to get block hashes of %d: %w", blockHeight, err)
+		}
+		for _, item := range rescanned.TxArray {
+			canonical[item.Tx] = true
+		}
+		if len(rescanned.TxArray) > 0 {
+			reorg.Rescanned = append(reorg.Rescanned, rescanned)
+		}
+		metas = append(metas, newBlockMeta(blockHeight, hash, parentHash, rescanned))
+	}
+
+	// txs of the orphaned blocks that didn't make it to the canonical chain are errata
+	for i := len(orphaned) - 1; i >= 0; i-- {
+		var errataTxs []types.ErrataTx
+		for _, txID := range orphaned[i].TxIDs {
+			if canonical[txID] {
+				continue
+			}
+			d.logger.Info().Int64("height", orphaned[i].Height).Str("txid", txID).Msg("errata tx")
+			errataTxs = append(errataTxs, types.ErrataTx{
+				TxID:  common.TxID(txID),
+				Chain: d.chain,
+			})
+		}
+		if len(errataTxs) > 0 {
+			reorg.Errata = append(reorg.Errata, types.ErrataBlock{
+				Height: orphaned[i].Height,
+				Txs:    errataTxs,
+			})
+		}
+	}
+
+	d.logger.Warn().
+		Int64("height", height).
+		Int64("fork_height", reorg.ForkHeight).
+		Int64("orphaned", reorg.Orphaned).
+		Int("errata_blocks", len(reorg.Errata)).
+		Msg("reorg detected")
+
+	return reorg, metas, nil
+}
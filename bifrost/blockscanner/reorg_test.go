@@ -0,0 +1,166 @@
+package blockscanner
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+// fakeForkChain is a chain whose blocks can be replaced to simulate a reorg
+type fakeForkChain struct {
+	DummyFetcher
+	// fork is the fork each height is currently on
+	fork map[int64]int
+	// txs are the txs of each fork/height
+	txs map[string][]string
+	// unavailable is a height that can't be fetched
+	unavailable int64
+}
+
+func newFakeForkChain() *fakeForkChain {
+	return &fakeForkChain{
+		fork: make(map[int64]int),
+		txs:  make(map[string][]string),
+	}
+}
+
+func (f *fakeForkChain) key(height int64) string {
+	return fmt.Sprintf("%d-%d", f.fork[height], height)
+}
+
+func (f *fakeForkChain) GetBlockHashes(height int64) (string, string, error) {
+	return "hash-" + f.key(height), "hash-" + f.key(height-1), nil
+}
+
+func (f *fakeForkChain) FetchTxs(height, chainHeight int64) (types.TxIn, error) {
+	if height == f.unavailable {
+		return types.TxIn{}, fmt.Errorf("block %d is unavailable", height)
+	}
+	txIn := types.TxIn{Chain: common.GAIAChain}
+	for _, tx := range f.txs[f.key(height)] {
+		txIn.TxArray = append(txIn.TxArray, &types.TxInItem{BlockHeight: height, Tx: tx})
+	}
+	return txIn, nil
+}
+
+type ReorgDetectorSuite struct{}
+
+var _ = Suite(&ReorgDetectorSuite{})
+
+func (s *ReorgDetectorSuite) TestNewReorgDetector(c *C) {
+	cfg := config.BifrostBlockScannerConfiguration{ChainID: common.GAIAChain}
+	c.Check(NewReorgDetector(cfg, NewMockScannerStorage(), newFakeForkChain()), IsNil)
+
+	cfg.MaxReorgDepth = 10
+	c.Check(NewReorgDetector(cfg, NewMockScannerStorage(), newFakeForkChain()), NotNil)
+
+	// chain scanners without block hashes are not checked
+	c.Check(NewReorgDetector(cfg, NewMockScannerStorage(), DummyFetcher{}), IsNil)
+}
+
+func (s *ReorgDetectorSuite) TestProcessBlock(c *C) {
+	chain := newFakeForkChain()
+	storage := NewMockScannerStorage()
+	cfg := config.BifrostBlockScannerConfiguration{ChainID: common.GAIAChain, MaxReorgDepth: 5}
+	detector := NewReorgDetector(cfg, storage, chain)
+	c.Assert(detector, NotNil)
+
+	scan := func(height int64) *Reorg {
+		txIn, err := chain.FetchTxs(height, height)
+		c.Assert(err, IsNil)
+		reorg, err := detector.ProcessBlock(height, height, txIn)
+		c.Assert(err, IsNil)
+		c.Assert(detector.Commit(), IsNil)
+		return reorg
+	}
+
+	chain.txs["0-11"] = []string{"tx1"}
+	chain.txs["0-12"] = []string{"tx2", "tx3"}
+	for height := int64(10); height <= 13; height++ {
+		c.Assert(scan(height), IsNil)
+	}
+	meta, err := storage.GetBlockMeta(12)
+	c.Assert(err, IsNil)
+	c.Check(meta.Hash, Equals, "hash-0-12")
+	c.Check(meta.TxIDs, DeepEquals, []string{"tx2", "tx3"})
+
+	// blocks 12 and 13 are replaced, tx2 moved to block 14 and tx3 is gone
+	chain.fork[12] = 1
+	chain.fork[13] = 1
+	chain.fork[14] = 1
+	chain.txs["1-12"] = []string{"tx4"}
+	chain.txs["1-14"] = []string{"tx2"}
+
+	// a rescan failing partway records nothing, the retry still reveals the whole reorg
+	chain.unavailable = 13
+	txIn, err := chain.FetchTxs(14, 14)
+	c.Assert(err, IsNil)
+	_, err = detector.ProcessBlock(14, 14, txIn)
+	c.Assert(err, NotNil)
+	meta, err = storage.GetBlockMeta(12)
+	c.Assert(err, IsNil)
+	c.Check(meta.Hash, Equals, "hash-0-12")
+
+	// a reorg that wasn't committed is revealed again
+	chain.unavailable = 0
+	reorg, err := detector.ProcessBlock(14, 14, txIn)
+	c.Assert(err, IsNil)
+	c.Assert(reorg, NotNil)
+	meta, err = storage.GetBlockMeta(12)
+	c.Assert(err, IsNil)
+	c.Check(meta.Hash, Equals, "hash-0-12")
+
+	reorg = scan(14)
+	c.Assert(reorg, NotNil)
+	c.Check(reorg.ForkHeight, Equals, int64(11))
+	c.Check(reorg.Orphaned, Equals, int64(2))
+	c.Assert(reorg.Rescanned, HasLen, 1)
+	c.Check(reorg.Rescanned[0].TxArray[0].Tx, Equals, "tx4")
+	c.Assert(reorg.Errata, HasLen, 1)
+	c.Check(reorg.Errata[0].Height, Equals, int64(12))
+	c.Check(reorg.Errata[0].Txs, DeepEquals, []types.ErrataTx{{TxID: "tx3", Chain: common.GAIAChain}})
+
+	// the canonical blocks replaced the orphaned ones
+	meta, err = storage.GetBlockMeta(12)
+	c.Assert(err, IsNil)
+	c.Check(meta.Hash, Equals, "hash-1-12")
+	c.Check(meta.TxIDs, DeepEquals, []string{"tx4"})
+	c.Assert(scan(15), IsNil)
+
+	// a reorg deeper than the max depth only rescans the blocks within it
+	for height := int64(7); height <= 16; height++ {
+		chain.fork[height] = 2
+	}
+	reorg = scan(16)
+	c.Assert(reorg, NotNil)
+	c.Check(reorg.ForkHeight, Equals, int64(10))
+	c.Check(reorg.Orphaned, Equals, int64(5))
+	c.Check(reorg.Rescanned, HasLen, 0)
+	c.Assert(reorg.Errata, HasLen, 3)
+	c.Check(reorg.Errata[0].Height, Equals, int64(11))
+	c.Check(reorg.Errata[1].Height, Equals, int64(12))
+	c.Check(reorg.Errata[2].Height, Equals, int64(14))
+}
+
+func (s *ReorgDetectorSuite) TestPruneBlockMetas(c *C) {
+	storage, err := NewBlockScannerStorage("", config.LevelDBOptions{})
+	c.Assert(err, IsNil)
+	var metas []BlockMeta
+	for height := int64(1); height <= 20; height++ {
+		metas = append(metas, BlockMeta{Height: height, Hash: fmt.Sprintf("hash-%d", height)})
+	}
+	c.Assert(storage.SaveBlockMetas(metas), IsNil)
+	c.Assert(storage.PruneBlockMetas(15), IsNil)
+
+	meta, err := storage.GetBlockMeta(14)
+	c.Assert(err, IsNil)
+	c.Check(meta, IsNil)
+	meta, err = storage.GetBlockMeta(15)
+	c.Assert(err, IsNil)
+	c.Assert(meta, NotNil)
+	c.Check(meta.Hash, Equals, "hash-15")
+}
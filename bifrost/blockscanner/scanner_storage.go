@@ -17,6 +17,9 @@ type ScannerStorage interface {
 	SetBlockScanStatus(block Block, status BlockScanStatus) error
 	RemoveBlockStatus(block int64) error
 	GetBlocksForRetry(failedOnly bool) ([]Block, error)
+	GetBlockMeta(height int64) (*BlockMeta, error)
+	SaveBlockMetas(metas []BlockMeta) error
+	PruneBlockMetas(height int64) error
 	GetInternalDb() *leveldb.DB
 	io.Closer
 }
@@ -23,6 +23,7 @@ const (
 	CurrentPosition         MetricName = `current_position`
 	TotalRetryBlocks        MetricName = `total_retry_blocks`
 	CommonBlockScannerError MetricName = `block_scanner_error`
+	CommonBlockScannerReorg MetricName = `block_scanner_reorg`
 
 	SwitchlyBlockScannerError MetricName = `switchly_block_scan_error`
 	BlockDiscoveryDuration    MetricName = `block_discovery_duration`
@@ -43,6 +44,9 @@ const (
 	MessagesBatched MetricName = `messages_batched`
 	BatchSize       MetricName = `batch_size`
 	BatchSendTime   MetricName = `batch_send_time`
+
+	HealthCheckStatus MetricName = `health_check_status`
+	HealthCheckValue  MetricName = `health_check_value`
 )
 
 // Metrics used to provide promethus metrics
@@ -106,6 +110,14 @@ var (
 		}, []string{
 			"error_name", "additional",
 		}),
+		CommonBlockScannerReorg: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "block_scanner",
+			Subsystem: "common_block_scanner",
+			Name:      "reorgs_total",
+			Help:      "reorgs detected by the common block scanner",
+		}, []string{
+			"chain",
+		}),
 
 		SwitchlyBlockScannerError: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: "block_scanner",
@@ -193,6 +205,25 @@ var (
 	}
 
 	gauges = map[MetricName]prometheus.Gauge{}
+
+	gaugeVecs = map[MetricName]*prometheus.GaugeVec{
+		HealthCheckStatus: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bifrost",
+			Subsystem: "health",
+			Name:      "check_status",
+			Help:      "status of the health checks, 0 is ok, 1 is degraded and 2 is failing",
+		}, []string{
+			"check", "target",
+		}),
+		HealthCheckValue: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bifrost",
+			Subsystem: "health",
+			Name:      "check_value",
+			Help:      "value measured by the health checks, e.g. the block lag of a chain scanner",
+		}, []string{
+			"check", "target",
+		}),
+	}
 )
 
 // NewMetrics create a new instance of Metrics
@@ -214,6 +245,9 @@ func NewMetrics(cfg config.BifrostMetricsConfiguration) (*Metrics, error) {
 	for _, item := range gauges {
 		prometheus.MustRegister(item)
 	}
+	for _, item := range gaugeVecs {
+		prometheus.MustRegister(item)
+	}
 	// create a new mux server
 	server := http.NewServeMux()
 	// register a new handler for the /metrics endpoint
@@ -272,6 +306,14 @@ func (m *Metrics) GetGauge(name MetricName) prometheus.Gauge {
 	return nil
 }
 
+// GetGaugeVec return a gauge vec by name
+func (m *Metrics) GetGaugeVec(name MetricName) *prometheus.GaugeVec {
+	if g, ok := gaugeVecs[name]; ok {
+		return g
+	}
+	return nil
+}
+
 func (m *Metrics) GetCounterVec(name MetricName) *prometheus.CounterVec {
 	if c, ok := counterVecs[name]; ok {
 		return c
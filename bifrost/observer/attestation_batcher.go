@@ -336,6 +336,13 @@ func (b *AttestationBatcher) AddErrataTx(errata common.AttestErrataTx) {
 	}
 }
 
+// Pending returns the number of attestations waiting to be sent to the peers
+func (b *AttestationBatcher) Pending() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.observedTxBatch) + len(b.networkFeeBatch) + len(b.solvencyBatch) + len(b.errataTxBatch)
+}
+
 // triggerBatchSend triggers an immediate batch send outside the regular interval
 func (b *AttestationBatcher) triggerBatchSend() {
 	select {
@@ -311,6 +311,39 @@ func TestAttestationBatcher(t *testing.T) {
 		// batchClears := m.GetCounter(metrics.BatchClears)
 		// assert.GreaterOrEqual(t, testutil.ToFloat64(batchClears), float64(1), "Batch clears metric should be incremented")
 	})
+
+	t.Run("counts pending attestations", func(t *testing.T) {
+		// Create batcher, it isn't started so nothing is sent
+		batcher := NewAttestationBatcher(NewBatcherMockHost([]peer.ID{}), logger, nil, time.Hour, 10, 1*time.Second, 4)
+		assert.Equal(t, 0, batcher.Pending())
+
+		batcher.AddObservedTx(common.AttestTx{
+			ObsTx: common.ObservedTx{
+				Tx: common.Tx{
+					ID:    common.TxID("tx"),
+					Chain: common.BTCChain,
+				},
+			},
+		})
+		batcher.AddNetworkFee(common.AttestNetworkFee{
+			NetworkFee: &common.NetworkFee{
+				Chain:  common.BTCChain,
+				Height: 100,
+			},
+		})
+		batcher.AddSolvency(common.AttestSolvency{
+			Solvency: &common.Solvency{
+				Chain: common.BTCChain,
+			},
+		})
+		batcher.AddErrataTx(common.AttestErrataTx{
+			ErrataTx: &common.ErrataTx{
+				Chain: common.BTCChain,
+			},
+		})
+
+		assert.Equal(t, 4, batcher.Pending(), "All attestation types should be counted")
+	})
 }
 
 // BatcherMockHost is a more detailed mock host implementation for testing the batcher
@@ -333,6 +333,11 @@ func (s *AttestationGossip) SetObserverHandleObservedTxCommitted(o *Observer) {
 	s.observerHandleObservedTxCommitted = o.handleObservedTxCommitted
 }
 
+// PendingAttestations returns the number of attestations waiting in the batcher
+func (s *AttestationGossip) PendingAttestations() int {
+	return s.batcher.Pending()
+}
+
 // Handle a committed quorum transaction event
 func (s *AttestationGossip) handleQuorumTxCommitted(en *ebifrost.EventNotification) {
 	s.logger.Debug().Msg("handling quorum tx committed event")
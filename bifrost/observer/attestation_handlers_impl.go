@@ -1,9 +1,11 @@
 package observer
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
 
@@ -35,11 +37,23 @@ func (s *AttestationGossip) handleObservedTxAttestation(ctx context.Context, tx
 	defer state.mu.Unlock()
 
 	// Add the attestation
+	count := state.AttestationCount()
 	if err := state.AddAttestation(tx.Attestation); err != nil {
 		s.logger.Error().Err(err).Msg("fail to add attestation")
 		return
 	}
 
+	// the deck attests its txs again until they are committed, trace the first one only
+	if state.AttestationCount() > count && bytes.Equal(tx.Attestation.PubKey, s.pubKey) {
+		tracing.Event(tracing.CorrelationID(obsTx.Tx), tracing.StageAttested,
+			tracing.AttrChain.String(obsTx.Tx.Chain.String()),
+			tracing.AttrObservedTx.String(obsTx.Tx.ID.String()),
+			tracing.AttrHeight.Int64(obsTx.BlockHeight),
+			tracing.AttrInbound.Bool(tx.Inbound),
+			tracing.AttrFinalised.Bool(obsTx.IsFinal()),
+		)
+	}
+
 	// Determine the number of validators needed for attestation
 	var total int
 	if k.AllowFutureObservation {
@@ -78,6 +92,8 @@ func (s *AttestationGossip) sendObservedTxAttestationsToSwitchlynode(
 		s.logger.Debug().Msg("no unsent observed tx attestations")
 		return
 	}
+	firstQuorum := isQuorum && state.quorumAttestationsSent.IsZero()
+
 	// Send via gRPC to switchlynode
 	if _, err := s.grpcClient.SendQuorumTx(ctx, &common.QuorumTx{
 		ObsTx:                  tx,
@@ -92,6 +108,16 @@ func (s *AttestationGossip) sendObservedTxAttestationsToSwitchlynode(
 	s.logger.Info().Msgf("sent quorum tx to switchlynode - %s, id: %s, inbound: %t, final: %t, attestations: %s",
 		tx.Tx.Chain, tx.Tx.ID, inbound, tx.IsFinal(), state.State())
 
+	if firstQuorum {
+		tracing.Event(tracing.CorrelationID(tx.Tx), tracing.StageQuorum,
+			tracing.AttrChain.String(tx.Tx.Chain.String()),
+			tracing.AttrObservedTx.String(tx.Tx.ID.String()),
+			tracing.AttrInbound.Bool(inbound),
+			tracing.AttrFinalised.Bool(tx.IsFinal()),
+			tracing.AttrAttestations.Int(len(unsent)),
+		)
+	}
+
 	// Mark attestations as sent
 	state.MarkAttestationsSent(isQuorum)
 }
@@ -16,8 +16,17 @@ type EventClient struct {
 	logger     zerolog.Logger
 	client     ebifrost.LocalhostBifrostClient
 	eventTypes []string
+	chains     []string
+	pubKeys    []string
 	handlers   map[string]func(*ebifrost.EventNotification)
 
+	// lastSequence is the sequence of the last received event, the subscription resumes
+	// after it when reconnecting so events emitted while disconnected aren't missed
+	lastSequence uint64
+	// bootID is the boot id of the stream lastSequence was received from, switchlynode
+	// replays all its buffered events when it restarted since
+	bootID string
+
 	// Subscription state
 	mu         sync.RWMutex
 	isActive   bool
@@ -90,6 +99,24 @@ func (ec *EventClient) RegisterHandler(eventType string, handler func(*ebifrost.
 	}
 }
 
+// SetFilters limits the subscription to events of the given chains and vault pubkeys, an
+// empty list matches everything. It takes effect on the next (re)subscription.
+func (ec *EventClient) SetFilters(chains []string, pubKeys []string) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	ec.chains = chains
+	ec.pubKeys = pubKeys
+}
+
+// LastSequence returns the sequence of the last received event
+func (ec *EventClient) LastSequence() uint64 {
+	ec.mu.RLock()
+	defer ec.mu.RUnlock()
+
+	return ec.lastSequence
+}
+
 // Start begins a new subscription, canceling any previous one
 func (ec *EventClient) Start() {
 	ec.mu.Lock()
@@ -243,16 +270,21 @@ func (ec *EventClient) subscribe(ctx context.Context) error {
 		// Continue with subscription
 	}
 
-	// Get a copy of current event types and handlers
+	// Get a copy of current event types, filters and cursor
 	ec.mu.RLock()
 	eventTypes := make([]string, len(ec.eventTypes))
 	copy(eventTypes, ec.eventTypes)
+	req := &ebifrost.SubscribeRequest{
+		EventTypes:   eventTypes,
+		Chains:       append([]string{}, ec.chains...),
+		PubKeys:      append([]string{}, ec.pubKeys...),
+		FromSequence: ec.lastSequence,
+		BootId:       ec.bootID,
+	}
 	ec.mu.RUnlock()
 
-	// Start the subscription
-	stream, err := ec.client.SubscribeToEvents(ctx, &ebifrost.SubscribeRequest{
-		EventTypes: eventTypes,
-	})
+	// Start the subscription, resuming after the last received event
+	stream, err := ec.client.SubscribeToEvents(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -281,10 +313,11 @@ func (ec *EventClient) subscribe(ctx context.Context) error {
 			}
 		}
 
-		// Get the appropriate handler
-		ec.mu.RLock()
+		// Get the appropriate handler and advance the cursor
+		ec.mu.Lock()
+		ec.trackSequence(event)
 		handler, exists := ec.handlers[event.EventType]
-		ec.mu.RUnlock()
+		ec.mu.Unlock()
 
 		if exists {
 			// Use a separate goroutine for handler to avoid blocking
@@ -294,3 +327,22 @@ func (ec *EventClient) subscribe(ctx context.Context) error {
 		}
 	}
 }
+
+// trackSequence advances the cursor to the sequence of the given event, the lock must be held
+func (ec *EventClient) trackSequence(event *ebifrost.EventNotification) {
+	if event.Sequence == 0 {
+		return
+	}
+	if event.BootId != ec.bootID {
+		// sequences restart along with switchlynode, which replays its buffered events
+		if ec.bootID != "" {
+			ec.logger.Warn().
+				Str("boot_id", event.BootId).
+				Uint64("last_sequence", ec.lastSequence).
+				Uint64("sequence", event.Sequence).
+				Msg("Event stream restarted")
+		}
+		ec.bootID = event.BootId
+	}
+	ec.lastSequence = event.Sequence
+}
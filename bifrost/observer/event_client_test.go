@@ -130,6 +130,15 @@ func (m *MockBifrostClient) SubscribeToEvents(ctx context.Context, in *ebifrost.
 	return args.Get(0).(ebifrost.LocalhostBifrost_SubscribeToEventsClient), args.Error(1)
 }
 
+func (m *MockBifrostClient) GetPendingItems(ctx context.Context, in *ebifrost.PendingItemsRequest, opts ...grpc.CallOption) (*ebifrost.PendingItemsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	// nolint:forcetypeassert
+	return args.Get(0).(*ebifrost.PendingItemsResponse), args.Error(1)
+}
+
 func TestNewEventClient(t *testing.T) {
 	mockClient := new(MockBifrostClient)
 	client := observer.NewEventClient(mockClient)
@@ -529,3 +538,62 @@ waitLoop:
 
 	mockClient.AssertExpectations(t)
 }
+
+func TestSubscriptionResumesFromLastSequence(t *testing.T) {
+	mockClient := new(MockBifrostClient)
+	client := observer.NewEventClient(mockClient)
+	client.SetFilters([]string{"BTC"}, nil)
+
+	handled := make(chan uint64, 5)
+	client.RegisterHandler("transaction", func(event *ebifrost.EventNotification) {
+		handled <- event.Sequence
+	})
+
+	// First stream delivers two events then disconnects
+	firstStream := &MockBifrostStream{
+		events: []*ebifrost.EventNotification{
+			{EventType: "transaction", Sequence: 1, Chain: "BTC", BootId: "boot1"},
+			{EventType: "transaction", Sequence: 2, Chain: "BTC", BootId: "boot1"},
+		},
+	}
+	mockClient.On("SubscribeToEvents",
+		mock.Anything,
+		mock.MatchedBy(func(req *ebifrost.SubscribeRequest) bool {
+			return req.FromSequence == 0 && req.BootId == ""
+		}),
+		mock.Anything).Return(firstStream, nil).Once()
+
+	// The reconnection resumes after the last received event of the same boot, with the same
+	// filters
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	secondStream := &MockBifrostStream{
+		events: []*ebifrost.EventNotification{
+			{EventType: "transaction", Sequence: 3, Chain: "BTC", BootId: "boot1"},
+		},
+		ctx: ctx,
+	}
+	mockClient.On("SubscribeToEvents",
+		mock.Anything,
+		mock.MatchedBy(func(req *ebifrost.SubscribeRequest) bool {
+			return req.FromSequence == 2 && req.BootId == "boot1" && len(req.Chains) == 1 && req.Chains[0] == "BTC"
+		}),
+		mock.Anything).Return(secondStream, nil).Once()
+
+	client.Start()
+
+	received := map[uint64]bool{}
+	for len(received) < 3 {
+		select {
+		case seq := <-handled:
+			received[seq] = true
+		case <-time.After(3 * time.Second):
+			t.Fatal("events were not received within timeout")
+		}
+	}
+
+	client.Stop()
+
+	assert.Equal(t, uint64(3), client.LastSequence())
+	mockClient.AssertExpectations(t)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	stypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
@@ -288,6 +289,13 @@ func (o *Observer) handleObservedTxCommitted(tx common.ObservedTx) {
 		return
 	}
 
+	tracing.Event(tracing.CorrelationID(tx.Tx), tracing.StageCommitted,
+		tracing.AttrChain.String(tx.Tx.Chain.String()),
+		tracing.AttrObservedTx.String(tx.Tx.ID.String()),
+		tracing.AttrHeight.Int64(tx.BlockHeight),
+		tracing.AttrFinalised.Bool(isFinal),
+	)
+
 	o.logger.Debug().
 		Int("ondeck_size", len(o.onDeck)).
 		Str("id", tx.Tx.ID.String()).
@@ -477,6 +485,7 @@ func (o *Observer) processObservedTx(txIn types.TxIn) {
 			o.logger.Error().Err(err).Msg("fail to get chain client for confirmation count")
 		}
 		o.logger.Debug().Msgf("filterObservations took %s", time.Since(filterStart))
+		traceScanned(txIn)
 	}
 
 	k := TxInKey(&txIn)
@@ -534,6 +543,27 @@ func (o *Observer) processObservedTx(txIn types.TxIn) {
 	o.logger.Debug().Msgf("AddOrUpdateTx new took %s", time.Since(setDeckStart))
 }
 
+// traceScanned records the scanning of the new items of a tx in, which start the
+// lifecycle of inbounds and report the broadcast of outbounds.
+func traceScanned(txIn types.TxIn) {
+	if !tracing.Enabled() {
+		return
+	}
+	for _, item := range txIn.TxArray {
+		txID, err := common.NewTxID(item.Tx)
+		if err != nil {
+			continue
+		}
+		tracing.Event(tracing.CorrelationID(common.Tx{ID: txID, Memo: item.Memo}), tracing.StageScanned,
+			tracing.AttrChain.String(txIn.Chain.String()),
+			tracing.AttrHeight.Int64(item.BlockHeight),
+			tracing.AttrObservedTx.String(txID.String()),
+			tracing.AttrMemo.String(item.Memo),
+			tracing.AttrMemPool.Bool(txIn.MemPool),
+		)
+	}
+}
+
 func (o *Observer) filterObservations(chain common.Chain, items []*types.TxInItem, memPool bool) []*types.TxInItem {
 	var txs []*types.TxInItem
 	for _, txInItem := range items {
@@ -426,6 +426,15 @@ func (c *Communication) Start(priKeyBytes []byte) error {
 	return err
 }
 
+// StartWithHost will start the communication over the given host instead of creating one,
+// there is no peer discovery so the host is expected to be connected to its peers already
+func (c *Communication) StartWithHost(h host.Host) {
+	c.host = h
+	h.SetStreamHandler(TSSProtocolID, c.handleStreamTss)
+	c.wg.Add(1)
+	go c.ProcessBroadcast()
+}
+
 // Stop communication
 func (c *Communication) Stop() error {
 	// we need to stop the handler and the p2p services firstly, then terminate the our communication threads
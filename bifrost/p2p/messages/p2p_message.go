@@ -23,6 +23,8 @@ const (
 	TSSControlMsg
 	// TSSTaskDone is the message of Tss process notification
 	TSSTaskDone
+	// TSSReshareMsg is the message generated by tss-lib to reshare a key to a new set of parties
+	TSSReshareMsg
 	// Unknown is the message indicates the undefined message type
 	Unknown
 )
@@ -38,6 +40,8 @@ func (msgType SWITCHLYChainTSSMessageType) String() string {
 		return "TSSKeyGenVerMsg"
 	case TSSKeySignVerMsg:
 		return "TSSKeySignVerMsg"
+	case TSSReshareMsg:
+		return "TSSReshareMsg"
 	default:
 		return "Unknown"
 	}
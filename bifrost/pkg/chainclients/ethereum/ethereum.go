@@ -40,7 +40,6 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
-	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/aggregators"
 	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
 )
 
@@ -199,7 +198,7 @@ func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan s
 	c.tssKeySigner.Start()
 	// Seed initial network fee to avoid zero max gas during cold start
 	c.ethScanner.reportInitialNetworkFee()
-	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
+	c.blockScanner.Start(globalTxsQueue, globalErrataQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
 	go c.unstuck()
 	c.wg.Add(1)
@@ -609,9 +608,7 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, *sty
 
 	if tx.Aggregator != "" {
 		var gasLimitForAggregator uint64
-		gasLimitForAggregator, err = aggregators.FetchDexAggregatorGasLimit(
-			c.cfg.ChainID, tx.Aggregator,
-		)
+		gasLimitForAggregator, err = c.ethScanner.dexAggregators.GasLimit(tx.Aggregator)
 		if err != nil {
 			c.logger.Err(err).
 				Str("aggregator", tx.Aggregator).
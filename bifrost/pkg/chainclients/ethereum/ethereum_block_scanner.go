@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"sort"
 	"strings"
 	"sync"
 
@@ -31,6 +30,7 @@ import (
 	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	tokenlist "github.com/switchlyprotocol/switchlynode/v3/common/tokenlist"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
@@ -60,6 +60,7 @@ type ETHScanner struct {
 	m                     *metrics.Metrics
 	errCounter            *prometheus.CounterVec
 	gasPriceChanged       bool
+	feeModel              feemodel.EIP1559
 	gasPrice              *big.Int
 	lastReportedGasPrice  uint64
 	client                *ethclient.Client
@@ -70,6 +71,7 @@ type ETHScanner struct {
 	erc20ABI              *abi.ABI
 	tokens                *evm.LevelDBTokenMeta
 	bridge                switchlyclient.SwitchlyBridge
+	dexAggregators        *evm.DexAggregators
 	pubkeyMgr             pubkeymanager.PubKeyValidator
 	eipSigner             etypes.Signer
 	currentBlockHeight    int64
@@ -126,12 +128,14 @@ func NewETHScanner(cfg config.BifrostBlockScannerConfiguration,
 		client:               client,
 		db:                   storage,
 		m:                    m,
-		gasPrice:             big.NewInt(initialGasPrice),
+		feeModel:             feemodel.EIP1559{},
+		gasPrice:             feemodel.EIP1559{}.InitialGasPrice(),
 		lastReportedGasPrice: 0,
 		gasPriceChanged:      false,
 		blockMetaAccessor:    blockMetaAccessor,
 		tokens:               tokens,
 		bridge:               bridge,
+		dexAggregators:       evm.NewDexAggregators(common.ETHChain, bridge),
 		vaultABI:             vaultABI,
 		erc20ABI:             erc20ABI,
 		eipSigner:            etypes.NewPragueSigner(chainID),
@@ -159,19 +163,18 @@ func (e *ETHScanner) reportInitialNetworkFee() {
 		return
 	}
 
-	txSize := e.cfg.MaxGasLimit
-	if txSize == 0 {
-		txSize = 21000
-	}
-
-	const minTxRate uint64 = 1
+	// the smallest gas price the fee model reports
+	txSize, txRate := e.feeModel.NetworkFee(feemodel.Observation{
+		Size: e.cfg.MaxGasLimit,
+		Rate: 1,
+	})
 
 	select {
 	case e.globalNetworkFeeQueue <- common.NetworkFee{
 		Chain:           common.ETHChain,
 		Height:          1,
 		TransactionSize: txSize,
-		TransactionRate: minTxRate,
+		TransactionRate: txRate,
 	}:
 		// seeded
 	default:
@@ -243,18 +246,17 @@ func (e *ETHScanner) FetchTxs(height, chainHeight int64) (stypes.TxIn, error) {
 	}
 
 	// gas price to 1e8 from 1e18
-	gasPrice := e.GetGasPrice()
-	tcGasPrice := new(big.Int).Div(gasPrice, big.NewInt(1e10)).Uint64()
-	if tcGasPrice == 0 {
-		tcGasPrice = 1
-	}
+	gasLimit, tcGasPrice := e.feeModel.NetworkFee(feemodel.Observation{
+		Size: e.cfg.MaxGasLimit,
+		Rate: e.GetGasPrice().Uint64(),
+	})
 
 	// post to switchly if there is a fee and it has changed
-	if gasPrice.Cmp(big.NewInt(0)) != 0 && tcGasPrice != e.lastReportedGasPrice {
+	if tcGasPrice != 0 && tcGasPrice != e.lastReportedGasPrice {
 		e.globalNetworkFeeQueue <- common.NetworkFee{
 			Chain:           common.ETHChain,
 			Height:          height,
-			TransactionSize: e.cfg.MaxGasLimit,
+			TransactionSize: gasLimit,
 			TransactionRate: tcGasPrice,
 		}
 
@@ -271,18 +273,12 @@ func (e *ETHScanner) FetchTxs(height, chainHeight int64) (stypes.TxIn, error) {
 
 // updateGasPrice records base fee + 25th percentile priority fee, rounded up 10 gwei.
 func (e *ETHScanner) updateGasPrice(baseFee *big.Int, priorityFees []*big.Int) {
-	// skip empty blocks
-	if len(priorityFees) == 0 {
+	// consider gas price as base fee + 25th percentile priority fee, skip empty blocks
+	gasPriceWei := e.feeModel.GasPrice(baseFee, priorityFees)
+	if gasPriceWei == nil {
 		return
 	}
 
-	// find the 25th percentile priority fee in the block
-	sort.Slice(priorityFees, func(i, j int) bool { return priorityFees[i].Cmp(priorityFees[j]) == -1 })
-	priorityFee := priorityFees[len(priorityFees)/4]
-
-	// consider gas price as base fee + 25th percentile priority fee
-	gasPriceWei := new(big.Int).Add(baseFee, priorityFee)
-
 	// round the price up to nearest configured resolution
 	resolution := big.NewInt(e.cfg.GasPriceResolution)
 	gasPriceWei.Add(gasPriceWei, new(big.Int).Sub(resolution, big.NewInt(1)))
@@ -714,6 +710,7 @@ func (e *ETHScanner) isToValidContractAddress(addr *ecommon.Address, includeWhit
 	contractAddresses := e.pubkeyMgr.GetContracts(common.ETHChain)
 	if includeWhiteList || useWhitelistSmartContract {
 		contractAddresses = append(contractAddresses, whitelistSmartContractAddress...)
+		contractAddresses = append(contractAddresses, e.dexAggregators.Contracts()...)
 	}
 	// combine the whitelist smart contract address
 	for _, item := range contractAddresses {
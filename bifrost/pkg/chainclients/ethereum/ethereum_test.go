@@ -25,6 +25,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/cmd"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	openapi "github.com/switchlyprotocol/switchlynode/v3/openapi/gen"
 	types2 "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
@@ -309,7 +310,7 @@ func (s *EthereumSuite) TestClient(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(height, Equals, int64(7))
 	gasPrice := e2.GetGasPrice()
-	c.Check(gasPrice.Uint64(), Equals, uint64(initialGasPrice))
+	c.Check(gasPrice.Uint64(), Equals, feemodel.EIP1559{}.InitialGasPrice().Uint64())
 
 	acct, err := e2.GetAccount(types2.GetRandomPubKey(), nil)
 	c.Assert(err, IsNil)
@@ -0,0 +1,318 @@
+package evm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ecommon "github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/evm"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
+	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
+)
+
+// maxBatchOutputs is the most outbounds a router batchTransferOut call can pay
+const maxBatchOutputs = 10
+
+// batchTransferOutMethod is the router method paying several outbounds in one call,
+// routers before version 4.2 don't have it
+const batchTransferOutMethod = "batchTransferOut"
+
+// routerCoin is the Coin struct of the router contract
+type routerCoin struct {
+	Asset  ecommon.Address
+	Amount *big.Int
+}
+
+// MaxBatchOutputs returns the most outbounds to pay with one batchTransferOut call for
+// the outbounds scheduled at the given SWITCHLYChain height, 0 if batching is disabled
+// on the chain.
+func (c *EVMClient) MaxBatchOutputs(height int64) (int64, error) {
+	key := fmt.Sprintf(constants.MimirTemplateMaxBatchOutputs, c.cfg.ChainID.String())
+	maxOutputs, err := c.bridge.GetMimirAtHeight(key, height)
+	if err != nil {
+		return 0, fmt.Errorf("fail to get %s: %w", key, err)
+	}
+	if maxOutputs <= 1 {
+		return 0, nil
+	}
+	if maxOutputs > maxBatchOutputs {
+		return maxBatchOutputs, nil
+	}
+	return maxOutputs, nil
+}
+
+// IsBatchable returns true if the outbound can be paid by a batchTransferOut call. Only
+// plain outbounds and refunds of one gas asset coin with a max gas qualify, and only from
+// a vault whose router pays batchTransferOut calls. The router reverts the whole batch
+// when a token transfer fails, a paused or blacklisting token would fail every outbound
+// batched with it, so token outbounds are always paid on their own. It only looks at the
+// outbound and the router, so every signer of the vault agrees on it, an error is
+// returned when the router could not be probed.
+func (c *EVMClient) IsBatchable(tx stypes.TxOutItem) (bool, error) {
+	if !tx.Chain.Equals(c.cfg.ChainID) || len(tx.Coins) != 1 || tx.MaxGas.IsEmpty() {
+		return false, nil
+	}
+	if !tx.Coins[0].Asset.Equals(c.cfg.ChainID.GetGasAsset()) {
+		return false, nil
+	}
+	if tx.Coins[0].Amount.IsZero() || tx.Aggregator != "" || tx.ToAddress.IsEmpty() {
+		return false, nil
+	}
+	memo, err := mem.ParseMemo(common.LatestVersion, tx.Memo)
+	if err != nil {
+		return false, nil
+	}
+	if memo.GetType() != mem.TxOutbound && memo.GetType() != mem.TxRefund {
+		return false, nil
+	}
+	return c.routerSupportsBatch(c.getSmartContractAddr(tx.VaultPubKey))
+}
+
+// routerSupportsBatch returns true if the deployed router pays batchTransferOut calls,
+// probed with an eth_call of an empty batch: routers before version 4.2 have neither
+// the method nor a fallback, so the call reverts. Any other failure is returned, the
+// router is probed again on the next call. The result is cached per router, a router
+// is never upgraded in place.
+func (c *EVMClient) routerSupportsBatch(router common.Address) (bool, error) {
+	if router.IsEmpty() {
+		return false, nil
+	}
+	key := strings.ToLower(router.String())
+
+	c.batchRoutersLock.Lock()
+	defer c.batchRoutersLock.Unlock()
+	if supported, ok := c.batchRouters[key]; ok {
+		return supported, nil
+	}
+
+	data, err := c.vaultABI.Pack(batchTransferOutMethod, []ecommon.Address{}, []routerCoin{}, []string{})
+	if err != nil {
+		return false, fmt.Errorf("fail to pack %s probe: %w", batchTransferOutMethod, err)
+	}
+	to := ecommon.HexToAddress(router.String())
+	ctx, cancel := c.getTimeoutContext()
+	defer cancel()
+	_, err = c.ethClient.CallContract(ctx, ethereum.CallMsg{
+		To:   &to,
+		Data: data,
+	}, nil)
+	supported := err == nil
+	if err != nil && !isExecutionReverted(err) {
+		return false, fmt.Errorf("fail to probe router (%s) for %s: %w", router, batchTransferOutMethod, err)
+	}
+	c.batchRouters[key] = supported
+	return supported, nil
+}
+
+// isExecutionReverted returns true if the error is the node reporting the call reverted,
+// rather than failing to run it
+func isExecutionReverted(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return strings.Contains(strings.ToLower(rpcErr.Error()), "revert")
+}
+
+// SignBatchTx builds and signs one batchTransferOut call paying all the given outbounds,
+// they must all be batchable and from the same vault, as grouped by the signer from the
+// keysign block. The first item is the one the
+// nonce checkpoint is stored on, the switchly height is the height the outbounds were
+// scheduled at and is put in the memo of every outbound so observers can match the
+// TransferOut events back to their outbound. There is no instant observation, the
+// events are observed on chain.
+func (c *EVMClient) SignBatchTx(txs []stypes.TxOutItem, switchlyHeight int64) ([]byte, []byte, *stypes.TxInItem, error) {
+	if len(txs) < 2 {
+		return nil, nil, nil, errors.New("a batch needs at least two outbounds")
+	}
+	tx := txs[0]
+	if tx.Checkpoint == nil {
+		maxOutputs, err := c.MaxBatchOutputs(switchlyHeight)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if int64(len(txs)) > maxOutputs {
+			return nil, nil, nil, fmt.Errorf("batch of %d outbounds is over the max batch outputs", len(txs))
+		}
+	}
+
+	// skip batches that have been signed
+	if c.signerCacheManager.HasSigned(tx.CacheHash()) {
+		c.logger.Info().Msgf("ignoring already signed batch transaction: (%+v)", tx)
+		return nil, nil, nil, nil
+	}
+
+	for _, item := range txs {
+		if !item.VaultPubKey.Equals(tx.VaultPubKey) {
+			return nil, nil, nil, errors.New("batch outbounds must be from the same vault")
+		}
+		batchable, err := c.IsBatchable(item)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !batchable {
+			return nil, nil, nil, fmt.Errorf("outbound (%s) can't be batched", item.Hash())
+		}
+	}
+
+	fromAddr, err := tx.VaultPubKey.GetAddress(c.cfg.ChainID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to get %s address for pub key(%s): %w", c.GetChain().String(), tx.VaultPubKey, err)
+	}
+	nonce, err := c.outboundNonce(tx, fromAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	nonceBytes, err := json.Marshal(nonce)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to marshal nonce: %w", err)
+	}
+
+	outboundTx, err := c.buildBatchTx(txs, switchlyHeight, nonce)
+	if err != nil {
+		c.logger.Err(err).Msg("fail to build batch tx")
+		return nil, nil, nil, err
+	}
+
+	// if transaction is nil, abort to allow switchlynode reschedule
+	if outboundTx == nil {
+		return nil, nil, nil, nil
+	}
+
+	rawTx, err := c.sign(outboundTx, tx.VaultPubKey, switchlyHeight, tx)
+	if err != nil || len(rawTx) == 0 {
+		return nil, nonceBytes, nil, fmt.Errorf("fail to sign message: %w", err)
+	}
+	return rawTx, nil, nil, nil
+}
+
+// getBatchTxData packs the batchTransferOut call paying the outbounds, the memo of
+// every outbound is the batch memo with its index. It returns the call data and the
+// native value to send with it, the sum of the native outbounds.
+func (c *EVMClient) getBatchTxData(txs []stypes.TxOutItem, switchlyHeight int64) ([]byte, *big.Int, error) {
+	evmValue := big.NewInt(0)
+	recipients := make([]ecommon.Address, len(txs))
+	coins := make([]routerCoin, len(txs))
+	memos := make([]string, len(txs))
+	memo := mem.NewBatchOutboundMemo(switchlyHeight)
+	for i, item := range txs {
+		tokenAddr := c.getTokenAddressFromAsset(item.Coins[0].Asset)
+		value := c.evmScanner.tokenManager.ConvertSigningAmount(item.Coins[0].Amount.BigInt(), tokenAddr)
+		if strings.EqualFold(tokenAddr, evm.NativeTokenAddr) {
+			evmValue.Add(evmValue, value)
+		}
+		recipients[i] = ecommon.HexToAddress(item.ToAddress.String())
+		coins[i] = routerCoin{
+			Asset:  ecommon.HexToAddress(tokenAddr),
+			Amount: value,
+		}
+		memos[i] = memo.WithIndex(int64(i)).String()
+	}
+	data, err := c.vaultABI.Pack(batchTransferOutMethod, recipients, coins, memos)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to create data to call smart contract(batchTransferOut): %w", err)
+	}
+	return data, evmValue, nil
+}
+
+// buildBatchTx builds the batchTransferOut call paying the outbounds. The gas limit is
+// the sum of the max gas of the outbounds, so the batch never spends more than the
+// outbounds would on their own.
+func (c *EVMClient) buildBatchTx(txs []stypes.TxOutItem, switchlyHeight int64, nonce uint64) (*etypes.Transaction, error) {
+	tx := txs[0]
+	contractAddr := c.getSmartContractAddr(tx.VaultPubKey)
+	if contractAddr.IsEmpty() {
+		return nil, fmt.Errorf("can't sign tx, fail to get smart contract address")
+	}
+	fromAddr, err := tx.VaultPubKey.GetAddress(c.cfg.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get EVM address for pub key(%s): %w", tx.VaultPubKey, err)
+	}
+
+	txData, evmValue, err := c.getBatchTxData(txs, switchlyHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	gasRate := c.outboundGasRate(tx)
+
+	// same as a single outbound, estimate with a fixed value the vault surely has
+	estimatedEVMValue := big.NewInt(0)
+	if evmValue.Sign() > 0 {
+		estimatedEVMValue = estimatedEVMValue.SetInt64(21000)
+	}
+	createdTx := etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), estimatedEVMValue, c.cfg.BlockScanner.MaxGasLimit, gasRate, txData)
+	estimatedGas, err := c.evmScanner.ethRpc.EstimateGas(fromAddr.String(), createdTx)
+	if err != nil {
+		c.logger.Err(err).Msg("fail to estimate gas of batch tx")
+		return nil, nil
+	}
+
+	scheduledMaxFee := big.NewInt(0)
+	for _, item := range txs {
+		itemMaxFee := big.NewInt(0)
+		for _, coin := range item.MaxGas {
+			itemMaxFee.Add(itemMaxFee, convertSwitchlyProtocolAmountToWei(coin.Amount.BigInt()))
+		}
+		scheduledMaxFee.Add(scheduledMaxFee, itemMaxFee)
+	}
+
+	// L2 chains require a small amount of gas asset left for the L1 fee
+	if c.cfg.EVM.ExtraL1GasFee > 0 {
+		l1Fee := big.NewInt(c.cfg.EVM.ExtraL1GasFee)
+		scheduledMaxFee = scheduledMaxFee.Sub(scheduledMaxFee, convertSwitchlyProtocolAmountToWei(l1Fee))
+	}
+
+	maxGasUnits := new(big.Int).Div(scheduledMaxFee, gasRate).Uint64()
+	if estimatedGas > maxGasUnits {
+		c.logger.Warn().
+			Int("outbounds", len(txs)).
+			Stringer("rate", gasRate).
+			Uint64("estimated_gas_units", estimatedGas).
+			Uint64("max_gas_units", maxGasUnits).
+			Msg("max gas of batch exceeded, aborting to let switchlynode reschedule")
+		return nil, nil
+	}
+
+	// before signing, confirm the vault has enough gas asset
+	estimatedFee := big.NewInt(int64(estimatedGas))
+	estimatedFee.Mul(estimatedFee, gasRate)
+	gasBalance, err := c.GetBalance(fromAddr.String(), evm.NativeTokenAddr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get gas asset balance: %w", err)
+	}
+	if gasBalance.Cmp(big.NewInt(0).Add(evmValue, estimatedFee)) < 0 {
+		return nil, fmt.Errorf("insufficient gas asset balance: %s < %s + %s", gasBalance.String(), evmValue.String(), estimatedFee.String())
+	}
+
+	return etypes.NewTransaction(
+		nonce, ecommon.HexToAddress(contractAddr.String()), evmValue, maxGasUnits, gasRate, txData,
+	), nil
+}
+
+// BroadcastBatchTx broadcasts the call signed by SignBatchTx and marks every outbound
+// of the batch as signed.
+func (c *EVMClient) BroadcastBatchTx(txs []stypes.TxOutItem, payload []byte) (string, error) {
+	if len(txs) == 0 {
+		return "", errors.New("no outbounds to broadcast")
+	}
+	txID, err := c.BroadcastTx(txs[0], payload)
+	if err != nil {
+		return "", err
+	}
+	for _, txOut := range txs[1:] {
+		if err = c.signerCacheManager.SetSigned(txOut.CacheHash(), txOut.CacheVault(c.GetChain()), txID); err != nil {
+			c.logger.Err(err).Interface("txOutItem", txOut).Msg("fail to mark tx out item as signed")
+		}
+	}
+	return txID, nil
+}
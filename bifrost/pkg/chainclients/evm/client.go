@@ -37,7 +37,6 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
-	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/aggregators"
 	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
 )
 
@@ -64,6 +63,8 @@ type EVMClient struct {
 	globalSolvencyQueue     chan stypes.Solvency
 	signerCacheManager      *signercache.CacheManager
 	lastSolvencyCheckHeight int64
+	batchRouters            map[string]bool
+	batchRoutersLock        sync.Mutex
 }
 
 // NewEVMClient creates a new EVMClient.
@@ -204,6 +205,7 @@ func NewEVMClient(
 		tssKeySigner: tssKm,
 		wg:           &sync.WaitGroup{},
 		stopchan:     make(chan struct{}),
+		batchRouters: make(map[string]bool),
 	}
 
 	// initialize storage
@@ -270,7 +272,7 @@ func (c *EVMClient) Start(
 	c.evmScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.tssKeySigner.Start()
-	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
+	c.blockScanner.Start(globalTxsQueue, globalErrataQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
 	go c.unstuck()
 	c.wg.Add(1)
@@ -558,38 +560,7 @@ func (c *EVMClient) buildOutboundTx(txOutItem stypes.TxOutItem, memo mem.Memo, n
 		evmValue = cosmos.ZeroUint().BigInt()
 	}
 
-	gasRate := c.GetGasPrice()
-	if c.cfg.BlockScanner.FixedGasRate > 0 || gasRate.Cmp(big.NewInt(0)) == 0 {
-		// if chain gas is zero we are still filling our gas price buffer, use outbound rate
-		gasRate = convertSwitchlyProtocolAmountToWei(big.NewInt(txOutItem.GasRate))
-	} else {
-		// Switchlynode uses a gas rate 1.5x the reported network fee for the rate and computed
-		// max gas to ensure the rate is sufficient when it is signed later. Since we now know
-		// the more recent rate, we will use our current rate with a lower bound on 2/3 the
-		// outbound rate (the original rate we reported to Switchlynode in the network fee).
-		lowerBound := convertSwitchlyProtocolAmountToWei(big.NewInt(txOutItem.GasRate))
-		lowerBound.Mul(lowerBound, big.NewInt(2))
-		lowerBound.Div(lowerBound, big.NewInt(3))
-
-		// round current rate to avoid consensus trouble, same rounding implied in outbound
-		gasRate.Div(gasRate, big.NewInt(common.One*100))
-		if gasRate.Cmp(big.NewInt(0)) == 0 { // floor at 1 like in network fee reporting
-			gasRate = big.NewInt(1)
-		}
-		gasRate.Mul(gasRate, big.NewInt(common.One*100))
-
-		// if the gas rate is less than the lower bound, use the lower bound
-		if gasRate.Cmp(lowerBound) < 0 {
-			gasRate = lowerBound
-		}
-	}
-
-	c.logger.Info().
-		Stringer("inHash", txOutItem.InHash).
-		Str("outboundRate", convertSwitchlyProtocolAmountToWei(big.NewInt(txOutItem.GasRate)).String()).
-		Str("currentRate", c.GetGasPrice().String()).
-		Str("effectiveRate", gasRate.String()).
-		Msg("gas rate")
+	gasRate := c.outboundGasRate(txOutItem)
 
 	// outbound tx always send to smart contract address
 	estimatedEVMValue := big.NewInt(0)
@@ -619,9 +590,7 @@ func (c *EVMClient) buildOutboundTx(txOutItem stypes.TxOutItem, memo mem.Memo, n
 
 	if txOutItem.Aggregator != "" {
 		var gasLimitForAggregator uint64
-		gasLimitForAggregator, err = aggregators.FetchDexAggregatorGasLimit(
-			c.cfg.ChainID, txOutItem.Aggregator,
-		)
+		gasLimitForAggregator, err = c.evmScanner.dexAggregators.GasLimit(txOutItem.Aggregator)
 		if err != nil {
 			c.logger.Err(err).
 				Str("aggregator", txOutItem.Aggregator).
@@ -686,6 +655,44 @@ func (c *EVMClient) buildOutboundTx(txOutItem stypes.TxOutItem, memo mem.Memo, n
 	return createdTx, nil
 }
 
+// outboundGasRate returns the gas rate to sign the outbound with, the current rate
+// bounded by the rate switchlynode scheduled the outbound with.
+func (c *EVMClient) outboundGasRate(txOutItem stypes.TxOutItem) *big.Int {
+	gasRate := c.GetGasPrice()
+	if c.cfg.BlockScanner.FixedGasRate > 0 || gasRate.Cmp(big.NewInt(0)) == 0 {
+		// if chain gas is zero we are still filling our gas price buffer, use outbound rate
+		gasRate = convertSwitchlyProtocolAmountToWei(big.NewInt(txOutItem.GasRate))
+	} else {
+		// Switchlynode uses a gas rate 1.5x the reported network fee for the rate and computed
+		// max gas to ensure the rate is sufficient when it is signed later. Since we now know
+		// the more recent rate, we will use our current rate with a lower bound on 2/3 the
+		// outbound rate (the original rate we reported to Switchlynode in the network fee).
+		lowerBound := convertSwitchlyProtocolAmountToWei(big.NewInt(txOutItem.GasRate))
+		lowerBound.Mul(lowerBound, big.NewInt(2))
+		lowerBound.Div(lowerBound, big.NewInt(3))
+
+		// round current rate to avoid consensus trouble, same rounding implied in outbound
+		gasRate.Div(gasRate, big.NewInt(common.One*100))
+		if gasRate.Cmp(big.NewInt(0)) == 0 { // floor at 1 like in network fee reporting
+			gasRate = big.NewInt(1)
+		}
+		gasRate.Mul(gasRate, big.NewInt(common.One*100))
+
+		// if the gas rate is less than the lower bound, use the lower bound
+		if gasRate.Cmp(lowerBound) < 0 {
+			gasRate = lowerBound
+		}
+	}
+
+	c.logger.Info().
+		Stringer("inHash", txOutItem.InHash).
+		Str("outboundRate", convertSwitchlyProtocolAmountToWei(big.NewInt(txOutItem.GasRate)).String()).
+		Str("currentRate", c.GetGasPrice().String()).
+		Str("effectiveRate", gasRate.String()).
+		Msg("gas rate")
+	return gasRate
+}
+
 // --------------------------------- sign ---------------------------------
 
 // SignTx returns the signed transaction.
@@ -719,38 +726,13 @@ func (c *EVMClient) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, *
 		return nil, nil, nil, fmt.Errorf("can't sign tx when it doesn't have memo")
 	}
 
-	// the nonce is stored as the transaction checkpoint, if it is set deserialize it
-	// so we only retry with the same nonce to avoid double spend
-	var nonce uint64
-	var fromAddr common.Address
-	fromAddr, err = tx.VaultPubKey.GetAddress(c.cfg.ChainID)
-	if tx.Checkpoint != nil {
-		if err = json.Unmarshal(tx.Checkpoint, &nonce); err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to unmarshal checkpoint: %w", err)
-		}
-		c.logger.Warn().Stringer("in_hash", tx.InHash).Uint64("nonce", nonce).Msg("using checkpoint nonce")
-	} else {
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to get %s address for pub key(%s): %w", c.GetChain().String(), tx.VaultPubKey, err)
-		}
-		nonce, err = c.evmScanner.GetNonce(fromAddr.String())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to fetch account(%s) nonce: %w", fromAddr, err)
-		}
-
-		// abort signing if the pending nonce is too far in the future
-		var finalizedNonce uint64
-		finalizedNonce, err = c.evmScanner.GetNonceFinalized(fromAddr.String())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to fetch account(%s) finalized nonce: %w", fromAddr, err)
-		}
-		if (nonce - finalizedNonce) > c.cfg.MaxPendingNonces {
-			c.logger.Warn().
-				Uint64("nonce", nonce).
-				Uint64("finalizedNonce", finalizedNonce).
-				Msg("pending nonce too far in future")
-			return nil, nil, nil, fmt.Errorf("pending nonce too far in future")
-		}
+	fromAddr, err := tx.VaultPubKey.GetAddress(c.cfg.ChainID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to get %s address for pub key(%s): %w", c.GetChain().String(), tx.VaultPubKey, err)
+	}
+	nonce, err := c.outboundNonce(tx, fromAddr)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	// serialize nonce for later
@@ -814,6 +796,38 @@ func (c *EVMClient) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, *
 	return rawTx, nil, txIn, nil
 }
 
+// outboundNonce returns the nonce to sign the outbound with. The nonce is stored as the
+// transaction checkpoint, if it is set deserialize it so we only retry with the same
+// nonce to avoid double spend.
+func (c *EVMClient) outboundNonce(tx stypes.TxOutItem, fromAddr common.Address) (uint64, error) {
+	var nonce uint64
+	if tx.Checkpoint != nil {
+		if err := json.Unmarshal(tx.Checkpoint, &nonce); err != nil {
+			return 0, fmt.Errorf("fail to unmarshal checkpoint: %w", err)
+		}
+		c.logger.Warn().Stringer("in_hash", tx.InHash).Uint64("nonce", nonce).Msg("using checkpoint nonce")
+		return nonce, nil
+	}
+	nonce, err := c.evmScanner.GetNonce(fromAddr.String())
+	if err != nil {
+		return 0, fmt.Errorf("fail to fetch account(%s) nonce: %w", fromAddr, err)
+	}
+
+	// abort signing if the pending nonce is too far in the future
+	finalizedNonce, err := c.evmScanner.GetNonceFinalized(fromAddr.String())
+	if err != nil {
+		return 0, fmt.Errorf("fail to fetch account(%s) finalized nonce: %w", fromAddr, err)
+	}
+	if (nonce - finalizedNonce) > c.cfg.MaxPendingNonces {
+		c.logger.Warn().
+			Uint64("nonce", nonce).
+			Uint64("finalizedNonce", finalizedNonce).
+			Msg("pending nonce too far in future")
+		return 0, fmt.Errorf("pending nonce too far in future")
+	}
+	return nonce, nil
+}
+
 // sign is design to sign a given message with keysign party and keysign wrapper
 func (c *EVMClient) sign(tx *etypes.Transaction, poolPubKey common.PubKey, height int64, txOutItem stypes.TxOutItem) ([]byte, error) {
 	rawBytes, err := c.kw.Sign(tx, poolPubKey)
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	etypes "github.com/ethereum/go-ethereum/core/types"
 	. "gopkg.in/check.v1"
 
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/metrics"
@@ -106,6 +108,9 @@ func (s *EVMSuite) SetUpTest(c *C) {
 		case "/switchly/mimir/key/MaxUTXOsToSpend":
 			_, err := rw.Write([]byte(`-1`))
 			c.Assert(err, IsNil)
+		case "/switchly/mimir/key/MaxBatchOutputs-AVAX?height=1":
+			_, err := rw.Write([]byte(`3`))
+			c.Assert(err, IsNil)
 		default:
 			body, err := io.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -189,7 +194,11 @@ func (s *EVMSuite) SetUpTest(c *C) {
 				c.Assert(err, IsNil)
 			}
 			if rpcRequest.Method == "eth_call" {
-				if string(rpcRequest.Params) == `[{"data":"0x95d89b41","from":"0x0000000000000000000000000000000000000000","to":"0x333c3310824b7c685133F2BeDb2CA4b8b4DF633d"},"latest"]` {
+				if strings.Contains(string(rpcRequest.Params), `"to":"0x5f2be9a02b43f748ee460bf36eed24fafa109920"`) {
+					// a router before batchTransferOut reverts the probe
+					_, err = rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`))
+					c.Assert(err, IsNil)
+				} else if string(rpcRequest.Params) == `[{"data":"0x95d89b41","from":"0x0000000000000000000000000000000000000000","to":"0x333c3310824b7c685133F2BeDb2CA4b8b4DF633d"},"latest"]` {
 					_, err = rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x00000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000003544b4e0000000000000000000000000000000000000000000000000000000000"}`))
 					c.Assert(err, IsNil)
 				} else {
@@ -688,3 +697,103 @@ func (s *EVMSuite) TestSignEVMTx(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(obs.Sender, Equals, fromAddr.String())
 }
+
+func (s *EVMSuite) TestSignBatchTx(c *C) {
+	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	poolMgr := switchlyclient.NewPoolMgr(s.bridge)
+	chainConfig := config.BifrostChainConfiguration{
+		ChainID: common.AVAXChain,
+		RPCHost: "http://" + s.server.Listener.Addr().String(),
+		BlockScanner: config.BifrostBlockScannerConfiguration{
+			StartBlockHeight:   1, // avoids querying switchly for block height
+			HTTPRequestTimeout: time.Second,
+			MaxGasLimit:        80000,
+		},
+	}
+	chainConfig.EVM.TokenMaxGasMultiplier = 3
+	e, err := NewEVMClient(s.thorKeys, chainConfig, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	c.Assert(err, IsNil)
+	c.Assert(pubkeyMgr.Start(), IsNil)
+	defer func() { c.Assert(pubkeyMgr.Stop(), IsNil) }()
+	pubkeys := pubkeyMgr.GetPubKeys()
+	addr, err := pubkeys[len(pubkeys)-1].GetAddress(common.AVAXChain)
+	c.Assert(err, IsNil)
+	asset, err := common.NewAsset("AVAX.TKN-0X3B7FA4DD21C6F9BA3CA375217EAD7CAB9D6BF483")
+	c.Assert(err, IsNil)
+
+	maxOutputs, err := e.MaxBatchOutputs(1)
+	c.Assert(err, IsNil)
+	c.Assert(maxOutputs, Equals, int64(3))
+
+	newItem := func(coin common.Coin, memo string) stypes.TxOutItem {
+		return stypes.TxOutItem{
+			Chain:       common.AVAXChain,
+			ToAddress:   addr,
+			VaultPubKey: e.localPubKey,
+			Coins:       common.Coins{coin},
+			MaxGas: common.Gas{
+				common.NewCoin(common.AVAXAsset, cosmos.NewUint(e.cfg.BlockScanner.MaxGasLimit*4)),
+			},
+			GasRate: 1,
+			Memo:    memo,
+		}
+	}
+	outbound := newItem(common.NewCoin(common.AVAXAsset, cosmos.NewUint(common.One)), "OUT:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87")
+	refund := newItem(common.NewCoin(common.AVAXAsset, cosmos.NewUint(common.One)), "REFUND:9F6D6DE2D8E9C1E0F3E5AF4A7D2C0A27A2E2F8F4CB7E4B54C2DB6C9A6B9A8D01")
+	batchable := func(tx stypes.TxOutItem) bool {
+		ok, batchErr := e.IsBatchable(tx)
+		c.Assert(batchErr, IsNil)
+		return ok
+	}
+	c.Check(batchable(outbound), Equals, true)
+	c.Check(batchable(refund), Equals, true)
+
+	// a failing token transfer reverts the whole batch, token outbounds are paid alone
+	token := newItem(common.NewCoin(asset, cosmos.NewUint(common.One)), "OUT:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87")
+	c.Check(batchable(token), Equals, false)
+
+	// migrations, aggregator calls and outbounds without max gas are never batched
+	migrate := newItem(common.NewCoin(common.AVAXAsset, cosmos.NewUint(common.One)), "MIGRATE:1024")
+	c.Check(batchable(migrate), Equals, false)
+	aggregator := outbound
+	aggregator.Aggregator = "0x69800327b38A4CeF30367Dec3f64c2f2386f3848"
+	c.Check(batchable(aggregator), Equals, false)
+	noMaxGas := outbound
+	noMaxGas.MaxGas = nil
+	c.Check(batchable(noMaxGas), Equals, false)
+
+	// routers reverting the batchTransferOut probe don't batch
+	supported, err := e.routerSupportsBatch(common.Address("0x5f2be9a02b43f748ee460bf36eed24fafa109920"))
+	c.Assert(err, IsNil)
+	c.Check(supported, Equals, false)
+
+	// a batch needs two outbounds up to the max batch outputs
+	_, _, _, err = e.SignBatchTx([]stypes.TxOutItem{outbound}, 1)
+	c.Assert(err, NotNil)
+	_, _, _, err = e.SignBatchTx([]stypes.TxOutItem{outbound, refund, outbound, refund}, 1)
+	c.Assert(err, NotNil)
+	_, _, _, err = e.SignBatchTx([]stypes.TxOutItem{outbound, migrate}, 1)
+	c.Assert(err, NotNil)
+	_, _, _, err = e.SignBatchTx([]stypes.TxOutItem{outbound, token}, 1)
+	c.Assert(err, NotNil)
+
+	result, checkpoint, obs, err := e.SignBatchTx([]stypes.TxOutItem{outbound, refund}, 1)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	c.Assert(checkpoint, IsNil)
+	c.Assert(obs, IsNil)
+
+	// one call to the router paying both outbounds with their summed native value
+	signedTx := &etypes.Transaction{}
+	c.Assert(signedTx.UnmarshalJSON(result), IsNil)
+	c.Check(strings.EqualFold(signedTx.To().String(), "0x17aB05351fC94a1a67Bf3f56DdbB941aE6c63E25"), Equals, true)
+	c.Check(signedTx.Value().String(), Equals, "2000000000000000000")
+	method, err := e.vaultABI.MethodById(signedTx.Data())
+	c.Assert(err, IsNil)
+	c.Check(method.Name, Equals, "batchTransferOut")
+	args, err := method.Inputs.Unpack(signedTx.Data()[4:])
+	c.Assert(err, IsNil)
+	c.Assert(args, HasLen, 3)
+	c.Check(args[2], DeepEquals, []string{"BATCH:1", "BATCH:1:1"})
+}
@@ -33,10 +33,10 @@ import (
 	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/common/tokenlist"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
-	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/aggregators"
 	memo "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
 )
 
@@ -65,7 +65,7 @@ type EVMScanner struct {
 	gasCache              []*big.Int
 	solvencyReporter      SolvencyReporter
 	whitelistTokens       []tokenlist.ERC20Token
-	whitelistContracts    []common.Address
+	dexAggregators        *evm.DexAggregators
 	signerCacheManager    *signercache.CacheManager
 	tokenManager          *evm.TokenManager
 
@@ -162,14 +162,6 @@ func NewEVMScanner(cfg config.BifrostBlockScannerConfiguration,
 		return nil, err
 	}
 
-	// load whitelist contracts for the chain
-	whitelistContracts := []common.Address{}
-	for _, agg := range aggregators.DexAggregators() {
-		if agg.Chain.Equals(cfg.ChainID) {
-			whitelistContracts = append(whitelistContracts, common.Address(agg.Address))
-		}
-	}
-
 	return &EVMScanner{
 		cfg:                  cfg,
 		logger:               log.Logger.With().Stringer("chain", cfg.ChainID).Logger(),
@@ -190,7 +182,7 @@ func NewEVMScanner(cfg config.BifrostBlockScannerConfiguration,
 		gasCache:             make([]*big.Int, 0),
 		solvencyReporter:     solvencyReporter,
 		whitelistTokens:      whitelistTokens,
-		whitelistContracts:   whitelistContracts,
+		dexAggregators:       evm.NewDexAggregators(cfg.ChainID, bridge),
 		signerCacheManager:   signerCacheManager,
 		tokenManager:         tokenManager,
 	}, nil
@@ -401,16 +393,18 @@ func (e *EVMScanner) getTxInOptimized(method string, block *etypes.Block) (stype
 		if txInItem == nil {
 			continue
 		}
-		if len(txInItem.To) == 0 {
-			continue
-		}
-		if len([]byte(txInItem.Memo)) > constants.MaxMemoSize {
-			continue
-		}
+		for _, item := range e.splitBatchTxIn(receipt, txInItem) {
+			if len(item.To) == 0 {
+				continue
+			}
+			if len([]byte(item.Memo)) > constants.MaxMemoSize {
+				continue
+			}
 
-		// add the txInItem to the txInbound
-		txInItem.BlockHeight = block.Number().Int64()
-		txInbound.TxArray = append(txInbound.TxArray, txInItem)
+			// add the txInItem to the txInbound
+			item.BlockHeight = block.Number().Int64()
+			txInbound.TxArray = append(txInbound.TxArray, item)
+		}
 	}
 
 	if len(txInbound.TxArray) == 0 {
@@ -509,16 +503,18 @@ func (e *EVMScanner) getTxIn(block *etypes.Block) (stypes.TxIn, error) {
 			if txInItem == nil {
 				continue
 			}
-			if len(txInItem.To) == 0 {
-				continue
-			}
-			if len([]byte(txInItem.Memo)) > constants.MaxMemoSize {
-				continue
-			}
+			for _, item := range e.splitBatchTxIn(receipt, txInItem) {
+				if len(item.To) == 0 {
+					continue
+				}
+				if len([]byte(item.Memo)) > constants.MaxMemoSize {
+					continue
+				}
 
-			// add the txInItem to the txInbound
-			txInItem.BlockHeight = block.Number().Int64()
-			txInbound.TxArray = append(txInbound.TxArray, txInItem)
+				// add the txInItem to the txInbound
+				item.BlockHeight = block.Number().Int64()
+				txInbound.TxArray = append(txInbound.TxArray, item)
+			}
 		}
 	}
 
@@ -786,14 +782,17 @@ func (e *EVMScanner) reportNetworkFee(height int64) {
 	}
 
 	// gas price to 1e8 from 1e18
-	tcGasPrice := new(big.Int).Div(gasPrice, big.NewInt(1e10))
+	gasLimit, tcGasPrice := feemodel.Get(e.cfg.ChainID).NetworkFee(feemodel.Observation{
+		Size: e.cfg.MaxGasLimit,
+		Rate: gasPrice.Uint64(),
+	})
 
 	// post to switchly
 	e.globalNetworkFeeQueue <- common.NetworkFee{
 		Chain:           e.cfg.ChainID,
 		Height:          height,
-		TransactionSize: e.cfg.MaxGasLimit,
-		TransactionRate: tcGasPrice.Uint64(),
+		TransactionSize: gasLimit,
+		TransactionRate: tcGasPrice,
 	}
 
 	e.lastReportedGasPrice = gasPrice.Uint64()
@@ -859,7 +858,7 @@ func (e *EVMScanner) isToValidContractAddress(addr *ecommon.Address, includeWhit
 	// get the smart contract used by switchlynode
 	contractAddresses := e.pubkeyMgr.GetContracts(e.cfg.ChainID)
 	if includeWhiteList {
-		contractAddresses = append(contractAddresses, e.whitelistContracts...)
+		contractAddresses = append(contractAddresses, e.dexAggregators.Contracts()...)
 	}
 
 	// combine the whitelist smart contract address
@@ -886,16 +885,7 @@ func (e *EVMScanner) getTxInFromSmartContract(tx *etypes.Transaction, receipt *e
 		e.logger.Debug().Stringer("txid", tx.Hash()).Uint64("status", receipt.Status).Msg("tx failed")
 		return nil, nil
 	}
-	p := evm.NewSmartContractLogParser(e.isToValidContractAddress,
-		e.tokenManager.GetAssetFromTokenAddress,
-		e.tokenManager.GetTokenDecimalsForSwitchlyProtocol,
-		func(token string, amt *big.Int) cosmos.Uint {
-			return cosmos.NewUintFromBigInt(e.tokenManager.ConvertAmount(token, amt))
-		},
-		e.vaultABI,
-		e.cfg.ChainID.GetGasAsset(),
-		maxLogs,
-	)
+	p := e.newSmartContractLogParser(maxLogs)
 
 	// txInItem will be changed in p.getTxInItem function, so if the function return an
 	// error txInItem should be abandoned
@@ -941,6 +931,49 @@ func (e *EVMScanner) getTxInFromSmartContract(tx *etypes.Transaction, receipt *e
 	return txInItem, nil
 }
 
+func (e *EVMScanner) newSmartContractLogParser(maxLogs int64) evm.SmartContractLogParser {
+	return evm.NewSmartContractLogParser(e.isToValidContractAddress,
+		e.tokenManager.GetAssetFromTokenAddress,
+		e.tokenManager.GetTokenDecimalsForSwitchlyProtocol,
+		func(token string, amt *big.Int) cosmos.Uint {
+			return cosmos.NewUintFromBigInt(e.tokenManager.ConvertAmount(token, amt))
+		},
+		e.vaultABI,
+		e.cfg.ChainID.GetGasAsset(),
+		maxLogs,
+	)
+}
+
+// splitBatchTxIn returns the items to observe for the tx: one item per outbound of a
+// router batchTransferOut call, each with an even share of the gas, or else the item
+// of the tx itself.
+func (e *EVMScanner) splitBatchTxIn(receipt *etypes.Receipt, txInItem *stypes.TxInItem) []*stypes.TxInItem {
+	m, err := memo.ParseMemo(common.LatestVersion, txInItem.Memo)
+	if err != nil || !m.IsType(memo.TxBatchOutbound) || receipt.Status != 1 {
+		return []*stypes.TxInItem{txInItem}
+	}
+	p := e.newSmartContractLogParser(0)
+	items, err := p.GetBatchTxInItems(receipt.Logs, *txInItem)
+	if err != nil || len(items) == 0 {
+		e.logger.Err(err).Str("tx hash", txInItem.Tx).Msg("fail to split batch tx")
+		return []*stypes.TxInItem{txInItem}
+	}
+	count := cosmos.NewUint(uint64(len(items)))
+	for i, item := range items {
+		item.Gas = make(common.Gas, len(txInItem.Gas))
+		for j, coin := range txInItem.Gas {
+			share := coin.Amount.Quo(count)
+			if i == 0 {
+				// the first outbound pays the remainder
+				share = coin.Amount.Sub(share.Mul(count.SubUint64(1)))
+			}
+			item.Gas[j] = coin
+			item.Gas[j].Amount = share
+		}
+	}
+	return items
+}
+
 // getTxInFromFailedTransaction when a transaction failed due to out of gas, this method
 // will check whether the transaction is an outbound it fake a txInItem if the failed
 // transaction is an outbound , and report it back to switchlynode, thus the gas fee can be
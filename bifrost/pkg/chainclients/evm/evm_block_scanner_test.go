@@ -265,6 +265,9 @@ func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
 			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		case strings.HasPrefix(req.RequestURI, switchlyclient.LastBlockEndpoint):
 			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/lastblock/eth.json")
+		case strings.HasPrefix(req.RequestURI, switchlyclient.DexAggregatorsEndpoint):
+			_, err = rw.Write([]byte(`[{"chain":"AVAX","address":"0x40bcd4dB8889a8Bf0b1391d0c819dcd9627f9d0a","gas_limit":400000,"enabled":true,"version":""}]`))
+			c.Assert(err, IsNil)
 		case strings.HasPrefix(req.RequestURI, switchlyclient.AuthAccountEndpoint):
 			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/auth/accounts/template.json")
 		default:
@@ -317,7 +320,6 @@ func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
 	}, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
-	bs.whitelistContracts = append(bs.whitelistContracts, "0x40bcd4dB8889a8Bf0b1391d0c819dcd9627f9d0a")
 	txIn, err := bs.FetchTxs(int64(1), int64(1))
 	c.Assert(err, IsNil)
 	c.Check(len(txIn.TxArray), Equals, 1)
@@ -344,8 +346,16 @@ func httpTestHandler(c *C, rw http.ResponseWriter, fixture string) {
 }
 
 func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
+	whitelistAggregator := false
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		switch {
+		case strings.HasPrefix(req.RequestURI, switchlyclient.DexAggregatorsEndpoint):
+			aggs := `[]`
+			if whitelistAggregator {
+				aggs = `[{"chain":"AVAX","address":"0x17aB05351fC94a1a67Bf3f56DdbB941aE6c63E25","gas_limit":400000,"enabled":true,"version":""}]`
+			}
+			_, err := rw.Write([]byte(aggs))
+			c.Assert(err, IsNil)
 		case req.RequestURI == switchlyclient.ChainVersionEndpoint:
 			_, err := rw.Write([]byte(`{"current":"` + types.GetCurrentVersion().String() + `"}`))
 			c.Assert(err, IsNil)
@@ -522,12 +532,12 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 	c.Assert(txInItem.Coins[0].Amount.Uint64(), Equals, cosmos.NewUint(200000000).Uint64())
 
 	config.WhitelistTokens = append(config.WhitelistTokens, "0x333c3310824b7c685133F2BeDb2CA4b8b4DF633d")
+	// whitelist the aggregator for test
+	whitelistAggregator = true
 
 	bs, err = NewEVMScanner(config, storage, big.NewInt(43112), ethClient, rpcClient, bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
-	// whitelist the address for test
-	bs.whitelistContracts = append(bs.whitelistContracts, "0x17aB05351fC94a1a67Bf3f56DdbB941aE6c63E25")
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 
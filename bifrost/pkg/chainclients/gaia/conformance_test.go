@@ -0,0 +1,400 @@
+package gaia
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	ctypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	atypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/metrics"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/conformance"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/types"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	"github.com/switchlyprotocol/switchlynode/v3/cmd"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, newFakeCosmosNode(fakeCosmosZone{
+		chain:   common.GAIAChain,
+		chainID: "localgaia",
+		denom:   "uatom",
+		symbol:  "ATOM",
+	}))
+}
+
+func TestConformanceOsmosis(t *testing.T) {
+	conformance.Run(t, newFakeCosmosNode(fakeCosmosZone{
+		chain:   common.OSMOChain,
+		chainID: "localosmosis",
+		denom:   "uosmo",
+		symbol:  "OSMO",
+	}))
+}
+
+// fakeCosmosZone is the zone a fake Cosmos node runs, its gas asset is paid in denom
+type fakeCosmosZone struct {
+	chain   common.Chain
+	chainID string
+	denom   string
+	symbol  string
+}
+
+// fakeCosmosNode is a Cosmos node serving the RPC and gRPC calls of the client from
+// memory. Every tx succeeds, and the balances are not rolled back on reorgs.
+type fakeCosmosNode struct {
+	lock          sync.Mutex
+	zone          fakeCosmosZone
+	keys          *switchlyclient.Keys
+	vault         common.PubKey
+	user          common.Address
+	scanCfg       config.BifrostBlockScannerConfiguration
+	txConfig      client.TxConfig
+	blocks        []*tmtypes.Block
+	mempool       []tmtypes.Tx
+	balances      map[string]ctypes.Coins
+	accounts      map[string]*atypes.BaseAccount
+	forks         int
+	failBroadcast bool
+}
+
+var _ conformance.Node = &fakeCosmosNode{}
+
+func newFakeCosmosNode(zone fakeCosmosZone) func(t *testing.T) conformance.Node {
+	return func(t *testing.T) conformance.Node {
+		return zone.newNode(t)
+	}
+}
+
+func (zone fakeCosmosZone) newNode(t *testing.T) conformance.Node {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	btypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	kb := cKeys.NewInMemory(cdc)
+	_, _, err := kb.NewMnemonic("bob", cKeys.English, cmd.SwitchlyHDPath, "password", hd.Secp256k1)
+	require.NoError(t, err)
+	keys := switchlyclient.NewKeysWithKeybase(kb, "bob", "password")
+	priv, err := keys.GetPrivateKey()
+	require.NoError(t, err)
+	pk, err := cryptocodec.ToCmtPubKeyInterface(priv.PubKey())
+	require.NoError(t, err)
+	vault, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+
+	prefix := zone.chain.AddressPrefix(common.CurrentChainNetwork)
+	user, err := common.NewAddress(ctypes.MustBech32ifyAddressBytes(prefix, secp256k1.GenPrivKey().PubKey().Address()))
+	require.NoError(t, err)
+
+	n := &fakeCosmosNode{
+		zone:  zone,
+		keys:  keys,
+		vault: vault,
+		user:  user,
+		scanCfg: config.BifrostBlockScannerConfiguration{
+			ChainID:                      zone.chain,
+			BlockHeightDiscoverBackoff:   50 * time.Millisecond,
+			ObservationFlexibilityBlocks: 10,
+			MaxReorgDepth:                10,
+			GasPriceResolution:           100,
+			WhitelistCosmosAssets: []config.WhitelistCosmosAsset{
+				{Denom: zone.denom, Decimals: 6, SwitchlySymbol: zone.symbol},
+			},
+		},
+		txConfig: authtx.NewTxConfig(cdc, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT}),
+		balances: make(map[string]ctypes.Coins),
+		accounts: make(map[string]*atypes.BaseAccount),
+	}
+	vaultAddr, err := vault.GetAddress(zone.chain)
+	require.NoError(t, err)
+	n.balances[vaultAddr.String()] = ctypes.NewCoins(ctypes.NewInt64Coin(zone.denom, 100_000_000))
+	n.balances[user.String()] = ctypes.NewCoins(ctypes.NewInt64Coin(zone.denom, 1_000_000_000))
+	n.blocks = append(n.blocks, n.newBlock(1, nil))
+	return n
+}
+
+func (n *fakeCosmosNode) Capabilities() conformance.Capabilities {
+	return conformance.Capabilities{Reorg: true}
+}
+
+func (n *fakeCosmosNode) Client(bridge switchlyclient.SwitchlyBridge) (types.ChainClient, error) {
+	n.lock.Lock()
+	scanCfg := n.scanCfg
+	// the scanner lags the tip by one block
+	scanCfg.StartBlockHeight = int64(len(n.blocks))
+	n.lock.Unlock()
+
+	if m == nil {
+		var err error
+		m, err = metrics.NewMetrics(config.BifrostMetricsConfiguration{
+			Chains: common.Chains{common.GAIAChain, common.OSMOChain},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chainCfg := config.BifrostChainConfiguration{
+		ChainID:        n.zone.chain,
+		RPCHost:        "http://localhost:26657",
+		CosmosGRPCHost: "localhost:9090",
+		BlockScanner:   scanCfg,
+	}
+	chainCfg.Cosmos.ChainID = n.zone.chainID
+	chainCfg.Cosmos.FeeDenom = n.zone.denom
+	c, err := NewCosmosClient(n.keys, chainCfg, nil, bridge, m)
+	if err != nil {
+		return nil, err
+	}
+	c.txClient = &fakeTxServiceClient{node: n}
+	c.bankClient = &fakeBankQueryClient{node: n}
+	c.accountClient = &fakeAuthQueryClient{node: n}
+	c.cosmosScanner.rpc = n
+	// a network fee has been reported, so the client reports solvency
+	c.cosmosScanner.lastFee = sdkmath.NewUint(5000)
+	return c, nil
+}
+
+func (n *fakeCosmosNode) Vault() common.PubKey {
+	return n.vault
+}
+
+func (n *fakeCosmosNode) User() common.Address {
+	return n.user
+}
+
+func (n *fakeCosmosNode) Send(to common.Address, coins common.Coins, memo string) (string, error) {
+	scanner := &CosmosBlockScanner{cfg: n.scanCfg}
+	amount := ctypes.Coins{}
+	for _, coin := range coins {
+		cCoin, err := scanner.fromSwitchlyToCosmos(coin)
+		if err != nil {
+			return "", err
+		}
+		amount = amount.Add(cCoin)
+	}
+
+	builder := n.txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(&btypes.MsgSend{
+		FromAddress: n.user.String(),
+		ToAddress:   to.String(),
+		Amount:      amount,
+	}); err != nil {
+		return "", err
+	}
+	builder.SetMemo(memo)
+	builder.SetFeeAmount(ctypes.NewCoins(ctypes.NewInt64Coin(n.zone.denom, 5000)))
+	builder.SetGasLimit(GasLimit)
+	rawTx, err := n.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return "", err
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.mempool = append(n.mempool, rawTx)
+	return hex.EncodeToString(tmhash.Sum(rawTx)), nil
+}
+
+func (n *fakeCosmosNode) Mine() (int64, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for _, rawTx := range n.mempool {
+		tx, err := n.txConfig.TxDecoder()(rawTx)
+		if err != nil {
+			return 0, err
+		}
+		for _, msg := range tx.GetMsgs() {
+			send, ok := msg.(*btypes.MsgSend)
+			if !ok {
+				continue
+			}
+			if err = n.transfer(send.FromAddress, send.ToAddress, send.Amount); err != nil {
+				return 0, err
+			}
+			if feeTx, ok := tx.(ctypes.FeeTx); ok {
+				n.balances[send.FromAddress] = n.balances[send.FromAddress].Sub(feeTx.GetFee()...)
+			}
+			n.account(send.FromAddress).Sequence++
+		}
+	}
+
+	height := int64(len(n.blocks)) + 1
+	n.blocks = append(n.blocks, n.newBlock(height, n.mempool))
+	n.mempool = nil
+	return height, nil
+}
+
+func (n *fakeCosmosNode) Reorg(height int64) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	tip := int64(len(n.blocks))
+	if height <= 1 || height > tip {
+		return fmt.Errorf("can't reorg block %d of %d", height, tip)
+	}
+	n.forks++
+	n.blocks = n.blocks[:height-1]
+	for ; height <= tip; height++ {
+		n.blocks = append(n.blocks, n.newBlock(height, nil))
+	}
+	return nil
+}
+
+func (n *fakeCosmosNode) FailBroadcast() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.failBroadcast = true
+}
+
+// newBlock returns a block extending the tip, the fork count is part of the hash so the
+// blocks of a reorg replace the previous ones
+func (n *fakeCosmosNode) newBlock(height int64, txs []tmtypes.Tx) *tmtypes.Block {
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{
+			ChainID:        n.zone.chainID,
+			Height:         height,
+			Time:           time.Unix(1_700_000_000+height*6, 0).UTC(),
+			ValidatorsHash: tmhash.Sum([]byte("validators")),
+			AppHash:        tmhash.Sum([]byte(fmt.Sprintf("fork-%d", n.forks))),
+		},
+		Data:       tmtypes.Data{Txs: txs},
+		LastCommit: &tmtypes.Commit{Height: height - 1},
+	}
+	if len(n.blocks) > 0 {
+		block.LastBlockID = tmtypes.BlockID{Hash: n.blocks[len(n.blocks)-1].Hash()}
+	}
+	return block
+}
+
+func (n *fakeCosmosNode) transfer(from, to string, amount ctypes.Coins) error {
+	balance, negative := n.balances[from].SafeSub(amount...)
+	if negative {
+		return fmt.Errorf("insufficient balance of %s: %s < %s", from, n.balances[from], amount)
+	}
+	n.balances[from] = balance
+	n.balances[to] = n.balances[to].Add(amount...)
+	return nil
+}
+
+func (n *fakeCosmosNode) account(address string) *atypes.BaseAccount {
+	acct, ok := n.accounts[address]
+	if !ok {
+		acct = &atypes.BaseAccount{
+			Address:       address,
+			AccountNumber: uint64(len(n.accounts) + 1),
+		}
+		n.accounts[address] = acct
+	}
+	return acct
+}
+
+// Block implements TendermintRPC
+func (n *fakeCosmosNode) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	block := n.blocks[len(n.blocks)-1]
+	if height != nil {
+		if *height < 1 || *height > int64(len(n.blocks)) {
+			return nil, fmt.Errorf("height %d is not available", *height)
+		}
+		block = n.blocks[*height-1]
+	}
+	return &coretypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: block.Hash()},
+		Block:   block,
+	}, nil
+}
+
+// BlockResults implements TendermintRPC
+func (n *fakeCosmosNode) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	result, err := n.Block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	txResults := make([]*abci.ExecTxResult, len(result.Block.Txs))
+	for i := range txResults {
+		txResults[i] = &abci.ExecTxResult{Code: 0}
+	}
+	return &coretypes.ResultBlockResults{
+		Height:     result.Block.Height,
+		TxsResults: txResults,
+	}, nil
+}
+
+type fakeTxServiceClient struct {
+	txtypes.ServiceClient
+	node *fakeCosmosNode
+}
+
+func (c *fakeTxServiceClient) BroadcastTx(_ context.Context, in *txtypes.BroadcastTxRequest, _ ...grpc.CallOption) (*txtypes.BroadcastTxResponse, error) {
+	n := c.node
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.failBroadcast {
+		n.failBroadcast = false
+		return nil, errors.New("connection refused")
+	}
+	if _, err := n.txConfig.TxDecoder()(in.TxBytes); err != nil {
+		return nil, err
+	}
+	n.mempool = append(n.mempool, in.TxBytes)
+	return &txtypes.BroadcastTxResponse{
+		TxResponse: &ctypes.TxResponse{
+			TxHash: strings.ToUpper(hex.EncodeToString(tmhash.Sum(in.TxBytes))),
+		},
+	}, nil
+}
+
+type fakeBankQueryClient struct {
+	btypes.QueryClient
+	node *fakeCosmosNode
+}
+
+func (c *fakeBankQueryClient) AllBalances(_ context.Context, in *btypes.QueryAllBalancesRequest, _ ...grpc.CallOption) (*btypes.QueryAllBalancesResponse, error) {
+	c.node.lock.Lock()
+	defer c.node.lock.Unlock()
+	return &btypes.QueryAllBalancesResponse{Balances: c.node.balances[in.Address]}, nil
+}
+
+type fakeAuthQueryClient struct {
+	atypes.QueryClient
+	node *fakeCosmosNode
+}
+
+func (c *fakeAuthQueryClient) Account(_ context.Context, in *atypes.QueryAccountRequest, _ ...grpc.CallOption) (*atypes.QueryAccountResponse, error) {
+	c.node.lock.Lock()
+	defer c.node.lock.Unlock()
+	acct, err := codectypes.NewAnyWithValue(c.node.account(in.Address))
+	if err != nil {
+		return nil, err
+	}
+	return &atypes.QueryAccountResponse{Account: acct}, nil
+}
@@ -26,6 +26,10 @@ import (
 	govv1beta1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramsproptypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -40,6 +44,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 )
 
@@ -56,7 +61,8 @@ const (
 	// reciprocal of the gas price precision.
 	GasPriceFactor = uint64(1e9)
 
-	// GasLimit is the default gas limit we will use for all outbound transactions.
+	// GasLimit is the default gas limit of outbound transactions, used when the zone does
+	// not configure one.
 	GasLimit = 200000
 
 	// GasCacheTransactions is the number of transactions over which we compute an average
@@ -74,6 +80,7 @@ var (
 // CosmosBlockScanner is to scan the blocks
 type CosmosBlockScanner struct {
 	cfg                   config.BifrostBlockScannerConfiguration
+	zone                  CosmosZone
 	logger                zerolog.Logger
 	db                    blockscanner.ScannerStorage
 	cdc                   *codec.ProtoCodec
@@ -82,11 +89,12 @@ type CosmosBlockScanner struct {
 	bridge                switchlyclient.SwitchlyBridge
 	solvencyReporter      SolvencyReporter
 	globalNetworkFeeQueue chan common.NetworkFee
+	globalErrataQueue     chan types.ErrataBlock
 
 	// feeCache contains a rolling window of suggested gas fees which are computed as the
-	// gas price paid in each observed transaction multiplied by the default GasLimit.
-	// Fees are stored at 100x the values on the observed chain due to compensate for the
-	// difference in base chain decimals (switchly:1e8, gaia:1e6).
+	// gas price paid in each observed transaction multiplied by the gas limit of the zone.
+	// Fees are stored in SWITCHLYChain decimals (1e8) rather than the decimals of the fee
+	// denom (e.g. 1e6 for uatom).
 	feeCache []sdkmath.Uint
 	lastFee  sdkmath.Uint
 }
@@ -94,6 +102,7 @@ type CosmosBlockScanner struct {
 // NewCosmosBlockScanner create a new instance of BlockScan
 func NewCosmosBlockScanner(rpcHost string,
 	cfg config.BifrostBlockScannerConfiguration,
+	zone CosmosZone,
 	scanStorage blockscanner.ScannerStorage,
 	bridge switchlyclient.SwitchlyBridge,
 	m *metrics.Metrics,
@@ -125,6 +134,10 @@ func NewCosmosBlockScanner(rpcHost string,
 	paramsproptypes.RegisterInterfaces(registry)
 	upgradetypes.RegisterInterfaces(registry)
 	distribtypes.RegisterInterfaces(registry)
+	transfertypes.RegisterInterfaces(registry)
+	channeltypes.RegisterInterfaces(registry)
+	clienttypes.RegisterInterfaces(registry)
+	ibctm.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
 	// Registry for encoding txs
@@ -136,6 +149,7 @@ func NewCosmosBlockScanner(rpcHost string,
 
 	return &CosmosBlockScanner{
 		cfg:              cfg,
+		zone:             zone,
 		logger:           logger,
 		db:               scanStorage,
 		cdc:              cdc,
@@ -189,6 +203,15 @@ func (c *CosmosBlockScanner) GetBlock(height int64) (*tmtypes.Block, error) {
 	return resultBlock.Block, nil
 }
 
+// GetBlockHashes returns the hash and the parent hash of the block at the given height
+func (c *CosmosBlockScanner) GetBlockHashes(height int64) (hash, parentHash string, err error) {
+	block, err := c.GetBlock(height)
+	if err != nil {
+		return "", "", err
+	}
+	return block.Hash().String(), block.LastBlockID.Hash.String(), nil
+}
+
 func (c *CosmosBlockScanner) updateGasCache(tx ctypes.FeeTx) {
 	fees := tx.GetFee()
 
@@ -197,7 +220,7 @@ func (c *CosmosBlockScanner) updateGasCache(tx ctypes.FeeTx) {
 		return
 	}
 
-	// only consider transactions with fee paid in uatom
+	// only consider transactions with fee paid in the fee denom
 	coin, err := c.fromCosmosToSwitchly(fees[0])
 	if err != nil || !coin.Asset.Equals(c.cfg.ChainID.GetGasAsset()) {
 		return
@@ -219,7 +242,7 @@ func (c *CosmosBlockScanner) updateGasCache(tx ctypes.FeeTx) {
 	// add the fee to our cache
 	amount := coin.Amount.Mul(sdkmath.NewUint(GasPriceFactor)) // multiply to handle price < 1
 	price := amount.Quo(sdkmath.NewUint(tx.GetGas()))          // divide by gas to get the price
-	fee := price.Mul(sdkmath.NewUint(GasLimit))                // tx fee for default gas limit
+	fee := price.Mul(sdkmath.NewUint(c.zone.GasLimit))         // tx fee for the zone gas limit
 	fee = fee.Quo(sdkmath.NewUint(GasPriceFactor))             // unroll the multiple
 	c.feeCache = append(c.feeCache, fee)
 
@@ -242,57 +265,107 @@ func (c *CosmosBlockScanner) averageFee() sdkmath.Uint {
 	}
 	mean := sum.Quo(sdkmath.NewUint(uint64(len(c.feeCache))))
 
-	// round the price up to avoid fee noise
+	// never suggest less than the minimum the zone accepts
+	return c.roundFee(sdkmath.MaxUint(mean, c.minFee()))
+}
+
+// minFee returns the fee of the zone gas limit at the zone minimum gas price.
+func (c *CosmosBlockScanner) minFee() sdkmath.Uint {
+	if c.zone.MinGasPrice.IsNil() || !c.zone.MinGasPrice.IsPositive() {
+		return sdkmath.NewUint(0)
+	}
+	amount := c.zone.MinGasPrice.MulInt64(int64(c.zone.GasLimit)).Ceil().TruncateInt()
+	coin, err := c.fromCosmosToSwitchly(cosmos.NewCoin(c.zone.FeeDenom, amount))
+	if err != nil {
+		c.logger.Err(err).Str("denom", c.zone.FeeDenom).Msg("unable to convert min fee")
+		return sdkmath.NewUint(0)
+	}
+	return coin.Amount
+}
+
+// roundFee rounds the fee up to the gas price resolution to avoid fee noise.
+func (c *CosmosBlockScanner) roundFee(fee sdkmath.Uint) sdkmath.Uint {
 	resolution := sdkmath.NewUint(uint64(c.cfg.GasPriceResolution))
-	if mean.LTE(resolution) {
+	if fee.LTE(resolution) {
 		return resolution
 	}
-	mean = mean.Sub(sdkmath.NewUint(1))
-	mean = mean.Quo(resolution)
-	mean = mean.Add(sdkmath.NewUint(1))
-	mean = mean.Mul(resolution)
+	fee = fee.Sub(sdkmath.NewUint(1))
+	fee = fee.Quo(resolution)
+	fee = fee.Add(sdkmath.NewUint(1))
+	fee = fee.Mul(resolution)
 
-	return mean
+	return fee
 }
 
 func (c *CosmosBlockScanner) updateGasFees(height int64) error {
-	// post the gas fee over every cache period when we have a full gas cache
-	if height%GasUpdatePeriodBlocks == 0 && len(c.feeCache) == GasCacheTransactions {
-		gasFee := c.averageFee()
-
-		// sanity check the fee is not zero
-		if gasFee.IsZero() {
-			return errors.New("suggested gas fee was zero")
-		}
+	// post the gas fee over every cache period
+	if height%GasUpdatePeriodBlocks != 0 {
+		return nil
+	}
 
-		// skip fee if less than 1 resolution away from the last
-		feeDelta := sdkmath.MaxUint(c.lastFee, gasFee).Sub(sdkmath.MinUint(c.lastFee, gasFee))
-		if feeDelta.LTE(sdkmath.NewUint(uint64(c.cfg.GasPriceResolution))) {
+	var gasFee sdkmath.Uint
+	switch c.zone.GasModel {
+	case GasModelFixed:
+		gasFee = c.roundFee(c.minFee())
+	default:
+		// the average is only posted when we have a full gas cache
+		if len(c.feeCache) != GasCacheTransactions {
 			return nil
 		}
+		gasFee = c.averageFee()
+	}
 
-		// NOTE: We post the fee to the network instead of the transaction rate, and set the
-		// transaction size 1 to ensure the MaxGas in the generated TxOut contains the
-		// correct fee. We cannot pass the proper size and rate without a deeper change to
-		// Switchlynode, as the rate on Cosmos chains is less than 1 and cannot be represented
-		// by the uint.
-		c.globalNetworkFeeQueue <- common.NetworkFee{
-			Chain:           c.cfg.ChainID,
-			Height:          height,
-			TransactionSize: 1,
-			TransactionRate: gasFee.Uint64(),
-		}
-		c.lastFee = gasFee
-		c.logger.Info().
-			Uint64("fee", gasFee.Uint64()).
-			Int64("height", height).
-			Msg("sent network fee to SWITCHLYChain")
+	// sanity check the fee is not zero
+	if gasFee.IsZero() {
+		return errors.New("suggested gas fee was zero")
+	}
+
+	// skip fee if less than 1 resolution away from the last
+	feeDelta := sdkmath.MaxUint(c.lastFee, gasFee).Sub(sdkmath.MinUint(c.lastFee, gasFee))
+	if feeDelta.LTE(sdkmath.NewUint(uint64(c.cfg.GasPriceResolution))) {
+		return nil
 	}
 
+	// the flat fee model posts the fee per transaction as the rate of a transaction of size 1
+	txSize, txRate := feemodel.Get(c.cfg.ChainID).NetworkFee(feemodel.Observation{Rate: gasFee.Uint64()})
+	c.globalNetworkFeeQueue <- common.NetworkFee{
+		Chain:           c.cfg.ChainID,
+		Height:          height,
+		TransactionSize: txSize,
+		TransactionRate: txRate,
+	}
+	c.lastFee = gasFee
+	c.logger.Info().
+		Uint64("fee", gasFee.Uint64()).
+		Int64("height", height).
+		Msg("sent network fee to SWITCHLYChain")
+
 	return nil
 }
 
-func (c *CosmosBlockScanner) processTxs(height int64, rawTxs []tmtypes.Tx) ([]*types.TxInItem, error) {
+// gasFees converts the fees of a transaction to SWITCHLYChain coins (taking into account
+// gas asset decimal precision).
+func (c *CosmosBlockScanner) gasFees(fees ctypes.Coins) common.Gas {
+	gasFees := common.Gas{}
+	for _, fee := range fees {
+		cCoin, err := c.fromCosmosToSwitchly(fee)
+		if err != nil {
+			c.logger.Debug().Err(err).Interface("fees", fees).Msg("unable to convert coin, not whitelisted. skipping...")
+			continue
+		}
+		gasFees = append(gasFees, cCoin)
+	}
+	// SWITCHLYChain only supports gas paid in the gas asset, if gas is paid in another
+	// asset then fake gas as 1 unit of the gas asset, the fee is not used but cannot be empty
+	if gasFees.IsEmpty() {
+		gasFees = append(gasFees, common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(1)))
+	}
+	return gasFees
+}
+
+// processTxs returns the observed transactions of the block, and the errata of outbounds
+// returned to the vault by IBC.
+func (c *CosmosBlockScanner) processTxs(height int64, rawTxs []tmtypes.Tx) ([]*types.TxInItem, []types.ErrataTx, error) {
 	// Proto types for Cosmos chains that we are transacting with may not be included in this repo.
 	// Therefore, it is necessary to include them in the "proto" directory and register them in
 	// the cdc (codec) that is passed below. Registry occurs in the NewCosmosBlockScanner function.
@@ -303,10 +376,11 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs []tmtypes.Tx) ([]*t
 	defer cancel()
 	blockResults, err := c.rpc.BlockResults(ctx, &height)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get BlockResults: %w", err)
+		return nil, nil, fmt.Errorf("unable to get BlockResults: %w", err)
 	}
 
 	var txIn []*types.TxInItem
+	var errata []types.ErrataTx
 	for i, rawTx := range rawTxs {
 		hash := hex.EncodeToString(tmhash.Sum(rawTx))
 		var tx ctypes.Tx
@@ -330,6 +404,13 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs []tmtypes.Tx) ([]*t
 		memo := mem.GetMemo()
 		c.updateGasCache(feeTx)
 
+		// IBC transfers, receives and refunds of successful transactions
+		if blockResults.TxsResults[i].Code == 0 {
+			ibcTxIn, ibcErrata := c.processIBCMsgs(hash, height, memo, fees, tx.GetMsgs(), blockResults.TxsResults[i])
+			txIn = append(txIn, ibcTxIn...)
+			errata = append(errata, ibcErrata...)
+		}
+
 		for _, msg := range tx.GetMsgs() {
 			if msg, isMsgSend := msg.(*banktypes.MsgSend); isMsgSend {
 				// Transaction contains a relevant MsgSend, check if the transaction was successful...
@@ -355,22 +436,6 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs []tmtypes.Tx) ([]*t
 					continue
 				}
 
-				// Convert cosmos gas to switchly coins (taking into account gas asset decimal precision)
-				gasFees := common.Gas{}
-				for _, fee := range fees {
-					var cCoin common.Coin
-					cCoin, err = c.fromCosmosToSwitchly(fee)
-					if err != nil {
-						c.logger.Debug().Err(err).Interface("fees", fees).Msg("unable to convert coin, not whitelisted. skipping...")
-						continue
-					}
-					gasFees = append(gasFees, cCoin)
-				}
-				// SWITCHLYChain only supports gas paid in ATOM, if gas is paid in another asset
-				// then fake gas as `0.000001 ATOM`, the fee is not used but cannot be empty
-				if gasFees.IsEmpty() {
-					gasFees = append(gasFees, common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(1)))
-				}
 				txIn = append(txIn, &types.TxInItem{
 					Tx:          hash,
 					BlockHeight: height,
@@ -378,7 +443,7 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs []tmtypes.Tx) ([]*t
 					Sender:      msg.FromAddress,
 					To:          msg.ToAddress,
 					Coins:       coins,
-					Gas:         gasFees,
+					Gas:         c.gasFees(fees),
 				})
 
 				// If there are more than one TxIn item per transaction hash,
@@ -390,7 +455,7 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs []tmtypes.Tx) ([]*t
 
 	}
 
-	return txIn, nil
+	return txIn, errata, nil
 }
 
 func (c *CosmosBlockScanner) FetchTxs(height, chainHeight int64) (types.TxIn, error) {
@@ -399,11 +464,19 @@ func (c *CosmosBlockScanner) FetchTxs(height, chainHeight int64) (types.TxIn, er
 		return types.TxIn{}, err
 	}
 
-	txs, err := c.processTxs(height, block.Data.Txs)
+	txs, errata, err := c.processTxs(height, block.Data.Txs)
 	if err != nil {
 		return types.TxIn{}, err
 	}
 
+	// outbounds returned to the vault are reverted on SWITCHLYChain
+	if len(errata) > 0 && c.globalErrataQueue != nil {
+		c.globalErrataQueue <- types.ErrataBlock{
+			Height: height,
+			Txs:    errata,
+		}
+	}
+
 	txIn := types.TxIn{
 		Chain:    c.cfg.ChainID,
 		TxArray:  txs,
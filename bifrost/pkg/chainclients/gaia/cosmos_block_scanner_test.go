@@ -107,7 +107,10 @@ func (s *BlockScannerTestSuite) TestCalculateAverageGasFees(c *C) {
 			{Denom: "uatom", Decimals: 6, SwitchlySymbol: "ATOM"},
 		},
 	}
-	blockScanner := CosmosBlockScanner{cfg: cfg}
+	blockScanner := CosmosBlockScanner{
+		cfg:  cfg,
+		zone: CosmosZone{Chain: common.GAIAChain, FeeDenom: "uatom", GasLimit: GasLimit, GasModel: GasModelAverage},
+	}
 
 	atomToSwitchly := int64(100)
 
@@ -216,9 +219,50 @@ func (s *BlockScannerTestSuite) TestProcessTxs(c *C) {
 	block, err := blockScanner.GetBlock(1)
 	c.Assert(err, IsNil)
 
-	txInItems, err := blockScanner.processTxs(1, block.Data.Txs)
+	txInItems, errata, err := blockScanner.processTxs(1, block.Data.Txs)
 	c.Assert(err, IsNil)
+	c.Assert(errata, HasLen, 0)
 
 	// proccessTxs should filter out everything besides the valid MsgSend
 	c.Assert(len(txInItems), Equals, 1)
 }
+
+func (s *BlockScannerTestSuite) TestUpdateGasFeesFixed(c *C) {
+	cfg := config.BifrostBlockScannerConfiguration{
+		ChainID:            common.NOBLEChain,
+		GasPriceResolution: 100_000,
+		WhitelistCosmosAssets: []config.WhitelistCosmosAsset{
+			{Denom: "uusdc", Decimals: 6, SwitchlySymbol: "USDC"},
+		},
+	}
+	blockScanner := CosmosBlockScanner{
+		cfg: cfg,
+		zone: CosmosZone{
+			Chain:       common.NOBLEChain,
+			FeeDenom:    "uusdc",
+			GasLimit:    GasLimit,
+			GasModel:    GasModelFixed,
+			MinGasPrice: sdkmath.LegacyNewDecWithPrec(1, 1),
+		},
+		lastFee:               sdkmath.NewUint(0),
+		globalNetworkFeeQueue: make(chan common.NetworkFee, 1),
+	}
+
+	// the fixed model does not wait for observed transactions
+	c.Assert(blockScanner.updateGasFees(9), IsNil)
+	c.Check(len(blockScanner.globalNetworkFeeQueue), Equals, 0)
+	c.Assert(blockScanner.updateGasFees(10), IsNil)
+	fee := <-blockScanner.globalNetworkFeeQueue
+	c.Check(fee.Chain, Equals, common.NOBLEChain)
+	c.Check(fee.TransactionSize, Equals, uint64(1))
+	// 0.1 uusdc * 200k gas = 0.02 USDC
+	c.Check(fee.TransactionRate, Equals, uint64(2_000_000))
+
+	// the average model is floored at the min gas price
+	blockScanner.zone.GasModel = GasModelAverage
+	blockScanner.updateGasCache(&MockFeeTx{
+		gas: GasLimit,
+		fee: ctypes.Coins{ctypes.NewCoin("uusdc", sdkmath.NewInt(1000))},
+	})
+	c.Check(blockScanner.averageFee().Uint64(), Equals, uint64(2_000_000))
+}
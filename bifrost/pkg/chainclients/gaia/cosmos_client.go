@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"os"
 	"sync"
 	"time"
 
@@ -17,6 +16,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	ctypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
@@ -25,6 +25,7 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	atypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	tssp "github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/tss"
@@ -57,7 +58,7 @@ var CosmosSuccessCodes = map[uint32]bool{
 type CosmosClient struct {
 	logger              zerolog.Logger
 	cfg                 config.BifrostChainConfiguration
-	chainID             string
+	zone                CosmosZone
 	txConfig            client.TxConfig
 	txClient            txtypes.ServiceClient
 	bankClient          btypes.QueryClient
@@ -75,7 +76,8 @@ type CosmosClient struct {
 	stopchan            chan struct{}
 }
 
-// NewCosmosClient creates a new instance of a Cosmos-based chain client
+// NewCosmosClient creates a new instance of a Cosmos-based chain client, the zone it
+// connects to is configured by the Cosmos section of the chain configuration
 func NewCosmosClient(
 	thorKeys *switchlyclient.Keys,
 	cfg config.BifrostChainConfiguration,
@@ -85,6 +87,11 @@ func NewCosmosClient(
 ) (*CosmosClient, error) {
 	logger := log.With().Str("module", cfg.ChainID.String()).Logger()
 
+	zone, err := NewCosmosZone(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("fail to configure cosmos zone: %w", err)
+	}
+
 	tssKm, err := tss.NewKeySign(server, switchlyBridge)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create tss signer: %w", err)
@@ -119,22 +126,12 @@ func NewCosmosClient(
 	}
 
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
-	interfaceRegistry.RegisterImplementations((*ctypes.Msg)(nil), &btypes.MsgSend{})
+	interfaceRegistry.RegisterImplementations((*ctypes.Msg)(nil), &btypes.MsgSend{}, &transfertypes.MsgTransfer{})
 	marshaler := codec.NewProtoCodec(interfaceRegistry)
 	txConfig := authtx.NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT})
 
-	// CHANGEME: each SWITCHLYNode network (e.g. mainnet, mocknet, etc.) may connect to a Cosmos chain with a different chain ID
-	// Implement the logic here for determinine which chain ID to use.
-	chainID := ""
-	switch os.Getenv("NET") {
-	case "mainnet", "stagenet":
-		chainID = "cosmoshub-4"
-	case "mocknet":
-		chainID = "localgaia"
-	}
-
 	c := &CosmosClient{
-		chainID:         chainID,
+		zone:            zone,
 		logger:          logger,
 		cfg:             cfg,
 		txConfig:        txConfig,
@@ -161,6 +158,7 @@ func NewCosmosClient(
 	c.cosmosScanner, err = NewCosmosBlockScanner(
 		c.cfg.RPCHost,
 		c.cfg.BlockScanner,
+		c.zone,
 		c.storage,
 		c.switchlyBridge,
 		m,
@@ -193,8 +191,9 @@ func (c *CosmosClient) Start(
 ) {
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.cosmosScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
+	c.cosmosScanner.globalErrataQueue = globalErrataQueue
 	c.tssKeyManager.Start()
-	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
+	c.blockScanner.Start(globalTxsQueue, globalErrataQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
 	go runners.SolvencyCheckRunner(c.GetChain(), c, c.switchlyBridge, c.stopchan, c.wg, constants.SwitchlyBlockTime)
 }
@@ -310,7 +309,9 @@ func (c *CosmosClient) GetAccountByAddress(address string, height *big.Int) (com
 	}, nil
 }
 
-func (c *CosmosClient) processOutboundTx(tx stypes.TxOutItem, switchlyHeight int64) (*btypes.MsgSend, error) {
+// processOutboundTx returns the message paying the outbound, a bank send to accounts of
+// the zone or an IBC transfer to accounts of other zones.
+func (c *CosmosClient) processOutboundTx(tx stypes.TxOutItem, switchlyHeight int64) (ctypes.Msg, error) {
 	fromAddr, err := tx.VaultPubKey.GetAddress(c.GetChain())
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert address (%s) to bech32: %w", tx.VaultPubKey.String(), err)
@@ -329,6 +330,21 @@ func (c *CosmosClient) processOutboundTx(tx stypes.TxOutItem, switchlyHeight int
 		coins = append(coins, cosmosCoin)
 	}
 
+	if tx.ToAddress.IsIBCChain(c.GetChain()) {
+		var blockTime time.Time
+		blockTime, err = c.switchlyBridge.GetBlockTime(tx.Height)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get time of switchly block %d: %w", tx.Height, err)
+		}
+		return c.ibcTransfer(fromAddr.String(), tx.ToAddress, coins.Sort(), blockTime)
+	}
+
+	// outbounds can only be sent to accounts of the zone, or over IBC
+	prefix, _, err := bech32.DecodeAndConvert(tx.ToAddress.String())
+	if err != nil || prefix != c.zone.Bech32Prefix {
+		return nil, fmt.Errorf("to address (%s) is not a %s address", tx.ToAddress, c.GetChain())
+	}
+
 	return &btypes.MsgSend{
 		FromAddress: fromAddr.String(),
 		ToAddress:   tx.ToAddress.String(),
@@ -407,7 +423,7 @@ func (c *CosmosClient) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signed
 				c.accts.Set(tx.VaultPubKey, meta)
 			}
 			// Check whether the vault has enough funds for the intended outbound.
-			// If a vault attempts a GAIA outbound with insufficient funds,
+			// If a vault attempts a Cosmos outbound with insufficient funds,
 			// the unobserved gas cost will make the vault insolvent.
 			neededCoins := tx.Coins.Add(tx.MaxGas...)
 			for _, neededCoin := range neededCoins {
@@ -451,6 +467,7 @@ func (c *CosmosClient) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signed
 		tx.VaultPubKey,
 		tx.Memo,
 		fee,
+		c.zone.GasLimit,
 		uint64(meta.AccountNumber),
 		uint64(meta.SeqNumber),
 	)
@@ -485,7 +502,7 @@ func (c *CosmosClient) signMsg(
 
 	modeHandler := c.txConfig.SignModeHandler()
 	signingData := authsigning.SignerData{
-		ChainID:       c.chainID,
+		ChainID:       c.zone.ChainID,
 		AccountNumber: account,
 		Sequence:      sequence,
 	}
@@ -53,7 +53,7 @@ func GetMetricForTest(c *C) *metrics.Metrics {
 			ListenPort:   9000,
 			ReadTimeout:  time.Second,
 			WriteTimeout: time.Second,
-			Chains:       common.Chains{common.GAIAChain},
+			Chains:       common.Chains{common.GAIAChain, common.OSMOChain},
 		})
 		c.Assert(m, NotNil)
 		c.Assert(err, IsNil)
@@ -103,6 +103,7 @@ func (s *CosmosTestSuite) TestGetAddress(c *C) {
 	mockAccountServiceClient := NewMockAccountServiceClient()
 
 	cfg := config.BifrostBlockScannerConfiguration{
+		ChainID: common.GAIAChain,
 		WhitelistCosmosAssets: []config.WhitelistCosmosAsset{
 			{Denom: "uatom", Decimals: 6, SwitchlySymbol: "ATOM"},
 		},
@@ -181,8 +182,10 @@ func (s *CosmosTestSuite) TestProcessOutboundTx(c *C) {
 		InHash:      "hash",
 	}
 
-	msg, err := client.processOutboundTx(txOut, 1)
+	out, err := client.processOutboundTx(txOut, 1)
 	c.Assert(err, IsNil)
+	msg, ok := out.(*btypes.MsgSend)
+	c.Assert(ok, Equals, true)
 
 	expectedAmount := int64(245283)
 	expectedDenom := "uatom"
@@ -226,7 +229,7 @@ func (s *CosmosTestSuite) TestSign(c *C) {
 		cosmosScanner:   &CosmosBlockScanner{cfg: scannerConfig, rpc: &mockTendermintRPC{}},
 		bankClient:      mockBankServiceClient,
 		accountClient:   mockAccountServiceClient,
-		chainID:         "columbus-5",
+		zone:            CosmosZone{Chain: common.GAIAChain, ChainID: "columbus-5", Bech32Prefix: "cosmos", GasLimit: GasLimit},
 		localKeyManager: localKm,
 		accts:           NewCosmosMetaDataStore(),
 	}
@@ -263,6 +266,7 @@ func (s *CosmosTestSuite) TestSign(c *C) {
 		vaultPubKey,
 		"memo",
 		gas,
+		GasLimit,
 		uint64(meta.AccountNumber),
 		uint64(meta.SeqNumber),
 	)
@@ -0,0 +1,130 @@
+package gaia
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+const (
+	// GasModelAverage reports the mean gas price paid by the last GasCacheTransactions
+	// observed transactions, floored at the minimum gas price of the zone.
+	GasModelAverage = "average"
+
+	// GasModelFixed reports the minimum gas price of the zone, for zones where the gas
+	// price is fixed by the fee market or too few transactions are observed to average.
+	GasModelFixed = "fixed"
+
+	// IBCTimeout is the timeout of outbound IBC transfers after the time of the SWITCHLYChain
+	// block they are scheduled at. An outbound signed after it is rescheduled, and gets the
+	// timeout of its new height.
+	IBCTimeout = time.Hour
+)
+
+// CosmosZone contains the parameters which differ between the Cosmos SDK zones served
+// by the Cosmos client.
+type CosmosZone struct {
+	// Chain is the SWITCHLYChain chain of the zone.
+	Chain common.Chain
+
+	// ChainID is the chain id of the zone network, signed into outbound transactions.
+	ChainID string
+
+	// Bech32Prefix is the account address prefix of the zone.
+	Bech32Prefix string
+
+	// FeeDenom is the denom fees are paid in, it maps to the gas asset of the chain.
+	FeeDenom string
+
+	// GasLimit is the gas limit of outbound transactions.
+	GasLimit uint64
+
+	// GasModel is GasModelAverage or GasModelFixed.
+	GasModel string
+
+	// MinGasPrice is the minimum gas price in FeeDenom per gas unit, zero if unset.
+	MinGasPrice sdkmath.LegacyDec
+}
+
+// NewCosmosZone returns the zone of the given chain configuration, and validates it can
+// be served by the Cosmos client.
+func NewCosmosZone(cfg config.BifrostChainConfiguration) (CosmosZone, error) {
+	if !cfg.ChainID.IsCosmos() {
+		return CosmosZone{}, fmt.Errorf("chain %s is not a cosmos zone", cfg.ChainID)
+	}
+
+	zone := CosmosZone{
+		Chain:        cfg.ChainID,
+		ChainID:      cfg.Cosmos.ChainID,
+		Bech32Prefix: cfg.ChainID.AddressPrefix(common.CurrentChainNetwork),
+		FeeDenom:     cfg.Cosmos.FeeDenom,
+		GasLimit:     cfg.Cosmos.GasLimit,
+		GasModel:     cfg.Cosmos.GasModel,
+		MinGasPrice:  sdkmath.LegacyZeroDec(),
+	}
+
+	// gaia predates the zone configuration, default to its chain id of the network
+	if zone.ChainID == "" && cfg.ChainID.Equals(common.GAIAChain) {
+		switch os.Getenv("NET") {
+		case "mainnet", "stagenet":
+			zone.ChainID = "cosmoshub-4"
+		case "mocknet":
+			zone.ChainID = "localgaia"
+		}
+	}
+	if zone.ChainID == "" {
+		return CosmosZone{}, fmt.Errorf("chain id of %s is not configured", cfg.ChainID)
+	}
+
+	if zone.GasLimit == 0 {
+		zone.GasLimit = GasLimit
+	}
+
+	switch zone.GasModel {
+	case "":
+		zone.GasModel = GasModelAverage
+	case GasModelAverage, GasModelFixed:
+	default:
+		return CosmosZone{}, fmt.Errorf("invalid gas model of %s: %s", cfg.ChainID, zone.GasModel)
+	}
+
+	if cfg.Cosmos.MinGasPrice != "" {
+		price, err := sdkmath.LegacyNewDecFromStr(cfg.Cosmos.MinGasPrice)
+		if err != nil {
+			return CosmosZone{}, fmt.Errorf("invalid min gas price of %s: %w", cfg.ChainID, err)
+		}
+		if price.IsNegative() {
+			return CosmosZone{}, fmt.Errorf("min gas price of %s is negative", cfg.ChainID)
+		}
+		zone.MinGasPrice = price
+	}
+	if zone.GasModel == GasModelFixed && !zone.MinGasPrice.IsPositive() {
+		return CosmosZone{}, fmt.Errorf("fixed gas model of %s requires a min gas price", cfg.ChainID)
+	}
+
+	// the fee denom must be the whitelisted denom of the gas asset, fees paid in any other
+	// denom can't be reported to SWITCHLYChain, when unset it is that denom
+	gasAsset := cfg.ChainID.GetGasAsset()
+	found := false
+	for _, asset := range cfg.BlockScanner.WhitelistCosmosAssets {
+		if !strings.EqualFold(asset.SwitchlySymbol, gasAsset.Symbol.String()) {
+			continue
+		}
+		if zone.FeeDenom == "" {
+			zone.FeeDenom = asset.Denom
+		}
+		found = strings.EqualFold(asset.Denom, zone.FeeDenom)
+		break
+	}
+	if !found {
+		return CosmosZone{}, fmt.Errorf("fee denom %q of %s is not whitelisted as %s", zone.FeeDenom, cfg.ChainID, gasAsset)
+	}
+
+	return zone, nil
+}
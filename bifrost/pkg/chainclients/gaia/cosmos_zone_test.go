@@ -0,0 +1,93 @@
+package gaia
+
+import (
+	"os"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	. "gopkg.in/check.v1"
+)
+
+type CosmosZoneTestSuite struct{}
+
+var _ = Suite(&CosmosZoneTestSuite{})
+
+func zoneTestConfig(chain common.Chain, denom, symbol string) config.BifrostChainConfiguration {
+	cfg := config.BifrostChainConfiguration{ChainID: chain}
+	cfg.BlockScanner.WhitelistCosmosAssets = []config.WhitelistCosmosAsset{
+		{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Decimals: 6, SwitchlySymbol: "ATOM"},
+		{Denom: denom, Decimals: 6, SwitchlySymbol: symbol},
+	}
+	return cfg
+}
+
+func (s *CosmosZoneTestSuite) TestNewCosmosZone(c *C) {
+	cfg := zoneTestConfig(common.OSMOChain, "uosmo", "OSMO")
+	cfg.Cosmos.ChainID = "osmosis-1"
+	cfg.Cosmos.MinGasPrice = "0.0025"
+	zone, err := NewCosmosZone(cfg)
+	c.Assert(err, IsNil)
+	c.Check(zone.Chain, Equals, common.OSMOChain)
+	c.Check(zone.ChainID, Equals, "osmosis-1")
+	c.Check(zone.Bech32Prefix, Equals, "osmo")
+	c.Check(zone.FeeDenom, Equals, "uosmo") // defaults to the gas asset denom
+	c.Check(zone.GasLimit, Equals, uint64(GasLimit))
+	c.Check(zone.GasModel, Equals, GasModelAverage)
+	c.Check(zone.MinGasPrice.Equal(sdkmath.LegacyNewDecWithPrec(25, 4)), Equals, true)
+
+	// gaia defaults the chain id to the one of the network
+	c.Assert(os.Setenv("NET", "mainnet"), IsNil)
+	defer func() { c.Assert(os.Unsetenv("NET"), IsNil) }()
+	zone, err = NewCosmosZone(zoneTestConfig(common.GAIAChain, "uatom", "ATOM"))
+	c.Assert(err, IsNil)
+	c.Check(zone.ChainID, Equals, "cosmoshub-4")
+	c.Check(zone.Bech32Prefix, Equals, "cosmos")
+	c.Check(zone.MinGasPrice.IsZero(), Equals, true)
+
+	// other zones must configure it
+	_, err = NewCosmosZone(zoneTestConfig(common.NOBLEChain, "uusdc", "USDC"))
+	c.Check(err, ErrorMatches, "chain id of NOBLE is not configured")
+
+	// not a cosmos zone
+	cfg = zoneTestConfig(common.BTCChain, "sat", "BTC")
+	cfg.Cosmos.ChainID = "bitcoin"
+	_, err = NewCosmosZone(cfg)
+	c.Check(err, ErrorMatches, "chain BTC is not a cosmos zone")
+
+	// the fee denom must be the gas asset
+	cfg = zoneTestConfig(common.OSMOChain, "uosmo", "OSMO")
+	cfg.Cosmos.ChainID = "osmosis-1"
+	cfg.Cosmos.FeeDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	_, err = NewCosmosZone(cfg)
+	c.Check(err, ErrorMatches, `fee denom .* of OSMO is not whitelisted as OSMO.OSMO`)
+
+	cfg = zoneTestConfig(common.OSMOChain, "uion", "ION")
+	cfg.Cosmos.ChainID = "osmosis-1"
+	_, err = NewCosmosZone(cfg)
+	c.Check(err, ErrorMatches, `fee denom "" of OSMO is not whitelisted as OSMO.OSMO`)
+
+	// gas models
+	cfg = zoneTestConfig(common.NOBLEChain, "uusdc", "USDC")
+	cfg.Cosmos.ChainID = "noble-1"
+	cfg.Cosmos.GasModel = "median"
+	_, err = NewCosmosZone(cfg)
+	c.Check(err, ErrorMatches, "invalid gas model of NOBLE: median")
+
+	cfg.Cosmos.GasModel = GasModelFixed
+	_, err = NewCosmosZone(cfg)
+	c.Check(err, ErrorMatches, "fixed gas model of NOBLE requires a min gas price")
+
+	cfg.Cosmos.MinGasPrice = "-0.1"
+	_, err = NewCosmosZone(cfg)
+	c.Check(err, ErrorMatches, "min gas price of NOBLE is negative")
+
+	cfg.Cosmos.MinGasPrice = "0.1"
+	cfg.Cosmos.GasLimit = 100_000
+	zone, err = NewCosmosZone(cfg)
+	c.Assert(err, IsNil)
+	c.Check(zone.Bech32Prefix, Equals, "noble")
+	c.Check(zone.GasModel, Equals, GasModelFixed)
+	c.Check(zone.GasLimit, Equals, uint64(100_000))
+}
@@ -0,0 +1,265 @@
+package gaia
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
+)
+
+// IBC (ICS-20) transfers of the Cosmos client:
+//   - deposits from other zones are observed from the MsgRecvPacket of the relayer, with
+//     the memo of the packet and the sender on the other zone, prefixed with the channel
+//     the packet was received on so refunds are sent back over it
+//   - outbounds to accounts of other zones are sent as a MsgTransfer over the channel the
+//     destination address is prefixed with (see common.NewIBCAddress)
+//   - outbounds which time out or are acknowledged with an error are returned to the
+//     vault by the transfer module, and are reported as errata of the outbound tx, which
+//     SWITCHLYChain refunds
+
+// ibcPacketPrefix is the storage prefix of the outbound tx of a sent packet
+const ibcPacketPrefix = "ibc-packet-v1-"
+
+// processIBCMsgs returns the transfers of a successful transaction, and the errata of
+// outbounds it returned to the vault.
+func (c *CosmosBlockScanner) processIBCMsgs(hash string, height int64, memo string, fees ctypes.Coins, msgs []ctypes.Msg, result *abci.ExecTxResult) ([]*types.TxInItem, []types.ErrataTx) {
+	var txIn []*types.TxInItem
+	var errata []types.ErrataTx
+
+	// a relayer transaction can receive several packets, each is matched to the event of
+	// the transfer module once, and redundant relays of a received packet have no event
+	usedEvents := make(map[int]bool)
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *transfertypes.MsgTransfer:
+			coin, err := c.fromCosmosToSwitchly(msg.Token)
+			if err != nil {
+				c.logger.Debug().Err(err).Str("txhash", hash).Msg("unable to convert ibc transfer coin, not whitelisted. skipping...")
+				continue
+			}
+			txIn = append(txIn, &types.TxInItem{
+				Tx:          hash,
+				BlockHeight: height,
+				Memo:        memo,
+				Sender:      msg.Sender,
+				To:          common.NewIBCAddress(msg.SourceChannel, common.Address(msg.Receiver)).String(),
+				Coins:       common.Coins{coin},
+				Gas:         c.gasFees(fees),
+			})
+
+			// remember the outbound which sent the packet, in case it is returned
+			if !isOutboundMemo(memo) {
+				continue
+			}
+			sequence, ok := sentPacketSequence(result, msg.SourcePort, msg.SourceChannel)
+			if !ok {
+				c.logger.Error().Str("txhash", hash).Msg("ibc transfer has no send packet event")
+				continue
+			}
+			c.setIBCPacketTx(msg.SourceChannel, sequence, hash)
+
+		case *channeltypes.MsgRecvPacket:
+			if msg.Packet.DestinationPort != transfertypes.PortID {
+				continue
+			}
+			var data transfertypes.FungibleTokenPacketData
+			if err := transfertypes.ModuleCdc.UnmarshalJSON(msg.Packet.GetData(), &data); err != nil {
+				continue
+			}
+			if !receivedPacket(result, data, usedEvents) {
+				c.logger.Debug().Str("txhash", hash).Uint64("sequence", msg.Packet.Sequence).Msg("ibc packet was not received, ignoring...")
+				continue
+			}
+			amount, ok := sdkmath.NewIntFromString(data.Amount)
+			if !ok {
+				continue
+			}
+			coin, err := c.fromCosmosToSwitchly(cosmos.NewCoin(receivedDenom(msg.Packet, data.Denom), amount))
+			if err != nil {
+				c.logger.Debug().Err(err).Str("denom", data.Denom).Msg("unable to convert ibc packet coin, not whitelisted. skipping...")
+				continue
+			}
+			txIn = append(txIn, &types.TxInItem{
+				Tx:          hash,
+				BlockHeight: height,
+				Memo:        data.Memo,
+				Sender:      common.NewIBCAddress(msg.Packet.DestinationChannel, common.Address(data.Sender)).String(),
+				To:          data.Receiver,
+				Coins:       common.Coins{coin},
+				Gas:         c.gasFees(fees),
+			})
+
+		case *channeltypes.MsgAcknowledgement:
+			var ack channeltypes.Acknowledgement
+			if err := transfertypes.ModuleCdc.UnmarshalJSON(msg.Acknowledgement, &ack); err != nil || ack.Success() {
+				continue
+			}
+			if e, ok := c.returnedIBCPacket(msg.Packet); ok {
+				errata = append(errata, e)
+			}
+
+		case *channeltypes.MsgTimeout:
+			if e, ok := c.returnedIBCPacket(msg.Packet); ok {
+				errata = append(errata, e)
+			}
+
+		case *channeltypes.MsgTimeoutOnClose:
+			if e, ok := c.returnedIBCPacket(msg.Packet); ok {
+				errata = append(errata, e)
+			}
+		}
+	}
+
+	return txIn, errata
+}
+
+// returnedIBCPacket returns the errata of the outbound which sent a packet returned to
+// the vault, the outbound is forgotten so it is reported once.
+func (c *CosmosBlockScanner) returnedIBCPacket(packet channeltypes.Packet) (types.ErrataTx, bool) {
+	if packet.SourcePort != transfertypes.PortID || c.db == nil || c.db.GetInternalDb() == nil {
+		return types.ErrataTx{}, false
+	}
+	db := c.db.GetInternalDb()
+	key := ibcPacketKey(packet.SourceChannel, packet.Sequence)
+	value, err := db.Get(key, nil)
+	if err != nil {
+		if !errors.Is(err, leveldb.ErrNotFound) {
+			c.logger.Err(err).Str("channel", packet.SourceChannel).Uint64("sequence", packet.Sequence).Msg("fail to get ibc packet tx")
+		}
+		return types.ErrataTx{}, false
+	}
+	txID, err := common.NewTxID(string(value))
+	if err != nil {
+		c.logger.Err(err).Str("tx", string(value)).Msg("invalid ibc packet tx")
+		return types.ErrataTx{}, false
+	}
+	if err = db.Delete(key, nil); err != nil {
+		c.logger.Err(err).Str("channel", packet.SourceChannel).Uint64("sequence", packet.Sequence).Msg("fail to delete ibc packet tx")
+	}
+	c.logger.Warn().
+		Str("txid", txID.String()).
+		Str("channel", packet.SourceChannel).
+		Uint64("sequence", packet.Sequence).
+		Msg("ibc outbound returned to vault")
+	return types.ErrataTx{TxID: txID, Chain: c.cfg.ChainID}, true
+}
+
+func (c *CosmosBlockScanner) setIBCPacketTx(channel string, sequence uint64, hash string) {
+	if c.db == nil || c.db.GetInternalDb() == nil {
+		return
+	}
+	if err := c.db.GetInternalDb().Put(ibcPacketKey(channel, sequence), []byte(hash), nil); err != nil {
+		c.logger.Err(err).Str("channel", channel).Uint64("sequence", sequence).Msg("fail to set ibc packet tx")
+	}
+}
+
+func ibcPacketKey(channel string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%d", ibcPacketPrefix, channel, sequence))
+}
+
+// isOutboundMemo returns true for the memos of transactions sent by vaults
+func isOutboundMemo(memo string) bool {
+	m, err := mem.ParseMemo(common.LatestVersion, memo)
+	return err == nil && (m.IsOutbound() || m.IsInternal())
+}
+
+// sentPacketSequence returns the sequence of the packet sent on the channel
+func sentPacketSequence(result *abci.ExecTxResult, port, channel string) (uint64, bool) {
+	for _, event := range result.Events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		attrs := eventAttributes(event)
+		if attrs[channeltypes.AttributeKeySrcPort] != port || attrs[channeltypes.AttributeKeySrcChannel] != channel {
+			continue
+		}
+		sequence, err := strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		return sequence, true
+	}
+	return 0, false
+}
+
+// receivedPacket returns true when the transfer module acknowledged the packet with
+// success, the matched event is marked used.
+func receivedPacket(result *abci.ExecTxResult, data transfertypes.FungibleTokenPacketData, used map[int]bool) bool {
+	for i, event := range result.Events {
+		if used[i] || event.Type != transfertypes.EventTypePacket {
+			continue
+		}
+		attrs := eventAttributes(event)
+		if attrs[ctypes.AttributeKeySender] != data.Sender ||
+			attrs[transfertypes.AttributeKeyReceiver] != data.Receiver ||
+			attrs[transfertypes.AttributeKeyDenom] != data.Denom ||
+			attrs[transfertypes.AttributeKeyAmount] != data.Amount {
+			continue
+		}
+		used[i] = true
+		return attrs[transfertypes.AttributeKeyAckSuccess] == "true"
+	}
+	return false
+}
+
+// receivedDenom returns the denom of the received packet tokens on this zone, tokens
+// returning to this zone are unwrapped and others are IBC vouchers.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(prefix):]).IBCDenom()
+	}
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
+
+func eventAttributes(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+// ibcTransfer returns the ICS-20 transfer of the coins to an account of another zone,
+// scheduled at a SWITCHLYChain block of the given time
+func (c *CosmosClient) ibcTransfer(from string, to common.Address, coins ctypes.Coins, blockTime time.Time) (*transfertypes.MsgTransfer, error) {
+	channel, receiver, ok := to.IBCChannel()
+	if !ok {
+		return nil, fmt.Errorf("to address (%s) has no ibc channel", to)
+	}
+	// an ICS-20 packet transfers a single token
+	if len(coins) != 1 {
+		return nil, fmt.Errorf("ibc transfer must send one coin, got %d", len(coins))
+	}
+	return &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    channel,
+		Token:            coins[0],
+		Sender:           from,
+		Receiver:         receiver.String(),
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: ibcTimeoutTimestamp(blockTime),
+	}, nil
+}
+
+// ibcTimeoutTimestamp returns the timeout of a transfer scheduled at a SWITCHLYChain block
+// of the given time. Every signer of the keysign must build the same transaction, so the
+// timeout is derived from the block rather than the clock of the node.
+func ibcTimeoutTimestamp(blockTime time.Time) uint64 {
+	return uint64(blockTime.Add(IBCTimeout).UnixNano())
+}
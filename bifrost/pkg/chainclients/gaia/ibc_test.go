@@ -0,0 +1,228 @@
+package gaia
+
+import (
+	"errors"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/blockscanner"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	. "gopkg.in/check.v1"
+)
+
+type IBCTestSuite struct{}
+
+var _ = Suite(&IBCTestSuite{})
+
+const (
+	ibcTestOutHash = "B5A7F0D5D5D4E2B8E1A2F4A4D2B3C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6"
+	ibcTestTxHash  = "0F2E1D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A69788796A5B4C3D2E1F0"
+)
+
+func newIBCTestScanner(c *C) *CosmosBlockScanner {
+	storage, err := blockscanner.NewBlockScannerStorage("", config.LevelDBOptions{})
+	c.Assert(err, IsNil)
+	return &CosmosBlockScanner{
+		cfg: config.BifrostBlockScannerConfiguration{
+			ChainID: common.GAIAChain,
+			WhitelistCosmosAssets: []config.WhitelistCosmosAsset{
+				{Denom: "uatom", Decimals: 6, SwitchlySymbol: "ATOM"},
+			},
+		},
+		db:     storage,
+		logger: log.Logger.With().Str("module", "blockscanner").Str("chain", common.GAIAChain.String()).Logger(),
+	}
+}
+
+func ibcTestEvent(typ string, attrs ...string) abci.Event {
+	event := abci.Event{Type: typ}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return event
+}
+
+func ibcTestRecvPacket(data transfertypes.FungibleTokenPacketData, sequence uint64) *channeltypes.MsgRecvPacket {
+	return &channeltypes.MsgRecvPacket{
+		Packet: channeltypes.Packet{
+			Sequence:           sequence,
+			SourcePort:         transfertypes.PortID,
+			SourceChannel:      "channel-0",
+			DestinationPort:    transfertypes.PortID,
+			DestinationChannel: "channel-141",
+			Data:               data.GetBytes(),
+		},
+	}
+}
+
+func (s *IBCTestSuite) TestRecvPacket(c *C) {
+	scanner := newIBCTestScanner(c)
+	fees := ctypes.NewCoins(ctypes.NewCoin("uatom", sdkmath.NewInt(5000)))
+
+	// atom returning from osmosis is unwrapped
+	data := transfertypes.NewFungibleTokenPacketData("transfer/channel-0/uatom", "1500000", "osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s", "cosmos1vault", "=:OSMO.OSMO:osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	packetEvent := func(success string) abci.Event {
+		return ibcTestEvent(transfertypes.EventTypePacket,
+			ctypes.AttributeKeySender, data.Sender,
+			transfertypes.AttributeKeyReceiver, data.Receiver,
+			transfertypes.AttributeKeyDenom, data.Denom,
+			transfertypes.AttributeKeyAmount, data.Amount,
+			transfertypes.AttributeKeyAckSuccess, success,
+		)
+	}
+	msgs := []ctypes.Msg{ibcTestRecvPacket(data, 7)}
+	result := &abci.ExecTxResult{Events: []abci.Event{packetEvent("true")}}
+
+	txIn, errata := scanner.processIBCMsgs(ibcTestTxHash, 10, "relayer memo", fees, msgs, result)
+	c.Assert(errata, HasLen, 0)
+	c.Assert(txIn, HasLen, 1)
+	c.Check(txIn[0].Tx, Equals, ibcTestTxHash)
+	c.Check(txIn[0].BlockHeight, Equals, int64(10))
+	c.Check(txIn[0].Memo, Equals, data.Memo)
+	// refunds are sent back over the channel the packet was received on
+	c.Check(txIn[0].Sender, Equals, "channel-141-"+data.Sender)
+	c.Check(txIn[0].To, Equals, data.Receiver)
+	c.Assert(txIn[0].Coins, HasLen, 1)
+	c.Check(txIn[0].Coins[0].Asset.Equals(common.ATOMAsset), Equals, true)
+	c.Check(txIn[0].Coins[0].Amount.Uint64(), Equals, uint64(150000000))
+	c.Check(txIn[0].Gas.IsEmpty(), Equals, false)
+
+	// the packet was acknowledged with an error
+	result = &abci.ExecTxResult{Events: []abci.Event{packetEvent("false")}}
+	txIn, _ = scanner.processIBCMsgs(ibcTestTxHash, 10, "", fees, msgs, result)
+	c.Check(txIn, HasLen, 0)
+
+	// a relayer relays the packet twice in a transaction, only one was received
+	msgs = []ctypes.Msg{ibcTestRecvPacket(data, 7), ibcTestRecvPacket(data, 7)}
+	result = &abci.ExecTxResult{Events: []abci.Event{packetEvent("true")}}
+	txIn, _ = scanner.processIBCMsgs(ibcTestTxHash, 10, "", fees, msgs, result)
+	c.Check(txIn, HasLen, 1)
+
+	// redundant relays of a received packet have no event
+	txIn, _ = scanner.processIBCMsgs(ibcTestTxHash, 10, "", fees, msgs[:1], &abci.ExecTxResult{})
+	c.Check(txIn, HasLen, 0)
+
+	// vouchers which are not whitelisted are ignored
+	data.Denom = "uosmo"
+	msgs = []ctypes.Msg{ibcTestRecvPacket(data, 8)}
+	result = &abci.ExecTxResult{Events: []abci.Event{packetEvent("true")}}
+	txIn, _ = scanner.processIBCMsgs(ibcTestTxHash, 10, "", fees, msgs, result)
+	c.Check(txIn, HasLen, 0)
+}
+
+func (s *IBCTestSuite) TestReceivedDenom(c *C) {
+	packet := ibcTestRecvPacket(transfertypes.FungibleTokenPacketData{}, 1).Packet
+	c.Check(receivedDenom(packet, "transfer/channel-0/uatom"), Equals, "uatom")
+	c.Check(receivedDenom(packet, "uosmo"), Equals, transfertypes.ParseDenomTrace("transfer/channel-141/uosmo").IBCDenom())
+}
+
+func (s *IBCTestSuite) TestReturnedTransfer(c *C) {
+	scanner := newIBCTestScanner(c)
+	fees := ctypes.NewCoins(ctypes.NewCoin("uatom", sdkmath.NewInt(5000)))
+
+	transfer := &transfertypes.MsgTransfer{
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-141",
+		Token:         ctypes.NewCoin("uatom", sdkmath.NewInt(1000000)),
+		Sender:        "cosmos1vault",
+		Receiver:      "osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s",
+	}
+	sendPacket := func(sequence string) *abci.ExecTxResult {
+		return &abci.ExecTxResult{Events: []abci.Event{ibcTestEvent(channeltypes.EventTypeSendPacket,
+			channeltypes.AttributeKeySrcPort, transfertypes.PortID,
+			channeltypes.AttributeKeySrcChannel, "channel-141",
+			channeltypes.AttributeKeySequence, sequence,
+		)}}
+	}
+
+	// outbounds are observed and remembered
+	txIn, errata := scanner.processIBCMsgs(ibcTestTxHash, 10, "OUT:"+ibcTestOutHash, fees, []ctypes.Msg{transfer}, sendPacket("3"))
+	c.Assert(errata, HasLen, 0)
+	c.Assert(txIn, HasLen, 1)
+	c.Check(txIn[0].Memo, Equals, "OUT:"+ibcTestOutHash)
+	c.Check(txIn[0].To, Equals, "channel-141-"+transfer.Receiver)
+	c.Check(txIn[0].Coins[0].Amount.Uint64(), Equals, uint64(100000000))
+
+	// other transfers of the vault are not
+	_, _ = scanner.processIBCMsgs(ibcTestTxHash, 11, "not an outbound", fees, []ctypes.Msg{transfer}, sendPacket("4"))
+
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{
+			Sequence:           sequence,
+			SourcePort:         transfertypes.PortID,
+			SourceChannel:      "channel-141",
+			DestinationPort:    transfertypes.PortID,
+			DestinationChannel: "channel-0",
+		}
+	}
+	ack := func(sequence uint64, ack channeltypes.Acknowledgement) ctypes.Msg {
+		return &channeltypes.MsgAcknowledgement{Packet: packet(sequence), Acknowledgement: ack.Acknowledgement()}
+	}
+
+	// successful acknowledgements don't return the outbound
+	_, errata = scanner.processIBCMsgs(ibcTestTxHash, 12, "", fees, []ctypes.Msg{ack(3, channeltypes.NewResultAcknowledgement([]byte{1}))}, &abci.ExecTxResult{})
+	c.Check(errata, HasLen, 0)
+
+	// error acknowledgements do, once
+	errAck := ack(3, channeltypes.NewErrorAcknowledgement(errors.New("invalid receiver")))
+	_, errata = scanner.processIBCMsgs(ibcTestTxHash, 12, "", fees, []ctypes.Msg{errAck}, &abci.ExecTxResult{})
+	c.Assert(errata, HasLen, 1)
+	c.Check(errata[0].TxID.String(), Equals, ibcTestTxHash)
+	c.Check(errata[0].Chain.Equals(common.GAIAChain), Equals, true)
+	_, errata = scanner.processIBCMsgs(ibcTestTxHash, 12, "", fees, []ctypes.Msg{errAck}, &abci.ExecTxResult{})
+	c.Check(errata, HasLen, 0)
+
+	// timeouts return the outbound, unknown packets are ignored
+	_, _ = scanner.processIBCMsgs(ibcTestTxHash, 13, "OUT:"+ibcTestOutHash, fees, []ctypes.Msg{transfer}, sendPacket("5"))
+	msgs := []ctypes.Msg{
+		&channeltypes.MsgTimeout{Packet: packet(4)},
+		&channeltypes.MsgTimeout{Packet: packet(5)},
+	}
+	_, errata = scanner.processIBCMsgs(ibcTestTxHash, 14, "", fees, msgs, &abci.ExecTxResult{})
+	c.Assert(errata, HasLen, 1)
+	c.Check(errata[0].TxID.String(), Equals, ibcTestTxHash)
+}
+
+func (s *IBCTestSuite) TestIBCTransfer(c *C) {
+	client := &CosmosClient{
+		cfg: config.BifrostChainConfiguration{ChainID: common.GAIAChain},
+		zone: CosmosZone{
+			Chain:        common.GAIAChain,
+			Bech32Prefix: "cosmos",
+		},
+	}
+	blockTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	coins := ctypes.NewCoins(ctypes.NewCoin("uatom", sdkmath.NewInt(1000)))
+	to := common.Address("channel-141-osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+
+	msg, err := client.ibcTransfer("cosmos1vault", to, coins, blockTime)
+	c.Assert(err, IsNil)
+	c.Check(msg.SourcePort, Equals, transfertypes.PortID)
+	c.Check(msg.SourceChannel, Equals, "channel-141")
+	c.Check(msg.Token.String(), Equals, "1000uatom")
+	c.Check(msg.Sender, Equals, "cosmos1vault")
+	c.Check(msg.Receiver, Equals, "osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	c.Check(msg.TimeoutHeight, Equals, clienttypes.ZeroHeight())
+	c.Check(msg.TimeoutTimestamp, Equals, uint64(blockTime.Add(IBCTimeout).UnixNano()))
+
+	// the channel is taken from the destination
+	msg, err = client.ibcTransfer("cosmos1vault", "channel-536-noble10tjz4ave7znpctgd2rfu6v2r6zkeup2dhc4ryv", coins, blockTime)
+	c.Assert(err, IsNil)
+	c.Check(msg.SourceChannel, Equals, "channel-536")
+	c.Check(msg.Receiver, Equals, "noble10tjz4ave7znpctgd2rfu6v2r6zkeup2dhc4ryv")
+
+	_, err = client.ibcTransfer("cosmos1vault", "osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s", coins, blockTime)
+	c.Check(err, ErrorMatches, "to address .* has no ibc channel")
+
+	coins = coins.Add(ctypes.NewCoin("uosmo", sdkmath.NewInt(1)))
+	_, err = client.ibcTransfer("cosmos1vault", to, coins, blockTime)
+	c.Check(err, ErrorMatches, "ibc transfer must send one coin, got 2")
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	ctypes "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
-	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
@@ -21,14 +20,15 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-// buildUnsigned takes a MsgSend and other parameters and returns a txBuilder
+// buildUnsigned takes a MsgSend or MsgTransfer and other parameters and returns a txBuilder
 // It can be used to simulateTx or as the input to signMsg before BraodcastTx
 func buildUnsigned(
 	txConfig client.TxConfig,
-	msg *btypes.MsgSend,
+	msg ctypes.Msg,
 	pubkey common.PubKey,
 	memo string,
 	fee ctypes.Coins,
+	gasLimit uint64,
 	account uint64,
 	sequence uint64,
 ) (client.TxBuilder, error) {
@@ -45,7 +45,7 @@ func buildUnsigned(
 
 	txBuilder.SetMemo(memo)
 	txBuilder.SetFeeAmount(fee)
-	txBuilder.SetGasLimit(GasLimit)
+	txBuilder.SetGasLimit(gasLimit)
 
 	sigData := &signingtypes.SingleSignatureData{
 		SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT,
@@ -70,7 +70,7 @@ func (c *CosmosBlockScanner) fromCosmosToSwitchly(coin cosmos.Coin) (common.Coin
 		return common.NoCoin, fmt.Errorf("asset does not exist / not whitelisted by client")
 	}
 
-	switchlyAsset, err := common.NewAsset(fmt.Sprintf("GAIA.%s", cosmosAsset.SwitchlyProtocolSymbol))
+	switchlyAsset, err := common.NewAsset(fmt.Sprintf("%s.%s", c.cfg.ChainID, cosmosAsset.SwitchlyProtocolSymbol))
 	if err != nil {
 		return common.NoCoin, fmt.Errorf("invalid switchly asset: %w", err)
 	}
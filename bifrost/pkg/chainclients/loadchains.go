@@ -11,6 +11,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/evm"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/gaia"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/types"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/solana"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/stellar"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/utxo"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/xrp"
@@ -44,7 +45,7 @@ func LoadChains(thorKeys *switchlyclient.Keys,
 			return ethereum.NewClient(thorKeys, chain, server, switchlyBridge, m, pubKeyValidator, poolMgr)
 		case common.AVAXChain, common.BSCChain, common.BASEChain:
 			return evm.NewEVMClient(thorKeys, chain, server, switchlyBridge, m, pubKeyValidator, poolMgr)
-		case common.GAIAChain:
+		case common.GAIAChain, common.OSMOChain, common.NOBLEChain, common.DYDXChain:
 			return gaia.NewCosmosClient(thorKeys, chain, server, switchlyBridge, m)
 		case common.BTCChain, common.BCHChain, common.LTCChain, common.DOGEChain:
 			return utxo.NewClient(thorKeys, chain, server, switchlyBridge, m)
@@ -52,6 +53,8 @@ func LoadChains(thorKeys *switchlyclient.Keys,
 			return xrp.NewClient(thorKeys, chain, server, switchlyBridge, m)
 		case common.StellarChain:
 			return stellar.NewClient(thorKeys, chain, server, switchlyBridge, m)
+		case common.SolanaChain:
+			return solana.NewClient(thorKeys, chain, server, switchlyBridge, m)
 		default:
 			log.Fatal().Msgf("chain %s is not supported", chain.ChainID)
 			return nil, nil
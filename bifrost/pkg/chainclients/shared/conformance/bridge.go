@@ -0,0 +1,171 @@
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+// Bridge is the SwitchlyBridge the chain client under test is connected to. It serves
+// the calls made by the block scanner, the signer and the solvency runner from memory,
+// any other call panics.
+type Bridge struct {
+	switchlyclient.SwitchlyBridge
+
+	lock           sync.Mutex
+	height         int64
+	blockTimes     map[int64]time.Time
+	mimir          map[string]int64
+	asgards        stypes.Vaults
+	networkFees    map[common.Chain][2]uint64
+	keysignFailure int
+}
+
+// NewBridge creates a new instance of Bridge at the given SWITCHLYChain height
+func NewBridge(height int64) *Bridge {
+	return &Bridge{
+		height:      height,
+		blockTimes:  make(map[int64]time.Time),
+		mimir:       make(map[string]int64),
+		networkFees: make(map[common.Chain][2]uint64),
+	}
+}
+
+// SetHeight sets the SWITCHLYChain height returned by GetBlockHeight
+func (b *Bridge) SetHeight(height int64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.height = height
+}
+
+// SetBlockTime sets the time of the SWITCHLYChain block at the given height
+func (b *Bridge) SetBlockTime(height int64, blockTime time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.blockTimes[height] = blockTime
+}
+
+// SetMimir sets the value of a mimir key
+func (b *Bridge) SetMimir(key string, value int64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.mimir[key] = value
+}
+
+// SetAsgards sets the asgard vaults returned by GetAsgards
+func (b *Bridge) SetAsgards(vaults ...stypes.Vault) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.asgards = vaults
+}
+
+// KeysignFailures returns the number of keysign failures posted by the client
+func (b *Bridge) KeysignFailures() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.keysignFailure
+}
+
+func (b *Bridge) GetMimir(key string) (int64, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.mimir[key], nil
+}
+
+func (b *Bridge) GetMimirWithRef(template, ref string) (int64, error) {
+	return b.GetMimir(fmt.Sprintf(template, ref))
+}
+
+func (b *Bridge) GetMimirAtHeight(key string, _ int64) (int64, error) {
+	return b.GetMimir(key)
+}
+
+func (b *Bridge) GetBlockHeight() (int64, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.height, nil
+}
+
+func (b *Bridge) GetBlockTime(height int64) (time.Time, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	blockTime, ok := b.blockTimes[height]
+	if !ok {
+		return time.Time{}, fmt.Errorf("block %d not found", height)
+	}
+	return blockTime, nil
+}
+
+func (b *Bridge) GetLastObservedInHeight(chain common.Chain) (int64, error) {
+	return 0, nil
+}
+
+func (b *Bridge) GetLastSignedOutHeight(chain common.Chain) (int64, error) {
+	return 0, nil
+}
+
+func (b *Bridge) GetConstants() (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+
+func (b *Bridge) GetAsgards() (stypes.Vaults, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return append(stypes.Vaults{}, b.asgards...), nil
+}
+
+func (b *Bridge) GetVault(pubkey string) (stypes.Vault, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, vault := range b.asgards {
+		if vault.PubKey.String() == pubkey {
+			return vault, nil
+		}
+	}
+	return stypes.Vault{}, errors.New("vault not found")
+}
+
+func (b *Bridge) HasNetworkFee(chain common.Chain) (bool, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	_, ok := b.networkFees[chain]
+	return ok, nil
+}
+
+func (b *Bridge) GetNetworkFee(chain common.Chain) (transactionSize, transactionFeeRate uint64, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	fee := b.networkFees[chain]
+	return fee[0], fee[1], nil
+}
+
+func (b *Bridge) PostNetworkFee(height int64, chain common.Chain, transactionSize, transactionRate uint64) (common.TxID, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.networkFees[chain] = [2]uint64{transactionSize, transactionRate}
+	return common.BlankTxID, nil
+}
+
+func (b *Bridge) PostKeysignFailure(blame stypes.Blame, height int64, memo string, coins common.Coins, pubkey common.PubKey) (common.TxID, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.keysignFailure++
+	return common.BlankTxID, nil
+}
+
+func (b *Bridge) IsCatchingUp() (bool, error) {
+	return false, nil
+}
+
+func (b *Bridge) WaitToCatchUp() error {
+	return nil
+}
+
+func (b *Bridge) RagnarokInProgress() (bool, error) {
+	return false, nil
+}
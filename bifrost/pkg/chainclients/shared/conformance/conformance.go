@@ -0,0 +1,415 @@
+// Package conformance is a test suite every chain client can run against a fake node of
+// its chain, to check it fulfils the ChainClient contract the observer and the signer
+// rely on: scanning inbounds and their memos, mempool scanning, solvency reporting,
+// signing and broadcasting outbounds, rescheduling a failed broadcast and reporting the
+// txs of reorged blocks as errata.
+//
+// A chain client plugs in by implementing Node in a test of its package, and calling Run.
+package conformance
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/runners"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/types"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	switchlytypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+const (
+	// bridgeHeight is the SWITCHLYChain height reported by the bridge
+	bridgeHeight = 100
+
+	// waitTimeout is how long the suite waits for the client to report something
+	waitTimeout = 20 * time.Second
+
+	// mineInterval is how often the suite mines an empty block while it waits, so the
+	// clients requiring confirmations or lagging the tip make progress
+	mineInterval = 100 * time.Millisecond
+
+	// solvencyBackoff is the backoff of the solvency runner started by the suite
+	solvencyBackoff = 50 * time.Millisecond
+)
+
+// Capabilities are the optional behaviours of a chain client checked by the suite
+type Capabilities struct {
+	// Mempool is set when the client reports inbounds from the mempool
+	Mempool bool
+	// Reorg is set when the node can reorg and the client detects it
+	Reorg bool
+}
+
+// Node is a fake node of the chain of the client under test, the adapter between the
+// suite and the chain client.
+type Node interface {
+	// Capabilities returns the optional behaviours checked on the client
+	Capabilities() Capabilities
+
+	// Client creates the chain client under test connected to the node and the bridge.
+	// The client signs for Vault with a local key, and scans from the current tip.
+	Client(bridge switchlyclient.SwitchlyBridge) (types.ChainClient, error)
+
+	// Vault returns the pub key of the vault the client signs for, funded on the node
+	Vault() common.PubKey
+
+	// User returns the address of a funded account, the sender of the inbounds and the
+	// receiver of the outbounds
+	User() common.Address
+
+	// Send submits a transfer of the given coins from User to the given address with a
+	// memo, and returns the hash the client reports the tx with. The tx stays in the
+	// mempool until the next block is mined.
+	Send(to common.Address, coins common.Coins, memo string) (string, error)
+
+	// Mine mines a block with the txs of the mempool, and returns its height
+	Mine() (int64, error)
+
+	// Reorg replaces the blocks from the given height up to the tip with as many blocks
+	// without any tx. It is only called when Capabilities.Reorg is set.
+	Reorg(height int64) error
+
+	// FailBroadcast makes the next tx broadcast to the node fail
+	FailBroadcast()
+}
+
+// Run runs the conformance suite, newNode is called to create a new node for every test
+func Run(t *testing.T, newNode func(t *testing.T) Node) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, h *harness)
+	}{
+		{"Scan", testScan},
+		{"Memo", testMemo},
+		{"Mempool", testMempool},
+		{"Solvency", testSolvency},
+		{"SignRoundTrip", testSignRoundTrip},
+		{"Reschedule", testReschedule},
+		{"Reorg", testReorg},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newHarness(t, newNode(t)))
+		})
+	}
+}
+
+// harness is a chain client connected to a node and a bridge, with the queues it reports to
+type harness struct {
+	node     Node
+	bridge   *Bridge
+	client   types.ChainClient
+	txs      chan stypes.TxIn
+	errata   chan stypes.ErrataBlock
+	solvency chan stypes.Solvency
+	fees     chan common.NetworkFee
+}
+
+func newHarness(t *testing.T, node Node) *harness {
+	bridge := NewBridge(bridgeHeight)
+	client, err := node.Client(bridge)
+	require.NoError(t, err, "fail to create chain client")
+	return &harness{
+		node:     node,
+		bridge:   bridge,
+		client:   client,
+		txs:      make(chan stypes.TxIn, 1000),
+		errata:   make(chan stypes.ErrataBlock, 1000),
+		solvency: make(chan stypes.Solvency, 1000),
+		fees:     make(chan common.NetworkFee, 1000),
+	}
+}
+
+func (h *harness) start(t *testing.T) {
+	h.client.Start(h.txs, h.errata, h.solvency, h.fees)
+	t.Cleanup(h.client.Stop)
+}
+
+func (h *harness) chain() common.Chain {
+	return h.client.GetChain()
+}
+
+func (h *harness) vaultAddress(t *testing.T) common.Address {
+	addr, err := common.NewAddress(h.client.GetAddress(h.node.Vault()))
+	require.NoError(t, err, "fail to get vault address")
+	return addr
+}
+
+// coins returns the given amount of the gas asset, in 1e8
+func (h *harness) coins(amount uint64) common.Coins {
+	return common.Coins{common.NewCoin(h.chain().GetGasAsset(), cosmos.NewUint(amount))}
+}
+
+func (h *harness) mine(t *testing.T) int64 {
+	height, err := h.node.Mine()
+	require.NoError(t, err, "fail to mine block")
+	return height
+}
+
+// waitTxs mines empty blocks until the client reported the txs with the given hashes,
+// and returns them by hash
+func (h *harness) waitTxs(t *testing.T, hashes ...string) map[string]*stypes.TxInItem {
+	found := make(map[string]*stypes.TxInItem)
+	deadline := time.After(waitTimeout)
+	for len(found) < len(hashes) {
+		select {
+		case txIn := <-h.txs:
+			require.Equal(t, h.chain(), txIn.Chain)
+			for _, item := range txIn.TxArray {
+				for _, hash := range hashes {
+					if strings.EqualFold(item.Tx, hash) {
+						found[hash] = item
+					}
+				}
+			}
+		case <-time.After(mineInterval):
+			h.mine(t)
+		case <-deadline:
+			require.FailNowf(t, "txs not reported", "reported %d of %d txs", len(found), len(hashes))
+		}
+	}
+	return found
+}
+
+// outbound returns an outbound of the vault to the user
+func (h *harness) outbound(inHash string) stypes.TxOutItem {
+	return stypes.TxOutItem{
+		Chain:       h.chain(),
+		ToAddress:   h.node.User(),
+		VaultPubKey: h.node.Vault(),
+		Coins:       h.coins(common.One / 10),
+		MaxGas:      common.Gas{common.NewCoin(h.chain().GetGasAsset(), cosmos.NewUint(common.One/100))},
+		GasRate:     1,
+		Memo:        fmt.Sprintf("OUT:%s", inHash),
+		InHash:      common.TxID(inHash),
+	}
+}
+
+// testScan checks an inbound to the vault is reported once mined
+func testScan(t *testing.T, h *harness) {
+	h.start(t)
+	vault := h.vaultAddress(t)
+
+	hash, err := h.node.Send(vault, h.coins(common.One), "ADD:"+h.chain().GetGasAsset().String())
+	require.NoError(t, err)
+	height := h.mine(t)
+
+	item := h.waitTxs(t, hash)[hash]
+	require.Equal(t, height, item.BlockHeight)
+	require.True(t, strings.EqualFold(vault.String(), item.To), "inbound to %s reported to %s", vault, item.To)
+	require.True(t, strings.EqualFold(h.node.User().String(), item.Sender), "inbound from %s reported from %s", h.node.User(), item.Sender)
+	require.True(t, item.Coins.EqualsEx(h.coins(common.One)), "inbound of %s reported as %s", h.coins(common.One), item.Coins)
+	require.False(t, item.Gas.IsEmpty(), "inbound reported without gas")
+
+	scanned, err := h.client.GetBlockScannerHeight()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, scanned, height)
+}
+
+// testMemo checks the memos of the inbounds in a block are reported as sent
+func testMemo(t *testing.T, h *harness) {
+	h.start(t)
+	vault := h.vaultAddress(t)
+	asset := h.chain().GetGasAsset()
+
+	memos := []string{
+		fmt.Sprintf("=:%s:%s", asset, h.node.User()),
+		fmt.Sprintf("SWAP:%s:%s:0/1/0", asset, h.node.User()),
+		fmt.Sprintf("+:%s", asset),
+		"NOOP",
+	}
+	sent := make(map[string]string)
+	var hashes []string
+	for _, memo := range memos {
+		hash, err := h.node.Send(vault, h.coins(common.One), memo)
+		require.NoError(t, err)
+		sent[hash] = memo
+		hashes = append(hashes, hash)
+	}
+	h.mine(t)
+
+	for hash, item := range h.waitTxs(t, hashes...) {
+		require.Equal(t, sent[hash], item.Memo, "memo of %s", hash)
+	}
+}
+
+// testMempool checks an inbound is reported from the mempool before it is mined
+func testMempool(t *testing.T, h *harness) {
+	if !h.node.Capabilities().Mempool {
+		t.Skip("client doesn't scan the mempool")
+	}
+	vault := h.vaultAddress(t)
+	hash, err := h.node.Send(vault, h.coins(common.One), "ADD:"+h.chain().GetGasAsset().String())
+	require.NoError(t, err)
+	h.start(t)
+
+	deadline := time.After(waitTimeout)
+	for {
+		select {
+		case txIn := <-h.txs:
+			if !txIn.MemPool {
+				require.FailNow(t, "block scanned before the mempool", "%+v", txIn)
+			}
+			for _, item := range txIn.TxArray {
+				if strings.EqualFold(item.Tx, hash) {
+					return
+				}
+			}
+		case <-deadline:
+			require.FailNow(t, "mempool inbound not reported")
+		}
+	}
+}
+
+// testSolvency checks the solvency runner reports a solvent vault while the chain is
+// halted by the solvency checks
+func testSolvency(t *testing.T, h *harness) {
+	provider, ok := h.client.(runners.SolvencyCheckProvider)
+	require.True(t, ok, "client is not a solvency check provider")
+
+	vault := h.node.Vault()
+	acct, err := h.client.GetAccount(vault, nil)
+	require.NoError(t, err)
+	require.False(t, acct.Coins.IsEmpty(), "vault is not funded")
+	asgard := switchlytypes.NewVault(1, switchlytypes.VaultStatus_ActiveVault, switchlytypes.VaultType_AsgardVault, vault, []string{h.chain().String()}, nil)
+	asgard.AddFunds(acct.Coins)
+	// keep the ed25519 key of the vault the client was set up with, if any
+	if existing, err := h.bridge.GetVault(vault.String()); err == nil {
+		asgard.Ed25519PubKey = existing.Ed25519PubKey
+	}
+	h.bridge.SetAsgards(asgard)
+	h.bridge.SetMimir(fmt.Sprintf("SolvencyHalt%sChain", h.chain()), 1)
+	h.start(t)
+
+	stop := make(chan struct{})
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go runners.SolvencyCheckRunner(h.chain(), provider, h.bridge, stop, wg, solvencyBackoff)
+	t.Cleanup(func() {
+		close(stop)
+		wg.Wait()
+	})
+
+	deadline := time.After(waitTimeout)
+	for {
+		select {
+		case solvency := <-h.solvency:
+			require.Equal(t, h.chain(), solvency.Chain)
+			require.True(t, solvency.PubKey.Equals(vault), "solvency reported for %s", solvency.PubKey)
+			require.True(t, solvency.Coins.EqualsEx(acct.Coins), "solvency of %s reported as %s", acct.Coins, solvency.Coins)
+			height, err := h.client.GetHeight()
+			require.NoError(t, err)
+			require.LessOrEqual(t, solvency.Height, height)
+			return
+		case <-time.After(mineInterval):
+			h.mine(t)
+		case <-deadline:
+			require.FailNow(t, "solvency not reported while the chain is halted")
+		}
+	}
+}
+
+// testSignRoundTrip checks a signed and broadcast outbound is reported as sent by the
+// vault, and is not signed again
+func testSignRoundTrip(t *testing.T, h *harness) {
+	h.start(t)
+	vault := h.vaultAddress(t)
+	tx := h.outbound("8A5C2D3E8F0B0A6E6C7D1F4E9B3A2C5D8E7F6A1B0C9D8E7F6A5B4C3D2E1F0A9B")
+
+	signed, _, _, err := h.client.SignTx(tx, bridgeHeight)
+	require.NoError(t, err)
+	require.NotEmpty(t, signed, "outbound not signed")
+	hash, err := h.client.BroadcastTx(tx, signed)
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+
+	item := h.waitTxs(t, hash)[hash]
+	require.True(t, strings.EqualFold(vault.String(), item.Sender), "outbound from %s reported from %s", vault, item.Sender)
+	require.True(t, strings.EqualFold(h.node.User().String(), item.To), "outbound to %s reported to %s", h.node.User(), item.To)
+	require.Equal(t, tx.Memo, item.Memo)
+	require.True(t, item.Coins.EqualsEx(tx.Coins), "outbound of %s reported as %s", tx.Coins, item.Coins)
+	// the observer sets the vault the tx was observed for before passing it back
+	item.ObservedVaultPubKey = h.node.Vault()
+	h.client.OnObservedTxIn(*item, item.BlockHeight)
+
+	signed, _, _, err = h.client.SignTx(tx, bridgeHeight)
+	require.NoError(t, err)
+	require.Empty(t, signed, "observed outbound signed again")
+
+	observed, broadcast, err := h.client.GetLatestTxForVault(h.node.Vault().String())
+	require.NoError(t, err)
+	require.True(t, strings.EqualFold(hash, observed), "latest observed %s, expected %s", observed, hash)
+	require.True(t, strings.EqualFold(hash, broadcast), "latest broadcast %s, expected %s", broadcast, hash)
+}
+
+// testReschedule checks an outbound that failed to broadcast can be signed and
+// broadcast again, and is sent once
+func testReschedule(t *testing.T, h *harness) {
+	h.start(t)
+	tx := h.outbound("0F1E2D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A69788796A5B4C3D2E1F0")
+
+	h.node.FailBroadcast()
+	signed, checkpoint, _, err := h.client.SignTx(tx, bridgeHeight)
+	require.NoError(t, err)
+	require.NotEmpty(t, signed, "outbound not signed")
+	_, err = h.client.BroadcastTx(tx, signed)
+	require.Error(t, err, "broadcast didn't fail")
+
+	// the signer reschedules the outbound with the checkpoint of the first attempt
+	tx.Checkpoint = checkpoint
+	signed, _, _, err = h.client.SignTx(tx, bridgeHeight)
+	require.NoError(t, err)
+	require.NotEmpty(t, signed, "rescheduled outbound not signed")
+	hash, err := h.client.BroadcastTx(tx, signed)
+	require.NoError(t, err)
+	h.waitTxs(t, hash)
+
+	signed, _, _, err = h.client.SignTx(tx, bridgeHeight)
+	require.NoError(t, err)
+	require.Empty(t, signed, "broadcast outbound signed again")
+}
+
+// testReorg checks an inbound of a reorged block is reported as errata
+func testReorg(t *testing.T, h *harness) {
+	if !h.node.Capabilities().Reorg {
+		t.Skip("client doesn't detect reorgs")
+	}
+	h.start(t)
+	vault := h.vaultAddress(t)
+
+	hash, err := h.node.Send(vault, h.coins(common.One), "ADD:"+h.chain().GetGasAsset().String())
+	require.NoError(t, err)
+	height := h.mine(t)
+	h.waitTxs(t, hash)
+	require.NoError(t, h.node.Reorg(height))
+
+	deadline := time.After(waitTimeout)
+	for {
+		select {
+		case errata := <-h.errata:
+			for _, tx := range errata.Txs {
+				if strings.EqualFold(tx.TxID.String(), hash) {
+					require.Equal(t, height, errata.Height)
+					require.Equal(t, h.chain(), tx.Chain)
+					return
+				}
+			}
+		case txIn := <-h.txs:
+			for _, item := range txIn.TxArray {
+				require.False(t, strings.EqualFold(item.Tx, hash), "reorged inbound reported again")
+			}
+		case <-time.After(mineInterval):
+			h.mine(t)
+		case <-deadline:
+			require.FailNow(t, "reorged inbound not reported as errata")
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package evm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+const (
+	// DexAggregatorsRefreshInterval is how often the dex aggregators registered on
+	// SWITCHLYChain are refreshed
+	DexAggregatorsRefreshInterval = time.Minute
+
+	// defaultDexAggregatorGasLimit is the gas limit of aggregator calls when no dex
+	// aggregator is registered for the chain, it matches SWITCHLYChain which lets any
+	// aggregator through in that case
+	defaultDexAggregatorGasLimit = 400_000
+)
+
+// DexAggregators keeps the dex aggregators of a chain registered on SWITCHLYChain, they
+// are refreshed at most once per DexAggregatorsRefreshInterval
+type DexAggregators struct {
+	chain       common.Chain
+	bridge      switchlyclient.SwitchlyBridge
+	logger      zerolog.Logger
+	lock        sync.Mutex
+	aggregators []stypes.DexAggregator
+	loaded      bool
+	lastRefresh time.Time
+}
+
+// NewDexAggregators create a new instance of DexAggregators for the given chain
+func NewDexAggregators(chain common.Chain, bridge switchlyclient.SwitchlyBridge) *DexAggregators {
+	return &DexAggregators{
+		chain:  chain,
+		bridge: bridge,
+		logger: log.Logger.With().Str("module", "dex_aggregators").Stringer("chain", chain).Logger(),
+	}
+}
+
+// get return the cached aggregators, refreshing them when they are stale. The previous
+// aggregators are kept when they fail to refresh.
+func (d *DexAggregators) get() ([]stypes.DexAggregator, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if time.Since(d.lastRefresh) < DexAggregatorsRefreshInterval {
+		return d.aggregators, d.loaded
+	}
+	d.lastRefresh = time.Now()
+	aggs, err := d.bridge.GetDexAggregators(d.chain)
+	if err != nil {
+		d.logger.Err(err).Msg("fail to get dex aggregators")
+		return d.aggregators, d.loaded
+	}
+	d.aggregators = aggs
+	d.loaded = true
+	return d.aggregators, d.loaded
+}
+
+// Contracts return the addresses of the enabled aggregators
+func (d *DexAggregators) Contracts() []common.Address {
+	aggs, _ := d.get()
+	addrs := make([]common.Address, 0, len(aggs))
+	for _, agg := range aggs {
+		if agg.Enabled {
+			addrs = append(addrs, common.Address(agg.Address))
+		}
+	}
+	return addrs
+}
+
+// GasLimit return the gas limit of the enabled aggregator that ends with the given
+// suffix
+func (d *DexAggregators) GasLimit(suffix string) (uint64, error) {
+	aggs, loaded := d.get()
+	if !loaded {
+		return 0, errors.New("dex aggregators are not loaded")
+	}
+	// If no aggregators are registered, fall through to the default gas limit
+	if len(aggs) == 0 {
+		return defaultDexAggregatorGasLimit, nil
+	}
+	for _, agg := range aggs {
+		if !strings.HasSuffix(strings.ToLower(agg.Address), strings.ToLower(suffix)) {
+			continue
+		}
+		if !agg.Enabled {
+			return 0, fmt.Errorf("%s aggregator is disabled", suffix)
+		}
+		return agg.GasLimit, nil
+	}
+	return 0, fmt.Errorf("%s aggregator not found", suffix)
+}
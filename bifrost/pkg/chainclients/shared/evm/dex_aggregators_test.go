@@ -0,0 +1,70 @@
+package evm
+
+import (
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+type DexAggregatorsTestSuite struct{}
+
+var _ = Suite(&DexAggregatorsTestSuite{})
+
+type dexAggregatorsBridge struct {
+	switchlyclient.SwitchlyBridge
+	aggregators []stypes.DexAggregator
+	err         error
+	calls       int
+}
+
+func (b *dexAggregatorsBridge) GetDexAggregators(chain common.Chain) ([]stypes.DexAggregator, error) {
+	b.calls++
+	return b.aggregators, b.err
+}
+
+func (s *DexAggregatorsTestSuite) TestDexAggregators(c *C) {
+	bridge := &dexAggregatorsBridge{err: errors.New("kaboom")}
+	aggs := NewDexAggregators(common.ETHChain, bridge)
+
+	// never loaded
+	_, err := aggs.GasLimit("2f2386f3848")
+	c.Assert(err, NotNil)
+	c.Check(aggs.Contracts(), HasLen, 0)
+	c.Check(bridge.calls, Equals, 1)
+
+	// no aggregators registered, fall through to the default gas limit
+	bridge.err = nil
+	aggs.lastRefresh = time.Time{}
+	gasLimit, err := aggs.GasLimit("whatever")
+	c.Assert(err, IsNil)
+	c.Check(gasLimit, Equals, uint64(defaultDexAggregatorGasLimit))
+
+	bridge.aggregators = []stypes.DexAggregator{
+		stypes.NewDexAggregator(common.ETHChain, "0x69800327b38A4CeF30367Dec3f64c2f2386f3848", 500_000, true, ""),
+		stypes.NewDexAggregator(common.ETHChain, "0xd31f7e39afECEc4855fecc51b693F9A0Cec49fd2", 400_000, false, ""),
+	}
+	// cached until the refresh interval passed
+	c.Check(aggs.Contracts(), HasLen, 0)
+	aggs.lastRefresh = time.Time{}
+	contracts := aggs.Contracts()
+	c.Assert(contracts, HasLen, 1)
+	c.Check(contracts[0].String(), Equals, "0x69800327b38A4CeF30367Dec3f64c2f2386f3848")
+
+	gasLimit, err = aggs.GasLimit("0x69800327b38a4cef30367dec3f64c2f2386f3848")
+	c.Assert(err, IsNil)
+	c.Check(gasLimit, Equals, uint64(500_000))
+	_, err = aggs.GasLimit("0xd31f7e39afECEc4855fecc51b693F9A0Cec49fd2")
+	c.Check(err, ErrorMatches, ".*aggregator is disabled")
+	_, err = aggs.GasLimit("whatever")
+	c.Check(err, ErrorMatches, ".*aggregator not found")
+
+	// keep the previous aggregators when they fail to refresh
+	bridge.err = errors.New("kaboom")
+	aggs.lastRefresh = time.Time{}
+	c.Check(aggs.Contracts(), HasLen, 1)
+}
@@ -157,6 +157,51 @@ func (scp *SmartContractLogParser) parseTransferOutAndCall(log etypes.Log) (*SWI
 	return event, nil
 }
 
+// GetBatchTxInItems decomposes the TransferOut events of a router batchTransferOut call
+// into one item per outbound, copies of the given item with the recipient, memo and coin
+// of their event. Every event of a batch carries a batch outbound memo, nil is returned
+// for logs of any other call.
+func (scp *SmartContractLogParser) GetBatchTxInItems(logs []*etypes.Log, txInItem types.TxInItem) ([]*types.TxInItem, error) {
+	if int(scp.maxLogs) > 0 && len(logs) > int(scp.maxLogs) {
+		scp.logger.Info().Msgf("tx logs are too many, ignore")
+		return nil, nil
+	}
+	var items []*types.TxInItem
+	for _, item := range logs {
+		// only events produced by SWITCHLYChain router is processed
+		if !scp.addressValidator(&item.Address, false) {
+			continue
+		}
+		if len(item.Topics) == 0 || item.Topics[0].String() != transferOutEvent {
+			continue
+		}
+		transferOutEvt, err := scp.parseTransferOut(*item)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse transfer out event: %w", err)
+		}
+		m, err := memo.ParseMemo(common.LatestVersion, transferOutEvt.Memo)
+		if err != nil || !m.IsType(memo.TxBatchOutbound) {
+			return nil, nil
+		}
+		asset, err := scp.assetResolver(transferOutEvt.Asset.String())
+		if err != nil {
+			return nil, fmt.Errorf("fail to get asset from token address: %w", err)
+		}
+		if asset.IsEmpty() {
+			return nil, nil
+		}
+		decimals := scp.decimalResolver(transferOutEvt.Asset.String())
+		out := txInItem
+		out.To = transferOutEvt.To.String()
+		out.Memo = transferOutEvt.Memo
+		out.Coins = common.NewCoins(
+			common.NewCoin(asset, scp.amtConverter(transferOutEvt.Asset.String(), transferOutEvt.Amount)).WithDecimals(decimals),
+		)
+		items = append(items, &out)
+	}
+	return items, nil
+}
+
 func (scp *SmartContractLogParser) GetTxInItem(logs []*etypes.Log, txInItem *types.TxInItem) (bool, error) {
 	if len(logs) == 0 {
 		scp.logger.Info().Msg("tx logs are empty return nil")
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	ecommon "github.com/ethereum/go-ethereum/common"
@@ -15,6 +16,8 @@ import (
 	. "gopkg.in/check.v1"
 )
 
+func TestPackage(t *testing.T) { TestingT(t) }
+
 type SmartContractLogParserTestSuite struct {
 	abi *abi.ABI
 }
@@ -322,6 +325,57 @@ func (t *SmartContractLogParserTestSuite) TestGetTxInItem_TransferOutEvents(c *C
 	c.Assert(txInItem.Coins.IsEmpty(), Equals, true)
 }
 
+func (t *SmartContractLogParserTestSuite) TestGetBatchTxInItems(c *C) {
+	vaultABI, _, err := GetContractABI(routerContractABI, erc20ContractABI)
+	c.Assert(err, IsNil)
+	parser := NewSmartContractLogParser(mockIsValidContractAddr, mockAssetResolver, mockTokenDecimalResolver, mockAmountConverter, vaultABI, common.ETHAsset, 0)
+	router := "0xE65e9d372F8cAcc7b6dfcd4af6507851Ed31bb44"
+	vault := "0x6c4a2eeb8531e3c18bca51104df7eb2377708263"
+	txInItem := types.TxInItem{
+		Tx:     types2.GetRandomTxHash().String(),
+		Sender: vault,
+	}
+
+	// every event of a batch call is an item of its own
+	items, err := parser.GetBatchTxInItems([]*etypes.Log{
+		t.getTransferOutEvent(router, vault, "0x9eca25ee04fdcc9d9cdff377aa8da019dba38437", NativeTokenAddr, big.NewInt(1024000), "BATCH:10"),
+		t.getTransferOutEvent(router, vault, "0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473", tknTestToken, big.NewInt(2048000), "BATCH:10:1"),
+	}, txInItem)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].Tx, Equals, txInItem.Tx)
+	c.Check(items[0].Sender, Equals, vault)
+	c.Check(items[0].Memo, Equals, "BATCH:10")
+	c.Check(strings.EqualFold(items[0].To, "0x9eca25ee04fdcc9d9cdff377aa8da019dba38437"), Equals, true)
+	c.Check(items[0].Coins.EqualsEx(common.NewCoins(common.NewCoin(common.ETHAsset, cosmos.NewUint(1024000)))), Equals, true)
+	c.Check(items[1].Tx, Equals, txInItem.Tx)
+	c.Check(items[1].Memo, Equals, "BATCH:10:1")
+	c.Check(strings.EqualFold(items[1].To, "0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473"), Equals, true)
+	c.Check(items[1].Coins[0].Asset.Symbol.String(), Equals, "TKN-0X3B7FA4DD21C6F9BA3CA375217EAD7CAB9D6BF483")
+
+	// events of other routers are ignored
+	items, err = parser.GetBatchTxInItems([]*etypes.Log{
+		t.getTransferOutEvent(router, vault, "0x9eca25ee04fdcc9d9cdff377aa8da019dba38437", NativeTokenAddr, big.NewInt(1024000), "BATCH:10"),
+		t.getTransferOutEvent("0x3fd2D4cE97B082d4BcE3f9fee2A3D60668D2f473", vault, "0x9eca25ee04fdcc9d9cdff377aa8da019dba38437", NativeTokenAddr, big.NewInt(1024000), "BATCH:10:1"),
+	}, txInItem)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+
+	// a plain transfer out is not a batch
+	items, err = parser.GetBatchTxInItems([]*etypes.Log{
+		t.getTransferOutEvent(router, vault, "0x9eca25ee04fdcc9d9cdff377aa8da019dba38437", NativeTokenAddr, big.NewInt(1024000), "OUT:"+types2.GetRandomTxHash().String()),
+	}, txInItem)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+
+	// invalid asset should result in an error
+	items, err = parser.GetBatchTxInItems([]*etypes.Log{
+		t.getTransferOutEvent(router, vault, "0x9eca25ee04fdcc9d9cdff377aa8da019dba38437", errAssetToken, big.NewInt(1024000), "BATCH:10"),
+	}, txInItem)
+	c.Assert(err, NotNil)
+	c.Assert(items, HasLen, 0)
+}
+
 func (t *SmartContractLogParserTestSuite) TestGetTxInItem_TransferAllowance(c *C) {
 	vaultABI, _, err := GetContractABI(routerContractABI, erc20ContractABI)
 	c.Assert(err, IsNil)
@@ -8,6 +8,8 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/util"
+
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 )
 
 // -------------------------------------------------------------------------------------
@@ -31,6 +33,11 @@ const (
 	// PrefixObservedTx is the LevelDB key prefix used for storing observed transactions.
 	// The hash of the transaction is appended for the final key.
 	PrefixObservedTx = "observed-"
+
+	// PrefixSignedTx is the LevelDB key prefix used for storing outbounds our vaults have
+	// broadcast and are not yet committed. The hash of the transaction is appended for
+	// the final key.
+	PrefixSignedTx = "signedtx-"
 )
 
 // -------------------------------------------------------------------------------------
@@ -51,6 +58,22 @@ type TransactionFee struct {
 	VSize int32 `json:"v_size"`
 }
 
+// SignedTx represents an outbound transaction broadcast by our vaults, it is kept until
+// the transaction is committed so the fee can be bumped if it is stuck in the mempool.
+type SignedTx struct {
+	// TxID is the hash of the transaction.
+	TxID string `json:"txid"`
+
+	// Height is the Switchly height the transaction was broadcast at.
+	Height int64 `json:"height"`
+
+	// Tx is the serialized signed transaction.
+	Tx []byte `json:"tx"`
+
+	// TxOutItems are the outbounds paid by the transaction, more than one for a batch.
+	TxOutItems []stypes.TxOutItem `json:"tx_out_items"`
+}
+
 // -------------------------------------------------------------------------------------
 // TemporalStorage
 // -------------------------------------------------------------------------------------
@@ -256,6 +279,39 @@ func (t *TemporalStorage) UntrackObservedTx(txid string) error {
 	return t.db.Delete([]byte(key), nil)
 }
 
+// AddSignedTx stores the provided signed transaction, overwriting any existing value.
+func (t *TemporalStorage) AddSignedTx(signedTx SignedTx) error {
+	buf, err := json.Marshal(signedTx)
+	if err != nil {
+		return fmt.Errorf("fail to marshal signed tx to json: %w", err)
+	}
+	return t.db.Put([]byte(t.getSignedTxKey(signedTx.TxID)), buf, nil)
+}
+
+// RemoveSignedTx removes the signed transaction with the provided txid.
+func (t *TemporalStorage) RemoveSignedTx(txid string) error {
+	return t.db.Delete([]byte(t.getSignedTxKey(txid)), nil)
+}
+
+// GetSignedTxs returns all the signed transactions in storage.
+func (t *TemporalStorage) GetSignedTxs() ([]SignedTx, error) {
+	signedTxs := make([]SignedTx, 0)
+	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixSignedTx)), nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		buf := iterator.Value()
+		if len(buf) == 0 {
+			continue
+		}
+		var signedTx SignedTx
+		if err := json.Unmarshal(buf, &signedTx); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal signed tx: %w", err)
+		}
+		signedTxs = append(signedTxs, signedTx)
+	}
+	return signedTxs, nil
+}
+
 // ------------------------------ internal ------------------------------
 
 func (t *TemporalStorage) getBlockMetaKey(height int64) string {
@@ -269,3 +325,7 @@ func (t *TemporalStorage) getMemPoolKey(txid string) string {
 func (t *TemporalStorage) getObservedTxKey(txid string) string {
 	return PrefixObservedTx + txid
 }
+
+func (t *TemporalStorage) getSignedTxKey(txid string) string {
+	return PrefixSignedTx + txid
+}
@@ -80,3 +80,36 @@ func (s *BitcoinTemporalStorageTestSuite) TestTemporalStorage(c *C) {
 	c.Assert(vSize, Equals, int32(1))
 	c.Assert(db.Close(), IsNil)
 }
+
+func (s *BitcoinTemporalStorageTestSuite) TestSignedTx(c *C) {
+	memStorage := storage.NewMemStorage()
+	db, err := leveldb.Open(memStorage, nil)
+	c.Assert(err, IsNil)
+	store, err := NewTemporalStorage(db, 0)
+	c.Assert(err, IsNil)
+
+	signedTxs, err := store.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 0)
+
+	hash1 := switchly.GetRandomTxHash().String()
+	hash2 := switchly.GetRandomTxHash().String()
+	c.Assert(store.AddSignedTx(SignedTx{TxID: hash1, Height: 10, Tx: []byte{1, 2}}), IsNil)
+	c.Assert(store.AddSignedTx(SignedTx{TxID: hash2, Height: 11}), IsNil)
+	c.Assert(store.AddSignedTx(SignedTx{TxID: hash1, Height: 12, Tx: []byte{1, 2}}), IsNil)
+	signedTxs, err = store.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 2)
+
+	c.Assert(store.RemoveSignedTx(hash2), IsNil)
+	signedTxs, err = store.GetSignedTxs()
+	c.Assert(err, IsNil)
+	c.Assert(signedTxs, HasLen, 1)
+	c.Assert(signedTxs[0].TxID, Equals, hash1)
+	c.Assert(signedTxs[0].Height, Equals, int64(12))
+	c.Assert(signedTxs[0].Tx, DeepEquals, []byte{1, 2})
+
+	// removing a transaction that is not stored is not an error
+	c.Assert(store.RemoveSignedTx(hash2), IsNil)
+	c.Assert(db.Close(), IsNil)
+}
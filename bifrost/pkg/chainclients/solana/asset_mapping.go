@@ -0,0 +1,126 @@
+package solana
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+)
+
+// SolanaAssetMapping maps a Solana asset to a SwitchlyProtocol asset
+type SolanaAssetMapping struct {
+	// Mint is the address of the SPL token mint, empty for native SOL
+	Mint string
+	// SolanaDecimals is the decimal precision of the asset on Solana
+	SolanaDecimals int64
+	// SwitchlyAsset is the SwitchlyProtocol representation of the asset
+	SwitchlyAsset common.Asset
+}
+
+// solanaAssetMappings maps the Solana assets to SWITCHLYChain assets and provides the asset decimals
+// CHANGEME: define assets that should be observed by SWITCHLYChain here. This also acts a whitelist.
+var solanaAssetMappings = []SolanaAssetMapping{
+	{
+		Mint:           "",
+		SolanaDecimals: 9,
+		SwitchlyAsset:  common.SOLAsset,
+	},
+	{
+		Mint:           "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		SolanaDecimals: 6,
+		SwitchlyAsset: common.Asset{
+			Chain:  common.SolanaChain,
+			Symbol: "USDC-EPJFWDD5AUFQSSQEM2QN1XZYBAPC8G4WEGGKZWYTDT1V",
+			Ticker: "USDC",
+		},
+	},
+	{
+		Mint:           "Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB",
+		SolanaDecimals: 6,
+		SwitchlyAsset: common.Asset{
+			Chain:  common.SolanaChain,
+			Symbol: "USDT-ES9VMFRZACERMJFRF4H2FYD4KCONKY11MCCE8BENWNYB",
+			Ticker: "USDT",
+		},
+	},
+}
+
+// GetAssetByMint finds the asset mapping of an SPL token by its mint address, an empty
+// mint returns the native SOL mapping
+func GetAssetByMint(mint string) (SolanaAssetMapping, bool) {
+	for _, mapping := range solanaAssetMappings {
+		if strings.EqualFold(mapping.Mint, mint) {
+			return mapping, true
+		}
+	}
+	return SolanaAssetMapping{}, false
+}
+
+// GetAssetBySwitchlyAsset finds the asset mapping by SwitchlyAsset
+func GetAssetBySwitchlyAsset(asset common.Asset) (SolanaAssetMapping, bool) {
+	for _, mapping := range solanaAssetMappings {
+		if asset.Equals(mapping.SwitchlyAsset) {
+			return mapping, true
+		}
+	}
+	return SolanaAssetMapping{}, false
+}
+
+// AddAssetMapping adds a new asset mapping (useful for dynamic asset registration)
+func AddAssetMapping(mapping SolanaAssetMapping) {
+	solanaAssetMappings = append(solanaAssetMappings, mapping)
+}
+
+// GetAllAssetMappings returns all configured asset mappings
+func GetAllAssetMappings() []SolanaAssetMapping {
+	return solanaAssetMappings
+}
+
+// IsNative checks if the mapping is the native SOL asset
+func (m SolanaAssetMapping) IsNative() bool {
+	return m.Mint == ""
+}
+
+// ConvertToSwitchlyAmount converts an amount in the asset base units on Solana to a coin
+// in SWITCHLYChain decimals
+func (m SolanaAssetMapping) ConvertToSwitchlyAmount(amount uint64) common.Coin {
+	value := new(big.Int).SetUint64(amount)
+	var exp big.Int
+	if m.SolanaDecimals > common.SwitchlyDecimals {
+		// Decimals are more than native SWITCHLYChain, so divide...
+		decimalDiff := m.SolanaDecimals - common.SwitchlyDecimals
+		value.Quo(value, exp.Exp(big.NewInt(10), big.NewInt(decimalDiff), nil))
+	} else if m.SolanaDecimals < common.SwitchlyDecimals {
+		// Decimals are less than native SWITCHLYChain, so multiply...
+		decimalDiff := common.SwitchlyDecimals - m.SolanaDecimals
+		value.Mul(value, exp.Exp(big.NewInt(10), big.NewInt(decimalDiff), nil))
+	}
+	return common.Coin{
+		Asset:    m.SwitchlyAsset,
+		Amount:   sdkmath.NewUintFromBigInt(value),
+		Decimals: m.SolanaDecimals,
+	}
+}
+
+// ConvertFromSwitchlyAmount converts an amount in SWITCHLYChain decimals to the asset base
+// units on Solana
+func (m SolanaAssetMapping) ConvertFromSwitchlyAmount(amount sdkmath.Uint) (uint64, error) {
+	value := amount.BigInt()
+	var exp big.Int
+	if m.SolanaDecimals > common.SwitchlyDecimals {
+		// Decimals are more than native SWITCHLYChain, so multiply...
+		decimalDiff := m.SolanaDecimals - common.SwitchlyDecimals
+		value.Mul(value, exp.Exp(big.NewInt(10), big.NewInt(decimalDiff), nil))
+	} else if m.SolanaDecimals < common.SwitchlyDecimals {
+		// Decimals are less than native SWITCHLYChain, so divide...
+		decimalDiff := common.SwitchlyDecimals - m.SolanaDecimals
+		value.Quo(value, exp.Exp(big.NewInt(10), big.NewInt(decimalDiff), nil))
+	}
+	if !value.IsUint64() {
+		return 0, fmt.Errorf("amount %s of %s overflows", amount, m.SwitchlyAsset)
+	}
+	return value.Uint64(), nil
+}
@@ -0,0 +1,80 @@
+package solana
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	. "gopkg.in/check.v1"
+)
+
+type AssetMappingTestSuite struct{}
+
+var _ = Suite(&AssetMappingTestSuite{})
+
+func (s *AssetMappingTestSuite) TestGetAsset(c *C) {
+	sol, ok := GetAssetByMint("")
+	c.Assert(ok, Equals, true)
+	c.Check(sol.IsNative(), Equals, true)
+	c.Check(sol.SwitchlyAsset.Equals(common.SOLAsset), Equals, true)
+
+	// mints are matched case insensitively, as SWITCHLYChain symbols are upper case
+	usdc, ok := GetAssetByMint("EPJFWDD5AUFQSSQEM2QN1XZYBAPC8G4WEGGKZWYTDT1V")
+	c.Assert(ok, Equals, true)
+	c.Check(usdc.IsNative(), Equals, false)
+	c.Check(usdc.SolanaDecimals, Equals, int64(6))
+	c.Check(usdc.SwitchlyAsset.String(), Equals, "SOL.USDC-EPJFWDD5AUFQSSQEM2QN1XZYBAPC8G4WEGGKZWYTDT1V")
+
+	mapping, ok := GetAssetBySwitchlyAsset(usdc.SwitchlyAsset)
+	c.Assert(ok, Equals, true)
+	c.Check(mapping.Mint, Equals, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	_, ok = GetAssetByMint("So11111111111111111111111111111111111111112")
+	c.Check(ok, Equals, false)
+	_, ok = GetAssetBySwitchlyAsset(common.XRPAsset)
+	c.Check(ok, Equals, false)
+}
+
+func (s *AssetMappingTestSuite) TestAddAssetMapping(c *C) {
+	mappings := GetAllAssetMappings()
+	defer func() { solanaAssetMappings = mappings }()
+
+	asset, err := common.NewAsset("SOL.BONK-DEZXAZ8Z7PNRNRJJZ3WXBORGIXCA6XJNB7YAB1PPB263")
+	c.Assert(err, IsNil)
+	AddAssetMapping(SolanaAssetMapping{
+		Mint:           "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263",
+		SolanaDecimals: 5,
+		SwitchlyAsset:  asset,
+	})
+	c.Check(GetAllAssetMappings(), HasLen, len(mappings)+1)
+	mapping, ok := GetAssetByMint("DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263")
+	c.Assert(ok, Equals, true)
+	c.Check(mapping.SwitchlyAsset.Equals(asset), Equals, true)
+}
+
+func (s *AssetMappingTestSuite) TestConvertAmount(c *C) {
+	sol, _ := GetAssetBySwitchlyAsset(common.SOLAsset)
+	usdc, _ := GetAssetByMint("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	// 1.5 SOL, 9 decimals
+	coin := sol.ConvertToSwitchlyAmount(1_500_000_000)
+	c.Check(coin.Asset.Equals(common.SOLAsset), Equals, true)
+	c.Check(coin.Amount.Uint64(), Equals, uint64(150_000_000))
+	c.Check(coin.Decimals, Equals, int64(9))
+	// below 1e-8 SOL is truncated
+	c.Check(sol.ConvertToSwitchlyAmount(9).Amount.IsZero(), Equals, true)
+
+	lamports, err := sol.ConvertFromSwitchlyAmount(sdkmath.NewUint(150_000_000))
+	c.Assert(err, IsNil)
+	c.Check(lamports, Equals, uint64(1_500_000_000))
+
+	// 2.5 USDC, 6 decimals
+	coin = usdc.ConvertToSwitchlyAmount(2_500_000)
+	c.Check(coin.Amount.Uint64(), Equals, uint64(250_000_000))
+	c.Check(coin.Decimals, Equals, int64(6))
+	amount, err := usdc.ConvertFromSwitchlyAmount(sdkmath.NewUint(250_000_099))
+	c.Assert(err, IsNil)
+	c.Check(amount, Equals, uint64(2_500_000))
+
+	_, err = sol.ConvertFromSwitchlyAmount(sdkmath.NewUintFromString("100000000000000000000"))
+	c.Check(err, NotNil)
+}
@@ -0,0 +1,366 @@
+package solana
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/mr-tron/base58"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/blockscanner"
+	btypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/blockscanner/types"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/metrics"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+// SolvencyReporter is to report solvency info to SWITCHLYNode
+type SolvencyReporter func(int64) error
+
+const (
+	// FeeUpdatePeriodBlocks is the slot interval at which we report fee changes.
+	FeeUpdatePeriodBlocks = 150
+
+	// FeeCacheTransactions is the number of transactions over which we compute an average
+	// (mean) fee price to use for outbound transactions. Note that only transactions
+	// transferring a whitelisted asset will be considered.
+	FeeCacheTransactions = 200
+)
+
+// SolanaBlockScanner is to scan the finalized slots
+type SolanaBlockScanner struct {
+	cfg              config.BifrostBlockScannerConfiguration
+	logger           zerolog.Logger
+	db               blockscanner.ScannerStorage
+	bridge           switchlyclient.SwitchlyBridge
+	solvencyReporter SolvencyReporter
+	rpc              *RPCClient
+
+	globalNetworkFeeQueue chan common.NetworkFee
+
+	// feeCache contains a rolling window of the fees paid by the observed transfers.
+	// Fees are stored at 1/10 the values on the observed chain due to compensate for the
+	// difference in base chain decimals (switchly:1e8, solana:1e9).
+	feeCache []sdkmath.Uint
+	lastFee  sdkmath.Uint
+}
+
+// NewSolanaBlockScanner create a new instance of BlockScan
+func NewSolanaBlockScanner(rpcHost string,
+	cfg config.BifrostBlockScannerConfiguration,
+	scanStorage blockscanner.ScannerStorage,
+	bridge switchlyclient.SwitchlyBridge,
+	m *metrics.Metrics,
+	solvencyReporter SolvencyReporter,
+) (*SolanaBlockScanner, error) {
+	if scanStorage == nil {
+		return nil, errors.New("scanStorage is nil")
+	}
+	if m == nil {
+		return nil, errors.New("metrics is nil")
+	}
+
+	logger := log.Logger.With().Str("module", "blockscanner").Str("chain", cfg.ChainID.String()).Logger()
+
+	return &SolanaBlockScanner{
+		cfg:              cfg,
+		logger:           logger,
+		db:               scanStorage,
+		rpc:              NewRPCClient(rpcHost),
+		feeCache:         make([]sdkmath.Uint, 0),
+		lastFee:          sdkmath.NewUint(0),
+		bridge:           bridge,
+		solvencyReporter: solvencyReporter,
+	}, nil
+}
+
+// GetHeight returns the highest finalized slot, slots are used as block heights
+func (c *SolanaBlockScanner) GetHeight() (int64, error) {
+	slot, err := c.rpc.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	return int64(slot), nil
+}
+
+// FetchMemPool returns nothing since we are only concerned about finalized transactions in Solana
+func (c *SolanaBlockScanner) FetchMemPool(height int64) (types.TxIn, error) {
+	return types.TxIn{}, nil
+}
+
+// GetNetworkFee returns current chain network fee according to Bifrost.
+func (c *SolanaBlockScanner) GetNetworkFee() (transactionSize, transactionFeeRate uint64) {
+	return 1, c.lastFee.Uint64()
+}
+
+func (c *SolanaBlockScanner) updateFeeCache(fee common.Coin) {
+	// sanity check to ensure fee is non-zero
+	err := fee.Valid()
+	if err != nil {
+		c.logger.Err(err).Interface("fee", fee).Msg("transaction with zero fee")
+		return
+	}
+
+	// add the fee to our cache
+	c.feeCache = append(c.feeCache, fee.Amount)
+
+	// truncate fee prices older than our max cached transactions
+	if len(c.feeCache) > FeeCacheTransactions {
+		c.feeCache = c.feeCache[(len(c.feeCache) - FeeCacheTransactions):]
+	}
+}
+
+func (c *SolanaBlockScanner) averageFee() sdkmath.Uint {
+	// avoid divide by zero
+	if len(c.feeCache) == 0 {
+		return sdkmath.NewUint(0)
+	}
+
+	// compute mean
+	sum := sdkmath.NewUint(0)
+	for _, val := range c.feeCache {
+		sum = sum.Add(val)
+	}
+	mean := sum.Quo(sdkmath.NewUint(uint64(len(c.feeCache))))
+
+	return mean
+}
+
+func (c *SolanaBlockScanner) updateFees(height int64) error {
+	// post the gas fee over every cache period when we have a full gas cache
+	if height%FeeUpdatePeriodBlocks == 0 && len(c.feeCache) == FeeCacheTransactions {
+		avgFee := c.averageFee()
+
+		// sanity check the fee is not zero
+		if avgFee.IsZero() {
+			return errors.New("suggested gas fee was zero")
+		}
+
+		// skip fee update if it has not changed
+		if c.lastFee.Equal(avgFee) {
+			return nil
+		}
+
+		// the flat fee model posts the fee per transaction as the rate of a transaction of size 1
+		txSize, txRate := feemodel.Get(c.cfg.ChainID).NetworkFee(feemodel.Observation{Rate: avgFee.Uint64()})
+		c.globalNetworkFeeQueue <- common.NetworkFee{
+			Chain:           c.cfg.ChainID,
+			Height:          height,
+			TransactionSize: txSize,
+			TransactionRate: txRate,
+		}
+
+		c.lastFee = avgFee
+		c.logger.Info().
+			Uint64("fee", avgFee.Uint64()).
+			Int64("height", height).
+			Msg("sent network fee to SWITCHLYChain")
+	}
+
+	return nil
+}
+
+// FetchTxs returns the transfers of the finalized block of the slot. A skipped slot has
+// no block and is returned as an empty TxIn.
+func (c *SolanaBlockScanner) FetchTxs(height, chainHeight int64) (types.TxIn, error) {
+	txIn := types.TxIn{
+		Chain:    c.cfg.ChainID,
+		TxArray:  nil,
+		Filtered: false,
+		MemPool:  false,
+	}
+
+	block, err := c.rpc.GetBlock(uint64(height))
+	switch {
+	case err == nil:
+		txIn.TxArray = c.processTxs(height, block.Transactions)
+	case IsRPCError(err, RPCErrSlotSkipped), IsRPCError(err, RPCErrLongTermStorageSlotSkipped):
+		c.logger.Debug().Int64("height", height).Msg("skipped slot")
+	case IsRPCError(err, RPCErrBlockNotAvailable):
+		return types.TxIn{}, btypes.ErrUnavailableBlock
+	default:
+		return types.TxIn{}, fmt.Errorf("fail to get block %d: %w", height, err)
+	}
+
+	// skip reporting network fee and solvency if block more than flexibility blocks from tip
+	if chainHeight-height > c.cfg.ObservationFlexibilityBlocks {
+		return txIn, nil
+	}
+
+	err = c.updateFees(height)
+	if err != nil {
+		c.logger.Err(err).Int64("height", height).Msg("unable to update network fee")
+	}
+
+	if err = c.solvencyReporter(height); err != nil {
+		c.logger.Err(err).Msg("fail to send solvency to SWITCHLYChain")
+	}
+
+	return txIn, nil
+}
+
+func (c *SolanaBlockScanner) processTxs(height int64, txs []BlockTransaction) []*types.TxInItem {
+	var txIn []*types.TxInItem
+	for _, tx := range txs {
+		// Ignore failed transactions, they only paid the fee
+		if tx.Meta == nil || tx.Meta.Err != nil || len(tx.Transaction.Signatures) == 0 {
+			continue
+		}
+
+		ctxLog := c.logger.Info().Str("tx", tx.Transaction.Signatures[0])
+		item, err := parseTransaction(tx)
+		if err != nil {
+			ctxLog.AnErr("reason", err).Msg("skipping tx")
+			continue
+		}
+		if item == nil {
+			// This was not a transfer tx
+			continue
+		}
+
+		native, _ := GetAssetByMint("")
+		fee := native.ConvertToSwitchlyAmount(tx.Meta.Fee)
+		c.updateFeeCache(fee)
+
+		item.BlockHeight = height
+		item.Gas = common.Gas{fee}
+		txIn = append(txIn, item)
+	}
+	return txIn
+}
+
+// transfer is a transfer of a whitelisted asset parsed from an instruction
+type transfer struct {
+	from, to string
+	coin     common.Coin
+}
+
+// parseTransaction returns the transfers of a transaction as a TxInItem, or nil when it
+// has none. Transfers are keyed on the first one: only the transfers between the same
+// sender and recipient are added up, as a TxInItem has a single sender and recipient.
+func parseTransaction(tx BlockTransaction) (*types.TxInItem, error) {
+	keys := tx.Transaction.Message.AccountKeys
+	if tx.Meta.LoadedAddresses != nil {
+		// the addresses loaded from lookup tables by versioned transactions follow the
+		// static keys, the writable ones first
+		keys = append(append(append([]string{}, keys...), tx.Meta.LoadedAddresses.Writable...), tx.Meta.LoadedAddresses.Readonly...)
+	}
+	account := func(ix RPCInstruction, i int) (string, error) {
+		if i >= len(ix.Accounts) || ix.Accounts[i] < 0 || ix.Accounts[i] >= len(keys) {
+			return "", fmt.Errorf("instruction account %d out of range", i)
+		}
+		return keys[ix.Accounts[i]], nil
+	}
+
+	var memo string
+	var transfers []transfer
+	for _, ix := range tx.Transaction.Message.Instructions {
+		if ix.ProgramIDIndex < 0 || ix.ProgramIDIndex >= len(keys) {
+			return nil, fmt.Errorf("program index %d out of range", ix.ProgramIDIndex)
+		}
+		data, err := base58.Decode(ix.Data)
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode instruction data: %w", err)
+		}
+
+		switch keys[ix.ProgramIDIndex] {
+		case MemoProgramID.String(), MemoV1ProgramID.String():
+			memo = string(data)
+
+		case SystemProgramID.String():
+			if len(data) != 12 || binary.LittleEndian.Uint32(data) != systemInstructionTransfer {
+				continue
+			}
+			var t transfer
+			if t.from, err = account(ix, 0); err != nil {
+				return nil, err
+			}
+			if t.to, err = account(ix, 1); err != nil {
+				return nil, err
+			}
+			native, _ := GetAssetByMint("")
+			t.coin = native.ConvertToSwitchlyAmount(binary.LittleEndian.Uint64(data[4:]))
+			transfers = append(transfers, t)
+
+		case TokenProgramID.String():
+			var t transfer
+			var dst int
+			switch {
+			case len(data) == 9 && data[0] == tokenInstructionTransfer:
+				dst = 1
+				t.from, err = account(ix, 2)
+			case len(data) == 10 && data[0] == tokenInstructionTransferChecked:
+				dst = 2
+				t.from, err = account(ix, 3)
+			default:
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if dst >= len(ix.Accounts) {
+				return nil, fmt.Errorf("instruction account %d out of range", dst)
+			}
+			// the recipient is the owner of the destination token account, the mint and
+			// owner of which are reported in the token balances of the transaction
+			balance, ok := tokenBalance(tx.Meta.PostTokenBalances, ix.Accounts[dst])
+			if !ok {
+				return nil, fmt.Errorf("no token balance for account %d", ix.Accounts[dst])
+			}
+			mapping, ok := GetAssetByMint(balance.Mint)
+			if !ok || mapping.IsNative() {
+				// not a whitelisted token
+				continue
+			}
+			t.to = balance.Owner
+			t.coin = mapping.ConvertToSwitchlyAmount(binary.LittleEndian.Uint64(data[1:9]))
+			transfers = append(transfers, t)
+		}
+	}
+
+	if len(transfers) == 0 {
+		return nil, nil
+	}
+
+	coins := common.Coins{}
+	for _, t := range transfers {
+		if t.from != transfers[0].from || t.to != transfers[0].to {
+			continue
+		}
+		coins = coins.Add(t.coin)
+	}
+
+	txID, err := SignatureToTxID(tx.Transaction.Signatures[0])
+	if err != nil {
+		return nil, err
+	}
+	return &types.TxInItem{
+		Tx:     txID,
+		Memo:   memo,
+		Sender: transfers[0].from,
+		To:     transfers[0].to,
+		Coins:  coins,
+	}, nil
+}
+
+func tokenBalance(balances []TokenBalance, accountIndex int) (TokenBalance, bool) {
+	for _, balance := range balances {
+		if balance.AccountIndex == accountIndex {
+			return balance, true
+		}
+	}
+	return TokenBalance{}, false
+}
+
+// parseTokenAmount parses an amount of token base units
+func parseTokenAmount(amount TokenAmount) (uint64, error) {
+	return strconv.ParseUint(amount.Amount, 10, 64)
+}
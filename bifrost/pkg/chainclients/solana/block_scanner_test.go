@@ -0,0 +1,268 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/mr-tron/base58"
+	"github.com/rs/zerolog/log"
+
+	btypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/blockscanner/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	. "gopkg.in/check.v1"
+)
+
+type BlockScannerTestSuite struct {
+	from, to, mint, usdc PublicKey
+	fromATA, toATA       PublicKey
+}
+
+var _ = Suite(&BlockScannerTestSuite{})
+
+func (s *BlockScannerTestSuite) SetUpTest(c *C) {
+	s.from, _ = newTestKey(c)
+	s.to, _ = newTestKey(c)
+	s.mint, _ = newTestKey(c)
+	s.usdc = MustPublicKey("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	s.fromATA, _ = newTestKey(c)
+	s.toATA, _ = newTestKey(c)
+}
+
+func newTestScanner() *SolanaBlockScanner {
+	return &SolanaBlockScanner{
+		cfg: config.BifrostBlockScannerConfiguration{
+			ChainID:                      common.SolanaChain,
+			ObservationFlexibilityBlocks: 10,
+		},
+		logger:           log.Logger,
+		feeCache:         make([]sdkmath.Uint, 0),
+		lastFee:          sdkmath.NewUint(0),
+		solvencyReporter: func(int64) error { return nil },
+	}
+}
+
+// newRPCTx returns a successful tx of the given keys and instructions, as returned by getBlock
+func newRPCTx(c *C, keys []PublicKey, instructions ...RPCInstruction) BlockTransaction {
+	sig := make([]byte, SignatureSize)
+	_, err := rand.Read(sig)
+	c.Assert(err, IsNil)
+	tx := BlockTransaction{
+		Transaction: RPCTransaction{
+			Signatures: []string{base58.Encode(sig)},
+			Message:    RPCMessage{Instructions: instructions},
+		},
+		Meta: &TransactionMeta{Fee: 5000},
+	}
+	for _, key := range keys {
+		tx.Transaction.Message.AccountKeys = append(tx.Transaction.Message.AccountKeys, key.String())
+	}
+	return tx
+}
+
+func systemTransfer(from, to int, lamports uint64) RPCInstruction {
+	data := binary.LittleEndian.AppendUint32(nil, systemInstructionTransfer)
+	data = binary.LittleEndian.AppendUint64(data, lamports)
+	return RPCInstruction{ProgramIDIndex: 0, Accounts: []int{from, to}, Data: base58.Encode(data)}
+}
+
+func memoInstruction(program int, memo string) RPCInstruction {
+	return RPCInstruction{ProgramIDIndex: program, Data: base58.Encode([]byte(memo))}
+}
+
+func (s *BlockScannerTestSuite) TestProcessNativeTransfer(c *C) {
+	scanner := newTestScanner()
+	keys := []PublicKey{SystemProgramID, s.from, s.to, MemoProgramID}
+	tx := newRPCTx(c, keys, systemTransfer(1, 2, 1_500_000_000), memoInstruction(3, "ADD:SOL.SOL"))
+
+	txs := scanner.processTxs(10, []BlockTransaction{tx})
+	c.Assert(txs, HasLen, 1)
+	item := txs[0]
+	txID, err := SignatureToTxID(tx.Transaction.Signatures[0])
+	c.Assert(err, IsNil)
+	c.Check(item.Tx, Equals, txID)
+	c.Check(item.BlockHeight, Equals, int64(10))
+	c.Check(item.Sender, Equals, s.from.String())
+	c.Check(item.To, Equals, s.to.String())
+	c.Check(item.Memo, Equals, "ADD:SOL.SOL")
+	c.Check(item.Coins.EqualsEx(common.Coins{common.NewCoin(common.SOLAsset, cosmos.NewUint(150_000_000))}), Equals, true)
+	c.Check(item.Gas.Equals(common.Gas{common.NewCoin(common.SOLAsset, cosmos.NewUint(500))}), Equals, true)
+	c.Check(scanner.feeCache, HasLen, 1)
+	c.Check(scanner.averageFee().Uint64(), Equals, uint64(500))
+	_, err = common.NewTxID(item.Tx)
+	c.Check(err, IsNil)
+
+	// the first memo program is observed too
+	tx = newRPCTx(c, []PublicKey{SystemProgramID, s.from, s.to, MemoV1ProgramID}, systemTransfer(1, 2, 1), memoInstruction(3, "memo"))
+	txs = scanner.processTxs(10, []BlockTransaction{tx})
+	c.Assert(txs, HasLen, 1)
+	c.Check(txs[0].Memo, Equals, "memo")
+}
+
+func (s *BlockScannerTestSuite) TestProcessSkippedTxs(c *C) {
+	scanner := newTestScanner()
+	keys := []PublicKey{SystemProgramID, s.from, s.to, MemoProgramID}
+
+	failed := newRPCTx(c, keys, systemTransfer(1, 2, 1000))
+	failed.Meta.Err = map[string]any{"InstructionError": []any{0, "Custom"}}
+	noMeta := newRPCTx(c, keys, systemTransfer(1, 2, 1000))
+	noMeta.Meta = nil
+	memoOnly := newRPCTx(c, keys, memoInstruction(3, "hello"))
+	// a system instruction which is not a transfer, e.g. CreateAccount
+	createAccount := newRPCTx(c, keys, RPCInstruction{ProgramIDIndex: 0, Accounts: []int{1, 2}, Data: base58.Encode(make([]byte, 52))})
+	outOfRange := newRPCTx(c, keys, systemTransfer(1, 7, 1000))
+
+	txs := scanner.processTxs(10, []BlockTransaction{failed, noMeta, memoOnly, createAccount, outOfRange})
+	c.Check(txs, HasLen, 0)
+	c.Check(scanner.feeCache, HasLen, 0)
+}
+
+func (s *BlockScannerTestSuite) TestProcessTokenTransfer(c *C) {
+	scanner := newTestScanner()
+	keys := []PublicKey{s.from, s.fromATA, s.toATA, s.usdc, TokenProgramID, MemoProgramID}
+	data := binary.LittleEndian.AppendUint64([]byte{tokenInstructionTransferChecked}, 2_500_000)
+	tx := newRPCTx(c, keys,
+		RPCInstruction{ProgramIDIndex: 4, Accounts: []int{1, 3, 2, 0}, Data: base58.Encode(append(data, 6))},
+		memoInstruction(5, "SWAP:SOL.SOL"),
+	)
+	tx.Meta.PostTokenBalances = []TokenBalance{
+		{AccountIndex: 1, Mint: s.usdc.String(), Owner: s.from.String()},
+		{AccountIndex: 2, Mint: s.usdc.String(), Owner: s.to.String()},
+	}
+
+	txs := scanner.processTxs(10, []BlockTransaction{tx})
+	c.Assert(txs, HasLen, 1)
+	usdc, _ := GetAssetByMint(s.usdc.String())
+	c.Check(txs[0].Sender, Equals, s.from.String())
+	c.Check(txs[0].To, Equals, s.to.String())
+	c.Check(txs[0].Memo, Equals, "SWAP:SOL.SOL")
+	c.Check(txs[0].Coins.EqualsEx(common.Coins{common.NewCoin(usdc.SwitchlyAsset, cosmos.NewUint(250_000_000))}), Equals, true)
+
+	// a plain transfer, without the mint in the accounts
+	data = binary.LittleEndian.AppendUint64([]byte{tokenInstructionTransfer}, 1_000_000)
+	tx = newRPCTx(c, keys, RPCInstruction{ProgramIDIndex: 4, Accounts: []int{1, 2, 0}, Data: base58.Encode(data)})
+	tx.Meta.PostTokenBalances = []TokenBalance{{AccountIndex: 2, Mint: s.usdc.String(), Owner: s.to.String()}}
+	txs = scanner.processTxs(10, []BlockTransaction{tx})
+	c.Assert(txs, HasLen, 1)
+	c.Check(txs[0].To, Equals, s.to.String())
+	c.Check(txs[0].Coins.EqualsEx(common.Coins{common.NewCoin(usdc.SwitchlyAsset, cosmos.NewUint(100_000_000))}), Equals, true)
+
+	// tokens which are not whitelisted are ignored
+	tx.Meta.PostTokenBalances = []TokenBalance{{AccountIndex: 2, Mint: s.mint.String(), Owner: s.to.String()}}
+	c.Check(scanner.processTxs(10, []BlockTransaction{tx}), HasLen, 0)
+
+	// the mint of the destination must be known
+	tx.Meta.PostTokenBalances = nil
+	c.Check(scanner.processTxs(10, []BlockTransaction{tx}), HasLen, 0)
+}
+
+func (s *BlockScannerTestSuite) TestProcessAggregatedTransfers(c *C) {
+	scanner := newTestScanner()
+	other, _ := newTestKey(c)
+	keys := []PublicKey{SystemProgramID, s.from, s.to, other, MemoProgramID}
+	tx := newRPCTx(c, keys,
+		systemTransfer(1, 2, 1_000_000_000),
+		systemTransfer(1, 3, 7_000_000_000),
+		systemTransfer(1, 2, 500_000_000),
+		memoInstruction(4, "ADD:SOL.SOL"),
+	)
+
+	txs := scanner.processTxs(10, []BlockTransaction{tx})
+	c.Assert(txs, HasLen, 1)
+	// only the transfers between the sender and recipient of the first one are added up
+	c.Check(txs[0].To, Equals, s.to.String())
+	c.Check(txs[0].Coins.EqualsEx(common.Coins{common.NewCoin(common.SOLAsset, cosmos.NewUint(150_000_000))}), Equals, true)
+}
+
+func (s *BlockScannerTestSuite) TestProcessVersionedTx(c *C) {
+	scanner := newTestScanner()
+	// the recipient is loaded from an address lookup table
+	tx := newRPCTx(c, []PublicKey{s.from, SystemProgramID, MemoProgramID},
+		RPCInstruction{ProgramIDIndex: 1, Accounts: []int{0, 3}, Data: systemTransfer(0, 0, 1_000_000_000).Data},
+		memoInstruction(2, "ADD:SOL.SOL"),
+	)
+	tx.Version = 0
+	tx.Meta.LoadedAddresses = &struct {
+		Writable []string `json:"writable"`
+		Readonly []string `json:"readonly"`
+	}{Writable: []string{s.to.String()}}
+
+	txs := scanner.processTxs(10, []BlockTransaction{tx})
+	c.Assert(txs, HasLen, 1)
+	c.Check(txs[0].Sender, Equals, s.from.String())
+	c.Check(txs[0].To, Equals, s.to.String())
+}
+
+func (s *BlockScannerTestSuite) TestFetchTxs(c *C) {
+	node := newFakeSolanaNode()
+	defer node.Close()
+	scanner := newTestScanner()
+	scanner.rpc = NewRPCClient(node.URL())
+
+	from, priv := newTestKey(c)
+	node.Fund(from.String(), 10_000_000_000)
+	msg, err := NewMessage(from, []Instruction{
+		NewTransferInstruction(from, s.to, 1_000_000_000),
+		NewMemoInstruction("ADD:SOL.SOL"),
+	}, node.LatestBlockhash())
+	c.Assert(err, IsNil)
+	tx := NewTransaction(msg)
+	c.Assert(tx.AddSignature(from, ed25519.Sign(priv, msg.Serialize())), IsNil)
+	raw, err := tx.Serialize()
+	c.Assert(err, IsNil)
+	_, err = node.Submit(raw)
+	c.Assert(err, IsNil)
+	slot := int64(node.Mine())
+
+	height, err := scanner.GetHeight()
+	c.Assert(err, IsNil)
+	c.Check(height, Equals, slot)
+
+	txIn, err := scanner.FetchTxs(slot, slot)
+	c.Assert(err, IsNil)
+	c.Check(txIn.Chain, Equals, common.SolanaChain)
+	c.Assert(txIn.TxArray, HasLen, 1)
+	c.Check(txIn.TxArray[0].Tx, Equals, tx.ID())
+	c.Check(txIn.TxArray[0].Memo, Equals, "ADD:SOL.SOL")
+
+	// the slot before was skipped
+	txIn, err = scanner.FetchTxs(slot-1, slot)
+	c.Assert(err, IsNil)
+	c.Check(txIn.TxArray, HasLen, 0)
+
+	// the next slot is not finalized yet
+	_, err = scanner.FetchTxs(slot+1, slot)
+	c.Check(err, Equals, btypes.ErrUnavailableBlock)
+}
+
+func (s *BlockScannerTestSuite) TestUpdateFees(c *C) {
+	scanner := newTestScanner()
+	scanner.globalNetworkFeeQueue = make(chan common.NetworkFee, 1)
+	for i := 0; i < FeeCacheTransactions; i++ {
+		scanner.updateFeeCache(common.NewCoin(common.SOLAsset, cosmos.NewUint(uint64(500+i%2*100))))
+	}
+	scanner.updateFeeCache(common.NewCoin(common.SOLAsset, cosmos.ZeroUint()))
+	c.Check(scanner.feeCache, HasLen, FeeCacheTransactions)
+
+	// fees are only reported every fee update period
+	c.Assert(scanner.updateFees(FeeUpdatePeriodBlocks+1), IsNil)
+	c.Check(scanner.globalNetworkFeeQueue, HasLen, 0)
+
+	c.Assert(scanner.updateFees(FeeUpdatePeriodBlocks), IsNil)
+	c.Assert(scanner.globalNetworkFeeQueue, HasLen, 1)
+	fee := <-scanner.globalNetworkFeeQueue
+	c.Check(fee.Chain, Equals, common.SolanaChain)
+	c.Check(fee.TransactionSize, Equals, uint64(1))
+	c.Check(fee.TransactionRate, Equals, uint64(550))
+	c.Check(scanner.lastFee.Uint64(), Equals, uint64(550))
+	transactionSize, transactionRate := scanner.GetNetworkFee()
+	c.Check(transactionSize, Equals, uint64(1))
+	c.Check(transactionRate, Equals, uint64(550))
+
+	// unchanged fees are not reported again
+	c.Assert(scanner.updateFees(2*FeeUpdatePeriodBlocks), IsNil)
+	c.Check(scanner.globalNetworkFeeQueue, HasLen, 0)
+}
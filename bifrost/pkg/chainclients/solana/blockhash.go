@@ -0,0 +1,133 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Every node of the keysign committee builds the outbound on its own, so they only produce
+// a valid EdDSA signature if they all refer to the same recent blockhash. Instead of the
+// latest blockhash of each node's RPC, which differ between nodes, an outbound refers to
+// the blockhash of the last finalized block produced at or before the time of a
+// SWITCHLYChain block, which is the same for every node. Solana block times don't
+// decrease, so once a later block is finalized no other block can become the reference.
+const (
+	// blockhashLookbackSlots is how many slots before the finalized slot the reference
+	// block is searched for, an older blockhash can't be referred to anymore
+	blockhashLookbackSlots = 150
+	// blockhashRefreshBlocks is the interval of the SWITCHLYChain heights whose block time
+	// an outbound is built with again once the blockhash of its own height expired
+	blockhashRefreshBlocks = 6
+)
+
+// errBlockhashExpired is returned when the reference block of a SWITCHLYChain height is
+// older than the blockhashes which can be referred to
+var errBlockhashExpired = errors.New("reference blockhash expired")
+
+// referenceBlockhash returns the blockhash the outbound scheduled at the given SWITCHLYChain
+// height is built with. Once the blockhash of that height expired, the blockhash of the last
+// SWITCHLYChain height multiple of blockhashRefreshBlocks is used instead.
+func (c *Client) referenceBlockhash(switchlyHeight int64) (SolanaMetadata, error) {
+	meta, err := c.blockhashAt(switchlyHeight)
+	if err == nil || !errors.Is(err, errBlockhashExpired) {
+		return meta, err
+	}
+
+	current, err := c.switchlyBridge.GetBlockHeight()
+	if err != nil {
+		return SolanaMetadata{}, fmt.Errorf("fail to get switchly height: %w", err)
+	}
+	refreshHeight := current - current%blockhashRefreshBlocks
+	if refreshHeight <= switchlyHeight {
+		return SolanaMetadata{}, fmt.Errorf("blockhash of switchly height %d expired, waiting for height %d: %w", switchlyHeight, refreshHeight+blockhashRefreshBlocks, errBlockhashExpired)
+	}
+	return c.blockhashAt(refreshHeight)
+}
+
+// blockhashAt returns the blockhash of the last finalized block produced at or before the
+// time of the SWITCHLYChain block at the given height
+func (c *Client) blockhashAt(switchlyHeight int64) (SolanaMetadata, error) {
+	blockTime, err := c.switchlyBridge.GetBlockTime(switchlyHeight)
+	if err != nil {
+		return SolanaMetadata{}, fmt.Errorf("fail to get time of switchly block %d: %w", switchlyHeight, err)
+	}
+	refTime := blockTime.Unix()
+
+	tip, err := c.rpc.GetSlot()
+	if err != nil {
+		return SolanaMetadata{}, fmt.Errorf("fail to get finalized slot: %w", err)
+	}
+	oldest := uint64(0)
+	if tip > blockhashLookbackSlots {
+		oldest = tip - blockhashLookbackSlots
+	}
+
+	// a later block must be finalized, or the reference block may still change
+	tipSlot, tipTime, err := c.producedBlock(tip, oldest)
+	if err != nil {
+		return SolanaMetadata{}, err
+	}
+	if tipTime <= refTime {
+		return SolanaMetadata{}, fmt.Errorf("no block finalized after switchly block %d yet, last at slot %d", switchlyHeight, tipSlot)
+	}
+	_, oldestTime, err := c.producedBlock(oldest, 0)
+	if err != nil {
+		return SolanaMetadata{}, err
+	}
+	if oldestTime > refTime {
+		return SolanaMetadata{}, fmt.Errorf("switchly block %d is older than slot %d: %w", switchlyHeight, oldest, errBlockhashExpired)
+	}
+
+	// the last slot whose block at or before it was produced at or before the time
+	lo, hi := oldest, tipSlot
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		var midTime int64
+		_, midTime, err = c.producedBlock(mid, 0)
+		if err != nil {
+			return SolanaMetadata{}, err
+		}
+		if midTime <= refTime {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	slot, _, err := c.producedBlock(lo, 0)
+	if err != nil {
+		return SolanaMetadata{}, err
+	}
+
+	blockhash, err := c.rpc.GetBlockhash(slot)
+	if err != nil {
+		return SolanaMetadata{}, fmt.Errorf("fail to get blockhash of slot %d: %w", slot, err)
+	}
+	valid, err := c.rpc.IsBlockhashValid(blockhash)
+	if err != nil {
+		return SolanaMetadata{}, fmt.Errorf("fail to check blockhash: %w", err)
+	}
+	if !valid {
+		return SolanaMetadata{}, fmt.Errorf("blockhash of slot %d: %w", slot, errBlockhashExpired)
+	}
+	return SolanaMetadata{
+		Blockhash:      blockhash,
+		SwitchlyHeight: switchlyHeight,
+	}, nil
+}
+
+// producedBlock returns the slot and time of the last block produced at or before the
+// given slot, skipped slots are walked back down to the floor slot
+func (c *Client) producedBlock(slot, floor uint64) (uint64, int64, error) {
+	for {
+		blockTime, err := c.rpc.GetBlockTime(slot)
+		switch {
+		case err == nil:
+			return slot, blockTime, nil
+		case !IsRPCError(err, RPCErrSlotSkipped) && !IsRPCError(err, RPCErrLongTermStorageSlotSkipped):
+			return 0, 0, fmt.Errorf("fail to get block time of slot %d: %w", slot, err)
+		case slot <= floor || slot == 0:
+			return 0, 0, fmt.Errorf("no block produced at or before slot %d", slot)
+		}
+		slot--
+	}
+}
@@ -0,0 +1,610 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/blockscanner"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/metrics"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/runners"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/signercache"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss"
+	tssp "github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/tss"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
+	memo "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
+)
+
+// ErrBlockhashExpired is returned by BroadcastTx when the recent blockhash of a signed tx
+// expired before the tx landed, the tx can never be processed and must be signed again.
+// The signer matches the error message to clear the signed tx.
+var ErrBlockhashExpired = errors.New("blockhash_expired")
+
+// tssSigner is the ed25519 threshold signer of the vault transactions
+type tssSigner interface {
+	Start()
+	Stop()
+	RemoteSignEdDSA(msg []byte, poolPubKey string) ([]byte, error)
+}
+
+// SolanaMetadata is the recent blockhash an outbound was built with and the SWITCHLYChain
+// height it was derived from, stored as the transaction checkpoint
+type SolanaMetadata struct {
+	Blockhash      string `json:"blockhash"`
+	SwitchlyHeight int64  `json:"switchly_height"`
+}
+
+// Client is a structure to sign and broadcast tx to Solana chain used by signer mostly
+type Client struct {
+	logger              zerolog.Logger
+	cfg                 config.BifrostChainConfiguration
+	tssKeyManager       tssSigner
+	switchlyBridge      switchlyclient.SwitchlyBridge
+	storage             *blockscanner.BlockScannerStorage
+	blockScanner        *blockscanner.BlockScanner
+	signerCacheManager  *signercache.CacheManager
+	solanaScanner       *SolanaBlockScanner
+	globalSolvencyQueue chan stypes.Solvency
+	wg                  *sync.WaitGroup
+	stopchan            chan struct{}
+	rpc                 *RPCClient
+
+	// vaultAddrs memoises the vault secp256k1 pubkey -> Solana address mapping of the
+	// vaults with an ed25519 key, which is immutable for the life of the vault
+	vaultAddrs sync.Map
+}
+
+// NewClient creates a new instance of a Solana chain client
+func NewClient(
+	thorKeys *switchlyclient.Keys,
+	cfg config.BifrostChainConfiguration,
+	server *tssp.TssServer,
+	switchlyBridge switchlyclient.SwitchlyBridge,
+	m *metrics.Metrics,
+) (*Client, error) {
+	logger := log.With().Str("module", cfg.ChainID.String()).Logger()
+
+	if switchlyBridge == nil {
+		return nil, errors.New("switchly bridge is nil")
+	}
+
+	tssKm, err := tss.NewKeySign(server, switchlyBridge)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create tss signer: %w", err)
+	}
+
+	c := &Client{
+		logger:         logger,
+		cfg:            cfg,
+		tssKeyManager:  tssKm,
+		switchlyBridge: switchlyBridge,
+		wg:             &sync.WaitGroup{},
+		stopchan:       make(chan struct{}),
+		rpc:            NewRPCClient(cfg.RPCHost),
+	}
+
+	var path string // if not set later, will in memory storage
+	if len(c.cfg.BlockScanner.DBPath) > 0 {
+		path = fmt.Sprintf("%s/%s", c.cfg.BlockScanner.DBPath, c.cfg.BlockScanner.ChainID)
+	}
+	c.storage, err = blockscanner.NewBlockScannerStorage(path, c.cfg.ScannerLevelDB)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create scan storage: %w", err)
+	}
+
+	c.solanaScanner, err = NewSolanaBlockScanner(
+		c.cfg.RPCHost,
+		c.cfg.BlockScanner,
+		c.storage,
+		c.switchlyBridge,
+		m,
+		c.ReportSolvency,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create solana scanner: %w", err)
+	}
+
+	c.blockScanner, err = blockscanner.NewBlockScanner(c.cfg.BlockScanner, c.storage, m, c.switchlyBridge, c.solanaScanner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create block scanner: %w", err)
+	}
+
+	signerCacheManager, err := signercache.NewSignerCacheManager(c.storage.GetInternalDb())
+	if err != nil {
+		return nil, fmt.Errorf("fail to create signer cache manager")
+	}
+	c.signerCacheManager = signerCacheManager
+
+	return c, nil
+}
+
+// Start Solana chain client
+func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan stypes.ErrataBlock, globalSolvencyQueue chan stypes.Solvency, globalNetworkFeeQueue chan common.NetworkFee) {
+	c.globalSolvencyQueue = globalSolvencyQueue
+	c.solanaScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
+	c.tssKeyManager.Start()
+	c.blockScanner.Start(globalTxsQueue, globalErrataQueue, globalNetworkFeeQueue)
+	c.wg.Add(1)
+	go runners.SolvencyCheckRunner(c.GetChain(), c, c.switchlyBridge, c.stopchan, c.wg, constants.SwitchlyBlockTime)
+}
+
+// Stop Solana chain client
+func (c *Client) Stop() {
+	c.tssKeyManager.Stop()
+	c.blockScanner.Stop()
+	close(c.stopchan)
+	c.wg.Wait()
+}
+
+// GetConfig return the configuration used by Solana chain client
+func (c *Client) GetConfig() config.BifrostChainConfiguration {
+	return c.cfg
+}
+
+func (c *Client) IsBlockScannerHealthy() bool {
+	return c.blockScanner.IsHealthy()
+}
+
+func (c *Client) GetChain() common.Chain {
+	return c.cfg.ChainID
+}
+
+func (c *Client) GetHeight() (int64, error) {
+	return c.solanaScanner.GetHeight()
+}
+
+// GetBlockScannerHeight returns blockscanner height
+func (c *Client) GetBlockScannerHeight() (int64, error) {
+	return c.blockScanner.PreviousHeight(), nil
+}
+
+// RollbackBlockScanner rolls back the block scanner to the last observed block
+func (c *Client) RollbackBlockScanner() error {
+	return c.blockScanner.RollbackToLastObserved()
+}
+
+func (c *Client) GetLatestTxForVault(vault string) (string, string, error) {
+	lastObserved, err := c.signerCacheManager.GetLatestRecordedTx(stypes.InboundCacheKey(vault, c.GetChain().String()))
+	if err != nil {
+		return "", "", err
+	}
+	lastBroadCasted, err := c.signerCacheManager.GetLatestRecordedTx(stypes.BroadcastCacheKey(vault, c.GetChain().String()))
+	return lastObserved, lastBroadCasted, err
+}
+
+// vaultAddress resolves the Solana address of a vault from its ed25519 key. A vault is
+// identified by its secp256k1 pubkey, which derives no Solana address, so the ed25519
+// key is looked up on the vault. A vault without an ed25519 key has no address.
+func (c *Client) vaultAddress(vaultPubKey common.PubKey) (common.Address, error) {
+	if addr, ok := c.vaultAddrs.Load(vaultPubKey.String()); ok {
+		return addr.(common.Address), nil
+	}
+	vault, err := c.switchlyBridge.GetVault(vaultPubKey.String())
+	if err != nil {
+		return common.NoAddress, fmt.Errorf("fail to get vault %s: %w", vaultPubKey, err)
+	}
+	addr, err := vault.PubKeyForChain(c.GetChain()).GetAddress(c.GetChain())
+	if err != nil {
+		return common.NoAddress, fmt.Errorf("fail to derive solana address for vault %s: %w", vaultPubKey, err)
+	}
+	c.vaultAddrs.Store(vaultPubKey.String(), addr)
+	return addr, nil
+}
+
+// GetAddress return the Solana address of the vault, derived from its ed25519 key
+func (c *Client) GetAddress(poolPubKey common.PubKey) string {
+	addr, err := c.vaultAddress(poolPubKey)
+	if err != nil {
+		c.logger.Err(err).Str("pool_pub_key", poolPubKey.String()).Msg("fail to get pool address")
+		return ""
+	}
+	return addr.String()
+}
+
+func (c *Client) GetAccount(pkey common.PubKey, height *big.Int) (common.Account, error) {
+	addr, err := c.vaultAddress(pkey)
+	if err != nil {
+		return common.Account{}, err
+	}
+	return c.GetAccountByAddress(addr.String(), height)
+}
+
+// GetAccountByAddress returns the SOL balance of the address and the balances of its
+// associated token accounts of the whitelisted tokens. Balances are queried at the
+// finalized slot, historical balances are not available so the height is ignored.
+func (c *Client) GetAccountByAddress(address string, _ *big.Int) (common.Account, error) {
+	wallet, err := NewPublicKey(address)
+	if err != nil {
+		return common.Account{}, err
+	}
+
+	lamports, err := c.rpc.GetBalance(address)
+	if err != nil {
+		return common.Account{}, fmt.Errorf("fail to get balance of %s: %w", address, err)
+	}
+	native, _ := GetAssetByMint("")
+	coins := common.Coins{native.ConvertToSwitchlyAmount(lamports)}
+
+	for _, mapping := range GetAllAssetMappings() {
+		if mapping.IsNative() {
+			continue
+		}
+		var mint, ata PublicKey
+		mint, err = NewPublicKey(mapping.Mint)
+		if err != nil {
+			return common.Account{}, err
+		}
+		ata, err = AssociatedTokenAddress(wallet, mint)
+		if err != nil {
+			return common.Account{}, err
+		}
+		var balance TokenAmount
+		balance, err = c.rpc.GetTokenAccountBalance(ata.String())
+		if IsRPCError(err, RPCErrInvalidParams) {
+			// the token account doesn't exist, the wallet never held the token
+			continue
+		}
+		if err != nil {
+			return common.Account{}, fmt.Errorf("fail to get %s balance of %s: %w", mapping.SwitchlyAsset, address, err)
+		}
+		var amount uint64
+		amount, err = parseTokenAmount(balance)
+		if err != nil {
+			return common.Account{}, fmt.Errorf("fail to parse %s balance of %s: %w", mapping.SwitchlyAsset, address, err)
+		}
+		if amount == 0 {
+			continue
+		}
+		coins = append(coins, mapping.ConvertToSwitchlyAmount(amount))
+	}
+
+	return common.Account{
+		Sequence:      0,
+		AccountNumber: 0,
+		Coins:         coins,
+	}, nil
+}
+
+// buildInstructions returns the instructions of the outbound: a system transfer for SOL,
+// or a transfer between the associated token accounts for an SPL token, creating the
+// recipient's if needed, followed by the memo
+func (c *Client) buildInstructions(from PublicKey, tx stypes.TxOutItem) ([]Instruction, error) {
+	if len(tx.Coins) != 1 {
+		return nil, fmt.Errorf("cannot send more than 1 set of coins, trying %d set coins", len(tx.Coins))
+	}
+	coin := tx.Coins[0]
+	mapping, ok := GetAssetBySwitchlyAsset(coin.Asset)
+	if !ok {
+		return nil, fmt.Errorf("asset (%s) does not exist / not whitelisted by client", coin.Asset)
+	}
+	amount, err := mapping.ConvertFromSwitchlyAmount(coin.Amount)
+	if err != nil {
+		return nil, err
+	}
+	to, err := NewPublicKey(tx.ToAddress.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %w", err)
+	}
+
+	var instructions []Instruction
+	if mapping.IsNative() {
+		instructions = append(instructions, NewTransferInstruction(from, to, amount))
+	} else {
+		var mint, source, destination PublicKey
+		var create Instruction
+		mint, err = NewPublicKey(mapping.Mint)
+		if err != nil {
+			return nil, err
+		}
+		if source, err = AssociatedTokenAddress(from, mint); err != nil {
+			return nil, err
+		}
+		if destination, err = AssociatedTokenAddress(to, mint); err != nil {
+			return nil, err
+		}
+		if create, err = NewCreateAssociatedTokenAccountIdempotentInstruction(from, to, mint); err != nil {
+			return nil, err
+		}
+		instructions = append(instructions,
+			create,
+			NewTransferCheckedInstruction(source, mint, destination, from, amount, uint8(mapping.SolanaDecimals)),
+		)
+	}
+	return append(instructions, NewMemoInstruction(tx.Memo)), nil
+}
+
+// SignTx sign the the given TxArrayItem
+func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signedTx, checkpoint []byte, _ *stypes.TxInItem, err error) {
+	defer func() {
+		if err != nil {
+			var keysignError tss.KeysignError
+			if errors.As(err, &keysignError) {
+				if len(keysignError.Blame.BlameNodes) == 0 {
+					c.logger.Err(err).Msg("TSS doesn't know which node to blame")
+					return
+				}
+
+				// key sign error forward the keysign blame to switchly
+				var txID common.TxID
+				txID, err = c.switchlyBridge.PostKeysignFailure(keysignError.Blame, switchlyHeight, tx.Memo, tx.Coins, tx.VaultPubKey)
+				if err != nil {
+					c.logger.Err(err).Msg("fail to post keysign failure to SWITCHLYChain")
+					return
+				}
+				c.logger.Info().Str("tx_id", txID.String()).Msgf("post keysign failure to switchly")
+			}
+			c.logger.Err(err).Msg("failed to sign tx")
+			return
+		}
+	}()
+
+	if c.signerCacheManager.HasSigned(tx.CacheHash()) {
+		c.logger.Info().Interface("tx", tx).Msg("transaction already signed, ignoring...")
+		return nil, nil, nil, nil
+	}
+
+	if tx.Memo == "" {
+		return nil, nil, nil, fmt.Errorf("tx out memo is empty")
+	}
+
+	vaultAddr, err := c.vaultAddress(tx.VaultPubKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	from, err := NewPublicKey(vaultAddr.String())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	instructions, err := c.buildInstructions(from, tx)
+	if err != nil {
+		c.logger.Err(err).Msg("failed to process outbound tx")
+		return nil, nil, nil, err
+	}
+
+	// the recent blockhash is stored as the transaction checkpoint, while it is valid a
+	// retry signs the same message, and the network processes a message at most once
+	meta := SolanaMetadata{}
+	if tx.Checkpoint != nil {
+		if err = json.Unmarshal(tx.Checkpoint, &meta); err != nil {
+			c.logger.Err(err).Msg("fail to unmarshal checkpoint")
+			return nil, nil, nil, err
+		}
+	}
+	if meta.Blockhash != "" {
+		var valid bool
+		valid, err = c.rpc.IsBlockhashValid(meta.Blockhash)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("fail to check blockhash: %w", err)
+		}
+		if !valid {
+			meta = SolanaMetadata{}
+		}
+	}
+	if meta.Blockhash == "" {
+		// the blockhash is derived from the SWITCHLYChain height the outbound was scheduled
+		// at, so every node of the keysign committee signs the same message
+		meta, err = c.referenceBlockhash(switchlyHeight)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("fail to get reference blockhash: %w", err)
+		}
+	}
+
+	// serialize the checkpoint for later
+	checkpointBytes, err := json.Marshal(meta)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to marshal checkpoint: %w", err)
+	}
+
+	blockhash, err := NewHash(meta.Blockhash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	msg, err := NewMessage(from, instructions, blockhash)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to compile message: %w", err)
+	}
+
+	txBytes, err := c.signMsg(msg, from, tx.VaultPubKey)
+	if err != nil {
+		return nil, checkpointBytes, nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	return txBytes, nil, nil, nil
+}
+
+// signMsg signs the message with the vault's ed25519 key via EdDSA threshold signing,
+// and returns the serialized transaction. It returns nil when this node was not part
+// of the keysign committee.
+func (c *Client) signMsg(msg Message, signer PublicKey, vaultPubKey common.PubKey) ([]byte, error) {
+	msgBytes := msg.Serialize()
+	sig, err := c.tssKeyManager.RemoteSignEdDSA(msgBytes, hex.EncodeToString(signer[:]))
+	if err != nil {
+		return nil, fmt.Errorf("fail to tss-sign solana tx: %w", err)
+	}
+	if len(sig) == 0 {
+		c.logger.Info().Str("vault_pubkey", vaultPubKey.String()).Msg("not part of the keysign committee")
+		return nil, nil
+	}
+
+	// Ensure the signature is valid
+	if !ed25519.Verify(signer[:], msgBytes, sig) {
+		return nil, fmt.Errorf("unable to verify signature with ed25519 pubkey %s", signer)
+	}
+
+	tx := NewTransaction(msg)
+	if err = tx.AddSignature(signer, sig); err != nil {
+		return nil, err
+	}
+	return tx.Serialize()
+}
+
+// txNeedsBroadcast returns false when the node already knows the tx with the given signature
+func (c *Client) txNeedsBroadcast(signature string) bool {
+	status, err := c.rpc.GetSignatureStatus(signature)
+	if err != nil {
+		c.logger.Info().AnErr("error", err).Msg("error get signature status")
+		return true
+	}
+	return status == nil
+}
+
+// BroadcastTx is to broadcast the tx to solana chain
+func (c *Client) BroadcastTx(tx stypes.TxOutItem, txBytes []byte) (string, error) {
+	solTx, err := DecodeTransaction(txBytes)
+	if err != nil {
+		return "", fmt.Errorf("fail to decode tx: %w", err)
+	}
+	txID, signature := solTx.ID(), solTx.Signature()
+
+	// if tx has already been broadcasted, don't try again
+	if c.txNeedsBroadcast(signature) {
+		if _, err = c.rpc.SendTransaction(txBytes); err != nil {
+			c.logger.Info().Err(err).Str("signature", signature).Msg("Solana BroadcastTx failed")
+			return "", c.broadcastError(signature, solTx.Message.RecentBlockhash, err)
+		}
+		c.logger.Info().Str("signature", signature).Msg("Solana BroadcastTx success")
+	}
+
+	if err = c.signerCacheManager.SetSigned(tx.CacheHash(), tx.CacheVault(c.GetChain()), txID); err != nil {
+		c.logger.Err(err).Msg("fail to set signer cache")
+	}
+
+	return txID, nil
+}
+
+// broadcastError wraps the error of a failed broadcast with ErrBlockhashExpired when the
+// tx can't be processed anymore, so it is signed again with a new blockhash
+func (c *Client) broadcastError(signature string, blockhash Hash, err error) error {
+	valid, validErr := c.rpc.IsBlockhashValid(blockhash.String())
+	if validErr != nil || valid {
+		return fmt.Errorf("broadcast msg failed, %w", err)
+	}
+	// the blockhash expired, make sure the tx did not land before it did
+	if status, statusErr := c.rpc.GetSignatureStatus(signature); statusErr != nil || status != nil {
+		return fmt.Errorf("broadcast msg failed, %w", err)
+	}
+	return fmt.Errorf("broadcast msg failed, %w: %w", ErrBlockhashExpired, err)
+}
+
+// ConfirmationCountReady solana only scans finalized slots, so doesn't need to wait for confirmation
+func (c *Client) ConfirmationCountReady(txIn stypes.TxIn) bool {
+	return true
+}
+
+// GetConfirmationCount determine how many confirmations are required
+// NOTE: only finalized slots are scanned, which can not be rolled back, so
+// confirmations are not needed.
+func (c *Client) GetConfirmationCount(txIn stypes.TxIn) int64 {
+	return 0
+}
+
+func (c *Client) ReportSolvency(blockHeight int64) error {
+	if !c.ShouldReportSolvency(blockHeight) {
+		return nil
+	}
+
+	// when block scanner is not healthy, only report from auto-unhalt SolvencyCheckRunner
+	// (FetchTxs passes PreviousHeight + 1 from scanBlocks, while SolvencyCheckRunner passes chainHeight)
+	if !c.IsBlockScannerHealthy() && blockHeight == c.blockScanner.PreviousHeight()+1 {
+		return nil
+	}
+
+	// fetch all asgard vaults
+	asgardVaults, err := c.switchlyBridge.GetAsgards()
+	if err != nil {
+		return fmt.Errorf("fail to get asgards,err: %w", err)
+	}
+
+	currentGasFee := c.solanaScanner.lastFee
+
+	// report insolvent asgard vaults,
+	// or else all if the chain is halted and all are solvent
+	msgs := make([]stypes.Solvency, 0, len(asgardVaults))
+	solventMsgs := make([]stypes.Solvency, 0, len(asgardVaults))
+	for i := range asgardVaults {
+		var acct common.Account
+		acct, err = c.GetAccount(asgardVaults[i].PubKey, new(big.Int).SetInt64(blockHeight))
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to get account balance")
+			continue
+		}
+
+		msg := stypes.Solvency{
+			Height: blockHeight,
+			Chain:  c.cfg.ChainID,
+			PubKey: asgardVaults[i].PubKey,
+			Coins:  acct.Coins,
+		}
+
+		if runners.IsVaultSolvent(acct, asgardVaults[i], currentGasFee) {
+			solventMsgs = append(solventMsgs, msg) // Solvent-vault message
+			continue
+		}
+		msgs = append(msgs, msg) // Insolvent-vault message
+	}
+
+	// Only if the block scanner is unhealthy (e.g. solvency-halted) and all vaults are solvent,
+	// report that all the vaults are solvent.
+	// If there are any insolvent vaults, report only them.
+	// Not reporting both solvent and insolvent vaults is to avoid noise (spam).
+	solvent := false
+	if !c.IsBlockScannerHealthy() && len(solventMsgs) == len(asgardVaults) {
+		msgs = solventMsgs
+		solvent = true
+	}
+
+	for i := range msgs {
+		c.logger.Info().
+			Stringer("asgard", msgs[i].PubKey).
+			Interface("coins", msgs[i].Coins).
+			Bool("solvent", solvent).
+			Msg("reporting solvency")
+
+		// send solvency to switchly via global queue consumed by the observer
+		select {
+		case c.globalSolvencyQueue <- msgs[i]:
+		case <-time.After(constants.SwitchlyBlockTime):
+			c.logger.Info().Msgf("fail to send solvency info to SWITCHLYChain, timeout")
+		}
+	}
+	return nil
+}
+
+func (c *Client) ShouldReportSolvency(height int64) bool {
+	// Slots are ~400ms on Solana (150 slots/min). Since the last fee is used as a buffer
+	// we also want to ensure that is non-zero (enough blocks have been seen) before
+	// checking insolvency to avoid false positives.
+	return height%c.cfg.SolvencyBlocks == 0 && !c.solanaScanner.lastFee.IsZero()
+}
+
+// OnObservedTxIn update the signer cache (in case we haven't already)
+func (c *Client) OnObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
+	m, err := memo.ParseMemo(common.LatestVersion, txIn.Memo)
+	if err != nil {
+		// Debug log only as ParseMemo error is expected for SWITCHName inbounds.
+		c.logger.Debug().Err(err).Msgf("fail to parse memo: %s", txIn.Memo)
+		return
+	}
+	if !m.IsOutbound() {
+		return
+	}
+	if m.GetTxID().IsEmpty() {
+		return
+	}
+	if err = c.signerCacheManager.SetSigned(txIn.CacheHash(c.GetChain(), m.GetTxID().String()), txIn.CacheVault(c.GetChain()), txIn.Tx); err != nil {
+		c.logger.Err(err).Msg("fail to update signer cache")
+	}
+}
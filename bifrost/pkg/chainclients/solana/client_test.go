@@ -0,0 +1,283 @@
+package solana
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/conformance"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+type SolanaTestSuite struct {
+	node   *fakeSolanaConformanceNode
+	bridge *conformance.Bridge
+	client *Client
+	usdc   SolanaAssetMapping
+}
+
+var _ = Suite(&SolanaTestSuite{})
+
+func (s *SolanaTestSuite) SetUpTest(c *C) {
+	var err error
+	s.node, err = newFakeSolanaVaultNode()
+	c.Assert(err, IsNil)
+	s.bridge = conformance.NewBridge(1)
+	client, err := s.node.Client(s.bridge)
+	c.Assert(err, IsNil)
+	s.client = client.(*Client)
+	var ok bool
+	s.usdc, ok = GetAssetByMint("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	c.Assert(ok, Equals, true)
+}
+
+func (s *SolanaTestSuite) TearDownTest(c *C) {
+	s.node.Close()
+}
+
+func (s *SolanaTestSuite) outbound(coin common.Coin) stypes.TxOutItem {
+	return stypes.TxOutItem{
+		Chain:       common.SolanaChain,
+		ToAddress:   s.node.User(),
+		VaultPubKey: s.node.vault,
+		Coins:       common.Coins{coin},
+		MaxGas:      common.Gas{common.NewCoin(common.SOLAsset, cosmos.NewUint(1_000_000))},
+		GasRate:     1,
+		Memo:        "OUT:0F1E2D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A69788796A5B4C3D2E1F0",
+		InHash:      "0F1E2D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A69788796A5B4C3D2E1F0",
+	}
+}
+
+func (s *SolanaTestSuite) TestGetAddress(c *C) {
+	c.Check(s.client.GetAddress(s.node.vault), Equals, s.node.vaultAddress().String())
+	// a vault without an ed25519 key has no solana address
+	c.Check(s.client.GetAddress(common.PubKey("unknown")), Equals, "")
+}
+
+func (s *SolanaTestSuite) TestGetAccount(c *C) {
+	mint := MustPublicKey(s.usdc.Mint)
+	c.Assert(s.node.FundToken(s.node.vaultAddress(), mint, 12_000_000), IsNil)
+
+	acct, err := s.client.GetAccount(s.node.vault, nil)
+	c.Assert(err, IsNil)
+	c.Check(acct.Coins.GetCoin(common.SOLAsset).Amount.Uint64(), Equals, uint64(1_000_000_000))
+	c.Check(acct.Coins.GetCoin(s.usdc.SwitchlyAsset).Amount.Uint64(), Equals, uint64(1_200_000_000))
+
+	// the user holds no tokens
+	acct, err = s.client.GetAccountByAddress(s.node.User().String(), nil)
+	c.Assert(err, IsNil)
+	c.Check(acct.Coins, HasLen, 1)
+
+	_, err = s.client.GetAccountByAddress("bad address", nil)
+	c.Check(err, NotNil)
+}
+
+func (s *SolanaTestSuite) TestSignTokenOutbound(c *C) {
+	mint := MustPublicKey(s.usdc.Mint)
+	c.Assert(s.node.FundToken(s.node.vaultAddress(), mint, 12_000_000), IsNil)
+
+	// the recipient has no token account, it is created by the outbound
+	tx := s.outbound(common.NewCoin(s.usdc.SwitchlyAsset, cosmos.NewUint(500_000_000)))
+	signed, checkpoint, _, err := s.client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+	c.Assert(signed, NotNil)
+	c.Check(checkpoint, IsNil)
+
+	txID, err := s.client.BroadcastTx(tx, signed)
+	c.Assert(err, IsNil)
+	_, err = common.NewTxID(txID)
+	c.Assert(err, IsNil)
+	slot := s.node.fakeSolanaNode.Mine()
+
+	acct, err := s.client.GetAccountByAddress(s.node.User().String(), nil)
+	c.Assert(err, IsNil)
+	c.Check(acct.Coins.GetCoin(s.usdc.SwitchlyAsset).Amount.Uint64(), Equals, uint64(500_000_000))
+	acct, err = s.client.GetAccount(s.node.vault, nil)
+	c.Assert(err, IsNil)
+	c.Check(acct.Coins.GetCoin(s.usdc.SwitchlyAsset).Amount.Uint64(), Equals, uint64(700_000_000))
+
+	// the outbound is observed from the vault to the user
+	txIn, err := s.client.solanaScanner.FetchTxs(int64(slot), int64(slot))
+	c.Assert(err, IsNil)
+	c.Assert(txIn.TxArray, HasLen, 1)
+	c.Check(txIn.TxArray[0].Tx, Equals, txID)
+	c.Check(txIn.TxArray[0].Sender, Equals, s.node.vaultAddress().String())
+	c.Check(txIn.TxArray[0].To, Equals, s.node.User().String())
+	c.Check(txIn.TxArray[0].Memo, Equals, tx.Memo)
+	c.Check(txIn.TxArray[0].Coins.EqualsEx(tx.Coins), Equals, true)
+
+	// the tx is not signed twice
+	signed, _, _, err = s.client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+	c.Check(signed, IsNil)
+}
+
+func (s *SolanaTestSuite) TestSignTxErrors(c *C) {
+	tx := s.outbound(common.NewCoin(common.SOLAsset, cosmos.NewUint(10_000_000)))
+	tx.Memo = ""
+	_, _, _, err := s.client.SignTx(tx, 1)
+	c.Check(err, ErrorMatches, "tx out memo is empty")
+
+	tx = s.outbound(common.NewCoin(common.XRPAsset, cosmos.NewUint(10_000_000)))
+	_, _, _, err = s.client.SignTx(tx, 1)
+	c.Check(err, ErrorMatches, ".*not whitelisted.*")
+
+	tx = s.outbound(common.NewCoin(common.SOLAsset, cosmos.NewUint(10_000_000)))
+	tx.VaultPubKey = common.PubKey("unknown")
+	_, _, _, err = s.client.SignTx(tx, 1)
+	c.Check(err, NotNil)
+
+	// nodes outside the keysign committee get no signature
+	s.client.tssKeyManager = &nilTssSigner{}
+	signed, _, _, err := s.client.SignTx(s.outbound(common.NewCoin(common.SOLAsset, cosmos.NewUint(10_000_000))), 1)
+	c.Assert(err, IsNil)
+	c.Check(signed, IsNil)
+}
+
+func (s *SolanaTestSuite) TestCheckpoint(c *C) {
+	tx := s.outbound(common.NewCoin(common.SOLAsset, cosmos.NewUint(10_000_000)))
+	blockhash := s.node.LatestBlockhash()
+	tx.Checkpoint, _ = json.Marshal(SolanaMetadata{Blockhash: blockhash.String()})
+	s.node.fakeSolanaNode.Mine()
+
+	// the blockhash of the checkpoint is reused while it is valid
+	signed, _, _, err := s.client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+	solTx, err := DecodeTransaction(signed)
+	c.Assert(err, IsNil)
+	c.Check(solTx.Message.RecentBlockhash, Equals, blockhash)
+
+	// a failing signature returns the checkpoint for the retry
+	s.client.tssKeyManager = &fakeTssSigner{key: s.node.userKey}
+	_, checkpoint, _, err := s.client.SignTx(tx, 1)
+	c.Assert(err, NotNil)
+	var meta SolanaMetadata
+	c.Assert(json.Unmarshal(checkpoint, &meta), IsNil)
+	c.Check(meta.Blockhash, Equals, blockhash.String())
+}
+
+func (s *SolanaTestSuite) TestBroadcastExpiredBlockhash(c *C) {
+	tx := s.outbound(common.NewCoin(common.SOLAsset, cosmos.NewUint(10_000_000)))
+	signed, _, _, err := s.client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+	solTx, err := DecodeTransaction(signed)
+	c.Assert(err, IsNil)
+
+	// a failing broadcast with a valid blockhash is retried as is
+	s.node.FailBroadcast()
+	_, err = s.client.BroadcastTx(tx, signed)
+	c.Assert(err, NotNil)
+	c.Check(errors.Is(err, ErrBlockhashExpired), Equals, false)
+
+	for i := 0; i <= fakeBlockhashValidity/2; i++ {
+		s.node.fakeSolanaNode.Mine()
+	}
+	_, err = s.client.BroadcastTx(tx, signed)
+	c.Assert(err, NotNil)
+	c.Check(errors.Is(err, ErrBlockhashExpired), Equals, true)
+	// the signer matches the error to sign the tx again
+	c.Check(strings.Contains(err.Error(), "blockhash_expired"), Equals, true)
+
+	// the expired blockhash of the checkpoint is replaced once the switchly height reaches
+	// the next multiple of blockhashRefreshBlocks
+	tx.Checkpoint, _ = json.Marshal(SolanaMetadata{Blockhash: solTx.Message.RecentBlockhash.String(), SwitchlyHeight: 1})
+	_, _, _, err = s.client.SignTx(tx, 1)
+	c.Check(err, ErrorMatches, ".*waiting for height 6.*")
+	s.bridge.SetHeight(7)
+	s.bridge.SetBlockTime(6, s.node.ReferenceTime())
+	signed, checkpoint, _, err := s.client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+	c.Check(checkpoint, IsNil)
+	resigned, err := DecodeTransaction(signed)
+	c.Assert(err, IsNil)
+	c.Check(resigned.Message.RecentBlockhash, Equals, s.node.ReferenceBlockhash())
+	txID, err := s.client.BroadcastTx(tx, signed)
+	c.Assert(err, IsNil)
+	c.Check(txID, Equals, resigned.ID())
+	c.Check(txID, Not(Equals), solTx.ID())
+}
+
+func (s *SolanaTestSuite) TestSignMultiParty(c *C) {
+	tx := s.outbound(common.NewCoin(common.SOLAsset, cosmos.NewUint(10_000_000)))
+	refTime, refBlockhash := s.node.ReferenceTime(), s.node.ReferenceBlockhash()
+	signed, _, _, err := s.client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+	c.Assert(signed, NotNil)
+
+	// another party of the keysign committee builds the outbound on its own, after more
+	// blocks were finalized
+	for i := 0; i < 5; i++ {
+		s.node.fakeSolanaNode.Mine()
+	}
+	bridge := conformance.NewBridge(1)
+	client, err := s.node.Client(bridge)
+	c.Assert(err, IsNil)
+	bridge.SetBlockTime(1, refTime)
+	other, _, _, err := client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+
+	// both refer to the blockhash of the switchly block, not to the latest one, so they
+	// sign the same message
+	c.Check(other, DeepEquals, signed)
+	solTx, err := DecodeTransaction(other)
+	c.Assert(err, IsNil)
+	c.Check(solTx.Message.RecentBlockhash, Equals, refBlockhash)
+	c.Check(solTx.Message.RecentBlockhash, Not(Equals), s.node.LatestBlockhash())
+}
+
+func (s *SolanaTestSuite) TestReferenceBlockhash(c *C) {
+	slot, err := s.client.rpc.GetSlot()
+	c.Assert(err, IsNil)
+	latest := s.node.LatestBlockhash()
+
+	// the reference block may change until a later block is finalized
+	s.bridge.SetBlockTime(2, time.Unix(fakeGenesisTime+int64(slot), 0))
+	_, err = s.client.referenceBlockhash(2)
+	c.Check(err, ErrorMatches, "no block finalized after switchly block 2 yet.*")
+	s.node.fakeSolanaNode.Mine()
+	meta, err := s.client.referenceBlockhash(2)
+	c.Assert(err, IsNil)
+	c.Check(meta.Blockhash, Equals, latest.String())
+	c.Check(meta.SwitchlyHeight, Equals, int64(2))
+
+	// a switchly block older than the blockhashes which can be referred to has expired
+	s.bridge.SetBlockTime(3, time.Unix(fakeGenesisTime, 0))
+	for i := 0; i <= fakeBlockhashValidity/2; i++ {
+		s.node.fakeSolanaNode.Mine()
+	}
+	_, err = s.client.blockhashAt(3)
+	c.Check(errors.Is(err, errBlockhashExpired), Equals, true)
+}
+
+func (s *SolanaTestSuite) TestBroadcastLandedTx(c *C) {
+	tx := s.outbound(common.NewCoin(common.SOLAsset, cosmos.NewUint(10_000_000)))
+	signed, _, _, err := s.client.SignTx(tx, 1)
+	c.Assert(err, IsNil)
+	txID, err := s.client.BroadcastTx(tx, signed)
+	c.Assert(err, IsNil)
+	s.node.fakeSolanaNode.Mine()
+
+	// a tx known to the network is not sent again, even once its blockhash expired
+	for i := 0; i <= fakeBlockhashValidity/2; i++ {
+		s.node.fakeSolanaNode.Mine()
+	}
+	again, err := s.client.BroadcastTx(tx, signed)
+	c.Assert(err, IsNil)
+	c.Check(again, Equals, txID)
+}
+
+// nilTssSigner returns no signature, like the keysign of a node outside the committee
+type nilTssSigner struct{}
+
+func (s *nilTssSigner) Start() {}
+
+func (s *nilTssSigner) Stop() {}
+
+func (s *nilTssSigner) RemoteSignEdDSA(msg []byte, poolPubKey string) ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,199 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/metrics"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/conformance"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/types"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	switchlytypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+var m *metrics.Metrics
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, newFakeSolanaConformanceNode)
+}
+
+// fakeTssSigner signs the vault transactions with a local ed25519 key
+type fakeTssSigner struct {
+	key ed25519.PrivateKey
+}
+
+func (s *fakeTssSigner) Start() {}
+
+func (s *fakeTssSigner) Stop() {}
+
+func (s *fakeTssSigner) RemoteSignEdDSA(msg []byte, poolPubKey string) ([]byte, error) {
+	if poolPubKey != hex.EncodeToString(s.key.Public().(ed25519.PublicKey)) {
+		return nil, errors.New("unknown pool pubkey")
+	}
+	return ed25519.Sign(s.key, msg), nil
+}
+
+// fakeSolanaConformanceNode is the conformance adapter of the fake node. The vault is
+// a secp256k1 pubkey carrying an ed25519 key, like the vaults of an EdDSA keygen.
+type fakeSolanaConformanceNode struct {
+	*fakeSolanaNode
+	vault    common.PubKey
+	vaultKey ed25519.PrivateKey
+	userKey  ed25519.PrivateKey
+}
+
+var _ conformance.Node = &fakeSolanaConformanceNode{}
+
+func newFakeSolanaConformanceNode(t *testing.T) conformance.Node {
+	n, err := newFakeSolanaVaultNode()
+	require.NoError(t, err)
+	t.Cleanup(n.Close)
+	return n
+}
+
+// newFakeSolanaVaultNode returns a fake node with a funded vault and user
+func newFakeSolanaVaultNode() (*fakeSolanaConformanceNode, error) {
+	_, vaultKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return nil, err
+	}
+	_, userKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &fakeSolanaConformanceNode{
+		fakeSolanaNode: newFakeSolanaNode(),
+		vault:          switchlytypes.GetRandomPubKey(),
+		vaultKey:       vaultKey,
+		userKey:        userKey,
+	}
+	n.Fund(n.vaultAddress().String(), 10_000_000_000)
+	n.Fund(n.User().String(), 100_000_000_000)
+	// the block outbounds refer to is followed by a finalized block
+	n.fakeSolanaNode.Mine()
+	return n, nil
+}
+
+func (n *fakeSolanaConformanceNode) vaultAddress() PublicKey {
+	var pk PublicKey
+	copy(pk[:], n.vaultKey.Public().(ed25519.PublicKey))
+	return pk
+}
+
+func (n *fakeSolanaConformanceNode) Capabilities() conformance.Capabilities {
+	return conformance.Capabilities{}
+}
+
+func (n *fakeSolanaConformanceNode) Client(bridge switchlyclient.SwitchlyBridge) (types.ChainClient, error) {
+	edPubKey, err := common.NewPubKeyFromEd25519(n.vaultKey.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	vault := switchlytypes.NewVault(1, switchlytypes.VaultStatus_ActiveVault, switchlytypes.VaultType_AsgardVault, n.vault, []string{common.SolanaChain.String()}, nil)
+	vault.Ed25519PubKey = edPubKey
+	bridge.(*conformance.Bridge).SetAsgards(vault)
+	height, err := bridge.GetBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	bridge.(*conformance.Bridge).SetBlockTime(height, n.ReferenceTime())
+
+	if m == nil {
+		m, err = metrics.NewMetrics(config.BifrostMetricsConfiguration{
+			Chains: common.Chains{common.SolanaChain},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	n.lock.Lock()
+	slot := int64(n.slot)
+	n.lock.Unlock()
+
+	c, err := NewClient(nil, config.BifrostChainConfiguration{
+		ChainID:        common.SolanaChain,
+		RPCHost:        n.URL(),
+		SolvencyBlocks: 1,
+		BlockScanner: config.BifrostBlockScannerConfiguration{
+			ChainID:                      common.SolanaChain,
+			StartBlockHeight:             slot,
+			BlockHeightDiscoverBackoff:   50 * time.Millisecond,
+			ObservationFlexibilityBlocks: 10,
+		},
+	}, nil, bridge, m)
+	if err != nil {
+		return nil, err
+	}
+	c.tssKeyManager = &fakeTssSigner{key: n.vaultKey}
+	// a network fee has been reported, so the client reports solvency
+	c.solanaScanner.lastFee = sdkmath.NewUint(500)
+	return c, nil
+}
+
+func (n *fakeSolanaConformanceNode) Vault() common.PubKey {
+	return n.vault
+}
+
+func (n *fakeSolanaConformanceNode) User() common.Address {
+	return common.Address(PublicKey(n.userKey.Public().(ed25519.PublicKey)).String())
+}
+
+func (n *fakeSolanaConformanceNode) Send(to common.Address, coins common.Coins, memo string) (string, error) {
+	if len(coins) != 1 {
+		return "", errors.New("only one coin can be sent")
+	}
+	mapping, ok := GetAssetBySwitchlyAsset(coins[0].Asset)
+	if !ok || !mapping.IsNative() {
+		return "", errors.New("only SOL can be sent")
+	}
+	lamports, err := mapping.ConvertFromSwitchlyAmount(coins[0].Amount)
+	if err != nil {
+		return "", err
+	}
+	recipient, err := NewPublicKey(to.String())
+	if err != nil {
+		return "", err
+	}
+	user, err := NewPublicKey(n.User().String())
+	if err != nil {
+		return "", err
+	}
+
+	msg, err := NewMessage(user, []Instruction{
+		NewTransferInstruction(user, recipient, lamports),
+		NewMemoInstruction(memo),
+	}, n.LatestBlockhash())
+	if err != nil {
+		return "", err
+	}
+	tx := NewTransaction(msg)
+	if err = tx.AddSignature(user, ed25519.Sign(n.userKey, msg.Serialize())); err != nil {
+		return "", err
+	}
+	raw, err := tx.Serialize()
+	if err != nil {
+		return "", err
+	}
+	if _, err = n.Submit(raw); err != nil {
+		return "", err
+	}
+	return tx.ID(), nil
+}
+
+func (n *fakeSolanaConformanceNode) Mine() (int64, error) {
+	return int64(n.fakeSolanaNode.Mine()), nil
+}
+
+func (n *fakeSolanaConformanceNode) Reorg(height int64) error {
+	return errors.New("finalized slots can not be reorged")
+}
@@ -0,0 +1,430 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// fakeFee is the fee in lamports of every transaction
+	fakeFee = 5000
+	// fakeBlockhashValidity is the number of slots a blockhash can be referred to
+	fakeBlockhashValidity = 150
+	// fakeGenesisTime is the unix time of slot 0, every slot lasts a second
+	fakeGenesisTime = 1_700_000_000
+)
+
+// fakeTokenAccount is a token account of the fake node
+type fakeTokenAccount struct {
+	owner, mint string
+	amount      uint64
+}
+
+// fakeSolanaNode is a Solana node serving the JSON-RPC calls of the client from memory.
+// Every other slot is skipped, blocks are final once produced and a slot lasts a second. A transaction is
+// executed when it is submitted, failing the submission when it can not be, and the
+// programs only support the instructions built by the client.
+type fakeSolanaNode struct {
+	lock          sync.Mutex
+	server        *httptest.Server
+	slot          uint64
+	blocks        map[uint64]*Block
+	blockhashes   map[string]uint64
+	mempool       []BlockTransaction
+	balances      map[string]uint64
+	tokens        map[string]fakeTokenAccount
+	statuses      map[string]*SignatureStatus
+	failBroadcast bool
+}
+
+// newFakeSolanaNode starts a new fake node, it must be closed once done
+func newFakeSolanaNode() *fakeSolanaNode {
+	n := &fakeSolanaNode{
+		blocks:      make(map[uint64]*Block),
+		blockhashes: make(map[string]uint64),
+		balances:    make(map[string]uint64),
+		tokens:      make(map[string]fakeTokenAccount),
+		statuses:    make(map[string]*SignatureStatus),
+	}
+	n.produce(0)
+	n.server = httptest.NewServer(http.HandlerFunc(n.serve))
+	return n
+}
+
+func (n *fakeSolanaNode) Close() {
+	n.server.Close()
+}
+
+// URL returns the url of the JSON-RPC endpoint
+func (n *fakeSolanaNode) URL() string {
+	return n.server.URL
+}
+
+// Fund credits lamports to the address
+func (n *fakeSolanaNode) Fund(address string, lamports uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.balances[address] += lamports
+}
+
+// FundToken credits tokens to the associated token account of the wallet
+func (n *fakeSolanaNode) FundToken(wallet, mint PublicKey, amount uint64) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	ata, err := AssociatedTokenAddress(wallet, mint)
+	if err != nil {
+		return err
+	}
+	account := n.tokens[ata.String()]
+	account.owner, account.mint = wallet.String(), mint.String()
+	account.amount += amount
+	n.tokens[ata.String()] = account
+	return nil
+}
+
+// Mine skips a slot and produces a block with the txs of the mempool in the next one,
+// and returns its slot
+func (n *fakeSolanaNode) Mine() uint64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.slot++
+	return n.produce(n.slot + 1)
+}
+
+func (n *fakeSolanaNode) produce(slot uint64) uint64 {
+	hash := sha256.Sum256(binary.LittleEndian.AppendUint64(nil, slot))
+	blockTime := int64(fakeGenesisTime + slot)
+	block := &Block{
+		Blockhash:    base58.Encode(hash[:]),
+		ParentSlot:   n.slot,
+		BlockTime:    &blockTime,
+		Transactions: n.mempool,
+	}
+	if prev, ok := n.blocks[n.slot]; ok {
+		block.PreviousBlockhash = prev.Blockhash
+	}
+	for _, tx := range n.mempool {
+		status := n.statuses[tx.Transaction.Signatures[0]]
+		status.Slot = slot
+		status.ConfirmationStatus = commitmentFinalized
+	}
+	n.mempool = nil
+	n.slot = slot
+	n.blocks[slot] = block
+	n.blockhashes[block.Blockhash] = slot
+	return slot
+}
+
+// FailBroadcast makes the next sendTransaction fail
+func (n *fakeSolanaNode) FailBroadcast() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.failBroadcast = true
+}
+
+// LatestBlockhash returns the hash of the last block
+func (n *fakeSolanaNode) LatestBlockhash() Hash {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	hash, _ := NewHash(n.blocks[n.slot].Blockhash)
+	return hash
+}
+
+// ReferenceTime returns the time of the block before the last one, whose blockhash an
+// outbound scheduled at a SWITCHLYChain block of that time refers to
+func (n *fakeSolanaNode) ReferenceTime() time.Time {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return time.Unix(*n.previousBlock().BlockTime, 0)
+}
+
+// ReferenceBlockhash returns the hash of the block before the last one
+func (n *fakeSolanaNode) ReferenceBlockhash() Hash {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	hash, _ := NewHash(n.previousBlock().Blockhash)
+	return hash
+}
+
+func (n *fakeSolanaNode) previousBlock() *Block {
+	for slot := n.slot - 1; ; slot-- {
+		if block, ok := n.blocks[slot]; ok {
+			return block
+		}
+	}
+}
+
+// Submit executes the serialized transaction and adds it to the mempool
+func (n *fakeSolanaNode) Submit(raw []byte) (string, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	signature, rpcErr := n.submit(raw)
+	if rpcErr != nil {
+		return "", rpcErr
+	}
+	return signature, nil
+}
+
+func (n *fakeSolanaNode) submit(raw []byte) (string, *RPCError) {
+	if n.failBroadcast {
+		n.failBroadcast = false
+		return "", &RPCError{Code: -32005, Message: "Node is unhealthy"}
+	}
+	tx, err := DecodeTransaction(raw)
+	if err != nil {
+		return "", &RPCError{Code: RPCErrInvalidParams, Message: err.Error()}
+	}
+	if !n.blockhashValid(tx.Message.RecentBlockhash.String()) {
+		return "", &RPCError{Code: -32002, Message: "Transaction simulation failed: Blockhash not found"}
+	}
+	msg := tx.Message.Serialize()
+	for i, signer := range tx.Message.Signers() {
+		if !ed25519.Verify(signer[:], msg, tx.Signatures[i][:]) {
+			return "", &RPCError{Code: -32003, Message: "Transaction signature verification failure"}
+		}
+	}
+	if _, ok := n.statuses[tx.Signature()]; ok {
+		return "", &RPCError{Code: -32002, Message: "Transaction simulation failed: This transaction has already been processed"}
+	}
+	blockTx, err := n.execute(tx)
+	if err != nil {
+		return "", &RPCError{Code: -32002, Message: fmt.Sprintf("Transaction simulation failed: %s", err)}
+	}
+	n.mempool = append(n.mempool, blockTx)
+	n.statuses[tx.Signature()] = &SignatureStatus{ConfirmationStatus: "processed"}
+	return tx.Signature(), nil
+}
+
+func (n *fakeSolanaNode) blockhashValid(blockhash string) bool {
+	slot, ok := n.blockhashes[blockhash]
+	return ok && n.slot-slot <= fakeBlockhashValidity
+}
+
+// execute applies the instructions of the tx to copies of the balances, which replace
+// the balances once they all succeeded, and returns the tx as reported in a block
+func (n *fakeSolanaNode) execute(tx Transaction) (BlockTransaction, error) {
+	msg := tx.Message
+	keys := make([]string, len(msg.AccountKeys))
+	for i, key := range msg.AccountKeys {
+		keys[i] = key.String()
+	}
+	isSigner := func(i uint8) bool {
+		return i < msg.Header.NumRequiredSignatures
+	}
+	balances := maps.Clone(n.balances)
+	tokens := maps.Clone(n.tokens)
+
+	if balances[keys[0]] < fakeFee {
+		return BlockTransaction{}, fmt.Errorf("insufficient funds for fee")
+	}
+	balances[keys[0]] -= fakeFee
+
+	for _, ix := range msg.Instructions {
+		switch msg.AccountKeys[ix.ProgramIDIndex] {
+		case SystemProgramID:
+			if len(ix.Data) != 12 || binary.LittleEndian.Uint32(ix.Data) != systemInstructionTransfer || len(ix.Accounts) != 2 {
+				return BlockTransaction{}, fmt.Errorf("unsupported system instruction")
+			}
+			from, to := ix.Accounts[0], ix.Accounts[1]
+			lamports := binary.LittleEndian.Uint64(ix.Data[4:])
+			if !isSigner(from) {
+				return BlockTransaction{}, fmt.Errorf("missing signature of %s", keys[from])
+			}
+			if balances[keys[from]] < lamports {
+				return BlockTransaction{}, fmt.Errorf("insufficient lamports %d, need %d", balances[keys[from]], lamports)
+			}
+			balances[keys[from]] -= lamports
+			balances[keys[to]] += lamports
+
+		case AssociatedTokenProgramID:
+			if len(ix.Accounts) != 6 || len(ix.Data) != 1 || ix.Data[0] != associatedTokenInstructionCreateIdempotent {
+				return BlockTransaction{}, fmt.Errorf("unsupported associated token instruction")
+			}
+			ata, wallet, mint := msg.AccountKeys[ix.Accounts[1]], msg.AccountKeys[ix.Accounts[2]], msg.AccountKeys[ix.Accounts[3]]
+			expected, err := AssociatedTokenAddress(wallet, mint)
+			if err != nil || expected != ata {
+				return BlockTransaction{}, fmt.Errorf("invalid associated token account %s", ata)
+			}
+			if _, ok := tokens[ata.String()]; !ok {
+				tokens[ata.String()] = fakeTokenAccount{owner: wallet.String(), mint: mint.String()}
+			}
+
+		case TokenProgramID:
+			if len(ix.Accounts) != 4 || len(ix.Data) != 10 || ix.Data[0] != tokenInstructionTransferChecked {
+				return BlockTransaction{}, fmt.Errorf("unsupported token instruction")
+			}
+			src, dst := tokens[keys[ix.Accounts[0]]], tokens[keys[ix.Accounts[2]]]
+			mint, owner := keys[ix.Accounts[1]], ix.Accounts[3]
+			amount := binary.LittleEndian.Uint64(ix.Data[1:9])
+			switch {
+			case src.mint != mint || dst.mint != mint:
+				return BlockTransaction{}, fmt.Errorf("invalid token accounts")
+			case src.owner != keys[owner] || !isSigner(owner):
+				return BlockTransaction{}, fmt.Errorf("owner does not match")
+			case src.amount < amount:
+				return BlockTransaction{}, fmt.Errorf("insufficient tokens")
+			}
+			src.amount -= amount
+			tokens[keys[ix.Accounts[0]]] = src
+			dst = tokens[keys[ix.Accounts[2]]]
+			dst.amount += amount
+			tokens[keys[ix.Accounts[2]]] = dst
+
+		case MemoProgramID, MemoV1ProgramID:
+		default:
+			return BlockTransaction{}, fmt.Errorf("unsupported program %s", msg.AccountKeys[ix.ProgramIDIndex])
+		}
+	}
+	n.balances, n.tokens = balances, tokens
+
+	blockTx := BlockTransaction{
+		Transaction: RPCTransaction{
+			Message: RPCMessage{
+				AccountKeys:     keys,
+				RecentBlockhash: msg.RecentBlockhash.String(),
+			},
+		},
+		Meta: &TransactionMeta{Fee: fakeFee},
+	}
+	for _, sig := range tx.Signatures {
+		blockTx.Transaction.Signatures = append(blockTx.Transaction.Signatures, base58.Encode(sig[:]))
+	}
+	for _, ix := range msg.Instructions {
+		rpcIx := RPCInstruction{
+			ProgramIDIndex: int(ix.ProgramIDIndex),
+			Accounts:       []int{},
+			Data:           base58.Encode(ix.Data),
+		}
+		for _, account := range ix.Accounts {
+			rpcIx.Accounts = append(rpcIx.Accounts, int(account))
+		}
+		blockTx.Transaction.Message.Instructions = append(blockTx.Transaction.Message.Instructions, rpcIx)
+	}
+	for i, key := range keys {
+		blockTx.Meta.PostBalances = append(blockTx.Meta.PostBalances, balances[key])
+		if account, ok := tokens[key]; ok {
+			blockTx.Meta.PostTokenBalances = append(blockTx.Meta.PostTokenBalances, TokenBalance{
+				AccountIndex:  i,
+				Mint:          account.mint,
+				Owner:         account.owner,
+				ProgramID:     TokenProgramID.String(),
+				UITokenAmount: TokenAmount{Amount: strconv.FormatUint(account.amount, 10)},
+			})
+		}
+	}
+	return blockTx, nil
+}
+
+type fakeRPCRequest struct {
+	ID     uint64            `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func (n *fakeSolanaNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req fakeRPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	n.lock.Lock()
+	result, rpcErr := n.handle(req.Method, req.Params)
+	n.lock.Unlock()
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	if rpcErr != nil {
+		resp["error"] = rpcErr
+	} else {
+		resp["result"] = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (n *fakeSolanaNode) handle(method string, params []json.RawMessage) (any, *RPCError) {
+	withContext := func(value any) any {
+		return map[string]any{"context": map[string]any{"slot": n.slot}, "value": value}
+	}
+	var first string
+	if len(params) > 0 {
+		_ = json.Unmarshal(params[0], &first)
+	}
+
+	switch method {
+	case "getSlot":
+		return n.slot, nil
+
+	case "getBlock":
+		var slot uint64
+		if len(params) == 0 || json.Unmarshal(params[0], &slot) != nil {
+			return nil, &RPCError{Code: RPCErrInvalidParams, Message: "Invalid params"}
+		}
+		if slot > n.slot {
+			return nil, &RPCError{Code: RPCErrBlockNotAvailable, Message: fmt.Sprintf("Block not available for slot %d", slot)}
+		}
+		block, ok := n.blocks[slot]
+		if !ok {
+			return nil, &RPCError{Code: RPCErrSlotSkipped, Message: fmt.Sprintf("Slot %d was skipped, or missing due to ledger jump to recent snapshot", slot)}
+		}
+		return block, nil
+
+	case "getBlockTime":
+		var slot uint64
+		if len(params) == 0 || json.Unmarshal(params[0], &slot) != nil {
+			return nil, &RPCError{Code: RPCErrInvalidParams, Message: "Invalid params"}
+		}
+		block, ok := n.blocks[slot]
+		if !ok || slot > n.slot {
+			return nil, &RPCError{Code: RPCErrSlotSkipped, Message: fmt.Sprintf("Slot %d was skipped, or missing due to ledger jump to recent snapshot", slot)}
+		}
+		return block.BlockTime, nil
+
+	case "getBalance":
+		return withContext(n.balances[first]), nil
+
+	case "getTokenAccountBalance":
+		account, ok := n.tokens[first]
+		if !ok {
+			return nil, &RPCError{Code: RPCErrInvalidParams, Message: "Invalid param: could not find account"}
+		}
+		return withContext(TokenAmount{Amount: strconv.FormatUint(account.amount, 10)}), nil
+
+	case "isBlockhashValid":
+		return withContext(n.blockhashValid(first)), nil
+
+	case "sendTransaction":
+		raw, err := base64.StdEncoding.DecodeString(first)
+		if err != nil {
+			return nil, &RPCError{Code: RPCErrInvalidParams, Message: err.Error()}
+		}
+		signature, rpcErr := n.submit(raw)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		return signature, nil
+
+	case "getSignatureStatuses":
+		var signatures []string
+		if len(params) == 0 || json.Unmarshal(params[0], &signatures) != nil {
+			return nil, &RPCError{Code: RPCErrInvalidParams, Message: "Invalid params"}
+		}
+		statuses := make([]*SignatureStatus, len(signatures))
+		for i, sig := range signatures {
+			statuses[i] = n.statuses[sig]
+		}
+		return withContext(statuses), nil
+	}
+	return nil, &RPCError{Code: -32601, Message: "Method not found"}
+}
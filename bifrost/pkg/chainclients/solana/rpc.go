@@ -0,0 +1,319 @@
+package solana
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// commitmentFinalized only returns data of blocks confirmed by a supermajority and
+	// rooted, which can not be rolled back
+	commitmentFinalized = "finalized"
+
+	rpcTimeout = 10 * time.Second
+)
+
+// JSON-RPC error codes returned by the Solana nodes
+const (
+	// RPCErrInvalidParams is returned for invalid params, like the balance of a token
+	// account which doesn't exist
+	RPCErrInvalidParams = -32602
+	// RPCErrBlockNotAvailable is returned for a slot beyond the highest finalized slot of
+	// the node
+	RPCErrBlockNotAvailable = -32004
+	// RPCErrSlotSkipped is returned for a slot the leader did not produce a block for
+	RPCErrSlotSkipped = -32007
+	// RPCErrLongTermStorageSlotSkipped is returned by nodes with long term storage for a
+	// skipped slot
+	RPCErrLongTermStorageSlotSkipped = -32009
+)
+
+// RPCError is an error returned by a Solana node
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// IsRPCError returns true when err is an RPCError with the given code
+func IsRPCError(err error, code int) bool {
+	var rpcErr *RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == code
+}
+
+// RPCClient is a JSON-RPC client of a Solana node
+type RPCClient struct {
+	host   string
+	client *http.Client
+	id     atomic.Uint64
+}
+
+// NewRPCClient creates a new instance of RPCClient
+func NewRPCClient(host string) *RPCClient {
+	return &RPCClient{
+		host:   host,
+		client: &http.Client{Timeout: rpcTimeout},
+	}
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+}
+
+func (c *RPCClient) call(method string, result any, params ...any) error {
+	if params == nil {
+		params = []any{}
+	}
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "2.0",
+		ID:      c.id.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to marshal %s request: %w", method, err)
+	}
+	resp, err := c.client.Post(c.host, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("fail to call %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("fail to read %s response: %w", method, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fail to call %s: status %d: %s", method, resp.StatusCode, string(buf))
+	}
+	var rpcResp rpcResponse
+	if err = json.Unmarshal(buf, &rpcResp); err != nil {
+		return fmt.Errorf("fail to unmarshal %s response: %w", method, err)
+	}
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+	if result == nil {
+		return nil
+	}
+	if err = json.Unmarshal(rpcResp.Result, result); err != nil {
+		return fmt.Errorf("fail to unmarshal %s result: %w", method, err)
+	}
+	return nil
+}
+
+type commitmentConfig struct {
+	Commitment string `json:"commitment"`
+}
+
+// contextResult is the result of the methods returning the slot they were evaluated at
+type contextResult[T any] struct {
+	Context struct {
+		Slot uint64 `json:"slot"`
+	} `json:"context"`
+	Value T `json:"value"`
+}
+
+// GetSlot returns the highest finalized slot
+func (c *RPCClient) GetSlot() (uint64, error) {
+	var slot uint64
+	err := c.call("getSlot", &slot, commitmentConfig{Commitment: commitmentFinalized})
+	return slot, err
+}
+
+// Block is a block returned by getBlock with the transactions in json encoding
+type Block struct {
+	Blockhash         string             `json:"blockhash"`
+	PreviousBlockhash string             `json:"previousBlockhash"`
+	ParentSlot        uint64             `json:"parentSlot"`
+	BlockHeight       *uint64            `json:"blockHeight"`
+	BlockTime         *int64             `json:"blockTime"`
+	Transactions      []BlockTransaction `json:"transactions"`
+}
+
+// BlockTransaction is a transaction of a block with its execution status
+type BlockTransaction struct {
+	Transaction RPCTransaction   `json:"transaction"`
+	Meta        *TransactionMeta `json:"meta"`
+	Version     any              `json:"version,omitempty"`
+}
+
+// RPCTransaction is a transaction in json encoding
+type RPCTransaction struct {
+	Signatures []string   `json:"signatures"`
+	Message    RPCMessage `json:"message"`
+}
+
+// RPCMessage is a transaction message in json encoding
+type RPCMessage struct {
+	AccountKeys     []string         `json:"accountKeys"`
+	RecentBlockhash string           `json:"recentBlockhash"`
+	Instructions    []RPCInstruction `json:"instructions"`
+}
+
+// RPCInstruction is a compiled instruction in json encoding, data is base58 encoded
+type RPCInstruction struct {
+	ProgramIDIndex int    `json:"programIdIndex"`
+	Accounts       []int  `json:"accounts"`
+	Data           string `json:"data"`
+}
+
+// TransactionMeta is the execution status of a transaction
+type TransactionMeta struct {
+	Err               any            `json:"err"`
+	Fee               uint64         `json:"fee"`
+	PreBalances       []uint64       `json:"preBalances"`
+	PostBalances      []uint64       `json:"postBalances"`
+	PreTokenBalances  []TokenBalance `json:"preTokenBalances"`
+	PostTokenBalances []TokenBalance `json:"postTokenBalances"`
+	LoadedAddresses   *struct {
+		Writable []string `json:"writable"`
+		Readonly []string `json:"readonly"`
+	} `json:"loadedAddresses,omitempty"`
+}
+
+// TokenBalance is the balance of a token account of a transaction
+type TokenBalance struct {
+	AccountIndex  int         `json:"accountIndex"`
+	Mint          string      `json:"mint"`
+	Owner         string      `json:"owner"`
+	ProgramID     string      `json:"programId"`
+	UITokenAmount TokenAmount `json:"uiTokenAmount"`
+}
+
+// TokenAmount is an amount of tokens, Amount is in the token base units
+type TokenAmount struct {
+	Amount   string `json:"amount"`
+	Decimals uint8  `json:"decimals"`
+}
+
+type getBlockConfig struct {
+	Encoding                       string `json:"encoding"`
+	TransactionDetails             string `json:"transactionDetails"`
+	Rewards                        bool   `json:"rewards"`
+	Commitment                     string `json:"commitment"`
+	MaxSupportedTransactionVersion int    `json:"maxSupportedTransactionVersion"`
+}
+
+// GetBlock returns the finalized block of the given slot
+func (c *RPCClient) GetBlock(slot uint64) (*Block, error) {
+	var block Block
+	err := c.call("getBlock", &block, slot, getBlockConfig{
+		Encoding:                       "json",
+		TransactionDetails:             "full",
+		Rewards:                        false,
+		Commitment:                     commitmentFinalized,
+		MaxSupportedTransactionVersion: 0,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// GetBlockhash returns the blockhash of the finalized block of the given slot
+func (c *RPCClient) GetBlockhash(slot uint64) (string, error) {
+	var block Block
+	err := c.call("getBlock", &block, slot, getBlockConfig{
+		Encoding:                       "json",
+		TransactionDetails:             "none",
+		Rewards:                        false,
+		Commitment:                     commitmentFinalized,
+		MaxSupportedTransactionVersion: 0,
+	})
+	return block.Blockhash, err
+}
+
+// GetBlockTime returns the unix time the finalized block of the given slot was produced at
+func (c *RPCClient) GetBlockTime(slot uint64) (int64, error) {
+	var blockTime *int64
+	if err := c.call("getBlockTime", &blockTime, slot); err != nil {
+		return 0, err
+	}
+	if blockTime == nil {
+		return 0, fmt.Errorf("block of slot %d has no time", slot)
+	}
+	return *blockTime, nil
+}
+
+// GetBalance returns the lamports balance of the account
+func (c *RPCClient) GetBalance(address string) (uint64, error) {
+	var result contextResult[uint64]
+	err := c.call("getBalance", &result, address, commitmentConfig{Commitment: commitmentFinalized})
+	return result.Value, err
+}
+
+// GetTokenAccountBalance returns the balance of the token account
+func (c *RPCClient) GetTokenAccountBalance(address string) (TokenAmount, error) {
+	var result contextResult[TokenAmount]
+	err := c.call("getTokenAccountBalance", &result, address, commitmentConfig{Commitment: commitmentFinalized})
+	return result.Value, err
+}
+
+// IsBlockhashValid returns whether a transaction referring to the blockhash can still
+// be processed
+func (c *RPCClient) IsBlockhashValid(blockhash string) (bool, error) {
+	var result contextResult[bool]
+	err := c.call("isBlockhashValid", &result, blockhash, commitmentConfig{Commitment: "processed"})
+	return result.Value, err
+}
+
+type sendTransactionConfig struct {
+	Encoding            string `json:"encoding"`
+	PreflightCommitment string `json:"preflightCommitment"`
+}
+
+// SendTransaction submits the serialized transaction and returns its signature
+func (c *RPCClient) SendTransaction(raw []byte) (string, error) {
+	var signature string
+	err := c.call("sendTransaction", &signature, base64.StdEncoding.EncodeToString(raw), sendTransactionConfig{
+		Encoding:            "base64",
+		PreflightCommitment: "confirmed",
+	})
+	return signature, err
+}
+
+// SignatureStatus is the processing status of a transaction
+type SignatureStatus struct {
+	Slot               uint64  `json:"slot"`
+	Confirmations      *uint64 `json:"confirmations"`
+	Err                any     `json:"err"`
+	ConfirmationStatus string  `json:"confirmationStatus"`
+}
+
+type getSignatureStatusesConfig struct {
+	SearchTransactionHistory bool `json:"searchTransactionHistory"`
+}
+
+// GetSignatureStatus returns the status of the transaction with the given signature,
+// nil when the transaction is unknown to the node
+func (c *RPCClient) GetSignatureStatus(signature string) (*SignatureStatus, error) {
+	var result contextResult[[]*SignatureStatus]
+	err := c.call("getSignatureStatuses", &result, []string{signature}, getSignatureStatusesConfig{
+		SearchTransactionHistory: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Value) == 0 {
+		return nil, nil
+	}
+	return result.Value[0], nil
+}
@@ -0,0 +1,524 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// PublicKeySize is the size of a Solana account address
+	PublicKeySize = 32
+
+	// SignatureSize is the size of an ed25519 transaction signature
+	SignatureSize = 64
+
+	// MaxTransactionSize is the max size of a serialized transaction, the IPv6 MTU minus
+	// the headers
+	MaxTransactionSize = 1232
+
+	// maxSeeds and maxSeedLength bound the seeds of a program derived address
+	maxSeeds      = 16
+	maxSeedLength = 32
+
+	// messageVersionPrefix is set on the first byte of a versioned message, legacy
+	// messages start with the number of required signatures which is below it
+	messageVersionPrefix = 0x80
+
+	systemInstructionTransfer                  = 2
+	tokenInstructionTransfer                   = 3
+	tokenInstructionTransferChecked            = 12
+	associatedTokenInstructionCreateIdempotent = 1
+)
+
+var (
+	SystemProgramID          = MustPublicKey("11111111111111111111111111111111")
+	TokenProgramID           = MustPublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	AssociatedTokenProgramID = MustPublicKey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
+	// MemoProgramID is the memo program used by the wallets, its memos are observed
+	MemoProgramID = MustPublicKey("MemoSq4gqABAXKfzGYa3wRsVZcTTttBFdd6tDKjMJ1Q")
+	// MemoV1ProgramID is the first memo program, which requires no signer and is used
+	// for the outbound memos
+	MemoV1ProgramID = MustPublicKey("Memo1UhkJRfHyvLMcVucJwxXeuD728EqVDDwQDxFMNo")
+
+	ErrInvalidTransaction = errors.New("invalid solana transaction")
+)
+
+// PublicKey is a Solana account address: an ed25519 public key, or a program derived
+// address which is off the curve
+type PublicKey [PublicKeySize]byte
+
+// NewPublicKey decodes a base58 encoded account address
+func NewPublicKey(address string) (PublicKey, error) {
+	var pk PublicKey
+	decoded, err := base58.Decode(address)
+	if err != nil {
+		return pk, fmt.Errorf("fail to decode address %s: %w", address, err)
+	}
+	if len(decoded) != PublicKeySize {
+		return pk, fmt.Errorf("invalid address %s: %d bytes", address, len(decoded))
+	}
+	copy(pk[:], decoded)
+	return pk, nil
+}
+
+// MustPublicKey decodes a base58 encoded account address, it panics on invalid input
+func MustPublicKey(address string) PublicKey {
+	pk, err := NewPublicKey(address)
+	if err != nil {
+		panic(err)
+	}
+	return pk
+}
+
+func (p PublicKey) String() string {
+	return base58.Encode(p[:])
+}
+
+// Hash is a Solana block hash, a transaction refers to a recent one to expire
+type Hash [32]byte
+
+// NewHash decodes a base58 encoded block hash
+func NewHash(hash string) (Hash, error) {
+	var h Hash
+	decoded, err := base58.Decode(hash)
+	if err != nil {
+		return h, fmt.Errorf("fail to decode hash %s: %w", hash, err)
+	}
+	if len(decoded) != len(h) {
+		return h, fmt.Errorf("invalid hash %s: %d bytes", hash, len(decoded))
+	}
+	copy(h[:], decoded)
+	return h, nil
+}
+
+func (h Hash) String() string {
+	return base58.Encode(h[:])
+}
+
+// isOnCurve returns true when the given bytes are a point of the ed25519 curve
+func isOnCurve(b []byte) bool {
+	_, err := new(edwards25519.Point).SetBytes(b)
+	return err == nil
+}
+
+// createProgramAddress returns the program derived address of the given seeds, which
+// must be off the ed25519 curve so nobody holds its private key
+func createProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, error) {
+	if len(seeds) > maxSeeds {
+		return PublicKey{}, fmt.Errorf("too many seeds: %d", len(seeds))
+	}
+	h := sha256.New()
+	for _, seed := range seeds {
+		if len(seed) > maxSeedLength {
+			return PublicKey{}, fmt.Errorf("seed too long: %d bytes", len(seed))
+		}
+		h.Write(seed)
+	}
+	h.Write(programID[:])
+	h.Write([]byte("ProgramDerivedAddress"))
+	var pk PublicKey
+	copy(pk[:], h.Sum(nil))
+	if isOnCurve(pk[:]) {
+		return PublicKey{}, errors.New("program address is on the curve")
+	}
+	return pk, nil
+}
+
+// FindProgramAddress returns the first program derived address of the given seeds off
+// the curve, searching the bump seed down from 255, and the bump seed
+func FindProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, uint8, error) {
+	for bump := 255; bump >= 0; bump-- {
+		pk, err := createProgramAddress(append(seeds, []byte{byte(bump)}), programID)
+		if err == nil {
+			return pk, uint8(bump), nil
+		}
+	}
+	return PublicKey{}, 0, errors.New("unable to find a valid program address")
+}
+
+// AssociatedTokenAddress returns the address of the token account holding the tokens of
+// the given mint for the wallet
+func AssociatedTokenAddress(wallet, mint PublicKey) (PublicKey, error) {
+	pk, _, err := FindProgramAddress([][]byte{wallet[:], TokenProgramID[:], mint[:]}, AssociatedTokenProgramID)
+	return pk, err
+}
+
+// AccountMeta is an account an instruction reads or writes
+type AccountMeta struct {
+	PublicKey  PublicKey
+	IsSigner   bool
+	IsWritable bool
+}
+
+// Instruction is a call to a program
+type Instruction struct {
+	ProgramID PublicKey
+	Accounts  []AccountMeta
+	Data      []byte
+}
+
+// NewTransferInstruction returns a system program transfer of lamports
+func NewTransferInstruction(from, to PublicKey, lamports uint64) Instruction {
+	data := make([]byte, 12)
+	binary.LittleEndian.PutUint32(data, systemInstructionTransfer)
+	binary.LittleEndian.PutUint64(data[4:], lamports)
+	return Instruction{
+		ProgramID: SystemProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: from, IsSigner: true, IsWritable: true},
+			{PublicKey: to, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+// NewTransferCheckedInstruction returns a token program transfer between two token
+// accounts of the given mint, authorized by the owner of the source account
+func NewTransferCheckedInstruction(source, mint, destination, owner PublicKey, amount uint64, decimals uint8) Instruction {
+	data := make([]byte, 10)
+	data[0] = tokenInstructionTransferChecked
+	binary.LittleEndian.PutUint64(data[1:], amount)
+	data[9] = decimals
+	return Instruction{
+		ProgramID: TokenProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: source, IsWritable: true},
+			{PublicKey: mint},
+			{PublicKey: destination, IsWritable: true},
+			{PublicKey: owner, IsSigner: true},
+		},
+		Data: data,
+	}
+}
+
+// NewCreateAssociatedTokenAccountIdempotentInstruction returns an instruction creating
+// the associated token account of the wallet for the mint, paid by the payer, which
+// succeeds when the account already exists
+func NewCreateAssociatedTokenAccountIdempotentInstruction(payer, wallet, mint PublicKey) (Instruction, error) {
+	ata, err := AssociatedTokenAddress(wallet, mint)
+	if err != nil {
+		return Instruction{}, err
+	}
+	return Instruction{
+		ProgramID: AssociatedTokenProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: payer, IsSigner: true, IsWritable: true},
+			{PublicKey: ata, IsWritable: true},
+			{PublicKey: wallet},
+			{PublicKey: mint},
+			{PublicKey: SystemProgramID},
+			{PublicKey: TokenProgramID},
+		},
+		Data: []byte{associatedTokenInstructionCreateIdempotent},
+	}, nil
+}
+
+// NewMemoInstruction returns a memo program instruction, the memo is logged as is
+func NewMemoInstruction(memo string) Instruction {
+	return Instruction{
+		ProgramID: MemoV1ProgramID,
+		Data:      []byte(memo),
+	}
+}
+
+// MessageHeader counts the signers and the read only accounts of a message
+type MessageHeader struct {
+	NumRequiredSignatures       uint8
+	NumReadonlySignedAccounts   uint8
+	NumReadonlyUnsignedAccounts uint8
+}
+
+// CompiledInstruction is an instruction referring to the accounts of its message by index
+type CompiledInstruction struct {
+	ProgramIDIndex uint8
+	Accounts       []uint8
+	Data           []byte
+}
+
+// Message is a legacy transaction message, the payload signed by the signers
+type Message struct {
+	Header          MessageHeader
+	AccountKeys     []PublicKey
+	RecentBlockhash Hash
+	Instructions    []CompiledInstruction
+}
+
+// NewMessage compiles the instructions into a message paid by the fee payer. The
+// accounts are ordered as the runtime expects them: the fee payer first, then the
+// writable signers, the read only signers, the writable and the read only accounts.
+func NewMessage(feePayer PublicKey, instructions []Instruction, recentBlockhash Hash) (Message, error) {
+	metas := []AccountMeta{{PublicKey: feePayer, IsSigner: true, IsWritable: true}}
+	index := map[PublicKey]int{feePayer: 0}
+	add := func(meta AccountMeta) {
+		if i, ok := index[meta.PublicKey]; ok {
+			metas[i].IsSigner = metas[i].IsSigner || meta.IsSigner
+			metas[i].IsWritable = metas[i].IsWritable || meta.IsWritable
+			return
+		}
+		index[meta.PublicKey] = len(metas)
+		metas = append(metas, meta)
+	}
+	for _, ix := range instructions {
+		for _, meta := range ix.Accounts {
+			add(meta)
+		}
+		add(AccountMeta{PublicKey: ix.ProgramID})
+	}
+
+	// stable partition, so the fee payer stays first and the order of appearance is kept
+	ordered := make([]AccountMeta, 0, len(metas))
+	for _, group := range []struct{ signer, writable bool }{{true, true}, {true, false}, {false, true}, {false, false}} {
+		for _, meta := range metas {
+			if meta.IsSigner == group.signer && meta.IsWritable == group.writable {
+				ordered = append(ordered, meta)
+			}
+		}
+	}
+	if len(ordered) > 256 {
+		return Message{}, fmt.Errorf("too many accounts: %d", len(ordered))
+	}
+
+	msg := Message{RecentBlockhash: recentBlockhash}
+	for i, meta := range ordered {
+		index[meta.PublicKey] = i
+		msg.AccountKeys = append(msg.AccountKeys, meta.PublicKey)
+		switch {
+		case meta.IsSigner && meta.IsWritable:
+			msg.Header.NumRequiredSignatures++
+		case meta.IsSigner:
+			msg.Header.NumRequiredSignatures++
+			msg.Header.NumReadonlySignedAccounts++
+		case !meta.IsWritable:
+			msg.Header.NumReadonlyUnsignedAccounts++
+		}
+	}
+	for _, ix := range instructions {
+		compiled := CompiledInstruction{
+			ProgramIDIndex: uint8(index[ix.ProgramID]),
+			Data:           ix.Data,
+		}
+		for _, meta := range ix.Accounts {
+			compiled.Accounts = append(compiled.Accounts, uint8(index[meta.PublicKey]))
+		}
+		msg.Instructions = append(msg.Instructions, compiled)
+	}
+	return msg, nil
+}
+
+// Signers returns the accounts which must sign the message, in signature order
+func (m Message) Signers() []PublicKey {
+	return m.AccountKeys[:m.Header.NumRequiredSignatures]
+}
+
+// Serialize returns the wire encoding of the message, the bytes being signed
+func (m Message) Serialize() []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{m.Header.NumRequiredSignatures, m.Header.NumReadonlySignedAccounts, m.Header.NumReadonlyUnsignedAccounts})
+	writeCompactU16(&buf, len(m.AccountKeys))
+	for _, key := range m.AccountKeys {
+		buf.Write(key[:])
+	}
+	buf.Write(m.RecentBlockhash[:])
+	writeCompactU16(&buf, len(m.Instructions))
+	for _, ix := range m.Instructions {
+		buf.WriteByte(ix.ProgramIDIndex)
+		writeCompactU16(&buf, len(ix.Accounts))
+		buf.Write(ix.Accounts)
+		writeCompactU16(&buf, len(ix.Data))
+		buf.Write(ix.Data)
+	}
+	return buf.Bytes()
+}
+
+// Transaction is a message with the signatures of its signers
+type Transaction struct {
+	Signatures [][SignatureSize]byte
+	Message    Message
+}
+
+// NewTransaction returns an unsigned transaction of the message
+func NewTransaction(msg Message) Transaction {
+	return Transaction{
+		Signatures: make([][SignatureSize]byte, msg.Header.NumRequiredSignatures),
+		Message:    msg,
+	}
+}
+
+// AddSignature sets the signature of the given signer
+func (tx *Transaction) AddSignature(signer PublicKey, signature []byte) error {
+	if len(signature) != SignatureSize {
+		return fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+	for i, key := range tx.Message.Signers() {
+		if key == signer {
+			copy(tx.Signatures[i][:], signature)
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a signer of the transaction", signer)
+}
+
+// Signature returns the base58 encoded first signature, identifying the transaction on
+// the network
+func (tx Transaction) Signature() string {
+	if len(tx.Signatures) == 0 {
+		return ""
+	}
+	return base58.Encode(tx.Signatures[0][:])
+}
+
+// ID returns the id the transaction is reported with to SWITCHLYChain, the hex encoded
+// first signature, as a tx id must be hex and the base58 signature is case sensitive
+func (tx Transaction) ID() string {
+	if len(tx.Signatures) == 0 {
+		return ""
+	}
+	return hex.EncodeToString(tx.Signatures[0][:])
+}
+
+// SignatureToTxID converts a base58 encoded transaction signature to the tx id
+func SignatureToTxID(signature string) (string, error) {
+	decoded, err := base58.Decode(signature)
+	if err != nil {
+		return "", fmt.Errorf("fail to decode signature %s: %w", signature, err)
+	}
+	if len(decoded) != SignatureSize {
+		return "", fmt.Errorf("invalid signature %s: %d bytes", signature, len(decoded))
+	}
+	return hex.EncodeToString(decoded), nil
+}
+
+// Serialize returns the wire encoding of the transaction
+func (tx Transaction) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	writeCompactU16(&buf, len(tx.Signatures))
+	for _, sig := range tx.Signatures {
+		buf.Write(sig[:])
+	}
+	buf.Write(tx.Message.Serialize())
+	if buf.Len() > MaxTransactionSize {
+		return nil, fmt.Errorf("transaction too large: %d bytes", buf.Len())
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeTransaction decodes the wire encoding of a legacy transaction
+func DecodeTransaction(raw []byte) (Transaction, error) {
+	r := bytes.NewReader(raw)
+	var tx Transaction
+	numSigs, err := readCompactU16(r)
+	if err != nil {
+		return tx, err
+	}
+	tx.Signatures = make([][SignatureSize]byte, numSigs)
+	for i := range tx.Signatures {
+		if _, err = readFull(r, tx.Signatures[i][:]); err != nil {
+			return tx, err
+		}
+	}
+
+	var header [3]byte
+	if _, err = readFull(r, header[:]); err != nil {
+		return tx, err
+	}
+	if header[0]&messageVersionPrefix != 0 {
+		return tx, fmt.Errorf("%w: versioned messages are not supported", ErrInvalidTransaction)
+	}
+	tx.Message.Header = MessageHeader{
+		NumRequiredSignatures:       header[0],
+		NumReadonlySignedAccounts:   header[1],
+		NumReadonlyUnsignedAccounts: header[2],
+	}
+	numKeys, err := readCompactU16(r)
+	if err != nil {
+		return tx, err
+	}
+	tx.Message.AccountKeys = make([]PublicKey, numKeys)
+	for i := range tx.Message.AccountKeys {
+		if _, err = readFull(r, tx.Message.AccountKeys[i][:]); err != nil {
+			return tx, err
+		}
+	}
+	if _, err = readFull(r, tx.Message.RecentBlockhash[:]); err != nil {
+		return tx, err
+	}
+	numInstructions, err := readCompactU16(r)
+	if err != nil {
+		return tx, err
+	}
+	for i := 0; i < numInstructions; i++ {
+		var ix CompiledInstruction
+		if ix.ProgramIDIndex, err = r.ReadByte(); err != nil {
+			return tx, fmt.Errorf("%w: %w", ErrInvalidTransaction, err)
+		}
+		if ix.Accounts, err = readCompactBytes(r); err != nil {
+			return tx, err
+		}
+		if ix.Data, err = readCompactBytes(r); err != nil {
+			return tx, err
+		}
+		tx.Message.Instructions = append(tx.Message.Instructions, ix)
+	}
+	if r.Len() != 0 {
+		return tx, fmt.Errorf("%w: %d trailing bytes", ErrInvalidTransaction, r.Len())
+	}
+	if int(tx.Message.Header.NumRequiredSignatures) != len(tx.Signatures) ||
+		int(tx.Message.Header.NumRequiredSignatures) > len(tx.Message.AccountKeys) {
+		return tx, fmt.Errorf("%w: %d signatures for %d signers", ErrInvalidTransaction, len(tx.Signatures), tx.Message.Header.NumRequiredSignatures)
+	}
+	return tx, nil
+}
+
+// writeCompactU16 writes the length as a compact-u16, 7 bits per byte little endian
+func writeCompactU16(buf *bytes.Buffer, n int) {
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			buf.WriteByte(b)
+			return
+		}
+		buf.WriteByte(b | 0x80)
+	}
+}
+
+func readCompactU16(r *bytes.Reader) (int, error) {
+	n := 0
+	for i := 0; i < 3; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidTransaction, err)
+		}
+		n |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: compact-u16 overflow", ErrInvalidTransaction)
+}
+
+func readCompactBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := readCompactU16(r)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, n)
+	if _, err = readFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func readFull(r *bytes.Reader, b []byte) (int, error) {
+	if r.Len() < len(b) {
+		return 0, fmt.Errorf("%w: unexpected end of data", ErrInvalidTransaction)
+	}
+	return r.Read(b)
+}
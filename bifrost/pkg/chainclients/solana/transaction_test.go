@@ -0,0 +1,168 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type TransactionTestSuite struct{}
+
+var _ = Suite(&TransactionTestSuite{})
+
+func newTestKey(c *C) (PublicKey, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	c.Assert(err, IsNil)
+	var pk PublicKey
+	copy(pk[:], pub)
+	return pk, priv
+}
+
+func (s *TransactionTestSuite) TestCompactU16(c *C) {
+	for _, tc := range []struct {
+		n       int
+		encoded []byte
+	}{
+		{0, []byte{0x00}},
+		{0x7f, []byte{0x7f}},
+		{0x80, []byte{0x80, 0x01}},
+		{0x3fff, []byte{0xff, 0x7f}},
+		{0x4000, []byte{0x80, 0x80, 0x01}},
+		{0xffff, []byte{0xff, 0xff, 0x03}},
+	} {
+		var buf bytes.Buffer
+		writeCompactU16(&buf, tc.n)
+		c.Check(buf.Bytes(), DeepEquals, tc.encoded, Commentf("%d", tc.n))
+		n, err := readCompactU16(bytes.NewReader(tc.encoded))
+		c.Assert(err, IsNil)
+		c.Check(n, Equals, tc.n)
+	}
+
+	_, err := readCompactU16(bytes.NewReader([]byte{0x80, 0x80, 0x80}))
+	c.Check(err, NotNil)
+}
+
+func (s *TransactionTestSuite) TestNewMessage(c *C) {
+	payer, _ := newTestKey(c)
+	to, _ := newTestKey(c)
+	mint, _ := newTestKey(c)
+	source, err := AssociatedTokenAddress(payer, mint)
+	c.Assert(err, IsNil)
+	destination, err := AssociatedTokenAddress(to, mint)
+	c.Assert(err, IsNil)
+	create, err := NewCreateAssociatedTokenAccountIdempotentInstruction(payer, to, mint)
+	c.Assert(err, IsNil)
+
+	msg, err := NewMessage(payer, []Instruction{
+		create,
+		NewTransferCheckedInstruction(source, mint, destination, payer, 1000, 6),
+		NewMemoInstruction("OUT:hash"),
+	}, Hash{1})
+	c.Assert(err, IsNil)
+
+	// the payer is the only signer, the accounts are deduplicated and ordered by
+	// signer then writable
+	c.Check(msg.Header, Equals, MessageHeader{NumRequiredSignatures: 1, NumReadonlyUnsignedAccounts: 6})
+	c.Check(msg.AccountKeys, DeepEquals, []PublicKey{
+		payer, destination, source,
+		to, mint, SystemProgramID, TokenProgramID, AssociatedTokenProgramID, MemoV1ProgramID,
+	})
+	c.Check(msg.Signers(), DeepEquals, []PublicKey{payer})
+	c.Check(msg.Instructions[0], DeepEquals, CompiledInstruction{ProgramIDIndex: 7, Accounts: []uint8{0, 1, 3, 4, 5, 6}, Data: []byte{1}})
+	c.Check(msg.Instructions[1].ProgramIDIndex, Equals, uint8(6))
+	c.Check(msg.Instructions[1].Accounts, DeepEquals, []uint8{2, 4, 1, 0})
+	c.Check(msg.Instructions[2], DeepEquals, CompiledInstruction{ProgramIDIndex: 8, Data: []byte("OUT:hash")})
+}
+
+func (s *TransactionTestSuite) TestTransactionRoundTrip(c *C) {
+	from, priv := newTestKey(c)
+	to, _ := newTestKey(c)
+	msg, err := NewMessage(from, []Instruction{
+		NewTransferInstruction(from, to, 1_000_000),
+		NewMemoInstruction("ADD:SOL.SOL"),
+	}, Hash{2})
+	c.Assert(err, IsNil)
+
+	tx := NewTransaction(msg)
+	c.Check(tx.AddSignature(to, make([]byte, SignatureSize)), NotNil)
+	c.Check(tx.AddSignature(from, []byte{1}), NotNil)
+	c.Assert(tx.AddSignature(from, ed25519.Sign(priv, msg.Serialize())), IsNil)
+	raw, err := tx.Serialize()
+	c.Assert(err, IsNil)
+
+	decoded, err := DecodeTransaction(raw)
+	c.Assert(err, IsNil)
+	c.Check(decoded.Signatures, DeepEquals, tx.Signatures)
+	c.Check(decoded.Message.Serialize(), DeepEquals, msg.Serialize())
+	c.Check(ed25519.Verify(from[:], decoded.Message.Serialize(), decoded.Signatures[0][:]), Equals, true)
+	c.Check(decoded.ID(), HasLen, 128)
+	id, err := SignatureToTxID(decoded.Signature())
+	c.Assert(err, IsNil)
+	c.Check(id, Equals, decoded.ID())
+
+	// trailing and truncated data
+	_, err = DecodeTransaction(append(raw, 0))
+	c.Check(err, ErrorMatches, ".*trailing bytes")
+	_, err = DecodeTransaction(raw[:len(raw)-1])
+	c.Check(err, NotNil)
+
+	// versioned messages are not supported
+	versioned := append([]byte{}, raw...)
+	versioned[1+SignatureSize] |= messageVersionPrefix
+	_, err = DecodeTransaction(versioned)
+	c.Check(err, ErrorMatches, ".*versioned messages are not supported")
+
+	// too large transactions
+	msg, err = NewMessage(from, []Instruction{NewMemoInstruction(string(make([]byte, MaxTransactionSize)))}, Hash{})
+	c.Assert(err, IsNil)
+	_, err = NewTransaction(msg).Serialize()
+	c.Check(err, ErrorMatches, "transaction too large.*")
+}
+
+func (s *TransactionTestSuite) TestAssociatedTokenAddress(c *C) {
+	wallet, _ := newTestKey(c)
+	mint := MustPublicKey("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+
+	ata, err := AssociatedTokenAddress(wallet, mint)
+	c.Assert(err, IsNil)
+	again, err := AssociatedTokenAddress(wallet, mint)
+	c.Assert(err, IsNil)
+	c.Check(ata, Equals, again)
+	// a program derived address has no private key
+	c.Check(isOnCurve(ata[:]), Equals, false)
+	c.Check(isOnCurve(wallet[:]), Equals, true)
+
+	// the bump seed is the first one from 255 deriving an address off the curve
+	pda, bump, err := FindProgramAddress([][]byte{wallet[:], TokenProgramID[:], mint[:]}, AssociatedTokenProgramID)
+	c.Assert(err, IsNil)
+	c.Check(pda, Equals, ata)
+	for b := 255; b > int(bump); b-- {
+		_, err = createProgramAddress([][]byte{wallet[:], TokenProgramID[:], mint[:], {byte(b)}}, AssociatedTokenProgramID)
+		c.Check(err, NotNil)
+	}
+
+	other, err := AssociatedTokenAddress(mint, wallet)
+	c.Assert(err, IsNil)
+	c.Check(other, Not(Equals), ata)
+
+	_, err = createProgramAddress([][]byte{make([]byte, maxSeedLength+1)}, AssociatedTokenProgramID)
+	c.Check(err, ErrorMatches, "seed too long.*")
+}
+
+func (s *TransactionTestSuite) TestNewPublicKey(c *C) {
+	pk, err := NewPublicKey("11111111111111111111111111111111")
+	c.Assert(err, IsNil)
+	c.Check(pk, Equals, PublicKey{})
+	c.Check(pk.String(), Equals, "11111111111111111111111111111111")
+
+	_, err = NewPublicKey("0OIl")
+	c.Check(err, NotNil)
+	_, err = NewPublicKey("1111")
+	c.Check(err, NotNil)
+	_, err = NewHash("1111")
+	c.Check(err, NotNil)
+}
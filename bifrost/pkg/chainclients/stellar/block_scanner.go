@@ -21,6 +21,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 
 	"sync"
@@ -74,6 +75,10 @@ type StellarBlockScanner struct {
 
 	globalNetworkFeeQueue chan common.NetworkFee
 	globalTxsQueue        chan types.TxIn
+	globalErrataQueue     chan types.ErrataBlock
+
+	// reorgDetector is nil if reorg detection is disabled
+	reorgDetector *blockscanner.ReorgDetector
 
 	// feeCache contains a rolling window of suggested fees.
 	feeCache []sdkmath.Uint
@@ -139,12 +144,13 @@ func NewStellarBlockScanner(rpcHost string,
 		globalNetworkFeeQueue: globalNetworkFeeQueue,
 		globalTxsQueue:        globalTxsQueue,
 		feeCache:              make([]sdkmath.Uint, 0, FeeCacheTransactions),
-		lastFee:               sdkmath.NewUint(100), // Initialize with initial fee for mocknet
+		lastFee:               baseNetworkFee(), // Initialize with initial fee for mocknet
 		healthy:               newHealthyAtomicBool(),
 		stopChan:              make(chan struct{}),
 		wg:                    &sync.WaitGroup{},
 		isRunning:             &atomic.Bool{},
 	}
+	scanner.reorgDetector = blockscanner.NewReorgDetector(cfg, scanStorage, scanner)
 
 	scanner.logger.Info().
 		Str("module", "stellar").
@@ -204,6 +210,20 @@ func (c *StellarBlockScanner) GetHeight() (int64, error) {
 	return 0, fmt.Errorf("max retries exceeded for getting chain height")
 }
 
+// GetBlockHashes returns the hash and the parent hash of the ledger at the given height
+func (c *StellarBlockScanner) GetBlockHashes(height int64) (hash, parentHash string, err error) {
+	var ledger horizon.Ledger
+	err = c.retryHorizonCall("get_ledger", func() error {
+		var ledgerErr error
+		ledger, ledgerErr = c.horizonClient.LedgerDetail(uint32(height))
+		return ledgerErr
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get ledger %d: %w", height, err)
+	}
+	return ledger.Hash, ledger.PrevHash, nil
+}
+
 // findFirstAvailableLedger finds the first available ledger by checking from a starting point
 func (c *StellarBlockScanner) findFirstAvailableLedger(startHeight int64) (int64, error) {
 	currentHeight, err := c.GetHeight()
@@ -386,6 +406,25 @@ func (c *StellarBlockScanner) scanNewBlocks() (bool, error) {
 			continue
 		}
 
+		// Check the ledger extends the scanned chain, the block is retried on failure
+		if c.reorgDetector != nil {
+			var reorg *blockscanner.Reorg
+			reorg, err = c.reorgDetector.ProcessBlock(blockHeight, chainHeight, txIn)
+			if err != nil {
+				c.logger.Error().Err(err).Int64("block_height", blockHeight).
+					Msg("Failed to check block for reorg")
+				return false, err
+			}
+			if reorg != nil && !c.sendReorg(reorg) {
+				return true, nil
+			}
+			if err = c.reorgDetector.Commit(); err != nil {
+				c.logger.Error().Err(err).Int64("block_height", blockHeight).
+					Msg("Failed to record block for reorg")
+				return false, err
+			}
+		}
+
 		// Process transactions if any found
 		if len(txIn.TxArray) > 0 {
 			// Send transactions to global queue if needed
@@ -448,6 +487,28 @@ func (c *StellarBlockScanner) scanNewBlocks() (bool, error) {
 	return fullGap <= blocksToProcess, nil
 }
 
+// sendReorg reports the errata of a reorg and the txs of the rescanned ledgers, it
+// returns false if the scanner was stopped
+func (c *StellarBlockScanner) sendReorg(reorg *blockscanner.Reorg) bool {
+	if c.globalErrataQueue != nil {
+		for _, errata := range reorg.Errata {
+			select {
+			case <-c.stopChan:
+				return false
+			case c.globalErrataQueue <- errata:
+			}
+		}
+	}
+	for _, txIn := range reorg.Rescanned {
+		select {
+		case <-c.stopChan:
+			return false
+		case c.globalTxsQueue <- txIn:
+		}
+	}
+	return true
+}
+
 // HandleGapDetection performs Stellar-specific gap detection and position adjustment
 // This is called by the Stellar client before starting the blockscanner
 func (c *StellarBlockScanner) HandleGapDetection() error {
@@ -522,7 +583,7 @@ func (c *StellarBlockScanner) FetchLastHeight() (int64, error) {
 func (c *StellarBlockScanner) GetNetworkFee() (transactionSize, transactionFeeRate uint64) {
 	// Ensure we have a valid fee, fallback to initial fee if needed
 	if c.lastFee.IsZero() {
-		c.lastFee = sdkmath.NewUint(100)
+		c.lastFee = baseNetworkFee()
 	}
 	return 1, c.lastFee.Uint64()
 }
@@ -547,7 +608,7 @@ func (c *StellarBlockScanner) updateFeeCache(fee common.Coin) {
 func (c *StellarBlockScanner) averageFee() sdkmath.Uint {
 	// avoid divide by zero
 	if len(c.feeCache) == 0 {
-		return sdkmath.NewUint(100)
+		return sdkmath.ZeroUint()
 	}
 
 	// compute mean
@@ -570,8 +631,12 @@ func (c *StellarBlockScanner) updateFees(height int64) error {
 			return errors.New("suggested gas fee was zero")
 		}
 
+		// the average fee is in stroops, the fee model reports it in 1e8 and never below the base fee
+		txSize, txRate := feemodel.Get(c.cfg.ChainID).NetworkFee(feemodel.Observation{Rate: avgFee.Uint64()})
+		fee := sdkmath.NewUint(txRate)
+
 		// skip fee update if it has not changed
-		if c.lastFee.Equal(avgFee) {
+		if c.lastFee.Equal(fee) {
 			return nil
 		}
 
@@ -580,27 +645,27 @@ func (c *StellarBlockScanner) updateFees(height int64) error {
 			c.globalNetworkFeeQueue <- common.NetworkFee{
 				Chain:           c.cfg.ChainID,
 				Height:          height,
-				TransactionSize: 1,
-				TransactionRate: avgFee.Uint64(),
+				TransactionSize: txSize,
+				TransactionRate: txRate,
 			}
 
 			c.logger.Info().
-				Uint64("fee", avgFee.Uint64()).
+				Uint64("fee", txRate).
 				Int64("height", height).
 				Msg("sent network fee to SwitchlyProtocol")
 		} else {
 			c.logger.Warn().
-				Uint64("fee", avgFee.Uint64()).
+				Uint64("fee", txRate).
 				Int64("height", height).
 				Msg("global network fee queue not initialized, skipping fee update")
 		}
 
-		c.lastFee = avgFee
+		c.lastFee = fee
 	}
 
 	// Always ensure we have a network fee - send default fee every few blocks if needed
 	if height%FeeUpdatePeriodBlocks == 0 {
-		defaultFee := sdkmath.NewUint(100)
+		defaultFee := baseNetworkFee()
 
 		c.logger.Info().
 			Int64("height", height).
@@ -642,6 +707,13 @@ func (c *StellarBlockScanner) updateFees(height int64) error {
 	return nil
 }
 
+// baseNetworkFee returns the network fee of the Stellar base fee, which is reported
+// before any fee has been observed
+func baseNetworkFee() sdkmath.Uint {
+	_, rate := feemodel.Get(common.StellarChain).NetworkFee(feemodel.Observation{})
+	return sdkmath.NewUint(rate)
+}
+
 // reportInitialNetworkFee reports the initial network fee immediately on startup
 // This ensures SwitchlyNode has a valid fee from the beginning without waiting for blocks
 func (c *StellarBlockScanner) reportInitialNetworkFee() error {
@@ -654,9 +726,8 @@ func (c *StellarBlockScanner) reportInitialNetworkFee() error {
 	}
 	c.logger.Info().Msg("DEBUG: globalNetworkFeeQueue is not nil")
 
-	// Use a simple hardcoded fee to avoid any constant issues
-	// 100 stroops = 0.00001 XLM (very reasonable fallback fee)
-	initialFee := sdkmath.NewUint(100)
+	// Use the Stellar base fee of 100 stroops = 0.00001 XLM
+	initialFee := baseNetworkFee()
 	c.logger.Info().Msg("DEBUG: created initialFee")
 
 	// Report the initial fee immediately - use non-blocking send to avoid deadlock
@@ -365,6 +365,7 @@ func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan s
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.stellarScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
 	c.stellarScanner.globalTxsQueue = globalTxsQueue
+	c.stellarScanner.globalErrataQueue = globalErrataQueue
 	c.tssKeyManager.Start()
 
 	// Wait for the Stellar node to be fully synced, then start the block scanner — but do so in a
@@ -655,6 +656,11 @@ func (c *Client) getNextSequence(vaultPubKey common.PubKey) (int64, error) {
 	if err != nil {
 		return 0, fmt.Errorf("fail to resolve vault stellar address: %w", err)
 	}
+	return c.getAccountSequence(vaultPubKey, addr)
+}
+
+// getAccountSequence returns the current sequence of the given account of the vault.
+func (c *Client) getAccountSequence(vaultPubKey common.PubKey, addr common.Address) (int64, error) {
 	// Get current account sequence from Horizon (just like the test script)
 	acc, err := c.GetAccountByAddress(addr.String(), nil)
 	if err != nil {
@@ -664,6 +670,7 @@ func (c *Client) getNextSequence(vaultPubKey common.PubKey) (int64, error) {
 	// Return current sequence - Stellar SDK will increment with IncrementSequenceNum: true
 	c.logger.Info().
 		Str("vault", vaultPubKey.String()).
+		Str("account", addr.String()).
 		Int64("current_sequence", acc.Sequence).
 		Msg("got current sequence from Horizon - SDK will increment")
 
@@ -794,6 +801,16 @@ func (c *Client) buildRouterTransferOutTransaction(tx stypes.TxOutItem, sequence
 	}
 	vaultAddr := vaultStellarAddr.String()
 
+	// An EdDSA backfill migration moves the funds off the vault's placeholder account, so that account
+	// is the source, the router vault arg and the signer.
+	placeholderAddr, fromPlaceholder, err := placeholderMigrationSource(c.switchlyBridge, tx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to resolve migration source: %w", err)
+	}
+	if fromPlaceholder {
+		vaultAddr = placeholderAddr.String()
+	}
+
 	op, err := c.buildTransferOutInvokeOp(tx, memo, vaultAddr)
 	if err != nil {
 		return nil, err
@@ -854,7 +871,12 @@ func (c *Client) buildRouterTransferOutTransaction(tx stypes.TxOutItem, sequence
 		return nil, fmt.Errorf("fail to build prepared soroban tx: %w", err)
 	}
 
-	signedTx, err := c.signTransactionWithTSS(preparedTx, tx.VaultPubKey, c.networkPassphrase)
+	var signedTx *txnbuild.Transaction
+	if fromPlaceholder {
+		signedTx, err = c.signTransactionWithPlaceholder(preparedTx, tx.VaultPubKey, c.networkPassphrase)
+	} else {
+		signedTx, err = c.signTransactionWithTSS(preparedTx, tx.VaultPubKey, c.networkPassphrase)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("fail to sign soroban tx: %w", err)
 	}
@@ -986,7 +1008,8 @@ func (c *Client) signTransactionWithTSS(stellarTx *txnbuild.Transaction, vaultPu
 
 // signTransactionWithPlaceholder signs with the INSECURE mocknet-only placeholder key derived from the
 // secp256k1 vault key (DeriveStellarkeyFromVaultPubKey is itself gated to mocknet). Used only until a
-// vault carries a real ed25519 group key from the EdDSA keygen.
+// vault carries a real ed25519 group key from the EdDSA keygen, and for the EdDSA backfill migration
+// that moves the funds off the placeholder account (see placeholderMigrationSource).
 func (c *Client) signTransactionWithPlaceholder(stellarTx *txnbuild.Transaction, vaultPubKey common.PubKey, networkPassphrase string) (*txnbuild.Transaction, error) {
 	c.logger.Info().
 		Str("vault_pubkey", vaultPubKey.String()).
@@ -1064,8 +1087,18 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signedTx, ch
 		sequence = meta.SeqNumber
 		c.logger.Info().Int64("sequence", sequence).Msg("using checkpoint sequence for retry")
 	} else {
-		// Get fresh sequence number
-		sequence, err = c.getNextSequence(tx.VaultPubKey)
+		// Get fresh sequence number, from the placeholder account for an EdDSA backfill migration
+		var placeholderAddr common.Address
+		var fromPlaceholder bool
+		placeholderAddr, fromPlaceholder, err = placeholderMigrationSource(c.switchlyBridge, tx)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("fail to resolve migration source: %w", err)
+		}
+		if fromPlaceholder {
+			sequence, err = c.getAccountSequence(tx.VaultPubKey, placeholderAddr)
+		} else {
+			sequence, err = c.getNextSequence(tx.VaultPubKey)
+		}
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("fail to get next sequence: %w", err)
 		}
@@ -1,6 +1,8 @@
 package stellar
 
 import (
+	"time"
+
 	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +18,8 @@ import (
 type MockSwitchlyBridge struct {
 	// VaultToReturn is returned by GetVault; the zero value is an empty vault (previous behaviour).
 	VaultToReturn switchlytypes.Vault
+	// GetVaultCalls counts the calls to GetVault.
+	GetVaultCalls int
 }
 
 func (m *MockSwitchlyBridge) EnsureNodeWhitelisted() error {
@@ -39,6 +43,7 @@ func (m *MockSwitchlyBridge) GetAsgards() (switchlytypes.Vaults, error) {
 }
 
 func (m *MockSwitchlyBridge) GetVault(pubkey string) (switchlytypes.Vault, error) {
+	m.GetVaultCalls++
 	return m.VaultToReturn, nil
 }
 
@@ -78,6 +83,10 @@ func (m *MockSwitchlyBridge) GetMimirWithRef(template, ref string) (int64, error
 	return 0, nil
 }
 
+func (m *MockSwitchlyBridge) GetMimirAtHeight(key string, height int64) (int64, error) {
+	return 0, nil
+}
+
 func (m *MockSwitchlyBridge) GetInboundOutbound(txIns common.ObservedTxs) (common.ObservedTxs, common.ObservedTxs, error) {
 	return common.ObservedTxs{}, common.ObservedTxs{}, nil
 }
@@ -86,6 +95,10 @@ func (m *MockSwitchlyBridge) GetPools() (switchlytypes.Pools, error) {
 	return switchlytypes.Pools{}, nil
 }
 
+func (m *MockSwitchlyBridge) GetDexAggregators(chain common.Chain) ([]switchlytypes.DexAggregator, error) {
+	return []switchlytypes.DexAggregator{}, nil
+}
+
 func (m *MockSwitchlyBridge) GetPubKeys() ([]switchlyclient.PubKeyContractAddressPair, error) {
 	return []switchlyclient.PubKeyContractAddressPair{}, nil
 }
@@ -138,6 +151,10 @@ func (m *MockSwitchlyBridge) GetBlockHeight() (int64, error) {
 	return 1, nil
 }
 
+func (m *MockSwitchlyBridge) GetBlockTime(height int64) (time.Time, error) {
+	return time.Time{}, nil
+}
+
 func (m *MockSwitchlyBridge) GetLastObservedInHeight(chain common.Chain) (int64, error) {
 	return 1, nil
 }
@@ -2,16 +2,20 @@ package stellar
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
+	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
 )
 
 // vaultStellarAddrCache memoises the secp256k1-vault-identity -> Stellar-account-address mapping.
-// The mapping is immutable for the life of a vault (the ed25519 group key is fixed at keygen), so a
-// successful resolution can be cached forever; this keeps the per-tx scanner path from issuing a
-// GetVault for every transaction.
+// Once a vault carries its ed25519 group key the mapping is immutable for the life of the vault, so
+// that resolution can be cached forever; this keeps the per-tx scanner path from issuing a GetVault
+// for every transaction. The placeholder address of a vault without an ed25519 key is not cached, as
+// an EdDSA backfill can attach the key to the vault later.
 var vaultStellarAddrCache sync.Map // vault secp pubkey string -> stellar address string
 
 // vaultStellarAddress resolves a vault's Stellar account address from its EdDSA (ed25519) group key
@@ -35,8 +39,32 @@ func vaultStellarAddress(bridge switchlyclient.SwitchlyBridge, vaultPubKey commo
 	if err != nil {
 		return common.NoAddress, fmt.Errorf("fail to derive stellar address for vault %s: %w", vaultPubKey, err)
 	}
-	if !addr.IsEmpty() {
+	if !addr.IsEmpty() && vault.HasEd25519PubKey() {
 		vaultStellarAddrCache.Store(vaultPubKey.String(), addr.String())
 	}
 	return addr, nil
 }
+
+// placeholderMigrationSource reports whether tx is the migration scheduled by an EdDSA backfill, which
+// moves a vault's XLM from its secp256k1 placeholder account to the account derived from its newly
+// attached ed25519 key. That outbound must be sourced from, sequenced on and signed by the placeholder
+// account, so the placeholder address is returned alongside true. Any other outbound returns false
+// from the type of its memo alone, without resolving the vault.
+func placeholderMigrationSource(bridge switchlyclient.SwitchlyBridge, tx stypes.TxOutItem) (common.Address, bool, error) {
+	txType, err := mem.StringToTxType(strings.SplitN(tx.Memo, ":", 2)[0])
+	if err != nil || txType != mem.TxMigrate {
+		return common.NoAddress, false, nil
+	}
+	placeholder, err := tx.VaultPubKey.GetAddress(common.StellarChain)
+	if err != nil {
+		return common.NoAddress, false, fmt.Errorf("fail to derive placeholder address for vault %s: %w", tx.VaultPubKey, err)
+	}
+	addr, err := vaultStellarAddress(bridge, tx.VaultPubKey)
+	if err != nil {
+		return common.NoAddress, false, err
+	}
+	if addr.Equals(placeholder) || !addr.Equals(tx.ToAddress) {
+		return common.NoAddress, false, nil
+	}
+	return placeholder, true, nil
+}
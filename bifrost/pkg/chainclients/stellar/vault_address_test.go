@@ -3,6 +3,7 @@ package stellar
 import (
 	. "gopkg.in/check.v1"
 
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	switchlytypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
@@ -45,3 +46,81 @@ func (s *StellarClientTestSuite) TestVaultStellarAddressLegacyFallback(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(addr.String(), Equals, placeholder.String())
 }
+
+// TestVaultStellarAddressBackfill asserts the placeholder resolution of a vault without an ed25519
+// key is not cached, so the real address is picked up once an EdDSA backfill attaches the key.
+func (s *StellarClientTestSuite) TestVaultStellarAddressBackfill(c *C) {
+	secp := newTestVaultPubKey(c)
+	bridge := &MockSwitchlyBridge{VaultToReturn: switchlytypes.Vault{PubKey: secp}}
+	placeholder, err := secp.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	addr, err := vaultStellarAddress(bridge, secp)
+	c.Assert(err, IsNil)
+	c.Assert(addr.String(), Equals, placeholder.String())
+
+	edPub := switchlytypes.GetRandomEd25519PubKey()
+	bridge.VaultToReturn.Ed25519PubKey = edPub
+	addr, err = vaultStellarAddress(bridge, secp)
+	c.Assert(err, IsNil)
+	want, err := edPub.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	c.Assert(addr.String(), Equals, want.String())
+}
+
+// TestPlaceholderMigrationSource asserts only the migration of a vault's funds to its own ed25519
+// address is sourced from the placeholder account.
+func (s *StellarClientTestSuite) TestPlaceholderMigrationSource(c *C) {
+	secp := newTestVaultPubKey(c)
+	edPub := switchlytypes.GetRandomEd25519PubKey()
+	bridge := &MockSwitchlyBridge{VaultToReturn: switchlytypes.Vault{PubKey: secp, Ed25519PubKey: edPub}}
+	placeholder, err := secp.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	vaultAddr, err := edPub.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+
+	tx := stypes.TxOutItem{
+		Chain:       common.StellarChain,
+		ToAddress:   vaultAddr,
+		VaultPubKey: secp,
+		Memo:        "MIGRATE:10",
+	}
+	addr, ok, err := placeholderMigrationSource(bridge, tx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Assert(addr.String(), Equals, placeholder.String())
+
+	// a migration to another vault
+	other, err := newTestVaultPubKey(c).GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	tx.ToAddress = other
+	_, ok, err = placeholderMigrationSource(bridge, tx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+
+	// not a migration, the vault is not resolved
+	tx.ToAddress = vaultAddr
+	tx.VaultPubKey = newTestVaultPubKey(c)
+	calls := bridge.GetVaultCalls
+	for _, memo := range []string{"OUT:" + common.BlankTxID.String(), "REFUND:" + common.BlankTxID.String(), "", "migrated"} {
+		tx.Memo = memo
+		_, ok, err = placeholderMigrationSource(bridge, tx)
+		c.Assert(err, IsNil)
+		c.Assert(ok, Equals, false)
+	}
+	c.Assert(bridge.GetVaultCalls, Equals, calls)
+
+	// a vault without an ed25519 key has nothing to migrate
+	secp = newTestVaultPubKey(c)
+	bridge.VaultToReturn = switchlytypes.Vault{PubKey: secp}
+	placeholder, err = secp.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	tx = stypes.TxOutItem{
+		Chain:       common.StellarChain,
+		ToAddress:   placeholder,
+		VaultPubKey: secp,
+		Memo:        "MIGRATE:10",
+	}
+	_, ok, err = placeholderMigrationSource(bridge, tx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+}
@@ -1,6 +1,7 @@
 package utxo
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -15,6 +16,10 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	btcwire "github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -45,6 +50,9 @@ type BitcoinSignerSuite struct {
 	m      *metrics.Metrics
 	db     *leveldb.DB
 	keys   *switchlyclient.Keys
+
+	// broadcast is the hex of the last transaction sent to the test server
+	broadcast string
 }
 
 var _ = Suite(&BitcoinSignerSuite{})
@@ -89,12 +97,16 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 			c.Assert(err, IsNil)
 		} else if strings.HasPrefix(req.RequestURI, "/switchly/vaults") && strings.HasSuffix(req.RequestURI, "/signers") {
 			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/tss/keysign_party.json")
+		} else if req.RequestURI == "/switchly/mimir/key/MaxBatchOutputs-BTC?height=100" {
+			_, err := rw.Write([]byte("3"))
+			c.Assert(err, IsNil)
 		} else if req.RequestURI == switchlyclient.ChainVersionEndpoint {
 			_, err := rw.Write([]byte(`{"current":"` + types2.GetCurrentVersion().String() + `"}`))
 			c.Assert(err, IsNil)
 		} else {
 			r := struct {
-				Method string `json:"method"`
+				Method string        `json:"method"`
+				Params []interface{} `json:"params"`
 			}{}
 			buf, err := io.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -119,7 +131,11 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 			case "getinfo":
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/getinfo.json")
 			case "sendrawtransaction":
+				s.broadcast, _ = r.Params[0].(string)
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/sendrawtransaction.json")
+			case "getmempoolentry":
+				_, err = rw.Write([]byte(`{ "result": {}, "error": null, "id": 1 }`))
+				c.Assert(err, IsNil)
 			case "importaddress":
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/importaddress.json")
 			case "createwallet":
@@ -290,6 +306,92 @@ func (s *BitcoinSignerSuite) TestSignTxWithoutPredefinedMaxGas(c *C) {
 	c.Assert(buf, NotNil)
 }
 
+func (s *BitcoinSignerSuite) TestSignBatchTx(c *C) {
+	priKeyBuf, err := hex.DecodeString("b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5")
+	c.Assert(err, IsNil)
+	pkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), priKeyBuf)
+	c.Assert(pkey, NotNil)
+	s.client.nodePrivKey = pkey
+	s.client.nodePubKey, err = bech32AccountPubKey(pkey)
+	c.Assert(err, IsNil)
+	maxOutputs, err := s.client.MaxBatchOutputs(100)
+	c.Assert(err, IsNil)
+	c.Assert(maxOutputs, Equals, int64(3))
+
+	newItem := func(memo string, amount uint64) stypes.TxOutItem {
+		addr, addrErr := types2.GetRandomPubKey().GetAddress(common.BTCChain)
+		c.Assert(addrErr, IsNil)
+		return stypes.TxOutItem{
+			Chain:       common.BTCChain,
+			ToAddress:   addr,
+			VaultPubKey: s.client.nodePubKey,
+			Coins: common.Coins{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(amount)),
+			},
+			MaxGas: common.Gas{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(10000)),
+			},
+			InHash: switchly.GetRandomTxHash(),
+			Memo:   memo,
+		}
+	}
+	txs := []stypes.TxOutItem{
+		newItem("OUT:"+switchly.GetRandomTxHash().String(), 100000),
+		newItem("REFUND:"+switchly.GetRandomTxHash().String(), 200000),
+		newItem("OUT:"+switchly.GetRandomTxHash().String(), 300000),
+	}
+	batchable := func(tx stypes.TxOutItem) bool {
+		ok, batchErr := s.client.IsBatchable(tx)
+		c.Assert(batchErr, IsNil)
+		return ok
+	}
+	for _, tx := range txs {
+		c.Check(batchable(tx), Equals, true)
+	}
+
+	// only plain outbounds and refunds of the gas asset with max gas are batched
+	tx := newItem("MIGRATE:100", 100000)
+	c.Check(batchable(tx), Equals, false)
+	tx = newItem("OUT:"+switchly.GetRandomTxHash().String(), 100000)
+	tx.MaxGas = nil
+	c.Check(batchable(tx), Equals, false)
+	tx = newItem("OUT:"+switchly.GetRandomTxHash().String(), 100000)
+	tx.Coins = append(tx.Coins, common.NewCoin(common.ETHAsset, cosmos.NewUint(1)))
+	c.Check(batchable(tx), Equals, false)
+
+	// a batch needs more than one and at most the max outputs
+	_, _, _, err = s.client.SignBatchTx(txs[:1], 100)
+	c.Assert(err, NotNil)
+	_, _, _, err = s.client.SignBatchTx(append(txs, newItem("OUT:"+switchly.GetRandomTxHash().String(), 100000)), 100)
+	c.Assert(err, NotNil)
+
+	buf, checkpoint, obs, err := s.client.SignBatchTx(txs, 100)
+	c.Assert(err, IsNil)
+	c.Assert(buf, NotNil)
+	c.Assert(checkpoint, IsNil)
+	c.Assert(obs, IsNil)
+
+	// every outbound is paid by its own output, the fee shared equally out of the max gas
+	redeemTx := btcwire.NewMsgTx(btcwire.TxVersion)
+	c.Assert(redeemTx.Deserialize(bytes.NewReader(buf)), IsNil)
+	c.Assert(redeemTx.TxOut, HasLen, 5)
+	share := int64(0)
+	for i, tx := range txs {
+		script, scriptErr := s.client.getPayToAddrScript(tx.ToAddress)
+		c.Assert(scriptErr, IsNil)
+		c.Check(redeemTx.TxOut[i].PkScript, DeepEquals, script)
+		gas := int64(tx.Coins[0].Amount.Uint64()) + 10000 - redeemTx.TxOut[i].Value
+		if i == 0 {
+			share = gas
+		}
+		c.Check(gas, Equals, share)
+	}
+	c.Check(share > 0, Equals, true)
+	memo, err := s.client.getNullDataScript("BATCH:100")
+	c.Assert(err, IsNil)
+	c.Check(redeemTx.TxOut[4].PkScript, DeepEquals, memo)
+}
+
 func (s *BitcoinSignerSuite) TestBroadcastTx(c *C) {
 	txOutItem := stypes.TxOutItem{
 		Chain:       common.ETHChain,
@@ -313,6 +415,112 @@ func (s *BitcoinSignerSuite) TestBroadcastTx(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *BitcoinSignerSuite) TestUnstuckTx(c *C) {
+	priKeyBuf, err := hex.DecodeString("b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5")
+	c.Assert(err, IsNil)
+	pkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), priKeyBuf)
+	c.Assert(pkey, NotNil)
+	s.client.nodePrivKey = pkey
+	s.client.nodePubKey, err = bech32AccountPubKey(pkey)
+	c.Assert(err, IsNil)
+	s.client.cfg.UTXO.MaxSatsPerVByte = 1000
+
+	addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	txOutItem := stypes.TxOutItem{
+		Chain:       common.BTCChain,
+		ToAddress:   addr,
+		VaultPubKey: s.client.nodePubKey,
+		Coins: common.Coins{
+			common.NewCoin(common.BTCAsset, cosmos.NewUint(10000000)),
+		},
+		MaxGas: common.Gas{
+			common.NewCoin(common.BTCAsset, cosmos.NewUint(2000)),
+		},
+		InHash: switchly.GetRandomTxHash(),
+		Memo:   "OUT:" + switchly.GetRandomTxHash().String(),
+	}
+	sourceScript, err := s.client.getSourceScript(txOutItem)
+	c.Assert(err, IsNil)
+	customerScript, err := s.client.getPayToAddrScript(addr)
+	c.Assert(err, IsNil)
+	memoScript, err := s.client.getNullDataScript(txOutItem.Memo)
+	c.Assert(err, IsNil)
+
+	// the stuck outbound spends one output of the getrawtransaction fixture
+	inputAmount := int64(19590108)
+	newStuckTx := func(sequence uint32) (*btcwire.MsgTx, utxo.SignedTx) {
+		prevHash, hashErr := chainhash.NewHashFromStr("31f8699ce9028e9cd37f8a6d58a79e614a96e3fdd0f58be5fc36d2d95484716f")
+		c.Assert(hashErr, IsNil)
+		tx := btcwire.NewMsgTx(btcwire.TxVersion)
+		txIn := btcwire.NewTxIn(btcwire.NewOutPoint(prevHash, 0), nil, nil)
+		txIn.Sequence = sequence
+		tx.AddTxIn(txIn)
+		tx.AddTxOut(btcwire.NewTxOut(10000000, customerScript))
+		tx.AddTxOut(btcwire.NewTxOut(inputAmount-10000000-2000, sourceScript))
+		tx.AddTxOut(btcwire.NewTxOut(0, memoScript))
+		checkpoint := utxo.SignCheckpoint{
+			IndividualAmounts: map[string]int64{prevHash.String() + "-0": inputAmount},
+		}
+		signedTx, _, _, signErr := s.client.signRedeemTx(txOutItem, tx, checkpoint, sourceScript, 1)
+		c.Assert(signErr, IsNil)
+		var buf bytes.Buffer
+		c.Assert(signedTx.Serialize(&buf), IsNil)
+		return signedTx, utxo.SignedTx{
+			TxID:       signedTx.TxHash().String(),
+			Height:     1,
+			Tx:         buf.Bytes(),
+			TxOutItems: []stypes.TxOutItem{txOutItem},
+		}
+	}
+	decodeBroadcast := func() *btcwire.MsgTx {
+		buf, decodeErr := hex.DecodeString(s.broadcast)
+		c.Assert(decodeErr, IsNil)
+		tx := btcwire.NewMsgTx(btcwire.TxVersion)
+		c.Assert(tx.Deserialize(bytes.NewReader(buf)), IsNil)
+		return tx
+	}
+
+	// replace by fee, the replacement pays the extra fee out of the change
+	stuckTx, item := newStuckTx(rbfSequence)
+	c.Assert(s.client.unstuckTx(s.client.log, item, 10), IsNil)
+	replaceTx := decodeBroadcast()
+	c.Assert(replaceTx.TxHash().String(), Not(Equals), stuckTx.TxHash().String())
+	c.Assert(replaceTx.TxIn, HasLen, 1)
+	c.Check(replaceTx.TxIn[0].PreviousOutPoint, Equals, stuckTx.TxIn[0].PreviousOutPoint)
+	c.Check(replaceTx.TxIn[0].Witness, NotNil)
+	c.Assert(replaceTx.TxOut, HasLen, 3)
+	c.Check(replaceTx.TxOut[0].Value, Equals, int64(10000000))
+	c.Check(replaceTx.TxOut[0].PkScript, DeepEquals, customerScript)
+	c.Check(replaceTx.TxOut[2].PkScript, DeepEquals, memoScript)
+	extraFee := stuckTx.TxOut[1].Value - replaceTx.TxOut[1].Value
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(stuckTx))
+	c.Check(extraFee, Equals, (2000/vSize)*2*vSize-2000)
+	c.Check(s.client.signerCacheManager.HasSigned(txOutItem.CacheHash()), Equals, true)
+
+	// child pays for parent when the stuck outbound does not signal replace by fee
+	s.broadcast = ""
+	stuckTx, item = newStuckTx(btcwire.MaxTxInSequenceNum)
+	c.Assert(s.client.unstuckTx(s.client.log, item, 10), IsNil)
+	childTx := decodeBroadcast()
+	c.Assert(childTx.TxIn, HasLen, 1)
+	c.Check(childTx.TxIn[0].PreviousOutPoint.Hash, Equals, stuckTx.TxHash())
+	c.Check(childTx.TxIn[0].PreviousOutPoint.Index, Equals, uint32(1))
+	c.Assert(childTx.TxOut, HasLen, 2)
+	c.Check(childTx.TxOut[0].PkScript, DeepEquals, sourceScript)
+	c.Check(childTx.TxOut[0].Value < stuckTx.TxOut[1].Value, Equals, true)
+	consolidate, err := s.client.getNullDataScript("consolidate")
+	c.Assert(err, IsNil)
+	c.Check(childTx.TxOut[1].PkScript, DeepEquals, consolidate)
+
+	// nothing to bump once the fee rate is at the max
+	s.broadcast = ""
+	s.client.cfg.UTXO.MaxSatsPerVByte = 2000 / vSize
+	_, item = newStuckTx(rbfSequence)
+	c.Assert(s.client.unstuckTx(s.client.log, item, 10), IsNil)
+	c.Check(s.broadcast, Equals, "")
+}
+
 func (s *BitcoinSignerSuite) TestIsSelfTransaction(c *C) {
 	c.Check(s.client.isSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, false)
 	bm := utxo.NewBlockMeta("", 1024, "")
@@ -1425,6 +1425,87 @@ func (s *BitcoinSuite) TestGetOutput(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *BitcoinSuite) TestSplitBatchTxIn(c *C) {
+	var vaultPubKey common.PubKey
+	var err error
+	if common.CurrentChainNetwork == common.MainNet {
+		vaultPubKey, err = common.NewPubKey("switchpub1addwnpepqg8m9v30fy6rlx4zwd2lp4rg3x07fxt3acgdc9xtca9hdeupusxjs74fvlr") // valid mainnet key
+	} else {
+		vaultPubKey, err = common.NewPubKey("tswitchpub1addwnpepq2zqsng9kvg5f75rhkq2xq89jp9pvfyluuf7ctfq0ntqfa5xsgxxqx07yrr") // valid testnet key
+	}
+	c.Assert(err, IsNil)
+	vaultAddress, err := vaultPubKey.GetAddress(s.client.GetChain())
+	c.Assert(err, IsNil)
+	s.client.asgardAddresses = []common.Address{vaultAddress}
+	s.client.lastAsgard = time.Now()
+
+	tx := btcjson.TxRawResult{
+		Txid: "5b0876dcc027d2f0c671fc250460ee388df39697c3ff082007b6ddd9cb9a7513",
+		Vout: []btcjson.Vout{
+			{
+				Value: 0.001,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{"bc1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw4t7g20"},
+				},
+			},
+			{
+				Value: 0.002,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{"bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2cyd"},
+				},
+			},
+			{
+				Value: 1.5,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{vaultAddress.String()},
+				},
+			},
+			{
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Asm:  "OP_RETURN 42415443483a313030",
+					Type: "nulldata",
+				},
+			},
+		},
+	}
+	txInItem := types.TxInItem{
+		BlockHeight: 10,
+		Tx:          tx.Txid,
+		Sender:      vaultAddress.String(),
+		To:          "bc1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw4t7g20",
+		Coins:       common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(100000))},
+		Memo:        "BATCH:100",
+		Gas:         common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(3001))},
+	}
+
+	// every output paid by the batch is observed on its own, the change is not
+	items := s.client.splitBatchTxIn(&tx, txInItem)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].Tx, Equals, tx.Txid)
+	c.Check(items[0].To, Equals, "bc1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw4t7g20")
+	c.Check(items[0].Memo, Equals, "BATCH:100")
+	c.Check(items[0].Coins[0].Amount.Uint64(), Equals, uint64(100000))
+	c.Check(items[0].Gas[0].Amount.Uint64(), Equals, uint64(1501))
+	c.Check(items[1].Tx, Equals, tx.Txid)
+	c.Check(items[1].To, Equals, "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2cyd")
+	c.Check(items[1].Memo, Equals, "BATCH:100:1")
+	c.Check(items[1].Coins[0].Amount.Uint64(), Equals, uint64(200000))
+	c.Check(items[1].Gas[0].Amount.Uint64(), Equals, uint64(1500))
+
+	// other outbounds are returned as is
+	txInItem.Memo = "OUT:5b0876dcc027d2f0c671fc250460ee388df39697c3ff082007b6ddd9cb9a7513"
+	items = s.client.splitBatchTxIn(&tx, txInItem)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0], DeepEquals, txInItem)
+
+	// a batch memo not sent from asgard is not split
+	txInItem.Memo = "BATCH:100"
+	txInItem.Sender = "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2cyd"
+	items = s.client.splitBatchTxIn(&tx, txInItem)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0], DeepEquals, txInItem)
+}
+
 func (s *BitcoinSuite) TestIsValidUTXO(c *C) {
 	// normal pay to pubkey hash segwit
 	c.Assert(s.client.isValidUTXO("00140653096f54ae1ae2d73291d15854aef08ebcfa8c"), Equals, true)
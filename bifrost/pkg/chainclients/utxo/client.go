@@ -253,7 +253,7 @@ func (c *Client) GetAddress(pubkey common.PubKey) string {
 // Client - Control
 ////////////////////////////////////////////////////////////////////////////////////////
 
-// Start starts the scanner, signer, and solvency check.
+// Start starts the scanner, signer, solvency check, and unstuck routine.
 func (c *Client) Start(
 	globalTxsQueue chan types.TxIn,
 	globalErrataQueue chan types.ErrataBlock,
@@ -264,11 +264,13 @@ func (c *Client) Start(
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.globalNetworkFeeQueue = globalNetworkFeeQueue
 	c.tssKeySigner.Start()
-	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
+	c.blockScanner.Start(globalTxsQueue, globalErrataQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
 	go runners.SolvencyCheckRunner(
 		c.GetChain(), c, c.bridge, c.stopchan, c.wg, constants.SwitchlyBlockTime,
 	)
+	c.wg.Add(1)
+	go c.unstuck()
 }
 
 // Stop stops the scanner, signer, and solvency check.
@@ -602,11 +604,12 @@ func (c *Client) FetchMemPool(height int64) (types.TxIn, error) {
 			if txInItem.IsEmpty() {
 				continue
 			}
-			if txInItem.Coins.IsEmpty() {
-				continue
+			for _, item := range c.splitBatchTxIn(result, txInItem) {
+				if item.Coins.IsEmpty() {
+					continue
+				}
+				txIn.TxArray = append(txIn.TxArray, &item)
 			}
-
-			txIn.TxArray = append(txIn.TxArray, &txInItem)
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
 )
@@ -289,16 +290,13 @@ func (c *Client) sendNetworkFee(height int64) error {
 		return nil
 	}
 
-	if c.cfg.UTXO.EstimatedAverageTxSize*feeRate < c.minRelayFeeSats {
-		feeRate = c.minRelayFeeSats / c.cfg.UTXO.EstimatedAverageTxSize
-		if feeRate*c.cfg.UTXO.EstimatedAverageTxSize < c.minRelayFeeSats {
-			feeRate++
-		}
-	}
-
-	if feeRate < uint64(c.cfg.UTXO.MinSatsPerVByte) {
-		feeRate = uint64(c.cfg.UTXO.MinSatsPerVByte)
-	}
+	// raise the rate to the min relay fee and the configured min rate
+	txSize, feeRate := feemodel.Get(c.cfg.ChainID).NetworkFee(feemodel.Observation{
+		Size:    c.cfg.UTXO.EstimatedAverageTxSize,
+		Rate:    feeRate,
+		MinFee:  c.minRelayFeeSats,
+		MinRate: uint64(c.cfg.UTXO.MinSatsPerVByte),
+	})
 
 	// if gas cache blocks are set, use the max gas over that window
 	if c.cfg.BlockScanner.GasCacheBlocks > 0 {
@@ -322,7 +320,7 @@ func (c *Client) sendNetworkFee(height int64) error {
 	c.globalNetworkFeeQueue <- common.NetworkFee{
 		Chain:           c.cfg.ChainID,
 		Height:          height,
-		TransactionSize: c.cfg.UTXO.EstimatedAverageTxSize,
+		TransactionSize: txSize,
 		TransactionRate: feeRate,
 	}
 
@@ -355,11 +353,13 @@ func (c *Client) sendNetworkFeeFromBlock(blockResult *btcjson.GetBlockVerboseTxR
 		return fmt.Errorf("fail to parse total block fee amount, err: %w", err)
 	}
 
-	// average fee rate in sats/vbyte or default min relay fee
-	feeRateSats := uint64(amt.ToUnit(btcutil.AmountSatoshi) / float64(totalVSize))
-	if c.cfg.UTXO.DefaultMinRelayFeeSats > feeRateSats {
-		feeRateSats = c.cfg.UTXO.DefaultMinRelayFeeSats
-	}
+	// average fee rate in sats/vbyte or default min relay fee, a block paying less than
+	// 1 sat/vbyte is observed at 1 sat/vbyte so the min relay fee is still reported
+	txSize, feeRateSats := feemodel.Get(c.cfg.ChainID).NetworkFee(feemodel.Observation{
+		Size:    c.cfg.UTXO.EstimatedAverageTxSize,
+		Rate:    max(uint64(amt.ToUnit(btcutil.AmountSatoshi)/float64(totalVSize)), 1),
+		MinRate: c.cfg.UTXO.DefaultMinRelayFeeSats,
+	})
 
 	// round to prevent fee observation noise
 	resolution := uint64(c.cfg.BlockScanner.GasPriceResolution)
@@ -381,7 +381,7 @@ func (c *Client) sendNetworkFeeFromBlock(blockResult *btcjson.GetBlockVerboseTxR
 	c.globalNetworkFeeQueue <- common.NetworkFee{
 		Chain:           c.cfg.ChainID,
 		Height:          height,
-		TransactionSize: c.cfg.UTXO.EstimatedAverageTxSize,
+		TransactionSize: txSize,
 		TransactionRate: feeRateSats,
 	}
 
@@ -543,6 +543,76 @@ func (c *Client) getTxIn(tx *btcjson.TxRawResult, height int64, isMemPool bool,
 	}, nil
 }
 
+// splitBatchTxIn splits the observation of a batch outbound, a tx from asgard paying
+// several outbounds with one output each, into one observation per paid output so
+// SWITCHLYChain matches every output to its outbound on its own. The output index is
+// appended to the memo and the gas is shared equally between the outputs, as it was
+// taken out of their max gas when the tx was built. Any other observation is returned
+// as is.
+func (c *Client) splitBatchTxIn(tx *btcjson.TxRawResult, txInItem types.TxInItem) []types.TxInItem {
+	m, err := mem.ParseMemo(common.LatestVersion, txInItem.Memo)
+	if err != nil || !m.IsType(mem.TxBatchOutbound) || !c.isAsgardAddress(txInItem.Sender) {
+		return []types.TxInItem{txInItem}
+	}
+	batchMemo, ok := m.(mem.BatchOutboundMemo)
+	if !ok {
+		return []types.TxInItem{txInItem}
+	}
+
+	sender := txInItem.Sender
+	if c.cfg.ChainID.Equals(common.BCHChain) {
+		sender = c.stripBCHAddress(sender)
+	}
+	var receivers []string
+	var amounts []uint64
+	for _, vout := range tx.Vout {
+		if strings.EqualFold(vout.ScriptPubKey.Type, "nulldata") || vout.Value <= 0 {
+			continue
+		}
+		addresses := c.getAddressesFromScriptPubKey(vout.ScriptPubKey)
+		if len(addresses) != 1 {
+			continue
+		}
+		receiver := addresses[0]
+		if c.cfg.ChainID.Equals(common.BCHChain) {
+			receiver = c.stripBCHAddress(receiver)
+		}
+		// the output back to the vault is the change of the batch
+		if strings.EqualFold(receiver, sender) {
+			continue
+		}
+		var amount btcutil.Amount
+		amount, err = btcutil.NewAmount(vout.Value)
+		if err != nil {
+			c.log.Err(err).Str("txid", tx.Txid).Msg("fail to parse batch output amount")
+			return []types.TxInItem{txInItem}
+		}
+		receivers = append(receivers, receiver)
+		amounts = append(amounts, uint64(amount.ToUnit(btcutil.AmountSatoshi)))
+	}
+	if len(receivers) == 0 {
+		return []types.TxInItem{txInItem}
+	}
+
+	gasAsset := c.cfg.ChainID.GetGasAsset()
+	fee := txInItem.Gas.ToCoins().GetCoin(gasAsset).Amount.Uint64()
+	share := fee / uint64(len(receivers))
+	items := make([]types.TxInItem, len(receivers))
+	for i := range receivers {
+		gas := share
+		if i == 0 { // any remainder goes to the first output
+			gas += fee - share*uint64(len(receivers))
+		}
+		item := txInItem
+		item.To = receivers[i]
+		item.Coins = common.Coins{common.NewCoin(gasAsset, cosmos.NewUint(amounts[i]))}
+		item.Memo = batchMemo.WithIndex(int64(i)).String()
+		item.Gas = common.Gas{common.NewCoin(gasAsset, cosmos.NewUint(gas))}
+		items[i] = item
+	}
+	return items
+}
+
 // stripBCHAddress removes prefix on bch addresses.
 func (c *Client) stripBCHAddress(addr string) string {
 	split := strings.Split(addr, ":")
@@ -676,10 +746,21 @@ func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn,
 		if txInItem.IsEmpty() {
 			continue
 		}
-		if txInItem.Coins.IsEmpty() {
-			continue
+		// committed outbounds of our vaults no longer need a fee bump
+		if err = c.temporalStorage.RemoveSignedTx(txInItem.Tx); err != nil {
+			c.log.Err(err).Str("txid", txInItem.Tx).Msg("fail to remove signed tx")
 		}
-		if txInItem.Coins[0].Amount.LT(c.cfg.ChainID.DustThreshold()) {
+		var items []types.TxInItem
+		for _, item := range c.splitBatchTxIn(&block.Tx[idx], txInItem) {
+			if item.Coins.IsEmpty() {
+				continue
+			}
+			if item.Coins[0].Amount.LT(c.cfg.ChainID.DustThreshold()) {
+				continue
+			}
+			items = append(items, item)
+		}
+		if len(items) == 0 {
 			continue
 		}
 		var added bool
@@ -694,7 +775,9 @@ func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn,
 			}
 			continue
 		}
-		txItems = append(txItems, &txInItem)
+		for i := range items {
+			txItems = append(txItems, &items[i])
+		}
 	}
 	txIn.TxArray = txItems
 	return txIn, nil
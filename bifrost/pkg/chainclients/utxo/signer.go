@@ -42,108 +42,181 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) ([]byte, []by
 		return nil, nil, nil, nil
 	}
 
-	if c.cfg.ChainID.Equals(common.BCHChain) {
-		if !tx.ToAddress.IsValidBCHAddress() {
-			c.log.Error().Msgf("to address: %s is legacy not allowed ", tx.ToAddress)
-			return nil, nil, nil, nil
-		}
-	}
-
 	// skip outbounds that have been signed
 	if c.signerCacheManager.HasSigned(tx.CacheHash()) {
 		c.log.Info().Msgf("ignoring already signed transaction: (%+v)", tx)
 		return nil, nil, nil, nil
 	}
 
+	valid, err := c.isValidOutputAddress(tx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !valid {
+		return nil, nil, nil, nil
+	}
+
 	sourceScript, err := c.getSourceScript(tx)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("fail to get source pay to address script: %w", err)
 	}
 
-	// get chain specific address type
-	var outputAddr interface{}
-	var outputAddrStr string
-	switch c.cfg.ChainID {
-	case common.DOGEChain:
-		outputAddr, err = dogutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgDOGE())
+	redeemTx, checkpoint, err := c.loadCheckpoint(tx, func() (*btcwire.MsgTx, map[string]int64, error) {
+		return c.buildTx(tx, sourceScript)
+	})
+	if err != nil || redeemTx == nil {
+		return nil, nil, nil, err
+	}
+
+	redeemTx, totalAmount, checkpointBytes, err := c.signRedeemTx(tx, redeemTx, checkpoint, sourceScript, switchlyHeight)
+	if err != nil {
+		return nil, checkpointBytes, nil, err
+	}
+	var signedTx bytes.Buffer
+	if err = redeemTx.Serialize(&signedTx); err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to serialize tx to bytes: %w", err)
+	}
+
+	// create the observation to be sent by the signer before broadcast
+	chainHeight, err := c.rpc.GetBlockCount()
+	if err != nil { // fall back to the scanner height, switchlynode voter does not use height
+		chainHeight = c.currentBlockHeight.Load()
+	}
+	amt := redeemTx.TxOut[0].Value // the first output is the outbound amount
+	gas := totalAmount
+	for _, txOut := range redeemTx.TxOut { // subtract all vouts to from vins to get the gas
+		gas -= txOut.Value
+	}
+	var txIn *stypes.TxInItem
+	sender, err := tx.VaultPubKey.GetAddress(tx.Chain)
+	if err == nil {
+		txIn = stypes.NewTxInItem(
+			chainHeight,
+			redeemTx.TxHash().String(),
+			tx.Memo,
+			sender.String(),
+			tx.ToAddress.String(),
+			common.NewCoins(
+				common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(uint64(amt))),
+			),
+			common.Gas(common.NewCoins(
+				common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(uint64(gas))),
+			)),
+			tx.VaultPubKey,
+			"",
+			"",
+			nil,
+		)
+	}
+
+	return signedTx.Bytes(), nil, txIn, nil
+}
+
+// SignBatchTx builds and signs one transaction paying all the given outbounds, they
+// must all be batchable and from the same vault, as grouped by the signer from the
+// keysign block. The first item is the one the checkpoint is stored on, the switchly
+// height is the height the outbounds were scheduled at and is put in the memo so observers can match every output back to its
+// outbound. There is no instant observation, the outputs are observed on chain.
+func (c *Client) SignBatchTx(txs []stypes.TxOutItem, switchlyHeight int64) ([]byte, []byte, *stypes.TxInItem, error) {
+	if len(txs) < 2 {
+		return nil, nil, nil, errors.New("a batch needs at least two outbounds")
+	}
+	tx := txs[0]
+	if tx.Checkpoint == nil {
+		maxOutputs, err := c.MaxBatchOutputs(switchlyHeight)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
+			return nil, nil, nil, err
 		}
-		outputAddrStr = outputAddr.(dogutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
-	case common.BCHChain:
-		outputAddr, err = bchutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBCH())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
+		if int64(len(txs)) > maxOutputs {
+			return nil, nil, nil, fmt.Errorf("batch of %d outbounds is over the max batch outputs", len(txs))
 		}
-		outputAddrStr = outputAddr.(bchutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
-	case common.LTCChain:
-		outputAddr, err = ltcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgLTC())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
+	}
+
+	// skip batches that have been signed
+	if c.signerCacheManager.HasSigned(tx.CacheHash()) {
+		c.log.Info().Msgf("ignoring already signed batch transaction: (%+v)", tx)
+		return nil, nil, nil, nil
+	}
+
+	for _, item := range txs {
+		if !item.VaultPubKey.Equals(tx.VaultPubKey) {
+			return nil, nil, nil, errors.New("batch outbounds must be from the same vault")
 		}
-		outputAddrStr = outputAddr.(ltcutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
-	case common.BTCChain:
-		outputAddr, err = btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBTC())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
+		if batchable, _ := c.IsBatchable(item); !batchable {
+			return nil, nil, nil, fmt.Errorf("outbound (%s) can't be batched", item.Hash())
 		}
-		outputAddrStr = outputAddr.(btcutil.Address).String()
-	default:
-		c.log.Fatal().Msg("unsupported chain")
 	}
 
-	// verify address
-	if !strings.EqualFold(outputAddrStr, tx.ToAddress.String()) {
-		c.log.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddrStr, tx.ToAddress.String())
-		return nil, nil, nil, nil
+	sourceScript, err := c.getSourceScript(tx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to get source pay to address script: %w", err)
 	}
-	switch outputAddr.(type) {
-	case *dogutil.AddressPubKey, *bchutil.AddressPubKey, *ltcutil.AddressPubKey, *btcutil.AddressPubKey:
-		c.log.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddrStr)
-		return nil, nil, nil, nil
-	default: // keep lint happy
+
+	redeemTx, checkpoint, err := c.loadCheckpoint(tx, func() (*btcwire.MsgTx, map[string]int64, error) {
+		return c.buildBatchTx(txs, switchlyHeight, sourceScript)
+	})
+	if err != nil || redeemTx == nil {
+		return nil, nil, nil, err
 	}
 
-	// load from checkpoint if it exists
+	redeemTx, _, checkpointBytes, err := c.signRedeemTx(tx, redeemTx, checkpoint, sourceScript, switchlyHeight)
+	if err != nil {
+		return nil, checkpointBytes, nil, err
+	}
+	var signedTx bytes.Buffer
+	if err = redeemTx.Serialize(&signedTx); err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to serialize tx to bytes: %w", err)
+	}
+	return signedTx.Bytes(), nil, nil, nil
+}
+
+// loadCheckpoint returns the unsigned tx and checkpoint of the outbound, from its
+// checkpoint if it has one, otherwise from the build function. A nil tx is returned if
+// any input of the checkpoint has been spent since, the outbound must not be signed.
+func (c *Client) loadCheckpoint(tx stypes.TxOutItem, build func() (*btcwire.MsgTx, map[string]int64, error)) (*btcwire.MsgTx, utxo.SignCheckpoint, error) {
 	checkpoint := utxo.SignCheckpoint{}
 	redeemTx := &btcwire.MsgTx{}
 	if tx.Checkpoint != nil {
-		if err = json.Unmarshal(tx.Checkpoint, &checkpoint); err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to unmarshal checkpoint: %w", err)
+		if err := json.Unmarshal(tx.Checkpoint, &checkpoint); err != nil {
+			return nil, checkpoint, fmt.Errorf("fail to unmarshal checkpoint: %w", err)
 		}
-		if err = redeemTx.Deserialize(bytes.NewReader(checkpoint.UnsignedTx)); err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to deserialize tx: %w", err)
+		if err := redeemTx.Deserialize(bytes.NewReader(checkpoint.UnsignedTx)); err != nil {
+			return nil, checkpoint, fmt.Errorf("fail to deserialize tx: %w", err)
 		}
 
 		// abort if any checkpoint VIN is spent
 		c.log.Info().Stringer("in_hash", tx.InHash).Msgf("verifying checkpoint vins")
-		var unspent bool
-		unspent, err = c.vinsUnspent(tx, redeemTx.TxIn)
+		unspent, err := c.vinsUnspent(tx, redeemTx.TxIn)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to verify checkpoint vins: %w", err)
+			return nil, checkpoint, fmt.Errorf("fail to verify checkpoint vins: %w", err)
 		}
 		if !unspent {
-			return nil, nil, nil, nil
+			return nil, checkpoint, nil
 		}
+		return redeemTx, checkpoint, nil
+	}
 
-	} else {
-		redeemTx, checkpoint.IndividualAmounts, err = c.buildTx(tx, sourceScript)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		buf := bytes.NewBuffer([]byte{})
-		err = redeemTx.Serialize(buf)
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to serialize tx: %w", err)
-		}
-		checkpoint.UnsignedTx = buf.Bytes()
+	redeemTx, individualAmounts, err := build()
+	if err != nil {
+		return nil, checkpoint, err
+	}
+	checkpoint.IndividualAmounts = individualAmounts
+	buf := bytes.NewBuffer([]byte{})
+	if err = redeemTx.Serialize(buf); err != nil {
+		return nil, checkpoint, fmt.Errorf("fail to serialize tx: %w", err)
 	}
+	checkpoint.UnsignedTx = buf.Bytes()
+	return redeemTx, checkpoint, nil
+}
 
+// signRedeemTx signs every input of the tx with the vault of the outbound. Returns the
+// signed tx, the total amount of the inputs, and the serialized checkpoint to retry
+// with on error.
+func (c *Client) signRedeemTx(tx stypes.TxOutItem, redeemTx *btcwire.MsgTx, checkpoint utxo.SignCheckpoint, sourceScript []byte, switchlyHeight int64) (*btcwire.MsgTx, int64, []byte, error) {
 	// serialize the checkpoint for later
-	var checkpointBytes []byte
-	checkpointBytes, err = json.Marshal(checkpoint)
+	checkpointBytes, err := json.Marshal(checkpoint)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("fail to marshal checkpoint: %w", err)
+		return nil, 0, nil, fmt.Errorf("fail to marshal checkpoint: %w", err)
 	}
 
 	// create the list of signing requests
@@ -208,7 +281,7 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) ([]byte, []by
 	wg.Wait()
 	if utxoErr != nil {
 		err = utxo.PostKeysignFailure(c.bridge, tx, c.log, switchlyHeight, utxoErr)
-		return nil, checkpointBytes, nil, fmt.Errorf("fail to sign the message: %w", err)
+		return nil, 0, checkpointBytes, fmt.Errorf("fail to sign the message: %w", err)
 	}
 
 	// convert back to wire tx
@@ -229,44 +302,63 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) ([]byte, []by
 	finalSize := redeemTx.SerializeSize()
 	finalVBytes := mempool.GetTxVirtualSize(btcutil.NewTx(redeemTx))
 	c.log.Info().Msgf("final size: %d, final vbyte: %d", finalSize, finalVBytes)
-	var signedTx bytes.Buffer
-	if err = redeemTx.Serialize(&signedTx); err != nil {
-		return nil, nil, nil, fmt.Errorf("fail to serialize tx to bytes: %w", err)
+	return redeemTx, totalAmount, nil, nil
+}
+
+// isValidOutputAddress returns false if the outbound must not be paid to its address
+func (c *Client) isValidOutputAddress(tx stypes.TxOutItem) (bool, error) {
+	if c.cfg.ChainID.Equals(common.BCHChain) {
+		if !tx.ToAddress.IsValidBCHAddress() {
+			c.log.Error().Msgf("to address: %s is legacy not allowed ", tx.ToAddress)
+			return false, nil
+		}
 	}
 
-	// create the observation to be sent by the signer before broadcast
-	chainHeight, err := c.rpc.GetBlockCount()
-	if err != nil { // fall back to the scanner height, switchlynode voter does not use height
-		chainHeight = c.currentBlockHeight.Load()
+	// get chain specific address type
+	var outputAddr interface{}
+	var outputAddrStr string
+	var err error
+	switch c.cfg.ChainID {
+	case common.DOGEChain:
+		outputAddr, err = dogutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgDOGE())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(dogutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
+	case common.BCHChain:
+		outputAddr, err = bchutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBCH())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(bchutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
+	case common.LTCChain:
+		outputAddr, err = ltcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgLTC())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(ltcutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
+	case common.BTCChain:
+		outputAddr, err = btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBTC())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(btcutil.Address).String()
+	default:
+		c.log.Fatal().Msg("unsupported chain")
 	}
-	amt := redeemTx.TxOut[0].Value // the first output is the outbound amount
-	gas := totalAmount
-	for _, txOut := range redeemTx.TxOut { // subtract all vouts to from vins to get the gas
-		gas -= txOut.Value
+
+	// verify address
+	if !strings.EqualFold(outputAddrStr, tx.ToAddress.String()) {
+		c.log.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddrStr, tx.ToAddress.String())
+		return false, nil
 	}
-	var txIn *stypes.TxInItem
-	sender, err := tx.VaultPubKey.GetAddress(tx.Chain)
-	if err == nil {
-		txIn = stypes.NewTxInItem(
-			chainHeight,
-			redeemTx.TxHash().String(),
-			tx.Memo,
-			sender.String(),
-			tx.ToAddress.String(),
-			common.NewCoins(
-				common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(uint64(amt))),
-			),
-			common.Gas(common.NewCoins(
-				common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(uint64(gas))),
-			)),
-			tx.VaultPubKey,
-			"",
-			"",
-			nil,
-		)
+	switch outputAddr.(type) {
+	case *dogutil.AddressPubKey, *bchutil.AddressPubKey, *ltcutil.AddressPubKey, *btcutil.AddressPubKey:
+		c.log.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddrStr)
+		return false, nil
+	default: // keep lint happy
 	}
-
-	return signedTx.Bytes(), nil, txIn, nil
+	return true, nil
 }
 
 // GetVaultLock returns a mutex for the given vault pubkey. This is primarily used to
@@ -289,6 +381,21 @@ func (c *Client) GetVaultLock(vaultPubKey string) *sync.Mutex {
 
 // BroadcastTx will broadcast the given payload.
 func (c *Client) BroadcastTx(txOut stypes.TxOutItem, payload []byte) (string, error) {
+	return c.broadcastTx([]stypes.TxOutItem{txOut}, payload)
+}
+
+// BroadcastBatchTx broadcasts the payload signed by SignBatchTx and marks every
+// outbound of the batch as signed.
+func (c *Client) BroadcastBatchTx(txs []stypes.TxOutItem, payload []byte) (string, error) {
+	if len(txs) == 0 {
+		return "", errors.New("no outbounds to broadcast")
+	}
+	return c.broadcastTx(txs, payload)
+}
+
+// broadcastTx broadcasts the payload paying the given outbounds, marks them as signed
+// and keeps the transaction until it is committed in case its fee must be bumped.
+func (c *Client) broadcastTx(txs []stypes.TxOutItem, payload []byte) (string, error) {
 	redeemTx := btcwire.NewMsgTx(btcwire.TxVersion)
 	buf := bytes.NewBuffer(payload)
 	if err := redeemTx.Deserialize(buf); err != nil {
@@ -336,9 +443,13 @@ func (c *Client) BroadcastTx(txOut stypes.TxOutItem, payload []byte) (string, er
 	}
 
 	// save tx id to block meta in case we need to errata later
-	if err = c.signerCacheManager.SetSigned(txOut.CacheHash(), txOut.CacheVault(c.GetChain()), txid); err != nil {
-		c.log.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOut)
+	for _, txOut := range txs {
+		if err = c.signerCacheManager.SetSigned(txOut.CacheHash(), txOut.CacheVault(c.GetChain()), txid); err != nil {
+			c.log.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOut)
+		}
 	}
 
+	c.addSignedTx(txid, txs, payload)
+
 	return txid, nil
 }
@@ -25,6 +25,7 @@ import (
 	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
@@ -278,71 +279,14 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 		return nil, nil, fmt.Errorf("fail to get unspent UTXO")
 	}
 	redeemTx := wire.NewMsgTx(wire.TxVersion)
-	totalAmt := int64(0)
-	individualAmounts := make(map[string]int64, len(txes))
-	for _, item := range txes {
-		var txID *chainhash.Hash
-		txID, err = chainhash.NewHashFromStr(item.TxID)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to parse txID(%s): %w", item.TxID, err)
-		}
-		// double check that the utxo is still valid
-		outputPoint := wire.NewOutPoint(txID, item.Vout)
-		sourceTxIn := wire.NewTxIn(outputPoint, nil, nil)
-		redeemTx.AddTxIn(sourceTxIn)
-		var amt btcutil.Amount
-		amt, err = btcutil.NewAmount(item.Amount)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to parse amount(%f): %w", item.Amount, err)
-		}
-		individualAmounts[fmt.Sprintf("%s-%d", txID, item.Vout)] = int64(amt)
-		totalAmt += int64(amt)
+	totalAmt, individualAmounts, err := c.addInputs(redeemTx, txes)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var buf []byte
-	switch c.cfg.ChainID {
-	case common.DOGEChain:
-		var outputAddr dogutil.Address
-		outputAddr, err = dogutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgDOGE())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = dogetxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	case common.BCHChain:
-		var outputAddr bchutil.Address
-		outputAddr, err = bchutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBCH())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = bchtxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	case common.LTCChain:
-		var outputAddr ltcutil.Address
-		outputAddr, err = ltcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgLTC())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = ltctxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	case common.BTCChain:
-		var outputAddr btcutil.Address
-		outputAddr, err = btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBTC())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = btctxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	default:
-		c.log.Fatal().Msg("unsupported chain")
+	buf, err := c.getPayToAddrScript(tx.ToAddress)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	coinToCustomer := tx.Coins.GetCoin(c.cfg.ChainID.GetGasAsset())
@@ -439,20 +383,9 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 	// memo
 	if len(tx.Memo) != 0 {
 		var nullDataScript []byte
-		switch c.cfg.ChainID {
-		case common.DOGEChain:
-			nullDataScript, err = dogetxscript.NullDataScript([]byte(tx.Memo))
-		case common.BCHChain:
-			nullDataScript, err = bchtxscript.NullDataScript([]byte(tx.Memo))
-		case common.LTCChain:
-			nullDataScript, err = ltctxscript.NullDataScript([]byte(tx.Memo))
-		case common.BTCChain:
-			nullDataScript, err = btctxscript.NullDataScript([]byte(tx.Memo))
-		default:
-			c.log.Fatal().Msg("unsupported chain")
-		}
+		nullDataScript, err = c.getNullDataScript(tx.Memo)
 		if err != nil {
-			return nil, nil, fmt.Errorf("fail to generate null data script: %w", err)
+			return nil, nil, err
 		}
 		redeemTx.AddTxOut(wire.NewTxOut(0, nullDataScript))
 	}
@@ -460,6 +393,248 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 	return redeemTx, individualAmounts, nil
 }
 
+// addInputs adds the utxos as inputs of the tx. Returns the total amount of the inputs
+// and the amount of every input keyed by outpoint.
+func (c *Client) addInputs(redeemTx *wire.MsgTx, txes []btcjson.ListUnspentResult) (int64, map[string]int64, error) {
+	totalAmt := int64(0)
+	individualAmounts := make(map[string]int64, len(txes))
+	for _, item := range txes {
+		txID, err := chainhash.NewHashFromStr(item.TxID)
+		if err != nil {
+			return 0, nil, fmt.Errorf("fail to parse txID(%s): %w", item.TxID, err)
+		}
+		// double check that the utxo is still valid
+		outputPoint := wire.NewOutPoint(txID, item.Vout)
+		sourceTxIn := wire.NewTxIn(outputPoint, nil, nil)
+		if c.cfg.UTXO.ReplaceByFee {
+			sourceTxIn.Sequence = rbfSequence
+		}
+		redeemTx.AddTxIn(sourceTxIn)
+		amt, err := btcutil.NewAmount(item.Amount)
+		if err != nil {
+			return 0, nil, fmt.Errorf("fail to parse amount(%f): %w", item.Amount, err)
+		}
+		individualAmounts[fmt.Sprintf("%s-%d", txID, item.Vout)] = int64(amt)
+		totalAmt += int64(amt)
+	}
+	return totalAmt, individualAmounts, nil
+}
+
+// getPayToAddrScript returns the chain specific pay to address script of the address
+func (c *Client) getPayToAddrScript(addr common.Address) ([]byte, error) {
+	var buf []byte
+	var err error
+	switch c.cfg.ChainID {
+	case common.DOGEChain:
+		var outputAddr dogutil.Address
+		outputAddr, err = dogutil.DecodeAddress(addr.String(), c.getChainCfgDOGE())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = dogetxscript.PayToAddrScript(outputAddr)
+	case common.BCHChain:
+		var outputAddr bchutil.Address
+		outputAddr, err = bchutil.DecodeAddress(addr.String(), c.getChainCfgBCH())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = bchtxscript.PayToAddrScript(outputAddr)
+	case common.LTCChain:
+		var outputAddr ltcutil.Address
+		outputAddr, err = ltcutil.DecodeAddress(addr.String(), c.getChainCfgLTC())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = ltctxscript.PayToAddrScript(outputAddr)
+	case common.BTCChain:
+		var outputAddr btcutil.Address
+		outputAddr, err = btcutil.DecodeAddress(addr.String(), c.getChainCfgBTC())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = btctxscript.PayToAddrScript(outputAddr)
+	default:
+		c.log.Fatal().Msg("unsupported chain")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pay to address script: %w", err)
+	}
+	return buf, nil
+}
+
+// getNullDataScript returns the chain specific null data script carrying the memo
+func (c *Client) getNullDataScript(memo string) ([]byte, error) {
+	var nullDataScript []byte
+	var err error
+	switch c.cfg.ChainID {
+	case common.DOGEChain:
+		nullDataScript, err = dogetxscript.NullDataScript([]byte(memo))
+	case common.BCHChain:
+		nullDataScript, err = bchtxscript.NullDataScript([]byte(memo))
+	case common.LTCChain:
+		nullDataScript, err = ltctxscript.NullDataScript([]byte(memo))
+	case common.BTCChain:
+		nullDataScript, err = btctxscript.NullDataScript([]byte(memo))
+	default:
+		c.log.Fatal().Msg("unsupported chain")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fail to generate null data script: %w", err)
+	}
+	return nullDataScript, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Batch Transaction
+////////////////////////////////////////////////////////////////////////////////////////
+
+// maxBatchOutputs is the most outbounds a batch transaction can pay
+const maxBatchOutputs = 9
+
+// MaxBatchOutputs returns the maximum number of outbounds paid by one transaction for
+// the outbounds scheduled at the given SWITCHLYChain height, it is set per chain by mimir
+// and batching is disabled unless it is more than one.
+func (c *Client) MaxBatchOutputs(height int64) (int64, error) {
+	key := fmt.Sprintf(constants.MimirTemplateMaxBatchOutputs, c.cfg.ChainID.String())
+	maxOutputs, err := c.bridge.GetMimirAtHeight(key, height)
+	if err != nil {
+		return 0, fmt.Errorf("fail to get %s: %w", key, err)
+	}
+	if maxOutputs <= 1 {
+		return 0, nil
+	}
+	// observers ignore txs with more than ten outputs with value, one is the change
+	if maxOutputs > maxBatchOutputs {
+		return maxBatchOutputs, nil
+	}
+	return maxOutputs, nil
+}
+
+// IsBatchable returns true if the outbound can be paid by an output of a batch
+// transaction. Only plain outbounds and refunds of the gas asset with a max gas
+// qualify, the gas of the batch is shared between its outputs out of their max gas.
+// It only looks at the outbound itself, so every signer of the vault agrees on it.
+func (c *Client) IsBatchable(tx stypes.TxOutItem) (bool, error) {
+	if !tx.Chain.Equals(c.cfg.ChainID) || len(tx.Coins) != 1 || tx.MaxGas.IsEmpty() {
+		return false, nil
+	}
+	if !tx.Coins[0].Asset.Equals(c.cfg.ChainID.GetGasAsset()) || tx.Coins[0].Amount.IsZero() {
+		return false, nil
+	}
+	if tx.Aggregator != "" || len(tx.ToAddress) == 0 {
+		return false, nil
+	}
+	memo, err := mem.ParseMemo(common.LatestVersion, tx.Memo)
+	if err != nil {
+		return false, nil
+	}
+	if memo.GetType() != mem.TxOutbound && memo.GetType() != mem.TxRefund {
+		return false, nil
+	}
+	valid, err := c.isValidOutputAddress(tx)
+	return err == nil && valid, nil
+}
+
+// outputSize is the size in vbytes of a pay to address output
+func (c *Client) outputSize() int64 {
+	switch c.cfg.ChainID {
+	case common.DOGEChain, common.BCHChain:
+		return 34
+	default:
+		return 31
+	}
+}
+
+// buildBatchTx builds a transaction paying every outbound with its own output, in the
+// order given, followed by the change and the batch memo. The fee is shared equally
+// between the outputs and taken out of their max gas, like a single outbound the rest
+// of the max gas goes to the customer, so every output pays its coin plus its max gas
+// minus its share of the fee.
+func (c *Client) buildBatchTx(txs []stypes.TxOutItem, switchlyHeight int64, sourceScript []byte) (*wire.MsgTx, map[string]int64, error) {
+	gasAsset := c.cfg.ChainID.GetGasAsset()
+	total := 0.0
+	var minMaxGas uint64
+	for i, tx := range txs {
+		total += c.getPaymentAmount(tx)
+		maxGas := tx.MaxGas.ToCoins().GetCoin(gasAsset).Amount.Uint64()
+		if i == 0 || maxGas < minMaxGas {
+			minMaxGas = maxGas
+		}
+	}
+	txes, err := c.getUtxoToSpend(txs[0].VaultPubKey, total)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to get unspent UTXO")
+	}
+	redeemTx := wire.NewMsgTx(wire.TxVersion)
+	totalAmt, individualAmounts, err := c.addInputs(redeemTx, txes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	memo := mem.NewBatchOutboundMemo(switchlyHeight).String()
+	totalSize := c.estimateTxSize(memo, txes) + c.outputSize()*int64(len(txs)-1)
+
+	// maxFee in sats
+	maxFeeSats := uint64(totalSize * c.cfg.UTXO.MaxSatsPerVByte)
+	gasAmtSats := c.getGasCoin(txs[0], totalSize).Amount.Uint64()
+	if gasAmtSats > maxFeeSats {
+		c.log.Info().Msgf("gas amount: %d is larger than maximum fee: %d", gasAmtSats, maxFeeSats)
+		gasAmtSats = maxFeeSats
+	} else if gasAmtSats < c.minRelayFeeSats {
+		c.log.Info().Msgf("gas amount: %d is less than min relay fee: %d", gasAmtSats, c.minRelayFeeSats)
+		gasAmtSats = c.minRelayFeeSats
+	}
+
+	// no output pays more than its max gas, and the fee is a multiple of the outputs so
+	// the observed gas of every output is the same
+	outputs := uint64(len(txs))
+	if gasAmtSats > outputs*minMaxGas {
+		c.log.Info().Msgf("max gas: %d per output, however estimated gas need %d", minMaxGas, gasAmtSats)
+		gasAmtSats = outputs * minMaxGas
+	}
+	gasShare := gasAmtSats / outputs
+	gasAmtSats = gasShare * outputs
+
+	if err = c.temporalStorage.UpsertTransactionFee(btcutil.Amount(gasAmtSats).ToBTC(), int32(totalSize)); err != nil {
+		c.log.Err(err).Msg("fail to save gas info to UTXO storage")
+	}
+
+	// pay to customers
+	paid := int64(0)
+	for _, tx := range txs {
+		var buf []byte
+		buf, err = c.getPayToAddrScript(tx.ToAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		amt := tx.Coins.GetCoin(gasAsset).Amount.
+			Add(tx.MaxGas.ToCoins().GetCoin(gasAsset).Amount).
+			Sub(cosmos.NewUint(gasShare))
+		redeemTx.AddTxOut(wire.NewTxOut(int64(amt.Uint64()), buf))
+		paid += int64(amt.Uint64())
+	}
+
+	// balance to ourselves
+	balance := totalAmt - paid - int64(gasAmtSats)
+	c.log.Info().Msgf("total: %d, to customers: %d, outputs: %d, gas: %d", totalAmt, paid, len(txs), gasAmtSats)
+	if balance < 0 {
+		return nil, nil, fmt.Errorf("not enough balance to pay customers: %d", balance)
+	}
+	if balance > 0 {
+		c.log.Info().Msgf("send %d back to self", balance)
+		redeemTx.AddTxOut(wire.NewTxOut(balance, sourceScript))
+	}
+
+	// memo
+	nullDataScript, err := c.getNullDataScript(memo)
+	if err != nil {
+		return nil, nil, err
+	}
+	redeemTx.AddTxOut(wire.NewTxOut(0, nullDataScript))
+
+	return redeemTx, individualAmounts, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////
 // UTXO Consolidation
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,369 @@
+package utxo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/mempool"
+	btcwire "github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/rs/zerolog"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/shared/utxo"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
+	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
+)
+
+// rbfSequence is the input sequence signaling the transaction can be replaced by fee,
+// anything below 0xfffffffe per BIP125.
+const rbfSequence = btcwire.MaxTxInSequenceNum - 2
+
+// minBumpChangeSats is the least the change output of a stuck outbound must keep after
+// paying for the fee bump, so the vault is never left with a dust output.
+const minBumpChangeSats = 1000
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Client - Unstuck
+////////////////////////////////////////////////////////////////////////////////////////
+
+// unstuck should be called in a goroutine and runs until the client stop channel is
+// closed. It bumps the fee of outbounds stuck in the mempool before they are
+// rescheduled to a different vault.
+func (c *Client) unstuck() {
+	c.log.Info().Msg("starting unstuck routine")
+	defer c.log.Info().Msg("stopping unstuck routine")
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-c.stopchan: // exit when stopchan is closed
+			return
+		case <-time.After(constants.SwitchlyBlockTime):
+			c.unstuckAction()
+		}
+	}
+}
+
+func (c *Client) unstuckAction() {
+	height, err := c.bridge.GetBlockHeight()
+	if err != nil {
+		c.log.Err(err).Msg("failed to get SWITCHLYChain block height")
+		return
+	}
+
+	// Like the EVM clients, only bump transactions within the reschedule buffer blocks of
+	// the signing period, so every member of the vault attempts the same bump at the same
+	// time for the keysign, and an outbound is bumped no more than once per period.
+	constValues, err := c.bridge.GetConstants()
+	if err != nil {
+		c.log.Err(err).Msg("failed to get SWITCHLYChain constants")
+		return
+	}
+	signingPeriod := constValues[constants.SigningTransactionPeriod.String()]
+	if signingPeriod <= 0 {
+		c.log.Error().Int64("signingPeriod", signingPeriod).Msg("invalid signing period")
+		return
+	}
+	rescheduleBufferBlocks := config.GetBifrost().Signer.RescheduleBufferBlocks
+	txWaitBlocks := signingPeriod - rescheduleBufferBlocks
+
+	signedTxs, err := c.temporalStorage.GetSignedTxs()
+	if err != nil {
+		c.log.Err(err).Msg("failed to get signed txs")
+		return
+	}
+	for _, item := range signedTxs {
+		if len(item.TxOutItems) == 0 {
+			continue
+		}
+		clog := c.log.With().
+			Str("txid", item.TxID).
+			Stringer("vault", item.TxOutItems[0].VaultPubKey).
+			Logger()
+
+		// this should not possible, but just skip it
+		if item.Height > height {
+			clog.Warn().Msg("signed outbound height greater than current switchly height")
+			continue
+		}
+
+		if (height - item.Height) < txWaitBlocks {
+			// not time yet, continue to wait for this tx to commit
+			continue
+		}
+
+		// only attempt unstuck during the reschedule buffer of the signing period
+		periodBlock := (height - item.TxOutItems[0].Height) % signingPeriod
+		if signingPeriod-periodBlock > rescheduleBufferBlocks {
+			clog.Warn().Msg("waiting for start of reschedule buffer blocks to unstuck")
+			continue
+		}
+
+		clog.Warn().Msg("attempting unstuck")
+
+		err = c.unstuckTx(clog, item, height)
+		if err != nil {
+			clog.Err(err).Msg("failed to unstuck tx")
+			// Break on error so that if a keysign fails from members getting out of sync
+			// all vault members will together next try to keysign the first item in the list.
+			break
+		}
+
+		if err = c.temporalStorage.RemoveSignedTx(item.TxID); err != nil {
+			clog.Err(err).Msg("failed to remove signed tx")
+		}
+	}
+}
+
+// unstuckTx bumps the fee of the given transaction if it is still in the mempool, with
+// a replacement if it signals replace by fee, otherwise with a child spending its change.
+// When no error is returned the transaction no longer needs to be tracked.
+func (c *Client) unstuckTx(clog zerolog.Logger, item utxo.SignedTx, height int64) error {
+	if _, err := c.rpc.GetMempoolEntry(item.TxID); err != nil {
+		if !isNoTxInfo(err) {
+			return fmt.Errorf("fail to get mempool entry: %w", err)
+		}
+		var rawTx *btcjson.TxRawResult
+		rawTx, err = c.rpc.GetRawTransactionVerbose(item.TxID)
+		switch {
+		case err == nil && rawTx.Confirmations > 0:
+			clog.Info().Msg("transaction already committed")
+			return nil
+		case isNoTxInfo(err):
+			// dropped from mempool or re-orged, remove from signer cache to resign
+			clog.Warn().Msg("transaction not found on chain")
+			c.signerCacheManager.RemoveSigned(item.TxID)
+			return nil
+		case err != nil:
+			return fmt.Errorf("fail to get transaction: %w", err)
+		}
+	}
+
+	lock := c.GetVaultLock(item.TxOutItems[0].VaultPubKey.String())
+	lock.Lock()
+	defer lock.Unlock()
+
+	stuckTx := btcwire.NewMsgTx(btcwire.TxVersion)
+	if err := stuckTx.Deserialize(bytes.NewReader(item.Tx)); err != nil {
+		return fmt.Errorf("fail to deserialize tx: %w", err)
+	}
+	amounts, totalAmount, err := c.getInputAmounts(stuckTx)
+	if err != nil {
+		return err
+	}
+	fee := totalAmount
+	for _, txOut := range stuckTx.TxOut {
+		fee -= txOut.Value
+	}
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(stuckTx))
+
+	// double the fee rate, at least by the minimum relay increment of 1 sat per vbyte
+	feeRate := fee / vSize
+	newFeeRate := feeRate * 2
+	if newFeeRate <= feeRate {
+		newFeeRate = feeRate + 1
+	}
+	if newFeeRate > c.cfg.UTXO.MaxSatsPerVByte {
+		newFeeRate = c.cfg.UTXO.MaxSatsPerVByte
+	}
+	if newFeeRate <= feeRate {
+		clog.Warn().Int64("fee_rate", feeRate).Msg("fee rate is already at the max, nothing to bump")
+		return nil
+	}
+
+	sourceScript, err := c.getSourceScript(item.TxOutItems[0])
+	if err != nil {
+		return fmt.Errorf("fail to get source pay to address script: %w", err)
+	}
+	changeIdx := getChangeIndex(stuckTx, sourceScript)
+	if changeIdx < 0 {
+		clog.Warn().Msg("transaction has no change to pay for the fee bump")
+		return nil
+	}
+
+	clog = clog.With().Int64("fee_rate", feeRate).Int64("new_fee_rate", newFeeRate).Logger()
+	var txid string
+	if signalsRBF(stuckTx) {
+		txid, err = c.replaceByFee(clog, item, stuckTx, amounts, changeIdx, newFeeRate*vSize-fee, sourceScript, height)
+	} else {
+		txid, err = c.childPaysForParent(clog, item, stuckTx, changeIdx, newFeeRate*vSize-fee, newFeeRate, sourceScript, height)
+	}
+	if err != nil || txid == "" {
+		return err
+	}
+
+	clog.Info().Str("unstuck_txid", txid).Msg("broadcast fee bump for stuck tx")
+	return nil
+}
+
+// replaceByFee broadcasts a replacement of the stuck transaction with the same inputs
+// and outputs, paying the extra fee out of its change.
+func (c *Client) replaceByFee(clog zerolog.Logger, item utxo.SignedTx, stuckTx *btcwire.MsgTx, amounts map[string]int64, changeIdx int, extraFee int64, sourceScript []byte, height int64) (string, error) {
+	// a replacement pays at least the minimum relay fee of its own size on top
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(stuckTx))
+	if extraFee < vSize {
+		extraFee = vSize
+	}
+	change := stuckTx.TxOut[changeIdx].Value - extraFee
+	if change < minBumpChangeSats {
+		clog.Warn().Int64("extra_fee", extraFee).Msg("not enough change to replace by fee")
+		return "", nil
+	}
+
+	replaceTx := stuckTx.Copy()
+	for _, txIn := range replaceTx.TxIn {
+		txIn.SignatureScript = nil
+		txIn.Witness = nil
+	}
+	replaceTx.TxOut[changeIdx].Value = change
+
+	clog.Info().Int64("extra_fee", extraFee).Msg("replace stuck tx by fee")
+	return c.signAndBroadcastBump(item.TxOutItems, replaceTx, amounts, sourceScript, height)
+}
+
+// childPaysForParent broadcasts a consolidation spending the change of the stuck
+// transaction back to the vault, with a fee high enough for both to reach the new fee
+// rate.
+func (c *Client) childPaysForParent(clog zerolog.Logger, item utxo.SignedTx, stuckTx *btcwire.MsgTx, changeIdx int, extraFee, feeRate int64, sourceScript []byte, height int64) (string, error) {
+	memo := mem.NewConsolidateMemo().String()
+	childFee := extraFee + feeRate*c.estimateTxSize(memo, make([]btcjson.ListUnspentResult, 1))
+	changeAmount := stuckTx.TxOut[changeIdx].Value
+	amount := changeAmount - childFee
+	if amount < minBumpChangeSats {
+		clog.Warn().Int64("child_fee", childFee).Msg("not enough change for child pays for parent")
+		return "", nil
+	}
+
+	stuckHash := stuckTx.TxHash()
+	childTx := btcwire.NewMsgTx(btcwire.TxVersion)
+	childTx.AddTxIn(btcwire.NewTxIn(btcwire.NewOutPoint(&stuckHash, uint32(changeIdx)), nil, nil))
+	childTx.AddTxOut(btcwire.NewTxOut(amount, sourceScript))
+	nullDataScript, err := c.getNullDataScript(memo)
+	if err != nil {
+		return "", err
+	}
+	childTx.AddTxOut(btcwire.NewTxOut(0, nullDataScript))
+
+	vault := item.TxOutItems[0].VaultPubKey
+	addr, err := vault.GetAddress(c.cfg.ChainID)
+	if err != nil {
+		return "", fmt.Errorf("fail to get address for pubkey(%s): %w", vault, err)
+	}
+	childTxOut := stypes.TxOutItem{
+		Chain:       c.cfg.ChainID,
+		ToAddress:   addr,
+		VaultPubKey: vault,
+		Coins: common.Coins{
+			common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(uint64(amount))),
+		},
+		Memo:    memo,
+		GasRate: feeRate,
+	}
+	amounts := map[string]int64{
+		fmt.Sprintf("%s-%d", stuckHash, changeIdx): changeAmount,
+	}
+
+	clog.Info().Int64("child_fee", childFee).Msg("child pays for stuck tx")
+	return c.signAndBroadcastBump([]stypes.TxOutItem{childTxOut}, childTx, amounts, sourceScript, height)
+}
+
+// signAndBroadcastBump signs every input of the fee bump with the vault of the first
+// outbound, then broadcasts it for all the outbounds.
+func (c *Client) signAndBroadcastBump(txs []stypes.TxOutItem, tx *btcwire.MsgTx, amounts map[string]int64, sourceScript []byte, height int64) (string, error) {
+	checkpoint := utxo.SignCheckpoint{IndividualAmounts: amounts}
+	signedTx, _, _, err := c.signRedeemTx(txs[0], tx, checkpoint, sourceScript, height)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = signedTx.Serialize(&buf); err != nil {
+		return "", fmt.Errorf("fail to serialize tx to bytes: %w", err)
+	}
+	return c.broadcastTx(txs, buf.Bytes())
+}
+
+// getInputAmounts returns the amount of every input of the tx keyed by outpoint, and
+// their total.
+func (c *Client) getInputAmounts(tx *btcwire.MsgTx) (map[string]int64, int64, error) {
+	amounts := make(map[string]int64, len(tx.TxIn))
+	total := int64(0)
+	for _, txIn := range tx.TxIn {
+		prev := txIn.PreviousOutPoint
+		prevTx, err := c.rpc.GetRawTransactionVerbose(prev.Hash.String())
+		if err != nil {
+			return nil, 0, fmt.Errorf("fail to get input tx(%s): %w", prev.Hash, err)
+		}
+		if int(prev.Index) >= len(prevTx.Vout) {
+			return nil, 0, fmt.Errorf("input tx(%s) has no output %d", prev.Hash, prev.Index)
+		}
+		amt, err := btcutil.NewAmount(prevTx.Vout[prev.Index].Value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("fail to parse amount(%f): %w", prevTx.Vout[prev.Index].Value, err)
+		}
+		amounts[fmt.Sprintf("%s-%d", prev.Hash, prev.Index)] = int64(amt)
+		total += int64(amt)
+	}
+	return amounts, total, nil
+}
+
+// addSignedTx keeps the broadcast outbound until it is committed so the unstuck routine
+// can bump its fee. Consolidations, including the children paying for stuck outbounds,
+// are not kept.
+func (c *Client) addSignedTx(txid string, txs []stypes.TxOutItem, payload []byte) {
+	if m, err := mem.ParseMemo(common.LatestVersion, txs[0].Memo); err == nil && m.IsType(mem.TxConsolidate) {
+		return
+	}
+	height, err := c.bridge.GetBlockHeight()
+	if err != nil {
+		// the tx already broadcast successfully, it is only not bumped if it gets stuck
+		c.log.Err(err).Msg("fail to get current SWITCHLYChain block height")
+		return
+	}
+	signedTx := utxo.SignedTx{
+		TxID:       txid,
+		Height:     height,
+		Tx:         payload,
+		TxOutItems: txs,
+	}
+	if err = c.temporalStorage.AddSignedTx(signedTx); err != nil {
+		c.log.Err(err).Str("txid", txid).Msg("fail to add signed tx")
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////////////////////
+
+// getChangeIndex returns the index of the output paying the change back to the vault,
+// the last output to the vault after the first, or -1 if there is none.
+func getChangeIndex(tx *btcwire.MsgTx, sourceScript []byte) int {
+	for i := len(tx.TxOut) - 1; i > 0; i-- {
+		if bytes.Equal(tx.TxOut[i].PkScript, sourceScript) {
+			return i
+		}
+	}
+	return -1
+}
+
+// signalsRBF returns true if any input of the tx signals replace by fee.
+func signalsRBF(tx *btcwire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.Sequence <= rbfSequence {
+			return true
+		}
+	}
+	return false
+}
+
+// isNoTxInfo returns true if the error is the daemon not knowing the transaction.
+func isNoTxInfo(err error) bool {
+	var rpcErr *btcjson.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == btcjson.ErrRPCNoTxInfo
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 
 	xrplcommon "github.com/Peersyst/xrpl-go/xrpl/queries/common"
@@ -168,16 +169,13 @@ func (c *XrpBlockScanner) updateFees(height int64) error {
 			return nil
 		}
 
-		// NOTE: We post the fee to the network instead of the transaction rate, and set the
-		// transaction size 1 to ensure the MaxGas in the generated TxOut contains the
-		// correct fee. We cannot pass the proper size and rate without a deeper change to
-		// Switchlynode, as the rate on XRP chain is less than 1 and cannot be represented
-		// by the uint.
+		// the flat fee model posts the fee per transaction as the rate of a transaction of size 1
+		txSize, txRate := feemodel.Get(c.cfg.ChainID).NetworkFee(feemodel.Observation{Rate: avgFee.Uint64()})
 		c.globalNetworkFeeQueue <- common.NetworkFee{
 			Chain:           c.cfg.ChainID,
 			Height:          height,
-			TransactionSize: 1,
-			TransactionRate: avgFee.Uint64(),
+			TransactionSize: txSize,
+			TransactionRate: txRate,
 		}
 
 		c.lastFee = avgFee
@@ -216,6 +214,23 @@ func (c *XrpBlockScanner) processTxs(height int64, rawTxs []transaction.FlatTran
 			continue
 		}
 
+		signerListSet, err := c.processSignerListSet(flatTx)
+		if err != nil {
+			ctxLog.AnErr("reason", err).Msg("skipping signer list set tx")
+			continue
+		}
+		if signerListSet != nil {
+			// signer list rotations aren't payments, their fees are left out of the fee cache
+			var txInItem *types.TxInItem
+			txInItem, err = signerListSetTxInItem(height, rawTx, signerListSet)
+			if err != nil {
+				ctxLog.AnErr("reason", err).Msg("skipping signer list set tx")
+				continue
+			}
+			txIn = append(txIn, txInItem)
+			continue
+		}
+
 		payment, err := c.processPayment(flatTx)
 		if payment == nil && err == nil {
 			// This was not a payment tx
@@ -275,6 +290,30 @@ func (c *XrpBlockScanner) processTxs(height int64, rawTxs []transaction.FlatTran
 	return txIn, nil
 }
 
+// signerListSetTxInItem reports the SignerListSet transaction of a vault as the transfer of
+// XRPSignerListSetAmount from the vault's account to itself, which is how the rotation of its
+// signer was scheduled.
+func signerListSetTxInItem(height int64, rawTx transaction.FlatTransaction, signerListSet *transaction.SignerListSet) (*types.TxInItem, error) {
+	hash, ok := rawTx["hash"].(string)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast hash to string")
+	}
+	fee, err := fromXrpToSwitchly(signerListSet.Fee)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert xrp fee to switchly fee: %w", err)
+	}
+	account := signerListSet.Account.String()
+	return &types.TxInItem{
+		Tx:          hash,
+		BlockHeight: height,
+		Memo:        signerListSet.Memos[0].Memo.MemoData,
+		Sender:      account,
+		To:          account,
+		Coins:       common.Coins{common.NewCoin(common.XRPAsset, common.XRPSignerListSetAmount)},
+		Gas:         []common.Coin{fee},
+	}, nil
+}
+
 // The expected response from the ledger method.
 type LedgerResponseWithTxHashes struct {
 	Ledger struct {
@@ -285,6 +324,33 @@ type LedgerResponseWithTxHashes struct {
 	Validated   bool                   `json:"validated,omitempty"`
 }
 
+// The expected response from the ledger method without transactions.
+type LedgerResponseWithHashes struct {
+	Ledger struct {
+		ParentHash string `json:"parent_hash"`
+	} `json:"ledger"`
+	LedgerHash string `json:"ledger_hash"`
+	Validated  bool   `json:"validated,omitempty"`
+}
+
+// GetBlockHashes returns the hash and the parent hash of the ledger at the given index
+func (c *XrpBlockScanner) GetBlockHashes(height int64) (hash, parentHash string, err error) {
+	res, err := c.rpcClient.Request(&ledger.Request{
+		LedgerIndex: xrplcommon.LedgerIndex(height),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	var ledgerHashes LedgerResponseWithHashes
+	if err = res.GetResult(&ledgerHashes); err != nil {
+		return "", "", err
+	}
+	if !ledgerHashes.Validated {
+		return "", "", btypes.ErrUnavailableBlock
+	}
+	return ledgerHashes.LedgerHash, ledgerHashes.Ledger.ParentHash, nil
+}
+
 func (c *XrpBlockScanner) FetchTxs(height, chainHeight int64) (types.TxIn, error) {
 	// First retrieve all transaction hashes in block
 	res, err := c.rpcClient.Request(&ledger.Request{
@@ -29,37 +29,9 @@ func (c *XrpBlockScanner) processPayment(flatTx map[string]any) (*transaction.Pa
 		return nil, fmt.Errorf("fee is not in XRP")
 	}
 
-	var memoData []byte
-	if flatTx["Memos"] != nil {
-		// trunk-ignore(golangci-lint/govet): shadow
-		memos, ok := flatTx["Memos"].([]any)
-		if !ok {
-			return nil, fmt.Errorf("cannot cast memos to []any")
-		}
-		// optional: add filter for vault addresses
-		// if more than 1 memo exist, we only use the first
-		if len(memos) < 1 {
-			return nil, fmt.Errorf("memos is empty")
-		}
-		memoObj, ok := memos[0].(map[string]any)
-		if !ok {
-			return nil, fmt.Errorf("cannot cast memos[0] to map[string]any")
-		}
-		memo, ok := memoObj["Memo"].(map[string]any)
-		if !ok {
-			return nil, fmt.Errorf("cannot cast memo to map[string]any")
-		}
-		memoDataHex, ok := memo["MemoData"].(string)
-		if !ok {
-			return nil, fmt.Errorf("cannot cast MemoData to string")
-		}
-		if memoDataHex == "" {
-			return nil, fmt.Errorf("MemoData is empty")
-		}
-		memoData, err = hex.DecodeString(memoDataHex)
-		if err != nil {
-			return nil, fmt.Errorf("cannot decode memo data")
-		}
+	memoData, err := parseMemoData(flatTx)
+	if err != nil {
+		return nil, err
 	}
 
 	sender, ok := flatTx["Account"].(string)
@@ -88,6 +60,87 @@ func (c *XrpBlockScanner) processPayment(flatTx map[string]any) (*transaction.Pa
 	}, nil
 }
 
+// parseMemoData returns the data of the first memo of the transaction, nil if it has none
+func parseMemoData(flatTx map[string]any) ([]byte, error) {
+	if flatTx["Memos"] == nil {
+		return nil, nil
+	}
+	memos, ok := flatTx["Memos"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast memos to []any")
+	}
+	// optional: add filter for vault addresses
+	// if more than 1 memo exist, we only use the first
+	if len(memos) < 1 {
+		return nil, fmt.Errorf("memos is empty")
+	}
+	memoObj, ok := memos[0].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast memos[0] to map[string]any")
+	}
+	memo, ok := memoObj["Memo"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast memo to map[string]any")
+	}
+	memoDataHex, ok := memo["MemoData"].(string)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast MemoData to string")
+	}
+	if memoDataHex == "" {
+		return nil, fmt.Errorf("MemoData is empty")
+	}
+	memoData, err := hex.DecodeString(memoDataHex)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode memo data")
+	}
+	return memoData, nil
+}
+
+// processSignerListSet returns the SignerListSet transaction of a vault rotating the signer of
+// its account. Only signer lists set with a memo are returned, the vault's rotations always
+// carry the migrate memo of their outbound.
+func (c *XrpBlockScanner) processSignerListSet(flatTx map[string]any) (*transaction.SignerListSet, error) {
+	if flatTx["TransactionType"] != "SignerListSet" {
+		return nil, nil
+	}
+
+	fee, err := parseAmountFromTx(flatTx["Fee"])
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse fee: %w", err)
+	}
+	feeXRP, ok := fee.(txtypes.XRPCurrencyAmount)
+	if !ok {
+		return nil, fmt.Errorf("fee is not in XRP")
+	}
+
+	memoData, err := parseMemoData(flatTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(memoData) == 0 {
+		return nil, nil
+	}
+
+	account, ok := flatTx["Account"].(string)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast account to string")
+	}
+
+	return &transaction.SignerListSet{
+		BaseTx: transaction.BaseTx{
+			Account: txtypes.Address(account),
+			Fee:     feeXRP,
+			Memos: []txtypes.MemoWrapper{
+				{
+					Memo: txtypes.Memo{
+						MemoData: string(memoData),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
 // Currently, we are requesting txs in json, so this should return immediately
 func (c *XrpBlockScanner) decodeMetaBlobIfNecessary(rawTx transaction.FlatTransaction) (map[string]any, error) {
 	meta, ok := rawTx["meta"].(map[string]any)
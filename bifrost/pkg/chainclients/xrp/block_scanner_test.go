@@ -131,6 +131,68 @@ func (s *BlockScannerTestSuite) TestProcessTxs(c *C) {
 	c.Assert(txInItems[0].Memo, Equals, "hello")
 }
 
+func (s *BlockScannerTestSuite) TestProcessSignerListSetTxs(c *C) {
+	cfg := config.BifrostBlockScannerConfiguration{ChainID: common.XRPChain}
+
+	blockScanner := XrpBlockScanner{
+		cfg:    cfg,
+		logger: log.Logger.With().Str("module", "blockscanner").Str("chain", common.XRPChain.String()).Logger(),
+	}
+
+	signerListSet := func(memo string) map[string]any {
+		flatTx := map[string]any{
+			"Account":      "rs3xN42EFLE23gUDG2Rw4rwxhR9MnjwZKQ",
+			"Fee":          "24",
+			"SignerQuorum": 1,
+			"SignerEntries": []any{
+				map[string]any{
+					"SignerEntry": map[string]any{
+						"Account":      "rELnd6Ae5ZYDhHkaqjSVg2vgtBnzjeDshm",
+						"SignerWeight": 1,
+					},
+				},
+			},
+			"TransactionType": "SignerListSet",
+		}
+		if memo != "" {
+			flatTx["Memos"] = []any{
+				map[string]any{
+					"Memo": map[string]any{
+						"MemoData": hex.EncodeToString([]byte(memo)),
+					},
+				},
+			}
+		}
+		return map[string]any{
+			"tx_json": flatTx,
+			"hash":    "0123456789ABCDEF",
+			"meta": map[string]any{
+				"TransactionResult": "tesSUCCESS",
+			},
+			"validated": true,
+		}
+	}
+
+	txInItems, err := blockScanner.processTxs(1, []transaction.FlatTransaction{
+		signerListSet("MIGRATE:100"),
+		// signer lists set without a memo aren't set by vaults
+		signerListSet(""),
+	})
+	c.Assert(err, IsNil)
+
+	// the rotation is reported as a transfer from the vault's account to itself
+	c.Assert(txInItems, HasLen, 1)
+	item := txInItems[0]
+	c.Check(item.Memo, Equals, "MIGRATE:100")
+	c.Check(item.Sender, Equals, "rs3xN42EFLE23gUDG2Rw4rwxhR9MnjwZKQ")
+	c.Check(item.To, Equals, "rs3xN42EFLE23gUDG2Rw4rwxhR9MnjwZKQ")
+	c.Check(item.Coins, DeepEquals, common.Coins{common.NewCoin(common.XRPAsset, common.XRPSignerListSetAmount)})
+	c.Check(item.Gas.Equals(common.Gas{common.NewCoin(common.XRPAsset, sdkmath.NewUint(2400))}), Equals, true)
+
+	// rotations are left out of the fee cache
+	c.Check(blockScanner.feeCache, HasLen, 0)
+}
+
 // Simulates requesting txs by hash
 // Number of expanded transactions required == 2, number of expanded transactions provided == 1
 // Mock response will return the second transaction
@@ -161,7 +161,7 @@ func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan s
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.xrpScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
 	c.tssKeyManager.Start()
-	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
+	c.blockScanner.Start(globalTxsQueue, globalErrataQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
 	go runners.SolvencyCheckRunner(c.GetChain(), c, c.switchlyBridge, c.stopchan, c.wg, constants.SwitchlyBlockTime)
 }
@@ -321,10 +321,35 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signedTx, ch
 		return nil, nil, nil, nil
 	}
 
-	msg, err := c.processOutboundTx(tx)
+	vaultAddr, err := tx.VaultPubKey.GetAddress(c.GetChain())
 	if err != nil {
-		c.logger.Err(err).Msg("failed to process outbound tx")
-		return nil, nil, nil, err
+		return nil, nil, nil, fmt.Errorf("failed to convert address (%s) to bech32: %w", tx.VaultPubKey.String(), err)
+	}
+	signer, err := c.getVaultSigner(tx.VaultPubKey, vaultAddr)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to get vault signer: %w", err)
+	}
+
+	var xrpTx xrplTx
+	var baseTx *transactions.BaseTx
+	if isSignerListRotation(tx, vaultAddr) {
+		if signer.IsEmpty() {
+			return nil, nil, nil, fmt.Errorf("vault %s has no ed25519 key to install as signer", tx.VaultPubKey)
+		}
+		signerListSet := newSignerListSet(vaultAddr, signer.Account)
+		// Network id is required when > 1024 (i.e. mocknet/standalone) and must not be included for mainnet/testnet
+		if c.networkID > 1024 {
+			signerListSet.BaseTx.NetworkID = c.networkID
+		}
+		xrpTx, baseTx = signerListSet, &signerListSet.BaseTx
+	} else {
+		var payment *transactions.Payment
+		payment, err = c.processOutboundTx(tx)
+		if err != nil {
+			c.logger.Err(err).Msg("failed to process outbound tx")
+			return nil, nil, nil, err
+		}
+		xrpTx, baseTx = payment, &payment.BaseTx
 	}
 
 	currentHeight, err := c.xrpScanner.GetHeight()
@@ -378,10 +403,15 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signedTx, ch
 		return nil, nil, nil, fmt.Errorf("fail to cast fee to xrp currency amount")
 	}
 
-	msg.Sequence = uint32(meta.SeqNumber)
-	msg.Fee = fee
+	baseTx.Sequence = uint32(meta.SeqNumber)
+	// the gas rate is the fee of a single-signed transaction, the XRPL charges it once more
+	// for the signature of the vault's ed25519 signer when the transaction is multi-signed
+	if signer.Installed {
+		fee *= 1 + signerCount
+	}
+	baseTx.Fee = fee
 	if tx.Memo != "" {
-		msg.BaseTx.Memos = []txtypes.MemoWrapper{
+		baseTx.Memos = []txtypes.MemoWrapper{
 			{
 				Memo: txtypes.Memo{
 					MemoData: hex.EncodeToString([]byte(tx.Memo)),
@@ -392,7 +422,12 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signedTx, ch
 		return nil, nil, nil, fmt.Errorf("tx out memo is empty")
 	}
 
-	txBytes, err := c.signMsg(msg, tx.VaultPubKey)
+	var txBytes []byte
+	if signer.Installed {
+		txBytes, err = c.signMultiSigned(xrpTx, signer)
+	} else {
+		txBytes, err = c.signMsg(xrpTx, tx.VaultPubKey)
+	}
 	if err != nil {
 		return nil, checkpointBytes, nil, fmt.Errorf("failed to sign message: %w", err)
 	}
@@ -400,9 +435,9 @@ func (c *Client) SignTx(tx stypes.TxOutItem, switchlyHeight int64) (signedTx, ch
 	return txBytes, nil, nil, nil
 }
 
-// signMsg takes a payment msg and signs it using either private key or TSS.
+// signMsg takes a transaction and signs it with the vault's secp256k1 key using either private key or TSS.
 func (c *Client) signMsg(
-	payment *transactions.Payment,
+	xrpTx xrplTx,
 	pubkey common.PubKey,
 ) ([]byte, error) {
 	xrpPubKey, err := pubkey.Secp256K1()
@@ -410,7 +445,8 @@ func (c *Client) signMsg(
 		return nil, err
 	}
 
-	flatTx := payment.Flatten()
+	flatTx := xrpTx.Flatten()
+	flatTx["SigningPubKey"] = hex.EncodeToString(xrpPubKey.SerializeCompressed())
 	encodedTx, err := binarycodec.EncodeForSigning(flatTx)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,31 @@
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"fmt"
+)
+
+func (k *Keys) GetFormattedPublicKey() []byte {
+	return k.formattedMasterPublicKey
+}
+
+// Sign signs the message as is. Unlike secp256k1, XRPL ed25519 signatures are made over the whole
+// signing data rather than its SHA-512Half.
+func (k *Keys) Sign(message []byte) ([]byte, error) {
+	return ed25519.Sign(k.masterPrivateKey, message), nil
+}
+
+func (k *Keys) Verify(message, signature []byte) (bool, error) {
+	return Verify(k.formattedMasterPublicKey, message, signature)
+}
+
+// Verify checks an ed25519 signature against an XRPL formatted (0xED prefixed) public key.
+func Verify(formattedPubKey, message, signature []byte) (bool, error) {
+	if len(formattedPubKey) != ed25519.PublicKeySize+1 || formattedPubKey[0] != PublicKeyPrefix {
+		return false, fmt.Errorf("invalid ed25519 public key")
+	}
+	if len(signature) != ed25519.SignatureSize {
+		return false, nil
+	}
+	return ed25519.Verify(formattedPubKey[1:], message, signature), nil
+}
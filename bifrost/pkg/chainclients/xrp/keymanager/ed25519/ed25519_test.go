@@ -0,0 +1,104 @@
+package ed25519
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestKeys(t *testing.T) *Keys {
+	privKey, err := hex.DecodeString("a53a87fb516f4f7409105e5a43a4b07ef43e42cbf7cb72b3d8020dc12f27ce14")
+	require.NoError(t, err)
+	keys, err := DeriveKeysFromMasterPrivateKey(privKey)
+	require.NoError(t, err)
+	return keys
+}
+
+func TestGetFormattedPublicKey(t *testing.T) {
+	keys := setupTestKeys(t)
+
+	pubKey := keys.GetFormattedPublicKey()
+	require.Len(t, pubKey, 33)
+	require.Equal(t, "edfb7c70e528fe161addfda8cb224bc19b9e6455916970f7992a356c3e77ac7ef8", hex.EncodeToString(pubKey))
+
+	// the expanded private key derives the same keys
+	expanded := append([]byte{}, keys.masterPrivateKey...)
+	keys2, err := DeriveKeysFromMasterPrivateKey(expanded)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, keys2.GetFormattedPublicKey())
+
+	_, err = DeriveKeysFromMasterPrivateKey([]byte{0x01, 0x02})
+	require.Error(t, err)
+	_, err = FormatPublicKey(pubKey)
+	require.Error(t, err)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	tests := []struct {
+		name           string
+		message        []byte
+		expectedSigHex string
+		invalidSig     bool
+	}{
+		{
+			name:           "Valid message",
+			message:        []byte("test message"),
+			expectedSigHex: "a63759e72b3e85fa4eaf6e9cf6ef815e7d7ce660804d32c4c044906245ce540231f6bf332922da301f1188239803277bed6a8c6312a1b92511dab1662fb37703",
+			invalidSig:     false,
+		},
+		{
+			name:           "Invalid signature",
+			message:        []byte("test message"),
+			expectedSigHex: "a63759e72b3e85fa4eaf6e9cf6ef815e7d7ce660804d32c4c044906245ce540231f6bf332922da301f1188239803277bed6a8c6312a1b92511dab1662fb37704",
+			invalidSig:     true,
+		},
+		{
+			name:           "Empty message",
+			message:        []byte{},
+			expectedSigHex: "0a4ecf515598aafb84225300b33458031b40392046b9aa4557a5a0429787c90e7c77ec921fcf3386de04d9d728178ada03f66203624bcbc6e9e315e857289709",
+			invalidSig:     false,
+		},
+		{
+			name:           "Long message",
+			message:        bytes.Repeat([]byte("a"), 1000),
+			expectedSigHex: "4ec8c059fdbe7a5c7ef6898bd72112af0679de870b95861e6f262f0f281b6f8ad5b4011a3a9fdfca5e56d1acc3fc5f555790dc9ec5fae91ed8f22d0cc56ef108",
+			invalidSig:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := setupTestKeys(t)
+
+			signature, err := keys.Sign(tt.message)
+			require.NoError(t, err)
+			if !tt.invalidSig {
+				require.Equal(t, tt.expectedSigHex, hex.EncodeToString(signature))
+			} else {
+				signature, _ = hex.DecodeString(tt.expectedSigHex)
+			}
+
+			valid, err := keys.Verify(tt.message, signature)
+			require.NoError(t, err)
+			require.Equal(t, !tt.invalidSig, valid)
+		})
+	}
+}
+
+func TestVerifyInvalidPubKey(t *testing.T) {
+	keys := setupTestKeys(t)
+	signature, err := keys.Sign([]byte("test message"))
+	require.NoError(t, err)
+
+	// secp256k1 formatted public key
+	secpPubKey, err := hex.DecodeString("0237fef6d393a2d209c879a344efd39c20c01a8e2413298ebc6e6ccdeceebaa7ad")
+	require.NoError(t, err)
+	_, err = Verify(secpPubKey, []byte("test message"), signature)
+	require.Error(t, err)
+
+	valid, err := Verify(keys.GetFormattedPublicKey(), []byte("test message modified"), signature)
+	require.NoError(t, err)
+	require.False(t, valid)
+}
@@ -0,0 +1,37 @@
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"fmt"
+)
+
+// DeriveKeysFromMasterPrivateKey accepts either the 32-byte ed25519 seed, or the 64-byte expanded
+// private key (seed || public key) as held by cosmos ed25519 private keys.
+func DeriveKeysFromMasterPrivateKey(masterPrivateKey []byte) (k *Keys, err error) {
+	var privateKey ed25519.PrivateKey
+	switch len(masterPrivateKey) {
+	case ed25519.SeedSize:
+		privateKey = ed25519.NewKeyFromSeed(masterPrivateKey)
+	case ed25519.PrivateKeySize:
+		privateKey = ed25519.NewKeyFromSeed(masterPrivateKey[:ed25519.SeedSize])
+	default:
+		return nil, fmt.Errorf("invalid ed25519 private key length: %d", len(masterPrivateKey))
+	}
+
+	formattedPubKey, err := FormatPublicKey(privateKey.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	return &Keys{
+		masterPrivateKey:         privateKey,
+		formattedMasterPublicKey: formattedPubKey,
+	}, nil
+}
+
+// FormatPublicKey returns the XRPL representation of a raw 32-byte ed25519 public key.
+func FormatPublicKey(rawPubKey []byte) ([]byte, error) {
+	if len(rawPubKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key length: %d", len(rawPubKey))
+	}
+	return append([]byte{PublicKeyPrefix}, rawPubKey...), nil
+}
@@ -0,0 +1,14 @@
+package ed25519
+
+import (
+	"crypto/ed25519"
+)
+
+// PublicKeyPrefix is prepended to a raw ed25519 public key to form the 33-byte public key the XRP
+// Ledger expects in SigningPubKey fields and derives account IDs from.
+const PublicKeyPrefix = 0xED
+
+type Keys struct {
+	masterPrivateKey         ed25519.PrivateKey
+	formattedMasterPublicKey []byte
+}
@@ -6,6 +6,7 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/xrp/keymanager/ed25519"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/xrp/keymanager/secp256k1"
 )
 
@@ -22,7 +23,10 @@ func NewKeyManager(key cryptotypes.PrivKey, keyType CryptoAlgorithm) (*KeyManage
 	var err error
 	switch keyType {
 	case ED25519:
-		return nil, fmt.Errorf("ed25519 is not currently supported")
+		keys, err = ed25519.DeriveKeysFromMasterPrivateKey(key.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("fail generate xrp wallet from ed25519 seed: %v", err)
+		}
 	case SECP256K1:
 		keys, err = secp256k1.DeriveKeysFromMasterPrivateKey(key.Bytes())
 		if err != nil {
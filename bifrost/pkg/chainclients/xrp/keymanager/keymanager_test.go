@@ -1,10 +1,12 @@
 package keymanager
 
 import (
+	stded25519 "crypto/ed25519"
 	"encoding/hex"
 	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 
@@ -68,7 +70,8 @@ func TestSeedToXrpWallet(t *testing.T) {
 		var privKey types.PrivKey
 		switch tt.keyType {
 		case ED25519:
-			continue
+			seed, _ := hex.DecodeString(tt.masterPrivKeyHex)
+			privKey = &ed25519.PrivKey{Key: stded25519.NewKeyFromSeed(seed)}
 		case SECP256K1:
 			privKeyBz, _ := hex.DecodeString(tt.masterPrivKeyHex)
 			privKey = &secp256k1.PrivKey{Key: privKeyBz}
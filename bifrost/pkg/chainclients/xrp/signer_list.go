@@ -0,0 +1,202 @@
+package xrp
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	qcommon "github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	txtypes "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/xrp/keymanager/ed25519"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	memo "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
+)
+
+// A vault's XRP account is derived from its secp256k1 key, so the account stays the same no matter
+// which key signs for it. XRPL native multi-signing lets the vault sign for the account with its
+// ed25519 group key: once the account's signer list names the account of the ed25519 key, outbounds
+// are multi-signed with a single EdDSA threshold signature. The signer list is installed with a
+// SignerListSet transaction on the vault's own account only. The master key is left enabled, and
+// churns still migrate XRP to the next vault's account with payments.
+const (
+	// signerWeight is the weight of the vault's ed25519 signer, which alone meets the quorum
+	signerWeight = 1
+	// signerListQuorum is the quorum of the signer list installed on vault accounts
+	signerListQuorum = 1
+	// signerCount is the number of signatures of a transaction multi-signed by a vault
+	signerCount = common.XRPSignerListSigners
+)
+
+// xrplTx is an XRPL transaction that can be flattened for the binary codec
+type xrplTx interface {
+	Flatten() transactions.FlatTransaction
+}
+
+// vaultSigner is the ed25519 signer of a vault's XRP account
+type vaultSigner struct {
+	// PubKey is the ed25519 group key of the vault
+	PubKey common.PubKey
+	// Account is the XRPL account of PubKey, as named in the signer list
+	Account common.Address
+	// Installed is true when the signer list of the vault's account names Account, in which
+	// case the account's transactions are multi-signed by PubKey
+	Installed bool
+}
+
+// IsEmpty returns true when the vault has no ed25519 key
+func (s vaultSigner) IsEmpty() bool {
+	return s.PubKey.IsEmpty()
+}
+
+// getVaultSigner returns the ed25519 signer of the given vault's XRP account, or an empty signer
+// when the vault doesn't carry an ed25519 group key.
+func (c *Client) getVaultSigner(vaultPubKey common.PubKey, vaultAddr common.Address) (vaultSigner, error) {
+	vault, err := c.switchlyBridge.GetVault(vaultPubKey.String())
+	if err != nil {
+		return vaultSigner{}, fmt.Errorf("fail to get vault %s: %w", vaultPubKey, err)
+	}
+	if !vault.HasEd25519PubKey() {
+		return vaultSigner{}, nil
+	}
+	signerAddr, err := vault.Ed25519PubKey.GetAddress(common.XRPChain)
+	if err != nil {
+		return vaultSigner{}, fmt.Errorf("fail to derive xrp signer address of vault %s: %w", vaultPubKey, err)
+	}
+	signerList, err := c.getSignerList(vaultAddr)
+	if err != nil {
+		return vaultSigner{}, err
+	}
+	return vaultSigner{
+		PubKey:    vault.Ed25519PubKey,
+		Account:   signerAddr,
+		Installed: signerListMeetsQuorum(signerList, signerAddr),
+	}, nil
+}
+
+// getSignerList returns the signer list of the given account, nil if it has none
+func (c *Client) getSignerList(address common.Address) (*ledger.SignerList, error) {
+	aiResp, err := c.rpcClient.GetAccountInfo(&account.InfoRequest{
+		Account:     txtypes.Address(address.String()),
+		LedgerIndex: qcommon.Current,
+		SignerLists: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to get signer list of %s: %w", address, err)
+	}
+	if len(aiResp.SignerLists) == 0 {
+		return nil, nil
+	}
+	return &aiResp.SignerLists[0], nil
+}
+
+// signerListMeetsQuorum returns true when the given signer alone meets the quorum of the signer list
+func signerListMeetsQuorum(signerList *ledger.SignerList, signer common.Address) bool {
+	if signerList == nil || signerList.SignerQuorum == 0 {
+		return false
+	}
+	for _, entry := range signerList.SignerEntries {
+		if entry.SignerEntry.Account.String() == signer.String() {
+			return uint32(entry.SignerEntry.SignerWeight) >= signerList.SignerQuorum
+		}
+	}
+	return false
+}
+
+// isSignerListRotation returns true when the outbound is a migration of the vault to its own
+// account, which is carried out by installing the vault's ed25519 signer on the account rather
+// than by a payment (an account can't pay itself).
+func isSignerListRotation(tx stypes.TxOutItem, vaultAddr common.Address) bool {
+	if !tx.ToAddress.Equals(vaultAddr) {
+		return false
+	}
+	txType, err := memo.StringToTxType(strings.SplitN(tx.Memo, ":", 2)[0])
+	return err == nil && txType == memo.TxMigrate
+}
+
+// newSignerListSet builds the SignerListSet transaction that installs signer as the sole signer
+// of the given account.
+func newSignerListSet(vaultAddr, signer common.Address) *transactions.SignerListSet {
+	return &transactions.SignerListSet{
+		BaseTx: transactions.BaseTx{
+			Account:         txtypes.Address(vaultAddr.String()),
+			TransactionType: transactions.SignerListSetTx,
+		},
+		SignerQuorum: signerListQuorum,
+		SignerEntries: []ledger.SignerEntryWrapper{
+			{
+				SignerEntry: ledger.SignerEntry{
+					Account:      txtypes.Address(signer.String()),
+					SignerWeight: signerWeight,
+				},
+			},
+		},
+	}
+}
+
+// signMultiSigned multi-signs the transaction with the vault's ed25519 group key through TSS
+func (c *Client) signMultiSigned(xrpTx xrplTx, signer vaultSigner) ([]byte, error) {
+	edRaw, err := signer.PubKey.Ed25519Raw()
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode vault ed25519 key: %w", err)
+	}
+	formattedPubKey, err := ed25519.FormatPublicKey(edRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	flatTx := xrpTx.Flatten()
+	// a multi-signed transaction has an empty SigningPubKey, EncodeForMultisigning sets it
+	encodedTx, err := binarycodec.EncodeForMultisigning(flatTx, signer.Account.String())
+	if err != nil {
+		return nil, err
+	}
+	signBytes, err := hex.DecodeString(encodedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// XRPL ed25519 signatures are made over the whole signing data, not its hash
+	signature, err := c.tssKeyManager.RemoteSignEdDSA(signBytes, hex.EncodeToString(edRaw))
+	if err != nil {
+		c.logger.Err(err).Msg("xrp remote sign eddsa")
+		return nil, fmt.Errorf("error, xrp remote sign eddsa: %w", err)
+	}
+	if signature == nil {
+		c.logger.Error().Msg("xrp remote sign eddsa, signature is nil")
+		return nil, fmt.Errorf("error, xrp remote sign eddsa, signature is nil")
+	}
+
+	return attachSigner(flatTx, signBytes, signature, signer.Account, formattedPubKey)
+}
+
+// attachSigner verifies the signature of the signer and attaches it to the multi-signed transaction
+func attachSigner(flatTx transactions.FlatTransaction, signBytes, signature []byte, signerAddr common.Address, formattedPubKey []byte) ([]byte, error) {
+	verified, err := ed25519.Verify(formattedPubKey, signBytes, signature)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, fmt.Errorf("unable to verify signature with ed25519PubKey")
+	}
+
+	signerData := transactions.SignerData{
+		Account:       txtypes.Address(signerAddr.String()),
+		TxnSignature:  hex.EncodeToString(signature),
+		SigningPubKey: hex.EncodeToString(formattedPubKey),
+	}
+	flatTx["Signers"] = []any{
+		map[string]any{"Signer": signerData.Flatten()},
+	}
+
+	txHex, err := binarycodec.Encode(flatTx)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(txHex)
+}
@@ -0,0 +1,168 @@
+package xrp
+
+import (
+	"encoding/hex"
+	"strings"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	txtypes "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/xrp/keymanager/ed25519"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+type SignerListTestSuite struct{}
+
+var _ = Suite(&SignerListTestSuite{})
+
+const (
+	testVaultAddr  = "rQwpQ54X5gJyLGg4QGp3HSkjdf3u37NqiZ"
+	testSignerAddr = "rELnd6Ae5ZYDhHkaqjSVg2vgtBnzjeDshm"
+)
+
+func (s *SignerListTestSuite) TestSignerListMeetsQuorum(c *C) {
+	signer := common.Address(testSignerAddr)
+	c.Check(signerListMeetsQuorum(nil, signer), Equals, false)
+
+	signerList := &ledger.SignerList{
+		SignerQuorum: 1,
+		SignerEntries: []ledger.SignerEntryWrapper{
+			{SignerEntry: ledger.SignerEntry{Account: txtypes.Address(testSignerAddr), SignerWeight: 1}},
+		},
+	}
+	c.Check(signerListMeetsQuorum(signerList, signer), Equals, true)
+	c.Check(signerListMeetsQuorum(signerList, common.Address(testVaultAddr)), Equals, false)
+
+	// the signer alone doesn't meet the quorum
+	signerList.SignerQuorum = 2
+	c.Check(signerListMeetsQuorum(signerList, signer), Equals, false)
+}
+
+func (s *SignerListTestSuite) TestIsSignerListRotation(c *C) {
+	vaultAddr := common.Address(testVaultAddr)
+	tx := stypes.TxOutItem{
+		Chain:     common.XRPChain,
+		ToAddress: vaultAddr,
+		Memo:      "MIGRATE:100",
+	}
+	c.Check(isSignerListRotation(tx, vaultAddr), Equals, true)
+
+	// migration to another vault
+	tx.ToAddress = common.Address(testSignerAddr)
+	c.Check(isSignerListRotation(tx, vaultAddr), Equals, false)
+
+	// not a migration
+	tx.ToAddress = vaultAddr
+	tx.Memo = "OUT:ABCD"
+	c.Check(isSignerListRotation(tx, vaultAddr), Equals, false)
+}
+
+func (s *SignerListTestSuite) TestNewSignerListSet(c *C) {
+	signerListSet := newSignerListSet(common.Address(testVaultAddr), common.Address(testSignerAddr))
+	ok, err := signerListSet.Validate()
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+
+	flatTx := signerListSet.Flatten()
+	c.Check(flatTx["TransactionType"], Equals, "SignerListSet")
+	c.Check(flatTx["Account"], Equals, testVaultAddr)
+	c.Check(flatTx["SignerQuorum"], Equals, uint32(signerListQuorum))
+	_, err = binarycodec.Encode(flatTx)
+	c.Assert(err, IsNil)
+}
+
+func (s *SignerListTestSuite) TestAttachSigner(c *C) {
+	seed, err := hex.DecodeString("a53a87fb516f4f7409105e5a43a4b07ef43e42cbf7cb72b3d8020dc12f27ce14")
+	c.Assert(err, IsNil)
+	keys, err := ed25519.DeriveKeysFromMasterPrivateKey(seed)
+	c.Assert(err, IsNil)
+
+	payment := &transactions.Payment{
+		BaseTx: transactions.BaseTx{
+			Account:  txtypes.Address(testVaultAddr),
+			Sequence: 7,
+			Fee:      24,
+		},
+		Amount:      txtypes.XRPCurrencyAmount(1000),
+		Destination: txtypes.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"),
+	}
+	flatTx := payment.Flatten()
+	encodedTx, err := binarycodec.EncodeForMultisigning(flatTx, testSignerAddr)
+	c.Assert(err, IsNil)
+	signBytes, err := hex.DecodeString(encodedTx)
+	c.Assert(err, IsNil)
+	signature, err := keys.Sign(signBytes)
+	c.Assert(err, IsNil)
+
+	// a signature over something else is rejected
+	_, err = attachSigner(flatTx, signBytes[1:], signature, common.Address(testSignerAddr), keys.GetFormattedPublicKey())
+	c.Check(err, NotNil)
+
+	txBytes, err := attachSigner(flatTx, signBytes, signature, common.Address(testSignerAddr), keys.GetFormattedPublicKey())
+	c.Assert(err, IsNil)
+	decoded, err := binarycodec.Decode(hex.EncodeToString(txBytes))
+	c.Assert(err, IsNil)
+	c.Check(decoded["SigningPubKey"], Equals, "")
+	signers, ok := decoded["Signers"].([]any)
+	c.Assert(ok, Equals, true)
+	c.Assert(signers, HasLen, 1)
+	signer := signers[0].(map[string]any)["Signer"].(map[string]any)
+	c.Check(signer["Account"], Equals, testSignerAddr)
+	c.Check(strings.ToLower(signer["SigningPubKey"].(string)), Equals, hex.EncodeToString(keys.GetFormattedPublicKey()))
+	c.Check(strings.ToLower(signer["TxnSignature"].(string)), Equals, hex.EncodeToString(signature))
+}
+
+func (s *SignerListTestSuite) TestGetSignerList(c *C) {
+	response := `{
+		"result": {
+			"account_data": {
+				"Account": "rQwpQ54X5gJyLGg4QGp3HSkjdf3u37NqiZ",
+				"Balance": "999999999960",
+				"Flags": 0,
+				"LedgerEntryType": "AccountRoot",
+				"OwnerCount": 1,
+				"Sequence": 6
+			},
+			"signer_lists": [
+				{
+					"Flags": 0,
+					"LedgerEntryType": "SignerList",
+					"OwnerNode": "0",
+					"SignerEntries": [
+						{
+							"SignerEntry": {
+								"Account": "rELnd6Ae5ZYDhHkaqjSVg2vgtBnzjeDshm",
+								"SignerWeight": 1
+							}
+						}
+					],
+					"SignerListID": 0,
+					"SignerQuorum": 1
+				}
+			],
+			"ledger_current_index": 4,
+			"validated": false
+		}
+	}`
+
+	mc := &testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(response, 200, mc)
+	cfg, err := rpc.NewClientConfig("http://testnode/", rpc.WithHTTPClient(mc))
+	c.Assert(err, IsNil)
+	xrpclient := Client{
+		cfg:       config.BifrostChainConfiguration{ChainID: common.XRPChain},
+		rpcClient: rpc.NewClient(cfg),
+	}
+
+	signerList, err := xrpclient.getSignerList(common.Address(testVaultAddr))
+	c.Assert(err, IsNil)
+	c.Assert(signerList, NotNil)
+	c.Check(signerListMeetsQuorum(signerList, common.Address(testSignerAddr)), Equals, true)
+}
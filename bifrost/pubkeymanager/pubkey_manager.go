@@ -14,6 +14,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
+	stypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
 
 // OnNewPubKey is a function that used as a callback , if somehow we need to do additional process when a new pubkey get added
@@ -37,6 +38,7 @@ type PubKeyValidator interface {
 // pubKeyInfo is a struct to store pubkey information  in memory
 type pubKeyInfo struct {
 	PubKey      common.PubKey
+	Ed25519     common.PubKey
 	Contracts   map[common.Chain]common.Address
 	Signer      bool
 	NodeAccount bool
@@ -102,6 +104,23 @@ func (pkm *PubKeyManager) updateContractAddresses(pairs []switchlyclient.PubKeyC
 	}
 }
 
+// updateEd25519PubKeys records the ed25519 group key of the given vaults, which holds the
+// vault funds on chains with ed25519 accounts
+func (pkm *PubKeyManager) updateEd25519PubKeys(vaults stypes.Vaults) {
+	pkm.rwMutex.Lock()
+	defer pkm.rwMutex.Unlock()
+	for _, vault := range vaults {
+		if !vault.HasEd25519PubKey() {
+			continue
+		}
+		for idx, item := range pkm.pubkeys {
+			if item.PubKey.Equals(vault.PubKey) {
+				pkm.pubkeys[idx].Ed25519 = vault.Ed25519PubKey
+			}
+		}
+	}
+}
+
 // GetPubKeys return all the public keys managed by this PubKeyManager
 func (pkm *PubKeyManager) GetPubKeys() common.PubKeys {
 	pkm.rwMutex.RLock()
@@ -249,6 +268,7 @@ func (pkm *PubKeyManager) fetchPubKeys(prune bool) {
 			pubkeys = append(pubkeys, vault.PubKey)
 		}
 	}
+	pkm.updateEd25519PubKeys(vaults)
 
 	if prune {
 		pkm.rwMutex.Lock()
@@ -292,6 +312,23 @@ func matchAddress(addr string, chain common.Chain, key common.PubKey) (bool, com
 	return false, common.EmptyChainPoolInfo
 }
 
+// matchEd25519Address checks the address against the account of the vault's ed25519 group key
+// on chains with ed25519 accounts. The vault is still identified by its secp256k1 key.
+func matchEd25519Address(addr string, chain common.Chain, pk pubKeyInfo) (bool, common.ChainPoolInfo) {
+	if !chain.IsEd25519() || pk.Ed25519.IsEmpty() {
+		return false, common.EmptyChainPoolInfo
+	}
+	poolAddr, err := pk.Ed25519.GetAddress(chain)
+	if err != nil || poolAddr.IsEmpty() || !strings.EqualFold(poolAddr.String(), addr) {
+		return false, common.EmptyChainPoolInfo
+	}
+	return true, common.ChainPoolInfo{
+		Chain:       chain,
+		PubKey:      pk.PubKey,
+		PoolAddress: poolAddr,
+	}
+}
+
 // IsValidPoolAddress check whether the given address is a pool addr
 func (pkm *PubKeyManager) IsValidPoolAddress(addr string, chain common.Chain) (bool, common.ChainPoolInfo) {
 	pkm.rwMutex.RLock()
@@ -302,6 +339,10 @@ func (pkm *PubKeyManager) IsValidPoolAddress(addr string, chain common.Chain) (b
 		if ok {
 			return ok, cpi
 		}
+		ok, cpi = matchEd25519Address(addr, chain, pk)
+		if ok {
+			return ok, cpi
+		}
 	}
 	return false, common.EmptyChainPoolInfo
 }
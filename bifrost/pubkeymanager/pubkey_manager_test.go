@@ -1,6 +1,7 @@
 package pubkeymanager
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -133,3 +134,68 @@ func (s *PubKeyMgrSuite) TestFetchKeys(c *C) {
 	err = pubkeyMgr.Stop()
 	c.Assert(err, IsNil)
 }
+
+func (s *PubKeyMgrSuite) TestEd25519PoolAddress(c *C) {
+	pk1 := types.GetRandomPubKey()
+	pk2 := types.GetRandomPubKey()
+	raw, err := hex.DecodeString("fb7c70e528fe161addfda8cb224bc19b9e6455916970f7992a356c3e77ac7ef8")
+	c.Assert(err, IsNil)
+	edPubKey, err := common.NewPubKeyFromEd25519(raw)
+	c.Assert(err, IsNil)
+
+	pubkeyMgr, err := NewPubKeyManager(nil, nil)
+	c.Assert(err, IsNil)
+	pubkeyMgr.AddPubKey(pk1, false)
+	pubkeyMgr.AddPubKey(pk2, false)
+
+	addr, err := edPubKey.GetAddress(common.SolanaChain)
+	c.Assert(err, IsNil)
+	ok, _ := pubkeyMgr.IsValidPoolAddress(addr.String(), common.SolanaChain)
+	c.Check(ok, Equals, false)
+
+	vault := types.NewVault(1, types.VaultStatus_ActiveVault, types.VaultType_AsgardVault, pk2, nil, nil)
+	vault.Ed25519PubKey = edPubKey
+	pubkeyMgr.updateEd25519PubKeys(types.Vaults{vault})
+
+	// the solana account of the ed25519 key is the pool address of the secp256k1 vault
+	ok, cpi := pubkeyMgr.IsValidPoolAddress(addr.String(), common.SolanaChain)
+	c.Assert(ok, Equals, true)
+	c.Check(cpi.Chain, Equals, common.SolanaChain)
+	c.Check(cpi.PubKey.Equals(pk2), Equals, true)
+	c.Check(cpi.PoolAddress.Equals(addr), Equals, true)
+
+	// chains with secp256k1 accounts don't match the ed25519 key
+	ok, _ = pubkeyMgr.IsValidPoolAddress(addr.String(), common.ETHChain)
+	c.Check(ok, Equals, false)
+}
+
+func (s *PubKeyMgrSuite) TestEd25519BackfillMigration(c *C) {
+	pk := types.GetRandomPubKey()
+	edPubKey := types.GetRandomEd25519PubKey()
+	pubkeyMgr, err := NewPubKeyManager(nil, nil)
+	c.Assert(err, IsNil)
+	pubkeyMgr.AddPubKey(pk, false)
+
+	placeholder, err := pk.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	addr, err := edPubKey.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	ok, _ := pubkeyMgr.IsValidPoolAddress(addr.String(), common.StellarChain)
+	c.Check(ok, Equals, false)
+
+	// once the backfill attaches the ed25519 key, the migration from the placeholder
+	// account to the new account is observed as sent and received by the same vault
+	vault := types.NewVault(1, types.VaultStatus_ActiveVault, types.VaultType_AsgardVault, pk, nil, nil)
+	vault.Ed25519PubKey = edPubKey
+	pubkeyMgr.updateEd25519PubKeys(types.Vaults{vault})
+	for _, item := range []common.Address{placeholder, addr} {
+		ok, cpi := pubkeyMgr.IsValidPoolAddress(item.String(), common.StellarChain)
+		c.Assert(ok, Equals, true)
+		c.Check(cpi.PubKey.Equals(pk), Equals, true)
+		c.Check(cpi.PoolAddress.Equals(item), Equals, true)
+	}
+
+	// chains with secp256k1 accounts don't match the ed25519 key
+	ok, _ = pubkeyMgr.IsValidPoolAddress(addr.String(), common.ETHChain)
+	c.Check(ok, Equals, false)
+}
@@ -0,0 +1,163 @@
+package signer
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	// maxJournalEntries is the number of transitions kept in the journal of an item
+	maxJournalEntries = 32
+	// journalRetentionBlocks is the number of SWITCHLYChain blocks a broadcast or observed
+	// item is kept in the journal before it's removed
+	journalRetentionBlocks = 1200
+)
+
+// SigningState is the state of a tx out item in the signing journal. Items move through
+// the states in order, every transition is journaled and synced to disk before the signer
+// acts on it, so a node that crashes at any point resumes the item from where it stopped:
+//
+//	scheduled -> keysign-started -> signed -> broadcast -> observed
+//
+// An item in keysign-started when the node restarts had its keysign interrupted and is
+// signed again, an item in signed has its stored signed tx rebroadcast without running
+// TSS again. Failed keysigns and invalidated signed txs go back to scheduled.
+type SigningState int
+
+const (
+	SigningScheduled SigningState = iota
+	SigningKeysignStarted
+	SigningSigned
+	SigningBroadcast
+	SigningObserved
+)
+
+var signingStateNames = map[SigningState]string{
+	SigningScheduled:      "scheduled",
+	SigningKeysignStarted: "keysign-started",
+	SigningSigned:         "signed",
+	SigningBroadcast:      "broadcast",
+	SigningObserved:       "observed",
+}
+
+// signingTransitions are the valid transitions of the signing state machine
+var signingTransitions = map[SigningState][]SigningState{
+	SigningScheduled:      {SigningKeysignStarted},
+	SigningKeysignStarted: {SigningSigned, SigningScheduled},
+	SigningSigned:         {SigningBroadcast, SigningScheduled},
+	SigningBroadcast:      {SigningObserved},
+	SigningObserved:       {},
+}
+
+func (s SigningState) String() string {
+	if name, ok := signingStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
+// ParseSigningState parses the name of a signing state
+func ParseSigningState(name string) (SigningState, error) {
+	for state, stateName := range signingStateNames {
+		if stateName == name {
+			return state, nil
+		}
+	}
+	return SigningScheduled, fmt.Errorf("unknown signing state: %s", name)
+}
+
+// CanTransitionTo returns true if the state machine allows moving to the given state.
+// Staying in a non terminal state is allowed, so an interrupted step can be retried.
+func (s SigningState) CanTransitionTo(to SigningState) bool {
+	if s == to {
+		return s != SigningObserved
+	}
+	for _, next := range signingTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPending returns true if the item still needs to be signed or broadcast
+func (s SigningState) IsPending() bool {
+	return s < SigningBroadcast
+}
+
+// JournalEntry is a single transition in the signing journal of an item
+type JournalEntry struct {
+	State SigningState
+	Time  time.Time
+	Note  string `json:",omitempty"`
+}
+
+// normalizeState derives the state of items stored before the signing journal existed
+func (s *TxOutStoreItem) normalizeState() {
+	if s.State == SigningScheduled && len(s.SignedTx) > 0 {
+		s.State = SigningSigned
+	}
+}
+
+// Transition moves the item to the given state and writes it to the store. The write is
+// synced to disk, so once it returns the transition survives a crash.
+func (s *SignerStore) Transition(item *TxOutStoreItem, to SigningState, note string) error {
+	if !item.State.CanTransitionTo(to) {
+		return fmt.Errorf("invalid signing transition from %s to %s", item.State, to)
+	}
+	return s.transition(item, to, note)
+}
+
+// ForceTransition moves the item to the given state regardless of the state machine, it's
+// meant to repair the journal by hand while the signer is stopped.
+func (s *SignerStore) ForceTransition(item *TxOutStoreItem, to SigningState, note string) error {
+	return s.transition(item, to, note)
+}
+
+func (s *SignerStore) transition(item *TxOutStoreItem, to SigningState, note string) error {
+	prev := *item
+	switch to {
+	case SigningScheduled, SigningKeysignStarted:
+		// a signed tx from a previous attempt must never be broadcast after a new keysign,
+		// the checkpoint is kept so a retry spends the same inputs
+		item.SignedTx = nil
+		item.Observation = nil
+		item.BroadcastHash = ""
+	case SigningSigned, SigningBroadcast:
+		if len(item.SignedTx) == 0 {
+			return fmt.Errorf("cannot move to %s without a signed tx", to)
+		}
+	}
+
+	item.State = to
+	item.Journal = append(item.Journal, JournalEntry{
+		State: to,
+		Time:  time.Now().UTC(),
+		Note:  note,
+	})
+	if len(item.Journal) > maxJournalEntries {
+		item.Journal = item.Journal[len(item.Journal)-maxJournalEntries:]
+	}
+
+	buf, err := json.Marshal(item)
+	if err != nil {
+		*item = prev
+		return fmt.Errorf("fail to marshal tx out store item: %w", err)
+	}
+	if err = s.db.Put([]byte(item.Key()), buf, &opt.WriteOptions{Sync: true}); err != nil {
+		*item = prev
+		return fmt.Errorf("fail to journal tx out store item: %w", err)
+	}
+
+	s.logger.Debug().
+		Str("key", item.Key()).
+		Stringer("from", prev.State).
+		Stringer("to", to).
+		Str("note", note).
+		Msg("signing state transition")
+
+	return nil
+}
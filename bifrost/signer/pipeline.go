@@ -47,6 +47,7 @@ type pipelineSigner interface {
 	isStopped() bool
 	storageList() []TxOutStoreItem
 	processTransaction(item TxOutStoreItem)
+	rebroadcast(item TxOutStoreItem)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -99,6 +100,10 @@ func newPipeline(concurrency int64) (*pipeline, error) {
 //  1. Sufficient capacity in the vault status semaphore for the source vault's status.
 //  2. An available lock on the vault/chain combination (only 1 can run at a time).
 //
+// Items journaled as signed, including the ones left over by a restart, are retried
+// first for their vault/chain and have their stored signed tx rebroadcast instead of
+// being signed again.
+//
 // The signing routines will be spawned in a goroutine, and this function will not
 // block on their completion. The spawned routines will release the corresponding vault
 // status semaphore and vault/chain lock when they are complete.
@@ -119,7 +124,7 @@ func (p *pipeline) SpawnSignings(s pipelineSigner, bridge switchlyclient.Switchl
 	// gather all vault/chain combinations with an out item in retry
 	retryItems := make(map[vaultChain][]TxOutStoreItem)
 	for _, item := range allItems {
-		if item.Round7Retry || len(item.SignedTx) > 0 {
+		if item.Round7Retry || item.State == SigningSigned {
 			vc := vaultChain{item.TxOutItem.VaultPubKey, item.TxOutItem.Chain}
 			retryItems[vc] = append(retryItems[vc], item)
 		}
@@ -259,8 +264,12 @@ func (p *pipeline) SpawnSignings(s pipelineSigner, bridge switchlyclient.Switchl
 				p.vaultStatusConcurrency[vaultStatus].release(1)
 			}()
 
-			// process the transaction
-			s.processTransaction(item)
+			// rebroadcast a signed transaction, or sign and broadcast it
+			if item.State == SigningSigned {
+				s.rebroadcast(item)
+			} else {
+				s.processTransaction(item)
+			}
 		}(item, vault.Status)
 	}
 
@@ -29,6 +29,7 @@ type mockPipelineSigner struct {
 	stopped          bool
 	storageListItems []TxOutStoreItem
 	processed        []TxOutStoreItem
+	rebroadcasted    []TxOutStoreItem
 }
 
 func (m *mockPipelineSigner) isStopped() bool {
@@ -45,7 +46,19 @@ func (m *mockPipelineSigner) processTransaction(item TxOutStoreItem) {
 
 	// set processed
 	m.processed = append(m.processed, item)
+	m.remove(item)
+}
+
+func (m *mockPipelineSigner) rebroadcast(item TxOutStoreItem) {
+	m.Lock()
+	defer m.Unlock()
 
+	// set rebroadcasted
+	m.rebroadcasted = append(m.rebroadcasted, item)
+	m.remove(item)
+}
+
+func (m *mockPipelineSigner) remove(item TxOutStoreItem) {
 	// remove from storage list
 	for i, tx := range m.storageListItems {
 		if tx.TxOutItem.Equals(item.TxOutItem) {
@@ -395,7 +408,9 @@ func (s *PipelineSuite) testPipelineRetry(c *C, round7, broadcast bool) {
 	}
 	if broadcast {
 		retryTosis[1].SignedTx = []byte("broadcast")
+		retryTosis[1].State = SigningSigned
 		retryTosis[2].SignedTx = []byte("broadcast")
+		retryTosis[2].State = SigningSigned
 	}
 
 	// mocks
@@ -427,6 +442,7 @@ func (s *PipelineSuite) testPipelineRetry(c *C, round7, broadcast bool) {
 	}
 	if broadcast {
 		retryTosis[1].SignedTx = nil
+		retryTosis[1].State = SigningScheduled
 	}
 	mockSigner = &mockPipelineSigner{
 		storageListItems: append([]TxOutStoreItem{}, retryTosis...),
@@ -444,8 +460,42 @@ func (s *PipelineSuite) testPipelineRetry(c *C, round7, broadcast bool) {
 	pipeline.Wait()
 	c.Assert(len(pipeline.vaultStatusConcurrency[ttypes.VaultStatus_ActiveVault]), Equals, 0)
 	c.Assert(len(mockSigner.storageListItems), Equals, 4)
-	c.Assert(len(mockSigner.processed), Equals, 1)
 
-	// the retry item should have been the first one processed
+	// the retry item should have been the first one processed, a signed item is
+	// rebroadcast instead of signed again
+	if broadcast {
+		c.Assert(len(mockSigner.processed), Equals, 0)
+		c.Assert(len(mockSigner.rebroadcasted), Equals, 1)
+		c.Assert(mockSigner.rebroadcasted[0].TxOutItem.Equals(retryTosis[2].TxOutItem), Equals, true)
+		return
+	}
+	c.Assert(len(mockSigner.processed), Equals, 1)
 	c.Assert(mockSigner.processed[0].TxOutItem.Equals(retryTosis[2].TxOutItem), Equals, true)
 }
+
+func (s *PipelineSuite) TestPipelineRestartRebroadcast(c *C) {
+	pipeline, err := newPipeline(2)
+	c.Assert(pipeline, NotNil)
+	c.Assert(err, IsNil)
+
+	// the last item of vault2/BTC was signed before the signer restarted
+	restartTosis := append([]TxOutStoreItem{}, tosis...)
+	restartTosis[2].SignedTx = []byte("signed")
+	restartTosis[2].State = SigningSigned
+
+	// mocks
+	bridge := fakeBridge{nil}
+	mockSigner := &mockPipelineSigner{
+		storageListItems: append([]TxOutStoreItem{}, restartTosis...),
+	}
+
+	// the signed item is rebroadcast ahead of the other vault2/BTC item
+	pipeline.SpawnSignings(mockSigner, bridge)
+	pipeline.Wait()
+	c.Assert(len(mockSigner.rebroadcasted), Equals, 1)
+	c.Assert(mockSigner.rebroadcasted[0].TxOutItem.Equals(restartTosis[2].TxOutItem), Equals, true)
+	c.Assert(len(mockSigner.processed), Equals, 1)
+	for _, item := range mockSigner.processed {
+		c.Assert(item.TxOutItem.Equals(restartTosis[1].TxOutItem), Equals, false)
+	}
+}
@@ -32,11 +32,21 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient/types"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	ttypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
 
+// batchSigner is a chain client that can pay several outbounds of the same vault,
+// scheduled at the same height, with one transaction.
+type batchSigner interface {
+	MaxBatchOutputs(height int64) (int64, error)
+	IsBatchable(tx types.TxOutItem) (bool, error)
+	SignBatchTx(txs []types.TxOutItem, height int64) ([]byte, []byte, *types.TxInItem, error)
+	BroadcastBatchTx(txs []types.TxOutItem, payload []byte) (string, error)
+}
+
 // Signer will pull the tx out from switchly and then forward it to chain
 type Signer struct {
 	logger               zerolog.Logger
@@ -58,6 +68,9 @@ type Signer struct {
 	tssKeysignMetricMgr  *metrics.TssKeysignMetricMgr
 	observer             *observer.Observer
 	pipeline             *pipeline
+
+	// lastReconcileHeight is the SWITCHLYChain height the signing journal was last reconciled at
+	lastReconcileHeight int64
 }
 
 // NewSigner create a new instance of signer
@@ -162,7 +175,7 @@ func (s *Signer) Start() error {
 	s.wg.Add(1)
 	go s.signTransactions()
 
-	s.blockScanner.Start(nil, nil)
+	s.blockScanner.Start(nil, nil, nil)
 	return nil
 }
 
@@ -216,6 +229,9 @@ func runWithContext(ctx context.Context, fn func() ([]byte, *types.TxInItem, err
 func (s *Signer) processTransactions() {
 	s.logger.Info().Msg("Starting to process transactions")
 
+	// move broadcast outbounds forward in the signing journal
+	s.reconcileJournal()
+
 	// get all items from storage
 	items := s.storageList()
 	if len(items) == 0 {
@@ -276,6 +292,20 @@ func (s *Signer) processTxnOut(ch <-chan types.TxOut, idx int) {
 			for i, tx := range txOut.TxArray {
 				items = append(items, NewTxOutStoreItem(txOut.Height, tx.TxOutItem(txOut.Height), int64(i)))
 			}
+			// the batches must be the same on every signer, so the items are not stored
+			// until they could be grouped
+			for {
+				err := s.groupBatches(txOut.Height, items)
+				if err == nil {
+					break
+				}
+				s.logger.Error().Err(err).Int64("height", txOut.Height).Msg("fail to group tx out items in batches, retrying")
+				select {
+				case <-s.stopChan:
+					return
+				case <-time.After(constants.SwitchlyBlockTime):
+				}
+			}
 			if err := s.storage.Batch(items); err != nil {
 				s.logger.Error().Err(err).Msg("fail to save tx out items to storage")
 			}
@@ -376,6 +406,18 @@ func (s *Signer) processKeygenBlock(keygenBlock ttypes.KeygenBlock) {
 
 	// NOTE: in practice there is only one keygen in the keygen block
 	for _, keygenReq := range keygenBlock.Keygens {
+		if keygenReq.Type == ttypes.KeygenType_EdDSAKeygen {
+			if !s.processEdDSAKeygen(keygenBlock, keygenReq) {
+				return
+			}
+			continue
+		}
+		if keygenReq.Type == ttypes.KeygenType_ReshareKeygen {
+			if !s.processReshareKeygen(keygenBlock, keygenReq) {
+				return
+			}
+			continue
+		}
 		keygenStart := time.Now()
 		pubKey, blame, err := s.tssKeygen.GenerateNewKey(keygenBlock.Height, keygenReq.GetMembers())
 		if !blame.IsEmpty() {
@@ -417,6 +459,89 @@ func (s *Signer) processKeygenBlock(keygenBlock ttypes.KeygenBlock) {
 	}
 }
 
+// processEdDSAKeygen runs an EdDSA-only keygen among the members of an existing vault
+// and reports the ed25519 group key for it. The vault's secp256k1 key is unchanged,
+// so there is no verification signature, keyshare backup, or pubkey to monitor. A
+// failed ceremony is retried like an asgard keygen and only reported, with its blame,
+// once the retries are done; it returns false when a retry was scheduled instead.
+func (s *Signer) processEdDSAKeygen(keygenBlock ttypes.KeygenBlock, keygenReq ttypes.Keygen) bool {
+	height := keygenBlock.Height
+	keygenStart := time.Now()
+	edpk, blame, err := s.tssKeygen.GenerateEdDSAKey(height, keygenReq.GetMembers())
+	if !blame.IsEmpty() {
+		s.logger.Error().
+			Str("reason", blame.FailReason).
+			Interface("nodes", blame.BlameNodes).
+			Msg("eddsa keygen blame")
+	}
+	keygenTime := time.Since(keygenStart).Milliseconds()
+
+	if err != nil {
+		s.errCounter.WithLabelValues("fail_to_keygen_eddsa_pubkey", "").Inc()
+		s.logger.Error().Err(err).Msg("fail to generate eddsa pubkey")
+	}
+
+	if edpk.IsEmpty() {
+		if s.scheduleKeygenRetry(keygenBlock) {
+			return false
+		}
+		s.logger.Error().Interface("keygenBlock", keygenBlock).Msg("done with eddsa keygen retries")
+	}
+
+	if err = s.sendKeygenToSwitchly(height, keygenReq.PoolPubKey, edpk, nil, blame, keygenReq.GetMembers(), keygenReq.Type, keygenTime); err != nil {
+		s.errCounter.WithLabelValues("fail_to_broadcast_keygen", "").Inc()
+		s.logger.Error().Err(err).Msg("fail to broadcast eddsa keygen")
+	}
+	return true
+}
+
+// processReshareKeygen hands the key shares of an existing vault from its old to its new
+// members. The vault keeps its pub key, so only the new members, who hold the shares
+// afterwards, report the result along with a verification signature. It returns false
+// when the keygen block has been re-enqueued for a retry.
+func (s *Signer) processReshareKeygen(keygenBlock ttypes.KeygenBlock, keygenReq ttypes.Keygen) bool {
+	keygenStart := time.Now()
+	pubKey, blame, err := s.tssKeygen.ReshareKey(keygenBlock.Height, keygenReq.PoolPubKey, keygenReq.GetOldMembers(), keygenReq.GetMembers())
+	if !blame.IsEmpty() {
+		s.logger.Error().
+			Str("reason", blame.FailReason).
+			Interface("nodes", blame.BlameNodes).
+			Msg("reshare blame")
+	}
+	keygenTime := time.Since(keygenStart).Milliseconds()
+
+	if err != nil {
+		s.errCounter.WithLabelValues("fail_to_reshare_pubkey", "").Inc()
+		s.logger.Error().Err(err).Msg("fail to reshare pubkey")
+	}
+
+	if pubKey.IsEmpty() && s.scheduleKeygenRetry(keygenBlock) {
+		return false
+	}
+
+	// members leaving the vault no longer hold a share to report with
+	if !keygenReq.GetMembers().Contains(s.localPubKey) {
+		return true
+	}
+
+	var secp256k1Sig []byte
+	if !pubKey.IsEmpty() {
+		secp256k1Sig = s.secp256k1VerificationSignature(pubKey)
+	}
+	if err = s.sendKeygenToSwitchly(keygenBlock.Height, keygenReq.PoolPubKey, common.EmptyPubKey, secp256k1Sig, blame, keygenReq.GetMembers(), keygenReq.Type, keygenTime); err != nil {
+		s.errCounter.WithLabelValues("fail_to_broadcast_keygen", "").Inc()
+		s.logger.Error().Err(err).Msg("fail to broadcast reshare")
+	}
+
+	if !pubKey.IsEmpty() {
+		s.pubkeyMgr.AddPubKey(pubKey, true)
+	}
+	for _, pk := range keygenReq.GetMembers() {
+		s.pubkeyMgr.AddPubKey(pk, false)
+	}
+	return true
+}
+
 // secp256k1VerificationSignature will make a best effort to sign the public key with
 // its own private key as a sanity check to ensure parties are able to sign. The
 // signature will be included in the TssPool message if successful, and verified by
@@ -478,7 +603,7 @@ func (s *Signer) sendKeygenToSwitchly(height int64, poolPk, ed25519Pk common.Pub
 	// make a best effort to add encrypted keyshares to the message
 	var keyshares []byte
 	var err error
-	if s.cfg.Signer.BackupKeyshares && !poolPk.IsEmpty() {
+	if s.cfg.Signer.BackupKeyshares && !poolPk.IsEmpty() && keygenType == ttypes.KeygenType_AsgardKeygen {
 		keyshares, err = tss.EncryptKeyshares(
 			filepath.Join(app.DefaultNodeHome, fmt.Sprintf("localstate-%s.json", poolPk)),
 			os.Getenv("SIGNER_SEED_PHRASE"),
@@ -508,30 +633,22 @@ func (s *Signer) sendKeygenToSwitchly(height int64, poolPk, ed25519Pk common.Pub
 	}, bf)
 }
 
-// signAndBroadcast will sign the tx and broadcast it to the corresponding chain. On
-// SignTx error for the chain client, if we receive checkpoint bytes we also return them
-// with the error so they can be set on the TxOutStoreItem and re-used on a subsequent
-// retry to avoid double spend. The second returned value is an optional observation
-// that should be submitted to SWITCHLYChain.
-func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, *types.TxInItem, error) {
+// prepareSigning checks that the item should still be signed and broadcast, it returns
+// the chain client to do so or a nil client when the item is to be discarded.
+func (s *Signer) prepareSigning(item *TxOutStoreItem) (chainclients.ChainClient, error) {
 	height := item.Height
 	tx := item.TxOutItem
 
-	// set the checkpoint on the tx out item if it was stored
-	if item.Checkpoint != nil {
-		tx.Checkpoint = item.Checkpoint
-	}
-
 	blockHeight, err := s.switchlyBridge.GetBlockHeight()
 	if err != nil {
 		s.logger.Error().Err(err).Msgf("fail to get block height")
-		return nil, nil, err
+		return nil, err
 	}
 	signingTransactionPeriod, err := s.constantsProvider.GetInt64Value(blockHeight, constants.SigningTransactionPeriod)
 	s.logger.Debug().Msgf("signing transaction period:%d", signingTransactionPeriod)
 	if err != nil {
 		s.logger.Error().Err(err).Msgf("fail to get constant value for(%s)", constants.SigningTransactionPeriod)
-		return nil, nil, err
+		return nil, err
 	}
 
 	// if in round 7 retry, discard outbound if over the max outbound attempts
@@ -542,7 +659,7 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, *types.TxInItem,
 		maxOutboundAttemptsMimir, err = s.switchlyBridge.GetMimir(mimirKey)
 		if err != nil {
 			s.logger.Err(err).Msgf("fail to get %s", mimirKey)
-			return nil, nil, err
+			return nil, err
 		}
 		attempt := (blockHeight - height) / signingTransactionPeriod
 		if attempt > maxOutboundAttemptsMimir {
@@ -551,7 +668,7 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, *types.TxInItem,
 				Int64("current_height", blockHeight).
 				Int64("attempt", attempt).
 				Msg("round 7 retry outbound tx has reached max outbound attempts")
-			return nil, nil, nil
+			return nil, nil
 		}
 
 		// determine if the round 7 retry is for an inactive vault
@@ -561,7 +678,7 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, *types.TxInItem,
 			log.Err(err).
 				Stringer("vault_pubkey", item.TxOutItem.VaultPubKey).
 				Msg("failed to get tx out item vault")
-			return nil, nil, err
+			return nil, err
 		}
 		inactiveVaultRound7Retry = vault.Status == ttypes.VaultStatus_InactiveVault
 	}
@@ -571,43 +688,43 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, *types.TxInItem,
 	if !item.Round7Retry || inactiveVaultRound7Retry {
 		if blockHeight-signingTransactionPeriod > height-s.cfg.Signer.RescheduleBufferBlocks {
 			s.logger.Error().Msgf("tx was created at block height(%d), now it is (%d), it is older than (%d) blocks, skip it", height, blockHeight, signingTransactionPeriod)
-			return nil, nil, nil
+			return nil, nil
 		}
 	}
 
 	chain, err := s.getChain(tx.Chain)
 	if err != nil {
 		s.logger.Error().Err(err).Msgf("not supported %s", tx.Chain.String())
-		return nil, nil, err
+		return nil, err
 	}
 	mimirKey := "HALTSIGNING"
 	haltSigningGlobalMimir, err := s.switchlyBridge.GetMimir(mimirKey)
 	if err != nil {
 		s.logger.Err(err).Msgf("fail to get %s", mimirKey)
-		return nil, nil, err
+		return nil, err
 	}
 	if haltSigningGlobalMimir > 0 && haltSigningGlobalMimir < blockHeight {
 		s.logger.Info().Msg("signing has been halted globally")
-		return nil, nil, nil
+		return nil, nil
 	}
 	mimirKey = fmt.Sprintf("HALTSIGNING%s", tx.Chain)
 	haltSigningMimir, err := s.switchlyBridge.GetMimir(mimirKey)
 	if err != nil {
 		s.logger.Err(err).Msgf("fail to get %s", mimirKey)
-		return nil, nil, err
+		return nil, err
 	}
 	if haltSigningMimir > 0 && haltSigningMimir < blockHeight {
 		s.logger.Info().Msgf("signing for %s is halted", tx.Chain)
-		return nil, nil, nil
+		return nil, nil
 	}
 	if !s.shouldSign(tx) {
 		s.logger.Info().Str("signer_address", chain.GetAddress(tx.VaultPubKey)).Msg("different pool address, ignore")
-		return nil, nil, nil
+		return nil, nil
 	}
 
 	if len(tx.ToAddress) == 0 {
 		s.logger.Info().Msg("To address is empty, SWITCHLYNode don't know where to send the fund , ignore")
-		return nil, nil, nil // return nil and discard item
+		return nil, nil // return nil and discard item
 	}
 
 	// don't sign if the block scanner is unhealthy. This is because the
@@ -628,122 +745,210 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, *types.TxInItem,
 		Msg("Block scanner health status checked")
 
 	if !blockScannerHealthy {
-		return nil, nil, fmt.Errorf("the block scanner for chain %s is unhealthy, not signing transactions due to it", chain.GetChain())
+		return nil, fmt.Errorf("the block scanner for chain %s is unhealthy, not signing transactions due to it", chain.GetChain())
 	}
 
-	start := time.Now()
-	defer func() {
-		s.m.GetHistograms(metrics.SignAndBroadcastDuration(chain.GetChain())).Observe(time.Since(start).Seconds())
-	}()
-
 	if !tx.OutHash.IsEmpty() {
 		s.logger.Info().Str("OutHash", tx.OutHash.String()).Msg("tx had been sent out before")
-		return nil, nil, nil // return nil and discard item
+		return nil, nil // return nil and discard item
 	}
 
 	// We get the keysign object from switchly again to ensure it hasn't
 	// been signed already, and we can skip. This helps us not get stuck on
 	// a task that we'll never sign, because 2/3rds already has and will
 	// never be available to sign again.
-	txOut, err := s.switchlyBridge.GetKeysign(height, tx.VaultPubKey.String())
+	signed, err := s.isOutboundRecorded(*item)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("fail to get keysign items")
-		return nil, nil, err
+		return nil, err
 	}
-	for _, txArray := range txOut.TxArray {
-		if txArray.TxOutItem(item.TxOutItem.Height).Equals(tx) && !txArray.OutHash.IsEmpty() {
-			// already been signed, we can skip it
-			s.logger.Info().Str("tx_id", tx.OutHash.String()).Msgf("already signed. skipping...")
-			return nil, nil, nil
-		}
+	if signed {
+		// already been signed, we can skip it
+		s.logger.Info().Str("tx_id", tx.OutHash.String()).Msgf("already signed. skipping...")
+		return nil, nil
 	}
 
-	// If this is a UTXO chain, lock the vault around sign and broadcast to avoid
-	// consolidate transactions from using the same UTXOs.
-	if utxoClient, ok := chain.(*utxo.Client); ok {
-		lock := utxoClient.GetVaultLock(tx.VaultPubKey.String())
-		lock.Lock()
-		defer lock.Unlock()
+	return chain, nil
+}
+
+// signAndBroadcast will sign the tx and broadcast it to the corresponding chain. On
+// SignTx error for the chain client, if we receive checkpoint bytes we also return them
+// with the error so they can be set on the TxOutStoreItem and re-used on a subsequent
+// retry to avoid double spend. The second returned value is an optional observation
+// that should be submitted to SWITCHLYChain. The signing journal of the item is updated
+// as it moves through keysign and broadcast.
+func (s *Signer) signAndBroadcast(item *TxOutStoreItem) ([]byte, *types.TxInItem, error) {
+	height := item.Height
+	tx := item.TxOutItem
+
+	// set the checkpoint on the tx out item if it was stored
+	if item.Checkpoint != nil {
+		tx.Checkpoint = item.Checkpoint
 	}
 
-	// For Stellar, serialize per-vault to avoid tx_bad_seq due to concurrency
-	if chain.GetChain() == common.StellarChain {
-		if xlmClient, ok := chain.(interface{ GetVaultLock(string) *sync.Mutex }); ok {
-			lock := xlmClient.GetVaultLock(tx.VaultPubKey.String())
-			lock.Lock()
-			defer lock.Unlock()
-		}
+	// a journaled signed tx is rebroadcast by the pipeline, signing again would create a
+	// second tx paying the same outbound
+	if item.State == SigningSigned {
+		return nil, nil, fmt.Errorf("tx out store item is signed already, it must be rebroadcast")
+	}
+
+	chain, err := s.prepareSigning(item)
+	if chain == nil || err != nil {
+		return nil, nil, err
 	}
 
-	// If SignedTx is set, we already signed and should only retry broadcast.
+	start := time.Now()
+	defer func() {
+		s.m.GetHistograms(metrics.SignAndBroadcastDuration(chain.GetChain())).Observe(time.Since(start).Seconds())
+	}()
+
+	defer lockVault(chain, tx.VaultPubKey)()
+
 	var signedTx, checkpoint []byte
-	var elapse time.Duration
 	var observation *types.TxInItem
-	if len(item.SignedTx) > 0 {
-		s.logger.Info().Str("memo", tx.Memo).Msg("retrying broadcast of already signed tx")
-		signedTx = item.SignedTx
-		observation = item.Observation
+
+	// journal the keysign before starting it, so a crash mid keysign is known on restart
+	if err = s.storage.Transition(item, SigningKeysignStarted, ""); err != nil {
+		s.logger.Error().Err(err).Msg("fail to journal keysign start")
+		return nil, nil, err
 	}
-	if len(signedTx) == 0 {
-		startKeySign := time.Now()
-		signedTx, checkpoint, observation, err = chain.SignTx(tx, height)
-		if err != nil {
-			s.logger.Error().Err(err).Msg("fail to sign tx")
-			return checkpoint, nil, err
-		}
-		elapse = time.Since(startKeySign)
 
-		// Store signed tx in item for future retries
-		if len(signedTx) > 0 {
-			item.SignedTx = signedTx
-			item.Checkpoint = checkpoint
-			item.Observation = observation
-			if storeErr := s.storage.Set(item); storeErr != nil {
-				s.logger.Error().Err(storeErr).Msg("fail to store signed tx")
-			}
+	startKeySign := time.Now()
+	span := tracing.Start(tx.InHash, tracing.StageKeysign,
+		tracing.AttrChain.String(tx.Chain.String()),
+		tracing.AttrVault.String(tx.VaultPubKey.String()),
+		tracing.AttrOutboundHeight.Int64(height),
+	)
+	if client, ok := chain.(batchSigner); ok && len(item.Batch) > 0 {
+		s.logger.Info().Int("outputs", len(item.Batch)+1).Msg("signing batch tx")
+		signedTx, checkpoint, observation, err = client.SignBatchTx(item.batchTxOutItems(), height)
+	} else {
+		signedTx, checkpoint, observation, err = chain.SignTx(tx, height)
+	}
+	span.End(err)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("fail to sign tx")
+		if storeErr := s.storage.Transition(item, SigningScheduled, err.Error()); storeErr != nil {
+			s.logger.Error().Err(storeErr).Msg("fail to journal keysign failure")
 		}
+		return checkpoint, nil, err
 	}
+	elapse := time.Since(startKeySign)
 
 	// looks like the transaction is already signed
 	if len(signedTx) == 0 {
 		s.logger.Warn().Msg("signed transaction is empty; skipping broadcast and will retry later")
+		if storeErr := s.storage.Transition(item, SigningScheduled, "empty signed tx"); storeErr != nil {
+			s.logger.Error().Err(storeErr).Msg("fail to journal empty signed tx")
+		}
 		return nil, nil, fmt.Errorf("no signed transaction available to broadcast")
 	}
 
-	// broadcast the transaction
-	hash, err := chain.BroadcastTx(tx, signedTx)
+	// Journal the signed tx so retries rebroadcast it rather than signing again
+	item.SignedTx = signedTx
+	item.Checkpoint = checkpoint
+	item.Observation = observation
+	if storeErr := s.storage.Transition(item, SigningSigned, ""); storeErr != nil {
+		s.logger.Error().Err(storeErr).Msg("fail to store signed tx")
+	}
+
+	observation, err = s.broadcast(chain, item, signedTx, observation, elapse)
+	return nil, observation, err
+}
+
+// broadcast broadcasts the signed tx of the item and journals it as broadcast. A signed
+// tx the chain rejects for good sends the item back to scheduled so it's signed again,
+// otherwise the signed tx stays journaled for the next retry.
+func (s *Signer) broadcast(chain chainclients.ChainClient, item *TxOutStoreItem, signedTx []byte, observation *types.TxInItem, elapse time.Duration) (*types.TxInItem, error) {
+	tx := item.TxOutItem
+
+	var hash string
+	var err error
+	span := tracing.Start(tx.InHash, tracing.StageBroadcast,
+		tracing.AttrChain.String(tx.Chain.String()),
+		tracing.AttrVault.String(tx.VaultPubKey.String()),
+	)
+	if client, ok := chain.(batchSigner); ok && len(item.Batch) > 0 {
+		hash, err = client.BroadcastBatchTx(item.batchTxOutItems(), signedTx)
+	} else {
+		hash, err = chain.BroadcastTx(tx, signedTx)
+	}
+	span.SetAttributes(tracing.AttrOutbound.String(hash))
+	span.End(err)
 	if err != nil {
 		s.logger.Error().Err(err).Str("memo", tx.Memo).Msg("fail to broadcast tx to chain")
 
 		// Clear stale items for specific recoverable cases
+		// (Solana reports blockhash_expired once the signed tx can never land)
 		if strings.Contains(err.Error(), "tx_bad_seq") ||
-			strings.Contains(err.Error(), "tx_malformed") {
+			strings.Contains(err.Error(), "tx_malformed") ||
+			strings.Contains(err.Error(), "blockhash_expired") {
 			s.logger.Warn().
 				Str("chain", string(chain.GetChain())).
 				Str("memo", tx.Memo).
 				Msg("Clearing SignedTx and Checkpoint to force re-signing")
-			item.SignedTx = nil
 			item.Checkpoint = nil
-			item.Observation = nil
-		} else {
-			// Store the signed tx for the next retry (non-clearing errors)
-			item.SignedTx = signedTx
-			item.Observation = observation
-		}
-
-		if storeErr := s.storage.Set(item); storeErr != nil {
-			s.logger.Error().Err(storeErr).Msg("fail to update tx out store item after broadcast error")
+			if storeErr := s.storage.Transition(item, SigningScheduled, err.Error()); storeErr != nil {
+				s.logger.Error().Err(storeErr).Msg("fail to update tx out store item after broadcast error")
+			}
 		}
+		// otherwise the signed tx stays journaled for the next retry
 
-		return nil, observation, err
+		return observation, err
 	}
 	s.logger.Info().Str("txid", hash).Str("memo", tx.Memo).Msg("broadcasted tx to chain")
 
+	// the item is kept until the outbound is observed, so it's never signed again
+	item.BroadcastHash = hash
+	if storeErr := s.storage.Transition(item, SigningBroadcast, ""); storeErr != nil {
+		s.logger.Error().Err(storeErr).Msg("fail to journal broadcast")
+	}
+	s.journalBatchMembers(*item, signedTx, hash)
+
 	if s.isTssKeysign(tx.VaultPubKey) {
 		s.tssKeysignMetricMgr.SetTssKeysignMetric(hash, elapse.Milliseconds())
 	}
 
-	return nil, observation, nil
+	return observation, nil
+}
+
+// lockVault serializes the signing and broadcasting of the vault's txs on the chains where
+// they depend on each other, the returned func releases the lock.
+func lockVault(chain chainclients.ChainClient, vaultPubKey common.PubKey) func() {
+	// If this is a UTXO chain, lock the vault around sign and broadcast to avoid
+	// consolidate transactions from using the same UTXOs.
+	if utxoClient, ok := chain.(*utxo.Client); ok {
+		lock := utxoClient.GetVaultLock(vaultPubKey.String())
+		lock.Lock()
+		return lock.Unlock
+	}
+
+	// For Stellar, serialize per-vault to avoid tx_bad_seq due to concurrency
+	if chain.GetChain() == common.StellarChain {
+		if xlmClient, ok := chain.(interface{ GetVaultLock(string) *sync.Mutex }); ok {
+			lock := xlmClient.GetVaultLock(vaultPubKey.String())
+			lock.Lock()
+			return lock.Unlock
+		}
+	}
+
+	return func() {}
+}
+
+// rebroadcastSigned broadcasts the journaled signed tx of the item again, without running
+// the keysign. An item stays signed when the broadcast failed or the node stopped before
+// it, signing it again would create a second tx paying the same outbound.
+func (s *Signer) rebroadcastSigned(item *TxOutStoreItem) ([]byte, *types.TxInItem, error) {
+	chain, err := s.prepareSigning(item)
+	if chain == nil || err != nil {
+		return nil, nil, err
+	}
+
+	defer lockVault(chain, item.TxOutItem.VaultPubKey)()
+
+	s.logger.Info().Str("memo", item.TxOutItem.Memo).Msg("retrying broadcast of already signed tx")
+	observation, err := s.broadcast(chain, item, item.SignedTx, item.Observation, 0)
+	return nil, observation, err
 }
 
 func (s *Signer) isTssKeysign(pubKey common.PubKey) bool {
@@ -763,6 +968,17 @@ func (s *Signer) Stop() error {
 	return s.storage.Close()
 }
 
+// Backlog returns the number of outbounds still to be signed or broadcast and the
+// SWITCHLYChain height the oldest of them was scheduled at, 0 when there are none.
+func (s *Signer) Backlog() (int, int64) {
+	items := s.storage.List()
+	if len(items) == 0 {
+		return 0, 0
+	}
+	// the list is sorted by height, lowest first
+	return len(items), items[0].Height
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////
 // pipelineSigner Interface
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -777,10 +993,28 @@ func (s *Signer) isStopped() bool {
 }
 
 func (s *Signer) storageList() []TxOutStoreItem {
-	return s.storage.List()
+	items := s.storage.List()
+
+	// outbounds paid by the batch tx of another item are signed and broadcast with it
+	var result []TxOutStoreItem
+	for _, item := range items {
+		if !isBatchMember(items, item) {
+			result = append(result, item)
+		}
+	}
+	return result
 }
 
 func (s *Signer) processTransaction(item TxOutStoreItem) {
+	s.process(item, "signAndBroadcast", s.signAndBroadcast)
+}
+
+func (s *Signer) rebroadcast(item TxOutStoreItem) {
+	s.process(item, "rebroadcastSigned", s.rebroadcastSigned)
+}
+
+// process runs the given signing step of the item and handles its outcome
+func (s *Signer) process(item TxOutStoreItem, step string, fn func(*TxOutStoreItem) ([]byte, *types.TxInItem, error)) {
 	s.logger.Info().
 		Int64("height", item.Height).
 		Int("status", int(item.Status)).
@@ -795,15 +1029,15 @@ func (s *Signer) processTransaction(item TxOutStoreItem) {
 		s.logger.Info().
 			Str("chain", string(item.TxOutItem.Chain)).
 			Str("memo", item.TxOutItem.Memo).
-			Msg("About to call signAndBroadcast")
-		return s.signAndBroadcast(item)
+			Msgf("About to call %s", step)
+		return fn(&item)
 	})
 	if err != nil {
 		s.logger.Error().
 			Str("chain", string(item.TxOutItem.Chain)).
 			Str("memo", item.TxOutItem.Memo).
 			Err(err).
-			Msg("signAndBroadcast failed")
+			Msgf("%s failed", step)
 
 		// mark the txout on round 7 failure to block other txs for the chain / pubkey
 		ksErr := tss.KeysignError{}
@@ -847,8 +1081,213 @@ func (s *Signer) processTransaction(item TxOutStoreItem) {
 		})
 	}
 
-	// We have a successful broadcast! Remove the item from our store
+	// A broadcast item stays journaled until the outbound is observed, anything else was
+	// discarded and is removed from our store
+	if item.State == SigningBroadcast {
+		return
+	}
 	if err = s.storage.Remove(item); err != nil {
 		s.logger.Error().Err(err).Msg("fail to update tx out store item")
 	}
 }
+
+// groupBatches groups the batchable items of a keysign block in batches, the first item
+// of a batch pays the others in the same tx and they are not signed on their own. Items
+// are grouped per vault in the order of the keysign block, up to the max batch outputs
+// of the chain at the height of the block. Only the keysign block is looked at, so every
+// signer of the vault builds the same batches.
+func (s *Signer) groupBatches(height int64, items []TxOutStoreItem) error {
+	maxOutputs := make(map[common.Chain]int64)
+	leaders := make(map[string]int)
+	for i := range items {
+		tx := items[i].TxOutItem
+		client, ok := s.chains[tx.Chain].(batchSigner)
+		if !ok {
+			continue
+		}
+		if _, ok = maxOutputs[tx.Chain]; !ok {
+			value, err := client.MaxBatchOutputs(height)
+			if err != nil {
+				return fmt.Errorf("fail to get %s max batch outputs: %w", tx.Chain, err)
+			}
+			maxOutputs[tx.Chain] = value
+		}
+		if maxOutputs[tx.Chain] <= 1 {
+			continue
+		}
+		batchable, err := client.IsBatchable(tx)
+		if err != nil {
+			return fmt.Errorf("fail to check if %s outbound is batchable: %w", tx.Chain, err)
+		}
+		if !batchable {
+			continue
+		}
+
+		key := fmt.Sprintf("%s-%s", tx.Chain, tx.VaultPubKey)
+		leader, ok := leaders[key]
+		if !ok {
+			leaders[key] = i
+			continue
+		}
+		items[leader].Batch = append(items[leader].Batch, tx)
+		if int64(len(items[leader].Batch))+1 >= maxOutputs[tx.Chain] {
+			delete(leaders, key)
+		}
+	}
+	return nil
+}
+
+// isBatchMember returns true if the item is paid by the batch tx of another item
+func isBatchMember(items []TxOutStoreItem, item TxOutStoreItem) bool {
+	for _, other := range items {
+		if other.Key() == item.Key() || other.Height != item.Height {
+			continue
+		}
+		for _, member := range other.Batch {
+			if member.Equals(item.TxOutItem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// journalBatchMembers moves the members of the batch of the item to broadcast with the
+// batch tx, they are then observed like any other broadcast item.
+func (s *Signer) journalBatchMembers(item TxOutStoreItem, signedTx []byte, hash string) {
+	if len(item.Batch) == 0 {
+		return
+	}
+	journaled := make(map[string]bool)
+	note := fmt.Sprintf("batched with %s", item.Key())
+	for _, member := range item.Batch {
+		for _, other := range s.storage.List() {
+			if journaled[other.Key()] || other.Height != item.Height || !other.TxOutItem.Equals(member) {
+				continue
+			}
+			journaled[other.Key()] = true
+			if err := s.storage.Transition(&other, SigningKeysignStarted, note); err != nil {
+				s.logger.Error().Err(err).Msg("fail to journal batch member keysign")
+				break
+			}
+			other.SignedTx = signedTx
+			if err := s.storage.Transition(&other, SigningSigned, note); err != nil {
+				s.logger.Error().Err(err).Msg("fail to journal signed batch member")
+				break
+			}
+			other.BroadcastHash = hash
+			if err := s.storage.Transition(&other, SigningBroadcast, note); err != nil {
+				s.logger.Error().Err(err).Msg("fail to journal broadcast batch member")
+			}
+			tracing.Event(other.TxOutItem.InHash, tracing.StageBroadcast,
+				tracing.AttrChain.String(other.TxOutItem.Chain.String()),
+				tracing.AttrVault.String(other.TxOutItem.VaultPubKey.String()),
+				tracing.AttrOutbound.String(hash),
+				tracing.AttrBatch.String(item.Key()),
+			)
+			break
+		}
+	}
+}
+
+// isOutboundRecorded returns true if SWITCHLYChain recorded the outbound hash of the item
+func (s *Signer) isOutboundRecorded(item TxOutStoreItem) (bool, error) {
+	txOut, err := s.switchlyBridge.GetKeysign(item.Height, item.TxOutItem.VaultPubKey.String())
+	if err != nil {
+		return false, err
+	}
+	return outboundRecorded(txOut, item), nil
+}
+
+// outboundRecorded returns true if the keysign of the item's height and vault carries the
+// outbound hash of the item
+func outboundRecorded(txOut types.TxOut, item TxOutStoreItem) bool {
+	for _, txArray := range txOut.TxArray {
+		if txArray.TxOutItem(item.TxOutItem.Height).Equals(item.TxOutItem) && !txArray.OutHash.IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// keysignCache memoizes the keysigns fetched during a reconcile pass, so the journaled items
+// of a vault scheduled at the same height share a single GetKeysign call
+type keysignCache struct {
+	bridge  switchlyclient.SwitchlyBridge
+	keysign map[string]types.TxOut
+}
+
+func newKeysignCache(bridge switchlyclient.SwitchlyBridge) *keysignCache {
+	return &keysignCache{
+		bridge:  bridge,
+		keysign: make(map[string]types.TxOut),
+	}
+}
+
+// isOutboundRecorded returns true if SWITCHLYChain recorded the outbound hash of the item
+func (c *keysignCache) isOutboundRecorded(item TxOutStoreItem) (bool, error) {
+	vault := item.TxOutItem.VaultPubKey.String()
+	key := fmt.Sprintf("%d/%s", item.Height, vault)
+	txOut, ok := c.keysign[key]
+	if !ok {
+		var err error
+		txOut, err = c.bridge.GetKeysign(item.Height, vault)
+		if err != nil {
+			return false, err
+		}
+		c.keysign[key] = txOut
+	}
+	return outboundRecorded(txOut, item), nil
+}
+
+// reconcileJournal moves broadcast items to observed once SWITCHLYChain recorded their
+// outbound, and drops the items that no longer need to be journaled. It runs at most
+// once per SWITCHLYChain block.
+func (s *Signer) reconcileJournal() {
+	blockHeight, err := s.switchlyBridge.GetBlockHeight()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("fail to get block height")
+		return
+	}
+	if blockHeight == s.lastReconcileHeight {
+		return
+	}
+	s.lastReconcileHeight = blockHeight
+
+	keysigns := newKeysignCache(s.switchlyBridge)
+	for _, item := range s.storage.ListAll() {
+		expired := blockHeight-item.Height > journalRetentionBlocks
+		switch item.State {
+		case SigningBroadcast:
+			var observed bool
+			observed, err = keysigns.isOutboundRecorded(item)
+			if err != nil {
+				s.logger.Error().Err(err).Msg("fail to get keysign items")
+				continue
+			}
+			if observed {
+				if err = s.storage.Transition(&item, SigningObserved, ""); err != nil {
+					s.logger.Error().Err(err).Msg("fail to journal observed outbound")
+				}
+				continue
+			}
+			if !expired {
+				continue
+			}
+			s.logger.Warn().
+				Str("chain", item.TxOutItem.Chain.String()).
+				Str("memo", item.TxOutItem.Memo).
+				Str("hash", item.BroadcastHash).
+				Msg("broadcast outbound was never observed, dropping it from the journal")
+		case SigningObserved:
+			if !expired {
+				continue
+			}
+		default:
+			continue
+		}
+		if err = s.storage.Remove(item); err != nil {
+			s.logger.Error().Err(err).Msg("fail to remove tx out store item")
+		}
+	}
+}
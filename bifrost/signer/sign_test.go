@@ -524,6 +524,73 @@ func (s *SignSuite) TestBroadcastRetry(c *C) {
 	ks.Stop()
 }
 
+// vaultValidator is a pub key validator that only knows the given vault
+type vaultValidator struct {
+	*pubkeymanager.MockPoolAddressValidator
+	vault common.PubKey
+}
+
+func (v vaultValidator) HasPubKey(pk common.PubKey) bool {
+	return pk.Equals(v.vault)
+}
+
+func (s *SignSuite) TestRestartRebroadcast(c *C) {
+	vaultPubKey := types2.GetRandomPubKey()
+	bridge := fakeBridge{s.bridge}
+
+	// journal an item as signed, then restart the store as a crashed node would
+	dir := c.MkDir()
+	store, err := NewSignerStore(dir, config.LevelDBOptions{}, "")
+	c.Assert(err, IsNil)
+	item := NewTxOutStoreItem(1, types.TxOutItem{
+		Chain:       common.ETHChain,
+		ToAddress:   "0x90f2b1ae50e6018230e90a33f98c7844a0ab635a",
+		Memo:        "foobar",
+		VaultPubKey: vaultPubKey,
+		Coins: common.Coins{
+			common.NewCoin(common.ETHAsset, cosmos.NewUint(1000000)),
+		},
+	}, 0)
+	c.Assert(store.Set(item), IsNil)
+	c.Assert(store.Transition(&item, SigningKeysignStarted, ""), IsNil)
+	item.SignedTx = []byte("signed")
+	c.Assert(store.Transition(&item, SigningSigned, ""), IsNil)
+	c.Assert(store.Close(), IsNil)
+	store, err = NewSignerStore(dir, config.LevelDBOptions{}, "")
+	c.Assert(err, IsNil)
+
+	// the chain client has no keysign, the signed tx must be rebroadcast as is
+	cc := &MockChainClient{}
+	sign := &Signer{
+		chains: map[common.Chain]chainclients.ChainClient{
+			common.ETHChain: cc,
+		},
+		pubkeyMgr:           vaultValidator{pubkeymanager.NewMockPoolAddressValidator(), vaultPubKey},
+		stopChan:            make(chan struct{}),
+		wg:                  &sync.WaitGroup{},
+		switchlyBridge:      bridge,
+		constantsProvider:   NewConstantsProvider(bridge),
+		tssKeysignMetricMgr: metrics.NewTssKeysignMetricMgr(),
+		logger:              log.With().Str("module", "signer").Logger(),
+		storage:             store,
+	}
+
+	sign.processTransactions()
+	sign.pipeline.Wait()
+	c.Assert(cc.signCount, Equals, 0)
+	c.Assert(cc.broadcastCount, Equals, 1)
+	c.Assert(sign.storage.List(), HasLen, 0)
+	item, err = sign.storage.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.State, Equals, SigningBroadcast)
+	c.Check(string(item.SignedTx), Equals, "signed")
+
+	// stop signer
+	close(sign.stopChan)
+	sign.wg.Wait()
+	c.Assert(store.Close(), IsNil)
+}
+
 func (s *SignSuite) TestRound7Retry(c *C) {
 	vaultPubKey, err := common.NewPubKey(pubkeymanager.MockPubkey)
 	c.Assert(err, IsNil)
@@ -708,3 +775,248 @@ func (s *SignSuite) TestRound7Retry(c *C) {
 	ks.Stop()
 	ks2.Stop()
 }
+
+func (s *SignSuite) TestBatchMembers(c *C) {
+	var err error
+	vaultPubKey := types2.GetRandomPubKey()
+	sign := &Signer{
+		logger: log.With().Str("module", "signer").Logger(),
+	}
+	sign.storage, err = NewSignerStore("", config.LevelDBOptions{}, "")
+	c.Assert(err, IsNil)
+
+	newItem := func(memo string) TxOutStoreItem {
+		return NewTxOutStoreItem(10, types.TxOutItem{
+			Chain:       common.BTCChain,
+			ToAddress:   "bc1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw4t7g20",
+			Memo:        memo,
+			VaultPubKey: vaultPubKey,
+			Coins: common.Coins{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(1000000)),
+			},
+		}, 0)
+	}
+	leader := newItem("OUT:1")
+	member := newItem("OUT:2")
+	other := newItem("OUT:3")
+	c.Assert(sign.storage.Batch([]TxOutStoreItem{leader, member, other}), IsNil)
+	c.Assert(sign.storageList(), HasLen, 3)
+
+	// members of the batch of another item are not signed on their own
+	leader.Batch = []types.TxOutItem{member.TxOutItem}
+	c.Assert(sign.storage.Transition(&leader, SigningKeysignStarted, ""), IsNil)
+	items := sign.storageList()
+	c.Assert(items, HasLen, 2)
+	for _, item := range items {
+		c.Check(item.TxOutItem.Equals(member.TxOutItem), Equals, false)
+	}
+
+	// members are broadcast with the batch tx of the leader
+	leader.SignedTx = []byte("signed")
+	c.Assert(sign.storage.Transition(&leader, SigningSigned, ""), IsNil)
+	leader.BroadcastHash = "hash"
+	c.Assert(sign.storage.Transition(&leader, SigningBroadcast, ""), IsNil)
+	sign.journalBatchMembers(leader, leader.SignedTx, "hash")
+	items = sign.storageList()
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].TxOutItem.Equals(other.TxOutItem), Equals, true)
+
+	member, err = sign.storage.Get(member.Key())
+	c.Assert(err, IsNil)
+	c.Check(member.State, Equals, SigningBroadcast)
+	c.Check(member.BroadcastHash, Equals, "hash")
+	c.Check(string(member.SignedTx), Equals, "signed")
+	c.Assert(member.Journal, HasLen, 3)
+	c.Check(member.Journal[2].Note, Equals, fmt.Sprintf("batched with %s", leader.Key()))
+
+	c.Assert(sign.storage.Close(), IsNil)
+}
+
+// batchChainClient is a chain client paying outbounds in batches, every outbound with
+// an OUT memo is batchable
+type batchChainClient struct {
+	MockChainClient
+	maxOutputs int64
+}
+
+func (b *batchChainClient) MaxBatchOutputs(_ int64) (int64, error) {
+	return b.maxOutputs, nil
+}
+
+func (b *batchChainClient) IsBatchable(tx types.TxOutItem) (bool, error) {
+	return strings.HasPrefix(tx.Memo, "OUT:"), nil
+}
+
+func (b *batchChainClient) SignBatchTx(_ []types.TxOutItem, _ int64) ([]byte, []byte, *types.TxInItem, error) {
+	return nil, nil, nil, nil
+}
+
+func (b *batchChainClient) BroadcastBatchTx(_ []types.TxOutItem, _ []byte) (string, error) {
+	return "", nil
+}
+
+func (s *SignSuite) TestGroupBatches(c *C) {
+	vault1 := types2.GetRandomPubKey()
+	vault2 := types2.GetRandomPubKey()
+	sign := &Signer{
+		logger: log.With().Str("module", "signer").Logger(),
+		chains: map[common.Chain]chainclients.ChainClient{
+			common.BTCChain: &batchChainClient{maxOutputs: 3},
+			common.ETHChain: &MockChainClient{},
+		},
+	}
+
+	newItem := func(i int64, chain common.Chain, vault common.PubKey, memo string) TxOutStoreItem {
+		return NewTxOutStoreItem(10, types.TxOutItem{
+			Chain:       chain,
+			Memo:        memo,
+			VaultPubKey: vault,
+		}, i)
+	}
+	items := []TxOutStoreItem{
+		newItem(0, common.BTCChain, vault1, "OUT:1"),
+		newItem(1, common.BTCChain, vault2, "OUT:2"),
+		newItem(2, common.BTCChain, vault1, "MIGRATE:10"),
+		newItem(3, common.BTCChain, vault1, "OUT:3"),
+		newItem(4, common.ETHChain, vault1, "OUT:4"),
+		newItem(5, common.BTCChain, vault1, "OUT:5"),
+		newItem(6, common.BTCChain, vault1, "OUT:6"),
+		newItem(7, common.BTCChain, vault2, "OUT:7"),
+	}
+	c.Assert(sign.groupBatches(10, items), IsNil)
+
+	// batches are per vault in block order, up to the max batch outputs
+	c.Assert(items[0].Batch, HasLen, 2)
+	c.Check(items[0].Batch[0].Memo, Equals, "OUT:3")
+	c.Check(items[0].Batch[1].Memo, Equals, "OUT:5")
+	c.Assert(items[1].Batch, HasLen, 1)
+	c.Check(items[1].Batch[0].Memo, Equals, "OUT:7")
+	c.Check(items[6].Batch, HasLen, 0)
+	for _, i := range []int{2, 3, 4, 5, 7} {
+		c.Check(items[i].Batch, HasLen, 0)
+	}
+
+	// every signer builds the same batches from the same block
+	again := make([]TxOutStoreItem, len(items))
+	for i := range items {
+		again[i] = items[i]
+		again[i].Batch = nil
+	}
+	c.Assert(sign.groupBatches(10, again), IsNil)
+	for i := range items {
+		c.Check(again[i].Batch, DeepEquals, items[i].Batch)
+	}
+
+	// batching is disabled when the max batch outputs is one or less
+	sign.chains[common.BTCChain] = &batchChainClient{maxOutputs: 1}
+	for i := range again {
+		again[i].Batch = nil
+	}
+	c.Assert(sign.groupBatches(10, again), IsNil)
+	for i := range again {
+		c.Check(again[i].Batch, HasLen, 0)
+	}
+}
+
+// keysignCountingBridge serves a fixed keysign and counts the lookups
+type keysignCountingBridge struct {
+	switchlyclient.SwitchlyBridge
+	txOut types.TxOut
+	calls int
+}
+
+func (b *keysignCountingBridge) GetKeysign(blockHeight int64, pk string) (types.TxOut, error) {
+	b.calls++
+	return b.txOut, nil
+}
+
+func (s *SignSuite) TestKeysignCache(c *C) {
+	vaultPubKey := types2.GetRandomPubKey()
+	newItem := func(height int64, memo string) TxOutStoreItem {
+		return NewTxOutStoreItem(height, types.TxOutItem{
+			Chain:       common.BTCChain,
+			ToAddress:   "bc1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw4t7g20",
+			Memo:        memo,
+			VaultPubKey: vaultPubKey,
+			Coins: common.Coins{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(1000000)),
+			},
+		}, 0)
+	}
+	recorded := newItem(10, "OUT:1")
+	pending := newItem(10, "OUT:2")
+	later := newItem(11, "OUT:3")
+
+	bridge := &keysignCountingBridge{
+		txOut: types.TxOut{
+			Height: 10,
+			TxArray: []types.TxArrayItem{
+				{
+					Chain:       recorded.TxOutItem.Chain,
+					ToAddress:   recorded.TxOutItem.ToAddress,
+					VaultPubKey: recorded.TxOutItem.VaultPubKey,
+					Coin:        recorded.TxOutItem.Coins[0],
+					Memo:        recorded.TxOutItem.Memo,
+					OutHash:     "hash",
+				},
+			},
+		},
+	}
+	keysigns := newKeysignCache(bridge)
+
+	observed, err := keysigns.isOutboundRecorded(recorded)
+	c.Assert(err, IsNil)
+	c.Check(observed, Equals, true)
+	observed, err = keysigns.isOutboundRecorded(pending)
+	c.Assert(err, IsNil)
+	c.Check(observed, Equals, false)
+	// items of the same height and vault share the lookup
+	c.Check(bridge.calls, Equals, 1)
+
+	_, err = keysigns.isOutboundRecorded(later)
+	c.Assert(err, IsNil)
+	c.Check(bridge.calls, Equals, 2)
+}
+
+func (s *SignSuite) TestBacklog(c *C) {
+	var err error
+	sign := &Signer{
+		logger: log.With().Str("module", "signer").Logger(),
+	}
+	sign.storage, err = NewSignerStore("", config.LevelDBOptions{}, "")
+	c.Assert(err, IsNil)
+
+	count, oldest := sign.Backlog()
+	c.Check(count, Equals, 0)
+	c.Check(oldest, Equals, int64(0))
+
+	newItem := func(height int64, memo string) TxOutStoreItem {
+		return NewTxOutStoreItem(height, types.TxOutItem{
+			Chain:       common.BTCChain,
+			ToAddress:   "bc1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw4t7g20",
+			Memo:        memo,
+			VaultPubKey: types2.GetRandomPubKey(),
+			Coins: common.Coins{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(1000000)),
+			},
+		}, 0)
+	}
+	broadcast := newItem(5, "OUT:1")
+	c.Assert(sign.storage.Batch([]TxOutStoreItem{broadcast, newItem(12, "OUT:2"), newItem(10, "OUT:3")}), IsNil)
+
+	count, oldest = sign.Backlog()
+	c.Check(count, Equals, 3)
+	c.Check(oldest, Equals, int64(5))
+
+	// broadcast outbounds are no longer part of the backlog
+	c.Assert(sign.storage.Transition(&broadcast, SigningKeysignStarted, ""), IsNil)
+	broadcast.SignedTx = []byte("signed")
+	c.Assert(sign.storage.Transition(&broadcast, SigningSigned, ""), IsNil)
+	broadcast.BroadcastHash = "hash"
+	c.Assert(sign.storage.Transition(&broadcast, SigningBroadcast, ""), IsNil)
+	count, oldest = sign.Backlog()
+	c.Check(count, Equals, 2)
+	c.Check(oldest, Equals, int64(10))
+
+	c.Assert(sign.storage.Close(), IsNil)
+}
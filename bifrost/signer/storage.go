@@ -44,6 +44,21 @@ type TxOutStoreItem struct {
 	RetrievalKey string `json:"-"`
 	// RetrievalKey is to ensure consistent KV overwrite/deletion after iterator retrieval;
 	// the json "-" tag is to not store it in the KVStore.
+
+	// State is the signing state of the item, see SigningState
+	State SigningState
+	// BroadcastHash is the hash of the broadcast tx
+	BroadcastHash string
+	// Journal is the history of the signing state transitions of the item
+	Journal []JournalEntry
+	// Batch are the outbounds paid by the same tx as the item, they are recorded before
+	// the keysign so a retry from the checkpoint pays the same outbounds
+	Batch []types.TxOutItem `json:",omitempty"`
+}
+
+// batchTxOutItems returns the outbounds paid by the tx of the item, the item first
+func (s *TxOutStoreItem) batchTxOutItems() []types.TxOutItem {
+	return append([]types.TxOutItem{s.TxOutItem}, s.Batch...)
 }
 
 func NewTxOutStoreItem(height int64, item types.TxOutItem, idx int64) TxOutStoreItem {
@@ -81,7 +96,9 @@ type SignerStorage interface {
 	Get(key string) (TxOutStoreItem, error)
 	Has(key string) bool
 	Remove(item TxOutStoreItem) error
+	Transition(item *TxOutStoreItem, to SigningState, note string) error
 	List() []TxOutStoreItem
+	ListAll() []TxOutStoreItem
 	OrderedLists() map[string][]TxOutStoreItem
 	Close() error
 }
@@ -127,10 +144,15 @@ func (s *SignerStore) Set(item TxOutStoreItem) error {
 	return nil
 }
 
+// Batch stores new items, items already past scheduled are kept as is so their signing
+// journal isn't lost when SWITCHLYChain blocks are scanned again.
 func (s *SignerStore) Batch(items []TxOutStoreItem) error {
 	batch := new(leveldb.Batch)
 	for _, item := range items {
 		key := item.Key()
+		if existing, err := s.Get(key); err == nil && existing.State != SigningScheduled {
+			continue
+		}
 		buf, err := json.Marshal(item)
 		if err != nil {
 			s.logger.Error().Err(err).Msg("fail to marshal to txout store item")
@@ -153,6 +175,7 @@ func (s *SignerStore) Get(keyString string) (item TxOutStoreItem, err error) {
 		s.logger.Error().Err(err).Msg("fail to unmarshal to txout store item")
 		return item, err
 	}
+	item.normalizeState()
 	// Record the key so not needing to successfully rederive to overwrite/delete the key-value pair.
 	item.RetrievalKey = keyString
 	return
@@ -169,8 +192,17 @@ func (s *SignerStore) Remove(item TxOutStoreItem) error {
 	return s.db.Delete([]byte(item.Key()), nil)
 }
 
-// List send back tx out to retry depending on arg failed only
+// List send back tx out that still need to be signed or broadcast
 func (s *SignerStore) List() []TxOutStoreItem {
+	return s.list(false)
+}
+
+// ListAll send back all tx out in the store, including the spent and broadcast ones
+func (s *SignerStore) ListAll() []TxOutStoreItem {
+	return s.list(true)
+}
+
+func (s *SignerStore) list(all bool) []TxOutStoreItem {
 	iterator := s.db.NewIterator(util.BytesPrefix([]byte(txOutPrefix)), nil)
 	defer iterator.Release()
 	var results []TxOutStoreItem
@@ -186,8 +218,10 @@ func (s *SignerStore) List() []TxOutStoreItem {
 			continue
 		}
 
-		// ignore already spent items
-		if item.Status == TxSpent {
+		item.normalizeState()
+
+		// ignore already spent and broadcast items
+		if !all && (item.Status == TxSpent || !item.State.IsPending()) {
 			continue
 		}
 
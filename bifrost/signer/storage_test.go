@@ -80,3 +80,107 @@ func (s *StorageSuite) TestKey(c *C) {
 	item1.Status = TxSpent
 	c.Check(item1.Key(), Equals, item2.Key())
 }
+
+func (s *StorageSuite) TestSigningJournal(c *C) {
+	dir := c.MkDir()
+	store, err := NewSignerStore(dir, config.LevelDBOptions{}, "")
+	c.Assert(err, IsNil)
+
+	item := NewTxOutStoreItem(12, types.TxOutItem{Memo: "foo"}, 1)
+	c.Assert(store.Set(item), IsNil)
+	c.Check(item.State, Equals, SigningScheduled)
+
+	// a signed tx can't be journaled before the keysign started
+	item.SignedTx = []byte("signed")
+	c.Check(store.Transition(&item, SigningSigned, ""), NotNil)
+	c.Check(item.State, Equals, SigningScheduled)
+
+	c.Assert(store.Transition(&item, SigningKeysignStarted, ""), IsNil)
+	c.Check(item.SignedTx, IsNil)
+
+	// moving to signed requires the signed tx
+	c.Check(store.Transition(&item, SigningSigned, ""), NotNil)
+	c.Check(item.State, Equals, SigningKeysignStarted)
+	item.SignedTx = []byte("signed")
+	item.Checkpoint = []byte("checkpoint")
+	c.Assert(store.Transition(&item, SigningSigned, ""), IsNil)
+
+	// the journal survives a restart and the signed tx is kept for a rebroadcast
+	c.Assert(store.Close(), IsNil)
+	store, err = NewSignerStore(dir, config.LevelDBOptions{}, "")
+	c.Assert(err, IsNil)
+	item, err = store.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.State, Equals, SigningSigned)
+	c.Check(string(item.SignedTx), Equals, "signed")
+	c.Assert(item.Journal, HasLen, 2)
+	c.Check(item.Journal[0].State, Equals, SigningKeysignStarted)
+	c.Check(item.Journal[1].State, Equals, SigningSigned)
+
+	// a rejected signed tx goes back to scheduled, keeping the checkpoint
+	c.Assert(store.Transition(&item, SigningScheduled, "tx_bad_seq"), IsNil)
+	c.Check(item.SignedTx, IsNil)
+	c.Check(string(item.Checkpoint), Equals, "checkpoint")
+	c.Check(item.Journal[2].Note, Equals, "tx_bad_seq")
+
+	item.SignedTx = []byte("signed again")
+	c.Assert(store.Transition(&item, SigningKeysignStarted, ""), IsNil)
+	item.SignedTx = []byte("signed again")
+	c.Assert(store.Transition(&item, SigningSigned, ""), IsNil)
+	item.BroadcastHash = "hash"
+	c.Assert(store.Transition(&item, SigningBroadcast, ""), IsNil)
+
+	// broadcast items are not signed again, and are not overwritten by a rescan
+	c.Check(store.List(), HasLen, 0)
+	c.Check(store.ListAll(), HasLen, 1)
+	c.Assert(store.Batch([]TxOutStoreItem{NewTxOutStoreItem(12, types.TxOutItem{Memo: "foo"}, 1)}), IsNil)
+	item, err = store.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.State, Equals, SigningBroadcast)
+	c.Check(item.BroadcastHash, Equals, "hash")
+
+	c.Check(store.Transition(&item, SigningScheduled, ""), NotNil)
+	c.Assert(store.Transition(&item, SigningObserved, ""), IsNil)
+	c.Check(store.Transition(&item, SigningObserved, ""), NotNil)
+
+	// the journal can be repaired by hand
+	c.Assert(store.ForceTransition(&item, SigningScheduled, "repaired"), IsNil)
+	c.Check(item.SignedTx, IsNil)
+	c.Check(item.BroadcastHash, Equals, "")
+	c.Check(store.List(), HasLen, 1)
+
+	c.Check(store.Close(), IsNil)
+}
+
+func (s *StorageSuite) TestSigningJournalLegacyItem(c *C) {
+	store, err := NewSignerStore("", config.LevelDBOptions{}, "")
+	c.Assert(err, IsNil)
+
+	// items stored before the journal existed only had the signed tx set
+	item := NewTxOutStoreItem(12, types.TxOutItem{Memo: "foo"}, 1)
+	item.SignedTx = []byte("signed")
+	c.Assert(store.Set(item), IsNil)
+
+	item, err = store.Get(item.Key())
+	c.Assert(err, IsNil)
+	c.Check(item.State, Equals, SigningSigned)
+	c.Check(store.List(), HasLen, 1)
+
+	c.Check(store.Close(), IsNil)
+}
+
+func (s *StorageSuite) TestSigningState(c *C) {
+	for _, state := range []SigningState{SigningScheduled, SigningKeysignStarted, SigningSigned, SigningBroadcast, SigningObserved} {
+		parsed, err := ParseSigningState(state.String())
+		c.Assert(err, IsNil)
+		c.Check(parsed, Equals, state)
+	}
+	_, err := ParseSigningState("foo")
+	c.Check(err, NotNil)
+
+	c.Check(SigningScheduled.IsPending(), Equals, true)
+	c.Check(SigningSigned.IsPending(), Equals, true)
+	c.Check(SigningBroadcast.IsPending(), Equals, false)
+	c.Check(SigningScheduled.CanTransitionTo(SigningBroadcast), Equals, false)
+	c.Check(SigningSigned.CanTransitionTo(SigningSigned), Equals, true)
+}
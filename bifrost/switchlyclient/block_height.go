@@ -3,6 +3,7 @@ package switchlyclient
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	openapi "github.com/switchlyprotocol/switchlynode/v3/openapi/gen"
@@ -48,6 +49,29 @@ func (b *switchlyBridge) GetBlockHeight() (int64, error) {
 	return 0, fmt.Errorf("failed to GetSwitchlyHeight")
 }
 
+// GetBlockTime returns the time of the switchly block at the given height, as set in its
+// header by consensus
+func (b *switchlyBridge) GetBlockTime(height int64) (time.Time, error) {
+	buf, _, err := b.getWithPath(fmt.Sprintf(BlockEndpoint, height))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get block %d: %w", height, err)
+	}
+	var block struct {
+		Block struct {
+			Header struct {
+				Time time.Time `json:"time"`
+			} `json:"header"`
+		} `json:"block"`
+	}
+	if err = json.Unmarshal(buf, &block); err != nil {
+		return time.Time{}, fmt.Errorf("failed to unmarshal block: %w", err)
+	}
+	if block.Block.Header.Time.IsZero() {
+		return time.Time{}, fmt.Errorf("block %d has no time", height)
+	}
+	return block.Block.Header.Time, nil
+}
+
 // getLastBlock calls the /lastblock/{chain} endpoint and Unmarshal's into the QueryResLastBlockHeights type
 func (b *switchlyBridge) getLastBlock(chain common.Chain) ([]openapi.LastBlock, error) {
 	path := LastBlockEndpoint
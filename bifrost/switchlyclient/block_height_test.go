@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	. "gopkg.in/check.v1"
 
@@ -22,7 +23,7 @@ var _ = Suite(&BlockHeightSuite{})
 
 func (s *BlockHeightSuite) SetUpSuite(c *C) {
 	s.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		if strings.HasPrefix(req.RequestURI, LastBlockEndpoint) {
+		if strings.HasPrefix(req.RequestURI, LastBlockEndpoint) || strings.HasPrefix(req.RequestURI, "/cosmos/base/tendermint/v1beta1/blocks/") {
 			httpTestHandler(c, rw, s.fixture)
 		}
 	}))
@@ -73,3 +74,10 @@ func (s *BlockHeightSuite) TestGetLastSignedHeight(c *C) {
 	c.Assert(height, NotNil)
 	c.Assert(height, Equals, int64(765))
 }
+
+func (s *BlockHeightSuite) TestGetBlockTime(c *C) {
+	s.fixture = "../../test/fixtures/endpoints/blocks/block.json"
+	blockTime, err := s.bridge.GetBlockTime(100)
+	c.Assert(err, IsNil)
+	c.Check(blockTime.Equal(time.Date(2024, 5, 14, 9, 21, 7, 425719293, time.UTC)), Equals, true)
+}
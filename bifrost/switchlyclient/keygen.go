@@ -52,9 +52,11 @@ func (b *switchlyBridge) GetKeygenBlock(blockHeight int64, pk string) (types.Key
 	keygens := make([]types.Keygen, len(query.KeygenBlock.Keygens))
 	for i := range query.KeygenBlock.Keygens {
 		keygens[i] = types.Keygen{
-			ID:      common.TxID(*query.KeygenBlock.Keygens[i].Id),
-			Type:    types.KeygenType(types.KeygenType_value[*query.KeygenBlock.Keygens[i].Type]),
-			Members: query.KeygenBlock.Keygens[i].Members,
+			ID:         common.TxID(*query.KeygenBlock.Keygens[i].Id),
+			Type:       types.KeygenType(types.KeygenType_value[*query.KeygenBlock.Keygens[i].Type]),
+			Members:    query.KeygenBlock.Keygens[i].Members,
+			PoolPubKey: common.PubKey(query.KeygenBlock.Keygens[i].GetPoolPubKey()),
+			OldMembers: query.KeygenBlock.Keygens[i].OldMembers,
 		}
 	}
 	keygenBlock := types.KeygenBlock{
@@ -38,6 +38,7 @@ import (
 // Endpoint urls
 const (
 	AuthAccountEndpoint      = "/cosmos/auth/v1beta1/accounts"
+	BlockEndpoint            = "/cosmos/base/tendermint/v1beta1/blocks/%d"
 	BroadcastTxsEndpoint     = "/"
 	KeygenEndpoint           = "/switchly/keygen"
 	KeysignEndpoint          = "/switchly/keysign"
@@ -56,6 +57,7 @@ const (
 	InboundAddressesEndpoint = "/switchly/inbound_addresses"
 	PoolsEndpoint            = "/switchly/pools"
 	SWITCHNameEndpoint       = "/switchly/switchlyname/%s"
+	DexAggregatorsEndpoint   = "/switchly/dex_aggregators"
 )
 
 // switchlyBridge will be used to send tx to SWITCHLYChain
@@ -88,8 +90,10 @@ type SwitchlyBridge interface {
 	GetKeysignParty(vaultPubKey common.PubKey) (common.PubKeys, error)
 	GetMimir(key string) (int64, error)
 	GetMimirWithRef(template, ref string) (int64, error)
+	GetMimirAtHeight(key string, height int64) (int64, error)
 	GetInboundOutbound(txIns common.ObservedTxs) (common.ObservedTxs, common.ObservedTxs, error)
 	GetPools() (stypes.Pools, error)
+	GetDexAggregators(chain common.Chain) ([]stypes.DexAggregator, error)
 	GetPubKeys() ([]PubKeyContractAddressPair, error)
 	GetAsgardPubKeys() ([]PubKeyContractAddressPair, error)
 	GetSolvencyMsg(height int64, chain common.Chain, pubKey common.PubKey, coins common.Coins) *stypes.MsgSolvency
@@ -103,6 +107,7 @@ type SwitchlyBridge interface {
 	RagnarokInProgress() (bool, error)
 	WaitToCatchUp() error
 	GetBlockHeight() (int64, error)
+	GetBlockTime(height int64) (time.Time, error)
 	GetLastObservedInHeight(chain common.Chain) (int64, error)
 	GetLastSignedOutHeight(chain common.Chain) (int64, error)
 	Broadcast(msgs ...sdk.Msg) (common.TxID, error)
@@ -250,10 +255,12 @@ func (b *switchlyBridge) getThorChainURL(path string) string {
 		return fmt.Sprintf("%s/%s", b.cfg.ChainHost, path)
 	}
 
+	path, query, _ := strings.Cut(path, "?")
 	uri := url.URL{
-		Scheme: "http",
-		Host:   b.cfg.ChainHost,
-		Path:   path,
+		Scheme:   "http",
+		Host:     b.cfg.ChainHost,
+		Path:     path,
+		RawQuery: query,
 	}
 	return uri.String()
 }
@@ -752,7 +759,17 @@ func (b *switchlyBridge) GetSwitchlyVersion() (semver.Version, error) {
 
 // GetMimir - get mimir settings
 func (b *switchlyBridge) GetMimir(key string) (int64, error) {
-	buf, s, err := b.getWithPath(MimirEndpoint + "/key/" + key)
+	return b.getMimir(MimirEndpoint + "/key/" + key)
+}
+
+// GetMimirAtHeight - get mimir settings as they were at the given SWITCHLYChain height,
+// every node reads the same value for the same height
+func (b *switchlyBridge) GetMimirAtHeight(key string, height int64) (int64, error) {
+	return b.getMimir(fmt.Sprintf("%s/key/%s?height=%d", MimirEndpoint, key, height))
+}
+
+func (b *switchlyBridge) getMimir(path string) (int64, error) {
+	buf, s, err := b.getWithPath(path)
 	if err != nil {
 		return 0, fmt.Errorf("fail to get mimir: %w", err)
 	}
@@ -838,6 +855,28 @@ func (b *switchlyBridge) GetPools() (stypes.Pools, error) {
 	return pools, nil
 }
 
+// GetDexAggregators get the dex aggregators of the given chain registered on SWITCHLYChain
+func (b *switchlyBridge) GetDexAggregators(chain common.Chain) ([]stypes.DexAggregator, error) {
+	buf, s, err := b.getWithPath(DexAggregatorsEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get dex aggregators: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", s)
+	}
+	var aggs []stypes.DexAggregator
+	if err = json.Unmarshal(buf, &aggs); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal dex aggregators from json: %w", err)
+	}
+	result := make([]stypes.DexAggregator, 0, len(aggs))
+	for _, agg := range aggs {
+		if agg.Chain.Equals(chain) {
+			result = append(result, agg)
+		}
+	}
+	return result, nil
+}
+
 // GetSWITCHName get SWITCHName from SWITCHLYChain
 func (b *switchlyBridge) GetSWITCHName(name string) (stypes.SWITCHName, error) {
 	p := fmt.Sprintf(SWITCHNameEndpoint, name)
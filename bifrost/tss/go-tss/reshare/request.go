@@ -0,0 +1,39 @@
+package reshare
+
+import "sort"
+
+// Request request to reshare the key of a pool from its old to a new set of parties
+type Request struct {
+	PoolPubKey  string   `json:"pool_pub_key"`
+	OldKeys     []string `json:"old_keys"`
+	NewKeys     []string `json:"new_keys"`
+	BlockHeight int64    `json:"block_height"`
+	Version     string   `json:"tss_version"`
+}
+
+// NewRequest create a new instance of reshare.Request
+func NewRequest(poolPubKey string, oldKeys, newKeys []string, blockHeight int64, version string) Request {
+	return Request{
+		PoolPubKey:  poolPubKey,
+		OldKeys:     oldKeys,
+		NewKeys:     newKeys,
+		BlockHeight: blockHeight,
+		Version:     version,
+	}
+}
+
+// Parties returns the sorted union of the old and the new parties, every one of them takes
+// part in the reshare
+func (r Request) Parties() []string {
+	seen := make(map[string]bool, len(r.OldKeys)+len(r.NewKeys))
+	var parties []string
+	for _, key := range append(append([]string{}, r.OldKeys...), r.NewKeys...) {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		parties = append(parties, key)
+	}
+	sort.Strings(parties)
+	return parties
+}
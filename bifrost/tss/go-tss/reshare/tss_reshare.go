@@ -0,0 +1,480 @@
+package reshare
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	bcrypto "github.com/binance-chain/tss-lib/crypto"
+	bkg "github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/ecdsa/resharing"
+	btss "github.com/binance-chain/tss-lib/tss"
+	tcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types/bech32/legacybech32"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/conversion"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/messages"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/storage"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/blame"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/common"
+)
+
+const (
+	oldCommittee = "old"
+	newCommittee = "new"
+
+	// taskDoneTimeout is how long a party waits for the others to confirm they are done
+	// before it releases the streams of the reshare
+	taskDoneTimeout = 5 * time.Second
+)
+
+// TssReshare reshares the ECDSA key of a pool from its old to a new set of parties, the
+// pool pub key stays the same. A node in both sets runs an old and a new party, both use
+// the node pub key as their key so the new shares keep the x-coordinates keysign derives
+// from the node pub keys.
+//
+// The reshare messages are exchanged directly between the parties, signed by the node key
+// of their sender. Unlike keygen there is no broadcast hash check, a party sending
+// different broadcasts to different peers makes the reshare fail and the pool keeps its
+// old shares.
+type TssReshare struct {
+	logger          zerolog.Logger
+	conf            common.TssConfig
+	localNodePubKey string
+	localPeerID     string
+	preParams       *bkg.LocalPreParams
+	broadcastChan   chan *messages.BroadcastMsgChan
+	stopChan        chan struct{}
+	msgID           string
+	stateManager    storage.LocalStateManager
+	privateKey      tcrypto.PrivKey
+	reshareMsg      chan *p2p.Message
+	blame           *blame.Blame
+
+	parties       map[string]*btss.PartyID // every party by party id
+	pubKeys       map[string]string        // the node pub key of every party by party id
+	peers         map[string]peer.ID       // the peer of every party by party id
+	localParties  map[string]btss.Party
+	finishedPeers map[peer.ID]bool
+}
+
+// NewTssReshare create a new instance of TssReshare
+func NewTssReshare(localP2PID string,
+	conf common.TssConfig,
+	localNodePubKey string,
+	broadcastChan chan *messages.BroadcastMsgChan,
+	stopChan chan struct{},
+	preParam *bkg.LocalPreParams,
+	msgID string,
+	stateManager storage.LocalStateManager,
+	privateKey tcrypto.PrivKey,
+) *TssReshare {
+	b := blame.NewBlame("", nil)
+	return &TssReshare{
+		logger: log.With().
+			Str("module", "reshare").
+			Str("msgID", msgID).Logger(),
+		conf:            conf,
+		localNodePubKey: localNodePubKey,
+		localPeerID:     localP2PID,
+		preParams:       preParam,
+		broadcastChan:   broadcastChan,
+		stopChan:        stopChan,
+		msgID:           msgID,
+		stateManager:    stateManager,
+		privateKey:      privateKey,
+		reshareMsg:      make(chan *p2p.Message, 1024),
+		blame:           &b,
+		parties:         make(map[string]*btss.PartyID),
+		pubKeys:         make(map[string]string),
+		peers:           make(map[string]peer.ID),
+		localParties:    make(map[string]btss.Party),
+		finishedPeers:   make(map[peer.ID]bool),
+	}
+}
+
+// GetTssReshareChannel returns the channel the reshare messages of the peers are delivered to
+func (r *TssReshare) GetTssReshareChannel() chan *p2p.Message {
+	return r.reshareMsg
+}
+
+// GetBlame returns the nodes blamed for a failed reshare
+func (r *TssReshare) GetBlame() *blame.Blame {
+	return r.blame
+}
+
+// ReshareKey runs the reshare and returns the pool pub key, a party of the new set saves
+// its new share for the pool
+func (r *TssReshare) ReshareKey(req Request) (*bcrypto.ECPoint, error) {
+	oldIDs, localOld, err := r.setupParties(oldCommittee, req.OldKeys)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get old parties: %w", err)
+	}
+	newIDs, localNew, err := r.setupParties(newCommittee, req.NewKeys)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get new parties: %w", err)
+	}
+	if localOld == nil && localNew == nil {
+		return nil, errors.New("local party is not in the old or the new parties")
+	}
+	oldThreshold, err := conversion.GetThreshold(len(oldIDs))
+	if err != nil {
+		return nil, err
+	}
+	newThreshold, err := conversion.GetThreshold(len(newIDs))
+	if err != nil {
+		return nil, err
+	}
+	if r.preParams == nil {
+		return nil, errors.New("error, empty pre-parameters")
+	}
+
+	oldCtx := btss.NewPeerContext(oldIDs)
+	newCtx := btss.NewPeerContext(newIDs)
+	outCh := make(chan btss.Message, len(oldIDs)+len(newIDs))
+	endCh := make(chan bkg.LocalPartySaveData, 2)
+	errCh := make(chan *btss.Error, 1)
+
+	var poolPubKey *bcrypto.ECPoint
+	if localOld != nil {
+		state, err := r.stateManager.GetLocalState(req.PoolPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get local keygen state: %w", err)
+		}
+		if !sameKeys(state.ParticipantKeys, req.OldKeys) {
+			return nil, errors.New("old parties are not the participants of the pool")
+		}
+		poolPubKey = state.LocalData.ECDSAPub
+		params := btss.NewReSharingParameters(oldCtx, newCtx, localOld, len(oldIDs), oldThreshold, len(newIDs), newThreshold)
+		r.localParties[localOld.Id] = resharing.NewLocalParty(params, state.LocalData, outCh, endCh)
+	}
+	if localNew != nil {
+		save := bkg.NewLocalPartySaveData(len(newIDs))
+		save.LocalPreParams = *r.preParams
+		params := btss.NewReSharingParameters(oldCtx, newCtx, localNew, len(oldIDs), oldThreshold, len(newIDs), newThreshold)
+		r.localParties[localNew.Id] = resharing.NewLocalParty(params, save, outCh, endCh)
+	}
+
+	for _, party := range r.localParties {
+		go func(party btss.Party) {
+			if err := party.Start(); err != nil {
+				r.logger.Error().Err(err).Msg("fail to start reshare party")
+				sendError(errCh, err)
+			}
+		}(party)
+	}
+
+	newPubKey, err := r.processReshare(req, errCh, outCh, endCh)
+	if err != nil {
+		return nil, err
+	}
+	r.waitForTaskDone()
+	if newPubKey != nil {
+		return newPubKey, nil
+	}
+	return poolPubKey, nil
+}
+
+func (r *TssReshare) processReshare(req Request,
+	errCh chan *btss.Error,
+	outCh <-chan btss.Message,
+	endCh <-chan bkg.LocalPartySaveData,
+) (*bcrypto.ECPoint, error) {
+	defer r.logger.Debug().Msg("finished reshare process")
+	var newPubKey *bcrypto.ECPoint
+	for pending := len(r.localParties); pending > 0; {
+		select {
+		case <-r.stopChan: // when TSS processor receive signal to quit
+			return nil, errors.New("received exit signal")
+
+		case err := <-errCh:
+			r.blameCulprits(err)
+			return nil, fmt.Errorf("fail to reshare: %w", err)
+
+		case <-time.After(r.conf.KeyGenTimeout):
+			r.logger.Error().Msgf("fail to reshare with %s", r.conf.KeyGenTimeout.String())
+			r.timeoutBlame()
+			return nil, blame.ErrTssTimeOut
+
+		case msg := <-outCh:
+			if err := r.processOutbound(msg, errCh); err != nil {
+				return nil, fmt.Errorf("fail to process the reshare message: %w", err)
+			}
+
+		case msg := <-r.reshareMsg:
+			if err := r.processInbound(msg, errCh); err != nil {
+				r.logger.Error().Err(err).Msg("fail to process the reshare message from peer")
+			}
+
+		case save := <-endCh:
+			pending--
+			// an old party hands its share over and ends without one
+			if save.Xi == nil {
+				continue
+			}
+			pubKey, _, err := conversion.GetTssPubKey(save.ECDSAPub)
+			if err != nil {
+				return nil, fmt.Errorf("fail to get switchly pubkey: %w", err)
+			}
+			if pubKey != req.PoolPubKey {
+				return nil, fmt.Errorf("reshared pool key %s does not match %s", pubKey, req.PoolPubKey)
+			}
+			state := storage.KeygenLocalState{
+				PubKey:          pubKey,
+				LocalData:       save,
+				ParticipantKeys: req.NewKeys,
+				LocalPartyKey:   r.localNodePubKey,
+			}
+			if err := r.stateManager.SaveLocalState(state); err != nil {
+				return nil, fmt.Errorf("fail to save reshare result to storage: %w", err)
+			}
+			newPubKey = save.ECDSAPub
+		}
+	}
+	return newPubKey, nil
+}
+
+// setupParties creates the parties of a committee, their ids are prefixed by the committee
+// so the old and the new party of a node stay distinct
+func (r *TssReshare) setupParties(committee string, keys []string) (btss.SortedPartyIDs, *btss.PartyID, error) {
+	sorted := append([]string{}, keys...)
+	sort.Strings(sorted)
+	var localParty *btss.PartyID
+	unsorted := make([]*btss.PartyID, 0, len(sorted))
+	for idx, item := range sorted {
+		if idx > 0 && sorted[idx-1] == item {
+			return nil, nil, fmt.Errorf("duplicated party %s", item)
+		}
+		pk, err := sdk.UnmarshalPubKey(sdk.AccPK, item) // nolint:staticcheck
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to get account pub key address(%s): %w", item, err)
+		}
+		peerID, err := conversion.GetPeerIDFromPubKey(item)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to get peer id of %s: %w", item, err)
+		}
+		partyID := btss.NewPartyID(fmt.Sprintf("%s-%d", committee, idx), "", new(big.Int).SetBytes(pk.Bytes()))
+		r.parties[partyID.Id] = partyID
+		r.pubKeys[partyID.Id] = item
+		r.peers[partyID.Id] = peerID
+		if item == r.localNodePubKey {
+			localParty = partyID
+		}
+		unsorted = append(unsorted, partyID)
+	}
+	return btss.SortPartyIDs(unsorted), localParty, nil
+}
+
+// processOutbound delivers a message of a local party to the local parties it is addressed
+// to and sends it once to every other peer it is addressed to
+func (r *TssReshare) processOutbound(msg btss.Message, errCh chan *btss.Error) error {
+	wireBytes, routing, err := msg.WireBytes()
+	if err != nil {
+		return fmt.Errorf("fail to get wire bytes: %w", err)
+	}
+	if len(routing.To) == 0 {
+		return errors.New("reshare message has no receiver")
+	}
+	from := r.parties[routing.From.Id]
+	var peers []peer.ID
+	seen := make(map[peer.ID]bool)
+	for _, to := range routing.To {
+		if to.Id == from.Id {
+			continue
+		}
+		if party, ok := r.localParties[to.Id]; ok {
+			go r.update(party, wireBytes, from, routing.IsBroadcast, errCh)
+			continue
+		}
+		peerID, ok := r.peers[to.Id]
+		if !ok {
+			return fmt.Errorf("unknown receiver %s", to.Id)
+		}
+		if !seen[peerID] {
+			seen[peerID] = true
+			peers = append(peers, peerID)
+		}
+	}
+	if len(peers) == 0 {
+		return nil
+	}
+
+	sig, err := r.privateKey.Sign(r.dataForSigning(wireBytes))
+	if err != nil {
+		return fmt.Errorf("fail to sign the reshare message: %w", err)
+	}
+	payload, err := json.Marshal(messages.WireMessage{
+		Routing:   routing,
+		RoundInfo: msg.Type(),
+		Message:   wireBytes,
+		Sig:       sig,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to marshal the wire message: %w", err)
+	}
+	r.broadcastChan <- &messages.BroadcastMsgChan{
+		WrappedMessage: messages.WrappedMessage{
+			MessageType: messages.TSSReshareMsg,
+			MsgID:       r.msgID,
+			Payload:     payload,
+		},
+		PeersID: peers,
+	}
+	return nil
+}
+
+// processInbound verifies a message of a peer and delivers it to the local parties it is
+// addressed to
+func (r *TssReshare) processInbound(msg *p2p.Message, errCh chan *btss.Error) error {
+	var wrapped messages.WrappedMessage
+	if err := json.Unmarshal(msg.Payload, &wrapped); err != nil {
+		return fmt.Errorf("fail to unmarshal wrapped message: %w", err)
+	}
+	switch wrapped.MessageType {
+	case messages.TSSTaskDone:
+		r.finishedPeers[msg.PeerID] = true
+		return nil
+	case messages.TSSReshareMsg:
+	default:
+		return fmt.Errorf("unexpected message type %s", wrapped.MessageType)
+	}
+
+	var wire messages.WireMessage
+	if err := json.Unmarshal(wrapped.Payload, &wire); err != nil {
+		return fmt.Errorf("fail to unmarshal wire message: %w", err)
+	}
+	if wire.Routing == nil || wire.Routing.From == nil || wire.Routing.From.MessageWrapper_PartyID == nil {
+		return errors.New("invalid wire message")
+	}
+	from, ok := r.parties[wire.Routing.From.Id]
+	if !ok || !bytes.Equal(from.GetKey(), wire.Routing.From.GetKey()) {
+		return fmt.Errorf("unknown sender %s", wire.Routing.From.Id)
+	}
+	if r.peers[from.Id] != msg.PeerID {
+		return fmt.Errorf("message of %s is not sent by its peer", from.Id)
+	}
+	pk := secp256k1.PubKey(from.GetKey())
+	if !pk.VerifySignature(r.dataForSigning(wire.Message), wire.Sig) {
+		return fmt.Errorf("fail to verify the signature of %s", from.Id)
+	}
+	for _, to := range wire.Routing.To {
+		if party, ok := r.localParties[to.Id]; ok {
+			go r.update(party, wire.Message, from, wire.Routing.IsBroadcast, errCh)
+		}
+	}
+	return nil
+}
+
+func (r *TssReshare) update(party btss.Party, wireBytes []byte, from *btss.PartyID, isBroadcast bool, errCh chan *btss.Error) {
+	if _, err := party.UpdateFromBytes(wireBytes, from, isBroadcast); err != nil {
+		r.logger.Error().Err(err).Msgf("fail to update party %s with the message of %s", party.PartyID().Id, from.Id)
+		sendError(errCh, err)
+	}
+}
+
+func (r *TssReshare) dataForSigning(msg []byte) []byte {
+	var buf bytes.Buffer
+	buf.Write(msg)
+	buf.WriteString(r.msgID)
+	return buf.Bytes()
+}
+
+// blameCulprits blames the nodes tss-lib found sending invalid shares
+func (r *TssReshare) blameCulprits(err *btss.Error) {
+	var nodes []blame.Node
+	for _, culprit := range err.Culprits() {
+		if pk, ok := r.pubKeys[culprit.Id]; ok && pk != r.localNodePubKey {
+			nodes = append(nodes, blame.NewNode(pk, nil, nil))
+		}
+	}
+	r.blame.SetBlame(blame.TssBrokenMsg, nodes, false, "")
+}
+
+// timeoutBlame blames the nodes the local parties are still waiting for
+func (r *TssReshare) timeoutBlame() {
+	var nodes []blame.Node
+	seen := make(map[string]bool)
+	for _, party := range r.localParties {
+		for _, waiting := range party.WaitingFor() {
+			pk, ok := r.pubKeys[waiting.Id]
+			if !ok || pk == r.localNodePubKey || seen[pk] {
+				continue
+			}
+			seen[pk] = true
+			nodes = append(nodes, blame.NewNode(pk, nil, nil))
+		}
+	}
+	r.blame.SetBlame(blame.TssTimeout, nodes, false, "")
+}
+
+// waitForTaskDone tells the peers this node is done and waits for them to be done as well,
+// so the last messages are delivered before the streams are released
+func (r *TssReshare) waitForTaskDone() {
+	var peers []peer.ID
+	seen := make(map[peer.ID]bool)
+	for _, peerID := range r.peers {
+		if peerID.String() == r.localPeerID || seen[peerID] {
+			continue
+		}
+		seen[peerID] = true
+		peers = append(peers, peerID)
+	}
+	data, err := json.Marshal(messages.TssTaskNotifier{TaskDone: true})
+	if err != nil {
+		r.logger.Error().Err(err).Msg("fail to marshal the task done notification")
+		return
+	}
+	r.broadcastChan <- &messages.BroadcastMsgChan{
+		WrappedMessage: messages.WrappedMessage{
+			MessageType: messages.TSSTaskDone,
+			MsgID:       r.msgID,
+			Payload:     data,
+		},
+		PeersID: peers,
+	}
+	timeout := time.After(taskDoneTimeout)
+	for len(r.finishedPeers) < len(peers) {
+		select {
+		case msg := <-r.reshareMsg:
+			if err := r.processInbound(msg, nil); err != nil {
+				r.logger.Debug().Err(err).Msg("fail to process the reshare message from peer")
+			}
+		case <-timeout:
+			return
+		case <-r.stopChan:
+			return
+		}
+	}
+}
+
+func sendError(errCh chan *btss.Error, err *btss.Error) {
+	select {
+	case errCh <- err:
+	default:
+	}
+}
+
+func sameKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,252 @@
+package simulator
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	tcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/messages"
+)
+
+// AnyParty matches every receiving party of a fault
+const AnyParty = -1
+
+// FaultKind is the kind of fault injected into the TSS messages of a party
+type FaultKind int
+
+const (
+	// FaultDrop drops the messages
+	FaultDrop FaultKind = iota
+	// FaultDelay delays the messages by the fault delay
+	FaultDelay
+	// FaultEquivocate sends every peer a different, validly signed, broadcast message
+	FaultEquivocate
+	// FaultOffline takes the sending party offline, it neither sends nor receives anymore
+	FaultOffline
+)
+
+var errOffline = errors.New("party is offline")
+
+// Fault is injected into the TSS messages a party sends to a peer
+type Fault struct {
+	Kind FaultKind
+	// From is the index of the sending party
+	From int
+	// To is the index of the receiving party, AnyParty for every peer
+	To int
+	// After is the number of matching messages sent before the fault applies
+	After int
+	// Delay is how long the messages are held for a FaultDelay
+	Delay time.Duration
+}
+
+type faultState struct {
+	Fault
+	sent int
+}
+
+type faultSet struct {
+	lock    *sync.Mutex
+	faults  []*faultState
+	offline map[int]bool
+}
+
+func newFaultSet() *faultSet {
+	return &faultSet{
+		lock:    &sync.Mutex{},
+		offline: make(map[int]bool),
+	}
+}
+
+type action struct {
+	drop       bool
+	delay      time.Duration
+	equivocate bool
+}
+
+// apply counts a message from one party to another and returns what to do with it,
+// faults apply in the order they were injected
+func (fs *faultSet) apply(from, to int) action {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	var act action
+	for _, f := range fs.faults {
+		if f.From != from || (f.To != AnyParty && f.To != to) {
+			continue
+		}
+		f.sent++
+		if f.sent <= f.After {
+			continue
+		}
+		switch f.Kind {
+		case FaultDrop:
+			act.drop = true
+		case FaultDelay:
+			act.delay += f.Delay
+		case FaultEquivocate:
+			act.equivocate = true
+		case FaultOffline:
+			fs.offline[from] = true
+		}
+	}
+	if fs.offline[from] || fs.offline[to] {
+		act.drop = true
+	}
+	return act
+}
+
+func (fs *faultSet) isOffline(idx int) bool {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	return fs.offline[idx]
+}
+
+// Inject adds a fault to the TSS messages sent from now on
+func (n *Network) Inject(f Fault) {
+	n.faults.lock.Lock()
+	defer n.faults.lock.Unlock()
+	n.faults.faults = append(n.faults.faults, &faultState{Fault: f})
+}
+
+// SetOffline takes a party offline or brings it back, an offline party neither sends
+// nor receives and is not asked to join ceremonies
+func (n *Network) SetOffline(idx int, offline bool) {
+	n.faults.lock.Lock()
+	defer n.faults.lock.Unlock()
+	n.faults.offline[idx] = offline
+}
+
+// ClearFaults removes all the faults and brings every party back online
+func (n *Network) ClearFaults() {
+	n.faults.lock.Lock()
+	defer n.faults.lock.Unlock()
+	n.faults.faults = nil
+	n.faults.offline = make(map[int]bool)
+}
+
+// faultyHost applies the network faults to the streams a party opens, every stream
+// ignores deadlines as the in-memory streams do not support them
+type faultyHost struct {
+	host.Host
+	network *Network
+	index   int
+	privKey tcrypto.PrivKey
+}
+
+func (h *faultyHost) NewStream(ctx context.Context, p peer.ID, pids ...protocol.ID) (network.Stream, error) {
+	to, ok := h.network.indexes[p]
+	if !ok {
+		return nil, errors.New("unknown party")
+	}
+	if h.network.faults.isOffline(h.index) || h.network.faults.isOffline(to) {
+		return nil, errOffline
+	}
+	s, err := h.Host.NewStream(ctx, p, pids...)
+	if err != nil {
+		return nil, err
+	}
+	if len(pids) != 1 || pids[0] != p2p.TSSProtocolID {
+		return &faultyStream{Stream: s}, nil
+	}
+	return &faultyStream{Stream: s, host: h, to: to}, nil
+}
+
+func (h *faultyHost) SetStreamHandler(pid protocol.ID, handler network.StreamHandler) {
+	h.Host.SetStreamHandler(pid, func(s network.Stream) {
+		handler(&faultyStream{Stream: s})
+	})
+}
+
+// faultyStream reassembles the length prefixed TSS messages written to it and applies
+// the faults of its host to each of them
+type faultyStream struct {
+	network.Stream
+	host *faultyHost
+	to   int
+	buf  []byte
+}
+
+func (s *faultyStream) SetDeadline(time.Time) error      { return nil }
+func (s *faultyStream) SetReadDeadline(time.Time) error  { return nil }
+func (s *faultyStream) SetWriteDeadline(time.Time) error { return nil }
+
+func (s *faultyStream) Write(p []byte) (int, error) {
+	if s.host == nil {
+		return s.Stream.Write(p)
+	}
+	s.buf = append(s.buf, p...)
+	for len(s.buf) >= p2p.LengthHeader {
+		end := p2p.LengthHeader + int(binary.LittleEndian.Uint32(s.buf[:p2p.LengthHeader]))
+		if len(s.buf) < end {
+			break
+		}
+		msg := make([]byte, end-p2p.LengthHeader)
+		copy(msg, s.buf[p2p.LengthHeader:end])
+		s.buf = s.buf[end:]
+		if err := s.send(msg); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (s *faultyStream) send(msg []byte) error {
+	act := s.host.network.faults.apply(s.host.index, s.to)
+	if act.drop {
+		return nil
+	}
+	if act.delay > 0 {
+		time.Sleep(act.delay)
+	}
+	if act.equivocate {
+		msg = s.equivocate(msg)
+	}
+	header := make([]byte, p2p.LengthHeader)
+	binary.LittleEndian.PutUint32(header, uint32(len(msg)))
+	if _, err := s.Stream.Write(append(header, msg...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// equivocate makes a broadcast TSS message unique to its receiver and signs it again, so
+// the receiver accepts it but disagrees with the other parties on its hash
+func (s *faultyStream) equivocate(msg []byte) []byte {
+	var wrapped messages.WrappedMessage
+	if err := json.Unmarshal(msg, &wrapped); err != nil {
+		return msg
+	}
+	if wrapped.MessageType != messages.TSSKeyGenMsg && wrapped.MessageType != messages.TSSKeySignMsg {
+		return msg
+	}
+	var wire messages.WireMessage
+	if err := json.Unmarshal(wrapped.Payload, &wire); err != nil || wire.Routing == nil || !wire.Routing.IsBroadcast {
+		return msg
+	}
+	wire.Message = append(append([]byte{}, wire.Message...), s.host.network.nodes[s.to].PeerID...)
+	sig, err := s.host.privKey.Sign(append(append([]byte{}, wire.Message...), wrapped.MsgID...))
+	if err != nil {
+		return msg
+	}
+	wire.Sig = sig
+	payload, err := json.Marshal(wire)
+	if err != nil {
+		return msg
+	}
+	wrapped.Payload = payload
+	out, err := json.Marshal(wrapped)
+	if err != nil {
+		return msg
+	}
+	return out
+}
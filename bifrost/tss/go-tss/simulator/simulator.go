@@ -0,0 +1,371 @@
+// Package simulator runs TSS parties in process over an in-memory libp2p network, so
+// keygen, keysign, churn and reshare can be regression tested with injected faults in go test.
+package simulator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	bkeygen "github.com/binance-chain/tss-lib/ecdsa/keygen"
+	tcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	coskey "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types/bech32/legacybech32"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	maddr "github.com/multiformats/go-multiaddr"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/conversion"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/messages"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/storage"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/blame"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/common"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/keygen"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/keysign"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/reshare"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/tss"
+)
+
+// Node is a simulated TSS party
+type Node struct {
+	Index   int
+	PubKey  string
+	PeerID  peer.ID
+	privKey tcrypto.PrivKey
+	server  *tss.TssServer
+}
+
+// Network is a set of simulated TSS parties connected over an in-memory network
+type Network struct {
+	mn      mocknet.Mocknet
+	nodes   []*Node
+	indexes map[peer.ID]int
+	faults  *faultSet
+}
+
+// KeygenResult is the outcome of a keygen for one party
+type KeygenResult struct {
+	Response keygen.Response
+	Err      error
+}
+
+// KeysignResult is the outcome of a keysign for one party
+type KeysignResult struct {
+	Response keysign.Response
+	Err      error
+}
+
+// Failed returns whether the keygen failed for the party
+func (r KeygenResult) Failed() bool {
+	return r.Err != nil || r.Response.Status != common.Success
+}
+
+// Failed returns whether the keysign failed for the party
+func (r KeysignResult) Failed() bool {
+	return r.Err != nil || r.Response.Status != common.Success
+}
+
+// ReshareResult is the outcome of a reshare for one party
+type ReshareResult struct {
+	Response reshare.Response
+	Err      error
+}
+
+// Failed returns whether the reshare failed for the party
+func (r ReshareResult) Failed() bool {
+	return r.Err != nil || r.Response.Status != common.Success
+}
+
+// ChurnResult is the outcome of a churn from an old to a new set of parties
+type ChurnResult struct {
+	Keygen  map[int]KeygenResult
+	Keysign map[int]KeysignResult
+	PubKey  string
+}
+
+// NewNetwork creates one party per pre parameter and connects them all, the party keys
+// are derived from their index so every run has the same identities
+func NewNetwork(conf common.TssConfig, preParams []*bkeygen.LocalPreParams, baseFolder string) (*Network, error) {
+	conversion.SetupBech32Prefix()
+	n := &Network{
+		mn:      mocknet.New(context.Background()),
+		indexes: make(map[peer.ID]int),
+		faults:  newFaultSet(),
+	}
+	for i := range preParams {
+		privKey := secp256k1.GenPrivKeySecp256k1([]byte("tss-simulator-" + strconv.Itoa(i)))
+		node, err := n.addNode(i, privKey, conf, preParams[i], filepath.Join(baseFolder, strconv.Itoa(i)))
+		if err != nil {
+			n.Stop()
+			return nil, fmt.Errorf("fail to create party %d: %w", i, err)
+		}
+		n.nodes = append(n.nodes, node)
+	}
+	if err := n.mn.LinkAll(); err != nil {
+		n.Stop()
+		return nil, fmt.Errorf("fail to link parties: %w", err)
+	}
+	if err := n.mn.ConnectAllButSelf(); err != nil {
+		n.Stop()
+		return nil, fmt.Errorf("fail to connect parties: %w", err)
+	}
+	return n, nil
+}
+
+func (n *Network) addNode(index int, privKey tcrypto.PrivKey, conf common.TssConfig, preParams *bkeygen.LocalPreParams, folder string) (*Node, error) {
+	p2pPrivKey, err := crypto.UnmarshalSecp256k1PrivateKey(privKey.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("fail to convert private key: %w", err)
+	}
+	addr, err := maddr.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 10000+index))
+	if err != nil {
+		return nil, fmt.Errorf("fail to create address: %w", err)
+	}
+	h, err := n.mn.AddPeer(p2pPrivKey, addr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to add peer: %w", err)
+	}
+	pubKey, err := sdk.MarshalPubKey(sdk.AccPK, &coskey.PubKey{Key: privKey.PubKey().Bytes()})
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal pub key: %w", err)
+	}
+	n.indexes[h.ID()] = index
+
+	comm, err := p2p.NewCommunication(&p2p.Config{RendezvousString: "simulator"}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create communication: %w", err)
+	}
+	comm.StartWithHost(&faultyHost{Host: h, network: n, index: index, privKey: privKey})
+	stateManager, err := storage.NewFileStateMgr(folder)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create state manager: %w", err)
+	}
+	server, err := tss.NewTss(comm, stateManager, privKey, conf, preParams)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create tss server: %w", err)
+	}
+	if err := server.Start(); err != nil {
+		return nil, fmt.Errorf("fail to start tss server: %w", err)
+	}
+	return &Node{
+		Index:   index,
+		PubKey:  pubKey,
+		PeerID:  h.ID(),
+		privKey: privKey,
+		server:  server,
+	}, nil
+}
+
+// Nodes returns all the parties of the network
+func (n *Network) Nodes() []*Node {
+	return n.nodes
+}
+
+// PubKeys returns the pub keys of the given parties
+func (n *Network) PubKeys(parties []int) []string {
+	pubKeys := make([]string, 0, len(parties))
+	for _, idx := range parties {
+		pubKeys = append(pubKeys, n.nodes[idx].PubKey)
+	}
+	return pubKeys
+}
+
+// Blamed returns the sorted indexes of the parties named by the given blame
+func (n *Network) Blamed(b blame.Blame) []int {
+	var blamed []int
+	for _, node := range b.BlameNodes {
+		for _, party := range n.nodes {
+			if party.PubKey == node.Pubkey {
+				blamed = append(blamed, party.Index)
+			}
+		}
+	}
+	sort.Ints(blamed)
+	return blamed
+}
+
+// KeygenLeader returns the party coordinating the join party of a keygen, it is blamed
+// along with the missing parties when the join party fails
+func (n *Network) KeygenLeader(parties []int, blockHeight int64) (int, error) {
+	keys := n.PubKeys(parties)
+	sort.Strings(keys)
+	msgID, err := common.MsgToHashString([]byte(strings.Join(keys, "")))
+	if err != nil {
+		return 0, fmt.Errorf("fail to get keygen message id: %w", err)
+	}
+	return n.leader(msgID, parties, blockHeight)
+}
+
+// ReshareLeader returns the party coordinating the join party of a reshare
+func (n *Network) ReshareLeader(poolPubKey string, oldParties, newParties []int, blockHeight int64) (int, error) {
+	req := reshare.NewRequest(poolPubKey, n.PubKeys(oldParties), n.PubKeys(newParties), blockHeight, messages.NEWJOINPARTYVERSION)
+	msgID, err := common.MsgToHashString([]byte("reshare:" + poolPubKey + strings.Join(req.Parties(), "")))
+	if err != nil {
+		return 0, fmt.Errorf("fail to get reshare message id: %w", err)
+	}
+	return n.leader(msgID, n.union(oldParties, newParties), blockHeight)
+}
+
+func (n *Network) leader(msgID string, parties []int, blockHeight int64) (int, error) {
+	peerIDs := make([]string, 0, len(parties))
+	for _, idx := range parties {
+		peerIDs = append(peerIDs, n.nodes[idx].PeerID.String())
+	}
+	leader, err := p2p.LeaderNode(msgID, blockHeight, peerIDs)
+	if err != nil {
+		return 0, fmt.Errorf("fail to get leader: %w", err)
+	}
+	leaderID, err := peer.Decode(leader)
+	if err != nil {
+		return 0, fmt.Errorf("fail to decode leader: %w", err)
+	}
+	return n.indexes[leaderID], nil
+}
+
+// Keygen runs a keygen over the given parties, parties that are offline are not asked
+// to join, the results are keyed by party index
+func (n *Network) Keygen(parties []int, blockHeight int64) map[int]KeygenResult {
+	keys := n.PubKeys(parties)
+	results := make(map[int]KeygenResult)
+	lock := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, idx := range n.online(parties) {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			req := keygen.NewRequest(keys, blockHeight, messages.NEWJOINPARTYVERSION)
+			resp, err := n.nodes[idx].server.Keygen(req)
+			lock.Lock()
+			defer lock.Unlock()
+			results[idx] = KeygenResult{Response: resp, Err: err}
+		}(idx)
+	}
+	wg.Wait()
+	return results
+}
+
+// KeySign signs the given messages with the pool key of the given signers, parties that are
+// offline are not asked to join, the results are keyed by party index
+func (n *Network) KeySign(poolPubKey string, signers []int, msgs [][]byte, blockHeight int64) map[int]KeysignResult {
+	keys := n.PubKeys(signers)
+	results := make(map[int]KeysignResult)
+	lock := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, idx := range n.online(signers) {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			encoded := make([]string, 0, len(msgs))
+			for _, msg := range msgs {
+				encoded = append(encoded, base64.StdEncoding.EncodeToString(msg))
+			}
+			req := keysign.NewRequest(poolPubKey, encoded, blockHeight, keys, messages.NEWJOINPARTYVERSION)
+			resp, err := n.nodes[idx].server.KeySign(req)
+			lock.Lock()
+			defer lock.Unlock()
+			results[idx] = KeysignResult{Response: resp, Err: err}
+		}(idx)
+	}
+	wg.Wait()
+	return results
+}
+
+// Churn moves a pool from the old to the new parties the way the network churns today, the
+// new parties run a keygen and the old parties sign the migration to the new pool key
+func (n *Network) Churn(poolPubKey string, oldParties, newParties []int, blockHeight int64) (ChurnResult, error) {
+	result := ChurnResult{
+		Keygen: n.Keygen(newParties, blockHeight),
+	}
+	pubKey, err := agreedPubKey(result.Keygen)
+	if err != nil {
+		return result, fmt.Errorf("fail to keygen new pool: %w", err)
+	}
+	result.PubKey = pubKey
+	migrate := sha256.Sum256([]byte("migrate:" + poolPubKey + ":" + pubKey))
+	result.Keysign = n.KeySign(poolPubKey, oldParties, [][]byte{migrate[:]}, blockHeight+1)
+	for idx, res := range result.Keysign {
+		if res.Failed() {
+			return result, fmt.Errorf("party %d fail to sign migrate: %v", idx, res.Err)
+		}
+	}
+	return result, nil
+}
+
+// Reshare moves the shares of a pool from the old to the new parties, the pool key stays
+// the same. Every party of either set takes part, parties that are offline are not asked to
+// join, the results are keyed by party index
+func (n *Network) Reshare(poolPubKey string, oldParties, newParties []int, blockHeight int64) map[int]ReshareResult {
+	req := reshare.NewRequest(poolPubKey, n.PubKeys(oldParties), n.PubKeys(newParties), blockHeight, messages.NEWJOINPARTYVERSION)
+	parties := n.union(oldParties, newParties)
+	results := make(map[int]ReshareResult)
+	lock := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, idx := range n.online(parties) {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			resp, err := n.nodes[idx].server.Reshare(req)
+			lock.Lock()
+			defer lock.Unlock()
+			results[idx] = ReshareResult{Response: resp, Err: err}
+		}(idx)
+	}
+	wg.Wait()
+	return results
+}
+
+func agreedPubKey(results map[int]KeygenResult) (string, error) {
+	var pubKey string
+	for idx, res := range results {
+		if res.Failed() {
+			return "", fmt.Errorf("party %d fail to keygen: %v", idx, res.Err)
+		}
+		if pubKey != "" && pubKey != res.Response.PubKey {
+			return "", errors.New("parties generated different pub keys")
+		}
+		pubKey = res.Response.PubKey
+	}
+	if pubKey == "" {
+		return "", errors.New("no party generated a pub key")
+	}
+	return pubKey, nil
+}
+
+func (n *Network) union(oldParties, newParties []int) []int {
+	var parties []int
+	seen := make(map[int]bool)
+	for _, idx := range append(append([]int{}, oldParties...), newParties...) {
+		if !seen[idx] {
+			seen[idx] = true
+			parties = append(parties, idx)
+		}
+	}
+	return parties
+}
+
+func (n *Network) online(parties []int) []int {
+	var online []int
+	for _, idx := range parties {
+		if !n.faults.isOffline(idx) {
+			online = append(online, idx)
+		}
+	}
+	return online
+}
+
+// Stop stops all the parties, which closes their hosts on the network
+func (n *Network) Stop() {
+	for _, node := range n.nodes {
+		node.server.Stop()
+	}
+}
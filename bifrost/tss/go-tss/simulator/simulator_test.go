@@ -0,0 +1,198 @@
+package simulator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	btsskeygen "github.com/binance-chain/tss-lib/ecdsa/keygen"
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/common"
+)
+
+func TestPackage(t *testing.T) {
+	TestingT(t)
+}
+
+type SimulatorTestSuite struct {
+	network *Network
+}
+
+var _ = Suite(&SimulatorTestSuite{})
+
+var allParties = []int{0, 1, 2, 3}
+
+func (s *SimulatorTestSuite) SetUpTest(c *C) {
+	if testing.Short() {
+		c.Skip("skip the test")
+	}
+	buf, err := os.ReadFile(path.Join("..", "test_data", "preParam_test.data"))
+	c.Assert(err, IsNil)
+	var preParams []*btsskeygen.LocalPreParams
+	for _, item := range strings.Split(string(buf), "\n") {
+		val, err := hex.DecodeString(item)
+		c.Assert(err, IsNil)
+		var preParam btsskeygen.LocalPreParams
+		c.Assert(json.Unmarshal(val, &preParam), IsNil)
+		preParams = append(preParams, &preParam)
+	}
+	conf := common.TssConfig{
+		PartyTimeout:    5 * time.Second,
+		KeyGenTimeout:   45 * time.Second,
+		KeySignTimeout:  15 * time.Second,
+		PreParamTimeout: 5 * time.Second,
+	}
+	s.network, err = NewNetwork(conf, preParams, c.MkDir())
+	c.Assert(err, IsNil)
+	c.Assert(s.network.Nodes(), HasLen, 4)
+}
+
+func (s *SimulatorTestSuite) TearDownTest(c *C) {
+	if s.network != nil {
+		s.network.Stop()
+	}
+}
+
+func (s *SimulatorTestSuite) keygen(c *C) string {
+	results := s.network.Keygen(allParties, 10)
+	c.Assert(results, HasLen, 4)
+	pubKey, err := agreedPubKey(results)
+	c.Assert(err, IsNil)
+	return pubKey
+}
+
+// checkKeygenBlame asserts every party but the faulty one failed and blamed the culprits
+func (s *SimulatorTestSuite) checkKeygenBlame(c *C, results map[int]KeygenResult, faulty int, culprits ...int) {
+	c.Assert(len(results) > 0, Equals, true)
+	sort.Ints(culprits)
+	for idx, res := range results {
+		if idx == faulty {
+			continue
+		}
+		comment := Commentf("party %d", idx)
+		c.Check(res.Failed(), Equals, true, comment)
+		c.Check(s.network.Blamed(res.Response.Blame), DeepEquals, culprits, comment)
+	}
+}
+
+func (s *SimulatorTestSuite) TestKeygenKeysignAndChurn(c *C) {
+	pubKey := s.keygen(c)
+
+	msg := sha256.Sum256([]byte("helloworld"))
+	results := s.network.KeySign(pubKey, allParties, [][]byte{msg[:]}, 20)
+	c.Assert(results, HasLen, 4)
+	for idx, res := range results {
+		c.Assert(res.Failed(), Equals, false, Commentf("party %d", idx))
+		c.Assert(res.Response.Signatures, HasLen, 1)
+		c.Check(res.Response.Signatures, DeepEquals, results[0].Response.Signatures)
+	}
+
+	churn, err := s.network.Churn(pubKey, allParties, []int{1, 2, 3}, 30)
+	c.Assert(err, IsNil)
+	c.Check(churn.Keygen, HasLen, 3)
+	c.Check(churn.Keysign, HasLen, 4)
+	c.Check(churn.PubKey, Not(Equals), pubKey)
+}
+
+func (s *SimulatorTestSuite) TestReshare(c *C) {
+	keygen := s.network.Keygen([]int{0, 1, 2}, 10)
+	c.Assert(keygen, HasLen, 3)
+	pubKey, err := agreedPubKey(keygen)
+	c.Assert(err, IsNil)
+
+	// party 0 leaves and party 3 joins, the pool key stays the same
+	results := s.network.Reshare(pubKey, []int{0, 1, 2}, []int{1, 2, 3}, 20)
+	c.Assert(results, HasLen, 4)
+	for idx, res := range results {
+		comment := Commentf("party %d", idx)
+		c.Assert(res.Failed(), Equals, false, comment)
+		c.Check(res.Response.PubKey, Equals, pubKey, comment)
+	}
+
+	msg := sha256.Sum256([]byte("helloworld"))
+	signed := s.network.KeySign(pubKey, []int{1, 2, 3}, [][]byte{msg[:]}, 30)
+	c.Assert(signed, HasLen, 3)
+	for idx, res := range signed {
+		c.Assert(res.Failed(), Equals, false, Commentf("party %d", idx))
+		c.Assert(res.Response.Signatures, HasLen, 1)
+	}
+
+	// a party missing from the reshare is blamed along with the leader, the pool keeps its
+	// shares
+	s.network.SetOffline(0, true)
+	leader, err := s.network.ReshareLeader(pubKey, []int{1, 2, 3}, allParties, 40)
+	c.Assert(err, IsNil)
+	results = s.network.Reshare(pubKey, []int{1, 2, 3}, allParties, 40)
+	c.Assert(results, HasLen, 3)
+	blamed := []int{0, leader}
+	if leader == 0 {
+		blamed = []int{0}
+	}
+	for idx, res := range results {
+		comment := Commentf("party %d", idx)
+		c.Check(res.Failed(), Equals, true, comment)
+		c.Check(s.network.Blamed(res.Response.Blame), DeepEquals, blamed, comment)
+	}
+}
+
+func (s *SimulatorTestSuite) TestDelayedMessages(c *C) {
+	s.network.Inject(Fault{Kind: FaultDelay, From: 0, To: AnyParty, Delay: 100 * time.Millisecond})
+	s.keygen(c)
+}
+
+func (s *SimulatorTestSuite) TestDroppedMessages(c *C) {
+	s.network.Inject(Fault{Kind: FaultDrop, From: 1, To: AnyParty})
+	s.checkKeygenBlame(c, s.network.Keygen(allParties, 10), 1, 1)
+}
+
+func (s *SimulatorTestSuite) TestEquivocation(c *C) {
+	// the other parties confirm they received a different message, so party 3 fetches
+	// the one the majority agrees on
+	s.network.Inject(Fault{Kind: FaultEquivocate, From: 2, To: 3})
+	s.keygen(c)
+}
+
+func (s *SimulatorTestSuite) TestOffline(c *C) {
+	// an offline party never joins the party, the leader is blamed along with it
+	s.network.SetOffline(3, true)
+	leader, err := s.network.KeygenLeader(allParties, 10)
+	c.Assert(err, IsNil)
+	results := s.network.Keygen(allParties, 10)
+	c.Assert(results, HasLen, 3)
+	if leader == 3 {
+		s.checkKeygenBlame(c, results, 3, 3)
+	} else {
+		s.checkKeygenBlame(c, results, 3, 3, leader)
+	}
+
+	// a party going offline during the keygen is blamed by the others
+	s.network.ClearFaults()
+	s.network.Inject(Fault{Kind: FaultOffline, From: 0, To: AnyParty, After: 6})
+	s.checkKeygenBlame(c, s.network.Keygen(allParties, 20), 0, 0)
+}
+
+func (s *SimulatorTestSuite) TestKeysignBlame(c *C) {
+	pubKey := s.keygen(c)
+
+	// the leader picks the signing committee, the parties signing with party 3 fail while
+	// the others get the signature, an honest party must never be blamed
+	msg := sha256.Sum256([]byte("helloworld"))
+	s.network.Inject(Fault{Kind: FaultDrop, From: 3, To: AnyParty})
+	results := s.network.KeySign(pubKey, allParties, [][]byte{msg[:]}, 20)
+	c.Assert(results, HasLen, 4)
+	for idx, res := range results {
+		if idx == 3 || !res.Failed() {
+			continue
+		}
+		for _, blamed := range s.network.Blamed(res.Response.Blame) {
+			c.Check(blamed, Equals, 3, Commentf("party %d", idx))
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package tss
+
+import (
+	"errors"
+	"time"
+
+	bcrypto "github.com/binance-chain/tss-lib/crypto"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/conversion"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/messages"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/blame"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/common"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/reshare"
+)
+
+// Reshare moves the ECDSA key of a pool from its old to a new set of parties without
+// changing the pool pub key, every node of either set has to take part
+func (t *TssServer) Reshare(req reshare.Request) (reshare.Response, error) {
+	t.tssKeyGenLocker.Lock()
+	defer t.tssKeyGenLocker.Unlock()
+	if len(req.PoolPubKey) == 0 || len(req.OldKeys) == 0 || len(req.NewKeys) == 0 {
+		return reshare.Response{}, errors.New("pool pub key, old keys and new keys are required")
+	}
+	msgID, err := t.requestToMsgId(req)
+	if err != nil {
+		return reshare.Response{}, err
+	}
+
+	reshareInstance := reshare.NewTssReshare(
+		t.p2pCommunication.GetLocalPeerID(),
+		t.conf,
+		t.localNodePubKey,
+		t.p2pCommunication.BroadcastMsgChan,
+		t.stopChan,
+		t.preParams,
+		msgID,
+		t.stateManager,
+		t.privateKey)
+
+	reshareMsgChannel := reshareInstance.GetTssReshareChannel()
+	t.p2pCommunication.SetSubscribe(messages.TSSReshareMsg, msgID, reshareMsgChannel)
+	t.p2pCommunication.SetSubscribe(messages.TSSTaskDone, msgID, reshareMsgChannel)
+
+	defer func() {
+		t.p2pCommunication.CancelSubscribe(messages.TSSReshareMsg, msgID)
+		t.p2pCommunication.CancelSubscribe(messages.TSSTaskDone, msgID)
+
+		t.p2pCommunication.ReleaseStream(msgID)
+		t.partyCoordinator.ReleaseStream(msgID)
+	}()
+
+	parties := req.Parties()
+	sigChan := make(chan string)
+	onlinePeers, leader, errJoinParty := t.joinParty(msgID, req.Version, req.BlockHeight, parties, len(parties)-1, sigChan)
+	if errJoinParty != nil {
+		t.logger.Error().Err(errJoinParty).Msgf("fail to form reshare party with online:%v", onlinePeers)
+		if leader == "NONE" && onlinePeers == nil {
+			return reshare.Response{
+				Status: common.Fail,
+				Blame:  blame.NewBlame(blame.InternalError, []blame.Node{}),
+			}, nil
+		}
+		blameMgr := blame.NewBlameManager()
+		blameNodes, err := blameMgr.NodeSyncBlame(parties, onlinePeers)
+		if err != nil {
+			t.logger.Error().Err(err).Msg("failed to blame nodes for joinParty failure")
+		}
+		if leader == "NONE" {
+			return reshare.Response{
+				Status: common.Fail,
+				Blame:  blameNodes,
+			}, nil
+		}
+		// make sure we blame the leader as well
+		leaderPubKey, err := conversion.GetPubKeyFromPeerID(leader)
+		if err != nil {
+			t.logger.Error().Err(err).Msgf("failed to convert peerID->pubkey for leader %s", leader)
+		} else if len(onlinePeers) == 0 {
+			blameNodes = blame.NewBlame(blame.TssSyncFail, []blame.Node{blame.NewNode(leaderPubKey, nil, nil)})
+		} else {
+			blameNodes.AddBlameNodes(blame.NewNode(leaderPubKey, nil, nil))
+		}
+		return reshare.Response{
+			Status: common.Fail,
+			Blame:  blameNodes,
+		}, nil
+	}
+
+	t.logger.Info().Msg("joinParty succeeded, reshare party formed")
+	t.notifyJoinPartyChan()
+
+	beforeReshare := time.Now()
+	var k *bcrypto.ECPoint
+	// resharing is ECDSA only, it runs under the secp256k1 curve like an ECDSA keygen
+	err = common.WithCurveForAlgo(common.ECDSA, func() error {
+		var e error
+		k, e = reshareInstance.ReshareKey(req)
+		return e
+	})
+	if err != nil {
+		blameNodes := *reshareInstance.GetBlame()
+		t.logger.Error().Err(err).Msgf("failed to reshare key after %s, blaming: %+v", time.Since(beforeReshare), blameNodes.BlameNodes)
+		return reshare.NewResponse("", "", common.Fail, blameNodes), err
+	}
+
+	status := common.Success
+	newPubKey, addr, err := conversion.GetTssPubKey(k)
+	if err != nil {
+		t.logger.Error().Err(err).Msg("failed to generate tss pubkey from reshared key")
+		status = common.Fail
+	}
+	return reshare.NewResponse(
+		newPubKey,
+		addr.String(),
+		status,
+		*reshareInstance.GetBlame(),
+	), nil
+}
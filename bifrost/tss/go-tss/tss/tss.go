@@ -24,6 +24,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/keygen"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/keysign"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/monitor"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/reshare"
 )
 
 // TssServer is the structure that can provide all keysign and key gen features
@@ -149,6 +150,11 @@ func (t *TssServer) requestToMsgId(request interface{}) (string, error) {
 		dat = []byte(strings.Join(value.Messages, ","))
 		keys = value.SignerPubKeys
 		algo = value.Algo
+	case reshare.Request:
+		// a reshare runs over the old and the new parties of a pool, the pool key keeps its id
+		// apart from a keygen of the same parties
+		dat = []byte("reshare:" + value.PoolPubKey)
+		keys = value.Parties()
 	default:
 		t.logger.Error().Msg("unknown request type")
 		return "", errors.New("unknown request type")
@@ -12,8 +12,10 @@ import (
 	"github.com/blang/semver"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/blame"
 	gotsscommon "github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/common"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/keygen"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/reshare"
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/tss"
 
 	"github.com/switchlyprotocol/switchlynode/v3/bifrost/switchlyclient"
@@ -61,7 +63,7 @@ func (kg *KeyGen) eddsaKeygenEnabled() bool {
 	if os.Getenv("BIFROST_EDDSA_KEYGEN_VALIDATION") == "true" {
 		return true
 	}
-	v, err := kg.bridge.GetMimir("EDDSAKEYGENENABLED")
+	v, err := kg.bridge.GetMimir(constants.MimirKeyEdDSAKeygenEnabled)
 	if err != nil {
 		kg.logger.Debug().Err(err).Msg("fail to read EDDSAKEYGENENABLED mimir")
 		return false
@@ -149,17 +151,7 @@ func (kg *KeyGen) GenerateNewKey(keygenBlockHeight int64, pKeys common.PubKeys)
 	}
 
 	// copy blame to our own struct
-	blame = types.Blame{
-		FailReason: resp.Blame.FailReason,
-		IsUnicast:  resp.Blame.IsUnicast,
-		Round:      resp.Blame.Round,
-		BlameNodes: make([]types.Node, len(resp.Blame.BlameNodes)),
-	}
-	for i, n := range resp.Blame.BlameNodes {
-		blame.BlameNodes[i].Pubkey = n.Pubkey
-		blame.BlameNodes[i].BlameData = n.BlameData
-		blame.BlameNodes[i].BlameSignature = n.BlameSignature
-	}
+	blame = newBlame(resp.Blame)
 
 	if err != nil {
 		// the resp from kg.server.Keygen will not be nil
@@ -184,30 +176,107 @@ func (kg *KeyGen) GenerateNewKey(keygenBlockHeight int64, pKeys common.PubKeys)
 	// before, so a disabled/failed EdDSA keygen leaves behaviour unchanged.
 	ed25519PubKey := cpk
 	if kg.eddsaKeygenEnabled() {
-		edReq := keygen.Request{
-			Keys:        keys,
-			Version:     currentVersion.String(),
-			BlockHeight: keygenBlockHeight,
-			Algo:        gotsscommon.EdDSA,
-		}
-		edResp, edErr := kg.server.Keygen(edReq)
-		switch {
-		case edErr != nil || edResp.Status != gotsscommon.Success:
-			kg.logger.Error().Err(edErr).Str("round", edResp.Blame.Round).
+		edpk, edBlame, edErr := kg.eddsaKeygen(keys, currentVersion.String(), keygenBlockHeight)
+		if edErr != nil {
+			kg.logger.Error().Err(edErr).Str("round", edBlame.Round).
 				Int64("height", keygenBlockHeight).Msg("EDDSA-KEYGEN: failed")
-		default:
-			// edResp.PubKey is the hex-encoded 32-byte ed25519 group key (conversion.GetTssPubKeyEdDSA)
-			if raw, e := hex.DecodeString(edResp.PubKey); e != nil {
-				kg.logger.Error().Err(e).Str("ed25519_group_key", edResp.PubKey).Msg("EDDSA-KEYGEN: fail to decode group key hex")
-			} else if edpk, e := common.NewPubKeyFromEd25519(raw); e != nil {
-				kg.logger.Error().Err(e).Str("ed25519_group_key", edResp.PubKey).Msg("EDDSA-KEYGEN: fail to encode ed25519 pubkey")
-			} else {
-				ed25519PubKey = edpk
-				kg.logger.Info().Str("ed25519_group_key", edResp.PubKey).Str("ed25519_pubkey", edpk.String()).
-					Int64("height", keygenBlockHeight).Msg("EDDSA-KEYGEN: success")
-			}
+		} else {
+			ed25519PubKey = edpk
 		}
 	}
 
 	return common.NewPubKeySet(cpk, ed25519PubKey), blame, nil
 }
+
+// GenerateEdDSAKey runs only the EdDSA (ed25519) keygen among the members of an existing
+// vault, used when the vault has no agreed ed25519 group key yet
+func (kg *KeyGen) GenerateEdDSAKey(keygenBlockHeight int64, pKeys common.PubKeys) (common.PubKey, types.Blame, error) {
+	if len(pKeys) == 0 {
+		return common.EmptyPubKey, types.Blame{}, nil
+	}
+	var keys []string
+	for _, item := range pKeys {
+		keys = append(keys, item.String())
+	}
+	return kg.eddsaKeygen(keys, kg.getVersion().String(), keygenBlockHeight)
+}
+
+// ReshareKey hands the key shares of an existing vault from its old to its new members,
+// the vault keeps its secp256k1 pub key. Every old and new member has to take part.
+func (kg *KeyGen) ReshareKey(keygenBlockHeight int64, poolPubKey common.PubKey, oldKeys, newKeys common.PubKeys) (common.PubKey, types.Blame, error) {
+	if len(oldKeys) == 0 || len(newKeys) == 0 {
+		return common.EmptyPubKey, types.Blame{}, nil
+	}
+	resp, err := kg.server.Reshare(reshare.NewRequest(poolPubKey.String(), oldKeys.Strings(), newKeys.Strings(), keygenBlockHeight, kg.getVersion().String()))
+	blame := newBlame(resp.Blame)
+	if err == nil && resp.Status != gotsscommon.Success {
+		err = fmt.Errorf("reshare status: %d", resp.Status)
+	}
+	if err != nil {
+		if blame.IsEmpty() {
+			blame.FailReason = err.Error()
+		}
+		return common.EmptyPubKey, blame, fmt.Errorf("fail to reshare, err: %w", err)
+	}
+
+	pk, err := common.NewPubKey(resp.PubKey)
+	if err != nil {
+		return common.EmptyPubKey, blame, fmt.Errorf("fail to create common.PubKey,%w", err)
+	}
+	if !pk.Equals(poolPubKey) {
+		return common.EmptyPubKey, blame, fmt.Errorf("reshared pub key(%s) doesn't match the vault(%s)", pk, poolPubKey)
+	}
+	kg.logger.Info().Int64("height", keygenBlockHeight).Str("pubkey", pk.String()).Msg("tss reshare results success")
+	return pk, blame, nil
+}
+
+// eddsaKeygen runs the EdDSA keygen ceremony and returns the ed25519 group key
+func (kg *KeyGen) eddsaKeygen(keys []string, version string, keygenBlockHeight int64) (common.PubKey, types.Blame, error) {
+	resp, err := kg.server.Keygen(keygen.Request{
+		Keys:        keys,
+		Version:     version,
+		BlockHeight: keygenBlockHeight,
+		Algo:        gotsscommon.EdDSA,
+	})
+	blame := newBlame(resp.Blame)
+	if err == nil && resp.Status != gotsscommon.Success {
+		err = fmt.Errorf("eddsa keygen status: %d", resp.Status)
+	}
+	if err != nil {
+		if blame.IsEmpty() {
+			blame.FailReason = err.Error()
+		}
+		return common.EmptyPubKey, blame, fmt.Errorf("fail to eddsa keygen, err: %w", err)
+	}
+
+	// resp.PubKey is the hex-encoded 32-byte ed25519 group key (conversion.GetTssPubKeyEdDSA)
+	raw, err := hex.DecodeString(resp.PubKey)
+	if err != nil {
+		kg.logger.Error().Err(err).Str("ed25519_group_key", resp.PubKey).Msg("EDDSA-KEYGEN: fail to decode group key hex")
+		return common.EmptyPubKey, blame, fmt.Errorf("fail to decode ed25519 group key: %w", err)
+	}
+	edpk, err := common.NewPubKeyFromEd25519(raw)
+	if err != nil {
+		kg.logger.Error().Err(err).Str("ed25519_group_key", resp.PubKey).Msg("EDDSA-KEYGEN: fail to encode ed25519 pubkey")
+		return common.EmptyPubKey, blame, fmt.Errorf("fail to encode ed25519 pubkey: %w", err)
+	}
+	kg.logger.Info().Str("ed25519_group_key", resp.PubKey).Str("ed25519_pubkey", edpk.String()).
+		Int64("height", keygenBlockHeight).Msg("EDDSA-KEYGEN: success")
+	return edpk, blame, nil
+}
+
+// newBlame copies the go-tss blame to our own struct
+func newBlame(b blame.Blame) types.Blame {
+	result := types.Blame{
+		FailReason: b.FailReason,
+		IsUnicast:  b.IsUnicast,
+		Round:      b.Round,
+		BlameNodes: make([]types.Node, len(b.BlameNodes)),
+	}
+	for i, n := range b.BlameNodes {
+		result.BlameNodes[i].Pubkey = n.Pubkey
+		result.BlameNodes[i].BlameData = n.BlameData
+		result.BlameNodes[i].BlameSignature = n.BlameSignature
+	}
+	return result
+}
@@ -1,6 +1,6 @@
 // SPDX-License-Identifier: MIT
 // -------------------
-// Router Version: 4.1
+// Router Version: 4.2
 // -------------------
 pragma solidity 0.8.13;
 
@@ -105,20 +105,23 @@ contract SWITCHLYChain_Router {
     // Any vault calls to transfer any asset to any recipient.
     // Note: Contract recipients of ETH are only given 2300 Gas to complete execution.
     function transferOut(address payable to, address asset, uint amount, string memory memo) public payable nonReentrant {
-        uint safeAmount;
-        if(asset == address(0)){
-            safeAmount = msg.value;
-            bool success = to.send(safeAmount); // Send ETH. 
-            if (!success) {
-                payable(address(msg.sender)).transfer(safeAmount); // For failure, bounce back to Yggdrasil & continue.
+        _transferOut(to, asset, amount, msg.value, memo);
+    }
+
+    // Vault calls to pay several outbounds in one transaction, one TransferOut event per outbound
+    // msg.value must be the sum of the ETH amounts
+    function batchTransferOut(address payable[] memory recipients, Coin[] memory coins, string[] memory memos) public payable nonReentrant {
+        require(recipients.length == coins.length && coins.length == memos.length, "length mismatch");
+        uint ethAmount;
+        for(uint i = 0; i < coins.length; i++){
+            if(coins[i].asset == address(0)){
+                ethAmount += coins[i].amount;
             }
-        } else {
-            _vaultAllowance[msg.sender][asset] -= amount; // Reduce allowance
-            (bool success, bytes memory data) = asset.call(abi.encodeWithSignature("transfer(address,uint256)" , to, amount));
-            require(success && (data.length == 0 || abi.decode(data, (bool))));
-            safeAmount = amount;
         }
-        emit TransferOut(msg.sender, to, asset, safeAmount, memo);
+        require(ethAmount == msg.value, "ETH amount mismatch");
+        for(uint i = 0; i < coins.length; i++){
+            _transferOut(recipients[i], coins[i].asset, coins[i].amount, coins[i].amount, memos[i]);
+        }
     }
 
     // Any vault calls to transferAndCall on a target contract that conforms with "swapOut(address,address,uint256)"
@@ -163,6 +166,24 @@ contract SWITCHLYChain_Router {
         return _vaultAllowance[vault][token];
     }
 
+    // Pays one outbound of the vault, ETH sent with the call or tokens from the vault allowance
+    function _transferOut(address payable _to, address _asset, uint _amount, uint _value, string memory _memo) internal {
+        uint safeAmount;
+        if(_asset == address(0)){
+            safeAmount = _value;
+            bool success = _to.send(safeAmount); // Send ETH. 
+            if (!success) {
+                payable(address(msg.sender)).transfer(safeAmount); // For failure, bounce back to Yggdrasil & continue.
+            }
+        } else {
+            _vaultAllowance[msg.sender][_asset] -= _amount; // Reduce allowance
+            (bool success, bytes memory data) = _asset.call(abi.encodeWithSignature("transfer(address,uint256)" , _to, _amount));
+            require(success && (data.length == 0 || abi.decode(data, (bool))));
+            safeAmount = _amount;
+        }
+        emit TransferOut(msg.sender, _to, _asset, safeAmount, _memo);
+    }
+
     // Safe transferFrom in case asset charges transfer fees
     function safeTransferFrom(address _asset, uint _amount) internal returns(uint amount) {
         uint _startBal = iERC20(_asset).balanceOf(address(this));
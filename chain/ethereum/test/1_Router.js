@@ -322,6 +322,46 @@ describe("Router contract", function () {
       expect(changeBal).to.equal(_10);
     });
 
+    it("Should batch transfer ETH to USER1 and USER2", async function () {
+      let startBal = getBN(await web3.eth.getBalance(USER2));
+      let tx = await ROUTER1.batchTransferOut(
+        [USER1, USER2],
+        [
+          { asset: ETH, amount: _1 },
+          { asset: ETH, amount: _10 },
+        ],
+        ["BATCH:1", "BATCH:1:1"],
+        { from: YGGDRASIL1, value: "11000000000000000000" },
+      );
+      expect(tx.logs.length).to.equal(2);
+      expect(tx.logs[0].event).to.equal("TransferOut");
+      expect(tx.logs[0].args.to).to.equal(USER1);
+      expect(tx.logs[0].args.memo).to.equal("BATCH:1");
+      expect(BN2Str(tx.logs[0].args.amount)).to.equal(_1);
+      expect(tx.logs[1].event).to.equal("TransferOut");
+      expect(tx.logs[1].args.to).to.equal(USER2);
+      expect(tx.logs[1].args.memo).to.equal("BATCH:1:1");
+      expect(BN2Str(tx.logs[1].args.amount)).to.equal(_10);
+
+      let endBal = getBN(await web3.eth.getBalance(USER2));
+      let changeBal = BN2Str(endBal.minus(startBal));
+      expect(changeBal).to.equal(_10);
+    });
+
+    it("Should revert batch transfer when ETH value is not the sum of the ETH amounts", async function () {
+      await truffleAssert.reverts(
+        ROUTER1.batchTransferOut(
+          [USER1, USER2],
+          [
+            { asset: ETH, amount: _1 },
+            { asset: ETH, amount: _10 },
+          ],
+          ["BATCH:1", "BATCH:1:1"],
+          { from: YGGDRASIL1, value: _10 },
+        ),
+      );
+    });
+
     it("Should transfer tokens to USER2", async function () {
       let tx = await ROUTER1.transferOut(USER2, TOKEN.address, _250k, "OUT:", {
         from: YGGDRASIL1,
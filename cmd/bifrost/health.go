@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -116,6 +117,7 @@ type HealthServer struct {
 	s         *http.Server
 	tssServer tss.Server
 	chains    map[common.Chain]chainclients.ChainClient
+	checker   atomic.Pointer[HealthChecker]
 }
 
 // NewHealthServer create a new instance of health server
@@ -142,9 +144,64 @@ func (s *HealthServer) newHandler() http.Handler {
 	router.Handle("/status/p2p", http.HandlerFunc(s.p2pStatus)).Methods(http.MethodGet)
 	router.Handle("/status/scanner", http.HandlerFunc(s.chainScanner)).Methods(http.MethodGet)
 	router.Handle("/status/signing", http.HandlerFunc(s.currentSigning)).Methods(http.MethodGet)
+	router.Handle("/health/live", http.HandlerFunc(s.liveHandler)).Methods(http.MethodGet)
+	router.Handle("/health/ready", http.HandlerFunc(s.readyHandler)).Methods(http.MethodGet)
 	return router
 }
 
+// SetHealthChecker sets the checker backing the liveness and readiness probes, the
+// health server starts before the observer and the signer it checks exist
+func (s *HealthServer) SetHealthChecker(checker *HealthChecker) {
+	s.checker.Store(checker)
+}
+
+// liveHandler fails once the health checks stopped completing, bifrost is wedged and
+// should be restarted. It succeeds while bifrost is still starting up.
+func (s *HealthServer) liveHandler(w http.ResponseWriter, _ *http.Request) {
+	checker := s.checker.Load()
+	if checker == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	status := http.StatusOK
+	if !checker.IsLive() {
+		status = http.StatusServiceUnavailable
+	}
+	s.writeHealthReport(w, status, checker.Report())
+}
+
+// readyHandler fails while bifrost is starting up and whenever a health check is failing
+func (s *HealthServer) readyHandler(w http.ResponseWriter, _ *http.Request) {
+	checker := s.checker.Load()
+	if checker == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	status := http.StatusOK
+	if !checker.IsReady() {
+		status = http.StatusServiceUnavailable
+	}
+	s.writeHealthReport(w, status, checker.Report())
+}
+
+func (s *HealthServer) writeHealthReport(w http.ResponseWriter, status int, report *HealthReport) {
+	if report == nil {
+		w.WriteHeader(status)
+		return
+	}
+	jsonBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		s.logger.Error().Err(err).Msg("fail to write to response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err = w.Write(jsonBytes); err != nil {
+		s.logger.Error().Err(err).Msg("fail to write to response")
+	}
+}
+
 func (s *HealthServer) pingHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
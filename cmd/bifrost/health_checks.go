@@ -0,0 +1,437 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/metrics"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/p2p/conversion"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/tss"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+// -------------------------------------------------------------------------------------
+// Checks
+// -------------------------------------------------------------------------------------
+
+const (
+	checkChainLag            = "chain_lag"
+	checkSwitchlyLag         = "switchly_lag"
+	checkSigningBacklog      = "signing_backlog"
+	checkPendingAttestations = "pending_attestations"
+	checkTSSPeers            = "tss_peers"
+)
+
+// HealthStatus is the status of a health check, or of all of them in a report
+type HealthStatus string
+
+const (
+	HealthOK       HealthStatus = "ok"
+	HealthDegraded HealthStatus = "degraded"
+	HealthFailing  HealthStatus = "failing"
+)
+
+// level is the value of the status in the prometheus gauge, higher is worse
+func (s HealthStatus) level() int {
+	switch s {
+	case HealthOK:
+		return 0
+	case HealthDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// HealthCheck is the result of a single check. The value measured by the check is
+// degraded once it's above the warn threshold and failing once it's above the fail one.
+type HealthCheck struct {
+	Name    string       `json:"name"`
+	Target  string       `json:"target,omitempty"`
+	Status  HealthStatus `json:"status"`
+	Value   int64        `json:"value"`
+	Warn    int64        `json:"warn"`
+	Fail    int64        `json:"fail"`
+	Message string       `json:"message,omitempty"`
+}
+
+func newHealthCheck(name, target string, value, warn, fail int64) HealthCheck {
+	check := HealthCheck{
+		Name:   name,
+		Target: target,
+		Status: HealthOK,
+		Value:  value,
+		Warn:   warn,
+		Fail:   fail,
+	}
+	switch {
+	case value > fail:
+		check.Status = HealthFailing
+	case value > warn:
+		check.Status = HealthDegraded
+	}
+	return check
+}
+
+// newFailedHealthCheck is the result of a check that couldn't measure its value
+func newFailedHealthCheck(name, target string, err error) HealthCheck {
+	return HealthCheck{
+		Name:    name,
+		Target:  target,
+		Status:  HealthFailing,
+		Value:   -1,
+		Message: err.Error(),
+	}
+}
+
+// HealthReport is the result of a run of all the checks, its status is the worst status
+// of its checks
+type HealthReport struct {
+	Status    HealthStatus  `json:"status"`
+	CheckedAt time.Time     `json:"checked_at"`
+	Checks    []HealthCheck `json:"checks"`
+}
+
+// -------------------------------------------------------------------------------------
+// Health Checker
+// -------------------------------------------------------------------------------------
+
+// healthBridge is the part of switchlyclient.SwitchlyBridge used by the health checks
+type healthBridge interface {
+	GetBlockHeight() (int64, error)
+	GetLastObservedInHeight(chain common.Chain) (int64, error)
+	GetAsgards() (types.Vaults, error)
+	GetKeysignParty(vaultPubKey common.PubKey) (common.PubKeys, error)
+}
+
+// signingBacklog is implemented by signer.Signer
+type signingBacklog interface {
+	Backlog() (int, int64)
+}
+
+// attestationBacklog is implemented by observer.AttestationGossip
+type attestationBacklog interface {
+	PendingAttestations() int
+}
+
+// HealthChecker periodically checks the chain scanners, the signer, the attestation
+// batcher and the TSS peers against the thresholds in the health configuration. The
+// result of the last run backs the readiness and liveness probes of the health server
+// and is mirrored in prometheus.
+type HealthChecker struct {
+	logger       zerolog.Logger
+	cfg          config.BifrostHealthConfiguration
+	chains       map[common.Chain]chainclients.ChainClient
+	bridge       healthBridge
+	tssServer    tss.Server
+	signer       signingBacklog
+	attestations attestationBacklog
+	m            *metrics.Metrics
+
+	lock      sync.RWMutex
+	report    *HealthReport
+	startedAt time.Time
+	stopChan  chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewHealthChecker create a new instance of HealthChecker
+func NewHealthChecker(cfg config.BifrostHealthConfiguration,
+	chains map[common.Chain]chainclients.ChainClient,
+	bridge healthBridge,
+	tssServer tss.Server,
+	signer signingBacklog,
+	attestations attestationBacklog,
+	m *metrics.Metrics,
+) *HealthChecker {
+	return &HealthChecker{
+		logger:       log.With().Str("module", "health").Logger(),
+		cfg:          cfg,
+		chains:       chains,
+		bridge:       bridge,
+		tssServer:    tssServer,
+		signer:       signer,
+		attestations: attestations,
+		m:            m,
+		stopChan:     make(chan struct{}),
+	}
+}
+
+// Start runs the checks every check interval until the checker is stopped
+func (h *HealthChecker) Start() {
+	h.startedAt = time.Now()
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		ticker := time.NewTicker(h.cfg.CheckInterval)
+		defer ticker.Stop()
+		for {
+			h.run()
+			select {
+			case <-h.stopChan:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop the checker, it waits for the current run to finish
+func (h *HealthChecker) Stop() {
+	close(h.stopChan)
+	h.wg.Wait()
+}
+
+// Report returns the result of the last run, nil until the first run is done
+func (h *HealthChecker) Report() *HealthReport {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	return h.report
+}
+
+// IsLive returns false once the checks haven't completed for three check intervals, a
+// run blocked that long means bifrost is wedged
+func (h *HealthChecker) IsLive() bool {
+	since := h.startedAt
+	if report := h.Report(); report != nil {
+		since = report.CheckedAt
+	}
+	return time.Since(since) <= 3*h.cfg.CheckInterval
+}
+
+// IsReady returns true when the last run had no failing check
+func (h *HealthChecker) IsReady() bool {
+	report := h.Report()
+	return report != nil && report.Status != HealthFailing
+}
+
+func (h *HealthChecker) run() {
+	report := h.check()
+	h.lock.Lock()
+	h.report = &report
+	h.lock.Unlock()
+
+	h.updateMetrics(report)
+	if report.Status != HealthOK {
+		for _, check := range report.Checks {
+			if check.Status == HealthOK {
+				continue
+			}
+			h.logger.Warn().
+				Str("check", check.Name).
+				Str("target", check.Target).
+				Str("status", string(check.Status)).
+				Int64("value", check.Value).
+				Str("reason", check.Message).
+				Msg("health check is not ok")
+		}
+	}
+}
+
+func (h *HealthChecker) check() HealthReport {
+	checks := h.checkChains()
+	checks = append(checks, h.checkSigningBacklog(), h.checkPendingAttestations())
+	checks = append(checks, h.checkTSSPeers()...)
+
+	report := HealthReport{
+		Status:    HealthOK,
+		CheckedAt: time.Now(),
+		Checks:    checks,
+	}
+	for _, check := range checks {
+		if check.Status.level() > report.Status.level() {
+			report.Status = check.Status
+		}
+	}
+	return report
+}
+
+func (h *HealthChecker) updateMetrics(report HealthReport) {
+	if h.m == nil {
+		return
+	}
+	status := h.m.GetGaugeVec(metrics.HealthCheckStatus)
+	value := h.m.GetGaugeVec(metrics.HealthCheckValue)
+	// targets come and go with the vaults, don't keep reporting the stale ones
+	status.Reset()
+	value.Reset()
+	for _, check := range report.Checks {
+		status.WithLabelValues(check.Name, check.Target).Set(float64(check.Status.level()))
+		value.WithLabelValues(check.Name, check.Target).Set(float64(check.Value))
+	}
+}
+
+// checkChains measures how far each chain scanner lags behind the tip of the chain and
+// behind the height the network last observed on switchly. The thresholds scale with the
+// observation flexibility blocks of the chain, since block times differ widely.
+func (h *HealthChecker) checkChains() []HealthCheck {
+	checks := make([]HealthCheck, 0, 2*len(h.chains))
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for chain, client := range h.chains {
+		wg.Add(1)
+		go func(chain common.Chain, client chainclients.ChainClient) {
+			defer wg.Done()
+			chainChecks := h.checkChain(chain, client)
+			mu.Lock()
+			checks = append(checks, chainChecks...)
+			mu.Unlock()
+		}(chain, client)
+	}
+	wg.Wait()
+
+	// keep the report stable between runs
+	sortHealthChecks(checks)
+	return checks
+}
+
+func (h *HealthChecker) checkChain(chain common.Chain, client chainclients.ChainClient) []HealthCheck {
+	warn := client.GetConfig().BlockScanner.ObservationFlexibilityBlocks
+	if warn < 1 {
+		warn = 1
+	}
+	fail := warn * h.cfg.ChainLagFailFactor
+
+	scanned, err := client.GetBlockScannerHeight()
+	if err != nil {
+		err = fmt.Errorf("fail to get block scanner height: %w", err)
+		return []HealthCheck{
+			newFailedHealthCheck(checkChainLag, chain.String(), err),
+			newFailedHealthCheck(checkSwitchlyLag, chain.String(), err),
+		}
+	}
+
+	var chainLag, switchlyLag HealthCheck
+	tip, err := client.GetHeight()
+	if err != nil {
+		chainLag = newFailedHealthCheck(checkChainLag, chain.String(), fmt.Errorf("fail to get chain height: %w", err))
+	} else {
+		chainLag = newHealthCheck(checkChainLag, chain.String(), max(tip-scanned, 0), warn, fail)
+	}
+
+	recorded, err := h.bridge.GetLastObservedInHeight(chain)
+	if err != nil {
+		switchlyLag = newFailedHealthCheck(checkSwitchlyLag, chain.String(), fmt.Errorf("fail to get last observed height: %w", err))
+	} else {
+		switchlyLag = newHealthCheck(checkSwitchlyLag, chain.String(), max(recorded-scanned, 0), warn, fail)
+	}
+
+	return []HealthCheck{chainLag, switchlyLag}
+}
+
+// checkSigningBacklog measures the age in switchly blocks of the oldest outbound still to
+// be signed or broadcast
+func (h *HealthChecker) checkSigningBacklog() HealthCheck {
+	count, oldest := h.signer.Backlog()
+	var age int64
+	if count > 0 {
+		height, err := h.bridge.GetBlockHeight()
+		if err != nil {
+			return newFailedHealthCheck(checkSigningBacklog, "", fmt.Errorf("fail to get block height: %w", err))
+		}
+		age = max(height-oldest, 0)
+	}
+	check := newHealthCheck(checkSigningBacklog, "", age, h.cfg.SigningBacklogWarnBlocks, h.cfg.SigningBacklogFailBlocks)
+	check.Message = fmt.Sprintf("%d outbounds pending", count)
+	return check
+}
+
+// checkPendingAttestations measures the attestations waiting to be sent to the peers
+func (h *HealthChecker) checkPendingAttestations() HealthCheck {
+	pending := int64(h.attestations.PendingAttestations())
+	return newHealthCheck(checkPendingAttestations, "", pending, h.cfg.PendingAttestationsWarn, h.cfg.PendingAttestationsFail)
+}
+
+// checkTSSPeers measures the peers of the keysign party of each active vault the node is
+// a member of that aren't connected. Any missing peer is degraded, it fails once too few
+// are left to reach the keysign threshold.
+func (h *HealthChecker) checkTSSPeers() []HealthCheck {
+	vaults, err := h.bridge.GetAsgards()
+	if err != nil {
+		return []HealthCheck{newFailedHealthCheck(checkTSSPeers, "", fmt.Errorf("fail to get asgards: %w", err))}
+	}
+
+	local := h.tssServer.GetLocalPeerID()
+	connected := make(map[string]bool)
+	for _, pi := range h.tssServer.GetKnownPeers() {
+		connected[pi.ID] = true
+	}
+
+	var checks []HealthCheck
+	for _, vault := range vaults {
+		if vault.Status != types.VaultStatus_ActiveVault {
+			continue
+		}
+		target := vault.PubKey.String()
+		party, err := h.bridge.GetKeysignParty(vault.PubKey)
+		if err != nil {
+			checks = append(checks, newFailedHealthCheck(checkTSSPeers, target, fmt.Errorf("fail to get keysign party: %w", err)))
+			continue
+		}
+
+		member := false
+		var peers, reachable int64
+		for _, pk := range party {
+			var peerID string
+			peerID, err = peerIDFromPubKey(pk)
+			if err != nil {
+				h.logger.Error().Err(err).Str("pubkey", pk.String()).Msg("fail to get peer id of keysign party member")
+				peers++
+				continue
+			}
+			if peerID == local {
+				member = true
+				continue
+			}
+			peers++
+			if connected[peerID] {
+				reachable++
+			}
+		}
+		if !member {
+			continue
+		}
+
+		threshold, err := conversion.GetThreshold(len(party))
+		if err != nil {
+			checks = append(checks, newFailedHealthCheck(checkTSSPeers, target, err))
+			continue
+		}
+		// the node signs along with threshold peers, the others may be unreachable
+		check := newHealthCheck(checkTSSPeers, target, peers-reachable, 0, peers-int64(threshold))
+		check.Message = fmt.Sprintf("%d/%d keysign party peers reachable", reachable, peers)
+		checks = append(checks, check)
+	}
+
+	if len(checks) == 0 {
+		check := newHealthCheck(checkTSSPeers, "", 0, 0, 0)
+		check.Message = "not in the keysign party of an active vault"
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+func peerIDFromPubKey(pk common.PubKey) (string, error) {
+	peerID, err := conversion.GetPeerIDFromPubKey(pk.String())
+	if err != nil {
+		return "", err
+	}
+	return peerID.String(), nil
+}
+
+func sortHealthChecks(checks []HealthCheck) {
+	sort.SliceStable(checks, func(i, j int) bool {
+		if checks[i].Target != checks[j].Target {
+			return checks[i].Target < checks[j].Target
+		}
+		return checks[i].Name < checks[j].Name
+	})
+}
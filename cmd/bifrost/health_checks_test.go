@@ -0,0 +1,257 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients"
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/tss/go-tss/tss"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+type healthChainClient struct {
+	chainclients.ChainClient
+	height    int64
+	scanned   int64
+	heightErr error
+}
+
+func (c *healthChainClient) GetHeight() (int64, error) {
+	return c.height, c.heightErr
+}
+
+func (c *healthChainClient) GetBlockScannerHeight() (int64, error) {
+	return c.scanned, nil
+}
+
+func (c *healthChainClient) GetConfig() config.BifrostChainConfiguration {
+	cfg := config.BifrostChainConfiguration{}
+	cfg.BlockScanner.ObservationFlexibilityBlocks = 3
+	return cfg
+}
+
+type healthBridgeMock struct {
+	height   int64
+	observed map[common.Chain]int64
+	vaults   types.Vaults
+	party    common.PubKeys
+}
+
+func (b *healthBridgeMock) GetBlockHeight() (int64, error) {
+	return b.height, nil
+}
+
+func (b *healthBridgeMock) GetLastObservedInHeight(chain common.Chain) (int64, error) {
+	return b.observed[chain], nil
+}
+
+func (b *healthBridgeMock) GetAsgards() (types.Vaults, error) {
+	return b.vaults, nil
+}
+
+func (b *healthBridgeMock) GetKeysignParty(_ common.PubKey) (common.PubKeys, error) {
+	return b.party, nil
+}
+
+type healthTssServer struct {
+	MockTssServer
+	local string
+	peers []tss.PeerInfo
+}
+
+func (s *healthTssServer) GetLocalPeerID() string {
+	return s.local
+}
+
+func (s *healthTssServer) GetKnownPeers() []tss.PeerInfo {
+	return s.peers
+}
+
+type healthSigner struct {
+	count  int
+	oldest int64
+}
+
+func (s healthSigner) Backlog() (int, int64) {
+	return s.count, s.oldest
+}
+
+type healthAttestations int
+
+func (a healthAttestations) PendingAttestations() int {
+	return int(a)
+}
+
+type HealthCheckerTestSuite struct{}
+
+var _ = Suite(&HealthCheckerTestSuite{})
+
+func (HealthCheckerTestSuite) newHealthConfig() config.BifrostHealthConfiguration {
+	return config.BifrostHealthConfiguration{
+		CheckInterval:            time.Minute,
+		ChainLagFailFactor:       5,
+		SigningBacklogWarnBlocks: 150,
+		SigningBacklogFailBlocks: 600,
+		PendingAttestationsWarn:  1000,
+		PendingAttestationsFail:  10000,
+	}
+}
+
+func (HealthCheckerTestSuite) findCheck(c *C, report HealthReport, name, target string) HealthCheck {
+	for _, check := range report.Checks {
+		if check.Name == name && check.Target == target {
+			return check
+		}
+	}
+	c.Fatalf("check %s/%s not found", name, target)
+	return HealthCheck{}
+}
+
+func (HealthCheckerTestSuite) TestNewHealthCheck(c *C) {
+	c.Check(newHealthCheck("test", "", 3, 3, 15).Status, Equals, HealthOK)
+	c.Check(newHealthCheck("test", "", 4, 3, 15).Status, Equals, HealthDegraded)
+	c.Check(newHealthCheck("test", "", 15, 3, 15).Status, Equals, HealthDegraded)
+	c.Check(newHealthCheck("test", "", 16, 3, 15).Status, Equals, HealthFailing)
+
+	check := newFailedHealthCheck("test", "BTC", errors.New("kaboom"))
+	c.Check(check.Status, Equals, HealthFailing)
+	c.Check(check.Value, Equals, int64(-1))
+	c.Check(check.Message, Equals, "kaboom")
+}
+
+func (s HealthCheckerTestSuite) TestCheck(c *C) {
+	pks := common.PubKeys{types.GetRandomPubKey(), types.GetRandomPubKey(), types.GetRandomPubKey(), types.GetRandomPubKey()}
+	peerIDs := make([]string, len(pks))
+	for i, pk := range pks {
+		var err error
+		peerIDs[i], err = peerIDFromPubKey(pk)
+		c.Assert(err, IsNil)
+	}
+	vault := types.NewVault(100, types.VaultStatus_ActiveVault, types.VaultType_AsgardVault, types.GetRandomPubKey(), common.Chains{common.BTCChain}.Strings(), nil)
+
+	chains := map[common.Chain]chainclients.ChainClient{
+		common.BTCChain: &healthChainClient{height: 1000, scanned: 999},
+		common.ETHChain: &healthChainClient{height: 2000, scanned: 1990},
+		common.LTCChain: &healthChainClient{height: 3000, scanned: 3000, heightErr: errors.New("kaboom")},
+	}
+	bridge := &healthBridgeMock{
+		height: 500,
+		observed: map[common.Chain]int64{
+			common.BTCChain: 998,
+			common.ETHChain: 2010,
+			common.LTCChain: 3000,
+		},
+		vaults: types.Vaults{vault},
+		party:  pks,
+	}
+	tssServer := &healthTssServer{
+		local: peerIDs[0],
+		peers: []tss.PeerInfo{{ID: peerIDs[1]}, {ID: peerIDs[2]}, {ID: peerIDs[3]}},
+	}
+	hc := NewHealthChecker(s.newHealthConfig(), chains, bridge, tssServer, healthSigner{count: 2, oldest: 400}, healthAttestations(10), nil)
+
+	report := hc.check()
+	c.Check(report.Checks, HasLen, 9)
+
+	check := s.findCheck(c, report, checkChainLag, "BTC")
+	c.Check(check.Status, Equals, HealthOK)
+	c.Check(check.Value, Equals, int64(1))
+	c.Check(check.Warn, Equals, int64(3))
+	c.Check(check.Fail, Equals, int64(15))
+	check = s.findCheck(c, report, checkSwitchlyLag, "BTC")
+	c.Check(check.Status, Equals, HealthOK)
+	c.Check(check.Value, Equals, int64(0))
+
+	check = s.findCheck(c, report, checkChainLag, "ETH")
+	c.Check(check.Status, Equals, HealthDegraded)
+	c.Check(check.Value, Equals, int64(10))
+	check = s.findCheck(c, report, checkSwitchlyLag, "ETH")
+	c.Check(check.Status, Equals, HealthFailing)
+	c.Check(check.Value, Equals, int64(20))
+
+	check = s.findCheck(c, report, checkChainLag, "LTC")
+	c.Check(check.Status, Equals, HealthFailing)
+	c.Check(check.Message, Matches, "fail to get chain height: kaboom")
+
+	check = s.findCheck(c, report, checkSigningBacklog, "")
+	c.Check(check.Status, Equals, HealthOK)
+	c.Check(check.Value, Equals, int64(100))
+	c.Check(check.Message, Equals, "2 outbounds pending")
+
+	check = s.findCheck(c, report, checkPendingAttestations, "")
+	c.Check(check.Status, Equals, HealthOK)
+	c.Check(check.Value, Equals, int64(10))
+
+	check = s.findCheck(c, report, checkTSSPeers, vault.PubKey.String())
+	c.Check(check.Status, Equals, HealthOK)
+	c.Check(check.Value, Equals, int64(0))
+	c.Check(check.Message, Equals, "3/3 keysign party peers reachable")
+
+	c.Check(report.Status, Equals, HealthFailing)
+
+	// a keysign of 4 nodes needs 3 signers, the node and 2 peers
+	tssServer.peers = []tss.PeerInfo{{ID: peerIDs[1]}, {ID: peerIDs[2]}}
+	check = s.findCheck(c, hc.check(), checkTSSPeers, vault.PubKey.String())
+	c.Check(check.Status, Equals, HealthDegraded)
+	c.Check(check.Message, Equals, "2/3 keysign party peers reachable")
+	tssServer.peers = []tss.PeerInfo{{ID: peerIDs[1]}}
+	check = s.findCheck(c, hc.check(), checkTSSPeers, vault.PubKey.String())
+	c.Check(check.Status, Equals, HealthFailing)
+	c.Check(check.Value, Equals, int64(2))
+
+	// nodes outside of the keysign party have no peers to check
+	tssServer.local = "16Uiu2HAm"
+	check = s.findCheck(c, hc.check(), checkTSSPeers, "")
+	c.Check(check.Status, Equals, HealthOK)
+	c.Check(check.Message, Equals, "not in the keysign party of an active vault")
+}
+
+func (s HealthCheckerTestSuite) TestProbes(c *C) {
+	chains := map[common.Chain]chainclients.ChainClient{
+		common.BTCChain: &healthChainClient{height: 1000, scanned: 1000},
+	}
+	bridge := &healthBridgeMock{observed: map[common.Chain]int64{}}
+	attestations := healthAttestations(0)
+	hc := NewHealthChecker(s.newHealthConfig(), chains, bridge, &healthTssServer{}, healthSigner{}, &attestations, nil)
+
+	hs := NewHealthServer("127.0.0.1:8080", &MockTssServer{}, nil)
+	probe := func(path string) int {
+		res := httptest.NewRecorder()
+		hs.newHandler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+		return res.Code
+	}
+
+	// starting up
+	c.Check(probe("/health/live"), Equals, http.StatusOK)
+	c.Check(probe("/health/ready"), Equals, http.StatusServiceUnavailable)
+
+	hs.SetHealthChecker(hc)
+	hc.Start()
+	defer hc.Stop()
+	for i := 0; i < 100 && hc.Report() == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Assert(hc.Report(), NotNil)
+	c.Check(hc.Report().Status, Equals, HealthOK)
+	c.Check(probe("/health/live"), Equals, http.StatusOK)
+	c.Check(probe("/health/ready"), Equals, http.StatusOK)
+
+	// a failing check makes bifrost unready but still alive
+	attestations = healthAttestations(20000)
+	hc.run()
+	c.Check(hc.Report().Status, Equals, HealthFailing)
+	c.Check(probe("/health/live"), Equals, http.StatusOK)
+	c.Check(probe("/health/ready"), Equals, http.StatusServiceUnavailable)
+
+	// checks that stopped completing make bifrost not alive
+	hc.lock.Lock()
+	hc.report.CheckedAt = time.Now().Add(-time.Hour)
+	hc.lock.Unlock()
+	c.Check(probe("/health/live"), Equals, http.StatusServiceUnavailable)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/cmd"
 	tcommon "github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 )
@@ -75,6 +76,13 @@ func main() {
 	if err = m.Start(); err != nil {
 		log.Fatal().Err(err).Msg("fail to start metric collector")
 	}
+
+	// observed tx lifecycle tracing
+	shutdownTracing, err := tracing.Init("bifrost", cfg.Tracing)
+	if err != nil {
+		log.Fatal().Err(err).Msg("fail to init tracing")
+	}
+
 	if len(cfg.Switchly.SignerName) == 0 {
 		log.Fatal().Msg("signer name is empty")
 	}
@@ -235,6 +243,11 @@ func main() {
 		log.Fatal().Err(err).Msg("fail to start signer")
 	}
 
+	// start health checks
+	healthChecker := NewHealthChecker(cfg.Health, chains, switchlyBridge, tssIns, sign, ag, m)
+	healthChecker.Start()
+	healthServer.SetHealthChecker(healthChecker)
+
 	// wait....
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
@@ -244,6 +257,9 @@ func main() {
 	}
 	log.Info().Msg("stop signal received")
 
+	// stop health checks
+	healthChecker.Stop()
+
 	// stop observer
 	if err = obs.Stop(); err != nil {
 		log.Fatal().Err(err).Msg("fail to stop observer")
@@ -257,6 +273,10 @@ func main() {
 	if err = healthServer.Stop(); err != nil {
 		log.Fatal().Err(err).Msg("fail to stop health server")
 	}
+	// flush the spans still buffered
+	if err = shutdownTracing(context.Background()); err != nil {
+		log.Error().Err(err).Msg("fail to shutdown tracing")
+	}
 }
 
 func initPrefix() {
@@ -47,6 +47,7 @@ import (
 	thorlog "github.com/switchlyprotocol/switchlynode/v3/log"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/client/cli"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/ebifrost"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/history"
 
 	"github.com/rs/zerolog"
 )
@@ -72,6 +73,7 @@ func initAppConfig() (string, interface{}) {
 		serverconfig.Config
 		Wasm     wasmtypes.WasmConfig    `mapstructure:"wasm"`
 		EBifrost ebifrost.EBifrostConfig `mapstructure:"ebifrost"`
+		History  history.HistoryConfig   `mapstructure:"history"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -100,6 +102,7 @@ func initAppConfig() (string, interface{}) {
 	customAppTemplate := serverconfig.DefaultConfigTemplate
 	customAppTemplate += wasmtypes.DefaultConfigTemplate()
 	customAppTemplate += ebifrost.DefaultConfigTemplate()
+	customAppTemplate += history.DefaultConfigTemplate()
 
 	return customAppTemplate, customAppConfig
 }
@@ -163,6 +166,7 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	}
 	wasm.AddModuleInitFlags(startCmd)
 	ebifrost.AddModuleInitFlags(startCmd)
+	history.AddModuleInitFlags(startCmd)
 }
 
 func renderConfigCommand() *cobra.Command {
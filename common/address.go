@@ -3,6 +3,7 @@ package common
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	xrp "github.com/Peersyst/xrpl-go/address-codec"
@@ -17,6 +18,7 @@ import (
 	"github.com/gcash/bchutil"
 	ltcchaincfg "github.com/ltcsuite/ltcd/chaincfg"
 	"github.com/ltcsuite/ltcutil"
+	"github.com/mr-tron/base58"
 	"github.com/stellar/go/strkey"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
@@ -33,12 +35,25 @@ const (
 
 var alphaNumRegex = regexp.MustCompile("^[:A-Za-z0-9]*$")
 
-// NewAddress create a new Address. Supports ETH/bech2/BTC/LTC/BCH/DOGE/XRP/XLM.
+// IBCChannelPrefix is the prefix of ICS-20 channel identifiers. An account of another
+// Cosmos zone is paid over IBC when its address is prefixed with the channel of the
+// paying zone to that zone, e.g. "channel-141-osmo1...".
+const IBCChannelPrefix = "channel-"
+
+// NewAddress create a new Address. Supports ETH/bech2/BTC/LTC/BCH/DOGE/XRP/XLM/SOL.
 func NewAddress(address string) (Address, error) {
 	if len(address) == 0 {
 		return NoAddress, nil
 	}
 
+	// Check is an account of another cosmos zone with the channel to pay it
+	if _, account, ok := Address(address).IBCChannel(); ok {
+		if _, _, err := bech32.Decode(account.String()); err != nil {
+			return NoAddress, fmt.Errorf("ibc account format not supported: %s", address)
+		}
+		return Address(address), nil
+	}
+
 	if !alphaNumRegex.MatchString(address) {
 		return NoAddress, fmt.Errorf("address format not supported: %s", address)
 	}
@@ -64,6 +79,11 @@ func NewAddress(address string) (Address, error) {
 		return Address(address), nil
 	}
 
+	// Check is sol address
+	if IsValidSOLAddress(address) {
+		return Address(address), nil
+	}
+
 	// Network-specific (with build tags) address checking.
 	return newAddress(address)
 }
@@ -100,6 +120,19 @@ func IsValidXLMAddress(address string) bool {
 	return false
 }
 
+// IsValidSOLAddress checks the address is a base58 encoded 32-byte Solana account key. Program
+// derived addresses are off the ed25519 curve, so any 32 bytes are accepted.
+func IsValidSOLAddress(address string) bool {
+	if len(address) < 32 || len(address) > 44 {
+		return false
+	}
+	decoded, err := base58.Decode(address)
+	if err != nil {
+		return false
+	}
+	return len(decoded) == 32
+}
+
 // IsValidBCHAddress determinate whether the address is a valid new BCH address format
 func (addr Address) IsValidBCHAddress() bool {
 	// Check mainnet other formats
@@ -180,10 +213,12 @@ func (addr Address) IsChain(chain Chain) bool {
 		return IsValidXRPAddress(addr.String())
 	case StellarChain:
 		return IsValidXLMAddress(addr.String())
-	case GAIAChain:
-		// Note: Gaia does not use a special prefix for testnet
+	case SolanaChain:
+		return IsValidSOLAddress(addr.String())
+	case GAIAChain, OSMOChain, NOBLEChain, DYDXChain:
+		// Note: Cosmos zones do not use a special prefix for testnet
 		prefix, _, _ := bech32.Decode(addr.String())
-		return prefix == "cosmos"
+		return prefix == chain.AddressPrefix(MainNet)
 	case SWITCHLYChain:
 		prefix, _, _ := bech32.Decode(addr.String())
 		return prefix == "switch" || prefix == "sswitch" || prefix == "tswitch" ||
@@ -259,10 +294,49 @@ func (addr Address) IsChain(chain Chain) bool {
 	}
 }
 
+// NewIBCAddress returns the address of an account of another Cosmos zone, paid over the
+// given ICS-20 channel.
+func NewIBCAddress(channel string, account Address) Address {
+	return Address(channel + "-" + account.String())
+}
+
+// IBCChannel returns the ICS-20 channel and the account of an address of another Cosmos
+// zone, ok is false for other addresses.
+func (addr Address) IBCChannel() (channel string, account Address, ok bool) {
+	rest, found := strings.CutPrefix(addr.String(), IBCChannelPrefix)
+	if !found {
+		return "", NoAddress, false
+	}
+	id, acc, found := strings.Cut(rest, "-")
+	if !found || acc == "" {
+		return "", NoAddress, false
+	}
+	if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+		return "", NoAddress, false
+	}
+	return IBCChannelPrefix + id, Address(acc), true
+}
+
+// IsIBCChain returns true when the address is an account of another Cosmos zone with the
+// channel the given Cosmos chain pays it over by an ICS-20 transfer.
+func (addr Address) IsIBCChain(chain Chain) bool {
+	if !chain.IsCosmos() {
+		return false
+	}
+	_, account, ok := addr.IBCChannel()
+	if !ok {
+		return false
+	}
+	if _, _, err := bech32.Decode(account.String()); err != nil {
+		return false
+	}
+	return !account.IsChain(chain) && !account.IsChain(SWITCHLYChain)
+}
+
 // Note that this will always return ETHChain for an AVAXChain address,
 // so perhaps only use it when determining a network (e.g. mainnet/testnet).
 func (addr Address) GetChain() Chain {
-	for _, chain := range []Chain{ETHChain, SWITCHLYChain, BTCChain, LTCChain, BCHChain, DOGEChain, GAIAChain, AVAXChain, XRPChain, StellarChain} {
+	for _, chain := range []Chain{ETHChain, SWITCHLYChain, BTCChain, LTCChain, BCHChain, DOGEChain, GAIAChain, AVAXChain, XRPChain, StellarChain, SolanaChain, OSMOChain, NOBLEChain, DYDXChain} {
 		if addr.IsChain(chain) {
 			return chain
 		}
@@ -368,8 +442,8 @@ func (addr Address) GetNetwork(chain Chain) ChainNetwork {
 		if err == nil {
 			return MockNet
 		}
-	case StellarChain:
-		// Stellar addresses don't have different formats per network
+	case StellarChain, SolanaChain:
+		// Stellar and Solana addresses don't have different formats per network
 		return currentNetwork
 	}
 	return currentNetwork
@@ -323,6 +323,23 @@ func (s *AddressSuite) TestAddress(c *C) {
 	addr, err = NewAddress("r4KYDZBbcAaJJ5MQPwRR9apJ2p5EBz8bq")
 	c.Check(err, NotNil)
 
+	// sol tests
+	addr, err = NewAddress("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	c.Check(err, IsNil)
+	c.Check(addr.IsChain(BTCChain), Equals, false)
+	c.Check(addr.IsChain(ETHChain), Equals, false)
+	c.Check(addr.IsChain(XRPChain), Equals, false)
+	c.Check(addr.IsChain(StellarChain), Equals, false)
+	c.Check(addr.IsChain(SolanaChain), Equals, true)
+	c.Check(addr.GetChain(), Equals, SolanaChain)
+	c.Check(addr.GetNetwork(SolanaChain), Equals, MockNet)
+	// the system program, all zero bytes
+	c.Check(IsValidSOLAddress("11111111111111111111111111111111"), Equals, true)
+	// decodes, but not to 32 bytes
+	c.Check(IsValidSOLAddress("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt"), Equals, false)
+	// 0, O, I and l are not in the base58 alphabet
+	c.Check(IsValidSOLAddress("0PjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"), Equals, false)
+
 	addr, err = NewAddress("nfWiQeddE4zsYsDuYhvpgVC7y4gjr5RyqK")
 	c.Check(err, IsNil)
 	c.Check(addr.IsChain(BCHChain), Equals, false)
@@ -347,3 +364,45 @@ func (s *AddressSuite) TestAddress(c *C) {
 	c.Check(addr.IsChain(XRPChain), Equals, false)
 	c.Check(addr.GetNetwork(DOGEChain), Equals, MockNet)
 }
+
+func (s *AddressSuite) TestIBCAddress(c *C) {
+	osmo, err := NewAddress("channel-141-osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	c.Assert(err, IsNil)
+	channel, account, ok := osmo.IBCChannel()
+	c.Check(ok, Equals, true)
+	c.Check(channel, Equals, "channel-141")
+	c.Check(account.String(), Equals, "osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	c.Check(NewIBCAddress(channel, account), Equals, osmo)
+	c.Check(osmo.IsChain(OSMOChain), Equals, false)
+	c.Check(osmo.IsIBCChain(GAIAChain), Equals, true)
+	c.Check(osmo.IsIBCChain(NOBLEChain), Equals, true)
+	// an account of the chain itself is not an IBC destination
+	c.Check(osmo.IsIBCChain(OSMOChain), Equals, false)
+	// only cosmos zones pay over IBC
+	c.Check(osmo.IsIBCChain(BTCChain), Equals, false)
+	c.Check(osmo.IsIBCChain(SWITCHLYChain), Equals, false)
+
+	// accounts of zones without a chain are paid over the channel too
+	stride, err := NewAddress("channel-391-stride10tjz4ave7znpctgd2rfu6v2r6zkeup2dusqhgw")
+	c.Assert(err, IsNil)
+	c.Check(stride.IsIBCChain(GAIAChain), Equals, true)
+
+	// the channel is required
+	plain := Address("osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	c.Check(plain.IsIBCChain(GAIAChain), Equals, false)
+	_, _, ok = plain.IBCChannel()
+	c.Check(ok, Equals, false)
+
+	thor := Address("channel-0-switch1le5fx58yn23rtuvq3t0ujna0vcw0tcreyca4ax")
+	c.Check(thor.IsIBCChain(GAIAChain), Equals, false)
+
+	for _, invalid := range []string{
+		"channel--osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s",
+		"channel-a-osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s",
+		"channel-141-",
+		"channel-141-osmo1invalid",
+	} {
+		_, err = NewAddress(invalid)
+		c.Check(err, NotNil, Commentf("%s", invalid))
+	}
+}
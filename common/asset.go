@@ -39,6 +39,14 @@ var (
 	XLMAsset = Asset{Chain: StellarChain, Symbol: "XLM", Ticker: "XLM", Synth: false}
 	// XLMUSDC USDC on Stellar
 	XLMUSDC = Asset{Chain: StellarChain, Symbol: "USDC", Ticker: "USDC", Synth: false}
+	// SOLAsset SOL
+	SOLAsset = Asset{Chain: SolanaChain, Symbol: "SOL", Ticker: "SOL", Synth: false}
+	// OSMOAsset OSMO
+	OSMOAsset = Asset{Chain: OSMOChain, Symbol: "OSMO", Ticker: "OSMO", Synth: false}
+	// NOBLEUSDCAsset USDC on Noble, which pays the Noble fees
+	NOBLEUSDCAsset = Asset{Chain: NOBLEChain, Symbol: "USDC", Ticker: "USDC", Synth: false}
+	// DYDXAsset DYDX
+	DYDXAsset = Asset{Chain: DYDXChain, Symbol: "DYDX", Ticker: "DYDX", Synth: false}
 	// SwitchNative SWITCH on switchly
 	SwitchNative = Asset{Chain: SWITCHLYChain, Symbol: "SWITCH", Ticker: "SWITCH", Synth: false}
 	RUJI         = Asset{Chain: SWITCHLYChain, Symbol: "RUJI", Ticker: "RUJI", Synth: false}
@@ -119,6 +127,7 @@ func NewAssetWithShortCodesV3_1_0(input string) (Asset, error) {
 	shorts[XRPAsset.ShortCode()] = XRPAsset.String()
 	shorts[XLMAsset.ShortCode()] = XLMAsset.String()
 	shorts[XLMUSDC.ShortCode()] = XLMUSDC.String()
+	shorts[SOLAsset.ShortCode()] = SOLAsset.String()
 
 	long, ok := shorts[input]
 	if ok {
@@ -311,6 +320,8 @@ func (a Asset) ShortCode() string {
 		return "m"
 	case "XLM.USDC":
 		return "u"
+	case "SOL.SOL":
+		return "s"
 	default:
 		return ""
 	}
@@ -27,6 +27,10 @@ const (
 	BASEChain     = Chain("BASE")
 	XRPChain      = Chain("XRP")
 	StellarChain  = Chain("XLM")
+	SolanaChain   = Chain("SOL")
+	OSMOChain     = Chain("OSMO")
+	NOBLEChain    = Chain("NOBLE")
+	DYDXChain     = Chain("DYDX")
 
 	SigningAlgoSecp256k1 = SigningAlgo("secp256k1")
 	SigningAlgoEd25519   = SigningAlgo("ed25519")
@@ -45,8 +49,22 @@ var AllChains = [...]Chain{
 	BASEChain,
 	XRPChain,
 	StellarChain,
+	SolanaChain,
+	OSMOChain,
+	NOBLEChain,
+	DYDXChain,
 }
 
+// XRPSignerListSetAmount is the amount, in 1e8 XRP, an XRP signer list rotation is scheduled
+// and observed with. The SignerListSet transaction that installs a vault's ed25519 signer on
+// its account moves no funds, it is accounted as a transfer of one drop from the vault to
+// itself since an outbound can't carry an empty coin.
+var XRPSignerListSetAmount = cosmos.NewUint(100) // 1 drop
+
+// XRPSignerListSigners is the number of signers of the signer list installed on a vault's XRP
+// account, each of them signs the account's outbounds and pays a transaction fee.
+const XRPSignerListSigners = 1
+
 type SigningAlgo string
 
 type Chain string
@@ -112,6 +130,18 @@ func GetUTXOChains() []Chain {
 	return []Chain{BTCChain, LTCChain, BCHChain, DOGEChain}
 }
 
+// GetCosmosChains returns all Cosmos SDK zones connected to SWITCHLYChain, which are
+// served by the generic Cosmos chain client.
+func GetCosmosChains() []Chain {
+	return []Chain{GAIAChain, OSMOChain, NOBLEChain, DYDXChain}
+}
+
+// GetEd25519Chains returns all chains whose accounts are ed25519 keys, so a vault's address
+// on them is derived from its EdDSA group key rather than its secp256k1 key.
+func GetEd25519Chains() []Chain {
+	return []Chain{StellarChain, SolanaChain}
+}
+
 // IsEVM returns true if given chain is an EVM chain.
 // See working definition of an "EVM" chain in the
 // `GetEVMChains` function description
@@ -125,6 +155,27 @@ func (c Chain) IsEVM() bool {
 	return false
 }
 
+// IsCosmos returns true if given chain is a Cosmos SDK zone, see `GetCosmosChains`
+func (c Chain) IsCosmos() bool {
+	for _, chain := range GetCosmosChains() {
+		if c.Equals(chain) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEd25519 returns true if given chain uses ed25519 accounts.
+// See `GetEd25519Chains` function description
+func (c Chain) IsEd25519() bool {
+	for _, chain := range GetEd25519Chains() {
+		if c.Equals(chain) {
+			return true
+		}
+	}
+	return false
+}
+
 // HasRouter returns true if the chain has a router smart contract
 // This includes EVM chains and other chains like Stellar
 func (c Chain) HasRouter() bool {
@@ -193,6 +244,14 @@ func (c Chain) GetGasAsset() Asset {
 		return XRPAsset
 	case StellarChain:
 		return XLMAsset
+	case SolanaChain:
+		return SOLAsset
+	case OSMOChain:
+		return OSMOAsset
+	case NOBLEChain:
+		return NOBLEUSDCAsset
+	case DYDXChain:
+		return DYDXAsset
 	default:
 		return EmptyAsset
 	}
@@ -219,6 +278,14 @@ func (c Chain) GetGasUnits() string {
 		return "drop"
 	case StellarChain:
 		return "stroop"
+	case SolanaChain:
+		return "lamport"
+	case OSMOChain:
+		return "uosmo"
+	case NOBLEChain:
+		return "uusdc"
+	case DYDXChain:
+		return "adydx"
 	default:
 		return ""
 	}
@@ -235,6 +302,8 @@ func (c Chain) GetGasAssetDecimal() int64 {
 		return 6
 	case StellarChain:
 		return 7
+	case OSMOChain, NOBLEChain:
+		return 6
 	default:
 		return cosmos.DefaultCoinDecimals
 	}
@@ -258,6 +327,12 @@ func (c Chain) AddressPrefix(cn ChainNetwork) string {
 		switch c {
 		case GAIAChain:
 			return "cosmos"
+		case OSMOChain:
+			return "osmo"
+		case NOBLEChain:
+			return "noble"
+		case DYDXChain:
+			return "dydx"
 		case SWITCHLYChain:
 			// TODO update this to use mocknet address prefix
 			return types.GetConfig().GetBech32AccountAddrPrefix()
@@ -272,6 +347,12 @@ func (c Chain) AddressPrefix(cn ChainNetwork) string {
 		switch c {
 		case GAIAChain:
 			return "cosmos"
+		case OSMOChain:
+			return "osmo"
+		case NOBLEChain:
+			return "noble"
+		case DYDXChain:
+			return "dydx"
 		case SWITCHLYChain:
 			return types.GetConfig().GetBech32AccountAddrPrefix()
 		case BTCChain:
@@ -297,7 +378,7 @@ func (c Chain) DustThreshold() cosmos.Uint {
 		return cosmos.NewUint(10_000)
 	case DOGEChain:
 		return cosmos.NewUint(100_000_000)
-	case ETHChain, AVAXChain, GAIAChain, BSCChain, BASEChain:
+	case ETHChain, AVAXChain, GAIAChain, BSCChain, BASEChain, OSMOChain, NOBLEChain, DYDXChain:
 		return cosmos.OneUint()
 	case XRPChain:
 		// XRP's dust threshold is being set to 1 XRP. This is the base reserve requirement on XRP's ledger.
@@ -315,6 +396,11 @@ func (c Chain) DustThreshold() cosmos.Uint {
 		// On churns, we can optionally delete the account to recover an additional 0.5 XLM, but would increases code complexity and will remove related ledger entries
 		// Comparing to BTC, this dust threshold should be reasonable.
 		return cosmos.NewUint(One) // 1 XLM
+	case SolanaChain:
+		// SOL's dust threshold is being set to 0.001 SOL, just above the rent-exempt minimum of a
+		// system account (890,880 lamports). A transfer that leaves a new account below that minimum
+		// is rejected by the runtime, so smaller outbounds to fresh addresses could never succeed.
+		return cosmos.NewUint(100_000) // 0.001 SOL
 	default:
 		return cosmos.ZeroUint()
 	}
@@ -376,6 +462,14 @@ func (c Chain) ApproximateBlockMilliseconds() int64 {
 		return 4_000 // approx 3-5 seconds
 	case StellarChain:
 		return 5_000 // approx 5 seconds
+	case SolanaChain:
+		return 400 // approx 400 milliseconds per slot
+	case OSMOChain:
+		return 1_500 // approx 1.5 seconds
+	case NOBLEChain:
+		return 2_000 // approx 2 seconds
+	case DYDXChain:
+		return 1_000 // approx 1 second
 	default:
 		return 0
 	}
@@ -387,7 +481,7 @@ func (c Chain) InboundNotes() string {
 		return "First output should be to inbound_address, second output should be change back to self, third output should be OP_RETURN, limited to 80 bytes. Do not send below the dust threshold. Do not use exotic spend scripts, locks or address formats."
 	case ETHChain, AVAXChain, BSCChain, BASEChain:
 		return "Base Asset: Send the inbound_address the asset with the memo encoded in hex in the data field. Tokens: First approve router to spend tokens from user: asset.approve(router, amount). Then call router.depositWithExpiry(inbound_address, asset, amount, memo, expiry). Asset is the token contract address. Amount should be in native asset decimals (eg 1e18 for most tokens). Do not swap to smart contract addresses."
-	case GAIAChain:
+	case GAIAChain, OSMOChain, NOBLEChain, DYDXChain:
 		return "Transfer the inbound_address the asset with the memo. Do not use multi-in, multi-out transactions."
 	case SWITCHLYChain:
 		return "Broadcast a MsgDeposit to the Switchly network with the appropriate memo. Do not use multi-in, multi-out transactions."
@@ -395,6 +489,8 @@ func (c Chain) InboundNotes() string {
 		return "Transfer the inbound_address the asset with the memo. Only a single memo is supported and only MemoData is used."
 	case StellarChain:
 		return "Transfer the inbound_address the asset with the memo. Use MemoText for the memo field. Do not use multi-in, multi-out transactions."
+	case SolanaChain:
+		return "Transfer the inbound_address the asset with the memo in a Memo program instruction of the same transaction. SPL tokens must be sent to the inbound_address's associated token account. Do not use multi-in, multi-out transactions."
 	default:
 		return ""
 	}
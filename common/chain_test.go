@@ -25,6 +25,14 @@ func (s ChainSuite) TestChain(c *C) {
 	c.Check(stellarChain.IsEmpty(), Equals, false)
 	c.Check(stellarChain.String(), Equals, "XLM")
 
+	solanaChain, err := NewChain("sol")
+	c.Assert(err, IsNil)
+	c.Check(solanaChain.Equals(SolanaChain), Equals, true)
+	c.Check(solanaChain.String(), Equals, "SOL")
+	c.Check(SolanaChain.IsEd25519(), Equals, true)
+	c.Check(StellarChain.IsEd25519(), Equals, true)
+	c.Check(ETHChain.IsEd25519(), Equals, false)
+
 	_, err = NewChain("B") // too short
 	c.Assert(err, NotNil)
 
@@ -52,6 +60,13 @@ func (s ChainSuite) TestChain(c *C) {
 	c.Assert(StellarChain.ApproximateBlockMilliseconds(), Equals, int64(5000))
 	c.Assert(StellarChain.InboundNotes(), Equals, "Transfer the inbound_address the asset with the memo. Use MemoText for the memo field. Do not use multi-in, multi-out transactions.")
 
+	// Test Solana chain properties
+	c.Assert(SolanaChain.GetGasAsset(), Equals, SOLAsset)
+	c.Assert(SolanaChain.GetGasUnits(), Equals, "lamport")
+	c.Assert(SolanaChain.GetGasAssetDecimal(), Equals, int64(8))     // lamports are 1e9, capped at 1e8
+	c.Assert(SolanaChain.DustThreshold().String(), Equals, "100000") // 0.001 SOL
+	c.Assert(SolanaChain.ApproximateBlockMilliseconds(), Equals, int64(400))
+
 	c.Assert(BTCChain.AddressPrefix(MockNet), Equals, chaincfg.RegressionNetParams.Bech32HRPSegwit)
 	c.Assert(BTCChain.AddressPrefix(MainNet), Equals, chaincfg.MainNetParams.Bech32HRPSegwit)
 	c.Assert(BTCAsset.Chain.AddressPrefix(StageNet), Equals, chaincfg.MainNetParams.Bech32HRPSegwit)
@@ -0,0 +1,75 @@
+package feemodel
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+const (
+	// defaultGasLimit is the gas of a plain transfer, reported when no max gas limit is
+	// configured
+	defaultGasLimit = 21000
+
+	// weiPerRate converts a gas price in wei (1e18) to 1e8 of the gas asset
+	weiPerRate = 1e10
+)
+
+// EIP1559 prices EVM chains, where an outbound pays its gas at the base fee of the block
+// plus a priority fee. The observed rate is the gas price in wei.
+type EIP1559 struct{}
+
+var _ Model = EIP1559{}
+
+// Name implements Model.
+func (EIP1559) Name() string {
+	return "eip1559"
+}
+
+// NetworkFee reports the configured gas limit and the gas price in 1e8 of the gas
+// asset. A gas price below 1e10 wei is reported as 1 so the network fee stays valid.
+func (EIP1559) NetworkFee(obs Observation) (uint64, uint64) {
+	size := obs.Size
+	if size == 0 {
+		size = defaultGasLimit
+	}
+	if obs.Rate == 0 {
+		return size, 0
+	}
+	rate := obs.Rate / weiPerRate
+	if rate == 0 {
+		rate = 1
+	}
+	return size, max(rate, obs.MinRate)
+}
+
+// OutboundFee implements Model.
+func (EIP1559) OutboundFee(size, rate uint64) cosmos.Uint {
+	return linearFee(size, rate)
+}
+
+// MaxGas implements Model.
+func (EIP1559) MaxGas(size, rate uint64, _ Account) cosmos.Uint {
+	return maxGas(size, rate, cosmos.OneUint())
+}
+
+// Reserve implements Model.
+func (EIP1559) Reserve(_ Account) cosmos.Uint {
+	return cosmos.ZeroUint()
+}
+
+// InitialGasPrice returns the gas price in wei assumed before any block was observed.
+func (EIP1559) InitialGasPrice() *big.Int {
+	return big.NewInt(initialGasPrice)
+}
+
+// GasPrice returns the gas price paid in a block, the base fee plus the 25th percentile
+// of the priority fees, or nil for a block without transactions.
+func (EIP1559) GasPrice(baseFee *big.Int, priorityFees []*big.Int) *big.Int {
+	if len(priorityFees) == 0 {
+		return nil
+	}
+	sort.Slice(priorityFees, func(i, j int) bool { return priorityFees[i].Cmp(priorityFees[j]) == -1 })
+	return new(big.Int).Add(baseFee, priorityFees[len(priorityFees)/4])
+}
@@ -1,7 +1,7 @@
 //go:build !mocknet
 // +build !mocknet
 
-package ethereum
+package feemodel
 
 const (
 	initialGasPrice = 0
@@ -1,7 +1,7 @@
 //go:build mocknet
 // +build mocknet
 
-package ethereum
+package feemodel
 
 import "github.com/switchlyprotocol/switchlynode/v3/common"
 
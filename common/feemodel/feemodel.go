@@ -0,0 +1,114 @@
+// Package feemodel describes how the transaction fees of each chain are priced.
+//
+// Bifrost uses the model of a chain to turn the fees it observes into the network fee
+// it reports to SWITCHLYChain, and SWITCHLYChain uses the same model to turn the
+// reported network fee into outbound fees and the max gas of outbounds. Supporting a new
+// chain means registering its model here instead of patching each of those call sites.
+package feemodel
+
+import (
+	"fmt"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// Observation is the fee market bifrost observed on a chain.
+type Observation struct {
+	// Size is the configured size of an outbound in the unit the chain charges fees by,
+	// gas for EVM chains and vbytes for UTXO chains. Flat fee chains ignore it.
+	Size uint64
+
+	// Rate is the observed fee rate, in the unit documented by the model of the chain.
+	Rate uint64
+
+	// MinFee is the least fee the chain relays a transaction for, in 1e8 of the gas asset.
+	MinFee uint64
+
+	// MinRate is the configured floor of the reported rate.
+	MinRate uint64
+}
+
+// Account is the vault account an outbound is sent from.
+type Account struct {
+	// Signers is the number of signers that multi-sign the outbounds of the account, zero
+	// when the account signs with its own key.
+	Signers uint64
+}
+
+// Model prices the transaction fees of a chain. The size and rate of a network fee are
+// the values reported by bifrost, with the rate in 1e8 of the gas asset per unit of size.
+type Model interface {
+	// Name returns the name of the fee model.
+	Name() string
+
+	// NetworkFee returns the transaction size and rate bifrost reports for the observed
+	// fee market. A zero rate means there is nothing to report yet.
+	NetworkFee(obs Observation) (size, rate uint64)
+
+	// OutboundFee returns the base fee of an outbound at the reported network fee, in 1e8
+	// of the gas asset, before the outbound fee multiplier is applied.
+	OutboundFee(size, rate uint64) cosmos.Uint
+
+	// MaxGas returns the most gas an outbound may spend at the reported network fee, in
+	// 1e8 of the gas asset. It is never zero, a network fee that has not been reported
+	// yet has a zero size or rate and gets a chain specific fallback.
+	MaxGas(size, rate uint64, acct Account) cosmos.Uint
+
+	// Reserve returns the balance the account must hold on the chain, which can never be
+	// sent from a vault, in 1e8 of the gas asset.
+	Reserve(acct Account) cosmos.Uint
+}
+
+var models = map[common.Chain]Model{}
+
+func init() {
+	for _, chain := range []common.Chain{common.BTCChain, common.LTCChain, common.BCHChain, common.DOGEChain} {
+		Register(chain, UTXO{})
+	}
+	for _, chain := range []common.Chain{common.ETHChain, common.BSCChain, common.AVAXChain, common.BASEChain} {
+		Register(chain, EIP1559{})
+	}
+	for _, chain := range common.GetCosmosChains() {
+		Register(chain, FlatFee{})
+	}
+	// 1 XRP base reserve, plus the 0.2 XRP owner reserve of the signer list of a vault
+	// account that multi-signs its outbounds.
+	Register(common.XRPChain, FlatFee{
+		AccountReserve:    cosmos.NewUint(100_000_000),
+		SignerListReserve: cosmos.NewUint(20_000_000),
+	})
+	Register(common.StellarChain, Stellar{})
+	Register(common.SolanaChain, FlatFee{AccountReserve: cosmos.NewUint(89_088)}) // rent-exempt minimum, 890,880 lamports
+}
+
+// Register sets the fee model of a chain, it panics if the chain already has one.
+func Register(chain common.Chain, model Model) {
+	if _, ok := models[chain]; ok {
+		panic(fmt.Sprintf("fee model of chain %s already registered", chain))
+	}
+	models[chain] = model
+}
+
+// Get returns the fee model of a chain. Chains without a registered model are priced
+// by size and rate alone.
+func Get(chain common.Chain) Model {
+	if model, ok := models[chain]; ok {
+		return model
+	}
+	return FlatFee{}
+}
+
+// linearFee returns size * rate
+func linearFee(size, rate uint64) cosmos.Uint {
+	return cosmos.NewUint(size).MulUint64(rate)
+}
+
+// maxGas returns 1.5x the linear fee, or the fallback when the network fee has not been
+// reported yet
+func maxGas(size, rate uint64, fallback cosmos.Uint) cosmos.Uint {
+	if size == 0 || rate == 0 {
+		return fallback
+	}
+	return linearFee(size, rate).MulUint64(3).QuoUint64(2)
+}
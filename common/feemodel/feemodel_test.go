@@ -0,0 +1,125 @@
+package feemodel
+
+import (
+	"math/big"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type FeeModelSuite struct{}
+
+var _ = Suite(&FeeModelSuite{})
+
+func (s *FeeModelSuite) TestRegistry(c *C) {
+	c.Check(Get(common.BTCChain).Name(), Equals, "utxo")
+	c.Check(Get(common.DOGEChain).Name(), Equals, "utxo")
+	c.Check(Get(common.ETHChain).Name(), Equals, "eip1559")
+	c.Check(Get(common.BASEChain).Name(), Equals, "eip1559")
+	c.Check(Get(common.GAIAChain).Name(), Equals, "flat")
+	c.Check(Get(common.XRPChain).Name(), Equals, "flat")
+	c.Check(Get(common.StellarChain).Name(), Equals, "stellar")
+
+	// chains without a model are priced by size and rate
+	c.Check(Get(common.Chain("FOO")).OutboundFee(3, 4).Uint64(), Equals, uint64(12))
+
+	c.Check(func() { Register(common.BTCChain, UTXO{}) }, PanicMatches, "fee model of chain BTC already registered")
+
+	for _, chain := range common.AllChains {
+		if chain.IsSWITCHLYChain() {
+			continue
+		}
+		c.Check(Get(chain).MaxGas(0, 0, Account{}).IsZero(), Equals, false, Commentf("%s", chain))
+	}
+}
+
+func (s *FeeModelSuite) TestEIP1559(c *C) {
+	m := EIP1559{}
+
+	size, rate := m.NetworkFee(Observation{Size: 80000, Rate: 25_000_000_000})
+	c.Check(size, Equals, uint64(80000))
+	c.Check(rate, Equals, uint64(2))
+
+	// below 1e10 wei rounds up to 1
+	size, rate = m.NetworkFee(Observation{Rate: 1_000_000_000})
+	c.Check(size, Equals, uint64(defaultGasLimit))
+	c.Check(rate, Equals, uint64(1))
+
+	_, rate = m.NetworkFee(Observation{Size: 80000})
+	c.Check(rate, Equals, uint64(0))
+
+	c.Check(m.OutboundFee(80000, 2).Uint64(), Equals, uint64(160000))
+	c.Check(m.MaxGas(123, 127, Account{}).Uint64(), Equals, uint64(23431))
+	c.Check(m.MaxGas(0, 127, Account{}).Uint64(), Equals, uint64(1))
+	c.Check(m.Reserve(Account{}).IsZero(), Equals, true)
+
+	fees := []*big.Int{big.NewInt(40), big.NewInt(10), big.NewInt(30), big.NewInt(20)}
+	c.Check(m.GasPrice(big.NewInt(100), fees).Int64(), Equals, int64(120))
+	c.Check(m.GasPrice(big.NewInt(100), nil), IsNil)
+}
+
+func (s *FeeModelSuite) TestUTXO(c *C) {
+	m := UTXO{}
+
+	size, rate := m.NetworkFee(Observation{Size: 250, Rate: 20, MinFee: 1000, MinRate: 2})
+	c.Check(size, Equals, uint64(250))
+	c.Check(rate, Equals, uint64(20))
+
+	// raised to pay the min relay fee
+	_, rate = m.NetworkFee(Observation{Size: 250, Rate: 1, MinFee: 1001})
+	c.Check(rate, Equals, uint64(5))
+
+	// raised to the min rate
+	_, rate = m.NetworkFee(Observation{Size: 250, Rate: 1, MinFee: 100, MinRate: 3})
+	c.Check(rate, Equals, uint64(3))
+
+	_, rate = m.NetworkFee(Observation{Size: 250, MinRate: 3})
+	c.Check(rate, Equals, uint64(0))
+
+	c.Check(m.OutboundFee(250, 20).Uint64(), Equals, uint64(5000))
+	c.Check(m.MaxGas(250, 20, Account{}).Uint64(), Equals, uint64(7500))
+}
+
+func (s *FeeModelSuite) TestFlatFee(c *C) {
+	xrp := Get(common.XRPChain)
+	size, rate := xrp.NetworkFee(Observation{Size: 250, Rate: 1200})
+	c.Check(size, Equals, uint64(1))
+	c.Check(rate, Equals, uint64(1200))
+	c.Check(xrp.OutboundFee(size, rate).Uint64(), Equals, uint64(1200))
+	c.Check(xrp.MaxGas(size, rate, Account{}).Uint64(), Equals, uint64(1800))
+	c.Check(xrp.Reserve(Account{}).Equal(cosmos.NewUint(100_000_000)), Equals, true)
+	// a multi-signing vault pays for its signature and the reserve of its signer list
+	multisig := Account{Signers: 1}
+	c.Check(xrp.MaxGas(size, rate, multisig).Uint64(), Equals, uint64(3600))
+	c.Check(xrp.Reserve(multisig).Equal(cosmos.NewUint(120_000_000)), Equals, true)
+
+	gaia := Get(common.GAIAChain)
+	_, rate = gaia.NetworkFee(Observation{Rate: 1200})
+	c.Check(rate, Equals, uint64(1200))
+	c.Check(gaia.Reserve(Account{Signers: 1}).IsZero(), Equals, true)
+	c.Check(FlatFee{}.Reserve(Account{}).IsZero(), Equals, true)
+}
+
+func (s *FeeModelSuite) TestStellar(c *C) {
+	m := Stellar{}
+
+	// observed stroops are reported in 1e8
+	size, rate := m.NetworkFee(Observation{Rate: 300})
+	c.Check(size, Equals, uint64(1))
+	c.Check(rate, Equals, uint64(3000))
+
+	// never below the base fee
+	_, rate = m.NetworkFee(Observation{})
+	c.Check(rate, Equals, uint64(1000))
+	_, rate = m.NetworkFee(Observation{Rate: 50})
+	c.Check(rate, Equals, uint64(1000))
+
+	c.Check(m.MaxGas(1, 1000, Account{}).Uint64(), Equals, uint64(1500))
+	c.Check(m.MaxGas(0, 0, Account{}).Uint64(), Equals, uint64(stellarFallbackMaxGas))
+	c.Check(m.Reserve(Account{}).IsZero(), Equals, true)
+}
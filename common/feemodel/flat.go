@@ -0,0 +1,59 @@
+package feemodel
+
+import (
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// FlatFee prices chains where an outbound pays a fee per transaction regardless of its
+// size, like XRP drops or Cosmos chains. The rate of those chains is below 1 per unit of
+// gas and cannot be represented by the network fee, so the fee is reported as the rate
+// of a transaction of size 1. The observed rate is the fee per transaction in 1e8 of the
+// gas asset.
+type FlatFee struct {
+	// AccountReserve is the balance an account must hold on the chain, in 1e8 of the gas
+	// asset.
+	AccountReserve cosmos.Uint
+
+	// SignerListReserve is the balance an account that multi-signs its outbounds must hold
+	// on top of AccountReserve, like the owner reserve of an XRPL signer list.
+	SignerListReserve cosmos.Uint
+}
+
+var _ Model = FlatFee{}
+
+// Name implements Model.
+func (FlatFee) Name() string {
+	return "flat"
+}
+
+// NetworkFee implements Model.
+func (FlatFee) NetworkFee(obs Observation) (uint64, uint64) {
+	if obs.Rate == 0 {
+		return 1, 0
+	}
+	return 1, max(obs.Rate, obs.MinRate)
+}
+
+// OutboundFee implements Model.
+func (FlatFee) OutboundFee(size, rate uint64) cosmos.Uint {
+	return linearFee(size, rate)
+}
+
+// MaxGas implements Model. Chains charging the fee once per signature on top of the
+// transaction's, like the XRPL, report the fee of a single-signed transaction, so the
+// max gas of a multi-signing account leaves room for the fee of each signature.
+func (FlatFee) MaxGas(size, rate uint64, acct Account) cosmos.Uint {
+	return maxGas(size, rate*(1+acct.Signers), cosmos.OneUint())
+}
+
+// Reserve implements Model.
+func (m FlatFee) Reserve(acct Account) cosmos.Uint {
+	reserve := cosmos.ZeroUint()
+	if !m.AccountReserve.IsNil() {
+		reserve = reserve.Add(m.AccountReserve)
+	}
+	if acct.Signers > 0 && !m.SignerListReserve.IsNil() {
+		reserve = reserve.Add(m.SignerListReserve)
+	}
+	return reserve
+}
@@ -0,0 +1,52 @@
+package feemodel
+
+import (
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+const (
+	// stellarBaseFee is the minimum fee of a Stellar operation, in stroops
+	stellarBaseFee = 100
+
+	// stroopsPerRate converts stroops (1e7) to 1e8 of XLM
+	stroopsPerRate = 10
+
+	// stellarFallbackMaxGas is the max gas of an outbound before the network fee has been
+	// reported, in 1e8 of XLM
+	stellarFallbackMaxGas = 1_000_000
+)
+
+// Stellar prices the Stellar chain, where an outbound pays at least the network base fee
+// per operation. The observed rate is the fee charged per transaction in stroops, and is
+// reported like a flat fee.
+type Stellar struct{}
+
+var _ Model = Stellar{}
+
+// Name implements Model.
+func (Stellar) Name() string {
+	return "stellar"
+}
+
+// NetworkFee reports the observed fee converted to 1e8 of XLM, never below the base fee.
+// A zero observed rate reports the base fee, so a fee is known before any transaction
+// has been observed.
+func (Stellar) NetworkFee(obs Observation) (uint64, uint64) {
+	fee := max(obs.Rate, stellarBaseFee) * stroopsPerRate
+	return 1, max(fee, obs.MinRate)
+}
+
+// OutboundFee implements Model.
+func (Stellar) OutboundFee(size, rate uint64) cosmos.Uint {
+	return linearFee(size, rate)
+}
+
+// MaxGas implements Model.
+func (Stellar) MaxGas(size, rate uint64, _ Account) cosmos.Uint {
+	return maxGas(size, rate, cosmos.NewUint(stellarFallbackMaxGas))
+}
+
+// Reserve implements Model.
+func (Stellar) Reserve(_ Account) cosmos.Uint {
+	return cosmos.ZeroUint()
+}
@@ -0,0 +1,48 @@
+package feemodel
+
+import (
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// UTXO prices UTXO chains, where an outbound pays a fee rate in sats per vbyte of the
+// transaction. The observed rate is in sats per vbyte.
+type UTXO struct{}
+
+var _ Model = UTXO{}
+
+// Name implements Model.
+func (UTXO) Name() string {
+	return "utxo"
+}
+
+// NetworkFee reports the configured average transaction size and the fee rate, raised
+// so a transaction of that size pays at least the min relay fee and the configured min
+// rate.
+func (UTXO) NetworkFee(obs Observation) (uint64, uint64) {
+	if obs.Rate == 0 {
+		return obs.Size, 0
+	}
+	rate := obs.Rate
+	if obs.Size > 0 && obs.Size*rate < obs.MinFee {
+		rate = obs.MinFee / obs.Size
+		if rate*obs.Size < obs.MinFee {
+			rate++
+		}
+	}
+	return obs.Size, max(rate, obs.MinRate)
+}
+
+// OutboundFee implements Model.
+func (UTXO) OutboundFee(size, rate uint64) cosmos.Uint {
+	return linearFee(size, rate)
+}
+
+// MaxGas implements Model.
+func (UTXO) MaxGas(size, rate uint64, _ Account) cosmos.Uint {
+	return maxGas(size, rate, cosmos.OneUint())
+}
+
+// Reserve implements Model.
+func (UTXO) Reserve(_ Account) cosmos.Uint {
+	return cosmos.ZeroUint()
+}
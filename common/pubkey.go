@@ -19,6 +19,7 @@ import (
 	"github.com/eager7/dogutil"
 	ltcchaincfg "github.com/ltcsuite/ltcd/chaincfg"
 	"github.com/ltcsuite/ltcutil"
+	"github.com/mr-tron/base58"
 
 	bchchaincfg "github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchutil"
@@ -27,6 +28,7 @@ import (
 	eth "github.com/ethereum/go-ethereum/crypto"
 
 	xrpkm "github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/xrp/keymanager"
+	xrped25519 "github.com/switchlyprotocol/switchlynode/v3/bifrost/pkg/chainclients/xrp/keymanager/ed25519"
 
 	"github.com/stellar/go/strkey"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
@@ -158,12 +160,21 @@ func (p PubKey) GetAddress(chain Chain) (Address, error) {
 	var addressString string
 	switch chain {
 	case XRPChain:
+		if raw, err := p.Ed25519Raw(); err == nil {
+			// ed25519 vault group key: the account of the vault's XRPL multi-signing signer
+			formatted, err := xrped25519.FormatPublicKey(raw)
+			if err != nil {
+				return NoAddress, err
+			}
+			addressString = xrpkm.MasterPubKeyToAccountID(formatted)
+			break
+		}
 		pk, err := p.Secp256K1()
 		if err != nil {
 			return NoAddress, fmt.Errorf("get pub key secp256k1, %w", err)
 		}
 		addressString = xrpkm.MasterPubKeyToAccountID(pk.SerializeCompressed())
-	case GAIAChain, SWITCHLYChain:
+	case GAIAChain, SWITCHLYChain, OSMOChain, NOBLEChain, DYDXChain:
 		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(p))
 		if err != nil {
 			return NoAddress, err
@@ -269,6 +280,22 @@ func (p PubKey) GetAddress(chain Chain) (Address, error) {
 			}
 			addressString = stellarAddr.String()
 		}
+	case SolanaChain:
+		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(p))
+		if err != nil {
+			return NoAddress, err
+		}
+		raw := pk.Bytes()
+		if len(raw) != ed25519.PublicKeySize {
+			// Solana accounts are ed25519 and, unlike Stellar, there is no placeholder derivation
+			// for secp256k1-only vaults: such a vault has no Solana address.
+			return NoAddress, fmt.Errorf("pubkey %s is not an ed25519 key, it has no solana address", p)
+		}
+		solAddr, err := Ed25519PubKeyToSolanaAddress(raw)
+		if err != nil {
+			return NoAddress, err
+		}
+		addressString = solAddr.String()
 	default:
 		// Only EVM chains remain.
 		if !chain.IsEVM() {
@@ -304,6 +331,15 @@ func Ed25519PubKeyToStellarAddress(ed25519Pub []byte) (Address, error) {
 	return NewAddress(encoded)
 }
 
+// Ed25519PubKeyToSolanaAddress encodes a raw 32-byte ed25519 public key as a Solana account
+// address, which is the base58 encoding of the key itself.
+func Ed25519PubKeyToSolanaAddress(ed25519Pub []byte) (Address, error) {
+	if len(ed25519Pub) != ed25519.PublicKeySize {
+		return NoAddress, fmt.Errorf("invalid ed25519 public key length: got %d, want %d", len(ed25519Pub), ed25519.PublicKeySize)
+	}
+	return NewAddress(base58.Encode(ed25519Pub))
+}
+
 // GetThorAddress will return the address for SWITCHLYChain (legacy method)
 // NOTE: This method is deprecated, use GetSwitchlyAddress instead
 func (p PubKey) GetThorAddress() (cosmos.AccAddress, error) {
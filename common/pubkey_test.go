@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -222,6 +223,49 @@ func (s *PubKeyTestSuite) TestEd25519PubKeyToStellarAddress(c *C) {
 	c.Assert(IsValidXLMAddress(xlmAddr.String()), Equals, true)
 }
 
+func (s *PubKeyTestSuite) TestEd25519PubKeyToXRPAddress(c *C) {
+	// XRPL vector: ed25519 key EDFB7C70...7EF8 belongs to account rELnd6Ae5ZYDhHkaqjSVg2vgtBnzjeDshm
+	raw, err := hex.DecodeString("fb7c70e528fe161addfda8cb224bc19b9e6455916970f7992a356c3e77ac7ef8")
+	c.Assert(err, IsNil)
+	pk, err := NewPubKeyFromEd25519(raw)
+	c.Assert(err, IsNil)
+	addr, err := pk.GetAddress(XRPChain)
+	c.Assert(err, IsNil)
+	c.Check(addr.String(), Equals, "rELnd6Ae5ZYDhHkaqjSVg2vgtBnzjeDshm")
+}
+
+func (s *PubKeyTestSuite) TestEd25519PubKeyToSolanaAddress(c *C) {
+	// the all zero key is the system program account
+	addr, err := Ed25519PubKeyToSolanaAddress(make([]byte, 32))
+	c.Assert(err, IsNil)
+	c.Check(addr.String(), Equals, "11111111111111111111111111111111")
+
+	_, err = Ed25519PubKeyToSolanaAddress(make([]byte, 31))
+	c.Assert(err, NotNil)
+
+	// the account key of an ed25519 vault is its raw public key
+	raw, err := hex.DecodeString("fb7c70e528fe161addfda8cb224bc19b9e6455916970f7992a356c3e77ac7ef8")
+	c.Assert(err, IsNil)
+	pk, err := NewPubKeyFromEd25519(raw)
+	c.Assert(err, IsNil)
+	addr, err = pk.GetAddress(SolanaChain)
+	c.Assert(err, IsNil)
+	c.Check(IsValidSOLAddress(addr.String()), Equals, true)
+	expected, err := Ed25519PubKeyToSolanaAddress(raw)
+	c.Assert(err, IsNil)
+	c.Check(addr.Equals(expected), Equals, true)
+
+	// secp256k1 keys have no solana address
+	_, pubKey, _ := testdata.KeyTestPubAddr()
+	spk, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pubKey)
+	c.Assert(err, IsNil)
+	pk, err = NewPubKey(spk)
+	c.Assert(err, IsNil)
+	addr, err = pk.GetAddress(SolanaChain)
+	c.Assert(err, NotNil)
+	c.Check(addr.IsEmpty(), Equals, true)
+}
+
 func (s *PubKeyTestSuite) TestPubKeySet(c *C) {
 	_, pubKey, _ := testdata.KeyTestPubAddr()
 	spk, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pubKey)
@@ -0,0 +1,158 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Record is a span as written by the FileExporter, one JSON object per line.
+type Record struct {
+	TxID       string            `json:"tx_id"`
+	Stage      string            `json:"stage"`
+	Service    string            `json:"service"`
+	TraceID    string            `json:"trace_id"`
+	SpanID     string            `json:"span_id"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Error      string            `json:"error,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// FileExporter appends the spans as JSON lines to a local file.
+type FileExporter struct {
+	lock sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+var _ sdktrace.SpanExporter = &FileExporter{}
+
+// NewFileExporter opens the file spans are appended to, creating it and its directory
+// when missing.
+func NewFileExporter(path string) (*FileExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("fail to create trace directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open trace file: %w", err)
+	}
+	return &FileExporter{
+		file: file,
+		enc:  json.NewEncoder(file),
+	}, nil
+}
+
+// ExportSpans implements sdktrace.SpanExporter.
+func (e *FileExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.file == nil {
+		return errors.New("trace file exporter is shut down")
+	}
+	for _, span := range spans {
+		if err := e.enc.Encode(newRecord(span)); err != nil {
+			return fmt.Errorf("fail to write span: %w", err)
+		}
+	}
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *FileExporter) Shutdown(_ context.Context) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.file == nil {
+		return nil
+	}
+	err := e.file.Close()
+	e.file = nil
+	return err
+}
+
+func newRecord(span sdktrace.ReadOnlySpan) Record {
+	record := Record{
+		Stage:   span.Name(),
+		TraceID: span.SpanContext().TraceID().String(),
+		SpanID:  span.SpanContext().SpanID().String(),
+		Start:   span.StartTime().UTC(),
+		End:     span.EndTime().UTC(),
+	}
+	if service, ok := span.Resource().Set().Value("service.name"); ok {
+		record.Service = service.Emit()
+	}
+	if span.Status().Code == codes.Error {
+		record.Error = span.Status().Description
+	}
+	for _, attr := range span.Attributes() {
+		if attr.Key == AttrTxID {
+			record.TxID = attr.Value.Emit()
+			continue
+		}
+		if record.Attributes == nil {
+			record.Attributes = make(map[string]string)
+		}
+		record.Attributes[string(attr.Key)] = attr.Value.Emit()
+	}
+	return record
+}
+
+// ReadRecords returns the records of the given inbound transaction found in the trace
+// files, sorted by start time.
+func ReadRecords(txID string, paths ...string) ([]Record, error) {
+	txID = strings.ToUpper(txID)
+	var records []Record
+	for _, path := range paths {
+		found, err := readRecords(txID, path)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, found...)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Start.Before(records[j].Start)
+	})
+	return records, nil
+}
+
+func readRecords(txID, path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open trace file: %w", err)
+	}
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		// skip lines that cannot match before decoding them
+		if !strings.Contains(scanner.Text(), txID) {
+			continue
+		}
+		// a line cut short by a crash of the process writing it is skipped
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		if record.TxID == txID {
+			records = append(records, record)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("fail to read trace file %s: %w", path, err)
+	}
+	return records, nil
+}
@@ -0,0 +1,203 @@
+// Package tracing traces the lifecycle of observed transactions across bifrost and
+// switchlynode. Every stage an observed transaction goes through, from the chain scanner
+// to the signer broadcasting its outbound, records a span named after the stage and keyed
+// by the hash of the inbound transaction.
+//
+// The trace id of a span is derived from the inbound hash, so the spans recorded by the
+// bifrost and switchlynode processes of every node for the same inbound share a single
+// trace without propagating any context between them.
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+const instrumentationName = "github.com/switchlyprotocol/switchlynode/v3/common/tracing"
+
+// Stages of the lifecycle of an observed transaction, in the order they are reached.
+const (
+	StageScanned           = "scanner.scanned"
+	StageAttested          = "observer.attested"
+	StageQuorum            = "gossip.quorum"
+	StageEBifrostReceived  = "ebifrost.received"
+	StageEBifrostInjected  = "ebifrost.injected"
+	StageConsensus         = "handler.consensus"
+	StageSwapQueued        = "handler.swap_queued"
+	StageRefund            = "handler.refund"
+	StageProcessed         = "handler.processed"
+	StageEBifrostConfirmed = "ebifrost.confirmed"
+	StageCommitted         = "observer.committed"
+	StageScheduled         = "txout.scheduled"
+	StageKeysign           = "signer.keysign"
+	StageBroadcast         = "signer.broadcast"
+	StageOutboundObserved  = "handler.outbound_observed"
+)
+
+// Attribute keys shared by the stages. AttrHeight is the height of the observed tx on its
+// chain, AttrBlockHeight and AttrOutboundHeight are switchly heights.
+const (
+	AttrTxID           = attribute.Key("switchly.tx_id")
+	AttrChain          = attribute.Key("switchly.chain")
+	AttrHeight         = attribute.Key("switchly.height")
+	AttrBlockHeight    = attribute.Key("switchly.block_height")
+	AttrOutboundHeight = attribute.Key("switchly.outbound_height")
+	AttrInbound        = attribute.Key("switchly.inbound")
+	AttrObservedTx     = attribute.Key("switchly.observed_tx")
+	AttrMemPool        = attribute.Key("switchly.mempool")
+	AttrFinalised      = attribute.Key("switchly.finalised")
+	AttrAttestations   = attribute.Key("switchly.attestations")
+	AttrOutbound       = attribute.Key("switchly.outbound")
+	AttrVault          = attribute.Key("switchly.vault")
+	AttrBatch          = attribute.Key("switchly.batch")
+	AttrMemo           = attribute.Key("switchly.memo")
+	AttrReason         = attribute.Key("switchly.reason")
+	AttrCode           = attribute.Key("switchly.code")
+)
+
+var tracerProvider atomic.Pointer[sdktrace.TracerProvider]
+
+// Init starts exporting the spans of the service as configured, it is a noop when
+// tracing is disabled. The returned function flushes the spans still buffered and stops
+// the export.
+func Init(service string, cfg config.TracingConfiguration) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+	}
+	if cfg.File != "" {
+		exporter, err := NewFileExporter(os.ExpandEnv(cfg.File))
+		if err != nil {
+			return nil, fmt.Errorf("fail to create trace file exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	if cfg.OTLPEndpoint != "" {
+		otlpOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(context.Background(), otlpOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create otlp trace exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	if len(opts) == 1 {
+		return nil, errors.New("tracing is enabled without a file or an otlp endpoint")
+	}
+
+	// the provider is kept private rather than installed globally, so the otel
+	// instrumentation of the dependencies does not start exporting their spans as well
+	tp := sdktrace.NewTracerProvider(opts...)
+	tracerProvider.Store(tp)
+	return func(ctx context.Context) error {
+		tracerProvider.CompareAndSwap(tp, nil)
+		return tp.Shutdown(ctx)
+	}, nil
+}
+
+// Span is a stage of an observed transaction in progress.
+type Span struct {
+	span trace.Span
+}
+
+// Start starts the span of a stage of the given inbound transaction, it must be ended
+// once the stage completes.
+func Start(txID common.TxID, stage string, attrs ...attribute.KeyValue) Span {
+	tp := tracerProvider.Load()
+	if tp == nil || txID.IsEmpty() {
+		return Span{}
+	}
+	id := strings.ToUpper(txID.String())
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), rootSpanContext(id))
+	_, span := tp.Tracer(instrumentationName).Start(ctx, stage,
+		trace.WithAttributes(append(attrs, AttrTxID.String(id))...),
+	)
+	return Span{span: span}
+}
+
+// SetAttributes adds attributes known once the stage is in progress.
+func (s Span) SetAttributes(attrs ...attribute.KeyValue) {
+	if s.span != nil {
+		s.span.SetAttributes(attrs...)
+	}
+}
+
+// End ends the span, recording the error the stage failed with if any.
+func (s Span) End(err error) {
+	if s.span == nil {
+		return
+	}
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
+
+// Event records a stage of the given inbound transaction that completed at once.
+func Event(txID common.TxID, stage string, attrs ...attribute.KeyValue) {
+	Start(txID, stage, attrs...).End(nil)
+}
+
+// Enabled returns whether spans are exported, to skip building expensive attributes.
+func Enabled() bool {
+	return tracerProvider.Load() != nil
+}
+
+// CorrelationID returns the hash of the inbound transaction an observed transaction is
+// traced under, which is the inbound referenced by the memo of outbounds and refunds and
+// the transaction itself otherwise.
+func CorrelationID(tx common.Tx) common.TxID {
+	parts := strings.SplitN(tx.Memo, ":", 2)
+	if len(parts) == 2 {
+		switch strings.ToUpper(parts[0]) {
+		case "OUT", "REFUND":
+			if inHash, err := common.NewTxID(strings.TrimSpace(parts[1])); err == nil {
+				return inHash
+			}
+		}
+	}
+	return tx.ID
+}
+
+// TraceID returns the id of the trace the stages of the given inbound transaction are
+// recorded in.
+func TraceID(txID common.TxID) trace.TraceID {
+	return rootSpanContext(strings.ToUpper(txID.String())).TraceID()
+}
+
+// rootSpanContext returns the parent of all the spans of an inbound transaction, derived
+// from its hash so every process agrees on it.
+func rootSpanContext(txID string) trace.SpanContext {
+	sum := sha256.Sum256([]byte(txID))
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
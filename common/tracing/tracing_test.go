@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type TracingSuite struct{}
+
+var _ = Suite(&TracingSuite{})
+
+const (
+	inHash  = "B6F4BEE5D9A2D7E1C4BC4D1D5C4B3D5F2E0A2F6C9D4B8A1E3F5C7D9B1A3C5E7F"
+	outHash = "0E8D1E6C5F2A4B3C7D9E1F3A5B7C9D1E3F5A7B9C1D3E5F7A9B1C3D5E7F9A1B3C"
+)
+
+func (s *TracingSuite) TestCorrelationID(c *C) {
+	tx := common.Tx{ID: common.TxID(outHash), Memo: "OUT:" + strings.ToLower(inHash)}
+	c.Check(CorrelationID(tx).String(), Equals, inHash)
+	tx.Memo = "refund:" + inHash
+	c.Check(CorrelationID(tx).String(), Equals, inHash)
+
+	// inbounds and outbounds not referencing an inbound are traced under their own hash
+	tx.Memo = "=:BTC.BTC:bc1qxyz"
+	c.Check(CorrelationID(tx).String(), Equals, outHash)
+	tx.Memo = "OUT:notahash"
+	c.Check(CorrelationID(tx).String(), Equals, outHash)
+}
+
+func (s *TracingSuite) TestTraceID(c *C) {
+	c.Check(TraceID(common.TxID(inHash)), Equals, TraceID(common.TxID(strings.ToLower(inHash))))
+	c.Check(TraceID(common.TxID(inHash)), Not(Equals), TraceID(common.TxID(outHash)))
+	c.Check(TraceID(common.TxID(inHash)).IsValid(), Equals, true)
+}
+
+func (s *TracingSuite) TestInit(c *C) {
+	shutdown, err := Init("test", config.TracingConfiguration{})
+	c.Assert(err, IsNil)
+	c.Check(Enabled(), Equals, false)
+	c.Check(shutdown(context.Background()), IsNil)
+
+	// spans are dropped while tracing is disabled
+	Event(common.TxID(inHash), StageScanned)
+
+	_, err = Init("test", config.TracingConfiguration{Enabled: true})
+	c.Check(err, ErrorMatches, "tracing is enabled without a file or an otlp endpoint")
+}
+
+func (s *TracingSuite) TestFileExporter(c *C) {
+	path := filepath.Join(c.MkDir(), "traces", "traces.jsonl")
+	shutdown, err := Init("bifrost", config.TracingConfiguration{Enabled: true, File: path})
+	c.Assert(err, IsNil)
+	c.Check(Enabled(), Equals, true)
+
+	Event(common.TxID(strings.ToLower(inHash)), StageScanned, AttrChain.String("BTC"), AttrHeight.Int64(10))
+	span := Start(common.TxID(inHash), StageKeysign)
+	span.SetAttributes(AttrVault.String("vault"))
+	span.End(errors.New("kaboom"))
+	Event(common.TxID(outHash), StageScanned)
+	Event(common.TxID(""), StageScanned)
+	c.Assert(shutdown(context.Background()), IsNil)
+	c.Check(Enabled(), Equals, false)
+
+	// a line cut short is skipped
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	c.Assert(err, IsNil)
+	_, err = f.WriteString(`{"tx_id":"` + inHash + `","sta`)
+	c.Assert(err, IsNil)
+	c.Assert(f.Close(), IsNil)
+
+	records, err := ReadRecords(strings.ToLower(inHash), path)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	traceID := TraceID(common.TxID(inHash)).String()
+
+	c.Check(records[0].TxID, Equals, inHash)
+	c.Check(records[0].Stage, Equals, StageScanned)
+	c.Check(records[0].Service, Equals, "bifrost")
+	c.Check(records[0].TraceID, Equals, traceID)
+	c.Check(records[0].Error, Equals, "")
+	c.Check(records[0].Attributes, DeepEquals, map[string]string{
+		"switchly.chain":  "BTC",
+		"switchly.height": "10",
+	})
+
+	c.Check(records[1].Stage, Equals, StageKeysign)
+	c.Check(records[1].TraceID, Equals, traceID)
+	c.Check(records[1].SpanID, Not(Equals), records[0].SpanID)
+	c.Check(records[1].Error, Equals, "kaboom")
+	c.Check(records[1].Attributes["switchly.vault"], Equals, "vault")
+	c.Check(records[1].Start.Before(records[0].Start), Equals, false)
+
+	records, err = ReadRecords(outHash, path)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 1)
+
+	_, err = ReadRecords(inHash, filepath.Join(c.MkDir(), "missing.jsonl"))
+	c.Check(err, NotNil)
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -32,6 +33,10 @@ func NewTxID(hash string) (TxID, error) {
 			err := fmt.Errorf("txid error: must be 66 characters (got %d)", len(hash))
 			return TxID(""), err
 		}
+	case 128: // SOL check, the hex encoded 64 bytes signature identifying the tx
+		if _, err := hex.DecodeString(hash); err != nil {
+			return TxID(""), fmt.Errorf("txid error: must be hex encoded: %w", err)
+		}
 	default:
 		err := fmt.Errorf("txid error: must be 64 characters (got %d)", len(hash))
 		return TxID(""), err
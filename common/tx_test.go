@@ -22,6 +22,12 @@ func (s TxSuite) TestTxID(c *C) {
 	_, err = NewTxID("0xb41cf456e942f3430681298c503def54b79a96e3373ef9d44ea314d7eae41952")
 	c.Assert(err, IsNil)
 
+	// check sol signature
+	_, err = NewTxID("5E6AD94D1D4F01B2C4E2A9F15B8A0C2D7E3F4A5B6C7D8E9F0A1B2C3D4E5F6A7B8C9D0E1F2A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5")
+	c.Assert(err, IsNil)
+	_, err = NewTxID("ZE6AD94D1D4F01B2C4E2A9F15B8A0C2D7E3F4A5B6C7D8E9F0A1B2C3D4E5F6A7B8C9D0E1F2A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5")
+	c.Check(err, NotNil)
+
 	tx, err = NewTxID("bogus")
 	c.Check(err, NotNil)
 	c.Check(tx.Int64(), Equals, int64(0))
@@ -207,6 +207,10 @@ func Init() {
 		"bifrost.chains.XLM.rpc_host",
 		"XLM_HOST",
 	))
+	assert(viper.BindEnv(
+		"bifrost.chains.SOL.rpc_host",
+		"SOL_HOST",
+	))
 	assert(viper.BindEnv(
 		"bifrost.chains.GAIA.cosmos_grpc_host",
 		"GAIA_GRPC_HOST",
@@ -224,6 +228,15 @@ func Init() {
 		"GAIA_GRPC_TLS",
 	))
 	assert(viper.BindEnv("bifrost.chains.GAIA.disabled", "GAIA_DISABLED"))
+	for _, zone := range []string{"OSMO", "NOBLE", "DYDX"} {
+		assert(viper.BindEnv(fmt.Sprintf("bifrost.chains.%s.rpc_host", zone), zone+"_HOST"))
+		assert(viper.BindEnv(fmt.Sprintf("bifrost.chains.%s.block_scanner.start_block_height", zone), zone+"_START_BLOCK_HEIGHT"))
+		assert(viper.BindEnv(fmt.Sprintf("bifrost.chains.%s.cosmos_grpc_host", zone), zone+"_GRPC_HOST"))
+		assert(viper.BindEnv(fmt.Sprintf("bifrost.chains.%s.block_scanner.cosmos_grpc_host", zone), zone+"_GRPC_HOST"))
+		assert(viper.BindEnv(fmt.Sprintf("bifrost.chains.%s.cosmos_grpc_tls", zone), zone+"_GRPC_TLS"))
+		assert(viper.BindEnv(fmt.Sprintf("bifrost.chains.%s.block_scanner.cosmos_grpc_tls", zone), zone+"_GRPC_TLS"))
+		assert(viper.BindEnv(fmt.Sprintf("bifrost.chains.%s.disabled", zone), zone+"_DISABLED"))
+	}
 	assert(viper.BindEnv("bifrost.chains.DOGE.disabled", "DOGE_DISABLED"))
 	assert(viper.BindEnv("bifrost.chains.LTC.disabled", "LTC_DISABLED"))
 	assert(viper.BindEnv("bifrost.chains.AVAX.disabled", "AVAX_DISABLED"))
@@ -527,6 +540,10 @@ type Switchly struct {
 		// directory with JSON events for all slash increments and decrements. This feature
 		// should not be enabled on production nodes.
 		SlashPoints bool `mapstructure:"slash_points"`
+
+		// Tracing emits a span for every stage an observed transaction goes through in the
+		// handlers and the TxOutStore, keyed by the hash of the inbound transaction.
+		Tracing TracingConfiguration `mapstructure:"tracing"`
 	} `mapstructure:"telemetry"`
 
 	// LogFilter will drop logs matching the modules and messages when not in debug level.
@@ -584,6 +601,12 @@ type Switchly struct {
 			CacheItemTTL time.Duration `mapstructure:"cache_item_ttl"`
 		} `mapstructure:"ebifrost"`
 
+		History struct {
+			Enable       bool   `mapstructure:"enable"`
+			Dir          string `mapstructure:"dir"`
+			RetainBlocks int64  `mapstructure:"retain_blocks"`
+		} `mapstructure:"history"`
+
 		StateSync struct {
 			SnapshotInterval   int64 `mapstructure:"snapshot_interval"`
 			SnapshotKeepRecent int64 `mapstructure:"snapshot_keep_recent"`
@@ -645,6 +668,8 @@ type Bifrost struct {
 	Switchly          BifrostClientConfiguration     `mapstructure:"switchly"`
 	AttestationGossip BifrostAttestationGossipConfig `mapstructure:"attestation_gossip"`
 	Metrics           BifrostMetricsConfiguration    `mapstructure:"metrics"`
+	Health            BifrostHealthConfiguration     `mapstructure:"health"`
+	Tracing           TracingConfiguration           `mapstructure:"tracing"`
 	Chains            struct {
 		AVAX BifrostChainConfiguration `mapstructure:"avax"`
 		BCH  BifrostChainConfiguration `mapstructure:"bch"`
@@ -657,6 +682,12 @@ type Bifrost struct {
 		BASE BifrostChainConfiguration `mapstructure:"base"`
 		XRP  BifrostChainConfiguration `mapstructure:"xrp"`
 		XLM  BifrostChainConfiguration `mapstructure:"xlm"`
+		SOL  BifrostChainConfiguration `mapstructure:"sol"`
+
+		// Cosmos SDK zones served by the generic Cosmos client, see Cosmos below.
+		OSMO  BifrostChainConfiguration `mapstructure:"osmo"`
+		NOBLE BifrostChainConfiguration `mapstructure:"noble"`
+		DYDX  BifrostChainConfiguration `mapstructure:"dydx"`
 	} `mapstructure:"chains"`
 	TSS             BifrostTSSConfiguration `mapstructure:"tss"`
 	ObserverLevelDB LevelDBOptions          `mapstructure:"observer_leveldb"`
@@ -676,6 +707,10 @@ func (b Bifrost) GetChains() map[common.Chain]BifrostChainConfiguration {
 		common.BASEChain:    b.Chains.BASE,
 		common.XRPChain:     b.Chains.XRP,
 		common.StellarChain: b.Chains.XLM,
+		common.SolanaChain:  b.Chains.SOL,
+		common.OSMOChain:    b.Chains.OSMO,
+		common.NOBLEChain:   b.Chains.NOBLE,
+		common.DYDXChain:    b.Chains.DYDX,
 	}
 }
 
@@ -885,7 +920,38 @@ type BifrostChainConfiguration struct {
 		// MaxUTXOsToSpend is the maximum number of UTXOs to spend in a single transaction.
 		// This is overridden at runtime by the `MaxUTXOsToSpend` mimir value.
 		MaxUTXOsToSpend int64 `mapstructure:"max_utxos_to_spend"`
+
+		// ReplaceByFee signals on the inputs of outbounds that they can be replaced by fee
+		// (BIP125), so stuck outbounds are bumped by a replacement transaction instead of a
+		// child spending the change to pay for the parent.
+		ReplaceByFee bool `mapstructure:"replace_by_fee"`
 	} `mapstructure:"utxo"`
+
+	// Cosmos contains Cosmos SDK zone specific configuration. The bech32 prefix of a zone
+	// is not configured, it is the address prefix of its chain so vault addresses match.
+	Cosmos struct {
+		// ChainID is the chain id of the zone signed into outbound transactions (e.g.
+		// cosmoshub-4, osmosis-1). When empty Gaia falls back to the chain id of NET.
+		ChainID string `mapstructure:"chain_id"`
+
+		// FeeDenom is the denom outbound fees are paid in, and the only denom of observed
+		// fees used for the gas price. It must be whitelisted as the chain gas asset.
+		FeeDenom string `mapstructure:"fee_denom"`
+
+		// GasLimit is the gas limit of outbound transactions, and the gas the reported
+		// network fee pays for.
+		GasLimit uint64 `mapstructure:"gas_limit"`
+
+		// GasModel is how the network fee is derived: "average" is the mean gas price paid
+		// by observed transactions, "fixed" is MinGasPrice for zones with a fixed or
+		// rarely changing minimum gas price.
+		GasModel string `mapstructure:"gas_model"`
+
+		// MinGasPrice is the minimum gas price of the zone in FeeDenom per gas unit as a
+		// decimal (e.g. "0.025"). It is the floor of the average gas model and the price
+		// of the fixed gas model. Empty means no floor.
+		MinGasPrice string `mapstructure:"min_gas_price"`
+	} `mapstructure:"cosmos"`
 }
 
 func (b *BifrostChainConfiguration) Validate() {
@@ -975,6 +1041,10 @@ type BifrostBlockScannerConfiguration struct {
 
 	// MaxReorgRescanBlocks is the maximum number of blocks to rescan during a reorg.
 	MaxReorgRescanBlocks int64 `mapstructure:"max_reorg_rescan_blocks"`
+
+	// MaxReorgDepth is the number of blocks the common block scanner keeps the hashes of
+	// to detect reorgs, for chain clients that report block hashes. Zero disables it.
+	MaxReorgDepth int64 `mapstructure:"max_reorg_depth"`
 }
 
 type BifrostClientConfiguration struct {
@@ -996,6 +1066,42 @@ type BifrostMetricsConfiguration struct {
 	Chains       []common.Chain `mapstructure:"chains"`
 }
 
+type BifrostHealthConfiguration struct {
+	// how often the health checks are run, the readiness and liveness probes and the
+	// prometheus gauges report the result of the last run.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// a chain scanner lagging behind the chain tip or the height recorded on switchly by
+	// more than the observation flexibility blocks of the chain is degraded, it fails once
+	// it lags by this many times the observation flexibility blocks.
+	ChainLagFailFactor int64 `mapstructure:"chain_lag_fail_factor"`
+
+	// age in switchly blocks of the oldest outbound still to be signed or broadcast before
+	// the signing backlog is degraded or failing.
+	SigningBacklogWarnBlocks int64 `mapstructure:"signing_backlog_warn_blocks"`
+	SigningBacklogFailBlocks int64 `mapstructure:"signing_backlog_fail_blocks"`
+
+	// attestations waiting in the batcher before the attestation backlog is degraded or
+	// failing.
+	PendingAttestationsWarn int64 `mapstructure:"pending_attestations_warn"`
+	PendingAttestationsFail int64 `mapstructure:"pending_attestations_fail"`
+}
+
+// TracingConfiguration configures the export of the observed transaction lifecycle
+// spans, to a local file of JSON lines, an OTLP collector, or both.
+type TracingConfiguration struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// File is the path of the JSON lines file spans are appended to, environment
+	// variables are expanded. No file is written when empty.
+	File string `mapstructure:"file"`
+
+	// OTLPEndpoint is the host:port of an OTLP/HTTP collector spans are sent to. No
+	// spans are sent when empty.
+	OTLPEndpoint string `mapstructure:"otlp_endpoint"`
+	OTLPInsecure bool   `mapstructure:"otlp_insecure"`
+}
+
 type BifrostTSSConfiguration struct {
 	BootstrapPeers               []string `mapstructure:"bootstrap_peers"`
 	Rendezvous                   string   `mapstructure:"rendezvous"`
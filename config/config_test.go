@@ -49,6 +49,12 @@ func (Test) TestStellarChainMapping(c *C) {
 			BASE BifrostChainConfiguration `mapstructure:"base"`
 			XRP  BifrostChainConfiguration `mapstructure:"xrp"`
 			XLM  BifrostChainConfiguration `mapstructure:"xlm"`
+			SOL  BifrostChainConfiguration `mapstructure:"sol"`
+
+			// Cosmos SDK zones served by the generic Cosmos client, see Cosmos below.
+			OSMO  BifrostChainConfiguration `mapstructure:"osmo"`
+			NOBLE BifrostChainConfiguration `mapstructure:"noble"`
+			DYDX  BifrostChainConfiguration `mapstructure:"dydx"`
 		}{
 			XLM: BifrostChainConfiguration{
 				ChainID: common.StellarChain,
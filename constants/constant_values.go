@@ -170,6 +170,13 @@ const (
 	SignerConcurrency
 	StrictBondLiquidityRatio
 	SwapOutDexAggregationDisabled
+
+	// EdDSA keygen constants
+	EdDSAKeygenMaxRetries
+
+	// Resharing constants
+	ReshareMaxEpochs
+	ReshareMaxMembersChanged
 )
 
 // ConstantValues define methods used to get constant values
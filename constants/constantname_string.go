@@ -162,11 +162,14 @@ func _() {
 	_ = x[SignerConcurrency-151]
 	_ = x[StrictBondLiquidityRatio-152]
 	_ = x[SwapOutDexAggregationDisabled-153]
+	_ = x[EdDSAKeygenMaxRetries-154]
+	_ = x[ReshareMaxEpochs-155]
+	_ = x[ReshareMaxMembersChanged-156]
 }
 
-const _ConstantName_name = "EmissionCurveMaxSWITCHSupplyBlocksPerYearOutboundTransactionFeeNativeTransactionFeePoolCycleMinSWITCHPoolDepthMaxAvailablePoolsStagedPoolCostPendingLiquidityAgeLimitMinimumNodesForBFTDesiredValidatorSetAsgardSizeDerivedDepthBasisPtsDerivedMinDepthMaxAnchorSlipMaxAnchorBlocksDynamicMaxAnchorSlipBlocksDynamicMaxAnchorTargetDynamicMaxAnchorCalcIntervalChurnIntervalChurnRetryIntervalMissingBlockChurnOutMaxMissingBlockChurnOutMaxTrackMissingBlockBadValidatorRedlineLackOfObservationPenaltySigningTransactionPeriodDoubleSignMaxAgePauseBondPauseUnbondMinimumBondInSWITCHFundMigrationIntervalMaxOutboundAttemptsSlashPenaltyPauseOnSlashThresholdFailKeygenSlashPointsFailKeysignSlashPointsLiquidityLockUpBlocksObserveSlashPointsDoubleBlockSignSlashPointsMissBlockSignSlashPointsObservationDelayFlexibilityJailTimeKeygenJailTimeKeysignNodePauseChainBlocksEnableDerivedAssetsMinSwapsPerBlockMaxSwapsPerBlockEnableOrderBooksEnableAdvSwapQueueMaxSynthPerPoolDepthMaxSynthsForSaversYieldVirtualMultSynthsVirtualMultSynthsBasisPointsMinSlashPointsForBadValidatorMaxBondProvidersMinTxOutVolumeThresholdTxOutDelayRateTxOutDelayMaxMaxTxOutOffsetTNSRegisterFeeTNSFeeOnSaleTNSFeePerBlockStreamingSwapPauseStreamingSwapMinBPFeeStreamingSwapMaxLengthStreamingSwapMaxLengthNativeMinCRMaxCRLoanStreamingSwapsIntervalPauseLoansLoanRepaymentMaturityLendingLeverPermittedSolvencyGapNodeOperatorFeeValidatorMaxRewardRatioMaxNodeToChurnOutForLowVersionChurnOutForLowVersionBlocksPOLMaxNetworkDepositPOLMaxPoolMovementPOLTargetSynthPerPoolDepthPOLBufferRagnarokProcessNumOfLPPerIterationSynthYieldBasisPointsSynthYieldCycleMinimumL1OutboundFeeUSDMinimumPoolLiquidityFeeChurnMigrateRoundsAllowWideBlameMaxAffiliateFeeBasisPointsTargetOutboundFeeSurplusSWITCHMaxOutboundFeeMultiplierBasisPointsMinOutboundFeeMultiplierBasisPointsNativeOutboundFeeUSDNativeTransactionFeeUSDTNSRegisterFeeUSDTNSFeePerBlockUSDEnableUSDFeesPreferredAssetOutboundFeeMultiplierFeeUSDRoundSignificantDigitsMigrationVaultSecurityBpsCloutResetCloutLimitKeygenRetryIntervalSaversStreamingSwapsIntervalRescheduleCoalesceBlocksL1SlipMinBpsSynthSlipMinBpsTradeAccountsSlipMinBpsDerivedSlipMinBpsTradeAccountsEnabledTradeAccountsDepositEnabledSecuredAssetSlipMinBpsEVMDisableContractWhitelistOperationalVotesMinSWITCHPoolEnabledSWITCHPoolDepositMaturityBlocksSWITCHPoolMaxReserveBackstopSaversEjectIntervalSystemIncomeBurnRateBpsDevFundSystemIncomeBpsDevFundAddressPendulumAssetsBasisPointsPendulumUseEffectiveSecurityPendulumUseVaultAssetsTVLCapBasisPointsMultipleAffiliatesMaxCountBondSlashBanBankSendEnabledSWITCHPoolHaltDepositSWITCHPoolHaltWithdrawMinSWITCHForSWCYStakeDistributionMinSWCYForSWCYStakeDistributionSWCYStakeSystemIncomeBpsSWCYClaimingSwapHaltSWCYStakeDistributionHaltSWCYStakingHaltSWCYUnstakingHaltSWCYClaimingHaltStellarMinAccountBalanceStellarBaseFeeStellarMaxMemoLengthArtificialRagnarokBlockHeightBondLockupPeriodBurnSynthsDefaultPoolStatusManualSwapsToSynthDisabledMaximumLiquiditySWITCHMintSynthsNumberOfNewNodesPerChurnSignerConcurrencyStrictBondLiquidityRatioSwapOutDexAggregationDisabled"
+const _ConstantName_name = "EmissionCurveMaxSWITCHSupplyBlocksPerYearOutboundTransactionFeeNativeTransactionFeePoolCycleMinSWITCHPoolDepthMaxAvailablePoolsStagedPoolCostPendingLiquidityAgeLimitMinimumNodesForBFTDesiredValidatorSetAsgardSizeDerivedDepthBasisPtsDerivedMinDepthMaxAnchorSlipMaxAnchorBlocksDynamicMaxAnchorSlipBlocksDynamicMaxAnchorTargetDynamicMaxAnchorCalcIntervalChurnIntervalChurnRetryIntervalMissingBlockChurnOutMaxMissingBlockChurnOutMaxTrackMissingBlockBadValidatorRedlineLackOfObservationPenaltySigningTransactionPeriodDoubleSignMaxAgePauseBondPauseUnbondMinimumBondInSWITCHFundMigrationIntervalMaxOutboundAttemptsSlashPenaltyPauseOnSlashThresholdFailKeygenSlashPointsFailKeysignSlashPointsLiquidityLockUpBlocksObserveSlashPointsDoubleBlockSignSlashPointsMissBlockSignSlashPointsObservationDelayFlexibilityJailTimeKeygenJailTimeKeysignNodePauseChainBlocksEnableDerivedAssetsMinSwapsPerBlockMaxSwapsPerBlockEnableOrderBooksEnableAdvSwapQueueMaxSynthPerPoolDepthMaxSynthsForSaversYieldVirtualMultSynthsVirtualMultSynthsBasisPointsMinSlashPointsForBadValidatorMaxBondProvidersMinTxOutVolumeThresholdTxOutDelayRateTxOutDelayMaxMaxTxOutOffsetTNSRegisterFeeTNSFeeOnSaleTNSFeePerBlockStreamingSwapPauseStreamingSwapMinBPFeeStreamingSwapMaxLengthStreamingSwapMaxLengthNativeMinCRMaxCRLoanStreamingSwapsIntervalPauseLoansLoanRepaymentMaturityLendingLeverPermittedSolvencyGapNodeOperatorFeeValidatorMaxRewardRatioMaxNodeToChurnOutForLowVersionChurnOutForLowVersionBlocksPOLMaxNetworkDepositPOLMaxPoolMovementPOLTargetSynthPerPoolDepthPOLBufferRagnarokProcessNumOfLPPerIterationSynthYieldBasisPointsSynthYieldCycleMinimumL1OutboundFeeUSDMinimumPoolLiquidityFeeChurnMigrateRoundsAllowWideBlameMaxAffiliateFeeBasisPointsTargetOutboundFeeSurplusSWITCHMaxOutboundFeeMultiplierBasisPointsMinOutboundFeeMultiplierBasisPointsNativeOutboundFeeUSDNativeTransactionFeeUSDTNSRegisterFeeUSDTNSFeePerBlockUSDEnableUSDFeesPreferredAssetOutboundFeeMultiplierFeeUSDRoundSignificantDigitsMigrationVaultSecurityBpsCloutResetCloutLimitKeygenRetryIntervalSaversStreamingSwapsIntervalRescheduleCoalesceBlocksL1SlipMinBpsSynthSlipMinBpsTradeAccountsSlipMinBpsDerivedSlipMinBpsTradeAccountsEnabledTradeAccountsDepositEnabledSecuredAssetSlipMinBpsEVMDisableContractWhitelistOperationalVotesMinSWITCHPoolEnabledSWITCHPoolDepositMaturityBlocksSWITCHPoolMaxReserveBackstopSaversEjectIntervalSystemIncomeBurnRateBpsDevFundSystemIncomeBpsDevFundAddressPendulumAssetsBasisPointsPendulumUseEffectiveSecurityPendulumUseVaultAssetsTVLCapBasisPointsMultipleAffiliatesMaxCountBondSlashBanBankSendEnabledSWITCHPoolHaltDepositSWITCHPoolHaltWithdrawMinSWITCHForSWCYStakeDistributionMinSWCYForSWCYStakeDistributionSWCYStakeSystemIncomeBpsSWCYClaimingSwapHaltSWCYStakeDistributionHaltSWCYStakingHaltSWCYUnstakingHaltSWCYClaimingHaltStellarMinAccountBalanceStellarBaseFeeStellarMaxMemoLengthArtificialRagnarokBlockHeightBondLockupPeriodBurnSynthsDefaultPoolStatusManualSwapsToSynthDisabledMaximumLiquiditySWITCHMintSynthsNumberOfNewNodesPerChurnSignerConcurrencyStrictBondLiquidityRatioSwapOutDexAggregationDisabledEdDSAKeygenMaxRetriesReshareMaxEpochsReshareMaxMembersChanged"
 
-var _ConstantName_index = [...]uint16{0, 13, 28, 41, 63, 83, 92, 110, 127, 141, 165, 183, 202, 212, 232, 247, 260, 275, 301, 323, 351, 364, 382, 402, 425, 445, 464, 488, 512, 528, 537, 548, 567, 588, 607, 619, 640, 661, 683, 704, 722, 748, 772, 799, 813, 828, 848, 867, 883, 899, 915, 933, 953, 976, 993, 1021, 1050, 1066, 1089, 1103, 1116, 1130, 1144, 1156, 1170, 1188, 1209, 1231, 1259, 1264, 1269, 1295, 1305, 1326, 1338, 1358, 1373, 1396, 1426, 1453, 1473, 1491, 1517, 1526, 1560, 1581, 1596, 1619, 1642, 1660, 1674, 1700, 1730, 1765, 1800, 1820, 1843, 1860, 1877, 1890, 1925, 1953, 1978, 1988, 1998, 2017, 2045, 2069, 2081, 2096, 2119, 2136, 2156, 2183, 2205, 2232, 2251, 2268, 2299, 2327, 2346, 2369, 2391, 2405, 2430, 2458, 2480, 2497, 2523, 2535, 2550, 2571, 2593, 2626, 2657, 2681, 2701, 2726, 2741, 2758, 2774, 2798, 2812, 2832, 2861, 2877, 2887, 2904, 2930, 2952, 2962, 2986, 3003, 3027, 3056}
+var _ConstantName_index = [...]uint16{0, 13, 28, 41, 63, 83, 92, 110, 127, 141, 165, 183, 202, 212, 232, 247, 260, 275, 301, 323, 351, 364, 382, 402, 425, 445, 464, 488, 512, 528, 537, 548, 567, 588, 607, 619, 640, 661, 683, 704, 722, 748, 772, 799, 813, 828, 848, 867, 883, 899, 915, 933, 953, 976, 993, 1021, 1050, 1066, 1089, 1103, 1116, 1130, 1144, 1156, 1170, 1188, 1209, 1231, 1259, 1264, 1269, 1295, 1305, 1326, 1338, 1358, 1373, 1396, 1426, 1453, 1473, 1491, 1517, 1526, 1560, 1581, 1596, 1619, 1642, 1660, 1674, 1700, 1730, 1765, 1800, 1820, 1843, 1860, 1877, 1890, 1925, 1953, 1978, 1988, 1998, 2017, 2045, 2069, 2081, 2096, 2119, 2136, 2156, 2183, 2205, 2232, 2251, 2268, 2299, 2327, 2346, 2369, 2391, 2405, 2430, 2458, 2480, 2497, 2523, 2535, 2550, 2571, 2593, 2626, 2657, 2681, 2701, 2726, 2741, 2758, 2774, 2798, 2812, 2832, 2861, 2877, 2887, 2904, 2930, 2952, 2962, 2986, 3003, 3027, 3056, 3077, 3093, 3117}
 
 func (i ConstantName) String() string {
 	if i < 0 || i >= ConstantName(len(_ConstantName_index)-1) {
@@ -109,6 +109,9 @@ func NewConstantValue() *ConstantVals {
 			CloutReset:                          720,              // number of blocks before clout spent gets reset
 			CloutLimit:                          0,                // max clout allowed to spend
 			KeygenRetryInterval:                 0,                // number of blocks to wait before retrying a keygen
+			EdDSAKeygenMaxRetries:               3,                // number of EdDSA-only keygens scheduled for a vault without an ed25519 supermajority before the members are blamed
+			ReshareMaxEpochs:                    6,                // number of resharing churns a vault key goes through before a churn forces a full keygen
+			ReshareMaxMembersChanged:            6,                // most members joining or leaving a vault in a resharing churn, a churn changing more forces a full keygen
 			SaversStreamingSwapsInterval:        0,                // For Savers deposits and withdraws, the streaming swaps interval to use for the Native <> Synth swap
 			RescheduleCoalesceBlocks:            0,                // number of blocks to coalesce rescheduled outbounds
 			TradeAccountsEnabled:                0,                // enable/disable trade account
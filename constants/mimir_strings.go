@@ -9,6 +9,8 @@ const (
 	MimirKeyWasmPermissionless     = "WasmPermissionless"
 	MimirKeyWasmHaltGlobal         = "HaltWasmGlobal"
 	MimirKeyWasmMinGasPrice        = "WasmMinGasPrice"
+	MimirKeyEdDSAKeygenEnabled     = "EDDSAKEYGENENABLED"
+	MimirKeyReshareChurnEnabled    = "RESHARECHURNENABLED"
 
 	MimirTemplateConfMultiplierBasisPoints = "ConfMultiplierBasisPoints-%s" // Use with Chain
 	MimirTemplateMaxConfirmations          = "MaxConfirmations-%s"          // Use with Chain
@@ -19,6 +21,8 @@ const (
 	MimirTemplateWasmHaltContract          = "HaltWasmContract-%s"          // Use contract address checksum (last 6) for brevity and to fit inside mimir's 64 char length
 	MimirTemplateSwitch                    = "EnableSwitch-%s-%s"           // Use with Chain, Symbol
 	MimirTemplatePauseLPDeposit            = "PauseLPDeposit-%s"            // Use with Asset MimirString
+	MimirTemplateMaxBatchOutputs           = "MaxBatchOutputs-%s"           // Use with Chain
+	MimirTemplateIBCChannel                = "IBCChannel-%s-%s"             // Use with Chain, ICS-20 channel (e.g. channel-141)
 
 	MimirRefL1           = "L1"           // Use with SwapSlipBasisPoints
 	MimirRefSynth        = "Synth"        // Use with SwapSlipBasisPoints
@@ -145,6 +145,11 @@ type ApiBorrowersRequest struct {
 	ApiService *BorrowersApiService
 	asset string
 	height *int64
+	paginationKey *string
+	paginationOffset *int64
+	paginationLimit *int64
+	paginationCountTotal *bool
+	addressPrefix *string
 }
 
 // optional block height, defaults to current tip
@@ -153,6 +158,36 @@ func (r ApiBorrowersRequest) Height(height int64) ApiBorrowersRequest {
 	return r
 }
 
+// key of the first record of the page, as returned in the pagination.next_key of the previous page
+func (r ApiBorrowersRequest) PaginationKey(paginationKey string) ApiBorrowersRequest {
+	r.paginationKey = &paginationKey
+	return r
+}
+
+// number of matching records to skip, cannot be combined with pagination.key
+func (r ApiBorrowersRequest) PaginationOffset(paginationOffset int64) ApiBorrowersRequest {
+	r.paginationOffset = &paginationOffset
+	return r
+}
+
+// maximum number of records to return when paginating, defaults to 100 and is capped at 1000. Paginated requests return the records along with the page metadata instead of a bare list
+func (r ApiBorrowersRequest) PaginationLimit(paginationLimit int64) ApiBorrowersRequest {
+	r.paginationLimit = &paginationLimit
+	return r
+}
+
+// return the total number of matching records in pagination.total, only supported with pagination.offset
+func (r ApiBorrowersRequest) PaginationCountTotal(paginationCountTotal bool) ApiBorrowersRequest {
+	r.paginationCountTotal = &paginationCountTotal
+	return r
+}
+
+// only return records with an address starting with the prefix
+func (r ApiBorrowersRequest) AddressPrefix(addressPrefix string) ApiBorrowersRequest {
+	r.addressPrefix = &addressPrefix
+	return r
+}
+
 func (r ApiBorrowersRequest) Execute() ([]Borrower, *http.Response, error) {
 	return r.ApiService.BorrowersExecute(r)
 }
@@ -199,6 +234,21 @@ func (a *BorrowersApiService) BorrowersExecute(r ApiBorrowersRequest) ([]Borrowe
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
+	if r.paginationKey != nil {
+		localVarQueryParams.Add("pagination.key", parameterToString(*r.paginationKey, ""))
+	}
+	if r.paginationOffset != nil {
+		localVarQueryParams.Add("pagination.offset", parameterToString(*r.paginationOffset, ""))
+	}
+	if r.paginationLimit != nil {
+		localVarQueryParams.Add("pagination.limit", parameterToString(*r.paginationLimit, ""))
+	}
+	if r.paginationCountTotal != nil {
+		localVarQueryParams.Add("pagination.count_total", parameterToString(*r.paginationCountTotal, ""))
+	}
+	if r.addressPrefix != nil {
+		localVarQueryParams.Add("address_prefix", parameterToString(*r.addressPrefix, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
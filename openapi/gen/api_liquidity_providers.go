@@ -140,11 +140,154 @@ func (a *LiquidityProvidersApiService) LiquidityProviderExecute(r ApiLiquidityPr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiLiquidityProviderHistoryRequest struct {
+	ctx context.Context
+	ApiService *LiquidityProvidersApiService
+	asset string
+	address string
+	fromHeight *int64
+	toHeight *int64
+	limit *int64
+}
+
+// first height of the range, the position at this height is always included
+func (r ApiLiquidityProviderHistoryRequest) FromHeight(fromHeight int64) ApiLiquidityProviderHistoryRequest {
+	r.fromHeight = &fromHeight
+	return r
+}
+
+// last height of the range, defaults to the last height recorded
+func (r ApiLiquidityProviderHistoryRequest) ToHeight(toHeight int64) ApiLiquidityProviderHistoryRequest {
+	r.toHeight = &toHeight
+	return r
+}
+
+// maximum number of positions to return, defaults to 100 and is capped at 1000
+func (r ApiLiquidityProviderHistoryRequest) Limit(limit int64) ApiLiquidityProviderHistoryRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiLiquidityProviderHistoryRequest) Execute() (*LiquidityProviderHistoryResponse, *http.Response, error) {
+	return r.ApiService.LiquidityProviderHistoryExecute(r)
+}
+
+/*
+LiquidityProviderHistory Method for LiquidityProviderHistory
+
+Returns the liquidity provider position at every height it or the pool changed within a height range, served by nodes recording position history.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param asset
+ @param address
+ @return ApiLiquidityProviderHistoryRequest
+*/
+func (a *LiquidityProvidersApiService) LiquidityProviderHistory(ctx context.Context, asset string, address string) ApiLiquidityProviderHistoryRequest {
+	return ApiLiquidityProviderHistoryRequest{
+		ApiService: a,
+		ctx: ctx,
+		asset: asset,
+		address: address,
+	}
+}
+
+// Execute executes the request
+//  @return LiquidityProviderHistoryResponse
+func (a *LiquidityProvidersApiService) LiquidityProviderHistoryExecute(r ApiLiquidityProviderHistoryRequest) (*LiquidityProviderHistoryResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *LiquidityProviderHistoryResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LiquidityProvidersApiService.LiquidityProviderHistory")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/switchly/pool/{asset}/liquidity_provider/{address}/history"
+	localVarPath = strings.Replace(localVarPath, "{"+"asset"+"}", url.PathEscape(parameterToString(r.asset, "")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"address"+"}", url.PathEscape(parameterToString(r.address, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.fromHeight != nil {
+		localVarQueryParams.Add("from_height", parameterToString(*r.fromHeight, ""))
+	}
+	if r.toHeight != nil {
+		localVarQueryParams.Add("to_height", parameterToString(*r.toHeight, ""))
+	}
+	if r.limit != nil {
+		localVarQueryParams.Add("limit", parameterToString(*r.limit, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiLiquidityProvidersRequest struct {
 	ctx context.Context
 	ApiService *LiquidityProvidersApiService
 	asset string
 	height *int64
+	paginationKey *string
+	paginationOffset *int64
+	paginationLimit *int64
+	paginationCountTotal *bool
+	addressPrefix *string
+	minUnits *string
 }
 
 // optional block height, defaults to current tip
@@ -153,6 +296,42 @@ func (r ApiLiquidityProvidersRequest) Height(height int64) ApiLiquidityProviders
 	return r
 }
 
+// key of the first record of the page, as returned in the pagination.next_key of the previous page
+func (r ApiLiquidityProvidersRequest) PaginationKey(paginationKey string) ApiLiquidityProvidersRequest {
+	r.paginationKey = &paginationKey
+	return r
+}
+
+// number of matching records to skip, cannot be combined with pagination.key
+func (r ApiLiquidityProvidersRequest) PaginationOffset(paginationOffset int64) ApiLiquidityProvidersRequest {
+	r.paginationOffset = &paginationOffset
+	return r
+}
+
+// maximum number of records to return when paginating, defaults to 100 and is capped at 1000. Paginated requests return the records along with the page metadata instead of a bare list
+func (r ApiLiquidityProvidersRequest) PaginationLimit(paginationLimit int64) ApiLiquidityProvidersRequest {
+	r.paginationLimit = &paginationLimit
+	return r
+}
+
+// return the total number of matching records in pagination.total, only supported with pagination.offset
+func (r ApiLiquidityProvidersRequest) PaginationCountTotal(paginationCountTotal bool) ApiLiquidityProvidersRequest {
+	r.paginationCountTotal = &paginationCountTotal
+	return r
+}
+
+// only return records with an address starting with the prefix
+func (r ApiLiquidityProvidersRequest) AddressPrefix(addressPrefix string) ApiLiquidityProvidersRequest {
+	r.addressPrefix = &addressPrefix
+	return r
+}
+
+// only return records with at least this many units
+func (r ApiLiquidityProvidersRequest) MinUnits(minUnits string) ApiLiquidityProvidersRequest {
+	r.minUnits = &minUnits
+	return r
+}
+
 func (r ApiLiquidityProvidersRequest) Execute() ([]LiquidityProviderSummary, *http.Response, error) {
 	return r.ApiService.LiquidityProvidersExecute(r)
 }
@@ -199,6 +378,24 @@ func (a *LiquidityProvidersApiService) LiquidityProvidersExecute(r ApiLiquidityP
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
+	if r.paginationKey != nil {
+		localVarQueryParams.Add("pagination.key", parameterToString(*r.paginationKey, ""))
+	}
+	if r.paginationOffset != nil {
+		localVarQueryParams.Add("pagination.offset", parameterToString(*r.paginationOffset, ""))
+	}
+	if r.paginationLimit != nil {
+		localVarQueryParams.Add("pagination.limit", parameterToString(*r.paginationLimit, ""))
+	}
+	if r.paginationCountTotal != nil {
+		localVarQueryParams.Add("pagination.count_total", parameterToString(*r.paginationCountTotal, ""))
+	}
+	if r.addressPrefix != nil {
+		localVarQueryParams.Add("address_prefix", parameterToString(*r.addressPrefix, ""))
+	}
+	if r.minUnits != nil {
+		localVarQueryParams.Add("min_units", parameterToString(*r.minUnits, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -245,6 +245,125 @@ func (a *NetworkApiService) ConstantsExecute(r ApiConstantsRequest) (*ConstantsR
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiDexAggregatorsRequest struct {
+	ctx context.Context
+	ApiService *NetworkApiService
+	height *int64
+	chain *string
+}
+
+// optional block height, defaults to current tip
+func (r ApiDexAggregatorsRequest) Height(height int64) ApiDexAggregatorsRequest {
+	r.height = &height
+	return r
+}
+
+// only return the dex aggregators of this chain
+func (r ApiDexAggregatorsRequest) Chain(chain string) ApiDexAggregatorsRequest {
+	r.chain = &chain
+	return r
+}
+
+func (r ApiDexAggregatorsRequest) Execute() ([]DexAggregator, *http.Response, error) {
+	return r.ApiService.DexAggregatorsExecute(r)
+}
+
+/*
+DexAggregators Method for DexAggregators
+
+Returns the dex aggregators whitelisted for swap outs, optionally filtered by chain.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiDexAggregatorsRequest
+*/
+func (a *NetworkApiService) DexAggregators(ctx context.Context) ApiDexAggregatorsRequest {
+	return ApiDexAggregatorsRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return []DexAggregator
+func (a *NetworkApiService) DexAggregatorsExecute(r ApiDexAggregatorsRequest) ([]DexAggregator, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  []DexAggregator
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkApiService.DexAggregators")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/switchly/dex_aggregators"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.chain != nil {
+		localVarQueryParams.Add("chain", parameterToString(*r.chain, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiInboundAddressesRequest struct {
 	ctx context.Context
 	ApiService *NetworkApiService
@@ -140,6 +140,12 @@ type ApiNodesRequest struct {
 	ctx context.Context
 	ApiService *NodesApiService
 	height *int64
+	paginationKey *string
+	paginationOffset *int64
+	paginationLimit *int64
+	paginationCountTotal *bool
+	addressPrefix *string
+	status *string
 }
 
 // optional block height, defaults to current tip
@@ -148,6 +154,42 @@ func (r ApiNodesRequest) Height(height int64) ApiNodesRequest {
 	return r
 }
 
+// key of the first record of the page, as returned in the pagination.next_key of the previous page
+func (r ApiNodesRequest) PaginationKey(paginationKey string) ApiNodesRequest {
+	r.paginationKey = &paginationKey
+	return r
+}
+
+// number of matching records to skip, cannot be combined with pagination.key
+func (r ApiNodesRequest) PaginationOffset(paginationOffset int64) ApiNodesRequest {
+	r.paginationOffset = &paginationOffset
+	return r
+}
+
+// maximum number of records to return when paginating, defaults to 100 and is capped at 1000. Paginated requests return the records along with the page metadata instead of a bare list
+func (r ApiNodesRequest) PaginationLimit(paginationLimit int64) ApiNodesRequest {
+	r.paginationLimit = &paginationLimit
+	return r
+}
+
+// return the total number of matching records in pagination.total, only supported with pagination.offset
+func (r ApiNodesRequest) PaginationCountTotal(paginationCountTotal bool) ApiNodesRequest {
+	r.paginationCountTotal = &paginationCountTotal
+	return r
+}
+
+// only return records with an address starting with the prefix
+func (r ApiNodesRequest) AddressPrefix(addressPrefix string) ApiNodesRequest {
+	r.addressPrefix = &addressPrefix
+	return r
+}
+
+// only return nodes with this status
+func (r ApiNodesRequest) Status(status string) ApiNodesRequest {
+	r.status = &status
+	return r
+}
+
 func (r ApiNodesRequest) Execute() ([]Node, *http.Response, error) {
 	return r.ApiService.NodesExecute(r)
 }
@@ -191,6 +233,24 @@ func (a *NodesApiService) NodesExecute(r ApiNodesRequest) ([]Node, *http.Respons
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
+	if r.paginationKey != nil {
+		localVarQueryParams.Add("pagination.key", parameterToString(*r.paginationKey, ""))
+	}
+	if r.paginationOffset != nil {
+		localVarQueryParams.Add("pagination.offset", parameterToString(*r.paginationOffset, ""))
+	}
+	if r.paginationLimit != nil {
+		localVarQueryParams.Add("pagination.limit", parameterToString(*r.paginationLimit, ""))
+	}
+	if r.paginationCountTotal != nil {
+		localVarQueryParams.Add("pagination.count_total", parameterToString(*r.paginationCountTotal, ""))
+	}
+	if r.addressPrefix != nil {
+		localVarQueryParams.Add("address_prefix", parameterToString(*r.addressPrefix, ""))
+	}
+	if r.status != nil {
+		localVarQueryParams.Add("status", parameterToString(*r.status, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -16,6 +16,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 
@@ -131,6 +132,250 @@ func (a *QueueApiService) QueueExecute(r ApiQueueRequest) (*QueueResponse, *http
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiQueueLimitSwapBookRequest struct {
+	ctx context.Context
+	ApiService *QueueApiService
+	sourceAsset *string
+	targetAsset *string
+	height *int64
+}
+
+// the asset offered by the limit swaps
+func (r ApiQueueLimitSwapBookRequest) SourceAsset(sourceAsset string) ApiQueueLimitSwapBookRequest {
+	r.sourceAsset = &sourceAsset
+	return r
+}
+
+// the asset requested by the limit swaps
+func (r ApiQueueLimitSwapBookRequest) TargetAsset(targetAsset string) ApiQueueLimitSwapBookRequest {
+	r.targetAsset = &targetAsset
+	return r
+}
+
+// optional block height, defaults to current tip
+func (r ApiQueueLimitSwapBookRequest) Height(height int64) ApiQueueLimitSwapBookRequest {
+	r.height = &height
+	return r
+}
+
+func (r ApiQueueLimitSwapBookRequest) Execute() (*LimitSwapBookResponse, *http.Response, error) {
+	return r.ApiService.QueueLimitSwapBookExecute(r)
+}
+
+/*
+QueueLimitSwapBook Method for QueueLimitSwapBook
+
+Returns the resting limit swaps for a pair aggregated by ratio.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQueueLimitSwapBookRequest
+*/
+func (a *QueueApiService) QueueLimitSwapBook(ctx context.Context) ApiQueueLimitSwapBookRequest {
+	return ApiQueueLimitSwapBookRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return LimitSwapBookResponse
+func (a *QueueApiService) QueueLimitSwapBookExecute(r ApiQueueLimitSwapBookRequest) (*LimitSwapBookResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *LimitSwapBookResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QueueApiService.QueueLimitSwapBook")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/switchly/queue/limit_swaps/book"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.sourceAsset == nil {
+		return localVarReturnValue, nil, reportError("sourceAsset is required and must be specified")
+	}
+	if r.targetAsset == nil {
+		return localVarReturnValue, nil, reportError("targetAsset is required and must be specified")
+	}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	localVarQueryParams.Add("source_asset", parameterToString(*r.sourceAsset, ""))
+	localVarQueryParams.Add("target_asset", parameterToString(*r.targetAsset, ""))
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiQueueLimitSwapsRequest struct {
+	ctx context.Context
+	ApiService *QueueApiService
+	address string
+	height *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiQueueLimitSwapsRequest) Height(height int64) ApiQueueLimitSwapsRequest {
+	r.height = &height
+	return r
+}
+
+func (r ApiQueueLimitSwapsRequest) Execute() ([]MsgSwap, *http.Response, error) {
+	return r.ApiService.QueueLimitSwapsExecute(r)
+}
+
+/*
+QueueLimitSwaps Method for QueueLimitSwaps
+
+Returns the resting limit swaps sent from the provided address.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param address
+ @return ApiQueueLimitSwapsRequest
+*/
+func (a *QueueApiService) QueueLimitSwaps(ctx context.Context, address string) ApiQueueLimitSwapsRequest {
+	return ApiQueueLimitSwapsRequest{
+		ApiService: a,
+		ctx: ctx,
+		address: address,
+	}
+}
+
+// Execute executes the request
+//  @return []MsgSwap
+func (a *QueueApiService) QueueLimitSwapsExecute(r ApiQueueLimitSwapsRequest) ([]MsgSwap, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  []MsgSwap
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QueueApiService.QueueLimitSwaps")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/switchly/queue/limit_swaps/{address}"
+	localVarPath = strings.Replace(localVarPath, "{"+"address"+"}", url.PathEscape(parameterToString(r.address, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiQueueOutboundRequest struct {
 	ctx context.Context
 	ApiService *QueueApiService
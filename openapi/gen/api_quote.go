@@ -643,6 +643,8 @@ type ApiQuoteswapRequest struct {
 	liquidityToleranceBps *int64
 	affiliateBps *int64
 	affiliate *string
+	optimizeStreaming *bool
+	maxStreamingSeconds *int64
 }
 
 // optional block height, defaults to current tip
@@ -717,6 +719,18 @@ func (r ApiQuoteswapRequest) Affiliate(affiliate string) ApiQuoteswapRequest {
 	return r
 }
 
+// search the streaming interval and quantity maximising the output, streaming_quantity must not be set
+func (r ApiQuoteswapRequest) OptimizeStreaming(optimizeStreaming bool) ApiQuoteswapRequest {
+	r.optimizeStreaming = &optimizeStreaming
+	return r
+}
+
+// the maximum duration of the searched streaming swap in seconds
+func (r ApiQuoteswapRequest) MaxStreamingSeconds(maxStreamingSeconds int64) ApiQuoteswapRequest {
+	r.maxStreamingSeconds = &maxStreamingSeconds
+	return r
+}
+
 func (r ApiQuoteswapRequest) Execute() (*QuoteSwapResponse, *http.Response, error) {
 	return r.ApiService.QuoteswapExecute(r)
 }
@@ -793,6 +807,12 @@ func (a *QuoteApiService) QuoteswapExecute(r ApiQuoteswapRequest) (*QuoteSwapRes
 	if r.affiliate != nil {
 		localVarQueryParams.Add("affiliate", parameterToString(*r.affiliate, ""))
 	}
+	if r.optimizeStreaming != nil {
+		localVarQueryParams.Add("optimize_streaming", parameterToString(*r.optimizeStreaming, ""))
+	}
+	if r.maxStreamingSeconds != nil {
+		localVarQueryParams.Add("max_streaming_seconds", parameterToString(*r.maxStreamingSeconds, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -846,3 +866,113 @@ func (a *QuoteApiService) QuoteswapExecute(r ApiQuoteswapRequest) (*QuoteSwapRes
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiQuoteswapbatchRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	quoteSwapBatchRequest *QuoteSwapBatchRequest
+}
+
+func (r ApiQuoteswapbatchRequest) QuoteSwapBatchRequest(quoteSwapBatchRequest QuoteSwapBatchRequest) ApiQuoteswapbatchRequest {
+	r.quoteSwapBatchRequest = &quoteSwapBatchRequest
+	return r
+}
+
+func (r ApiQuoteswapbatchRequest) Execute() (*QuoteSwapBatchResponse, *http.Response, error) {
+	return r.ApiService.QuoteswapbatchExecute(r)
+}
+
+/*
+Quoteswapbatch Method for Quoteswapbatch
+
+Provide quote estimates for many swaps, all evaluated against the same pool state.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuoteswapbatchRequest
+*/
+func (a *QuoteApiService) Quoteswapbatch(ctx context.Context) ApiQuoteswapbatchRequest {
+	return ApiQuoteswapbatchRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteSwapBatchResponse
+func (a *QuoteApiService) QuoteswapbatchExecute(r ApiQuoteswapbatchRequest) (*QuoteSwapBatchResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteSwapBatchResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quoteswapbatch")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/switchly/quote/swap/batch"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.quoteSwapBatchRequest == nil {
+		return localVarReturnValue, nil, reportError("quoteSwapBatchRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.quoteSwapBatchRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -249,6 +249,12 @@ type ApiSWITCHProvidersRequest struct {
 	ctx context.Context
 	ApiService *SWITCHPoolApiService
 	height *int64
+	paginationKey *string
+	paginationOffset *int64
+	paginationLimit *int64
+	paginationCountTotal *bool
+	addressPrefix *string
+	minUnits *string
 }
 
 // optional block height, defaults to current tip
@@ -257,6 +263,42 @@ func (r ApiSWITCHProvidersRequest) Height(height int64) ApiSWITCHProvidersReques
 	return r
 }
 
+// key of the first record of the page, as returned in the pagination.next_key of the previous page
+func (r ApiSWITCHProvidersRequest) PaginationKey(paginationKey string) ApiSWITCHProvidersRequest {
+	r.paginationKey = &paginationKey
+	return r
+}
+
+// number of matching records to skip, cannot be combined with pagination.key
+func (r ApiSWITCHProvidersRequest) PaginationOffset(paginationOffset int64) ApiSWITCHProvidersRequest {
+	r.paginationOffset = &paginationOffset
+	return r
+}
+
+// maximum number of records to return when paginating, defaults to 100 and is capped at 1000. Paginated requests return the records along with the page metadata instead of a bare list
+func (r ApiSWITCHProvidersRequest) PaginationLimit(paginationLimit int64) ApiSWITCHProvidersRequest {
+	r.paginationLimit = &paginationLimit
+	return r
+}
+
+// return the total number of matching records in pagination.total, only supported with pagination.offset
+func (r ApiSWITCHProvidersRequest) PaginationCountTotal(paginationCountTotal bool) ApiSWITCHProvidersRequest {
+	r.paginationCountTotal = &paginationCountTotal
+	return r
+}
+
+// only return records with an address starting with the prefix
+func (r ApiSWITCHProvidersRequest) AddressPrefix(addressPrefix string) ApiSWITCHProvidersRequest {
+	r.addressPrefix = &addressPrefix
+	return r
+}
+
+// only return records with at least this many units
+func (r ApiSWITCHProvidersRequest) MinUnits(minUnits string) ApiSWITCHProvidersRequest {
+	r.minUnits = &minUnits
+	return r
+}
+
 func (r ApiSWITCHProvidersRequest) Execute() ([]SWITCHProvider, *http.Response, error) {
 	return r.ApiService.SWITCHProvidersExecute(r)
 }
@@ -300,6 +342,24 @@ func (a *SWITCHPoolApiService) SWITCHProvidersExecute(r ApiSWITCHProvidersReques
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
+	if r.paginationKey != nil {
+		localVarQueryParams.Add("pagination.key", parameterToString(*r.paginationKey, ""))
+	}
+	if r.paginationOffset != nil {
+		localVarQueryParams.Add("pagination.offset", parameterToString(*r.paginationOffset, ""))
+	}
+	if r.paginationLimit != nil {
+		localVarQueryParams.Add("pagination.limit", parameterToString(*r.paginationLimit, ""))
+	}
+	if r.paginationCountTotal != nil {
+		localVarQueryParams.Add("pagination.count_total", parameterToString(*r.paginationCountTotal, ""))
+	}
+	if r.addressPrefix != nil {
+		localVarQueryParams.Add("address_prefix", parameterToString(*r.addressPrefix, ""))
+	}
+	if r.minUnits != nil {
+		localVarQueryParams.Add("min_units", parameterToString(*r.minUnits, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
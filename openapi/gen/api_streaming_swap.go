@@ -140,6 +140,12 @@ type ApiStreamSwapsRequest struct {
 	ctx context.Context
 	ApiService *StreamingSwapApiService
 	height *int64
+	paginationKey *string
+	paginationOffset *int64
+	paginationLimit *int64
+	paginationCountTotal *bool
+	sourceAsset *string
+	targetAsset *string
 }
 
 // optional block height, defaults to current tip
@@ -148,6 +154,42 @@ func (r ApiStreamSwapsRequest) Height(height int64) ApiStreamSwapsRequest {
 	return r
 }
 
+// key of the first record of the page, as returned in the pagination.next_key of the previous page
+func (r ApiStreamSwapsRequest) PaginationKey(paginationKey string) ApiStreamSwapsRequest {
+	r.paginationKey = &paginationKey
+	return r
+}
+
+// number of matching records to skip, cannot be combined with pagination.key
+func (r ApiStreamSwapsRequest) PaginationOffset(paginationOffset int64) ApiStreamSwapsRequest {
+	r.paginationOffset = &paginationOffset
+	return r
+}
+
+// maximum number of records to return when paginating, defaults to 100 and is capped at 1000. Paginated requests return the records along with the page metadata instead of a bare list
+func (r ApiStreamSwapsRequest) PaginationLimit(paginationLimit int64) ApiStreamSwapsRequest {
+	r.paginationLimit = &paginationLimit
+	return r
+}
+
+// return the total number of matching records in pagination.total, only supported with pagination.offset
+func (r ApiStreamSwapsRequest) PaginationCountTotal(paginationCountTotal bool) ApiStreamSwapsRequest {
+	r.paginationCountTotal = &paginationCountTotal
+	return r
+}
+
+// only return streaming swaps from this asset
+func (r ApiStreamSwapsRequest) SourceAsset(sourceAsset string) ApiStreamSwapsRequest {
+	r.sourceAsset = &sourceAsset
+	return r
+}
+
+// only return streaming swaps to this asset
+func (r ApiStreamSwapsRequest) TargetAsset(targetAsset string) ApiStreamSwapsRequest {
+	r.targetAsset = &targetAsset
+	return r
+}
+
 func (r ApiStreamSwapsRequest) Execute() ([]StreamingSwap, *http.Response, error) {
 	return r.ApiService.StreamSwapsExecute(r)
 }
@@ -191,6 +233,24 @@ func (a *StreamingSwapApiService) StreamSwapsExecute(r ApiStreamSwapsRequest) ([
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
+	if r.paginationKey != nil {
+		localVarQueryParams.Add("pagination.key", parameterToString(*r.paginationKey, ""))
+	}
+	if r.paginationOffset != nil {
+		localVarQueryParams.Add("pagination.offset", parameterToString(*r.paginationOffset, ""))
+	}
+	if r.paginationLimit != nil {
+		localVarQueryParams.Add("pagination.limit", parameterToString(*r.paginationLimit, ""))
+	}
+	if r.paginationCountTotal != nil {
+		localVarQueryParams.Add("pagination.count_total", parameterToString(*r.paginationCountTotal, ""))
+	}
+	if r.sourceAsset != nil {
+		localVarQueryParams.Add("source_asset", parameterToString(*r.sourceAsset, ""))
+	}
+	if r.targetAsset != nil {
+		localVarQueryParams.Add("target_asset", parameterToString(*r.targetAsset, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
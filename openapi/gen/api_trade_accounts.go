@@ -28,6 +28,12 @@ type ApiTradeAccountsRequest struct {
 	ApiService *TradeAccountsApiService
 	asset string
 	height *int64
+	paginationKey *string
+	paginationOffset *int64
+	paginationLimit *int64
+	paginationCountTotal *bool
+	addressPrefix *string
+	minUnits *string
 }
 
 // optional block height, defaults to current tip
@@ -36,6 +42,42 @@ func (r ApiTradeAccountsRequest) Height(height int64) ApiTradeAccountsRequest {
 	return r
 }
 
+// key of the first record of the page, as returned in the pagination.next_key of the previous page
+func (r ApiTradeAccountsRequest) PaginationKey(paginationKey string) ApiTradeAccountsRequest {
+	r.paginationKey = &paginationKey
+	return r
+}
+
+// number of matching records to skip, cannot be combined with pagination.key
+func (r ApiTradeAccountsRequest) PaginationOffset(paginationOffset int64) ApiTradeAccountsRequest {
+	r.paginationOffset = &paginationOffset
+	return r
+}
+
+// maximum number of records to return when paginating, defaults to 100 and is capped at 1000. Paginated requests return the records along with the page metadata instead of a bare list
+func (r ApiTradeAccountsRequest) PaginationLimit(paginationLimit int64) ApiTradeAccountsRequest {
+	r.paginationLimit = &paginationLimit
+	return r
+}
+
+// return the total number of matching records in pagination.total, only supported with pagination.offset
+func (r ApiTradeAccountsRequest) PaginationCountTotal(paginationCountTotal bool) ApiTradeAccountsRequest {
+	r.paginationCountTotal = &paginationCountTotal
+	return r
+}
+
+// only return records with an address starting with the prefix
+func (r ApiTradeAccountsRequest) AddressPrefix(addressPrefix string) ApiTradeAccountsRequest {
+	r.addressPrefix = &addressPrefix
+	return r
+}
+
+// only return records with at least this many units
+func (r ApiTradeAccountsRequest) MinUnits(minUnits string) ApiTradeAccountsRequest {
+	r.minUnits = &minUnits
+	return r
+}
+
 func (r ApiTradeAccountsRequest) Execute() ([]TradeAccountResponse, *http.Response, error) {
 	return r.ApiService.TradeAccountsExecute(r)
 }
@@ -82,6 +124,24 @@ func (a *TradeAccountsApiService) TradeAccountsExecute(r ApiTradeAccountsRequest
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
+	if r.paginationKey != nil {
+		localVarQueryParams.Add("pagination.key", parameterToString(*r.paginationKey, ""))
+	}
+	if r.paginationOffset != nil {
+		localVarQueryParams.Add("pagination.offset", parameterToString(*r.paginationOffset, ""))
+	}
+	if r.paginationLimit != nil {
+		localVarQueryParams.Add("pagination.limit", parameterToString(*r.paginationLimit, ""))
+	}
+	if r.paginationCountTotal != nil {
+		localVarQueryParams.Add("pagination.count_total", parameterToString(*r.paginationCountTotal, ""))
+	}
+	if r.addressPrefix != nil {
+		localVarQueryParams.Add("address_prefix", parameterToString(*r.addressPrefix, ""))
+	}
+	if r.minUnits != nil {
+		localVarQueryParams.Add("min_units", parameterToString(*r.minUnits, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -19,6 +19,9 @@ type Keygen struct {
 	Id *string `json:"id,omitempty"`
 	Type *string `json:"type,omitempty"`
 	Members []string `json:"members,omitempty"`
+	// the vault an EdDSA-only keygen attaches its ed25519 group key to, or a reshare keygen hands over
+	PoolPubKey *string `json:"pool_pub_key,omitempty"`
+	OldMembers []string `json:"old_members,omitempty"`
 }
 
 // NewKeygen instantiates a new Keygen object
@@ -134,6 +137,70 @@ func (o *Keygen) SetMembers(v []string) {
 	o.Members = v
 }
 
+// GetPoolPubKey returns the PoolPubKey field value if set, zero value otherwise.
+func (o *Keygen) GetPoolPubKey() string {
+	if o == nil || o.PoolPubKey == nil {
+		var ret string
+		return ret
+	}
+	return *o.PoolPubKey
+}
+
+// GetPoolPubKeyOk returns a tuple with the PoolPubKey field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Keygen) GetPoolPubKeyOk() (*string, bool) {
+	if o == nil || o.PoolPubKey == nil {
+		return nil, false
+	}
+	return o.PoolPubKey, true
+}
+
+// HasPoolPubKey returns a boolean if a field has been set.
+func (o *Keygen) HasPoolPubKey() bool {
+	if o != nil && o.PoolPubKey != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPoolPubKey gets a reference to the given string and assigns it to the PoolPubKey field.
+func (o *Keygen) SetPoolPubKey(v string) {
+	o.PoolPubKey = &v
+}
+
+// GetOldMembers returns the OldMembers field value if set, zero value otherwise.
+func (o *Keygen) GetOldMembers() []string {
+	if o == nil || o.OldMembers == nil {
+		var ret []string
+		return ret
+	}
+	return o.OldMembers
+}
+
+// GetOldMembersOk returns a tuple with the OldMembers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Keygen) GetOldMembersOk() ([]string, bool) {
+	if o == nil || o.OldMembers == nil {
+		return nil, false
+	}
+	return o.OldMembers, true
+}
+
+// HasOldMembers returns a boolean if a field has been set.
+func (o *Keygen) HasOldMembers() bool {
+	if o != nil && o.OldMembers != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetOldMembers gets a reference to the given []string and assigns it to the OldMembers field.
+func (o *Keygen) SetOldMembers(v []string) {
+	o.OldMembers = v
+}
+
 func (o Keygen) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if o.Id != nil {
@@ -145,6 +212,12 @@ func (o Keygen) MarshalJSON_deprecated() ([]byte, error) {
 	if o.Members != nil {
 		toSerialize["members"] = o.Members
 	}
+	if o.PoolPubKey != nil {
+		toSerialize["pool_pub_key"] = o.PoolPubKey
+	}
+	if o.OldMembers != nil {
+		toSerialize["old_members"] = o.OldMembers
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -55,6 +55,8 @@ type QuoteSwapResponse struct {
 	StreamingSwapSeconds *int64 `json:"streaming_swap_seconds,omitempty"`
 	// total number of seconds a swap is expected to take (inbound conf + streaming swap + outbound delay)
 	TotalSwapSeconds *int64 `json:"total_swap_seconds,omitempty"`
+	// the expected output of the searched streaming quantities, set if optimize_streaming was requested
+	StreamingCurve []StreamingQuotePoint `json:"streaming_curve,omitempty"`
 }
 
 // NewQuoteSwapResponse instantiates a new QuoteSwapResponse object
@@ -665,6 +667,38 @@ func (o *QuoteSwapResponse) SetTotalSwapSeconds(v int64) {
 	o.TotalSwapSeconds = &v
 }
 
+// GetStreamingCurve returns the StreamingCurve field value if set, zero value otherwise.
+func (o *QuoteSwapResponse) GetStreamingCurve() []StreamingQuotePoint {
+	if o == nil || o.StreamingCurve == nil {
+		var ret []StreamingQuotePoint
+		return ret
+	}
+	return o.StreamingCurve
+}
+
+// GetStreamingCurveOk returns a tuple with the StreamingCurve field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *QuoteSwapResponse) GetStreamingCurveOk() ([]StreamingQuotePoint, bool) {
+	if o == nil || o.StreamingCurve == nil {
+		return nil, false
+	}
+	return o.StreamingCurve, true
+}
+
+// HasStreamingCurve returns a boolean if a field has been set.
+func (o *QuoteSwapResponse) HasStreamingCurve() bool {
+	if o != nil && o.StreamingCurve != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStreamingCurve gets a reference to the given []StreamingQuotePoint and assigns it to the StreamingCurve field.
+func (o *QuoteSwapResponse) SetStreamingCurve(v []StreamingQuotePoint) {
+	o.StreamingCurve = v
+}
+
 func (o QuoteSwapResponse) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if o.InboundAddress != nil {
@@ -727,6 +761,9 @@ func (o QuoteSwapResponse) MarshalJSON_deprecated() ([]byte, error) {
 	if o.TotalSwapSeconds != nil {
 		toSerialize["total_swap_seconds"] = o.TotalSwapSeconds
 	}
+	if o.StreamingCurve != nil {
+		toSerialize["streaming_curve"] = o.StreamingCurve
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -31,6 +31,8 @@ type Vault struct {
 	Routers []VaultRouter `json:"routers"`
 	Addresses []VaultAddress `json:"addresses"`
 	Frozen []string `json:"frozen,omitempty"`
+	// the number of resharing churns that handed the vault to a new membership
+	MembershipEpoch *int64 `json:"membership_epoch,omitempty"`
 }
 
 // NewVault instantiates a new Vault object
@@ -470,6 +472,38 @@ func (o *Vault) SetFrozen(v []string) {
 	o.Frozen = v
 }
 
+// GetMembershipEpoch returns the MembershipEpoch field value if set, zero value otherwise.
+func (o *Vault) GetMembershipEpoch() int64 {
+	if o == nil || o.MembershipEpoch == nil {
+		var ret int64
+		return ret
+	}
+	return *o.MembershipEpoch
+}
+
+// GetMembershipEpochOk returns a tuple with the MembershipEpoch field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Vault) GetMembershipEpochOk() (*int64, bool) {
+	if o == nil || o.MembershipEpoch == nil {
+		return nil, false
+	}
+	return o.MembershipEpoch, true
+}
+
+// HasMembershipEpoch returns a boolean if a field has been set.
+func (o *Vault) HasMembershipEpoch() bool {
+	if o != nil && o.MembershipEpoch != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMembershipEpoch gets a reference to the given int64 and assigns it to the MembershipEpoch field.
+func (o *Vault) SetMembershipEpoch(v int64) {
+	o.MembershipEpoch = &v
+}
+
 func (o Vault) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if o.BlockHeight != nil {
@@ -514,6 +548,9 @@ func (o Vault) MarshalJSON_deprecated() ([]byte, error) {
 	if o.Frozen != nil {
 		toSerialize["frozen"] = o.Frozen
 	}
+	if o.MembershipEpoch != nil {
+		toSerialize["membership_epoch"] = o.MembershipEpoch
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -129,20 +129,23 @@ func (rgParams *ReSharingParameters) OldAndNewPartyCount() int {
 	return rgParams.OldPartyCount() + rgParams.NewPartyCount()
 }
 
+// IsOldCommittee reports whether this party is in the old committee. A party is matched by its
+// key and its id, so one node can run an old and a new party under the same key. (switchly patch)
 func (rgParams *ReSharingParameters) IsOldCommittee() bool {
 	partyID := rgParams.partyID
 	for _, Pj := range rgParams.parties.IDs() {
-		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 {
+		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 && partyID.Id == Pj.Id {
 			return true
 		}
 	}
 	return false
 }
 
+// IsNewCommittee reports whether this party is in the new committee, see IsOldCommittee.
 func (rgParams *ReSharingParameters) IsNewCommittee() bool {
 	partyID := rgParams.partyID
 	for _, Pj := range rgParams.newParties.IDs() {
-		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 {
+		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 && partyID.Id == Pj.Id {
 			return true
 		}
 	}
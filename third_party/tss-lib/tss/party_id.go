@@ -122,10 +122,12 @@ func (spids SortedPartyIDs) FindByKey(key *big.Int) *PartyID {
 	return nil
 }
 
+// Exclude drops the given party, matched by its key and its id so a resharing party does not drop
+// the other committee's party of the same node. (switchly patch)
 func (spids SortedPartyIDs) Exclude(exclude *PartyID) SortedPartyIDs {
 	newSpIDs := make(SortedPartyIDs, 0, len(spids))
 	for _, pid := range spids {
-		if pid.KeyInt().Cmp(exclude.KeyInt()) == 0 {
+		if pid.KeyInt().Cmp(exclude.KeyInt()) == 0 && pid.Id == exclude.Id {
 			continue // exclude
 		}
 		newSpIDs = append(newSpIDs, pid)
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/switchlyprotocol/switchlynode/v3/bifrost/signer"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+)
+
+// -------------------------------------------------------------------------------------
+// Flags
+// -------------------------------------------------------------------------------------
+
+var (
+	flagDB  *string
+	flagAll *bool
+)
+
+func init() {
+	flagDB = flag.String("db", "/var/data/bifrost/signer_db", "path to the bifrost signer db")
+	flagAll = flag.Bool("all", false, "list broadcast and observed items as well")
+	flag.Usage = usage
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Inspect and repair the bifrost signing journal. Bifrost must be stopped, it holds the db lock.
+
+Usage:
+  signer-journal [flags] list
+  signer-journal [flags] show <key>
+  signer-journal [flags] reset <key>
+  signer-journal [flags] set-state <key> <state>
+  signer-journal [flags] remove <key>
+
+Commands:
+  list       list the journaled items
+  show       print the item and its journal
+  reset      move the item back to scheduled, dropping its signed tx so it is signed again
+  set-state  force the item into the given state (scheduled, keysign-started, signed, broadcast, observed)
+  remove     remove the item from the store
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
+
+// -------------------------------------------------------------------------------------
+// Helpers
+// -------------------------------------------------------------------------------------
+
+// errUsage is returned when the command line is invalid
+var errUsage = errors.New("invalid usage")
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+func args(n int) ([]string, error) {
+	if flag.NArg() != n+1 {
+		return nil, errUsage
+	}
+	return flag.Args()[1:], nil
+}
+
+func get(store *signer.SignerStore, key string) (signer.TxOutStoreItem, error) {
+	if !store.Has(key) {
+		return signer.TxOutStoreItem{}, fmt.Errorf("item not found: %s", key)
+	}
+	item, err := store.Get(key)
+	if err != nil {
+		return signer.TxOutStoreItem{}, fmt.Errorf("fail to get item: %w", err)
+	}
+	return item, nil
+}
+
+// -------------------------------------------------------------------------------------
+// Commands
+// -------------------------------------------------------------------------------------
+
+func list(store *signer.SignerStore) {
+	items := store.List()
+	if *flagAll {
+		items = store.ListAll()
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Height != items[j].Height {
+			return items[i].Height < items[j].Height
+		}
+		return items[i].Index < items[j].Index
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tHEIGHT\tCHAIN\tSTATE\tROUND7\tHASH\tMEMO")
+	for _, item := range items {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%t\t%s\t%s\n",
+			item.Key(),
+			item.Height,
+			item.TxOutItem.Chain,
+			item.State,
+			item.Round7Retry,
+			item.BroadcastHash,
+			item.TxOutItem.Memo,
+		)
+	}
+	_ = w.Flush()
+}
+
+// itemView is an item with its states spelled out
+type itemView struct {
+	signer.TxOutStoreItem
+	State   string
+	Journal []journalEntryView
+}
+
+type journalEntryView struct {
+	State string
+	Time  time.Time
+	Note  string `json:",omitempty"`
+}
+
+func show(store *signer.SignerStore, key string) error {
+	item, err := get(store, key)
+	if err != nil {
+		return err
+	}
+	view := itemView{TxOutStoreItem: item, State: item.State.String()}
+	for _, entry := range item.Journal {
+		view.Journal = append(view.Journal, journalEntryView{entry.State.String(), entry.Time, entry.Note})
+	}
+	buf, err := json.MarshalIndent(view, "", "  ")
+	if err != nil {
+		return fmt.Errorf("fail to marshal item: %w", err)
+	}
+	fmt.Println(string(buf))
+	return nil
+}
+
+func setState(store *signer.SignerStore, key string, state signer.SigningState) error {
+	item, err := get(store, key)
+	if err != nil {
+		return err
+	}
+	from := item.State
+	if err = store.ForceTransition(&item, state, fmt.Sprintf("repaired: %s -> %s", from, state)); err != nil {
+		return fmt.Errorf("fail to set state: %w", err)
+	}
+	fmt.Printf("%s: %s -> %s\n", key, from, state)
+	return nil
+}
+
+func remove(store *signer.SignerStore, key string) error {
+	item, err := get(store, key)
+	if err != nil {
+		return err
+	}
+	if err = store.Remove(item); err != nil {
+		return fmt.Errorf("fail to remove item: %w", err)
+	}
+	fmt.Printf("%s: removed\n", key)
+	return nil
+}
+
+// run runs the command given on the command line against the store
+func run(store *signer.SignerStore) error {
+	switch flag.Arg(0) {
+	case "list":
+		if _, err := args(0); err != nil {
+			return err
+		}
+		list(store)
+		return nil
+	case "show":
+		a, err := args(1)
+		if err != nil {
+			return err
+		}
+		return show(store, a[0])
+	case "reset":
+		a, err := args(1)
+		if err != nil {
+			return err
+		}
+		return setState(store, a[0], signer.SigningScheduled)
+	case "remove":
+		a, err := args(1)
+		if err != nil {
+			return err
+		}
+		return remove(store, a[0])
+	case "set-state":
+		a, err := args(2)
+		if err != nil {
+			return err
+		}
+		state, err := signer.ParseSigningState(a[1])
+		if err != nil {
+			return err
+		}
+		return setState(store, a[0], state)
+	default:
+		return errUsage
+	}
+}
+
+// -------------------------------------------------------------------------------------
+// Main
+// -------------------------------------------------------------------------------------
+
+func main() {
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// the store would silently create an empty db at a wrong path
+	if _, err := os.Stat(*flagDB); err != nil {
+		fail("fail to open signer db: %s", err)
+	}
+	store, err := signer.NewSignerStore(*flagDB, config.LevelDBOptions{}, "")
+	if err != nil {
+		fail("fail to open signer db (is bifrost still running?): %s", err)
+	}
+
+	// the store is closed before exiting so repairs are flushed and the db lock released
+	err = run(store)
+	if closeErr := store.Close(); closeErr != nil && err == nil {
+		err = fmt.Errorf("fail to close signer db: %w", closeErr)
+	}
+	if errors.Is(err, errUsage) {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err != nil {
+		fail("%s", err)
+	}
+}
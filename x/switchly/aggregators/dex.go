@@ -1,52 +1,14 @@
 package aggregators
 
 import (
-	"fmt"
-	"strings"
-
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 )
 
+// Aggregator is a dex aggregator contract compiled into the binary. These are only the
+// defaults seeded into the genesis state, the aggregators whitelisted by a running chain
+// are kept in the keeper and managed by node votes (MsgUpdateAggregator).
 type Aggregator struct {
 	Chain         common.Chain
 	Address       string
 	GasUnitsLimit uint64
 }
-
-// FetchDexAggregator - fetches a dex aggregator address that matches the given chain and suffix
-func FetchDexAggregator(chain common.Chain, suffix string) (string, error) {
-	contracts := DexAggregators()
-	// If no whitelist contracts are set, fall through to the suffix
-	if len(contracts) == 0 {
-		return suffix, nil
-	}
-	for _, agg := range contracts {
-		if !chain.Equals(agg.Chain) {
-			continue
-		}
-		if strings.HasSuffix(agg.Address, suffix) {
-			return agg.Address, nil
-		}
-	}
-
-	return "", fmt.Errorf("%s aggregator not found", suffix)
-}
-
-// FetchDexAggregatorGasLimit - fetches a dex aggregator gas limit that matches the given chain and suffix
-func FetchDexAggregatorGasLimit(chain common.Chain, suffix string) (uint64, error) {
-	contracts := DexAggregators()
-	// If no whitelist contracts are set, fall through to the default of 400_000
-	if len(contracts) == 0 {
-		return 400_000, nil
-	}
-	for _, agg := range contracts {
-		if !chain.Equals(agg.Chain) {
-			continue
-		}
-		if strings.HasSuffix(agg.Address, suffix) {
-			return agg.GasUnitsLimit, nil
-		}
-	}
-
-	return 0, fmt.Errorf("%s aggregator not found", suffix)
-}
@@ -4,7 +4,6 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
-	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/aggregators"
 	mem "github.com/switchlyprotocol/switchlynode/v3/x/switchly/memo"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
@@ -54,11 +53,13 @@ const (
 	NodeTypeVault     = types.NodeType_TypeVault
 
 	// Bond type
-	BondPaid     = types.BondType_bond_paid
-	BondReturned = types.BondType_bond_returned
-	BondCost     = types.BondType_bond_cost
-	BondReward   = types.BondType_bond_reward
-	AsgardKeygen = types.KeygenType_AsgardKeygen
+	BondPaid      = types.BondType_bond_paid
+	BondReturned  = types.BondType_bond_returned
+	BondCost      = types.BondType_bond_cost
+	BondReward    = types.BondType_bond_reward
+	AsgardKeygen  = types.KeygenType_AsgardKeygen
+	EdDSAKeygen   = types.KeygenType_EdDSAKeygen
+	ReshareKeygen = types.KeygenType_ReshareKeygen
 
 	// Bond type
 	AddPendingLiquidity      = types.PendingLiquidityType_add
@@ -73,25 +74,27 @@ const (
 	BurnSupplyType = types.MintBurnSupplyType_burn
 
 	// Memos
-	TxSwap          = mem.TxSwap
-	TxLimitSwap     = mem.TxLimitSwap
-	TxAdd           = mem.TxAdd
-	TxBond          = mem.TxBond
-	TxMigrate       = mem.TxMigrate
-	TxRagnarok      = mem.TxRagnarok
-	TxReserve       = mem.TxReserve
-	TxOutbound      = mem.TxOutbound
-	TxRefund        = mem.TxRefund
-	TxUnBond        = mem.TxUnbond
-	TxLeave         = mem.TxLeave
-	TxMaint         = mem.TxMaint
-	TxWithdraw      = mem.TxWithdraw
-	TxSWITCHName    = mem.TxSWITCHName
-	TxLoanOpen      = mem.TxLoanOpen
-	TxLoanRepayment = mem.TxLoanRepayment
-	TxSWCYClaim     = mem.TxSWCYClaim
-	TxSWCYStake     = mem.TxSWCYStake
-	TxSWCYUnstake   = mem.TxSWCYUnstake
+	TxSwap            = mem.TxSwap
+	TxLimitSwap       = mem.TxLimitSwap
+	TxAdd             = mem.TxAdd
+	TxBond            = mem.TxBond
+	TxMigrate         = mem.TxMigrate
+	TxRagnarok        = mem.TxRagnarok
+	TxReserve         = mem.TxReserve
+	TxOutbound        = mem.TxOutbound
+	TxRefund          = mem.TxRefund
+	TxBatchOutbound   = mem.TxBatchOutbound
+	TxUnBond          = mem.TxUnbond
+	TxLeave           = mem.TxLeave
+	TxMaint           = mem.TxMaint
+	TxModifyLimitSwap = mem.TxModifyLimitSwap
+	TxWithdraw        = mem.TxWithdraw
+	TxSWITCHName      = mem.TxSWITCHName
+	TxLoanOpen        = mem.TxLoanOpen
+	TxLoanRepayment   = mem.TxLoanRepayment
+	TxSWCYClaim       = mem.TxSWCYClaim
+	TxSWCYStake       = mem.TxSWCYStake
+	TxSWCYUnstake     = mem.TxSWCYUnstake
 )
 
 var (
@@ -102,10 +105,14 @@ var (
 	NewObservedTx                  = common.NewObservedTx
 	NewTssVoter                    = types.NewTssVoter
 	NewBanVoter                    = types.NewBanVoter
+	NewEdDSABackfillVoter          = types.NewEdDSABackfillVoter
+	NewDexAggregator               = types.NewDexAggregator
+	NewDexAggregatorVoter          = types.NewDexAggregatorVoter
+	NewVaultMembershipEpoch        = types.NewVaultMembershipEpoch
 	NewErrataTxVoter               = types.NewErrataTxVoter
 	NewObservedTxVoter             = types.NewObservedTxVoter
-	NewMsgSwitchPoolDeposit          = types.NewMsgSwitchPoolDeposit
-	NewMsgSwitchPoolWithdraw         = types.NewMsgSwitchPoolWithdraw
+	NewMsgSwitchPoolDeposit        = types.NewMsgSwitchPoolDeposit
+	NewMsgSwitchPoolWithdraw       = types.NewMsgSwitchPoolWithdraw
 	NewMsgTradeAccountDeposit      = types.NewMsgTradeAccountDeposit
 	NewMsgTradeAccountWithdrawal   = types.NewMsgTradeAccountWithdrawal
 	NewMsgSecuredAssetDeposit      = types.NewMsgSecuredAssetDeposit
@@ -125,7 +132,10 @@ var (
 	NewMsgAddLiquidity             = types.NewMsgAddLiquidity
 	NewMsgWithdrawLiquidity        = types.NewMsgWithdrawLiquidity
 	NewMsgSwap                     = types.NewMsgSwap
+	NewMsgModifyLimitSwap          = types.NewMsgModifyLimitSwap
 	NewKeygen                      = types.NewKeygen
+	NewEdDSAKeygen                 = types.NewEdDSAKeygen
+	NewReshareKeygen               = types.NewReshareKeygen
 	NewKeygenBlock                 = types.NewKeygenBlock
 	NewMsgSetNodeKeys              = types.NewMsgSetNodeKeys
 	NewMsgManageSWITCHName         = types.NewMsgManageSWITCHName
@@ -138,6 +148,7 @@ var (
 	NewEventAffiliateFee           = types.NewEventAffiliateFee
 	NewEventStreamingSwap          = types.NewEventStreamingSwap
 	NewEventLimitSwap              = types.NewEventLimitSwap
+	NewEventModifyLimitSwap        = types.NewEventModifyLimitSwap
 	NewEventAddLiquidity           = types.NewEventAddLiquidity
 	NewEventWithdraw               = types.NewEventWithdraw
 	NewEventRefund                 = types.NewEventRefund
@@ -152,6 +163,7 @@ var (
 	NewEventFee                    = types.NewEventFee
 	NewEventOutbound               = types.NewEventOutbound
 	NewEventSetMimir               = types.NewEventSetMimir
+	NewEventDexAggregator          = types.NewEventDexAggregator
 	NewEventSetNodeMimir           = types.NewEventSetNodeMimir
 	NewEventTssKeygenSuccess       = types.NewEventTssKeygenSuccess
 	NewEventTssKeygenFailure       = types.NewEventTssKeygenFailure
@@ -189,6 +201,8 @@ var (
 	NewMsgUnBond                   = types.NewMsgUnBond
 	NewMsgErrataTx                 = types.NewMsgErrataTx
 	NewMsgBan                      = types.NewMsgBan
+	NewMsgEdDSABackfill            = types.NewMsgEdDSABackfill
+	NewMsgUpdateAggregator         = types.NewMsgUpdateAggregator
 	NewMsgLeave                    = types.NewMsgLeave
 	NewMsgSetVersion               = types.NewMsgSetVersion
 	NewMsgProposeUpgrade           = types.NewMsgProposeUpgrade
@@ -221,6 +235,7 @@ var (
 	GetRandomBech32ConsensusPubKey = types.GetRandomBech32ConsensusPubKey
 	GetRandomPubKey                = types.GetRandomPubKey
 	GetRandomPubKeySet             = types.GetRandomPubKeySet
+	GetRandomEd25519PubKey         = types.GetRandomEd25519PubKey
 	GetCurrentVersion              = types.GetCurrentVersion
 	SetupConfigForTest             = types.SetupConfigForTest
 	HasSimpleMajority              = types.HasSimpleMajority
@@ -242,9 +257,7 @@ var (
 	NewOutboundMemo          = mem.NewOutboundMemo
 	NewRagnarokMemo          = mem.NewRagnarokMemo
 	NewMigrateMemo           = mem.NewMigrateMemo
-
-	FetchDexAggregator         = aggregators.FetchDexAggregator
-	FetchDexAggregatorGasLimit = aggregators.FetchDexAggregatorGasLimit
+	NewBatchOutboundMemo     = mem.NewBatchOutboundMemo
 )
 
 type (
@@ -270,7 +283,10 @@ type (
 	MsgRefundTx               = types.MsgRefundTx
 	MsgErrataTx               = types.MsgErrataTx
 	MsgBan                    = types.MsgBan
+	MsgEdDSABackfill          = types.MsgEdDSABackfill
+	MsgUpdateAggregator       = types.MsgUpdateAggregator
 	MsgSwap                   = types.MsgSwap
+	MsgModifyLimitSwap        = types.MsgModifyLimitSwap
 	MsgSetVersion             = types.MsgSetVersion
 	MsgProposeUpgrade         = types.MsgProposeUpgrade
 	MsgApproveUpgrade         = types.MsgApproveUpgrade
@@ -289,8 +305,8 @@ type (
 	MsgSolvency               = types.MsgSolvency
 	MsgLoanOpen               = types.MsgLoanOpen
 	MsgLoanRepayment          = types.MsgLoanRepayment
-	MsgSwitchPoolDeposit        = types.MsgSwitchPoolDeposit
-	MsgSwitchPoolWithdraw       = types.MsgSwitchPoolWithdraw
+	MsgSwitchPoolDeposit      = types.MsgSwitchPoolDeposit
+	MsgSwitchPoolWithdraw     = types.MsgSwitchPoolWithdraw
 	MsgWasmExec               = types.MsgWasmExec
 	MsgSwitch                 = types.MsgSwitch
 	MsgSWCYClaim              = types.MsgSWCYClaim
@@ -312,6 +328,10 @@ type (
 	ObservedTxVoter          = types.ObservedTxVoter
 	ObservedTxVoters         = types.ObservedTxVoters
 	BanVoter                 = types.BanVoter
+	EdDSABackfillVoter       = types.EdDSABackfillVoter
+	DexAggregator            = types.DexAggregator
+	DexAggregatorVoter       = types.DexAggregatorVoter
+	VaultMembershipEpoch     = types.VaultMembershipEpoch
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
@@ -396,13 +416,15 @@ type (
 	SecuredAssetWithdrawMemo   = mem.SecuredAssetWithdrawMemo
 	LoanOpenMemo               = mem.LoanOpenMemo
 	LoanRepaymentMemo          = mem.LoanRepaymentMemo
-	SwitchPoolDepositMemo        = mem.SwitchPoolDepositMemo
-	SwitchPoolWithdrawMemo       = mem.SwitchPoolWithdrawMemo
+	SwitchPoolDepositMemo      = mem.SwitchPoolDepositMemo
+	SwitchPoolWithdrawMemo     = mem.SwitchPoolWithdrawMemo
 	ExecMemo                   = mem.ExecMemo
 	SwitchMemo                 = mem.SwitchMemo
 	SWCYClaimMemo              = mem.SWCYClaimMemo
 	SWCYStakeMemo              = mem.SWCYStakeMemo
 	SWCYUnstakeMemo            = mem.SWCYUnstakeMemo
+	ModifyLimitSwapMemo        = mem.ModifyLimitSwapMemo
+	BatchOutboundMemo          = mem.BatchOutboundMemo
 
 	// Proto
 	ProtoStrings = types.ProtoStrings
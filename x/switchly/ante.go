@@ -123,6 +123,8 @@ func (ad AnteDecorator) anteHandleMessage(ctx sdk.Context, version semver.Versio
 	// consensus handlers
 	case *types.MsgBan:
 		return BanAnteHandler(ctx, version, ad.keeper, *m)
+	case *types.MsgEdDSABackfill:
+		return EdDSABackfillAnteHandler(ctx, version, ad.keeper, *m)
 	case *types.MsgErrataTx:
 		return ErrataTxAnteHandler(ctx, version, ad.keeper, *m)
 	case *types.MsgNetworkFee:
@@ -137,6 +139,8 @@ func (ad AnteDecorator) anteHandleMessage(ctx sdk.Context, version semver.Versio
 		return TssAnteHandler(ctx, version, ad.keeper, *m)
 	case *types.MsgTssKeysignFail:
 		return TssKeysignFailAnteHandler(ctx, version, ad.keeper, *m)
+	case *types.MsgUpdateAggregator:
+		return UpdateAggregatorAnteHandler(ctx, version, ad.keeper, *m)
 
 	// cli handlers (non-consensus)
 	case *types.MsgSetIPAddress:
@@ -5,10 +5,22 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common/relay"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+const (
+	flagAddressPrefix = "address-prefix"
+	flagMinUnits      = "min-units"
+	flagMinAmount     = "min-amount"
+	flagStatus        = "status"
+	flagSourceAsset   = "source-asset"
+	flagTargetAsset   = "target-asset"
 )
 
 type ver struct {
@@ -30,6 +42,18 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	queryCmd.AddCommand(
+		GetCmdLiquidityProviders(),
+		GetCmdSavers(),
+		GetCmdBorrowers(),
+		GetCmdTradeAccounts(),
+		GetCmdSwitchProviders(),
+		GetCmdSWCYStakers(),
+		GetCmdNodes(),
+		GetCmdStreamingSwaps(),
+		GetCmdTxTimeline(),
+	)
+
 	return queryCmd
 }
 
@@ -89,3 +113,320 @@ func GetCmdGetNORelay() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdLiquidityProviders queries the liquidity providers of a pool
+func GetCmdLiquidityProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-providers [asset]",
+		Short: "Query the liquidity providers of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			addressPrefix, _ := cmd.Flags().GetString(flagAddressPrefix)
+			minUnits, _ := cmd.Flags().GetString(flagMinUnits)
+
+			res, err := types.NewQueryClient(clientCtx).LiquidityProviders(cmd.Context(), &types.QueryLiquidityProvidersRequest{
+				Asset:         args[0],
+				Pagination:    pageReq,
+				AddressPrefix: addressPrefix,
+				MinUnits:      minUnits,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagAddressPrefix, "", "only list liquidity providers with a switch or asset address starting with the prefix")
+	cmd.Flags().String(flagMinUnits, "", "only list liquidity providers with at least this many pool units")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "liquidity providers")
+
+	return cmd
+}
+
+// GetCmdSavers queries the savers of a pool
+func GetCmdSavers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "savers [asset]",
+		Short: "Query the savers of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			addressPrefix, _ := cmd.Flags().GetString(flagAddressPrefix)
+			minUnits, _ := cmd.Flags().GetString(flagMinUnits)
+
+			res, err := types.NewQueryClient(clientCtx).Savers(cmd.Context(), &types.QuerySaversRequest{
+				Asset:         args[0],
+				Pagination:    pageReq,
+				AddressPrefix: addressPrefix,
+				MinUnits:      minUnits,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagAddressPrefix, "", "only list savers with an asset address starting with the prefix")
+	cmd.Flags().String(flagMinUnits, "", "only list savers with at least this many pool units")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "savers")
+
+	return cmd
+}
+
+// GetCmdBorrowers queries the borrowers of a pool
+func GetCmdBorrowers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "borrowers [asset]",
+		Short: "Query the borrowers of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			addressPrefix, _ := cmd.Flags().GetString(flagAddressPrefix)
+
+			res, err := types.NewQueryClient(clientCtx).Borrowers(cmd.Context(), &types.QueryBorrowersRequest{
+				Asset:         args[0],
+				Pagination:    pageReq,
+				AddressPrefix: addressPrefix,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagAddressPrefix, "", "only list borrowers with an owner address starting with the prefix")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "borrowers")
+
+	return cmd
+}
+
+// GetCmdTradeAccounts queries the trade accounts of an asset
+func GetCmdTradeAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trade-accounts [asset]",
+		Short: "Query the trade accounts of an asset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			addressPrefix, _ := cmd.Flags().GetString(flagAddressPrefix)
+			minUnits, _ := cmd.Flags().GetString(flagMinUnits)
+
+			res, err := types.NewQueryClient(clientCtx).TradeAccounts(cmd.Context(), &types.QueryTradeAccountsRequest{
+				Asset:         args[0],
+				Pagination:    pageReq,
+				AddressPrefix: addressPrefix,
+				MinUnits:      minUnits,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagAddressPrefix, "", "only list trade accounts with an owner address starting with the prefix")
+	cmd.Flags().String(flagMinUnits, "", "only list trade accounts with at least this many units")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trade accounts")
+
+	return cmd
+}
+
+// GetCmdSwitchProviders queries the SWITCH pool providers
+func GetCmdSwitchProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "switch-providers",
+		Short: "Query the SWITCH pool providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			addressPrefix, _ := cmd.Flags().GetString(flagAddressPrefix)
+			minUnits, _ := cmd.Flags().GetString(flagMinUnits)
+
+			res, err := types.NewQueryClient(clientCtx).SWITCHProviders(cmd.Context(), &types.QuerySWITCHProvidersRequest{
+				Pagination:    pageReq,
+				AddressPrefix: addressPrefix,
+				MinUnits:      minUnits,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagAddressPrefix, "", "only list providers with a switch address starting with the prefix")
+	cmd.Flags().String(flagMinUnits, "", "only list providers with at least this many pool units")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "switch providers")
+
+	return cmd
+}
+
+// GetCmdSWCYStakers queries the SWCY stakers
+func GetCmdSWCYStakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swcy-stakers",
+		Short: "Query the SWCY stakers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			addressPrefix, _ := cmd.Flags().GetString(flagAddressPrefix)
+			minAmount, _ := cmd.Flags().GetString(flagMinAmount)
+
+			res, err := types.NewQueryClient(clientCtx).SWCYStakers(cmd.Context(), &types.QuerySWCYStakersRequest{
+				Pagination:    pageReq,
+				AddressPrefix: addressPrefix,
+				MinAmount:     minAmount,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagAddressPrefix, "", "only list stakers with an address starting with the prefix")
+	cmd.Flags().String(flagMinAmount, "", "only list stakers with at least this amount staked")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swcy stakers")
+
+	return cmd
+}
+
+// GetCmdNodes queries the bonded validator nodes
+func GetCmdNodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodes",
+		Short: "Query the bonded validator nodes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			addressPrefix, _ := cmd.Flags().GetString(flagAddressPrefix)
+			status, _ := cmd.Flags().GetString(flagStatus)
+
+			res, err := types.NewQueryClient(clientCtx).Nodes(cmd.Context(), &types.QueryNodesRequest{
+				Pagination:    pageReq,
+				AddressPrefix: addressPrefix,
+				Status:        status,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagAddressPrefix, "", "only list nodes with a node address starting with the prefix")
+	cmd.Flags().String(flagStatus, "", "only list nodes with the status (e.g. Active, Standby)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nodes")
+
+	return cmd
+}
+
+// GetCmdStreamingSwaps queries the in progress streaming swaps
+func GetCmdStreamingSwaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streaming-swaps",
+		Short: "Query the in progress streaming swaps",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			sourceAsset, _ := cmd.Flags().GetString(flagSourceAsset)
+			targetAsset, _ := cmd.Flags().GetString(flagTargetAsset)
+
+			res, err := types.NewQueryClient(clientCtx).StreamingSwaps(cmd.Context(), &types.QueryStreamingSwapsRequest{
+				Pagination:  pageReq,
+				SourceAsset: sourceAsset,
+				TargetAsset: targetAsset,
+			})
+			if err != nil {
+				return err
+			}
+			return printPage(cmd, clientCtx, res, res.Pagination)
+		},
+	}
+
+	cmd.Flags().String(flagSourceAsset, "", "only list streaming swaps from the asset")
+	cmd.Flags().String(flagTargetAsset, "", "only list streaming swaps to the asset")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "streaming swaps")
+
+	return cmd
+}
+
+// printPage prints a paginated list response, the list responses marshal to
+// plain arrays so the key of the next page is printed separately
+func printPage(cmd *cobra.Command, clientCtx client.Context, res proto.Message, page *query.PageResponse) error {
+	if err := clientCtx.PrintProto(res); err != nil {
+		return err
+	}
+	if page != nil && len(page.NextKey) > 0 {
+		cmd.PrintErrf("next page key: %s\n", page.NextKey)
+	}
+	return nil
+}
@@ -37,6 +37,8 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(GetCmdRejectUpgrade())
 	txCmd.AddCommand(GetCmdSetIPAddress())
 	txCmd.AddCommand(GetCmdBan())
+	txCmd.AddCommand(GetCmdEdDSABackfill())
+	txCmd.AddCommand(GetCmdUpdateAggregator())
 	txCmd.AddCommand(GetCmdMimir())
 	txCmd.AddCommand(GetCmdNodePauseChain())
 	txCmd.AddCommand(GetCmdNodeResumeChain())
@@ -208,6 +210,80 @@ func GetCmdBan() *cobra.Command {
 	}
 }
 
+// GetCmdEdDSABackfill command to vote for the EdDSA key backfill of a vault
+func GetCmdEdDSABackfill() *cobra.Command {
+	return &cobra.Command{
+		Use:   "eddsa-backfill [vault pubkey]",
+		Short: "votes to generate the missing ed25519 key of an active vault",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pk, err := common.NewPubKey(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid vault pubkey: %w", err)
+			}
+
+			msg := types.NewMsgEdDSABackfill(pk, clientCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+const (
+	flagDisabled          = "disabled"
+	flagRemove            = "remove"
+	flagAggregatorVersion = "aggregator-version"
+)
+
+// GetCmdUpdateAggregator command to vote for adding, updating or removing a dex aggregator
+func GetCmdUpdateAggregator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-aggregator [chain] [address] [gas limit]",
+		Short: "votes to add, update or remove a whitelisted dex aggregator",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain, err := common.NewChain(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid chain: %w", err)
+			}
+
+			var gasLimit uint64
+			if len(args) > 2 {
+				gasLimit, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid gas limit: %w", err)
+				}
+			}
+
+			disabled, _ := cmd.Flags().GetBool(flagDisabled)
+			remove, _ := cmd.Flags().GetBool(flagRemove)
+			version, _ := cmd.Flags().GetString(flagAggregatorVersion)
+
+			msg := types.NewMsgUpdateAggregator(chain, args[1], gasLimit, !disabled, version, remove, clientCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(flagDisabled, false, "keep the aggregator registered but reject swaps through it")
+	cmd.Flags().Bool(flagRemove, false, "remove the aggregator")
+	cmd.Flags().String(flagAggregatorVersion, "", "version tag of the aggregator contract")
+	return cmd
+}
+
 // GetCmdSetIPAddress command to set a node accounts IP Address
 func GetCmdSetIPAddress() *cobra.Command {
 	return &cobra.Command{
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+const flagTraceFile = "trace-file"
+
+// TxTimeline is the lifecycle of an inbound tx, the trace records of every stage it went
+// through in bifrost and switchlynode followed by the stages switchly reports for it.
+type TxTimeline struct {
+	TxID        string            `json:"tx_id"`
+	TraceID     string            `json:"trace_id"`
+	Events      []TxTimelineEvent `json:"events"`
+	Stages      json.RawMessage   `json:"stages,omitempty"`
+	StagesError string            `json:"stages_error,omitempty"`
+}
+
+// TxTimelineEvent is a trace record along with the time elapsed since the first one.
+type TxTimelineEvent struct {
+	tracing.Record
+	Elapsed string `json:"elapsed"`
+}
+
+// GetCmdTxTimeline reconstructs the timeline of an inbound tx from trace files
+func GetCmdTxTimeline() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-timeline [hash]",
+		Short: "Reconstruct the lifecycle of an inbound tx from trace files and its stages",
+		Long: `Reconstruct the lifecycle of an inbound tx from the trace files written by bifrost and
+switchlynode when tracing is enabled, merged with the stages switchly reports for the tx.
+The trace file of this node is read by default, trace files copied from bifrost or other
+nodes can be added with --trace-file.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			txID, err := common.NewTxID(args[0])
+			if err != nil {
+				return err
+			}
+			paths, _ := cmd.Flags().GetStringSlice(flagTraceFile)
+			if file := defaultTraceFile(); file != "" && !slices.Contains(paths, file) {
+				paths = append(paths, file)
+			}
+
+			records, err := tracing.ReadRecords(txID.String(), paths...)
+			if err != nil {
+				return err
+			}
+			timeline := newTxTimeline(txID, records)
+
+			// the timeline is still printed without the stages, traces are most useful when
+			// switchly does not know the tx
+			res, err := types.NewQueryClient(clientCtx).TxStages(cmd.Context(), &types.QueryTxStagesRequest{
+				TxId: txID.String(),
+			})
+			if err == nil {
+				timeline.Stages, err = clientCtx.Codec.MarshalJSON(res)
+			}
+			if err != nil {
+				timeline.StagesError = err.Error()
+			}
+
+			out, err := json.Marshal(timeline)
+			if err != nil {
+				return fmt.Errorf("fail to marshal timeline: %w", err)
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagTraceFile, nil, "trace file to read in addition to the one of this node, can be repeated")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// defaultTraceFile returns the trace file of this node, if it exists.
+func defaultTraceFile() string {
+	file := os.ExpandEnv(config.GetSwitchly().Telemetry.Tracing.File)
+	if file == "" {
+		return ""
+	}
+	if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+		return ""
+	}
+	return file
+}
+
+func newTxTimeline(txID common.TxID, records []tracing.Record) TxTimeline {
+	timeline := TxTimeline{
+		TxID:    txID.String(),
+		TraceID: tracing.TraceID(txID).String(),
+		Events:  make([]TxTimelineEvent, len(records)),
+	}
+	for i, record := range records {
+		timeline.Events[i] = TxTimelineEvent{
+			Record:  record,
+			Elapsed: record.Start.Sub(records[0].Start).Round(time.Millisecond).String(),
+		}
+	}
+	return timeline
+}
@@ -2,23 +2,29 @@ package ebifrost
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
 const (
-	flagEnabled = "ebifrost.enable"
-	flagAddress = "ebifrost.address"
+	flagEnabled  = "ebifrost.enable"
+	flagAddress  = "ebifrost.address"
+	flagCacheDir = "ebifrost.cache_dir"
 )
 
 type EBifrostConfig struct {
 	Enable       bool          `json:"enable"`
 	Address      string        `json:"address"`
 	CacheItemTTL time.Duration `json:"cache_item_ttl"`
+	// CacheDir is the directory the inject caches are persisted in, so pending items are
+	// replayed after a restart. The caches are kept in memory only when empty.
+	CacheDir string `json:"cache_dir"`
 }
 
 func DefaultEBifrostConfig() EBifrostConfig {
@@ -41,7 +47,11 @@ address = "%s"
 
 # Cache item TTL
 cache_item_ttl = "%s"
-`, c.Enable, c.Address, c.CacheItemTTL.String())
+
+# Directory the pending cache items are persisted in to be replayed after a restart,
+# relative paths are resolved against the node home. Leave empty to keep them in memory only.
+cache_dir = "%s"
+`, c.Enable, c.Address, c.CacheItemTTL.String(), c.CacheDir)
 }
 
 func DefaultConfigTemplate() string {
@@ -55,6 +65,7 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	defaults := DefaultEBifrostConfig()
 	startCmd.Flags().Bool(flagEnabled, defaults.Enable, "Enable the local enshrined bifrost GRPC listener")
 	startCmd.Flags().String(flagAddress, defaults.Address, "Address of the enshrined bifrost GRPC listener")
+	startCmd.Flags().String(flagCacheDir, defaults.CacheDir, "Directory the enshrined bifrost pending cache items are persisted in")
 }
 
 // ReadEBifrostConfig reads the ebifrost specific configuration
@@ -75,5 +86,15 @@ func ReadEBifrostConfig(opts servertypes.AppOptions) (EBifrostConfig, error) {
 		}
 	}
 
+	if v := opts.Get(flagCacheDir); v != nil {
+		var ok bool
+		if cfg.CacheDir, ok = v.(string); !ok {
+			return cfg, fmt.Errorf("expected string for %s, got %T", flagCacheDir, v)
+		}
+		if cfg.CacheDir != "" && !filepath.IsAbs(cfg.CacheDir) {
+			cfg.CacheDir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), cfg.CacheDir)
+		}
+	}
+
 	return cfg, nil
 }
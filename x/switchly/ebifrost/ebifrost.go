@@ -14,9 +14,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 
 	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
 	common "github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -50,8 +54,13 @@ type EnshrinedBifrost struct {
 	solvencyCache   *InjectCache[*common.QuorumSolvency]
 	errataCache     *InjectCache[*common.QuorumErrataTx]
 
-	subscribers   map[string][]chan *EventNotification
-	subscribersMu sync.Mutex
+	// db is the write-ahead store of the caches, nil if they are kept in memory only
+	db dbm.DB
+
+	// quorumTracker reports the progress of the pending items towards their quorum, optional
+	quorumTracker QuorumTracker
+
+	events *eventStream
 
 	started bool
 	mu      sync.Mutex
@@ -73,7 +82,7 @@ func NewEnshrinedBifrost(cdc codec.Codec, logger log.Logger, config EBifrostConf
 		networkFeeCache: NewInjectCache[*common.QuorumNetworkFee](),
 		solvencyCache:   NewInjectCache[*common.QuorumSolvency](),
 		errataCache:     NewInjectCache[*common.QuorumErrataTx](),
-		subscribers:     make(map[string][]chan *EventNotification),
+		events:          newEventStream(eventBufferSize),
 		cfg:             config,
 		stopChan:        make(chan struct{}),
 	}
@@ -94,6 +103,17 @@ func (b *EnshrinedBifrost) Start() error {
 	}
 	b.started = true
 
+	if b.cfg.CacheDir != "" && b.db == nil {
+		db, err := dbm.NewGoLevelDB(injectStoreName, b.cfg.CacheDir, nil)
+		if err != nil {
+			return fmt.Errorf("failed to open enshrined bifrost cache store: %w", err)
+		}
+		if err = b.restoreCaches(db); err != nil {
+			_ = db.Close()
+			return err
+		}
+	}
+
 	lis, err := net.Listen("tcp", b.cfg.Address)
 	if err != nil {
 		return err
@@ -126,6 +146,77 @@ func (b *EnshrinedBifrost) Stop() {
 	close(b.stopChan)
 
 	b.s.Stop()
+
+	if b.db != nil {
+		if err := b.db.Close(); err != nil {
+			b.logger.Error("Failed to close cache store", "error", err)
+		}
+		b.db = nil
+	}
+}
+
+// restoreCaches backs the inject caches with the given db and reloads the items that were
+// pending when the node stopped. Items that outlived the cache TTL while the node was down
+// are pruned right away instead of being injected again.
+func (b *EnshrinedBifrost) restoreCaches(db dbm.DB) error {
+	nTxs, err := b.quorumTxCache.Restore(newInjectStore(db, prefixQuorumTx,
+		(*common.QuorumTx).Marshal,
+		func(bz []byte) (*common.QuorumTx, error) {
+			item := &common.QuorumTx{}
+			return item, item.Unmarshal(bz)
+		},
+		b.logger,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to restore quorum tx cache: %w", err)
+	}
+	nNfs, err := b.networkFeeCache.Restore(newInjectStore(db, prefixNetworkFee,
+		(*common.QuorumNetworkFee).Marshal,
+		func(bz []byte) (*common.QuorumNetworkFee, error) {
+			item := &common.QuorumNetworkFee{}
+			return item, item.Unmarshal(bz)
+		},
+		b.logger,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to restore quorum network fee cache: %w", err)
+	}
+	nSlvs, err := b.solvencyCache.Restore(newInjectStore(db, prefixSolvency,
+		(*common.QuorumSolvency).Marshal,
+		func(bz []byte) (*common.QuorumSolvency, error) {
+			item := &common.QuorumSolvency{}
+			return item, item.Unmarshal(bz)
+		},
+		b.logger,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to restore quorum solvency cache: %w", err)
+	}
+	nEtxs, err := b.errataCache.Restore(newInjectStore(db, prefixErrata,
+		(*common.QuorumErrataTx).Marshal,
+		func(bz []byte) (*common.QuorumErrataTx, error) {
+			item := &common.QuorumErrataTx{}
+			return item, item.Unmarshal(bz)
+		},
+		b.logger,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to restore quorum errata tx cache: %w", err)
+	}
+	b.db = db
+
+	if b.cfg.CacheItemTTL > 0 {
+		b.pruneExpiredItems()
+	}
+
+	b.logger.Info("Restored cache items",
+		"quorum_txs", nTxs,
+		"network_fees", nNfs,
+		"solvencies", nSlvs,
+		"errata_txs", nEtxs,
+	)
+
+	return nil
 }
 
 // startPruneTimer starts a timer to periodically prune expired items from the caches
@@ -142,56 +233,7 @@ func (b *EnshrinedBifrost) startPruneTimer() {
 		for {
 			select {
 			case <-ticker.C:
-				b.logger.Debug("Pruning expired cache items", "ttl", b.cfg.CacheItemTTL.String())
-				prunedTxs := b.quorumTxCache.PruneExpiredItems(b.cfg.CacheItemTTL)
-				prunedNfs := b.networkFeeCache.PruneExpiredItems(b.cfg.CacheItemTTL)
-				prunedSlvs := b.solvencyCache.PruneExpiredItems(b.cfg.CacheItemTTL)
-				prunedEtxs := b.errataCache.PruneExpiredItems(b.cfg.CacheItemTTL)
-
-				for _, tx := range prunedTxs {
-					b.logger.Warn(
-						"EBifrost pruned quorum tx",
-						"attestations", len(tx.Attestations),
-						"chain", tx.ObsTx.Tx.Chain,
-						"hash", tx.ObsTx.Tx.ID,
-						"block_height", tx.ObsTx.BlockHeight,
-						"finalise_height", tx.ObsTx.FinaliseHeight,
-						"from", tx.ObsTx.Tx.FromAddress,
-						"to", tx.ObsTx.Tx.ToAddress,
-						"memo", tx.ObsTx.Tx.Memo,
-						"coins", tx.ObsTx.Tx.Coins.String(),
-						"gas", tx.ObsTx.Tx.Gas.ToCoins().String(),
-						"inbound", tx.Inbound,
-					)
-				}
-				for _, nf := range prunedNfs {
-					b.logger.Warn(
-						"EBifrost pruned quorum network fee",
-						"attestations", len(nf.Attestations),
-						"chain", nf.NetworkFee.Chain,
-						"height", nf.NetworkFee.Height,
-						"tx_size", nf.NetworkFee.TransactionSize,
-						"tx_rate", nf.NetworkFee.TransactionRate,
-					)
-				}
-				for _, slv := range prunedSlvs {
-					b.logger.Warn(
-						"Ebifrost pruned quorum solvency",
-						"attestations", len(slv.Attestations),
-						"chain", slv.Solvency.Chain,
-						"height", slv.Solvency.Height,
-						"pubkey", slv.Solvency.PubKey,
-						"coins", slv.Solvency.Coins.String(),
-					)
-				}
-				for _, etx := range prunedEtxs {
-					b.logger.Warn(
-						"Ebifrost pruned quorum errata tx",
-						"attestations", len(etx.Attestations),
-						"chain", etx.ErrataTx.Chain,
-						"id", etx.ErrataTx.Id,
-					)
-				}
+				b.pruneExpiredItems()
 			case <-b.stopChan:
 				return
 			}
@@ -199,6 +241,60 @@ func (b *EnshrinedBifrost) startPruneTimer() {
 	}()
 }
 
+// pruneExpiredItems prunes the items that have been in the caches longer than the TTL
+func (b *EnshrinedBifrost) pruneExpiredItems() {
+	b.logger.Debug("Pruning expired cache items", "ttl", b.cfg.CacheItemTTL.String())
+	prunedTxs := b.quorumTxCache.PruneExpiredItems(b.cfg.CacheItemTTL)
+	prunedNfs := b.networkFeeCache.PruneExpiredItems(b.cfg.CacheItemTTL)
+	prunedSlvs := b.solvencyCache.PruneExpiredItems(b.cfg.CacheItemTTL)
+	prunedEtxs := b.errataCache.PruneExpiredItems(b.cfg.CacheItemTTL)
+
+	for _, tx := range prunedTxs {
+		b.logger.Warn(
+			"EBifrost pruned quorum tx",
+			"attestations", len(tx.Attestations),
+			"chain", tx.ObsTx.Tx.Chain,
+			"hash", tx.ObsTx.Tx.ID,
+			"block_height", tx.ObsTx.BlockHeight,
+			"finalise_height", tx.ObsTx.FinaliseHeight,
+			"from", tx.ObsTx.Tx.FromAddress,
+			"to", tx.ObsTx.Tx.ToAddress,
+			"memo", tx.ObsTx.Tx.Memo,
+			"coins", tx.ObsTx.Tx.Coins.String(),
+			"gas", tx.ObsTx.Tx.Gas.ToCoins().String(),
+			"inbound", tx.Inbound,
+		)
+	}
+	for _, nf := range prunedNfs {
+		b.logger.Warn(
+			"EBifrost pruned quorum network fee",
+			"attestations", len(nf.Attestations),
+			"chain", nf.NetworkFee.Chain,
+			"height", nf.NetworkFee.Height,
+			"tx_size", nf.NetworkFee.TransactionSize,
+			"tx_rate", nf.NetworkFee.TransactionRate,
+		)
+	}
+	for _, slv := range prunedSlvs {
+		b.logger.Warn(
+			"Ebifrost pruned quorum solvency",
+			"attestations", len(slv.Attestations),
+			"chain", slv.Solvency.Chain,
+			"height", slv.Solvency.Height,
+			"pubkey", slv.Solvency.PubKey,
+			"coins", slv.Solvency.Coins.String(),
+		)
+	}
+	for _, etx := range prunedEtxs {
+		b.logger.Warn(
+			"Ebifrost pruned quorum errata tx",
+			"attestations", len(etx.Attestations),
+			"chain", etx.ErrataTx.Chain,
+			"id", etx.ErrataTx.Id,
+		)
+	}
+}
+
 func (b *EnshrinedBifrost) SendQuorumTx(ctx context.Context, tx *common.QuorumTx) (*SendQuorumTxResult, error) {
 	if err := b.quorumTxCache.AddItem(
 		tx,
@@ -209,6 +305,14 @@ func (b *EnshrinedBifrost) SendQuorumTx(ctx context.Context, tx *common.QuorumTx
 		return nil, err
 	}
 
+	tracing.Event(tracing.CorrelationID(tx.ObsTx.Tx), tracing.StageEBifrostReceived,
+		tracing.AttrChain.String(tx.ObsTx.Tx.Chain.String()),
+		tracing.AttrObservedTx.String(tx.ObsTx.Tx.ID.String()),
+		tracing.AttrInbound.Bool(tx.Inbound),
+		tracing.AttrFinalised.Bool(tx.ObsTx.IsFinal()),
+		tracing.AttrAttestations.Int(len(tx.Attestations)),
+	)
+
 	return &SendQuorumTxResult{}, nil
 }
 
@@ -239,16 +343,10 @@ func (b *EnshrinedBifrost) SendQuorumSolvency(ctx context.Context, s *common.Quo
 }
 
 func (b *EnshrinedBifrost) SendQuorumErrataTx(ctx context.Context, e *common.QuorumErrataTx) (*SendQuorumErrataTxResult, error) {
-	b.quorumTxCache.Lock()
-	for i, item := range b.quorumTxCache.items {
-		tx := item.Item
-		if tx.ObsTx.Tx.Chain == e.ErrataTx.Chain && tx.ObsTx.Tx.ID == e.ErrataTx.Id {
-			// remove the tx from the cache because we observed an error for it
-			b.quorumTxCache.items = append(b.quorumTxCache.items[:i], b.quorumTxCache.items[i+1:]...)
-			break
-		}
-	}
-	b.quorumTxCache.Unlock()
+	// remove the tx from the cache because we observed an error for it
+	b.quorumTxCache.RemoveIf(func(tx *common.QuorumTx) bool {
+		return tx.ObsTx.Tx.Chain == e.ErrataTx.Chain && tx.ObsTx.Tx.ID == e.ErrataTx.Id
+	})
 
 	if err := b.errataCache.AddItem(
 		e,
@@ -262,6 +360,11 @@ func (b *EnshrinedBifrost) SendQuorumErrataTx(ctx context.Context, e *common.Quo
 	return &SendQuorumErrataTxResult{}, nil
 }
 
+// SubscribeToEvents streams the events matching the request filters. When a from sequence is
+// given, the buffered events emitted after it are replayed first so a reconnecting subscriber
+// doesn't miss them, all of them when the sequence was handed out before switchlynode
+// restarted. A subscriber that can't keep up is disconnected and expected to resume from the
+// last sequence it received.
 func (b *EnshrinedBifrost) SubscribeToEvents(req *SubscribeRequest, stream LocalhostBifrost_SubscribeToEventsServer) error {
 	for _, eventType := range req.EventTypes {
 		found := false
@@ -276,83 +379,61 @@ func (b *EnshrinedBifrost) SubscribeToEvents(req *SubscribeRequest, stream Local
 		}
 	}
 
-	eventCh := make(chan *EventNotification)
+	sub, backlog, evicted := b.events.subscribe(newEventFilter(req), req.FromSequence, req.BootId)
+	defer b.events.unsubscribe(sub)
 
-	// Register this client
-	b.subscribersMu.Lock()
-	for _, eventType := range req.EventTypes {
-		b.subscribers[eventType] = append(b.subscribers[eventType], eventCh)
+	if evicted > 0 {
+		b.logger.Warn("Subscriber resumed past the event buffer", "from_sequence", req.FromSequence, "boot_id", req.BootId, "evicted", evicted)
 	}
-	b.subscribersMu.Unlock()
 
-	b.logger.Info("Client subscribed to events", "event_types", req.EventTypes)
+	b.logger.Info("Client subscribed to events",
+		"event_types", req.EventTypes,
+		"chains", req.Chains,
+		"pub_keys", req.PubKeys,
+		"from_sequence", req.FromSequence,
+		"boot_id", req.BootId,
+		"replayed", len(backlog),
+	)
+
+	for _, event := range backlog {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
 
 	// Keep the connection open and forward events to client
 	for {
 		select {
-		case event := <-eventCh:
+		case event := <-sub.ch:
 			if err := stream.Send(event); err != nil {
 				return err
 			}
+		case <-sub.lagged:
+			b.logger.Error("Dropped subscriber that fell behind", "event_types", req.EventTypes)
+			return status.Error(codes.ResourceExhausted, "subscriber fell behind, resume from the last received sequence")
 		case <-stream.Context().Done():
-			// Client disconnected, clean up
-			b.removeSubscriber(eventCh)
+			// Client disconnected
 			return nil
 		}
 	}
 }
 
-// removeSubscriber removes the given channel from all event type subscriptions
-func (b *EnshrinedBifrost) removeSubscriber(ch chan *EventNotification) {
-	b.subscribersMu.Lock()
-	defer b.subscribersMu.Unlock()
-
-	// Iterate through all event types
-	// analyze-ignore(map-iteration)
-	for eventType, subscribers := range b.subscribers {
-		// Create a new slice without the channel we're removing
-		newSubscribers := make([]chan *EventNotification, 0, len(subscribers))
-
-		for _, subCh := range subscribers {
-			if subCh != ch {
-				newSubscribers = append(newSubscribers, subCh)
-			}
-		}
-
-		// Update the subscribers list for this event type
-		if len(newSubscribers) == 0 {
-			// No subscribers left for this event type, remove the key
-			delete(b.subscribers, eventType)
-		} else {
-			b.subscribers[eventType] = newSubscribers
-		}
+// eventBroadcaster returns the broadcast func for events of the given chain and vault
+func (b *EnshrinedBifrost) eventBroadcaster(chain common.Chain, pubKey common.PubKey) func(string, []byte) {
+	return func(eventType string, payload []byte) {
+		b.broadcastEvent(eventType, chain, pubKey, payload)
 	}
-
-	// Close the channel to signal subscribers they won't receive more events
-	close(ch)
 }
 
-func (b *EnshrinedBifrost) broadcastEvent(eventType string, payload []byte) {
-	event := &EventNotification{
+func (b *EnshrinedBifrost) broadcastEvent(eventType string, chain common.Chain, pubKey common.PubKey, payload []byte) {
+	b.events.publish(&EventNotification{
 		EventType: eventType,
 		Payload:   payload,
 		Timestamp: time.Now().Unix(),
-	}
-
-	b.subscribersMu.Lock()
-	subscribers := b.subscribers[eventType]
-	b.subscribersMu.Unlock()
-
-	for _, ch := range subscribers {
-		select {
-		case ch <- event:
-			b.logger.Debug("Event sent to subscriber", "event", eventType)
-			// Event sent successfully
-		default:
-			b.logger.Error("Failed to send event to subscriber", "event", eventType)
-			// Channel is full or closed, could implement cleanup here
-		}
-	}
+		Chain:     chain.String(),
+		PubKey:    pubKey.String(),
+	})
+	b.logger.Debug("Event published", "event", eventType)
 }
 
 func (b *EnshrinedBifrost) broadcastQuorumTxEvent(tx *common.QuorumTx) {
@@ -361,7 +442,7 @@ func (b *EnshrinedBifrost) broadcastQuorumTxEvent(tx *common.QuorumTx) {
 		func(item *common.QuorumTx) ([]byte, error) {
 			return item.Marshal()
 		},
-		b.broadcastEvent,
+		b.eventBroadcaster(tx.ObsTx.Tx.Chain, tx.ObsTx.ObservedPubKey),
 		EventQuorumTxCommitted,
 		b.logger,
 	)
@@ -402,6 +483,16 @@ func (b *EnshrinedBifrost) MarkQuorumTxAttestationsConfirmed(ctx context.Context
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	height := sdkCtx.BlockHeight()
 
+	if sdkCtx.ExecMode() == sdk.ExecModeFinalize {
+		tracing.Event(tracing.CorrelationID(qtx.ObsTx.Tx), tracing.StageEBifrostConfirmed,
+			tracing.AttrChain.String(qtx.ObsTx.Tx.Chain.String()),
+			tracing.AttrObservedTx.String(qtx.ObsTx.Tx.ID.String()),
+			tracing.AttrBlockHeight.Int64(height),
+			tracing.AttrInbound.Bool(qtx.Inbound),
+			tracing.AttrFinalised.Bool(qtx.ObsTx.IsFinal()),
+		)
+	}
+
 	b.quorumTxCache.AddToBlock(height, qtx)
 	b.quorumTxCache.CleanOldBlocks(height, cachedBlocks)
 }
@@ -412,7 +503,7 @@ func (b *EnshrinedBifrost) broadcastQuorumNetworkFeeEvent(nf *common.QuorumNetwo
 		func(item *common.QuorumNetworkFee) ([]byte, error) {
 			return item.Marshal()
 		},
-		b.broadcastEvent,
+		b.eventBroadcaster(nf.NetworkFee.Chain, common.EmptyPubKey),
 		EventQuorumNetworkFeeCommitted,
 		b.logger,
 	)
@@ -467,7 +558,7 @@ func (b *EnshrinedBifrost) broadcastQuorumSolvencyEvent(s *common.QuorumSolvency
 		func(item *common.QuorumSolvency) ([]byte, error) {
 			return item.Marshal()
 		},
-		b.broadcastEvent,
+		b.eventBroadcaster(s.Solvency.Chain, s.Solvency.PubKey),
 		EventQuorumSolvencyCommitted,
 		b.logger,
 	)
@@ -522,7 +613,7 @@ func (b *EnshrinedBifrost) broadcastQuorumErrataTxEvent(qe *common.QuorumErrataT
 		func(item *common.QuorumErrataTx) ([]byte, error) {
 			return item.Marshal()
 		},
-		b.broadcastEvent,
+		b.eventBroadcaster(qe.ErrataTx.Chain, common.EmptyPubKey),
 		EventQuorumErrataTxCommitted,
 		b.logger,
 	)
@@ -596,6 +687,14 @@ func (b *EnshrinedBifrost) ProposalInjectTxs(ctx sdk.Context, maxTxBytes int64)
 				"finalized", obsTx.IsFinal(),
 				"inbound", tx.Inbound,
 				"attestations", len(tx.Attestations))
+			tracing.Event(tracing.CorrelationID(obsTx.Tx), tracing.StageEBifrostInjected,
+				tracing.AttrChain.String(obsTx.Tx.Chain.String()),
+				tracing.AttrObservedTx.String(obsTx.Tx.ID.String()),
+				tracing.AttrBlockHeight.Int64(ctx.BlockHeight()),
+				tracing.AttrInbound.Bool(tx.Inbound),
+				tracing.AttrFinalised.Bool(obsTx.IsFinal()),
+				tracing.AttrAttestations.Int(len(tx.Attestations)),
+			)
 		},
 		b.logger,
 	)
@@ -0,0 +1,186 @@
+package ebifrost
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// eventBufferSize is the number of events kept to replay to resuming subscribers
+	eventBufferSize = 1024
+	// subscriberBufferSize is the number of events queued per subscriber before it's
+	// considered to have fallen behind
+	subscriberBufferSize = 128
+)
+
+// eventFilter is the filter of a subscription, an empty field matches everything
+type eventFilter struct {
+	eventTypes map[string]bool
+	chains     map[string]bool
+	pubKeys    map[string]bool
+}
+
+func newEventFilter(req *SubscribeRequest) eventFilter {
+	toSet := func(values []string, normalize func(string) string) map[string]bool {
+		if len(values) == 0 {
+			return nil
+		}
+		set := make(map[string]bool, len(values))
+		for _, v := range values {
+			set[normalize(v)] = true
+		}
+		return set
+	}
+	identity := func(s string) string { return s }
+	return eventFilter{
+		eventTypes: toSet(req.EventTypes, identity),
+		chains:     toSet(req.Chains, strings.ToUpper),
+		pubKeys:    toSet(req.PubKeys, identity),
+	}
+}
+
+func (f eventFilter) matches(event *EventNotification) bool {
+	if f.eventTypes != nil && !f.eventTypes[event.EventType] {
+		return false
+	}
+	if f.chains != nil && !f.chains[strings.ToUpper(event.Chain)] {
+		return false
+	}
+	if f.pubKeys != nil && !f.pubKeys[event.PubKey] {
+		return false
+	}
+	return true
+}
+
+// eventSubscriber is a single subscription to the event stream
+type eventSubscriber struct {
+	filter eventFilter
+	ch     chan *EventNotification
+	// lagged is closed when the subscriber fell behind and was dropped from the stream
+	lagged chan struct{}
+}
+
+// eventStream assigns sequences to events, keeps the most recent ones in a ring buffer and
+// fans them out to the subscribers whose filter they match. Subscribers that fall behind are
+// dropped rather than blocking the stream, they resume from their last received sequence.
+//
+// Sequences only order the events of a single switchlynode process, every event carries the
+// random boot id of the stream so a subscriber can tell its sequence apart from the ones
+// handed out after a restart.
+type eventStream struct {
+	mu          sync.Mutex
+	bootID      string
+	sequence    uint64
+	buffer      []*EventNotification
+	next        int
+	subscribers []*eventSubscriber
+}
+
+func newEventStream(size int) *eventStream {
+	return &eventStream{
+		bootID: newBootID(),
+		buffer: make([]*EventNotification, 0, size),
+	}
+}
+
+// newBootID returns a random id for the stream of this process
+func newBootID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		// fall back to the boot time, which is unique across restarts as well
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(id)
+}
+
+// publish assigns the next sequence to the event and sends it to the matching subscribers
+func (s *eventStream) publish(event *EventNotification) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sequence++
+	event.Sequence = s.sequence
+	event.BootId = s.bootID
+
+	if len(s.buffer) < cap(s.buffer) {
+		s.buffer = append(s.buffer, event)
+	} else {
+		s.buffer[s.next] = event
+		s.next = (s.next + 1) % cap(s.buffer)
+	}
+
+	subscribers := s.subscribers[:0]
+	for _, sub := range s.subscribers {
+		if !sub.filter.matches(event) {
+			subscribers = append(subscribers, sub)
+			continue
+		}
+		select {
+		case sub.ch <- event:
+			subscribers = append(subscribers, sub)
+		default:
+			close(sub.lagged)
+		}
+	}
+	// clear the dropped subscribers from the tail of the backing array
+	for i := len(subscribers); i < len(s.subscribers); i++ {
+		s.subscribers[i] = nil
+	}
+	s.subscribers = subscribers
+}
+
+// subscribe registers a new subscriber and returns the buffered events after the given
+// sequence that match its filter, along with the number of events after the sequence that
+// were already evicted from the buffer. A sequence of another boot id, or one ahead of the
+// stream, was handed out before a restart, in which case all buffered events are returned.
+func (s *eventStream) subscribe(filter eventFilter, fromSequence uint64, bootID string) (sub *eventSubscriber, backlog []*EventNotification, evicted uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replay := fromSequence > 0
+	if bootID != "" && bootID != s.bootID {
+		replay = true
+		fromSequence = 0
+	}
+	if replay {
+		if fromSequence > s.sequence {
+			fromSequence = 0
+		}
+		if len(s.buffer) > 0 && s.buffer[s.next].Sequence > fromSequence+1 {
+			// the events right after the sequence were evicted from the buffer
+			evicted = s.buffer[s.next].Sequence - fromSequence - 1
+		}
+		for i := 0; i < len(s.buffer); i++ {
+			event := s.buffer[(s.next+i)%len(s.buffer)]
+			if event.Sequence > fromSequence && filter.matches(event) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	sub = &eventSubscriber{
+		filter: filter,
+		ch:     make(chan *EventNotification, subscriberBufferSize),
+		lagged: make(chan struct{}),
+	}
+	s.subscribers = append(s.subscribers, sub)
+
+	return sub, backlog, evicted
+}
+
+// unsubscribe removes the subscriber from the stream
+func (s *eventStream) unsubscribe(sub *eventSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.subscribers {
+		if existing == sub {
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+			return
+		}
+	}
+}
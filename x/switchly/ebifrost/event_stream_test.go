@@ -0,0 +1,123 @@
+package ebifrost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventFilter(t *testing.T) {
+	event := &EventNotification{
+		EventType: EventQuorumSolvencyCommitted,
+		Chain:     "BTC",
+		PubKey:    "pubkey1",
+	}
+
+	require.True(t, newEventFilter(&SubscribeRequest{}).matches(event))
+	require.True(t, newEventFilter(&SubscribeRequest{
+		EventTypes: []string{EventQuorumTxCommitted, EventQuorumSolvencyCommitted},
+		Chains:     []string{"btc"},
+		PubKeys:    []string{"pubkey1"},
+	}).matches(event))
+	require.False(t, newEventFilter(&SubscribeRequest{
+		EventTypes: []string{EventQuorumTxCommitted},
+	}).matches(event))
+	require.False(t, newEventFilter(&SubscribeRequest{
+		Chains: []string{"ETH"},
+	}).matches(event))
+	require.False(t, newEventFilter(&SubscribeRequest{
+		PubKeys: []string{"pubkey2"},
+	}).matches(event))
+
+	// events without a vault don't match a pubkey filter
+	event.PubKey = ""
+	require.False(t, newEventFilter(&SubscribeRequest{
+		PubKeys: []string{"pubkey1"},
+	}).matches(event))
+}
+
+func TestEventStreamResume(t *testing.T) {
+	stream := newEventStream(4)
+	btcOnly := newEventFilter(&SubscribeRequest{Chains: []string{"BTC"}})
+
+	live, backlog, evicted := stream.subscribe(btcOnly, 0, "")
+	require.Empty(t, backlog)
+	require.Zero(t, evicted)
+
+	for _, chain := range []string{"BTC", "ETH", "BTC"} {
+		stream.publish(&EventNotification{EventType: EventQuorumTxCommitted, Chain: chain})
+	}
+
+	// live subscribers only receive the events matching their filter
+	require.Len(t, live.ch, 2)
+	require.Equal(t, uint64(1), (<-live.ch).Sequence)
+	require.Equal(t, uint64(3), (<-live.ch).Sequence)
+
+	// a resuming subscriber gets the matching buffered events after its sequence
+	_, backlog, evicted = stream.subscribe(btcOnly, 1, stream.bootID)
+	require.Len(t, backlog, 1)
+	require.Equal(t, uint64(3), backlog[0].Sequence)
+	require.Zero(t, evicted)
+
+	// overflow the ring buffer
+	for i := 0; i < 3; i++ {
+		stream.publish(&EventNotification{EventType: EventQuorumTxCommitted, Chain: "ETH"})
+	}
+	_, backlog, evicted = stream.subscribe(newEventFilter(&SubscribeRequest{}), 1, stream.bootID)
+	require.Len(t, backlog, 4)
+	require.Equal(t, uint64(3), backlog[0].Sequence)
+	require.Equal(t, uint64(6), backlog[3].Sequence)
+	require.Equal(t, uint64(1), evicted)
+
+	// a sequence ahead of the stream was handed out before a restart, replay everything
+	_, backlog, _ = stream.subscribe(newEventFilter(&SubscribeRequest{}), 100, "")
+	require.Len(t, backlog, 4)
+}
+
+func TestEventStreamReplaysAfterRestart(t *testing.T) {
+	before := newEventStream(4)
+	before.publish(&EventNotification{EventType: EventQuorumTxCommitted, Chain: "BTC"})
+	before.publish(&EventNotification{EventType: EventQuorumTxCommitted, Chain: "BTC"})
+	require.NotEmpty(t, before.buffer[1].BootId)
+	require.Equal(t, before.bootID, before.buffer[1].BootId)
+
+	// the restarted stream hands out the same sequences again under a new boot id
+	stream := newEventStream(4)
+	require.NotEqual(t, before.bootID, stream.bootID)
+	for i := 0; i < 3; i++ {
+		stream.publish(&EventNotification{EventType: EventQuorumTxCommitted, Chain: "BTC"})
+	}
+	require.Equal(t, stream.bootID, stream.buffer[0].BootId)
+
+	// a subscriber resuming from sequence 2 of the previous boot gets every buffered event
+	_, backlog, evicted := stream.subscribe(newEventFilter(&SubscribeRequest{}), 2, before.bootID)
+	require.Len(t, backlog, 3)
+	require.Equal(t, uint64(1), backlog[0].Sequence)
+	require.Zero(t, evicted)
+
+	// while one of the current boot only gets the events after its sequence
+	_, backlog, _ = stream.subscribe(newEventFilter(&SubscribeRequest{}), 2, stream.bootID)
+	require.Len(t, backlog, 1)
+	require.Equal(t, uint64(3), backlog[0].Sequence)
+}
+
+func TestEventStreamDropsLaggingSubscriber(t *testing.T) {
+	stream := newEventStream(eventBufferSize)
+	sub, _, _ := stream.subscribe(newEventFilter(&SubscribeRequest{}), 0, "")
+	other, _, _ := stream.subscribe(newEventFilter(&SubscribeRequest{Chains: []string{"ETH"}}), 0, "")
+
+	for i := 0; i <= subscriberBufferSize; i++ {
+		stream.publish(&EventNotification{EventType: EventQuorumTxCommitted, Chain: "BTC"})
+	}
+
+	select {
+	case <-sub.lagged:
+	default:
+		t.Fatal("lagging subscriber wasn't dropped")
+	}
+	require.Len(t, stream.subscribers, 1)
+	require.Equal(t, other, stream.subscribers[0])
+
+	stream.unsubscribe(other)
+	require.Empty(t, stream.subscribers)
+}
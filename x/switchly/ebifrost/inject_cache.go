@@ -11,12 +11,23 @@ import (
 type TimestampedItem[T any] struct {
 	Item      T
 	Timestamp time.Time
+	// FailedHeight is the height of the last block in which the injected tx carrying the item
+	// failed, zero if it never did.
+	FailedHeight int64
+
+	// seq is the insertion sequence of the item, used as its key in the store
+	seq uint64
 }
 
 type InjectCache[T any] struct {
 	items []TimestampedItem[T]
 	mu    *PriorityRWLock
 
+	// store is the optional write-ahead store the items are persisted in, nil if the cache is
+	// in memory only. Changes are queued with the lock held and flushed by unlock.
+	store   *injectStore[T]
+	nextSeq uint64
+
 	// recentBlockItems is a map of block height to items that were included in that block.
 	// This is used to keep track of recently processed items so we don't reprocess them.
 	recentBlockItems map[int64][]T
@@ -34,12 +45,90 @@ func NewInjectCache[T any]() *InjectCache[T] {
 // Add adds an item to the cache
 func (c *InjectCache[T]) Add(item T) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
+
+	c.appendItem(item)
+}
 
+// appendItem appends a new item to the cache and persists it, the lock must be held
+func (c *InjectCache[T]) appendItem(item T) {
+	c.nextSeq++
 	c.items = append(c.items, TimestampedItem[T]{
 		Item:      item,
 		Timestamp: time.Now(),
+		seq:       c.nextSeq,
 	})
+	c.persist(len(c.items) - 1)
+}
+
+// persist queues the write of the item at the given index to the store, the lock must be held
+func (c *InjectCache[T]) persist(index int) {
+	if c.store == nil {
+		return
+	}
+	c.store.Set(c.items[index])
+}
+
+// unlock releases the lock and then writes the changes made while it was held to the store,
+// so readers of the cache don't wait on disk I/O. Writes that fail stay queued and are retried
+// by the next unlock.
+func (c *InjectCache[T]) unlock() {
+	c.mu.Unlock()
+	if c.store == nil {
+		return
+	}
+	if err := c.store.Flush(); err != nil {
+		c.store.logger.Error("Failed to flush inject cache, retrying on next change", "error", err)
+	}
+}
+
+// removeItem removes the item at the given index from the cache and queues its removal from the
+// store, the lock must be held
+func (c *InjectCache[T]) removeItem(index int) {
+	if c.store != nil {
+		c.store.Delete(c.items[index].seq)
+	}
+	c.items = append(c.items[:index], c.items[index+1:]...)
+}
+
+// Restore backs the cache with the given store and loads the items persisted in it. Restored
+// items keep their original timestamps, so PruneExpiredItems applies the TTL across restarts,
+// and the height their injected tx last failed at.
+func (c *InjectCache[T]) Restore(store *injectStore[T]) (int, error) {
+	stored, err := store.Load()
+	if err != nil {
+		return 0, err
+	}
+
+	c.mu.Lock()
+	defer c.unlock()
+
+	c.store = store
+	for _, s := range stored {
+		c.items = append(c.items, s)
+		if s.seq > c.nextSeq {
+			c.nextSeq = s.seq
+		}
+	}
+
+	// persist the items that were added before the store was attached
+	for i := 0; i < len(c.items)-len(stored); i++ {
+		c.nextSeq++
+		c.items[i].seq = c.nextSeq
+		c.persist(i)
+	}
+
+	return len(stored), nil
+}
+
+// Pending returns a copy of the items in the cache along with their metadata
+func (c *InjectCache[T]) Pending() []TimestampedItem[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	result := make([]TimestampedItem[T], len(c.items))
+	copy(result, c.items)
+	return result
 }
 
 // Get returns all items in the cache (thread-safe)
@@ -61,19 +150,51 @@ func (c *InjectCache[T]) Lock() {
 
 // Unlock unlocks the mutex
 func (c *InjectCache[T]) Unlock() {
-	c.mu.Unlock()
+	c.unlock()
 }
 
 // RemoveAt removes the item at the given index
 func (c *InjectCache[T]) RemoveAt(index int) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	if index < 0 || index >= len(c.items) {
 		return
 	}
 
-	c.items = append(c.items[:index], c.items[index+1:]...)
+	c.removeItem(index)
+}
+
+// RemoveIf removes the first item matching the provided predicate, returns true if an item was removed
+func (c *InjectCache[T]) RemoveIf(matches func(T) bool) bool {
+	c.mu.Lock()
+	defer c.unlock()
+
+	for i, item := range c.items {
+		if matches(item.Item) {
+			c.removeItem(i)
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarkFailed records the height at which the injected tx carrying the item failed, so the
+// pending item can be reported with the reason it hasn't been committed.
+func (c *InjectCache[T]) MarkFailed(item T, equals func(T, T) bool, height int64) bool {
+	c.mu.Lock()
+	defer c.unlock()
+
+	for i := range c.items {
+		if equals(c.items[i].Item, item) {
+			c.items[i].FailedHeight = height
+			c.persist(i)
+			return true
+		}
+	}
+
+	return false
 }
 
 // AddToBlock adds items to the specified block height
@@ -117,21 +238,19 @@ func (c *InjectCache[T]) CheckRecentBlocks(matches func(T) bool) bool {
 // MergeWithExisting tries to merge an item with an existing one or adds it as new
 func (c *InjectCache[T]) MergeWithExisting(item T, equals func(T, T) bool, merge func(existing T, new T)) bool {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	for i, existing := range c.items {
 		if equals(existing.Item, item) {
 			merge(c.items[i].Item, item)
 			// Update the timestamp since we modified the item
 			c.items[i].Timestamp = time.Now()
+			c.persist(i)
 			return true
 		}
 	}
 
-	c.items = append(c.items, TimestampedItem[T]{
-		Item:      item,
-		Timestamp: time.Now(),
-	})
+	c.appendItem(item)
 	return false
 }
 
@@ -146,7 +265,7 @@ func (c *InjectCache[T]) MarkAttestationsConfirmed(
 	logInfo func(T, log.Logger),
 ) bool {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	found := false
 	for i := 0; i < len(c.items); i++ {
@@ -156,10 +275,11 @@ func (c *InjectCache[T]) MarkAttestationsConfirmed(
 			logInfo(cacheItem, logger)
 			if empty := removeAttestations(cacheItem, getAttestations(item)); empty {
 				// Remove the element at index i
-				c.items = append(c.items[:i], c.items[i+1:]...)
+				c.removeItem(i)
 			} else {
 				// Update the timestamp since we modified the item
 				c.items[i].Timestamp = time.Now()
+				c.persist(i)
 			}
 			break
 		}
@@ -284,7 +404,7 @@ func (c *InjectCache[T]) ProcessForProposal(
 // PruneExpiredItems removes items that have been in the cache longer than the TTL
 func (c *InjectCache[T]) PruneExpiredItems(ttl time.Duration) []T {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	now := time.Now()
 	var newItems []TimestampedItem[T]
@@ -296,6 +416,9 @@ func (c *InjectCache[T]) PruneExpiredItems(ttl time.Duration) []T {
 			newItems = append(newItems, item)
 		} else {
 			prunedItems = append(prunedItems, item.Item)
+			if c.store != nil {
+				c.store.Delete(item.seq)
+			}
 		}
 	}
 
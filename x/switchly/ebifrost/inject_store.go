@@ -0,0 +1,188 @@
+package ebifrost
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	// injectStoreName is the name of the leveldb the inject caches are persisted in
+	injectStoreName = "ebifrost"
+
+	prefixQuorumTx   = "qtx/"
+	prefixNetworkFee = "qnf/"
+	prefixSolvency   = "qslv/"
+	prefixErrata     = "qetx/"
+)
+
+// injectStore is the write-ahead store behind an InjectCache. Every change to the items of the
+// cache is queued while the cache lock is held and written once it is released, in a single
+// synced batch per change, so the pending items survive a restart of the node and are replayed
+// on the next Start() without holding up the readers of the cache on disk I/O.
+//
+// Items are keyed by the store prefix and the insertion sequence of the item, so they load in
+// the order they were added. The value is the unix nano timestamp of the item, the height its
+// injected tx last failed at and the marshaled item, which lets the cache TTL be applied to
+// restored items.
+type injectStore[T any] struct {
+	db        dbm.DB
+	prefix    []byte
+	marshal   func(T) ([]byte, error)
+	unmarshal func([]byte) (T, error)
+	logger    log.Logger
+
+	// mu guards pending, the writes queued in the order the cache changed
+	mu      sync.Mutex
+	pending []storeWrite
+	// flushMu serializes the flushes, so the queued writes hit the db in order
+	flushMu sync.Mutex
+}
+
+// storeWrite is a queued write of the store, a nil value deletes the key
+type storeWrite struct {
+	key   []byte
+	value []byte
+}
+
+// storeHeaderLen is the length of the timestamp and failed height preceding the item
+const storeHeaderLen = 16
+
+func newInjectStore[T any](
+	db dbm.DB,
+	prefix string,
+	marshal func(T) ([]byte, error),
+	unmarshal func([]byte) (T, error),
+	logger log.Logger,
+) *injectStore[T] {
+	return &injectStore[T]{
+		db:        db,
+		prefix:    []byte(prefix),
+		marshal:   marshal,
+		unmarshal: unmarshal,
+		logger:    logger,
+	}
+}
+
+func (s *injectStore[T]) key(seq uint64) []byte {
+	key := make([]byte, len(s.prefix)+8)
+	copy(key, s.prefix)
+	binary.BigEndian.PutUint64(key[len(s.prefix):], seq)
+	return key
+}
+
+// Set queues the write of the item. The item is marshaled right away, as the cache keeps
+// modifying it once its lock is released.
+func (s *injectStore[T]) Set(item TimestampedItem[T]) {
+	bz, err := s.marshal(item.Item)
+	if err != nil {
+		s.logger.Error("Failed to marshal inject cache item", "error", err)
+		return
+	}
+	value := make([]byte, storeHeaderLen+len(bz))
+	binary.BigEndian.PutUint64(value, uint64(item.Timestamp.UnixNano()))
+	binary.BigEndian.PutUint64(value[8:], uint64(item.FailedHeight))
+	copy(value[storeHeaderLen:], bz)
+	s.queue(storeWrite{key: s.key(item.seq), value: value})
+}
+
+// Delete queues the removal of the item with the given sequence
+func (s *injectStore[T]) Delete(seq uint64) {
+	s.queue(storeWrite{key: s.key(seq)})
+}
+
+func (s *injectStore[T]) queue(w storeWrite) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, w)
+}
+
+// Flush writes the queued writes to the db in a single synced batch. If the batch fails the
+// writes are put back in front of the queue, so the next flush retries them in order.
+func (s *injectStore[T]) Flush() error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	s.mu.Lock()
+	writes := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	if len(writes) == 0 {
+		return nil
+	}
+
+	if err := s.write(writes); err != nil {
+		s.mu.Lock()
+		s.pending = append(writes, s.pending...)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (s *injectStore[T]) write(writes []storeWrite) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, w := range writes {
+		var err error
+		if w.value == nil {
+			err = batch.Delete(w.key)
+		} else {
+			err = batch.Set(w.key, w.value)
+		}
+		if err != nil {
+			return fmt.Errorf("fail to batch inject cache write: %w", err)
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("fail to persist inject cache items: %w", err)
+	}
+	return nil
+}
+
+// Load returns all items of the store in insertion order. Items that fail to decode are
+// dropped from the store rather than failing the load, as they would never be injected.
+func (s *injectStore[T]) Load() ([]TimestampedItem[T], error) {
+	iter, err := dbm.IteratePrefix(s.db, s.prefix)
+	if err != nil {
+		return nil, fmt.Errorf("fail to iterate inject store: %w", err)
+	}
+	defer iter.Close()
+
+	var items []TimestampedItem[T]
+	var corrupted [][]byte
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		if len(key) != len(s.prefix)+8 || len(value) < storeHeaderLen {
+			corrupted = append(corrupted, append([]byte{}, key...))
+			continue
+		}
+		item, err := s.unmarshal(value[storeHeaderLen:])
+		if err != nil {
+			s.logger.Error("Failed to unmarshal inject cache item", "error", err)
+			corrupted = append(corrupted, append([]byte{}, key...))
+			continue
+		}
+		items = append(items, TimestampedItem[T]{
+			Item:         item,
+			Timestamp:    time.Unix(0, int64(binary.BigEndian.Uint64(value))),
+			FailedHeight: int64(binary.BigEndian.Uint64(value[8:])),
+			seq:          binary.BigEndian.Uint64(key[len(s.prefix):]),
+		})
+	}
+	if err = iter.Error(); err != nil {
+		return nil, fmt.Errorf("fail to iterate inject store: %w", err)
+	}
+
+	for _, key := range corrupted {
+		if err = s.db.DeleteSync(key); err != nil {
+			s.logger.Error("Failed to delete corrupted inject cache item", "error", err)
+		}
+	}
+
+	return items, nil
+}
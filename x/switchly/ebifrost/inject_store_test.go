@@ -0,0 +1,277 @@
+package ebifrost
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+
+	common "github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+func newTestEnshrinedBifrost(ttl time.Duration) *EnshrinedBifrost {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	return NewEnshrinedBifrost(cdc, log.NewNopLogger(), EBifrostConfig{
+		Enable:       true,
+		Address:      "localhost:50051",
+		CacheItemTTL: ttl,
+	})
+}
+
+// TestRestoreCaches tests that pending items are replayed after a restart
+func TestRestoreCaches(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	ebs := newTestEnshrinedBifrost(time.Hour)
+	require.NoError(t, ebs.restoreCaches(db))
+
+	tx1 := createTestQuorumTx(common.BTCChain, "hash1", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	tx2 := createTestQuorumTx(common.ETHChain, "hash2", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	_, err := ebs.SendQuorumTx(context.Background(), tx1)
+	require.NoError(t, err)
+	_, err = ebs.SendQuorumTx(context.Background(), tx2)
+	require.NoError(t, err)
+
+	// a new attestation for tx1 is persisted with it
+	_, err = ebs.SendQuorumTx(context.Background(), createTestQuorumTx(common.BTCChain, "hash1", true, []*common.Attestation{
+		createTestAttestation("pubkey2", "sig2"),
+	}))
+	require.NoError(t, err)
+
+	_, err = ebs.SendQuorumNetworkFee(context.Background(), &common.QuorumNetworkFee{
+		NetworkFee: &common.NetworkFee{
+			Chain:           common.BTCChain,
+			Height:          100,
+			TransactionSize: 250,
+			TransactionRate: 10,
+		},
+		Attestations: []*common.Attestation{createTestAttestation("pubkey1", "sig1")},
+	})
+	require.NoError(t, err)
+
+	// tx2 is committed, so it's removed from the store as well
+	ebs.MarkQuorumTxAttestationsConfirmed(createTestSDKContext(10), tx2)
+
+	// the failure of the injected tx carrying tx1 is persisted with it
+	ebs.MarkInjectedMsgFailed(createTestSDKContext(11), types.NewMsgObservedTxQuorum(tx1, ebifrostSignerAcc))
+
+	restarted := newTestEnshrinedBifrost(time.Hour)
+	require.NoError(t, restarted.restoreCaches(db))
+
+	pending := restarted.quorumTxCache.Get()
+	require.Len(t, pending, 1)
+	require.True(t, pending[0].Equals(tx1))
+	require.Len(t, pending[0].Attestations, 2)
+	require.Equal(t, int64(11), restarted.quorumTxCache.Pending()[0].FailedHeight)
+	require.Len(t, restarted.networkFeeCache.Get(), 1)
+	require.Empty(t, restarted.solvencyCache.Get())
+	require.Empty(t, restarted.errataCache.Get())
+
+	// items added after the restart don't overwrite the restored ones
+	tx3 := createTestQuorumTx(common.ETHChain, "hash3", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	_, err = restarted.SendQuorumTx(context.Background(), tx3)
+	require.NoError(t, err)
+	restarted.quorumTxCache.RemoveIf(func(tx *common.QuorumTx) bool {
+		return tx.Equals(tx1)
+	})
+
+	restarted = newTestEnshrinedBifrost(time.Hour)
+	require.NoError(t, restarted.restoreCaches(db))
+	pending = restarted.quorumTxCache.Get()
+	require.Len(t, pending, 1)
+	require.True(t, pending[0].Equals(tx3))
+}
+
+// TestRestoreCachesPrunesExpiredItems tests that items which outlived the TTL while the node was down are pruned on load
+func TestRestoreCachesPrunesExpiredItems(t *testing.T) {
+	db := dbm.NewMemDB()
+	ebs := newTestEnshrinedBifrost(time.Minute)
+	logger := log.NewNopLogger()
+
+	store := newInjectStore(db, prefixQuorumTx,
+		(*common.QuorumTx).Marshal,
+		func(bz []byte) (*common.QuorumTx, error) {
+			item := &common.QuorumTx{}
+			return item, item.Unmarshal(bz)
+		},
+		logger,
+	)
+	expired := createTestQuorumTx(common.BTCChain, "expired", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	fresh := createTestQuorumTx(common.BTCChain, "fresh", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	store.Set(TimestampedItem[*common.QuorumTx]{Item: expired, Timestamp: time.Now().Add(-2 * time.Minute), seq: 1})
+	store.Set(TimestampedItem[*common.QuorumTx]{Item: fresh, Timestamp: time.Now().Add(-30 * time.Second), seq: 2})
+	require.NoError(t, store.Flush())
+	// corrupted items are dropped
+	require.NoError(t, db.Set(store.key(3), []byte{1, 2, 3}))
+
+	require.NoError(t, ebs.restoreCaches(db))
+
+	pending := ebs.quorumTxCache.Get()
+	require.Len(t, pending, 1)
+	require.True(t, pending[0].Equals(fresh))
+
+	stored, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.Equal(t, uint64(2), stored[0].seq)
+}
+
+// failingDB is a db whose batches fail to write while fail is set
+type failingDB struct {
+	*dbm.MemDB
+	fail bool
+}
+
+func (db *failingDB) NewBatch() dbm.Batch {
+	return failingBatch{Batch: db.MemDB.NewBatch(), db: db}
+}
+
+type failingBatch struct {
+	dbm.Batch
+	db *failingDB
+}
+
+func (b failingBatch) WriteSync() error {
+	if b.db.fail {
+		return errors.New("disk full")
+	}
+	return b.Batch.WriteSync()
+}
+
+// TestFlushRetriesFailedWrites tests that writes are kept queued when the batch fails to write
+func TestFlushRetriesFailedWrites(t *testing.T) {
+	db := &failingDB{MemDB: dbm.NewMemDB(), fail: true}
+	store := newInjectStore(db, prefixQuorumTx,
+		(*common.QuorumTx).Marshal,
+		func(bz []byte) (*common.QuorumTx, error) {
+			item := &common.QuorumTx{}
+			return item, item.Unmarshal(bz)
+		},
+		log.NewNopLogger(),
+	)
+	tx1 := createTestQuorumTx(common.BTCChain, "hash1", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	tx2 := createTestQuorumTx(common.BTCChain, "hash2", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	store.Set(TimestampedItem[*common.QuorumTx]{Item: tx1, Timestamp: time.Now(), seq: 1})
+	require.Error(t, store.Flush())
+
+	stored, err := store.Load()
+	require.NoError(t, err)
+	require.Empty(t, stored)
+
+	// writes queued after the failure land behind the failed ones
+	store.Set(TimestampedItem[*common.QuorumTx]{Item: tx2, Timestamp: time.Now(), seq: 2})
+	store.Delete(1)
+	db.fail = false
+	require.NoError(t, store.Flush())
+
+	stored, err = store.Load()
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.True(t, stored[0].Item.Equals(tx2))
+}
+
+// testQuorumTracker reports the quorum tx status for quorum txs and the other status for the rest
+type testQuorumTracker [2]QuorumStatus
+
+func (t testQuorumTracker) QuorumTxStatus(*common.QuorumTx) (QuorumStatus, error) {
+	return t[0], nil
+}
+
+func (t testQuorumTracker) NetworkFeeStatus(*common.QuorumNetworkFee) (QuorumStatus, error) {
+	return t[1], nil
+}
+
+func (t testQuorumTracker) SolvencyStatus(*common.QuorumSolvency) (QuorumStatus, error) {
+	return t[1], nil
+}
+
+func (t testQuorumTracker) ErrataTxStatus(*common.QuorumErrataTx) (QuorumStatus, error) {
+	return t[1], nil
+}
+
+// TestGetPendingItems tests that the pending items are listed with the reason they haven't been committed
+func TestGetPendingItems(t *testing.T) {
+	ebs := newTestEnshrinedBifrost(time.Hour)
+
+	tx1 := createTestQuorumTx(common.BTCChain, "hash1", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+		createTestAttestation("pubkey2", "sig2"),
+	})
+	tx2 := createTestQuorumTx(common.ETHChain, "hash2", true, []*common.Attestation{
+		createTestAttestation("pubkey1", "sig1"),
+	})
+	_, err := ebs.SendQuorumTx(context.Background(), tx1)
+	require.NoError(t, err)
+	_, err = ebs.SendQuorumTx(context.Background(), tx2)
+	require.NoError(t, err)
+	_, err = ebs.SendQuorumErrataTx(context.Background(), &common.QuorumErrataTx{
+		ErrataTx:     &common.ErrataTx{Chain: common.BTCChain, Id: "hash3"},
+		Attestations: []*common.Attestation{createTestAttestation("pubkey1", "sig1")},
+	})
+	require.NoError(t, err)
+
+	ebs.MarkInjectedMsgFailed(createTestSDKContext(42), types.NewMsgObservedTxQuorum(tx2, ebifrostSignerAcc))
+
+	resp, err := ebs.GetPendingItems(context.Background(), &PendingItemsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 3)
+
+	require.Equal(t, PendingItemQuorumTx, resp.Items[0].ItemType)
+	require.Equal(t, "BTC", resp.Items[0].Chain)
+	require.Equal(t, "hash1", resp.Items[0].Id)
+	require.Len(t, resp.Items[0].Attesters, 2)
+	require.Zero(t, resp.Items[0].FailedHeight)
+	require.Equal(t, "observed inbound tx quorum: 2 attestations pending, awaiting injection in the next proposed block", resp.Items[0].Reason)
+	require.Greater(t, resp.Items[0].ExpiresIn, int64(0))
+
+	require.Equal(t, "hash2", resp.Items[1].Id)
+	require.Equal(t, int64(42), resp.Items[1].FailedHeight)
+	require.Equal(t, "observed inbound tx quorum: 1 attestations pending, injected tx failed at height 42, retrying", resp.Items[1].Reason)
+
+	require.Equal(t, PendingItemQuorumErrataTx, resp.Items[2].ItemType)
+	require.Equal(t, "hash3", resp.Items[2].Id)
+
+	// with the chain state at hand, the reason says how far the item is from its quorum
+	ebs.SetQuorumTracker(testQuorumTracker{
+		QuorumStatus{Signers: 1, Required: 5},
+		QuorumStatus{Signers: 5, Required: 5},
+	})
+	resp, err = ebs.GetPendingItems(context.Background(), &PendingItemsRequest{
+		ItemTypes: []string{PendingItemQuorumTx, PendingItemQuorumErrataTx},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "observed inbound tx quorum short by 4 signers (1/5 committed, 2 attestations pending), awaiting injection in the next proposed block", resp.Items[0].Reason)
+	require.Equal(t, "errata tx quorum reached with 5/5 signers, 1 late attestations pending, awaiting injection in the next proposed block", resp.Items[2].Reason)
+
+	resp, err = ebs.GetPendingItems(context.Background(), &PendingItemsRequest{
+		ItemTypes: []string{PendingItemQuorumErrataTx},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+
+	_, err = ebs.GetPendingItems(context.Background(), &PendingItemsRequest{
+		ItemTypes: []string{"unknown"},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,204 @@
+package ebifrost
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	common "github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+const (
+	PendingItemQuorumTx         = "quorum_tx"
+	PendingItemQuorumNetworkFee = "quorum_network_fee"
+	PendingItemQuorumSolvency   = "quorum_solvency"
+	PendingItemQuorumErrataTx   = "quorum_errata_tx"
+)
+
+var pendingItemTypes = []string{PendingItemQuorumTx, PendingItemQuorumNetworkFee, PendingItemQuorumSolvency, PendingItemQuorumErrataTx}
+
+// QuorumStatus is the progress of a pending item towards its quorum on chain
+type QuorumStatus struct {
+	// Signers is the number of active validators whose attestation of the item has been committed
+	Signers int
+	// Required is the number of signers the item needs to reach its quorum
+	Required int
+}
+
+// QuorumTracker looks up the committed progress of the pending items towards their quorum. It is
+// provided by the app, as the caches have no access to the chain state.
+type QuorumTracker interface {
+	QuorumTxStatus(qtx *common.QuorumTx) (QuorumStatus, error)
+	NetworkFeeStatus(qnf *common.QuorumNetworkFee) (QuorumStatus, error)
+	SolvencyStatus(qs *common.QuorumSolvency) (QuorumStatus, error)
+	ErrataTxStatus(qe *common.QuorumErrataTx) (QuorumStatus, error)
+}
+
+// SetQuorumTracker sets the tracker used to report how far the pending items are from their quorum
+func (b *EnshrinedBifrost) SetQuorumTracker(tracker QuorumTracker) {
+	if b == nil {
+		return
+	}
+	b.quorumTracker = tracker
+}
+
+// MarkInjectedMsgFailed is intended to be called by the bifrost post handler when an injected tx failed.
+// The items stay in the caches to be injected again, the failure is recorded so it can be reported.
+func (b *EnshrinedBifrost) MarkInjectedMsgFailed(ctx sdk.Context, msg sdk.Msg) {
+	if b == nil {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	switch m := msg.(type) {
+	case *types.MsgObservedTxQuorum:
+		b.quorumTxCache.MarkFailed(m.QuoTx, (*common.QuorumTx).Equals, height)
+	case *types.MsgNetworkFeeQuorum:
+		b.networkFeeCache.MarkFailed(m.QuoNetFee, (*common.QuorumNetworkFee).Equals, height)
+	case *types.MsgSolvencyQuorum:
+		b.solvencyCache.MarkFailed(m.QuoSolvency, (*common.QuorumSolvency).Equals, height)
+	case *types.MsgErrataTxQuorum:
+		b.errataCache.MarkFailed(m.QuoErrata, (*common.QuorumErrataTx).Equals, height)
+	}
+}
+
+// GetPendingItems lists the items waiting in the inject caches, along with the attestations that
+// haven't been committed yet and the reason the items haven't been committed.
+func (b *EnshrinedBifrost) GetPendingItems(ctx context.Context, req *PendingItemsRequest) (*PendingItemsResponse, error) {
+	itemTypes := req.ItemTypes
+	if len(itemTypes) == 0 {
+		itemTypes = pendingItemTypes
+	}
+
+	now := time.Now()
+	resp := &PendingItemsResponse{}
+	for _, itemType := range itemTypes {
+		switch itemType {
+		case PendingItemQuorumTx:
+			for _, item := range b.quorumTxCache.Pending() {
+				tx := item.Item.ObsTx.Tx
+				quorum := "observed outbound tx"
+				if item.Item.Inbound {
+					quorum = "observed inbound tx"
+				}
+				var status func() (QuorumStatus, error)
+				if b.quorumTracker != nil {
+					status = func() (QuorumStatus, error) { return b.quorumTracker.QuorumTxStatus(item.Item) }
+				}
+				resp.Items = append(resp.Items, b.newPendingItem(itemType, quorum, tx.Chain, tx.ID.String(), item.Item.Attestations, item.Timestamp, item.FailedHeight, now, status))
+			}
+		case PendingItemQuorumNetworkFee:
+			for _, item := range b.networkFeeCache.Pending() {
+				nf := item.Item.NetworkFee
+				var status func() (QuorumStatus, error)
+				if b.quorumTracker != nil {
+					status = func() (QuorumStatus, error) { return b.quorumTracker.NetworkFeeStatus(item.Item) }
+				}
+				resp.Items = append(resp.Items, b.newPendingItem(itemType, "network fee", nf.Chain, fmt.Sprintf("%d", nf.Height), item.Item.Attestations, item.Timestamp, item.FailedHeight, now, status))
+			}
+		case PendingItemQuorumSolvency:
+			for _, item := range b.solvencyCache.Pending() {
+				s := item.Item.Solvency
+				var status func() (QuorumStatus, error)
+				if b.quorumTracker != nil {
+					status = func() (QuorumStatus, error) { return b.quorumTracker.SolvencyStatus(item.Item) }
+				}
+				resp.Items = append(resp.Items, b.newPendingItem(itemType, "solvency", s.Chain, fmt.Sprintf("%d", s.Height), item.Item.Attestations, item.Timestamp, item.FailedHeight, now, status))
+			}
+		case PendingItemQuorumErrataTx:
+			for _, item := range b.errataCache.Pending() {
+				e := item.Item.ErrataTx
+				var status func() (QuorumStatus, error)
+				if b.quorumTracker != nil {
+					status = func() (QuorumStatus, error) { return b.quorumTracker.ErrataTxStatus(item.Item) }
+				}
+				resp.Items = append(resp.Items, b.newPendingItem(itemType, "errata tx", e.Chain, e.Id.String(), item.Item.Attestations, item.Timestamp, item.FailedHeight, now, status))
+			}
+		default:
+			return nil, fmt.Errorf("unknown item type: %s", itemType)
+		}
+	}
+
+	return resp, nil
+}
+
+// newPendingItem builds the pending item of the given quorum. The reason names the quorum and,
+// when the status of the item on chain can be looked up, how many signers it is short of it.
+func (b *EnshrinedBifrost) newPendingItem(
+	itemType string,
+	quorum string,
+	chain common.Chain,
+	id string,
+	attestations []*common.Attestation,
+	updated time.Time,
+	failedHeight int64,
+	now time.Time,
+	status func() (QuorumStatus, error),
+) *PendingItem {
+	item := &PendingItem{
+		ItemType:     itemType,
+		Chain:        chain.String(),
+		Id:           id,
+		Updated:      updated.Unix(),
+		FailedHeight: failedHeight,
+	}
+
+	for _, att := range attestations {
+		item.Attesters = append(item.Attesters, attesterPubKey(att))
+	}
+
+	if b.cfg.CacheItemTTL > 0 {
+		expiresIn := b.cfg.CacheItemTTL - now.Sub(updated)
+		if expiresIn > 0 {
+			item.ExpiresIn = int64(expiresIn.Seconds())
+		}
+	}
+
+	progress := fmt.Sprintf("%s quorum: %d attestations pending", quorum, len(attestations))
+	if status != nil {
+		st, err := status()
+		if err != nil {
+			b.logger.Error("Failed to get quorum status of pending item", "type", itemType, "id", id, "error", err)
+		} else {
+			progress = quorumProgress(quorum, st, len(attestations))
+		}
+	}
+
+	switch {
+	case len(attestations) == 0:
+		item.Reason = fmt.Sprintf("%s, no attestations left to commit", progress)
+	case failedHeight > 0:
+		item.Reason = fmt.Sprintf("%s, injected tx failed at height %d, retrying", progress, failedHeight)
+	default:
+		item.Reason = fmt.Sprintf("%s, awaiting injection in the next proposed block", progress)
+	}
+
+	return item
+}
+
+// quorumProgress describes how far the item is from its quorum, counting the committed signers
+func quorumProgress(quorum string, st QuorumStatus, pending int) string {
+	if st.Signers >= st.Required {
+		return fmt.Sprintf("%s quorum reached with %d/%d signers, %d late attestations pending", quorum, st.Signers, st.Required, pending)
+	}
+	return fmt.Sprintf("%s quorum short by %d signers (%d/%d committed, %d attestations pending)", quorum, st.Required-st.Signers, st.Signers, st.Required, pending)
+}
+
+// attesterPubKey returns the bech32 account pubkey of the node that made the attestation
+func attesterPubKey(att *common.Attestation) string {
+	if att == nil {
+		return ""
+	}
+	pk := secp256k1.PubKey{Key: att.PubKey}
+	bech32Pub, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, &pk)
+	if err != nil {
+		return hex.EncodeToString(att.PubKey)
+	}
+	return bech32Pub
+}
@@ -16,7 +16,7 @@ func NewEnshrineBifrostPostDecorator(eb *EnshrinedBifrost) *EnshrinedBifrostPost
 }
 
 func (e *EnshrinedBifrostPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
-	if simulate || !success {
+	if simulate {
 		return next(ctx, tx, simulate, success)
 	}
 
@@ -24,6 +24,14 @@ func (e *EnshrinedBifrostPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, s
 		return next(ctx, tx, simulate, success)
 	}
 
+	// if the injected tx failed, the items stay pending, record the failure so it can be reported.
+	if !success {
+		for _, msg := range tx.GetMsgs() {
+			e.EnshrinedBifrost.MarkInjectedMsgFailed(ctx, msg)
+		}
+		return next(ctx, tx, simulate, success)
+	}
+
 	// if the tx is a wInjectTx, then we need to inform enshrined bifrost that the tx has been processed.
 	for _, msg := range tx.GetMsgs() {
 		switch m := msg.(type) {
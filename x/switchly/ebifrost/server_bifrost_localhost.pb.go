@@ -66,8 +66,20 @@ func (m *SendQuorumTxResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_SendQuorumTxResult proto.InternalMessageInfo
 
 type SubscribeRequest struct {
-	// Optional fields to filter what events to subscribe to
+	// Optional fields to filter what events to subscribe to, all events if empty
 	EventTypes []string `protobuf:"bytes,1,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Optional chains to filter events by, all chains if empty
+	Chains []string `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+	// Optional vault pubkeys to filter events by, events without a vault are
+	// filtered out when set
+	PubKeys []string `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// Resume the subscription after the given sequence, replaying the buffered
+	// events that were emitted since. Zero to only receive new events.
+	FromSequence uint64 `protobuf:"varint,4,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Boot id of the stream from_sequence was received from. When it doesn't
+	// match the current one switchlynode restarted since, and all the buffered
+	// events are replayed.
+	BootId string `protobuf:"bytes,5,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
@@ -110,10 +122,46 @@ func (m *SubscribeRequest) GetEventTypes() []string {
 	return nil
 }
 
+func (m *SubscribeRequest) GetChains() []string {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetFromSequence() uint64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetBootId() string {
+	if m != nil {
+		return m.BootId
+	}
+	return ""
+}
+
 type EventNotification struct {
 	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
 	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// monotonically increasing sequence of the event, restarts from 1 when
+	// switchlynode restarts
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Chain    string `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
+	// vault the event relates to, empty if none
+	PubKey string `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// random id of the switchlynode process the sequence was assigned by
+	BootId string `protobuf:"bytes,7,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`
 }
 
 func (m *EventNotification) Reset()         { *m = EventNotification{} }
@@ -170,6 +218,34 @@ func (m *EventNotification) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *EventNotification) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventNotification) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *EventNotification) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *EventNotification) GetBootId() string {
+	if m != nil {
+		return m.BootId
+	}
+	return ""
+}
+
 // SendQuorumNetworkFeeResult is the empty return type
 type SendQuorumNetworkFeeResult struct {
 }
@@ -281,6 +357,202 @@ func (m *SendQuorumErrataTxResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SendQuorumErrataTxResult proto.InternalMessageInfo
 
+type PendingItemsRequest struct {
+	// Optional fields to filter what item types to list
+	ItemTypes []string `protobuf:"bytes,1,rep,name=item_types,json=itemTypes,proto3" json:"item_types,omitempty"`
+}
+
+func (m *PendingItemsRequest) Reset()         { *m = PendingItemsRequest{} }
+func (m *PendingItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingItemsRequest) ProtoMessage()    {}
+func (*PendingItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7177b2ff595545a4, []int{6}
+}
+func (m *PendingItemsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingItemsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingItemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingItemsRequest.Merge(m, src)
+}
+func (m *PendingItemsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingItemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingItemsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingItemsRequest proto.InternalMessageInfo
+
+func (m *PendingItemsRequest) GetItemTypes() []string {
+	if m != nil {
+		return m.ItemTypes
+	}
+	return nil
+}
+
+type PendingItem struct {
+	// quorum_tx, quorum_network_fee, quorum_solvency or quorum_errata_tx
+	ItemType string `protobuf:"bytes,1,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	Chain    string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	// tx hash, network fee / solvency height or errata tx id
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// public keys of the nodes whose attestations haven't been committed yet
+	Attesters []string `protobuf:"bytes,4,rep,name=attesters,proto3" json:"attesters,omitempty"`
+	// unix time the item was last updated
+	Updated int64 `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	// seconds until the item is pruned, 0 if items don't expire
+	ExpiresIn int64 `protobuf:"varint,6,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// height of the last block in which the injected tx carrying the item failed
+	FailedHeight int64 `protobuf:"varint,7,opt,name=failed_height,json=failedHeight,proto3" json:"failed_height,omitempty"`
+	// why the item has not been committed yet
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PendingItem) Reset()         { *m = PendingItem{} }
+func (m *PendingItem) String() string { return proto.CompactTextString(m) }
+func (*PendingItem) ProtoMessage()    {}
+func (*PendingItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7177b2ff595545a4, []int{7}
+}
+func (m *PendingItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingItem.Merge(m, src)
+}
+func (m *PendingItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingItem proto.InternalMessageInfo
+
+func (m *PendingItem) GetItemType() string {
+	if m != nil {
+		return m.ItemType
+	}
+	return ""
+}
+
+func (m *PendingItem) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *PendingItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PendingItem) GetAttesters() []string {
+	if m != nil {
+		return m.Attesters
+	}
+	return nil
+}
+
+func (m *PendingItem) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *PendingItem) GetExpiresIn() int64 {
+	if m != nil {
+		return m.ExpiresIn
+	}
+	return 0
+}
+
+func (m *PendingItem) GetFailedHeight() int64 {
+	if m != nil {
+		return m.FailedHeight
+	}
+	return 0
+}
+
+func (m *PendingItem) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PendingItemsResponse struct {
+	Items []*PendingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (m *PendingItemsResponse) Reset()         { *m = PendingItemsResponse{} }
+func (m *PendingItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingItemsResponse) ProtoMessage()    {}
+func (*PendingItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7177b2ff595545a4, []int{8}
+}
+func (m *PendingItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingItemsResponse.Merge(m, src)
+}
+func (m *PendingItemsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingItemsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingItemsResponse proto.InternalMessageInfo
+
+func (m *PendingItemsResponse) GetItems() []*PendingItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SendQuorumTxResult)(nil), "types.SendQuorumTxResult")
 	proto.RegisterType((*SubscribeRequest)(nil), "types.SubscribeRequest")
@@ -288,6 +560,9 @@ func init() {
 	proto.RegisterType((*SendQuorumNetworkFeeResult)(nil), "types.SendQuorumNetworkFeeResult")
 	proto.RegisterType((*SendQuorumSolvencyResult)(nil), "types.SendQuorumSolvencyResult")
 	proto.RegisterType((*SendQuorumErrataTxResult)(nil), "types.SendQuorumErrataTxResult")
+	proto.RegisterType((*PendingItemsRequest)(nil), "types.PendingItemsRequest")
+	proto.RegisterType((*PendingItem)(nil), "types.PendingItem")
+	proto.RegisterType((*PendingItemsResponse)(nil), "types.PendingItemsResponse")
 }
 
 func init() {
@@ -295,35 +570,52 @@ func init() {
 }
 
 var fileDescriptor_7177b2ff595545a4 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xb3, 0x44, 0x80, 0x32, 0xf4, 0x90, 0x2e, 0x15, 0x2c, 0x56, 0x71, 0x43, 0xc4, 0x21,
-	0xa7, 0x18, 0x91, 0x23, 0x07, 0xa4, 0x4a, 0x45, 0x1c, 0xa0, 0x12, 0x4e, 0x2e, 0x70, 0x89, 0x6c,
-	0x67, 0x4a, 0x16, 0x6c, 0x4f, 0xd8, 0x5d, 0xa7, 0xcd, 0x3b, 0x70, 0xe0, 0xb1, 0x38, 0xf6, 0xc8,
-	0x11, 0x25, 0x2f, 0x52, 0xc5, 0xf1, 0xda, 0x75, 0x9c, 0x93, 0xb5, 0xff, 0xec, 0xfc, 0x9a, 0xf9,
-	0xfc, 0x2f, 0xbc, 0x36, 0xab, 0x05, 0x6a, 0x4f, 0xa3, 0x5a, 0xa2, 0x9a, 0x86, 0xf2, 0x4a, 0x91,
-	0x36, 0xd3, 0x98, 0xa2, 0x20, 0x9e, 0x93, 0x36, 0xc3, 0x85, 0x22, 0x43, 0xfc, 0x61, 0x7e, 0xcb,
-	0x79, 0x1a, 0x51, 0x92, 0x50, 0xea, 0xed, 0x3e, 0xbb, 0x5a, 0xff, 0x04, 0xf8, 0x18, 0xd3, 0xd9,
-	0x97, 0x8c, 0x54, 0x96, 0x4c, 0x6e, 0x7c, 0xd4, 0x59, 0x6c, 0xfa, 0x23, 0xe8, 0x8e, 0xb3, 0x50,
-	0x47, 0x4a, 0x86, 0xe8, 0xe3, 0xaf, 0x0c, 0xb5, 0xe1, 0x67, 0xf0, 0x04, 0x97, 0x98, 0x9a, 0x69,
-	0xee, 0x26, 0x58, 0xaf, 0x3d, 0xe8, 0xf8, 0x90, 0x4b, 0x93, 0xad, 0xd2, 0xff, 0x01, 0xc7, 0x17,
-	0xdb, 0xd3, 0x25, 0x19, 0x79, 0x25, 0xa3, 0xc0, 0x48, 0x4a, 0xf9, 0x4b, 0x80, 0xaa, 0x4b, 0xb0,
-	0x1e, 0x1b, 0x74, 0xfc, 0x4e, 0xd9, 0xc4, 0x05, 0x3c, 0x5e, 0x04, 0xab, 0x98, 0x82, 0x99, 0x78,
-	0xd0, 0x63, 0x83, 0x23, 0xdf, 0x1e, 0xf9, 0x29, 0x74, 0x8c, 0x4c, 0x50, 0x9b, 0x20, 0x59, 0x88,
-	0x76, 0x8f, 0x0d, 0xda, 0x7e, 0x25, 0xf4, 0x4f, 0xc1, 0xa9, 0xc6, 0xbe, 0x44, 0x73, 0x4d, 0xea,
-	0xe7, 0x07, 0xc4, 0x62, 0x7c, 0x07, 0x44, 0x55, 0x1d, 0x53, 0xbc, 0xc4, 0x34, 0x5a, 0x1d, 0xaa,
-	0x5d, 0x28, 0x15, 0x98, 0xc0, 0xae, 0xfd, 0xf6, 0x77, 0x1b, 0xba, 0x9f, 0x2c, 0xbc, 0xf3, 0x1d,
-	0x4d, 0xfe, 0x0e, 0x8e, 0xee, 0x13, 0xe2, 0xdd, 0x61, 0x01, 0xd0, 0x2a, 0xce, 0x8b, 0x61, 0x8e,
-	0x64, 0xd8, 0x04, 0xc9, 0xc7, 0x70, 0x72, 0x68, 0x4e, 0x2e, 0xea, 0x26, 0x55, 0xc5, 0x79, 0xd5,
-	0x30, 0xdb, 0x5f, 0x8f, 0x7f, 0xbe, 0xff, 0xcf, 0xec, 0x7a, 0xfc, 0x59, 0xdd, 0xd2, 0xea, 0xce,
-	0x59, 0xc3, 0xb0, 0x4e, 0xa4, 0x6e, 0x67, 0x89, 0xec, 0xdb, 0x59, 0xfd, 0x80, 0x5d, 0x1d, 0x22,
-	0xff, 0x08, 0xc7, 0x65, 0x76, 0x26, 0x94, 0x27, 0x42, 0xf3, 0xe7, 0xb6, 0x6b, 0x2f, 0x55, 0x8e,
-	0x28, 0x0a, 0x8d, 0xe4, 0xbc, 0x61, 0xe7, 0x5f, 0xff, 0xae, 0x5d, 0x76, 0xbb, 0x76, 0xd9, 0xff,
-	0xb5, 0xcb, 0xfe, 0x6c, 0xdc, 0xd6, 0xed, 0xc6, 0x6d, 0xfd, 0xdb, 0xb8, 0xad, 0x6f, 0xef, 0xbf,
-	0x4b, 0x33, 0xcf, 0xc2, 0xed, 0x88, 0x9e, 0xbe, 0x96, 0x26, 0x9a, 0xc7, 0xab, 0x3c, 0xcf, 0x11,
-	0xc5, 0xa5, 0x90, 0xd2, 0x0c, 0xbd, 0xe5, 0xc8, 0xbb, 0x29, 0x15, 0x0f, 0x8b, 0x27, 0x12, 0x3e,
-	0xca, 0x6f, 0x8f, 0xee, 0x02, 0x00, 0x00, 0xff, 0xff, 0x36, 0x92, 0x7f, 0x14, 0x41, 0x03, 0x00,
-	0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcb, 0x52, 0xdb, 0x4a,
+	0x10, 0x45, 0x16, 0xc6, 0x76, 0xe3, 0x7b, 0x2f, 0x0c, 0x2e, 0x10, 0x02, 0x8c, 0xaf, 0xef, 0x5d,
+	0x78, 0x65, 0xa7, 0x20, 0xbb, 0x2c, 0x92, 0xa2, 0x8a, 0x04, 0xf2, 0xa0, 0x12, 0x99, 0x4d, 0xb2,
+	0x51, 0xe9, 0xd1, 0xe0, 0x29, 0x24, 0x8d, 0xa2, 0x19, 0x19, 0xfc, 0x17, 0xf9, 0x88, 0x7c, 0x4c,
+	0x56, 0x29, 0x96, 0x2c, 0x53, 0x90, 0x0f, 0x49, 0x69, 0xf4, 0xb0, 0x65, 0x7b, 0xa5, 0xea, 0xd3,
+	0xa3, 0x33, 0xdd, 0xe7, 0x74, 0x0f, 0xfc, 0x2f, 0x26, 0x21, 0xf2, 0x01, 0xc7, 0x68, 0x8c, 0x91,
+	0x69, 0xd3, 0xab, 0x88, 0x71, 0x61, 0x7a, 0xcc, 0xb1, 0xbc, 0x11, 0xe3, 0xa2, 0x1f, 0x46, 0x4c,
+	0x30, 0x52, 0x95, 0xa7, 0xf4, 0x2d, 0x87, 0xf9, 0x3e, 0x0b, 0x06, 0xe9, 0x27, 0xcd, 0x75, 0x5b,
+	0x40, 0x86, 0x18, 0xb8, 0x9f, 0x62, 0x16, 0xc5, 0xfe, 0xe5, 0x9d, 0x81, 0x3c, 0xf6, 0x44, 0xf7,
+	0xbb, 0x02, 0x1b, 0xc3, 0xd8, 0xe6, 0x4e, 0x44, 0x6d, 0x34, 0xf0, 0x6b, 0x8c, 0x5c, 0x90, 0x43,
+	0x58, 0xc7, 0x31, 0x06, 0xc2, 0x94, 0x74, 0x9a, 0xd2, 0x51, 0x7b, 0x0d, 0x03, 0x24, 0x74, 0x99,
+	0x20, 0x64, 0x1b, 0xd6, 0x9c, 0x91, 0x45, 0x03, 0xae, 0x55, 0x64, 0x2e, 0x8b, 0xc8, 0x2e, 0xd4,
+	0xc3, 0xd8, 0x36, 0x6f, 0x70, 0xc2, 0x35, 0x55, 0x66, 0x6a, 0x61, 0x6c, 0xbf, 0xc3, 0x09, 0x27,
+	0xff, 0xc1, 0x5f, 0x57, 0x11, 0xf3, 0x4d, 0x9e, 0xdc, 0x11, 0x38, 0xa8, 0xad, 0x76, 0x94, 0xde,
+	0xaa, 0xd1, 0x4c, 0xc0, 0x61, 0x86, 0x91, 0x1d, 0xa8, 0xd9, 0x8c, 0x09, 0x93, 0xba, 0x5a, 0xb5,
+	0xa3, 0x24, 0xc4, 0x49, 0x78, 0xee, 0x76, 0x7f, 0x2a, 0xb0, 0x79, 0x9a, 0xdc, 0x7f, 0xc1, 0x04,
+	0xbd, 0xa2, 0x8e, 0x25, 0x28, 0x0b, 0xc8, 0x01, 0xc0, 0xb4, 0x4e, 0x4d, 0x91, 0x7f, 0x34, 0x8a,
+	0x32, 0x89, 0x06, 0xb5, 0xd0, 0x9a, 0x78, 0xcc, 0x72, 0xb5, 0x4a, 0x47, 0xe9, 0x35, 0x8d, 0x3c,
+	0x24, 0xfb, 0xd0, 0x10, 0xd4, 0x47, 0x2e, 0x2c, 0x3f, 0xd4, 0xd4, 0x8e, 0xd2, 0x53, 0x8d, 0x29,
+	0x40, 0x74, 0xa8, 0xcf, 0x55, 0x59, 0xc4, 0xa4, 0x05, 0x55, 0xd9, 0x6b, 0x56, 0x5f, 0x1a, 0x24,
+	0x75, 0x67, 0x7d, 0x6b, 0x6b, 0x69, 0xdd, 0x69, 0xdb, 0xb3, 0x0d, 0xd5, 0x4a, 0x0d, 0xed, 0x83,
+	0x3e, 0x75, 0xe3, 0x02, 0xc5, 0x2d, 0x8b, 0x6e, 0x5e, 0x23, 0x66, 0xae, 0xe8, 0xa0, 0x4d, 0xb3,
+	0x43, 0xe6, 0x8d, 0x31, 0x70, 0x26, 0xcb, 0x72, 0xa7, 0x51, 0x64, 0x09, 0xab, 0x70, 0xf3, 0x39,
+	0x6c, 0x7d, 0xc4, 0xc0, 0xa5, 0xc1, 0xf5, 0xb9, 0x40, 0x9f, 0xe7, 0x7e, 0x1e, 0x00, 0x50, 0x81,
+	0x7e, 0xc9, 0xce, 0x46, 0x82, 0x48, 0x37, 0xbb, 0xbf, 0x15, 0x58, 0x9f, 0xf9, 0x8d, 0xec, 0x41,
+	0xa3, 0x38, 0x9e, 0xa9, 0x5a, 0xcf, 0x4f, 0x4f, 0x05, 0xa8, 0xcc, 0x0a, 0xf0, 0x37, 0x54, 0xa8,
+	0x2b, 0x95, 0x6c, 0x18, 0x15, 0x2a, 0x05, 0xb6, 0x84, 0x40, 0x2e, 0x30, 0xe2, 0xda, 0x6a, 0x7a,
+	0x61, 0x01, 0x24, 0xc6, 0xc4, 0xa1, 0x6b, 0x09, 0x4c, 0x6d, 0x56, 0x8d, 0x3c, 0x94, 0x8e, 0xde,
+	0x85, 0x34, 0x42, 0x6e, 0xd2, 0x40, 0x6a, 0xa9, 0x1a, 0x8d, 0x0c, 0x39, 0x0f, 0xe4, 0x10, 0x59,
+	0xd4, 0x43, 0xd7, 0x1c, 0x21, 0xbd, 0x1e, 0x09, 0x29, 0xaa, 0x6a, 0x34, 0x53, 0xf0, 0x4c, 0x62,
+	0xc9, 0x70, 0x46, 0x68, 0x71, 0x16, 0x68, 0xf5, 0x54, 0xf2, 0x34, 0xea, 0xbe, 0x82, 0x56, 0x59,
+	0x1c, 0x1e, 0xb2, 0x80, 0x23, 0xe9, 0x41, 0x35, 0xe9, 0x2e, 0x15, 0x66, 0xfd, 0x88, 0xf4, 0xa5,
+	0x4c, 0xfd, 0x99, 0xb3, 0x46, 0x7a, 0xe0, 0xe8, 0x41, 0x85, 0x8d, 0xf7, 0xf9, 0xca, 0x9d, 0xa4,
+	0x3b, 0x48, 0x5e, 0x40, 0x73, 0x76, 0xaf, 0xc8, 0x46, 0x3f, 0x5b, 0xbb, 0x1c, 0xd1, 0x77, 0x33,
+	0xc6, 0xc5, 0xf5, 0x23, 0x43, 0x68, 0x2d, 0x1b, 0x03, 0xa2, 0x95, 0x49, 0xa6, 0x19, 0xfd, 0xdf,
+	0x05, 0xb2, 0xf9, 0xe9, 0x21, 0x1f, 0x80, 0x2c, 0x4e, 0x0f, 0xd9, 0x2e, 0x53, 0xe6, 0xb8, 0x7e,
+	0xb8, 0x40, 0x58, 0x1e, 0xb8, 0x32, 0x5d, 0x3e, 0x70, 0xf3, 0x74, 0x39, 0xbe, 0x84, 0xae, 0x3c,
+	0xa3, 0xe4, 0x0c, 0x36, 0x8b, 0x07, 0xe7, 0x92, 0xc9, 0xa5, 0xe6, 0x64, 0x27, 0xff, 0x6b, 0xee,
+	0x29, 0xd2, 0xb5, 0x2c, 0xb1, 0xb0, 0xfc, 0xcf, 0x14, 0xf2, 0x16, 0xfe, 0x79, 0x83, 0x62, 0xd6,
+	0x53, 0xa2, 0x2f, 0x9a, 0x97, 0x6f, 0x81, 0xbe, 0xb7, 0x34, 0x97, 0x0e, 0xc1, 0xc9, 0xe7, 0x1f,
+	0x8f, 0x6d, 0xe5, 0xfe, 0xb1, 0xad, 0xfc, 0x7a, 0x6c, 0x2b, 0xdf, 0x9e, 0xda, 0x2b, 0xf7, 0x4f,
+	0xed, 0x95, 0x87, 0xa7, 0xf6, 0xca, 0x97, 0x97, 0xd7, 0x54, 0x8c, 0x62, 0x3b, 0x69, 0x77, 0xc0,
+	0x6f, 0xa9, 0x70, 0x46, 0xde, 0x44, 0xbe, 0xa8, 0x0e, 0xf3, 0x0a, 0x20, 0x60, 0x2e, 0x0e, 0xc6,
+	0xc7, 0x83, 0xbb, 0x02, 0x19, 0x60, 0xf6, 0x48, 0xdb, 0x6b, 0xf2, 0xf4, 0xf1, 0x9f, 0x01, 0x00,
+	0x53, 0x8d, 0x42, 0xfe, 0xc3, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +636,8 @@ type LocalhostBifrostClient interface {
 	SendQuorumErrataTx(ctx context.Context, in *common.QuorumErrataTx, opts ...grpc.CallOption) (*SendQuorumErrataTxResult, error)
 	// Server streaming for notifications
 	SubscribeToEvents(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (LocalhostBifrost_SubscribeToEventsClient, error)
+	// Lists the items waiting in the inject caches and why they haven't been committed yet
+	GetPendingItems(ctx context.Context, in *PendingItemsRequest, opts ...grpc.CallOption) (*PendingItemsResponse, error)
 }
 
 type localhostBifrostClient struct {
@@ -422,6 +716,15 @@ func (x *localhostBifrostSubscribeToEventsClient) Recv() (*EventNotification, er
 	return m, nil
 }
 
+func (c *localhostBifrostClient) GetPendingItems(ctx context.Context, in *PendingItemsRequest, opts ...grpc.CallOption) (*PendingItemsResponse, error) {
+	out := new(PendingItemsResponse)
+	err := c.cc.Invoke(ctx, "/types.LocalhostBifrost/GetPendingItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LocalhostBifrostServer is the server API for LocalhostBifrost service.
 type LocalhostBifrostServer interface {
 	SendQuorumTx(context.Context, *common.QuorumTx) (*SendQuorumTxResult, error)
@@ -430,6 +733,8 @@ type LocalhostBifrostServer interface {
 	SendQuorumErrataTx(context.Context, *common.QuorumErrataTx) (*SendQuorumErrataTxResult, error)
 	// Server streaming for notifications
 	SubscribeToEvents(*SubscribeRequest, LocalhostBifrost_SubscribeToEventsServer) error
+	// Lists the items waiting in the inject caches and why they haven't been committed yet
+	GetPendingItems(context.Context, *PendingItemsRequest) (*PendingItemsResponse, error)
 }
 
 // UnimplementedLocalhostBifrostServer can be embedded to have forward compatible implementations.
@@ -451,6 +756,9 @@ func (*UnimplementedLocalhostBifrostServer) SendQuorumErrataTx(ctx context.Conte
 func (*UnimplementedLocalhostBifrostServer) SubscribeToEvents(req *SubscribeRequest, srv LocalhostBifrost_SubscribeToEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToEvents not implemented")
 }
+func (*UnimplementedLocalhostBifrostServer) GetPendingItems(ctx context.Context, req *PendingItemsRequest) (*PendingItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingItems not implemented")
+}
 
 func RegisterLocalhostBifrostServer(s grpc1.Server, srv LocalhostBifrostServer) {
 	s.RegisterService(&_LocalhostBifrost_serviceDesc, srv)
@@ -549,6 +857,25 @@ func (x *localhostBifrostSubscribeToEventsServer) Send(m *EventNotification) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _LocalhostBifrost_GetPendingItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalhostBifrostServer).GetPendingItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.LocalhostBifrost/GetPendingItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalhostBifrostServer).GetPendingItems(ctx, req.(*PendingItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var LocalhostBifrost_serviceDesc = _LocalhostBifrost_serviceDesc
 var _LocalhostBifrost_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.LocalhostBifrost",
 	HandlerType: (*LocalhostBifrostServer)(nil),
@@ -569,6 +896,10 @@ var _LocalhostBifrost_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendQuorumErrataTx",
 			Handler:    _LocalhostBifrost_SendQuorumErrataTx_Handler,
 		},
+		{
+			MethodName: "GetPendingItems",
+			Handler:    _LocalhostBifrost_GetPendingItems_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -623,6 +954,36 @@ func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BootId) > 0 {
+		i -= len(m.BootId)
+		copy(dAtA[i:], m.BootId)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.BootId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FromSequence != 0 {
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(m.FromSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Chains[iNdEx])
+			copy(dAtA[i:], m.Chains[iNdEx])
+			i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.Chains[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.EventTypes) > 0 {
 		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EventTypes[iNdEx])
@@ -655,6 +1016,32 @@ func (m *EventNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BootId) > 0 {
+		i -= len(m.BootId)
+		copy(dAtA[i:], m.BootId)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.BootId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -746,57 +1133,235 @@ func (m *SendQuorumErrataTxResult) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintServerBifrostLocalhost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovServerBifrostLocalhost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingItemsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SendQuorumTxResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *PendingItemsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscribeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingItemsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.EventTypes) > 0 {
-		for _, s := range m.EventTypes {
-			l = len(s)
-			n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	if len(m.ItemTypes) > 0 {
+		for iNdEx := len(m.ItemTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ItemTypes[iNdEx])
+			copy(dAtA[i:], m.ItemTypes[iNdEx])
+			i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.ItemTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNotification) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EventType)
-	if l > 0 {
-		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	if m.FailedHeight != 0 {
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(m.FailedHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovServerBifrostLocalhost(uint64(m.Timestamp))
+	if m.ExpiresIn != 0 {
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(m.ExpiresIn))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Updated != 0 {
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(m.Updated))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Attesters) > 0 {
+		for iNdEx := len(m.Attesters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attesters[iNdEx])
+			copy(dAtA[i:], m.Attesters[iNdEx])
+			i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.Attesters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ItemType) > 0 {
+		i -= len(m.ItemType)
+		copy(dAtA[i:], m.ItemType)
+		i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(len(m.ItemType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServerBifrostLocalhost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServerBifrostLocalhost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServerBifrostLocalhost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SendQuorumTxResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+		}
+	}
+	if len(m.Chains) > 0 {
+		for _, s := range m.Chains {
+			l = len(s)
+			n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+		}
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+		}
+	}
+	if m.FromSequence != 0 {
+		n += 1 + sovServerBifrostLocalhost(uint64(m.FromSequence))
+	}
+	l = len(m.BootId)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServerBifrostLocalhost(uint64(m.Timestamp))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovServerBifrostLocalhost(uint64(m.Sequence))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	l = len(m.BootId)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
 	}
 	return n
 }
@@ -828,13 +1393,680 @@ func (m *SendQuorumErrataTxResult) Size() (n int) {
 	return n
 }
 
+func (m *PendingItemsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ItemTypes) > 0 {
+		for _, s := range m.ItemTypes {
+			l = len(s)
+			n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ItemType)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	if len(m.Attesters) > 0 {
+		for _, s := range m.Attesters {
+			l = len(s)
+			n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+		}
+	}
+	if m.Updated != 0 {
+		n += 1 + sovServerBifrostLocalhost(uint64(m.Updated))
+	}
+	if m.ExpiresIn != 0 {
+		n += 1 + sovServerBifrostLocalhost(uint64(m.ExpiresIn))
+	}
+	if m.FailedHeight != 0 {
+		n += 1 + sovServerBifrostLocalhost(uint64(m.FailedHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovServerBifrostLocalhost(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovServerBifrostLocalhost(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozServerBifrostLocalhost(x uint64) (n int) {
 	return sovServerBifrostLocalhost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *SendQuorumTxResult) Unmarshal(dAtA []byte) error {
+func (m *SendQuorumTxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServerBifrostLocalhost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendQuorumTxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendQuorumTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServerBifrostLocalhost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+			}
+			m.FromSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BootId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNotification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServerBifrostLocalhost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNotification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BootId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendQuorumNetworkFeeResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServerBifrostLocalhost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendQuorumNetworkFeeResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendQuorumNetworkFeeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendQuorumSolvencyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServerBifrostLocalhost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendQuorumSolvencyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendQuorumSolvencyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendQuorumErrataTxResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -857,10 +2089,10 @@ func (m *SendQuorumTxResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendQuorumTxResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendQuorumErrataTxResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendQuorumTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendQuorumErrataTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -884,7 +2116,7 @@ func (m *SendQuorumTxResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingItemsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -907,15 +2139,15 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingItemsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingItemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -943,7 +2175,7 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			m.ItemTypes = append(m.ItemTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -966,7 +2198,7 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNotification) Unmarshal(dAtA []byte) error {
+func (m *PendingItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -989,15 +2221,15 @@ func (m *EventNotification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNotification: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1025,13 +2257,13 @@ func (m *EventNotification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventType = string(dAtA[iNdEx:postIndex])
+			m.ItemType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServerBifrostLocalhost
@@ -1041,31 +2273,29 @@ func (m *EventNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServerBifrostLocalhost
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServerBifrostLocalhost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServerBifrostLocalhost
@@ -1075,61 +2305,145 @@ func (m *EventNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthServerBifrostLocalhost
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SendQuorumNetworkFeeResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServerBifrostLocalhost
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Attesters = append(m.Attesters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SendQuorumNetworkFeeResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendQuorumNetworkFeeResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			m.ExpiresIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedHeight", wireType)
+			}
+			m.FailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
@@ -1151,7 +2465,7 @@ func (m *SendQuorumNetworkFeeResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendQuorumSolvencyResult) Unmarshal(dAtA []byte) error {
+func (m *PendingItemsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1174,62 +2488,46 @@ func (m *SendQuorumSolvencyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendQuorumSolvencyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingItemsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendQuorumSolvencyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthServerBifrostLocalhost
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServerBifrostLocalhost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SendQuorumErrataTxResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServerBifrostLocalhost
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServerBifrostLocalhost
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Items = append(m.Items, &PendingItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SendQuorumErrataTxResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendQuorumErrataTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServerBifrostLocalhost(dAtA[iNdEx:])
@@ -0,0 +1,110 @@
+package switchly
+
+import (
+	"fmt"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/ebifrost"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+// QuorumTracker looks up the committed progress of the items pending in the enshrined bifrost
+// caches towards their quorum, counting the active validators who signed the voters on chain.
+type QuorumTracker struct {
+	k        keeper.Keeper
+	queryCtx func() (cosmos.Context, error)
+}
+
+// NewQuorumTracker creates a QuorumTracker reading the latest committed state through queryCtx
+func NewQuorumTracker(k keeper.Keeper, queryCtx func() (cosmos.Context, error)) QuorumTracker {
+	return QuorumTracker{
+		k:        k,
+		queryCtx: queryCtx,
+	}
+}
+
+var _ ebifrost.QuorumTracker = QuorumTracker{}
+
+// status counts the signers in the active validator set against its supermajority
+func (t QuorumTracker) status(ctx cosmos.Context, signers []cosmos.AccAddress) (ebifrost.QuorumStatus, error) {
+	active, err := t.k.ListActiveValidators(ctx)
+	if err != nil {
+		return ebifrost.QuorumStatus{}, fmt.Errorf("fail to get list of active node accounts: %w", err)
+	}
+	st := ebifrost.QuorumStatus{}
+	for _, signer := range signers {
+		if active.IsNodeKeys(signer) {
+			st.Signers++
+		}
+	}
+	// the smallest number of signers HasSuperMajority accepts
+	st.Required = (len(active)*2 + types.SuperMajorityFactor - 1) / types.SuperMajorityFactor
+	return st, nil
+}
+
+// QuorumTxStatus returns the committed progress of the observed tx voter of the item
+func (t QuorumTracker) QuorumTxStatus(qtx *common.QuorumTx) (ebifrost.QuorumStatus, error) {
+	ctx, err := t.queryCtx()
+	if err != nil {
+		return ebifrost.QuorumStatus{}, err
+	}
+	var voter ObservedTxVoter
+	if qtx.Inbound {
+		voter, err = t.k.GetObservedTxInVoter(ctx, qtx.ObsTx.Tx.ID)
+	} else {
+		voter, err = t.k.GetObservedTxOutVoter(ctx, observedTxOutVoterID(ctx, t.k, qtx.ObsTx))
+	}
+	if err != nil {
+		return ebifrost.QuorumStatus{}, fmt.Errorf("fail to get observed tx voter: %w", err)
+	}
+	var signers []cosmos.AccAddress
+	for _, tx := range voter.Txs {
+		if tx.Equals(qtx.ObsTx) {
+			signers = tx.GetSigners()
+			break
+		}
+	}
+	return t.status(ctx, signers)
+}
+
+// NetworkFeeStatus returns the committed progress of the network fee voter of the item
+func (t QuorumTracker) NetworkFeeStatus(qnf *common.QuorumNetworkFee) (ebifrost.QuorumStatus, error) {
+	ctx, err := t.queryCtx()
+	if err != nil {
+		return ebifrost.QuorumStatus{}, err
+	}
+	nf := qnf.NetworkFee
+	voter, err := t.k.GetObservedNetworkFeeVoter(ctx, nf.Height, nf.Chain, int64(nf.TransactionRate), int64(nf.TransactionSize))
+	if err != nil {
+		return ebifrost.QuorumStatus{}, fmt.Errorf("fail to get network fee voter: %w", err)
+	}
+	return t.status(ctx, voter.GetSigners())
+}
+
+// SolvencyStatus returns the committed progress of the solvency voter of the item
+func (t QuorumTracker) SolvencyStatus(qs *common.QuorumSolvency) (ebifrost.QuorumStatus, error) {
+	ctx, err := t.queryCtx()
+	if err != nil {
+		return ebifrost.QuorumStatus{}, err
+	}
+	voter, err := t.k.GetSolvencyVoter(ctx, qs.Solvency.Id, qs.Solvency.Chain)
+	if err != nil {
+		return ebifrost.QuorumStatus{}, fmt.Errorf("fail to get solvency voter: %w", err)
+	}
+	return t.status(ctx, voter.GetSigners())
+}
+
+// ErrataTxStatus returns the committed progress of the errata tx voter of the item
+func (t QuorumTracker) ErrataTxStatus(qe *common.QuorumErrataTx) (ebifrost.QuorumStatus, error) {
+	ctx, err := t.queryCtx()
+	if err != nil {
+		return ebifrost.QuorumStatus{}, err
+	}
+	voter, err := t.k.GetErrataTxVoter(ctx, qe.ErrataTx.Id, qe.ErrataTx.Chain)
+	if err != nil {
+		return ebifrost.QuorumStatus{}, fmt.Errorf("fail to get errata tx voter: %w", err)
+	}
+	return t.status(ctx, voter.GetSigners())
+}
@@ -0,0 +1,53 @@
+package switchly
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+type QuorumTrackerSuite struct{}
+
+var _ = Suite(&QuorumTrackerSuite{})
+
+func (s *QuorumTrackerSuite) TestNetworkFeeStatus(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	active := NodeAccounts{}
+	for i := 0; i < 4; i++ {
+		na := GetRandomValidatorNode(NodeActive)
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+		active = append(active, na)
+	}
+	standby := GetRandomValidatorNode(NodeStandby)
+	c.Assert(k.SetNodeAccount(ctx, standby), IsNil)
+
+	qnf := &common.QuorumNetworkFee{
+		NetworkFee: &common.NetworkFee{
+			Chain:           common.BTCChain,
+			Height:          100,
+			TransactionSize: 250,
+			TransactionRate: 10,
+		},
+	}
+	tracker := NewQuorumTracker(k, func() (cosmos.Context, error) { return ctx, nil })
+
+	st, err := tracker.NetworkFeeStatus(qnf)
+	c.Assert(err, IsNil)
+	c.Check(st.Signers, Equals, 0)
+	c.Check(st.Required, Equals, 3)
+
+	// only the signatures of active validators count
+	voter, err := k.GetObservedNetworkFeeVoter(ctx, 100, common.BTCChain, 10, 250)
+	c.Assert(err, IsNil)
+	voter.Sign(active[0].NodeAddress)
+	voter.Sign(standby.NodeAddress)
+	k.SetObservedNetworkFeeVoter(ctx, voter)
+
+	st, err = tracker.NetworkFeeStatus(qnf)
+	c.Assert(err, IsNil)
+	c.Check(st.Signers, Equals, 1)
+	c.Check(st.Required, Equals, 3)
+	c.Check(HasSuperMajority(st.Required, len(active)), Equals, true)
+	c.Check(HasSuperMajority(st.Required-1, len(active)), Equals, false)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/aggregators"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
@@ -97,6 +98,12 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, agg := range data.DexAggregators {
+		if err := agg.Valid(); err != nil {
+			return fmt.Errorf("invalid dex aggregator: %w", err)
+		}
+	}
+
 	for _, n := range data.SWITCHNames {
 		if len(n.Name) > 30 {
 			return errors.New("SWITCHName cannot exceed 30 characters")
@@ -149,7 +156,19 @@ func DefaultGenesisState() GenesisState {
 		SwitchProviders:           make([]SWITCHProvider, 0),
 		SwitchPool:                NewSwitchPool(),
 		AffiliateCollectors:       []AffiliateFeeCollector{},
+		DexAggregators:            defaultDexAggregators(),
+	}
+}
+
+// defaultDexAggregators return the dex aggregators compiled into the binary, they are
+// only used to seed the aggregators of a new chain (or of a running chain by the 7 to 8
+// migration), which are managed by node votes afterwards
+func defaultDexAggregators() []DexAggregator {
+	aggs := make([]DexAggregator, 0)
+	for _, agg := range aggregators.DexAggregators() {
+		aggs = append(aggs, NewDexAggregator(agg.Chain, agg.Address, agg.GasUnitsLimit, true, ""))
 	}
+	return aggs
 }
 
 // initGenesis read the data in GenesisState and apply it to data store
@@ -345,6 +364,10 @@ func initGenesis(ctx cosmos.Context, keeper keeper.Keeper, data GenesisState) []
 		}
 	}
 
+	for _, agg := range data.DexAggregators {
+		keeper.SetDexAggregator(ctx, agg)
+	}
+
 	reserveAddr, _ := keeper.GetModuleAddress(ReserveName)
 	ctx.Logger().Info("Reserve Module", "address", reserveAddr.String())
 	bondAddr, _ := keeper.GetModuleAddress(BondName)
@@ -482,6 +505,11 @@ func ExportGenesis(ctx cosmos.Context, k keeper.Keeper) GenesisState {
 		swcyStakers = append(swcyStakers, staker)
 	}
 
+	dexAggregators, err := k.GetDexAggregators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	var observedTxInVoters ObservedTxVoters
 	var outs []TxOut
 	startBlockHeight := ctx.BlockHeight() - k.GetConstants().GetInt64Value(constants.SigningTransactionPeriod)
@@ -815,5 +843,6 @@ func ExportGenesis(ctx cosmos.Context, k keeper.Keeper) GenesisState {
 		AffiliateCollectors:       affiliateCollectors,
 		SwcyClaimers:              swcyClaimers,
 		SwcyStakers:               swcyStakers,
+		DexAggregators:            dexAggregators,
 	}
 }
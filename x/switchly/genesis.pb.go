@@ -169,6 +169,7 @@ type GenesisState struct {
 	SecuredAssets             []types.SecuredAsset                                     `protobuf:"bytes,41,rep,name=secured_assets,json=securedAssets,proto3" json:"secured_assets"`
 	SwcyClaimers              []types.SWCYClaimer                                      `protobuf:"bytes,42,rep,name=swcy_claimers,json=swcyClaimers,proto3" json:"swcy_claimers"`
 	SwcyStakers               []types.SWCYStaker                                       `protobuf:"bytes,43,rep,name=swcy_stakers,json=swcyStakers,proto3" json:"swcy_stakers"`
+	DexAggregators            []types.DexAggregator                                    `protobuf:"bytes,44,rep,name=dex_aggregators,json=dexAggregators,proto3" json:"dex_aggregators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -457,6 +458,13 @@ func (m *GenesisState) GetSwcyStakers() []types.SWCYStaker {
 	return nil
 }
 
+func (m *GenesisState) GetDexAggregators() []types.DexAggregator {
+	if m != nil {
+		return m.DexAggregators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LastChainHeight)(nil), "switchly.lastChainHeight")
 	proto.RegisterType((*Mimir)(nil), "switchly.mimir")
@@ -466,97 +474,99 @@ func init() {
 func init() { proto.RegisterFile("switchly/genesis.proto", fileDescriptor_da38bc4971a05fb2) }
 
 var fileDescriptor_da38bc4971a05fb2 = []byte{
-	// 1433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x36, 0x4e, 0xe2, 0x8e, 0xf3, 0xe1, 0x8c, 0x1d, 0x77, 0x52, 0x52, 0x27, 0xa4, 0x85,
-	0x06, 0x0a, 0xb1, 0xd4, 0x8a, 0x52, 0x90, 0x28, 0x8d, 0x2d, 0x42, 0x13, 0x9a, 0x26, 0xb5, 0xd3,
-	0x86, 0x72, 0x59, 0x6d, 0xd6, 0x53, 0x67, 0xd5, 0xf5, 0x8e, 0xbb, 0x6f, 0xd6, 0x89, 0xaf, 0xdc,
-	0xe0, 0x02, 0x67, 0x4e, 0x88, 0x03, 0x07, 0xfe, 0x92, 0x1e, 0x7b, 0xe4, 0x04, 0xa8, 0xfd, 0x47,
-	0xd0, 0x7c, 0xd9, 0xb3, 0xb1, 0x8b, 0x04, 0x51, 0xc5, 0xa5, 0xf5, 0xfe, 0xde, 0xd7, 0xef, 0xcd,
-	0xbc, 0xf7, 0xe6, 0x05, 0x95, 0xe0, 0x38, 0xe0, 0xfe, 0x51, 0xd8, 0xab, 0xb4, 0x68, 0x44, 0x21,
-	0x80, 0xf5, 0x4e, 0xcc, 0x38, 0xc3, 0x59, 0x83, 0x5f, 0x2c, 0xf8, 0xac, 0xdd, 0x66, 0x51, 0x45,
-	0xfd, 0xa7, 0xc4, 0x17, 0x17, 0x78, 0xaf, 0x43, 0xa1, 0x22, 0xfe, 0x75, 0x3b, 0x8c, 0x85, 0x1a,
-	0x5e, 0xb6, 0x60, 0xff, 0xc8, 0x0b, 0x22, 0xd7, 0x67, 0x11, 0x8f, 0x3d, 0x9f, 0x6b, 0x85, 0x25,
-	0x4b, 0x21, 0xa2, 0xfc, 0x98, 0xc5, 0x4f, 0xdd, 0x27, 0x94, 0x6a, 0x69, 0x51, 0x49, 0xdb, 0xd0,
-	0x72, 0xe1, 0xd8, 0xeb, 0x68, 0x74, 0xcd, 0xb2, 0xf1, 0x9e, 0x3c, 0x09, 0xc2, 0xc0, 0xe3, 0x54,
-	0x58, 0xb9, 0x3e, 0x0b, 0x43, 0xea, 0x73, 0x16, 0x8f, 0x08, 0x0f, 0x3c, 0xa6, 0x5e, 0x3b, 0x88,
-	0x52, 0xae, 0xc8, 0x70, 0xf8, 0x74, 0x68, 0x9d, 0x90, 0xc9, 0xe7, 0x8a, 0x85, 0xc6, 0x14, 0x68,
-	0xdc, 0xa5, 0x2a, 0xa3, 0xe0, 0x30, 0x19, 0x84, 0x2d, 0x59, 0x5a, 0x5d, 0x2f, 0x09, 0x4d, 0xb2,
-	0x17, 0x2c, 0x9c, 0x9f, 0xb8, 0x2c, 0xe1, 0x23, 0x0c, 0xda, 0x41, 0x3b, 0x30, 0x8e, 0x2e, 0xd9,
-	0xf4, 0x58, 0x93, 0xba, 0x9e, 0xef, 0xb3, 0x24, 0x1a, 0x75, 0x78, 0xec, 0x50, 0xb2, 0x69, 0xba,
-	0xfc, 0x44, 0x4b, 0x2f, 0x5b, 0xd2, 0x30, 0x78, 0x96, 0x04, 0xcd, 0x80, 0xf7, 0xdc, 0x4e, 0xcc,
-	0xba, 0x41, 0x93, 0x8e, 0x8a, 0x60, 0x6e, 0x38, 0xf2, 0xda, 0x74, 0xc4, 0xb5, 0x86, 0xcc, 0x8b,
-	0x46, 0xf0, 0xf5, 0xc3, 0x41, 0x1e, 0x65, 0x3b, 0xc1, 0xd8, 0x1b, 0x22, 0xbc, 0x32, 0x14, 0xed,
-	0x34, 0x9f, 0xa5, 0x11, 0x1a, 0x83, 0x72, 0xb2, 0xfd, 0x03, 0xf5, 0x93, 0x98, 0x36, 0x5d, 0x0f,
-	0x80, 0xf2, 0x91, 0xd9, 0xf8, 0x3d, 0xd7, 0x0f, 0xbd, 0xa0, 0xfd, 0x1a, 0xe7, 0x7e, 0xcf, 0x05,
-	0xee, 0x3d, 0xed, 0x4b, 0x8b, 0x2d, 0xd6, 0x62, 0xf2, 0x67, 0x45, 0xfc, 0x52, 0xe8, 0xea, 0xe7,
-	0x68, 0x2e, 0xf4, 0x80, 0xd7, 0x44, 0xf1, 0xde, 0xa5, 0x41, 0xeb, 0x88, 0xe3, 0x22, 0x9a, 0x90,
-	0xb5, 0x4c, 0x9c, 0x15, 0x67, 0xed, 0x7c, 0x5d, 0x7d, 0xe0, 0x12, 0x9a, 0x3c, 0x92, 0x72, 0x72,
-	0x6e, 0xc5, 0x59, 0x1b, 0xaf, 0xeb, 0xaf, 0xd5, 0x0a, 0x9a, 0x90, 0x57, 0x8a, 0xf3, 0x68, 0xfc,
-	0x29, 0xed, 0x69, 0x23, 0xf1, 0x53, 0x38, 0xea, 0x7a, 0x61, 0x42, 0xb5, 0x85, 0xfa, 0x58, 0xfd,
-	0xe9, 0x02, 0x9a, 0xfe, 0x52, 0xf5, 0x5e, 0x83, 0x7b, 0x9c, 0xe2, 0xab, 0x68, 0x42, 0x9c, 0x01,
-	0x10, 0x67, 0x65, 0x7c, 0x2d, 0x77, 0x3d, 0xb7, 0x2e, 0xd3, 0x58, 0xdf, 0x63, 0x2c, 0xac, 0x66,
-	0x9e, 0xff, 0xb1, 0x3c, 0x56, 0x57, 0x72, 0xfc, 0x8b, 0x83, 0x0a, 0xc3, 0x37, 0x0d, 0xe4, 0x9c,
-	0xb4, 0x23, 0xda, 0xee, 0x9e, 0xd1, 0xd8, 0xd3, 0x0a, 0xd5, 0x03, 0xe1, 0xe4, 0xb7, 0x3f, 0x97,
-	0x77, 0x5b, 0x01, 0x3f, 0x4a, 0x0e, 0xd7, 0x7d, 0xd6, 0xae, 0x98, 0x62, 0x90, 0xa7, 0xe0, 0xb3,
-	0xb0, 0x0f, 0x88, 0x3a, 0xac, 0x74, 0x6f, 0x54, 0x4e, 0xfa, 0x48, 0xe5, 0x35, 0x7e, 0xa1, 0x8e,
-	0xc3, 0x21, 0x0c, 0xff, 0xec, 0xa0, 0x05, 0xab, 0x58, 0xdd, 0x20, 0x72, 0xbb, 0x8c, 0x0b, 0x9a,
-	0xe3, 0x92, 0x66, 0x49, 0xd3, 0xdc, 0xd5, 0x3a, 0xfb, 0x27, 0x8f, 0x84, 0xb8, 0xfa, 0x50, 0x93,
-	0xdc, 0x39, 0x13, 0xc9, 0x53, 0x5e, 0xa1, 0x8e, 0x59, 0x1f, 0xd9, 0x8a, 0x14, 0x26, 0xce, 0xb1,
-	0x64, 0x53, 0x64, 0x09, 0x37, 0x1c, 0x33, 0xff, 0x07, 0xc7, 0xc2, 0x80, 0xe3, 0x6e, 0xc2, 0x35,
-	0xc9, 0x6b, 0x68, 0x4a, 0x51, 0x03, 0x32, 0x21, 0x49, 0x4d, 0x6b, 0x52, 0x52, 0x49, 0x17, 0xc6,
-	0x24, 0x17, 0x1f, 0x80, 0xbf, 0x77, 0xd0, 0x8c, 0x3d, 0x40, 0x80, 0x4c, 0x4a, 0x1b, 0xac, 0x6d,
-	0xee, 0xb3, 0x26, 0xdd, 0x50, 0xa2, 0xea, 0x03, 0x9d, 0xc4, 0xd6, 0x99, 0x92, 0xb0, 0x3c, 0x42,
-	0x7d, 0x3a, 0xb2, 0xbe, 0xf0, 0x33, 0x34, 0x29, 0xa7, 0x22, 0x90, 0xa9, 0x14, 0xf1, 0x47, 0x02,
-	0xac, 0x7e, 0xa5, 0xc3, 0xd7, 0xce, 0x14, 0x5e, 0xfa, 0x82, 0xba, 0x0e, 0x84, 0x09, 0x9a, 0xd2,
-	0xe3, 0x9a, 0x64, 0x57, 0x9c, 0xb5, 0x4c, 0xdd, 0x7c, 0xe2, 0x0f, 0x10, 0x16, 0xfd, 0xed, 0x42,
-	0xd0, 0x8a, 0x68, 0xd3, 0xd5, 0x2d, 0x8c, 0x64, 0x43, 0xe6, 0x85, 0xa4, 0x21, 0x05, 0xba, 0xf5,
-	0x77, 0xb4, 0xb6, 0x7a, 0xcb, 0x94, 0x32, 0x90, 0x9c, 0x4c, 0x63, 0x71, 0xdd, 0x70, 0x58, 0x3f,
-	0x35, 0x31, 0xf4, 0x65, 0xe4, 0x4f, 0xc1, 0x80, 0x7f, 0x75, 0x50, 0x71, 0xc4, 0x33, 0x02, 0x64,
-	0x5a, 0x7b, 0x54, 0xc9, 0xd4, 0x95, 0x4a, 0x6d, 0xa0, 0x51, 0xfd, 0x5a, 0x9f, 0xd2, 0xde, 0x99,
-	0x4e, 0x69, 0xd8, 0x31, 0xd4, 0x0b, 0xf1, 0x30, 0x88, 0xd7, 0xd1, 0x94, 0x7e, 0x1e, 0xc9, 0xcc,
-	0x8a, 0xb3, 0x96, 0xbb, 0x3e, 0x6b, 0x0a, 0x47, 0xa1, 0x3a, 0x43, 0xa3, 0x84, 0xbf, 0x40, 0x45,
-	0xaf, 0xd9, 0x95, 0x2f, 0xad, 0xfb, 0x2c, 0xa1, 0x09, 0x75, 0x03, 0x4e, 0xdb, 0x40, 0x0a, 0x32,
-	0x2f, 0x63, 0xbc, 0x03, 0xad, 0xc6, 0xb1, 0xd7, 0xd1, 0xc6, 0xf3, 0x5e, 0xb3, 0x2b, 0x3e, 0x1f,
-	0x08, 0xfd, 0x2d, 0xa1, 0x8e, 0x3f, 0x45, 0xd3, 0xd6, 0x52, 0x00, 0xa4, 0x28, 0xcd, 0xe7, 0xd3,
-	0xb1, 0x37, 0x29, 0xd5, 0x1e, 0x72, 0x51, 0x1f, 0x01, 0x5c, 0x43, 0x73, 0xe9, 0x8d, 0x03, 0x48,
-	0x49, 0x9a, 0x17, 0xb5, 0xb9, 0xbc, 0x89, 0x9a, 0x16, 0x6a, 0x0f, 0xb3, 0xbe, 0x0d, 0x02, 0xfe,
-	0x04, 0xe5, 0x1a, 0x07, 0x5b, 0xfb, 0xb5, 0xbb, 0xf7, 0xbd, 0x36, 0x05, 0x72, 0x21, 0x15, 0x7f,
-	0x20, 0x31, 0xf1, 0x2d, 0x5d, 0xfc, 0x21, 0x9a, 0x94, 0x73, 0x1f, 0x08, 0x91, 0x56, 0x73, 0x83,
-	0xf2, 0x90, 0xb8, 0xe9, 0x50, 0xa5, 0x84, 0xaf, 0xa2, 0x19, 0xe0, 0x2c, 0xa6, 0x6e, 0x97, 0xc6,
-	0x10, 0xb0, 0x88, 0x2c, 0x8a, 0x12, 0xac, 0x9e, 0x23, 0x4e, 0x7d, 0x5a, 0x0a, 0x1e, 0x29, 0x1c,
-	0x6f, 0xa0, 0xd9, 0x43, 0x16, 0x35, 0xad, 0xf1, 0x7e, 0x31, 0x95, 0x56, 0x95, 0x45, 0xcd, 0xfe,
-	0xb4, 0xd5, 0x41, 0x66, 0x0e, 0x6d, 0x10, 0x7f, 0x84, 0xc6, 0xf7, 0x76, 0xef, 0x91, 0xb7, 0xe4,
-	0x4d, 0x5e, 0x32, 0xcf, 0x89, 0xae, 0x9a, 0xdd, 0xe3, 0x88, 0x36, 0xfb, 0xb3, 0x5c, 0x3b, 0x10,
-	0xfa, 0xe2, 0x1d, 0x12, 0x3b, 0x00, 0x90, 0xa5, 0xd4, 0x3b, 0x74, 0x8f, 0x79, 0x91, 0x79, 0x87,
-	0xa4, 0x5c, 0x1c, 0x7d, 0x7a, 0xdb, 0x02, 0x72, 0x29, 0xc5, 0xb1, 0x61, 0xa4, 0xd6, 0xf5, 0xcf,
-	0x82, 0x0d, 0x02, 0xbe, 0x8d, 0xf2, 0x43, 0xe5, 0x53, 0xfe, 0x87, 0xf2, 0x99, 0x85, 0x74, 0xed,
-	0xdc, 0x46, 0x33, 0x02, 0xe9, 0xd0, 0x58, 0xad, 0x28, 0x64, 0x59, 0x1a, 0x17, 0x0c, 0x05, 0x25,
-	0xab, 0x09, 0x91, 0xf6, 0x30, 0x0d, 0x16, 0x86, 0xef, 0xa0, 0xd9, 0xd4, 0x0a, 0x03, 0x64, 0x25,
-	0xe5, 0x60, 0x5f, 0x08, 0xcd, 0xcc, 0xd4, 0xc7, 0xcc, 0x2d, 0x0c, 0xf0, 0xc7, 0x28, 0xa7, 0x3c,
-	0x24, 0x51, 0xc0, 0x81, 0xbc, 0x2d, 0xcd, 0xf3, 0xb6, 0xf9, 0xc3, 0x28, 0x30, 0xb6, 0x88, 0x1b,
-	0x00, 0xf0, 0x0f, 0x0e, 0x5a, 0x62, 0x09, 0x3f, 0x64, 0x49, 0xd4, 0x94, 0x7b, 0xed, 0x71, 0xc0,
-	0x8f, 0x8e, 0x68, 0xd8, 0xd4, 0x2b, 0x11, 0x59, 0xd5, 0x73, 0x53, 0xaf, 0xe0, 0x35, 0x16, 0x44,
-	0xd5, 0x3b, 0x7a, 0x22, 0xdc, 0xfa, 0x37, 0x13, 0xc1, 0x72, 0x00, 0xf5, 0x45, 0x13, 0x73, 0x93,
-	0xd2, 0x03, 0x1d, 0xb1, 0x21, 0x0d, 0xf0, 0x77, 0x0e, 0x5a, 0x4c, 0x31, 0x82, 0x0e, 0x8d, 0xb8,
-	0xa1, 0x73, 0xf9, 0x8d, 0xd0, 0x29, 0x59, 0x74, 0x1a, 0x22, 0x9c, 0xe6, 0xb2, 0x29, 0x0a, 0x23,
-	0xb5, 0x3b, 0x02, 0xb9, 0x22, 0x19, 0x2c, 0xa4, 0x1a, 0xb3, 0xbf, 0xde, 0xa8, 0x03, 0x9e, 0x53,
-	0x46, 0x83, 0x2e, 0xb8, 0x85, 0x72, 0xd6, 0x86, 0x49, 0xde, 0x91, 0xdd, 0xd0, 0xef, 0x6d, 0xa5,
-	0x3c, 0x58, 0xb1, 0x10, 0xf4, 0x11, 0x7c, 0x13, 0x21, 0xc1, 0x78, 0x47, 0xb5, 0xf7, 0xbb, 0xa9,
-	0x7b, 0xbd, 0x6f, 0x04, 0xc6, 0x6e, 0xa0, 0x89, 0x1f, 0xa2, 0xe2, 0xe0, 0xef, 0x95, 0xfe, 0xdf,
-	0x2a, 0x40, 0xae, 0x4a, 0x0f, 0x4b, 0xda, 0xc3, 0x86, 0x51, 0xd9, 0xa4, 0xb4, 0x66, 0x94, 0xb4,
-	0xb7, 0x42, 0xdf, 0xbe, 0x2f, 0x01, 0xfc, 0xad, 0x83, 0x16, 0x44, 0xe3, 0xb9, 0x9c, 0x71, 0x2f,
-	0x94, 0x8e, 0x3d, 0x4e, 0x63, 0x2f, 0x24, 0x6b, 0x6f, 0xe4, 0x62, 0x0a, 0x22, 0xd8, 0xbe, 0x88,
-	0x55, 0xeb, 0x87, 0x12, 0xed, 0x92, 0xda, 0xc8, 0x81, 0xbc, 0x97, 0xee, 0x37, 0x25, 0xdc, 0x10,
-	0x32, 0xd3, 0x2e, 0x60, 0x61, 0x80, 0x3f, 0x13, 0x0d, 0x3b, 0xd8, 0xd9, 0x81, 0xbc, 0x9f, 0x5a,
-	0x51, 0x1a, 0x07, 0xb5, 0xc7, 0x35, 0x25, 0x1a, 0xf4, 0xab, 0xdf, 0xd3, 0x90, 0x7c, 0x2b, 0xac,
-	0x9d, 0x1e, 0xc8, 0xb5, 0x53, 0xb3, 0xba, 0xf6, 0xb8, 0x21, 0x25, 0x66, 0x56, 0x0b, 0x65, 0x85,
-	0xc0, 0x76, 0x26, 0x7b, 0x3e, 0x8f, 0xb6, 0x33, 0xd9, 0xd9, 0xfc, 0xdc, 0x76, 0x26, 0x3b, 0x97,
-	0xcf, 0x6f, 0x67, 0xb2, 0xf9, 0xfc, 0xfc, 0x76, 0x26, 0x3b, 0x9f, 0xc7, 0xdb, 0x99, 0x2c, 0xce,
-	0x17, 0xb6, 0x33, 0xd9, 0x85, 0x7c, 0xa9, 0xba, 0xf7, 0xfc, 0x65, 0xd9, 0x79, 0xf1, 0xb2, 0xec,
-	0xfc, 0xf5, 0xb2, 0xec, 0xfc, 0xf8, 0xaa, 0x3c, 0xf6, 0xe2, 0x55, 0x79, 0xec, 0xf7, 0x57, 0xe5,
-	0xb1, 0x6f, 0x6e, 0xfe, 0xb7, 0x97, 0xf8, 0x70, 0x52, 0x2a, 0xdd, 0xf8, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0x17, 0xa7, 0xb4, 0x71, 0x79, 0x0f, 0x00, 0x00,
+	// 1464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0x13, 0xc7,
+	0x16, 0xf6, 0x60, 0xd9, 0x16, 0x2d, 0xff, 0xc8, 0x2d, 0x59, 0xb4, 0xb9, 0x46, 0xd6, 0x35, 0xdc,
+	0x8b, 0x13, 0x88, 0x55, 0x05, 0x15, 0x42, 0x52, 0x15, 0x82, 0xa5, 0xc4, 0xc1, 0x0e, 0xc6, 0x46,
+	0x32, 0x38, 0x64, 0x33, 0x35, 0x9e, 0x69, 0xe4, 0x29, 0x46, 0xd3, 0x62, 0x4e, 0x4b, 0xb6, 0xb7,
+	0xd9, 0x25, 0x9b, 0xe4, 0x11, 0x52, 0x59, 0x64, 0x91, 0x27, 0x61, 0xc9, 0x32, 0xab, 0x90, 0x82,
+	0x17, 0x49, 0xf5, 0x9f, 0xd4, 0x63, 0x89, 0x54, 0x25, 0x2e, 0x2a, 0x1b, 0x5b, 0xfd, 0x9d, 0xbf,
+	0xaf, 0xbb, 0xcf, 0x39, 0x7d, 0x06, 0x95, 0xe0, 0x28, 0xe4, 0xfe, 0x61, 0x74, 0x52, 0x6d, 0xd1,
+	0x98, 0x42, 0x08, 0x6b, 0x9d, 0x84, 0x71, 0x86, 0xb3, 0x06, 0xbf, 0x58, 0xf0, 0x59, 0xbb, 0xcd,
+	0xe2, 0xaa, 0xfa, 0xa7, 0xc4, 0x17, 0x17, 0xf8, 0x49, 0x87, 0x42, 0x55, 0xfc, 0x75, 0x3b, 0x8c,
+	0x45, 0x1a, 0x5e, 0xb6, 0x60, 0xff, 0xd0, 0x0b, 0x63, 0xd7, 0x67, 0x31, 0x4f, 0x3c, 0x9f, 0x6b,
+	0x85, 0x25, 0x4b, 0x21, 0xa6, 0xfc, 0x88, 0x25, 0xcf, 0xdc, 0xa7, 0x94, 0x6a, 0x69, 0x51, 0x49,
+	0xdb, 0xd0, 0x72, 0xe1, 0xc8, 0xeb, 0x68, 0x74, 0xd5, 0xb2, 0xf1, 0x9e, 0x3e, 0x0d, 0xa3, 0xd0,
+	0xe3, 0x54, 0x58, 0xb9, 0x3e, 0x8b, 0x22, 0xea, 0x73, 0x96, 0x8c, 0x08, 0x0f, 0x3c, 0xa1, 0x5e,
+	0x3b, 0x8c, 0x53, 0xae, 0xc8, 0x70, 0xf8, 0x74, 0x68, 0xbd, 0x21, 0xb3, 0x9f, 0x2b, 0x16, 0x9a,
+	0x50, 0xa0, 0x49, 0x8f, 0xaa, 0x1d, 0x85, 0x07, 0xdd, 0x41, 0xd8, 0x92, 0xa5, 0xd5, 0xf3, 0xba,
+	0x91, 0xd9, 0xec, 0x05, 0x0b, 0xe7, 0xc7, 0x2e, 0xeb, 0xf2, 0x11, 0x06, 0xed, 0xb0, 0x1d, 0x1a,
+	0x47, 0x97, 0x6c, 0x7a, 0x2c, 0xa0, 0xae, 0xe7, 0xfb, 0xac, 0x1b, 0x8f, 0x3a, 0x3c, 0x76, 0x20,
+	0xd9, 0x04, 0x2e, 0x3f, 0xd6, 0xd2, 0xcb, 0x96, 0x34, 0x0a, 0x9f, 0x77, 0xc3, 0x20, 0xe4, 0x27,
+	0x6e, 0x27, 0x61, 0xbd, 0x30, 0xa0, 0xa3, 0x22, 0x98, 0x1b, 0x8e, 0xbd, 0x36, 0x1d, 0x71, 0xad,
+	0x11, 0xf3, 0xe2, 0x11, 0x7c, 0xfd, 0x68, 0xb0, 0x8f, 0xb2, 0xbd, 0xc1, 0xc4, 0x1b, 0x22, 0x5c,
+	0x19, 0x8a, 0x76, 0x9a, 0xcf, 0xd2, 0x08, 0x8d, 0x41, 0x3a, 0xd9, 0xfe, 0x81, 0xfa, 0xdd, 0x84,
+	0x06, 0xae, 0x07, 0x40, 0xf9, 0xc8, 0xdd, 0xf8, 0x27, 0xae, 0x1f, 0x79, 0x61, 0xfb, 0x2d, 0xce,
+	0xfd, 0x13, 0x17, 0xb8, 0xf7, 0x8c, 0x8e, 0x4a, 0x96, 0x80, 0x1e, 0xbb, 0x5e, 0xab, 0x95, 0xd0,
+	0x96, 0x37, 0xb8, 0xd6, 0x62, 0x8b, 0xb5, 0x98, 0xfc, 0x59, 0x15, 0xbf, 0x14, 0xba, 0xf2, 0x19,
+	0x9a, 0x8b, 0x3c, 0xe0, 0x75, 0x91, 0xdd, 0xf7, 0x68, 0xd8, 0x3a, 0xe4, 0xb8, 0x88, 0x26, 0x64,
+	0xb2, 0x13, 0xa7, 0xe2, 0xac, 0x9e, 0x6f, 0xa8, 0x05, 0x2e, 0xa1, 0xc9, 0x43, 0x29, 0x27, 0xe7,
+	0x2a, 0xce, 0xea, 0x78, 0x43, 0xaf, 0x56, 0xaa, 0x68, 0x42, 0xde, 0x39, 0xce, 0xa3, 0xf1, 0x67,
+	0xf4, 0x44, 0x1b, 0x89, 0x9f, 0xc2, 0x51, 0xcf, 0x8b, 0xba, 0x54, 0x5b, 0xa8, 0xc5, 0xca, 0xab,
+	0x0b, 0x68, 0xfa, 0x4b, 0x55, 0x9c, 0x4d, 0xee, 0x71, 0x8a, 0xaf, 0xa2, 0x09, 0x71, 0x48, 0x40,
+	0x9c, 0xca, 0xf8, 0x6a, 0xee, 0x46, 0x6e, 0x4d, 0xee, 0x64, 0x6d, 0x97, 0xb1, 0xa8, 0x96, 0x79,
+	0xf1, 0xfb, 0xf2, 0x58, 0x43, 0xc9, 0xf1, 0xcf, 0x0e, 0x2a, 0x0c, 0xa7, 0x02, 0x90, 0x73, 0xd2,
+	0x8e, 0x68, 0xbb, 0xfb, 0x46, 0x63, 0x57, 0x2b, 0xd4, 0xf6, 0x85, 0x93, 0x5f, 0x5f, 0x2d, 0xef,
+	0xb4, 0x42, 0x7e, 0xd8, 0x3d, 0x58, 0xf3, 0x59, 0xbb, 0x6a, 0xb2, 0x45, 0x9e, 0x82, 0xcf, 0xa2,
+	0x3e, 0x20, 0x12, 0xb5, 0xda, 0xbb, 0x59, 0x3d, 0xee, 0x23, 0xd5, 0xb7, 0xf8, 0x85, 0x06, 0x8e,
+	0x86, 0x30, 0xfc, 0x93, 0x83, 0x16, 0xac, 0x6c, 0x76, 0xc3, 0xd8, 0xed, 0x31, 0x2e, 0x68, 0x8e,
+	0x4b, 0x9a, 0x25, 0x4d, 0x73, 0x47, 0xeb, 0xec, 0x1d, 0x3f, 0x16, 0xe2, 0xda, 0x23, 0x4d, 0x72,
+	0xfb, 0x4c, 0x24, 0x4f, 0x79, 0x85, 0x06, 0x66, 0x7d, 0x64, 0x33, 0x56, 0x98, 0x38, 0xc7, 0x92,
+	0x4d, 0x91, 0x75, 0xb9, 0xe1, 0x98, 0xf9, 0x37, 0x38, 0x16, 0x06, 0x1c, 0x77, 0xba, 0x5c, 0x93,
+	0xbc, 0x86, 0xa6, 0x14, 0x35, 0x20, 0x13, 0x92, 0xd4, 0xb4, 0x26, 0x25, 0x95, 0x74, 0x62, 0x4c,
+	0x72, 0xb1, 0x00, 0xfc, 0xbd, 0x83, 0x66, 0xec, 0x0e, 0x03, 0x64, 0x52, 0xda, 0x60, 0x6d, 0xf3,
+	0x80, 0x05, 0x74, 0x5d, 0x89, 0x6a, 0x0f, 0xf5, 0x26, 0x36, 0xcf, 0xb4, 0x09, 0xcb, 0x23, 0x34,
+	0xa6, 0x63, 0x6b, 0x85, 0x9f, 0xa3, 0x49, 0xd9, 0x36, 0x81, 0x4c, 0xa5, 0x88, 0x3f, 0x16, 0x60,
+	0xed, 0x2b, 0x1d, 0xbe, 0x7e, 0xa6, 0xf0, 0xd2, 0x17, 0x34, 0x74, 0x20, 0x4c, 0xd0, 0x94, 0xee,
+	0xe7, 0x24, 0x5b, 0x71, 0x56, 0x33, 0x0d, 0xb3, 0xc4, 0xd7, 0x11, 0x16, 0xf5, 0xed, 0x42, 0xd8,
+	0x8a, 0x69, 0xe0, 0xea, 0x12, 0x46, 0xb2, 0x20, 0xf3, 0x42, 0xd2, 0x94, 0x02, 0x5d, 0xfa, 0xdb,
+	0x5a, 0x5b, 0x3d, 0x76, 0x4a, 0x19, 0x48, 0x4e, 0x6e, 0x63, 0x71, 0xcd, 0x70, 0x58, 0x3b, 0xd5,
+	0x31, 0xf4, 0x65, 0xe4, 0x4f, 0xc1, 0x80, 0x7f, 0x71, 0x50, 0x71, 0xc4, 0x3b, 0x03, 0x64, 0x5a,
+	0x7b, 0x54, 0x9b, 0x69, 0x28, 0x95, 0xfa, 0x40, 0xa3, 0xf6, 0xb5, 0x3e, 0xa5, 0xdd, 0x33, 0x9d,
+	0xd2, 0xb0, 0x63, 0x68, 0x14, 0x92, 0x61, 0x10, 0xaf, 0xa1, 0x29, 0xfd, 0x7e, 0x92, 0x99, 0x8a,
+	0xb3, 0x9a, 0xbb, 0x31, 0x6b, 0x12, 0x47, 0xa1, 0x7a, 0x87, 0x46, 0x09, 0x7f, 0x81, 0x8a, 0x5e,
+	0xd0, 0x93, 0x4f, 0xb1, 0xfb, 0xbc, 0x4b, 0xbb, 0xd4, 0x0d, 0x39, 0x6d, 0x03, 0x29, 0x54, 0xc6,
+	0x2d, 0xe3, 0x6d, 0x68, 0x35, 0x8f, 0xbc, 0x8e, 0x36, 0x9e, 0xf7, 0x82, 0x9e, 0x58, 0x3e, 0x14,
+	0xfa, 0x9b, 0x42, 0x1d, 0x7f, 0x82, 0xa6, 0xad, 0xa9, 0x01, 0x48, 0x51, 0x9a, 0xcf, 0xa7, 0x63,
+	0x6f, 0x50, 0xaa, 0x3d, 0xe4, 0xe2, 0x3e, 0x02, 0xb8, 0x8e, 0xe6, 0xd2, 0x23, 0x09, 0x90, 0x92,
+	0x34, 0x2f, 0x6a, 0x73, 0x79, 0x13, 0x75, 0x2d, 0xd4, 0x1e, 0x66, 0x7d, 0x1b, 0x04, 0xfc, 0x31,
+	0xca, 0x35, 0xf7, 0x37, 0xf7, 0xea, 0xf7, 0x1e, 0x78, 0x6d, 0x0a, 0xe4, 0x42, 0x2a, 0xfe, 0x40,
+	0x62, 0xe2, 0x5b, 0xba, 0xf8, 0x03, 0x34, 0x29, 0xfb, 0x3e, 0x10, 0x22, 0xad, 0xe6, 0x06, 0xe9,
+	0x21, 0x71, 0x53, 0xa1, 0x4a, 0x09, 0x5f, 0x45, 0x33, 0xc0, 0x59, 0x42, 0xdd, 0x1e, 0x4d, 0x20,
+	0x64, 0x31, 0x59, 0x14, 0x29, 0x58, 0x3b, 0x47, 0x9c, 0xc6, 0xb4, 0x14, 0x3c, 0x56, 0x38, 0x5e,
+	0x47, 0xb3, 0x07, 0x2c, 0x0e, 0xac, 0xf6, 0x7e, 0x31, 0xb5, 0xad, 0x1a, 0x8b, 0x83, 0x7e, 0xb7,
+	0xd5, 0x41, 0x66, 0x0e, 0x6c, 0x10, 0x7f, 0x88, 0xc6, 0x77, 0x77, 0xee, 0x93, 0xff, 0xc8, 0x9b,
+	0xbc, 0x64, 0x9e, 0x13, 0x9d, 0x35, 0x3b, 0x47, 0x31, 0x0d, 0xfa, 0xbd, 0x5c, 0x3b, 0x10, 0xfa,
+	0xe2, 0x1d, 0x12, 0x43, 0x02, 0x90, 0xa5, 0xd4, 0x3b, 0x74, 0x9f, 0x79, 0xb1, 0x79, 0x87, 0xa4,
+	0x5c, 0x1c, 0x7d, 0x7a, 0x1c, 0x03, 0x72, 0x29, 0xc5, 0xb1, 0x69, 0xa4, 0xd6, 0xf5, 0xcf, 0x82,
+	0x0d, 0x02, 0xbe, 0x83, 0xf2, 0x43, 0xe9, 0x53, 0xfe, 0x8b, 0xf4, 0x99, 0x85, 0x74, 0xee, 0xdc,
+	0x41, 0x33, 0x02, 0xe9, 0xd0, 0x44, 0xcd, 0x30, 0x64, 0x59, 0x1a, 0x17, 0x0c, 0x05, 0x25, 0xab,
+	0x0b, 0x91, 0xf6, 0x30, 0x0d, 0x16, 0x86, 0xef, 0xa2, 0xd9, 0xd4, 0x8c, 0x03, 0xa4, 0x92, 0x72,
+	0xb0, 0x97, 0x78, 0xfd, 0x9e, 0x66, 0x8e, 0x99, 0x5b, 0x18, 0xe0, 0x8f, 0x50, 0x4e, 0x79, 0xe8,
+	0xc6, 0x21, 0x07, 0xf2, 0x5f, 0x69, 0x9e, 0xb7, 0xcd, 0x1f, 0xc5, 0xa1, 0xb1, 0x45, 0xdc, 0x00,
+	0x80, 0x7f, 0x70, 0xd0, 0x12, 0xeb, 0xf2, 0x03, 0xd6, 0x8d, 0x03, 0x39, 0xf8, 0x1e, 0x85, 0xfc,
+	0xf0, 0x90, 0x46, 0x81, 0x9e, 0x99, 0xc8, 0x8a, 0xee, 0x9b, 0x7a, 0x46, 0xaf, 0xb3, 0x30, 0xae,
+	0xdd, 0xd5, 0x1d, 0xe1, 0xf6, 0xdf, 0xe9, 0x08, 0x96, 0x03, 0x68, 0x2c, 0x9a, 0x98, 0x1b, 0x94,
+	0xee, 0xeb, 0x88, 0x4d, 0x69, 0x80, 0xbf, 0x73, 0xd0, 0x62, 0x8a, 0x11, 0x74, 0x68, 0xcc, 0x0d,
+	0x9d, 0xcb, 0xef, 0x84, 0x4e, 0xc9, 0xa2, 0xd3, 0x14, 0xe1, 0x34, 0x97, 0x0d, 0x91, 0x18, 0xa9,
+	0xe1, 0x12, 0xc8, 0x15, 0xc9, 0x60, 0x21, 0x55, 0x98, 0xfd, 0xf1, 0x46, 0x1d, 0xf0, 0x9c, 0x32,
+	0x1a, 0x54, 0xc1, 0x6d, 0x94, 0xb3, 0x46, 0x50, 0xf2, 0xbf, 0x8a, 0x63, 0xd7, 0xb6, 0x52, 0x1e,
+	0x8c, 0x58, 0x08, 0xfa, 0x08, 0xbe, 0x85, 0x90, 0x60, 0xbc, 0xad, 0xca, 0xfb, 0xff, 0xa9, 0x7b,
+	0x7d, 0x60, 0x04, 0xc6, 0x6e, 0xa0, 0x89, 0x1f, 0xa1, 0xe2, 0xe0, 0x83, 0xa6, 0xff, 0x31, 0x03,
+	0xe4, 0xaa, 0xf4, 0xb0, 0xa4, 0x3d, 0xac, 0x1b, 0x95, 0x0d, 0x4a, 0xeb, 0x46, 0x49, 0x7b, 0x2b,
+	0xf4, 0xed, 0xfb, 0x12, 0xc0, 0xdf, 0x3a, 0x68, 0x41, 0x14, 0x9e, 0xcb, 0x19, 0xf7, 0x22, 0xe9,
+	0xd8, 0xe3, 0x34, 0xf1, 0x22, 0xb2, 0xfa, 0x4e, 0x2e, 0xa6, 0x20, 0x82, 0xed, 0x89, 0x58, 0xf5,
+	0x7e, 0x28, 0x51, 0x2e, 0xa9, 0x91, 0x1d, 0xc8, 0x7b, 0xe9, 0x7a, 0x53, 0xc2, 0x75, 0x21, 0x33,
+	0xe5, 0x02, 0x16, 0x06, 0xf8, 0x53, 0x34, 0x63, 0x0f, 0xf5, 0x40, 0xde, 0x4f, 0x8d, 0x28, 0xcd,
+	0xfd, 0xfa, 0x93, 0xba, 0x12, 0x0d, 0xea, 0xd5, 0x3f, 0xd1, 0x90, 0x7c, 0x2b, 0xac, 0xa1, 0x1f,
+	0xc8, 0xb5, 0x53, 0xbd, 0xba, 0xfe, 0xa4, 0x29, 0x25, 0xa6, 0x57, 0x0b, 0x65, 0x85, 0xc8, 0x86,
+	0x95, 0xfe, 0x24, 0x00, 0x72, 0x3d, 0xd5, 0xb0, 0x3e, 0xa7, 0xc7, 0xeb, 0x7d, 0xa1, 0x69, 0x38,
+	0x81, 0x0d, 0xc2, 0x56, 0x26, 0x7b, 0x3e, 0x8f, 0xb6, 0x32, 0xd9, 0xd9, 0xfc, 0xdc, 0x56, 0x26,
+	0x3b, 0x97, 0xcf, 0x6f, 0x65, 0xb2, 0xf9, 0xfc, 0xfc, 0x56, 0x26, 0x3b, 0x9f, 0xc7, 0x5b, 0x99,
+	0x2c, 0xce, 0x17, 0xb6, 0x32, 0xd9, 0x85, 0x7c, 0xa9, 0xb6, 0xfb, 0xe2, 0x75, 0xd9, 0x79, 0xf9,
+	0xba, 0xec, 0xfc, 0xf1, 0xba, 0xec, 0xfc, 0xf8, 0xa6, 0x3c, 0xf6, 0xf2, 0x4d, 0x79, 0xec, 0xb7,
+	0x37, 0xe5, 0xb1, 0x6f, 0x6e, 0xfd, 0xb3, 0xe7, 0xfc, 0x60, 0x52, 0x2a, 0xdd, 0xfc, 0x73, 0x00,
+	0x6d, 0x85, 0xf3, 0x62, 0xdf, 0x0f, 0x00, 0x00,
 }
 
 func (m *LastChainHeight) Marshal() (dAtA []byte, err error) {
@@ -649,6 +659,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DexAggregators) > 0 {
+		for iNdEx := len(m.DexAggregators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DexAggregators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if len(m.SwcyStakers) > 0 {
 		for iNdEx := len(m.SwcyStakers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1409,6 +1435,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DexAggregators) > 0 {
+		for _, e := range m.DexAggregators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2825,6 +2857,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexAggregators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DexAggregators = append(m.DexAggregators, types.DexAggregator{})
+			if err := m.DexAggregators[len(m.DexAggregators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
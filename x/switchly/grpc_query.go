@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/history"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
 
@@ -17,12 +18,18 @@ type queryServer struct {
 	kbs      cosmos.KeybaseStore
 	regInit  bool
 	txConfig client.TxConfig
+	// history serves the position history queries, nil when not enabled on the node
+	history *history.Indexer
 }
 
 var _ types.QueryServer = &queryServer{}
 
 func NewQueryServerImpl(mgr *Mgrs, txConfig client.TxConfig, kbs cosmos.KeybaseStore) types.QueryServer {
-	return &queryServer{mgr: mgr, txConfig: txConfig, kbs: kbs}
+	return newQueryServer(mgr, txConfig, kbs, nil)
+}
+
+func newQueryServer(mgr *Mgrs, txConfig client.TxConfig, kbs cosmos.KeybaseStore, idx *history.Indexer) *queryServer {
+	return &queryServer{mgr: mgr, txConfig: txConfig, kbs: kbs, history: idx}
 }
 
 func (s *queryServer) unwrapSdkContext(c context.Context) sdk.Context {
@@ -85,6 +92,11 @@ func (s *queryServer) LiquidityProvider(c context.Context, req *types.QueryLiqui
 	return s.queryLiquidityProvider(ctx, req)
 }
 
+func (s *queryServer) LiquidityProviderHistory(c context.Context, req *types.QueryLiquidityProviderHistoryRequest) (*types.QueryLiquidityProviderHistoryResponse, error) {
+	ctx := s.unwrapSdkContext(c)
+	return s.queryLiquidityProviderHistory(ctx, req)
+}
+
 func (s *queryServer) LiquidityProviders(c context.Context, req *types.QueryLiquidityProvidersRequest) (*types.QueryLiquidityProvidersResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -101,6 +113,11 @@ func (s *queryServer) Saver(c context.Context, req *types.QuerySaverRequest) (*t
 	return s.querySaver(ctx, req)
 }
 
+func (s *queryServer) SaverHistory(c context.Context, req *types.QuerySaverHistoryRequest) (*types.QuerySaverHistoryResponse, error) {
+	ctx := s.unwrapSdkContext(c)
+	return s.querySaverHistory(ctx, req)
+}
+
 func (s *queryServer) Savers(c context.Context, req *types.QuerySaversRequest) (*types.QuerySaversResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -221,6 +238,14 @@ func (s *queryServer) OutboundFees(c context.Context, req *types.QueryOutboundFe
 	return s.queryOutboundFees(ctx, "")
 }
 
+func (s *queryServer) DexAggregators(c context.Context, req *types.QueryDexAggregatorsRequest) (*types.QueryDexAggregatorsResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryDexAggregators(ctx, req.Chain)
+}
+
 func (s *queryServer) StreamingSwap(c context.Context, req *types.QueryStreamingSwapRequest) (*types.QueryStreamingSwapResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -389,6 +414,14 @@ func (s *queryServer) QuoteSwap(c context.Context, req *types.QueryQuoteSwapRequ
 	return s.queryQuoteSwap(ctx, req)
 }
 
+func (s *queryServer) QuoteSwapBatch(c context.Context, req *types.QueryQuoteSwapBatchRequest) (*types.QueryQuoteSwapBatchResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryQuoteSwapBatch(ctx, req)
+}
+
 func (s *queryServer) QuoteSaverDeposit(c context.Context, req *types.QueryQuoteSaverDepositRequest) (*types.QueryQuoteSaverDepositResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -437,6 +470,22 @@ func (s *queryServer) SwapQueue(c context.Context, req *types.QuerySwapQueueRequ
 	return s.querySwapQueue(ctx, req)
 }
 
+func (s *queryServer) LimitSwapBook(c context.Context, req *types.QueryLimitSwapBookRequest) (*types.QueryLimitSwapBookResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryLimitSwapBook(ctx, req)
+}
+
+func (s *queryServer) LimitSwapsByAddress(c context.Context, req *types.QueryLimitSwapsByAddressRequest) (*types.QueryLimitSwapsByAddressResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryLimitSwapsByAddress(ctx, req)
+}
+
 func (s *queryServer) LastBlocks(c context.Context, req *types.QueryLastBlocksRequest) (*types.QueryLastBlocksResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -28,11 +28,11 @@ func NewInternalHandler(mgr Manager) cosmos.Handler {
 			return nil, cosmos.ErrUnknownRequest(errMsg)
 		}
 
-		// CacheContext() returns a context which caches all changes and only forwards
+		// cacheContext() returns a context which caches all changes and only forwards
 		// to the underlying context when commit() is called. Call commit() only when
 		// the handler succeeds, otherwise return error and the changes will be discarded.
-		// On commit, cached events also have to be explicitly emitted.
-		cacheCtx, commit := ctx.CacheContext()
+		// On commit, cached events and traced stages also have to be explicitly emitted.
+		cacheCtx, commit := cacheContext(ctx)
 		res, err := h.Run(cacheCtx, msg)
 		if err == nil {
 			// Success, commit the cached changes and events
@@ -75,6 +75,7 @@ func getInternalHandlerMapping(mgr Manager) map[string]MsgHandler {
 	m[sdk.MsgTypeURL(&MsgSWCYClaim{})] = NewSWCYClaimHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgSWCYStake{})] = NewSWCYStakeHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgSWCYUnstake{})] = NewSWCYUnstakeHandler(mgr)
+	m[sdk.MsgTypeURL(&MsgModifyLimitSwap{})] = NewModifyLimitSwapHandler(mgr)
 	return m
 }
 
@@ -130,6 +131,51 @@ func getMsgOutboundFromMemo(memo OutboundMemo, tx ObservedTx, signer cosmos.AccA
 	return NewMsgOutboundTx(tx, memo.GetTxID(), signer), nil
 }
 
+// getMsgBatchOutboundFromMemo resolves an output of a batched outbound to the TxOutItem
+// it pays, one scheduled for the observed vault at the block height of the memo, and
+// returns the msg of that item's own memo. The output either pays the exact coin of the
+// item (a router batch call), or the coin plus the part of the max gas left after its
+// share of the fee, which is observed as its gas (a UTXO batch tx).
+func getMsgBatchOutboundFromMemo(ctx cosmos.Context, keeper keeper.Keeper, memo BatchOutboundMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+	txOut, err := keeper.GetTxOut(ctx, memo.GetBlockHeight())
+	if err != nil {
+		return nil, fmt.Errorf("fail to get txout at height %d: %w", memo.GetBlockHeight(), err)
+	}
+	asset := tx.Tx.Chain.GetGasAsset()
+	actualSpend := tx.Tx.Coins.GetCoin(asset).Amount.Add(tx.Tx.Gas.ToCoins().GetCoin(asset).Amount)
+	for _, item := range txOut.TxArray {
+		if !item.OutHash.IsEmpty() ||
+			!item.Chain.Equals(tx.Tx.Chain) ||
+			!item.VaultPubKey.Equals(tx.ObservedPubKey) ||
+			!item.ToAddress.Equals(tx.Tx.ToAddress) {
+			continue
+		}
+		if !tx.Tx.Coins.EqualsEx(common.Coins{item.Coin}) {
+			if !item.Coin.Asset.Equals(asset) {
+				continue
+			}
+			intendToSpend := item.Coin.Amount.Add(item.MaxGas.ToCoins().GetCoin(asset).Amount)
+			if !intendToSpend.Equal(actualSpend) {
+				continue
+			}
+		}
+		// trunk-ignore(golangci-lint/govet): shadow
+		itemMemo, err := ParseMemoWithSWITCHNames(ctx, keeper, item.Memo)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse memo of batched outbound: %w", err)
+		}
+		switch m := itemMemo.(type) {
+		case OutboundMemo:
+			return getMsgOutboundFromMemo(m, tx, signer)
+		case RefundMemo:
+			return getMsgRefundFromMemo(m, tx, signer)
+		default:
+			return nil, fmt.Errorf("outbound with memo %s can't be batched", item.Memo)
+		}
+	}
+	return nil, fmt.Errorf("no outbound at height %d matches batch output %d", memo.GetBlockHeight(), memo.GetIndex())
+}
+
 func getMsgMigrateFromMemo(memo MigrateMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
 	return NewMsgMigrate(tx, memo.GetBlockHeight(), signer), nil
 }
@@ -206,6 +252,8 @@ func processOneTxIn(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, sig
 		newMsg, err = getMsgRefundFromMemo(m, tx, signer)
 	case OutboundMemo:
 		newMsg, err = getMsgOutboundFromMemo(m, tx, signer)
+	case BatchOutboundMemo:
+		newMsg, err = getMsgBatchOutboundFromMemo(ctx, keeper, m, tx, signer)
 	case MigrateMemo:
 		newMsg, err = getMsgMigrateFromMemo(m, tx, signer)
 	case BondMemo:
@@ -265,6 +313,8 @@ func processOneTxIn(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, sig
 		newMsg = NewMsgSWCYUnstake(tx.Tx, m.BasisPoints, signer)
 	case MaintMemo:
 		newMsg, err = getMsgMaintFromMemo(m, signer)
+	case ModifyLimitSwapMemo:
+		newMsg = NewMsgModifyLimitSwap(tx.Tx, m.TxID, m.ModifiedTargetAmount, signer)
 	default:
 		return nil, errInvalidMemo
 	}
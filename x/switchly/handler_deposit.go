@@ -119,12 +119,18 @@ func (h DepositHandler) handle(ctx cosmos.Context, msg MsgDeposit) (*cosmos.Resu
 		return nil, fmt.Errorf("cannot send inbound an outbound or internal transaction")
 	}
 
+	// the modify/cancel memo only carries instructions, reject native funds sent
+	// with it rather than moving them to the reserve
+	if memo.GetType() == TxModifyLimitSwap && !msg.Coins[0].Amount.IsZero() {
+		return nil, fmt.Errorf("(%s) memos must not carry funds", memo.GetType().String())
+	}
+
 	var targetModule string
 	switch memo.GetType() {
 	case TxBond, TxUnBond, TxLeave:
 		targetModule = BondName
 	// For TxSWCYClaim, send to Reserve so retrievable if done accidentally
-	case TxReserve, TxSWITCHName, TxSWCYClaim, TxMaint:
+	case TxReserve, TxSWITCHName, TxSWCYClaim, TxMaint, TxModifyLimitSwap:
 		targetModule = ReserveName
 	case TxSWCYStake, TxSWCYUnstake:
 		targetModule = SWCYStakeName
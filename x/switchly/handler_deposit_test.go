@@ -298,3 +298,25 @@ func (s *HandlerDepositSuite) TestTargetModule(c *C) {
 		tc.validator(c, ctx, result, err, tc.name, balDelta)
 	}
 }
+
+func (s *HandlerDepositSuite) TestModifyLimitSwapWithCoins(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewDepositHandler(mgr)
+	addr := GetRandomBech32Addr()
+	FundAccount(c, ctx, mgr.Keeper(), addr, 20*common.One)
+	memo := "m=<:" + GetRandomTxHash().String() + ":100"
+
+	// native funds sent with a modify/cancel memo are rejected rather than kept by
+	// the reserve
+	reserveBefore := mgr.Keeper().GetSWITCHBalanceOfModule(ctx, ReserveName)
+	coin := common.NewCoin(common.SwitchNative, cosmos.NewUint(20*common.One))
+	_, err := handler.Run(ctx, NewMsgDeposit(common.Coins{coin}, memo, addr))
+	c.Check(err, ErrorMatches, ".*memos must not carry funds")
+	c.Check(mgr.Keeper().GetSWITCHBalanceOfModule(ctx, ReserveName).Equal(reserveBefore), Equals, true)
+	c.Check(mgr.Keeper().GetBalance(ctx, addr).AmountOf(common.SwitchNative.Native()).Int64(), Equals, int64(20*common.One))
+
+	// without funds the memo is passed on to the modify limit swap handler
+	coin = common.NewCoin(common.SwitchNative, cosmos.ZeroUint())
+	_, err = handler.Run(ctx, NewMsgDeposit(common.Coins{coin}, memo, addr))
+	c.Check(err, ErrorMatches, "advanced swap queue is not enabled")
+}
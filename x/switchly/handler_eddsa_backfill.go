@@ -0,0 +1,260 @@
+package switchly
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
+)
+
+// EdDSABackfillHandler is to handle EdDSABackfill message. Once a supermajority of the
+// active nodes have voted to backfill a vault, an EdDSA-only keygen is scheduled among
+// the vault's members, the resulting group key is attached to the vault by the tss
+// handler.
+type EdDSABackfillHandler struct {
+	mgr Manager
+}
+
+// NewEdDSABackfillHandler create new instance of EdDSABackfillHandler
+func NewEdDSABackfillHandler(mgr Manager) EdDSABackfillHandler {
+	return EdDSABackfillHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point to execute EdDSABackfill logic
+func (h EdDSABackfillHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgEdDSABackfill)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("msg eddsa backfill failed validation", "error", err)
+		return nil, err
+	}
+	return h.handle(ctx, *msg)
+}
+
+func (h EdDSABackfillHandler) validate(ctx cosmos.Context, msg MsgEdDSABackfill) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h EdDSABackfillHandler) validateV3_0_0(ctx cosmos.Context, msg MsgEdDSABackfill) error {
+	// ValidateBasic is also executed in message service router's handler and isn't versioned there
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !isSignedByActiveNodeAccounts(ctx, h.mgr.Keeper(), msg.GetSigners()) {
+		return cosmos.ErrUnauthorized(errNotAuthorized.Error())
+	}
+
+	enabled, err := h.mgr.Keeper().GetMimir(ctx, constants.MimirKeyEdDSAKeygenEnabled)
+	if err != nil {
+		return fmt.Errorf("fail to get mimir: %w", err)
+	}
+	if enabled <= 0 {
+		return cosmos.ErrUnknownRequest("eddsa keygen is disabled")
+	}
+
+	vault, err := h.mgr.Keeper().GetVault(ctx, msg.PoolPubKey)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get vault(%s)", msg.PoolPubKey))
+	}
+	if !vault.IsAsgard() || vault.Status != ActiveVault {
+		return cosmos.ErrUnknownRequest("only active asgard vaults can be backfilled")
+	}
+	if vault.HasEd25519PubKey() {
+		return cosmos.ErrUnknownRequest("vault already has an ed25519 pubkey")
+	}
+	if len(vault.Membership) == 0 {
+		return cosmos.ErrUnknownRequest("vault has no members")
+	}
+
+	return nil
+}
+
+func (h EdDSABackfillHandler) handle(ctx cosmos.Context, msg MsgEdDSABackfill) (*cosmos.Result, error) {
+	ctx.Logger().Info("handleMsgEdDSABackfill request", "pubkey", msg.PoolPubKey.String())
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		ctx.Logger().Error(errInvalidVersion.Error())
+		return nil, errBadVersion
+	}
+}
+
+func (h EdDSABackfillHandler) handleV3_0_0(ctx cosmos.Context, msg MsgEdDSABackfill) (*cosmos.Result, error) {
+	active, err := h.mgr.Keeper().ListActiveValidators(ctx)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get list of active node accounts")
+		return nil, err
+	}
+
+	voter, err := h.mgr.Keeper().GetEdDSABackfillVoter(ctx, msg.PoolPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// a backfill that has been processed but did not produce a key (the ceremony failed
+	// or its members disagreed) can be voted for again once the retry interval passed
+	if voter.BlockHeight > 0 {
+		retryInterval := h.mgr.Keeper().GetConfigInt64(ctx, constants.ChurnRetryInterval)
+		if ctx.BlockHeight()-voter.BlockHeight < retryInterval {
+			return &cosmos.Result{}, nil
+		}
+		voter = NewEdDSABackfillVoter(msg.PoolPubKey)
+	}
+
+	voter.Sign(msg.Signer)
+	h.mgr.Keeper().SetEdDSABackfillVoter(ctx, voter)
+
+	// doesn't have consensus yet
+	if !voter.HasConsensus(active) {
+		ctx.Logger().Info("not having consensus yet, return")
+		return &cosmos.Result{}, nil
+	}
+
+	voter.BlockHeight = ctx.BlockHeight()
+	h.mgr.Keeper().SetEdDSABackfillVoter(ctx, voter)
+
+	vault, err := h.mgr.Keeper().GetVault(ctx, msg.PoolPubKey)
+	if err != nil {
+		return nil, ErrInternal(err, fmt.Sprintf("fail to get vault(%s)", msg.PoolPubKey))
+	}
+	// a backfill vote starts a new round of retries
+	h.mgr.Keeper().SetEdDSAKeygenRetries(ctx, vault.PubKey, 0)
+	if err := triggerEdDSAKeygen(ctx, h.mgr, vault); err != nil {
+		return nil, err
+	}
+
+	return &cosmos.Result{}, nil
+}
+
+// migrateStellarPlaceholderFunds schedules the migration of the vault's XLM funds from
+// the placeholder address derived from its secp256k1 key to the address derived from
+// its newly attached ed25519 key. Funds received before the vault had an ed25519 key
+// sit at the placeholder address.
+func migrateStellarPlaceholderFunds(ctx cosmos.Context, mgr Manager, vault Vault) error {
+	if !vault.HasEd25519PubKey() || vault.CoinLengthByChain(common.StellarChain) == 0 {
+		return nil
+	}
+	toAddr, err := vault.PubKeyForChain(common.StellarChain).GetAddress(common.StellarChain)
+	if err != nil {
+		return fmt.Errorf("fail to get stellar address of vault(%s): %w", vault.PubKey, err)
+	}
+	gas, err := mgr.GasMgr().GetMaxGas(ctx, common.StellarChain)
+	if err != nil {
+		return fmt.Errorf("fail to get max gas: %w", err)
+	}
+
+	scheduled := false
+	for _, coin := range vault.Coins {
+		if !coin.Asset.GetChain().Equals(common.StellarChain) || coin.IsEmpty() {
+			continue
+		}
+		amt := coin.Amount
+		if coin.Asset.Equals(common.StellarChain.GetGasAsset()) {
+			// the gas of every migration is paid from the gas asset, and the account
+			// reserve can't be transferred
+			gasAmount := gas.Amount.MulUint64(uint64(vault.CoinLengthByChain(common.StellarChain)))
+			amt = common.SafeSub(amt, gasAmount)
+			amt = common.SafeSub(amt, common.StellarChain.DustThreshold())
+		}
+		amt = cosmos.RoundToDecimal(amt, coin.Decimals)
+		if amt.IsZero() {
+			continue
+		}
+		toi := TxOutItem{
+			Chain:       common.StellarChain,
+			InHash:      common.BlankTxID,
+			ToAddress:   toAddr,
+			VaultPubKey: vault.PubKey,
+			Coin:        common.NewCoin(coin.Asset, amt),
+			Memo:        NewMigrateMemo(ctx.BlockHeight()).String(),
+		}
+		ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint())
+		if err != nil && !errors.Is(err, ErrNotEnoughToPayFee) {
+			return err
+		}
+		if ok {
+			scheduled = true
+		}
+	}
+	if !scheduled {
+		return nil
+	}
+
+	vault.AppendPendingTxBlockHeights(ctx.BlockHeight(), mgr.GetConstants())
+	if err := mgr.Keeper().SetVault(ctx, vault); err != nil {
+		return fmt.Errorf("fail to save vault: %w", err)
+	}
+	ctx.Logger().Info("stellar placeholder funds migration scheduled", "pubkey", vault.PubKey, "to", toAddr)
+	return nil
+}
+
+// rotateXRPSignerList schedules the installation of the vault's newly attached ed25519
+// key as the signer of its XRP account, after which bifrost multi-signs the account's
+// outbounds with it. The account stays derived from the secp256k1 key, the rotation is
+// a migration of the vault to its own address that bifrost signs as a SignerListSet and
+// reports as the transfer of XRPSignerListSetAmount. A vault without XRP has no account
+// on the ledger yet, it keeps signing with its secp256k1 key.
+func rotateXRPSignerList(ctx cosmos.Context, mgr Manager, vault Vault) error {
+	if !vault.HasEd25519PubKey() || vault.GetCoin(common.XRPAsset).IsEmpty() {
+		return nil
+	}
+	vaultAddr, err := vault.PubKey.GetAddress(common.XRPChain)
+	if err != nil {
+		return fmt.Errorf("fail to get xrp address of vault(%s): %w", vault.PubKey, err)
+	}
+	toi := TxOutItem{
+		Chain:       common.XRPChain,
+		InHash:      common.BlankTxID,
+		ToAddress:   vaultAddr,
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.XRPAsset, common.XRPSignerListSetAmount),
+		Memo:        NewMigrateMemo(ctx.BlockHeight()).String(),
+	}
+	ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	vault.AppendPendingTxBlockHeights(ctx.BlockHeight(), mgr.GetConstants())
+	if err := mgr.Keeper().SetVault(ctx, vault); err != nil {
+		return fmt.Errorf("fail to save vault: %w", err)
+	}
+	ctx.Logger().Info("xrp signer list rotation scheduled", "pubkey", vault.PubKey, "signer", vault.Ed25519PubKey)
+	return nil
+}
+
+// isXRPSignerListRotation returns true when the outbound is a signer list rotation
+// scheduled by rotateXRPSignerList, a migration from the vault's XRP account to itself.
+func isXRPSignerListRotation(toi TxOutItem, fromAddress common.Address) bool {
+	return toi.Chain.Equals(common.XRPChain) &&
+		toi.ToAddress.Equals(fromAddress) &&
+		toi.Coin.Equals(common.NewCoin(common.XRPAsset, common.XRPSignerListSetAmount))
+}
+
+// EdDSABackfillAnteHandler called by the ante handler to gate mempool entry
+// and also during deliver. Store changes will persist if this function
+// succeeds, regardless of the success of the transaction.
+func EdDSABackfillAnteHandler(ctx cosmos.Context, v semver.Version, k keeper.Keeper, msg MsgEdDSABackfill) (cosmos.Context, error) {
+	return activeNodeAccountsSignerPriority(ctx, k, msg.GetSigners())
+}
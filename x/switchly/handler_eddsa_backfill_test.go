@@ -0,0 +1,217 @@
+package switchly
+
+import (
+	"errors"
+	"sort"
+
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
+)
+
+var _ = Suite(&HandlerEdDSABackfillSuite{})
+
+type HandlerEdDSABackfillSuite struct{}
+
+func (s *HandlerEdDSABackfillSuite) setup(c *C) (cosmos.Context, *Mgrs, NodeAccounts, Vault) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(1024)
+
+	nas := NodeAccounts{
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+	}
+	var members common.PubKeys
+	for _, na := range nas {
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+		members = append(members, na.PubKeySet.Secp256k1)
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].String() < members[j].String()
+	})
+	vault := GetRandomVault()
+	vault.Membership = members.Strings()
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	mgr.Keeper().SetMimir(ctx, constants.MimirKeyEdDSAKeygenEnabled, 1)
+	return ctx, mgr, nas, vault
+}
+
+func (s *HandlerEdDSABackfillSuite) TestValidate(c *C) {
+	ctx, mgr, nas, vault := s.setup(c)
+	handler := NewEdDSABackfillHandler(mgr)
+
+	msg := NewMsgEdDSABackfill(vault.PubKey, nas[0].NodeAddress)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+
+	// invalid msg
+	c.Check(handler.validate(ctx, MsgEdDSABackfill{}), NotNil)
+
+	// not signed by an active node
+	err := handler.validate(ctx, *NewMsgEdDSABackfill(vault.PubKey, GetRandomBech32Addr()))
+	c.Check(errors.Is(err, se.ErrUnauthorized), Equals, true)
+
+	// unknown vault
+	c.Check(handler.validate(ctx, *NewMsgEdDSABackfill(GetRandomPubKey(), nas[0].NodeAddress)), NotNil)
+
+	// vault already has an ed25519 key
+	vault.Ed25519PubKey = GetRandomEd25519PubKey()
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	c.Check(handler.validate(ctx, *msg), NotNil)
+
+	// the placeholder is not a real ed25519 key
+	vault.Ed25519PubKey = vault.PubKey
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	c.Check(handler.validate(ctx, *msg), IsNil)
+
+	// retiring vault
+	vault.Status = RetiringVault
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	c.Check(handler.validate(ctx, *msg), NotNil)
+	vault.Status = ActiveVault
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+
+	// eddsa keygen disabled
+	mgr.Keeper().SetMimir(ctx, constants.MimirKeyEdDSAKeygenEnabled, 0)
+	c.Check(handler.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerEdDSABackfillSuite) TestHandle(c *C) {
+	ctx, mgr, nas, vault := s.setup(c)
+	handler := NewEdDSABackfillHandler(mgr)
+
+	hasKeygen := func(ctx cosmos.Context) bool {
+		keygenBlock, err := mgr.Keeper().GetKeygenBlock(ctx, ctx.BlockHeight())
+		c.Assert(err, IsNil)
+		for _, keygen := range keygenBlock.Keygens {
+			if keygen.Type == EdDSAKeygen && keygen.PoolPubKey.Equals(vault.PubKey) {
+				c.Check(keygen.GetMembers().Strings(), DeepEquals, vault.Membership)
+				return true
+			}
+		}
+		return false
+	}
+
+	for idx, na := range nas {
+		_, err := handler.Run(ctx, NewMsgEdDSABackfill(vault.PubKey, na.NodeAddress))
+		c.Assert(err, IsNil)
+		voter, err := mgr.Keeper().GetEdDSABackfillVoter(ctx, vault.PubKey)
+		c.Assert(err, IsNil)
+		consensus := HasSuperMajority(idx+1, len(nas))
+		c.Check(hasKeygen(ctx), Equals, consensus, Commentf("%d", idx))
+		if consensus {
+			c.Check(voter.BlockHeight, Equals, ctx.BlockHeight())
+		} else {
+			c.Check(voter.BlockHeight, Equals, int64(0))
+		}
+	}
+	keygenBlock, err := mgr.Keeper().GetKeygenBlock(ctx, ctx.BlockHeight())
+	c.Assert(err, IsNil)
+	c.Check(keygenBlock.Keygens, HasLen, 1)
+
+	// votes after consensus aren't counted, no new round before the retry interval passed
+	retryInterval := mgr.Keeper().GetConfigInt64(ctx, constants.ChurnRetryInterval)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + retryInterval - 1)
+	_, err = handler.Run(ctx, NewMsgEdDSABackfill(vault.PubKey, nas[0].NodeAddress))
+	c.Assert(err, IsNil)
+	voter, err := mgr.Keeper().GetEdDSABackfillVoter(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 3)
+	c.Check(voter.HasSigned(nas[3].NodeAddress), Equals, false)
+
+	// the keygen didn't produce a key, the nodes can vote again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, na := range nas[:3] {
+		_, err = handler.Run(ctx, NewMsgEdDSABackfill(vault.PubKey, na.NodeAddress))
+		c.Assert(err, IsNil)
+	}
+	voter, err = mgr.Keeper().GetEdDSABackfillVoter(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 3)
+	c.Check(voter.BlockHeight, Equals, ctx.BlockHeight())
+	c.Check(hasKeygen(ctx), Equals, true)
+}
+
+func (s *HandlerEdDSABackfillSuite) TestMigrateStellarPlaceholderFunds(c *C) {
+	ctx, mgr, _, vault := s.setup(c)
+
+	xlm := cosmos.NewUint(100 * common.One)
+	vault.Coins = common.NewCoins(
+		common.NewCoin(common.XLMAsset, xlm),
+		common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)),
+	)
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+
+	// nothing to migrate without an ed25519 key
+	c.Assert(migrateStellarPlaceholderFunds(ctx, mgr, vault), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	vault.Ed25519PubKey = GetRandomEd25519PubKey()
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	c.Assert(migrateStellarPlaceholderFunds(ctx, mgr, vault), IsNil)
+
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	toAddr, err := vault.PubKeyForChain(common.StellarChain).GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	gas, err := mgr.GasMgr().GetMaxGas(ctx, common.StellarChain)
+	c.Assert(err, IsNil)
+	item := items[0]
+	c.Check(item.Chain.Equals(common.StellarChain), Equals, true)
+	c.Check(item.ToAddress.Equals(toAddr), Equals, true)
+	c.Check(item.VaultPubKey.Equals(vault.PubKey), Equals, true)
+	c.Check(item.Memo, Equals, NewMigrateMemo(ctx.BlockHeight()).String())
+	expected := common.SafeSub(common.SafeSub(xlm, gas.Amount), common.StellarChain.DustThreshold())
+	c.Check(item.Coin.Amount.String(), Equals, expected.String())
+
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.PendingTxBlockHeights, DeepEquals, []int64{ctx.BlockHeight()})
+}
+
+func (s *HandlerEdDSABackfillSuite) TestRotateXRPSignerList(c *C) {
+	ctx, mgr, _, vault := s.setup(c)
+
+	// a vault without XRP has no account to rotate the signer of
+	edpk := GetRandomEd25519PubKey()
+	c.Assert(attachEd25519PubKey(ctx, mgr, vault.PubKey, edpk), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	vault.Ed25519PubKey = common.EmptyPubKey
+	vault.Coins = common.NewCoins(common.NewCoin(common.XRPAsset, cosmos.NewUint(100*common.One)))
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	c.Assert(mgr.Keeper().SaveNetworkFee(ctx, common.XRPChain, NewNetworkFee(common.XRPChain, 1, 2400)), IsNil)
+	c.Assert(attachEd25519PubKey(ctx, mgr, vault.PubKey, edpk), IsNil)
+
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	vaultAddr, err := vault.PubKey.GetAddress(common.XRPChain)
+	c.Assert(err, IsNil)
+	item := items[0]
+	c.Check(item.Chain.Equals(common.XRPChain), Equals, true)
+	c.Check(item.ToAddress.Equals(vaultAddr), Equals, true)
+	c.Check(item.VaultPubKey.Equals(vault.PubKey), Equals, true)
+	c.Check(item.Memo, Equals, NewMigrateMemo(ctx.BlockHeight()).String())
+	c.Check(item.Coin.Equals(common.NewCoin(common.XRPAsset, common.XRPSignerListSetAmount)), Equals, true)
+
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Ed25519PubKey.Equals(edpk), Equals, true)
+	c.Check(vault.PendingTxBlockHeights, DeepEquals, []int64{ctx.BlockHeight()})
+
+	// the key is attached once, the rotation isn't scheduled again
+	c.Assert(attachEd25519PubKey(ctx, mgr, vault.PubKey, GetRandomEd25519PubKey()), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 1)
+}
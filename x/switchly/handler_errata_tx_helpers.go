@@ -78,7 +78,10 @@ func processErrataTxAttestation(
 	}
 
 	if len(observedVoter.Txs) == 0 {
-		return processErrataOutboundTx(ctx, k, eventMgr, er)
+		if err = processErrataOutboundTx(ctx, k, eventMgr, er); err != nil {
+			return err
+		}
+		return refundReturnedIBCOutbound(ctx, mgr, er)
 	}
 	// set the observed Tx to reverted
 	observedVoter.SetReverted()
@@ -283,3 +286,65 @@ func processErrataOutboundTx(ctx cosmos.Context, k keeper.Keeper, eventMgr Event
 	k.SetObservedTxOutVoter(ctx, txOutVoter)
 	return nil
 }
+
+// refundReturnedIBCOutbound pays out again an outbound IBC transfer which timed out or was
+// acknowledged with an error. Unlike a re-org, the transfer module returned the coins to the
+// vault, so the outbound definitely didn't pay, and once the vault is credited back by
+// processErrataOutboundTx the coins are refunded to the sender of the inbound. When the
+// sender can't be paid on the chain, the outbound is sent to its destination again.
+func refundReturnedIBCOutbound(ctx cosmos.Context, mgr Manager, er *common.ErrataTx) error {
+	k := mgr.Keeper()
+	txOutVoter, err := k.GetObservedTxOutVoter(ctx, er.Id)
+	if err != nil {
+		return fmt.Errorf("fail to get observed tx out voter for tx (%s) : %w", er.Id, err)
+	}
+	tx := txOutVoter.Tx.Tx
+	if !tx.ToAddress.IsIBCChain(tx.Chain) {
+		return nil
+	}
+	vault, err := k.GetVault(ctx, txOutVoter.Tx.ObservedPubKey)
+	if err != nil {
+		return fmt.Errorf("fail to get vault with pubkey %s: %w", txOutVoter.Tx.ObservedPubKey, err)
+	}
+	if !vault.IsAsgard() {
+		// the coins were not credited back to a vault
+		return nil
+	}
+	m, err := ParseMemoWithSWITCHNames(ctx, k, tx.Memo)
+	if err != nil || !m.IsOutbound() {
+		return nil
+	}
+
+	inHash := m.GetTxID()
+	toAddr := tx.ToAddress
+	memo := tx.Memo
+	inVoter, err := k.GetObservedTxInVoter(ctx, inHash)
+	if err == nil && !inVoter.Tx.IsEmpty() {
+		from := inVoter.Tx.Tx.FromAddress
+		if !from.Equals(tx.ToAddress) && (from.IsChain(tx.Chain) || from.IsIBCChain(tx.Chain)) {
+			toAddr = from
+			memo = NewRefundMemo(inHash).String()
+		}
+	}
+
+	for _, coin := range tx.Coins {
+		toi := TxOutItem{
+			Chain:     tx.Chain,
+			InHash:    inHash,
+			ToAddress: toAddr,
+			Coin:      coin,
+			Memo:      memo,
+		}
+		// trunk-ignore(golangci-lint/govet): shadow
+		ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint())
+		if err != nil {
+			ctx.Logger().Error("fail to refund returned ibc outbound", "tx_id", er.Id, "error", err)
+			unrefundableCoinCleanup(ctx, mgr, toi, "failed_refund")
+			continue
+		}
+		if ok {
+			ctx.Logger().Info("refund returned ibc outbound", "tx_id", er.Id, "to", toAddr, "coin", coin)
+		}
+	}
+	return nil
+}
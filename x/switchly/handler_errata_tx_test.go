@@ -733,6 +733,73 @@ func (*HandlerErrataTxSuite) TestProcessErrortaOutboundTx_EnsureMigrateTxWillSet
 	c.Assert(ltcCoin.Equals(common.NewCoin(common.LTCAsset, cosmos.NewUint(102400000))), Equals, true)
 }
 
+func (*HandlerErrataTxSuite) TestRefundReturnedIBCOutbound(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+
+	vault := GetRandomVault()
+	vault.Chains = append(vault.Chains, common.GAIAChain.String())
+	vault.AddFunds(common.Coins{common.NewCoin(common.ATOMAsset, cosmos.NewUint(10*common.One))})
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	pool := NewPool()
+	pool.Asset = common.ATOMAsset
+	pool.BalanceAsset = cosmos.NewUint(1024 * common.One)
+	pool.BalanceSwitch = cosmos.NewUint(1024 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	c.Assert(k.SaveNetworkFee(ctx, common.GAIAChain, NetworkFee{
+		Chain:              common.GAIAChain,
+		TransactionSize:    1,
+		TransactionFeeRate: 7500,
+	}), IsNil)
+
+	destination := common.Address("channel-141-osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	returned := func(from common.Address) *common.ErrataTx {
+		inTx := GetRandomTx()
+		inTx.FromAddress = from
+		inTx.Memo = "SWAP:GAIA.ATOM:" + destination.String()
+		k.SetObservedTxInVoter(ctx, NewObservedTxVoter(inTx.ID, []common.ObservedTx{
+			NewObservedTx(inTx, 1024, vault.PubKey, 1024),
+		}))
+		voter, err := k.GetObservedTxInVoter(ctx, inTx.ID)
+		c.Assert(err, IsNil)
+		voter.Tx = voter.Txs[0]
+		k.SetObservedTxInVoter(ctx, voter)
+
+		outTx := common.NewTx(GetRandomTxHash(), GetRandomGAIAAddress(), destination,
+			common.Coins{common.NewCoin(common.ATOMAsset, cosmos.NewUint(common.One))},
+			common.Gas{common.NewCoin(common.ATOMAsset, cosmos.NewUint(7500))},
+			"OUT:"+inTx.ID.String())
+		outVoter := NewObservedTxVoter(outTx.ID, []common.ObservedTx{
+			NewObservedTx(outTx, 1024, vault.PubKey, 1024),
+		})
+		outVoter.Tx = outVoter.Txs[0]
+		k.SetObservedTxOutVoter(ctx, outVoter)
+		return &common.ErrataTx{Id: outTx.ID, Chain: common.GAIAChain}
+	}
+
+	// the gaia sender of the inbound is refunded
+	sender := GetRandomGAIAAddress()
+	er := returned(sender)
+	c.Assert(processErrataOutboundTx(ctx, k, mgr.EventMgr(), er), IsNil)
+	c.Assert(refundReturnedIBCOutbound(ctx, mgr, er), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].ToAddress, Equals, sender)
+	c.Check(items[0].Memo, Matches, "REFUND:.*")
+
+	// the sender of another chain can't be paid, the destination is paid again
+	er = returned(GetRandomBTCAddress())
+	c.Assert(processErrataOutboundTx(ctx, k, mgr.EventMgr(), er), IsNil)
+	c.Assert(refundReturnedIBCOutbound(ctx, mgr, er), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[1].ToAddress, Equals, destination)
+	c.Check(items[1].Memo, Matches, "OUT:.*")
+}
+
 func (s *HandlerErrataTxSuite) TestObservingSlashing(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	height := int64(1024)
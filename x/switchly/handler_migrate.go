@@ -93,12 +93,18 @@ func (h MigrateHandler) handleV3_0_0(ctx cosmos.Context, msg MsgMigrate) (*cosmo
 		// Resolve the source vault's address via PubKeyForChain so a Stellar migration matches its real
 		// ed25519-derived from-address (the bare secp256k1 key only yields the placeholder); falls back
 		// to the bare key for non-ed25519 chains or if the vault is gone.
-		fromAddress, _ := tx.VaultPubKey.GetAddress(tx.Chain)
+		placeholderAddress, _ := tx.VaultPubKey.GetAddress(tx.Chain)
+		fromAddress := placeholderAddress
 		if vault, vErr := h.mgr.Keeper().GetVault(ctx, tx.VaultPubKey); vErr == nil && !vault.IsEmpty() {
 			if a, aErr := vault.PubKeyForChain(tx.Chain).GetAddress(tx.Chain); aErr == nil {
 				fromAddress = a
 			}
 		}
+		// an EdDSA backfill migrates the vault's funds from the placeholder address to
+		// its own ed25519-derived address, so that migration is sent from the placeholder
+		if tx.ToAddress.Equals(fromAddress) && !fromAddress.Equals(placeholderAddress) {
+			fromAddress = placeholderAddress
+		}
 
 		if tx.InHash.Equals(common.BlankTxID) &&
 			tx.OutHash.IsEmpty() &&
@@ -128,6 +134,11 @@ func (h MigrateHandler) handleV3_0_0(ctx cosmos.Context, msg MsgMigrate) (*cosmo
 			txOut.TxArray[i].OutHash = msg.Tx.Tx.ID
 			shouldSlash = false
 
+			// the vault's ed25519 key now multi-signs the outbounds of its XRP account
+			if isXRPSignerListRotation(tx, fromAddress) {
+				h.mgr.Keeper().SetVaultSigners(ctx, common.XRPChain, tx.VaultPubKey, common.XRPSignerListSigners)
+			}
+
 			if err = h.mgr.Keeper().SetTxOut(ctx, txOut); nil != err {
 				return nil, ErrInternal(err, "fail to save tx out")
 			}
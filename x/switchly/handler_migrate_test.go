@@ -162,6 +162,113 @@ func (HandlerMigrateSuite) TestMigrateHappyPath(c *C) {
 	c.Assert(keeper.txout.TxArray[0].OutHash.Equals(tx.Tx.ID), Equals, true)
 }
 
+func (HandlerMigrateSuite) TestMigrateStellarPlaceholder(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	vault := GetRandomVault()
+	vault.Ed25519PubKey = GetRandomEd25519PubKey()
+
+	// an EdDSA backfill migrates the vault's XLM to its own ed25519-derived address
+	vaultAddr, err := vault.PubKeyForChain(common.StellarChain).GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	placeholderAddr, err := vault.PubKey.GetAddress(common.StellarChain)
+	c.Assert(err, IsNil)
+	txout := NewTxOut(1)
+	txout.TxArray = append(txout.TxArray, TxOutItem{
+		Chain:       common.StellarChain,
+		InHash:      common.BlankTxID,
+		ToAddress:   vaultAddr,
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.XLMAsset, cosmos.NewUint(1024)),
+		Memo:        NewMigrateMemo(1).String(),
+	})
+	keeper := &TestMigrateKeeperHappyPath{
+		Keeper:            k,
+		activeNodeAccount: GetRandomValidatorNode(NodeActive),
+		newVault:          vault,
+		retireVault:       vault,
+		txout:             txout,
+	}
+	handler := NewMigrateHandler(NewDummyMgrWithKeeper(keeper))
+	observe := func(from common.Address) ObservedTx {
+		return NewObservedTx(common.Tx{
+			ID:    GetRandomTxHash(),
+			Chain: common.StellarChain,
+			Coins: common.Coins{
+				common.NewCoin(common.XLMAsset, cosmos.NewUint(1024)),
+			},
+			Memo:        NewMigrateMemo(1).String(),
+			FromAddress: from,
+			ToAddress:   vaultAddr,
+			Gas: common.Gas{
+				common.NewCoin(common.XLMAsset, cosmos.NewUint(100)),
+			},
+		}, 1, vault.PubKey, 1)
+	}
+
+	// sent from the vault's own address, that's not the scheduled migration and the
+	// vault gets slashed
+	tx := observe(vaultAddr)
+	_, err = handler.Run(ctx, NewMsgMigrate(tx, 1, keeper.activeNodeAccount.NodeAddress))
+	c.Assert(err, NotNil)
+	c.Assert(keeper.txout.TxArray[0].OutHash.IsEmpty(), Equals, true)
+
+	tx = observe(placeholderAddr)
+	_, err = handler.Run(ctx, NewMsgMigrate(tx, 1, keeper.activeNodeAccount.NodeAddress))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.txout.TxArray[0].OutHash.Equals(tx.Tx.ID), Equals, true)
+}
+
+func (HandlerMigrateSuite) TestMigrateXRPSignerListRotation(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	vault := GetRandomVault()
+	vault.Ed25519PubKey = GetRandomEd25519PubKey()
+
+	vaultAddr, err := vault.PubKey.GetAddress(common.XRPChain)
+	c.Assert(err, IsNil)
+	txout := NewTxOut(1)
+	txout.TxArray = append(txout.TxArray, TxOutItem{
+		Chain:       common.XRPChain,
+		InHash:      common.BlankTxID,
+		ToAddress:   vaultAddr,
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.XRPAsset, common.XRPSignerListSetAmount),
+		Memo:        NewMigrateMemo(1).String(),
+	})
+	keeper := &TestMigrateKeeperHappyPath{
+		Keeper:            k,
+		activeNodeAccount: GetRandomValidatorNode(NodeActive),
+		newVault:          vault,
+		retireVault:       vault,
+		txout:             txout,
+	}
+	signers, err := k.GetVaultSigners(ctx, common.XRPChain, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(signers, Equals, int64(0))
+
+	handler := NewMigrateHandler(NewDummyMgrWithKeeper(keeper))
+	tx := NewObservedTx(common.Tx{
+		ID:    GetRandomTxHash(),
+		Chain: common.XRPChain,
+		Coins: common.Coins{
+			common.NewCoin(common.XRPAsset, common.XRPSignerListSetAmount),
+		},
+		Memo:        NewMigrateMemo(1).String(),
+		FromAddress: vaultAddr,
+		ToAddress:   vaultAddr,
+		Gas: common.Gas{
+			common.NewCoin(common.XRPAsset, cosmos.NewUint(2400)),
+		},
+	}, 1, vault.PubKey, 1)
+	_, err = handler.Run(ctx, NewMsgMigrate(tx, 1, keeper.activeNodeAccount.NodeAddress))
+	c.Assert(err, IsNil)
+	c.Assert(keeper.txout.TxArray[0].OutHash.Equals(tx.Tx.ID), Equals, true)
+
+	// the vault's outbounds are multi-signed from now on
+	signers, err = k.GetVaultSigners(ctx, common.XRPChain, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(signers, Equals, int64(common.XRPSignerListSigners))
+}
+
 func (HandlerMigrateSuite) TestSlash(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	retireVault := GetRandomVault()
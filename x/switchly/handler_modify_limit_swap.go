@@ -0,0 +1,145 @@
+package switchly
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// ModifyLimitSwapHandler updates the trade target of a resting limit swap in
+// the advanced swap queue, or removes and refunds it when the modified target
+// amount is zero
+type ModifyLimitSwapHandler struct {
+	mgr Manager
+}
+
+// NewModifyLimitSwapHandler create a new instance of ModifyLimitSwapHandler
+func NewModifyLimitSwapHandler(mgr Manager) ModifyLimitSwapHandler {
+	return ModifyLimitSwapHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point of ModifyLimitSwapHandler
+func (h ModifyLimitSwapHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgModifyLimitSwap)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	ctx.Logger().Info("receive MsgModifyLimitSwap", "swap tx id", msg.SwapTxID, "modified target", msg.ModifiedTargetAmount)
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgModifyLimitSwap failed validation", "error", err)
+		return nil, err
+	}
+
+	result, err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgModifyLimitSwap", "error", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (h ModifyLimitSwapHandler) validate(ctx cosmos.Context, msg MsgModifyLimitSwap) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h ModifyLimitSwapHandler) validateV3_0_0(ctx cosmos.Context, msg MsgModifyLimitSwap) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !h.mgr.Keeper().AdvSwapQueueEnabled(ctx) {
+		return fmt.Errorf("advanced swap queue is not enabled")
+	}
+
+	swap, err := h.mgr.Keeper().GetAdvSwapQueueItem(ctx, msg.SwapTxID)
+	if err != nil {
+		return fmt.Errorf("limit swap (%s) not found", msg.SwapTxID)
+	}
+	if swap.SwapType != LimitSwap {
+		return fmt.Errorf("swap (%s) is not a limit swap", msg.SwapTxID)
+	}
+	if !swap.Tx.FromAddress.Equals(msg.Tx.FromAddress) {
+		return fmt.Errorf("only the sender (%s) can modify limit swap (%s)", swap.Tx.FromAddress, msg.SwapTxID)
+	}
+	if h.mgr.Keeper().StreamingSwapExists(ctx, msg.SwapTxID) {
+		return fmt.Errorf("limit swap (%s) is partially filled and cannot be modified", msg.SwapTxID)
+	}
+	if !msg.IsCancel() && swap.TradeTarget.Equal(msg.ModifiedTargetAmount) {
+		return fmt.Errorf("limit swap (%s) already has a trade target of %s", msg.SwapTxID, msg.ModifiedTargetAmount)
+	}
+	return nil
+}
+
+func (h ModifyLimitSwapHandler) handle(ctx cosmos.Context, msg MsgModifyLimitSwap) (*cosmos.Result, error) {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		return nil, errBadVersion
+	}
+}
+
+func (h ModifyLimitSwapHandler) handleV3_0_0(ctx cosmos.Context, msg MsgModifyLimitSwap) (*cosmos.Result, error) {
+	swap, err := h.mgr.Keeper().GetAdvSwapQueueItem(ctx, msg.SwapTxID)
+	if err != nil {
+		return nil, err
+	}
+	source := swap.Tx.Coins[0]
+	target := common.NewCoin(swap.TargetAsset, swap.TradeTarget)
+
+	if msg.IsCancel() {
+		if err = h.mgr.Keeper().RemoveAdvSwapQueueItem(ctx, swap.Tx.ID); err != nil {
+			return nil, fmt.Errorf("fail to remove limit swap: %w", err)
+		}
+		if err = h.refund(ctx, swap.Tx, CodeSwapFail, "limit swap cancelled"); err != nil {
+			return nil, fmt.Errorf("fail to refund limit swap: %w", err)
+		}
+	} else {
+		// the index key is derived from the trade target, so drop the old
+		// index before the swap is re-queued under its new ratio
+		if err = h.mgr.Keeper().RemoveAdvSwapQueueIndex(ctx, swap); err != nil {
+			return nil, fmt.Errorf("fail to remove limit swap index: %w", err)
+		}
+		swap.TradeTarget = msg.ModifiedTargetAmount
+		if err = h.mgr.AdvSwapQueueMgr().AddSwapQueueItem(ctx, swap); err != nil {
+			return nil, fmt.Errorf("fail to update limit swap: %w", err)
+		}
+	}
+
+	// the modify/cancel memo only carries instructions, so any layer 1 funds
+	// sent along with it are returned to the sender. Native deposits with
+	// funds are rejected by the deposit handler.
+	if !msg.Tx.Chain.IsSWITCHLYChain() && !msg.Tx.Coins.IsEmpty() {
+		if err = h.refund(ctx, msg.Tx, CodeTxFail, "modify limit swap memos do not carry funds"); err != nil {
+			return nil, fmt.Errorf("fail to refund modify limit swap funds: %w", err)
+		}
+	}
+
+	evt := NewEventModifyLimitSwap(msg.Tx.FromAddress, source, target, msg.ModifiedTargetAmount, msg.Tx.ID, msg.SwapTxID)
+	if err = h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit modify limit swap event", "error", err)
+	}
+	return &cosmos.Result{}, nil
+}
+
+// refund returns the coins of the given inbound from the vault that observed
+// it, if known
+func (h ModifyLimitSwapHandler) refund(ctx cosmos.Context, inTx common.Tx, code uint32, reason string) error {
+	tx := ObservedTx{Tx: inTx}
+	voter, err := h.mgr.Keeper().GetObservedTxInVoter(ctx, inTx.ID)
+	if err == nil && !voter.Tx.IsEmpty() {
+		tx.ObservedPubKey = voter.Tx.ObservedPubKey
+	}
+	return refundTx(ctx, tx, h.mgr, code, reason, "")
+}
@@ -0,0 +1,147 @@
+package switchly
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
+)
+
+type HandlerModifyLimitSwapSuite struct{}
+
+var _ = Suite(&HandlerModifyLimitSwapSuite{})
+
+func (s *HandlerModifyLimitSwapSuite) setup(c *C) (cosmos.Context, *DummyMgr, MsgSwap) {
+	ctx, k := setupKeeperForTest(c)
+	k.SetMimir(ctx, constants.EnableAdvSwapQueue.String(), 1)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceSwitch = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	mgr := NewDummyMgrWithKeeper(k)
+	mgr.advSwapQueue = newSwapQueueAdvVCUR(k)
+
+	tx := GetRandomTx()
+	tx.Chain = common.BTCChain
+	tx.FromAddress = GetRandomBTCAddress()
+	tx.Coins = common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One))}
+	swap := NewMsgSwap(tx, common.SwitchNative, GetRandomSwitchAddress(), cosmos.NewUint(200*common.One),
+		common.NoAddress, cosmos.ZeroUint(), "", "", nil, LimitSwap, 0, 0, GetRandomBech32Addr())
+	c.Assert(mgr.AdvSwapQueueMgr().AddSwapQueueItem(ctx, *swap), IsNil)
+
+	return ctx, mgr, *swap
+}
+
+// modifyMsg builds a modify memo sent on BTC, carrying the dust amount a
+// layer 1 wallet has to send along with it
+func (s *HandlerModifyLimitSwapSuite) modifyMsg(swap MsgSwap, from common.Address, target cosmos.Uint) *MsgModifyLimitSwap {
+	tx := GetRandomTx()
+	tx.Chain = common.BTCChain
+	tx.FromAddress = from
+	tx.Coins = common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One/100))}
+	return NewMsgModifyLimitSwap(tx, swap.Tx.ID, target, GetRandomBech32Addr())
+}
+
+func (s *HandlerModifyLimitSwapSuite) TestValidate(c *C) {
+	ctx, mgr, swap := s.setup(c)
+	handler := NewModifyLimitSwapHandler(mgr)
+
+	msg := s.modifyMsg(swap, swap.Tx.FromAddress, cosmos.NewUint(150*common.One))
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+
+	// only the original sender can modify the limit swap
+	msg = s.modifyMsg(swap, GetRandomBTCAddress(), cosmos.NewUint(150*common.One))
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// unknown limit swap
+	msg = s.modifyMsg(swap, swap.Tx.FromAddress, cosmos.NewUint(150*common.One))
+	msg.SwapTxID = GetRandomTxHash()
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// unchanged trade target
+	msg = s.modifyMsg(swap, swap.Tx.FromAddress, swap.TradeTarget)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// partially filled limit swaps cannot be modified
+	mgr.Keeper().SetStreamingSwap(ctx, NewStreamingSwap(swap.Tx.ID, 2, 1, swap.TradeTarget, swap.Tx.Coins[0].Amount))
+	msg = s.modifyMsg(swap, swap.Tx.FromAddress, cosmos.NewUint(150*common.One))
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+	mgr.Keeper().RemoveStreamingSwap(ctx, swap.Tx.ID)
+
+	// advanced swap queue disabled
+	mgr.Keeper().SetMimir(ctx, constants.EnableAdvSwapQueue.String(), 0)
+	msg = s.modifyMsg(swap, swap.Tx.FromAddress, cosmos.NewUint(150*common.One))
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerModifyLimitSwapSuite) TestModify(c *C) {
+	ctx, mgr, swap := s.setup(c)
+	handler := NewModifyLimitSwapHandler(mgr)
+
+	newTarget := cosmos.NewUint(150 * common.One)
+	msg := s.modifyMsg(swap, swap.Tx.FromAddress, newTarget)
+	_, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	updated, err := mgr.Keeper().GetAdvSwapQueueItem(ctx, swap.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(updated.TradeTarget.Equal(newTarget), Equals, true)
+
+	// the old ratio index no longer holds the swap, the new one does
+	ok, err := mgr.Keeper().HasAdvSwapQueueIndex(ctx, swap)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+	ok, err = mgr.Keeper().HasAdvSwapQueueIndex(ctx, updated)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+
+	// only the funds sent with the modify memo are returned
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].InHash.Equals(msg.Tx.ID), Equals, true)
+	c.Check(items[0].ToAddress.Equals(swap.Tx.FromAddress), Equals, true)
+	c.Check(items[0].Coin.Asset.Equals(common.BTCAsset), Equals, true)
+
+	// the limit swap stays listed under its sender
+	iter := mgr.Keeper().GetAdvSwapQueueAddressIndexIterator(ctx, swap.Tx.FromAddress)
+	c.Assert(iter.Valid(), Equals, true)
+	c.Check(string(iter.Value()), Equals, swap.Tx.ID.String())
+	iter.Close()
+}
+
+func (s *HandlerModifyLimitSwapSuite) TestCancel(c *C) {
+	ctx, mgr, swap := s.setup(c)
+	handler := NewModifyLimitSwapHandler(mgr)
+
+	msg := s.modifyMsg(swap, swap.Tx.FromAddress, cosmos.ZeroUint())
+	_, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, swap.Tx.ID), Equals, false)
+	ok, err := mgr.Keeper().HasAdvSwapQueueIndex(ctx, swap)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	iter := mgr.Keeper().GetAdvSwapQueueAddressIndexIterator(ctx, swap.Tx.FromAddress)
+	c.Check(iter.Valid(), Equals, false)
+	iter.Close()
+
+	// both the limit swap and the funds sent with the cancel memo are returned
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].InHash.Equals(swap.Tx.ID), Equals, true)
+	c.Check(items[0].ToAddress.Equals(swap.Tx.FromAddress), Equals, true)
+	c.Check(items[0].Coin.Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(items[1].InHash.Equals(msg.Tx.ID), Equals, true)
+	c.Check(items[1].ToAddress.Equals(swap.Tx.FromAddress), Equals, true)
+
+	// a cancelled limit swap cannot be cancelled again
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
 )
@@ -152,6 +153,12 @@ func handleObservedTxInQuorum(
 	}
 
 	// all logic after this is upon consensus
+	traceTx(ctx, tx.Tx.ID, tracing.StageConsensus,
+		tracing.AttrChain.String(tx.Tx.Chain.String()),
+		tracing.AttrHeight.Int64(tx.BlockHeight),
+		tracing.AttrInbound.Bool(true),
+		tracing.AttrFinalised.Bool(tx.IsFinal()),
+	)
 
 	if voter.Reverted {
 		ctx.Logger().Info("tx had been reverted", "Tx", tx.String())
@@ -265,6 +272,7 @@ func handleObservedTxInQuorum(
 	// if its a swap, send it to our queue for processing later
 	if isSwap {
 		addSwap(ctx, k, mgr.AdvSwapQueueMgr(), mgr.EventMgr(), *swapMsg)
+		traceTx(ctx, tx.Tx.ID, tracing.StageSwapQueued, tracing.AttrMemo.String(tx.Tx.Memo))
 		return nil
 	}
 
@@ -310,6 +318,7 @@ func handleObservedTxInQuorum(
 	}
 
 	ctx.Logger().Info("tx in processed", "chain", tx.Tx.Chain, "id", tx.Tx.ID, "finalized", tx.IsFinal())
+	traceTx(ctx, tx.Tx.ID, tracing.StageProcessed, tracing.AttrMemo.String(tx.Tx.Memo))
 
 	return nil
 }
@@ -438,26 +447,26 @@ func processTxOutAttestation(
 
 // ensureVaultAndGetTxOutVoter will make sure the vault exists, then get the ObservedTxOutVoter from the store.
 // if it doesn't exist, it will create a new one.
-func ensureVaultAndGetTxOutVoter(ctx cosmos.Context, k keeper.Keeper, vaultPubKey common.PubKey, txID common.TxID, observers []cosmos.AccAddress, keysignMs int64) (ObservedTxVoter, error) {
+func ensureVaultAndGetTxOutVoter(ctx cosmos.Context, k keeper.Keeper, tx ObservedTx, observers []cosmos.AccAddress) (ObservedTxVoter, error) {
 	// check we are sending from a valid vault
-	if !k.VaultExists(ctx, vaultPubKey) {
-		ctx.Logger().Info("Not valid Observed Pubkey", "observed pub key", vaultPubKey)
-		return ObservedTxVoter{}, fmt.Errorf("vault not found for observed tx out pubkey: %s", vaultPubKey)
+	if !k.VaultExists(ctx, tx.ObservedPubKey) {
+		ctx.Logger().Info("Not valid Observed Pubkey", "observed pub key", tx.ObservedPubKey)
+		return ObservedTxVoter{}, fmt.Errorf("vault not found for observed tx out pubkey: %s", tx.ObservedPubKey)
 	}
 
-	if keysignMs > 0 {
-		keysignMetric, err := k.GetTssKeysignMetric(ctx, txID)
+	if tx.KeysignMs > 0 {
+		keysignMetric, err := k.GetTssKeysignMetric(ctx, tx.Tx.ID)
 		if err != nil {
 			ctx.Logger().Error("fail to get keysign metric", "error", err)
 		} else {
 			for _, o := range observers {
-				keysignMetric.AddNodeTssTime(o, keysignMs)
+				keysignMetric.AddNodeTssTime(o, tx.KeysignMs)
 			}
 			k.SetTssKeysignMetric(ctx, keysignMetric)
 		}
 	}
 
-	voter, err := k.GetObservedTxOutVoter(ctx, txID)
+	voter, err := k.GetObservedTxOutVoter(ctx, observedTxOutVoterID(ctx, k, tx))
 	if err != nil {
 		return ObservedTxVoter{}, fmt.Errorf("fail to get tx out voter: %w", err)
 	}
@@ -465,6 +474,22 @@ func ensureVaultAndGetTxOutVoter(ctx cosmos.Context, k keeper.Keeper, vaultPubKe
 	return voter, nil
 }
 
+// observedTxOutVoterID returns the id of the voter of an observed outbound. It is the tx
+// hash, except for the outputs of a batched outbound after the first one: they share the
+// tx hash but are observed and processed on their own, so the index of the output is
+// appended to keep their voters apart.
+func observedTxOutVoterID(ctx cosmos.Context, k keeper.Keeper, tx ObservedTx) common.TxID {
+	memo, err := ParseMemoWithSWITCHNames(ctx, k, tx.Tx.Memo)
+	if err != nil {
+		return tx.Tx.ID
+	}
+	batchMemo, ok := memo.(BatchOutboundMemo)
+	if !ok || batchMemo.GetIndex() == 0 {
+		return tx.Tx.ID
+	}
+	return common.TxID(fmt.Sprintf("%s-%d", tx.Tx.ID, batchMemo.GetIndex()))
+}
+
 // handleObservedTxOutQuorum - will process the observed tx out quorum.
 // used by both MsgObservedTxOut and MsgObservedTxOutQuorum after processing
 // attestation(s).
@@ -479,6 +504,24 @@ func handleObservedTxOutQuorum(
 	observers []cosmos.AccAddress,
 	isQuorum bool,
 ) error {
+	k := mgr.Keeper()
+
+	// a fee bump only replaces the outbound once it is finalised, and the replaced outbound
+	// is restored if it is finalised after all. Either may have reached consensus on a
+	// non-final observation already, so this is checked on the finalised consensus.
+	if tx.IsFinal() && voter.FinalisedHeight == ctx.BlockHeight() {
+		if voter.Reverted {
+			if replacement, ok := getReplacementOutbound(ctx, k, tx); ok {
+				restoreReplacedOutbound(ctx, mgr, tx, voter, replacement)
+				return nil
+			}
+		} else if replaced, ok := getReplacedOutbound(ctx, k, tx); ok {
+			processReplacementOutbound(ctx, mgr, tx, voter, replaced)
+			mgr.ObMgr().AppendObserver(tx.Tx.Chain, voter.GetTx(activeNodeAccounts).GetSigners())
+			return nil
+		}
+	}
+
 	// check whether the tx has consensus
 	if !isQuorum {
 		if voter.Height == ctx.BlockHeight() {
@@ -489,8 +532,6 @@ func handleObservedTxOutQuorum(
 		return nil
 	}
 
-	k := mgr.Keeper()
-
 	// if memo isn't valid or its an inbound memo, slash the vault
 	memo, _ := ParseMemoWithSWITCHNames(ctx, k, tx.Tx.Memo)
 	if memo.IsEmpty() || memo.IsInbound() {
@@ -520,6 +561,14 @@ func handleObservedTxOutQuorum(
 	}
 
 	txOut := voter.GetTx(activeNodeAccounts) // get consensus tx, in case our for loop is incorrect
+
+	// a fee bump of an outbound already processed is only accounted once it is finalised,
+	// until then the replaced outbound may still be the one that confirms
+	if _, ok := getReplacedOutbound(ctx, k, tx); ok {
+		mgr.ObMgr().AppendObserver(tx.Tx.Chain, txOut.GetSigners())
+		return nil
+	}
+
 	txOut.Tx.Memo = tx.Tx.Memo
 	m, err := processOneTxIn(ctx, k, *txOut, signer)
 	if err != nil || tx.Tx.Chain.IsEmpty() {
@@ -597,6 +646,219 @@ func handleObservedTxOutQuorum(
 	}
 
 	ctx.Logger().Info("tx out processed", "chain", tx.Tx.Chain, "id", tx.Tx.ID, "finalized", tx.IsFinal())
+	traceTx(ctx, tracing.CorrelationID(tx.Tx), tracing.StageOutboundObserved,
+		tracing.AttrChain.String(tx.Tx.Chain.String()),
+		tracing.AttrObservedTx.String(tx.Tx.ID.String()),
+		tracing.AttrHeight.Int64(tx.BlockHeight),
+	)
 
 	return nil
 }
+
+// getReplacedOutbound returns the voter of the outbound the observed tx replaces when it
+// is a fee bump of a UTXO outbound: it pays the same recipient, coins and memo from the
+// same vault as an outbound already processed but not finalised, with more gas. The
+// replaced outbound spends the same inputs, so only one of them can be finalised.
+func getReplacedOutbound(ctx cosmos.Context, k keeper.Keeper, tx ObservedTx) (ObservedTxVoter, bool) {
+	gasAsset := tx.Tx.Chain.GetGasAsset()
+	gas := tx.Tx.Gas.ToCoins().GetCoin(gasAsset).Amount
+	for _, voter := range getSameOutbounds(ctx, k, tx) {
+		if voter.FinalisedHeight == 0 && gas.GT(voter.Tx.Tx.Gas.ToCoins().GetCoin(gasAsset).Amount) {
+			return voter, true
+		}
+	}
+	return ObservedTxVoter{}, false
+}
+
+// getReplacementOutbound returns the voter of the fee bump that replaced the observed
+// outbound, see getReplacedOutbound.
+func getReplacementOutbound(ctx cosmos.Context, k keeper.Keeper, tx ObservedTx) (ObservedTxVoter, bool) {
+	gasAsset := tx.Tx.Chain.GetGasAsset()
+	gas := tx.Tx.Gas.ToCoins().GetCoin(gasAsset).Amount
+	for _, voter := range getSameOutbounds(ctx, k, tx) {
+		if voter.Tx.Tx.Gas.ToCoins().GetCoin(gasAsset).Amount.GT(gas) {
+			return voter, true
+		}
+	}
+	return ObservedTxVoter{}, false
+}
+
+// getSameOutbounds returns the voters of the processed and not reverted UTXO outbounds
+// paying the same recipient, coins and memo from the same vault as the observed tx.
+func getSameOutbounds(ctx cosmos.Context, k keeper.Keeper, tx ObservedTx) []ObservedTxVoter {
+	if !tx.Tx.Chain.IsUTXO() {
+		return nil
+	}
+	memo, err := ParseMemoWithSWITCHNames(ctx, k, tx.Tx.Memo)
+	if err != nil {
+		return nil
+	}
+
+	var candidates []common.TxID
+	if batchMemo, ok := memo.(BatchOutboundMemo); ok {
+		txOut, err := k.GetTxOut(ctx, batchMemo.GetBlockHeight())
+		if err != nil {
+			ctx.Logger().Error("fail to get txout", "error", err, "height", batchMemo.GetBlockHeight())
+			return nil
+		}
+		for _, item := range txOut.TxArray {
+			if !item.OutHash.IsEmpty() && item.ToAddress.Equals(tx.Tx.ToAddress) {
+				candidates = append(candidates, item.OutHash)
+			}
+		}
+	} else if inhash := memo.GetTxID(); !inhash.IsEmpty() {
+		candidates = k.GetObservedLink(ctx, inhash)
+	}
+
+	var voters []ObservedTxVoter
+	for _, txID := range candidates {
+		if txID.Equals(tx.Tx.ID) {
+			continue
+		}
+		sameTx := tx
+		sameTx.Tx.ID = txID
+		voter, err := k.GetObservedTxOutVoter(ctx, observedTxOutVoterID(ctx, k, sameTx))
+		if err != nil {
+			ctx.Logger().Error("fail to get tx out voter", "error", err, "hash", txID)
+			continue
+		}
+		// a fee bump that has not been finalised is not processed yet
+		if voter.Tx.IsEmpty() || voter.Reverted || voter.Tx.Status != common.Status_done {
+			continue
+		}
+		if voter.Tx.ObservedPubKey.Equals(tx.ObservedPubKey) &&
+			voter.Tx.Tx.Chain.Equals(tx.Tx.Chain) &&
+			voter.Tx.Tx.ToAddress.Equals(tx.Tx.ToAddress) &&
+			voter.Tx.Tx.Coins.EqualsEx(tx.Tx.Coins) &&
+			strings.EqualFold(voter.Tx.Tx.Memo, tx.Tx.Memo) {
+			voters = append(voters, voter)
+		}
+	}
+	return voters
+}
+
+// processReplacementOutbound accounts for an outbound replaced by a fee bump. The vault
+// already paid the coins and gas of the replaced outbound, so it is only charged the
+// extra gas, then the TxOutItem and the inbound are pointed at the replacement and the
+// replaced outbound is reverted.
+func processReplacementOutbound(ctx cosmos.Context, mgr Manager, tx ObservedTx, voter, replaced ObservedTxVoter) {
+	k := mgr.Keeper()
+	replacedGas := replaced.Tx.Tx.Gas.ToCoins()
+	extraGas := common.Gas{}
+	for _, coin := range tx.Tx.Gas {
+		amount := common.SafeSub(coin.Amount, replacedGas.GetCoin(coin.Asset).Amount)
+		if !amount.IsZero() {
+			extraGas = append(extraGas, common.NewCoin(coin.Asset, amount))
+		}
+	}
+	extraGasTx := tx
+	extraGasTx.Tx.Gas = extraGas
+	if err := addGasFees(ctx, mgr, extraGasTx); err != nil {
+		ctx.Logger().Error("fail to add gas fee", "error", err)
+		return
+	}
+
+	replacedID := replaced.Tx.Tx.ID
+	signingTransPeriod := mgr.GetConstants().GetInt64Value(constants.SigningTransactionPeriod)
+	if !replaceTxOutHash(ctx, k, tx, replacedID, replaced.Height-signingTransPeriod) {
+		ctx.Logger().Error("fail to find the outbound of the replaced tx", "hash", replacedID)
+	}
+
+	replaced.SetReverted()
+	k.SetObservedTxOutVoter(ctx, replaced)
+	voter.SetDone()
+	k.SetObservedTxOutVoter(ctx, voter)
+
+	ctx.Logger().Info("tx out replaced", "chain", tx.Tx.Chain, "id", tx.Tx.ID, "replaced", replacedID, "extra_gas", common.Coins(extraGas).String())
+}
+
+// restoreReplacedOutbound undoes processReplacementOutbound when the replaced outbound is
+// finalised after all. The vault is credited the extra gas it was charged for the
+// replacement, then the TxOutItem and the inbound are pointed back at the replaced
+// outbound and the replacement is reverted.
+func restoreReplacedOutbound(ctx cosmos.Context, mgr Manager, tx ObservedTx, voter, replacement ObservedTxVoter) {
+	k := mgr.Keeper()
+	gas := tx.Tx.Gas.ToCoins()
+	extraGas := common.Coins{}
+	for _, coin := range replacement.Tx.Tx.Gas {
+		amount := common.SafeSub(coin.Amount, gas.GetCoin(coin.Asset).Amount)
+		if !amount.IsZero() {
+			extraGas = append(extraGas, common.NewCoin(coin.Asset, amount))
+		}
+	}
+	vault, err := k.GetVault(ctx, tx.ObservedPubKey)
+	if err != nil {
+		ctx.Logger().Error("fail to get vault", "error", err)
+		return
+	}
+	vault.AddFunds(extraGas)
+	if err = k.SetVault(ctx, vault); err != nil {
+		ctx.Logger().Error("fail to save vault", "error", err)
+		return
+	}
+
+	replacementID := replacement.Tx.Tx.ID
+	signingTransPeriod := mgr.GetConstants().GetInt64Value(constants.SigningTransactionPeriod)
+	if !replaceTxOutHash(ctx, k, tx, replacementID, voter.Height-signingTransPeriod) {
+		ctx.Logger().Error("fail to find the outbound of the replacement tx", "hash", replacementID)
+	}
+
+	replacement.SetReverted()
+	k.SetObservedTxOutVoter(ctx, replacement)
+	voter.Reverted = false
+	voter.SetDone()
+	k.SetObservedTxOutVoter(ctx, voter)
+
+	ctx.Logger().Info("tx out restored", "chain", tx.Tx.Chain, "id", tx.Tx.ID, "replacement", replacementID, "extra_gas", extraGas.String())
+}
+
+// replaceTxOutHash sets the observed tx as the out hash of the TxOutItem paid by the
+// replaced tx, and of the out tx of its inbound, checking the blocks backwards from the
+// current height to the earliest height. Returns false if no TxOutItem is found.
+func replaceTxOutHash(ctx cosmos.Context, k keeper.Keeper, tx ObservedTx, replacedID common.TxID, earliestHeight int64) bool {
+	for height := ctx.BlockHeight(); height >= earliestHeight && height > 0; height-- {
+		txOut, err := k.GetTxOut(ctx, height)
+		if err != nil {
+			ctx.Logger().Error("unable to get txOut record", "error", err, "height", height)
+			return false
+		}
+		for i, item := range txOut.TxArray {
+			if !item.OutHash.Equals(replacedID) ||
+				!item.Chain.Equals(tx.Tx.Chain) ||
+				!item.VaultPubKey.Equals(tx.ObservedPubKey) ||
+				!item.ToAddress.Equals(tx.Tx.ToAddress) {
+				continue
+			}
+			txOut.TxArray[i].OutHash = tx.Tx.ID
+			if err = k.SetTxOut(ctx, txOut); err != nil {
+				ctx.Logger().Error("fail to save tx out", "error", err)
+			}
+
+			inVoter, err := k.GetObservedTxInVoter(ctx, item.InHash)
+			if err != nil {
+				ctx.Logger().Error("fail to get observed tx voter", "error", err, "hash", item.InHash)
+				return true
+			}
+			for j, outTx := range inVoter.OutTxs {
+				if outTx.ID.Equals(replacedID) && outTx.ToAddress.Equals(tx.Tx.ToAddress) {
+					inVoter.OutTxs[j] = tx.Tx
+				}
+			}
+			replaceOutHash(inVoter.Tx.OutHashes, replacedID, tx.Tx.ID)
+			for j := range inVoter.Txs {
+				replaceOutHash(inVoter.Txs[j].OutHashes, replacedID, tx.Tx.ID)
+			}
+			k.SetObservedTxInVoter(ctx, inVoter)
+			return true
+		}
+	}
+	return false
+}
+
+func replaceOutHash(outHashes []string, replacedID, txID common.TxID) {
+	for i, hash := range outHashes {
+		if strings.EqualFold(hash, replacedID.String()) {
+			outHashes[i] = txID.String()
+		}
+	}
+}
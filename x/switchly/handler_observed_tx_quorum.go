@@ -62,7 +62,7 @@ func (h ObservedTxQuorumHandler) handle(ctx cosmos.Context, msg types.MsgObserve
 			return &cosmos.Result{}, nil
 		}
 	} else {
-		voter, err = ensureVaultAndGetTxOutVoter(ctx, k, obsTx.ObservedPubKey, obsTx.Tx.ID, msg.GetSigners(), obsTx.KeysignMs)
+		voter, err = ensureVaultAndGetTxOutVoter(ctx, k, obsTx, msg.GetSigners())
 		if err != nil {
 			ctx.Logger().Error("fail to ensure vault and get tx out voter", "error", err)
 			return &cosmos.Result{}, nil
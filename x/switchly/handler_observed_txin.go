@@ -78,7 +78,6 @@ func (h ObservedTxInHandler) handle(ctx cosmos.Context, msg MsgObservedTxIn) (*c
 func addSwap(ctx cosmos.Context, k keeper.Keeper, advQueueMgr AdvSwapQueue, eventMgr EventManager, msg MsgSwap) {
 	if k.AdvSwapQueueEnabled(ctx) {
 		// TODO: swap to synth if layer1 asset (follow on PR)
-		// TODO: create handler to modify/cancel a limit swap (follow on PR)
 
 		source := msg.Tx.Coins[0]
 		target := common.NewCoin(msg.TargetAsset, msg.TradeTarget)
@@ -765,3 +765,353 @@ func (s *HandlerObservedTxOutSuite) TestObservingSlashing(c *C) {
 
 	// Note that nas[6], the Standby node, remains unaffected by the Actives nodes' observations.
 }
+
+func (s *HandlerObservedTxOutSuite) TestHandleBatchOutbound(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	height := int64(1024)
+	ctx = ctx.WithBlockHeight(height)
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+
+	vault := GetRandomVault()
+	vault.Chains = common.Chains{common.BTCChain}.Strings()
+	vault.Coins = common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(10*common.One))}
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	vaultAddr, err := vault.PubKey.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+
+	// two outbounds of the vault scheduled in the same block
+	maxGas := common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(10000))}
+	items := []TxOutItem{
+		{
+			Chain:       common.BTCChain,
+			InHash:      GetRandomTxHash(),
+			ToAddress:   GetRandomBTCAddress(),
+			VaultPubKey: vault.PubKey,
+			Coin:        common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)),
+			MaxGas:      maxGas,
+		},
+		{
+			Chain:       common.BTCChain,
+			InHash:      GetRandomTxHash(),
+			ToAddress:   GetRandomBTCAddress(),
+			VaultPubKey: vault.PubKey,
+			Coin:        common.NewCoin(common.BTCAsset, cosmos.NewUint(2*common.One)),
+			MaxGas:      maxGas,
+		},
+	}
+	items[0].Memo = NewOutboundMemo(items[0].InHash).String()
+	items[1].Memo = NewRefundMemo(items[1].InHash).String()
+	c.Assert(mgr.Keeper().SetTxOut(ctx, &TxOut{Height: height, TxArray: items}), IsNil)
+
+	// one transaction pays both, each output is observed with its share of the fee
+	txID := GetRandomTxHash()
+	memo := NewBatchOutboundMemo(height)
+	var txs ObservedTxs
+	for i, item := range items {
+		tx := common.NewTx(
+			txID,
+			vaultAddr,
+			item.ToAddress,
+			common.Coins{common.NewCoin(common.BTCAsset, item.Coin.Amount.Add(cosmos.NewUint(7000)))},
+			common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(3000))},
+			memo.WithIndex(int64(i)).String(),
+		)
+		txs = append(txs, NewObservedTx(tx, height, vault.PubKey, height))
+	}
+
+	handler := NewObservedTxOutHandler(mgr)
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(txs, na.NodeAddress))
+	c.Assert(err, IsNil)
+
+	// every output matched its own outbound
+	txOut, err := mgr.Keeper().GetTxOut(ctx, height)
+	c.Assert(err, IsNil)
+	c.Assert(txOut.TxArray, HasLen, 2)
+	for _, item := range txOut.TxArray {
+		c.Check(item.OutHash.Equals(txID), Equals, true, Commentf("%s", item.Memo))
+	}
+
+	// and has its own voter
+	voter, err := mgr.Keeper().GetObservedTxOutVoter(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(voter.Tx.Tx.ToAddress.Equals(items[0].ToAddress), Equals, true)
+	voter, err = mgr.Keeper().GetObservedTxOutVoter(ctx, common.TxID(txID.String()+"-1"))
+	c.Assert(err, IsNil)
+	c.Check(voter.Tx.Tx.ToAddress.Equals(items[1].ToAddress), Equals, true)
+}
+
+func (s *HandlerObservedTxOutSuite) TestHandleRouterBatchOutbound(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	height := int64(1024)
+	ctx = ctx.WithBlockHeight(height)
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+
+	tokenAsset, err := common.NewAsset("ETH.TKN-0X3B7FA4DD21C6F9BA3CA375217EAD7CAB9D6BF483")
+	c.Assert(err, IsNil)
+	vault := GetRandomVault()
+	vault.Chains = common.Chains{common.ETHChain}.Strings()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.ETHAsset, cosmos.NewUint(10*common.One)),
+		common.NewCoin(tokenAsset, cosmos.NewUint(10*common.One)),
+	}
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	vaultAddr, err := vault.PubKey.GetAddress(common.ETHChain)
+	c.Assert(err, IsNil)
+
+	// a gas asset and a token outbound of the vault scheduled in the same block
+	maxGas := common.Gas{common.NewCoin(common.ETHAsset, cosmos.NewUint(10000))}
+	items := []TxOutItem{
+		{
+			Chain:       common.ETHChain,
+			InHash:      GetRandomTxHash(),
+			ToAddress:   GetRandomETHAddress(),
+			VaultPubKey: vault.PubKey,
+			Coin:        common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)),
+			MaxGas:      maxGas,
+		},
+		{
+			Chain:       common.ETHChain,
+			InHash:      GetRandomTxHash(),
+			ToAddress:   GetRandomETHAddress(),
+			VaultPubKey: vault.PubKey,
+			Coin:        common.NewCoin(tokenAsset, cosmos.NewUint(2*common.One)),
+			MaxGas:      maxGas,
+		},
+	}
+	items[0].Memo = NewOutboundMemo(items[0].InHash).String()
+	items[1].Memo = NewOutboundMemo(items[1].InHash).String()
+	c.Assert(mgr.Keeper().SetTxOut(ctx, &TxOut{Height: height, TxArray: items}), IsNil)
+
+	// one router call pays both, each event is observed with the exact coin and a share of the gas
+	txID := GetRandomTxHash()
+	memo := NewBatchOutboundMemo(height)
+	var txs ObservedTxs
+	for i, item := range items {
+		tx := common.NewTx(
+			txID,
+			vaultAddr,
+			item.ToAddress,
+			common.Coins{item.Coin},
+			common.Gas{common.NewCoin(common.ETHAsset, cosmos.NewUint(3000))},
+			memo.WithIndex(int64(i)).String(),
+		)
+		txs = append(txs, NewObservedTx(tx, height, vault.PubKey, height))
+	}
+
+	handler := NewObservedTxOutHandler(mgr)
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(txs, na.NodeAddress))
+	c.Assert(err, IsNil)
+
+	txOut, err := mgr.Keeper().GetTxOut(ctx, height)
+	c.Assert(err, IsNil)
+	c.Assert(txOut.TxArray, HasLen, 2)
+	for _, item := range txOut.TxArray {
+		c.Check(item.OutHash.Equals(txID), Equals, true, Commentf("%s", item.Memo))
+	}
+
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.GetCoin(tokenAsset).Amount.Uint64(), Equals, uint64(8*common.One))
+	c.Check(vault.GetCoin(common.ETHAsset).Amount.Uint64(), Equals, uint64(9*common.One-6000))
+}
+
+func (s *HandlerObservedTxOutSuite) TestHandleReplacedOutbound(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	height := int64(1024)
+	ctx = ctx.WithBlockHeight(height)
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+
+	vault := GetRandomVault()
+	vault.Chains = common.Chains{common.BTCChain}.Strings()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BTCAsset, cosmos.NewUint(10*common.One)),
+	}
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	vaultAddr, err := vault.PubKey.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+
+	inHash := GetRandomTxHash()
+	item := TxOutItem{
+		Chain:       common.BTCChain,
+		InHash:      inHash,
+		ToAddress:   GetRandomBTCAddress(),
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)),
+		MaxGas:      common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(2000))},
+		Memo:        NewOutboundMemo(inHash).String(),
+	}
+	c.Assert(mgr.Keeper().SetTxOut(ctx, &TxOut{Height: height, TxArray: []TxOutItem{item}}), IsNil)
+	inVoter := NewObservedTxVoter(inHash, nil)
+	inVoter.Actions = []TxOutItem{item}
+	mgr.Keeper().SetObservedTxInVoter(ctx, inVoter)
+
+	// the outbound pays the rest of the max gas to the customer
+	coins := common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One+1000))}
+	newObservedTx := func(gas uint64) ObservedTx {
+		tx := common.NewTx(
+			GetRandomTxHash(),
+			vaultAddr,
+			item.ToAddress,
+			coins,
+			common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(gas))},
+			item.Memo,
+		)
+		// observed before it is confirmed, it never is once replaced
+		return NewObservedTx(tx, height, vault.PubKey, height+2)
+	}
+	handler := NewObservedTxOutHandler(mgr)
+	stuck := newObservedTx(1000)
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(ObservedTxs{stuck}, na.NodeAddress))
+	c.Assert(err, IsNil)
+	txOut, err := mgr.Keeper().GetTxOut(ctx, height)
+	c.Assert(err, IsNil)
+	c.Assert(txOut.TxArray[0].OutHash.Equals(stuck.Tx.ID), Equals, true)
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Assert(vault.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(9*common.One-2000))
+
+	// the replacement pays the same outbound with more gas, it isn't accounted before it
+	// is finalised since the stuck outbound may still confirm
+	ctx = ctx.WithBlockHeight(height + 10)
+	replacement := newObservedTx(2500)
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(ObservedTxs{replacement}, na.NodeAddress))
+	c.Assert(err, IsNil)
+	txOut, err = mgr.Keeper().GetTxOut(ctx, height)
+	c.Assert(err, IsNil)
+	c.Check(txOut.TxArray[0].OutHash.Equals(stuck.Tx.ID), Equals, true)
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(9*common.One-2000))
+
+	// a further bump replaces the processed outbound, never the pending replacement
+	voter, ok := getReplacedOutbound(ctx, mgr.Keeper(), newObservedTx(3000))
+	c.Check(ok, Equals, true)
+	c.Check(voter.Tx.Tx.ID.Equals(stuck.Tx.ID), Equals, true)
+
+	// paying no more gas is not a replacement
+	_, ok = getReplacedOutbound(ctx, mgr.Keeper(), newObservedTx(1000))
+	c.Check(ok, Equals, false)
+
+	// once finalised, the vault is only charged the extra gas and the outbound is pointed
+	// at the replacement
+	final := func(tx ObservedTx) ObservedTx {
+		tx.BlockHeight = tx.FinaliseHeight
+		return tx
+	}
+	ctx = ctx.WithBlockHeight(height + 11)
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(ObservedTxs{final(replacement)}, na.NodeAddress))
+	c.Assert(err, IsNil)
+	txOut, err = mgr.Keeper().GetTxOut(ctx, height)
+	c.Assert(err, IsNil)
+	c.Check(txOut.TxArray[0].OutHash.Equals(replacement.Tx.ID), Equals, true)
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(9*common.One-3500))
+
+	stuckVoter, err := mgr.Keeper().GetObservedTxOutVoter(ctx, stuck.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(stuckVoter.Reverted, Equals, true)
+	inVoter, err = mgr.Keeper().GetObservedTxInVoter(ctx, inHash)
+	c.Assert(err, IsNil)
+	c.Assert(inVoter.OutTxs, HasLen, 1)
+	c.Check(inVoter.OutTxs[0].ID.Equals(replacement.Tx.ID), Equals, true)
+
+	// a finalised replacement can't be replaced
+	_, ok = getReplacedOutbound(ctx, mgr.Keeper(), newObservedTx(3000))
+	c.Check(ok, Equals, false)
+}
+
+func (s *HandlerObservedTxOutSuite) TestRestoreReplacedOutbound(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	height := int64(1024)
+	ctx = ctx.WithBlockHeight(height)
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+
+	vault := GetRandomVault()
+	vault.Chains = common.Chains{common.BTCChain}.Strings()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BTCAsset, cosmos.NewUint(10*common.One)),
+	}
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	vaultAddr, err := vault.PubKey.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+
+	inHash := GetRandomTxHash()
+	item := TxOutItem{
+		Chain:       common.BTCChain,
+		InHash:      inHash,
+		ToAddress:   GetRandomBTCAddress(),
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)),
+		MaxGas:      common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(2000))},
+		Memo:        NewOutboundMemo(inHash).String(),
+	}
+	c.Assert(mgr.Keeper().SetTxOut(ctx, &TxOut{Height: height, TxArray: []TxOutItem{item}}), IsNil)
+	inVoter := NewObservedTxVoter(inHash, nil)
+	inVoter.Actions = []TxOutItem{item}
+	mgr.Keeper().SetObservedTxInVoter(ctx, inVoter)
+
+	coins := common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One+1000))}
+	newObservedTx := func(gas uint64, final bool) ObservedTx {
+		tx := common.NewTx(
+			GetRandomTxHash(),
+			vaultAddr,
+			item.ToAddress,
+			coins,
+			common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(gas))},
+			item.Memo,
+		)
+		if final {
+			return NewObservedTx(tx, height+2, vault.PubKey, height+2)
+		}
+		return NewObservedTx(tx, height, vault.PubKey, height+2)
+	}
+	handler := NewObservedTxOutHandler(mgr)
+	stuck := newObservedTx(1000, false)
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(ObservedTxs{stuck}, na.NodeAddress))
+	c.Assert(err, IsNil)
+
+	// the replacement is finalised first
+	ctx = ctx.WithBlockHeight(height + 10)
+	replacement := newObservedTx(2500, true)
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(ObservedTxs{replacement}, na.NodeAddress))
+	c.Assert(err, IsNil)
+	txOut, err := mgr.Keeper().GetTxOut(ctx, height)
+	c.Assert(err, IsNil)
+	c.Assert(txOut.TxArray[0].OutHash.Equals(replacement.Tx.ID), Equals, true)
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Assert(vault.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(9*common.One-3500))
+
+	// then the stuck outbound is finalised after all, it is restored and the vault gets
+	// the extra gas of the replacement back
+	ctx = ctx.WithBlockHeight(height + 11)
+	stuck.BlockHeight = stuck.FinaliseHeight
+	_, err = handler.Run(ctx, NewMsgObservedTxOut(ObservedTxs{stuck}, na.NodeAddress))
+	c.Assert(err, IsNil)
+	txOut, err = mgr.Keeper().GetTxOut(ctx, height)
+	c.Assert(err, IsNil)
+	c.Check(txOut.TxArray[0].OutHash.Equals(stuck.Tx.ID), Equals, true)
+	vault, err = mgr.Keeper().GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(9*common.One-2000))
+
+	stuckVoter, err := mgr.Keeper().GetObservedTxOutVoter(ctx, stuck.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(stuckVoter.Reverted, Equals, false)
+	replacementVoter, err := mgr.Keeper().GetObservedTxOutVoter(ctx, replacement.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(replacementVoter.Reverted, Equals, true)
+	inVoter, err = mgr.Keeper().GetObservedTxInVoter(ctx, inHash)
+	c.Assert(err, IsNil)
+	c.Assert(inVoter.OutTxs, HasLen, 1)
+	c.Check(inVoter.OutTxs[0].ID.Equals(stuck.Tx.ID), Equals, true)
+}
@@ -100,7 +100,7 @@ func (h SwapHandler) validateV3_0_0(ctx cosmos.Context, msg MsgSwap) error {
 			return fmt.Errorf("target asset (%s) is not gas asset , can't use dex feature", msg.TargetAsset)
 		}
 		// validate that a referenced dex aggregator is legit
-		addr, err := FetchDexAggregator(target.Chain, msg.Aggregator)
+		addr, err := FetchDexAggregator(ctx, h.mgr.Keeper(), target.Chain, msg.Aggregator)
 		if err != nil {
 			return err
 		}
@@ -278,7 +278,7 @@ func (h SwapHandler) handleV3_0_0(ctx cosmos.Context, msg MsgSwap) (*cosmos.Resu
 	dexAgg := ""
 	dexAggTargetAsset := ""
 	if len(msg.Aggregator) > 0 {
-		dexAgg, err = FetchDexAggregator(msg.TargetAsset.Chain, msg.Aggregator)
+		dexAgg, err = FetchDexAggregator(ctx, h.mgr.Keeper(), msg.TargetAsset.Chain, msg.Aggregator)
 		if err != nil {
 			return nil, err
 		}
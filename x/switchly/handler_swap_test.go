@@ -601,6 +601,8 @@ func (s *HandlerSwapSuite) TestSwapOutDexIntegration(c *C) {
 		TransactionFeeRate: 50_000,
 	}), IsNil)
 
+	mgr.Keeper().SetDexAggregator(ctx, NewDexAggregator(common.ETHChain, "0x69800327b38A4CeF30367Dec3f64c2f2386f3848", 400_000, true, ""))
+
 	swapMemo := "swap:ETH.ETH:" + types.GetRandomETHAddress().String() + "::::2f2386f3848:" + types.GetRandomETHAddress().String()
 	m, err := ParseMemoWithSWITCHNames(ctx, mgr.Keeper(), swapMemo)
 	c.Assert(err, IsNil)
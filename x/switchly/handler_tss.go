@@ -74,7 +74,7 @@ func MsgTssPoolValidateV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool)
 		return err
 	}
 
-	if msg.KeygenType != AsgardKeygen {
+	if msg.KeygenType != AsgardKeygen && msg.KeygenType != EdDSAKeygen && msg.KeygenType != ReshareKeygen {
 		return fmt.Errorf("only asgard vaults allowed for tss")
 	}
 
@@ -121,6 +121,10 @@ func MsgTssPoolValidateV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool)
 		if msg.KeygenType != keygen.Type {
 			continue
 		}
+		// an EdDSA-only keygen or a reshare must report against the vault it was scheduled for
+		if (keygen.Type == EdDSAKeygen || keygen.Type == ReshareKeygen) && !keygen.PoolPubKey.Equals(msg.PoolPubKey) {
+			continue
+		}
 		for _, member := range keygen.GetMembers() {
 			addr, err := member.GetThorAddress()
 			if err == nil && addr.Equals(msg.Signer) {
@@ -180,6 +184,9 @@ func MsgTssPoolHandleV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool) (*
 			"blamer", msg.Signer,
 		)
 	}
+	if msg.KeygenType == EdDSAKeygen {
+		return handleEdDSAKeygenTssPool(ctx, mgr, msg)
+	}
 	// only record TSS metric when keygen is success
 	if msg.IsSuccess() && !msg.PoolPubKey.IsEmpty() {
 		metric, err := mgr.Keeper().GetTssKeygenMetric(ctx, msg.PoolPubKey)
@@ -216,7 +223,7 @@ func MsgTssPoolHandleV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool) (*
 		telemetry.NewLabel("reason", "failed_observe_tss_pool"),
 	}))
 
-	if !voter.Sign(msg.Signer, msg.Chains, string(msg.Secp256K1Signature)) {
+	if !voter.Sign(msg.Signer, msg.Chains, string(msg.Secp256K1Signature), msg.ReportedEd25519PubKey()) {
 		// Slash for the network having to handle the extra message/s.
 		mgr.Slasher().IncSlashPoints(slashCtx, observeSlashPoints, msg.Signer)
 		ctx.Logger().Info("signer already signed MsgTssPool", "signer", msg.Signer.String(), "txid", msg.ID)
@@ -414,19 +421,28 @@ func MsgTssPoolHandleV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool) (*
 			voter.BlockHeight = ctx.BlockHeight()
 			mgr.Keeper().SetTssVoter(ctx, voter)
 
+			if msg.KeygenType == ReshareKeygen {
+				return handleReshareTssPool(ctx, mgr, msg, voter)
+			}
+
 			vaultType := AsgardVault
 			chains := voter.ConsensusChains()
 			vault := NewVault(ctx.BlockHeight(), InitVault, vaultType, voter.PoolPubKey, chains.Strings(), mgr.Keeper().GetChainContracts(ctx, chains))
 			vault.Membership = voter.PubKeys
-			// EdDSA (ed25519) group key for the Stellar vault, produced by the same keygen as the
-			// secp256k1 PoolPubKey. Empty for ECDSA-only churns (vault unchanged). All honest members
-			// derive the same ed25519 key from the deterministic DKG. (Consensus-hardening — folding the
-			// ed25519 key into the TSS voter id — is a follow-up; see docs §9.2.)
-			vault.Ed25519PubKey = msg.Ed25519PubKey
+			// EdDSA (ed25519) group key for the Stellar vault, produced by a separate ceremony over the
+			// same membership. Only a key reported by a supermajority of members is accepted; without
+			// one the vault is still created and the EdDSA ceremony is retried on its own.
+			if edpk, ok := voter.ConsensusEd25519PubKey(); ok {
+				vault.Ed25519PubKey = edpk
+			}
 
 			if err := mgr.Keeper().SetVault(ctx, vault); err != nil {
 				return nil, fmt.Errorf("fail to save vault: %w", err)
 			}
+			// every member has reported by now, the ed25519 tally settles with the keygen
+			if err := settleEd25519Tally(ctx, mgr, voter, vault); err != nil {
+				ctx.Logger().Error("fail to settle ed25519 tally", "error", err)
+			}
 			keygenBlock, err := mgr.Keeper().GetKeygenBlock(ctx, msg.Height)
 			if err != nil {
 				return nil, fmt.Errorf("fail to get keygen block, err: %w, height: %d", err, msg.Height)
@@ -450,7 +466,13 @@ func MsgTssPoolHandleV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool) (*
 				}
 			}
 
-			if len(initVaults) == len(keygenBlock.Keygens) {
+			asgardKeygens := 0
+			for _, keygen := range keygenBlock.Keygens {
+				if keygen.Type == AsgardKeygen {
+					asgardKeygens++
+				}
+			}
+			if len(initVaults) == asgardKeygens {
 				ctx.Logger().Info("tss keygen results churn", "asgards", len(initVaults))
 				for _, v := range initVaults {
 					if err := mgr.NetworkMgr().RotateVault(ctx, v); err != nil {
@@ -458,7 +480,7 @@ func MsgTssPoolHandleV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool) (*
 					}
 				}
 			} else {
-				ctx.Logger().Info("not enough keygen yet", "expecting", len(keygenBlock.Keygens), "current", len(initVaults))
+				ctx.Logger().Info("not enough keygen yet", "expecting", asgardKeygens, "current", len(initVaults))
 			}
 
 			addrs, err := vault.GetMembership().Addresses()
@@ -479,6 +501,371 @@ func MsgTssPoolHandleV3_0_0(ctx cosmos.Context, mgr Manager, msg *MsgTssPool) (*
 	return &cosmos.Result{}, nil
 }
 
+// handleReshareTssPool applies a resharing churn that reached complete consensus. The
+// vault keeps its pub key and funds, it only moves on to the new membership and a new
+// membership epoch, which the validator manager picks up as a completed churn.
+func handleReshareTssPool(ctx cosmos.Context, mgr Manager, msg *MsgTssPool, voter TssVoter) (*cosmos.Result, error) {
+	vault, err := mgr.Keeper().GetVault(ctx, voter.PoolPubKey)
+	if err != nil {
+		return nil, ErrInternal(err, fmt.Sprintf("fail to get vault(%s)", voter.PoolPubKey))
+	}
+	if !vault.IsAsgard() || !vault.IsActive() {
+		ctx.Logger().Error("reshared vault is not an active asgard", "pubkey", vault.PubKey, "status", vault.Status)
+		return &cosmos.Result{}, nil
+	}
+
+	oldMembership := vault.GetMembership()
+	// the keygen membership is recorded as epoch zero, so the members that left the vault
+	// since its keygen are known
+	if vault.MembershipEpoch == 0 {
+		mgr.Keeper().SetVaultMembershipEpoch(ctx, NewVaultMembershipEpoch(vault.PubKey, 0, vault.BlockHeight, vault.Membership))
+	}
+	vault.Reshare(voter.PubKeys, ctx.BlockHeight())
+	if err := mgr.Keeper().SetVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("fail to save vault: %w", err)
+	}
+	mgr.Keeper().SetVaultMembershipEpoch(ctx, NewVaultMembershipEpoch(vault.PubKey, vault.MembershipEpoch, ctx.BlockHeight(), vault.Membership))
+
+	// nodes that left the vault no longer sign for it, they still hold their old share
+	// which newChurnKeygen accounts for
+	for _, member := range oldMembership {
+		if vault.Contains(member) {
+			continue
+		}
+		na, err := mgr.Keeper().GetNodeAccountByPubKey(ctx, member)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get node account: %w", err)
+		}
+		na.TryRemoveSignerPubKey(vault.PubKey)
+		if err := mgr.Keeper().SetNodeAccount(ctx, na); err != nil {
+			return nil, fmt.Errorf("fail to save node account: %w", err)
+		}
+	}
+	for _, member := range vault.GetMembership() {
+		na, err := mgr.Keeper().GetNodeAccountByPubKey(ctx, member)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get node account: %w", err)
+		}
+		na.TryAddSignerPubKey(vault.PubKey)
+		if err := mgr.Keeper().SetNodeAccount(ctx, na); err != nil {
+			return nil, fmt.Errorf("fail to save node account: %w", err)
+		}
+	}
+	ctx.Logger().Info("tss reshare results churn", "pubkey", vault.PubKey, "epoch", vault.MembershipEpoch)
+
+	addrs, err := vault.GetMembership().Addresses()
+	if err != nil {
+		ctx.Logger().Error("fail to get member addresses", "error", err)
+		return &cosmos.Result{}, nil
+	}
+	members := make([]string, len(addrs))
+	for i, addr := range addrs {
+		members[i] = addr.String()
+	}
+	if err := mgr.EventMgr().EmitEvent(ctx, NewEventTssKeygenSuccess(msg.PoolPubKey, msg.Height, members)); err != nil {
+		ctx.Logger().Error("fail to emit keygen success event")
+	}
+	return &cosmos.Result{}, nil
+}
+
+// settleEd25519Tally closes the ed25519 tally of a voter once every member has reported
+// or the keygen has timed out, so no report is left out of it. Members that reported a
+// key other than the supermajority one are slashed, members that reported none are not.
+// When no key reaches a supermajority an EdDSA-only keygen is scheduled for the vault,
+// unless EdDSA keygen is disabled.
+func settleEd25519Tally(ctx cosmos.Context, mgr Manager, voter TssVoter, vault Vault) error {
+	if voter.Ed25519SettledHeight > 0 {
+		return nil
+	}
+	voter.Ed25519SettledHeight = ctx.BlockHeight()
+	edpk, ok := voter.ConsensusEd25519PubKey()
+	if ok && voter.Ed25519ConsensusBlockHeight == 0 {
+		voter.Ed25519ConsensusBlockHeight = ctx.BlockHeight()
+	}
+	mgr.Keeper().SetTssVoter(ctx, voter)
+
+	if ok {
+		slashEd25519Dissenters(ctx, mgr, voter.Ed25519Dissenters(edpk)...)
+		return nil
+	}
+	if vault.HasEd25519PubKey() {
+		return nil
+	}
+	// no member reports an ed25519 key while EdDSA keygen is disabled, so there is
+	// nothing to retry and nobody to blame
+	enabled, err := mgr.Keeper().GetMimir(ctx, constants.MimirKeyEdDSAKeygenEnabled)
+	if err != nil {
+		return fmt.Errorf("fail to get mimir: %w", err)
+	}
+	if enabled <= 0 {
+		return nil
+	}
+
+	ctx.Logger().Error(
+		"no supermajority on reported ed25519 pubkeys",
+		"id", voter.ID,
+		"pubkey", vault.PubKey,
+		"reported", strings.Join(voter.Ed25519PubKeys, ", "),
+	)
+
+	retries, err := mgr.Keeper().GetEdDSAKeygenRetries(ctx, vault.PubKey)
+	if err != nil {
+		return fmt.Errorf("fail to get eddsa keygen retries: %w", err)
+	}
+	maxRetries := mgr.Keeper().GetConfigInt64(ctx, constants.EdDSAKeygenMaxRetries)
+	if retries >= maxRetries {
+		failEdDSAKeygenRetries(ctx, mgr, voter, vault)
+		return nil
+	}
+	mgr.Keeper().SetEdDSAKeygenRetries(ctx, vault.PubKey, retries+1)
+	return triggerEdDSAKeygen(ctx, mgr, vault)
+}
+
+// failEdDSAKeygenRetries gives up on the EdDSA-only keygen of a vault once
+// EdDSAKeygenMaxRetries retries did not reach a supermajority. The members that did
+// not report an ed25519 group key in the last ceremony are slashed and blamed in a
+// keygen failure event. The vault is left without an ed25519 key until the nodes vote
+// for a new backfill.
+func failEdDSAKeygenRetries(ctx cosmos.Context, mgr Manager, voter TssVoter, vault Vault) {
+	mgr.Keeper().SetEdDSAKeygenRetries(ctx, vault.PubKey, 0)
+
+	var blamed []cosmos.AccAddress
+	var blames []string
+	for _, member := range vault.GetMembership() {
+		addr, err := member.GetThorAddress()
+		if err != nil {
+			ctx.Logger().Error("fail to get switchly address", "pubkey", member, "error", err)
+			continue
+		}
+		if !voter.ReportedEd25519PubKey(addr).IsEmpty() {
+			continue
+		}
+		blamed = append(blamed, addr)
+		blames = append(blames, addr.String())
+	}
+	ctx.Logger().Error("eddsa keygen retries exhausted", "pubkey", vault.PubKey, "blamed", strings.Join(blames, ", "))
+
+	slashPoints := mgr.GetConstants().GetInt64Value(constants.FailKeygenSlashPoints)
+	slashCtx := ctx.WithContext(context.WithValue(ctx.Context(), constants.CtxMetricLabels, []metrics.Label{
+		telemetry.NewLabel("reason", "failed_eddsa_keygen"),
+	}))
+	mgr.Slasher().IncSlashPoints(slashCtx, slashPoints, blamed...)
+
+	reason := "no supermajority on ed25519 pubkey after retries"
+	if err := mgr.EventMgr().EmitEvent(ctx, NewEventTssKeygenFailure(reason, "", false, ctx.BlockHeight(), blames)); err != nil {
+		ctx.Logger().Error("fail to emit keygen failure event", "error", err)
+	}
+}
+
+// settleTimedOutEdDSAKeygens settles the tally of the EdDSA-only keygens scheduled
+// ChurnRetryInterval blocks ago, whose MsgTssPool are no longer accepted, when not every
+// member reported the result of the ceremony.
+func settleTimedOutEdDSAKeygens(ctx cosmos.Context, mgr Manager) error {
+	churnRetryBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.ChurnRetryInterval)
+	height := ctx.BlockHeight() - churnRetryBlocks
+	if height <= 0 {
+		return nil
+	}
+	keygenBlock, err := mgr.Keeper().GetKeygenBlock(ctx, height)
+	if err != nil {
+		return fmt.Errorf("fail to get keygen block, err: %w, height: %d", err, height)
+	}
+	for _, keygen := range keygenBlock.Keygens {
+		if keygen.Type != EdDSAKeygen {
+			continue
+		}
+		// the voter of the successful reports, its id only depends on the keygen
+		members := make([]string, len(keygen.Members))
+		copy(members, keygen.Members)
+		msg, err := NewMsgTssPool(members, keygen.PoolPubKey, nil, nil, EdDSAKeygen, height, Blame{}, nil, nil, 0)
+		if err != nil {
+			ctx.Logger().Error("fail to create MsgTssPool", "pubkey", keygen.PoolPubKey, "error", err)
+			continue
+		}
+		voter, err := mgr.Keeper().GetTssVoter(ctx, msg.ID)
+		if err != nil {
+			ctx.Logger().Error("fail to get tss voter", "id", msg.ID, "error", err)
+			continue
+		}
+		if voter.PoolPubKey.IsEmpty() {
+			voter = NewTssVoter(msg.ID, msg.PubKeys, msg.PoolPubKey)
+		}
+		vault, err := mgr.Keeper().GetVault(ctx, keygen.PoolPubKey)
+		if err != nil {
+			ctx.Logger().Error("fail to get vault", "pubkey", keygen.PoolPubKey, "error", err)
+			continue
+		}
+		if err := settleEd25519Tally(ctx, mgr, voter, vault); err != nil {
+			ctx.Logger().Error("fail to settle ed25519 tally", "pubkey", vault.PubKey, "error", err)
+		}
+	}
+	return nil
+}
+
+// triggerEdDSAKeygen schedules an EdDSA-only keygen among the members of the given
+// vault, whose resulting ed25519 group key is attached to the vault on consensus
+func triggerEdDSAKeygen(ctx cosmos.Context, mgr Manager, vault Vault) error {
+	enabled, err := mgr.Keeper().GetMimir(ctx, constants.MimirKeyEdDSAKeygenEnabled)
+	if err != nil {
+		return fmt.Errorf("fail to get mimir: %w", err)
+	}
+	if enabled <= 0 {
+		ctx.Logger().Info("eddsa keygen is disabled, skip", "pubkey", vault.PubKey)
+		return nil
+	}
+
+	members := make([]string, len(vault.Membership))
+	copy(members, vault.Membership)
+	keygen, err := NewEdDSAKeygen(ctx.BlockHeight(), members, vault.PubKey)
+	if err != nil {
+		return fmt.Errorf("fail to create eddsa keygen: %w", err)
+	}
+	keygenBlock, err := mgr.Keeper().GetKeygenBlock(ctx, ctx.BlockHeight())
+	if err != nil {
+		return fmt.Errorf("fail to get keygen block from data store: %w", err)
+	}
+	if !keygenBlock.Contains(keygen) {
+		keygenBlock.Keygens = append(keygenBlock.Keygens, keygen)
+	}
+	mgr.Keeper().SetKeygenBlock(ctx, keygenBlock)
+
+	ctx.Logger().Info("eddsa keygen scheduled", "height", ctx.BlockHeight(), "pubkey", vault.PubKey)
+	return nil
+}
+
+// slashEd25519Dissenters increments the slash points of members that reported an
+// ed25519 group key disagreeing with the supermajority
+func slashEd25519Dissenters(ctx cosmos.Context, mgr Manager, dissenters ...cosmos.AccAddress) {
+	if len(dissenters) == 0 {
+		return
+	}
+	slashPoints := mgr.GetConstants().GetInt64Value(constants.FailKeygenSlashPoints)
+	slashCtx := ctx.WithContext(context.WithValue(ctx.Context(), constants.CtxMetricLabels, []metrics.Label{
+		telemetry.NewLabel("reason", "mismatched_ed25519_pubkey"),
+	}))
+	mgr.Slasher().IncSlashPoints(slashCtx, slashPoints, dissenters...)
+}
+
+// handleEdDSAKeygenTssPool processes the result of an EdDSA-only keygen. The members
+// keep their existing vault, so there is no churn or vault creation involved: once a
+// supermajority reports the same ed25519 group key it is attached to the vault, and the
+// tally settles once every member has reported.
+func handleEdDSAKeygenTssPool(ctx cosmos.Context, mgr Manager, msg *MsgTssPool) (*cosmos.Result, error) {
+	voter, err := mgr.Keeper().GetTssVoter(ctx, msg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get tss voter: %w", err)
+	}
+	if voter.PoolPubKey.IsEmpty() {
+		voter.PoolPubKey = msg.PoolPubKey
+		voter.PubKeys = msg.PubKeys
+	}
+	if !voter.PoolPubKey.Equals(msg.PoolPubKey) {
+		return nil, fmt.Errorf("invalid pool pubkey")
+	}
+
+	observeSlashPoints := mgr.GetConstants().GetInt64Value(constants.ObserveSlashPoints)
+	slashCtx := ctx.WithContext(context.WithValue(ctx.Context(), constants.CtxMetricLabels, []metrics.Label{
+		telemetry.NewLabel("reason", "failed_observe_tss_pool"),
+	}))
+
+	reported := msg.ReportedEd25519PubKey()
+	if !voter.Sign(msg.Signer, msg.Chains, "", reported) {
+		mgr.Slasher().IncSlashPoints(slashCtx, observeSlashPoints, msg.Signer)
+		ctx.Logger().Info("signer already signed MsgTssPool", "signer", msg.Signer.String(), "txid", msg.ID)
+		return &cosmos.Result{}, nil
+	}
+	mgr.Keeper().SetTssVoter(ctx, voter)
+
+	// the failed ceremony reached consensus on blame, the blamed nodes incur slash
+	// points but the active vault is not affected. The retry is scheduled when the
+	// tally of the successful reports settles.
+	if !msg.IsSuccess() {
+		if voter.HasConsensus() && voter.BlockHeight == 0 {
+			voter.BlockHeight = ctx.BlockHeight()
+			mgr.Keeper().SetTssVoter(ctx, voter)
+			var blamed []cosmos.AccAddress
+			for _, node := range msg.Blame.BlameNodes {
+				pk, err := common.NewPubKey(node.Pubkey)
+				if err != nil {
+					ctx.Logger().Error("fail to parse pubkey", "pubkey", node.Pubkey, "error", err)
+					continue
+				}
+				addr, err := pk.GetThorAddress()
+				if err != nil {
+					ctx.Logger().Error("fail to get switchly address", "pubkey", node.Pubkey, "error", err)
+					continue
+				}
+				blamed = append(blamed, addr)
+			}
+			slashPoints := mgr.GetConstants().GetInt64Value(constants.FailKeygenSlashPoints)
+			failedCtx := ctx.WithContext(context.WithValue(ctx.Context(), constants.CtxMetricLabels, []metrics.Label{
+				telemetry.NewLabel("reason", "failed_eddsa_keygen"),
+			}))
+			mgr.Slasher().IncSlashPoints(failedCtx, slashPoints, blamed...)
+			ctx.Logger().Info("eddsa keygen failed", "pubkey", msg.PoolPubKey, "height", msg.Height, "reason", msg.Blame.FailReason)
+		}
+		return &cosmos.Result{}, nil
+	}
+
+	// the ed25519 pubkey is attached as soon as a supermajority reports it
+	if edpk, ok := voter.ConsensusEd25519PubKey(); ok && voter.Ed25519ConsensusBlockHeight == 0 {
+		voter.BlockHeight = ctx.BlockHeight()
+		voter.Ed25519ConsensusBlockHeight = ctx.BlockHeight()
+		mgr.Keeper().SetTssVoter(ctx, voter)
+		if err := attachEd25519PubKey(ctx, mgr, msg.PoolPubKey, edpk); err != nil {
+			return nil, err
+		}
+	}
+
+	// the tally settles once every member has reported, or otherwise when the keygen
+	// times out (settleTimedOutEdDSAKeygens)
+	if !voter.HasCompleteConsensus() {
+		return &cosmos.Result{}, nil
+	}
+	vault, err := mgr.Keeper().GetVault(ctx, msg.PoolPubKey)
+	if err != nil {
+		return nil, ErrInternal(err, fmt.Sprintf("fail to get vault(%s)", msg.PoolPubKey))
+	}
+	if err := settleEd25519Tally(ctx, mgr, voter, vault); err != nil {
+		ctx.Logger().Error("fail to settle ed25519 tally", "error", err)
+	}
+	return &cosmos.Result{}, nil
+}
+
+// attachEd25519PubKey sets the ed25519 group key of the given vault, unless it has one
+// already, moves the XLM received at its placeholder address over and installs the key
+// as the signer of its XRP account.
+func attachEd25519PubKey(ctx cosmos.Context, mgr Manager, pubKey, edpk common.PubKey) error {
+	vault, err := mgr.Keeper().GetVault(ctx, pubKey)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get vault(%s)", pubKey))
+	}
+	if vault.HasEd25519PubKey() {
+		ctx.Logger().Info("vault already has an ed25519 pubkey", "pubkey", vault.PubKey, "ed25519", vault.Ed25519PubKey)
+		return nil
+	}
+	mgr.Keeper().SetEdDSAKeygenRetries(ctx, vault.PubKey, 0)
+	vault.Ed25519PubKey = edpk
+	if err := mgr.Keeper().SetVault(ctx, vault); err != nil {
+		return fmt.Errorf("fail to save vault: %w", err)
+	}
+	ctx.Logger().Info("ed25519 pubkey attached to vault", "pubkey", vault.PubKey, "ed25519", edpk)
+
+	// XLM received before the vault had an ed25519 key sits at the placeholder address
+	if err := migrateStellarPlaceholderFunds(ctx, mgr, vault); err != nil {
+		ctx.Logger().Error("fail to migrate stellar placeholder funds", "pubkey", vault.PubKey, "error", err)
+	}
+	// the stellar migration updates the pending tx heights of the vault
+	vault, err = mgr.Keeper().GetVault(ctx, pubKey)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get vault(%s)", pubKey))
+	}
+	if err := rotateXRPSignerList(ctx, mgr, vault); err != nil {
+		ctx.Logger().Error("fail to rotate xrp signer list", "pubkey", vault.PubKey, "error", err)
+	}
+	return nil
+}
+
 func judgeLateSigner(ctx cosmos.Context, mgr Manager, msg *MsgTssPool, voter TssVoter) {
 	// if the voter doesn't reach 2/3 majority consensus , this method should not take any actions
 	if !voter.HasConsensus() || !msg.IsSuccess() {
@@ -337,7 +337,7 @@ func (s *HandlerTssSuite) TestTssHandler(c *C) {
 					voter.PoolPubKey = tssMsg.PoolPubKey
 					voter.PubKeys = tssMsg.PubKeys
 				}
-				voter.Sign(tssMsg.Signer, tssMsg.Chains, "")
+				voter.Sign(tssMsg.Signer, tssMsg.Chains, "", common.EmptyPubKey)
 				helper.keeper.Keeper.SetTssVoter(helper.ctx, voter)
 				return tssMsg
 			},
@@ -444,7 +444,7 @@ func (s *HandlerTssSuite) TestTssHandler(c *C) {
 					voter.PubKeys = m.PubKeys
 				}
 				addr, _ := helper.members[3].GetThorAddress()
-				voter.Sign(addr, common.Chains{common.ETHChain}.Strings(), string(fakeSig))
+				voter.Sign(addr, common.Chains{common.ETHChain}.Strings(), string(fakeSig), common.EmptyPubKey)
 				helper.keeper.SetTssVoter(helper.ctx, voter)
 				return handler.Run(helper.ctx, msg)
 			},
@@ -774,6 +774,363 @@ func (s *HandlerTssSuite) TestKeygenSuccessHandlerEd25519(c *C) {
 	c.Assert(xlmAddr.Equals(wantAddr), Equals, true)
 }
 
+func (s *HandlerTssSuite) TestKeygenEd25519Tally(c *C) {
+	helper := newTssHandlerTestHelper(c)
+	handler := NewTssHandler(NewDummyMgrWithKeeper(helper.keeper))
+	dummySlasher, ok := handler.mgr.Slasher().(*DummySlasher)
+	c.Assert(ok, Equals, true)
+	failKeyGenSlashPoints := helper.constAccessor.GetInt64Value(constants.FailKeygenSlashPoints)
+	keygenTime := int64(1024)
+	chains := common.Chains{common.SwitchNative.Chain}.Strings()
+
+	// the last member reports a different ed25519 key, which is outvoted and slashed
+	checkSig := []byte("ed25519-tally-check-signature")
+	poolPubKey := GetRandomPubKey()
+	edpk := GetRandomEd25519PubKey()
+	for idx, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		reported := edpk
+		if idx == len(helper.members)-1 {
+			reported = GetRandomEd25519PubKey()
+		}
+		tssMsg, err := NewMsgTssPool(helper.members.Strings(), poolPubKey, checkSig, nil, AsgardKeygen, helper.ctx.BlockHeight(), Blame{}, chains, thorAddr, keygenTime, reported)
+		c.Assert(err, IsNil)
+		_, err = handler.handle(helper.ctx, tssMsg)
+		c.Assert(err, IsNil)
+	}
+	vault, err := helper.keeper.GetVault(helper.ctx, poolPubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Ed25519PubKey.Equals(edpk), Equals, true)
+	for idx, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		expected := int64(0)
+		if idx == len(helper.members)-1 {
+			expected = failKeyGenSlashPoints
+		}
+		c.Check(dummySlasher.pts[thorAddr.String()], Equals, expected)
+	}
+
+	// a split ed25519 tally still creates the vault and schedules an EdDSA-only retry
+	helper = newTssHandlerTestHelper(c)
+	handler = NewTssHandler(NewDummyMgrWithKeeper(helper.keeper))
+	dummySlasher, ok = handler.mgr.Slasher().(*DummySlasher)
+	c.Assert(ok, Equals, true)
+	helper.keeper.SetMimir(helper.ctx, constants.MimirKeyEdDSAKeygenEnabled, 1)
+	poolPubKey = GetRandomPubKey()
+	edpk1, edpk2 := GetRandomEd25519PubKey(), GetRandomEd25519PubKey()
+	for idx, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		reported := edpk1
+		if idx%2 == 0 {
+			reported = edpk2
+		}
+		tssMsg, err := NewMsgTssPool(helper.members.Strings(), poolPubKey, checkSig, nil, AsgardKeygen, helper.ctx.BlockHeight(), Blame{}, chains, thorAddr, keygenTime, reported)
+		c.Assert(err, IsNil)
+		_, err = handler.handle(helper.ctx, tssMsg)
+		c.Assert(err, IsNil)
+	}
+	vault, err = helper.keeper.GetVault(helper.ctx, poolPubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.Status, Equals, InitVault)
+	c.Check(vault.HasEd25519PubKey(), Equals, false)
+	for _, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		c.Check(dummySlasher.pts[thorAddr.String()], Equals, int64(0))
+	}
+	keygenBlock, err := helper.keeper.GetKeygenBlock(helper.ctx, helper.ctx.BlockHeight())
+	c.Assert(err, IsNil)
+	var retry *Keygen
+	for i := range keygenBlock.Keygens {
+		if keygenBlock.Keygens[i].Type == EdDSAKeygen {
+			retry = &keygenBlock.Keygens[i]
+		}
+	}
+	c.Assert(retry, NotNil)
+	c.Check(retry.PoolPubKey.Equals(poolPubKey), Equals, true)
+	c.Check(retry.Members, DeepEquals, vault.Membership)
+}
+
+func (s *HandlerTssSuite) TestEdDSAKeygenHandler(c *C) {
+	helper := newTssHandlerTestHelper(c)
+	handler := NewTssHandler(NewDummyMgrWithKeeper(helper.keeper))
+	dummySlasher, ok := handler.mgr.Slasher().(*DummySlasher)
+	c.Assert(ok, Equals, true)
+	failKeyGenSlashPoints := helper.constAccessor.GetInt64Value(constants.FailKeygenSlashPoints)
+	keygenTime := int64(1024)
+	chains := common.Chains{common.SwitchNative.Chain}.Strings()
+
+	// an active vault without an ed25519 key, and an EdDSA-only keygen for it
+	vault := NewVault(helper.ctx.BlockHeight(), ActiveVault, AsgardVault, GetRandomPubKey(), chains, []ChainContract{})
+	vault.Membership = helper.members.Strings()
+	c.Assert(helper.keeper.SetVault(helper.ctx, vault), IsNil)
+	keygen, err := NewEdDSAKeygen(helper.ctx.BlockHeight(), helper.members.Strings(), vault.PubKey)
+	c.Assert(err, IsNil)
+	keygenBlock := NewKeygenBlock(helper.ctx.BlockHeight())
+	keygenBlock.Keygens = []Keygen{keygen}
+	helper.keeper.SetKeygenBlock(helper.ctx, keygenBlock)
+
+	// must reference the scheduled vault
+	thorAddr, err := helper.members[0].GetThorAddress()
+	c.Assert(err, IsNil)
+	msg, err := NewMsgTssPool(helper.members.Strings(), GetRandomPubKey(), nil, nil, EdDSAKeygen, helper.ctx.BlockHeight(), Blame{}, chains, thorAddr, keygenTime, GetRandomEd25519PubKey())
+	c.Assert(err, IsNil)
+	c.Check(handler.validate(helper.ctx, msg), NotNil)
+
+	edpk := GetRandomEd25519PubKey()
+	for idx, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		reported := edpk
+		if idx == len(helper.members)-1 {
+			reported = GetRandomEd25519PubKey()
+		}
+		msg, err = NewMsgTssPool(helper.members.Strings(), vault.PubKey, nil, nil, EdDSAKeygen, helper.ctx.BlockHeight(), Blame{}, chains, thorAddr, keygenTime, reported)
+		c.Assert(err, IsNil)
+		_, err = handler.handle(helper.ctx, msg)
+		c.Assert(err, IsNil)
+
+		v, err := helper.keeper.GetVault(helper.ctx, vault.PubKey)
+		c.Assert(err, IsNil)
+		c.Check(v.Ed25519PubKey.Equals(edpk), Equals, HasSuperMajority(idx+1, len(helper.members)))
+	}
+
+	// the secp256k1 vault is untouched, the dissenting late signer is slashed
+	v, err := helper.keeper.GetVault(helper.ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(v.Status, Equals, ActiveVault)
+	c.Check(v.PubKeyForChain(common.StellarChain).Equals(edpk), Equals, true)
+	for idx, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		expected := int64(0)
+		if idx == len(helper.members)-1 {
+			expected = failKeyGenSlashPoints
+		}
+		c.Check(dummySlasher.pts[thorAddr.String()], Equals, expected)
+	}
+}
+
+func (s *HandlerTssSuite) TestEdDSAKeygenTallySettlement(c *C) {
+	helper := newTssHandlerTestHelper(c)
+	mgr := NewDummyMgrWithKeeper(helper.keeper)
+	handler := NewTssHandler(mgr)
+	dummySlasher, ok := handler.mgr.Slasher().(*DummySlasher)
+	c.Assert(ok, Equals, true)
+	helper.keeper.SetMimir(helper.ctx, constants.MimirKeyEdDSAKeygenEnabled, 1)
+	churnRetryBlocks := helper.keeper.GetConfigInt64(helper.ctx, constants.ChurnRetryInterval)
+	keygenTime := int64(1024)
+	chains := common.Chains{common.SwitchNative.Chain}.Strings()
+
+	schedule := func() Vault {
+		vault := NewVault(helper.ctx.BlockHeight(), ActiveVault, AsgardVault, GetRandomPubKey(), chains, []ChainContract{})
+		vault.Membership = helper.members.Strings()
+		c.Assert(helper.keeper.SetVault(helper.ctx, vault), IsNil)
+		keygen, err := NewEdDSAKeygen(helper.ctx.BlockHeight(), helper.members.Strings(), vault.PubKey)
+		c.Assert(err, IsNil)
+		keygenBlock := NewKeygenBlock(helper.ctx.BlockHeight())
+		keygenBlock.Keygens = []Keygen{keygen}
+		helper.keeper.SetKeygenBlock(helper.ctx, keygenBlock)
+		return vault
+	}
+	report := func(ctx cosmos.Context, vault Vault, reports []common.PubKey, from int) TssVoter {
+		var id string
+		for idx := from; idx < len(reports); idx++ {
+			thorAddr, err := helper.members[idx].GetThorAddress()
+			c.Assert(err, IsNil)
+			msg, err := NewMsgTssPool(helper.members.Strings(), vault.PubKey, nil, nil, EdDSAKeygen, helper.ctx.BlockHeight(), Blame{}, chains, thorAddr, keygenTime, reports[idx])
+			c.Assert(err, IsNil)
+			_, err = handler.handle(ctx, msg)
+			c.Assert(err, IsNil)
+			id = msg.ID
+		}
+		voter, err := helper.keeper.GetTssVoter(helper.ctx, id)
+		c.Assert(err, IsNil)
+		return voter
+	}
+	eddsaKeygens := func(height int64) []Keygen {
+		keygenBlock, err := helper.keeper.GetKeygenBlock(helper.ctx, height)
+		c.Assert(err, IsNil)
+		var keygens []Keygen
+		for _, keygen := range keygenBlock.Keygens {
+			if keygen.Type == EdDSAKeygen {
+				keygens = append(keygens, keygen)
+			}
+		}
+		return keygens
+	}
+	timeoutCtx := helper.ctx.WithBlockHeight(helper.ctx.BlockHeight() + churnRetryBlocks)
+
+	// a supermajority attaches the key, the member that reported none is not slashed and
+	// the tally only settles once the keygen times out, as the last member never reports
+	vault := schedule()
+	edpk := GetRandomEd25519PubKey()
+	reports := []common.PubKey{common.EmptyPubKey}
+	for len(reports) < len(helper.members)-1 {
+		reports = append(reports, edpk)
+	}
+	voter := report(helper.ctx, vault, reports, 0)
+	v, err := helper.keeper.GetVault(helper.ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(v.Ed25519PubKey.Equals(edpk), Equals, true)
+	c.Check(voter.Ed25519ConsensusBlockHeight, Equals, helper.ctx.BlockHeight())
+	c.Check(voter.Ed25519SettledHeight, Equals, int64(0))
+
+	c.Assert(settleTimedOutEdDSAKeygens(helper.ctx.WithBlockHeight(timeoutCtx.BlockHeight()-1), mgr), IsNil)
+	voter, err = helper.keeper.GetTssVoter(helper.ctx, voter.ID)
+	c.Assert(err, IsNil)
+	c.Check(voter.Ed25519SettledHeight, Equals, int64(0))
+	c.Assert(settleTimedOutEdDSAKeygens(timeoutCtx, mgr), IsNil)
+	voter, err = helper.keeper.GetTssVoter(helper.ctx, voter.ID)
+	c.Assert(err, IsNil)
+	c.Check(voter.Ed25519SettledHeight, Equals, timeoutCtx.BlockHeight())
+	c.Check(eddsaKeygens(timeoutCtx.BlockHeight()), HasLen, 0)
+	for _, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		c.Check(dummySlasher.pts[thorAddr.String()], Equals, int64(0))
+	}
+
+	// without a supermajority an early report does not settle the tally, an EdDSA-only
+	// retry is scheduled once every member has reported
+	vault = schedule()
+	edpk1, edpk2 := GetRandomEd25519PubKey(), GetRandomEd25519PubKey()
+	reports = nil
+	for len(reports) < len(helper.members) {
+		reports = append(reports, edpk1, edpk2)
+	}
+	voter = report(helper.ctx, vault, reports[:len(reports)-1], 0)
+	c.Check(voter.Ed25519SettledHeight, Equals, int64(0))
+	lastCtx := helper.ctx.WithBlockHeight(helper.ctx.BlockHeight() + 10)
+	c.Check(eddsaKeygens(lastCtx.BlockHeight()), HasLen, 0)
+	voter = report(lastCtx, vault, reports, len(reports)-1)
+	c.Check(voter.Ed25519SettledHeight, Equals, lastCtx.BlockHeight())
+	keygens := eddsaKeygens(lastCtx.BlockHeight())
+	c.Assert(keygens, HasLen, 1)
+	c.Check(keygens[0].PoolPubKey.Equals(vault.PubKey), Equals, true)
+	v, err = helper.keeper.GetVault(helper.ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(v.HasEd25519PubKey(), Equals, false)
+
+	// without any report the tally settles on timeout and schedules a retry
+	vault = schedule()
+	c.Assert(settleTimedOutEdDSAKeygens(timeoutCtx, mgr), IsNil)
+	keygens = eddsaKeygens(timeoutCtx.BlockHeight())
+	c.Assert(keygens, HasLen, 1)
+	c.Check(keygens[0].PoolPubKey.Equals(vault.PubKey), Equals, true)
+	retries, err := helper.keeper.GetEdDSAKeygenRetries(helper.ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(retries, Equals, int64(1))
+
+	// once the retries are exhausted no keygen is scheduled, the members that did not
+	// report a key are blamed and the retries start over
+	failKeyGenSlashPoints := helper.constAccessor.GetInt64Value(constants.FailKeygenSlashPoints)
+	maxRetries := helper.keeper.GetConfigInt64(helper.ctx, constants.EdDSAKeygenMaxRetries)
+	helper.keeper.SetEdDSAKeygenRetries(helper.ctx, vault.PubKey, maxRetries)
+	exhaustedCtx := timeoutCtx.WithBlockHeight(timeoutCtx.BlockHeight() + 1)
+	keygenBlock := NewKeygenBlock(exhaustedCtx.BlockHeight() - churnRetryBlocks)
+	keygenBlock.Keygens = []Keygen{keygens[0]}
+	helper.keeper.SetKeygenBlock(helper.ctx, keygenBlock)
+	c.Assert(settleTimedOutEdDSAKeygens(exhaustedCtx, mgr), IsNil)
+	c.Check(eddsaKeygens(exhaustedCtx.BlockHeight()), HasLen, 0)
+	retries, err = helper.keeper.GetEdDSAKeygenRetries(helper.ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(retries, Equals, int64(0))
+	for _, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		c.Check(dummySlasher.pts[thorAddr.String()], Equals, failKeyGenSlashPoints)
+	}
+}
+
+func (s *HandlerTssSuite) TestReshareKeygenHandler(c *C) {
+	helper := newTssHandlerTestHelper(c)
+	handler := NewTssHandler(NewDummyMgrWithKeeper(helper.keeper))
+	keygenTime := int64(1024)
+	chains := common.Chains{common.SwitchNative.Chain}.Strings()
+
+	// two members leave the active vault and two join it
+	leaving := common.PubKeys{GetRandomPubKey(), GetRandomPubKey()}
+	oldMembers := append(common.PubKeys{}, helper.members[2:]...)
+	oldMembers = append(oldMembers, leaving...)
+	for _, pk := range append(helper.members[1:], leaving...) {
+		na := GetRandomValidatorNode(NodeActive)
+		na.NodeAddress, _ = pk.GetThorAddress()
+		na.PubKeySet.Secp256k1 = pk
+		c.Assert(helper.keeper.SetNodeAccount(helper.ctx, na), IsNil)
+	}
+	vault := NewVault(helper.ctx.BlockHeight()-100, ActiveVault, AsgardVault, GetRandomPubKey(), chains, []ChainContract{})
+	vault.Membership = oldMembers.Strings()
+	vault.AddFunds(common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(100*common.One))))
+	c.Assert(helper.keeper.SetVault(helper.ctx, vault), IsNil)
+	for _, pk := range leaving {
+		na, err := helper.keeper.GetNodeAccountByPubKey(helper.ctx, pk)
+		c.Assert(err, IsNil)
+		na.TryAddSignerPubKey(vault.PubKey)
+		c.Assert(helper.keeper.SetNodeAccount(helper.ctx, na), IsNil)
+	}
+	keygen, err := NewReshareKeygen(helper.ctx.BlockHeight(), helper.members.Strings(), oldMembers.Strings(), vault.PubKey)
+	c.Assert(err, IsNil)
+	keygenBlock := NewKeygenBlock(helper.ctx.BlockHeight())
+	keygenBlock.Keygens = []Keygen{keygen}
+	helper.keeper.SetKeygenBlock(helper.ctx, keygenBlock)
+
+	// must reference the reshared vault
+	msg, err := NewMsgTssPool(helper.members.Strings(), GetRandomPubKey(), nil, nil, ReshareKeygen, helper.ctx.BlockHeight(), Blame{}, chains, helper.signer, keygenTime)
+	c.Assert(err, IsNil)
+	c.Check(handler.validate(helper.ctx, msg), NotNil)
+	msg, err = NewMsgTssPool(helper.members.Strings(), vault.PubKey, nil, nil, ReshareKeygen, helper.ctx.BlockHeight(), Blame{}, chains, helper.signer, keygenTime)
+	c.Assert(err, IsNil)
+	c.Check(handler.validate(helper.ctx, msg), IsNil)
+
+	checkSig := []byte("reshare-check-signature")
+	for _, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		msg, err = NewMsgTssPool(helper.members.Strings(), vault.PubKey, checkSig, nil, ReshareKeygen, helper.ctx.BlockHeight(), Blame{}, chains, thorAddr, keygenTime)
+		c.Assert(err, IsNil)
+		_, err = handler.handle(helper.ctx, msg)
+		c.Assert(err, IsNil)
+	}
+
+	// the vault keeps its key and funds and moves on to the new membership
+	v, err := helper.keeper.GetVault(helper.ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(v.Status, Equals, ActiveVault)
+	c.Check(v.StatusSince, Equals, helper.ctx.BlockHeight())
+	c.Check(v.MembershipEquals(helper.members), Equals, true)
+	c.Check(v.MembershipEpoch, Equals, int64(1))
+	c.Check(v.HasFunds(), Equals, true)
+	epoch, err := helper.keeper.GetVaultMembershipEpoch(helper.ctx, vault.PubKey, 1)
+	c.Assert(err, IsNil)
+	c.Check(epoch.BlockHeight, Equals, helper.ctx.BlockHeight())
+	c.Check(epoch.Membership, DeepEquals, v.Membership)
+	// the keygen membership is recorded as epoch zero
+	epoch, err = helper.keeper.GetVaultMembershipEpoch(helper.ctx, vault.PubKey, 0)
+	c.Assert(err, IsNil)
+	c.Check(epoch.BlockHeight, Equals, vault.BlockHeight)
+	c.Check(epoch.Membership, DeepEquals, oldMembers.Strings())
+
+	// no new vault is created
+	initVaults, err := helper.keeper.GetAsgardVaultsByStatus(helper.ctx, InitVault)
+	c.Assert(err, IsNil)
+	c.Check(initVaults, HasLen, 0)
+
+	for _, pk := range helper.members {
+		na, err := helper.keeper.GetNodeAccountByPubKey(helper.ctx, pk)
+		c.Assert(err, IsNil)
+		c.Check(na.GetSignerMembership().Contains(vault.PubKey), Equals, true)
+	}
+	for _, pk := range leaving {
+		na, err := helper.keeper.GetNodeAccountByPubKey(helper.ctx, pk)
+		c.Assert(err, IsNil)
+		c.Check(na.GetSignerMembership().Contains(vault.PubKey), Equals, false)
+	}
+}
+
 func (s *HandlerTssSuite) TestObservingSlashing(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	height := int64(1024)
@@ -892,3 +1249,39 @@ func (s *HandlerTssSuite) TestObservingSlashing(c *C) {
 
 	// Note that nas[6], the Standby node, remains unaffected by the Actives nodes' observations.
 }
+
+func (s *HandlerTssSuite) TestEdDSAKeygenTallyDisabled(c *C) {
+	helper := newTssHandlerTestHelper(c)
+	mgr := NewDummyMgrWithKeeper(helper.keeper)
+	dummySlasher, ok := mgr.Slasher().(*DummySlasher)
+	c.Assert(ok, Equals, true)
+	helper.keeper.SetMimir(helper.ctx, constants.MimirKeyEdDSAKeygenEnabled, 0)
+	chains := common.Chains{common.SwitchNative.Chain}.Strings()
+
+	vault := NewVault(helper.ctx.BlockHeight(), ActiveVault, AsgardVault, GetRandomPubKey(), chains, []ChainContract{})
+	vault.Membership = helper.members.Strings()
+	c.Assert(helper.keeper.SetVault(helper.ctx, vault), IsNil)
+	maxRetries := helper.keeper.GetConfigInt64(helper.ctx, constants.EdDSAKeygenMaxRetries)
+	helper.keeper.SetEdDSAKeygenRetries(helper.ctx, vault.PubKey, maxRetries)
+
+	// while EdDSA keygen is disabled nobody reports an ed25519 key, the tally settles
+	// without burning a retry, scheduling a keygen or blaming the members
+	voter := NewTssVoter("eddsa-disabled", helper.members.Strings(), vault.PubKey)
+	c.Assert(settleEd25519Tally(helper.ctx, mgr, voter, vault), IsNil)
+	voter, err := helper.keeper.GetTssVoter(helper.ctx, voter.ID)
+	c.Assert(err, IsNil)
+	c.Check(voter.Ed25519SettledHeight, Equals, helper.ctx.BlockHeight())
+	retries, err := helper.keeper.GetEdDSAKeygenRetries(helper.ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(retries, Equals, maxRetries)
+	keygenBlock, err := helper.keeper.GetKeygenBlock(helper.ctx, helper.ctx.BlockHeight())
+	c.Assert(err, IsNil)
+	for _, keygen := range keygenBlock.Keygens {
+		c.Check(keygen.Type, Not(Equals), EdDSAKeygen)
+	}
+	for _, item := range helper.members {
+		thorAddr, err := item.GetThorAddress()
+		c.Assert(err, IsNil)
+		c.Check(dummySlasher.pts[thorAddr.String()], Equals, int64(0))
+	}
+}
@@ -0,0 +1,160 @@
+package switchly
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
+)
+
+// UpdateAggregatorHandler is to handle UpdateAggregator message. Once a supermajority of
+// the active nodes have voted for the same proposal, the dex aggregator is added,
+// updated or removed from the aggregators registered in the keeper.
+type UpdateAggregatorHandler struct {
+	mgr Manager
+}
+
+// NewUpdateAggregatorHandler create new instance of UpdateAggregatorHandler
+func NewUpdateAggregatorHandler(mgr Manager) UpdateAggregatorHandler {
+	return UpdateAggregatorHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point to execute UpdateAggregator logic
+func (h UpdateAggregatorHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgUpdateAggregator)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("msg update aggregator failed validation", "error", err)
+		return nil, err
+	}
+	return h.handle(ctx, *msg)
+}
+
+func (h UpdateAggregatorHandler) validate(ctx cosmos.Context, msg MsgUpdateAggregator) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h UpdateAggregatorHandler) validateV3_0_0(ctx cosmos.Context, msg MsgUpdateAggregator) error {
+	// ValidateBasic is also executed in message service router's handler and isn't versioned there
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !isSignedByActiveNodeAccounts(ctx, h.mgr.Keeper(), msg.GetSigners()) {
+		return cosmos.ErrUnauthorized(errNotAuthorized.Error())
+	}
+
+	return nil
+}
+
+func (h UpdateAggregatorHandler) handle(ctx cosmos.Context, msg MsgUpdateAggregator) (*cosmos.Result, error) {
+	ctx.Logger().Info("handleMsgUpdateAggregator request", "chain", msg.Chain.String(), "address", msg.Address, "remove", msg.Remove)
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		ctx.Logger().Error(errInvalidVersion.Error())
+		return nil, errBadVersion
+	}
+}
+
+func (h UpdateAggregatorHandler) handleV3_0_0(ctx cosmos.Context, msg MsgUpdateAggregator) (*cosmos.Result, error) {
+	active, err := h.mgr.Keeper().ListActiveValidators(ctx)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get list of active node accounts")
+		return nil, err
+	}
+
+	proposal := msg.GetVoter()
+	voter, err := h.mgr.Keeper().GetDexAggregatorVoter(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := h.mgr.Keeper().GetDexAggregator(ctx, msg.Chain, msg.Address)
+	if err != nil {
+		return nil, ErrInternal(err, fmt.Sprintf("fail to get dex aggregator(%s)", msg.Address))
+	}
+
+	// a proposal that has been applied already is only voted for again once the state
+	// diverged from it, e.g. the aggregator was removed and should be added back
+	if voter.BlockHeight > 0 {
+		if isDexAggregatorApplied(current, proposal) {
+			return &cosmos.Result{}, nil
+		}
+		voter = proposal
+	}
+
+	voter.Sign(msg.Signer)
+	h.mgr.Keeper().SetDexAggregatorVoter(ctx, voter)
+
+	// doesn't have consensus yet
+	if !voter.HasConsensus(active) {
+		ctx.Logger().Info("not having consensus yet, return")
+		return &cosmos.Result{}, nil
+	}
+
+	voter.BlockHeight = ctx.BlockHeight()
+	h.mgr.Keeper().SetDexAggregatorVoter(ctx, voter)
+
+	action := "set"
+	agg := voter.Aggregator
+	if voter.Remove {
+		if current.IsEmpty() {
+			return &cosmos.Result{}, nil
+		}
+		var aggs []DexAggregator
+		aggs, err = h.mgr.Keeper().GetDexAggregators(ctx)
+		if err != nil {
+			return nil, ErrInternal(err, "fail to get dex aggregators")
+		}
+		agg = current
+		if len(aggs) > 1 {
+			action = "remove"
+			h.mgr.Keeper().RemoveDexAggregator(ctx, current)
+		} else {
+			// the last aggregator is disabled rather than removed, an empty registry
+			// doesn't restrict the aggregators swaps can call on mocknet
+			agg.Enabled = false
+			h.mgr.Keeper().SetDexAggregator(ctx, agg)
+		}
+	} else {
+		h.mgr.Keeper().SetDexAggregator(ctx, agg)
+	}
+
+	if err := h.mgr.EventMgr().EmitEvent(ctx, NewEventDexAggregator(action, agg)); err != nil {
+		ctx.Logger().Error("fail to emit dex_aggregator event", "error", err)
+	}
+
+	return &cosmos.Result{}, nil
+}
+
+// isDexAggregatorApplied return true when the keeper state already reflects the
+// given proposal
+func isDexAggregatorApplied(current DexAggregator, proposal DexAggregatorVoter) bool {
+	if proposal.Remove {
+		// the last aggregator is disabled instead
+		return current.IsEmpty() || !current.Enabled
+	}
+	return current.Equals(proposal.Aggregator)
+}
+
+// UpdateAggregatorAnteHandler called by the ante handler to gate mempool entry
+// and also during deliver. Store changes will persist if this function
+// succeeds, regardless of the success of the transaction.
+func UpdateAggregatorAnteHandler(ctx cosmos.Context, v semver.Version, k keeper.Keeper, msg MsgUpdateAggregator) (cosmos.Context, error) {
+	return activeNodeAccountsSignerPriority(ctx, k, msg.GetSigners())
+}
@@ -0,0 +1,128 @@
+package switchly
+
+import (
+	"errors"
+
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+var _ = Suite(&HandlerUpdateAggregatorSuite{})
+
+type HandlerUpdateAggregatorSuite struct{}
+
+func (s *HandlerUpdateAggregatorSuite) setup(c *C) (cosmos.Context, *Mgrs, NodeAccounts) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(1024)
+
+	nas := NodeAccounts{
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+	}
+	for _, na := range nas {
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	}
+	return ctx, mgr, nas
+}
+
+func (s *HandlerUpdateAggregatorSuite) TestValidate(c *C) {
+	ctx, mgr, nas := s.setup(c)
+	handler := NewUpdateAggregatorHandler(mgr)
+	addr := GetRandomETHAddress().String()
+
+	msg := NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, true, "", false, nas[0].NodeAddress)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+
+	// invalid msg
+	c.Check(handler.validate(ctx, MsgUpdateAggregator{}), NotNil)
+	c.Check(handler.validate(ctx, *NewMsgUpdateAggregator(common.ETHChain, addr, 0, true, "", false, nas[0].NodeAddress)), NotNil)
+	c.Check(handler.validate(ctx, *NewMsgUpdateAggregator(common.BTCChain, addr, 400_000, true, "", false, nas[0].NodeAddress)), NotNil)
+
+	// not signed by an active node
+	err := handler.validate(ctx, *NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, true, "", false, GetRandomBech32Addr()))
+	c.Check(errors.Is(err, se.ErrUnauthorized), Equals, true)
+}
+
+func (s *HandlerUpdateAggregatorSuite) TestHandle(c *C) {
+	ctx, mgr, nas := s.setup(c)
+	handler := NewUpdateAggregatorHandler(mgr)
+	addr := GetRandomETHAddress().String()
+
+	// add the aggregator
+	for idx, na := range nas {
+		_, err := handler.Run(ctx, NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, true, "v1", false, na.NodeAddress))
+		c.Assert(err, IsNil)
+		agg, err := mgr.Keeper().GetDexAggregator(ctx, common.ETHChain, addr)
+		c.Assert(err, IsNil)
+		c.Check(agg.IsEmpty(), Equals, !HasSuperMajority(idx+1, len(nas)), Commentf("%d", idx))
+	}
+	agg, err := mgr.Keeper().GetDexAggregator(ctx, common.ETHChain, addr)
+	c.Assert(err, IsNil)
+	c.Check(agg.GasLimit, Equals, uint64(400_000))
+	c.Check(agg.Enabled, Equals, true)
+	c.Check(agg.Version, Equals, "v1")
+
+	// votes after consensus aren't counted
+	voter, err := mgr.Keeper().GetDexAggregatorVoter(ctx, NewDexAggregatorVoter(agg, false))
+	c.Assert(err, IsNil)
+	c.Check(voter.BlockHeight, Equals, ctx.BlockHeight())
+	c.Check(voter.Signers, HasLen, 3)
+
+	// disable the aggregator, a different proposal
+	for _, na := range nas[:2] {
+		_, err = handler.Run(ctx, NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, false, "v1", false, na.NodeAddress))
+		c.Assert(err, IsNil)
+	}
+	agg, err = mgr.Keeper().GetDexAggregator(ctx, common.ETHChain, addr)
+	c.Assert(err, IsNil)
+	c.Check(agg.Enabled, Equals, true)
+	_, err = handler.Run(ctx, NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, false, "v1", false, nas[2].NodeAddress))
+	c.Assert(err, IsNil)
+	agg, err = mgr.Keeper().GetDexAggregator(ctx, common.ETHChain, addr)
+	c.Assert(err, IsNil)
+	c.Check(agg.Enabled, Equals, false)
+
+	// remove the aggregator
+	other := NewDexAggregator(common.AVAXChain, GetRandomETHAddress().String(), 400_000, true, "")
+	mgr.Keeper().SetDexAggregator(ctx, other)
+	for _, na := range nas[:3] {
+		_, err = handler.Run(ctx, NewMsgUpdateAggregator(common.ETHChain, addr, 0, false, "", true, na.NodeAddress))
+		c.Assert(err, IsNil)
+	}
+	agg, err = mgr.Keeper().GetDexAggregator(ctx, common.ETHChain, addr)
+	c.Assert(err, IsNil)
+	c.Check(agg.IsEmpty(), Equals, true)
+
+	// the state diverged from the applied proposal, the nodes can vote for it again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, na := range nas[:3] {
+		_, err = handler.Run(ctx, NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, true, "v1", false, na.NodeAddress))
+		c.Assert(err, IsNil)
+	}
+	agg, err = mgr.Keeper().GetDexAggregator(ctx, common.ETHChain, addr)
+	c.Assert(err, IsNil)
+	c.Check(agg.Enabled, Equals, true)
+	voter, err = mgr.Keeper().GetDexAggregatorVoter(ctx, NewDexAggregatorVoter(agg, false))
+	c.Assert(err, IsNil)
+	c.Check(voter.BlockHeight, Equals, ctx.BlockHeight())
+
+	// the last aggregator is disabled rather than removed
+	mgr.Keeper().RemoveDexAggregator(ctx, other)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, na := range nas[:3] {
+		_, err = handler.Run(ctx, NewMsgUpdateAggregator(common.ETHChain, addr, 0, false, "", true, na.NodeAddress))
+		c.Assert(err, IsNil)
+	}
+	agg, err = mgr.Keeper().GetDexAggregator(ctx, common.ETHChain, addr)
+	c.Assert(err, IsNil)
+	c.Check(agg.IsEmpty(), Equals, false)
+	c.Check(agg.Enabled, Equals, false)
+	aggs, err := mgr.Keeper().GetDexAggregators(ctx)
+	c.Assert(err, IsNil)
+	c.Check(aggs, HasLen, 1)
+}
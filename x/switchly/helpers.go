@@ -1,23 +1,92 @@
 package switchly
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hashicorp/go-metrics"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
 
+// traceTx records a stage of the lifecycle of the given inbound tx at the current block.
+// Stages are only recorded while finalizing blocks, so checks and simulations of a msg
+// do not record stages that never made it on chain. Within a context whose changes may
+// still be discarded (see withTraceBuffer), the stage is recorded once they are kept.
+func traceTx(ctx cosmos.Context, txID common.TxID, stage string, attrs ...attribute.KeyValue) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize || !tracing.Enabled() {
+		return
+	}
+	attrs = append(attrs, tracing.AttrBlockHeight.Int64(ctx.BlockHeight()))
+	if buf, ok := ctx.Context().Value(traceBufferKey{}).(*traceBuffer); ok {
+		buf.stages = append(buf.stages, tracedStage{txID: txID, stage: stage, attrs: attrs})
+		return
+	}
+	tracing.Event(txID, stage, attrs...)
+}
+
+type traceBufferKey struct{}
+
+type tracedStage struct {
+	txID  common.TxID
+	stage string
+	attrs []attribute.KeyValue
+}
+
+// traceBuffer holds the stages traced in a context until its changes are kept
+type traceBuffer struct {
+	stages []tracedStage
+}
+
+// withTraceBuffer returns a context whose traced stages are held back, and the function
+// recording them in the given context once the changes of the returned context are kept.
+// Stages traced in a context whose changes are discarded are never recorded.
+func withTraceBuffer(ctx cosmos.Context) (cosmos.Context, func()) {
+	buf := &traceBuffer{}
+	bufCtx := ctx.WithContext(context.WithValue(ctx.Context(), traceBufferKey{}, buf))
+	return bufCtx, func() {
+		for _, s := range buf.stages {
+			// the stages are held back again when ctx is buffered too
+			if parent, ok := ctx.Context().Value(traceBufferKey{}).(*traceBuffer); ok {
+				parent.stages = append(parent.stages, s)
+				continue
+			}
+			tracing.Event(s.txID, s.stage, s.attrs...)
+		}
+		buf.stages = nil
+	}
+}
+
+// cacheContext returns ctx.CacheContext(), the stages traced in the cached context are
+// only recorded when its changes are written.
+func cacheContext(ctx cosmos.Context) (cosmos.Context, func()) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx, record := withTraceBuffer(cacheCtx)
+	return cacheCtx, func() {
+		write()
+		record()
+	}
+}
+
 func refundTx(ctx cosmos.Context, tx ObservedTx, mgr Manager, refundCode uint32, refundReason, sourceModuleName string) error {
+	traceTx(ctx, tx.Tx.ID, tracing.StageRefund,
+		tracing.AttrCode.Int64(int64(refundCode)),
+		tracing.AttrReason.String(refundReason),
+	)
+
 	// If SWITCHLYNode recognize one of the coins, and therefore able to refund
 	// withholding fees, refund all coins.
 
@@ -888,6 +957,31 @@ func getNonSigners(nas []NodeAccount, signers []cosmos.AccAddress) []cosmos.AccA
 	return nonSigners
 }
 
+// getFeeAccount returns the account of the vault on the given chain as priced by the fee
+// model of the chain
+func getFeeAccount(ctx cosmos.Context, k keeper.Keeper, chain common.Chain, pubkey common.PubKey) (feemodel.Account, error) {
+	signers, err := k.GetVaultSigners(ctx, chain, pubkey)
+	if err != nil {
+		return feemodel.Account{}, fmt.Errorf("fail to get signers of vault(%s) on chain(%s): %w", pubkey, chain, err)
+	}
+	return feemodel.Account{Signers: uint64(signers)}, nil
+}
+
+// isIBCChannelEnabled returns true when the destination is an account of another Cosmos
+// zone and the mimir allows the given chain to pay it over its ICS-20 channel
+func isIBCChannelEnabled(ctx cosmos.Context, k keeper.Keeper, chain common.Chain, destination common.Address) bool {
+	channel, _, ok := destination.IBCChannel()
+	if !ok || !destination.IsIBCChain(chain) {
+		return false
+	}
+	enabled, err := k.GetMimirWithRef(ctx, constants.MimirTemplateIBCChannel, chain.String(), channel)
+	if err != nil {
+		ctx.Logger().Error("fail to get ibc channel mimir", "chain", chain, "channel", channel, "error", err)
+		return false
+	}
+	return enabled > 0
+}
+
 // In the case where the max gas of the chain of a queued outbound tx has changed
 // Update the ObservedTxVoter so the network can still match the outbound with
 // the observed inbound
@@ -1273,3 +1367,58 @@ func trimKeyPrefix(key []byte) string {
 func IsPeriodLastBlock(ctx cosmos.Context, blocksPerPeriod int64) bool {
 	return ctx.BlockHeight()%blocksPerPeriod == 0
 }
+
+// defaultDexAggregatorGasLimit is the gas limit of aggregator calls when no dex
+// aggregator is registered on mocknet
+const defaultDexAggregatorGasLimit = 400_000
+
+// fetchDexAggregator - fetches the registered dex aggregator that matches the given
+// chain and suffix. On mocknet, when no dex aggregator is registered at all, an empty
+// aggregator is returned so the caller can fall through to the given suffix, this helps
+// dex aggregator contract devs to test their work without governance. Other networks
+// only call registered aggregators.
+func fetchDexAggregator(ctx cosmos.Context, k keeper.Keeper, chain common.Chain, suffix string) (DexAggregator, error) {
+	aggs, err := k.GetDexAggregators(ctx)
+	if err != nil {
+		return DexAggregator{}, fmt.Errorf("fail to get dex aggregators: %w", err)
+	}
+	if len(aggs) == 0 && common.CurrentChainNetwork == common.MockNet {
+		return DexAggregator{}, nil
+	}
+	for _, agg := range aggs {
+		if !agg.Matches(chain, suffix) {
+			continue
+		}
+		if !agg.Enabled {
+			return DexAggregator{}, fmt.Errorf("%s aggregator is disabled", suffix)
+		}
+		return agg, nil
+	}
+	return DexAggregator{}, fmt.Errorf("%s aggregator not found", suffix)
+}
+
+// FetchDexAggregator - fetches a dex aggregator address that matches the given chain and suffix
+func FetchDexAggregator(ctx cosmos.Context, k keeper.Keeper, chain common.Chain, suffix string) (string, error) {
+	agg, err := fetchDexAggregator(ctx, k, chain, suffix)
+	if err != nil {
+		return "", err
+	}
+	// If no aggregators are registered on mocknet, fall through to the suffix
+	if agg.IsEmpty() {
+		return suffix, nil
+	}
+	return agg.Address, nil
+}
+
+// FetchDexAggregatorGasLimit - fetches a dex aggregator gas limit that matches the given chain and suffix
+func FetchDexAggregatorGasLimit(ctx cosmos.Context, k keeper.Keeper, chain common.Chain, suffix string) (uint64, error) {
+	agg, err := fetchDexAggregator(ctx, k, chain, suffix)
+	if err != nil {
+		return 0, err
+	}
+	// If no aggregators are registered on mocknet, fall through to the default gas limit
+	if agg.IsEmpty() {
+		return defaultDexAggregatorGasLimit, nil
+	}
+	return agg.GasLimit, nil
+}
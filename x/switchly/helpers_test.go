@@ -1,13 +1,18 @@
 package switchly
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "gopkg.in/check.v1"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
+	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
@@ -845,3 +850,80 @@ func (HandlerSuite) TestIsPeriodLastBlock(c *C) {
 	result = IsPeriodLastBlock(ctx, blockVar)
 	c.Assert(result, Equals, false)
 }
+
+func (s *HelperSuite) TestFetchDexAggregator(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// no aggregators registered, fall through to the suffix and default gas limit on
+	// mocknet only
+	addr, err := FetchDexAggregator(ctx, k, common.ETHChain, "whatever")
+	gasLimit, gasErr := FetchDexAggregatorGasLimit(ctx, k, common.ETHChain, "whatever")
+	if common.CurrentChainNetwork == common.MockNet {
+		c.Assert(err, IsNil)
+		c.Check(addr, Equals, "whatever")
+		c.Assert(gasErr, IsNil)
+		c.Check(gasLimit, Equals, uint64(400_000))
+	} else {
+		c.Check(err, ErrorMatches, "whatever aggregator not found")
+		c.Check(gasErr, ErrorMatches, "whatever aggregator not found")
+	}
+
+	k.SetDexAggregator(ctx, NewDexAggregator(common.ETHChain, "0x69800327b38A4CeF30367Dec3f64c2f2386f3848", 500_000, true, ""))
+	k.SetDexAggregator(ctx, NewDexAggregator(common.AVAXChain, "0x1429859428C0aBc9C2C47C8Ee9FBaf82cFA0F20f", 400_000, false, ""))
+
+	addr, err = FetchDexAggregator(ctx, k, common.ETHChain, "2f2386f3848")
+	c.Assert(err, IsNil)
+	c.Check(addr, Equals, "0x69800327b38A4CeF30367Dec3f64c2f2386f3848")
+	gasLimit, err = FetchDexAggregatorGasLimit(ctx, k, common.ETHChain, "2f2386f3848")
+	c.Assert(err, IsNil)
+	c.Check(gasLimit, Equals, uint64(500_000))
+
+	_, err = FetchDexAggregator(ctx, k, common.ETHChain, "whatever")
+	c.Check(err, ErrorMatches, "whatever aggregator not found")
+	_, err = FetchDexAggregator(ctx, k, common.AVAXChain, "2f2386f3848")
+	c.Check(err, NotNil)
+
+	// disabled aggregators can't be used
+	_, err = FetchDexAggregator(ctx, k, common.AVAXChain, "cFA0F20f")
+	c.Check(err, ErrorMatches, "cFA0F20f aggregator is disabled")
+	_, err = FetchDexAggregatorGasLimit(ctx, k, common.AVAXChain, "cFA0F20f")
+	c.Check(err, NotNil)
+}
+
+func (s *HelperSuite) TestTraceTxCacheContext(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+	path := filepath.Join(c.MkDir(), "traces.jsonl")
+	shutdown, err := tracing.Init("switchlynode", config.TracingConfiguration{Enabled: true, File: path})
+	c.Assert(err, IsNil)
+
+	written := common.TxID("0D5B6EE4CB5B2D55C5A0AAE2C9A46A4A7A7B3F3C0D2D8A6E5D5B2E0E5B4F1C2A")
+	discarded := common.TxID("A4C2E0D5B6EE4CB5B2D55C5A0AAE2C9A46A4A7A7B3F3C0D2D8A6E5D5B2E0E5B4")
+	nested := common.TxID("B3F3C0D2D8A6E5D5B2E0E5B4F1C2A0D5B6EE4CB5B2D55C5A0AAE2C9A46A4A7A7")
+
+	cacheCtx, commit := cacheContext(ctx)
+	traceTx(cacheCtx, written, tracing.StageConsensus)
+	innerCtx, innerCommit := cacheContext(cacheCtx)
+	traceTx(innerCtx, nested, tracing.StageRefund)
+	innerCommit()
+	discardedCtx, _ := cacheContext(cacheCtx)
+	traceTx(discardedCtx, discarded, tracing.StageRefund)
+	commit()
+
+	// the stages of a discarded context are never recorded
+	discardedCtx, _ = cacheContext(ctx)
+	traceTx(discardedCtx, discarded, tracing.StageProcessed)
+	c.Assert(shutdown(context.Background()), IsNil)
+
+	records, err := tracing.ReadRecords(written.String(), path)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Check(records[0].Stage, Equals, tracing.StageConsensus)
+	c.Check(records[0].Attributes["switchly.block_height"], Equals, fmt.Sprintf("%d", ctx.BlockHeight()))
+	records, err = tracing.ReadRecords(nested.String(), path)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 1)
+	records, err = tracing.ReadRecords(discarded.String(), path)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 0)
+}
@@ -0,0 +1,99 @@
+package history
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	flagEnabled      = "history.enable"
+	flagDir          = "history.dir"
+	flagRetainBlocks = "history.retain_blocks"
+)
+
+type HistoryConfig struct {
+	Enable bool `json:"enable"`
+	// Dir is the directory the position history is stored in, relative paths are resolved
+	// against the node home.
+	Dir string `json:"dir"`
+	// RetainBlocks is the number of blocks of history kept, older snapshots are pruned
+	// down to the last one before the window. Zero keeps all history.
+	RetainBlocks int64 `json:"retain_blocks"`
+}
+
+func DefaultHistoryConfig() HistoryConfig {
+	return HistoryConfig{
+		Enable:       false,
+		Dir:          "data",
+		RetainBlocks: 0,
+	}
+}
+
+// ConfigTemplate toml snippet for app.toml
+func ConfigTemplate(c HistoryConfig) string {
+	return fmt.Sprintf(`
+[history]
+# Whether liquidity provider and saver position history is recorded and served by this node
+enable = %t
+
+# Directory the position history is stored in, relative paths are resolved against the node home
+dir = "%s"
+
+# Number of blocks of position history to keep (0 to keep all)
+retain_blocks = %d
+`, c.Enable, c.Dir, c.RetainBlocks)
+}
+
+func DefaultConfigTemplate() string {
+	return ConfigTemplate(DefaultHistoryConfig())
+}
+
+// ____________________________________________________________________________
+
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	defaults := DefaultHistoryConfig()
+	startCmd.Flags().Bool(flagEnabled, defaults.Enable, "Record and serve liquidity provider and saver position history")
+	startCmd.Flags().String(flagDir, defaults.Dir, "Directory the position history is stored in")
+	startCmd.Flags().Int64(flagRetainBlocks, defaults.RetainBlocks, "Number of blocks of position history to keep (0 to keep all)")
+}
+
+// ReadHistoryConfig reads the position history specific configuration
+func ReadHistoryConfig(opts servertypes.AppOptions) (HistoryConfig, error) {
+	cfg := DefaultHistoryConfig()
+
+	if v := opts.Get(flagEnabled); v != nil {
+		var err error
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := opts.Get(flagDir); v != nil {
+		var ok bool
+		if cfg.Dir, ok = v.(string); !ok {
+			return cfg, fmt.Errorf("expected string for %s, got %T", flagDir, v)
+		}
+	}
+	if !filepath.IsAbs(cfg.Dir) {
+		cfg.Dir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), cfg.Dir)
+	}
+
+	if v := opts.Get(flagRetainBlocks); v != nil {
+		var err error
+		if cfg.RetainBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+		if cfg.RetainBlocks < 0 {
+			return cfg, fmt.Errorf("%s must not be negative", flagRetainBlocks)
+		}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,609 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+const (
+	// historyStoreName is the name of the leveldb the position history is stored in
+	historyStoreName = "history"
+
+	prefixPool     = "p/"
+	prefixPosition = "l/"
+	prefixGap      = "g/"
+	keyLastHeight  = "m/height"
+
+	// prefixes of the switchly store records the history is built from, see keeper/v1/keeper.go
+	storePrefixPool         = "pool//"
+	storePrefixLP           = "lp//"
+	storePrefixLiquidityFee = "pool_liquidity_fee//"
+
+	// pruneInterval is the number of blocks between prunes of the history
+	pruneInterval = 1000
+)
+
+// feeIndexScale is the precision of the per pool unit fee index
+var feeIndexScale = cosmos.NewUint(1e18)
+
+// PoolPoint is the state of a pool at a height it changed
+type PoolPoint struct {
+	BalanceAsset  cosmos.Uint `json:"balance_asset"`
+	BalanceSwitch cosmos.Uint `json:"balance_switch"`
+	LPUnits       cosmos.Uint `json:"lp_units"`
+	SynthUnits    cosmos.Uint `json:"synth_units"`
+	// FeeIndex is the cumulative SWITCH liquidity fee earned per pool unit, scaled by feeIndexScale
+	FeeIndex cosmos.Uint `json:"fee_index"`
+}
+
+// PositionPoint is the state of a liquidity provider at a height it changed
+type PositionPoint struct {
+	Units              cosmos.Uint `json:"units"`
+	AssetDepositValue  cosmos.Uint `json:"asset_deposit_value"`
+	SwitchDepositValue cosmos.Uint `json:"switch_deposit_value"`
+	// FeeIndex is the fee index of the pool at the height of the point
+	FeeIndex cosmos.Uint `json:"fee_index"`
+	// AccruedFees are the SWITCH liquidity fees earned by the position up to the height of the point
+	AccruedFees cosmos.Uint `json:"accrued_fees"`
+	Removed     bool        `json:"removed"`
+}
+
+func newPoolPoint() PoolPoint {
+	return PoolPoint{
+		BalanceAsset:  cosmos.ZeroUint(),
+		BalanceSwitch: cosmos.ZeroUint(),
+		LPUnits:       cosmos.ZeroUint(),
+		SynthUnits:    cosmos.ZeroUint(),
+		FeeIndex:      cosmos.ZeroUint(),
+	}
+}
+
+func (p PoolPoint) poolUnits() cosmos.Uint {
+	return p.LPUnits.Add(p.SynthUnits)
+}
+
+// accruedFees returns the fees earned by the position once the pool fee index reached feeIndex
+func (p PositionPoint) accruedFees(feeIndex cosmos.Uint) cosmos.Uint {
+	if feeIndex.LTE(p.FeeIndex) {
+		return p.AccruedFees
+	}
+	return p.AccruedFees.Add(p.Units.Mul(feeIndex.Sub(p.FeeIndex)).Quo(feeIndexScale))
+}
+
+// Indexer records a snapshot of every pool and liquidity provider (including savers) each
+// time they change, by listening to the changes committed to the switchly store. It keeps
+// the history in its own database, which is local to the node and not part of consensus,
+// and serves the range queries of positions over time.
+//
+// Liquidity fees are tracked as a cumulative fee per pool unit index on the pool snapshots,
+// so the fees accrued by a position between two heights are its units multiplied by the
+// change of the index.
+type Indexer struct {
+	db           dbm.DB
+	storeKey     storetypes.StoreKey
+	retainBlocks int64
+	logger       log.Logger
+
+	// pools caches the latest snapshot of each pool, it is only accessed from ListenCommit
+	pools map[string]PoolPoint
+}
+
+var _ storetypes.ABCIListener = &Indexer{}
+
+func NewIndexer(db dbm.DB, storeKey storetypes.StoreKey, retainBlocks int64, logger log.Logger) *Indexer {
+	return &Indexer{
+		db:           db,
+		storeKey:     storeKey,
+		retainBlocks: retainBlocks,
+		logger:       logger,
+		pools:        make(map[string]PoolPoint),
+	}
+}
+
+// OpenIndexer opens the position history database in the configured directory
+func OpenIndexer(cfg HistoryConfig, storeKey storetypes.StoreKey, logger log.Logger) (*Indexer, error) {
+	db, err := dbm.NewGoLevelDB(historyStoreName, cfg.Dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open position history store: %w", err)
+	}
+	return NewIndexer(db, storeKey, cfg.RetainBlocks, logger.With("module", "history")), nil
+}
+
+// Close closes the position history database
+func (i *Indexer) Close() error {
+	return i.db.Close()
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, the history is only built on commit
+func (i *Indexer) ListenFinalizeBlock(_ context.Context, _ abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, it records the pools and liquidity
+// providers changed in the committed block. The first block seen is used to seed the
+// history with the current state of every pool and liquidity provider.
+//
+// Blocks committed while the indexer was not listening, e.g. while the history was
+// disabled, are skipped heights whose changes are lost. They are recorded as a gap the
+// history queries report, and the history is seeded again from the current state.
+func (i *Indexer) ListenCommit(goCtx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	height := ctx.BlockHeight()
+
+	last, err := i.LastHeight()
+	if err != nil {
+		return err
+	}
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	var pools map[string]PoolPoint
+	switch {
+	case last == 0:
+		pools, err = i.seed(ctx.KVStore(i.storeKey), height, batch)
+	case height > last+1:
+		i.logger.Error("position history skipped heights, seeding it again", "from", last+1, "to", height-1)
+		if err = batch.Set(gapKey(last+1), heightBytes(height-1)); err != nil {
+			return err
+		}
+		pools, err = i.seed(ctx.KVStore(i.storeKey), height, batch)
+	default:
+		pools, err = i.index(changeSet, height, batch)
+	}
+	if err != nil {
+		return fmt.Errorf("fail to index block %d: %w", height, err)
+	}
+	if err = batch.Set([]byte(keyLastHeight), heightBytes(height)); err != nil {
+		return err
+	}
+	if err = batch.Write(); err != nil {
+		return fmt.Errorf("fail to write position history: %w", err)
+	}
+	for asset, point := range pools {
+		i.pools[asset] = point
+	}
+
+	if i.retainBlocks > 0 && height%pruneInterval == 0 {
+		if err = i.prune(height - i.retainBlocks); err != nil {
+			i.logger.Error("fail to prune position history", "height", height, "error", err)
+		}
+	}
+	return nil
+}
+
+// LastHeight returns the height of the last block indexed, zero when nothing was indexed yet
+func (i *Indexer) LastHeight() (int64, error) {
+	bz, err := i.db.Get([]byte(keyLastHeight))
+	if err != nil {
+		return 0, fmt.Errorf("fail to get last indexed height: %w", err)
+	}
+	if len(bz) != 8 {
+		return 0, nil
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// seed records the current state of every pool and liquidity provider. When the history
+// is seeded again after skipped heights, the fee index of the pools carries on, and the
+// positions that are no longer in the store are recorded as removed.
+func (i *Indexer) seed(store storetypes.KVStore, height int64, batch dbm.Batch) (map[string]PoolPoint, error) {
+	pools := make(map[string]PoolPoint)
+
+	poolIter := storetypes.KVStorePrefixIterator(store, []byte(storePrefixPool))
+	defer poolIter.Close()
+	for ; poolIter.Valid(); poolIter.Next() {
+		var pool types.Pool
+		if err := pool.Unmarshal(poolIter.Value()); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal pool %s: %w", poolIter.Key(), err)
+		}
+		asset := strings.TrimPrefix(string(poolIter.Key()), storePrefixPool)
+		prev, _, err := i.latestPool(asset)
+		if err != nil {
+			return nil, err
+		}
+		point := newPoolPoint()
+		point.FeeIndex = prev.FeeIndex
+		setPoolBalances(&point, pool)
+		if err = setPoint(batch, poolKey(asset, height), point); err != nil {
+			return nil, err
+		}
+		pools[asset] = point
+	}
+
+	seen := make(map[string]bool)
+	lpIter := storetypes.KVStorePrefixIterator(store, []byte(storePrefixLP))
+	defer lpIter.Close()
+	for ; lpIter.Valid(); lpIter.Next() {
+		var lp types.LiquidityProvider
+		if err := lp.Unmarshal(lpIter.Value()); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal liquidity provider %s: %w", lpIter.Key(), err)
+		}
+		asset, addr, ok := splitPositionKey(strings.TrimPrefix(string(lpIter.Key()), storePrefixLP))
+		if !ok {
+			continue
+		}
+		series := positionSeries(asset, addr)
+		seen[string(series)] = true
+		point, err := i.seedPosition(series, pools, asset)
+		if err != nil {
+			return nil, err
+		}
+		point.Units = lp.Units
+		point.AssetDepositValue = lp.AssetDepositValue
+		point.SwitchDepositValue = lp.SwitchDepositValue
+		if err = setPoint(batch, seriesKey(series, height), point); err != nil {
+			return nil, err
+		}
+	}
+
+	// positions recorded before that are no longer in the store were removed
+	iter, err := dbm.IteratePrefix(i.db, []byte(prefixPosition))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	var removed [][]byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) <= len(prefixPosition)+8 {
+			continue
+		}
+		series := key[:len(key)-8]
+		if seen[string(series)] {
+			continue
+		}
+		seen[string(series)] = true
+		removed = append(removed, append([]byte{}, series...))
+	}
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+	for _, series := range removed {
+		asset, _, ok := splitPositionKey(strings.TrimSuffix(strings.TrimPrefix(string(series), prefixPosition), "/"))
+		if !ok {
+			continue
+		}
+		var prev PositionPoint
+		if _, _, err = lastPoint(i.db, series, maxHeight, &prev); err != nil {
+			return nil, err
+		}
+		if prev.Removed {
+			continue
+		}
+		point, err := i.seedPosition(series, pools, asset)
+		if err != nil {
+			return nil, err
+		}
+		point.Removed = true
+		if err = setPoint(batch, seriesKey(series, height), point); err != nil {
+			return nil, err
+		}
+	}
+
+	return pools, nil
+}
+
+// seedPosition returns an empty snapshot of the position carrying on the fees it accrued
+// up to the fee index of its pool
+func (i *Indexer) seedPosition(series []byte, pools map[string]PoolPoint, asset string) (PositionPoint, error) {
+	pool, ok := pools[asset]
+	if !ok {
+		var err error
+		if pool, _, err = i.latestPool(asset); err != nil {
+			return PositionPoint{}, err
+		}
+	}
+	point := PositionPoint{
+		Units:              cosmos.ZeroUint(),
+		AssetDepositValue:  cosmos.ZeroUint(),
+		SwitchDepositValue: cosmos.ZeroUint(),
+		FeeIndex:           pool.FeeIndex,
+		AccruedFees:        cosmos.ZeroUint(),
+	}
+	var prev PositionPoint
+	_, found, err := lastPoint(i.db, series, maxHeight, &prev)
+	if err != nil {
+		return point, err
+	}
+	if found {
+		point.AccruedFees = prev.accruedFees(pool.FeeIndex)
+	}
+	return point, nil
+}
+
+// gap returns the first range of skipped heights overlapping the from to to range
+func (i *Indexer) gap(from, to int64) (int64, int64, bool, error) {
+	iter, err := dbm.IteratePrefix(i.db, []byte(prefixGap))
+	if err != nil {
+		return 0, 0, false, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		if len(key) != len(prefixGap)+8 || len(value) != 8 {
+			continue
+		}
+		start := int64(binary.BigEndian.Uint64(key[len(prefixGap):]))
+		end := int64(binary.BigEndian.Uint64(value))
+		if start <= to && end >= from {
+			return start, end, true, nil
+		}
+	}
+	return 0, 0, false, iter.Error()
+}
+
+// index records the pools and liquidity providers changed in the change set. Liquidity fees
+// are applied to the fee index after the pool balances are updated, and the liquidity
+// providers are recorded last so their snapshot carries the fee index of the block.
+func (i *Indexer) index(changeSet []*storetypes.StoreKVPair, height int64, batch dbm.Batch) (map[string]PoolPoint, error) {
+	fees := make(map[string]cosmos.Uint)
+	pools := make(map[string]*types.Pool)
+	lps := make(map[string]*types.LiquidityProvider)
+
+	for _, pair := range changeSet {
+		if pair.StoreKey != i.storeKey.Name() {
+			continue
+		}
+		key := string(pair.Key)
+		switch {
+		case strings.HasPrefix(key, storePrefixPool):
+			asset := strings.TrimPrefix(key, storePrefixPool)
+			if pair.Delete {
+				pools[asset] = nil
+				continue
+			}
+			pool := &types.Pool{}
+			if err := pool.Unmarshal(pair.Value); err != nil {
+				return nil, fmt.Errorf("fail to unmarshal pool %s: %w", asset, err)
+			}
+			pools[asset] = pool
+		case strings.HasPrefix(key, storePrefixLP):
+			lpKey := strings.TrimPrefix(key, storePrefixLP)
+			if pair.Delete {
+				lps[lpKey] = nil
+				continue
+			}
+			lp := &types.LiquidityProvider{}
+			if err := lp.Unmarshal(pair.Value); err != nil {
+				return nil, fmt.Errorf("fail to unmarshal liquidity provider %s: %w", lpKey, err)
+			}
+			lps[lpKey] = lp
+		case strings.HasPrefix(key, storePrefixLiquidityFee):
+			// keyed by <height>-<asset>, the value is the total fee of the block
+			parts := strings.SplitN(strings.TrimPrefix(key, storePrefixLiquidityFee), "-", 2)
+			if pair.Delete || len(parts) != 2 {
+				continue
+			}
+			var fee types.ProtoUint64
+			if err := fee.Unmarshal(pair.Value); err != nil {
+				return nil, fmt.Errorf("fail to unmarshal liquidity fee %s: %w", key, err)
+			}
+			fees[parts[1]] = cosmos.NewUint(fee.Value)
+		}
+	}
+
+	assets := make([]string, 0, len(pools)+len(fees))
+	for asset := range pools {
+		assets = append(assets, asset)
+	}
+	for asset := range fees {
+		if _, ok := pools[asset]; !ok {
+			assets = append(assets, asset)
+		}
+	}
+	sort.Strings(assets)
+
+	updated := make(map[string]PoolPoint, len(assets))
+	for _, asset := range assets {
+		point, _, err := i.latestPool(asset)
+		if err != nil {
+			return nil, err
+		}
+		if pool, ok := pools[asset]; ok {
+			feeIndex := point.FeeIndex
+			point = newPoolPoint()
+			point.FeeIndex = feeIndex
+			if pool != nil {
+				setPoolBalances(&point, *pool)
+			}
+		}
+		if fee, ok := fees[asset]; ok && !point.poolUnits().IsZero() {
+			point.FeeIndex = point.FeeIndex.Add(fee.Mul(feeIndexScale).Quo(point.poolUnits()))
+		}
+		if err = setPoint(batch, poolKey(asset, height), point); err != nil {
+			return nil, err
+		}
+		updated[asset] = point
+	}
+
+	lpKeys := make([]string, 0, len(lps))
+	for lpKey := range lps {
+		lpKeys = append(lpKeys, lpKey)
+	}
+	sort.Strings(lpKeys)
+
+	for _, lpKey := range lpKeys {
+		asset, addr, ok := splitPositionKey(lpKey)
+		if !ok {
+			continue
+		}
+		pool, ok := updated[asset]
+		if !ok {
+			var err error
+			if pool, _, err = i.latestPool(asset); err != nil {
+				return nil, err
+			}
+		}
+		var prev PositionPoint
+		_, found, err := lastPoint(i.db, positionSeries(asset, addr), maxHeight, &prev)
+		if err != nil {
+			return nil, err
+		}
+
+		point := PositionPoint{
+			Units:              cosmos.ZeroUint(),
+			AssetDepositValue:  cosmos.ZeroUint(),
+			SwitchDepositValue: cosmos.ZeroUint(),
+			FeeIndex:           pool.FeeIndex,
+			AccruedFees:        cosmos.ZeroUint(),
+			Removed:            lps[lpKey] == nil,
+		}
+		if found {
+			point.AccruedFees = prev.accruedFees(pool.FeeIndex)
+		}
+		if lp := lps[lpKey]; lp != nil {
+			point.Units = lp.Units
+			point.AssetDepositValue = lp.AssetDepositValue
+			point.SwitchDepositValue = lp.SwitchDepositValue
+		}
+		if err = setPoint(batch, positionKey(asset, addr, height), point); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// latestPool returns the latest snapshot of the pool, or an empty snapshot if there is none
+func (i *Indexer) latestPool(asset string) (PoolPoint, bool, error) {
+	if point, ok := i.pools[asset]; ok {
+		return point, true, nil
+	}
+	point := newPoolPoint()
+	_, found, err := lastPoint(i.db, poolSeries(asset), maxHeight, &point)
+	if err != nil {
+		return point, false, err
+	}
+	if found {
+		i.pools[asset] = point
+	}
+	return point, found, nil
+}
+
+// prune removes the snapshots older than cutoff, except the last one of each series before
+// the cutoff which is kept as the state at the start of the retained window. Positions
+// removed before the cutoff are dropped entirely.
+func (i *Indexer) prune(cutoff int64) error {
+	if cutoff <= 0 {
+		return nil
+	}
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	pruned := 0
+	for _, prefix := range []string{prefixPool, prefixPosition} {
+		iter, err := dbm.IteratePrefix(i.db, []byte(prefix))
+		if err != nil {
+			return err
+		}
+
+		var series, pending, pendingValue []byte
+		hasNewer := false
+		flush := func() error {
+			if pending == nil || hasNewer || prefix != prefixPosition {
+				return nil
+			}
+			var point PositionPoint
+			if err := json.Unmarshal(pendingValue, &point); err != nil || point.Removed {
+				pruned++
+				return batch.Delete(pending)
+			}
+			return nil
+		}
+
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()
+			if len(key) <= len(prefix)+8 {
+				continue
+			}
+			keySeries, height := key[:len(key)-8], int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+			if !bytes.Equal(keySeries, series) {
+				if err = flush(); err != nil {
+					iter.Close()
+					return err
+				}
+				series = append([]byte{}, keySeries...)
+				pending, pendingValue, hasNewer = nil, nil, false
+			}
+			if height >= cutoff {
+				hasNewer = true
+				continue
+			}
+			if pending != nil {
+				if err = batch.Delete(pending); err != nil {
+					iter.Close()
+					return err
+				}
+				pruned++
+			}
+			pending = append([]byte{}, key...)
+			pendingValue = append([]byte{}, iter.Value()...)
+		}
+		if err = flush(); err != nil {
+			iter.Close()
+			return err
+		}
+		err = iter.Error()
+		iter.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	// gaps before the retained window no longer matter
+	iter, err := dbm.IteratePrefix(i.db, []byte(prefixGap))
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid(); iter.Next() {
+		if value := iter.Value(); len(value) == 8 && int64(binary.BigEndian.Uint64(value)) < cutoff {
+			if err = batch.Delete(append([]byte{}, iter.Key()...)); err != nil {
+				iter.Close()
+				return err
+			}
+		}
+	}
+	err = iter.Error()
+	iter.Close()
+	if err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	i.logger.Debug("pruned position history", "cutoff", cutoff, "snapshots", pruned)
+	return nil
+}
+
+func setPoolBalances(point *PoolPoint, pool types.Pool) {
+	point.BalanceAsset = pool.BalanceAsset
+	point.BalanceSwitch = pool.BalanceSwitch
+	point.LPUnits = pool.LPUnits
+	point.SynthUnits = pool.SynthUnits
+}
+
+// splitPositionKey splits a liquidity provider key into the pool asset and the address, the
+// asset of a savers pool contains a slash so the key is split at the last one
+func splitPositionKey(key string) (string, string, bool) {
+	idx := strings.LastIndex(key, "/")
+	if idx <= 0 || idx == len(key)-1 {
+		return "", "", false
+	}
+	return key[:idx], key[idx+1:], true
+}
@@ -0,0 +1,306 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+const (
+	testAddrA = "tswitch1aaaa"
+	testAddrB = "tswitch1bbbb"
+)
+
+type testChain struct {
+	t       *testing.T
+	key     *storetypes.KVStoreKey
+	ctx     sdk.Context
+	indexer *Indexer
+}
+
+func newTestChain(t *testing.T) *testChain {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	return &testChain{
+		t:       t,
+		key:     key,
+		ctx:     ctx,
+		indexer: NewIndexer(dbm.NewMemDB(), key, 0, log.NewNopLogger()),
+	}
+}
+
+func testPool(asset common.Asset, balanceAsset, balanceSwitch, lpUnits uint64) types.Pool {
+	pool := types.NewPool()
+	pool.Asset = asset
+	pool.BalanceAsset = cosmos.NewUint(balanceAsset)
+	pool.BalanceSwitch = cosmos.NewUint(balanceSwitch)
+	pool.LPUnits = cosmos.NewUint(lpUnits)
+	return pool
+}
+
+func testLP(asset common.Asset, addr string, units, assetDeposit, switchDeposit uint64) types.LiquidityProvider {
+	return types.LiquidityProvider{
+		Asset:              asset,
+		SwitchAddress:      common.Address(addr),
+		Units:              cosmos.NewUint(units),
+		PendingSwitch:      cosmos.ZeroUint(),
+		PendingAsset:       cosmos.ZeroUint(),
+		AssetDepositValue:  cosmos.NewUint(assetDeposit),
+		SwitchDepositValue: cosmos.NewUint(switchDeposit),
+	}
+}
+
+func (c *testChain) poolPair(pool types.Pool) *storetypes.StoreKVPair {
+	bz, err := pool.Marshal()
+	require.NoError(c.t, err)
+	key := []byte(fmt.Sprintf("pool//%s", strings.ToUpper(pool.Asset.String())))
+	c.ctx.KVStore(c.key).Set(key, bz)
+	return &storetypes.StoreKVPair{StoreKey: c.key.Name(), Key: key, Value: bz}
+}
+
+func (c *testChain) lpPair(lp types.LiquidityProvider) *storetypes.StoreKVPair {
+	bz, err := lp.Marshal()
+	require.NoError(c.t, err)
+	key := []byte("lp//" + strings.ToUpper(lp.Key()))
+	c.ctx.KVStore(c.key).Set(key, bz)
+	return &storetypes.StoreKVPair{StoreKey: c.key.Name(), Key: key, Value: bz}
+}
+
+func (c *testChain) removeLPPair(asset common.Asset, addr string) *storetypes.StoreKVPair {
+	key := []byte("lp//" + strings.ToUpper(asset.String()+"/"+addr))
+	c.ctx.KVStore(c.key).Delete(key)
+	return &storetypes.StoreKVPair{StoreKey: c.key.Name(), Key: key, Delete: true}
+}
+
+func (c *testChain) feePair(height int64, asset common.Asset, fee uint64) *storetypes.StoreKVPair {
+	value := types.ProtoUint64{Value: fee}
+	bz, err := value.Marshal()
+	require.NoError(c.t, err)
+	key := []byte(fmt.Sprintf("pool_liquidity_fee//%d-%s", height, strings.ToUpper(asset.String())))
+	return &storetypes.StoreKVPair{StoreKey: c.key.Name(), Key: key, Value: bz}
+}
+
+func (c *testChain) commit(height int64, changeSet ...*storetypes.StoreKVPair) {
+	err := c.indexer.ListenCommit(c.ctx.WithBlockHeight(height), abci.ResponseCommit{}, changeSet)
+	require.NoError(c.t, err)
+}
+
+func requirePosition(t *testing.T, pos Position, height int64, units, switchRedeem, assetRedeem, fees uint64) {
+	t.Helper()
+	require.Equal(t, height, pos.Height)
+	require.Equal(t, cosmos.NewUint(units).String(), pos.Units.String(), "units at %d", height)
+	require.Equal(t, cosmos.NewUint(switchRedeem).String(), pos.SwitchRedeemValue.String(), "switch redeem at %d", height)
+	require.Equal(t, cosmos.NewUint(assetRedeem).String(), pos.AssetRedeemValue.String(), "asset redeem at %d", height)
+	require.Equal(t, cosmos.NewUint(fees).String(), pos.AccruedFees.String(), "accrued fees at %d", height)
+}
+
+func TestLiquidityProviderHistory(t *testing.T) {
+	c := newTestChain(t)
+	asset := common.BTCAsset
+
+	// the first block seeds the history from the store
+	c.poolPair(testPool(asset, 1000, 1000, 100))
+	c.lpPair(testLP(asset, testAddrA, 100, 1000, 1000))
+	c.commit(10)
+
+	// the pool earns fees while A owns all of it
+	c.commit(11,
+		c.poolPair(testPool(asset, 1000, 1100, 100)),
+		c.feePair(11, asset, 50),
+	)
+
+	// B joins and owns half of the pool
+	c.commit(12,
+		c.poolPair(testPool(asset, 2000, 2200, 200)),
+		c.lpPair(testLP(asset, testAddrB, 100, 1000, 1100)),
+	)
+
+	// a block that does not touch the pool
+	c.commit(13)
+
+	c.commit(14,
+		c.poolPair(testPool(asset, 2000, 2400, 200)),
+		c.feePair(14, asset, 40),
+	)
+
+	// A withdraws everything
+	c.commit(15,
+		c.poolPair(testPool(asset, 1000, 1200, 100)),
+		c.removeLPPair(asset, testAddrA),
+	)
+	c.commit(16)
+
+	last, err := c.indexer.LastHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(16), last)
+
+	positions, next, err := c.indexer.History(asset, common.Address(testAddrA), 0, 0, 100)
+	require.NoError(t, err)
+	require.Zero(t, next)
+	require.Len(t, positions, 5)
+	requirePosition(t, positions[0], 10, 100, 1000, 1000, 0)
+	requirePosition(t, positions[1], 11, 100, 1100, 1000, 50)
+	requirePosition(t, positions[2], 12, 100, 1100, 1000, 50)
+	requirePosition(t, positions[3], 14, 100, 1200, 1000, 70)
+	requirePosition(t, positions[4], 15, 0, 0, 0, 70)
+
+	// the range starts from the state at the from height
+	positions, _, err = c.indexer.History(asset, common.Address(testAddrB), 13, 16, 100)
+	require.NoError(t, err)
+	require.Len(t, positions, 4)
+	requirePosition(t, positions[0], 13, 100, 1100, 1000, 0)
+	requirePosition(t, positions[1], 14, 100, 1200, 1000, 20)
+	requirePosition(t, positions[2], 15, 100, 1200, 1000, 20)
+	requirePosition(t, positions[3], 16, 100, 1200, 1000, 20)
+
+	// the range continues from the returned height
+	positions, next, err = c.indexer.History(asset, common.Address(testAddrA), 0, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	require.Equal(t, int64(12), next)
+	positions, next, err = c.indexer.History(asset, common.Address(testAddrA), next, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	requirePosition(t, positions[0], 12, 100, 1100, 1000, 50)
+	requirePosition(t, positions[1], 14, 100, 1200, 1000, 70)
+	require.Equal(t, int64(15), next)
+
+	positions, _, err = c.indexer.History(asset, common.Address("tswitch1unknown"), 0, 0, 100)
+	require.NoError(t, err)
+	require.Empty(t, positions)
+
+	_, _, err = c.indexer.History(asset, common.Address(testAddrA), 15, 12, 100)
+	require.Error(t, err)
+}
+
+func TestSaverHistory(t *testing.T) {
+	c := newTestChain(t)
+	asset := common.BTCAsset.GetSyntheticAsset()
+
+	c.poolPair(testPool(asset, 1000, 0, 1000))
+	c.lpPair(testLP(asset, testAddrA, 500, 500, 0))
+	c.commit(1)
+
+	// the savers pool grows with the yield
+	c.commit(2, c.poolPair(testPool(asset, 1100, 0, 1000)))
+
+	positions, _, err := c.indexer.History(asset, common.Address(testAddrA), 1, 2, 100)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	requirePosition(t, positions[0], 1, 500, 0, 500, 0)
+	requirePosition(t, positions[1], 2, 500, 0, 550, 0)
+	require.Equal(t, "1000", positions[1].PoolUnits.String())
+}
+
+func TestPruneHistory(t *testing.T) {
+	c := newTestChain(t)
+	asset := common.BTCAsset
+
+	c.poolPair(testPool(asset, 1000, 1000, 100))
+	c.lpPair(testLP(asset, testAddrA, 100, 1000, 1000))
+	c.lpPair(testLP(asset, testAddrB, 100, 1000, 1000))
+	c.commit(1)
+	for height := int64(2); height <= 10; height++ {
+		c.commit(height,
+			c.poolPair(testPool(asset, 1000, 1000+uint64(height)*10, 100)),
+			c.feePair(height, asset, 10),
+		)
+	}
+	c.commit(11, c.removeLPPair(asset, testAddrB))
+	c.commit(12, c.poolPair(testPool(asset, 1000, 2000, 100)))
+
+	before, _, err := c.indexer.History(asset, common.Address(testAddrA), 8, 12, 100)
+	require.NoError(t, err)
+
+	require.NoError(t, c.indexer.prune(8))
+
+	// the state at the start of the retained window is kept
+	after, _, err := c.indexer.History(asset, common.Address(testAddrA), 8, 12, 100)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+
+	// older snapshots are gone
+	positions, _, err := c.indexer.History(asset, common.Address(testAddrA), 0, 12, 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), positions[1].Height)
+	poolPoints, more, err := loadPoints[PoolPoint](c.indexer.db, poolSeries(asset.String()), 0, 12, 100)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Len(t, poolPoints, 5)
+	require.Equal(t, int64(7), poolPoints[0].height)
+
+	// positions removed before the window are dropped
+	positions, _, err = c.indexer.History(asset, common.Address(testAddrB), 0, 12, 100)
+	require.NoError(t, err)
+	require.NotEmpty(t, positions)
+	require.NoError(t, c.indexer.prune(12))
+	positions, _, err = c.indexer.History(asset, common.Address(testAddrB), 0, 12, 100)
+	require.NoError(t, err)
+	require.Empty(t, positions)
+}
+
+func TestSkippedHeights(t *testing.T) {
+	c := newTestChain(t)
+	asset := common.BTCAsset
+
+	c.poolPair(testPool(asset, 1000, 1000, 100))
+	c.lpPair(testLP(asset, testAddrA, 50, 500, 500))
+	c.lpPair(testLP(asset, testAddrB, 50, 500, 500))
+	c.commit(10)
+	c.commit(11,
+		c.poolPair(testPool(asset, 1000, 1100, 100)),
+		c.feePair(11, asset, 100),
+	)
+
+	// blocks 12 to 14 are committed while the indexer is not listening
+	c.poolPair(testPool(asset, 2000, 2200, 150))
+	c.lpPair(testLP(asset, testAddrA, 100, 1000, 1000))
+	c.removeLPPair(asset, testAddrB)
+	c.commit(15)
+
+	// ranges overlapping the skipped heights are reported
+	_, _, err := c.indexer.History(asset, common.Address(testAddrA), 0, 0, 100)
+	require.ErrorContains(t, err, "heights 12 to 14 were not indexed")
+	_, _, err = c.indexer.History(asset, common.Address(testAddrA), 13, 15, 100)
+	require.Error(t, err)
+
+	// the history before the gap is kept
+	positions, _, err := c.indexer.History(asset, common.Address(testAddrA), 10, 11, 100)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	requirePosition(t, positions[1], 11, 50, 550, 500, 50)
+
+	// and is seeded again from the store after it, carrying on the accrued fees
+	c.commit(16,
+		c.poolPair(testPool(asset, 2000, 2300, 150)),
+		c.feePair(16, asset, 30),
+	)
+	positions, _, err = c.indexer.History(asset, common.Address(testAddrA), 15, 16, 100)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	requirePosition(t, positions[0], 15, 100, 1466, 1333, 50)
+	requirePosition(t, positions[1], 16, 100, 1533, 1333, 70)
+
+	// positions removed while skipped are recorded as removed
+	positions, _, err = c.indexer.History(asset, common.Address(testAddrB), 15, 16, 100)
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	requirePosition(t, positions[0], 15, 0, 0, 0, 50)
+
+	// the gap is pruned with the history before the retained window
+	require.NoError(t, c.indexer.prune(15))
+	_, _, err = c.indexer.History(asset, common.Address(testAddrA), 0, 0, 100)
+	require.NoError(t, err)
+}
@@ -0,0 +1,153 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// Position is the state of a liquidity provider or saver position at a height
+type Position struct {
+	Height int64
+	Units  cosmos.Uint
+	// PoolUnits are the units the position is redeemed against, the pool units of a
+	// liquidity pool and the LP units of a savers pool
+	PoolUnits          cosmos.Uint
+	SwitchRedeemValue  cosmos.Uint
+	AssetRedeemValue   cosmos.Uint
+	SwitchDepositValue cosmos.Uint
+	AssetDepositValue  cosmos.Uint
+	// AccruedFees are the SWITCH liquidity fees earned by the position, savers earn their
+	// yield in the pool asset so it is always zero for them
+	AccruedFees cosmos.Uint
+}
+
+// History returns the position of the address in the pool between the from and to heights
+// (inclusive), at from, at to and at every height in between the position or the pool
+// changed. A to height of zero is the last indexed height. When there are more than limit
+// positions in the range, the height to continue from is returned as well. A range
+// overlapping heights that were skipped by the indexer returns an error.
+func (i *Indexer) History(asset common.Asset, address common.Address, from, to int64, limit int) ([]Position, int64, error) {
+	if limit <= 0 {
+		return nil, 0, errors.New("limit must be positive")
+	}
+	if from < 0 || to < 0 || (to > 0 && from > to) {
+		return nil, 0, fmt.Errorf("invalid height range %d to %d", from, to)
+	}
+	last, err := i.LastHeight()
+	if err != nil {
+		return nil, 0, err
+	}
+	if to == 0 || to > last {
+		to = last
+	}
+	if from > to {
+		// nothing recorded in the range yet
+		return nil, 0, nil
+	}
+	start, end, skipped, err := i.gap(from, to)
+	if err != nil {
+		return nil, 0, err
+	}
+	if skipped {
+		return nil, 0, fmt.Errorf("heights %d to %d were not indexed, query a range before or after them", start, end)
+	}
+
+	assetKey := strings.ToUpper(asset.String())
+	addrKey := strings.ToUpper(address.String())
+	lps, moreLPs, err := loadPoints[PositionPoint](i.db, positionSeries(assetKey, addrKey), from, to, limit+1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(lps) == 0 {
+		return nil, 0, nil
+	}
+	pools, morePools, err := loadPoints[PoolPoint](i.db, poolSeries(assetKey), from, to, limit+1)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the state is only known up to the last snapshot loaded of a series with more snapshots
+	bound := to
+	if moreLPs {
+		bound = min(bound, lps[len(lps)-1].height)
+	}
+	if morePools {
+		bound = min(bound, pools[len(pools)-1].height)
+	}
+
+	heights := []int64{from, to}
+	for _, p := range lps {
+		heights = append(heights, p.height)
+	}
+	for _, p := range pools {
+		heights = append(heights, p.height)
+	}
+	sort.Slice(heights, func(a, b int) bool { return heights[a] < heights[b] })
+
+	var positions []Position
+	lpIdx, poolIdx := -1, -1
+	for n, height := range heights {
+		if height < from || (n > 0 && height == heights[n-1]) {
+			continue
+		}
+		if bound < to && height >= bound {
+			return positions, height, nil
+		}
+		for lpIdx+1 < len(lps) && lps[lpIdx+1].height <= height {
+			lpIdx++
+		}
+		for poolIdx+1 < len(pools) && pools[poolIdx+1].height <= height {
+			poolIdx++
+		}
+		if lpIdx < 0 {
+			continue
+		}
+		lp := lps[lpIdx]
+		// a removed position is reported once, at the height it was removed
+		if lp.value.Removed && lp.height != height {
+			continue
+		}
+		if len(positions) == limit {
+			return positions, height, nil
+		}
+		pool := newPoolPoint()
+		if poolIdx >= 0 {
+			pool = pools[poolIdx].value
+		}
+		positions = append(positions, newPosition(height, asset.IsSyntheticAsset(), lp.value, pool))
+	}
+
+	return positions, 0, nil
+}
+
+func newPosition(height int64, saver bool, lp PositionPoint, pool PoolPoint) Position {
+	pos := Position{
+		Height:             height,
+		Units:              lp.Units,
+		SwitchRedeemValue:  cosmos.ZeroUint(),
+		AssetRedeemValue:   cosmos.ZeroUint(),
+		SwitchDepositValue: lp.SwitchDepositValue,
+		AssetDepositValue:  lp.AssetDepositValue,
+		AccruedFees:        cosmos.ZeroUint(),
+	}
+	if saver {
+		pos.PoolUnits = pool.LPUnits
+		if !pos.PoolUnits.IsZero() {
+			pos.AssetRedeemValue = lp.Units.Mul(pool.BalanceAsset).Quo(pos.PoolUnits)
+		}
+		return pos
+	}
+
+	pos.PoolUnits = pool.poolUnits()
+	if !pos.PoolUnits.IsZero() {
+		pos.SwitchRedeemValue = lp.Units.Mul(pool.BalanceSwitch).Quo(pos.PoolUnits)
+		pos.AssetRedeemValue = lp.Units.Mul(pool.BalanceAsset).Quo(pos.PoolUnits)
+	}
+	pos.AccruedFees = lp.accruedFees(pool.FeeIndex)
+	return pos
+}
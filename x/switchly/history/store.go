@@ -0,0 +1,122 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// maxHeight is used to look up the latest snapshot of a series
+const maxHeight = int64(math.MaxInt64 - 1)
+
+// Snapshots are stored as json, keyed by the series prefix followed by the big endian
+// height, so the snapshots of a series iterate in height order:
+//
+//	p/<asset>/<height>           pool snapshot
+//	l/<asset>/<address>/<height> liquidity provider snapshot
+//
+// Skipped heights are keyed by the first height of the gap, the value is the last one:
+//
+//	g/<height>                   skipped heights
+
+func poolSeries(asset string) []byte {
+	return []byte(prefixPool + asset + "/")
+}
+
+func positionSeries(asset, addr string) []byte {
+	return []byte(prefixPosition + asset + "/" + addr + "/")
+}
+
+func gapKey(height int64) []byte {
+	return seriesKey([]byte(prefixGap), height)
+}
+
+func poolKey(asset string, height int64) []byte {
+	return seriesKey(poolSeries(asset), height)
+}
+
+func positionKey(asset, addr string, height int64) []byte {
+	return seriesKey(positionSeries(asset, addr), height)
+}
+
+func seriesKey(series []byte, height int64) []byte {
+	key := make([]byte, len(series)+8)
+	copy(key, series)
+	binary.BigEndian.PutUint64(key[len(series):], uint64(height))
+	return key
+}
+
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+func setPoint(batch dbm.Batch, key []byte, point any) error {
+	bz, err := json.Marshal(point)
+	if err != nil {
+		return fmt.Errorf("fail to marshal snapshot: %w", err)
+	}
+	return batch.Set(key, bz)
+}
+
+// point is a snapshot of a series at a height
+type point[T any] struct {
+	height int64
+	value  T
+}
+
+// lastPoint decodes the last snapshot of the series at or before height into value and
+// returns the height of the snapshot
+func lastPoint[T any](db dbm.DB, series []byte, height int64, value *T) (int64, bool, error) {
+	iter, err := db.ReverseIterator(seriesKey(series, 0), seriesKey(series, height+1))
+	if err != nil {
+		return 0, false, fmt.Errorf("fail to iterate position history: %w", err)
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false, iter.Error()
+	}
+	if err = json.Unmarshal(iter.Value(), value); err != nil {
+		return 0, false, fmt.Errorf("fail to unmarshal snapshot %x: %w", iter.Key(), err)
+	}
+	key := iter.Key()
+	return int64(binary.BigEndian.Uint64(key[len(key)-8:])), true, nil
+}
+
+// loadPoints returns the last snapshot of the series at or before from, followed by at most
+// limit snapshots after from and up to to. It also returns whether there are more snapshots
+// up to to that were not loaded.
+func loadPoints[T any](db dbm.DB, series []byte, from, to int64, limit int) ([]point[T], bool, error) {
+	var points []point[T]
+	var first T
+	height, found, err := lastPoint(db, series, from, &first)
+	if err != nil {
+		return nil, false, err
+	}
+	if found {
+		points = append(points, point[T]{height: height, value: first})
+	}
+
+	iter, err := db.Iterator(seriesKey(series, from+1), seriesKey(series, to+1))
+	if err != nil {
+		return nil, false, fmt.Errorf("fail to iterate position history: %w", err)
+	}
+	defer iter.Close()
+	for n := 0; iter.Valid() && n < limit; iter.Next() {
+		n++
+		var value T
+		if err = json.Unmarshal(iter.Value(), &value); err != nil {
+			return nil, false, fmt.Errorf("fail to unmarshal snapshot %x: %w", iter.Key(), err)
+		}
+		key := iter.Key()
+		points = append(points, point[T]{
+			height: int64(binary.BigEndian.Uint64(key[len(key)-8:])),
+			value:  value,
+		})
+	}
+	return points, iter.Valid(), iter.Error()
+}
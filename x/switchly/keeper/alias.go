@@ -42,6 +42,10 @@ type (
 	StreamingSwap            = types.StreamingSwap
 	ObservedTxVoter          = types.ObservedTxVoter
 	BanVoter                 = types.BanVoter
+	EdDSABackfillVoter       = types.EdDSABackfillVoter
+	DexAggregator            = types.DexAggregator
+	DexAggregatorVoter       = types.DexAggregatorVoter
+	VaultMembershipEpoch     = types.VaultMembershipEpoch
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
@@ -16,6 +16,7 @@ import (
 type Keeper interface {
 	Cdc() codec.BinaryCodec
 	DeleteKey(ctx cosmos.Context, key string)
+	GetRangeIterator(ctx cosmos.Context, start, end []byte) cosmos.Iterator
 	GetVersion() semver.Version
 	GetVersionWithCtx(ctx cosmos.Context) (semver.Version, bool)
 	SetVersionWithCtx(ctx cosmos.Context, v semver.Version)
@@ -68,6 +69,7 @@ type Keeper interface {
 	KeeperOutboundFees
 	KeeperSwapSlip
 	KeeperVault
+	KeeperVaultMembershipEpoch
 	KeeperReserveContributors
 	KeeperNetwork
 	KeeperTss
@@ -76,12 +78,14 @@ type Keeper interface {
 	KeeperRagnarok
 	KeeperErrataTx
 	KeeperBanVoter
+	KeeperEdDSABackfillVoter
 	KeeperSwapQueue
 	KeeperAdvSwapQueues
 	KeeperMimir
 	KeeperNetworkFee
 	KeeperObservedNetworkFeeVoter
 	KeeperChainContract
+	KeeperDexAggregator
 	KeeperSolvencyVoter
 	KeeperSWITCHName
 	KeeperHalt
@@ -300,6 +304,14 @@ type KeeperVault interface {
 	GetPendingOutbounds(_ cosmos.Context, _ common.Asset) []TxOutItem
 	DeleteVault(ctx cosmos.Context, pk common.PubKey) error
 	RemoveFromAsgardIndex(ctx cosmos.Context, pubkey common.PubKey) error
+	GetVaultSigners(_ cosmos.Context, _ common.Chain, _ common.PubKey) (int64, error)
+	SetVaultSigners(_ cosmos.Context, _ common.Chain, _ common.PubKey, _ int64)
+}
+
+type KeeperVaultMembershipEpoch interface {
+	SetVaultMembershipEpoch(_ cosmos.Context, _ VaultMembershipEpoch)
+	GetVaultMembershipEpoch(_ cosmos.Context, _ common.PubKey, _ int64) (VaultMembershipEpoch, error)
+	GetVaultMembershipEpochIterator(_ cosmos.Context, _ common.PubKey) cosmos.Iterator
 }
 
 type KeeperReserveContributors interface {
@@ -324,6 +336,8 @@ type KeeperTss interface {
 	SetTssKeysignMetric(_ cosmos.Context, metric *TssKeysignMetric)
 	GetTssKeysignMetric(_ cosmos.Context, txID common.TxID) (*TssKeysignMetric, error)
 	GetLatestTssKeysignMetric(_ cosmos.Context) (*TssKeysignMetric, error)
+	GetEdDSAKeygenRetries(_ cosmos.Context, pubkey common.PubKey) (int64, error)
+	SetEdDSAKeygenRetries(_ cosmos.Context, pubkey common.PubKey, retries int64)
 }
 
 type KeeperTssKeysignFail interface {
@@ -344,6 +358,12 @@ type KeeperBanVoter interface {
 	GetBanVoterIterator(_ cosmos.Context) cosmos.Iterator
 }
 
+type KeeperEdDSABackfillVoter interface {
+	SetEdDSABackfillVoter(_ cosmos.Context, _ EdDSABackfillVoter)
+	GetEdDSABackfillVoter(_ cosmos.Context, _ common.PubKey) (EdDSABackfillVoter, error)
+	GetEdDSABackfillVoterIterator(_ cosmos.Context) cosmos.Iterator
+}
+
 type KeeperRagnarok interface {
 	RagnarokInProgress(_ cosmos.Context) bool
 	GetRagnarokBlockHeight(_ cosmos.Context) (int64, error)
@@ -386,6 +406,7 @@ type KeeperAdvSwapQueues interface {
 	GetAdvSwapQueueIndex(_ cosmos.Context, _ MsgSwap) (common.TxIDs, error)
 	HasAdvSwapQueueIndex(_ cosmos.Context, _ MsgSwap) (bool, error)
 	RemoveAdvSwapQueueIndex(_ cosmos.Context, _ MsgSwap) error
+	GetAdvSwapQueueAddressIndexIterator(_ cosmos.Context, _ common.Address) cosmos.Iterator
 	SetAdvSwapQueueProcessor(_ cosmos.Context, _ []bool) error
 	GetAdvSwapQueueProcessor(_ cosmos.Context) ([]bool, error)
 }
@@ -425,6 +446,16 @@ type KeeperChainContract interface {
 	GetChainContractIterator(ctx cosmos.Context) cosmos.Iterator
 }
 
+type KeeperDexAggregator interface {
+	SetDexAggregator(ctx cosmos.Context, agg DexAggregator)
+	GetDexAggregator(ctx cosmos.Context, chain common.Chain, address string) (DexAggregator, error)
+	RemoveDexAggregator(ctx cosmos.Context, agg DexAggregator)
+	GetDexAggregatorIterator(ctx cosmos.Context) cosmos.Iterator
+	GetDexAggregators(ctx cosmos.Context) ([]DexAggregator, error)
+	SetDexAggregatorVoter(ctx cosmos.Context, voter DexAggregatorVoter)
+	GetDexAggregatorVoter(ctx cosmos.Context, voter DexAggregatorVoter) (DexAggregatorVoter, error)
+}
+
 type KeeperSolvencyVoter interface {
 	SetSolvencyVoter(_ cosmos.Context, _ SolvencyVoter)
 	GetSolvencyVoter(_ cosmos.Context, _ common.TxID, _ common.Chain) (SolvencyVoter, error)
@@ -475,6 +506,7 @@ type KeeperSWCYStaker interface {
 	GetSWCYStaker(ctx cosmos.Context, address common.Address) (SWCYStaker, error)
 	DeleteSWCYStaker(ctx cosmos.Context, address common.Address)
 	ListSWCYStakers(ctx cosmos.Context) ([]SWCYStaker, error)
+	GetSWCYStakerIterator(ctx cosmos.Context) cosmos.Iterator
 	SWCYStakerExists(ctx cosmos.Context, address common.Address) bool
 	UpdateSWCYStaker(ctx cosmos.Context, address common.Address, amount math.Uint) error
 }
@@ -26,10 +26,11 @@ var kaboom = errors.New("Kaboom!!!")
 
 type KVStoreDummy struct{}
 
-func (k KVStoreDummy) Cdc() codec.BinaryCodec                  { return testutil.MakeTestEncodingConfig().Codec }
-func (k KVStoreDummy) DeleteKey(_ cosmos.Context, _ string)    {}
-func (k KVStoreDummy) CoinKeeper() bankkeeper.Keeper           { return bankkeeper.BaseKeeper{} }
-func (k KVStoreDummy) AccountKeeper() authkeeper.AccountKeeper { return authkeeper.AccountKeeper{} }
+func (k KVStoreDummy) Cdc() codec.BinaryCodec                                         { return testutil.MakeTestEncodingConfig().Codec }
+func (k KVStoreDummy) DeleteKey(_ cosmos.Context, _ string)                           {}
+func (k KVStoreDummy) GetRangeIterator(_ cosmos.Context, _, _ []byte) cosmos.Iterator { return nil }
+func (k KVStoreDummy) CoinKeeper() bankkeeper.Keeper                                  { return bankkeeper.BaseKeeper{} }
+func (k KVStoreDummy) AccountKeeper() authkeeper.AccountKeeper                        { return authkeeper.AccountKeeper{} }
 func (k KVStoreDummy) Logger(ctx cosmos.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
 }
@@ -396,6 +397,11 @@ func (k KVStoreDummy) GetTssVoter(_ cosmos.Context, _ string) (TssVoter, error)
 	return TssVoter{}, kaboom
 }
 
+func (k KVStoreDummy) GetEdDSAKeygenRetries(_ cosmos.Context, _ common.PubKey) (int64, error) {
+	return 0, kaboom
+}
+func (k KVStoreDummy) SetEdDSAKeygenRetries(_ cosmos.Context, _ common.PubKey, _ int64) {}
+
 func (k KVStoreDummy) GetKeygenBlock(_ cosmos.Context, _ int64) (KeygenBlock, error) {
 	return KeygenBlock{}, kaboom
 }
@@ -491,6 +497,11 @@ func (k KVStoreDummy) RemoveFromAsgardIndex(ctx cosmos.Context, pubkey common.Pu
 	return kaboom
 }
 
+func (k KVStoreDummy) GetVaultSigners(_ cosmos.Context, _ common.Chain, _ common.PubKey) (int64, error) {
+	return 0, kaboom
+}
+func (k KVStoreDummy) SetVaultSigners(_ cosmos.Context, _ common.Chain, _ common.PubKey, _ int64) {}
+
 func (k KVStoreDummy) GetLeastSecure(_ cosmos.Context, _ Vaults, _ int64) Vault      { return Vault{} }
 func (k KVStoreDummy) GetMostSecure(_ cosmos.Context, _ Vaults, _ int64) Vault       { return Vault{} }
 func (k KVStoreDummy) GetMostSecureStrict(_ cosmos.Context, _ Vaults, _ int64) Vault { return Vault{} }
@@ -544,6 +555,24 @@ func (k KVStoreDummy) GetBanVoter(_ cosmos.Context, _ cosmos.AccAddress) (BanVot
 func (k KVStoreDummy) GetBanVoterIterator(ctx cosmos.Context) cosmos.Iterator {
 	return nil
 }
+
+func (k KVStoreDummy) SetEdDSABackfillVoter(_ cosmos.Context, _ EdDSABackfillVoter) {}
+func (k KVStoreDummy) GetEdDSABackfillVoter(_ cosmos.Context, _ common.PubKey) (EdDSABackfillVoter, error) {
+	return EdDSABackfillVoter{}, kaboom
+}
+
+func (k KVStoreDummy) GetEdDSABackfillVoterIterator(ctx cosmos.Context) cosmos.Iterator {
+	return nil
+}
+
+func (k KVStoreDummy) SetVaultMembershipEpoch(_ cosmos.Context, _ VaultMembershipEpoch) {}
+func (k KVStoreDummy) GetVaultMembershipEpoch(_ cosmos.Context, _ common.PubKey, _ int64) (VaultMembershipEpoch, error) {
+	return VaultMembershipEpoch{}, kaboom
+}
+
+func (k KVStoreDummy) GetVaultMembershipEpochIterator(_ cosmos.Context, _ common.PubKey) cosmos.Iterator {
+	return nil
+}
 func (k KVStoreDummy) SetSwapQueueItem(ctx cosmos.Context, msg MsgSwap, i int) error { return kaboom }
 func (k KVStoreDummy) GetSwapQueueIterator(ctx cosmos.Context) cosmos.Iterator       { return nil }
 func (k KVStoreDummy) RemoveSwapQueueItem(ctx cosmos.Context, _ common.TxID, _ int)  {}
@@ -590,6 +619,10 @@ func (k KVStoreDummy) RemoveAdvSwapQueueIndex(_ cosmos.Context, _ MsgSwap) error
 	return kaboom
 }
 
+func (k KVStoreDummy) GetAdvSwapQueueAddressIndexIterator(_ cosmos.Context, _ common.Address) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) SetAdvSwapQueueProcessor(ctx cosmos.Context, record []bool) error {
 	return kaboom
 }
@@ -645,6 +678,10 @@ func (k KVStoreDummy) ListSWCYStakers(ctx cosmos.Context) ([]SWCYStaker, error)
 	return nil, nil
 }
 
+func (k KVStoreDummy) GetSWCYStakerIterator(ctx cosmos.Context) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) SWCYStakerExists(ctx cosmos.Context, address common.Address) bool {
 	return false
 }
@@ -743,6 +780,23 @@ func (k KVStoreDummy) GetChainContractIterator(ctx cosmos.Context) cosmos.Iterat
 func (k KVStoreDummy) GetChainContracts(ctx cosmos.Context, chains common.Chains) []ChainContract {
 	return nil
 }
+
+func (k KVStoreDummy) SetDexAggregator(ctx cosmos.Context, agg DexAggregator) {}
+func (k KVStoreDummy) GetDexAggregator(ctx cosmos.Context, chain common.Chain, address string) (DexAggregator, error) {
+	return DexAggregator{}, kaboom
+}
+func (k KVStoreDummy) RemoveDexAggregator(ctx cosmos.Context, agg DexAggregator) {}
+func (k KVStoreDummy) GetDexAggregatorIterator(ctx cosmos.Context) cosmos.Iterator {
+	return nil
+}
+
+func (k KVStoreDummy) GetDexAggregators(ctx cosmos.Context) ([]DexAggregator, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) SetDexAggregatorVoter(ctx cosmos.Context, voter DexAggregatorVoter) {}
+func (k KVStoreDummy) GetDexAggregatorVoter(ctx cosmos.Context, voter DexAggregatorVoter) (DexAggregatorVoter, error) {
+	return DexAggregatorVoter{}, kaboom
+}
 func (k KVStoreDummy) SetSolvencyVoter(_ cosmos.Context, _ SolvencyVoter) {}
 func (k KVStoreDummy) GetSolvencyVoter(_ cosmos.Context, _ common.TxID, _ common.Chain) (SolvencyVoter, error) {
 	return SolvencyVoter{}, kaboom
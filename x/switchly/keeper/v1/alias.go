@@ -56,6 +56,9 @@ var (
 	NewObservedTx              = common.NewObservedTx
 	NewTssVoter                = types.NewTssVoter
 	NewBanVoter                = types.NewBanVoter
+	NewEdDSABackfillVoter      = types.NewEdDSABackfillVoter
+	NewDexAggregatorVoter      = types.NewDexAggregatorVoter
+	NewVaultMembershipEpoch    = types.NewVaultMembershipEpoch
 	NewErrataTxVoter           = types.NewErrataTxVoter
 	NewObservedTxVoter         = types.NewObservedTxVoter
 	NewKeygen                  = types.NewKeygen
@@ -110,6 +113,10 @@ type (
 	ObservedTxs              = common.ObservedTxs
 	ObservedTxVoter          = types.ObservedTxVoter
 	BanVoter                 = types.BanVoter
+	EdDSABackfillVoter       = types.EdDSABackfillVoter
+	DexAggregator            = types.DexAggregator
+	DexAggregatorVoter       = types.DexAggregatorVoter
+	VaultMembershipEpoch     = types.VaultMembershipEpoch
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
@@ -66,6 +66,9 @@ const (
 	prefixRagnarokPoolHeight        types.DbPrefix = "ragnarokPool/"
 	prefixErrataTx                  types.DbPrefix = "errata/"
 	prefixBanVoter                  types.DbPrefix = "ban/"
+	prefixEdDSABackfillVoter        types.DbPrefix = "eddsa_backfill/"
+	prefixVaultMembershipEpoch      types.DbPrefix = "vault_epoch/"
+	prefixVaultSigners              types.DbPrefix = "vault_signers/"
 	prefixNodeSlashPoints           types.DbPrefix = "slash/"
 	prefixNodeJail                  types.DbPrefix = "jail/"
 	prefixSwapQueueItem             types.DbPrefix = "swapitem/"
@@ -73,6 +76,7 @@ const (
 	prefixAdvSwapQueueLimitIndex    types.DbPrefix = "aqlim/"
 	prefixAdvSwapQueueMarketIndex   types.DbPrefix = "aqmark/"
 	prefixAdvSwapQueueProcessor     types.DbPrefix = "aqproc/"
+	prefixAdvSwapQueueAddressIndex  types.DbPrefix = "aqaddr/"
 	prefixOutboundFeeWithheldSwitch types.DbPrefix = "outbound_fee_withheld_rune/"
 	prefixOutboundFeeSpentSwitch    types.DbPrefix = "outbound_fee_spent_rune/"
 	prefixMimir                     types.DbPrefix = "mimir/"
@@ -82,9 +86,12 @@ const (
 	prefixNetworkFee                types.DbPrefix = "network_fee/"
 	prefixNetworkFeeVoter           types.DbPrefix = "network_fee_voter/"
 	prefixTssKeygenMetric           types.DbPrefix = "tss_keygen_metric/"
+	prefixEdDSAKeygenRetries        types.DbPrefix = "eddsa_keygen_retries/"
 	prefixTssKeysignMetric          types.DbPrefix = "tss_keysign_metric/"
 	prefixTssKeysignMetricLatest    types.DbPrefix = "latest_tss_keysign_metric/"
 	prefixChainContract             types.DbPrefix = "chain_contract/"
+	prefixDexAggregator             types.DbPrefix = "dex_aggregator/"
+	prefixDexAggregatorVoter        types.DbPrefix = "dex_aggregator_voter/"
 	prefixSolvencyVoter             types.DbPrefix = "solvency_voter/"
 	prefixSWITCHName                types.DbPrefix = "switchlyname/"
 	prefixAffiliateCollector        types.DbPrefix = "affcol/"
@@ -157,6 +164,12 @@ func (k KVStore) getIterator(ctx cosmos.Context, prefix types.DbPrefix) cosmos.I
 	return cosmos.KVStorePrefixIterator(store, []byte(prefix))
 }
 
+// GetRangeIterator - get an iterator over the keys from start (inclusive) to end (exclusive)
+func (k KVStore) GetRangeIterator(ctx cosmos.Context, start, end []byte) cosmos.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(start, end)
+}
+
 func (k KVStore) DeleteKey(ctx cosmos.Context, key string) {
 	k.del(ctx, key)
 }
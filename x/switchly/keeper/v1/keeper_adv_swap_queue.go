@@ -43,6 +43,7 @@ func (k KVStore) SetAdvSwapQueueItem(ctx cosmos.Context, msg MsgSwap) error {
 	if err := k.SetAdvSwapQueueIndex(ctx, msg); err != nil {
 		return err
 	}
+	k.setAdvSwapQueueAddressIndex(ctx, msg)
 	k.setMsgSwap(ctx, k.GetKey(prefixAdvSwapQueueItem, msg.Tx.ID.String()), msg)
 	return nil
 }
@@ -76,6 +77,7 @@ func (k KVStore) RemoveAdvSwapQueueItem(ctx cosmos.Context, txID common.TxID) er
 		_ = dbError(ctx, "failed to fetch adv swap queue item", err)
 	} else {
 		err = k.RemoveAdvSwapQueueIndex(ctx, msg)
+		k.del(ctx, k.getAdvSwapQueueAddressIndexKey(msg))
 	}
 	k.del(ctx, k.GetKey(prefixAdvSwapQueueItem, txID.String()))
 	return err
@@ -205,6 +207,34 @@ func (k KVStore) RemoveAdvSwapQueueIndex(ctx cosmos.Context, msg MsgSwap) error
 	return nil
 }
 
+///-------------------------- Adv Swap Queue Address Index --------------------------///
+// The address index keeps one key per resting limit swap under the sender's
+// address, so the limit swaps of an address can be listed without walking the
+// whole queue. The value of each key is the tx id of the limit swap.
+
+// GetAdvSwapQueueAddressIndexIterator iterate the limit swaps of the given address
+func (k KVStore) GetAdvSwapQueueAddressIndexIterator(ctx cosmos.Context, addr common.Address) cosmos.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	prefix := k.GetKey(prefixAdvSwapQueueAddressIndex, fmt.Sprintf("%s/", addr))
+	return cosmos.KVStorePrefixIterator(store, []byte(prefix))
+}
+
+// setAdvSwapQueueAddressIndex - indexes limit swaps by sender, and drops the
+// index of a swap that is no longer a limit swap
+func (k KVStore) setAdvSwapQueueAddressIndex(ctx cosmos.Context, msg MsgSwap) {
+	key := k.getAdvSwapQueueAddressIndexKey(msg)
+	if msg.SwapType != types.SwapType_limit {
+		k.del(ctx, key)
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(key), []byte(msg.Tx.ID.String()))
+}
+
+func (k KVStore) getAdvSwapQueueAddressIndexKey(msg MsgSwap) string {
+	return k.GetKey(prefixAdvSwapQueueAddressIndex, fmt.Sprintf("%s/%s", msg.Tx.FromAddress, msg.Tx.ID))
+}
+
 func (k KVStore) getAdvSwapQueueIndexKey(ctx cosmos.Context, msg MsgSwap) string {
 	switch msg.SwapType {
 	case types.SwapType_limit:
@@ -60,8 +60,19 @@ func (s *KeeperAdvSwapQueueSuite) TestKeeperAdvSwapQueue(c *C) {
 	}
 	iter.Close()
 
+	// limit swaps are indexed by sender
+	iter = k.GetAdvSwapQueueAddressIndexIterator(ctx, msg1.Tx.FromAddress)
+	c.Assert(iter.Valid(), Equals, true)
+	c.Check(string(iter.Value()), Equals, msg1.Tx.ID.String())
+	iter.Next()
+	c.Check(iter.Valid(), Equals, false)
+	iter.Close()
+
 	// test remove
 	c.Assert(k.RemoveAdvSwapQueueItem(ctx, msg1.Tx.ID), IsNil)
+	iter = k.GetAdvSwapQueueAddressIndexIterator(ctx, msg1.Tx.FromAddress)
+	c.Check(iter.Valid(), Equals, false)
+	iter.Close()
 	_, err = k.GetAdvSwapQueueItem(ctx, msg1.Tx.ID)
 	c.Check(err, NotNil)
 	c.Check(k.HasAdvSwapQueueItem(ctx, msg1.Tx.ID), Equals, false)
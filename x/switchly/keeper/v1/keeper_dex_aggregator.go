@@ -0,0 +1,107 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+func (k KVStore) setDexAggregator(ctx cosmos.Context, key string, record DexAggregator) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getDexAggregator(ctx cosmos.Context, key string, record *DexAggregator) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SetDexAggregator - save a dex aggregator
+func (k KVStore) SetDexAggregator(ctx cosmos.Context, agg DexAggregator) {
+	k.setDexAggregator(ctx, k.GetKey(prefixDexAggregator, agg.Key()), agg)
+}
+
+// GetDexAggregator - gets the dex aggregator of the given chain and address, the
+// aggregator is empty when it isn't registered
+func (k KVStore) GetDexAggregator(ctx cosmos.Context, chain common.Chain, address string) (DexAggregator, error) {
+	agg := DexAggregator{Chain: chain, Address: address}
+	var record DexAggregator
+	_, err := k.getDexAggregator(ctx, k.GetKey(prefixDexAggregator, agg.Key()), &record)
+	return record, err
+}
+
+// RemoveDexAggregator - remove the given dex aggregator
+func (k KVStore) RemoveDexAggregator(ctx cosmos.Context, agg DexAggregator) {
+	k.del(ctx, k.GetKey(prefixDexAggregator, agg.Key()))
+}
+
+// GetDexAggregatorIterator - get an iterator for dex aggregators
+func (k KVStore) GetDexAggregatorIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixDexAggregator)
+}
+
+// GetDexAggregators - return all the registered dex aggregators, ordered by chain and
+// address
+func (k KVStore) GetDexAggregators(ctx cosmos.Context) ([]DexAggregator, error) {
+	aggs := make([]DexAggregator, 0)
+	iter := k.GetDexAggregatorIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var agg DexAggregator
+		if err := k.cdc.Unmarshal(iter.Value(), &agg); err != nil {
+			return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", &agg, iter.Key()), err)
+		}
+		aggs = append(aggs, agg)
+	}
+	return aggs, nil
+}
+
+func (k KVStore) setDexAggregatorVoter(ctx cosmos.Context, key string, record DexAggregatorVoter) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getDexAggregatorVoter(ctx cosmos.Context, key string, record *DexAggregatorVoter) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SetDexAggregatorVoter - save a dex aggregator voter object
+func (k KVStore) SetDexAggregatorVoter(ctx cosmos.Context, voter DexAggregatorVoter) {
+	k.setDexAggregatorVoter(ctx, k.GetKey(prefixDexAggregatorVoter, voter.String()), voter)
+}
+
+// GetDexAggregatorVoter - gets the voter of the proposal of the given voter, the given
+// voter is returned when nobody voted for the proposal yet
+func (k KVStore) GetDexAggregatorVoter(ctx cosmos.Context, voter DexAggregatorVoter) (DexAggregatorVoter, error) {
+	record := voter
+	_, err := k.getDexAggregatorVoter(ctx, k.GetKey(prefixDexAggregatorVoter, voter.String()), &record)
+	return record, err
+}
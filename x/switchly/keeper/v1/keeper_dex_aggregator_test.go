@@ -0,0 +1,66 @@
+package keeperv1
+
+import (
+	"strings"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+)
+
+type KeeperDexAggregatorSuite struct{}
+
+var _ = Suite(&KeeperDexAggregatorSuite{})
+
+func (s *KeeperDexAggregatorSuite) TestDexAggregator(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	aggs, err := k.GetDexAggregators(ctx)
+	c.Assert(err, IsNil)
+	c.Check(aggs, HasLen, 0)
+
+	addr := "0x69800327b38A4CeF30367Dec3f64c2f2386f3848"
+	k.SetDexAggregator(ctx, DexAggregator{Chain: common.ETHChain, Address: addr, GasLimit: 400_000, Enabled: true})
+	k.SetDexAggregator(ctx, DexAggregator{Chain: common.AVAXChain, Address: GetRandomETHAddress().String(), GasLimit: 500_000})
+
+	// EVM addresses are looked up case-insensitively
+	agg, err := k.GetDexAggregator(ctx, common.ETHChain, strings.ToLower(addr))
+	c.Assert(err, IsNil)
+	c.Check(agg.Address, Equals, addr)
+	c.Check(agg.GasLimit, Equals, uint64(400_000))
+	c.Check(agg.Enabled, Equals, true)
+
+	agg1, err := k.GetDexAggregator(ctx, common.ETHChain, GetRandomETHAddress().String())
+	c.Assert(err, IsNil)
+	c.Check(agg1.IsEmpty(), Equals, true)
+
+	aggs, err = k.GetDexAggregators(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(aggs, HasLen, 2)
+	c.Check(aggs[0].Chain.Equals(common.AVAXChain), Equals, true)
+	c.Check(aggs[1].Chain.Equals(common.ETHChain), Equals, true)
+
+	k.RemoveDexAggregator(ctx, agg)
+	aggs, err = k.GetDexAggregators(ctx)
+	c.Assert(err, IsNil)
+	c.Check(aggs, HasLen, 1)
+}
+
+func (s *KeeperDexAggregatorSuite) TestDexAggregatorVoter(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	agg := DexAggregator{Chain: common.ETHChain, Address: GetRandomETHAddress().String(), GasLimit: 400_000, Enabled: true}
+	voter := NewDexAggregatorVoter(agg, false)
+	voter.BlockHeight = 10
+	voter.Sign(GetRandomBech32Addr())
+	k.SetDexAggregatorVoter(ctx, voter)
+
+	voter, err := k.GetDexAggregatorVoter(ctx, NewDexAggregatorVoter(agg, false))
+	c.Assert(err, IsNil)
+	c.Check(voter.BlockHeight, Equals, int64(10))
+	c.Check(voter.Signers, HasLen, 1)
+
+	// a different proposal for the same aggregator has its own voter
+	voter1, err := k.GetDexAggregatorVoter(ctx, NewDexAggregatorVoter(agg, true))
+	c.Assert(err, IsNil)
+	c.Check(voter1.Remove, Equals, true)
+	c.Check(voter1.Signers, HasLen, 0)
+}
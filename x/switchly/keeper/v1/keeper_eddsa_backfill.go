@@ -0,0 +1,48 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+func (k KVStore) setEdDSABackfillVoter(ctx cosmos.Context, key string, record EdDSABackfillVoter) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getEdDSABackfillVoter(ctx cosmos.Context, key string, record *EdDSABackfillVoter) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SetEdDSABackfillVoter - save an eddsa backfill voter object
+func (k KVStore) SetEdDSABackfillVoter(ctx cosmos.Context, voter EdDSABackfillVoter) {
+	k.setEdDSABackfillVoter(ctx, k.GetKey(prefixEdDSABackfillVoter, voter.String()), voter)
+}
+
+// GetEdDSABackfillVoter - gets the eddsa backfill voter of the given vault
+func (k KVStore) GetEdDSABackfillVoter(ctx cosmos.Context, pk common.PubKey) (EdDSABackfillVoter, error) {
+	record := NewEdDSABackfillVoter(pk)
+	_, err := k.getEdDSABackfillVoter(ctx, k.GetKey(prefixEdDSABackfillVoter, record.String()), &record)
+	return record, err
+}
+
+// GetEdDSABackfillVoterIterator - get an iterator for eddsa backfill voter
+func (k KVStore) GetEdDSABackfillVoterIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixEdDSABackfillVoter)
+}
@@ -0,0 +1,31 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type KeeperEdDSABackfillSuite struct{}
+
+var _ = Suite(&KeeperEdDSABackfillSuite{})
+
+func (s *KeeperEdDSABackfillSuite) TestEdDSABackfillVoter(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pk := GetRandomPubKey()
+	voter := NewEdDSABackfillVoter(pk)
+	voter.BlockHeight = 10
+	voter.Sign(GetRandomBech32Addr())
+	k.SetEdDSABackfillVoter(ctx, voter)
+	voter, err := k.GetEdDSABackfillVoter(ctx, pk)
+	c.Assert(err, IsNil)
+	c.Check(voter.PoolPubKey.Equals(pk), Equals, true)
+	c.Check(voter.BlockHeight, Equals, int64(10))
+	c.Check(voter.Signers, HasLen, 1)
+
+	voter1, err := k.GetEdDSABackfillVoter(ctx, GetRandomPubKey())
+	c.Check(err, IsNil)
+	c.Check(voter1.IsEmpty(), Equals, false)
+	c.Check(voter1.Signers, HasLen, 0)
+	iter := k.GetEdDSABackfillVoterIterator(ctx)
+	c.Check(iter, NotNil)
+	iter.Close()
+}
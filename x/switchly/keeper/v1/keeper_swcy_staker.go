@@ -57,8 +57,8 @@ func (k KVStore) SetSWCYStaker(ctx cosmos.Context, record SWCYStaker) error {
 	return nil
 }
 
-// getSWCYStakerIterator iterate SWCY stakers
-func (k KVStore) getSWCYStakerIterator(ctx cosmos.Context) cosmos.Iterator {
+// GetSWCYStakerIterator iterate SWCY stakers
+func (k KVStore) GetSWCYStakerIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixSWCYStaker)
 }
 
@@ -74,7 +74,7 @@ func (k KVStore) DeleteSWCYStaker(ctx cosmos.Context, address common.Address) {
 // ListSWCYStakers gets stakers
 func (k KVStore) ListSWCYStakers(ctx cosmos.Context) ([]SWCYStaker, error) {
 	var stakers []SWCYStaker
-	iterator := k.getSWCYStakerIterator(ctx)
+	iterator := k.GetSWCYStakerIterator(ctx)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var staker SWCYStaker
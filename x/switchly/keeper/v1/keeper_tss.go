@@ -128,3 +128,21 @@ func (k KVStore) GetLatestTssKeysignMetric(ctx cosmos.Context) (*TssKeysignMetri
 	_, err := k.getTssKeysignMetric(ctx, k.GetKey(prefixTssKeysignMetricLatest, "keysign"), &record)
 	return &record, err
 }
+
+// GetEdDSAKeygenRetries returns the number of EdDSA-only keygens retried for the vault
+func (k KVStore) GetEdDSAKeygenRetries(ctx cosmos.Context, pubkey common.PubKey) (int64, error) {
+	record := int64(0)
+	_, err := k.getInt64(ctx, k.GetKey(prefixEdDSAKeygenRetries, pubkey.String()), &record)
+	return record, err
+}
+
+// SetEdDSAKeygenRetries saves the number of EdDSA-only keygens retried for the vault,
+// zero removes the record
+func (k KVStore) SetEdDSAKeygenRetries(ctx cosmos.Context, pubkey common.PubKey, retries int64) {
+	key := k.GetKey(prefixEdDSAKeygenRetries, pubkey.String())
+	if retries == 0 {
+		k.del(ctx, key)
+		return
+	}
+	k.setInt64(ctx, key, retries)
+}
@@ -415,3 +415,22 @@ func (k KVStore) DeleteVault(ctx cosmos.Context, pubkey common.PubKey) error {
 	k.del(ctx, k.GetKey(prefixVault, vault.PubKey.String()))
 	return nil
 }
+
+// GetVaultSigners returns the number of signers that multi-sign the outbounds of the
+// vault's account on the given chain, zero when the vault signs with its own key
+func (k KVStore) GetVaultSigners(ctx cosmos.Context, chain common.Chain, pubkey common.PubKey) (int64, error) {
+	record := int64(0)
+	_, err := k.getInt64(ctx, k.GetKey(prefixVaultSigners, fmt.Sprintf("%s/%s", chain, pubkey)), &record)
+	return record, err
+}
+
+// SetVaultSigners saves the number of signers that multi-sign the outbounds of the
+// vault's account on the given chain, zero removes the record
+func (k KVStore) SetVaultSigners(ctx cosmos.Context, chain common.Chain, pubkey common.PubKey, signers int64) {
+	key := k.GetKey(prefixVaultSigners, fmt.Sprintf("%s/%s", chain, pubkey))
+	if signers == 0 {
+		k.del(ctx, key)
+		return
+	}
+	k.setInt64(ctx, key, signers)
+}
@@ -0,0 +1,50 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper/types"
+)
+
+func (k KVStore) setVaultMembershipEpoch(ctx cosmos.Context, key string, record VaultMembershipEpoch) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getVaultMembershipEpoch(ctx cosmos.Context, key string, record *VaultMembershipEpoch) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SetVaultMembershipEpoch - save the membership a resharing churn handed a vault to
+func (k KVStore) SetVaultMembershipEpoch(ctx cosmos.Context, record VaultMembershipEpoch) {
+	k.setVaultMembershipEpoch(ctx, k.GetKey(prefixVaultMembershipEpoch, record.Key()), record)
+}
+
+// GetVaultMembershipEpoch - gets the membership of the given vault at the given epoch
+func (k KVStore) GetVaultMembershipEpoch(ctx cosmos.Context, pk common.PubKey, epoch int64) (VaultMembershipEpoch, error) {
+	record := VaultMembershipEpoch{PubKey: pk, Epoch: epoch}
+	_, err := k.getVaultMembershipEpoch(ctx, k.GetKey(prefixVaultMembershipEpoch, record.Key()), &record)
+	return record, err
+}
+
+// GetVaultMembershipEpochIterator - get an iterator for the membership epochs of the given vault
+func (k KVStore) GetVaultMembershipEpochIterator(ctx cosmos.Context, pk common.PubKey) cosmos.Iterator {
+	key := k.GetKey(prefixVaultMembershipEpoch, pk.String()+"/")
+	return k.getIterator(ctx, types.DbPrefix(key))
+}
@@ -0,0 +1,39 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type KeeperVaultMembershipEpochSuite struct{}
+
+var _ = Suite(&KeeperVaultMembershipEpochSuite{})
+
+func (s *KeeperVaultMembershipEpochSuite) TestVaultMembershipEpoch(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pk := GetRandomPubKey()
+	membership := []string{GetRandomPubKey().String(), GetRandomPubKey().String()}
+	k.SetVaultMembershipEpoch(ctx, NewVaultMembershipEpoch(pk, 1, 10, membership))
+	k.SetVaultMembershipEpoch(ctx, NewVaultMembershipEpoch(pk, 2, 20, membership[:1]))
+	k.SetVaultMembershipEpoch(ctx, NewVaultMembershipEpoch(GetRandomPubKey(), 1, 30, membership))
+
+	record, err := k.GetVaultMembershipEpoch(ctx, pk, 2)
+	c.Assert(err, IsNil)
+	c.Check(record.PubKey.Equals(pk), Equals, true)
+	c.Check(record.BlockHeight, Equals, int64(20))
+	c.Check(record.Membership, DeepEquals, membership[:1])
+
+	record, err = k.GetVaultMembershipEpoch(ctx, pk, 3)
+	c.Assert(err, IsNil)
+	c.Check(record.Membership, HasLen, 0)
+
+	iter := k.GetVaultMembershipEpochIterator(ctx, pk)
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		var epoch VaultMembershipEpoch
+		c.Assert(k.cdc.Unmarshal(iter.Value(), &epoch), IsNil)
+		c.Check(epoch.PubKey.Equals(pk), Equals, true)
+		count++
+	}
+	c.Check(count, Equals, 2)
+}
@@ -180,3 +180,26 @@ func (s *KeeperVaultSuite) TestGetMostSecureStrict(c *C) {
 	vault = k.GetMostSecureStrict(ctx, vaults, signingPeriod)
 	c.Assert(vault.IsEmpty(), Equals, true)
 }
+
+func (s *KeeperVaultSuite) TestVaultSigners(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pubKey := GetRandomPubKey()
+
+	signers, err := k.GetVaultSigners(ctx, common.XRPChain, pubKey)
+	c.Assert(err, IsNil)
+	c.Check(signers, Equals, int64(0))
+
+	k.SetVaultSigners(ctx, common.XRPChain, pubKey, 1)
+	signers, err = k.GetVaultSigners(ctx, common.XRPChain, pubKey)
+	c.Assert(err, IsNil)
+	c.Check(signers, Equals, int64(1))
+	// the record is per chain
+	signers, err = k.GetVaultSigners(ctx, common.ETHChain, pubKey)
+	c.Assert(err, IsNil)
+	c.Check(signers, Equals, int64(0))
+
+	k.SetVaultSigners(ctx, common.XRPChain, pubKey, 0)
+	signers, err = k.GetVaultSigners(ctx, common.XRPChain, pubKey)
+	c.Assert(err, IsNil)
+	c.Check(signers, Equals, int64(0))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
@@ -157,9 +158,9 @@ func (gm *GasMgrVCUR) GetAssetOutboundFee(ctx cosmos.Context, asset common.Asset
 	minMultiplierBasisPoints := gm.keeper.GetConfigInt64(ctx, constants.MinOutboundFeeMultiplierBasisPoints)
 
 	// Calculate outbound fee based on current fee multiplier
-	chainBaseFee := chainOutboundFee.TransactionSize * chainOutboundFee.TransactionFeeRate
+	chainBaseFee := feemodel.Get(asset.GetChain()).OutboundFee(chainOutboundFee.TransactionSize, chainOutboundFee.TransactionFeeRate)
 	feeMultiplierBps := gm.CalcOutboundFeeMultiplier(ctx, cosmos.NewUint(uint64(targetOutboundFeeSurplus)), outboundFeeSpentSwitch, outboundFeeWithheldSwitch, cosmos.NewUint(uint64(maxMultiplierBasisPoints)), cosmos.NewUint(uint64(minMultiplierBasisPoints)))
-	finalFee := common.GetUncappedShare(feeMultiplierBps, cosmos.NewUint(constants.MaxBasisPts), cosmos.NewUint(chainBaseFee))
+	finalFee := common.GetUncappedShare(feeMultiplierBps, cosmos.NewUint(constants.MaxBasisPts), chainBaseFee)
 
 	fee := cosmos.RoundToDecimal(
 		finalFee,
@@ -270,30 +271,29 @@ func (gm *GasMgrVCUR) GetNetworkFee(ctx cosmos.Context, chain common.Chain) (typ
 
 // GetMaxGas will calculate the maximum gas fee a tx can use
 func (gm *GasMgrVCUR) GetMaxGas(ctx cosmos.Context, chain common.Chain) (common.Coin, error) {
-	gasAsset := chain.GetGasAsset()
-	var amount cosmos.Uint
+	return gm.getMaxGas(ctx, chain, feemodel.Account{})
+}
 
+// GetVaultMaxGas will calculate the maximum gas fee a tx sent from the given vault can use,
+// the outbounds of a vault that multi-signs them may pay for each signature
+func (gm *GasMgrVCUR) GetVaultMaxGas(ctx cosmos.Context, chain common.Chain, vaultPubKey common.PubKey) (common.Coin, error) {
+	acct, err := getFeeAccount(ctx, gm.keeper, chain, vaultPubKey)
+	if err != nil {
+		return common.NoCoin, err
+	}
+	return gm.getMaxGas(ctx, chain, acct)
+}
+
+func (gm *GasMgrVCUR) getMaxGas(ctx cosmos.Context, chain common.Chain, acct feemodel.Account) (common.Coin, error) {
 	nf, err := gm.keeper.GetNetworkFee(ctx, chain)
 	if err != nil {
 		return common.NoCoin, fmt.Errorf("fail to get network fee for chain(%s): %w", chain, err)
 	}
 
-	// Fallback: ensure non-zero gas even if network fee has not been observed yet
-	if nf.TransactionSize == 0 || nf.TransactionFeeRate == 0 {
-		// Chain-specific fallback values in SWITCHLY decimals (8 decimals)
-		switch chain {
-		case common.StellarChain:
-			// Stellar: ~10,000 stroops = 0.001 XLM = 1,000,000 in 8-decimal precision
-			amount = cosmos.NewUint(1_000_000)
-		default:
-			// Generic minimal fallback
-			amount = cosmos.NewUint(1)
-		}
-	} else {
-		amount = cosmos.NewUint(nf.TransactionSize * nf.TransactionFeeRate).MulUint64(3).QuoUint64(2)
-	}
+	// the fee model falls back to a non-zero gas if the network fee has not been observed yet
+	amount := feemodel.Get(chain).MaxGas(nf.TransactionSize, nf.TransactionFeeRate, acct)
 
-	gasCoin := common.NewCoin(gasAsset, amount)
+	gasCoin := common.NewCoin(chain.GetGasAsset(), amount)
 	chainGasAssetPrecision := chain.GetGasAssetDecimal()
 	gasCoin.Amount = cosmos.RoundToDecimal(amount, chainGasAssetPrecision)
 	gasCoin.Decimals = chainGasAssetPrecision
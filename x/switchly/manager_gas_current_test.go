@@ -268,6 +268,21 @@ func (GasManagerTestSuiteVCUR) TestGetMaxGas(c *C) {
 	gasCoin, err = gasMgr.GetMaxGas(ctx, common.ETHChain)
 	c.Assert(err, IsNil)
 	c.Assert(gasCoin.Amount.Uint64(), Equals, uint64(23431))
+
+	// the outbounds of a vault that multi-signs them pay for its signature
+	networkFee = NewNetworkFee(common.XRPChain, 1, 1200)
+	c.Assert(k.SaveNetworkFee(ctx, common.XRPChain, networkFee), IsNil)
+	pubKey := GetRandomPubKey()
+	gasCoin, err = gasMgr.GetVaultMaxGas(ctx, common.XRPChain, pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(gasCoin.Amount.Uint64(), Equals, uint64(1800))
+	k.SetVaultSigners(ctx, common.XRPChain, pubKey, 1)
+	gasCoin, err = gasMgr.GetVaultMaxGas(ctx, common.XRPChain, pubKey)
+	c.Assert(err, IsNil)
+	c.Assert(gasCoin.Amount.Uint64(), Equals, uint64(3600))
+	gasCoin, err = gasMgr.GetMaxGas(ctx, common.XRPChain)
+	c.Assert(err, IsNil)
+	c.Assert(gasCoin.Amount.Uint64(), Equals, uint64(1800))
 }
 
 func (GasManagerTestSuiteVCUR) TestOutboundFeeMultiplier(c *C) {
@@ -41,6 +41,10 @@ func (m *DummyGasManager) GetMaxGas(ctx cosmos.Context, chain common.Chain) (com
 	return common.NoCoin, errKaboom
 }
 
+func (m *DummyGasManager) GetVaultMaxGas(ctx cosmos.Context, chain common.Chain, _ common.PubKey) (common.Coin, error) {
+	return m.GetMaxGas(ctx, chain)
+}
+
 func (m *DummyGasManager) GetGasRate(ctx cosmos.Context, chain common.Chain) cosmos.Uint {
 	return cosmos.OneUint()
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-metrics"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/feemodel"
 	"github.com/switchlyprotocol/switchlynode/v3/common/swcysmartcontract"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
@@ -460,6 +461,10 @@ func (vm *NetworkMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		ctx.Logger().Error("fail to process pool ragnarok", "error", err)
 	}
 
+	if err := settleTimedOutEdDSAKeygens(ctx, mgr); err != nil {
+		ctx.Logger().Error("fail to settle timed out eddsa keygens", "error", err)
+	}
+
 	blocksPerYear := vm.k.GetConfigInt64(ctx, constants.BlocksPerYear)
 	blocksPerDay := blocksPerYear / 365
 	if IsPeriodLastBlock(ctx, blocksPerDay) {
@@ -622,7 +627,10 @@ func (vm *NetworkMgrVCUR) migrateFunds(ctx cosmos.Context, mgr Manager) error {
 				// the migrated funds). No-op for non-ed25519 chains.
 				addr, err := target.PubKeyForChain(coin.Asset.GetChain()).GetAddress(coin.Asset.GetChain())
 				if err != nil {
-					return err
+					// a target vault without an ed25519 key has no Solana address, the coin stays in
+					// the retiring vault rather than blocking the migration of the other coins
+					ctx.Logger().Error("fail to get address of target vault", "chain", coin.Asset.GetChain(), "error", err)
+					continue
 				}
 
 				// get index of target vault in active slice
@@ -676,7 +684,7 @@ func (vm *NetworkMgrVCUR) migrateFunds(ctx cosmos.Context, mgr Manager) error {
 				gasAsset := coin.Asset.GetChain().GetGasAsset()
 				if coin.Asset.Equals(gasAsset) {
 					gasMgr := mgr.GasMgr()
-					gas, err := gasMgr.GetMaxGas(ctx, coin.Asset.GetChain())
+					gas, err := gasMgr.GetVaultMaxGas(ctx, coin.Asset.GetChain(), vault.PubKey)
 					if err != nil {
 						ctx.Logger().Error("fail to get max gas: %w", err)
 						return err
@@ -691,6 +699,11 @@ func (vm *NetworkMgrVCUR) migrateFunds(ctx cosmos.Context, mgr Manager) error {
 
 					// burn the remainder if amount after deducting gas is below dust threshold
 					dustThreshold := coin.Asset.GetChain().DustThreshold()
+					acct, err := getFeeAccount(ctx, vm.k, coin.Asset.GetChain(), vault.PubKey)
+					if err != nil {
+						return err
+					}
+					reserve := feemodel.Get(coin.Asset.GetChain()).Reserve(acct)
 					if amt.LTE(dustThreshold) && nth > migrationRounds {
 						// No migration should be attempted, but only burn dust if there are no pending outbounds.
 						// (That is, truly only dust remaining in the vault for this Coin.)
@@ -698,7 +711,7 @@ func (vm *NetworkMgrVCUR) migrateFunds(ctx cosmos.Context, mgr Manager) error {
 							continue
 						}
 
-						if coin.Asset.GetChain().Equals(common.XRPChain) {
+						if !reserve.IsZero() {
 							ctx.Logger().Info("left coin is account reserve, thus burn it", "coin", coin, "gas", gasAmount)
 						} else {
 							ctx.Logger().Info("left coin is not enough to pay for gas, thus burn it", "coin", coin, "gas", gasAmount)
@@ -732,13 +745,15 @@ func (vm *NetworkMgrVCUR) migrateFunds(ctx cosmos.Context, mgr Manager) error {
 						continue
 					}
 
-					// on the final migration round(s), deduct amt by 1 XRP (1e8) so that we don't try to transfer any of the account reserve
-					// the account reserve balance will be burned on the next migration round
-					if nth >= migrationRounds && coin.Asset.GetChain().Equals(common.XRPChain) {
-						if amt.GT(dustThreshold) {
-							amt = common.SafeSub(amt, dustThreshold)
+					// on the final migration round(s), deduct the account reserve of the chain so that we don't try to
+					// transfer any of it, the account reserve balance will be burned on the next migration round.
+					// On XRP the reserve is the 1 XRP base reserve, plus the 0.2 XRP owner reserve of the signer
+					// list of a vault that multi-signs its outbounds.
+					if nth >= migrationRounds && !reserve.IsZero() {
+						if amt.GT(reserve) {
+							amt = common.SafeSub(amt, reserve)
 						} else {
-							// if amt <= dustThreshold / XRP reserve requirement, skip the transaction
+							// if amt <= account reserve requirement, skip the transaction
 							continue
 						}
 					}
@@ -1178,7 +1193,7 @@ func (vm *NetworkMgrVCUR) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) er
 	for i := range nas {
 		members = append(members, nas[i].PubKeySet.Secp256k1.String())
 	}
-	keygen, err := NewKeygen(ctx.BlockHeight(), members, AsgardKeygen)
+	keygen, err := vm.newChurnKeygen(ctx, members)
 	if err != nil {
 		return fmt.Errorf("fail to create a new keygen: %w", err)
 	}
@@ -1223,6 +1238,98 @@ func (vm *NetworkMgrVCUR) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) er
 	return nil
 }
 
+// newChurnKeygen creates the keygen for a churn to the given members. With the resharing
+// churn enabled the key shares of the single active asgard are handed over to the new
+// members, so the vault keeps its pub key and funds don't need to be migrated. Resharing
+// is ECDSA only, a vault holding an ed25519 key falls back to a full keygen.
+//
+// Members leaving the vault in a reshare keep their old share, and the shares of an
+// epoch still recover the vault key once enough of them are combined. A reshare is
+// therefore only done while the members that left since the last full keygen are fewer
+// than the signing threshold of every membership epoch of the vault, at most
+// ReshareMaxMembersChanged members change per reshare and after ReshareMaxEpochs
+// reshares the churn forces a full keygen, which moves the funds to a new key.
+func (vm *NetworkMgrVCUR) newChurnKeygen(ctx cosmos.Context, members []string) (Keygen, error) {
+	enabled, err := vm.k.GetMimir(ctx, constants.MimirKeyReshareChurnEnabled)
+	if err != nil || enabled <= 0 {
+		return NewKeygen(ctx.BlockHeight(), members, AsgardKeygen)
+	}
+	active, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return Keygen{}, fmt.Errorf("fail to get active vaults: %w", err)
+	}
+	if len(active) != 1 || active[0].HasEd25519PubKey() || len(active[0].Membership) == 0 {
+		ctx.Logger().Info("resharing churn not possible, fall back to keygen", "active vaults", len(active))
+		return NewKeygen(ctx.BlockHeight(), members, AsgardKeygen)
+	}
+	vault := active[0]
+	if reason := vm.reshareBlocker(ctx, vault, members); reason != "" {
+		ctx.Logger().Info("resharing churn not safe, fall back to keygen", "pubkey", vault.PubKey, "reason", reason)
+		return NewKeygen(ctx.BlockHeight(), members, AsgardKeygen)
+	}
+	oldMembers := make([]string, len(vault.Membership))
+	copy(oldMembers, vault.Membership)
+	return NewReshareKeygen(ctx.BlockHeight(), members, oldMembers, vault.PubKey)
+}
+
+// reshareBlocker returns why the vault key can't be reshared to the given members, or
+// an empty string if it can
+func (vm *NetworkMgrVCUR) reshareBlocker(ctx cosmos.Context, vault Vault, members []string) string {
+	maxEpochs := vm.k.GetConfigInt64(ctx, constants.ReshareMaxEpochs)
+	if vault.MembershipEpoch >= maxEpochs {
+		return fmt.Sprintf("vault reached %d membership epochs", vault.MembershipEpoch)
+	}
+
+	next := make(map[string]bool, len(members))
+	for _, member := range members {
+		next[member] = true
+	}
+	current := make(map[string]bool, len(vault.Membership))
+	changed := 0
+	for _, member := range vault.Membership {
+		current[member] = true
+		if !next[member] {
+			changed++
+		}
+	}
+	for _, member := range members {
+		if !current[member] {
+			changed++
+		}
+	}
+	maxChanged := vm.k.GetConfigInt64(ctx, constants.ReshareMaxMembersChanged)
+	if int64(changed) > maxChanged {
+		return fmt.Sprintf("%d members change, more than %d", changed, maxChanged)
+	}
+
+	// every membership the key was shared to since its keygen, the first reshare
+	// records the keygen membership as epoch zero
+	memberships := [][]string{vault.Membership}
+	iter := vm.k.GetVaultMembershipEpochIterator(ctx, vault.PubKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var epoch VaultMembershipEpoch
+		if err := vm.k.Cdc().Unmarshal(iter.Value(), &epoch); err != nil {
+			return fmt.Sprintf("fail to unmarshal membership epoch: %s", err)
+		}
+		memberships = append(memberships, epoch.Membership)
+	}
+	for _, membership := range memberships {
+		departed := 0
+		for _, member := range membership {
+			if !next[member] {
+				departed++
+			}
+		}
+		// the number of shares that sign, as in the tss threshold of the membership
+		threshold := (len(membership)*2 + 2) / 3
+		if departed >= threshold {
+			return fmt.Sprintf("%d of %d members of an epoch would have left, the signing threshold is %d", departed, len(membership), threshold)
+		}
+	}
+	return ""
+}
+
 // RotateVault update vault to Retiring and new vault to active
 func (vm *NetworkMgrVCUR) RotateVault(ctx cosmos.Context, vault Vault) error {
 	active, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
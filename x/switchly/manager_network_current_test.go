@@ -774,6 +774,69 @@ func (s *NetworkManagerVCURTestSuite) TestCleanupAsgardIndex(c *C) {
 	c.Assert(containsVault(asgards, vault4.PubKey), Equals, false)
 }
 
+func (s *NetworkManagerVCURTestSuite) TestTriggerKeygenReshare(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	mgr := NewDummyMgrWithKeeper(k)
+	networkMgr := newNetworkMgrVCUR(k, mgr.TxOutStore(), mgr.EventMgr())
+	nas := NodeAccounts{}
+	for i := 0; i < 6; i++ {
+		nas = append(nas, GetRandomValidatorNode(NodeActive))
+	}
+	nas = append(nas, GetRandomValidatorNode(NodeReady))
+	vault := NewVault(1024, ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.ETHChain}.Strings(), []ChainContract{})
+	leaving := GetRandomPubKey().String()
+	vault.Membership = []string{leaving}
+	for _, na := range nas[:6] {
+		vault.Membership = append(vault.Membership, na.PubKeySet.Secp256k1.String())
+	}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	lastKeygen := func() Keygen {
+		keygenBlock, err := k.GetKeygenBlock(ctx, ctx.BlockHeight())
+		c.Assert(err, IsNil)
+		c.Assert(len(keygenBlock.Keygens) > 0, Equals, true)
+		return keygenBlock.Keygens[len(keygenBlock.Keygens)-1]
+	}
+	trigger := func(nas NodeAccounts) Keygen {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		c.Assert(networkMgr.TriggerKeygen(ctx, nas), IsNil)
+		return lastKeygen()
+	}
+
+	// disabled by default
+	c.Check(trigger(nas).Type, Equals, AsgardKeygen)
+
+	k.SetMimir(ctx, constants.MimirKeyReshareChurnEnabled, 1)
+	keygen := trigger(nas)
+	c.Check(keygen.Type, Equals, ReshareKeygen)
+	c.Check(keygen.PoolPubKey.Equals(vault.PubKey), Equals, true)
+	c.Check(keygen.GetOldMembers().Equals(vault.GetMembership()), Equals, true)
+	c.Check(keygen.GetMembers().Contains(nas[6].PubKeySet.Secp256k1), Equals, true)
+
+	// at most ReshareMaxMembersChanged members change in a reshare
+	k.SetMimir(ctx, constants.ReshareMaxMembersChanged.String(), 1)
+	c.Check(trigger(nas).Type, Equals, AsgardKeygen)
+	k.SetMimir(ctx, constants.ReshareMaxMembersChanged.String(), 2)
+	c.Check(trigger(nas).Type, Equals, ReshareKeygen)
+
+	// a full keygen once the members that left any epoch reach its signing threshold
+	epochMembers := []string{leaving, GetRandomPubKey().String(), GetRandomPubKey().String(), nas[0].PubKeySet.Secp256k1.String(), nas[1].PubKeySet.Secp256k1.String()}
+	k.SetVaultMembershipEpoch(ctx, NewVaultMembershipEpoch(vault.PubKey, 0, vault.BlockHeight, epochMembers[:3]))
+	c.Check(trigger(nas).Type, Equals, AsgardKeygen)
+	k.SetVaultMembershipEpoch(ctx, NewVaultMembershipEpoch(vault.PubKey, 0, vault.BlockHeight, epochMembers))
+	c.Check(trigger(nas).Type, Equals, ReshareKeygen)
+
+	// and once the vault went through ReshareMaxEpochs reshares
+	vault.MembershipEpoch = k.GetConfigInt64(ctx, constants.ReshareMaxEpochs)
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	c.Check(trigger(nas).Type, Equals, AsgardKeygen)
+	vault.MembershipEpoch = 1
+
+	// resharing is ECDSA only, a vault with an ed25519 key gets a full keygen
+	vault.Ed25519PubKey = GetRandomEd25519PubKey()
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	c.Check(trigger(nas).Type, Equals, AsgardKeygen)
+}
+
 func (*NetworkManagerVCURTestSuite) TestPOLLiquidityAdd(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
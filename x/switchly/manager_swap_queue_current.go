@@ -213,6 +213,8 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 					dexAgg := ""
 					if len(pick.msg.Aggregator) > 0 {
 						dexAgg, err = FetchDexAggregator(
+							ctx,
+							vm.k,
 							pick.msg.TargetAsset.GetChain(),
 							pick.msg.Aggregator,
 						)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/tracing"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
 )
@@ -45,7 +46,12 @@ func (tos *TxOutStorageVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		return err
 	}
 
-	maxGasCache := make(map[common.Chain]common.Coin)
+	// the max gas of an outbound depends on the vault it is sent from
+	type vaultChain struct {
+		chain common.Chain
+		vault common.PubKey
+	}
+	maxGasCache := make(map[vaultChain]common.Coin)
 	gasRateCache := make(map[common.Chain]int64)
 
 	for i, tx := range txOut.TxArray {
@@ -63,14 +69,15 @@ func (tos *TxOutStorageVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		// update max gas, take the larger of the current gas, or the last gas used
 
 		// update cache if needed
-		if _, ok := maxGasCache[tx.Chain]; !ok {
-			maxGasCache[tx.Chain], _ = mgr.GasMgr().GetMaxGas(ctx, tx.Chain)
+		key := vaultChain{chain: tx.Chain, vault: tx.VaultPubKey}
+		if _, ok := maxGasCache[key]; !ok {
+			maxGasCache[key], _ = mgr.GasMgr().GetVaultMaxGas(ctx, tx.Chain, tx.VaultPubKey)
 		}
 		if _, ok := gasRateCache[tx.Chain]; !ok {
 			gasRateCache[tx.Chain] = int64(mgr.GasMgr().GetGasRate(ctx, tx.Chain).Uint64())
 		}
 
-		maxGas := maxGasCache[tx.Chain]
+		maxGas := maxGasCache[key]
 		gasRate := gasRateCache[tx.Chain]
 		if len(tx.MaxGas) == 0 || (!maxGas.IsEmpty() && !maxGas.Amount.Equal(tx.MaxGas[0].Amount)) {
 			txOut.TxArray[i].MaxGas = common.Gas{maxGas}
@@ -153,7 +160,7 @@ func (tos *TxOutStorageVCUR) TryAddTxOutItem(ctx cosmos.Context, mgr Manager, to
 		return true, nil
 	}
 
-	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx, commit := cacheContext(ctx)
 
 	// Deduct affiliate fee from outbound amount
 	amount, err := tos.takeAffiliateFee(cacheCtx, mgr, toi)
@@ -483,7 +490,8 @@ func (tos *TxOutStorageVCUR) prepareTxOutItem(ctx cosmos.Context, toi TxOutItem)
 	if toi.ToAddress.IsEmpty() {
 		return outputs, cosmos.ZeroUint(), fmt.Errorf("empty to address, can't send out")
 	}
-	if !toi.ToAddress.IsChain(toi.Chain) {
+	// cosmos zones pay accounts of other zones (e.g. refunds of IBC deposits) over IBC
+	if !toi.ToAddress.IsChain(toi.Chain) && !toi.ToAddress.IsIBCChain(toi.Chain) {
 		return outputs, cosmos.ZeroUint(), fmt.Errorf("to address(%s), is not of chain(%s)", toi.ToAddress, toi.Chain)
 	}
 
@@ -575,7 +583,7 @@ func (tos *TxOutStorageVCUR) prepareTxOutItem(ctx cosmos.Context, toi TxOutItem)
 	finalSWITCHFee := cosmos.ZeroUint()
 	for i := range outputs {
 		if outputs[i].MaxGas.IsEmpty() {
-			maxGasCoin, err := tos.gasManager.GetMaxGas(ctx, outputs[i].Chain)
+			maxGasCoin, err := tos.gasManager.GetVaultMaxGas(ctx, outputs[i].Chain, outputs[i].VaultPubKey)
 			if err != nil {
 				return nil, cosmos.ZeroUint(), fmt.Errorf("fail to get max gas coin: %w", err)
 			}
@@ -821,6 +829,12 @@ func (tos *TxOutStorageVCUR) addToBlockOut(ctx cosmos.Context, mgr Manager, item
 	if err := tos.eventMgr.EmitEvent(ctx, NewEventScheduledOutbound(item)); err != nil {
 		ctx.Logger().Error("fail to emit scheduled outbound event", "error", err)
 	}
+	traceTx(ctx, item.InHash, tracing.StageScheduled,
+		tracing.AttrChain.String(item.Chain.String()),
+		tracing.AttrVault.String(item.VaultPubKey.String()),
+		tracing.AttrOutboundHeight.Int64(outboundHeight),
+		tracing.AttrMemo.String(item.Memo),
+	)
 
 	return tos.keeper.AppendTxOut(ctx, outboundHeight, item)
 }
@@ -76,6 +76,7 @@ type GasManager interface {
 	GetGas() common.Gas
 	GetAssetOutboundFee(ctx cosmos.Context, asset common.Asset, inSWITCH bool) (cosmos.Uint, error)
 	GetMaxGas(ctx cosmos.Context, chain common.Chain) (common.Coin, error)
+	GetVaultMaxGas(ctx cosmos.Context, chain common.Chain, vaultPubKey common.PubKey) (common.Coin, error)
 	GetGasRate(ctx cosmos.Context, chain common.Chain) cosmos.Uint
 	GetNetworkFee(ctx cosmos.Context, chain common.Chain) (types.NetworkFee, error)
 	CalcOutboundFeeMultiplier(ctx cosmos.Context, targetSurplusSwitch, gasSpentSWITCH, gasWithheldSWITCH, maxMultiplier, minMultiplier cosmos.Uint) cosmos.Uint
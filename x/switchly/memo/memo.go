@@ -49,6 +49,8 @@ const (
 	TxSWCYStake
 	TxSWCYUnstake
 	TxMaint
+	TxModifyLimitSwap
+	TxBatchOutbound
 )
 
 var stringToTxTypeMap = map[string]TxType{
@@ -93,6 +95,10 @@ var stringToTxTypeMap = map[string]TxType{
 	"tcy+":        TxSWCYStake,
 	"tcy-":        TxSWCYUnstake,
 	"maint":       TxMaint,
+	"modify":      TxModifyLimitSwap,
+	"m=<":         TxModifyLimitSwap,
+	"cancel":      TxModifyLimitSwap,
+	"batch":       TxBatchOutbound,
 }
 
 var txToStringMap = map[TxType]string{
@@ -124,6 +130,8 @@ var txToStringMap = map[TxType]string{
 	TxSWCYStake:              "tcy+",
 	TxSWCYUnstake:            "tcy-",
 	TxMaint:                  "maint",
+	TxModifyLimitSwap:        "modify",
+	TxBatchOutbound:          "batch",
 }
 
 // converts a string into a txType
@@ -163,7 +171,8 @@ func (tx TxType) IsInbound() bool {
 		TxSwitch,
 		TxSWCYClaim,
 		TxSWCYStake,
-		TxSWCYUnstake:
+		TxSWCYUnstake,
+		TxModifyLimitSwap:
 		return true
 	default:
 		return false
@@ -172,7 +181,7 @@ func (tx TxType) IsInbound() bool {
 
 func (tx TxType) IsOutbound() bool {
 	switch tx {
-	case TxOutbound, TxRefund, TxRagnarok:
+	case TxOutbound, TxRefund, TxRagnarok, TxBatchOutbound:
 		return true
 	default:
 		return false
@@ -200,6 +209,7 @@ func (tx TxType) HasOutbound() bool {
 		TxReserve,
 		TxMigrate,
 		TxMaint,
+		TxModifyLimitSwap,
 		TxRagnarok:
 		return false
 	default:
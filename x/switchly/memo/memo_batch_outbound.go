@@ -0,0 +1,50 @@
+package switchly
+
+import (
+	"fmt"
+)
+
+// BatchOutboundMemo is the memo of a transaction paying several outbounds of the same
+// vault, scheduled in the same block, with one output each. On chain the memo only
+// carries the block height, observers append the index of the observed output so
+// every output is observed and matched back to its TxOutItem on its own.
+type BatchOutboundMemo struct {
+	MemoBase
+	BlockHeight int64
+	Index       int64
+}
+
+func (m BatchOutboundMemo) GetBlockHeight() int64 { return m.BlockHeight }
+func (m BatchOutboundMemo) GetIndex() int64       { return m.Index }
+
+func (m BatchOutboundMemo) String() string {
+	if m.Index > 0 {
+		return fmt.Sprintf("BATCH:%d:%d", m.BlockHeight, m.Index)
+	}
+	return fmt.Sprintf("BATCH:%d", m.BlockHeight)
+}
+
+// WithIndex returns the memo of the output at the given index of the batch
+func (m BatchOutboundMemo) WithIndex(index int64) BatchOutboundMemo {
+	m.Index = index
+	return m
+}
+
+func NewBatchOutboundMemo(blockHeight int64) BatchOutboundMemo {
+	return BatchOutboundMemo{
+		MemoBase:    MemoBase{TxType: TxBatchOutbound},
+		BlockHeight: blockHeight,
+	}
+}
+
+func (p *parser) ParseBatchOutboundMemo() (BatchOutboundMemo, error) {
+	blockHeight := p.getInt64(1, true, 0)
+	index := p.getInt64(2, false, 0)
+	if blockHeight <= 0 {
+		p.addErr(fmt.Errorf("invalid block height: %d", blockHeight))
+	}
+	if index < 0 {
+		p.addErr(fmt.Errorf("invalid output index: %d", index))
+	}
+	return NewBatchOutboundMemo(blockHeight).WithIndex(index), p.Error()
+}
@@ -0,0 +1,53 @@
+package switchly
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// ModifyLimitSwapMemo is used to modify the trade target of a resting limit
+// swap, or to cancel it (and refund it) when the modified target is zero
+type ModifyLimitSwapMemo struct {
+	MemoBase
+	TxID                 common.TxID
+	ModifiedTargetAmount cosmos.Uint
+}
+
+// NewModifyLimitSwapMemo creates a new ModifyLimitSwapMemo
+func NewModifyLimitSwapMemo(txID common.TxID, modifiedTargetAmount cosmos.Uint) ModifyLimitSwapMemo {
+	return ModifyLimitSwapMemo{
+		MemoBase:             MemoBase{TxType: TxModifyLimitSwap},
+		TxID:                 txID,
+		ModifiedTargetAmount: modifiedTargetAmount,
+	}
+}
+
+// GetTxID returns the hash of the limit swap to modify
+func (m ModifyLimitSwapMemo) GetTxID() common.TxID { return m.TxID }
+
+// GetAmount returns the modified trade target of the limit swap
+func (m ModifyLimitSwapMemo) GetAmount() cosmos.Uint { return m.ModifiedTargetAmount }
+
+// IsCancel returns true when the memo cancels the limit swap
+func (m ModifyLimitSwapMemo) IsCancel() bool { return m.ModifiedTargetAmount.IsZero() }
+
+// String implement fmt.Stringer
+func (m ModifyLimitSwapMemo) String() string {
+	if m.IsCancel() {
+		return fmt.Sprintf("cancel:%s", m.TxID.String())
+	}
+	return fmt.Sprintf("modify:%s:%s", m.TxID.String(), m.ModifiedTargetAmount.String())
+}
+
+// ParseModifyLimitSwapMemo parses MODIFY:TXID:TARGET and CANCEL:TXID memos
+func (p *parser) ParseModifyLimitSwapMemo() (ModifyLimitSwapMemo, error) {
+	txID := p.getTxID(1, true, common.BlankTxID)
+	target := cosmos.ZeroUint()
+	if !strings.EqualFold(p.get(0), "cancel") {
+		target = p.getUintWithScientificNotation(2, true, 0)
+	}
+	return NewModifyLimitSwapMemo(txID, target), p.Error()
+}
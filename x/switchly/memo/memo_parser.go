@@ -122,6 +122,10 @@ func (p *parser) parse() (mem Memo, err error) {
 		return p.ParseSWCYUnstakeMemo()
 	case TxMaint:
 		return p.ParseMaintMemo()
+	case TxModifyLimitSwap:
+		return p.ParseModifyLimitSwapMemo()
+	case TxBatchOutbound:
+		return p.ParseBatchOutboundMemo()
 
 	default:
 		return EmptyMemo, fmt.Errorf("TxType not supported: %s", p.getType().String())
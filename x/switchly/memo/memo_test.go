@@ -651,3 +651,62 @@ func (s *MemoSuite) TestParse(c *C) {
 	_, err = ParseMemoWithSWITCHNames(ctx, k, "tcy-") // emptu bps
 	c.Assert(err, NotNil)
 }
+
+func (s *MemoSuite) TestParseModifyLimitSwap(c *C) {
+	ctx := s.ctx
+	k := s.k
+
+	limitTxID := "5E1DF027321F1FE37CA19B9ECB11C2B4ABEC0D8322199D335D9CE4C39F85F115"
+	memo, err := ParseMemoWithSWITCHNames(ctx, k, "modify:"+limitTxID+":1e8")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxModifyLimitSwap), Equals, true)
+	c.Check(memo.GetTxID().String(), Equals, limitTxID)
+	c.Check(memo.GetAmount().Uint64(), Equals, uint64(100000000))
+	c.Check(memo.String(), Equals, "modify:"+limitTxID+":100000000")
+	memo, err = ParseMemoWithSWITCHNames(ctx, k, "m=<:"+limitTxID+":500")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxModifyLimitSwap), Equals, true)
+	c.Check(memo.GetAmount().Uint64(), Equals, uint64(500))
+	memo, err = ParseMemoWithSWITCHNames(ctx, k, "CANCEL:"+limitTxID)
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxModifyLimitSwap), Equals, true)
+	c.Check(memo.GetAmount().IsZero(), Equals, true)
+	c.Check(memo.String(), Equals, "cancel:"+limitTxID)
+	_, err = ParseMemoWithSWITCHNames(ctx, k, "modify:"+limitTxID) // missing target
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithSWITCHNames(ctx, k, "cancel") // missing tx id
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithSWITCHNames(ctx, k, "cancel:what") // invalid tx id
+	c.Assert(err, NotNil)
+}
+
+func (s *MemoSuite) TestParseBatchOutbound(c *C) {
+	memo, err := ParseMemo(common.LatestVersion, "BATCH:1024")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxBatchOutbound), Equals, true)
+	c.Check(memo.IsOutbound(), Equals, true)
+	c.Check(memo.IsInbound(), Equals, false)
+	c.Check(memo.GetBlockHeight(), Equals, int64(1024))
+	c.Check(memo.String(), Equals, "BATCH:1024")
+
+	batchMemo, ok := memo.(BatchOutboundMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(batchMemo.GetIndex(), Equals, int64(0))
+	c.Check(batchMemo.WithIndex(2).String(), Equals, "BATCH:1024:2")
+
+	memo, err = ParseMemo(common.LatestVersion, "batch:1024:3")
+	c.Assert(err, IsNil)
+	batchMemo, ok = memo.(BatchOutboundMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(batchMemo.GetBlockHeight(), Equals, int64(1024))
+	c.Check(batchMemo.GetIndex(), Equals, int64(3))
+
+	_, err = ParseMemo(common.LatestVersion, "BATCH") // missing height
+	c.Assert(err, NotNil)
+	_, err = ParseMemo(common.LatestVersion, "BATCH:0")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo(common.LatestVersion, "BATCH:1024:-1")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo(common.LatestVersion, "BATCH:1024:x")
+	c.Assert(err, NotNil)
+}
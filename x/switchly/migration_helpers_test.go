@@ -143,3 +143,32 @@ func (MigrationHelpersTestSuite) TestSetSWCYClaims(c *C) {
 	c.Assert(claimer.Asset.Equals(common.DOGEAsset), Equals, true)
 	c.Assert(claimer.L1Address.Equals(address3), Equals, true)
 }
+
+func (MigrationHelpersTestSuite) TestMigrate7to8DexAggregators(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	defaults := defaultDexAggregators()
+	c.Assert(len(defaults) > 1, Equals, true)
+
+	// aggregators voted on already are kept as they are
+	disabled := defaults[0]
+	disabled.Enabled = false
+	mgr.Keeper().SetDexAggregator(ctx, disabled)
+
+	m := NewMigrator(mgr)
+	c.Assert(m.Migrate7to8(ctx), IsNil)
+	aggs, err := mgr.Keeper().GetDexAggregators(ctx)
+	c.Assert(err, IsNil)
+	c.Check(aggs, HasLen, len(defaults))
+	agg, err := mgr.Keeper().GetDexAggregator(ctx, disabled.Chain, disabled.Address)
+	c.Assert(err, IsNil)
+	c.Check(agg.Enabled, Equals, false)
+	agg, err = mgr.Keeper().GetDexAggregator(ctx, defaults[1].Chain, defaults[1].Address)
+	c.Assert(err, IsNil)
+	c.Check(agg.Equals(defaults[1]), Equals, true)
+
+	// the migration is idempotent
+	c.Assert(m.Migrate7to8(ctx), IsNil)
+	aggs, err = mgr.Keeper().GetDexAggregators(ctx)
+	c.Assert(err, IsNil)
+	c.Check(aggs, HasLen, len(defaults))
+}
@@ -64,3 +64,69 @@ func (m Migrator) ClearObsoleteMimirs(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	// Loads the manager for this migration (we are in the x/upgrade's preblock)
+	// Note, we do not require the manager loaded for this migration, but it is okay
+	// to load it earlier and this is the pattern for migrations to follow.
+	if err := m.mgr.LoadManagerIfNecessary(ctx); err != nil {
+		return err
+	}
+
+	// ------------------------------ Limit Swap Address Index ------------------------------
+
+	// Limit swaps queued before the address index existed are re-written so
+	// that they are indexed by sender.
+	var limitSwaps []MsgSwap
+	iter := m.mgr.Keeper().GetAdvSwapQueueItemIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var msg MsgSwap
+		if err := m.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg); err != nil {
+			ctx.Logger().Error("fail to unmarshal adv swap queue item", "key", string(iter.Key()), "error", err)
+			continue
+		}
+		if msg.SwapType == LimitSwap {
+			limitSwaps = append(limitSwaps, msg)
+		}
+	}
+	for _, msg := range limitSwaps {
+		if err := m.mgr.Keeper().SetAdvSwapQueueItem(ctx, msg); err != nil {
+			ctx.Logger().Error("fail to index limit swap", "tx id", msg.Tx.ID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	// Loads the manager for this migration (we are in the x/upgrade's preblock)
+	// Note, we do not require the manager loaded for this migration, but it is okay
+	// to load it earlier and this is the pattern for migrations to follow.
+	if err := m.mgr.LoadManagerIfNecessary(ctx); err != nil {
+		return err
+	}
+
+	// ------------------------------ Dex Aggregators ------------------------------
+
+	// The dex aggregators moved from the binary to the keeper, but only new chains are
+	// seeded from their genesis. Seed the aggregators compiled into the binary that
+	// aren't registered yet, so swaps keep calling them.
+	for _, agg := range defaultDexAggregators() {
+		current, err := m.mgr.Keeper().GetDexAggregator(ctx, agg.Chain, agg.Address)
+		if err != nil {
+			return err
+		}
+		if !current.IsEmpty() {
+			continue
+		}
+		m.mgr.Keeper().SetDexAggregator(ctx, agg)
+		if err := m.mgr.EventMgr().EmitEvent(ctx, NewEventDexAggregator("set", agg)); err != nil {
+			ctx.Logger().Error("fail to emit dex_aggregator event", "error", err)
+		}
+	}
+
+	return nil
+}
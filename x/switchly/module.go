@@ -29,6 +29,7 @@ import (
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/client/cli"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/history"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -115,6 +116,7 @@ func NewAppModule(
 	mgr *Mgrs,
 	telemetryEnabled bool,
 	testApp bool,
+	historyIndexer *history.Indexer,
 ) AppModule {
 	kb := cosmos.KeybaseStore{}
 	var err error
@@ -133,7 +135,7 @@ func NewAppModule(
 		mgr:              mgr,
 		telemetryEnabled: telemetryEnabled,
 		msgServer:        NewMsgServerImpl(mgr),
-		queryServer:      NewQueryServerImpl(mgr, txConfig, kb),
+		queryServer:      newQueryServer(mgr, txConfig, kb, historyIndexer),
 	}
 }
 
@@ -142,7 +144,7 @@ func (AppModule) IsAppModule() {}
 func (AppModule) IsOnePerModuleType() {}
 
 func (AppModule) ConsensusVersion() uint64 {
-	return 6
+	return 8
 }
 
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
@@ -157,12 +159,13 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.queryServer)
 	wasmtypes.RegisterQueryServer(cfg.QueryServer(), wasmkeeper.Querier(&am.mgr.wasmKeeper))
 
-	// Migration registrations would go here when needed
-	// Example:
-	// m := NewMigrator(am.mgr)
-	// if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
-	//     panic(fmt.Sprintf("failed to migrate x/switchly from version 5 to 6: %v", err))
-	// }
+	m := NewMigrator(am.mgr)
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to register x/switchly migration from version 6 to 7: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to register x/switchly migration from version 7 to 8: %v", err))
+	}
 }
 
 // BeginBlock called when a block get proposed
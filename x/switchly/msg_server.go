@@ -32,6 +32,11 @@ func (ms msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*type
 	return externalHandler(goCtx, handler, msg)
 }
 
+func (ms msgServer) EdDSABackfill(goCtx context.Context, msg *types.MsgEdDSABackfill) (*types.MsgEmpty, error) {
+	handler := NewEdDSABackfillHandler(ms.mgr)
+	return externalHandler(goCtx, handler, msg)
+}
+
 func (ms msgServer) ErrataTx(goCtx context.Context, msg *types.MsgErrataTx) (*types.MsgEmpty, error) {
 	handler := NewErrataTxHandler(ms.mgr)
 	return externalHandler(goCtx, handler, msg)
@@ -112,6 +117,11 @@ func (ms msgServer) TssPool(goCtx context.Context, msg *types.MsgTssPool) (*type
 	return externalHandler(goCtx, handler, msg)
 }
 
+func (ms msgServer) UpdateAggregator(goCtx context.Context, msg *types.MsgUpdateAggregator) (*types.MsgEmpty, error) {
+	handler := NewUpdateAggregatorHandler(ms.mgr)
+	return externalHandler(goCtx, handler, msg)
+}
+
 func (ms msgServer) SetVersion(goCtx context.Context, msg *types.MsgSetVersion) (*types.MsgEmpty, error) {
 	handler := NewVersionHandler(ms.mgr)
 	return externalHandler(goCtx, handler, msg)
@@ -146,6 +156,9 @@ func externalHandler(goCtx context.Context, handler MsgHandler, msg sdk.Msg) (_
 		}
 	}()
 
+	// the changes of a failed msg are discarded by the msg service router, so are the
+	// stages it traced
+	ctx, record := withTraceBuffer(ctx)
 	result, err := handler.Run(ctx, msg)
 
 	if result != nil && result.Size() > 0 {
@@ -159,5 +172,6 @@ func externalHandler(goCtx context.Context, handler MsgHandler, msg sdk.Msg) (_
 		return nil, err
 	}
 
+	record()
 	return &types.MsgEmpty{}, err
 }
@@ -26,9 +26,11 @@ import (
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/common/swcysmartcontract"
 	"github.com/switchlyprotocol/switchlynode/v3/config"
 	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
+	kvTypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper/types"
 	keeperv1 "github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper/v1"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
@@ -161,6 +163,7 @@ func (qs queryServer) queryVault(ctx cosmos.Context, req *types.QueryVaultReques
 		Addresses:             getVaultChainAddresses(ctx, v),
 		Frozen:                v.Frozen,
 		Ed25519PubKey:         v.Ed25519PubKey.String(),
+		MembershipEpoch:       v.MembershipEpoch,
 	}
 	return &resp, nil
 }
@@ -197,6 +200,7 @@ func (qs queryServer) queryAsgardVaults(ctx cosmos.Context, _ *types.QueryAsgard
 				Frozen:                vault.Frozen,
 				Addresses:             getVaultChainAddresses(ctx, vault),
 				Ed25519PubKey:         vault.Ed25519PubKey.String(),
+				MembershipEpoch:       vault.MembershipEpoch,
 			})
 		}
 	}
@@ -382,7 +386,12 @@ func (qs queryServer) querySWITCHProvider(ctx cosmos.Context, req *types.QuerySW
 }
 
 // querySwitchProviders
-func (qs queryServer) querySwitchProviders(ctx cosmos.Context, _ *types.QuerySWITCHProvidersRequest) (*types.QuerySWITCHProvidersResponse, error) {
+func (qs queryServer) querySwitchProviders(ctx cosmos.Context, req *types.QuerySWITCHProvidersRequest) (*types.QuerySWITCHProvidersResponse, error) {
+	minUnits, err := parseMinFilter("min_units", req.MinUnits)
+	if err != nil {
+		return nil, err
+	}
+
 	// get switchpool value to determine current value and pnl
 	switchPool, err := qs.mgr.Keeper().GetSwitchPool(ctx)
 	if err != nil {
@@ -396,9 +405,15 @@ func (qs queryServer) querySwitchProviders(ctx cosmos.Context, _ *types.QuerySWI
 	var switchProviders []*types.QuerySWITCHProviderResponse
 	iterator := qs.mgr.Keeper().GetSWITCHProviderIterator(ctx)
 	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iterator, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var rp types.SWITCHProvider
-		qs.mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &rp)
+		qs.mgr.Keeper().Cdc().MustUnmarshal(value, &rp)
+		if rp.Units.LT(minUnits) || !hasAddressPrefix(rp.SwitchAddress.String(), req.AddressPrefix) {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
 
 		providerValue := common.GetSafeShare(rp.Units, switchPool.TotalUnits(), switchPoolValue)
 		providerPnl := providerValue.BigInt()
@@ -415,8 +430,12 @@ func (qs queryServer) querySwitchProviders(ctx cosmos.Context, _ *types.QuerySWI
 			LastDepositHeight:  rp.LastDepositHeight,
 			LastWithdrawHeight: rp.LastWithdrawHeight,
 		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to paginate switch providers: %w", err)
 	}
-	return &types.QuerySWITCHProvidersResponse{Providers: switchProviders}, nil
+	return &types.QuerySWITCHProvidersResponse{Providers: switchProviders, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) queryNetwork(ctx cosmos.Context, _ *types.QueryNetworkRequest) (*types.QueryNetworkResponse, error) {
@@ -522,6 +541,10 @@ func (qs queryServer) queryInboundAddresses(ctx cosmos.Context, _ *types.QueryIn
 		// account. PubKeyForChain falls back to vault.PubKey for non-ed25519 chains, so this is a no-op
 		// for everything except Stellar.
 		pubKeyForChain := vault.PubKeyForChain(chain)
+		if chain.Equals(common.SolanaChain) && !vault.HasEd25519PubKey() {
+			// unlike Stellar, a vault without an ed25519 key has no Solana address to advertise
+			continue
+		}
 		vaultAddress, err := pubKeyForChain.GetAddress(chain)
 		if err != nil {
 			ctx.Logger().Error("fail to get address for chain", "error", err)
@@ -748,8 +771,36 @@ func getNodeCurrentRewards(ctx cosmos.Context, mgr *Mgrs, nodeAcc NodeAccount, l
 
 // queryNodes return all the nodes that has bond
 // /switchly/nodes
-func (qs queryServer) queryNodes(ctx cosmos.Context, _ *types.QueryNodesRequest) (*types.QueryNodesResponse, error) {
-	nodeAccounts, err := qs.mgr.Keeper().ListValidatorsWithBond(ctx)
+func (qs queryServer) queryNodes(ctx cosmos.Context, req *types.QueryNodesRequest) (*types.QueryNodesResponse, error) {
+	if len(req.Status) > 0 {
+		if _, ok := types.NodeStatus_value[strings.Title(strings.ToLower(req.Status))]; !ok { // nolint SA1019
+			return nil, fmt.Errorf("invalid node status: %s", req.Status)
+		}
+	}
+
+	// same selection as ListValidatorsWithBond, paginated over the node account store
+	nodeAccounts := make(NodeAccounts, 0)
+	iter := qs.mgr.Keeper().GetNodeAccountIterator(ctx)
+	defer iter.Close()
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iter, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var na NodeAccount
+		if err := qs.mgr.Keeper().Cdc().Unmarshal(value, &na); err != nil {
+			return false, fmt.Errorf("fail to unmarshal node account: %w", err)
+		}
+		if na.Type != NodeTypeValidator || na.Bond.IsZero() {
+			return false, nil
+		}
+		if len(req.Status) > 0 && !strings.EqualFold(na.Status.String(), req.Status) {
+			return false, nil
+		}
+		if !hasAddressPrefix(na.NodeAddress.String(), req.AddressPrefix) {
+			return false, nil
+		}
+		if accumulate {
+			nodeAccounts = append(nodeAccounts, na)
+		}
+		return true, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("fail to get node accounts: %w", err)
 	}
@@ -884,7 +935,7 @@ func (qs queryServer) queryNodes(ctx cosmos.Context, _ *types.QueryNodesRequest)
 		}
 	}
 
-	return &types.QueryNodesResponse{Nodes: result}, nil
+	return &types.QueryNodesResponse{Nodes: result, Pagination: pageRes}, nil
 }
 
 // queryBorrowers
@@ -901,13 +952,22 @@ func (qs queryServer) queryBorrowers(ctx cosmos.Context, req *types.QueryBorrowe
 	var loans Loans
 	iterator := qs.mgr.Keeper().GetLoanIterator(ctx, asset)
 	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iterator, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var loan Loan
-		qs.mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &loan)
+		qs.mgr.Keeper().Cdc().MustUnmarshal(value, &loan)
 		if loan.CollateralDeposited.Equal(loan.CollateralWithdrawn) && loan.DebtIssued.Equal(loan.DebtRepaid) {
-			continue
+			return false, nil
+		}
+		if !hasAddressPrefix(loan.Owner.String(), req.AddressPrefix) {
+			return false, nil
 		}
-		loans = append(loans, loan)
+		if accumulate {
+			loans = append(loans, loan)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to paginate borrowers: %w", err)
 	}
 
 	borrowers := make([]*types.QueryBorrowerResponse, len(loans))
@@ -926,7 +986,7 @@ func (qs queryServer) queryBorrowers(ctx cosmos.Context, req *types.QueryBorrowe
 		}
 	}
 
-	return &types.QueryBorrowersResponse{Borrowers: borrowers}, nil
+	return &types.QueryBorrowersResponse{Borrowers: borrowers, Pagination: pageRes}, nil
 }
 
 // queryBorrower
@@ -1011,12 +1071,26 @@ func (qs queryServer) queryLiquidityProviders(ctx cosmos.Context, req *types.Que
 		return nil, fmt.Errorf("invalid request: requested pool is a SaversPool")
 	}
 
+	minUnits, err := parseMinFilter("min_units", req.MinUnits)
+	if err != nil {
+		return nil, err
+	}
+
 	var lps []*types.QueryLiquidityProviderResponse
 	iterator := qs.mgr.Keeper().GetLiquidityProviderIterator(ctx, asset)
 	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iterator, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var lp LiquidityProvider
-		qs.mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &lp)
+		qs.mgr.Keeper().Cdc().MustUnmarshal(value, &lp)
+		if lp.Units.LT(minUnits) {
+			return false, nil
+		}
+		if !hasAddressPrefix(lp.SwitchAddress.String(), req.AddressPrefix) && !hasAddressPrefix(lp.AssetAddress.String(), req.AddressPrefix) {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
 		lps = append(lps, &types.QueryLiquidityProviderResponse{
 			// No redeem or LUVI calculations for the array response.
 			Asset:              lp.Asset.GetLayer1Asset().String(),
@@ -1031,8 +1105,12 @@ func (qs queryServer) queryLiquidityProviders(ctx cosmos.Context, req *types.Que
 			SwitchDepositValue: lp.SwitchDepositValue.String(),
 			AssetDepositValue:  lp.AssetDepositValue.String(),
 		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to paginate liquidity providers: %w", err)
 	}
-	return &types.QueryLiquidityProvidersResponse{LiquidityProviders: lps}, nil
+	return &types.QueryLiquidityProvidersResponse{LiquidityProviders: lps, Pagination: pageRes}, nil
 }
 
 // queryLiquidityProvider
@@ -1138,16 +1216,30 @@ func (qs queryServer) querySavers(ctx cosmos.Context, req *types.QuerySaversRequ
 		return nil, fmt.Errorf("fail to get pool: %w", err)
 	}
 
+	minUnits, err := parseMinFilter("min_units", req.MinUnits)
+	if err != nil {
+		return nil, err
+	}
+
 	var savers []*types.QuerySaverResponse
 	iterator := qs.mgr.Keeper().GetLiquidityProviderIterator(ctx, asset)
 	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iterator, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var lp LiquidityProvider
-		qs.mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &lp)
-		savers = append(savers, newSaver(lp, pool))
+		qs.mgr.Keeper().Cdc().MustUnmarshal(value, &lp)
+		if lp.Units.LT(minUnits) || !hasAddressPrefix(lp.AssetAddress.String(), req.AddressPrefix) {
+			return false, nil
+		}
+		if accumulate {
+			savers = append(savers, newSaver(lp, pool))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to paginate savers: %w", err)
 	}
 
-	return &types.QuerySaversResponse{Savers: savers}, nil
+	return &types.QuerySaversResponse{Savers: savers, Pagination: pageRes}, nil
 }
 
 // querySaver
@@ -1232,13 +1324,28 @@ func newStreamingSwap(streamingSwap StreamingSwap, msgSwap MsgSwap) *types.Query
 	}
 }
 
-func (qs queryServer) queryStreamingSwaps(ctx cosmos.Context, _ *types.QueryStreamingSwapsRequest) (*types.QueryStreamingSwapsResponse, error) {
+func (qs queryServer) queryStreamingSwaps(ctx cosmos.Context, req *types.QueryStreamingSwapsRequest) (*types.QueryStreamingSwapsResponse, error) {
+	var sourceAsset, targetAsset common.Asset
+	var err error
+	if len(req.SourceAsset) > 0 {
+		sourceAsset, err = common.NewAsset(req.SourceAsset)
+		if err != nil {
+			return nil, fmt.Errorf("bad source asset: %w", err)
+		}
+	}
+	if len(req.TargetAsset) > 0 {
+		targetAsset, err = common.NewAsset(req.TargetAsset)
+		if err != nil {
+			return nil, fmt.Errorf("bad target asset: %w", err)
+		}
+	}
+
 	var streams []*types.QueryStreamingSwapResponse
 	iter := qs.mgr.Keeper().GetStreamingSwapIterator(ctx)
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iter, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var stream StreamingSwap
-		qs.mgr.Keeper().Cdc().MustUnmarshal(iter.Value(), &stream)
+		qs.mgr.Keeper().Cdc().MustUnmarshal(value, &stream)
 
 		var msgSwap MsgSwap
 		// Check up to the first two indices (0 through 1) for the MsgSwap; if not found, leave the fields blank.
@@ -1259,9 +1366,21 @@ func (qs queryServer) queryStreamingSwaps(ctx cosmos.Context, _ *types.QueryStre
 			break
 		}
 
-		streams = append(streams, newStreamingSwap(stream, msgSwap))
+		if !sourceAsset.IsEmpty() && (len(msgSwap.Tx.Coins) == 0 || !msgSwap.Tx.Coins[0].Asset.Equals(sourceAsset)) {
+			return false, nil
+		}
+		if !targetAsset.IsEmpty() && !msgSwap.TargetAsset.Equals(targetAsset) {
+			return false, nil
+		}
+		if accumulate {
+			streams = append(streams, newStreamingSwap(stream, msgSwap))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to paginate streaming swaps: %w", err)
 	}
-	return &types.QueryStreamingSwapsResponse{StreamingSwaps: streams}, nil
+	return &types.QueryStreamingSwapsResponse{StreamingSwaps: streams, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) querySwapperClout(ctx cosmos.Context, req *types.QuerySwapperCloutRequest) (*types.SwapperClout, error) {
@@ -1793,19 +1912,30 @@ func (qs queryServer) queryTradeAccounts(ctx cosmos.Context, req *types.QueryTra
 		return nil, fmt.Errorf("could not parse address: %w", err)
 	}
 
+	minUnits, err := parseMinFilter("min_units", req.MinUnits)
+	if err != nil {
+		return nil, err
+	}
+
 	accounts := make([]*types.QueryTradeAccountResponse, 0)
 	iter := qs.mgr.Keeper().GetTradeAccountIterator(ctx)
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iter, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var ta TradeAccount
-		if err := qs.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &ta); err != nil {
-			continue
+		if err := qs.mgr.Keeper().Cdc().Unmarshal(value, &ta); err != nil {
+			return false, nil
 		}
 		if !ta.Asset.Equals(asset) {
-			continue
+			return false, nil
 		}
-		if ta.Units.IsZero() {
-			continue
+		if ta.Units.IsZero() || ta.Units.LT(minUnits) {
+			return false, nil
+		}
+		if !hasAddressPrefix(ta.Owner.String(), req.AddressPrefix) {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
 		}
 		taResp := types.QueryTradeAccountResponse{
 			Asset:              ta.Asset.String(),
@@ -1815,9 +1945,13 @@ func (qs queryServer) queryTradeAccounts(ctx cosmos.Context, req *types.QueryTra
 			LastWithdrawHeight: ta.LastWithdrawHeight,
 		}
 		accounts = append(accounts, &taResp)
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to paginate trade accounts: %w", err)
 	}
 
-	return &types.QueryTradeAccountsResponse{TradeAccounts: accounts}, nil
+	return &types.QueryTradeAccountsResponse{TradeAccounts: accounts, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) queryTradeAccount(ctx cosmos.Context, req *types.QueryTradeAccountRequest) (*types.QueryTradeAccountsResponse, error) {
@@ -2293,10 +2427,11 @@ func (qs queryServer) queryKeygen(ctx cosmos.Context, req *types.QueryKeygenRequ
 			ctx.Logger().Error("fail to parse pubkey", "error", err)
 			return nil, fmt.Errorf("fail to parse pubkey: %w", err)
 		}
-		// only return those keygen contains the request pub key
+		// only return those keygen contains the request pub key, a reshare involves the
+		// members handing over their key shares as well
 		newKeygenBlock := NewKeygenBlock(keygenBlock.Height)
 		for _, keygen := range keygenBlock.Keygens {
-			if keygen.GetMembers().Contains(pk) {
+			if keygen.Involves(pk) {
 				newKeygenBlock.Keygens = append(newKeygenBlock.Keygens, keygen)
 			}
 		}
@@ -2935,6 +3070,33 @@ func (qs queryServer) queryOutboundFees(ctx cosmos.Context, asset string) (*type
 	return &types.QueryOutboundFeesResponse{OutboundFees: result}, nil
 }
 
+func (qs queryServer) queryDexAggregators(ctx cosmos.Context, chain string) (*types.QueryDexAggregatorsResponse, error) {
+	var filter common.Chain
+	if len(chain) > 0 {
+		var err error
+		filter, err = common.NewChain(chain)
+		if err != nil {
+			ctx.Logger().Error("fail to parse chain", "error", err, "chain", chain)
+			return nil, fmt.Errorf("fail to parse chain (%s): %w", chain, err)
+		}
+	}
+
+	aggs, err := qs.mgr.Keeper().GetDexAggregators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get dex aggregators: %w", err)
+	}
+
+	result := make([]types.DexAggregator, 0, len(aggs))
+	for _, agg := range aggs {
+		if !filter.IsEmpty() && !agg.Chain.Equals(filter) {
+			continue
+		}
+		result = append(result, agg)
+	}
+
+	return &types.QueryDexAggregatorsResponse{DexAggregators: result}, nil
+}
+
 func (qs queryServer) queryBan(ctx cosmos.Context, req *types.QueryBanRequest) (*types.BanVoter, error) {
 	if len(req.Address) == 0 {
 		return nil, errors.New("node address not available")
@@ -3031,6 +3193,103 @@ func (qs queryServer) querySwapQueue(ctx cosmos.Context, _ *types.QuerySwapQueue
 	return &types.QuerySwapQueueResponse{SwapQueue: result}, nil
 }
 
+func (qs queryServer) queryLimitSwapBook(ctx cosmos.Context, req *types.QueryLimitSwapBookRequest) (*types.QueryLimitSwapBookResponse, error) {
+	if len(req.SourceAsset) == 0 || len(req.TargetAsset) == 0 {
+		return nil, fmt.Errorf("source_asset and target_asset parameters are required")
+	}
+	source, err := common.NewAsset(req.SourceAsset)
+	if err != nil {
+		return nil, fmt.Errorf("bad source asset: %w", err)
+	}
+	target, err := common.NewAsset(req.TargetAsset)
+	if err != nil {
+		return nil, fmt.Errorf("bad target asset: %w", err)
+	}
+
+	levels := make([]*types.LimitSwapBookLevel, 0)
+	iter := qs.mgr.Keeper().GetAdvSwapQueueIndexIterator(ctx, LimitSwap, source, target)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// index keys end with the zero padded ratio: .../<source>><target>/<ratio>/
+		parts := strings.Split(string(iter.Key()), "/")
+		if len(parts) < 2 {
+			continue
+		}
+		ratio, err := strconv.ParseUint(parts[len(parts)-2], 10, 64)
+		if err != nil {
+			ctx.Logger().Error("fail to parse ratio", "key", string(iter.Key()), "error", err)
+			continue
+		}
+
+		value := ProtoStrings{}
+		if err := qs.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &value); err != nil {
+			ctx.Logger().Error("fail to fetch indexed txn hashes", "error", err)
+			continue
+		}
+
+		count := int64(0)
+		sourceAmount := cosmos.ZeroUint()
+		targetAmount := cosmos.ZeroUint()
+		for _, rec := range value.Value {
+			hash, err := common.NewTxID(rec)
+			if err != nil {
+				continue
+			}
+			msg, err := qs.mgr.Keeper().GetAdvSwapQueueItem(ctx, hash)
+			if err != nil || len(msg.Tx.Coins) == 0 {
+				continue
+			}
+			count++
+			sourceAmount = sourceAmount.Add(msg.Tx.Coins[0].Amount)
+			targetAmount = targetAmount.Add(msg.TradeTarget)
+		}
+		if count == 0 {
+			continue
+		}
+
+		levels = append(levels, &types.LimitSwapBookLevel{
+			Ratio:        strconv.FormatUint(ratio, 10),
+			Count:        count,
+			SourceAmount: sourceAmount.String(),
+			TargetAmount: targetAmount.String(),
+		})
+	}
+
+	return &types.QueryLimitSwapBookResponse{
+		SourceAsset: source.String(),
+		TargetAsset: target.String(),
+		Levels:      levels,
+	}, nil
+}
+
+func (qs queryServer) queryLimitSwapsByAddress(ctx cosmos.Context, req *types.QueryLimitSwapsByAddressRequest) (*types.QueryLimitSwapsByAddressResponse, error) {
+	if len(req.Address) == 0 {
+		return nil, fmt.Errorf("missing address parameter")
+	}
+	addr, err := common.NewAddress(req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("bad address: %w", err)
+	}
+
+	result := make([]*MsgSwap, 0)
+	iterator := qs.mgr.Keeper().GetAdvSwapQueueAddressIndexIterator(ctx, addr)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		hash, err := common.NewTxID(string(iterator.Value()))
+		if err != nil {
+			ctx.Logger().Error("fail to parse indexed limit swap hash", "key", string(iterator.Key()), "error", err)
+			continue
+		}
+		msg, err := qs.mgr.Keeper().GetAdvSwapQueueItem(ctx, hash)
+		if err != nil {
+			continue
+		}
+		result = append(result, &msg)
+	}
+
+	return &types.QueryLimitSwapsByAddressResponse{LimitSwaps: result}, nil
+}
+
 func (qs queryServer) queryTssKeygenMetric(ctx cosmos.Context, req *types.QueryTssKeygenMetricRequest) (*types.QueryTssKeygenMetricResponse, error) {
 	if len(req.PubKey) == 0 {
 		return nil, fmt.Errorf("missing pub_key parameter")
@@ -3447,18 +3706,59 @@ func dollarsPerSWITCHIgnoreHalt(ctx cosmos.Context, k keeper.Keeper) cosmos.Uint
 
 // querySWCYStakers
 func (qs queryServer) querySWCYStakers(ctx cosmos.Context, req *types.QuerySWCYStakersRequest) (*types.QuerySWCYStakersResponse, error) {
+	minAmount, err := parseMinFilter("min_amount", req.MinAmount)
+	if err != nil {
+		return nil, err
+	}
+	matches := func(staker SWCYStaker) bool {
+		return staker.Amount.GTE(minAmount) && hasAddressPrefix(staker.Address.String(), req.AddressPrefix)
+	}
+
 	var stakers []*types.QuerySWCYStakerResponse
-	swcyStakers, err := qs.mgr.Keeper().ListSWCYStakers(ctx)
+	var iter cosmos.Iterator = qs.mgr.Keeper().GetSWCYStakerIterator(ctx)
+	defer iter.Close()
+
+	// the swcy smart contract staker is not in the store, it is listed at the key it
+	// would be stored at so the cursor pages it like the other stakers
+	if staker, err := qs.swcySmartContractStaker(ctx); err == nil {
+		bz, err := qs.mgr.Keeper().Cdc().Marshal(&staker)
+		if err != nil {
+			return nil, fmt.Errorf("fail to marshal swcy smart contract staker: %w", err)
+		}
+		prefix, _ := iter.Domain()
+		key := qs.mgr.Keeper().GetKey(kvTypes.DbPrefix(prefix), staker.Address.String())
+		iter = newExtraRowIterator(iter, []byte(key), bz)
+	}
+
+	pageRes, err := paginate(ctx, qs.mgr.Keeper(), iter, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var staker SWCYStaker
+		if err := qs.mgr.Keeper().Cdc().Unmarshal(value, &staker); err != nil {
+			ctx.Logger().Error("fail to unmarshal swcy staker", "error", err)
+			return false, nil
+		}
+		if !matches(staker) {
+			return false, nil
+		}
+		if accumulate {
+			stakers = append(stakers, &types.QuerySWCYStakerResponse{
+				Address: staker.Address.String(),
+				Amount:  staker.Amount.String(),
+			})
+		}
+		return true, nil
+	})
 	if err != nil {
 		return &types.QuerySWCYStakersResponse{}, err
 	}
-	for _, staker := range swcyStakers {
-		stakers = append(stakers, &types.QuerySWCYStakerResponse{
-			Address: staker.Address.String(),
-			Amount:  staker.Amount.String(),
-		})
+	return &types.QuerySWCYStakersResponse{SwcyStakers: stakers, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) swcySmartContractStaker(ctx cosmos.Context) (SWCYStaker, error) {
+	address, err := swcysmartcontract.GetSWCYSmartContractAddress()
+	if err != nil {
+		return SWCYStaker{}, err
 	}
-	return &types.QuerySWCYStakersResponse{SwcyStakers: stakers}, nil
+	return qs.mgr.Keeper().GetSWCYStaker(ctx, address)
 }
 
 // querySWCYStaker
@@ -0,0 +1,134 @@
+package switchly
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/history"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
+)
+
+var errHistoryDisabled = errors.New("position history is not enabled on this node")
+
+// historyLimit returns the number of positions a history request may return
+func historyLimit(limit uint64) int {
+	if limit == 0 {
+		return defaultQueryPageLimit
+	}
+	if limit > maxQueryPageLimit {
+		return maxQueryPageLimit
+	}
+	return int(limit)
+}
+
+// parseHistoryRequest validates the pool asset and address of a history request
+func parseHistoryRequest(assetStr, addrStr string, saver bool) (common.Asset, common.Address, error) {
+	if len(assetStr) == 0 {
+		return common.EmptyAsset, common.NoAddress, errors.New("asset not provided")
+	}
+	if len(addrStr) == 0 {
+		return common.EmptyAsset, common.NoAddress, errors.New("lp not provided")
+	}
+	if saver {
+		assetStr = strings.Replace(assetStr, ".", "/", 1)
+	}
+	asset, err := common.NewAsset(assetStr)
+	if err != nil {
+		return common.EmptyAsset, common.NoAddress, fmt.Errorf("fail to parse asset: %w", err)
+	}
+	if asset.IsDerivedAsset() {
+		return common.EmptyAsset, common.NoAddress, fmt.Errorf("must not be a derived asset")
+	}
+	if saver && !asset.IsSyntheticAsset() {
+		return common.EmptyAsset, common.NoAddress, fmt.Errorf("invalid request: requested pool is not a SaversPool")
+	}
+	if !saver && asset.IsSyntheticAsset() {
+		return common.EmptyAsset, common.NoAddress, fmt.Errorf("invalid request: requested pool is a SaversPool")
+	}
+	addr, err := common.NewAddress(addrStr)
+	if err != nil {
+		return common.EmptyAsset, common.NoAddress, fmt.Errorf("fail to parse address: %w", err)
+	}
+	return asset, addr, nil
+}
+
+// queryLiquidityProviderHistory returns the recorded positions of a liquidity provider over a range of heights
+func (qs queryServer) queryLiquidityProviderHistory(ctx cosmos.Context, req *types.QueryLiquidityProviderHistoryRequest) (*types.QueryLiquidityProviderHistoryResponse, error) {
+	if qs.history == nil {
+		return nil, errHistoryDisabled
+	}
+	asset, addr, err := parseHistoryRequest(req.Asset, req.Address, false)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, next, err := qs.history.History(asset, addr, req.FromHeight, req.ToHeight, historyLimit(req.Limit))
+	if err != nil {
+		ctx.Logger().Error("fail to get liquidity provider history", "error", err)
+		return nil, fmt.Errorf("fail to get liquidity provider history: %w", err)
+	}
+
+	res := &types.QueryLiquidityProviderHistoryResponse{
+		Asset:      asset.String(),
+		Address:    addr.String(),
+		Positions:  make([]*types.LiquidityProviderPosition, 0, len(positions)),
+		NextHeight: next,
+	}
+	for _, pos := range positions {
+		res.Positions = append(res.Positions, &types.LiquidityProviderPosition{
+			Height:             pos.Height,
+			Units:              pos.Units.String(),
+			PoolUnits:          pos.PoolUnits.String(),
+			SwitchRedeemValue:  pos.SwitchRedeemValue.String(),
+			AssetRedeemValue:   pos.AssetRedeemValue.String(),
+			SwitchDepositValue: pos.SwitchDepositValue.String(),
+			AssetDepositValue:  pos.AssetDepositValue.String(),
+			AccruedFees:        pos.AccruedFees.String(),
+		})
+	}
+	return res, nil
+}
+
+// querySaverHistory returns the recorded positions of a saver over a range of heights
+func (qs queryServer) querySaverHistory(ctx cosmos.Context, req *types.QuerySaverHistoryRequest) (*types.QuerySaverHistoryResponse, error) {
+	if qs.history == nil {
+		return nil, errHistoryDisabled
+	}
+	asset, addr, err := parseHistoryRequest(req.Asset, req.Address, true)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, next, err := qs.history.History(asset, addr, req.FromHeight, req.ToHeight, historyLimit(req.Limit))
+	if err != nil {
+		ctx.Logger().Error("fail to get saver history", "error", err)
+		return nil, fmt.Errorf("fail to get saver history: %w", err)
+	}
+
+	res := &types.QuerySaverHistoryResponse{
+		Asset:      asset.GetLayer1Asset().String(),
+		Address:    addr.String(),
+		Positions:  make([]*types.SaverPosition, 0, len(positions)),
+		NextHeight: next,
+	}
+	for _, pos := range positions {
+		res.Positions = append(res.Positions, newSaverPosition(pos))
+	}
+	return res, nil
+}
+
+func newSaverPosition(pos history.Position) *types.SaverPosition {
+	accruedYield := new(big.Int).Sub(pos.AssetRedeemValue.BigInt(), pos.AssetDepositValue.BigInt())
+	return &types.SaverPosition{
+		Height:            pos.Height,
+		Units:             pos.Units.String(),
+		PoolUnits:         pos.PoolUnits.String(),
+		AssetDepositValue: pos.AssetDepositValue.String(),
+		AssetRedeemValue:  pos.AssetRedeemValue.String(),
+		AccruedYield:      accruedYield.String(),
+	}
+}
@@ -0,0 +1,184 @@
+package switchly
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
+)
+
+const (
+	// defaultQueryPageLimit is the page size used when a page request does not set a limit
+	defaultQueryPageLimit = 100
+	// maxQueryPageLimit caps the page size a single request may ask for
+	maxQueryPageLimit = 1000
+)
+
+// paginate walks a key-ordered store iterator and applies the cursor, offset and
+// limit of the page request, following the semantics of the cosmos-sdk
+// FilteredPaginate: onResult is called for every record from the cursor on and
+// returns whether the record matched the query filters; it must only add the
+// record to the results when accumulate is true. A nil page request returns every
+// matching record, which keeps the unpaginated queries unchanged. A cursor
+// re-opens the iterator's range at the cursor key, so later pages do not walk
+// the records before it.
+func paginate(ctx cosmos.Context, k keeper.Keeper, iter cosmos.Iterator, page *query.PageRequest, onResult func(key, value []byte, accumulate bool) (bool, error)) (*query.PageResponse, error) {
+	if page == nil {
+		for ; iter.Valid(); iter.Next() {
+			if _, err := onResult(iter.Key(), iter.Value(), true); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	if len(page.Key) > 0 && page.Offset > 0 {
+		return nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+	if page.Reverse {
+		return nil, errors.New("reverse pagination is not supported")
+	}
+
+	limit := page.Limit
+	if limit == 0 {
+		limit = defaultQueryPageLimit
+	}
+	if limit > maxQueryPageLimit {
+		limit = maxQueryPageLimit
+	}
+	// like the cosmos-sdk, totals are only counted for offset based requests
+	countTotal := page.CountTotal && len(page.Key) == 0
+
+	if len(page.Key) > 0 {
+		start, end := iter.Domain()
+		if bytes.Compare(page.Key, start) < 0 || (end != nil && bytes.Compare(page.Key, end) >= 0) {
+			return nil, errors.New("invalid request, pagination key is outside of the queried records")
+		}
+		if r, ok := iter.(rangeIterator); ok {
+			iter = r.Range(ctx, k, page.Key, end)
+		} else {
+			iter = k.GetRangeIterator(ctx, page.Key, end)
+		}
+		defer iter.Close()
+	}
+
+	var hits, total uint64
+	var nextKey []byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if hits == limit && nextKey == nil {
+			nextKey = bytes.Clone(key)
+			if !countTotal {
+				break
+			}
+		}
+
+		accumulate := nextKey == nil && total >= page.Offset
+		hit, err := onResult(key, iter.Value(), accumulate)
+		if err != nil {
+			return nil, err
+		}
+		if !hit {
+			continue
+		}
+		if accumulate {
+			hits++
+		}
+		total++
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = total
+	}
+	return res, nil
+}
+
+// rangeIterator is an iterator that re-opens itself from a cursor, for the iterators
+// that carry records the store range doesn't
+type rangeIterator interface {
+	Range(ctx cosmos.Context, k keeper.Keeper, start, end []byte) cosmos.Iterator
+}
+
+// extraRowIterator merges a record that isn't in the store into a store iterator at its
+// key, so paginate orders, counts and pages it like the stored records
+type extraRowIterator struct {
+	cosmos.Iterator
+	key, value []byte
+	// pending is true until the iterator moved past the extra record
+	pending bool
+}
+
+func newExtraRowIterator(iter cosmos.Iterator, key, value []byte) *extraRowIterator {
+	start, end := iter.Domain()
+	inDomain := bytes.Compare(key, start) >= 0 && (end == nil || bytes.Compare(key, end) < 0)
+	return &extraRowIterator{
+		Iterator: iter,
+		key:      key,
+		value:    value,
+		pending:  inDomain,
+	}
+}
+
+// atExtra returns true when the extra record is the current one
+func (it *extraRowIterator) atExtra() bool {
+	return it.pending && (!it.Iterator.Valid() || bytes.Compare(it.key, it.Iterator.Key()) <= 0)
+}
+
+func (it *extraRowIterator) Valid() bool {
+	return it.pending || it.Iterator.Valid()
+}
+
+func (it *extraRowIterator) Next() {
+	if it.atExtra() {
+		it.pending = false
+		// a stored record under the same key is shadowed by the extra one
+		if it.Iterator.Valid() && bytes.Equal(it.key, it.Iterator.Key()) {
+			it.Iterator.Next()
+		}
+		return
+	}
+	it.Iterator.Next()
+}
+
+func (it *extraRowIterator) Key() []byte {
+	if it.atExtra() {
+		return it.key
+	}
+	return it.Iterator.Key()
+}
+
+func (it *extraRowIterator) Value() []byte {
+	if it.atExtra() {
+		return it.value
+	}
+	return it.Iterator.Value()
+}
+
+func (it *extraRowIterator) Range(ctx cosmos.Context, k keeper.Keeper, start, end []byte) cosmos.Iterator {
+	return newExtraRowIterator(k.GetRangeIterator(ctx, start, end), it.key, it.value)
+}
+
+// hasAddressPrefix returns true if the address starts with the prefix, ignoring
+// case as store keys and some chains do not preserve it. An empty prefix matches
+// every address.
+func hasAddressPrefix(addr, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(addr), strings.ToLower(prefix))
+}
+
+// parseMinFilter parses an optional minimum amount filter, an empty value
+// returns zero so every record matches
+func parseMinFilter(name, value string) (cosmos.Uint, error) {
+	if len(value) == 0 {
+		return cosmos.ZeroUint(), nil
+	}
+	v, err := cosmos.ParseUint(value)
+	if err != nil {
+		return cosmos.ZeroUint(), fmt.Errorf("fail to parse %s: %w", name, err)
+	}
+	return v, nil
+}
@@ -3,6 +3,7 @@ package switchly
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"strconv"
 	"strings"
@@ -34,16 +35,27 @@ import (
 const (
 	quoteWarning         = "Do not cache this response. Do not send funds after the expiry."
 	quoteExpiration      = 15 * time.Minute
+	quoteSwapBatchLimit  = 50
 	ethBlockRewardAndFee = 3 * 1e18
 
 	dustLimitBtc  = 294
 	dustLimitLtc  = 2940
 	dustLimitDoge = 546
 	dustLimitBch  = 546
+
+	// the max number of streaming quantities evaluated per interval by the search
+	quoteStreamingCurvePoints = 24
+
+	// the max number of swaps simulated by the streaming searches of a batch of quotes,
+	// shared by its quotes, about four searches of every interval
+	quoteSwapBatchSimulationLimit = 500
 )
 
 var nullLogger = log.NewNopLogger()
 
+// the streaming intervals searched when the caller doesn't set one
+var quoteStreamingIntervals = []uint64{1, 2, 3, 5, 10}
+
 // -------------------------------------------------------------------------------------
 // Helpers
 // -------------------------------------------------------------------------------------
@@ -499,7 +511,148 @@ func calculateMinSwapAmount(ctx cosmos.Context, mgr *Mgrs, fromAsset, toAsset co
 	return minSwapAmount, nil
 }
 
+// quoteStreamingQuantities returns the quantities evaluated up to maxQuantity, denser
+// where the output changes the most.
+func quoteStreamingQuantities(maxQuantity uint64) []uint64 {
+	if maxQuantity <= quoteStreamingCurvePoints {
+		quantities := make([]uint64, maxQuantity)
+		for i := range quantities {
+			quantities[i] = uint64(i + 1)
+		}
+		return quantities
+	}
+
+	// geometric steps from 1 to the max quantity
+	var quantities []uint64
+	ratio := math.Pow(float64(maxQuantity), 1/float64(quoteStreamingCurvePoints-1))
+	for i := 0; i < quoteStreamingCurvePoints; i++ {
+		q := uint64(math.Round(math.Pow(ratio, float64(i))))
+		if len(quantities) > 0 && q <= quantities[len(quantities)-1] {
+			q = quantities[len(quantities)-1] + 1
+		}
+		if q >= maxQuantity {
+			break
+		}
+		quantities = append(quantities, q)
+	}
+	return append(quantities, maxQuantity)
+}
+
+// quoteStreamingMaxBlocks returns the longest a streaming swap between the assets can last
+func quoteStreamingMaxBlocks(ctx cosmos.Context, mgr *Mgrs, fromAsset, toAsset common.Asset) int64 {
+	if fromAsset.IsNative() && toAsset.IsNative() {
+		return mgr.Keeper().GetConfigInt64(ctx, constants.StreamingSwapMaxLengthNative)
+	}
+	return mgr.Keeper().GetConfigInt64(ctx, constants.StreamingSwapMaxLength)
+}
+
+// quoteStreamingSearch searches the streaming interval and quantity maximising the output
+// of the swap, among the streaming swaps lasting at most maxBlocks. Every candidate is
+// quoted with the simulation of its sub-swap the quote itself returns, so the chosen
+// configuration's output is the quoted ExpectedAmountOut before fees. Only the given
+// interval is searched if it is set. Ties go to the shortest swap. The curve is the
+// output of the quantities evaluated for the chosen interval. Each simulated swap is
+// taken from the budget if one is given, the search fails once it is spent.
+func quoteStreamingSearch(ctx cosmos.Context, mgr *Mgrs, msg *MsgSwap, amount sdkmath.Uint, interval uint64, maxBlocks int64, budget *int) (
+	bestInterval, bestQuantity uint64, curve []*types.StreamingQuotePoint, err error,
+) {
+	fromAsset, toAsset := msg.Tx.Coins[0].Asset, msg.TargetAsset
+
+	intervals := quoteStreamingIntervals
+	if interval > 0 {
+		intervals = []uint64{interval}
+	}
+
+	// the simulated output doesn't depend on the interval, quantities shared by several
+	// intervals are simulated once
+	type simulation struct {
+		emit        sdkmath.Uint
+		slippageBps int64
+		err         error
+	}
+	simulations := map[uint64]simulation{}
+	simulateQuantity := func(q uint64) (simulation, error) {
+		if sim, ok := simulations[q]; ok {
+			return sim, nil
+		}
+		if budget != nil {
+			if *budget <= 0 {
+				return simulation{}, fmt.Errorf("simulation limit of the batch reached, quote the swap on its own")
+			}
+			*budget--
+		}
+		sub := *msg
+		sub.Tx.Coins = common.Coins{common.NewCoin(fromAsset, amount)}
+		sub.TradeTarget = msg.TradeTarget.QuoUint64(q)
+		res, emit, _, err := quoteSimulateSwap(ctx, mgr, amount, &sub, q)
+		sim := simulation{emit: emit, err: err}
+		if err == nil {
+			sim.slippageBps = res.Fees.SlippageBps
+		}
+		simulations[q] = sim
+		return sim, nil
+	}
+
+	bestEmit := sdkmath.ZeroUint()
+	var bestBlocks int64
+	for _, iv := range intervals {
+		maxQuantity, err := getMaxSwapQuantity(ctx, mgr, fromAsset, toAsset, StreamingSwap{Interval: iv, Deposit: amount})
+		if err != nil {
+			return 0, 0, nil, fmt.Errorf("failed to calculate max streaming swap quantity: %w", err)
+		}
+		maxQuantity = min(maxQuantity, uint64(maxBlocks)/iv+1)
+		if maxQuantity == 0 {
+			continue
+		}
+
+		var ivCurve []*types.StreamingQuotePoint
+		improved := false
+		for _, q := range quoteStreamingQuantities(maxQuantity) {
+			sim, err := simulateQuantity(q)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			if sim.err != nil {
+				// smaller sub-swaps would fail as well
+				break
+			}
+			blocks := int64(iv) * int64(q-1)
+			ivCurve = append(ivCurve, &types.StreamingQuotePoint{
+				Interval:           int64(iv),
+				Quantity:           int64(q),
+				Blocks:             blocks,
+				ExpectedSwapOutput: sim.emit.String(),
+				SlippageBps:        sim.slippageBps,
+			})
+
+			if sim.emit.GT(bestEmit) || (sim.emit.Equal(bestEmit) && blocks < bestBlocks) {
+				bestEmit, bestBlocks = sim.emit, blocks
+				bestInterval, bestQuantity = iv, q
+				improved = true
+			}
+		}
+		if improved {
+			curve = ivCurve
+		}
+	}
+	if bestQuantity == 0 {
+		return 0, 0, nil, fmt.Errorf("amount too small to swap")
+	}
+
+	// a single sub-swap is a regular swap
+	if bestQuantity == 1 {
+		return 0, 0, curve, nil
+	}
+	return bestInterval, bestQuantity, curve, nil
+}
+
 func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSwapRequest) (*types.QueryQuoteSwapResponse, error) {
+	return qs.quoteSwap(ctx, req, nil)
+}
+
+// quoteSwap quotes the swap, the streaming search of the quote takes its simulated swaps
+// from the budget if one is given
+func (qs queryServer) quoteSwap(ctx cosmos.Context, req *types.QueryQuoteSwapRequest, budget *int) (*types.QueryQuoteSwapResponse, error) {
 	// validate required parameters
 	if len(req.FromAsset) == 0 {
 		return nil, fmt.Errorf("missing from_asset parameter")
@@ -554,6 +707,25 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 			return nil, fmt.Errorf("bad streaming quantity amount: %w", err)
 		}
 	}
+	// the streaming parameters maximising the output are searched once the swap is built,
+	// never past the longest streaming swap the chain accepts
+	var maxStreamingBlocks int64
+	if req.OptimizeStreaming {
+		if len(req.StreamingQuantity) > 0 {
+			return nil, fmt.Errorf("must not include streaming_quantity with optimize_streaming")
+		}
+		maxStreamingBlocks = quoteStreamingMaxBlocks(ctx, qs.mgr, fromAsset, toAsset)
+		if len(req.MaxStreamingSeconds) > 0 {
+			maxSeconds, err := strconv.ParseInt(req.MaxStreamingSeconds, 10, 64)
+			if err != nil || maxSeconds < 0 {
+				return nil, fmt.Errorf("bad max streaming seconds: %s", req.MaxStreamingSeconds)
+			}
+			maxStreamingBlocks = min(maxStreamingBlocks, maxSeconds*1000/common.SWITCHLYChain.ApproximateBlockMilliseconds())
+		}
+	} else if len(req.MaxStreamingSeconds) > 0 {
+		return nil, fmt.Errorf("max_streaming_seconds requires optimize_streaming")
+	}
+
 	swp := StreamingSwap{
 		Interval: streamingInterval,
 		Deposit:  amount,
@@ -738,6 +910,23 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 		AffiliateBasisPoints: cosmos.ZeroUint(),
 	}
 
+	// search the streaming parameters maximising the output
+	var streamingCurve []*types.StreamingQuotePoint
+	if req.OptimizeStreaming {
+		streamingInterval, streamingQuantity, streamingCurve, err = quoteStreamingSearch(ctx, qs.mgr, msg, amount, streamingInterval, maxStreamingBlocks, budget)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search streaming parameters: %w", err)
+		}
+		maxSwapQuantity, err = getMaxSwapQuantity(ctx, qs.mgr, fromAsset, toAsset, StreamingSwap{Interval: streamingInterval, Deposit: amount})
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate max streaming swap quantity: %w", err)
+		}
+		memo.StreamInterval = streamingInterval
+		memo.StreamQuantity = streamingQuantity
+		memoString = memo.String()
+		msg.Tx.Memo = memoString
+	}
+
 	// simulate the swap
 	res, emitAmount, outboundFeeAmount, err := quoteSimulateSwap(ctx, qs.mgr, amount, msg, 1)
 	if err != nil {
@@ -843,6 +1032,7 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 	}
 	res.StreamingSwapBlocks = streamSwapBlocks
 	res.StreamingSwapSeconds = streamSwapBlocks * common.SWITCHLYChain.ApproximateBlockMilliseconds() / 1000
+	res.StreamingCurve = streamingCurve
 
 	// estimate the inbound info
 	inboundAddress, routerAddress, inboundConfirmations, err := quoteInboundInfo(ctx, qs.mgr, amount, fromAsset.GetChain(), fromAsset)
@@ -999,6 +1189,53 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 	return res, nil
 }
 
+// -------------------------------------------------------------------------------------
+// Swap Batch
+// -------------------------------------------------------------------------------------
+
+// queryQuoteSwapBatch quotes every swap of the batch against the same pool snapshot, so
+// the quotes are consistent with each other. The snapshot caches the state read by the
+// first quotes for the following ones, and each quote runs in its own branch of it so the
+// simulated swap of one quote doesn't affect the others. The streaming searches of the
+// quotes share quoteSwapBatchSimulationLimit simulated swaps, the quotes searching past
+// it fail.
+func (qs queryServer) queryQuoteSwapBatch(ctx cosmos.Context, req *types.QueryQuoteSwapBatchRequest) (*types.QueryQuoteSwapBatchResponse, error) {
+	if len(req.Quotes) == 0 {
+		return nil, fmt.Errorf("missing quotes parameter")
+	}
+	if len(req.Quotes) > quoteSwapBatchLimit {
+		return nil, fmt.Errorf("too many quotes: %d, limit is %d", len(req.Quotes), quoteSwapBatchLimit)
+	}
+
+	snapshot := ctx.MultiStore().CacheMultiStore()
+	budget := quoteSwapBatchSimulationLimit
+	res := &types.QueryQuoteSwapBatchResponse{
+		Results: make([]*types.QuoteSwapBatchResult, len(req.Quotes)),
+	}
+	for i, quoteReq := range req.Quotes {
+		result := &types.QuoteSwapBatchResult{}
+		res.Results[i] = result
+
+		if quoteReq == nil {
+			result.Error = "missing quote"
+			continue
+		}
+		if len(quoteReq.Height) > 0 {
+			result.Error = "height must be set on the batch, not on the quote"
+			continue
+		}
+
+		quote, err := qs.quoteSwap(ctx.WithMultiStore(snapshot.CacheMultiStore()), quoteReq, &budget)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		result.Quote = quote
+	}
+
+	return res, nil
+}
+
 // -------------------------------------------------------------------------------------
 // Saver Deposit
 // -------------------------------------------------------------------------------------
@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/blang/semver"
+	dbm "github.com/cosmos/cosmos-db"
 
 	. "gopkg.in/check.v1"
 
@@ -15,13 +19,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	ckeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	types2 "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/switchlyprotocol/switchlynode/v3/app/params"
 	"github.com/switchlyprotocol/switchlynode/v3/cmd"
 	"github.com/switchlyprotocol/switchlynode/v3/common"
 	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+	"github.com/switchlyprotocol/switchlynode/v3/constants"
 	openapi "github.com/switchlyprotocol/switchlynode/v3/openapi/gen"
+	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/history"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper"
+	kvTypes "github.com/switchlyprotocol/switchlynode/v3/x/switchly/keeper/types"
 	"github.com/switchlyprotocol/switchlynode/v3/x/switchly/types"
 )
 
@@ -586,7 +594,160 @@ func (s *QuerierSuite) TestQueryLiquidityProviders(c *C) {
 	c.Assert(result, NotNil)
 	var savers LiquidityProviders
 	c.Assert(json.Unmarshal(result, &savers), IsNil)
-	c.Assert(lps, HasLen, 1)
+	c.Assert(savers, HasLen, 1)
+}
+
+func (s *QuerierSuite) TestQueryLiquidityProvidersPagination(c *C) {
+	for i := 1; i <= 5; i++ {
+		s.k.SetLiquidityProvider(s.ctx, LiquidityProvider{
+			Asset:         common.BTCAsset,
+			SwitchAddress: GetRandomSwitchAddress(),
+			AssetAddress:  GetRandomBTCAddress(),
+			LastAddHeight: 1024,
+			Units:         cosmos.NewUint(uint64(i * 10)),
+		})
+	}
+
+	// without a page request every liquidity provider is returned
+	resp, err := s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{Asset: "BTC.BTC"})
+	c.Assert(err, IsNil)
+	c.Check(resp.LiquidityProviders, HasLen, 5)
+	c.Check(resp.Pagination, IsNil)
+
+	// follow the cursor through all pages
+	seen := make(map[string]bool)
+	var key []byte
+	for pages := 1; ; pages++ {
+		resp, err = s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{
+			Asset:      "BTC.BTC",
+			Pagination: &query.PageRequest{Key: key, Limit: 2},
+		})
+		c.Assert(err, IsNil)
+		c.Assert(resp.Pagination, NotNil)
+		for _, lp := range resp.LiquidityProviders {
+			seen[lp.AssetAddress] = true
+		}
+		key = resp.Pagination.NextKey
+		if len(key) == 0 {
+			c.Check(pages, Equals, 3)
+			c.Check(resp.LiquidityProviders, HasLen, 1)
+			break
+		}
+		c.Check(resp.LiquidityProviders, HasLen, 2)
+	}
+	c.Check(seen, HasLen, 5)
+
+	// offset with total
+	resp, err = s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{
+		Asset:      "BTC.BTC",
+		Pagination: &query.PageRequest{Offset: 3, Limit: 10, CountTotal: true},
+	})
+	c.Assert(err, IsNil)
+	c.Check(resp.LiquidityProviders, HasLen, 2)
+	c.Check(resp.Pagination.Total, Equals, uint64(5))
+
+	// paginated responses carry the page metadata next to the records
+	result, err := resp.MarshalJSONPB(nil)
+	c.Assert(err, IsNil)
+	var paged openapi.PagedLiquidityProvidersResponse
+	c.Assert(json.Unmarshal(result, &paged), IsNil)
+	c.Check(paged.LiquidityProviders, HasLen, 2)
+	c.Check(paged.Pagination.GetTotal(), Equals, int64(5))
+
+	// filters
+	resp, err = s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{
+		Asset:    "BTC.BTC",
+		MinUnits: "30",
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp.LiquidityProviders, HasLen, 3)
+	for _, lp := range resp.LiquidityProviders {
+		c.Check(cosmos.NewUintFromString(lp.Units).GTE(cosmos.NewUint(30)), Equals, true)
+	}
+	resp, err = s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{
+		Asset:         "BTC.BTC",
+		AddressPrefix: strings.ToUpper(resp.LiquidityProviders[0].AssetAddress),
+	})
+	c.Assert(err, IsNil)
+	c.Check(resp.LiquidityProviders, HasLen, 1)
+
+	// invalid requests
+	_, err = s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{
+		Asset:      "BTC.BTC",
+		Pagination: &query.PageRequest{Key: []byte("lp/"), Offset: 1},
+	})
+	c.Check(err, NotNil)
+	_, err = s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{
+		Asset:      "BTC.BTC",
+		Pagination: &query.PageRequest{Key: []byte("node_account/"), Limit: 2},
+	})
+	c.Check(err, NotNil)
+	_, err = s.queryServer.LiquidityProviders(s.ctx, &types.QueryLiquidityProvidersRequest{
+		Asset:    "BTC.BTC",
+		MinUnits: "ten",
+	})
+	c.Check(err, NotNil)
+}
+
+func (s *QuerierSuite) TestPaginateExtraRow(c *C) {
+	for i := 1; i <= 4; i++ {
+		c.Assert(s.k.SetSWCYStaker(s.ctx, SWCYStaker{
+			Address: GetRandomSwitchAddress(),
+			Amount:  cosmos.NewUint(uint64(i * 10)),
+		}), IsNil)
+	}
+	// a record that isn't in the store, like the swcy smart contract staker
+	extra := SWCYStaker{Address: GetRandomSwitchAddress(), Amount: cosmos.NewUint(50)}
+	bz, err := s.k.Cdc().Marshal(&extra)
+	c.Assert(err, IsNil)
+	newIter := func() cosmos.Iterator {
+		iter := s.k.GetSWCYStakerIterator(s.ctx)
+		prefix, _ := iter.Domain()
+		return newExtraRowIterator(iter, []byte(s.k.GetKey(kvTypes.DbPrefix(prefix), extra.Address.String())), bz)
+	}
+	list := func(page *query.PageRequest) ([]string, *query.PageResponse) {
+		var addrs []string
+		iter := newIter()
+		defer iter.Close()
+		res, err := paginate(s.ctx, s.k, iter, page, func(_, value []byte, accumulate bool) (bool, error) {
+			var staker SWCYStaker
+			c.Assert(s.k.Cdc().Unmarshal(value, &staker), IsNil)
+			if accumulate {
+				addrs = append(addrs, staker.Address.String())
+			}
+			return true, nil
+		})
+		c.Assert(err, IsNil)
+		return addrs, res
+	}
+
+	// the extra record is listed with the stored ones
+	addrs, res := list(nil)
+	c.Check(addrs, HasLen, 5)
+	c.Check(res, IsNil)
+
+	// and paged exactly once wherever its key sorts
+	seen := make(map[string]int)
+	var key []byte
+	for pages := 1; ; pages++ {
+		addrs, res = list(&query.PageRequest{Key: key, Limit: 2})
+		for _, addr := range addrs {
+			seen[addr]++
+		}
+		key = res.NextKey
+		if len(key) == 0 {
+			c.Check(pages, Equals, 3)
+			break
+		}
+		c.Check(addrs, HasLen, 2)
+	}
+	c.Check(seen, HasLen, 5)
+	c.Check(seen[extra.Address.String()], Equals, 1)
+
+	// offsets count it too
+	addrs, res = list(&query.PageRequest{Offset: 4, Limit: 10, CountTotal: true})
+	c.Check(addrs, HasLen, 1)
+	c.Check(res.Total, Equals, uint64(5))
 }
 
 func (s *QuerierSuite) TestQueryTxInVoter(c *C) {
@@ -1371,6 +1532,214 @@ func (s *QuerierSuite) TestQuerySwap(c *C) {
 	c.Assert(len(queryPoolsResp.Vout), Equals, 0)
 }
 
+func (s *QuerierSuite) TestQuerySwapOptimizeStreaming(c *C) {
+	nodeAccount := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.mgr.Keeper().SetNodeAccount(s.ctx, nodeAccount), IsNil)
+
+	asgard := NewVault(
+		s.ctx.BlockHeight(),
+		ActiveVault,
+		AsgardVault,
+		GetRandomPubKey(),
+		common.Chains{common.BTCChain}.Strings(),
+		[]ChainContract{},
+	)
+	c.Assert(s.mgr.Keeper().SetVault(s.ctx, asgard), IsNil)
+
+	poolBTC := NewPool()
+	poolBTC.Asset = common.BTCAsset
+	poolBTC.BalanceAsset = cosmos.NewUint(1_000_000_000)
+	poolBTC.BalanceSwitch = cosmos.NewUint(10_000_000_000_000)
+	poolBTC.LPUnits = cosmos.NewUint(100)
+	c.Assert(s.mgr.Keeper().SetPool(s.ctx, poolBTC), IsNil)
+	s.mgr.Keeper().SetMimir(s.ctx, constants.L1SlipMinBps.String(), 5)
+
+	request := &types.QueryQuoteSwapRequest{
+		FromAsset:         common.BTCAsset.String(),
+		ToAsset:           common.SwitchNative.String(),
+		Amount:            "100000000",
+		OptimizeStreaming: true,
+	}
+
+	// the searched quantity can't be combined with a fixed one
+	request.StreamingQuantity = "5"
+	_, err := s.queryServer.QuoteSwap(s.ctx, request)
+	c.Assert(err, NotNil)
+	request.StreamingQuantity = ""
+
+	resp, err := s.queryServer.QuoteSwap(s.ctx, request)
+	c.Assert(err, IsNil)
+	c.Assert(len(resp.StreamingCurve) > 1, Equals, true)
+
+	// the chosen configuration is the best of the curve and the output never decreases
+	// with more sub-swaps
+	best := resp.StreamingCurve[0]
+	for i, point := range resp.StreamingCurve {
+		if i > 0 {
+			prev := resp.StreamingCurve[i-1]
+			c.Check(point.Quantity > prev.Quantity, Equals, true)
+			c.Check(cosmos.NewUintFromString(point.ExpectedSwapOutput).GTE(cosmos.NewUintFromString(prev.ExpectedSwapOutput)), Equals, true)
+			c.Check(point.SlippageBps <= prev.SlippageBps, Equals, true)
+		}
+		if cosmos.NewUintFromString(point.ExpectedSwapOutput).GT(cosmos.NewUintFromString(best.ExpectedSwapOutput)) {
+			best = point
+		}
+	}
+	c.Check(resp.StreamingSwapBlocks, Equals, best.Blocks)
+
+	// the estimate of the chosen configuration is the one the quote returns, and the same
+	// as quoting that configuration directly
+	outbound := cosmos.NewUintFromString(resp.Fees.Outbound)
+	c.Check(cosmos.NewUintFromString(best.ExpectedSwapOutput).Sub(outbound).String(), Equals, resp.ExpectedAmountOut)
+	fixed, err := s.queryServer.QuoteSwap(s.ctx, &types.QueryQuoteSwapRequest{
+		FromAsset:         request.FromAsset,
+		ToAsset:           request.ToAsset,
+		Amount:            request.Amount,
+		StreamingInterval: fmt.Sprintf("%d", best.Interval),
+		StreamingQuantity: fmt.Sprintf("%d", best.Quantity),
+	})
+	c.Assert(err, IsNil)
+	c.Check(fixed.ExpectedAmountOut, Equals, resp.ExpectedAmountOut)
+
+	// the search is bounded by the longest streaming swap the chain accepts
+	maxLength := s.mgr.Keeper().GetConfigInt64(s.ctx, constants.StreamingSwapMaxLength)
+	for _, point := range resp.StreamingCurve {
+		c.Check(point.Blocks <= maxLength, Equals, true)
+	}
+
+	// the duration limits the searched quantities
+	request.MaxStreamingSeconds = "30"
+	resp, err = s.queryServer.QuoteSwap(s.ctx, request)
+	c.Assert(err, IsNil)
+	maxBlocks := 30 * 1000 / common.SWITCHLYChain.ApproximateBlockMilliseconds()
+	c.Check(resp.StreamingSwapBlocks <= maxBlocks, Equals, true)
+	for _, point := range resp.StreamingCurve {
+		c.Check(point.Blocks <= maxBlocks, Equals, true)
+	}
+
+	// no time to stream, a regular swap is quoted
+	request.MaxStreamingSeconds = "0"
+	resp, err = s.queryServer.QuoteSwap(s.ctx, request)
+	c.Assert(err, IsNil)
+	c.Check(resp.StreamingSwapBlocks, Equals, int64(0))
+	c.Assert(resp.StreamingCurve, HasLen, 1)
+	c.Check(resp.StreamingCurve[0].Quantity, Equals, int64(1))
+
+	// the max duration only applies to the search
+	request.OptimizeStreaming = false
+	_, err = s.queryServer.QuoteSwap(s.ctx, request)
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQuerySwapBatch(c *C) {
+	nodeAccount := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.mgr.Keeper().SetNodeAccount(s.ctx, nodeAccount), IsNil)
+
+	asgard := NewVault(
+		s.ctx.BlockHeight(),
+		ActiveVault,
+		AsgardVault,
+		GetRandomPubKey(),
+		common.Chains{
+			common.BTCChain,
+			common.ETHChain,
+		}.Strings(),
+		[]ChainContract{},
+	)
+	c.Assert(s.mgr.Keeper().SetVault(s.ctx, asgard), IsNil)
+
+	poolBTC := NewPool()
+	poolBTC.Asset = common.BTCAsset
+	poolBTC.BalanceAsset = cosmos.NewUint(1_000_000_000)
+	poolBTC.BalanceSwitch = cosmos.NewUint(10_000_000_000_000)
+	poolBTC.LPUnits = cosmos.NewUint(100)
+	c.Assert(s.mgr.Keeper().SetPool(s.ctx, poolBTC), IsNil)
+
+	poolETH := NewPool()
+	poolETH.Asset = common.ETHAsset
+	poolETH.BalanceAsset = cosmos.NewUint(100_000_000_000)
+	poolETH.BalanceSwitch = cosmos.NewUint(10_000_000_000_000)
+	poolETH.LPUnits = cosmos.NewUint(100)
+	c.Assert(s.mgr.Keeper().SetPool(s.ctx, poolETH), IsNil)
+
+	btcToSwitch := &types.QueryQuoteSwapRequest{
+		FromAsset: common.BTCAsset.String(),
+		ToAsset:   common.SwitchNative.String(),
+		Amount:    "10000000",
+	}
+	ethToSwitch := &types.QueryQuoteSwapRequest{
+		FromAsset: common.ETHAsset.String(),
+		ToAsset:   common.SwitchNative.String(),
+		Amount:    "100000000",
+	}
+
+	// missing and oversized batches fail as a whole
+	_, err := s.queryServer.QuoteSwapBatch(s.ctx, &types.QueryQuoteSwapBatchRequest{})
+	c.Assert(err, NotNil)
+	quotes := make([]*types.QueryQuoteSwapRequest, quoteSwapBatchLimit+1)
+	for i := range quotes {
+		quotes[i] = btcToSwitch
+	}
+	_, err = s.queryServer.QuoteSwapBatch(s.ctx, &types.QueryQuoteSwapBatchRequest{Quotes: quotes})
+	c.Assert(err, NotNil)
+
+	resp, err := s.queryServer.QuoteSwapBatch(s.ctx, &types.QueryQuoteSwapBatchRequest{
+		Quotes: []*types.QueryQuoteSwapRequest{
+			btcToSwitch,
+			{FromAsset: common.BTCAsset.String(), ToAsset: common.ETHAsset.String()},
+			{FromAsset: common.BTCAsset.String(), ToAsset: common.ETHAsset.String(), Amount: "10000000", Height: "1"},
+			ethToSwitch,
+			btcToSwitch,
+		},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Results, HasLen, 5)
+
+	// failed entries don't fail the batch
+	c.Check(resp.Results[1].Quote, IsNil)
+	c.Check(resp.Results[1].Error, Equals, "missing Amount parameter")
+	c.Check(resp.Results[2].Quote, IsNil)
+	c.Check(resp.Results[2].Error, Equals, "height must be set on the batch, not on the quote")
+
+	// every quote is evaluated against the same pools, as a single quote would be
+	for _, i := range []int{0, 3, 4} {
+		c.Assert(resp.Results[i].Error, Equals, "")
+		c.Assert(resp.Results[i].Quote, NotNil)
+	}
+	single, err := s.queryServer.QuoteSwap(s.ctx, btcToSwitch)
+	c.Assert(err, IsNil)
+	c.Check(resp.Results[0].Quote.ExpectedAmountOut, Equals, single.ExpectedAmountOut)
+	c.Check(resp.Results[4].Quote.ExpectedAmountOut, Equals, single.ExpectedAmountOut)
+	single, err = s.queryServer.QuoteSwap(s.ctx, ethToSwitch)
+	c.Assert(err, IsNil)
+	c.Check(resp.Results[3].Quote.ExpectedAmountOut, Equals, single.ExpectedAmountOut)
+
+	// the simulated swaps are not written to the pools
+	pool, err := s.mgr.Keeper().GetPool(s.ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceAsset.Uint64(), Equals, poolBTC.BalanceAsset.Uint64())
+	c.Check(pool.BalanceSwitch.Uint64(), Equals, poolBTC.BalanceSwitch.Uint64())
+
+	// the streaming searches of the batch share a limit of simulated swaps, the quotes
+	// searching past it fail
+	s.mgr.Keeper().SetMimir(s.ctx, constants.L1SlipMinBps.String(), 5)
+	optimized := *btcToSwitch
+	optimized.Amount = "100000000"
+	optimized.OptimizeStreaming = true
+	single, err = s.queryServer.QuoteSwap(s.ctx, &optimized)
+	c.Assert(err, IsNil)
+	for i := range quotes {
+		quotes[i] = &optimized
+	}
+	resp, err = s.queryServer.QuoteSwapBatch(s.ctx, &types.QueryQuoteSwapBatchRequest{Quotes: quotes[:quoteSwapBatchLimit]})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Results[0].Quote, NotNil)
+	c.Check(resp.Results[0].Quote.ExpectedAmountOut, Equals, single.ExpectedAmountOut)
+	last := resp.Results[quoteSwapBatchLimit-1]
+	c.Check(last.Quote, IsNil)
+	c.Check(strings.Contains(last.Error, "simulation limit of the batch reached"), Equals, true, Commentf("%s", last.Error))
+}
+
 func (s *QuerierSuite) TestQueryCodes(c *C) {
 	result, err := s.queryServer.Codes(s.ctx, &types.QueryCodesRequest{})
 
@@ -1436,3 +1805,113 @@ func (s *QuerierSuite) TestNetwork(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(resp.SwitchlyPriceHalted, Equals, false)
 }
+
+func (s *QuerierSuite) TestQueryLimitSwaps(c *C) {
+	owner := GetRandomBTCAddress()
+	other := GetRandomBTCAddress()
+	limitSwap := func(from common.Address, amount, target uint64) MsgSwap {
+		tx := GetRandomTx()
+		tx.Chain = common.BTCChain
+		tx.FromAddress = from
+		tx.Coins = common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(amount))}
+		return *NewMsgSwap(tx, common.SwitchNative, GetRandomSwitchAddress(), cosmos.NewUint(target),
+			common.NoAddress, cosmos.ZeroUint(), "", "", nil, LimitSwap, 0, 0, GetRandomBech32Addr())
+	}
+	// two orders at the same ratio, one at a higher ratio
+	for _, msg := range []MsgSwap{
+		limitSwap(owner, common.One, 200*common.One),
+		limitSwap(owner, 2*common.One, 400*common.One),
+		limitSwap(other, common.One, 100*common.One),
+	} {
+		c.Assert(s.mgr.Keeper().SetAdvSwapQueueItem(s.ctx, msg), IsNil)
+	}
+
+	book, err := s.queryServer.LimitSwapBook(s.ctx, &types.QueryLimitSwapBookRequest{
+		SourceAsset: "BTC.BTC",
+		TargetAsset: "SWITCH",
+	})
+	c.Assert(err, IsNil)
+	c.Check(book.SourceAsset, Equals, common.BTCAsset.String())
+	c.Check(book.TargetAsset, Equals, common.SwitchNative.String())
+	c.Assert(book.Levels, HasLen, 2)
+	c.Check(book.Levels[0].Ratio, Equals, "1000000")
+	c.Check(book.Levels[0].Count, Equals, int64(1))
+	c.Check(book.Levels[1].Ratio, Equals, "500000")
+	c.Check(book.Levels[1].Count, Equals, int64(2))
+	c.Check(book.Levels[1].SourceAmount, Equals, cosmos.NewUint(3*common.One).String())
+	c.Check(book.Levels[1].TargetAmount, Equals, cosmos.NewUint(600*common.One).String())
+
+	// no orders in the opposite direction
+	book, err = s.queryServer.LimitSwapBook(s.ctx, &types.QueryLimitSwapBookRequest{
+		SourceAsset: "SWITCH",
+		TargetAsset: "BTC.BTC",
+	})
+	c.Assert(err, IsNil)
+	c.Check(book.Levels, HasLen, 0)
+
+	_, err = s.queryServer.LimitSwapBook(s.ctx, &types.QueryLimitSwapBookRequest{SourceAsset: "BTC.BTC"})
+	c.Assert(err, NotNil)
+
+	orders, err := s.queryServer.LimitSwapsByAddress(s.ctx, &types.QueryLimitSwapsByAddressRequest{
+		Address: owner.String(),
+	})
+	c.Assert(err, IsNil)
+	c.Assert(orders.LimitSwaps, HasLen, 2)
+	for _, msg := range orders.LimitSwaps {
+		c.Check(msg.Tx.FromAddress.Equals(owner), Equals, true)
+	}
+
+	orders, err = s.queryServer.LimitSwapsByAddress(s.ctx, &types.QueryLimitSwapsByAddressRequest{
+		Address: GetRandomBTCAddress().String(),
+	})
+	c.Assert(err, IsNil)
+	c.Check(orders.LimitSwaps, HasLen, 0)
+}
+
+func (s *QuerierSuite) TestQueryPositionHistory(c *C) {
+	addr := GetRandomBTCAddress().String()
+
+	_, err := s.queryServer.LiquidityProviderHistory(s.ctx, &types.QueryLiquidityProviderHistoryRequest{
+		Asset:   "BTC.BTC",
+		Address: addr,
+	})
+	c.Assert(err, ErrorMatches, "position history is not enabled on this node")
+	_, err = s.queryServer.SaverHistory(s.ctx, &types.QuerySaverHistoryRequest{
+		Asset:   "BTC.BTC",
+		Address: addr,
+	})
+	c.Assert(err, ErrorMatches, "position history is not enabled on this node")
+
+	idx := history.NewIndexer(dbm.NewMemDB(), storetypes.NewKVStoreKey(types.StoreKey), 0, log.NewNopLogger())
+	qs := newQueryServer(s.mgr, nil, s.kb, idx)
+
+	lpHistory, err := qs.LiquidityProviderHistory(s.ctx, &types.QueryLiquidityProviderHistoryRequest{
+		Asset:   "BTC.BTC",
+		Address: addr,
+	})
+	c.Assert(err, IsNil)
+	c.Check(lpHistory.Asset, Equals, "BTC.BTC")
+	c.Check(lpHistory.Positions, HasLen, 0)
+
+	saverHistory, err := qs.SaverHistory(s.ctx, &types.QuerySaverHistoryRequest{
+		Asset:   "BTC.BTC",
+		Address: addr,
+	})
+	c.Assert(err, IsNil)
+	c.Check(saverHistory.Asset, Equals, "BTC.BTC")
+	c.Check(saverHistory.Positions, HasLen, 0)
+
+	// savers pools are only served by the saver history
+	_, err = qs.LiquidityProviderHistory(s.ctx, &types.QueryLiquidityProviderHistoryRequest{
+		Asset:   "BTC/BTC",
+		Address: addr,
+	})
+	c.Assert(err, NotNil)
+	_, err = qs.LiquidityProviderHistory(s.ctx, &types.QueryLiquidityProviderHistoryRequest{
+		Asset:      "BTC.BTC",
+		Address:    addr,
+		FromHeight: 10,
+		ToHeight:   5,
+	})
+	c.Assert(err, NotNil)
+}
@@ -72,9 +72,13 @@ func (s *SwapperVCUR) Swap(ctx cosmos.Context,
 		}
 	}
 
-	if !destination.IsNoop() && !destination.IsChain(target.GetChain()) {
+	if !destination.IsNoop() && !destination.IsChain(target.GetChain()) && !destination.IsIBCChain(target.GetChain()) {
 		return cosmos.ZeroUint(), swapEvents, fmt.Errorf("destination address is not a valid %s address", target.GetChain())
 	}
+	// only the channels allowed by mimir pay accounts of other cosmos zones
+	if destination.IsIBCChain(target.GetChain()) && !isIBCChannelEnabled(ctx, mgr.Keeper(), target.GetChain(), destination) {
+		return cosmos.ZeroUint(), swapEvents, fmt.Errorf("destination ibc channel is not enabled on %s", target.GetChain())
+	}
 	if source.Equals(target) {
 		return cosmos.ZeroUint(), swapEvents, fmt.Errorf("cannot swap from %s --> %s, assets match", source, target)
 	}
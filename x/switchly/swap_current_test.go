@@ -582,6 +582,42 @@ func (s *SwapVCURSuite) TestSynthSwap_AssetSynth(c *C) {
 	// three inputs to the calculation.
 }
 
+func (s *SwapVCURSuite) TestSwapIBCDestination(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	k := mgr.Keeper()
+
+	pool := NewPool()
+	pool.Asset = common.ATOMAsset
+	pool.BalanceAsset = cosmos.NewUint(1024 * common.One)
+	pool.BalanceSwitch = cosmos.NewUint(1024 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	destination := common.Address("channel-141-osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	swap := func() error {
+		tx := common.NewTx(GetRandomTxHash(), GetRandomSWITCHLYAddress(), GetRandomSWITCHLYAddress(),
+			common.Coins{common.NewCoin(common.SwitchNative, cosmos.NewUint(common.One))},
+			common.Gas{common.NewCoin(common.SwitchNative, cosmos.NewUint(2_000_000))},
+			"",
+		)
+		_, _, err := newSwapperVCUR().Swap(ctx, k, tx, common.ATOMAsset, destination, cosmos.ZeroUint(), "", "", nil, StreamingSwap{}, 20_000, mgr)
+		return err
+	}
+
+	// channels aren't paid over until mimir allows them
+	err := swap()
+	c.Assert(err, NotNil)
+	c.Check(err.Error(), Equals, "destination ibc channel is not enabled on GAIA")
+
+	k.SetMimir(ctx, "IBCChannel-GAIA-channel-141", 1)
+	c.Assert(swap(), IsNil)
+
+	// other channels of the chain stay disabled
+	destination = common.Address("channel-0-osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+	c.Assert(swap(), NotNil)
+}
+
 func (s *SwapVCURSuite) TestSwap_GetSwapCalc(c *C) {
 	swapper := newSwapperVCUR()
 	inputs := []struct {
@@ -42,7 +42,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgErrataTx{}, ModuleName+"/MsgErrataTx", nil)
 	cdc.RegisterConcrete(&MsgErrataTxQuorum{}, ModuleName+"/MsgErrataTxQuorum", nil)
 	cdc.RegisterConcrete(&MsgBan{}, ModuleName+"/MsgBan", nil)
+	cdc.RegisterConcrete(&MsgEdDSABackfill{}, ModuleName+"/MsgEdDSABackfill", nil)
 	cdc.RegisterConcrete(&MsgMimir{}, ModuleName+"/MsgMimir", nil)
+	cdc.RegisterConcrete(&MsgUpdateAggregator{}, ModuleName+"/MsgUpdateAggregator", nil)
 	cdc.RegisterConcrete(&MsgDeposit{}, ModuleName+"/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgNetworkFee{}, ModuleName+"/MsgNetworkFee", nil)
 	cdc.RegisterConcrete(&MsgNetworkFeeQuorum{}, ModuleName+"/MsgNetworkFeeQuorum", nil)
@@ -61,6 +63,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSWCYClaim{}, ModuleName+"/MsgSWCYClaim", nil)
 	cdc.RegisterConcrete(&MsgSWCYStake{}, ModuleName+"/MsgSWCYStake", nil)
 	cdc.RegisterConcrete(&MsgSWCYUnstake{}, ModuleName+"/MsgSWCYUnstake", nil)
+	cdc.RegisterConcrete(&MsgModifyLimitSwap{}, ModuleName+"/MsgModifyLimitSwap", nil)
 }
 
 // RegisterInterfaces register the types
@@ -91,7 +94,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgErrataTx{},
 		&MsgErrataTxQuorum{},
 		&MsgBan{},
+		&MsgEdDSABackfill{},
 		&MsgMimir{},
+		&MsgUpdateAggregator{},
 		&MsgDeposit{},
 		&MsgNetworkFee{},
 		&MsgNetworkFeeQuorum{},
@@ -110,6 +115,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSWCYClaim{},
 		&MsgSWCYStake{},
 		&MsgSWCYUnstake{},
+		&MsgModifyLimitSwap{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -118,6 +124,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 func DefineCustomGetSigners(signingOptions *signing.Options) {
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgBan"), MsgBanCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgDeposit"), MsgDepositCustomGetSigners)
+	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgEdDSABackfill"), MsgEdDSABackfillCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgErrataTx"), MsgErrataCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgErrataTxQuorum"), MsgErrataTxQuorumCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgMimir"), MsgMimirCustomGetSigners)
@@ -134,6 +141,7 @@ func DefineCustomGetSigners(signingOptions *signing.Options) {
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgSolvencyQuorum"), MsgSolvencyQuorumCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgTssKeysignFail"), MsgTssKeysignFailCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgTssPool"), MsgTssPoolCustomGetSigners)
+	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgUpdateAggregator"), MsgUpdateAggregatorCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgSetVersion"), MsgSetVersionCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgProposeUpgrade"), MsgProposeUpgradeCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgApproveUpgrade"), MsgApproveUpgradeCustomGetSigners)
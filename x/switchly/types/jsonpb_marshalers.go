@@ -1,11 +1,13 @@
 package types
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/gogoproto/jsonpb"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
@@ -28,11 +30,13 @@ var (
 	_ jsonpb.JSONPBMarshaler = &QueryConstantValuesResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryDerivedPoolResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryDerivedPoolsResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryDexAggregatorsResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryInboundAddressesResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryKeygenResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryKeysignResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryLastBlocksResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryLiquidityProviderResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryLiquidityProviderHistoryResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryLiquidityProvidersResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryMimirAdminValuesResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryMimirNodesAllValuesResponse{}
@@ -53,15 +57,19 @@ var (
 	_ jsonpb.JSONPBMarshaler = &QueryQuoteSaverDepositResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryQuoteSaverWithdrawResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryQuoteSwapResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryQuoteSwapBatchResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryRagnarokResponse{}
 	_ jsonpb.JSONPBMarshaler = &QuerySWITCHProviderResponse{}
 	_ jsonpb.JSONPBMarshaler = &QuerySWITCHProvidersResponse{}
 	_ jsonpb.JSONPBMarshaler = &QuerySaverResponse{}
+	_ jsonpb.JSONPBMarshaler = &QuerySaverHistoryResponse{}
 	_ jsonpb.JSONPBMarshaler = &QuerySaversResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryStreamingSwapResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryStreamingSwapsResponse{}
 	_ jsonpb.JSONPBMarshaler = &SwapperClout{}
 	_ jsonpb.JSONPBMarshaler = &QuerySwapQueueResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryLimitSwapBookResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryLimitSwapsByAddressResponse{}
 	_ jsonpb.JSONPBMarshaler = &QuerySwitchlynameResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryTradeAccountsResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryTradeUnitsResponse{}
@@ -95,6 +103,20 @@ func jsonify(r any) ([]byte, error) {
 	return res, nil
 }
 
+// jsonifyPage marshals a paginated list query. Without a page request the bare
+// list is returned, which keeps the unpaginated responses unchanged, otherwise
+// the list is returned under the given field along with the page metadata.
+func jsonifyPage(field string, list any, page *query.PageResponse) ([]byte, error) {
+	if page == nil {
+		return jsonify(list)
+	}
+	res := openapi.PageResponse{
+		NextKey: wrapString(base64.StdEncoding.EncodeToString(page.NextKey)),
+		Total:   wrapInt64(int64(page.Total)),
+	}
+	return jsonify(map[string]any{field: list, "pagination": res})
+}
+
 func (m *QueryAsgardVaultsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
 	return jsonify(m.AsgardVaults)
 }
@@ -115,7 +137,7 @@ func (m *QueryBorrowerResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, erro
 }
 
 func (m *QueryBorrowersResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
-	return jsonify(m.Borrowers)
+	return jsonifyPage("borrowers", m.Borrowers, m.Pagination)
 }
 
 func (m *QueryConstantValuesResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
@@ -155,6 +177,10 @@ func (m *QueryDerivedPoolsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte,
 	return jsonify(m.Pools)
 }
 
+func (m *QueryDexAggregatorsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m.DexAggregators)
+}
+
 func (m *QueryInboundAddressesResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
 	return jsonify(m.InboundAddresses)
 }
@@ -190,8 +216,12 @@ func (m *QueryLiquidityProviderResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]b
 	return jsonify(m)
 }
 
+func (m *QueryLiquidityProviderHistoryResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m)
+}
+
 func (m *QueryLiquidityProvidersResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
-	return jsonify(m.LiquidityProviders)
+	return jsonifyPage("liquidity_providers", m.LiquidityProviders, m.Pagination)
 }
 
 func (m *QueryMimirAdminValuesResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
@@ -245,7 +275,7 @@ func (m *QueryNodeResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
 }
 
 func (m *QueryNodesResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
-	return jsonify(m.Nodes)
+	return jsonifyPage("nodes", m.Nodes, m.Pagination)
 }
 
 // ObservedTxVoter
@@ -319,6 +349,11 @@ func (m *QueryQuoteSwapResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, err
 	return jsonify(m)
 }
 
+// QueryQuoteSwapBatchResponse
+func (m *QueryQuoteSwapBatchResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m)
+}
+
 func (m *QueryRagnarokResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
 	return jsonify(m.InProgress)
 }
@@ -335,7 +370,7 @@ func (m *QuerySWITCHProviderResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte
 }
 
 func (m *QuerySWITCHProvidersResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
-	return jsonify(m.Providers)
+	return jsonifyPage("providers", m.Providers, m.Pagination)
 }
 
 // QuerySaverResponse
@@ -343,8 +378,12 @@ func (m *QuerySaverResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error)
 	return jsonify(m)
 }
 
+func (m *QuerySaverHistoryResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m)
+}
+
 func (m *QuerySaversResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
-	return jsonify(m.Savers)
+	return jsonifyPage("savers", m.Savers, m.Pagination)
 }
 
 // QueryStreamingSwapResponse
@@ -353,7 +392,7 @@ func (m *QueryStreamingSwapResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte,
 }
 
 func (m *QueryStreamingSwapsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
-	return jsonify(m.StreamingSwaps)
+	return jsonifyPage("streaming_swaps", m.StreamingSwaps, m.Pagination)
 }
 
 // SwapperClout
@@ -363,8 +402,22 @@ func (m *SwapperClout) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
 
 // Use openapi type since its swaptype is a string (i.e. "market", "limit") and not the int representation ("0", "1")
 func (m *QuerySwapQueueResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(castMsgSwaps(m.SwapQueue))
+}
+
+// QueryLimitSwapBookResponse
+func (m *QueryLimitSwapBookResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m)
+}
+
+// QueryLimitSwapsByAddressResponse
+func (m *QueryLimitSwapsByAddressResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(castMsgSwaps(m.LimitSwaps))
+}
+
+func castMsgSwaps(msgs []*MsgSwap) []openapi.MsgSwap {
 	result := make([]openapi.MsgSwap, 0)
-	for _, msg := range m.SwapQueue {
+	for _, msg := range msgs {
 		// Only display the SwapType if it is "limit", not if "market".
 		var swapType *string
 		if msg.SwapType != SwapType_market {
@@ -389,7 +442,7 @@ func (m *QuerySwapQueueResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, err
 			StreamInterval:          wrapInt64(int64(msg.StreamInterval)),
 		})
 	}
-	return jsonify(result)
+	return result
 }
 
 // QuerySwitchlynameResponse
@@ -398,7 +451,7 @@ func (m *QuerySwitchlynameResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte,
 }
 
 func (m *QueryTradeAccountsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
-	return jsonify(m.TradeAccounts)
+	return jsonifyPage("trade_accounts", m.TradeAccounts, m.Pagination)
 }
 
 // QueryTradeUnitResponse
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/switchlyprotocol/switchlynode/v3/api/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+var (
+	_ sdk.Msg              = &MsgEdDSABackfill{}
+	_ sdk.HasValidateBasic = &MsgEdDSABackfill{}
+	_ sdk.LegacyMsg        = &MsgEdDSABackfill{}
+)
+
+// NewMsgEdDSABackfill is a constructor function for MsgEdDSABackfill
+func NewMsgEdDSABackfill(poolPubKey common.PubKey, signer cosmos.AccAddress) *MsgEdDSABackfill {
+	return &MsgEdDSABackfill{
+		PoolPubKey: poolPubKey,
+		Signer:     signer,
+	}
+}
+
+// ValidateBasic implements HasValidateBasic
+// ValidateBasic is now ran in the message service router for messages that
+// used to be routed using the external handler and only when HasValidateBasic is implemented.
+// No versioning is used there.
+func (m *MsgEdDSABackfill) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.PoolPubKey.IsEmpty() {
+		return cosmos.ErrUnknownRequest("pool pubkey cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners return all the signer who signed this message
+// Implements LegacyMsg.
+func (m *MsgEdDSABackfill) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+func MsgEdDSABackfillCustomGetSigners(m proto.Message) ([][]byte, error) {
+	msg, ok := m.(*types.MsgEdDSABackfill)
+	if !ok {
+		return nil, fmt.Errorf("can't cast as MsgEdDSABackfill: %T", m)
+	}
+	return [][]byte{msg.Signer}, nil
+}
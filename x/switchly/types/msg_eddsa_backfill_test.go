@@ -0,0 +1,34 @@
+package types
+
+import (
+	"errors"
+
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+type MsgEdDSABackfillSuite struct{}
+
+var _ = Suite(&MsgEdDSABackfillSuite{})
+
+func (MsgEdDSABackfillSuite) TestMsgEdDSABackfill(c *C) {
+	pk := GetRandomPubKey()
+	signer := GetRandomBech32Addr()
+	msg := NewMsgEdDSABackfill(pk, signer)
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(msg.GetSigners(), NotNil)
+	c.Assert(msg.GetSigners()[0].String(), Equals, signer.String())
+
+	msg1 := NewMsgEdDSABackfill(pk, cosmos.AccAddress{})
+	err1 := msg1.ValidateBasic()
+	c.Assert(err1, NotNil)
+	c.Assert(errors.Is(err1, se.ErrInvalidAddress), Equals, true)
+
+	msg2 := NewMsgEdDSABackfill(common.EmptyPubKey, signer)
+	err2 := msg2.ValidateBasic()
+	c.Assert(err2, NotNil)
+	c.Assert(errors.Is(err2, se.ErrUnknownRequest), Equals, true)
+}
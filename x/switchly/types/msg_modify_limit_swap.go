@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+var (
+	_ sdk.Msg              = &MsgModifyLimitSwap{}
+	_ sdk.HasValidateBasic = &MsgModifyLimitSwap{}
+	_ sdk.LegacyMsg        = &MsgModifyLimitSwap{}
+)
+
+// NewMsgModifyLimitSwap is a constructor function for MsgModifyLimitSwap. A
+// zero modified target amount cancels the limit swap.
+func NewMsgModifyLimitSwap(tx common.Tx, swapTxID common.TxID, modifiedTargetAmount cosmos.Uint, signer cosmos.AccAddress) *MsgModifyLimitSwap {
+	return &MsgModifyLimitSwap{
+		Tx:                   tx,
+		SwapTxID:             swapTxID,
+		ModifiedTargetAmount: modifiedTargetAmount,
+		Signer:               signer,
+	}
+}
+
+// IsCancel returns true when the message cancels the limit swap instead of
+// modifying its trade target
+func (m *MsgModifyLimitSwap) IsCancel() bool {
+	return m.ModifiedTargetAmount.IsZero()
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgModifyLimitSwap) ValidateBasic() error {
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	if m.Tx.FromAddress.IsEmpty() {
+		return cosmos.ErrInvalidAddress("from address cannot be empty")
+	}
+	if m.SwapTxID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("swap tx id cannot be empty")
+	}
+	if m.SwapTxID.Equals(m.Tx.ID) {
+		return cosmos.ErrUnknownRequest("limit swap cannot modify itself")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgModifyLimitSwap) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
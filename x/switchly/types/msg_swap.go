@@ -83,7 +83,8 @@ func (m *MsgSwap) ValidateBasic() error {
 	if !m.AffiliateBasisPoints.IsZero() && m.AffiliateBasisPoints.GT(cosmos.NewUint(MaxAffiliateFeeBasisPoints)) {
 		return cosmos.ErrUnknownRequest(fmt.Sprintf("affiliate fee basis points can't be more than %d", MaxAffiliateFeeBasisPoints))
 	}
-	if !m.Destination.IsNoop() && !m.Destination.IsChain(m.TargetAsset.GetChain()) {
+	// cosmos zones can also pay accounts of other zones over IBC, the swapper checks mimir allows the channel
+	if !m.Destination.IsNoop() && !m.Destination.IsChain(m.TargetAsset.GetChain()) && !m.Destination.IsIBCChain(m.TargetAsset.GetChain()) {
 		return cosmos.ErrUnknownRequest("swap destination address is not the same chain as the target asset")
 	}
 	if !m.AffiliateAddress.IsEmpty() && !m.AffiliateAddress.IsChain(common.SWITCHLYChain) {
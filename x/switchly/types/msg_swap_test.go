@@ -236,3 +236,30 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 		}
 	}
 }
+
+func (MsgSwapSuite) TestMsgSwapIBCDestination(c *C) {
+	signer := GetRandomBech32Addr()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomETHAddress(),
+		GetRandomETHAddress(),
+		common.Coins{
+			common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)),
+		},
+		common.Gas{common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One))},
+		"SWAP:GAIA.ATOM",
+	)
+	osmo := common.Address("channel-141-osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s")
+
+	// gaia pays the osmosis account over IBC
+	m := NewMsgSwap(tx, common.ATOMAsset, osmo, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, 0, 0, signer)
+	c.Check(m.ValidateBasic(), IsNil)
+
+	// the channel to pay it over is required
+	m = NewMsgSwap(tx, common.ATOMAsset, common.Address("osmo10tjz4ave7znpctgd2rfu6v2r6zkeup2dhqnm2s"), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, 0, 0, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// other chains can't
+	m = NewMsgSwap(tx, common.BTCAsset, osmo, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, 0, 0, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+}
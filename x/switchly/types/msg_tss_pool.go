@@ -35,8 +35,8 @@ var (
 
 // NewMsgTssPool is a constructor function for MsgTssPool
 // NewMsgTssPool builds a MsgTssPool. ed25519pk is an optional (variadic) trailing arg carrying the
-// EdDSA group key for the Stellar vault; when present it is folded into the TSS id so keygen consensus
-// requires agreement on it. Existing callers that pass no ed25519 key are unaffected (id unchanged).
+// EdDSA group key for the Stellar vault. It is not part of the TSS id, so existing callers that pass
+// no ed25519 key are unaffected (id unchanged).
 func NewMsgTssPool(pks []string, poolpk common.PubKey, secp256k1Signature, keysharesBackup []byte, keygenType KeygenType, height int64, bl Blame, chains []string, signer cosmos.AccAddress, keygenTime int64, ed25519pk ...common.PubKey) (*MsgTssPool, error) {
 	var edpk common.PubKey
 	if len(ed25519pk) > 0 {
@@ -44,9 +44,8 @@ func NewMsgTssPool(pks []string, poolpk common.PubKey, secp256k1Signature, keysh
 	}
 	// NOTE: ed25519 is intentionally NOT part of the TSS id. The EdDSA keygen is a separate ceremony
 	// that can independently fail on some members (who then report a placeholder), so folding it into
-	// the id would diverge the ids and prevent the vault from ever reaching keygen consensus. The vault
-	// takes its ed25519 key from the consensus-triggering message; consensus-hardening it (a voter-side
-	// majority tally of the reported ed25519 keys) is a follow-up — see docs §9.2.
+	// the id would diverge the ids and prevent the vault from ever reaching keygen consensus. Instead
+	// the TssVoter tallies the reported ed25519 keys on its own (TssVoter.ConsensusEd25519PubKey).
 	id, err := getTssID(pks, poolpk, height, bl)
 	if err != nil {
 		return nil, fmt.Errorf("fail to get tss id: %w", err)
@@ -146,6 +145,12 @@ func (m *MsgTssPool) ValidateBasic() error {
 	if _, err := common.NewPubKey(m.PoolPubKey.String()); err != nil {
 		return cosmos.ErrUnknownRequest(err.Error())
 	}
+	// an EdDSA-only keygen exists to report the ed25519 group key
+	if m.KeygenType == KeygenType_EdDSAKeygen && m.IsSuccess() {
+		if _, err := m.Ed25519PubKey.Ed25519Raw(); err != nil {
+			return cosmos.ErrUnknownRequest("invalid ed25519 pubkey")
+		}
+	}
 	chains := m.GetChains()
 	if len(chains) != len(m.Chains) {
 		return cosmos.ErrUnknownRequest("One or more chains were not valid")
@@ -178,6 +183,19 @@ func (m *MsgTssPool) ValidateBasic() error {
 	return nil
 }
 
+// ReportedEd25519PubKey returns the ed25519 group key carried on the message, or
+// an empty key when the signer only has the placeholder (the secp256k1 pool key
+// mirrored into the ed25519 slot when the EdDSA ceremony is disabled or failed)
+func (m MsgTssPool) ReportedEd25519PubKey() common.PubKey {
+	if m.Ed25519PubKey.IsEmpty() || m.Ed25519PubKey.Equals(m.PoolPubKey) {
+		return common.EmptyPubKey
+	}
+	if _, err := m.Ed25519PubKey.Ed25519Raw(); err != nil {
+		return common.EmptyPubKey
+	}
+	return m.Ed25519PubKey
+}
+
 // IsSuccess when blame is empty , then treat it as success
 func (m MsgTssPool) IsSuccess() bool {
 	return m.Blame.IsEmpty()
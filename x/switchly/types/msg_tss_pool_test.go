@@ -69,3 +69,39 @@ func (s *MsgTssPoolSuite) TestMsgTssPool(c *C) {
 	c.Assert(err3, NotNil)
 	c.Check(errors.Is(err3, se.ErrUnknownRequest), Equals, true)
 }
+
+func (s *MsgTssPoolSuite) TestMsgTssPoolEd25519(c *C) {
+	pks := GetRandomPubKeySet()
+	pkStrings := []string{pks.Secp256k1.String(), pks.Ed25519.String()}
+	pk := GetRandomPubKey()
+	edpk := GetRandomEd25519PubKey()
+	addr, err := pks.Secp256k1.GetThorAddress()
+	c.Assert(err, IsNil)
+	chains := []string{common.SwitchNative.Chain.String()}
+	keygenTime := time.Now().Unix()
+
+	// the placeholder (secp256k1 key mirrored into the ed25519 slot) is not a report
+	msg, err := NewMsgTssPool(pkStrings, pk, nil, nil, KeygenType_AsgardKeygen, 1, Blame{}, chains, addr, keygenTime, pk)
+	c.Assert(err, IsNil)
+	c.Check(msg.ReportedEd25519PubKey().IsEmpty(), Equals, true)
+	msg, err = NewMsgTssPool(pkStrings, pk, nil, nil, KeygenType_AsgardKeygen, 1, Blame{}, chains, addr, keygenTime, GetRandomPubKey())
+	c.Assert(err, IsNil)
+	c.Check(msg.ReportedEd25519PubKey().IsEmpty(), Equals, true)
+	msg, err = NewMsgTssPool(pkStrings, pk, nil, nil, KeygenType_AsgardKeygen, 1, Blame{}, chains, addr, keygenTime, edpk)
+	c.Assert(err, IsNil)
+	c.Check(msg.ReportedEd25519PubKey().Equals(edpk), Equals, true)
+
+	// a successful EdDSA-only keygen must carry the ed25519 key
+	msg, err = NewMsgTssPool(pkStrings, pk, nil, nil, KeygenType_EdDSAKeygen, 1, Blame{}, chains, addr, keygenTime, edpk)
+	c.Assert(err, IsNil)
+	c.Check(msg.ValidateBasic(), IsNil)
+	msg, err = NewMsgTssPool(pkStrings, pk, nil, nil, KeygenType_EdDSAKeygen, 1, Blame{}, chains, addr, keygenTime)
+	c.Assert(err, IsNil)
+	c.Check(msg.ValidateBasic(), NotNil)
+
+	// a failed one only carries blame
+	bl := Blame{FailReason: "fail to eddsa keygen", BlameNodes: []Node{{Pubkey: pks.Ed25519.String()}}}
+	msg, err = NewMsgTssPool(pkStrings, pk, nil, nil, KeygenType_EdDSAKeygen, 1, bl, chains, addr, keygenTime)
+	c.Assert(err, IsNil)
+	c.Check(msg.ValidateBasic(), IsNil)
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/switchlyprotocol/switchlynode/v3/api/types"
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+var (
+	_ sdk.Msg              = &MsgUpdateAggregator{}
+	_ sdk.HasValidateBasic = &MsgUpdateAggregator{}
+	_ sdk.LegacyMsg        = &MsgUpdateAggregator{}
+)
+
+// NewMsgUpdateAggregator is a constructor function for MsgUpdateAggregator
+func NewMsgUpdateAggregator(chain common.Chain, address string, gasLimit uint64, enabled bool, version string, remove bool, signer cosmos.AccAddress) *MsgUpdateAggregator {
+	return &MsgUpdateAggregator{
+		Chain:    chain,
+		Address:  address,
+		GasLimit: gasLimit,
+		Enabled:  enabled,
+		Version:  version,
+		Remove:   remove,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic implements HasValidateBasic
+// ValidateBasic is now ran in the message service router for messages that
+// used to be routed using the external handler and only when HasValidateBasic is implemented.
+// No versioning is used there.
+func (m *MsgUpdateAggregator) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	voter := m.GetVoter()
+	if err := voter.Valid(); err != nil {
+		return cosmos.ErrUnknownRequest(err.Error())
+	}
+	return nil
+}
+
+// GetAggregator return the aggregator settings proposed by the message
+func (m *MsgUpdateAggregator) GetAggregator() DexAggregator {
+	return NewDexAggregator(m.Chain, m.Address, m.GasLimit, m.Enabled, m.Version)
+}
+
+// GetVoter return a new voter of the proposal of the message
+func (m *MsgUpdateAggregator) GetVoter() DexAggregatorVoter {
+	return NewDexAggregatorVoter(m.GetAggregator(), m.Remove)
+}
+
+// GetSigners return all the signer who signed this message
+// Implements LegacyMsg.
+func (m *MsgUpdateAggregator) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+func MsgUpdateAggregatorCustomGetSigners(m proto.Message) ([][]byte, error) {
+	msg, ok := m.(*types.MsgUpdateAggregator)
+	if !ok {
+		return nil, fmt.Errorf("can't cast as MsgUpdateAggregator: %T", m)
+	}
+	return [][]byte{msg.Signer}, nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"errors"
+
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+type MsgUpdateAggregatorSuite struct{}
+
+var _ = Suite(&MsgUpdateAggregatorSuite{})
+
+func (MsgUpdateAggregatorSuite) TestMsgUpdateAggregator(c *C) {
+	addr := GetRandomETHAddress().String()
+	signer := GetRandomBech32Addr()
+	msg := NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, true, "v1", false, signer)
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(msg.GetSigners(), NotNil)
+	c.Assert(msg.GetSigners()[0].String(), Equals, signer.String())
+	agg := msg.GetAggregator()
+	c.Check(agg.Equals(NewDexAggregator(common.ETHChain, addr, 400_000, true, "v1")), Equals, true)
+	c.Check(msg.GetVoter().Remove, Equals, false)
+
+	msg1 := NewMsgUpdateAggregator(common.ETHChain, addr, 400_000, true, "v1", false, cosmos.AccAddress{})
+	err1 := msg1.ValidateBasic()
+	c.Assert(err1, NotNil)
+	c.Assert(errors.Is(err1, se.ErrInvalidAddress), Equals, true)
+
+	msg2 := NewMsgUpdateAggregator(common.ETHChain, addr, 0, true, "v1", false, signer)
+	err2 := msg2.ValidateBasic()
+	c.Assert(err2, NotNil)
+	c.Assert(errors.Is(err2, se.ErrUnknownRequest), Equals, true)
+
+	// the settings of an aggregator to remove don't matter
+	msg3 := NewMsgUpdateAggregator(common.ETHChain, addr, 0, false, "", true, signer)
+	c.Assert(msg3.ValidateBasic(), IsNil)
+	c.Check(msg3.GetVoter().Remove, Equals, true)
+}
@@ -30,171 +30,183 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("types/query.proto", fileDescriptor_3dd69a0a5972f391) }
 
 var fileDescriptor_3dd69a0a5972f391 = []byte{
-	// 2611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0x1b, 0xb9,
-	0x15, 0x8f, 0xb6, 0xeb, 0x24, 0x66, 0xec, 0x38, 0x66, 0x3e, 0xfc, 0x2d, 0x3b, 0x71, 0x92, 0xdd,
-	0xed, 0x2e, 0x32, 0xe8, 0x06, 0x3d, 0xb5, 0x17, 0xdb, 0xdb, 0x8f, 0x20, 0xc9, 0x26, 0x59, 0x39,
-	0x09, 0xd2, 0xa2, 0x10, 0x28, 0x0d, 0x23, 0xcf, 0x6a, 0x34, 0x54, 0x86, 0x1c, 0x59, 0x8a, 0x57,
-	0x7b, 0x08, 0x5a, 0x14, 0x45, 0x2f, 0x05, 0x7a, 0xe8, 0xa9, 0xb7, 0xfe, 0x31, 0x3d, 0x2e, 0xd0,
-	0x4b, 0x8f, 0x45, 0xd2, 0x3f, 0xa4, 0x20, 0x87, 0x9c, 0x21, 0x67, 0x1e, 0x65, 0x1f, 0x7a, 0x31,
-	0x8c, 0xf7, 0x7b, 0x7c, 0xbf, 0x47, 0xf2, 0x91, 0xef, 0xf1, 0x8d, 0xd0, 0xb2, 0x98, 0x0c, 0x29,
-	0x0f, 0xde, 0x64, 0x34, 0x9d, 0xdc, 0x1b, 0xa6, 0x4c, 0x30, 0x3c, 0xa7, 0x44, 0xeb, 0x9b, 0x3d,
-	0xc6, 0x7a, 0x31, 0x0d, 0xc8, 0x30, 0x0a, 0x48, 0x92, 0x30, 0x41, 0x44, 0xc4, 0x12, 0x9e, 0x2b,
-	0xad, 0x5f, 0xeb, 0xb1, 0x1e, 0x53, 0xff, 0x06, 0xf2, 0x3f, 0x2d, 0xbd, 0x61, 0x59, 0x6b, 0x0f,
-	0x19, 0x8b, 0xb5, 0xbc, 0x69, 0xcb, 0x43, 0x9a, 0x46, 0x23, 0x1a, 0xda, 0xf8, 0x6d, 0x1b, 0x8f,
-	0xa3, 0x37, 0x59, 0x14, 0x46, 0x62, 0xd2, 0x1e, 0xa6, 0x6c, 0x14, 0x85, 0x34, 0xd5, 0x5a, 0x2b,
-	0xb6, 0x16, 0x27, 0xa3, 0x02, 0x58, 0xb7, 0x81, 0x0e, 0x4b, 0x53, 0x76, 0x5c, 0x60, 0x9b, 0x36,
-	0x26, 0x52, 0x12, 0xd2, 0x76, 0x96, 0x44, 0x42, 0xa3, 0xdb, 0x75, 0x94, 0x74, 0xbb, 0x2c, 0x4b,
-	0x04, 0x34, 0xa3, 0x84, 0x85, 0x54, 0xcb, 0x37, 0xaa, 0x33, 0x6d, 0xf3, 0x38, 0x1a, 0x42, 0xd3,
-	0x65, 0x99, 0xe8, 0xb0, 0x2c, 0x09, 0xdb, 0xaf, 0xa9, 0x19, 0xbc, 0xe3, 0x4c, 0x44, 0xa4, 0x94,
-	0x0c, 0xa2, 0xa4, 0xd7, 0xe6, 0xc7, 0xc4, 0x58, 0xb8, 0xee, 0xcc, 0x88, 0x24, 0xee, 0x44, 0xe5,
-	0x5f, 0x29, 0x6d, 0x8f, 0x98, 0x80, 0x17, 0x21, 0x25, 0xbd, 0x84, 0xa4, 0xac, 0xaf, 0xb1, 0x2d,
-	0x87, 0xf0, 0x38, 0x12, 0xdd, 0x23, 0x7b, 0xf9, 0x6f, 0x42, 0xf0, 0x8c, 0xb5, 0x1f, 0x44, 0x83,
-	0x28, 0x85, 0xc6, 0x46, 0x49, 0x3e, 0x55, 0x12, 0x86, 0x29, 0xe5, 0x26, 0x56, 0xd6, 0x6c, 0x95,
-	0x11, 0x4d, 0x79, 0xc4, 0x12, 0x68, 0xa5, 0x72, 0xe6, 0x78, 0x92, 0x90, 0x01, 0x85, 0x76, 0x2f,
-	0x4a, 0x46, 0x24, 0x8d, 0x48, 0x22, 0x40, 0xc3, 0x09, 0x15, 0xc7, 0x2c, 0xed, 0x43, 0x4b, 0xdc,
-	0x21, 0x31, 0x49, 0xba, 0xb4, 0x3d, 0x60, 0x61, 0x16, 0x1b, 0xd3, 0xab, 0xb6, 0xc6, 0x9b, 0x8c,
-	0x09, 0xca, 0xa1, 0x29, 0x75, 0x59, 0xc2, 0x05, 0x49, 0x44, 0x7b, 0x44, 0xe2, 0xac, 0x50, 0xd9,
-	0x74, 0xfd, 0x26, 0xc3, 0xf6, 0x9b, 0x8c, 0x66, 0xa0, 0xd7, 0x31, 0xe1, 0xa2, 0xdd, 0x89, 0x59,
-	0xb7, 0x0f, 0x2d, 0xe5, 0x88, 0x64, 0xb1, 0x89, 0xb5, 0x6b, 0x4e, 0x30, 0x8e, 0xa1, 0x10, 0x95,
-	0x54, 0x43, 0x9a, 0xb6, 0xbb, 0x31, 0xcb, 0x2a, 0x21, 0xaa, 0x82, 0xc2, 0x96, 0xaf, 0xb8, 0x13,
-	0x2c, 0xdd, 0x5b, 0x87, 0xc2, 0x13, 0x1a, 0x64, 0x7b, 0xed, 0x44, 0xa4, 0x28, 0xf6, 0x76, 0xc3,
-	0x22, 0x17, 0x9c, 0xb7, 0x07, 0x54, 0xa4, 0x51, 0x17, 0x22, 0xea, 0xd3, 0x09, 0x17, 0x2c, 0xa5,
-	0xd0, 0xde, 0x65, 0xc3, 0x9e, 0x3c, 0x7b, 0x10, 0xe4, 0x1e, 0x47, 0x67, 0xd3, 0xe8, 0x78, 0xc8,
-	0x52, 0xf0, 0xa0, 0x76, 0x48, 0xd2, 0x07, 0x97, 0x8f, 0x76, 0xb3, 0x94, 0x86, 0x6d, 0xc2, 0x39,
-	0x15, 0x70, 0x08, 0x76, 0xe5, 0x89, 0x24, 0x7d, 0x9a, 0x72, 0x78, 0xfd, 0xbb, 0x93, 0x76, 0x37,
-	0x26, 0xd1, 0xa0, 0x54, 0x58, 0x71, 0xc3, 0x25, 0x34, 0x41, 0xf2, 0xe5, 0x3f, 0x7e, 0x85, 0xe6,
-	0x9e, 0x49, 0x29, 0x0e, 0xd1, 0x85, 0xbd, 0x7c, 0x1e, 0x78, 0xfd, 0x9e, 0x52, 0xbf, 0xa7, 0x00,
-	0x2d, 0xfc, 0x86, 0xbe, 0xc9, 0x28, 0x17, 0xeb, 0x1b, 0x20, 0xc6, 0x87, 0x2c, 0xe1, 0xf4, 0xd6,
-	0xce, 0xbb, 0x7f, 0xfd, 0xf7, 0xaf, 0x1f, 0xad, 0xe3, 0xd5, 0x80, 0x64, 0xe2, 0x28, 0xd0, 0xeb,
-	0xc2, 0x83, 0x13, 0x7d, 0xd8, 0xa6, 0xb8, 0x87, 0x2e, 0xee, 0xe7, 0x91, 0xce, 0xb1, 0x63, 0xca,
-	0x48, 0x0d, 0xcf, 0x26, 0x0c, 0xd6, 0x88, 0xe4, 0x32, 0x06, 0xfa, 0xf0, 0xd8, 0x44, 0xaf, 0xd0,
-	0xf9, 0x5f, 0xa8, 0xb5, 0xc7, 0x6b, 0xb6, 0xa5, 0x5c, 0x66, 0x48, 0xd6, 0x21, 0x48, 0x53, 0xac,
-	0x2a, 0x0a, 0x8c, 0xaf, 0x04, 0xe6, 0xcc, 0x07, 0xf9, 0x66, 0xe2, 0x57, 0xe8, 0xe3, 0xa7, 0x8c,
-	0xc5, 0x78, 0xc5, 0x1e, 0x2d, 0x25, 0xc6, 0xec, 0x6a, 0x1d, 0xd0, 0x46, 0x9b, 0xca, 0xe8, 0x2a,
-	0xbe, 0x51, 0x1a, 0x95, 0x77, 0x5c, 0x70, 0xa2, 0x76, 0x7b, 0x8a, 0x9f, 0xa3, 0x39, 0xa9, 0xcf,
-	0x71, 0xcd, 0x44, 0xb1, 0x30, 0x6b, 0x00, 0xa2, 0xad, 0xaf, 0x28, 0xeb, 0xcb, 0x78, 0xc9, 0xb5,
-	0xce, 0x31, 0x43, 0x97, 0xbe, 0xca, 0x33, 0x9a, 0x72, 0xbc, 0x69, 0x9b, 0xb0, 0x00, 0x43, 0xb1,
-	0xed, 0xc5, 0x35, 0xd1, 0xb6, 0x22, 0x5a, 0xc3, 0x2b, 0x25, 0x51, 0xe8, 0xcc, 0xa3, 0x8f, 0x16,
-	0xac, 0x71, 0x1c, 0xfb, 0x2c, 0x16, 0xb3, 0xda, 0xf1, 0x2b, 0xf8, 0xf7, 0x23, 0xcc, 0x67, 0xf7,
-	0xf7, 0x06, 0x5a, 0x7e, 0x64, 0x12, 0xf2, 0x53, 0x9d, 0x13, 0xf0, 0x6d, 0xdb, 0x62, 0x0d, 0x36,
-	0xbc, 0x77, 0x4e, 0xd1, 0xd2, 0xe4, 0x3f, 0x53, 0xe4, 0x3f, 0xc5, 0xf7, 0xe1, 0x7d, 0x0b, 0xea,
-	0xf5, 0x80, 0x15, 0x8a, 0x7f, 0x6b, 0x20, 0x5c, 0x33, 0xcd, 0xf1, 0x6c, 0xea, 0x62, 0x65, 0xee,
-	0x9e, 0xa6, 0xa6, 0x5d, 0xfc, 0x52, 0xb9, 0xf8, 0x05, 0xfe, 0xf1, 0x99, 0x5d, 0xe4, 0x38, 0x46,
-	0x73, 0x2d, 0x59, 0xa3, 0xb8, 0xe1, 0xa6, 0x44, 0x60, 0xb8, 0x69, 0x44, 0x33, 0xde, 0x53, 0x8c,
-	0x9f, 0xe2, 0xbb, 0x1e, 0x46, 0x55, 0xfe, 0x58, 0xeb, 0xf0, 0x1a, 0x9d, 0x57, 0x06, 0x38, 0xae,
-	0x1b, 0xe5, 0xe0, 0x91, 0x34, 0x90, 0x26, 0xbc, 0xa3, 0x08, 0xb7, 0xf1, 0xd6, 0x2c, 0x42, 0x8e,
-	0xc7, 0xe8, 0xe2, 0xbe, 0x2e, 0xb0, 0x2a, 0x77, 0x8c, 0x96, 0xc2, 0x77, 0x4c, 0x01, 0x6a, 0xb6,
-	0x9f, 0x28, 0xb6, 0xcf, 0xf1, 0x67, 0x1e, 0x36, 0x53, 0xc4, 0x59, 0x33, 0x4c, 0xd1, 0xbc, 0x31,
-	0xc3, 0x31, 0x68, 0xbd, 0x98, 0xe7, 0x96, 0x07, 0xd5, 0xe4, 0x9f, 0x2a, 0xf2, 0x5b, 0x78, 0xe7,
-	0x14, 0x72, 0x8e, 0x13, 0x34, 0x7f, 0x28, 0x13, 0xd3, 0xf3, 0x24, 0x12, 0x2e, 0x67, 0x21, 0x06,
-	0x39, 0x2d, 0x54, 0x73, 0xde, 0x56, 0x9c, 0x4d, 0xbc, 0x59, 0x72, 0xaa, 0x72, 0x33, 0x90, 0xc5,
-	0x68, 0x71, 0xb4, 0xbf, 0x45, 0xa8, 0x18, 0xca, 0x31, 0x6c, 0xb2, 0x98, 0x65, 0xd3, 0x07, 0x6b,
-	0xca, 0x2d, 0x45, 0xb9, 0x82, 0xaf, 0x43, 0x94, 0x1c, 0x4f, 0xd1, 0x82, 0x1a, 0x64, 0x12, 0xd3,
-	0x76, 0xcd, 0x5c, 0x25, 0x3b, 0xdd, 0xf4, 0x29, 0x94, 0x94, 0x9f, 0x29, 0xca, 0x5d, 0x7c, 0xb3,
-	0x4a, 0xa9, 0xb3, 0x95, 0xb5, 0x9d, 0xdf, 0xa3, 0x45, 0xc7, 0x06, 0xde, 0x99, 0x61, 0xfe, 0xcc,
-	0x0e, 0x00, 0x5b, 0xeb, 0x38, 0xc0, 0x8b, 0xa5, 0x9e, 0xa0, 0x85, 0x56, 0x5e, 0x0d, 0xec, 0x49,
-	0x81, 0x3b, 0x7d, 0x1b, 0x01, 0x6f, 0x51, 0x57, 0x41, 0x93, 0xdf, 0x55, 0xe4, 0x3b, 0xb8, 0x59,
-	0x92, 0xeb, 0x82, 0x43, 0x71, 0x16, 0xd4, 0x19, 0x5a, 0xb4, 0xc7, 0x57, 0xa6, 0xee, 0x40, 0xe0,
-	0xd4, 0x2b, 0x1a, 0xfe, 0xbc, 0x61, 0xb3, 0x73, 0xfc, 0x5b, 0xf4, 0xf1, 0xd7, 0x2c, 0xa4, 0x6e,
-	0x6a, 0x95, 0x12, 0x30, 0xb5, 0xe6, 0x40, 0xad, 0x24, 0x28, 0x6c, 0xcb, 0xb7, 0x90, 0xb5, 0x9d,
-	0xcf, 0xd1, 0x9c, 0x1c, 0x51, 0x49, 0xae, 0x4a, 0x04, 0xde, 0x76, 0x1a, 0xf1, 0x27, 0xd7, 0x44,
-	0x59, 0x7b, 0x8d, 0x2e, 0xca, 0x4c, 0xd5, 0x8a, 0xa3, 0xa1, 0x7b, 0xdd, 0x18, 0x29, 0x78, 0xfc,
-	0x0c, 0xc8, 0x67, 0xd5, 0x06, 0xf2, 0xbd, 0x56, 0x6c, 0x09, 0x41, 0xf3, 0xc5, 0x20, 0xf7, 0xa0,
-	0x5b, 0xb6, 0xce, 0xc4, 0x04, 0x4c, 0x85, 0x2b, 0xab, 0xc7, 0xe8, 0xd2, 0x13, 0x5d, 0x6b, 0xff,
-	0x92, 0x52, 0xb7, 0x4e, 0xb0, 0x00, 0x30, 0xdc, 0x2c, 0x9c, 0xcf, 0x0a, 0x37, 0xfb, 0xad, 0x59,
-	0xcc, 0x2d, 0x45, 0x0b, 0xf6, 0x78, 0x37, 0xd2, 0x5d, 0xcb, 0x67, 0xa5, 0x06, 0x62, 0xcd, 0xa6,
-	0xe6, 0xf2, 0x74, 0xb7, 0xcc, 0xbb, 0xb6, 0x75, 0x4c, 0x86, 0x95, 0x10, 0xb7, 0x21, 0x38, 0xc4,
-	0x5d, 0x0d, 0xff, 0xe9, 0x96, 0x0f, 0xa2, 0xa0, 0x78, 0x42, 0x07, 0x27, 0x62, 0xdc, 0x8e, 0xc2,
-	0x29, 0x7e, 0x8b, 0x2e, 0x3b, 0x26, 0x38, 0xf6, 0x9b, 0x2f, 0xe6, 0x7d, 0x6b, 0x96, 0x8a, 0x76,
-	0xe1, 0xa6, 0x72, 0x61, 0x03, 0xaf, 0xb9, 0x2e, 0xf0, 0xd2, 0x07, 0xfc, 0x04, 0xfd, 0x68, 0x9f,
-	0x24, 0xf8, 0x86, 0x5b, 0x64, 0x27, 0x86, 0x65, 0x49, 0xcb, 0xf7, 0x49, 0xf2, 0x42, 0x3e, 0xdf,
-	0xa1, 0xc5, 0xec, 0x90, 0xc4, 0x3a, 0x5b, 0x04, 0x5d, 0xfc, 0x46, 0xbf, 0xe7, 0xdd, 0x43, 0x60,
-	0xa4, 0x60, 0xce, 0x2d, 0x41, 0xed, 0xfa, 0xba, 0xe2, 0xb9, 0x86, 0x71, 0xc9, 0x63, 0xda, 0x04,
-	0x38, 0x42, 0xa8, 0xa5, 0x84, 0xaa, 0x86, 0x75, 0x42, 0xbc, 0x94, 0x83, 0x89, 0xc7, 0x86, 0x35,
-	0xd1, 0xa6, 0x22, 0xba, 0x81, 0xaf, 0xd9, 0x6b, 0x24, 0xff, 0x91, 0x59, 0x16, 0xff, 0xbe, 0x81,
-	0x2e, 0xb7, 0x5e, 0x3e, 0x38, 0x3c, 0xf8, 0x75, 0x51, 0x4e, 0xba, 0x7b, 0xe3, 0x60, 0xf0, 0xde,
-	0x54, 0x54, 0x34, 0xef, 0xe7, 0x8a, 0xf7, 0x0e, 0xde, 0xad, 0xf2, 0x42, 0x85, 0xe3, 0xf7, 0x68,
-	0xc9, 0x35, 0xc3, 0xf1, 0x0c, 0x8e, 0x22, 0x46, 0x76, 0x67, 0xea, 0x68, 0x47, 0x6e, 0x29, 0x47,
-	0x36, 0xf1, 0xba, 0xd7, 0x11, 0x8e, 0x7b, 0xe8, 0xd2, 0xe3, 0x68, 0x10, 0xa5, 0x2f, 0x54, 0x5b,
-	0xc1, 0xbd, 0x0e, 0x2c, 0x00, 0x7c, 0x36, 0x38, 0xb8, 0xff, 0xde, 0x51, 0x6d, 0x1a, 0x2c, 0xd0,
-	0x82, 0xd2, 0x7f, 0x19, 0x89, 0xa3, 0x87, 0x74, 0x82, 0xeb, 0x96, 0x34, 0x02, 0x1e, 0x7f, 0x57,
-	0xc1, 0x7f, 0x08, 0x14, 0x57, 0xd0, 0xa7, 0x93, 0xe0, 0xa4, 0x4f, 0x27, 0x53, 0xfc, 0x1d, 0xba,
-	0xa2, 0x86, 0xee, 0x85, 0x83, 0x28, 0xd1, 0x73, 0xdc, 0xad, 0x19, 0xb6, 0x50, 0xc3, 0x7e, 0x7b,
-	0xb6, 0x92, 0xbf, 0xb6, 0xc9, 0x3d, 0x20, 0x52, 0x19, 0xff, 0xa9, 0x81, 0xae, 0xaa, 0xb1, 0x2a,
-	0xcd, 0xec, 0xc5, 0xb1, 0xf6, 0xe0, 0x6e, 0xcd, 0xb8, 0xab, 0x60, 0x9c, 0xf8, 0xe4, 0x54, 0xbd,
-	0xd3, 0x56, 0x42, 0xa5, 0xaf, 0x36, 0x89, 0xe3, 0x62, 0x25, 0x94, 0x05, 0xef, 0x4a, 0x58, 0xa8,
-	0x7f, 0x25, 0x1c, 0xa5, 0xd3, 0x56, 0x22, 0x4f, 0xa0, 0xef, 0x1a, 0x68, 0xa9, 0x18, 0xab, 0xd9,
-	0x6f, 0x81, 0x86, 0x5d, 0xf2, 0xdd, 0x99, 0x3a, 0xfe, 0x0c, 0x54, 0x72, 0x5b, 0x67, 0xed, 0x5d,
-	0x03, 0x5d, 0x79, 0x90, 0xb7, 0x06, 0xf7, 0x72, 0x59, 0x75, 0x0d, 0xaa, 0x28, 0xb8, 0x06, 0x75,
-	0x25, 0xed, 0xc7, 0xae, 0xf2, 0x63, 0x0b, 0x6f, 0x94, 0x7e, 0x54, 0x3a, 0x91, 0x94, 0xe3, 0xdf,
-	0xa1, 0x0b, 0x2f, 0xf2, 0xde, 0xa3, 0xdb, 0x83, 0xd1, 0x42, 0xb0, 0x07, 0x53, 0x60, 0x9a, 0x68,
-	0x4d, 0x11, 0x5d, 0xc5, 0xcb, 0x25, 0x91, 0xee, 0x67, 0xe2, 0x31, 0x5a, 0x68, 0x59, 0xfd, 0xcb,
-	0x4a, 0x3d, 0x69, 0x21, 0x70, 0x3d, 0xe9, 0x28, 0xf8, 0x9f, 0x64, 0x76, 0x8b, 0x34, 0x38, 0x91,
-	0x7f, 0xa7, 0x38, 0x46, 0xf3, 0x0f, 0x4c, 0x67, 0xd4, 0xad, 0x5d, 0x0a, 0x31, 0x58, 0xbb, 0x58,
-	0xa8, 0xff, 0xde, 0x2a, 0x7a, 0xae, 0xc1, 0xc9, 0x90, 0x88, 0xa3, 0xa9, 0xcc, 0x14, 0xc5, 0xc0,
-	0xca, 0x13, 0xa5, 0x94, 0x83, 0x99, 0xc2, 0x86, 0xfd, 0x99, 0xa2, 0x6c, 0xf2, 0xca, 0x1d, 0xfb,
-	0x3a, 0x6f, 0xea, 0xba, 0x3b, 0xa6, 0x85, 0xe0, 0x8e, 0x15, 0x98, 0x7f, 0xc7, 0x74, 0xa3, 0x18,
-	0x4f, 0xd1, 0xa2, 0xee, 0x7d, 0x3d, 0x56, 0x7d, 0x61, 0xb7, 0x46, 0x71, 0x20, 0xb0, 0x46, 0xa9,
-	0x68, 0x68, 0xc2, 0x4f, 0x14, 0xe1, 0x4d, 0xbc, 0x6d, 0x67, 0x73, 0xa5, 0x18, 0xe4, 0xed, 0x67,
-	0xb3, 0x6d, 0xaf, 0xd1, 0xfc, 0xb3, 0x8c, 0x09, 0xaa, 0xca, 0x23, 0x67, 0xdb, 0x0a, 0x31, 0xb8,
-	0x6d, 0x16, 0xea, 0x5f, 0x45, 0xd5, 0xcf, 0x56, 0x95, 0x09, 0xfe, 0x63, 0x03, 0x2d, 0xe7, 0x63,
-	0xe4, 0x0b, 0xfe, 0x2b, 0x3a, 0x64, 0x3c, 0x12, 0x6e, 0x07, 0xa7, 0x06, 0x83, 0x1d, 0x1c, 0x40,
-	0xcb, 0x1f, 0xa8, 0xda, 0x01, 0xd5, 0xa5, 0x08, 0x35, 0xe7, 0x9f, 0x1b, 0x08, 0x97, 0x46, 0x64,
-	0x52, 0x09, 0x53, 0x72, 0x8c, 0x3d, 0x24, 0x06, 0x07, 0x7b, 0x35, 0x90, 0x9a, 0xff, 0x52, 0xb2,
-	0x9d, 0x39, 0x36, 0xb4, 0xc7, 0x68, 0x51, 0x59, 0x79, 0xc4, 0x48, 0xf2, 0x64, 0x48, 0x13, 0x77,
-	0xfb, 0x1d, 0x08, 0xdc, 0xfe, 0x8a, 0x86, 0x3f, 0x21, 0xe4, 0xec, 0x31, 0x23, 0x49, 0xc0, 0x24,
-	0xcf, 0x09, 0xba, 0x5c, 0x8c, 0x3d, 0x88, 0x19, 0xa7, 0x18, 0xb6, 0xab, 0x30, 0xb0, 0xfe, 0xa9,
-	0xaa, 0xf8, 0x8f, 0xaf, 0xc5, 0xdd, 0x55, 0x54, 0x02, 0x5d, 0x3e, 0xd0, 0x5f, 0x34, 0x74, 0x36,
-	0x70, 0xc8, 0x5d, 0x0c, 0x24, 0xaf, 0xaa, 0x68, 0xf2, 0x0d, 0x45, 0x7e, 0x1d, 0x5f, 0x2d, 0xc9,
-	0xcd, 0xa7, 0x13, 0xf9, 0x8c, 0x9b, 0x97, 0x11, 0xfb, 0x2c, 0xa3, 0x19, 0x75, 0x63, 0xbd, 0x10,
-	0x83, 0xb1, 0x6e, 0xa1, 0xb3, 0x62, 0x9d, 0x66, 0x3a, 0xd6, 0x8f, 0x10, 0x7a, 0x44, 0xb8, 0xd8,
-	0x8f, 0x59, 0xb7, 0x5f, 0xb9, 0x9c, 0x4a, 0x39, 0x78, 0x39, 0xd9, 0xb0, 0x7f, 0x46, 0x31, 0xe1,
-	0x42, 0x7d, 0x14, 0xc1, 0x6f, 0xd1, 0xd2, 0xc1, 0x11, 0x89, 0x12, 0x5e, 0x0c, 0x74, 0xd3, 0x6a,
-	0x05, 0x3c, 0x2b, 0x27, 0x90, 0xc9, 0x0a, 0xce, 0xe0, 0xa4, 0x2b, 0x8d, 0x4e, 0x71, 0x1b, 0xcd,
-	0xbd, 0x20, 0x59, 0x2c, 0xdc, 0xb7, 0xb6, 0x12, 0x81, 0x6f, 0x6d, 0x8d, 0xf8, 0x23, 0x54, 0x7d,
-	0x84, 0x0a, 0x4e, 0x86, 0x59, 0xa7, 0xad, 0x8a, 0xb7, 0x14, 0x2d, 0xec, 0xf1, 0x1e, 0x49, 0x43,
-	0x35, 0xb2, 0xf2, 0x62, 0xb4, 0x11, 0x30, 0x97, 0xb9, 0x0a, 0xfe, 0x17, 0xa3, 0x62, 0xe5, 0x01,
-	0x51, 0xea, 0x78, 0x84, 0x16, 0xf3, 0x21, 0x4f, 0xb3, 0x4e, 0x9f, 0x4e, 0x2a, 0x4d, 0x11, 0x07,
-	0x02, 0x8f, 0x63, 0x45, 0xc3, 0xdf, 0xb8, 0xd0, 0xb4, 0x43, 0x4d, 0xf3, 0x2d, 0xba, 0x78, 0x38,
-	0x6e, 0x09, 0xd2, 0xab, 0x7e, 0x34, 0x31, 0x52, 0xf0, 0x71, 0x55, 0x82, 0xfe, 0x8d, 0x13, 0xe3,
-	0x80, 0x2b, 0xa5, 0xe2, 0x55, 0x6a, 0xb8, 0x44, 0x06, 0x71, 0x89, 0x6c, 0x06, 0x97, 0x02, 0x4f,
-	0xe3, 0x12, 0x59, 0xc9, 0xf5, 0x0c, 0x7d, 0x74, 0x38, 0xc6, 0xd7, 0x5d, 0x43, 0xc6, 0xfe, 0x8d,
-	0xaa, 0xd8, 0x7f, 0xba, 0xc4, 0xd8, 0x75, 0x5f, 0xbd, 0x59, 0x6b, 0xee, 0xe7, 0x52, 0x30, 0x23,
-	0x3f, 0xe9, 0x70, 0x9a, 0x8e, 0x68, 0xa8, 0x95, 0xc0, 0x4e, 0xe8, 0x38, 0x08, 0xa9, 0x20, 0x51,
-	0x5c, 0xba, 0x3f, 0x40, 0x97, 0x8c, 0xd5, 0x27, 0x71, 0xf8, 0x7f, 0xa7, 0xd3, 0x34, 0x01, 0x8f,
-	0x7a, 0x89, 0x9c, 0x0e, 0x45, 0x73, 0x07, 0x31, 0xcb, 0xaa, 0x6d, 0xc0, 0xfc, 0x6b, 0xab, 0x42,
-	0x0c, 0xd9, 0x55, 0xad, 0x60, 0x63, 0x50, 0xce, 0x51, 0x5f, 0x62, 0xf3, 0xf6, 0x84, 0xd3, 0x25,
-	0xcb, 0xef, 0xc0, 0x55, 0xf7, 0x3a, 0xb7, 0xee, 0xbf, 0x35, 0x00, 0xf1, 0x3f, 0xf1, 0xd4, 0xdd,
-	0x87, 0xdf, 0xa2, 0xe5, 0x56, 0xf7, 0x88, 0xca, 0xea, 0x22, 0x34, 0xfd, 0x1a, 0x37, 0xc3, 0xd7,
-	0x60, 0x30, 0xd2, 0x4a, 0x70, 0x56, 0x36, 0x53, 0xb7, 0xad, 0xb1, 0x87, 0x33, 0xb4, 0xf4, 0x94,
-	0x26, 0x61, 0x94, 0xf4, 0x0a, 0x66, 0xe7, 0x22, 0xac, 0x80, 0x67, 0xe3, 0x05, 0x8e, 0x6d, 0xce,
-	0x6b, 0xba, 0x4c, 0x72, 0x25, 0xf3, 0x5b, 0xd7, 0x59, 0x49, 0xe7, 0xae, 0x5d, 0x03, 0x10, 0xff,
-	0x4a, 0xe6, 0xd7, 0xfa, 0x1f, 0x1a, 0x68, 0xe9, 0x90, 0xf3, 0x87, 0x74, 0xd2, 0xa3, 0xc9, 0x63,
-	0xf5, 0x2d, 0xdb, 0x9d, 0x4e, 0x05, 0x04, 0x9f, 0x4b, 0x35, 0x1d, 0x7f, 0x77, 0x3c, 0xff, 0x5c,
-	0x2e, 0xdf, 0xcd, 0x3d, 0x9a, 0x58, 0x37, 0x70, 0x17, 0xcd, 0x1f, 0x72, 0xae, 0x1d, 0xd8, 0xac,
-	0x18, 0x77, 0xa9, 0xb7, 0x3c, 0xa8, 0xbf, 0x00, 0xce, 0x49, 0x65, 0x56, 0xbe, 0xf0, 0x90, 0x4e,
-	0xe4, 0x11, 0x70, 0xeb, 0x6b, 0x2d, 0x04, 0x8f, 0x57, 0x81, 0xf9, 0x6b, 0x8e, 0x7e, 0xae, 0x12,
-	0x9c, 0x1c, 0xd1, 0xa8, 0x77, 0x24, 0xa6, 0xf8, 0x3b, 0xb4, 0xa8, 0x87, 0xe5, 0xd7, 0xb3, 0x7b,
-	0xb5, 0x3b, 0xd0, 0x99, 0x38, 0xbf, 0x50, 0x9c, 0x77, 0xf1, 0x6d, 0x3f, 0xa7, 0xb5, 0x94, 0x31,
-	0x3a, 0x9f, 0xef, 0x86, 0xfb, 0x65, 0x2c, 0x97, 0x81, 0x5f, 0xc6, 0x0c, 0xe4, 0x6f, 0x2b, 0x99,
-	0xfd, 0xaa, 0xb3, 0xc9, 0xa7, 0xee, 0xf3, 0xfc, 0xd7, 0x0c, 0x4f, 0x53, 0x36, 0x64, 0x9c, 0xc4,
-	0x95, 0xa7, 0x6e, 0x15, 0x05, 0x9f, 0xba, 0x75, 0x25, 0xff, 0xdd, 0xaf, 0x7f, 0x3c, 0xd1, 0x1e,
-	0x16, 0x7c, 0x32, 0x8a, 0x2b, 0x16, 0xdc, 0x28, 0xae, 0x80, 0x60, 0x14, 0xd7, 0x74, 0xfc, 0x51,
-	0x5c, 0xf5, 0xc0, 0x3c, 0x71, 0x26, 0x68, 0x41, 0x5b, 0x91, 0x77, 0x71, 0xa5, 0x8e, 0xb0, 0x11,
-	0xb0, 0x8e, 0x70, 0x15, 0xfc, 0xd5, 0xbd, 0x61, 0x1f, 0x49, 0x45, 0x43, 0x2d, 0x10, 0x6a, 0xbd,
-	0x3c, 0x78, 0xd5, 0x52, 0x3f, 0xe5, 0xa8, 0x34, 0x34, 0x0b, 0x39, 0xdc, 0xd0, 0xb4, 0x60, 0xff,
-	0x9b, 0xce, 0xfa, 0xa1, 0x88, 0x75, 0xbf, 0x27, 0xe8, 0x52, 0x39, 0xbc, 0xd2, 0xd4, 0xb3, 0x00,
-	0xb0, 0xa9, 0xe7, 0xe0, 0x33, 0x3e, 0x5b, 0x58, 0xbf, 0x50, 0xc1, 0xe3, 0x9c, 0xef, 0x20, 0xff,
-	0x41, 0x4a, 0x9d, 0x4f, 0x03, 0x5e, 0xbe, 0x02, 0x9f, 0xd5, 0x60, 0x2f, 0x7f, 0xf1, 0xe2, 0x7c,
-	0x8d, 0x5d, 0xb0, 0x0c, 0x54, 0xb6, 0xd6, 0x46, 0xe0, 0x76, 0x87, 0xa3, 0x30, 0xe3, 0x03, 0x96,
-	0xfd, 0x73, 0x1b, 0x79, 0xe7, 0x1f, 0xd4, 0xbf, 0x31, 0x1d, 0x78, 0xbf, 0x31, 0x1d, 0x9c, 0xf6,
-	0x8d, 0x49, 0xfd, 0x58, 0x67, 0xff, 0xc5, 0x3f, 0xdf, 0x37, 0x1b, 0x3f, 0xbc, 0x6f, 0x36, 0xfe,
-	0xf3, 0xbe, 0xd9, 0xf8, 0xcb, 0x87, 0xe6, 0xb9, 0x1f, 0x3e, 0x34, 0xcf, 0xfd, 0xfb, 0x43, 0xf3,
-	0xdc, 0x6f, 0x7e, 0xde, 0x8b, 0xc4, 0x51, 0xd6, 0xb9, 0xd7, 0x65, 0x83, 0x62, 0x90, 0xfa, 0x69,
-	0x4f, 0x97, 0xc5, 0x65, 0x2b, 0x86, 0x85, 0x34, 0x18, 0xdd, 0x0f, 0xc6, 0x56, 0x81, 0x21, 0x7d,
-	0xe8, 0x9c, 0x57, 0xaa, 0xf7, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x8b, 0xa4, 0xef, 0x63,
-	0x29, 0x00, 0x00,
+	// 2806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0xde, 0x59, 0xd6, 0x49, 0x5c, 0xb1, 0xe3, 0x75, 0xe5, 0xe2, 0x4b, 0xec, 0xf1, 0x2d, 0xf1,
+	0x5e, 0x71, 0xc3, 0xae, 0xe0, 0x81, 0x85, 0x07, 0xdb, 0x0b, 0xda, 0xd5, 0xe6, 0xba, 0xe3, 0x24,
+	0x0a, 0x68, 0x35, 0xaa, 0x99, 0xae, 0xcc, 0xf4, 0xba, 0xdd, 0x35, 0xe9, 0xaa, 0x1e, 0xcf, 0xc4,
+	0x38, 0x12, 0x11, 0x08, 0x21, 0x5e, 0x90, 0x10, 0xe2, 0x89, 0x3f, 0xc2, 0x2f, 0xe0, 0x71, 0x25,
+	0x5e, 0x78, 0x44, 0x09, 0x3f, 0x04, 0x55, 0x75, 0x55, 0x77, 0x55, 0xf7, 0xa9, 0xb1, 0x85, 0x78,
+	0x19, 0x8d, 0xce, 0x77, 0xea, 0x7c, 0xa7, 0xae, 0xe7, 0xd4, 0xa9, 0x46, 0xf3, 0x62, 0x3c, 0xa0,
+	0x3c, 0x78, 0x9e, 0xd1, 0x74, 0xbc, 0x33, 0x48, 0x99, 0x60, 0x78, 0x4a, 0x89, 0x96, 0x57, 0x7a,
+	0x8c, 0xf5, 0x62, 0x1a, 0x90, 0x41, 0x14, 0x90, 0x24, 0x61, 0x82, 0x88, 0x88, 0x25, 0x3c, 0x57,
+	0x5a, 0xbe, 0xd6, 0x63, 0x3d, 0xa6, 0xfe, 0x06, 0xf2, 0x9f, 0x96, 0xde, 0xb0, 0xac, 0xb5, 0x07,
+	0x8c, 0xc5, 0x5a, 0xde, 0xb4, 0xe5, 0x21, 0x4d, 0xa3, 0x21, 0x0d, 0x6d, 0xfc, 0x96, 0x8d, 0xc7,
+	0xd1, 0xf3, 0x2c, 0x0a, 0x23, 0x31, 0x6e, 0x0f, 0x52, 0x36, 0x8c, 0x42, 0x9a, 0x6a, 0xad, 0x05,
+	0x5b, 0x8b, 0x93, 0x61, 0x01, 0x2c, 0xdb, 0x40, 0x87, 0xa5, 0x29, 0x3b, 0x2e, 0xb0, 0x15, 0x1b,
+	0x13, 0x29, 0x09, 0x69, 0x3b, 0x4b, 0x22, 0xa1, 0xd1, 0xb5, 0x3a, 0x4a, 0xba, 0x5d, 0x96, 0x25,
+	0x02, 0xea, 0x51, 0xc2, 0x42, 0xaa, 0xe5, 0x37, 0xab, 0x3d, 0x6d, 0xf3, 0x38, 0x1a, 0x40, 0xdd,
+	0x65, 0x99, 0xe8, 0xb0, 0x2c, 0x09, 0xdb, 0xcf, 0xa8, 0x69, 0xbc, 0xee, 0x74, 0x44, 0xa4, 0x94,
+	0x1c, 0x45, 0x49, 0xaf, 0xcd, 0x8f, 0x89, 0xb1, 0x70, 0xdd, 0xe9, 0x11, 0x49, 0xdc, 0x8e, 0xca,
+	0x5f, 0x29, 0x6d, 0x0f, 0x99, 0x80, 0x07, 0x21, 0x25, 0xbd, 0x84, 0xa4, 0xec, 0x50, 0x63, 0xab,
+	0x0e, 0xe1, 0x71, 0x24, 0xba, 0x7d, 0x7b, 0xf8, 0x37, 0x20, 0x78, 0xc2, 0xd8, 0x1f, 0x45, 0x47,
+	0x51, 0x0a, 0xb5, 0x8d, 0x92, 0xbc, 0xab, 0x24, 0x0c, 0x53, 0xca, 0xcd, 0x5a, 0x59, 0xb2, 0x55,
+	0x86, 0x34, 0xe5, 0x11, 0x4b, 0xa0, 0x91, 0xca, 0x99, 0xe3, 0x71, 0x42, 0x8e, 0x28, 0x34, 0x7b,
+	0x51, 0x32, 0x24, 0x69, 0x44, 0x12, 0x01, 0x1a, 0x4e, 0xa8, 0x38, 0x66, 0xe9, 0x21, 0x34, 0xc4,
+	0x1d, 0x12, 0x93, 0xa4, 0x4b, 0xdb, 0x47, 0x2c, 0xcc, 0x62, 0x63, 0x7a, 0xd1, 0xd6, 0x78, 0x9e,
+	0x31, 0x41, 0x39, 0xd4, 0xa5, 0x2e, 0x4b, 0xb8, 0x20, 0x89, 0x68, 0x0f, 0x49, 0x9c, 0x51, 0x0e,
+	0xf9, 0x25, 0xe7, 0xad, 0xfd, 0x3c, 0xa3, 0x19, 0xe8, 0x75, 0x4c, 0xb8, 0x68, 0x77, 0x62, 0xd6,
+	0x3d, 0x84, 0x86, 0x72, 0x48, 0xb2, 0xd8, 0xac, 0xb5, 0x6b, 0x36, 0x20, 0x46, 0xd0, 0x12, 0x95,
+	0x54, 0x03, 0x9a, 0xb6, 0xbb, 0x31, 0xcb, 0x2a, 0x4b, 0x54, 0xfe, 0x3a, 0xf2, 0x05, 0xb7, 0x83,
+	0xa5, 0x7b, 0xcb, 0xd0, 0xf2, 0x84, 0x1a, 0xd9, 0x5e, 0x3b, 0x2b, 0x52, 0x70, 0xee, 0xee, 0x03,
+	0x45, 0x2e, 0x38, 0x6f, 0x1f, 0x51, 0x91, 0x46, 0x5d, 0x88, 0xe8, 0x90, 0x8e, 0xb9, 0x60, 0x29,
+	0x85, 0xe6, 0x2e, 0x1b, 0xf4, 0xe4, 0xde, 0x83, 0x20, 0x77, 0x3b, 0x3a, 0x93, 0x46, 0x47, 0x03,
+	0x96, 0x82, 0x1b, 0xb5, 0x43, 0x92, 0x43, 0x70, 0xf8, 0x68, 0x37, 0x4b, 0x69, 0xd8, 0x26, 0x9c,
+	0x53, 0x01, 0x2f, 0xc1, 0xae, 0xdc, 0x91, 0xe4, 0x90, 0xa6, 0x1c, 0x1e, 0xff, 0xee, 0xb8, 0xdd,
+	0x8d, 0x49, 0x74, 0x54, 0x2a, 0x2c, 0xb8, 0xcb, 0x25, 0xa4, 0x1c, 0x5a, 0x83, 0x21, 0x1d, 0xb5,
+	0x49, 0xaf, 0x97, 0xd2, 0x1e, 0x11, 0x4c, 0x6f, 0x9e, 0x4f, 0x7e, 0x73, 0x0f, 0x4d, 0x3d, 0x94,
+	0x30, 0x0e, 0xd1, 0xc5, 0xdd, 0xbc, 0xa7, 0x78, 0x79, 0x47, 0xb5, 0xdb, 0x51, 0x80, 0x16, 0x7e,
+	0x4d, 0x9f, 0x67, 0x94, 0x8b, 0xe5, 0x9b, 0x20, 0xc6, 0x07, 0x2c, 0xe1, 0x74, 0x73, 0xfd, 0xd5,
+	0x3f, 0xff, 0xf3, 0xe7, 0xb7, 0x97, 0xf1, 0x62, 0x40, 0x32, 0xd1, 0x0f, 0xf4, 0xc8, 0xf1, 0xe0,
+	0x44, 0x6f, 0xc7, 0x53, 0xdc, 0x43, 0x97, 0xf6, 0xf2, 0xbd, 0xc0, 0xb1, 0x63, 0xca, 0x48, 0x0d,
+	0xcf, 0x0a, 0x0c, 0xd6, 0x88, 0xe4, 0x40, 0x07, 0x7a, 0x7b, 0xd9, 0x44, 0x4f, 0xd1, 0x85, 0x9f,
+	0xab, 0xd9, 0xc1, 0x4b, 0xb6, 0xa5, 0x5c, 0x66, 0x48, 0x96, 0x21, 0x48, 0x53, 0x2c, 0x2a, 0x0a,
+	0x8c, 0xdf, 0x0d, 0xcc, 0xa9, 0x10, 0xe4, 0xd3, 0x8d, 0x9f, 0xa2, 0x77, 0x1e, 0x30, 0x16, 0xe3,
+	0x05, 0xbb, 0xb5, 0x94, 0x18, 0xb3, 0x8b, 0x75, 0x40, 0x1b, 0x6d, 0x2a, 0xa3, 0x8b, 0xf8, 0x46,
+	0x69, 0x54, 0x9e, 0x82, 0xc1, 0x89, 0x5a, 0x0f, 0xa7, 0xf8, 0x11, 0x9a, 0x92, 0xfa, 0x1c, 0xd7,
+	0x4c, 0x14, 0x03, 0xb3, 0x04, 0x20, 0xda, 0xfa, 0x82, 0xb2, 0x3e, 0x8f, 0xe7, 0x5c, 0xeb, 0x1c,
+	0x33, 0x74, 0xf9, 0xf3, 0x3c, 0xe6, 0x29, 0xc7, 0x9b, 0xb6, 0x09, 0x0b, 0x30, 0x14, 0x6b, 0x5e,
+	0x5c, 0x13, 0xad, 0x29, 0xa2, 0x25, 0xbc, 0x50, 0x12, 0x85, 0x4e, 0x3f, 0x0e, 0xd1, 0x8c, 0xd5,
+	0x8e, 0x63, 0x9f, 0xc5, 0xa2, 0x57, 0xeb, 0x7e, 0x05, 0xff, 0x7c, 0x84, 0x79, 0xef, 0xfe, 0xd6,
+	0x40, 0xf3, 0x77, 0x4c, 0xc8, 0x7e, 0xa0, 0xa3, 0x06, 0xbe, 0x65, 0x5b, 0xac, 0xc1, 0x86, 0xf7,
+	0xf6, 0x19, 0x5a, 0x9a, 0xfc, 0x33, 0x45, 0xfe, 0x23, 0xfc, 0x29, 0x3c, 0x6f, 0x41, 0x3d, 0x63,
+	0xb0, 0x96, 0xe2, 0x5f, 0x1b, 0x08, 0xd7, 0x4c, 0x73, 0x3c, 0x99, 0xba, 0x18, 0x99, 0xed, 0xb3,
+	0xd4, 0xb4, 0x8b, 0x9f, 0x28, 0x17, 0x3f, 0xc6, 0x1f, 0x9e, 0xdb, 0x45, 0x8e, 0xff, 0xde, 0x40,
+	0x8b, 0x35, 0x93, 0x5f, 0x44, 0x5c, 0xb0, 0x74, 0x8c, 0x3f, 0x9a, 0x48, 0xac, 0xb5, 0x8c, 0x97,
+	0x1f, 0x9f, 0x4f, 0x59, 0xfb, 0xba, 0xaf, 0x7c, 0xfd, 0x19, 0xfe, 0xec, 0x7f, 0x18, 0xce, 0xa0,
+	0xaf, 0xfd, 0x8b, 0xd1, 0x54, 0x4b, 0xa6, 0x60, 0xee, 0x5e, 0x51, 0x22, 0x70, 0xaf, 0x68, 0x44,
+	0xbb, 0xb0, 0xa3, 0x5c, 0x78, 0x1f, 0x6f, 0x7b, 0x5c, 0x50, 0xd9, 0x9d, 0x35, 0x89, 0xcf, 0xd0,
+	0x05, 0x65, 0x80, 0xe3, 0xba, 0x51, 0x0e, 0x9e, 0x27, 0x06, 0xd2, 0x84, 0xb7, 0x15, 0xe1, 0x1a,
+	0x5e, 0x9d, 0x44, 0xc8, 0xf1, 0x1f, 0x1a, 0x68, 0x46, 0xb5, 0x34, 0xd3, 0xb0, 0x56, 0xb3, 0x59,
+	0x19, 0xfa, 0x75, 0xbf, 0x82, 0xa6, 0xfe, 0xb1, 0xa2, 0xfe, 0x01, 0xde, 0x39, 0x5f, 0x5f, 0x8b,
+	0x11, 0x1e, 0xa1, 0x4b, 0x7b, 0x3a, 0x97, 0xad, 0x1c, 0xd6, 0x5a, 0x0a, 0x1f, 0xd6, 0x05, 0xa8,
+	0xe9, 0x7f, 0xa8, 0xe8, 0x3f, 0xc2, 0x1f, 0x78, 0xe8, 0x4d, 0xbe, 0x6c, 0x8d, 0x76, 0x8a, 0xa6,
+	0x8d, 0x19, 0x8e, 0x41, 0xeb, 0xc5, 0x98, 0xaf, 0x7a, 0x50, 0x4d, 0xfe, 0xbe, 0x22, 0xdf, 0xc4,
+	0xeb, 0x67, 0x90, 0x73, 0x9c, 0xa0, 0xe9, 0x03, 0x99, 0x03, 0x3c, 0x4a, 0x22, 0xe1, 0x72, 0x16,
+	0x62, 0x90, 0xd3, 0x42, 0x35, 0xe7, 0x2d, 0xc5, 0xd9, 0xc4, 0x2b, 0x25, 0xa7, 0xca, 0xec, 0x03,
+	0x99, 0xf7, 0x17, 0x67, 0xe4, 0xb7, 0x08, 0x15, 0x4d, 0x39, 0x86, 0x4d, 0x16, 0xbd, 0x6c, 0xfa,
+	0x60, 0x4d, 0xb9, 0xaa, 0x28, 0x17, 0xf0, 0x75, 0x88, 0x92, 0xe3, 0x53, 0x34, 0xa3, 0x1a, 0x99,
+	0x08, 0xbf, 0x56, 0x33, 0x57, 0x09, 0xf3, 0x1b, 0x3e, 0x85, 0x92, 0xf2, 0x03, 0x45, 0xb9, 0x85,
+	0x37, 0xaa, 0x94, 0x3a, 0xec, 0x5b, 0xd3, 0xf9, 0x12, 0xcd, 0x3a, 0x36, 0xf0, 0xfa, 0x04, 0xf3,
+	0xe7, 0x76, 0x00, 0x98, 0x5a, 0xc7, 0x01, 0x5e, 0x0c, 0xf5, 0x18, 0xcd, 0xb4, 0xf2, 0xc4, 0x6b,
+	0x57, 0x0a, 0x2a, 0x7b, 0xca, 0x42, 0xe0, 0x3d, 0xe5, 0x28, 0x68, 0xf2, 0x6d, 0x45, 0xbe, 0x8e,
+	0x9b, 0x25, 0xb9, 0xce, 0xed, 0x14, 0x67, 0x41, 0x9d, 0xa1, 0x59, 0xbb, 0x7d, 0xa5, 0xeb, 0x0e,
+	0x04, 0x76, 0xbd, 0xa2, 0xe1, 0x0f, 0xc0, 0x36, 0x3b, 0xc7, 0xbf, 0x42, 0xef, 0xdc, 0x63, 0x21,
+	0x75, 0x73, 0x14, 0x29, 0x01, 0x73, 0x94, 0x1c, 0xa8, 0xe5, 0x56, 0x85, 0x6d, 0x79, 0xed, 0xb4,
+	0xa6, 0xf3, 0x11, 0x9a, 0x92, 0x2d, 0x2a, 0x59, 0x8a, 0x12, 0x81, 0x27, 0xaf, 0x46, 0xfc, 0x59,
+	0x4a, 0xa2, 0xac, 0x3d, 0x43, 0x97, 0x64, 0xc8, 0x6f, 0xc5, 0xd1, 0xc0, 0x3d, 0x6e, 0x8c, 0x14,
+	0xdc, 0x7e, 0x06, 0xe4, 0x93, 0x92, 0x2c, 0x79, 0x35, 0x2e, 0xa6, 0x84, 0xa0, 0xe9, 0xa2, 0x91,
+	0xbb, 0xd1, 0x2d, 0x5b, 0xe7, 0x62, 0x02, 0xba, 0xc2, 0x95, 0xd5, 0x63, 0x74, 0xf9, 0xbe, 0xbe,
+	0xd6, 0xfc, 0x82, 0x52, 0x37, 0xe1, 0xb2, 0x00, 0x70, 0xb9, 0x59, 0x38, 0x9f, 0xb4, 0xdc, 0xec,
+	0x6b, 0x7d, 0xd1, 0xb7, 0x14, 0xcd, 0xd8, 0xed, 0xdd, 0x95, 0xee, 0x5a, 0x3e, 0x2f, 0x35, 0xb0,
+	0xd6, 0x6c, 0x6a, 0x8e, 0x5f, 0xa0, 0x2b, 0x9f, 0xd3, 0xd1, 0x6e, 0x71, 0xb5, 0xe0, 0x78, 0xc3,
+	0xcd, 0xe6, 0x6c, 0xcc, 0xf0, 0x6e, 0x4e, 0x52, 0xd1, 0xcc, 0x1b, 0x8a, 0xf9, 0x26, 0x5e, 0x2a,
+	0x99, 0xdd, 0x4b, 0x0c, 0x97, 0x27, 0x4b, 0xcb, 0x94, 0x2f, 0x5a, 0xc7, 0x64, 0x50, 0xd9, 0x5e,
+	0x36, 0x04, 0x6f, 0x2f, 0x57, 0xc3, 0x7f, 0xb2, 0xc8, 0x7b, 0x6f, 0x50, 0x54, 0x4a, 0x82, 0x13,
+	0x31, 0x6a, 0x47, 0xe1, 0xa9, 0xec, 0xbb, 0x63, 0xa2, 0xd2, 0x77, 0x17, 0x03, 0xfb, 0x5e, 0x55,
+	0xf1, 0xf7, 0x5d, 0xba, 0xc0, 0x4b, 0x1f, 0xf0, 0x7d, 0xf4, 0xbd, 0x3d, 0x92, 0xe0, 0x1b, 0xee,
+	0x4d, 0x29, 0x31, 0x2c, 0x73, 0x5a, 0xbe, 0x47, 0x92, 0xc7, 0x4c, 0xd0, 0x14, 0x9a, 0xc8, 0x0e,
+	0x49, 0xac, 0x7d, 0x4d, 0xd0, 0xa5, 0xaf, 0x75, 0xd9, 0xc6, 0xdd, 0x80, 0x46, 0x0a, 0xc6, 0xfb,
+	0x12, 0xd4, 0xae, 0x2f, 0x2b, 0x9e, 0x6b, 0x18, 0x97, 0x3c, 0xa6, 0x1a, 0x84, 0x23, 0x84, 0x5a,
+	0x4a, 0xa8, 0x2e, 0x22, 0xce, 0xf6, 0x2a, 0xe5, 0x60, 0xd0, 0xb3, 0x61, 0x4d, 0xb4, 0xa2, 0x88,
+	0x6e, 0xe0, 0x6b, 0xf6, 0x18, 0xc9, 0x3f, 0x32, 0xc2, 0xe3, 0xdf, 0x36, 0xd0, 0x95, 0xd6, 0x93,
+	0x2f, 0x0f, 0xf6, 0xbf, 0x28, 0xee, 0x04, 0xee, 0xdc, 0x38, 0x18, 0x3c, 0x37, 0x15, 0x15, 0xcd,
+	0xfb, 0x91, 0xe2, 0xbd, 0x8d, 0xb7, 0xaa, 0xbc, 0x50, 0xf6, 0xff, 0x12, 0xcd, 0xb9, 0x66, 0x38,
+	0x9e, 0xc0, 0x51, 0xac, 0x91, 0xad, 0x89, 0x3a, 0xda, 0x91, 0x4d, 0xe5, 0xc8, 0x0a, 0x5e, 0xf6,
+	0x3a, 0xc2, 0x71, 0x0f, 0x5d, 0xbe, 0x2b, 0xab, 0x65, 0x8f, 0x55, 0xf5, 0xc8, 0x3d, 0x8a, 0x2c,
+	0x00, 0xbc, 0xfb, 0x39, 0xb8, 0xff, 0xcc, 0x53, 0xd5, 0x38, 0x2c, 0xd0, 0x8c, 0xd2, 0x7f, 0x12,
+	0x89, 0xfe, 0x57, 0xb4, 0x92, 0xb8, 0xda, 0x08, 0x78, 0xf4, 0xb8, 0x0a, 0xfe, 0x4d, 0xa0, 0xb8,
+	0x82, 0x43, 0x3a, 0x0e, 0x4e, 0x0e, 0xe9, 0xf8, 0x14, 0xff, 0x1a, 0xbd, 0xab, 0x9a, 0xee, 0x86,
+	0x47, 0x51, 0xa2, 0xfb, 0xb8, 0x55, 0x33, 0x6c, 0xa1, 0x86, 0xfd, 0xd6, 0x64, 0x25, 0x7f, 0x5e,
+	0x95, 0x7b, 0x40, 0xa4, 0xb2, 0xcc, 0xd6, 0xaf, 0xaa, 0xb6, 0x2a, 0xc4, 0xed, 0xc6, 0xb1, 0xf6,
+	0x60, 0xbb, 0x66, 0xdc, 0x55, 0x30, 0x4e, 0xbc, 0x77, 0xa6, 0xde, 0x59, 0x23, 0xa1, 0x42, 0x67,
+	0x9b, 0xc4, 0x71, 0x31, 0x12, 0xca, 0x82, 0x77, 0x24, 0x2c, 0xd4, 0x3f, 0x12, 0x8e, 0xd2, 0x59,
+	0x23, 0x91, 0x07, 0xef, 0x57, 0x0d, 0x34, 0x57, 0xb4, 0xd5, 0xec, 0x9b, 0xa0, 0x61, 0x97, 0x7c,
+	0x6b, 0xa2, 0x8e, 0x3f, 0xfa, 0x95, 0xdc, 0xd6, 0x5e, 0x7b, 0xd5, 0x40, 0xef, 0x7e, 0x99, 0x57,
+	0x80, 0x77, 0x73, 0x59, 0x75, 0x0c, 0xaa, 0x28, 0x38, 0x06, 0x75, 0x25, 0xed, 0xc7, 0x96, 0xf2,
+	0x63, 0x15, 0xdf, 0x2c, 0xfd, 0xa8, 0x14, 0x9c, 0x29, 0xc7, 0xdf, 0xa0, 0x8b, 0x8f, 0xf3, 0x12,
+	0xb3, 0x5b, 0x48, 0xd3, 0x42, 0xb0, 0x90, 0x56, 0x60, 0x9a, 0x68, 0x49, 0x11, 0x5d, 0xc5, 0xf3,
+	0x25, 0x91, 0x2e, 0x5b, 0xe3, 0x11, 0x9a, 0x69, 0x59, 0x65, 0xea, 0x4a, 0x2e, 0x6b, 0x21, 0x70,
+	0x2e, 0xeb, 0x28, 0xf8, 0xaf, 0xa6, 0x76, 0x25, 0x3c, 0x38, 0x91, 0xbf, 0xa7, 0x38, 0x46, 0xd3,
+	0x5f, 0x9a, 0x02, 0xb8, 0x9b, 0x37, 0x15, 0x62, 0x30, 0x6f, 0xb2, 0x50, 0xff, 0xb9, 0x55, 0x94,
+	0xd6, 0x83, 0x93, 0x01, 0x11, 0xfd, 0x53, 0x19, 0x29, 0x8a, 0x86, 0x95, 0xeb, 0x51, 0x29, 0x07,
+	0x23, 0x85, 0x0d, 0xfb, 0x23, 0x45, 0x54, 0x1a, 0xff, 0x06, 0x5d, 0xbc, 0x97, 0xd7, 0xee, 0xdd,
+	0x19, 0xd3, 0x42, 0x70, 0xc6, 0x0a, 0xcc, 0x3f, 0x63, 0xfa, 0x3d, 0x00, 0x9f, 0xa2, 0x59, 0x5d,
+	0xc0, 0xbc, 0xab, 0xca, 0xff, 0x6e, 0x8e, 0xe2, 0x40, 0x60, 0x8e, 0x52, 0xd1, 0xd0, 0x84, 0xef,
+	0x29, 0xc2, 0x0d, 0xbc, 0x66, 0x47, 0x73, 0xa5, 0x18, 0xe4, 0xaf, 0x0c, 0x66, 0xda, 0x9e, 0xa1,
+	0xe9, 0x87, 0x19, 0x13, 0x54, 0xa5, 0x47, 0xce, 0xb4, 0x15, 0x62, 0x70, 0xda, 0x2c, 0xd4, 0x3f,
+	0x8a, 0xea, 0xd9, 0x42, 0x65, 0x26, 0xf8, 0x25, 0xba, 0x52, 0x34, 0xd9, 0x23, 0xa2, 0xdb, 0x77,
+	0xc3, 0xad, 0x8b, 0x81, 0xe1, 0xb6, 0xaa, 0xe2, 0x2e, 0xcf, 0xcd, 0x65, 0x88, 0x36, 0xe8, 0x48,
+	0xdd, 0x9f, 0x34, 0x3e, 0xc4, 0xbf, 0x6f, 0xa0, 0xf9, 0xdc, 0x82, 0x2c, 0x67, 0x7c, 0x4e, 0x07,
+	0x8c, 0x47, 0xc2, 0x2d, 0x03, 0xd6, 0x60, 0xb0, 0x0c, 0x08, 0x68, 0xf9, 0x37, 0x8a, 0xf6, 0x44,
+	0x6a, 0x07, 0xa1, 0xe6, 0xfc, 0x63, 0x03, 0xe1, 0xd2, 0x88, 0x0c, 0x6a, 0x61, 0x4a, 0x8e, 0xb1,
+	0x87, 0xc4, 0xe0, 0x60, 0xc1, 0x0f, 0x52, 0xf3, 0x1f, 0x8a, 0xb6, 0x33, 0xc7, 0x86, 0xf6, 0x18,
+	0xcd, 0x2a, 0x2b, 0x77, 0x18, 0x49, 0xee, 0x0f, 0x68, 0xe2, 0x2e, 0x3f, 0x07, 0x02, 0x97, 0x5f,
+	0x45, 0xc3, 0x1f, 0x90, 0x72, 0xf6, 0x98, 0x91, 0x24, 0x60, 0x92, 0xe7, 0x04, 0x5d, 0x29, 0xda,
+	0xee, 0xc7, 0x8c, 0x53, 0x0c, 0xdb, 0x55, 0x98, 0x7f, 0x41, 0x58, 0x2a, 0xfe, 0xe3, 0xc3, 0xe2,
+	0xee, 0x2a, 0x2a, 0x81, 0xae, 0xec, 0xeb, 0x87, 0x33, 0x1d, 0x8d, 0x1c, 0x72, 0x17, 0x03, 0xc9,
+	0xab, 0x2a, 0x9a, 0xfc, 0xa6, 0x22, 0xbf, 0x8e, 0xaf, 0x96, 0xe4, 0xe6, 0x85, 0x4e, 0x5e, 0x61,
+	0xa7, 0xe5, 0xfa, 0x7d, 0x98, 0xd1, 0x8c, 0xba, 0x7b, 0xad, 0x10, 0x83, 0x7b, 0xcd, 0x42, 0x27,
+	0xed, 0x35, 0x9a, 0x15, 0x7b, 0x6d, 0xf6, 0x4e, 0x74, 0x14, 0x09, 0xb5, 0x59, 0x18, 0x3b, 0x74,
+	0xe7, 0xd4, 0x81, 0xc0, 0x39, 0xad, 0x68, 0xf8, 0xaf, 0x3d, 0x39, 0x67, 0x2c, 0xd5, 0xdb, 0xf9,
+	0xfd, 0xa3, 0x23, 0xe9, 0xfe, 0xd2, 0x40, 0x57, 0x0b, 0x1b, 0x7c, 0x6f, 0xac, 0x43, 0x25, 0xde,
+	0x06, 0x49, 0x4a, 0x05, 0x30, 0xef, 0x01, 0xf5, 0xb4, 0x4b, 0xdf, 0x57, 0x2e, 0xbd, 0x87, 0x6f,
+	0x4f, 0x72, 0xa9, 0x4c, 0x00, 0xfa, 0x08, 0xdd, 0x21, 0x5c, 0xec, 0xc9, 0x67, 0xc3, 0x4a, 0xd0,
+	0x28, 0xe5, 0x60, 0xd0, 0xb0, 0x61, 0xff, 0x4c, 0xcb, 0xa7, 0x54, 0xf5, 0x26, 0x89, 0x5f, 0xa0,
+	0xb9, 0xfd, 0x3e, 0x89, 0x12, 0x5e, 0x34, 0x74, 0xd3, 0x9d, 0x0a, 0x78, 0x5e, 0x4e, 0x20, 0xc3,
+	0x28, 0x38, 0x83, 0x93, 0xae, 0x34, 0x7a, 0x8a, 0xdb, 0x68, 0xea, 0xb1, 0x7c, 0xb5, 0x75, 0xeb,
+	0x2f, 0x4a, 0x04, 0xd6, 0x5f, 0x34, 0xe2, 0xdf, 0xb9, 0xea, 0x0d, 0x38, 0x38, 0x19, 0x64, 0x9d,
+	0xb6, 0x4a, 0xaa, 0x53, 0x34, 0xb3, 0xcb, 0x7b, 0x24, 0x0d, 0x55, 0xcb, 0x4a, 0x15, 0xc1, 0x46,
+	0xc0, 0x1c, 0xc3, 0x55, 0xf0, 0x57, 0x11, 0x14, 0x2b, 0x0f, 0x88, 0x52, 0xc7, 0x43, 0x34, 0x9b,
+	0x37, 0x79, 0x90, 0x75, 0xe4, 0x4b, 0xad, 0xbb, 0xa4, 0x1d, 0x08, 0x5c, 0xd2, 0x15, 0x0d, 0x7f,
+	0x31, 0x4b, 0xd3, 0x0e, 0x34, 0xcd, 0xb7, 0xe8, 0xd2, 0xc1, 0xa8, 0x25, 0x48, 0xaf, 0xfa, 0x22,
+	0x69, 0xa4, 0xe0, 0xa5, 0xb7, 0x04, 0xfd, 0x13, 0x27, 0x46, 0x01, 0x57, 0x4a, 0x45, 0xb5, 0xc0,
+	0x70, 0x89, 0x0c, 0xe2, 0x12, 0xd9, 0x04, 0x2e, 0x91, 0x9d, 0x83, 0x4b, 0x64, 0x25, 0xd7, 0x43,
+	0xf4, 0xf6, 0xc1, 0x08, 0x5f, 0x77, 0x0d, 0x19, 0xfb, 0x37, 0xaa, 0x62, 0xff, 0xa9, 0x23, 0x46,
+	0xae, 0xfb, 0xaa, 0x96, 0x50, 0x73, 0x3f, 0x97, 0x82, 0x99, 0xd2, 0xfd, 0x0e, 0xa7, 0xe9, 0x90,
+	0x86, 0x5a, 0x09, 0xac, 0x8e, 0x8f, 0x82, 0x90, 0x0a, 0x12, 0xc5, 0xa5, 0xfb, 0x47, 0xe8, 0xb2,
+	0xb1, 0x7a, 0x3f, 0x0e, 0xff, 0xef, 0x74, 0x9a, 0x26, 0xe0, 0x51, 0x2f, 0x91, 0xdd, 0xa1, 0x68,
+	0x6a, 0x3f, 0x66, 0x59, 0xb5, 0x34, 0x9c, 0x7f, 0xec, 0xa0, 0x10, 0x43, 0x76, 0x55, 0x2b, 0xd8,
+	0x18, 0x14, 0x8b, 0xd5, 0x87, 0x10, 0x79, 0x8a, 0xe2, 0x54, 0x4e, 0xf3, 0xd8, 0xb0, 0xe8, 0x86,
+	0x39, 0x2b, 0x2e, 0x2c, 0x01, 0x88, 0xff, 0xea, 0xad, 0x0e, 0x43, 0xfc, 0x02, 0xcd, 0xb7, 0xba,
+	0x7d, 0x2a, 0xb3, 0xbe, 0xd0, 0xd4, 0xf0, 0xdc, 0xcc, 0xa7, 0x06, 0x83, 0x2b, 0xad, 0x04, 0x27,
+	0x45, 0x79, 0x15, 0x85, 0x8c, 0x3d, 0x9c, 0xa1, 0xb9, 0x07, 0x34, 0x09, 0xa3, 0xa4, 0x57, 0x30,
+	0x3b, 0x07, 0x61, 0x05, 0x3c, 0x1f, 0x2f, 0xb0, 0x6d, 0x73, 0x5e, 0x53, 0x79, 0x94, 0x23, 0x99,
+	0x9f, 0xba, 0xce, 0x48, 0x3a, 0x67, 0xed, 0x12, 0x80, 0xf8, 0x47, 0x32, 0x3f, 0xd6, 0x7f, 0xd7,
+	0x40, 0x73, 0x07, 0x9c, 0x7f, 0x45, 0xc7, 0x3d, 0x9a, 0xdc, 0x55, 0x9f, 0x92, 0xb8, 0xdd, 0xa9,
+	0x80, 0xe0, 0x35, 0xb6, 0xa6, 0xe3, 0x7f, 0x31, 0xc9, 0xbf, 0x56, 0x91, 0xf5, 0x8c, 0x1e, 0x4d,
+	0xac, 0x13, 0xb8, 0x8b, 0xa6, 0x0f, 0x38, 0xd7, 0x0e, 0xac, 0x54, 0x8c, 0xbb, 0xd4, 0xab, 0x1e,
+	0xd4, 0x7f, 0x31, 0xc9, 0x49, 0x65, 0xb6, 0x72, 0xf1, 0x2b, 0x3a, 0x96, 0x5b, 0xc0, 0xbd, 0xf7,
+	0x68, 0x21, 0xb8, 0xbd, 0x0a, 0xcc, 0x9f, 0x8b, 0x1d, 0xe6, 0x2a, 0xc1, 0x49, 0x9f, 0x46, 0xbd,
+	0xbe, 0x90, 0x35, 0x9a, 0x59, 0xdd, 0x2c, 0x3f, 0x9e, 0xdd, 0xa3, 0xdd, 0x81, 0xce, 0xc5, 0xf9,
+	0xb1, 0xe2, 0xdc, 0xc6, 0xb7, 0xfc, 0x9c, 0xd6, 0x50, 0xc6, 0xe8, 0x42, 0x3e, 0x1b, 0xee, 0xcb,
+	0x6d, 0x2e, 0x03, 0x5f, 0x6e, 0x0d, 0xe4, 0x2f, 0xf7, 0x99, 0xf9, 0xaa, 0xb3, 0xc9, 0x12, 0xc4,
+	0xa3, 0xfc, 0x63, 0xa2, 0x07, 0x29, 0x1b, 0x30, 0x4e, 0xe2, 0x4a, 0x09, 0xa2, 0x8a, 0x82, 0x25,
+	0x88, 0xba, 0x92, 0xff, 0xec, 0xd7, 0xdf, 0x2e, 0xb5, 0x07, 0x46, 0x59, 0xad, 0xe2, 0x8a, 0x05,
+	0x77, 0x15, 0x57, 0x40, 0x70, 0x15, 0xd7, 0x74, 0xfc, 0xab, 0xb8, 0xea, 0x81, 0xb9, 0x7a, 0x8e,
+	0xd1, 0x8c, 0xb6, 0x22, 0xcf, 0xe2, 0x4a, 0x1e, 0x61, 0x23, 0x60, 0x1e, 0xe1, 0x2a, 0xf8, 0x6f,
+	0x3d, 0x86, 0x7d, 0x28, 0x15, 0x0d, 0xb5, 0x40, 0xa8, 0xf5, 0x64, 0xff, 0x69, 0x4b, 0x7d, 0x49,
+	0x55, 0x29, 0x34, 0x17, 0x72, 0xb8, 0xd0, 0x6c, 0xc1, 0xfe, 0xbb, 0xb6, 0xf5, 0x9d, 0x96, 0x75,
+	0xbe, 0x27, 0xe8, 0x72, 0xd9, 0xbc, 0x52, 0x6c, 0xb5, 0x00, 0xb0, 0xd8, 0xea, 0xe0, 0x13, 0x9e,
+	0xb2, 0x4a, 0x62, 0x8e, 0x47, 0x39, 0xdf, 0x7e, 0xfe, 0x3d, 0x58, 0x9d, 0x4f, 0x03, 0x5e, 0xbe,
+	0x02, 0x9f, 0xf4, 0xf0, 0x51, 0x7e, 0x70, 0xe6, 0xbc, 0xd0, 0xcf, 0x58, 0x06, 0x2a, 0x53, 0x6b,
+	0x23, 0x70, 0x19, 0xca, 0x51, 0x98, 0xf0, 0xa8, 0x69, 0x91, 0x73, 0x79, 0xe6, 0xef, 0xd7, 0xdf,
+	0x1d, 0xf7, 0xbd, 0xef, 0x8e, 0xfb, 0x67, 0xbd, 0x3b, 0xaa, 0x6f, 0xe5, 0xf6, 0x1e, 0xff, 0xe3,
+	0x75, 0xb3, 0xf1, 0xdd, 0xeb, 0x66, 0xe3, 0xdf, 0xaf, 0x9b, 0x8d, 0x3f, 0xbd, 0x69, 0xbe, 0xf5,
+	0xdd, 0x9b, 0xe6, 0x5b, 0xff, 0x7a, 0xd3, 0x7c, 0xeb, 0x97, 0x3f, 0xed, 0x45, 0xa2, 0x9f, 0x75,
+	0x76, 0xba, 0xec, 0xa8, 0x68, 0xa4, 0xbe, 0x9b, 0xeb, 0xb2, 0xb8, 0x10, 0xa8, 0x1a, 0xe4, 0xf0,
+	0xd3, 0x60, 0x54, 0xda, 0x55, 0x3e, 0x74, 0x2e, 0x28, 0xd5, 0x4f, 0xff, 0x3b, 0x00, 0x12, 0x06,
+	0x66, 0xae, 0xe2, 0x2c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -227,8 +239,10 @@ type QueryClient interface {
 	DerivedPools(ctx context.Context, in *QueryDerivedPoolsRequest, opts ...grpc.CallOption) (*QueryDerivedPoolsResponse, error)
 	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error)
+	LiquidityProviderHistory(ctx context.Context, in *QueryLiquidityProviderHistoryRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderHistoryResponse, error)
 	Saver(ctx context.Context, in *QuerySaverRequest, opts ...grpc.CallOption) (*QuerySaverResponse, error)
 	Savers(ctx context.Context, in *QuerySaversRequest, opts ...grpc.CallOption) (*QuerySaversResponse, error)
+	SaverHistory(ctx context.Context, in *QuerySaverHistoryRequest, opts ...grpc.CallOption) (*QuerySaverHistoryResponse, error)
 	Borrower(ctx context.Context, in *QueryBorrowerRequest, opts ...grpc.CallOption) (*QueryBorrowerResponse, error)
 	Borrowers(ctx context.Context, in *QueryBorrowersRequest, opts ...grpc.CallOption) (*QueryBorrowersResponse, error)
 	TradeUnit(ctx context.Context, in *QueryTradeUnitRequest, opts ...grpc.CallOption) (*QueryTradeUnitResponse, error)
@@ -243,6 +257,7 @@ type QueryClient interface {
 	PoolSlips(ctx context.Context, in *QueryPoolSlipsRequest, opts ...grpc.CallOption) (*QueryPoolSlipsResponse, error)
 	OutboundFee(ctx context.Context, in *QueryOutboundFeeRequest, opts ...grpc.CallOption) (*QueryOutboundFeesResponse, error)
 	OutboundFees(ctx context.Context, in *QueryOutboundFeesRequest, opts ...grpc.CallOption) (*QueryOutboundFeesResponse, error)
+	DexAggregators(ctx context.Context, in *QueryDexAggregatorsRequest, opts ...grpc.CallOption) (*QueryDexAggregatorsResponse, error)
 	StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (*QueryStreamingSwapsResponse, error)
 	Ban(ctx context.Context, in *QueryBanRequest, opts ...grpc.CallOption) (*BanVoter, error)
@@ -264,12 +279,15 @@ type QueryClient interface {
 	Network(ctx context.Context, in *QueryNetworkRequest, opts ...grpc.CallOption) (*QueryNetworkResponse, error)
 	BalanceModule(ctx context.Context, in *QueryBalanceModuleRequest, opts ...grpc.CallOption) (*QueryBalanceModuleResponse, error)
 	QuoteSwap(ctx context.Context, in *QueryQuoteSwapRequest, opts ...grpc.CallOption) (*QueryQuoteSwapResponse, error)
+	QuoteSwapBatch(ctx context.Context, in *QueryQuoteSwapBatchRequest, opts ...grpc.CallOption) (*QueryQuoteSwapBatchResponse, error)
 	QuoteSaverDeposit(ctx context.Context, in *QueryQuoteSaverDepositRequest, opts ...grpc.CallOption) (*QueryQuoteSaverDepositResponse, error)
 	QuoteSaverWithdraw(ctx context.Context, in *QueryQuoteSaverWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteSaverWithdrawResponse, error)
 	QuoteLoanOpen(ctx context.Context, in *QueryQuoteLoanOpenRequest, opts ...grpc.CallOption) (*QueryQuoteLoanOpenResponse, error)
 	QuoteLoanClose(ctx context.Context, in *QueryQuoteLoanCloseRequest, opts ...grpc.CallOption) (*QueryQuoteLoanCloseResponse, error)
 	ConstantValues(ctx context.Context, in *QueryConstantValuesRequest, opts ...grpc.CallOption) (*QueryConstantValuesResponse, error)
 	SwapQueue(ctx context.Context, in *QuerySwapQueueRequest, opts ...grpc.CallOption) (*QuerySwapQueueResponse, error)
+	LimitSwapBook(ctx context.Context, in *QueryLimitSwapBookRequest, opts ...grpc.CallOption) (*QueryLimitSwapBookResponse, error)
+	LimitSwapsByAddress(ctx context.Context, in *QueryLimitSwapsByAddressRequest, opts ...grpc.CallOption) (*QueryLimitSwapsByAddressResponse, error)
 	LastBlocks(ctx context.Context, in *QueryLastBlocksRequest, opts ...grpc.CallOption) (*QueryLastBlocksResponse, error)
 	ChainsLastBlock(ctx context.Context, in *QueryChainsLastBlockRequest, opts ...grpc.CallOption) (*QueryLastBlocksResponse, error)
 	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
@@ -389,6 +407,15 @@ func (c *queryClient) LiquidityProviders(ctx context.Context, in *QueryLiquidity
 	return out, nil
 }
 
+func (c *queryClient) LiquidityProviderHistory(ctx context.Context, in *QueryLiquidityProviderHistoryRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderHistoryResponse, error) {
+	out := new(QueryLiquidityProviderHistoryResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/LiquidityProviderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Saver(ctx context.Context, in *QuerySaverRequest, opts ...grpc.CallOption) (*QuerySaverResponse, error) {
 	out := new(QuerySaverResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/Saver", in, out, opts...)
@@ -407,6 +434,15 @@ func (c *queryClient) Savers(ctx context.Context, in *QuerySaversRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SaverHistory(ctx context.Context, in *QuerySaverHistoryRequest, opts ...grpc.CallOption) (*QuerySaverHistoryResponse, error) {
+	out := new(QuerySaverHistoryResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/SaverHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Borrower(ctx context.Context, in *QueryBorrowerRequest, opts ...grpc.CallOption) (*QueryBorrowerResponse, error) {
 	out := new(QueryBorrowerResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/Borrower", in, out, opts...)
@@ -533,6 +569,15 @@ func (c *queryClient) OutboundFees(ctx context.Context, in *QueryOutboundFeesReq
 	return out, nil
 }
 
+func (c *queryClient) DexAggregators(ctx context.Context, in *QueryDexAggregatorsRequest, opts ...grpc.CallOption) (*QueryDexAggregatorsResponse, error) {
+	out := new(QueryDexAggregatorsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/DexAggregators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error) {
 	out := new(QueryStreamingSwapResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/StreamingSwap", in, out, opts...)
@@ -722,6 +767,15 @@ func (c *queryClient) QuoteSwap(ctx context.Context, in *QueryQuoteSwapRequest,
 	return out, nil
 }
 
+func (c *queryClient) QuoteSwapBatch(ctx context.Context, in *QueryQuoteSwapBatchRequest, opts ...grpc.CallOption) (*QueryQuoteSwapBatchResponse, error) {
+	out := new(QueryQuoteSwapBatchResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteSwapBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QuoteSaverDeposit(ctx context.Context, in *QueryQuoteSaverDepositRequest, opts ...grpc.CallOption) (*QueryQuoteSaverDepositResponse, error) {
 	out := new(QueryQuoteSaverDepositResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/QuoteSaverDeposit", in, out, opts...)
@@ -776,6 +830,24 @@ func (c *queryClient) SwapQueue(ctx context.Context, in *QuerySwapQueueRequest,
 	return out, nil
 }
 
+func (c *queryClient) LimitSwapBook(ctx context.Context, in *QueryLimitSwapBookRequest, opts ...grpc.CallOption) (*QueryLimitSwapBookResponse, error) {
+	out := new(QueryLimitSwapBookResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/LimitSwapBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitSwapsByAddress(ctx context.Context, in *QueryLimitSwapsByAddressRequest, opts ...grpc.CallOption) (*QueryLimitSwapsByAddressResponse, error) {
+	out := new(QueryLimitSwapsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/LimitSwapsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LastBlocks(ctx context.Context, in *QueryLastBlocksRequest, opts ...grpc.CallOption) (*QueryLastBlocksResponse, error) {
 	out := new(QueryLastBlocksResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/LastBlocks", in, out, opts...)
@@ -1048,8 +1120,10 @@ type QueryServer interface {
 	DerivedPools(context.Context, *QueryDerivedPoolsRequest) (*QueryDerivedPoolsResponse, error)
 	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error)
+	LiquidityProviderHistory(context.Context, *QueryLiquidityProviderHistoryRequest) (*QueryLiquidityProviderHistoryResponse, error)
 	Saver(context.Context, *QuerySaverRequest) (*QuerySaverResponse, error)
 	Savers(context.Context, *QuerySaversRequest) (*QuerySaversResponse, error)
+	SaverHistory(context.Context, *QuerySaverHistoryRequest) (*QuerySaverHistoryResponse, error)
 	Borrower(context.Context, *QueryBorrowerRequest) (*QueryBorrowerResponse, error)
 	Borrowers(context.Context, *QueryBorrowersRequest) (*QueryBorrowersResponse, error)
 	TradeUnit(context.Context, *QueryTradeUnitRequest) (*QueryTradeUnitResponse, error)
@@ -1064,6 +1138,7 @@ type QueryServer interface {
 	PoolSlips(context.Context, *QueryPoolSlipsRequest) (*QueryPoolSlipsResponse, error)
 	OutboundFee(context.Context, *QueryOutboundFeeRequest) (*QueryOutboundFeesResponse, error)
 	OutboundFees(context.Context, *QueryOutboundFeesRequest) (*QueryOutboundFeesResponse, error)
+	DexAggregators(context.Context, *QueryDexAggregatorsRequest) (*QueryDexAggregatorsResponse, error)
 	StreamingSwap(context.Context, *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(context.Context, *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error)
 	Ban(context.Context, *QueryBanRequest) (*BanVoter, error)
@@ -1085,12 +1160,15 @@ type QueryServer interface {
 	Network(context.Context, *QueryNetworkRequest) (*QueryNetworkResponse, error)
 	BalanceModule(context.Context, *QueryBalanceModuleRequest) (*QueryBalanceModuleResponse, error)
 	QuoteSwap(context.Context, *QueryQuoteSwapRequest) (*QueryQuoteSwapResponse, error)
+	QuoteSwapBatch(context.Context, *QueryQuoteSwapBatchRequest) (*QueryQuoteSwapBatchResponse, error)
 	QuoteSaverDeposit(context.Context, *QueryQuoteSaverDepositRequest) (*QueryQuoteSaverDepositResponse, error)
 	QuoteSaverWithdraw(context.Context, *QueryQuoteSaverWithdrawRequest) (*QueryQuoteSaverWithdrawResponse, error)
 	QuoteLoanOpen(context.Context, *QueryQuoteLoanOpenRequest) (*QueryQuoteLoanOpenResponse, error)
 	QuoteLoanClose(context.Context, *QueryQuoteLoanCloseRequest) (*QueryQuoteLoanCloseResponse, error)
 	ConstantValues(context.Context, *QueryConstantValuesRequest) (*QueryConstantValuesResponse, error)
 	SwapQueue(context.Context, *QuerySwapQueueRequest) (*QuerySwapQueueResponse, error)
+	LimitSwapBook(context.Context, *QueryLimitSwapBookRequest) (*QueryLimitSwapBookResponse, error)
+	LimitSwapsByAddress(context.Context, *QueryLimitSwapsByAddressRequest) (*QueryLimitSwapsByAddressResponse, error)
 	LastBlocks(context.Context, *QueryLastBlocksRequest) (*QueryLastBlocksResponse, error)
 	ChainsLastBlock(context.Context, *QueryChainsLastBlockRequest) (*QueryLastBlocksResponse, error)
 	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
@@ -1152,12 +1230,18 @@ func (*UnimplementedQueryServer) LiquidityProvider(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) LiquidityProviders(ctx context.Context, req *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviders not implemented")
 }
+func (*UnimplementedQueryServer) LiquidityProviderHistory(ctx context.Context, req *QueryLiquidityProviderHistoryRequest) (*QueryLiquidityProviderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviderHistory not implemented")
+}
 func (*UnimplementedQueryServer) Saver(ctx context.Context, req *QuerySaverRequest) (*QuerySaverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Saver not implemented")
 }
 func (*UnimplementedQueryServer) Savers(ctx context.Context, req *QuerySaversRequest) (*QuerySaversResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Savers not implemented")
 }
+func (*UnimplementedQueryServer) SaverHistory(ctx context.Context, req *QuerySaverHistoryRequest) (*QuerySaverHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaverHistory not implemented")
+}
 func (*UnimplementedQueryServer) Borrower(ctx context.Context, req *QueryBorrowerRequest) (*QueryBorrowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Borrower not implemented")
 }
@@ -1200,6 +1284,9 @@ func (*UnimplementedQueryServer) OutboundFee(ctx context.Context, req *QueryOutb
 func (*UnimplementedQueryServer) OutboundFees(ctx context.Context, req *QueryOutboundFeesRequest) (*QueryOutboundFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutboundFees not implemented")
 }
+func (*UnimplementedQueryServer) DexAggregators(ctx context.Context, req *QueryDexAggregatorsRequest) (*QueryDexAggregatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DexAggregators not implemented")
+}
 func (*UnimplementedQueryServer) StreamingSwap(ctx context.Context, req *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StreamingSwap not implemented")
 }
@@ -1263,6 +1350,9 @@ func (*UnimplementedQueryServer) BalanceModule(ctx context.Context, req *QueryBa
 func (*UnimplementedQueryServer) QuoteSwap(ctx context.Context, req *QueryQuoteSwapRequest) (*QueryQuoteSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteSwap not implemented")
 }
+func (*UnimplementedQueryServer) QuoteSwapBatch(ctx context.Context, req *QueryQuoteSwapBatchRequest) (*QueryQuoteSwapBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSwapBatch not implemented")
+}
 func (*UnimplementedQueryServer) QuoteSaverDeposit(ctx context.Context, req *QueryQuoteSaverDepositRequest) (*QueryQuoteSaverDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteSaverDeposit not implemented")
 }
@@ -1281,6 +1371,12 @@ func (*UnimplementedQueryServer) ConstantValues(ctx context.Context, req *QueryC
 func (*UnimplementedQueryServer) SwapQueue(ctx context.Context, req *QuerySwapQueueRequest) (*QuerySwapQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapQueue not implemented")
 }
+func (*UnimplementedQueryServer) LimitSwapBook(ctx context.Context, req *QueryLimitSwapBookRequest) (*QueryLimitSwapBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitSwapBook not implemented")
+}
+func (*UnimplementedQueryServer) LimitSwapsByAddress(ctx context.Context, req *QueryLimitSwapsByAddressRequest) (*QueryLimitSwapsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitSwapsByAddress not implemented")
+}
 func (*UnimplementedQueryServer) LastBlocks(ctx context.Context, req *QueryLastBlocksRequest) (*QueryLastBlocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastBlocks not implemented")
 }
@@ -1532,6 +1628,24 @@ func _Query_LiquidityProviders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LiquidityProviderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProviderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProviderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/LiquidityProviderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProviderHistory(ctx, req.(*QueryLiquidityProviderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Saver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySaverRequest)
 	if err := dec(in); err != nil {
@@ -1568,6 +1682,24 @@ func _Query_Savers_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SaverHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySaverHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SaverHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/SaverHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SaverHistory(ctx, req.(*QuerySaverHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Borrower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBorrowerRequest)
 	if err := dec(in); err != nil {
@@ -1820,6 +1952,24 @@ func _Query_OutboundFees_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DexAggregators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDexAggregatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DexAggregators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/DexAggregators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DexAggregators(ctx, req.(*QueryDexAggregatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_StreamingSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryStreamingSwapRequest)
 	if err := dec(in); err != nil {
@@ -2198,6 +2348,24 @@ func _Query_QuoteSwap_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QuoteSwapBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSwapBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSwapBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteSwapBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSwapBatch(ctx, req.(*QueryQuoteSwapBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QuoteSaverDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryQuoteSaverDepositRequest)
 	if err := dec(in); err != nil {
@@ -2306,6 +2474,42 @@ func _Query_SwapQueue_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LimitSwapBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitSwapBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitSwapBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/LimitSwapBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitSwapBook(ctx, req.(*QueryLimitSwapBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitSwapsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitSwapsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitSwapsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/LimitSwapsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitSwapsByAddress(ctx, req.(*QueryLimitSwapsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LastBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLastBlocksRequest)
 	if err := dec(in); err != nil {
@@ -2810,6 +3014,7 @@ func _Query_Codes_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2850,6 +3055,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidityProviders",
 			Handler:    _Query_LiquidityProviders_Handler,
 		},
+		{
+			MethodName: "LiquidityProviderHistory",
+			Handler:    _Query_LiquidityProviderHistory_Handler,
+		},
 		{
 			MethodName: "Saver",
 			Handler:    _Query_Saver_Handler,
@@ -2858,6 +3067,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Savers",
 			Handler:    _Query_Savers_Handler,
 		},
+		{
+			MethodName: "SaverHistory",
+			Handler:    _Query_SaverHistory_Handler,
+		},
 		{
 			MethodName: "Borrower",
 			Handler:    _Query_Borrower_Handler,
@@ -2914,6 +3127,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OutboundFees",
 			Handler:    _Query_OutboundFees_Handler,
 		},
+		{
+			MethodName: "DexAggregators",
+			Handler:    _Query_DexAggregators_Handler,
+		},
 		{
 			MethodName: "StreamingSwap",
 			Handler:    _Query_StreamingSwap_Handler,
@@ -2998,6 +3215,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuoteSwap",
 			Handler:    _Query_QuoteSwap_Handler,
 		},
+		{
+			MethodName: "QuoteSwapBatch",
+			Handler:    _Query_QuoteSwapBatch_Handler,
+		},
 		{
 			MethodName: "QuoteSaverDeposit",
 			Handler:    _Query_QuoteSaverDeposit_Handler,
@@ -3022,6 +3243,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapQueue",
 			Handler:    _Query_SwapQueue_Handler,
 		},
+		{
+			MethodName: "LimitSwapBook",
+			Handler:    _Query_LimitSwapBook_Handler,
+		},
+		{
+			MethodName: "LimitSwapsByAddress",
+			Handler:    _Query_LimitSwapsByAddress_Handler,
+		},
 		{
 			MethodName: "LastBlocks",
 			Handler:    _Query_LastBlocks_Handler,
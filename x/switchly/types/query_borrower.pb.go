@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -200,8 +201,11 @@ func (m *QueryBorrowerResponse) GetLastRepayHeight() int64 {
 }
 
 type QueryBorrowersRequest struct {
-	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset      string             `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Height     string             `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return borrowers with an owner address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,4,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
 }
 
 func (m *QueryBorrowersRequest) Reset()         { *m = QueryBorrowersRequest{} }
@@ -251,8 +255,23 @@ func (m *QueryBorrowersRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QueryBorrowersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryBorrowersRequest) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
 type QueryBorrowersResponse struct {
-	Borrowers []*QueryBorrowerResponse `protobuf:"bytes,1,rep,name=borrowers,proto3" json:"borrowers,omitempty"`
+	Borrowers  []*QueryBorrowerResponse `protobuf:"bytes,1,rep,name=borrowers,proto3" json:"borrowers,omitempty"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryBorrowersResponse) Reset()         { *m = QueryBorrowersResponse{} }
@@ -295,6 +314,13 @@ func (m *QueryBorrowersResponse) GetBorrowers() []*QueryBorrowerResponse {
 	return nil
 }
 
+func (m *QueryBorrowersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBorrowerRequest)(nil), "types.QueryBorrowerRequest")
 	proto.RegisterType((*QueryBorrowerResponse)(nil), "types.QueryBorrowerResponse")
@@ -305,39 +331,45 @@ func init() {
 func init() { proto.RegisterFile("types/query_borrower.proto", fileDescriptor_ff65bcb6426532b4) }
 
 var fileDescriptor_ff65bcb6426532b4 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0x76, 0xed, 0xa8, 0x87, 0xd8, 0x66, 0xba, 0xca, 0x9a, 0x50, 0x52, 0xf5, 0xd4,
-	0x53, 0x83, 0xe8, 0x0d, 0x21, 0x24, 0x0a, 0x93, 0x40, 0x1c, 0x10, 0x16, 0x02, 0x89, 0x03, 0x55,
-	0x9a, 0x3c, 0x35, 0x91, 0x42, 0x9c, 0xd9, 0x0e, 0x21, 0xdf, 0x82, 0x8f, 0xc5, 0x71, 0x27, 0xc4,
-	0x29, 0x42, 0xed, 0x2d, 0x9f, 0x02, 0xc5, 0x6e, 0x9a, 0x2e, 0xab, 0x76, 0x8a, 0xdf, 0xef, 0xff,
-	0xde, 0xff, 0xd9, 0xf1, 0x33, 0xba, 0x94, 0x59, 0x0c, 0xc2, 0xbe, 0x4e, 0x80, 0x67, 0x8b, 0x25,
-	0xe3, 0x9c, 0xa5, 0xc0, 0xa7, 0x31, 0x67, 0x92, 0xe1, 0xae, 0xd2, 0x2e, 0x07, 0x2b, 0xb6, 0x62,
-	0x8a, 0xd8, 0xe5, 0x4a, 0x8b, 0xe3, 0x6f, 0x68, 0xf0, 0xb1, 0x2c, 0x9a, 0x6f, 0x6b, 0x28, 0x5c,
-	0x27, 0x20, 0x24, 0x1e, 0xa0, 0xae, 0x23, 0x04, 0x48, 0x62, 0x8c, 0x8c, 0x49, 0x9f, 0xea, 0x00,
-	0x13, 0x74, 0xec, 0x78, 0x1e, 0x07, 0x21, 0x48, 0x5b, 0xf1, 0x2a, 0xc4, 0x43, 0xd4, 0xf3, 0x21,
-	0x58, 0xf9, 0x92, 0x74, 0x94, 0xb0, 0x8d, 0xc6, 0x7f, 0x8e, 0xd0, 0x45, 0xa3, 0x81, 0x88, 0x59,
-	0x24, 0x00, 0x5b, 0xa8, 0xcb, 0xd2, 0x08, 0xb8, 0xee, 0x30, 0xef, 0x17, 0xb9, 0xa5, 0x01, 0xd5,
-	0x9f, 0x32, 0x41, 0x6f, 0xa1, 0x5d, 0x27, 0x28, 0x50, 0xed, 0xe6, 0x29, 0x3a, 0xf1, 0x60, 0x29,
-	0x17, 0x81, 0x10, 0x09, 0x78, 0xba, 0xf1, 0xfc, 0xb4, 0xc8, 0xad, 0x7d, 0x4c, 0x51, 0x19, 0xbc,
-	0x53, 0xeb, 0x5d, 0x05, 0x87, 0xd8, 0x09, 0x3c, 0x72, 0xd4, 0xa8, 0xd0, 0x58, 0x57, 0x50, 0xb5,
-	0xc6, 0x33, 0xf4, 0x50, 0x49, 0x6e, 0xc2, 0x39, 0x44, 0x92, 0x74, 0x55, 0xc9, 0x59, 0x91, 0x5b,
-	0xb7, 0x38, 0x55, 0x06, 0xaf, 0x75, 0x80, 0xdf, 0xa3, 0x81, 0xcb, 0xc2, 0xd0, 0x91, 0xc0, 0x9d,
-	0x70, 0xe1, 0x41, 0xcc, 0x44, 0x20, 0xc1, 0x23, 0x3d, 0x55, 0x4c, 0x8a, 0xdc, 0x3a, 0xa8, 0xd3,
-	0xc7, 0x35, 0x7d, 0x53, 0xc1, 0x86, 0x59, 0x1a, 0x48, 0xdf, 0xe3, 0x4e, 0x1a, 0x91, 0xe3, 0x83,
-	0x66, 0x3b, 0x7d, 0xdf, 0xec, 0x4b, 0x05, 0xf1, 0x15, 0xc2, 0x7b, 0xc9, 0xd5, 0xa1, 0x1e, 0x28,
-	0xab, 0x61, 0x91, 0x5b, 0x07, 0x54, 0x7a, 0x5e, 0xb3, 0xea, 0x80, 0x2f, 0xd1, 0x59, 0xe8, 0x08,
-	0xb9, 0x60, 0x31, 0x44, 0x8b, 0xed, 0xbd, 0xf7, 0x47, 0xc6, 0xa4, 0x33, 0x1f, 0x14, 0xb9, 0x75,
-	0x47, 0xa3, 0x8f, 0x4a, 0xf2, 0x21, 0x86, 0xe8, 0xad, 0x8a, 0xf1, 0x2b, 0x74, 0xae, 0x72, 0xca,
-	0x1f, 0x9e, 0x55, 0x06, 0x48, 0x19, 0x5c, 0x14, 0xb9, 0x75, 0x57, 0xa4, 0xa7, 0x25, 0x2a, 0xef,
-	0x24, 0xd3, 0x16, 0xe3, 0xab, 0xc6, 0x5c, 0x89, 0xfb, 0x27, 0xb7, 0x9e, 0xcf, 0xf6, 0xad, 0xf9,
-	0xfc, 0x84, 0x86, 0x4d, 0x9b, 0xed, 0x7c, 0x3e, 0x47, 0xfd, 0xea, 0x21, 0x09, 0x62, 0x8c, 0x3a,
-	0x93, 0x93, 0x67, 0x4f, 0xa6, 0xea, 0x29, 0x4d, 0x0f, 0x0e, 0x34, 0xad, 0xd3, 0xe7, 0x9f, 0x7f,
-	0xaf, 0x4d, 0xe3, 0x66, 0x6d, 0x1a, 0xff, 0xd6, 0xa6, 0xf1, 0x6b, 0x63, 0xb6, 0x6e, 0x36, 0x66,
-	0xeb, 0xef, 0xc6, 0x6c, 0x7d, 0x7d, 0xb1, 0x0a, 0xa4, 0x9f, 0x2c, 0xa7, 0x2e, 0xfb, 0x6e, 0x8b,
-	0x34, 0x90, 0xae, 0x1f, 0x66, 0xea, 0x25, 0xba, 0x2c, 0xdc, 0x81, 0x88, 0x79, 0x60, 0xff, 0x98,
-	0xd9, 0x3f, 0x77, 0xc4, 0x56, 0x8d, 0x97, 0x3d, 0x95, 0x3a, 0xfb, 0x1f, 0x00, 0x00, 0xff, 0xff,
-	0x4b, 0x02, 0x73, 0xd2, 0xef, 0x03, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xad, 0xd3, 0xa6, 0xfd, 0x32, 0xfd, 0xe8, 0xcf, 0x90, 0x56, 0x56, 0x85, 0xe2, 0x2a, 0x12,
+	0x50, 0xb1, 0xb0, 0x69, 0xbb, 0x43, 0x08, 0x89, 0xf0, 0x2f, 0x16, 0x94, 0x59, 0x80, 0xc4, 0x02,
+	0x6b, 0x6c, 0x5f, 0x1c, 0x4b, 0xae, 0xc7, 0x9d, 0x99, 0x34, 0xcd, 0x5b, 0xf0, 0x02, 0xbc, 0x05,
+	0x0f, 0xc1, 0xb2, 0x2b, 0xc4, 0xca, 0x42, 0xcd, 0xce, 0x4f, 0x81, 0x3c, 0x63, 0xc7, 0x6e, 0x1a,
+	0xc1, 0x2a, 0x73, 0xcf, 0xb9, 0xf7, 0xdc, 0xb9, 0x99, 0x7b, 0x8c, 0xf6, 0xe4, 0x24, 0x05, 0xe1,
+	0x9c, 0x8d, 0x80, 0x4f, 0x5c, 0x8f, 0x71, 0xce, 0xc6, 0xc0, 0xed, 0x94, 0x33, 0xc9, 0x70, 0x5b,
+	0x71, 0x7b, 0xdd, 0x90, 0x85, 0x4c, 0x21, 0x4e, 0x71, 0xd2, 0xe4, 0xde, 0x03, 0x9f, 0x89, 0x53,
+	0x26, 0x1c, 0x8f, 0x0a, 0xd0, 0xe5, 0xce, 0xf9, 0xa1, 0x07, 0x92, 0x1e, 0x3a, 0x29, 0x0d, 0xa3,
+	0x84, 0xca, 0x88, 0x25, 0x3a, 0xb7, 0xff, 0x19, 0x75, 0xdf, 0x17, 0x19, 0x83, 0x52, 0x9f, 0xc0,
+	0xd9, 0x08, 0x84, 0xc4, 0x5d, 0xd4, 0xa6, 0x42, 0x80, 0x34, 0x8d, 0x7d, 0xe3, 0xa0, 0x43, 0x74,
+	0x80, 0x4d, 0xb4, 0x46, 0x83, 0x80, 0x83, 0x10, 0x66, 0x4b, 0xe1, 0x55, 0x88, 0x77, 0xd1, 0xea,
+	0x10, 0xa2, 0x70, 0x28, 0xcd, 0x65, 0x45, 0x94, 0x51, 0xff, 0xe7, 0x0a, 0xda, 0x99, 0x6b, 0x20,
+	0x52, 0x96, 0x08, 0xc0, 0x16, 0x6a, 0xb3, 0x71, 0x02, 0x5c, 0x77, 0x18, 0x74, 0xf2, 0xcc, 0xd2,
+	0x00, 0xd1, 0x3f, 0x45, 0x82, 0xbe, 0x42, 0xab, 0x4e, 0x50, 0x40, 0x75, 0x9b, 0x87, 0x68, 0x3d,
+	0x00, 0x4f, 0xba, 0x91, 0x10, 0x23, 0x08, 0x74, 0xe3, 0xc1, 0x66, 0x9e, 0x59, 0x4d, 0x98, 0xa0,
+	0x22, 0x78, 0xa3, 0xce, 0xb3, 0x0a, 0x0e, 0x29, 0x8d, 0x02, 0x73, 0x65, 0xae, 0x42, 0xc3, 0xba,
+	0x82, 0xa8, 0x33, 0x3e, 0x46, 0xff, 0x2b, 0xca, 0x1f, 0x71, 0x0e, 0x89, 0x34, 0xdb, 0xaa, 0x64,
+	0x2b, 0xcf, 0xac, 0x6b, 0x38, 0x51, 0x02, 0xcf, 0x74, 0x80, 0xdf, 0xa2, 0xae, 0xcf, 0xe2, 0x98,
+	0x4a, 0xe0, 0x34, 0x76, 0x03, 0x48, 0x99, 0x88, 0x24, 0x04, 0xe6, 0xaa, 0x2a, 0x36, 0xf3, 0xcc,
+	0x5a, 0xc8, 0x93, 0xdb, 0x35, 0xfa, 0xbc, 0x02, 0xe7, 0xc4, 0xc6, 0x91, 0x1c, 0x06, 0x9c, 0x8e,
+	0x13, 0x73, 0x6d, 0xa1, 0xd8, 0x8c, 0x6f, 0x8a, 0x7d, 0xac, 0x40, 0xfc, 0x02, 0xe1, 0x46, 0x72,
+	0x35, 0xd4, 0x7f, 0x4a, 0x6a, 0x37, 0xcf, 0xac, 0x05, 0x2c, 0xd9, 0xae, 0xb1, 0x6a, 0xc0, 0x27,
+	0x68, 0x2b, 0xa6, 0x42, 0xba, 0x2c, 0x85, 0xc4, 0x2d, 0xdf, 0xbd, 0xb3, 0x6f, 0x1c, 0x2c, 0x0f,
+	0xba, 0x79, 0x66, 0xdd, 0xe0, 0xc8, 0x46, 0x81, 0xbc, 0x4b, 0x21, 0x79, 0xad, 0x62, 0xfc, 0x14,
+	0x6d, 0xab, 0x9c, 0xe2, 0x0f, 0x9f, 0x54, 0x02, 0x48, 0x09, 0xec, 0xe4, 0x99, 0x75, 0x93, 0x24,
+	0x9b, 0x05, 0x54, 0xbc, 0xc9, 0x44, 0x4b, 0xf4, 0xbf, 0x1b, 0x73, 0x8b, 0x25, 0xfe, 0xbe, 0xba,
+	0xf5, 0x82, 0xb6, 0x9a, 0x0b, 0x8a, 0x5f, 0x22, 0x54, 0x9b, 0x42, 0xed, 0xd0, 0xfa, 0xd1, 0x3d,
+	0x5b, 0x3b, 0xc8, 0x2e, 0x1c, 0x64, 0x2b, 0x07, 0xd9, 0xa5, 0x83, 0xec, 0x13, 0x1a, 0x42, 0xd9,
+	0x89, 0x34, 0x2a, 0xf1, 0x5d, 0xb4, 0x51, 0x7a, 0xc1, 0x4d, 0x39, 0x7c, 0x89, 0x2e, 0xf4, 0x76,
+	0x91, 0x5b, 0x25, 0x7a, 0xa2, 0xc0, 0xfe, 0x37, 0x03, 0xed, 0xce, 0x5f, 0xbb, 0x34, 0xc4, 0x23,
+	0xd4, 0xa9, 0x5c, 0x2e, 0x4c, 0x63, 0x7f, 0xf9, 0x60, 0xfd, 0xe8, 0x8e, 0xad, 0x7c, 0x6e, 0x2f,
+	0x74, 0x10, 0xa9, 0xd3, 0xf1, 0xab, 0x6b, 0x53, 0xb4, 0xd4, 0x14, 0xf7, 0xff, 0x39, 0x45, 0xa9,
+	0xd3, 0x28, 0x1d, 0x7c, 0xf8, 0x71, 0xd5, 0x33, 0x2e, 0xaf, 0x7a, 0xc6, 0xef, 0xab, 0x9e, 0xf1,
+	0x75, 0xda, 0x5b, 0xba, 0x9c, 0xf6, 0x96, 0x7e, 0x4d, 0x7b, 0x4b, 0x9f, 0x1e, 0x87, 0x91, 0x1c,
+	0x8e, 0x3c, 0xdb, 0x67, 0xa7, 0x8e, 0x18, 0x47, 0xd2, 0x1f, 0xc6, 0x13, 0xf5, 0x0d, 0xf1, 0x59,
+	0x3c, 0x03, 0x12, 0x16, 0x80, 0x73, 0x7e, 0xec, 0x5c, 0xcc, 0x10, 0x47, 0x4d, 0xe0, 0xad, 0xaa,
+	0xd4, 0xe3, 0x3f, 0x03, 0x00, 0xe6, 0xc2, 0xab, 0x66, 0xd5, 0x04, 0x00, 0x00,
 }
 
 func (m *QueryBorrowerRequest) Marshal() (dAtA []byte, err error) {
@@ -493,6 +525,25 @@ func (m *QueryBorrowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintQueryBorrower(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryBorrower(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -530,6 +581,18 @@ func (m *QueryBorrowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryBorrower(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Borrowers) > 0 {
 		for iNdEx := len(m.Borrowers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -640,6 +703,14 @@ func (m *QueryBorrowersRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryBorrower(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryBorrower(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovQueryBorrower(uint64(l))
+	}
 	return n
 }
 
@@ -655,6 +726,10 @@ func (m *QueryBorrowersResponse) Size() (n int) {
 			n += 1 + l + sovQueryBorrower(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryBorrower(uint64(l))
+	}
 	return n
 }
 
@@ -1247,6 +1322,74 @@ func (m *QueryBorrowersRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryBorrower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryBorrower
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryBorrower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryBorrower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryBorrower
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryBorrower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryBorrower(dAtA[iNdEx:])
@@ -1331,6 +1474,42 @@ func (m *QueryBorrowersResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryBorrower
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryBorrower
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryBorrower
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryBorrower(dAtA[iNdEx:])
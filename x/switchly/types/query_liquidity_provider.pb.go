@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -249,8 +250,13 @@ func (m *QueryLiquidityProviderResponse) GetLuviGrowthPct() string {
 }
 
 type QueryLiquidityProvidersRequest struct {
-	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset      string             `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Height     string             `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return liquidity providers with a switch or asset address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,4,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// only return liquidity providers with at least this many pool units
+	MinUnits string `protobuf:"bytes,5,opt,name=min_units,json=minUnits,proto3" json:"min_units,omitempty"`
 }
 
 func (m *QueryLiquidityProvidersRequest) Reset()         { *m = QueryLiquidityProvidersRequest{} }
@@ -300,8 +306,30 @@ func (m *QueryLiquidityProvidersRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QueryLiquidityProvidersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryLiquidityProvidersRequest) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *QueryLiquidityProvidersRequest) GetMinUnits() string {
+	if m != nil {
+		return m.MinUnits
+	}
+	return ""
+}
+
 type QueryLiquidityProvidersResponse struct {
 	LiquidityProviders []*QueryLiquidityProviderResponse `protobuf:"bytes,1,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers,omitempty"`
+	Pagination         *query.PageResponse               `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryLiquidityProvidersResponse) Reset()         { *m = QueryLiquidityProvidersResponse{} }
@@ -344,11 +372,270 @@ func (m *QueryLiquidityProvidersResponse) GetLiquidityProviders() []*QueryLiquid
 	return nil
 }
 
+func (m *QueryLiquidityProvidersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryLiquidityProviderHistoryRequest struct {
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// first height of the range, the position at this height is always included
+	FromHeight int64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// last height of the range, defaults to the last height recorded
+	ToHeight int64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// maximum number of positions returned
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryLiquidityProviderHistoryRequest) Reset()         { *m = QueryLiquidityProviderHistoryRequest{} }
+func (m *QueryLiquidityProviderHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProviderHistoryRequest) ProtoMessage()    {}
+func (*QueryLiquidityProviderHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f17d11fe4edb1f7, []int{4}
+}
+func (m *QueryLiquidityProviderHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityProviderHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProviderHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityProviderHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProviderHistoryRequest.Merge(m, src)
+}
+func (m *QueryLiquidityProviderHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProviderHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProviderHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityProviderHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityProviderHistoryRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryLiquidityProviderHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryLiquidityProviderHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryLiquidityProviderHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryLiquidityProviderHistoryRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type LiquidityProviderPosition struct {
+	Height             int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Units              string `protobuf:"bytes,2,opt,name=units,proto3" json:"units"`
+	PoolUnits          string `protobuf:"bytes,3,opt,name=pool_units,json=poolUnits,proto3" json:"pool_units"`
+	SwitchRedeemValue  string `protobuf:"bytes,4,opt,name=switch_redeem_value,json=switchRedeemValue,proto3" json:"switch_redeem_value"`
+	AssetRedeemValue   string `protobuf:"bytes,5,opt,name=asset_redeem_value,json=assetRedeemValue,proto3" json:"asset_redeem_value"`
+	SwitchDepositValue string `protobuf:"bytes,6,opt,name=switch_deposit_value,json=switchDepositValue,proto3" json:"switch_deposit_value"`
+	AssetDepositValue  string `protobuf:"bytes,7,opt,name=asset_deposit_value,json=assetDepositValue,proto3" json:"asset_deposit_value"`
+	// liquidity fees in SWITCH earned by the position up to the height
+	AccruedFees string `protobuf:"bytes,8,opt,name=accrued_fees,json=accruedFees,proto3" json:"accrued_fees"`
+}
+
+func (m *LiquidityProviderPosition) Reset()         { *m = LiquidityProviderPosition{} }
+func (m *LiquidityProviderPosition) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderPosition) ProtoMessage()    {}
+func (*LiquidityProviderPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f17d11fe4edb1f7, []int{5}
+}
+func (m *LiquidityProviderPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityProviderPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderPosition.Merge(m, src)
+}
+func (m *LiquidityProviderPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityProviderPosition proto.InternalMessageInfo
+
+func (m *LiquidityProviderPosition) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LiquidityProviderPosition) GetUnits() string {
+	if m != nil {
+		return m.Units
+	}
+	return ""
+}
+
+func (m *LiquidityProviderPosition) GetPoolUnits() string {
+	if m != nil {
+		return m.PoolUnits
+	}
+	return ""
+}
+
+func (m *LiquidityProviderPosition) GetSwitchRedeemValue() string {
+	if m != nil {
+		return m.SwitchRedeemValue
+	}
+	return ""
+}
+
+func (m *LiquidityProviderPosition) GetAssetRedeemValue() string {
+	if m != nil {
+		return m.AssetRedeemValue
+	}
+	return ""
+}
+
+func (m *LiquidityProviderPosition) GetSwitchDepositValue() string {
+	if m != nil {
+		return m.SwitchDepositValue
+	}
+	return ""
+}
+
+func (m *LiquidityProviderPosition) GetAssetDepositValue() string {
+	if m != nil {
+		return m.AssetDepositValue
+	}
+	return ""
+}
+
+func (m *LiquidityProviderPosition) GetAccruedFees() string {
+	if m != nil {
+		return m.AccruedFees
+	}
+	return ""
+}
+
+type QueryLiquidityProviderHistoryResponse struct {
+	Asset     string                       `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	Address   string                       `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+	Positions []*LiquidityProviderPosition `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+	// height to continue the range from when it was truncated by the limit
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) Reset()         { *m = QueryLiquidityProviderHistoryResponse{} }
+func (m *QueryLiquidityProviderHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProviderHistoryResponse) ProtoMessage()    {}
+func (*QueryLiquidityProviderHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f17d11fe4edb1f7, []int{6}
+}
+func (m *QueryLiquidityProviderHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityProviderHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProviderHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityProviderHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProviderHistoryResponse.Merge(m, src)
+}
+func (m *QueryLiquidityProviderHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProviderHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProviderHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityProviderHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityProviderHistoryResponse) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) GetPositions() []*LiquidityProviderPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryLiquidityProviderRequest)(nil), "types.QueryLiquidityProviderRequest")
 	proto.RegisterType((*QueryLiquidityProviderResponse)(nil), "types.QueryLiquidityProviderResponse")
 	proto.RegisterType((*QueryLiquidityProvidersRequest)(nil), "types.QueryLiquidityProvidersRequest")
 	proto.RegisterType((*QueryLiquidityProvidersResponse)(nil), "types.QueryLiquidityProvidersResponse")
+	proto.RegisterType((*QueryLiquidityProviderHistoryRequest)(nil), "types.QueryLiquidityProviderHistoryRequest")
+	proto.RegisterType((*LiquidityProviderPosition)(nil), "types.LiquidityProviderPosition")
+	proto.RegisterType((*QueryLiquidityProviderHistoryResponse)(nil), "types.QueryLiquidityProviderHistoryResponse")
 }
 
 func init() {
@@ -356,44 +643,66 @@ func init() {
 }
 
 var fileDescriptor_9f17d11fe4edb1f7 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x5d, 0x8b, 0xd3, 0x40,
-	0x14, 0x6d, 0xb6, 0xb6, 0x6b, 0xa7, 0x5f, 0xdb, 0x69, 0xd1, 0x20, 0x98, 0x94, 0xea, 0x4a, 0x11,
-	0x69, 0xc4, 0x05, 0x41, 0xf0, 0xa5, 0x8b, 0xb0, 0x2a, 0x22, 0x6b, 0x94, 0x0a, 0xbe, 0x84, 0x6e,
-	0x67, 0x48, 0x06, 0xb2, 0x4d, 0x9a, 0x99, 0xf4, 0xe3, 0x5f, 0xf8, 0xe0, 0x8f, 0xf2, 0x71, 0x1f,
-	0x7d, 0x2a, 0xd2, 0xbe, 0xf5, 0x57, 0x48, 0xee, 0x4c, 0x5a, 0xb3, 0xbb, 0xdd, 0xa7, 0xf6, 0x9e,
-	0x73, 0xee, 0xb9, 0x37, 0x77, 0xe6, 0x0e, 0x7a, 0x2a, 0x16, 0x21, 0xe5, 0xd6, 0x24, 0xa6, 0xd1,
-	0xc2, 0xf1, 0xd9, 0x24, 0x66, 0x84, 0x89, 0x85, 0x13, 0x46, 0xc1, 0x94, 0x11, 0x1a, 0xf5, 0xc2,
-	0x28, 0x10, 0x01, 0x2e, 0x80, 0xea, 0x51, 0xcb, 0x0d, 0xdc, 0x00, 0x10, 0x2b, 0xf9, 0x27, 0xc9,
-	0x8e, 0x8b, 0x1e, 0x7f, 0x49, 0xd2, 0x3f, 0xa5, 0xd9, 0xe7, 0x2a, 0xd9, 0xa6, 0x93, 0x98, 0x72,
-	0x81, 0x5b, 0xa8, 0x30, 0xe4, 0x9c, 0x0a, 0x5d, 0x6b, 0x6b, 0xdd, 0x92, 0x2d, 0x03, 0xac, 0xa3,
-	0xc3, 0x21, 0x21, 0x11, 0xe5, 0x5c, 0x3f, 0x00, 0x3c, 0x0d, 0xf1, 0x03, 0x54, 0xf4, 0x28, 0x73,
-	0x3d, 0xa1, 0xe7, 0x81, 0x50, 0x51, 0xe7, 0x57, 0x11, 0x19, 0xfb, 0x2a, 0xf1, 0x30, 0x18, 0x73,
-	0x8a, 0xcd, 0x4c, 0xa9, 0xd3, 0xd2, 0x66, 0x69, 0x4a, 0x20, 0xad, 0x7a, 0x8c, 0x6a, 0x7c, 0xc6,
-	0xc4, 0xc8, 0x73, 0xb2, 0xc5, 0xab, 0x12, 0xed, 0xab, 0x16, 0x9e, 0xa0, 0x2a, 0xe8, 0xb7, 0x2a,
-	0xd9, 0x49, 0x05, 0xc0, 0x54, 0xf4, 0x0c, 0xd5, 0xfd, 0x21, 0x07, 0x8d, 0xa3, 0x1a, 0xbe, 0xd7,
-	0xd6, 0xba, 0x79, 0xbb, 0x9a, 0xc0, 0x7d, 0x42, 0xde, 0x03, 0x88, 0x5f, 0xa2, 0x16, 0xe8, 0x66,
-	0x4c, 0x78, 0x24, 0x1a, 0xce, 0x52, 0x71, 0x01, 0xc4, 0x38, 0xe1, 0xbe, 0x2b, 0x4a, 0x65, 0x98,
-	0xa8, 0x10, 0x8f, 0x99, 0xe0, 0x7a, 0x71, 0xf7, 0x19, 0x00, 0xd8, 0xf2, 0x07, 0xbf, 0x41, 0xb5,
-	0x90, 0x8e, 0x09, 0x1b, 0xbb, 0x8e, 0x6c, 0x5c, 0x3f, 0x04, 0x25, 0xde, 0x2c, 0xcd, 0x6b, 0x8c,
-	0x5d, 0x55, 0xf1, 0x57, 0x08, 0xf1, 0x6b, 0x94, 0x02, 0x8e, 0x1c, 0xd5, 0x7d, 0xc8, 0x6c, 0x6c,
-	0x96, 0x66, 0x96, 0xb0, 0x2b, 0x2a, 0xec, 0xc3, 0xe4, 0x3a, 0xbb, 0x3c, 0x31, 0x77, 0x18, 0xd1,
-	0x4b, 0x30, 0x92, 0xb2, 0x02, 0xbf, 0xcd, 0x3f, 0x10, 0xfc, 0x11, 0xb5, 0xd4, 0x74, 0x09, 0x0d,
-	0x03, 0xce, 0x84, 0x33, 0x1d, 0xfa, 0x31, 0xd5, 0x11, 0x94, 0xd0, 0x37, 0x4b, 0xf3, 0x56, 0xde,
-	0xc6, 0x12, 0x7d, 0x27, 0xc1, 0x41, 0x82, 0xe1, 0x33, 0xd4, 0x94, 0x47, 0x90, 0xb5, 0x2a, 0x83,
-	0xd5, 0xc3, 0xcd, 0xd2, 0xbc, 0x8d, 0xb6, 0x1b, 0x00, 0x66, 0x8c, 0x7a, 0xa8, 0xa9, 0x8a, 0x46,
-	0x94, 0x50, 0x7a, 0xa9, 0x8c, 0x2a, 0xd0, 0x7e, 0x43, 0x0d, 0x09, 0x18, 0xa9, 0x7f, 0x81, 0xb0,
-	0x74, 0xce, 0xc8, 0xab, 0x20, 0x3f, 0x92, 0x93, 0xc9, 0xaa, 0xfd, 0x78, 0xca, 0xae, 0x75, 0x59,
-	0x93, 0xea, 0x84, 0xc9, 0xf4, 0xf2, 0x1c, 0x35, 0x40, 0x9d, 0xb1, 0xae, 0x83, 0xb8, 0x9e, 0x10,
-	0xff, 0x3b, 0x27, 0xd7, 0x2b, 0xd1, 0xba, 0x51, 0x30, 0x13, 0x9e, 0x13, 0x8e, 0x84, 0x7e, 0x24,
-	0xef, 0x6a, 0x02, 0x9f, 0x01, 0x7a, 0x3e, 0x12, 0x9d, 0xcf, 0xfb, 0xb6, 0x82, 0xdf, 0xbd, 0x80,
-	0xbb, 0x35, 0x3b, 0xc8, 0xac, 0xd9, 0x02, 0x99, 0x7b, 0xfd, 0xd4, 0x9a, 0x0d, 0x50, 0xf3, 0xe6,
-	0x5b, 0xc1, 0x75, 0xad, 0x9d, 0xef, 0x96, 0x5f, 0x1d, 0xf7, 0xe0, 0xb5, 0xe8, 0xdd, 0xbd, 0xaa,
-	0x36, 0xf6, 0x6f, 0xf8, 0x9f, 0x0e, 0x7e, 0xaf, 0x0c, 0xed, 0x6a, 0x65, 0x68, 0x7f, 0x57, 0x86,
-	0xf6, 0x73, 0x6d, 0xe4, 0xae, 0xd6, 0x46, 0xee, 0xcf, 0xda, 0xc8, 0xfd, 0x78, 0xeb, 0x32, 0xe1,
-	0xc5, 0x17, 0xbd, 0x51, 0x70, 0x69, 0xc9, 0x23, 0xf3, 0x17, 0xf0, 0xfc, 0x8c, 0x02, 0x7f, 0x0b,
-	0x8c, 0x03, 0x42, 0xad, 0xe9, 0x89, 0x35, 0xdf, 0x22, 0x16, 0xb4, 0x72, 0x51, 0x04, 0xe9, 0xc9,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xb7, 0xc8, 0x0b, 0xee, 0x04, 0x00, 0x00,
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0xc4, 0xb1, 0x13, 0x97, 0xe3, 0xfc, 0x74, 0xac, 0x65, 0xd8, 0x15, 0x9e, 0xc8, 0x6c,
+	0x96, 0x68, 0x05, 0x63, 0x76, 0x23, 0x21, 0x21, 0x71, 0x89, 0xb5, 0xda, 0x2c, 0x08, 0x24, 0x33,
+	0x40, 0x90, 0xb8, 0x8c, 0x26, 0x9e, 0x8e, 0xdd, 0x92, 0x3d, 0x3d, 0x99, 0x6e, 0x3b, 0xf6, 0x5b,
+	0x70, 0xe0, 0x29, 0x78, 0x0c, 0x4e, 0x1c, 0xf7, 0x88, 0x38, 0x0c, 0x28, 0xb9, 0xa0, 0x79, 0x0a,
+	0xd4, 0xd5, 0xed, 0x9f, 0xd9, 0xd8, 0x1b, 0x40, 0x9c, 0x76, 0xea, 0xab, 0xaf, 0xab, 0xca, 0xdd,
+	0x5f, 0x7d, 0x1b, 0x78, 0x2c, 0x27, 0x31, 0x15, 0xcd, 0xab, 0x21, 0x4d, 0x26, 0x7e, 0x9f, 0x5d,
+	0x0d, 0x59, 0xc8, 0xe4, 0xc4, 0x8f, 0x13, 0x3e, 0x62, 0x21, 0x4d, 0xdc, 0x38, 0xe1, 0x92, 0x93,
+	0x22, 0xb2, 0x1e, 0xd6, 0xba, 0xbc, 0xcb, 0x11, 0x69, 0xaa, 0x2f, 0x9d, 0x7c, 0xf8, 0xb4, 0xc3,
+	0xc5, 0x80, 0x8b, 0xe6, 0x45, 0x20, 0xa8, 0x2e, 0xd4, 0x1c, 0x3d, 0xbb, 0xa0, 0x32, 0x78, 0xd6,
+	0x8c, 0x83, 0x2e, 0x8b, 0x02, 0xc9, 0x78, 0xa4, 0xb9, 0x8d, 0x2e, 0xbc, 0xf7, 0xb5, 0x62, 0x7c,
+	0x39, 0xed, 0xd4, 0x36, 0x8d, 0x3c, 0x7a, 0x35, 0xa4, 0x42, 0x92, 0x1a, 0x14, 0x03, 0x21, 0xa8,
+	0xb4, 0xad, 0x43, 0xeb, 0xb8, 0xec, 0xe9, 0x80, 0xd8, 0xb0, 0x19, 0x84, 0x61, 0x42, 0x85, 0xb0,
+	0xd7, 0x11, 0x9f, 0x86, 0xe4, 0x01, 0x94, 0x7a, 0x94, 0x75, 0x7b, 0xd2, 0x2e, 0x60, 0xc2, 0x44,
+	0x8d, 0x9f, 0x4a, 0x50, 0x5f, 0xd5, 0x49, 0xc4, 0x3c, 0x12, 0x94, 0x38, 0xb9, 0x56, 0xad, 0x72,
+	0x96, 0x3a, 0x1a, 0x98, 0x76, 0x3d, 0x82, 0x1d, 0x71, 0xcd, 0x64, 0xa7, 0xe7, 0xe7, 0x9b, 0x57,
+	0x35, 0x7a, 0x6a, 0x46, 0x78, 0x1f, 0xaa, 0xc8, 0x9f, 0xb1, 0xf4, 0x24, 0xdb, 0x08, 0x4e, 0x49,
+	0x4f, 0x60, 0xb7, 0x1f, 0x08, 0xe4, 0xf8, 0x66, 0xe0, 0x8d, 0x43, 0xeb, 0xb8, 0xe0, 0x55, 0x15,
+	0x7c, 0x1a, 0x86, 0xaf, 0x10, 0x24, 0x1f, 0x43, 0x0d, 0x79, 0xd7, 0x4c, 0xf6, 0xc2, 0x24, 0xb8,
+	0x9e, 0x92, 0x8b, 0x48, 0x26, 0x2a, 0xf7, 0xbd, 0x49, 0x99, 0x13, 0x0e, 0x14, 0x87, 0x11, 0x93,
+	0xc2, 0x2e, 0xcd, 0x7f, 0x06, 0x02, 0x9e, 0xfe, 0x87, 0x7c, 0x0a, 0x3b, 0x31, 0x8d, 0x42, 0x16,
+	0x75, 0x7d, 0x3d, 0xb8, 0xbd, 0x89, 0x4c, 0x92, 0xa5, 0xce, 0x1b, 0x19, 0xaf, 0x6a, 0xe2, 0x6f,
+	0x30, 0x24, 0x9f, 0xc0, 0x14, 0xf0, 0xf5, 0x55, 0x6d, 0xe1, 0xc9, 0xfd, 0x2c, 0x75, 0xf2, 0x09,
+	0x6f, 0xdb, 0x84, 0xa7, 0x2a, 0x22, 0x8d, 0xf9, 0x39, 0x39, 0xf6, 0x59, 0x68, 0x97, 0xf1, 0x4a,
+	0x2a, 0x06, 0xfc, 0x76, 0xfc, 0x79, 0x48, 0xbe, 0x80, 0x9a, 0xb9, 0xdd, 0x90, 0xc6, 0x5c, 0x30,
+	0xe9, 0x8f, 0x82, 0xfe, 0x90, 0xda, 0x80, 0x2d, 0xec, 0x2c, 0x75, 0x96, 0xe6, 0x3d, 0xa2, 0xd1,
+	0x17, 0x1a, 0x3c, 0x57, 0x18, 0x39, 0x83, 0x03, 0xfd, 0x04, 0xf9, 0x52, 0x15, 0x2c, 0xf5, 0x4e,
+	0x96, 0x3a, 0xcb, 0xd2, 0xde, 0x3e, 0x82, 0xb9, 0x42, 0x2e, 0x1c, 0x98, 0xa6, 0x09, 0x0d, 0x29,
+	0x1d, 0x98, 0x42, 0xdb, 0x38, 0xfe, 0xbe, 0xb9, 0x24, 0xcc, 0x68, 0xfe, 0x87, 0x40, 0x74, 0xe5,
+	0x1c, 0xbd, 0x8a, 0xf4, 0x3d, 0x7d, 0x33, 0x79, 0x76, 0x7f, 0x38, 0x62, 0x6f, 0x4c, 0xb9, 0xa3,
+	0xd9, 0x2a, 0x93, 0x9b, 0xe5, 0x29, 0xec, 0x23, 0x3b, 0x57, 0x7a, 0x17, 0xc9, 0xbb, 0x2a, 0xb1,
+	0x58, 0x59, 0xc9, 0x4b, 0x71, 0xbb, 0x09, 0xbf, 0x96, 0x3d, 0x3f, 0xee, 0x48, 0x7b, 0x4f, 0x6b,
+	0x55, 0xc1, 0x67, 0x88, 0xb6, 0x3b, 0xb2, 0xf1, 0xbb, 0xb5, 0x6a, 0x2d, 0xc4, 0xdb, 0x37, 0x70,
+	0xbe, 0x67, 0xeb, 0x8b, 0x7b, 0x46, 0x5e, 0x02, 0xcc, 0x97, 0x1c, 0x95, 0x5f, 0x79, 0xfe, 0xc4,
+	0xd5, 0x8e, 0xe0, 0x2a, 0x47, 0x70, 0xd1, 0x11, 0x5c, 0xe3, 0x08, 0x6e, 0x3b, 0xe8, 0x52, 0xd3,
+	0xc9, 0x5b, 0x38, 0xa9, 0x76, 0xcd, 0xac, 0x8f, 0x1f, 0x27, 0xf4, 0x92, 0x8d, 0x71, 0x3d, 0xca,
+	0x5e, 0xd5, 0xa0, 0x6d, 0x04, 0xc9, 0x23, 0x28, 0x0f, 0x58, 0xe4, 0x6b, 0xc1, 0x17, 0x91, 0xb1,
+	0x35, 0x60, 0xd1, 0x77, 0x2a, 0x6e, 0xfc, 0x62, 0x81, 0xb3, 0xf2, 0xc7, 0x99, 0xa5, 0x3f, 0x87,
+	0x83, 0xbb, 0x2e, 0x27, 0x6c, 0xeb, 0xb0, 0x70, 0x5c, 0x79, 0x7e, 0xe4, 0xa2, 0xcf, 0xb9, 0x6f,
+	0x37, 0x0e, 0x8f, 0xf4, 0xef, 0xd4, 0x27, 0x67, 0xb9, 0x7b, 0x58, 0xc7, 0x7b, 0xf8, 0xe0, 0xde,
+	0x7b, 0x30, 0x05, 0x17, 0x8e, 0x36, 0x7e, 0xb6, 0xe0, 0xf1, 0xf2, 0xfe, 0xaf, 0x98, 0x90, 0x3c,
+	0x99, 0xfc, 0x57, 0xa7, 0x74, 0xa0, 0x72, 0x99, 0xf0, 0x81, 0xbf, 0x60, 0x97, 0x05, 0x0f, 0x14,
+	0x64, 0x8c, 0xe4, 0x11, 0x94, 0x25, 0xcf, 0x9b, 0xd3, 0x96, 0xe4, 0x26, 0x59, 0x83, 0x62, 0x9f,
+	0x0d, 0x98, 0x36, 0xa2, 0x0d, 0x4f, 0x07, 0x8d, 0xbf, 0x0a, 0xf0, 0xee, 0x9d, 0x39, 0xdb, 0x4a,
+	0xc2, 0xea, 0x4d, 0x1b, 0x33, 0xcd, 0xa8, 0x11, 0x0b, 0x2d, 0xc8, 0x52, 0xc7, 0x20, 0x33, 0xfd,
+	0xcc, 0xdc, 0x6b, 0x7d, 0x85, 0x7b, 0x7d, 0x04, 0x10, 0x73, 0xde, 0x37, 0x4f, 0x8e, 0xd6, 0xda,
+	0xda, 0xc9, 0x52, 0x67, 0x01, 0xf5, 0xca, 0xea, 0x1b, 0x35, 0xa0, 0x9c, 0x60, 0xd9, 0x02, 0x6f,
+	0xcc, 0x9d, 0x60, 0x49, 0x7a, 0xd9, 0x66, 0xbf, 0x58, 0xba, 0xd9, 0x28, 0xb9, 0xd6, 0x83, 0x2c,
+	0x75, 0x96, 0x64, 0x97, 0x6c, 0xfc, 0x2a, 0x93, 0x2b, 0xfd, 0x7f, 0x26, 0xb7, 0xf9, 0xaf, 0x4d,
+	0xee, 0x04, 0xb6, 0x83, 0x4e, 0x27, 0x19, 0xd2, 0xd0, 0xbf, 0xa4, 0x54, 0x18, 0x53, 0xdf, 0xcb,
+	0x52, 0x27, 0x87, 0x7b, 0x15, 0x13, 0xbd, 0xa4, 0x54, 0x34, 0xfe, 0xb0, 0xe0, 0xe8, 0x1e, 0x5d,
+	0xfe, 0xf3, 0xff, 0x57, 0xf3, 0x1a, 0x6d, 0x55, 0xb2, 0xd4, 0x99, 0x42, 0x73, 0xc1, 0x7e, 0x05,
+	0xe5, 0xd8, 0x48, 0x49, 0x3d, 0xbc, 0x5a, 0xd0, 0x43, 0xb3, 0xa0, 0x2b, 0x35, 0xd7, 0xaa, 0x66,
+	0xa9, 0x33, 0x3f, 0xe6, 0xcd, 0x3f, 0x95, 0xfe, 0x23, 0x3a, 0x96, 0x79, 0x81, 0x83, 0x82, 0xb4,
+	0xc4, 0x5b, 0xe7, 0xbf, 0xde, 0xd4, 0xad, 0xd7, 0x37, 0x75, 0xeb, 0xcf, 0x9b, 0xba, 0xf5, 0xe3,
+	0x6d, 0x7d, 0xed, 0xf5, 0x6d, 0x7d, 0xed, 0xb7, 0xdb, 0xfa, 0xda, 0x0f, 0x9f, 0x75, 0x99, 0xec,
+	0x0d, 0x2f, 0xdc, 0x0e, 0x1f, 0x34, 0xf5, 0xc3, 0xf4, 0x27, 0xf8, 0xf7, 0x4c, 0x87, 0xf7, 0x67,
+	0x40, 0xc4, 0x43, 0xda, 0x1c, 0x9d, 0x34, 0xc7, 0x33, 0xa4, 0x89, 0xc3, 0x5e, 0x94, 0x90, 0x7a,
+	0xf2, 0xf7, 0x00, 0xa0, 0x03, 0x7d, 0xe0, 0x6b, 0x09, 0x00, 0x00,
 }
 
 func (m *QueryLiquidityProviderRequest) Marshal() (dAtA []byte, err error) {
@@ -593,6 +902,32 @@ func (m *QueryLiquidityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinUnits) > 0 {
+		i -= len(m.MinUnits)
+		copy(dAtA[i:], m.MinUnits)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.MinUnits)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -630,6 +965,18 @@ func (m *QueryLiquidityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.LiquidityProviders) > 0 {
 		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -647,58 +994,243 @@ func (m *QueryLiquidityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQueryLiquidityProvider(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQueryLiquidityProvider(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLiquidityProviderHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryLiquidityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
-	}
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
-	}
-	return n
+
+func (m *QueryLiquidityProviderHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLiquidityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLiquidityProviderHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
-	}
-	l = len(m.SwitchAddress)
-	if l > 0 {
-		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.AssetAddress)
-	if l > 0 {
-		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	if m.ToHeight != 0 {
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.LastAddHeight != 0 {
-		n += 1 + sovQueryLiquidityProvider(uint64(m.LastAddHeight))
+	if m.FromHeight != 0 {
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProviderPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccruedFees) > 0 {
+		i -= len(m.AccruedFees)
+		copy(dAtA[i:], m.AccruedFees)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.AccruedFees)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AssetDepositValue) > 0 {
+		i -= len(m.AssetDepositValue)
+		copy(dAtA[i:], m.AssetDepositValue)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.AssetDepositValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SwitchDepositValue) > 0 {
+		i -= len(m.SwitchDepositValue)
+		copy(dAtA[i:], m.SwitchDepositValue)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.SwitchDepositValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AssetRedeemValue) > 0 {
+		i -= len(m.AssetRedeemValue)
+		copy(dAtA[i:], m.AssetRedeemValue)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.AssetRedeemValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SwitchRedeemValue) > 0 {
+		i -= len(m.SwitchRedeemValue)
+		copy(dAtA[i:], m.SwitchRedeemValue)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.SwitchRedeemValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PoolUnits) > 0 {
+		i -= len(m.PoolUnits)
+		copy(dAtA[i:], m.PoolUnits)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.PoolUnits)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Units) > 0 {
+		i -= len(m.Units)
+		copy(dAtA[i:], m.Units)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.Units)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQueryLiquidityProvider(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryLiquidityProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryLiquidityProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLiquidityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.SwitchAddress)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.AssetAddress)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	if m.LastAddHeight != 0 {
+		n += 1 + sovQueryLiquidityProvider(uint64(m.LastAddHeight))
 	}
 	if m.LastWithdrawHeight != 0 {
 		n += 1 + sovQueryLiquidityProvider(uint64(m.LastWithdrawHeight))
@@ -764,6 +1296,18 @@ func (m *QueryLiquidityProvidersRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.MinUnits)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
 	return n
 }
 
@@ -779,16 +1323,794 @@ func (m *QueryLiquidityProvidersResponse) Size() (n int) {
 			n += 1 + l + sovQueryLiquidityProvider(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQueryLiquidityProvider(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQueryLiquidityProvider(uint64(m.ToHeight))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQueryLiquidityProvider(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *LiquidityProviderPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQueryLiquidityProvider(uint64(m.Height))
+	}
+	l = len(m.Units)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.PoolUnits)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.SwitchRedeemValue)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.AssetRedeemValue)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.SwitchDepositValue)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.AssetDepositValue)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.AccruedFees)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQueryLiquidityProvider(uint64(l))
+		}
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQueryLiquidityProvider(uint64(m.NextHeight))
+	}
+	return n
+}
+
+func sovQueryLiquidityProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryLiquidityProvider(x uint64) (n int) {
+	return sovQueryLiquidityProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryLiquidityProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryLiquidityProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryLiquidityProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwitchAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAddHeight", wireType)
+			}
+			m.LastAddHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAddHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawHeight", wireType)
+			}
+			m.LastWithdrawHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWithdrawHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Units = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwitch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSwitch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchDepositValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwitchDepositValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDepositValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDepositValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchRedeemValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwitchRedeemValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRedeemValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRedeemValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LuviDepositValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LuviDepositValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LuviRedeemValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LuviRedeemValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LuviGrowthPct", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LuviGrowthPct = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryLiquidityProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQueryLiquidityProvider(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQueryLiquidityProvider(x uint64) (n int) {
-	return sovQueryLiquidityProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityProvidersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,10 +2133,10 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -851,7 +2173,7 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -879,11 +2201,47 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -911,7 +2269,39 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinUnits = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -934,7 +2324,7 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -957,17 +2347,17 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryLiquidityProvider
@@ -977,27 +2367,115 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQueryLiquidityProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueryLiquidityProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.LiquidityProviders = append(m.LiquidityProviders, &QueryLiquidityProviderResponse{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwitchAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryLiquidityProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryLiquidityProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryLiquidityProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1025,11 +2503,11 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwitchAddress = string(dAtA[iNdEx:postIndex])
+			m.Asset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1057,13 +2535,13 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAddHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			m.LastAddHeight = 0
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryLiquidityProvider
@@ -1073,16 +2551,16 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastAddHeight |= int64(b&0x7F) << shift
+				m.FromHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			m.LastWithdrawHeight = 0
+			m.ToHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryLiquidityProvider
@@ -1092,16 +2570,16 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastWithdrawHeight |= int64(b&0x7F) << shift
+				m.ToHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryLiquidityProvider
@@ -1111,93 +2589,66 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryLiquidityProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQueryLiquidityProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Units = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwitch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueryLiquidityProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryLiquidityProvider
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingSwitch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingAsset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueryLiquidityProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.PendingAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxId", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryLiquidityProvider
@@ -1207,27 +2658,14 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingTxId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwitchDepositValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1255,11 +2693,11 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwitchDepositValue = string(dAtA[iNdEx:postIndex])
+			m.Units = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetDepositValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1287,9 +2725,9 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetDepositValue = string(dAtA[iNdEx:postIndex])
+			m.PoolUnits = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SwitchRedeemValue", wireType)
 			}
@@ -1321,7 +2759,7 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.SwitchRedeemValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AssetRedeemValue", wireType)
 			}
@@ -1353,9 +2791,9 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.AssetRedeemValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LuviDepositValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchDepositValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1383,11 +2821,11 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LuviDepositValue = string(dAtA[iNdEx:postIndex])
+			m.SwitchDepositValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LuviRedeemValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDepositValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1415,11 +2853,11 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LuviRedeemValue = string(dAtA[iNdEx:postIndex])
+			m.AssetDepositValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LuviGrowthPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1447,7 +2885,7 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LuviGrowthPct = string(dAtA[iNdEx:postIndex])
+			m.AccruedFees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1470,7 +2908,7 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityProviderHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1493,10 +2931,10 @@ func (m *QueryLiquidityProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityProviderHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityProviderHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1533,7 +2971,7 @@ func (m *QueryLiquidityProvidersRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1561,61 +2999,11 @@ func (m *QueryLiquidityProvidersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueryLiquidityProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueryLiquidityProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLiquidityProvidersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueryLiquidityProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1642,11 +3030,30 @@ func (m *QueryLiquidityProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviders = append(m.LiquidityProviders, &QueryLiquidityProviderResponse{})
-			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, &LiquidityProviderPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLiquidityProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryLiquidityProvider(dAtA[iNdEx:])
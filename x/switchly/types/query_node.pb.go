@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	common "github.com/switchlyprotocol/switchlynode/v3/common"
@@ -300,7 +301,12 @@ func (m *QueryNodeResponse) GetMaintenance() bool {
 }
 
 type QueryNodesRequest struct {
-	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Height     string             `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return nodes with a node address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,3,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// only return nodes with this status
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (m *QueryNodesRequest) Reset()         { *m = QueryNodesRequest{} }
@@ -343,8 +349,30 @@ func (m *QueryNodesRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QueryNodesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryNodesRequest) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *QueryNodesRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 type QueryNodesResponse struct {
-	Nodes []*QueryNodeResponse `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Nodes      []*QueryNodeResponse `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryNodesResponse) Reset()         { *m = QueryNodesResponse{} }
@@ -387,6 +415,13 @@ func (m *QueryNodesResponse) GetNodes() []*QueryNodeResponse {
 	return nil
 }
 
+func (m *QueryNodesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type NodeBondProviders struct {
 	NodeOperatorFee string              `protobuf:"bytes,1,opt,name=node_operator_fee,json=nodeOperatorFee,proto3" json:"node_operator_fee"`
 	Providers       []*NodeBondProvider `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers"`
@@ -672,72 +707,79 @@ func init() {
 func init() { proto.RegisterFile("types/query_node.proto", fileDescriptor_aa5f5713cfe920f4) }
 
 var fileDescriptor_aa5f5713cfe920f4 = []byte{
-	// 1039 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x36, 0x23, 0x5b, 0xb6, 0x86, 0x96, 0x25, 0xad, 0x6c, 0x85, 0x3f, 0xe3, 0x07, 0x51, 0x15,
-	0x1a, 0x40, 0x40, 0x61, 0x0b, 0x8d, 0x81, 0x5e, 0xda, 0x8b, 0x19, 0x23, 0xad, 0xdb, 0xba, 0x71,
-	0xd7, 0x45, 0x0f, 0xed, 0x81, 0xa0, 0xc8, 0xb5, 0xc5, 0x86, 0xe6, 0x32, 0x5c, 0x4a, 0xa9, 0xd1,
-	0x97, 0xe8, 0x03, 0xf4, 0x81, 0xd2, 0x5b, 0x8e, 0x3d, 0x11, 0x85, 0x7d, 0xe3, 0x53, 0x14, 0x3b,
-	0x4b, 0x4a, 0x2b, 0xff, 0xb9, 0x88, 0x3b, 0xdf, 0x7c, 0x9c, 0x5d, 0xee, 0x7c, 0x33, 0x23, 0xe8,
-	0x65, 0x37, 0x09, 0x13, 0xe3, 0x77, 0x33, 0x96, 0xde, 0xb8, 0x31, 0x0f, 0xd8, 0x61, 0x92, 0xf2,
-	0x8c, 0x93, 0x0d, 0xc4, 0xf7, 0x77, 0xaf, 0xf8, 0x15, 0x47, 0x64, 0x2c, 0x57, 0xca, 0xb9, 0xdf,
-	0xf5, 0xf9, 0xf5, 0x35, 0x8f, 0xc7, 0xea, 0xa1, 0xc0, 0xe1, 0x09, 0xb4, 0x7f, 0x94, 0x51, 0x7e,
-	0xe0, 0x01, 0xa3, 0xec, 0xdd, 0x8c, 0x89, 0x8c, 0x58, 0xb0, 0xe9, 0x05, 0x41, 0xca, 0x84, 0xb0,
-	0x8c, 0x81, 0x31, 0x6a, 0xd0, 0xca, 0x24, 0x3d, 0xa8, 0x4f, 0x59, 0x78, 0x35, 0xcd, 0xac, 0x67,
-	0xe8, 0x28, 0xad, 0xe1, 0xdf, 0x00, 0x1d, 0x2d, 0x8c, 0x48, 0x78, 0x2c, 0x18, 0x39, 0x82, 0x6d,
-	0x79, 0x36, 0x77, 0x25, 0x98, 0xd3, 0x2e, 0x72, 0x7b, 0x05, 0xa7, 0xa6, 0xb4, 0x8e, 0xcb, 0x2d,
-	0x86, 0x50, 0x17, 0x99, 0x97, 0xcd, 0x84, 0xda, 0xc2, 0x81, 0x22, 0xb7, 0x4b, 0x84, 0x96, 0x4f,
-	0xf2, 0x1a, 0xcc, 0x64, 0x36, 0x71, 0xdf, 0xb2, 0x1b, 0x57, 0xb0, 0xcc, 0xaa, 0x0d, 0x8c, 0x91,
-	0xf9, 0xb2, 0x73, 0x58, 0x7e, 0xd8, 0xf9, 0x6c, 0xf2, 0x1d, 0xbb, 0xb9, 0x60, 0x99, 0xd3, 0xfd,
-	0x90, 0xdb, 0x6b, 0x45, 0x6e, 0xeb, 0x6c, 0xda, 0x48, 0x2a, 0x3f, 0x79, 0x03, 0xbd, 0xb9, 0x17,
-	0x85, 0x81, 0x97, 0xf1, 0xd4, 0xf5, 0x79, 0x2c, 0xdc, 0x92, 0x68, 0xad, 0xe3, 0xde, 0xfb, 0x45,
-	0x6e, 0x3f, 0xc1, 0xa0, 0xdd, 0x05, 0xfe, 0x8a, 0xc7, 0x42, 0xed, 0x49, 0x3e, 0x85, 0xcd, 0x84,
-	0xb1, 0xd4, 0x0d, 0x03, 0x6b, 0x03, 0x23, 0x98, 0x45, 0x6e, 0x57, 0x10, 0xad, 0xcb, 0xc5, 0x69,
-	0x40, 0xbe, 0x86, 0xae, 0xe7, 0x67, 0xe1, 0x9c, 0xb9, 0x93, 0x88, 0xfb, 0x6f, 0xdd, 0xf2, 0x4a,
-	0xeb, 0x03, 0x63, 0x54, 0x73, 0x9e, 0x17, 0xb9, 0xfd, 0x98, 0x9b, 0x76, 0x14, 0xe8, 0x48, 0xec,
-	0x1b, 0x84, 0xe4, 0x05, 0xab, 0x1b, 0x71, 0x45, 0x18, 0xfb, 0xcc, 0xda, 0xc4, 0x08, 0x78, 0xc1,
-	0x3a, 0x4e, 0x4d, 0x65, 0x5d, 0x48, 0x83, 0x9c, 0xc1, 0x1e, 0xde, 0x3e, 0x4f, 0x58, 0x8a, 0x9f,
-	0x55, 0xa5, 0x67, 0x0b, 0x4f, 0xfc, 0xbf, 0x22, 0xb7, 0x1f, 0x27, 0xd0, 0xae, 0x84, 0xdf, 0x94,
-	0x68, 0x95, 0xaf, 0x03, 0x80, 0x8c, 0x67, 0x5e, 0xe4, 0x4e, 0x78, 0x1c, 0x58, 0x0d, 0x8c, 0xb1,
-	0x53, 0xe4, 0xb6, 0x86, 0xd2, 0x06, 0xae, 0x1d, 0x1e, 0x07, 0xe4, 0x02, 0x76, 0x24, 0xe4, 0x26,
-	0x29, 0x9f, 0x87, 0x01, 0x4b, 0x85, 0x05, 0x98, 0x3d, 0xeb, 0x10, 0xa5, 0x7b, 0x28, 0x05, 0x24,
-	0x89, 0xe7, 0x95, 0xdf, 0x21, 0x45, 0x6e, 0xdf, 0x7b, 0x87, 0x36, 0x27, 0x3a, 0x85, 0x38, 0xd0,
-	0x11, 0xe1, 0x55, 0xcc, 0x52, 0xf7, 0x9a, 0x5d, 0x4f, 0x58, 0x2a, 0xa6, 0x61, 0x62, 0x99, 0x83,
-	0xda, 0xa8, 0xe1, 0xec, 0x15, 0xb9, 0xfd, 0xd0, 0x49, 0xdb, 0x0a, 0x3a, 0x5b, 0x20, 0xe4, 0x04,
-	0x48, 0xaa, 0xf4, 0xcf, 0x02, 0x37, 0xe3, 0x6e, 0xc4, 0xbc, 0x39, 0xb3, 0xb6, 0x07, 0xc6, 0x68,
-	0xcb, 0xe9, 0x15, 0xb9, 0xfd, 0x88, 0x97, 0xb6, 0x17, 0xd8, 0x4f, 0xfc, 0x7b, 0x89, 0x90, 0x2f,
-	0xa1, 0x75, 0xc9, 0x53, 0x5f, 0x0f, 0xd1, 0xc4, 0x10, 0xdd, 0x22, 0xb7, 0xef, 0xbb, 0x68, 0x53,
-	0x01, 0xd5, 0xcb, 0x47, 0xb0, 0x8d, 0x78, 0x25, 0x88, 0x9d, 0x65, 0x3a, 0x75, 0x9c, 0x9a, 0x68,
-	0x95, 0x1a, 0x38, 0x00, 0x08, 0x93, 0x45, 0x0e, 0x5b, 0xcb, 0xfb, 0x5f, 0xa2, 0xb4, 0x11, 0x26,
-	0x55, 0xba, 0x5e, 0xc0, 0xe6, 0x9c, 0xa5, 0x22, 0xe4, 0xb1, 0xd5, 0x5e, 0x2a, 0xb4, 0x84, 0x68,
-	0xb5, 0x40, 0x65, 0x45, 0x9e, 0x98, 0xba, 0x09, 0x0f, 0xe3, 0x4c, 0x58, 0x1d, 0x4d, 0x59, 0x1a,
-	0x4e, 0x4d, 0xb4, 0xce, 0xd1, 0x20, 0x07, 0xb0, 0xfe, 0x9b, 0x17, 0x46, 0x16, 0xc1, 0x8c, 0xb6,
-	0xb4, 0x8c, 0x7e, 0xeb, 0x85, 0x91, 0xb3, 0x55, 0xe4, 0x36, 0x12, 0x28, 0xfe, 0x92, 0x2f, 0xa0,
-	0xe9, 0xcf, 0xd2, 0x94, 0xc5, 0x99, 0xeb, 0xbd, 0xf7, 0xd2, 0xc0, 0xea, 0xe2, 0x81, 0x3a, 0x45,
-	0x6e, 0xaf, 0x3a, 0xe8, 0x76, 0x69, 0x1e, 0x4b, 0x8b, 0x9c, 0xc1, 0x0e, 0x9f, 0x08, 0x96, 0xce,
-	0x99, 0xeb, 0x4f, 0xbd, 0x30, 0x16, 0xd6, 0xee, 0xa0, 0x36, 0x32, 0x5f, 0x92, 0x72, 0xc3, 0x57,
-	0x12, 0x54, 0xb7, 0xa3, 0xc4, 0xb3, 0xca, 0xa6, 0xcd, 0xd2, 0x46, 0x9e, 0x20, 0xbf, 0x42, 0x3b,
-	0x49, 0xd9, 0x65, 0x24, 0xf9, 0x6e, 0xd9, 0x7a, 0xf6, 0xf0, 0x0b, 0xf6, 0xb5, 0x2f, 0x38, 0xaf,
-	0x28, 0x17, 0xc8, 0x70, 0x76, 0x8b, 0xdc, 0x7e, 0xf0, 0x1e, 0x6d, 0x25, 0xab, 0x34, 0xf2, 0x39,
-	0x98, 0xd7, 0x5e, 0x18, 0x67, 0x2c, 0xf6, 0x64, 0x81, 0xf6, 0x50, 0x0b, 0x2d, 0xd9, 0x92, 0x34,
-	0x98, 0xea, 0xc6, 0xf0, 0x33, 0xad, 0x95, 0x8a, 0xaa, 0x25, 0x2f, 0x1b, 0xaf, 0xb1, 0xd2, 0x78,
-	0x4f, 0x80, 0xe8, 0xe4, 0xb2, 0xf1, 0x1e, 0xc2, 0x86, 0x2c, 0x55, 0xd9, 0x71, 0x6b, 0x5a, 0x6d,
-	0x3d, 0xe8, 0xd0, 0x54, 0xd1, 0x86, 0x7f, 0x19, 0xd0, 0x79, 0x50, 0x78, 0xe4, 0x18, 0x3a, 0xab,
-	0x7d, 0xe0, 0x92, 0xb1, 0xb2, 0x87, 0x63, 0x55, 0x3d, 0x70, 0xd2, 0x96, 0xde, 0x20, 0x5e, 0x33,
-	0x46, 0x4e, 0xa0, 0xb1, 0x2c, 0xf4, 0x67, 0x78, 0x98, 0xe7, 0x4f, 0x14, 0xba, 0xd3, 0x2c, 0x72,
-	0x7b, 0xc9, 0xa6, 0xcb, 0xe5, 0xf0, 0x14, 0xda, 0xf7, 0xd9, 0xe4, 0x13, 0xd8, 0xc6, 0x9e, 0xb0,
-	0x3a, 0xa8, 0x4c, 0x89, 0x55, 0x52, 0x27, 0xb0, 0x8e, 0x3d, 0x49, 0x8d, 0x2a, 0x5c, 0x0f, 0x4f,
-	0x61, 0xab, 0xd2, 0x23, 0x79, 0x01, 0x3b, 0x29, 0x8b, 0x98, 0x27, 0x16, 0x05, 0x27, 0x83, 0xd4,
-	0x68, 0xb3, 0x44, 0xcb, 0x02, 0xeb, 0x41, 0x3d, 0x65, 0x9e, 0xe0, 0x71, 0x35, 0xf3, 0x94, 0x35,
-	0xa4, 0x60, 0x6a, 0x4a, 0x23, 0x36, 0x6c, 0xa0, 0xbe, 0xca, 0x1b, 0x6a, 0x14, 0xb9, 0xad, 0x00,
-	0xaa, 0x1e, 0x72, 0xb0, 0x69, 0xb3, 0xb3, 0xa6, 0x06, 0x5b, 0x59, 0xd1, 0x55, 0x3a, 0xff, 0x80,
-	0xee, 0x23, 0x62, 0xd3, 0x66, 0xa2, 0xf1, 0xe4, 0x4c, 0x1c, 0xae, 0x1e, 0x53, 0x71, 0x14, 0x52,
-	0x1d, 0x99, 0xfc, 0x1f, 0xd6, 0x7d, 0x1e, 0x30, 0x1c, 0x98, 0x35, 0x55, 0x8f, 0xd2, 0xa6, 0xf8,
-	0xeb, 0xfc, 0xfc, 0xe1, 0xb6, 0x6f, 0x7c, 0xbc, 0xed, 0x1b, 0xff, 0xde, 0xf6, 0x8d, 0x3f, 0xef,
-	0xfa, 0x6b, 0x1f, 0xef, 0xfa, 0x6b, 0xff, 0xdc, 0xf5, 0xd7, 0x7e, 0xf9, 0xea, 0x2a, 0xcc, 0xa6,
-	0xb3, 0x89, 0x1c, 0xb0, 0x63, 0xf1, 0x3e, 0xcc, 0xfc, 0x69, 0x74, 0x83, 0x7f, 0x1f, 0x7c, 0x1e,
-	0x2d, 0x00, 0x99, 0xfb, 0xf1, 0xfc, 0x68, 0xfc, 0xfb, 0x02, 0x19, 0x63, 0xa6, 0x27, 0x75, 0xa4,
-	0x1e, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x07, 0xc1, 0x99, 0xcd, 0xb5, 0x08, 0x00, 0x00,
+	// 1140 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x36, 0x23, 0x5b, 0xb6, 0x0e, 0x2d, 0x4b, 0x1a, 0x25, 0x0a, 0x7f, 0xe3, 0x87, 0xa8, 0x0a,
+	0x4d, 0x2b, 0x14, 0x88, 0x04, 0xdb, 0x40, 0x37, 0xed, 0xc6, 0x8c, 0xe1, 0xd4, 0x6d, 0xdd, 0xa8,
+	0xe3, 0xa2, 0x8b, 0x76, 0x41, 0x50, 0xe4, 0x58, 0x62, 0x23, 0x71, 0x18, 0x0e, 0xa5, 0xc4, 0xe8,
+	0x33, 0x14, 0xe8, 0x03, 0xf4, 0x29, 0xfa, 0x14, 0xe9, 0x2e, 0xcb, 0xae, 0x88, 0xc2, 0xde, 0xf1,
+	0x29, 0x8a, 0xb9, 0x50, 0x1a, 0xf9, 0x82, 0x6e, 0xa4, 0x39, 0xdf, 0xf9, 0xe6, 0x76, 0xce, 0x99,
+	0xef, 0x10, 0x5a, 0xe9, 0x55, 0x4c, 0xd8, 0xe0, 0xcd, 0x9c, 0x24, 0x57, 0x6e, 0x44, 0x03, 0xd2,
+	0x8f, 0x13, 0x9a, 0x52, 0xb4, 0x25, 0xf0, 0xfd, 0xc7, 0x63, 0x3a, 0xa6, 0x02, 0x19, 0xf0, 0x91,
+	0x74, 0xee, 0x7f, 0xe6, 0x53, 0x36, 0xa3, 0x6c, 0x30, 0xf2, 0x18, 0x91, 0x53, 0x07, 0x8b, 0x83,
+	0x11, 0x49, 0xbd, 0x83, 0x41, 0xec, 0x8d, 0xc3, 0xc8, 0x4b, 0x43, 0x1a, 0x29, 0x6e, 0xd3, 0xa7,
+	0xb3, 0x19, 0x8d, 0x06, 0xf2, 0x4f, 0x82, 0xdd, 0x13, 0xa8, 0x7f, 0xcf, 0xa7, 0x7d, 0x47, 0x03,
+	0x82, 0xc9, 0x9b, 0x39, 0x61, 0x29, 0xb2, 0x60, 0xdb, 0x0b, 0x82, 0x84, 0x30, 0x66, 0x19, 0x1d,
+	0xa3, 0x57, 0xc1, 0x85, 0x89, 0x5a, 0x50, 0x9e, 0x90, 0x70, 0x3c, 0x49, 0xad, 0x47, 0xc2, 0xa1,
+	0xac, 0xee, 0x5f, 0x00, 0x0d, 0x6d, 0x19, 0x16, 0xd3, 0x88, 0x11, 0x74, 0x04, 0xbb, 0xfc, 0x1e,
+	0xee, 0xda, 0x62, 0x4e, 0x3d, 0xcf, 0xec, 0x35, 0x1c, 0x9b, 0xdc, 0x3a, 0x56, 0x5b, 0x74, 0xa1,
+	0xcc, 0x52, 0x2f, 0x9d, 0x33, 0xb9, 0x85, 0x03, 0x79, 0x66, 0x2b, 0x04, 0xab, 0x7f, 0x74, 0x0a,
+	0x66, 0x3c, 0x1f, 0xb9, 0xaf, 0xc9, 0x95, 0xcb, 0x48, 0x6a, 0x95, 0x3a, 0x46, 0xcf, 0x3c, 0x6c,
+	0xf4, 0xd5, 0xc5, 0x86, 0xf3, 0xd1, 0x37, 0xe4, 0xea, 0x82, 0xa4, 0x4e, 0xf3, 0x7d, 0x66, 0x6f,
+	0xe4, 0x99, 0xad, 0xb3, 0x71, 0x25, 0x2e, 0xfc, 0xe8, 0x15, 0xb4, 0x16, 0xde, 0x34, 0x0c, 0xbc,
+	0x94, 0x26, 0xae, 0x4f, 0x23, 0xe6, 0x2a, 0xa2, 0xb5, 0x29, 0xf6, 0xde, 0xcf, 0x33, 0xfb, 0x01,
+	0x06, 0x6e, 0x2e, 0xf1, 0x17, 0x34, 0x62, 0x72, 0x4f, 0xf4, 0x31, 0x6c, 0xc7, 0x84, 0x24, 0x6e,
+	0x18, 0x58, 0x5b, 0x62, 0x05, 0x33, 0xcf, 0xec, 0x02, 0xc2, 0x65, 0x3e, 0x38, 0x0b, 0xd0, 0x4b,
+	0x68, 0x7a, 0x7e, 0x1a, 0x2e, 0x88, 0x3b, 0x9a, 0x52, 0xff, 0xb5, 0xab, 0x42, 0x5a, 0xee, 0x18,
+	0xbd, 0x92, 0xf3, 0x34, 0xcf, 0xec, 0xfb, 0xdc, 0xb8, 0x21, 0x41, 0x87, 0x63, 0x5f, 0x09, 0x88,
+	0x07, 0x58, 0x46, 0xc4, 0x65, 0x61, 0xe4, 0x13, 0x6b, 0x5b, 0xac, 0x20, 0x02, 0xac, 0xe3, 0xd8,
+	0x94, 0xd6, 0x05, 0x37, 0xd0, 0x39, 0x3c, 0x11, 0xd1, 0xa7, 0x31, 0x49, 0xc4, 0xb5, 0x8a, 0xf4,
+	0xec, 0x88, 0x13, 0xff, 0x2f, 0xcf, 0xec, 0xfb, 0x09, 0xb8, 0xc9, 0xe1, 0x57, 0x0a, 0x2d, 0xf2,
+	0xf5, 0x1c, 0x20, 0xa5, 0xa9, 0x37, 0x75, 0x47, 0x34, 0x0a, 0xac, 0x8a, 0x58, 0x63, 0x2f, 0xcf,
+	0x6c, 0x0d, 0xc5, 0x15, 0x31, 0x76, 0x68, 0x14, 0xa0, 0x0b, 0xd8, 0xe3, 0x90, 0x1b, 0x27, 0x74,
+	0x11, 0x06, 0x24, 0x61, 0x16, 0x88, 0xec, 0x59, 0x7d, 0x51, 0xe6, 0x7d, 0x5e, 0x40, 0x9c, 0x38,
+	0x2c, 0xfc, 0x0e, 0xca, 0x33, 0xfb, 0xd6, 0x1c, 0x5c, 0x1d, 0xe9, 0x14, 0xe4, 0x40, 0x83, 0x85,
+	0xe3, 0x88, 0x24, 0xee, 0x8c, 0xcc, 0x46, 0x24, 0x61, 0x93, 0x30, 0xb6, 0xcc, 0x4e, 0xa9, 0x57,
+	0x71, 0x9e, 0xe4, 0x99, 0x7d, 0xd7, 0x89, 0xeb, 0x12, 0x3a, 0x5f, 0x22, 0xe8, 0x04, 0x50, 0x22,
+	0xeb, 0x9f, 0x04, 0x6e, 0x4a, 0xdd, 0x29, 0xf1, 0x16, 0xc4, 0xda, 0xed, 0x18, 0xbd, 0x1d, 0xa7,
+	0x95, 0x67, 0xf6, 0x3d, 0x5e, 0x5c, 0x5f, 0x62, 0x3f, 0xd0, 0x6f, 0x39, 0x82, 0xbe, 0x80, 0xda,
+	0x25, 0x4d, 0x7c, 0x7d, 0x89, 0xaa, 0x58, 0xa2, 0x99, 0x67, 0xf6, 0x6d, 0x17, 0xae, 0x4a, 0xa0,
+	0x98, 0x7c, 0x04, 0xbb, 0x02, 0x2f, 0x0a, 0x62, 0x6f, 0x95, 0x4e, 0x1d, 0xc7, 0xa6, 0xb0, 0x54,
+	0x0d, 0x3c, 0x07, 0x08, 0xe3, 0x65, 0x0e, 0x6b, 0xab, 0xf8, 0xaf, 0x50, 0x5c, 0x09, 0xe3, 0x22,
+	0x5d, 0xcf, 0x60, 0x7b, 0x41, 0x12, 0x16, 0xd2, 0xc8, 0xaa, 0xaf, 0x2a, 0x54, 0x41, 0xb8, 0x18,
+	0x88, 0xca, 0x9a, 0x7a, 0x6c, 0xe2, 0xc6, 0x34, 0x8c, 0x52, 0x66, 0x35, 0xb4, 0xca, 0xd2, 0x70,
+	0x6c, 0x0a, 0x6b, 0x28, 0x0c, 0xf4, 0x1c, 0x36, 0x7f, 0xf1, 0xc2, 0xa9, 0x85, 0x44, 0x46, 0x6b,
+	0x5a, 0x46, 0xbf, 0xf6, 0xc2, 0xa9, 0xb3, 0x93, 0x67, 0xb6, 0x20, 0x60, 0xf1, 0x8b, 0x3e, 0x87,
+	0xaa, 0x3f, 0x4f, 0x12, 0x12, 0xa5, 0xae, 0xf7, 0xd6, 0x4b, 0x02, 0xab, 0x29, 0x0e, 0xd4, 0xc8,
+	0x33, 0x7b, 0xdd, 0x81, 0x77, 0x95, 0x79, 0xcc, 0x2d, 0x74, 0x0e, 0x7b, 0x74, 0xc4, 0x48, 0xb2,
+	0x20, 0xae, 0x3f, 0xf1, 0xc2, 0x88, 0x59, 0x8f, 0x3b, 0xa5, 0x9e, 0x79, 0x88, 0xd4, 0x86, 0x2f,
+	0x38, 0x28, 0xa3, 0x23, 0x8b, 0x67, 0x9d, 0x8d, 0xab, 0xca, 0x16, 0x3c, 0x86, 0x7e, 0x86, 0x7a,
+	0x9c, 0x90, 0xcb, 0x29, 0xe7, 0xbb, 0x4a, 0x7a, 0x9e, 0x88, 0x1b, 0xec, 0x6b, 0x37, 0x18, 0x16,
+	0x94, 0x0b, 0xc1, 0x70, 0x1e, 0xe7, 0x99, 0x7d, 0x67, 0x1e, 0xae, 0xc5, 0xeb, 0x34, 0x74, 0x00,
+	0xe6, 0xcc, 0x0b, 0xa3, 0x94, 0x44, 0x1e, 0x7f, 0xa0, 0x2d, 0x51, 0x0b, 0x35, 0x2e, 0x49, 0x1a,
+	0x8c, 0x75, 0xa3, 0xfb, 0xa7, 0xa1, 0x69, 0x29, 0x2b, 0x34, 0x79, 0xa5, 0xbc, 0x86, 0xae, 0xbc,
+	0xe8, 0x14, 0x60, 0x25, 0xf4, 0x42, 0x32, 0xcd, 0xc3, 0x4f, 0xfa, 0xb2, 0x2b, 0xf4, 0x79, 0x57,
+	0xe8, 0x8b, 0xae, 0xd0, 0x57, 0x5d, 0xa1, 0x3f, 0xf4, 0xc6, 0x85, 0xce, 0x63, 0x6d, 0x26, 0x7a,
+	0x06, 0x7b, 0xaa, 0x5a, 0x5c, 0x7e, 0x87, 0xf0, 0x9d, 0x50, 0xd5, 0x0a, 0xae, 0x2a, 0x74, 0x28,
+	0x40, 0x7e, 0x0c, 0x15, 0xa2, 0x4d, 0x79, 0x0c, 0x69, 0x75, 0x7f, 0x33, 0x00, 0xe9, 0x87, 0x56,
+	0x1d, 0xa0, 0x0f, 0x5b, 0x5c, 0x33, 0xb8, 0xf4, 0x97, 0xb4, 0x47, 0x7e, 0xa7, 0x55, 0x60, 0x49,
+	0x43, 0x2f, 0xef, 0xb9, 0xcd, 0xa7, 0xff, 0x79, 0x1b, 0xb5, 0x86, 0x36, 0xb5, 0xfb, 0x87, 0x01,
+	0x8d, 0x3b, 0x52, 0x82, 0x8e, 0xa1, 0xb1, 0xae, 0x6c, 0x97, 0x84, 0xa8, 0xae, 0x24, 0x74, 0xe2,
+	0x8e, 0x13, 0xd7, 0x74, 0xc9, 0x3b, 0x25, 0x04, 0x9d, 0x40, 0x65, 0x25, 0x5d, 0x8f, 0xc4, 0xad,
+	0x9e, 0x3e, 0x20, 0x5d, 0x4e, 0x35, 0xcf, 0xec, 0x15, 0x1b, 0xaf, 0x86, 0xdd, 0x33, 0xa8, 0xdf,
+	0x66, 0xa3, 0x8f, 0x60, 0x57, 0xa8, 0xdc, 0x7a, 0xeb, 0x35, 0x39, 0x56, 0x3c, 0x5e, 0x04, 0x9b,
+	0xdc, 0x54, 0xcd, 0x57, 0x8c, 0xbb, 0x67, 0xb0, 0x53, 0xbc, 0x30, 0x9e, 0xc4, 0x84, 0x4c, 0x89,
+	0xc7, 0x96, 0x12, 0xc2, 0x17, 0x29, 0xe1, 0xaa, 0x42, 0x95, 0x64, 0xb4, 0xa0, 0x9c, 0x10, 0x8f,
+	0xa9, 0x08, 0x57, 0xb0, 0xb2, 0xba, 0x18, 0x4c, 0xed, 0xed, 0x20, 0x1b, 0xb6, 0xc4, 0x8b, 0x51,
+	0x11, 0xaa, 0xe4, 0x99, 0x2d, 0x01, 0x2c, 0xff, 0x78, 0xab, 0xd6, 0xbe, 0x06, 0x4a, 0xb2, 0x55,
+	0x4b, 0x64, 0xf9, 0x65, 0xf0, 0x2b, 0x34, 0xef, 0x79, 0x3e, 0x5a, 0x97, 0x37, 0x1e, 0xec, 0xf2,
+	0xdd, 0xf5, 0x63, 0x4a, 0x8e, 0x44, 0x8a, 0x23, 0xa3, 0xff, 0xc3, 0xa6, 0x4f, 0x03, 0x22, 0x8a,
+	0xb5, 0x24, 0x15, 0x86, 0xdb, 0x58, 0xfc, 0x3a, 0x3f, 0xbe, 0xbf, 0x6e, 0x1b, 0x1f, 0xae, 0xdb,
+	0xc6, 0x3f, 0xd7, 0x6d, 0xe3, 0xf7, 0x9b, 0xf6, 0xc6, 0x87, 0x9b, 0xf6, 0xc6, 0xdf, 0x37, 0xed,
+	0x8d, 0x9f, 0xbe, 0x1c, 0x87, 0xe9, 0x64, 0x3e, 0xe2, 0x9f, 0x0c, 0x03, 0xf6, 0x36, 0x4c, 0xfd,
+	0xc9, 0xf4, 0x4a, 0x7c, 0x10, 0xf9, 0x74, 0xba, 0x04, 0x78, 0xee, 0x07, 0x8b, 0xa3, 0xc1, 0xbb,
+	0x25, 0x32, 0x10, 0x99, 0x1e, 0x95, 0x05, 0xf5, 0xe8, 0xdf, 0x01, 0x00, 0xbd, 0x58, 0x3c, 0xf4,
+	0xb3, 0x09, 0x00, 0x00,
 }
 
 func (m *QueryNodeRequest) Marshal() (dAtA []byte, err error) {
@@ -1016,6 +1058,32 @@ func (m *QueryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQueryNode(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintQueryNode(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -1046,6 +1114,18 @@ func (m *QueryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Nodes) > 0 {
 		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1386,6 +1466,18 @@ func (m *QueryNodesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryNode(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryNode(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovQueryNode(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQueryNode(uint64(l))
+	}
 	return n
 }
 
@@ -1401,6 +1493,10 @@ func (m *QueryNodesResponse) Size() (n int) {
 			n += 1 + l + sovQueryNode(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryNode(uint64(l))
+	}
 	return n
 }
 
@@ -2354,6 +2450,106 @@ func (m *QueryNodesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryNode(dAtA[iNdEx:])
@@ -2438,6 +2634,42 @@ func (m *QueryNodesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryNode(dAtA[iNdEx:])
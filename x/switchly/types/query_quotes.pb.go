@@ -37,6 +37,10 @@ type QueryQuoteSwapRequest struct {
 	Height                string   `protobuf:"bytes,11,opt,name=height,proto3" json:"height,omitempty"`
 	LiquidityToleranceBps string   `protobuf:"bytes,12,opt,name=liquidity_tolerance_bps,json=liquidityToleranceBps,proto3" json:"liquidity_tolerance_bps,omitempty"`
 	Extended              bool     `protobuf:"varint,13,opt,name=extended,proto3" json:"extended,omitempty"`
+	// search the streaming interval and quantity maximising the output, streaming_quantity must not be set
+	OptimizeStreaming bool `protobuf:"varint,14,opt,name=optimize_streaming,json=optimizeStreaming,proto3" json:"optimize_streaming,omitempty"`
+	// the maximum duration of the searched streaming swap in seconds, unbounded if not set
+	MaxStreamingSeconds string `protobuf:"bytes,15,opt,name=max_streaming_seconds,json=maxStreamingSeconds,proto3" json:"max_streaming_seconds,omitempty"`
 }
 
 func (m *QueryQuoteSwapRequest) Reset()         { *m = QueryQuoteSwapRequest{} }
@@ -163,6 +167,20 @@ func (m *QueryQuoteSwapRequest) GetExtended() bool {
 	return false
 }
 
+func (m *QueryQuoteSwapRequest) GetOptimizeStreaming() bool {
+	if m != nil {
+		return m.OptimizeStreaming
+	}
+	return false
+}
+
+func (m *QueryQuoteSwapRequest) GetMaxStreamingSeconds() string {
+	if m != nil {
+		return m.MaxStreamingSeconds
+	}
+	return ""
+}
+
 type QueryQuoteSwapResponse struct {
 	// the inbound address for the transaction on the source chain
 	InboundAddress string `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
@@ -205,6 +223,8 @@ type QueryQuoteSwapResponse struct {
 	TotalSwapSeconds int64 `protobuf:"varint,20,opt,name=total_swap_seconds,json=totalSwapSeconds,proto3" json:"total_swap_seconds,omitempty"`
 	// List of outputs needed (additional to deposit and change return). Meant for wallets to easily construct transactions with more than 80bytes
 	Vout []*Vout `protobuf:"bytes,21,rep,name=vout,proto3" json:"vout,omitempty"`
+	// the expected output of the searched streaming quantities, set if optimize_streaming was requested
+	StreamingCurve []*StreamingQuotePoint `protobuf:"bytes,22,rep,name=streaming_curve,json=streamingCurve,proto3" json:"streaming_curve,omitempty"`
 }
 
 func (m *QueryQuoteSwapResponse) Reset()         { *m = QueryQuoteSwapResponse{} }
@@ -387,6 +407,166 @@ func (m *QueryQuoteSwapResponse) GetVout() []*Vout {
 	return nil
 }
 
+func (m *QueryQuoteSwapResponse) GetStreamingCurve() []*StreamingQuotePoint {
+	if m != nil {
+		return m.StreamingCurve
+	}
+	return nil
+}
+
+type QueryQuoteSwapBatchRequest struct {
+	// the swap quotes to evaluate, each with its own route and streaming parameters
+	Quotes []*QueryQuoteSwapRequest `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	// the height all quotes are evaluated at
+	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryQuoteSwapBatchRequest) Reset()         { *m = QueryQuoteSwapBatchRequest{} }
+func (m *QueryQuoteSwapBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSwapBatchRequest) ProtoMessage()    {}
+func (*QueryQuoteSwapBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5502cf9fcacfb1bc, []int{2}
+}
+func (m *QueryQuoteSwapBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSwapBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSwapBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSwapBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSwapBatchRequest.Merge(m, src)
+}
+func (m *QueryQuoteSwapBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSwapBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSwapBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSwapBatchRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteSwapBatchRequest) GetQuotes() []*QueryQuoteSwapRequest {
+	if m != nil {
+		return m.Quotes
+	}
+	return nil
+}
+
+func (m *QueryQuoteSwapBatchRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type QuoteSwapBatchResult struct {
+	// the quote, empty if the entry failed
+	Quote *QueryQuoteSwapResponse `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	// the reason the entry failed, empty on success
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QuoteSwapBatchResult) Reset()         { *m = QuoteSwapBatchResult{} }
+func (m *QuoteSwapBatchResult) String() string { return proto.CompactTextString(m) }
+func (*QuoteSwapBatchResult) ProtoMessage()    {}
+func (*QuoteSwapBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5502cf9fcacfb1bc, []int{3}
+}
+func (m *QuoteSwapBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteSwapBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteSwapBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteSwapBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteSwapBatchResult.Merge(m, src)
+}
+func (m *QuoteSwapBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteSwapBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteSwapBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteSwapBatchResult proto.InternalMessageInfo
+
+func (m *QuoteSwapBatchResult) GetQuote() *QueryQuoteSwapResponse {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *QuoteSwapBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type QueryQuoteSwapBatchResponse struct {
+	// the result of each quote, in the order of the request
+	Results []*QuoteSwapBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryQuoteSwapBatchResponse) Reset()         { *m = QueryQuoteSwapBatchResponse{} }
+func (m *QueryQuoteSwapBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSwapBatchResponse) ProtoMessage()    {}
+func (*QueryQuoteSwapBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5502cf9fcacfb1bc, []int{4}
+}
+func (m *QueryQuoteSwapBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSwapBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSwapBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSwapBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSwapBatchResponse.Merge(m, src)
+}
+func (m *QueryQuoteSwapBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSwapBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSwapBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSwapBatchResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteSwapBatchResponse) GetResults() []*QuoteSwapBatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type QueryQuoteSaverDepositRequest struct {
 	Asset        string   `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
 	Amount       string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -399,7 +579,7 @@ func (m *QueryQuoteSaverDepositRequest) Reset()         { *m = QueryQuoteSaverDe
 func (m *QueryQuoteSaverDepositRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteSaverDepositRequest) ProtoMessage()    {}
 func (*QueryQuoteSaverDepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{2}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{5}
 }
 func (m *QueryQuoteSaverDepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,7 +683,7 @@ func (m *QueryQuoteSaverDepositResponse) Reset()         { *m = QueryQuoteSaverD
 func (m *QueryQuoteSaverDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteSaverDepositResponse) ProtoMessage()    {}
 func (*QueryQuoteSaverDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{3}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{6}
 }
 func (m *QueryQuoteSaverDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,7 +842,7 @@ func (m *QueryQuoteSaverWithdrawRequest) Reset()         { *m = QueryQuoteSaverW
 func (m *QueryQuoteSaverWithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteSaverWithdrawRequest) ProtoMessage()    {}
 func (*QueryQuoteSaverWithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{4}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{7}
 }
 func (m *QueryQuoteSaverWithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +939,7 @@ func (m *QueryQuoteSaverWithdrawResponse) Reset()         { *m = QueryQuoteSaver
 func (m *QueryQuoteSaverWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteSaverWithdrawResponse) ProtoMessage()    {}
 func (*QueryQuoteSaverWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{5}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{8}
 }
 func (m *QueryQuoteSaverWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1102,7 @@ func (m *QueryQuoteLoanOpenRequest) Reset()         { *m = QueryQuoteLoanOpenReq
 func (m *QueryQuoteLoanOpenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteLoanOpenRequest) ProtoMessage()    {}
 func (*QueryQuoteLoanOpenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{6}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{9}
 }
 func (m *QueryQuoteLoanOpenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,7 +1237,7 @@ func (m *QueryQuoteLoanOpenResponse) Reset()         { *m = QueryQuoteLoanOpenRe
 func (m *QueryQuoteLoanOpenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteLoanOpenResponse) ProtoMessage()    {}
 func (*QueryQuoteLoanOpenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{7}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{10}
 }
 func (m *QueryQuoteLoanOpenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1433,7 @@ func (m *QueryQuoteLoanCloseRequest) Reset()         { *m = QueryQuoteLoanCloseR
 func (m *QueryQuoteLoanCloseRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteLoanCloseRequest) ProtoMessage()    {}
 func (*QueryQuoteLoanCloseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{8}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{11}
 }
 func (m *QueryQuoteLoanCloseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1374,7 +1554,7 @@ func (m *QueryQuoteLoanCloseResponse) Reset()         { *m = QueryQuoteLoanClose
 func (m *QueryQuoteLoanCloseResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteLoanCloseResponse) ProtoMessage()    {}
 func (*QueryQuoteLoanCloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{9}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{12}
 }
 func (m *QueryQuoteLoanCloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1578,7 +1758,7 @@ func (m *QuoteFees) Reset()         { *m = QuoteFees{} }
 func (m *QuoteFees) String() string { return proto.CompactTextString(m) }
 func (*QuoteFees) ProtoMessage()    {}
 func (*QuoteFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{10}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{13}
 }
 func (m *QuoteFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1669,7 +1849,7 @@ func (m *Vout) Reset()         { *m = Vout{} }
 func (m *Vout) String() string { return proto.CompactTextString(m) }
 func (*Vout) ProtoMessage()    {}
 func (*Vout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5502cf9fcacfb1bc, []int{11}
+	return fileDescriptor_5502cf9fcacfb1bc, []int{14}
 }
 func (m *Vout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,9 +1899,93 @@ func (m *Vout) GetAmount() int64 {
 	return 0
 }
 
+type StreamingQuotePoint struct {
+	// the streaming interval in blocks
+	Interval int64 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval"`
+	// the number of sub-swaps
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity"`
+	// the number of blocks the streaming swap will execute over
+	Blocks int64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks"`
+	// the expected amount of the target asset out of the pools, before the outbound and affiliate fees
+	ExpectedSwapOutput string `protobuf:"bytes,4,opt,name=expected_swap_output,json=expectedSwapOutput,proto3" json:"expected_swap_output"`
+	// the swap slippage in basis points
+	SlippageBps int64 `protobuf:"varint,5,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps"`
+}
+
+func (m *StreamingQuotePoint) Reset()         { *m = StreamingQuotePoint{} }
+func (m *StreamingQuotePoint) String() string { return proto.CompactTextString(m) }
+func (*StreamingQuotePoint) ProtoMessage()    {}
+func (*StreamingQuotePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5502cf9fcacfb1bc, []int{15}
+}
+func (m *StreamingQuotePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamingQuotePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamingQuotePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamingQuotePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamingQuotePoint.Merge(m, src)
+}
+func (m *StreamingQuotePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamingQuotePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamingQuotePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamingQuotePoint proto.InternalMessageInfo
+
+func (m *StreamingQuotePoint) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *StreamingQuotePoint) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *StreamingQuotePoint) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *StreamingQuotePoint) GetExpectedSwapOutput() string {
+	if m != nil {
+		return m.ExpectedSwapOutput
+	}
+	return ""
+}
+
+func (m *StreamingQuotePoint) GetSlippageBps() int64 {
+	if m != nil {
+		return m.SlippageBps
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryQuoteSwapRequest)(nil), "types.QueryQuoteSwapRequest")
 	proto.RegisterType((*QueryQuoteSwapResponse)(nil), "types.QueryQuoteSwapResponse")
+	proto.RegisterType((*QueryQuoteSwapBatchRequest)(nil), "types.QueryQuoteSwapBatchRequest")
+	proto.RegisterType((*QuoteSwapBatchResult)(nil), "types.QuoteSwapBatchResult")
+	proto.RegisterType((*QueryQuoteSwapBatchResponse)(nil), "types.QueryQuoteSwapBatchResponse")
 	proto.RegisterType((*QueryQuoteSaverDepositRequest)(nil), "types.QueryQuoteSaverDepositRequest")
 	proto.RegisterType((*QueryQuoteSaverDepositResponse)(nil), "types.QueryQuoteSaverDepositResponse")
 	proto.RegisterType((*QueryQuoteSaverWithdrawRequest)(nil), "types.QueryQuoteSaverWithdrawRequest")
@@ -1732,115 +1996,130 @@ func init() {
 	proto.RegisterType((*QueryQuoteLoanCloseResponse)(nil), "types.QueryQuoteLoanCloseResponse")
 	proto.RegisterType((*QuoteFees)(nil), "types.QuoteFees")
 	proto.RegisterType((*Vout)(nil), "types.Vout")
+	proto.RegisterType((*StreamingQuotePoint)(nil), "types.StreamingQuotePoint")
 }
 
 func init() { proto.RegisterFile("types/query_quotes.proto", fileDescriptor_5502cf9fcacfb1bc) }
 
 var fileDescriptor_5502cf9fcacfb1bc = []byte{
-	// 1636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6f, 0xdc, 0xc6,
-	0x19, 0xf6, 0x6a, 0x57, 0xfb, 0xf1, 0xae, 0x24, 0x4b, 0xd4, 0x6a, 0x45, 0x49, 0xd6, 0x52, 0xde,
-	0xda, 0xa8, 0xd1, 0x0f, 0xc9, 0xb0, 0x8d, 0xa2, 0x05, 0x8c, 0xa2, 0x5e, 0x0b, 0x35, 0x54, 0xd4,
-	0xb0, 0x4d, 0xb9, 0x2e, 0xd0, 0x0b, 0x41, 0x2d, 0x47, 0x2b, 0xa2, 0xdc, 0x19, 0x8a, 0x33, 0xd4,
-	0x47, 0xaf, 0x3d, 0x15, 0xbd, 0xe4, 0x92, 0x1f, 0x90, 0x5b, 0xfe, 0x41, 0x7e, 0x40, 0x2e, 0x39,
-	0xfa, 0x12, 0x20, 0x27, 0x22, 0xb0, 0x73, 0xe2, 0x3f, 0xc8, 0x2d, 0x98, 0x0f, 0x72, 0xc9, 0x15,
-	0x77, 0x05, 0x27, 0x31, 0xe0, 0x24, 0xba, 0x90, 0x9c, 0xe7, 0x7d, 0x66, 0x38, 0x33, 0x7c, 0xdf,
-	0xe7, 0x9d, 0x77, 0x17, 0x74, 0x76, 0xee, 0x23, 0xba, 0x73, 0x1c, 0xa2, 0xe0, 0xdc, 0x3a, 0x0e,
-	0x09, 0x43, 0x74, 0xdb, 0x0f, 0x08, 0x23, 0xda, 0xac, 0xb0, 0xac, 0xb7, 0x06, 0x64, 0x40, 0x04,
-	0xb2, 0xc3, 0x9f, 0xa4, 0xb1, 0xfb, 0x4d, 0x19, 0x56, 0x5e, 0xf0, 0x3e, 0x2f, 0x78, 0x97, 0xfd,
-	0x53, 0xdb, 0x37, 0xd1, 0x71, 0x88, 0x28, 0xd3, 0x36, 0x01, 0x0e, 0x03, 0x32, 0xb4, 0x6c, 0x4a,
-	0x11, 0xd3, 0x4b, 0x5b, 0xa5, 0x3b, 0x0d, 0xb3, 0xc1, 0x91, 0x47, 0x1c, 0xd0, 0xd6, 0xa0, 0xce,
-	0x88, 0x32, 0xce, 0x08, 0x63, 0x8d, 0x11, 0x69, 0x6a, 0x43, 0xd5, 0x1e, 0x92, 0x10, 0x33, 0xbd,
-	0x2c, 0x0c, 0xaa, 0xa5, 0xfd, 0x1e, 0x34, 0xca, 0x02, 0x64, 0x0f, 0x5d, 0x3c, 0xb0, 0x5c, 0xcc,
-	0x50, 0x70, 0x62, 0x7b, 0x7a, 0x45, 0x70, 0x96, 0x52, 0xcb, 0x9e, 0x32, 0xe4, 0xe9, 0xc7, 0xa1,
-	0x8d, 0x99, 0xcb, 0xce, 0xf5, 0xd9, 0x31, 0xfa, 0x0b, 0x65, 0xd0, 0xb6, 0xa0, 0xe9, 0x20, 0xca,
-	0x5c, 0x6c, 0x33, 0x97, 0x60, 0xbd, 0x2a, 0x78, 0x59, 0x48, 0xfb, 0x15, 0xcc, 0x33, 0xe2, 0xa1,
-	0xc0, 0xc6, 0x7d, 0x64, 0x1d, 0xf8, 0x54, 0xaf, 0x09, 0xce, 0x5c, 0x0a, 0xf6, 0x7c, 0xaa, 0xdd,
-	0x86, 0x85, 0x00, 0x1d, 0x86, 0xd8, 0xb1, 0x6c, 0xc7, 0x09, 0x10, 0xa5, 0x7a, 0x5d, 0xb0, 0xe6,
-	0x25, 0xfa, 0x48, 0x82, 0xda, 0x0d, 0x68, 0xd8, 0x87, 0x87, 0xae, 0xe7, 0xda, 0x0c, 0xe9, 0x8d,
-	0xad, 0x32, 0xdf, 0x9c, 0x14, 0xe0, 0x6f, 0x4a, 0x1b, 0xe2, 0x4d, 0x20, 0x18, 0x73, 0x29, 0xc8,
-	0xdf, 0xd4, 0x86, 0xea, 0x11, 0x72, 0x07, 0x47, 0x4c, 0x6f, 0xca, 0x6d, 0x92, 0x2d, 0xed, 0x0f,
-	0xb0, 0xea, 0xb9, 0xc7, 0xa1, 0xeb, 0xb8, 0xec, 0xdc, 0xca, 0x4f, 0x78, 0x4e, 0x10, 0x57, 0x52,
-	0xf3, 0xcb, 0xec, 0xcc, 0xd7, 0xa1, 0x8e, 0xce, 0x18, 0xc2, 0x0e, 0x72, 0xf4, 0xf9, 0xad, 0xd2,
-	0x9d, 0xba, 0x99, 0xb6, 0xbb, 0x5f, 0xd6, 0xa1, 0x3d, 0xfe, 0x99, 0xa9, 0x4f, 0x30, 0x45, 0xda,
-	0xaf, 0xe1, 0xba, 0x8b, 0x0f, 0x48, 0x76, 0xc5, 0xf2, 0x63, 0x2f, 0x28, 0x38, 0x59, 0xf2, 0x9f,
-	0x61, 0x23, 0x21, 0xf6, 0x09, 0x3e, 0x74, 0x83, 0xa1, 0xd8, 0x56, 0xeb, 0xc0, 0x23, 0xfd, 0x7f,
-	0x53, 0xe1, 0x04, 0x65, 0x73, 0x4d, 0x51, 0x1e, 0x67, 0x18, 0x3d, 0x41, 0xd0, 0xfe, 0x02, 0x37,
-	0x0a, 0xfb, 0x53, 0xd4, 0x27, 0xd8, 0xa1, 0xc2, 0x59, 0xca, 0xe6, 0x7a, 0xc1, 0x00, 0xfb, 0x92,
-	0xa1, 0x3d, 0x85, 0x15, 0x12, 0x32, 0x39, 0x84, 0x83, 0x3c, 0xfb, 0x3c, 0x79, 0x37, 0xf7, 0xa1,
-	0x72, 0x6f, 0x2d, 0x8e, 0x8c, 0x62, 0x82, 0xb9, 0x9c, 0xc0, 0xbb, 0x1c, 0x55, 0x13, 0x7a, 0x0e,
-	0xed, 0x31, 0x76, 0x32, 0x95, 0x59, 0x31, 0xde, 0x7a, 0x1c, 0x19, 0x13, 0x18, 0x66, 0x2b, 0x37,
-	0x60, 0x32, 0xc1, 0x6d, 0xa8, 0x1c, 0x22, 0x44, 0x85, 0xf3, 0x35, 0xef, 0x2d, 0x6e, 0x8b, 0xc8,
-	0xdb, 0x16, 0x7b, 0xfe, 0x57, 0x84, 0x68, 0xaf, 0x1e, 0x47, 0x86, 0x60, 0x98, 0xe2, 0xca, 0x5d,
-	0x20, 0x20, 0x21, 0x43, 0x81, 0x72, 0x45, 0xd5, 0xd2, 0xba, 0x50, 0x45, 0x67, 0xbe, 0x1b, 0x9c,
-	0x0b, 0xe7, 0x2b, 0xf7, 0x20, 0x8e, 0x0c, 0x85, 0x98, 0xea, 0xae, 0xdd, 0x86, 0xda, 0xa9, 0x1d,
-	0x60, 0x17, 0x0f, 0xf4, 0x06, 0xef, 0xdc, 0x6b, 0xc6, 0x91, 0x91, 0x40, 0x66, 0xf2, 0xa0, 0x19,
-	0x30, 0x8b, 0xb9, 0x18, 0xe8, 0x20, 0x48, 0x8d, 0x38, 0x32, 0x24, 0x60, 0xca, 0x1b, 0x77, 0x78,
-	0x27, 0xa4, 0xcc, 0x62, 0x47, 0x01, 0xa2, 0x47, 0xc4, 0x73, 0x94, 0x3b, 0xce, 0x73, 0xf4, 0x65,
-	0x02, 0x6a, 0x7f, 0x82, 0xb5, 0x00, 0xf5, 0xc9, 0x70, 0x28, 0x1c, 0xca, 0x1a, 0xba, 0xd8, 0x92,
-	0x61, 0x6d, 0xb9, 0x58, 0xf9, 0x65, 0x3b, 0x43, 0x78, 0xea, 0xe2, 0x47, 0xc2, 0xbc, 0x87, 0xb5,
-	0xbb, 0xd0, 0xca, 0x76, 0x1d, 0xd8, 0xd4, 0x0a, 0x78, 0xd8, 0xcc, 0x8b, 0x5e, 0x5a, 0xc6, 0xf6,
-	0xc4, 0xa6, 0x26, 0x8f, 0x9f, 0x5b, 0xb0, 0x90, 0xb0, 0xac, 0x10, 0xbb, 0x8c, 0xea, 0x0b, 0x32,
-	0x54, 0x07, 0x92, 0xf0, 0x0f, 0x8e, 0x69, 0x1a, 0x54, 0x86, 0x68, 0x48, 0xf4, 0xeb, 0xc2, 0x26,
-	0x9e, 0xb5, 0x27, 0xb0, 0x8c, 0xce, 0x7c, 0xd4, 0x67, 0xc8, 0x49, 0xe6, 0x47, 0x42, 0xa6, 0x2f,
-	0x8a, 0xc5, 0xaf, 0xc6, 0x91, 0x51, 0x64, 0x36, 0x97, 0x12, 0x50, 0xce, 0xf9, 0x59, 0xc8, 0xb8,
-	0x73, 0x0c, 0xed, 0x33, 0xab, 0x40, 0x81, 0x96, 0x46, 0xce, 0x51, 0xcc, 0x30, 0x5b, 0x43, 0xfb,
-	0x6c, 0xff, 0x82, 0x40, 0x3d, 0x85, 0x95, 0x11, 0x97, 0x9e, 0xda, 0x7e, 0xe2, 0xbd, 0xda, 0xc8,
-	0x7b, 0x0b, 0x09, 0xe6, 0x72, 0x0a, 0xf3, 0xc8, 0x55, 0xde, 0xfb, 0x00, 0xda, 0x63, 0xec, 0xc4,
-	0x7b, 0x97, 0x45, 0x20, 0xb5, 0x72, 0x9d, 0x12, 0x0f, 0xfd, 0x1d, 0x68, 0x8c, 0x30, 0xdb, 0xcb,
-	0xf7, 0x68, 0x89, 0x1e, 0x8b, 0xc2, 0x92, 0x65, 0x1b, 0x50, 0x39, 0xe1, 0xdb, 0xb7, 0xb2, 0x55,
-	0xbe, 0xd3, 0xbc, 0xd7, 0x54, 0xfe, 0xfc, 0x8a, 0x6f, 0x99, 0x30, 0x74, 0x3f, 0x2d, 0xc1, 0x66,
-	0x46, 0x57, 0xec, 0x13, 0x14, 0xec, 0x22, 0x9f, 0x50, 0x97, 0x25, 0x69, 0xa4, 0x05, 0xb3, 0xd9,
-	0x0c, 0x22, 0x1b, 0x99, 0x14, 0x31, 0x93, 0x4b, 0x11, 0x39, 0x59, 0x2d, 0x5f, 0x2a, 0xab, 0x95,
-	0xa9, 0xb2, 0x3a, 0x9b, 0x95, 0xd5, 0xee, 0xff, 0x6a, 0xd0, 0x99, 0x34, 0x55, 0x25, 0x85, 0x0f,
-	0x27, 0x48, 0x61, 0x6f, 0x39, 0x8e, 0x8c, 0x71, 0xd3, 0x07, 0xa8, 0x8f, 0xf7, 0xa6, 0xea, 0x63,
-	0xb1, 0x08, 0x3e, 0x98, 0x2e, 0x82, 0x57, 0x42, 0xf7, 0x0e, 0x42, 0xf7, 0xb7, 0x69, 0x42, 0xd7,
-	0xd3, 0xe3, 0xc8, 0x28, 0xb4, 0x17, 0x4a, 0xe0, 0x1f, 0x8b, 0x25, 0xb0, 0xa7, 0xc5, 0x91, 0x31,
-	0x66, 0x19, 0x93, 0xc5, 0x1b, 0x59, 0x59, 0x94, 0x5f, 0x82, 0xb7, 0x95, 0x40, 0x6e, 0x4f, 0x11,
-	0xc8, 0x22, 0x1d, 0xdc, 0x87, 0xd5, 0x71, 0xbe, 0x23, 0xc3, 0x46, 0x08, 0x61, 0xa3, 0xb7, 0x11,
-	0x47, 0xc6, 0x24, 0x8a, 0xb9, 0x92, 0x1f, 0x50, 0x05, 0x5c, 0xf7, 0xff, 0xa5, 0x0b, 0xb1, 0xf8,
-	0x4f, 0x97, 0x1d, 0x39, 0x81, 0x7d, 0x3a, 0x5d, 0x37, 0x74, 0xa8, 0x25, 0x91, 0xa9, 0x0e, 0x9d,
-	0xaa, 0xa9, 0xdd, 0x84, 0xb9, 0x53, 0x35, 0x84, 0x90, 0x06, 0x79, 0xf4, 0x6c, 0x26, 0x58, 0x5e,
-	0x19, 0x2a, 0x39, 0x65, 0xf8, 0xac, 0x06, 0xc6, 0xc4, 0xd9, 0xfc, 0x4c, 0xa4, 0xe1, 0xea, 0xe8,
-	0x74, 0xa5, 0x28, 0xef, 0x49, 0x51, 0xee, 0x42, 0x53, 0xec, 0x82, 0x4a, 0xe8, 0xf2, 0xa8, 0x75,
-	0x3d, 0x8e, 0x8c, 0x2c, 0x6c, 0x02, 0x6f, 0xc8, 0x75, 0x4d, 0x3a, 0xa4, 0x2d, 0xbd, 0xeb, 0x21,
-	0xad, 0xfb, 0xdf, 0x19, 0x58, 0x1b, 0x45, 0xee, 0xdf, 0x89, 0x8d, 0x9f, 0xf9, 0x08, 0xbf, 0xbf,
-	0x0a, 0x76, 0x15, 0x6a, 0xfc, 0xeb, 0xf1, 0xc9, 0x2a, 0x09, 0x19, 0xba, 0x98, 0xab, 0xe4, 0x58,
-	0xf1, 0x39, 0x7b, 0xb1, 0xf8, 0xcc, 0x9d, 0x6c, 0xaa, 0x97, 0x9e, 0x6c, 0x6a, 0x53, 0x4f, 0x36,
-	0xf5, 0x9c, 0x7e, 0x7d, 0x0b, 0xb0, 0x5e, 0xb4, 0x0b, 0x57, 0x05, 0xde, 0x95, 0x4a, 0xfd, 0x82,
-	0x54, 0xea, 0xbd, 0x96, 0x83, 0x18, 0xb6, 0x52, 0x66, 0x9f, 0x78, 0x9e, 0xcd, 0x50, 0x60, 0x7b,
-	0xee, 0x7f, 0xa4, 0x87, 0x07, 0xfc, 0xa6, 0xf4, 0xeb, 0x56, 0x1c, 0x19, 0x97, 0x72, 0xcd, 0x4e,
-	0xc2, 0x78, 0x3c, 0x4e, 0x30, 0xf9, 0x55, 0x43, 0xb0, 0x59, 0x30, 0x46, 0x72, 0xae, 0x42, 0x8e,
-	0x28, 0x1a, 0x1b, 0xbd, 0x9b, 0x71, 0x64, 0x4c, 0x27, 0x9a, 0x1b, 0x17, 0xdf, 0xb4, 0x9b, 0x18,
-	0xf9, 0x97, 0x4b, 0x7b, 0x3b, 0xe8, 0x80, 0x59, 0x2e, 0xa5, 0x21, 0x72, 0x44, 0x09, 0xa9, 0xbe,
-	0x5c, 0x91, 0xdd, 0xd4, 0x12, 0x74, 0x17, 0x1d, 0xb0, 0x3d, 0x81, 0x4d, 0xae, 0x6f, 0x5b, 0xdf,
-	0xab, 0xbe, 0x7d, 0x3e, 0xb1, 0xbe, 0x5d, 0x19, 0x05, 0x6f, 0x31, 0x63, 0x42, 0xed, 0x6b, 0xc2,
-	0xaa, 0xac, 0x7d, 0x89, 0x8f, 0xb0, 0xe5, 0x11, 0x7b, 0x24, 0x4d, 0x6d, 0x31, 0xa4, 0x38, 0xca,
-	0x4e, 0xa0, 0x98, 0x2d, 0x61, 0xe0, 0xca, 0xca, 0x15, 0x56, 0x8d, 0xd9, 0xfd, 0xbc, 0x34, 0xae,
-	0xbd, 0x8f, 0x3d, 0x42, 0xd1, 0x0f, 0x4f, 0x41, 0x99, 0x54, 0x53, 0xce, 0xa5, 0x9a, 0x0d, 0x68,
-	0x04, 0xc8, 0xe7, 0xca, 0xe7, 0x53, 0x95, 0x85, 0xea, 0x02, 0xe0, 0x29, 0x62, 0x13, 0x40, 0x4c,
-	0x9a, 0x9c, 0x62, 0x14, 0xa8, 0x34, 0xd4, 0xe0, 0xc8, 0x33, 0x0e, 0x64, 0x32, 0x48, 0x35, 0x97,
-	0x41, 0x3e, 0x01, 0xd8, 0x28, 0x5c, 0xc5, 0x55, 0x0a, 0xb9, 0x4a, 0x21, 0x3f, 0xe9, 0xdf, 0x08,
-	0xa7, 0x1f, 0x5d, 0x7f, 0xb4, 0xf4, 0xb0, 0x0b, 0xda, 0x38, 0xd3, 0xc5, 0x2a, 0x21, 0xb4, 0xe3,
-	0xc8, 0x28, 0xb0, 0x9a, 0x8b, 0xf9, 0x61, 0xf6, 0xf0, 0x24, 0xd1, 0x4f, 0x6a, 0x58, 0x7c, 0x99,
-	0xe8, 0xa7, 0xc4, 0x22, 0xd1, 0x4f, 0x4a, 0x5a, 0x7c, 0x51, 0xf4, 0xb9, 0x7c, 0xb8, 0x53, 0x44,
-	0x5f, 0xda, 0xf3, 0xa2, 0x6f, 0x0a, 0xec, 0xc3, 0x17, 0xfd, 0x27, 0xb0, 0x2c, 0x15, 0x5d, 0x8a,
-	0x66, 0x5e, 0xf0, 0xc5, 0x27, 0x2e, 0x30, 0x9b, 0x4b, 0x02, 0xe4, 0x6b, 0x4c, 0xe2, 0xb6, 0xfb,
-	0xf1, 0x0c, 0x34, 0xd2, 0x28, 0xe5, 0x21, 0x93, 0xd1, 0x74, 0x19, 0x32, 0x02, 0x48, 0x7e, 0xa9,
-	0xc8, 0x9d, 0xf7, 0xa5, 0xb6, 0x67, 0xce, 0xfb, 0xeb, 0x50, 0x4f, 0xc4, 0x41, 0xc9, 0x7b, 0xda,
-	0xd6, 0x7e, 0x0b, 0x8d, 0xf4, 0x0f, 0x1e, 0x29, 0xf0, 0xbd, 0xf9, 0x38, 0x32, 0x46, 0xa0, 0x39,
-	0x7a, 0xe4, 0xf3, 0x10, 0x53, 0x95, 0x5a, 0x2f, 0xe7, 0x21, 0xe7, 0x2e, 0x6f, 0xda, 0x7d, 0x98,
-	0xa3, 0x9e, 0xeb, 0xfb, 0xf6, 0x40, 0x16, 0x16, 0x55, 0xb1, 0xf0, 0xc5, 0x38, 0x32, 0x72, 0xb8,
-	0xd9, 0x4c, 0x5a, 0x3c, 0x8d, 0xfc, 0x06, 0x1a, 0x72, 0x57, 0x92, 0x7f, 0xc9, 0xca, 0x72, 0x0a,
-	0x29, 0x68, 0xd6, 0xc5, 0x63, 0xcf, 0xa7, 0xdd, 0x43, 0xa8, 0xbc, 0x22, 0x21, 0x5f, 0x70, 0x85,
-	0x4b, 0x99, 0xda, 0x10, 0x11, 0x69, 0xbc, 0x6d, 0x8a, 0x2b, 0xb7, 0x3a, 0x36, 0xb3, 0xe5, 0x4e,
-	0x48, 0x2b, 0x6f, 0x9b, 0xe2, 0xca, 0xb5, 0x2c, 0x53, 0x6f, 0x29, 0x2d, 0x53, 0x85, 0xa3, 0xba,
-	0xf7, 0x5e, 0x7d, 0xf1, 0xa6, 0x53, 0x7a, 0xfd, 0xa6, 0x53, 0xfa, 0xfa, 0x4d, 0xa7, 0xf4, 0xd1,
-	0xdb, 0xce, 0xb5, 0xd7, 0x6f, 0x3b, 0xd7, 0xbe, 0x7a, 0xdb, 0xb9, 0xf6, 0xaf, 0x87, 0x03, 0x97,
-	0x1d, 0x85, 0x07, 0xdb, 0x7d, 0x32, 0xdc, 0xa1, 0xa7, 0x2e, 0xeb, 0x1f, 0x79, 0xe7, 0xe2, 0xdf,
-	0xcd, 0x3e, 0xf1, 0x52, 0x00, 0x13, 0x07, 0xed, 0x9c, 0xdc, 0xdf, 0x39, 0x4b, 0x91, 0x1d, 0xa1,
-	0xbc, 0x07, 0x55, 0x41, 0xbd, 0xff, 0x5d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x9f, 0x7f, 0x6d,
-	0x41, 0x1d, 0x00, 0x00,
+	// 1867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6f, 0xe4, 0x48,
+	0xf9, 0x9f, 0x4e, 0xa7, 0xdf, 0x9e, 0x4e, 0x32, 0x49, 0x75, 0xa7, 0xe3, 0x24, 0x93, 0x38, 0xdb,
+	0xff, 0x5d, 0xfd, 0x47, 0xbc, 0x24, 0xab, 0xcc, 0x08, 0x81, 0xb4, 0x42, 0x4c, 0x27, 0x62, 0x34,
+	0x88, 0xd1, 0xcc, 0xd4, 0x2c, 0x8b, 0xc4, 0xc5, 0x72, 0xda, 0x95, 0xc4, 0xe0, 0x76, 0x39, 0x76,
+	0x39, 0x2f, 0x7b, 0xe5, 0x84, 0x90, 0x10, 0x17, 0x2e, 0xdc, 0xb8, 0xf1, 0x0d, 0xf8, 0x00, 0x1c,
+	0xe0, 0xb8, 0x47, 0x4e, 0x16, 0x9a, 0xb9, 0xf9, 0x1b, 0x70, 0x43, 0xf5, 0xe6, 0x97, 0x8e, 0xbb,
+	0x87, 0x05, 0x46, 0x5a, 0x20, 0x97, 0x6e, 0xd7, 0xef, 0xf9, 0x55, 0xf9, 0x71, 0xf9, 0x79, 0x7e,
+	0x4f, 0x55, 0x19, 0x0c, 0x76, 0x13, 0x90, 0xe8, 0xe0, 0x22, 0x26, 0xe1, 0x8d, 0x75, 0x11, 0x53,
+	0x46, 0xa2, 0xfd, 0x20, 0xa4, 0x8c, 0xa2, 0x86, 0xb0, 0x6c, 0xf5, 0xcf, 0xe8, 0x19, 0x15, 0xc8,
+	0x01, 0xbf, 0x92, 0xc6, 0xe1, 0x9f, 0x16, 0x61, 0xfd, 0x15, 0xef, 0xf3, 0x8a, 0x77, 0x79, 0x7d,
+	0x65, 0x07, 0x98, 0x5c, 0xc4, 0x24, 0x62, 0x68, 0x07, 0xe0, 0x34, 0xa4, 0x13, 0xcb, 0x8e, 0x22,
+	0xc2, 0x8c, 0xda, 0x5e, 0xed, 0x61, 0x07, 0x77, 0x38, 0xf2, 0x84, 0x03, 0x68, 0x13, 0xda, 0x8c,
+	0x2a, 0xe3, 0x82, 0x30, 0xb6, 0x18, 0x95, 0xa6, 0x01, 0x34, 0xed, 0x09, 0x8d, 0x7d, 0x66, 0xd4,
+	0x85, 0x41, 0xb5, 0xd0, 0x37, 0x01, 0x45, 0x2c, 0x24, 0xf6, 0xc4, 0xf5, 0xcf, 0x2c, 0xd7, 0x67,
+	0x24, 0xbc, 0xb4, 0x3d, 0x63, 0x51, 0x70, 0xd6, 0x32, 0xcb, 0x33, 0x65, 0x28, 0xd3, 0x2f, 0x62,
+	0xdb, 0x67, 0x2e, 0xbb, 0x31, 0x1a, 0x53, 0xf4, 0x57, 0xca, 0x80, 0xf6, 0xa0, 0xeb, 0x90, 0x88,
+	0xb9, 0xbe, 0xcd, 0x5c, 0xea, 0x1b, 0x4d, 0xc1, 0x2b, 0x42, 0xe8, 0xff, 0x60, 0x99, 0x51, 0x8f,
+	0x84, 0xb6, 0x3f, 0x26, 0xd6, 0x49, 0x10, 0x19, 0x2d, 0xc1, 0x59, 0xca, 0xc0, 0x51, 0x10, 0xa1,
+	0x8f, 0x60, 0x25, 0x24, 0xa7, 0xb1, 0xef, 0x58, 0xb6, 0xe3, 0x84, 0x24, 0x8a, 0x8c, 0xb6, 0x60,
+	0x2d, 0x4b, 0xf4, 0x89, 0x04, 0xd1, 0x03, 0xe8, 0xd8, 0xa7, 0xa7, 0xae, 0xe7, 0xda, 0x8c, 0x18,
+	0x9d, 0xbd, 0x3a, 0x9f, 0x9c, 0x0c, 0xe0, 0x77, 0xca, 0x1a, 0xe2, 0x4e, 0x20, 0x18, 0x4b, 0x19,
+	0xc8, 0xef, 0x34, 0x80, 0xe6, 0x39, 0x71, 0xcf, 0xce, 0x99, 0xd1, 0x95, 0xd3, 0x24, 0x5b, 0xe8,
+	0x5b, 0xb0, 0xe1, 0xb9, 0x17, 0xb1, 0xeb, 0xb8, 0xec, 0xc6, 0x2a, 0x3b, 0xbc, 0x24, 0x88, 0xeb,
+	0x99, 0xf9, 0xd3, 0xa2, 0xe7, 0x5b, 0xd0, 0x26, 0xd7, 0x8c, 0xf8, 0x0e, 0x71, 0x8c, 0xe5, 0xbd,
+	0xda, 0xc3, 0x36, 0xce, 0xda, 0x7c, 0x2e, 0x69, 0xc0, 0xdc, 0x89, 0xfb, 0x39, 0xb1, 0xb2, 0xa9,
+	0x33, 0x56, 0x04, 0x6b, 0x4d, 0x5b, 0x5e, 0x6b, 0x03, 0x3a, 0x84, 0xf5, 0x89, 0x7d, 0x9d, 0x33,
+	0xad, 0x88, 0x8c, 0xa9, 0xef, 0x44, 0xc6, 0x7d, 0xe1, 0x40, 0x6f, 0x62, 0x5f, 0x67, 0xe4, 0xd7,
+	0xd2, 0x34, 0xfc, 0x6d, 0x07, 0x06, 0xd3, 0x91, 0x14, 0x05, 0xd4, 0x8f, 0x08, 0xfa, 0x7f, 0xb8,
+	0xef, 0xfa, 0x27, 0xb4, 0x38, 0xa9, 0x32, 0x9e, 0x56, 0x14, 0xac, 0x67, 0xf5, 0xbb, 0xb0, 0xad,
+	0x89, 0x63, 0xea, 0x9f, 0xba, 0xe1, 0x44, 0xbc, 0x39, 0xeb, 0xc4, 0xa3, 0xe3, 0x9f, 0x45, 0x22,
+	0xce, 0xea, 0x78, 0x53, 0x51, 0x8e, 0x0a, 0x8c, 0x91, 0x20, 0xa0, 0xef, 0xc1, 0x83, 0xca, 0xfe,
+	0xda, 0xfd, 0xba, 0x18, 0x60, 0xab, 0x62, 0x00, 0xf5, 0x14, 0xe8, 0x39, 0xac, 0xd3, 0x98, 0xc9,
+	0x21, 0x1c, 0xe2, 0xd9, 0x37, 0xfa, 0xde, 0x3c, 0x4c, 0xeb, 0xa3, 0xcd, 0x34, 0x31, 0xab, 0x09,
+	0xb8, 0xa7, 0xe1, 0x63, 0x8e, 0x2a, 0x87, 0x5e, 0xc2, 0x60, 0x8a, 0xad, 0x5d, 0x69, 0x88, 0xf1,
+	0xb6, 0xd2, 0xc4, 0x9c, 0xc1, 0xc0, 0xfd, 0xd2, 0x80, 0xda, 0xc1, 0x7d, 0x58, 0x3c, 0x25, 0x24,
+	0x12, 0xf1, 0xdd, 0x3d, 0x5c, 0xdd, 0x17, 0xc9, 0xbd, 0x2f, 0xe6, 0xfc, 0xfb, 0x84, 0x44, 0xa3,
+	0x76, 0x9a, 0x98, 0x82, 0x81, 0xc5, 0x2f, 0x8f, 0xb2, 0x90, 0xc6, 0x8c, 0x84, 0x2a, 0xda, 0x55,
+	0x0b, 0x0d, 0xa1, 0x49, 0xae, 0x03, 0x37, 0xbc, 0x11, 0xf1, 0x5d, 0x1f, 0x41, 0x9a, 0x98, 0x0a,
+	0xc1, 0xea, 0x1f, 0x7d, 0x04, 0xad, 0x2b, 0x3b, 0xf4, 0x79, 0xa8, 0x74, 0x78, 0xe7, 0x51, 0x37,
+	0x4d, 0x4c, 0x0d, 0x61, 0x7d, 0x81, 0x4c, 0x68, 0xf8, 0x94, 0x11, 0x1e, 0xe5, 0x9c, 0xd4, 0x49,
+	0x13, 0x53, 0x02, 0x58, 0xfe, 0xf1, 0x9c, 0x72, 0xe2, 0x88, 0x59, 0xec, 0x3c, 0x24, 0xd1, 0x39,
+	0xf5, 0x1c, 0x15, 0xf1, 0xcb, 0x1c, 0xfd, 0x54, 0x83, 0xe8, 0x3b, 0xb0, 0x19, 0x92, 0x31, 0x9d,
+	0x4c, 0x44, 0xcc, 0x5a, 0x13, 0xd7, 0xb7, 0xa4, 0x72, 0x58, 0xae, 0xaf, 0x42, 0x7f, 0x50, 0x20,
+	0x3c, 0x77, 0xfd, 0x27, 0xc2, 0xfc, 0xcc, 0x47, 0x1f, 0x43, 0xbf, 0xd8, 0xf5, 0xcc, 0x8e, 0xac,
+	0x90, 0x67, 0xe6, 0xb2, 0xe8, 0x85, 0x0a, 0xb6, 0xa7, 0x76, 0x84, 0x79, 0x8a, 0x7e, 0x08, 0x2b,
+	0x9a, 0x65, 0xc5, 0xbe, 0xcb, 0x22, 0x91, 0x0d, 0x1d, 0xbc, 0x74, 0x26, 0x09, 0x3f, 0xe2, 0x18,
+	0x42, 0xb0, 0x38, 0x21, 0x13, 0xaa, 0xe2, 0x5e, 0x5c, 0xa3, 0xa7, 0xd0, 0x23, 0xd7, 0x01, 0x19,
+	0x33, 0xe2, 0x68, 0xff, 0x68, 0xcc, 0x8c, 0x55, 0xf1, 0xf0, 0x1b, 0x69, 0x62, 0x56, 0x99, 0xf1,
+	0x9a, 0x06, 0xa5, 0xcf, 0x2f, 0x62, 0xc6, 0x83, 0xa3, 0x9c, 0x65, 0x99, 0xc8, 0xad, 0xe5, 0xc1,
+	0x51, 0xcd, 0xc0, 0xfd, 0x62, 0x0a, 0x66, 0x1a, 0xf8, 0x1c, 0xd6, 0x73, 0x6e, 0x74, 0x65, 0x07,
+	0x3a, 0x7a, 0x51, 0x1e, 0xbd, 0x95, 0x04, 0xdc, 0xcb, 0x60, 0x9e, 0xb9, 0x2a, 0x7a, 0x1f, 0xc3,
+	0x60, 0x8a, 0xad, 0xa3, 0xb7, 0x27, 0x12, 0xa9, 0x5f, 0xea, 0xa4, 0x23, 0xf4, 0x1b, 0x80, 0x18,
+	0x65, 0xb6, 0x57, 0xee, 0xd1, 0x17, 0x3d, 0x56, 0x85, 0xa5, 0xc8, 0x36, 0x61, 0xf1, 0x92, 0x4f,
+	0xdf, 0xfa, 0x5e, 0xfd, 0x61, 0xf7, 0xb0, 0xab, 0xe2, 0xf9, 0x33, 0x3e, 0x65, 0xc2, 0x80, 0x8e,
+	0xe0, 0x7e, 0xee, 0xc4, 0x38, 0x0e, 0x2f, 0x89, 0x31, 0x10, 0xdc, 0x2d, 0xc5, 0x2d, 0x4c, 0x03,
+	0x65, 0xe4, 0x25, 0x75, 0x7d, 0x86, 0x57, 0xb2, 0x2e, 0x47, 0xbc, 0xc7, 0xf0, 0xa7, 0xb0, 0x55,
+	0xd6, 0xa6, 0x91, 0xcd, 0xc6, 0xe7, 0xba, 0xd4, 0x3d, 0x86, 0xa6, 0xac, 0x98, 0x46, 0x4d, 0x8c,
+	0xfc, 0x20, 0xcb, 0xaa, 0x8a, 0xc2, 0x88, 0x15, 0xb7, 0xa0, 0xdf, 0x0b, 0x45, 0xfd, 0x1e, 0xda,
+	0xd0, 0x9f, 0xbe, 0x4d, 0x14, 0x7b, 0x0c, 0x3d, 0x82, 0x86, 0xe8, 0x29, 0xb4, 0xaf, 0x7b, 0xb8,
+	0x33, 0xe3, 0x26, 0x52, 0x33, 0xb1, 0xe4, 0xa2, 0x3e, 0x34, 0x48, 0x18, 0xd2, 0x50, 0xdd, 0x43,
+	0x36, 0x86, 0x36, 0x6c, 0x57, 0x3e, 0x8e, 0xec, 0x8b, 0x46, 0xd0, 0x0a, 0xc5, 0x3d, 0xf5, 0x03,
+	0x6d, 0x17, 0x65, 0x62, 0xca, 0x2f, 0x99, 0xd4, 0x8a, 0x8f, 0xf5, 0xc5, 0xf0, 0xf7, 0x35, 0xd8,
+	0x29, 0xdc, 0xc3, 0xbe, 0x24, 0xe1, 0x31, 0x09, 0x68, 0xe4, 0x32, 0x3d, 0x6b, 0x7d, 0x68, 0x14,
+	0xd7, 0x06, 0xb2, 0x51, 0x28, 0xfe, 0x0b, 0xa5, 0xe2, 0x5f, 0x2a, 0x98, 0xf5, 0x77, 0x16, 0xcc,
+	0xc5, 0xb9, 0x05, 0xb3, 0x51, 0x9a, 0xf0, 0x5f, 0xb4, 0x60, 0x77, 0x96, 0xab, 0x6a, 0x46, 0x3e,
+	0x99, 0x51, 0x81, 0x46, 0xbd, 0x34, 0x31, 0xa7, 0x4d, 0x5f, 0xc1, 0xb2, 0x74, 0x38, 0xb7, 0x2c,
+	0x55, 0xd7, 0x9e, 0xc7, 0xf3, 0x6b, 0xcf, 0x5d, 0x7d, 0xf9, 0x12, 0xf5, 0xe5, 0x07, 0xf3, 0xea,
+	0xcb, 0xc8, 0x48, 0x13, 0xb3, 0xd2, 0x5e, 0x59, 0x79, 0xbe, 0x5d, 0x5d, 0x79, 0x46, 0x28, 0x4d,
+	0xcc, 0x29, 0xcb, 0x54, 0x35, 0x7a, 0x50, 0xac, 0x46, 0xf2, 0x4d, 0xf0, 0xb6, 0xaa, 0x4b, 0xfb,
+	0x73, 0xea, 0x52, 0x55, 0xf9, 0x79, 0x0d, 0x1b, 0xd3, 0x7c, 0x47, 0xa6, 0x8d, 0xa8, 0x3f, 0x9d,
+	0xd1, 0x76, 0x9a, 0x98, 0xb3, 0x28, 0x78, 0xbd, 0x3c, 0xa0, 0x4a, 0xb8, 0xe1, 0x2f, 0x6b, 0xb7,
+	0x72, 0xf1, 0xc7, 0x2e, 0x3b, 0x77, 0x42, 0xfb, 0x6a, 0xbe, 0x6e, 0x18, 0xd0, 0xd2, 0x99, 0xa9,
+	0xb6, 0x13, 0xaa, 0x89, 0x3e, 0x80, 0xa5, 0x2b, 0x35, 0x84, 0x90, 0x06, 0xb9, 0xa9, 0xe8, 0x6a,
+	0xac, 0xac, 0x0c, 0x8b, 0x25, 0x65, 0xf8, 0x43, 0x0b, 0xcc, 0x99, 0xde, 0xfc, 0x97, 0x48, 0xc3,
+	0xdd, 0x8a, 0xf5, 0x4e, 0x51, 0xde, 0x93, 0xa2, 0x7c, 0x0c, 0x5d, 0x31, 0x0b, 0xaa, 0xa0, 0xcb,
+	0x15, 0xee, 0xfd, 0x34, 0x31, 0x8b, 0x30, 0x06, 0xde, 0x90, 0xcf, 0x35, 0x6b, 0x6d, 0xbc, 0xf6,
+	0x65, 0xd7, 0xc6, 0xc3, 0x9f, 0x2f, 0xc0, 0x66, 0x9e, 0xb9, 0x3f, 0xa4, 0xb6, 0xff, 0x22, 0x20,
+	0xfe, 0xfb, 0x3b, 0x9b, 0xd8, 0x80, 0x16, 0x7f, 0x7b, 0xdc, 0x59, 0x25, 0x21, 0x13, 0xd7, 0xe7,
+	0x2a, 0x39, 0x75, 0xac, 0xd0, 0xb8, 0x7d, 0xac, 0x50, 0x5a, 0xd9, 0x34, 0xdf, 0xb9, 0xb2, 0x69,
+	0xcd, 0x5d, 0xd9, 0xb4, 0x4b, 0xfa, 0xf5, 0x37, 0x80, 0xad, 0xaa, 0x59, 0xb8, 0xdb, 0x57, 0xdf,
+	0xa9, 0xd4, 0xff, 0x90, 0x4a, 0xbd, 0xd7, 0x5d, 0xb8, 0x0f, 0x7b, 0x19, 0x73, 0x4c, 0x3d, 0xcf,
+	0x66, 0x24, 0xb4, 0x3d, 0xf7, 0x73, 0x19, 0xe1, 0x21, 0xff, 0x53, 0xfa, 0xf5, 0x61, 0x9a, 0x98,
+	0xef, 0xe4, 0xe2, 0x5d, 0xcd, 0x38, 0x9a, 0x26, 0x60, 0xfe, 0x8b, 0x08, 0xec, 0x54, 0x8c, 0xa1,
+	0xd7, 0x55, 0xc4, 0x11, 0x7b, 0xf5, 0xce, 0xe8, 0x83, 0x34, 0x31, 0xe7, 0x13, 0xf1, 0xf6, 0xed,
+	0x3b, 0x1d, 0x6b, 0x23, 0x7f, 0x73, 0x59, 0x6f, 0x87, 0x9c, 0x30, 0xcb, 0x8d, 0xa2, 0x98, 0x38,
+	0x46, 0x2f, 0x7f, 0x73, 0x55, 0x76, 0x8c, 0x34, 0x7a, 0x4c, 0x4e, 0xd8, 0x33, 0x81, 0xcd, 0x3e,
+	0x56, 0xe8, 0xff, 0x53, 0xc7, 0x0a, 0x2f, 0x67, 0x1e, 0x2b, 0xac, 0xe7, 0xc9, 0x5b, 0xcd, 0x98,
+	0x71, 0xe4, 0x80, 0x61, 0x43, 0x1e, 0x39, 0xd0, 0x80, 0xf8, 0x96, 0x47, 0xed, 0x5c, 0x9a, 0x06,
+	0x62, 0x48, 0xb1, 0x94, 0x9d, 0x41, 0xc1, 0x7d, 0x61, 0xe0, 0xca, 0xca, 0x15, 0x56, 0x9f, 0x67,
+	0xfe, 0xb1, 0x36, 0xad, 0xbd, 0x47, 0x1e, 0x8d, 0xc8, 0xbf, 0x5e, 0x82, 0x0a, 0xa5, 0xa6, 0x5e,
+	0x2a, 0x35, 0xdb, 0xd0, 0x09, 0x49, 0x60, 0xdf, 0xa8, 0x0d, 0x30, 0x37, 0xb5, 0x05, 0xc0, 0x4b,
+	0xc4, 0x0e, 0x80, 0x70, 0x9a, 0x5e, 0xf9, 0x24, 0x54, 0x65, 0xa8, 0xc3, 0x91, 0x17, 0x1c, 0x28,
+	0x54, 0x90, 0x66, 0xa9, 0x82, 0xfc, 0x0e, 0x60, 0xbb, 0xf2, 0x29, 0xee, 0x4a, 0xc8, 0x5d, 0x09,
+	0xf9, 0x8f, 0x3e, 0x9a, 0x9d, 0xbf, 0x74, 0xfd, 0xb7, 0x95, 0x87, 0x63, 0x40, 0xd3, 0x4c, 0xd7,
+	0x57, 0x05, 0x61, 0x90, 0x26, 0x66, 0x85, 0x15, 0xaf, 0x96, 0x87, 0x79, 0xe6, 0xcf, 0x12, 0x7d,
+	0xbd, 0x87, 0xf5, 0xdf, 0x25, 0xfa, 0x19, 0xb1, 0x4a, 0xf4, 0xf5, 0x96, 0xd6, 0xbf, 0x2d, 0xfa,
+	0x5c, 0x3e, 0xdc, 0x39, 0xa2, 0x2f, 0xed, 0x65, 0xd1, 0xc7, 0x02, 0xfb, 0xea, 0x8b, 0xfe, 0x53,
+	0xe8, 0x49, 0x45, 0x97, 0xa2, 0x59, 0x16, 0x7c, 0xf1, 0x8a, 0x2b, 0xcc, 0x78, 0x4d, 0x80, 0xfc,
+	0x19, 0x75, 0xde, 0x0e, 0x7f, 0xb3, 0x00, 0x9d, 0x2c, 0x4b, 0x79, 0xca, 0x14, 0x34, 0x5d, 0xa6,
+	0x8c, 0x00, 0xf4, 0x49, 0x45, 0x69, 0xbd, 0x2f, 0xb5, 0x3d, 0x07, 0xf8, 0x57, 0x38, 0x2d, 0x0e,
+	0x4a, 0xde, 0xb3, 0x36, 0xfa, 0x3a, 0x74, 0xb2, 0x4f, 0x77, 0x52, 0xe0, 0x47, 0xcb, 0x69, 0x62,
+	0xe6, 0x20, 0xce, 0x2f, 0xb9, 0x1f, 0xc2, 0x55, 0xa3, 0x91, 0xfb, 0x21, 0x7d, 0x97, 0x7f, 0xe8,
+	0x11, 0x2c, 0x45, 0x9e, 0x1b, 0x04, 0xf6, 0x99, 0xdc, 0x58, 0x34, 0xc5, 0x83, 0xaf, 0xa6, 0x89,
+	0x59, 0xc2, 0x71, 0x57, 0xb7, 0x78, 0x19, 0xf9, 0x1a, 0x74, 0xe4, 0xac, 0xe8, 0xef, 0x9f, 0x75,
+	0xe9, 0x42, 0x06, 0xe2, 0xb6, 0xb8, 0x1c, 0x05, 0xd1, 0xf0, 0x14, 0x16, 0xf9, 0x39, 0x3c, 0xcf,
+	0x34, 0x2e, 0x65, 0x46, 0x2d, 0xcf, 0x34, 0xde, 0xc6, 0xe2, 0x97, 0x5b, 0x1d, 0x9b, 0xd9, 0xc6,
+	0x42, 0x6e, 0xe5, 0x6d, 0x2c, 0x7e, 0xb9, 0x96, 0x15, 0xf6, 0x5b, 0x4a, 0xcb, 0x24, 0xa2, 0xf7,
+	0x5e, 0xc3, 0x5f, 0x2d, 0x40, 0xaf, 0xe2, 0x10, 0x1f, 0x3d, 0x84, 0x76, 0xf6, 0x95, 0xb8, 0x26,
+	0x7a, 0x2f, 0xa5, 0x89, 0x99, 0x61, 0x38, 0xbb, 0xe2, 0xcc, 0xec, 0xdb, 0xc9, 0x42, 0xce, 0xd4,
+	0x18, 0xce, 0xae, 0xb8, 0x3f, 0x2a, 0x8c, 0x0b, 0xfe, 0x48, 0x04, 0xab, 0xff, 0x52, 0x16, 0x89,
+	0x38, 0xa4, 0x31, 0x0b, 0xf4, 0xc6, 0x70, 0x2a, 0x8b, 0x0a, 0xf6, 0x3c, 0x8b, 0x78, 0x94, 0xbe,
+	0x10, 0xd8, 0xad, 0x97, 0xd4, 0xf8, 0x07, 0x5e, 0xd2, 0xe8, 0xb3, 0x3f, 0xbf, 0xd9, 0xad, 0x7d,
+	0xf1, 0x66, 0xb7, 0xf6, 0xd7, 0x37, 0xbb, 0xb5, 0x5f, 0xbf, 0xdd, 0xbd, 0xf7, 0xc5, 0xdb, 0xdd,
+	0x7b, 0x7f, 0x79, 0xbb, 0x7b, 0xef, 0x27, 0x9f, 0x9c, 0xb9, 0xec, 0x3c, 0x3e, 0xd9, 0x1f, 0xd3,
+	0xc9, 0x41, 0x74, 0xe5, 0xb2, 0xf1, 0xb9, 0x77, 0x23, 0x3e, 0xe4, 0x8f, 0xa9, 0x97, 0x01, 0x3e,
+	0x75, 0xc8, 0xc1, 0xe5, 0xa3, 0x83, 0xeb, 0x0c, 0x39, 0x10, 0xa5, 0xe8, 0xa4, 0x29, 0xa8, 0x8f,
+	0xfe, 0x3e, 0x00, 0xef, 0x1f, 0x76, 0xfb, 0x2c, 0x20, 0x00, 0x00,
 }
 
 func (m *QueryQuoteSwapRequest) Marshal() (dAtA []byte, err error) {
@@ -1863,6 +2142,23 @@ func (m *QueryQuoteSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxStreamingSeconds) > 0 {
+		i -= len(m.MaxStreamingSeconds)
+		copy(dAtA[i:], m.MaxStreamingSeconds)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.MaxStreamingSeconds)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.OptimizeStreaming {
+		i--
+		if m.OptimizeStreaming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.Extended {
 		i--
 		if m.Extended {
@@ -1984,6 +2280,22 @@ func (m *QueryQuoteSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.StreamingCurve) > 0 {
+		for iNdEx := len(m.StreamingCurve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamingCurve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryQuotes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if len(m.Vout) > 0 {
 		for iNdEx := len(m.Vout) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2140,7 +2452,7 @@ func (m *QueryQuoteSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteSaverDepositRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryQuoteSwapBatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2150,12 +2462,12 @@ func (m *QueryQuoteSaverDepositRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteSaverDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQuoteSwapBatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteSaverDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQuoteSwapBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2165,44 +2477,26 @@ func (m *QueryQuoteSaverDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		copy(dAtA[i:], m.Height)
 		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Height)))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.AffiliateBps) > 0 {
-		for iNdEx := len(m.AffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AffiliateBps[iNdEx])
-			copy(dAtA[i:], m.AffiliateBps[iNdEx])
-			i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.AffiliateBps[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x12
 	}
-	if len(m.Affiliate) > 0 {
-		for iNdEx := len(m.Affiliate) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Affiliate[iNdEx])
-			copy(dAtA[i:], m.Affiliate[iNdEx])
-			i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Affiliate[iNdEx])))
+	if len(m.Quotes) > 0 {
+		for iNdEx := len(m.Quotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryQuotes(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Asset) > 0 {
-		i -= len(m.Asset)
-		copy(dAtA[i:], m.Asset)
-		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Asset)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteSaverDepositResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuoteSwapBatchResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2212,26 +2506,167 @@ func (m *QueryQuoteSaverDepositResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteSaverDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuoteSwapBatchResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteSaverDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuoteSwapBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExpectedAmountDeposit) > 0 {
-		i -= len(m.ExpectedAmountDeposit)
-		copy(dAtA[i:], m.ExpectedAmountDeposit)
-		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.ExpectedAmountDeposit)))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x12
+	}
+	if m.Quote != nil {
+		{
+			size, err := m.Quote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryQuotes(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0xa
 	}
-	if len(m.ExpectedAmountOut) > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSwapBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSwapBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSwapBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryQuotes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSaverDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSaverDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSaverDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AffiliateBps) > 0 {
+		for iNdEx := len(m.AffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AffiliateBps[iNdEx])
+			copy(dAtA[i:], m.AffiliateBps[iNdEx])
+			i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.AffiliateBps[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Affiliate) > 0 {
+		for iNdEx := len(m.Affiliate) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Affiliate[iNdEx])
+			copy(dAtA[i:], m.Affiliate[iNdEx])
+			i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Affiliate[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSaverDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSaverDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSaverDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedAmountDeposit) > 0 {
+		i -= len(m.ExpectedAmountDeposit)
+		copy(dAtA[i:], m.ExpectedAmountDeposit)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.ExpectedAmountDeposit)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ExpectedAmountOut) > 0 {
 		i -= len(m.ExpectedAmountOut)
 		copy(dAtA[i:], m.ExpectedAmountOut)
 		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.ExpectedAmountOut)))
@@ -3153,6 +3588,56 @@ func (m *Vout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamingQuotePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamingQuotePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingQuotePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlippageBps != 0 {
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(m.SlippageBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExpectedSwapOutput) > 0 {
+		i -= len(m.ExpectedSwapOutput)
+		copy(dAtA[i:], m.ExpectedSwapOutput)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.ExpectedSwapOutput)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQueryQuotes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQueryQuotes(v)
 	base := offset
@@ -3225,6 +3710,13 @@ func (m *QueryQuoteSwapRequest) Size() (n int) {
 	if m.Extended {
 		n += 2
 	}
+	if m.OptimizeStreaming {
+		n += 2
+	}
+	l = len(m.MaxStreamingSeconds)
+	if l > 0 {
+		n += 1 + l + sovQueryQuotes(uint64(l))
+	}
 	return n
 }
 
@@ -3311,6 +3803,63 @@ func (m *QueryQuoteSwapResponse) Size() (n int) {
 			n += 2 + l + sovQueryQuotes(uint64(l))
 		}
 	}
+	if len(m.StreamingCurve) > 0 {
+		for _, e := range m.StreamingCurve {
+			l = e.Size()
+			n += 2 + l + sovQueryQuotes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQuoteSwapBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotes) > 0 {
+		for _, e := range m.Quotes {
+			l = e.Size()
+			n += 1 + l + sovQueryQuotes(uint64(l))
+		}
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryQuotes(uint64(l))
+	}
+	return n
+}
+
+func (m *QuoteSwapBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quote != nil {
+		l = m.Quote.Size()
+		n += 1 + l + sovQueryQuotes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQueryQuotes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteSwapBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQueryQuotes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3827,6 +4376,31 @@ func (m *Vout) Size() (n int) {
 	return n
 }
 
+func (m *StreamingQuotePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovQueryQuotes(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQueryQuotes(uint64(m.Quantity))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQueryQuotes(uint64(m.Blocks))
+	}
+	l = len(m.ExpectedSwapOutput)
+	if l > 0 {
+		n += 1 + l + sovQueryQuotes(uint64(l))
+	}
+	if m.SlippageBps != 0 {
+		n += 1 + sovQueryQuotes(uint64(m.SlippageBps))
+	}
+	return n
+}
+
 func sovQueryQuotes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4266,19 +4840,71 @@ func (m *QueryQuoteSwapRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Extended = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimizeStreaming", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueryQuotes
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.OptimizeStreaming = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreamingSeconds", wireType)
 			}
-			iNdEx += skippy
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxStreamingSeconds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -4790,7 +5416,298 @@ func (m *QueryQuoteSwapResponse) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapBlocks", wireType)
 			}
-			m.StreamingSwapBlocks = 0
+			m.StreamingSwapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
+			}
+			m.StreamingSwapSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSwapSeconds", wireType)
+			}
+			m.TotalSwapSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSwapSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vout = append(m.Vout, &Vout{})
+			if err := m.Vout[len(m.Vout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamingCurve = append(m.StreamingCurve, &StreamingQuotePoint{})
+			if err := m.StreamingCurve[len(m.StreamingCurve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSwapBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryQuotes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSwapBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSwapBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotes = append(m.Quotes, &QueryQuoteSwapRequest{})
+			if err := m.Quotes[len(m.Quotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteSwapBatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryQuotes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteSwapBatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteSwapBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryQuotes
@@ -4800,35 +5717,33 @@ func (m *QueryQuoteSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamingSwapBlocks |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQueryQuotes
 			}
-			m.StreamingSwapSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueryQuotes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StreamingSwapSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSwapSeconds", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TotalSwapSeconds = 0
+			if m.Quote == nil {
+				m.Quote = &QueryQuoteSwapResponse{}
+			}
+			if err := m.Quote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryQuotes
@@ -4838,14 +5753,77 @@ func (m *QueryQuoteSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSwapSeconds |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSwapBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryQuotes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSwapBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSwapBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4872,8 +5850,8 @@ func (m *QueryQuoteSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vout = append(m.Vout, &Vout{})
-			if err := m.Vout[len(m.Vout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &QuoteSwapBatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8589,6 +9567,164 @@ func (m *Vout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StreamingQuotePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryQuotes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamingQuotePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamingQuotePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedSwapOutput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedSwapOutput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageBps", wireType)
+			}
+			m.SlippageBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlippageBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQueryQuotes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -184,8 +185,13 @@ func (m *QuerySaverResponse) GetGrowthPct() string {
 }
 
 type QuerySaversRequest struct {
-	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset      string             `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Height     string             `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return savers with an asset address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,4,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// only return savers with at least this many pool units
+	MinUnits string `protobuf:"bytes,5,opt,name=min_units,json=minUnits,proto3" json:"min_units,omitempty"`
 }
 
 func (m *QuerySaversRequest) Reset()         { *m = QuerySaversRequest{} }
@@ -235,8 +241,30 @@ func (m *QuerySaversRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QuerySaversRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySaversRequest) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *QuerySaversRequest) GetMinUnits() string {
+	if m != nil {
+		return m.MinUnits
+	}
+	return ""
+}
+
 type QuerySaversResponse struct {
-	Savers []*QuerySaverResponse `protobuf:"bytes,1,rep,name=savers,proto3" json:"savers,omitempty"`
+	Savers     []*QuerySaverResponse `protobuf:"bytes,1,rep,name=savers,proto3" json:"savers,omitempty"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QuerySaversResponse) Reset()         { *m = QuerySaversResponse{} }
@@ -279,46 +307,308 @@ func (m *QuerySaversResponse) GetSavers() []*QuerySaverResponse {
 	return nil
 }
 
+func (m *QuerySaversResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySaverHistoryRequest struct {
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// first height of the range, the position at this height is always included
+	FromHeight int64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// last height of the range, defaults to the last height recorded
+	ToHeight int64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// maximum number of positions returned
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QuerySaverHistoryRequest) Reset()         { *m = QuerySaverHistoryRequest{} }
+func (m *QuerySaverHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySaverHistoryRequest) ProtoMessage()    {}
+func (*QuerySaverHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1398a6f1035715c7, []int{4}
+}
+func (m *QuerySaverHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySaverHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySaverHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySaverHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySaverHistoryRequest.Merge(m, src)
+}
+func (m *QuerySaverHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySaverHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySaverHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySaverHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySaverHistoryRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QuerySaverHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySaverHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QuerySaverHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QuerySaverHistoryRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SaverPosition struct {
+	Height            int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Units             string `protobuf:"bytes,2,opt,name=units,proto3" json:"units"`
+	PoolUnits         string `protobuf:"bytes,3,opt,name=pool_units,json=poolUnits,proto3" json:"pool_units"`
+	AssetDepositValue string `protobuf:"bytes,4,opt,name=asset_deposit_value,json=assetDepositValue,proto3" json:"asset_deposit_value"`
+	AssetRedeemValue  string `protobuf:"bytes,5,opt,name=asset_redeem_value,json=assetRedeemValue,proto3" json:"asset_redeem_value"`
+	// redeem value less the deposit value, negative when the position is at a loss
+	AccruedYield string `protobuf:"bytes,6,opt,name=accrued_yield,json=accruedYield,proto3" json:"accrued_yield"`
+}
+
+func (m *SaverPosition) Reset()         { *m = SaverPosition{} }
+func (m *SaverPosition) String() string { return proto.CompactTextString(m) }
+func (*SaverPosition) ProtoMessage()    {}
+func (*SaverPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1398a6f1035715c7, []int{5}
+}
+func (m *SaverPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaverPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SaverPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SaverPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaverPosition.Merge(m, src)
+}
+func (m *SaverPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaverPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaverPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaverPosition proto.InternalMessageInfo
+
+func (m *SaverPosition) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SaverPosition) GetUnits() string {
+	if m != nil {
+		return m.Units
+	}
+	return ""
+}
+
+func (m *SaverPosition) GetPoolUnits() string {
+	if m != nil {
+		return m.PoolUnits
+	}
+	return ""
+}
+
+func (m *SaverPosition) GetAssetDepositValue() string {
+	if m != nil {
+		return m.AssetDepositValue
+	}
+	return ""
+}
+
+func (m *SaverPosition) GetAssetRedeemValue() string {
+	if m != nil {
+		return m.AssetRedeemValue
+	}
+	return ""
+}
+
+func (m *SaverPosition) GetAccruedYield() string {
+	if m != nil {
+		return m.AccruedYield
+	}
+	return ""
+}
+
+type QuerySaverHistoryResponse struct {
+	Asset     string           `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	Address   string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+	Positions []*SaverPosition `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+	// height to continue the range from when it was truncated by the limit
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *QuerySaverHistoryResponse) Reset()         { *m = QuerySaverHistoryResponse{} }
+func (m *QuerySaverHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySaverHistoryResponse) ProtoMessage()    {}
+func (*QuerySaverHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1398a6f1035715c7, []int{6}
+}
+func (m *QuerySaverHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySaverHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySaverHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySaverHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySaverHistoryResponse.Merge(m, src)
+}
+func (m *QuerySaverHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySaverHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySaverHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySaverHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySaverHistoryResponse) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QuerySaverHistoryResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySaverHistoryResponse) GetPositions() []*SaverPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QuerySaverHistoryResponse) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QuerySaverRequest)(nil), "types.QuerySaverRequest")
 	proto.RegisterType((*QuerySaverResponse)(nil), "types.QuerySaverResponse")
 	proto.RegisterType((*QuerySaversRequest)(nil), "types.QuerySaversRequest")
 	proto.RegisterType((*QuerySaversResponse)(nil), "types.QuerySaversResponse")
+	proto.RegisterType((*QuerySaverHistoryRequest)(nil), "types.QuerySaverHistoryRequest")
+	proto.RegisterType((*SaverPosition)(nil), "types.SaverPosition")
+	proto.RegisterType((*QuerySaverHistoryResponse)(nil), "types.QuerySaverHistoryResponse")
 }
 
 func init() { proto.RegisterFile("types/query_saver.proto", fileDescriptor_1398a6f1035715c7) }
 
 var fileDescriptor_1398a6f1035715c7 = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x86, 0xa4, 0x64, 0xa0, 0x40, 0xb6, 0x51, 0xbb, 0x70, 0xb0, 0xab, 0x1c, 0x50,
-	0x2f, 0xc4, 0x40, 0x25, 0x4e, 0x5c, 0x1a, 0x55, 0xa2, 0x47, 0x58, 0xa4, 0x22, 0xc1, 0xc1, 0x72,
-	0xbd, 0x2b, 0xdb, 0x92, 0x9b, 0x75, 0xbd, 0xeb, 0x98, 0xbc, 0x05, 0x4f, 0xc1, 0xb3, 0x70, 0xec,
-	0x91, 0x93, 0x85, 0x92, 0x9b, 0x9f, 0x02, 0x79, 0xd6, 0x69, 0x6d, 0x51, 0x71, 0xf2, 0xce, 0xef,
-	0xfb, 0xe6, 0x8f, 0x3c, 0x03, 0x87, 0x7a, 0x95, 0x0a, 0xe5, 0x5e, 0xe7, 0x22, 0x5b, 0x79, 0xca,
-	0x5f, 0x8a, 0x6c, 0x96, 0x66, 0x52, 0x4b, 0x32, 0x40, 0xe1, 0xc5, 0x24, 0x94, 0xa1, 0x44, 0xe2,
-	0xd6, 0x2f, 0x23, 0x4e, 0xbf, 0xc1, 0xf8, 0x53, 0x9d, 0xf1, 0xb9, 0x4e, 0x60, 0xe2, 0x3a, 0x17,
-	0x4a, 0x93, 0x09, 0x0c, 0x7c, 0xa5, 0x84, 0xa6, 0xd6, 0x91, 0x75, 0x3c, 0x62, 0x26, 0x20, 0x14,
-	0x76, 0x7d, 0xce, 0x33, 0xa1, 0x14, 0xdd, 0x41, 0xbe, 0x0d, 0xc9, 0x01, 0x0c, 0x23, 0x11, 0x87,
-	0x91, 0xa6, 0x7d, 0x14, 0x9a, 0x68, 0xfa, 0xb3, 0x0f, 0xa4, 0x5d, 0x5d, 0xa5, 0x72, 0xa1, 0x04,
-	0x71, 0x3a, 0xe5, 0xe7, 0xa3, 0xaa, 0x74, 0x0c, 0xd8, 0x76, 0x7a, 0x07, 0x7b, 0xf8, 0xf0, 0x3a,
-	0xfd, 0xe6, 0xe3, 0xaa, 0x74, 0xba, 0x02, 0x7b, 0x8c, 0xe1, 0x69, 0x33, 0xc7, 0x4b, 0x78, 0x9a,
-	0xf8, 0x0a, 0x55, 0xaf, 0x35, 0x50, 0x9f, 0xed, 0xd5, 0xf8, 0x94, 0xf3, 0x73, 0x84, 0xe4, 0x35,
-	0x4c, 0xd0, 0x57, 0xc4, 0x3a, 0xe2, 0x99, 0x5f, 0x6c, 0xcd, 0x0f, 0xd0, 0x4c, 0x6a, 0xed, 0x4b,
-	0x23, 0x35, 0x19, 0x0e, 0x0c, 0xf2, 0x45, 0xac, 0x15, 0x1d, 0xdc, 0x8d, 0x8c, 0x80, 0x99, 0x0f,
-	0xf9, 0x00, 0xfb, 0x66, 0x32, 0x2e, 0x52, 0xa9, 0x62, 0xed, 0x2d, 0xfd, 0x24, 0x17, 0x74, 0x88,
-	0xf6, 0xc3, 0xaa, 0x74, 0xee, 0x93, 0xd9, 0x18, 0xe1, 0x99, 0x61, 0x17, 0x35, 0x22, 0x67, 0x40,
-	0x8c, 0x33, 0x13, 0x5c, 0x88, 0xab, 0xa6, 0xce, 0x2e, 0xd6, 0x39, 0xa8, 0x4a, 0xe7, 0x1e, 0x95,
-	0x3d, 0x33, 0x7f, 0x0f, 0x91, 0xa9, 0xf2, 0x0a, 0x20, 0xcc, 0x64, 0xa1, 0x23, 0x2f, 0x0d, 0x34,
-	0x7d, 0x88, 0xd9, 0x4f, 0xaa, 0xd2, 0x69, 0x51, 0x36, 0x32, 0xef, 0x8f, 0x81, 0x9e, 0xce, 0xdb,
-	0x7b, 0x52, 0xff, 0x3f, 0x83, 0xbb, 0x65, 0xef, 0x74, 0x96, 0x7d, 0x0e, 0xfb, 0x9d, 0x1a, 0xcd,
-	0xb2, 0xdf, 0xc0, 0x10, 0x8f, 0x51, 0x51, 0xeb, 0xa8, 0x7f, 0xfc, 0xe8, 0xed, 0xf3, 0x19, 0x9e,
-	0xe3, 0xec, 0xdf, 0xbb, 0x60, 0x8d, 0x71, 0x7e, 0xf1, 0x6b, 0x6d, 0x5b, 0x37, 0x6b, 0xdb, 0xfa,
-	0xb3, 0xb6, 0xad, 0x1f, 0x1b, 0xbb, 0x77, 0xb3, 0xb1, 0x7b, 0xbf, 0x37, 0x76, 0xef, 0xeb, 0xfb,
-	0x30, 0xd6, 0x51, 0x7e, 0x39, 0x0b, 0xe4, 0x95, 0xab, 0x8a, 0x58, 0x07, 0x51, 0xb2, 0xc2, 0x3b,
-	0x0e, 0x64, 0x72, 0x0b, 0x16, 0x92, 0x0b, 0x77, 0x79, 0xe2, 0x7e, 0xbf, 0x25, 0x2e, 0xb6, 0xbc,
-	0x1c, 0xa2, 0xf5, 0xe4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xbe, 0x3f, 0x18, 0x2a, 0x03,
-	0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xc6, 0x09, 0x09, 0xf8, 0x84, 0xd0, 0x62, 0x22, 0x30, 0x20, 0xc5, 0xc8, 0x12, 0x14, 0x55,
+	0xc2, 0x2e, 0x20, 0x75, 0xd5, 0x0d, 0x11, 0x2a, 0x2c, 0xd3, 0xa9, 0xa0, 0x6a, 0xbb, 0xb0, 0x1c,
+	0x7b, 0x48, 0x2c, 0x25, 0x1e, 0xe3, 0x99, 0x24, 0xe4, 0x2d, 0xda, 0x17, 0xe8, 0xb2, 0xaf, 0xd2,
+	0xaa, 0x8b, 0x8a, 0xe5, 0x5d, 0x59, 0x57, 0xb0, 0xf3, 0x53, 0x5c, 0xcd, 0x78, 0x12, 0xdb, 0xf7,
+	0x72, 0xb9, 0x3f, 0xba, 0xab, 0xcc, 0xf9, 0xbe, 0x33, 0x67, 0xce, 0xcc, 0xf7, 0x1d, 0x07, 0xb6,
+	0xd9, 0x2c, 0xc2, 0xd4, 0xbe, 0x1b, 0xe3, 0x78, 0xe6, 0x50, 0x77, 0x82, 0x63, 0x2b, 0x8a, 0x09,
+	0x23, 0x5a, 0x4d, 0x10, 0xbb, 0xad, 0x3e, 0xe9, 0x13, 0x81, 0xd8, 0x7c, 0x95, 0x91, 0xbb, 0xdf,
+	0x7a, 0x84, 0x8e, 0x08, 0xb5, 0x7b, 0x2e, 0xc5, 0xd9, 0x5e, 0x7b, 0x72, 0xd2, 0xc3, 0xcc, 0x3d,
+	0xb1, 0x23, 0xb7, 0x1f, 0x84, 0x2e, 0x0b, 0x48, 0x98, 0xe5, 0x9a, 0xbf, 0xc3, 0xc6, 0x4f, 0x3c,
+	0xe3, 0x67, 0x5e, 0x1c, 0xe1, 0xbb, 0x31, 0xa6, 0x4c, 0x6b, 0x41, 0xcd, 0xa5, 0x14, 0x33, 0x5d,
+	0xd9, 0x57, 0x8e, 0x54, 0x94, 0x05, 0x9a, 0x0e, 0x2b, 0xae, 0xef, 0xc7, 0x98, 0x52, 0xbd, 0x22,
+	0xf0, 0x79, 0xa8, 0x6d, 0x41, 0x7d, 0x80, 0x83, 0xfe, 0x80, 0xe9, 0x55, 0x41, 0xc8, 0xc8, 0xfc,
+	0xbb, 0x0a, 0x5a, 0xb1, 0x3a, 0x8d, 0x48, 0x48, 0xb1, 0x66, 0x94, 0xca, 0x77, 0xd4, 0x34, 0x31,
+	0x32, 0x60, 0x7e, 0xd2, 0xf7, 0xd0, 0x14, 0x0b, 0xa7, 0x74, 0x5e, 0x67, 0x23, 0x4d, 0x8c, 0x32,
+	0x81, 0xd6, 0x44, 0x78, 0x2e, 0xfb, 0x38, 0x84, 0xaf, 0x86, 0x2e, 0x15, 0xac, 0x53, 0x68, 0xa8,
+	0x8a, 0x9a, 0x1c, 0x3e, 0xf7, 0xfd, 0x2b, 0x01, 0x6a, 0xdf, 0x41, 0x4b, 0xe4, 0x4d, 0x03, 0x36,
+	0xf0, 0x63, 0x77, 0x3a, 0x4f, 0x5e, 0x16, 0xc9, 0x1a, 0xe7, 0x7e, 0x91, 0x94, 0xdc, 0x61, 0x40,
+	0x6d, 0x1c, 0x06, 0x8c, 0xea, 0xb5, 0xbc, 0x65, 0x01, 0xa0, 0xec, 0x47, 0xbb, 0x84, 0xcd, 0xac,
+	0x33, 0x1f, 0x47, 0x84, 0x06, 0xcc, 0x99, 0xb8, 0xc3, 0x31, 0xd6, 0xeb, 0x22, 0x7d, 0x3b, 0x4d,
+	0x8c, 0xe7, 0x68, 0xb4, 0x21, 0xc0, 0x8b, 0x0c, 0xbb, 0xe1, 0x90, 0x76, 0x01, 0x5a, 0x96, 0x19,
+	0x63, 0x1f, 0xe3, 0x91, 0xac, 0xb3, 0x22, 0xea, 0x6c, 0xa5, 0x89, 0xf1, 0x0c, 0x8b, 0xbe, 0xce,
+	0x5e, 0x4f, 0x40, 0x59, 0x95, 0x63, 0x80, 0x7e, 0x4c, 0xa6, 0x6c, 0xe0, 0x44, 0x1e, 0xd3, 0x57,
+	0xc5, 0xee, 0xf5, 0x34, 0x31, 0x0a, 0x28, 0x52, 0xb3, 0x75, 0xd7, 0x63, 0xe6, 0xff, 0x4a, 0x51,
+	0x28, 0xfa, 0xb2, 0x0f, 0x72, 0xb5, 0x2b, 0x45, 0xb5, 0xb5, 0x1f, 0x01, 0x72, 0x7b, 0x89, 0x87,
+	0x6f, 0x9c, 0x1e, 0x5a, 0x99, 0x17, 0x2d, 0xee, 0x45, 0x4b, 0x78, 0xd1, 0x92, 0x5e, 0xb4, 0xba,
+	0x6e, 0x1f, 0xcb, 0x93, 0x50, 0x61, 0xa7, 0x76, 0x00, 0xeb, 0x52, 0x5e, 0x27, 0x8a, 0xf1, 0x6d,
+	0x70, 0x2f, 0x74, 0x51, 0x51, 0x53, 0xa2, 0x5d, 0x01, 0x6a, 0x7b, 0xa0, 0x8e, 0x82, 0xd0, 0x29,
+	0xc8, 0x82, 0x56, 0x47, 0x41, 0x78, 0xcd, 0x63, 0xf3, 0x4f, 0x05, 0x36, 0x4b, 0x17, 0x92, 0xd6,
+	0x3b, 0x81, 0xba, 0x18, 0x23, 0xaa, 0x2b, 0xfb, 0xd5, 0xa3, 0xc6, 0xe9, 0x8e, 0x25, 0x06, 0xc9,
+	0x7a, 0xd7, 0xa5, 0x48, 0x26, 0x6a, 0x97, 0xa5, 0x6b, 0x55, 0xc4, 0xb5, 0xbe, 0xf9, 0xe0, 0xb5,
+	0x64, 0x91, 0xc2, 0x56, 0xf3, 0x2f, 0x05, 0xf4, 0xfc, 0x9c, 0xab, 0x80, 0x32, 0x12, 0xcf, 0x3e,
+	0x77, 0xe4, 0x0c, 0x68, 0xdc, 0xc6, 0x64, 0x54, 0xb6, 0x39, 0x70, 0x48, 0x3a, 0x76, 0x0f, 0x54,
+	0x46, 0xca, 0xc6, 0x5e, 0x65, 0x44, 0x92, 0x2d, 0xa8, 0x0d, 0x83, 0x51, 0xc0, 0xc4, 0xbb, 0x2d,
+	0xa3, 0x2c, 0x30, 0xff, 0xab, 0x40, 0x53, 0xf4, 0xd6, 0xe5, 0x76, 0xe4, 0x52, 0x98, 0x0b, 0xa9,
+	0x79, 0x5b, 0xd5, 0x0e, 0xa4, 0x89, 0x21, 0x91, 0x85, 0xec, 0x8b, 0xd1, 0xa8, 0xbc, 0x67, 0x34,
+	0x8e, 0x01, 0x22, 0x42, 0x86, 0x52, 0xa9, 0x6a, 0xee, 0xc5, 0x1c, 0x45, 0x2a, 0x5f, 0x5f, 0xbf,
+	0x34, 0x49, 0xcb, 0x5f, 0x68, 0x92, 0x6a, 0x9f, 0x38, 0x49, 0xfc, 0x5b, 0xe4, 0x79, 0xf1, 0x18,
+	0xfb, 0xce, 0x2c, 0xc0, 0x43, 0x5f, 0xaf, 0x17, 0xbe, 0x45, 0x45, 0x02, 0xad, 0xc9, 0xf0, 0x57,
+	0x1e, 0x99, 0xff, 0x28, 0xb0, 0xf3, 0x8c, 0xda, 0x1f, 0xfb, 0x09, 0x3c, 0x78, 0x4b, 0xf9, 0x4e,
+	0x23, 0x4d, 0x8c, 0x39, 0x94, 0xdb, 0xe0, 0x1c, 0xd4, 0x48, 0x8a, 0xc5, 0x9f, 0x96, 0x5b, 0xba,
+	0x25, 0x2d, 0x5d, 0x52, 0xb2, 0xd3, 0x4c, 0x13, 0x23, 0x4f, 0x45, 0xf9, 0x92, 0x3b, 0x29, 0xc4,
+	0xf7, 0xac, 0x6c, 0x15, 0xe0, 0x50, 0x66, 0x96, 0xce, 0xcd, 0xbf, 0x8f, 0x6d, 0xe5, 0xe1, 0xb1,
+	0xad, 0xbc, 0x7e, 0x6c, 0x2b, 0x7f, 0x3c, 0xb5, 0x97, 0x1e, 0x9e, 0xda, 0x4b, 0xaf, 0x9e, 0xda,
+	0x4b, 0xbf, 0xfd, 0xd0, 0x0f, 0xd8, 0x60, 0xdc, 0xb3, 0x3c, 0x32, 0xb2, 0xe9, 0x34, 0x60, 0xde,
+	0x60, 0x38, 0x13, 0x7f, 0x2b, 0x1e, 0x19, 0x2e, 0x80, 0x90, 0xf8, 0xd8, 0x9e, 0x9c, 0xd9, 0xf7,
+	0x0b, 0xc4, 0x16, 0x0d, 0xf6, 0xea, 0x22, 0xf5, 0xec, 0xcd, 0x00, 0xd7, 0xba, 0xb9, 0x68, 0xe5,
+	0x06, 0x00, 0x00,
 }
 
 func (m *QuerySaverRequest) Marshal() (dAtA []byte, err error) {
@@ -460,6 +750,32 @@ func (m *QuerySaversRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinUnits) > 0 {
+		i -= len(m.MinUnits)
+		copy(dAtA[i:], m.MinUnits)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.MinUnits)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerySaver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -497,6 +813,18 @@ func (m *QuerySaversResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerySaver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Savers) > 0 {
 		for iNdEx := len(m.Savers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,65 +842,236 @@ func (m *QuerySaversResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuerySaver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuerySaver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySaverHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySaverRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovQuerySaver(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuerySaver(uint64(l))
-	}
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQuerySaver(uint64(l))
-	}
-	return n
+
+func (m *QuerySaverHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySaverResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySaverHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovQuerySaver(uint64(l))
-	}
-	l = len(m.AssetAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerySaver(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintQuerySaver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LastAddHeight != 0 {
-		n += 1 + sovQuerySaver(uint64(m.LastAddHeight))
+	if m.ToHeight != 0 {
+		i = encodeVarintQuerySaver(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.LastWithdrawHeight != 0 {
-		n += 1 + sovQuerySaver(uint64(m.LastWithdrawHeight))
+	if m.FromHeight != 0 {
+		i = encodeVarintQuerySaver(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Units)
-	if l > 0 {
-		n += 1 + l + sovQuerySaver(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AssetDepositValue)
-	if l > 0 {
-		n += 1 + l + sovQuerySaver(uint64(l))
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaverPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaverPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaverPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccruedYield) > 0 {
+		i -= len(m.AccruedYield)
+		copy(dAtA[i:], m.AccruedYield)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.AccruedYield)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AssetRedeemValue) > 0 {
+		i -= len(m.AssetRedeemValue)
+		copy(dAtA[i:], m.AssetRedeemValue)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.AssetRedeemValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AssetDepositValue) > 0 {
+		i -= len(m.AssetDepositValue)
+		copy(dAtA[i:], m.AssetDepositValue)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.AssetDepositValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PoolUnits) > 0 {
+		i -= len(m.PoolUnits)
+		copy(dAtA[i:], m.PoolUnits)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.PoolUnits)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Units) > 0 {
+		i -= len(m.Units)
+		copy(dAtA[i:], m.Units)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.Units)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuerySaver(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySaverHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySaverHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySaverHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintQuerySaver(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerySaver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuerySaver(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuerySaver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuerySaver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySaverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySaverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.AssetAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	if m.LastAddHeight != 0 {
+		n += 1 + sovQuerySaver(uint64(m.LastAddHeight))
+	}
+	if m.LastWithdrawHeight != 0 {
+		n += 1 + sovQuerySaver(uint64(m.LastWithdrawHeight))
+	}
+	l = len(m.Units)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.AssetDepositValue)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
 	}
 	l = len(m.AssetRedeemValue)
 	if l > 0 {
@@ -599,6 +1098,18 @@ func (m *QuerySaversRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuerySaver(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.MinUnits)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
 	return n
 }
 
@@ -614,16 +1125,864 @@ func (m *QuerySaversResponse) Size() (n int) {
 			n += 1 + l + sovQuerySaver(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySaverHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuerySaver(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuerySaver(uint64(m.ToHeight))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuerySaver(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *SaverPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuerySaver(uint64(m.Height))
+	}
+	l = len(m.Units)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.PoolUnits)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.AssetDepositValue)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.AssetRedeemValue)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.AccruedYield)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySaverHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuerySaver(uint64(l))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuerySaver(uint64(l))
+		}
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQuerySaver(uint64(m.NextHeight))
+	}
+	return n
+}
+
+func sovQuerySaver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuerySaver(x uint64) (n int) {
+	return sovQuerySaver(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySaverRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySaver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySaverRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySaverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySaver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySaver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySaverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySaverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAddHeight", wireType)
+			}
+			m.LastAddHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAddHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawHeight", wireType)
+			}
+			m.LastWithdrawHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWithdrawHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Units = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDepositValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDepositValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRedeemValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRedeemValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrowthPct", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrowthPct = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySaver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySaversRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySaver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySaversRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySaversRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySaver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySaversResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySaver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySaversResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySaversResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Savers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Savers = append(m.Savers, &QuerySaverResponse{})
+			if err := m.Savers[len(m.Savers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySaver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySaver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuerySaver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuerySaver(x uint64) (n int) {
-	return sovQuerySaver(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySaverRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySaverHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -646,10 +2005,10 @@ func (m *QuerySaverRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySaverRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySaverHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySaverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySaverHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -717,10 +2076,10 @@ func (m *QuerySaverRequest) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			var stringLen uint64
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerySaver
@@ -730,24 +2089,49 @@ func (m *QuerySaverRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FromHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerySaver
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerySaver
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerySaver(dAtA[iNdEx:])
@@ -769,7 +2153,7 @@ func (m *QuerySaverRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
+func (m *SaverPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -792,17 +2176,17 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySaverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SaverPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySaverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SaverPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerySaver
@@ -812,27 +2196,14 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerySaver
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerySaver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -860,49 +2231,11 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetAddress = string(dAtA[iNdEx:postIndex])
+			m.Units = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAddHeight", wireType)
-			}
-			m.LastAddHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerySaver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastAddHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawHeight", wireType)
-			}
-			m.LastWithdrawHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerySaver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastWithdrawHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -930,9 +2263,9 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Units = string(dAtA[iNdEx:postIndex])
+			m.PoolUnits = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AssetDepositValue", wireType)
 			}
@@ -964,7 +2297,7 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.AssetDepositValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AssetRedeemValue", wireType)
 			}
@@ -996,9 +2329,9 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.AssetRedeemValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrowthPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedYield", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1026,7 +2359,7 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GrowthPct = string(dAtA[iNdEx:postIndex])
+			m.AccruedYield = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1049,7 +2382,7 @@ func (m *QuerySaverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySaversRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySaverHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1072,10 +2405,10 @@ func (m *QuerySaversRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySaversRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySaverHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySaversRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySaverHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1112,7 +2445,7 @@ func (m *QuerySaversRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1140,61 +2473,11 @@ func (m *QuerySaversRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuerySaver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuerySaver
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySaversResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuerySaver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySaversResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySaversResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Savers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1221,11 +2504,30 @@ func (m *QuerySaversResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Savers = append(m.Savers, &QuerySaverResponse{})
-			if err := m.Savers[len(m.Savers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, &SaverPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySaver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerySaver(dAtA[iNdEx:])
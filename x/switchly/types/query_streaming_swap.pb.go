@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -238,7 +239,12 @@ func (m *QueryStreamingSwapResponse) GetFailedSwapReasons() []string {
 }
 
 type QueryStreamingSwapsRequest struct {
-	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Height     string             `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return streaming swaps from this asset
+	SourceAsset string `protobuf:"bytes,3,opt,name=source_asset,json=sourceAsset,proto3" json:"source_asset,omitempty"`
+	// only return streaming swaps to this asset
+	TargetAsset string `protobuf:"bytes,4,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset,omitempty"`
 }
 
 func (m *QueryStreamingSwapsRequest) Reset()         { *m = QueryStreamingSwapsRequest{} }
@@ -281,8 +287,30 @@ func (m *QueryStreamingSwapsRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QueryStreamingSwapsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryStreamingSwapsRequest) GetSourceAsset() string {
+	if m != nil {
+		return m.SourceAsset
+	}
+	return ""
+}
+
+func (m *QueryStreamingSwapsRequest) GetTargetAsset() string {
+	if m != nil {
+		return m.TargetAsset
+	}
+	return ""
+}
+
 type QueryStreamingSwapsResponse struct {
 	StreamingSwaps []*QueryStreamingSwapResponse `protobuf:"bytes,1,rep,name=streaming_swaps,json=streamingSwaps,proto3" json:"streaming_swaps,omitempty"`
+	Pagination     *query.PageResponse           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryStreamingSwapsResponse) Reset()         { *m = QueryStreamingSwapsResponse{} }
@@ -325,6 +353,13 @@ func (m *QueryStreamingSwapsResponse) GetStreamingSwaps() []*QueryStreamingSwapR
 	return nil
 }
 
+func (m *QueryStreamingSwapsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryStreamingSwapRequest)(nil), "types.QueryStreamingSwapRequest")
 	proto.RegisterType((*QueryStreamingSwapResponse)(nil), "types.QueryStreamingSwapResponse")
@@ -335,39 +370,44 @@ func init() {
 func init() { proto.RegisterFile("types/query_streaming_swap.proto", fileDescriptor_bf92a13f8c506349) }
 
 var fileDescriptor_bf92a13f8c506349 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xda, 0x3e,
-	0x18, 0xc6, 0x09, 0x29, 0x50, 0x1c, 0xfe, 0xfd, 0x6f, 0x6e, 0x55, 0xb9, 0x4c, 0x0a, 0x29, 0xd2,
-	0x24, 0x4e, 0x44, 0x1a, 0x3b, 0xee, 0xb2, 0x9e, 0xba, 0xdd, 0xe6, 0x4e, 0x3b, 0xec, 0x12, 0xb9,
-	0xc4, 0x0b, 0x96, 0xa8, 0x1d, 0xe2, 0x37, 0x05, 0xbe, 0xc5, 0x3e, 0xd6, 0x8e, 0x3d, 0xee, 0x84,
-	0x26, 0xb8, 0xf1, 0x29, 0x26, 0xdb, 0x84, 0x16, 0x89, 0x5d, 0x50, 0x9e, 0xdf, 0xf3, 0xbc, 0xaf,
-	0x5e, 0xc9, 0x0f, 0x28, 0x82, 0x65, 0xce, 0x75, 0x3c, 0x2b, 0x79, 0xb1, 0x4c, 0x34, 0x14, 0x9c,
-	0x3d, 0x08, 0x99, 0x25, 0x7a, 0xce, 0xf2, 0x61, 0x5e, 0x28, 0x50, 0xb8, 0x61, 0x13, 0xdd, 0x8b,
-	0x4c, 0x65, 0xca, 0x92, 0xd8, 0x7c, 0x39, 0xb3, 0x7f, 0x8b, 0xae, 0xbe, 0x98, 0xd1, 0xbb, 0x6a,
-	0xf2, 0x6e, 0xce, 0x72, 0xca, 0x67, 0x25, 0xd7, 0x80, 0xcf, 0x51, 0x03, 0x16, 0x89, 0x48, 0x89,
-	0x17, 0x79, 0x83, 0x36, 0x3d, 0x81, 0xc5, 0xa7, 0x14, 0x5f, 0xa2, 0xe6, 0x84, 0x8b, 0x6c, 0x02,
-	0xa4, 0x6e, 0xe9, 0x4e, 0xf5, 0xb7, 0x3e, 0xea, 0x1e, 0x5b, 0xa5, 0x73, 0x25, 0x35, 0x3f, 0xbe,
-	0xab, 0x8b, 0x4e, 0x85, 0x04, 0x5e, 0x3c, 0xb2, 0xa9, 0xdd, 0xe6, 0xd3, 0xbd, 0x36, 0xde, 0xac,
-	0x64, 0x12, 0x04, 0x2c, 0x89, 0xef, 0xbc, 0x4a, 0xe3, 0x0b, 0xd4, 0x18, 0xab, 0x52, 0x02, 0x39,
-	0xb1, 0x86, 0x13, 0xb8, 0x87, 0x82, 0x29, 0xd3, 0x90, 0xec, 0xce, 0x6b, 0x58, 0x0f, 0x19, 0x74,
-	0x6b, 0x09, 0x1e, 0xa1, 0x0e, 0x14, 0x2c, 0xe5, 0x09, 0xb0, 0x22, 0xe3, 0x40, 0x9a, 0xe6, 0x94,
-	0x9b, 0x57, 0xdb, 0x55, 0xef, 0x80, 0xd3, 0xc0, 0xaa, 0xaf, 0x56, 0xe0, 0x6b, 0xd4, 0xd1, 0xaa,
-	0x2c, 0xc6, 0x3c, 0x61, 0x5a, 0x73, 0x20, 0x2d, 0x7b, 0x7f, 0xe0, 0xd8, 0x47, 0x83, 0x4c, 0xc4,
-	0x4d, 0xee, 0x22, 0xa7, 0x2e, 0xe2, 0x98, 0x8b, 0x44, 0x28, 0x48, 0xb9, 0x06, 0x21, 0x19, 0x08,
-	0x25, 0x49, 0xdb, 0x25, 0x5e, 0x20, 0xfc, 0x16, 0xb5, 0x52, 0x9e, 0x2b, 0x2d, 0x80, 0x20, 0x7b,
-	0x57, 0xb0, 0x5d, 0xf5, 0x2a, 0x44, 0xab, 0x0f, 0x7c, 0x89, 0xea, 0x42, 0x92, 0xc0, 0x26, 0x9a,
-	0xdb, 0x55, 0xaf, 0x2e, 0x24, 0xad, 0x0b, 0x89, 0xaf, 0x90, 0xaf, 0x4a, 0x20, 0x1d, 0x6b, 0xb4,
-	0xb6, 0xab, 0x9e, 0x91, 0xd4, 0xfc, 0x98, 0xf3, 0x7e, 0x30, 0x31, 0xe5, 0xa9, 0x6d, 0x85, 0x26,
-	0xff, 0x45, 0xfe, 0xc0, 0xa7, 0x81, 0x63, 0xe6, 0x91, 0x34, 0x1e, 0xa2, 0xf3, 0x17, 0x91, 0xa4,
-	0xe0, 0x4c, 0x2b, 0xa9, 0xc9, 0x59, 0xe4, 0x0f, 0xda, 0xf4, 0xf5, 0x73, 0x92, 0x3a, 0xa3, 0xff,
-	0xfe, 0xd8, 0x5b, 0xeb, 0xaa, 0x37, 0xcf, 0x15, 0xf1, 0x0e, 0x2a, 0x22, 0xd0, 0x9b, 0xa3, 0x53,
-	0xbb, 0x8a, 0x7c, 0x46, 0xff, 0x1f, 0x16, 0x58, 0x13, 0x2f, 0xf2, 0x07, 0xc1, 0xbb, 0xeb, 0xa1,
-	0xad, 0xf0, 0xf0, 0xdf, 0xf5, 0xa2, 0x67, 0xfa, 0x60, 0xe7, 0xcd, 0xb7, 0x5f, 0xeb, 0xd0, 0x7b,
-	0x5a, 0x87, 0xde, 0x9f, 0x75, 0xe8, 0xfd, 0xdc, 0x84, 0xb5, 0xa7, 0x4d, 0x58, 0xfb, 0xbd, 0x09,
-	0x6b, 0xdf, 0x3f, 0x64, 0x02, 0x26, 0xe5, 0xfd, 0x70, 0xac, 0x1e, 0x62, 0x3d, 0x17, 0x30, 0x9e,
-	0x4c, 0x97, 0xf6, 0xbf, 0x30, 0x56, 0xd3, 0x3d, 0x90, 0x2a, 0xe5, 0xf1, 0xe3, 0x28, 0x5e, 0xec,
-	0x49, 0x6c, 0x4f, 0xb8, 0x6f, 0xda, 0xe8, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x64,
-	0x08, 0x87, 0x77, 0x03, 0x00, 0x00,
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0xc5, 0x31, 0x09, 0x30, 0xe6, 0xe3, 0x6b, 0x07, 0x84, 0x86, 0x54, 0x72, 0x4c, 0xa4, 0xb6,
+	0x51, 0x17, 0xb6, 0x80, 0x6d, 0x37, 0x65, 0xd1, 0xd2, 0xae, 0xda, 0xa1, 0xea, 0xa2, 0x1b, 0x6b,
+	0x62, 0x4f, 0x9d, 0x91, 0xc2, 0x8c, 0xf1, 0x1d, 0x03, 0x79, 0x8b, 0x3e, 0x4b, 0x1f, 0xa1, 0xab,
+	0x2e, 0x59, 0x76, 0x15, 0x55, 0xb0, 0xcb, 0x53, 0x54, 0x9e, 0x71, 0xfe, 0x44, 0x2a, 0x36, 0xd1,
+	0xdc, 0x73, 0xce, 0x9c, 0xdc, 0xeb, 0x7b, 0x06, 0x05, 0x7a, 0x94, 0x73, 0x88, 0x2e, 0x4b, 0x5e,
+	0x8c, 0x62, 0xd0, 0x05, 0x67, 0x17, 0x42, 0x66, 0x31, 0x5c, 0xb3, 0x3c, 0xcc, 0x0b, 0xa5, 0x15,
+	0x6e, 0x1a, 0x45, 0x7b, 0x2f, 0x53, 0x99, 0x32, 0x48, 0x54, 0x9d, 0x2c, 0xd9, 0x7e, 0x95, 0x28,
+	0xb8, 0x50, 0x10, 0xf5, 0x19, 0x70, 0x6b, 0x12, 0x5d, 0x1d, 0xf5, 0xb9, 0x66, 0x47, 0x51, 0xce,
+	0x32, 0x21, 0x99, 0x16, 0x4a, 0x5a, 0x6d, 0xf7, 0x0c, 0x1d, 0x7c, 0xaa, 0x14, 0xe7, 0xd3, 0x7f,
+	0x39, 0xbf, 0x66, 0x39, 0xe5, 0x97, 0x25, 0x07, 0x8d, 0x77, 0x51, 0x53, 0xdf, 0xc4, 0x22, 0x25,
+	0x4e, 0xe0, 0xf4, 0xb6, 0xe8, 0xba, 0xbe, 0x79, 0x9f, 0xe2, 0x7d, 0xd4, 0x1a, 0x70, 0x91, 0x0d,
+	0x34, 0x69, 0x18, 0xb4, 0xae, 0xba, 0x13, 0x17, 0xb5, 0x57, 0x59, 0x41, 0xae, 0x24, 0xf0, 0xd5,
+	0x5e, 0x6d, 0xb4, 0x29, 0xa4, 0xe6, 0xc5, 0x15, 0x1b, 0x1a, 0x37, 0x97, 0xce, 0xea, 0x8a, 0xbb,
+	0x2c, 0x99, 0xd4, 0x42, 0x8f, 0x88, 0x6b, 0xb9, 0x69, 0x8d, 0xf7, 0x50, 0x33, 0x51, 0xa5, 0xd4,
+	0x64, 0xdd, 0x10, 0xb6, 0xc0, 0x1d, 0xe4, 0x0d, 0x19, 0xe8, 0xb8, 0x6e, 0xaf, 0x69, 0x38, 0x54,
+	0x41, 0x67, 0x06, 0xc1, 0x27, 0x68, 0x5b, 0x17, 0x2c, 0xe5, 0xb1, 0x66, 0x45, 0xc6, 0x35, 0x69,
+	0x55, 0xad, 0x9c, 0x3e, 0x99, 0x8c, 0x3b, 0x4b, 0x38, 0xf5, 0x4c, 0xf5, 0xd9, 0x14, 0xf8, 0x10,
+	0x6d, 0x83, 0x2a, 0x8b, 0x84, 0xc7, 0x0c, 0x80, 0x6b, 0xb2, 0x61, 0xfa, 0xf7, 0x2c, 0xf6, 0x06,
+	0xc0, 0x4a, 0xec, 0xcd, 0x5a, 0xb2, 0x69, 0x25, 0x16, 0xb3, 0x92, 0x00, 0x79, 0x29, 0x07, 0x5d,
+	0x7f, 0x7c, 0xb2, 0x65, 0x15, 0x0b, 0x10, 0x7e, 0x8e, 0x36, 0x52, 0x9e, 0x2b, 0x10, 0x9a, 0x20,
+	0xd3, 0x97, 0x37, 0x19, 0x77, 0xa6, 0x10, 0x9d, 0x1e, 0xf0, 0x3e, 0x6a, 0x08, 0x49, 0x3c, 0xa3,
+	0x68, 0x4d, 0xc6, 0x9d, 0x86, 0x90, 0xb4, 0x21, 0x24, 0x3e, 0x40, 0xae, 0x2a, 0x35, 0xd9, 0x36,
+	0xc4, 0xc6, 0x64, 0xdc, 0xa9, 0x4a, 0x5a, 0xfd, 0x54, 0xed, 0x7d, 0x63, 0x62, 0xc8, 0x53, 0x93,
+	0x20, 0x20, 0xff, 0x05, 0x6e, 0xcf, 0xa5, 0x9e, 0xc5, 0xaa, 0x25, 0x01, 0x0e, 0xd1, 0xee, 0x82,
+	0x24, 0x2e, 0x38, 0x03, 0x25, 0x81, 0xec, 0x04, 0x6e, 0x6f, 0x8b, 0x3e, 0x9d, 0x2b, 0xa9, 0x25,
+	0xba, 0x3f, 0x9d, 0x55, 0xcb, 0x86, 0x69, 0x70, 0xe6, 0x19, 0x71, 0x16, 0x33, 0x82, 0xdf, 0x22,
+	0x34, 0x4f, 0xa0, 0xd9, 0xb8, 0x77, 0xfc, 0x22, 0xb4, 0x71, 0x0d, 0xab, 0xb8, 0x86, 0x26, 0xae,
+	0x61, 0x1d, 0xd7, 0xf0, 0x23, 0xcb, 0x78, 0xed, 0x49, 0x17, 0x6e, 0x3e, 0xd8, 0x89, 0xfb, 0xf8,
+	0x4e, 0xd6, 0x1f, 0xec, 0xa4, 0xfb, 0xc3, 0x41, 0xcf, 0x56, 0x0e, 0x51, 0x47, 0xf6, 0x03, 0xfa,
+	0x7f, 0xf9, 0xf1, 0x01, 0x71, 0x02, 0xb7, 0xe7, 0x1d, 0x1f, 0x86, 0xe6, 0xf9, 0x85, 0xff, 0x8e,
+	0x3b, 0xdd, 0x81, 0x25, 0x4f, 0xfc, 0x6e, 0xc5, 0xe4, 0x2f, 0x1f, 0x9d, 0xbc, 0x36, 0x5b, 0xb8,
+	0x7a, 0xfa, 0xe5, 0xd7, 0x9d, 0xef, 0xdc, 0xde, 0xf9, 0xce, 0x9f, 0x3b, 0xdf, 0xf9, 0x7e, 0xef,
+	0xaf, 0xdd, 0xde, 0xfb, 0x6b, 0xbf, 0xef, 0xfd, 0xb5, 0xaf, 0xaf, 0x33, 0xa1, 0x07, 0x65, 0x3f,
+	0x4c, 0xd4, 0x45, 0x04, 0xd7, 0x42, 0x27, 0x83, 0xe1, 0xc8, 0x3c, 0xf2, 0x44, 0x0d, 0x67, 0x80,
+	0x54, 0x29, 0x8f, 0xae, 0x4e, 0xa2, 0x9b, 0x19, 0x12, 0x99, 0x59, 0xfa, 0x2d, 0x23, 0x3d, 0xf9,
+	0x3b, 0x00, 0x92, 0xec, 0xbe, 0x40, 0x7c, 0x04, 0x00, 0x00,
 }
 
 func (m *QueryStreamingSwapRequest) Marshal() (dAtA []byte, err error) {
@@ -554,6 +594,32 @@ func (m *QueryStreamingSwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.TargetAsset) > 0 {
+		i -= len(m.TargetAsset)
+		copy(dAtA[i:], m.TargetAsset)
+		i = encodeVarintQueryStreamingSwap(dAtA, i, uint64(len(m.TargetAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceAsset) > 0 {
+		i -= len(m.SourceAsset)
+		copy(dAtA[i:], m.SourceAsset)
+		i = encodeVarintQueryStreamingSwap(dAtA, i, uint64(len(m.SourceAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryStreamingSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -584,6 +650,18 @@ func (m *QueryStreamingSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryStreamingSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.StreamingSwaps) > 0 {
 		for iNdEx := len(m.StreamingSwaps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -705,6 +783,18 @@ func (m *QueryStreamingSwapsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryStreamingSwap(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryStreamingSwap(uint64(l))
+	}
+	l = len(m.SourceAsset)
+	if l > 0 {
+		n += 1 + l + sovQueryStreamingSwap(uint64(l))
+	}
+	l = len(m.TargetAsset)
+	if l > 0 {
+		n += 1 + l + sovQueryStreamingSwap(uint64(l))
+	}
 	return n
 }
 
@@ -720,6 +810,10 @@ func (m *QueryStreamingSwapsResponse) Size() (n int) {
 			n += 1 + l + sovQueryStreamingSwap(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryStreamingSwap(uint64(l))
+	}
 	return n
 }
 
@@ -1394,6 +1488,106 @@ func (m *QueryStreamingSwapsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryStreamingSwap(dAtA[iNdEx:])
@@ -1478,6 +1672,42 @@ func (m *QueryStreamingSwapsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStreamingSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryStreamingSwap(dAtA[iNdEx:])
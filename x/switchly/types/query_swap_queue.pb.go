@@ -111,30 +111,339 @@ func (m *QuerySwapQueueResponse) GetSwapQueue() []*MsgSwap {
 	return nil
 }
 
+type QueryLimitSwapBookRequest struct {
+	SourceAsset string `protobuf:"bytes,1,opt,name=source_asset,json=sourceAsset,proto3" json:"source_asset,omitempty"`
+	TargetAsset string `protobuf:"bytes,2,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset,omitempty"`
+	Height      string `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryLimitSwapBookRequest) Reset()         { *m = QueryLimitSwapBookRequest{} }
+func (m *QueryLimitSwapBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitSwapBookRequest) ProtoMessage()    {}
+func (*QueryLimitSwapBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8755d6524823a050, []int{2}
+}
+func (m *QueryLimitSwapBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitSwapBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitSwapBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitSwapBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitSwapBookRequest.Merge(m, src)
+}
+func (m *QueryLimitSwapBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitSwapBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitSwapBookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitSwapBookRequest proto.InternalMessageInfo
+
+func (m *QueryLimitSwapBookRequest) GetSourceAsset() string {
+	if m != nil {
+		return m.SourceAsset
+	}
+	return ""
+}
+
+func (m *QueryLimitSwapBookRequest) GetTargetAsset() string {
+	if m != nil {
+		return m.TargetAsset
+	}
+	return ""
+}
+
+func (m *QueryLimitSwapBookRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type LimitSwapBookLevel struct {
+	// the ratio of source to target amounts (scaled by 1e8) shared by the orders at this level
+	Ratio string `protobuf:"bytes,1,opt,name=ratio,proto3" json:"ratio"`
+	// the number of resting limit swaps at this level
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	// the total amount of the source asset offered at this level
+	SourceAmount string `protobuf:"bytes,3,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount"`
+	// the total amount of the target asset requested at this level
+	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount"`
+}
+
+func (m *LimitSwapBookLevel) Reset()         { *m = LimitSwapBookLevel{} }
+func (m *LimitSwapBookLevel) String() string { return proto.CompactTextString(m) }
+func (*LimitSwapBookLevel) ProtoMessage()    {}
+func (*LimitSwapBookLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8755d6524823a050, []int{3}
+}
+func (m *LimitSwapBookLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitSwapBookLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitSwapBookLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitSwapBookLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitSwapBookLevel.Merge(m, src)
+}
+func (m *LimitSwapBookLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitSwapBookLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitSwapBookLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitSwapBookLevel proto.InternalMessageInfo
+
+func (m *LimitSwapBookLevel) GetRatio() string {
+	if m != nil {
+		return m.Ratio
+	}
+	return ""
+}
+
+func (m *LimitSwapBookLevel) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LimitSwapBookLevel) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return ""
+}
+
+func (m *LimitSwapBookLevel) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
+	}
+	return ""
+}
+
+type QueryLimitSwapBookResponse struct {
+	SourceAsset string `protobuf:"bytes,1,opt,name=source_asset,json=sourceAsset,proto3" json:"source_asset"`
+	TargetAsset string `protobuf:"bytes,2,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset"`
+	// price levels ordered from the highest ratio (first to execute) to the lowest
+	Levels []*LimitSwapBookLevel `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels"`
+}
+
+func (m *QueryLimitSwapBookResponse) Reset()         { *m = QueryLimitSwapBookResponse{} }
+func (m *QueryLimitSwapBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitSwapBookResponse) ProtoMessage()    {}
+func (*QueryLimitSwapBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8755d6524823a050, []int{4}
+}
+func (m *QueryLimitSwapBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitSwapBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitSwapBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitSwapBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitSwapBookResponse.Merge(m, src)
+}
+func (m *QueryLimitSwapBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitSwapBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitSwapBookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitSwapBookResponse proto.InternalMessageInfo
+
+func (m *QueryLimitSwapBookResponse) GetSourceAsset() string {
+	if m != nil {
+		return m.SourceAsset
+	}
+	return ""
+}
+
+func (m *QueryLimitSwapBookResponse) GetTargetAsset() string {
+	if m != nil {
+		return m.TargetAsset
+	}
+	return ""
+}
+
+func (m *QueryLimitSwapBookResponse) GetLevels() []*LimitSwapBookLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+type QueryLimitSwapsByAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryLimitSwapsByAddressRequest) Reset()         { *m = QueryLimitSwapsByAddressRequest{} }
+func (m *QueryLimitSwapsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitSwapsByAddressRequest) ProtoMessage()    {}
+func (*QueryLimitSwapsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8755d6524823a050, []int{5}
+}
+func (m *QueryLimitSwapsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitSwapsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitSwapsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitSwapsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitSwapsByAddressRequest.Merge(m, src)
+}
+func (m *QueryLimitSwapsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitSwapsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitSwapsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitSwapsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryLimitSwapsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryLimitSwapsByAddressRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type QueryLimitSwapsByAddressResponse struct {
+	LimitSwaps []*MsgSwap `protobuf:"bytes,1,rep,name=limit_swaps,json=limitSwaps,proto3" json:"limit_swaps,omitempty"`
+}
+
+func (m *QueryLimitSwapsByAddressResponse) Reset()         { *m = QueryLimitSwapsByAddressResponse{} }
+func (m *QueryLimitSwapsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitSwapsByAddressResponse) ProtoMessage()    {}
+func (*QueryLimitSwapsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8755d6524823a050, []int{6}
+}
+func (m *QueryLimitSwapsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitSwapsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitSwapsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitSwapsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitSwapsByAddressResponse.Merge(m, src)
+}
+func (m *QueryLimitSwapsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitSwapsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitSwapsByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitSwapsByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryLimitSwapsByAddressResponse) GetLimitSwaps() []*MsgSwap {
+	if m != nil {
+		return m.LimitSwaps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySwapQueueRequest)(nil), "types.QuerySwapQueueRequest")
 	proto.RegisterType((*QuerySwapQueueResponse)(nil), "types.QuerySwapQueueResponse")
+	proto.RegisterType((*QueryLimitSwapBookRequest)(nil), "types.QueryLimitSwapBookRequest")
+	proto.RegisterType((*LimitSwapBookLevel)(nil), "types.LimitSwapBookLevel")
+	proto.RegisterType((*QueryLimitSwapBookResponse)(nil), "types.QueryLimitSwapBookResponse")
+	proto.RegisterType((*QueryLimitSwapsByAddressRequest)(nil), "types.QueryLimitSwapsByAddressRequest")
+	proto.RegisterType((*QueryLimitSwapsByAddressResponse)(nil), "types.QueryLimitSwapsByAddressResponse")
 }
 
 func init() { proto.RegisterFile("types/query_swap_queue.proto", fileDescriptor_8755d6524823a050) }
 
 var fileDescriptor_8755d6524823a050 = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0xa9, 0x2c, 0x48,
-	0x2d, 0xd6, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0x8c, 0x2f, 0x2e, 0x4f, 0x2c, 0x88, 0x2f, 0x2c, 0x4d,
-	0x2d, 0x4d, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0xcb, 0x4a, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x45, 0xf4, 0x41, 0x2c, 0x88, 0xa4, 0x94, 0x08, 0x44, 0x6b, 0x6e, 0x71, 0x3a,
-	0x58, 0x23, 0x44, 0x54, 0x49, 0x9f, 0x4b, 0x34, 0x10, 0x64, 0x58, 0x70, 0x79, 0x62, 0x41, 0x20,
-	0xc8, 0xa8, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x21, 0x31, 0x2e, 0xb6, 0x8c, 0xd4, 0xcc,
-	0xf4, 0x8c, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x28, 0x4f, 0xc9, 0x9d, 0x4b, 0x0c,
-	0x5d, 0x43, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x2e, 0x17, 0x17, 0xc2, 0x45, 0x12, 0x8c,
-	0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x7c, 0x7a, 0x60, 0x5b, 0xf5, 0x7c, 0x8b, 0xd3, 0x41, 0x1a, 0x82,
-	0x38, 0x8b, 0x61, 0xda, 0x9c, 0xc2, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0xca, 0x26, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xb8, 0x3c, 0xb3,
-	0x24, 0x39, 0x23, 0xa7, 0x12, 0xec, 0xda, 0xe4, 0xfc, 0x1c, 0xb8, 0x40, 0x5e, 0x7e, 0x4a, 0xaa,
-	0x7e, 0x99, 0xb1, 0x7e, 0x05, 0x5c, 0x44, 0x1f, 0x6c, 0x55, 0x12, 0x1b, 0x58, 0xa9, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0xda, 0xea, 0x84, 0x82, 0x2b, 0x01, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xad, 0x1b, 0x1a, 0x94, 0x09, 0x20, 0xb0, 0x4a, 0x95, 0x46, 0xc8, 0x0e, 0x3e, 0xf5, 0x42,
+	0x2c, 0x11, 0x89, 0x13, 0x1c, 0xe2, 0x0b, 0x97, 0x72, 0xa8, 0x23, 0x71, 0xe0, 0x12, 0xb9, 0xce,
+	0xca, 0xb1, 0xb0, 0xb3, 0x8e, 0x67, 0xdd, 0xe0, 0xbf, 0xe0, 0xa7, 0x40, 0x1c, 0x7b, 0xe4, 0x14,
+	0xa1, 0xe4, 0x96, 0xaf, 0x40, 0xbb, 0xb3, 0x6e, 0xba, 0x90, 0x9e, 0xd6, 0x7e, 0xf3, 0xde, 0xcc,
+	0xce, 0x9b, 0x59, 0x78, 0x25, 0xea, 0x82, 0xa1, 0xbf, 0xac, 0x58, 0x59, 0x4f, 0x71, 0x15, 0x15,
+	0xd3, 0x65, 0xc5, 0x2a, 0x36, 0x2c, 0x4a, 0x2e, 0xb8, 0x7d, 0xa2, 0xa2, 0xfd, 0xd3, 0x84, 0x27,
+	0x5c, 0x21, 0xbe, 0xfc, 0xa2, 0x60, 0xff, 0x94, 0xa4, 0x39, 0x26, 0x4a, 0x48, 0xa8, 0xe7, 0xc3,
+	0xcb, 0x2b, 0x99, 0x6c, 0xb2, 0x8a, 0x8a, 0x2b, 0x99, 0x2a, 0x64, 0xcb, 0x8a, 0xa1, 0xb0, 0xcf,
+	0xa0, 0x3d, 0x67, 0x69, 0x32, 0x17, 0x3d, 0x6b, 0x60, 0x5d, 0x74, 0x42, 0xfd, 0xe7, 0x7d, 0x84,
+	0xb3, 0x7f, 0x05, 0x58, 0xf0, 0x05, 0x32, 0xfb, 0x0d, 0xc0, 0xfe, 0x46, 0x3d, 0x6b, 0xd0, 0xba,
+	0xe8, 0xbe, 0x7d, 0x36, 0x54, 0x55, 0x87, 0x9f, 0x30, 0x91, 0x82, 0xb0, 0x83, 0x8d, 0xcc, 0xab,
+	0xe1, 0x5c, 0x25, 0xba, 0x4c, 0xf3, 0x54, 0xc8, 0x60, 0xc0, 0xf9, 0xd7, 0xa6, 0xfa, 0x6b, 0x78,
+	0x82, 0xbc, 0x2a, 0x63, 0x36, 0x8d, 0x10, 0x59, 0x73, 0x87, 0x2e, 0x61, 0x63, 0x09, 0x49, 0x8a,
+	0x88, 0xca, 0x84, 0x09, 0x4d, 0x39, 0x26, 0x0a, 0x61, 0x44, 0xd9, 0xf7, 0xd0, 0x32, 0x7a, 0xf8,
+	0x69, 0x81, 0x6d, 0x94, 0xbd, 0x64, 0x37, 0x2c, 0xb3, 0x5d, 0x38, 0x29, 0x23, 0x91, 0x72, 0xaa,
+	0x16, 0x74, 0x76, 0x6b, 0x97, 0x80, 0x90, 0x0e, 0x49, 0x88, 0x79, 0xb5, 0xa0, 0x5a, 0x2d, 0x22,
+	0x28, 0x20, 0xa4, 0xc3, 0x7e, 0x07, 0x4f, 0x9b, 0x6b, 0xe7, 0x8a, 0xa8, 0xea, 0x06, 0x2f, 0x76,
+	0x6b, 0xd7, 0x0c, 0x84, 0xba, 0xbd, 0x71, 0xde, 0xe8, 0x9a, 0x5e, 0x48, 0xf7, 0x68, 0xaf, 0x33,
+	0x02, 0xa1, 0xee, 0x99, 0x74, 0xde, 0x0f, 0x0b, 0xfa, 0x87, 0x4c, 0xd4, 0x13, 0x19, 0x1d, 0x72,
+	0x31, 0x78, 0xbe, 0x5b, 0xbb, 0x06, 0x6e, 0xfa, 0x3a, 0x3a, 0xe4, 0x2b, 0x89, 0xee, 0xe3, 0xa6,
+	0xd3, 0x1f, 0xa0, 0x9d, 0x49, 0x0f, 0xb1, 0xd7, 0x52, 0x73, 0x3f, 0xd7, 0x73, 0xff, 0xdf, 0xe5,
+	0x00, 0x76, 0x6b, 0x57, 0x93, 0x43, 0x7d, 0x7a, 0x13, 0x70, 0xcd, 0x36, 0x30, 0xa8, 0xc7, 0xb3,
+	0x59, 0xc9, 0x10, 0x9b, 0x8d, 0xe8, 0xc1, 0xe3, 0x88, 0x10, 0xbd, 0x0c, 0xcd, 0xef, 0xbd, 0x29,
+	0x1f, 0x1b, 0x53, 0x9e, 0xc0, 0xe0, 0xe1, 0xa4, 0xda, 0x21, 0x1f, 0xba, 0x99, 0x0c, 0xab, 0x27,
+	0x81, 0x0f, 0x2c, 0x2d, 0x64, 0xfb, 0x0c, 0x9f, 0x7f, 0x6d, 0x1c, 0xeb, 0x76, 0xe3, 0x58, 0x7f,
+	0x36, 0x8e, 0xf5, 0x7d, 0xeb, 0x1c, 0xdd, 0x6e, 0x9d, 0xa3, 0xdf, 0x5b, 0xe7, 0xe8, 0xcb, 0xfb,
+	0x24, 0x15, 0xf3, 0xea, 0x7a, 0x18, 0xf3, 0xdc, 0xc7, 0x55, 0x2a, 0xe2, 0x79, 0x56, 0xab, 0x37,
+	0x16, 0xf3, 0xec, 0x0e, 0x58, 0xf0, 0x19, 0xf3, 0x6f, 0x46, 0xfe, 0xb7, 0x3b, 0xc4, 0x57, 0xb5,
+	0xae, 0xdb, 0x8a, 0x3a, 0xfa, 0x3b, 0x00, 0x7e, 0xf5, 0x5e, 0x74, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *QuerySwapQueueRequest) Marshal() (dAtA []byte, err error) {
@@ -194,62 +503,1005 @@ func (m *QuerySwapQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintQuerySwapQueue(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintQuerySwapQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitSwapBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitSwapBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitSwapBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetAsset) > 0 {
+		i -= len(m.TargetAsset)
+		copy(dAtA[i:], m.TargetAsset)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.TargetAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceAsset) > 0 {
+		i -= len(m.SourceAsset)
+		copy(dAtA[i:], m.SourceAsset)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.SourceAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitSwapBookLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitSwapBookLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitSwapBookLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ratio) > 0 {
+		i -= len(m.Ratio)
+		copy(dAtA[i:], m.Ratio)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.Ratio)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitSwapBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitSwapBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitSwapBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Levels) > 0 {
+		for iNdEx := len(m.Levels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Levels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerySwapQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TargetAsset) > 0 {
+		i -= len(m.TargetAsset)
+		copy(dAtA[i:], m.TargetAsset)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.TargetAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceAsset) > 0 {
+		i -= len(m.SourceAsset)
+		copy(dAtA[i:], m.SourceAsset)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.SourceAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitSwapsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitSwapsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitSwapsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuerySwapQueue(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitSwapsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitSwapsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitSwapsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitSwaps) > 0 {
+		for iNdEx := len(m.LimitSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerySwapQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuerySwapQueue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuerySwapQueue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySwapQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SwapQueue) > 0 {
+		for _, e := range m.SwapQueue {
+			l = e.Size()
+			n += 1 + l + sovQuerySwapQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLimitSwapBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAsset)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	l = len(m.TargetAsset)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitSwapBookLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ratio)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuerySwapQueue(uint64(m.Count))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLimitSwapBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAsset)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	l = len(m.TargetAsset)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	if len(m.Levels) > 0 {
+		for _, e := range m.Levels {
+			l = e.Size()
+			n += 1 + l + sovQuerySwapQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLimitSwapsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLimitSwapsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitSwaps) > 0 {
+		for _, e := range m.LimitSwaps {
+			l = e.Size()
+			n += 1 + l + sovQuerySwapQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuerySwapQueue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuerySwapQueue(x uint64) (n int) {
+	return sovQuerySwapQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySwapQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySwapQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySwapQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySwapQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapQueue = append(m.SwapQueue, &MsgSwap{})
+			if err := m.SwapQueue[len(m.SwapQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySwapQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLimitSwapBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySwapQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLimitSwapBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLimitSwapBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySwapQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitSwapBookLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySwapQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitSwapBookLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitSwapBookLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySwapQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuerySwapQueue(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuerySwapQueue(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QuerySwapQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQuerySwapQueue(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QuerySwapQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SwapQueue) > 0 {
-		for _, e := range m.SwapQueue {
-			l = e.Size()
-			n += 1 + l + sovQuerySwapQueue(uint64(l))
+func (m *QueryLimitSwapBookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerySwapQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLimitSwapBookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLimitSwapBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Levels = append(m.Levels, &LimitSwapBookLevel{})
+			if err := m.Levels[len(m.Levels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerySwapQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuerySwapQueue(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuerySwapQueue(x uint64) (n int) {
-	return sovQuerySwapQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySwapQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitSwapsByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -272,13 +1524,45 @@ func (m *QuerySwapQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitSwapsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitSwapsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwapQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwapQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -331,7 +1615,7 @@ func (m *QuerySwapQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitSwapsByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -354,15 +1638,15 @@ func (m *QuerySwapQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitSwapsByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitSwapsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSwaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -389,8 +1673,8 @@ func (m *QuerySwapQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapQueue = append(m.SwapQueue, &MsgSwap{})
-			if err := m.SwapQueue[len(m.SwapQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitSwaps = append(m.LimitSwaps, &MsgSwap{})
+			if err := m.LimitSwaps[len(m.LimitSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
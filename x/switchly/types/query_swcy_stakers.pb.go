@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -129,7 +130,12 @@ func (m *QuerySWCYStakerResponse) GetAmount() string {
 }
 
 type QuerySWCYStakersRequest struct {
-	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Height     string             `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return stakers with an address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,3,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// only return stakers with at least this amount staked
+	MinAmount string `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
 }
 
 func (m *QuerySWCYStakersRequest) Reset()         { *m = QuerySWCYStakersRequest{} }
@@ -172,8 +178,30 @@ func (m *QuerySWCYStakersRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QuerySWCYStakersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySWCYStakersRequest) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *QuerySWCYStakersRequest) GetMinAmount() string {
+	if m != nil {
+		return m.MinAmount
+	}
+	return ""
+}
+
 type QuerySWCYStakersResponse struct {
 	SwcyStakers []*QuerySWCYStakerResponse `protobuf:"bytes,1,rep,name=swcy_stakers,json=swcyStakers,proto3" json:"swcy_stakers,omitempty"`
+	Pagination  *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QuerySWCYStakersResponse) Reset()         { *m = QuerySWCYStakersResponse{} }
@@ -216,6 +244,13 @@ func (m *QuerySWCYStakersResponse) GetSwcyStakers() []*QuerySWCYStakerResponse {
 	return nil
 }
 
+func (m *QuerySWCYStakersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySWCYStakerRequest)(nil), "types.QuerySWCYStakerRequest")
 	proto.RegisterType((*QuerySWCYStakerResponse)(nil), "types.QuerySWCYStakerResponse")
@@ -226,25 +261,33 @@ func init() {
 func init() { proto.RegisterFile("types/query_swcy_stakers.proto", fileDescriptor_103f05cbfa3162f1) }
 
 var fileDescriptor_103f05cbfa3162f1 = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0xa9, 0x2c, 0x48,
-	0x2d, 0xd6, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0x8c, 0x2f, 0x2e, 0x4f, 0xae, 0x8c, 0x2f, 0x2e, 0x49,
-	0xcc, 0x4e, 0x2d, 0x2a, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0xcb, 0x4b, 0x89,
-	0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45, 0xf4, 0x41, 0x2c, 0x88, 0xa4, 0x92, 0x17, 0x97, 0x58, 0x20,
-	0x48, 0x63, 0x70, 0xb8, 0x73, 0x64, 0x30, 0x58, 0x5b, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89,
-	0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x67, 0x10, 0x8c, 0x2b, 0x24, 0xc6, 0xc5, 0x96, 0x91, 0x9a, 0x99, 0x9e, 0x51, 0x22, 0xc1, 0x04,
-	0x96, 0x80, 0xf2, 0x94, 0xc2, 0xb9, 0xc4, 0x31, 0xcc, 0x2a, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0xc5,
-	0x63, 0x98, 0x12, 0x17, 0x5b, 0x62, 0x6e, 0x7e, 0x69, 0x1e, 0xd4, 0x30, 0x27, 0xae, 0x57, 0xf7,
-	0xe4, 0xa1, 0x22, 0x41, 0x50, 0x5a, 0xc9, 0x10, 0xc3, 0xe0, 0x62, 0x98, 0x2b, 0x11, 0x6e, 0x61,
-	0x44, 0x71, 0x4b, 0x2c, 0x97, 0x04, 0xa6, 0x16, 0xa8, 0x63, 0x1c, 0xb9, 0x78, 0x90, 0x83, 0x49,
-	0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0x1c, 0x4e, 0x7a, 0x38, 0xbc, 0x10, 0xc4,
-	0x0d, 0xd2, 0x03, 0x35, 0xca, 0x29, 0xec, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x6c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8b, 0xcb, 0x33,
-	0x4b, 0x92, 0x33, 0x72, 0x2a, 0xc1, 0x41, 0x9d, 0x9c, 0x9f, 0x03, 0x17, 0xc8, 0xcb, 0x4f, 0x49,
-	0xd5, 0x2f, 0x33, 0xd6, 0xaf, 0x80, 0x8b, 0xe8, 0x83, 0x2d, 0x4f, 0x62, 0x03, 0x2b, 0x35, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x47, 0x6d, 0xaf, 0x97, 0xd4, 0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0xef, 0xd2, 0x30,
+	0x18, 0xc6, 0xa9, 0x28, 0x86, 0xa2, 0x1e, 0x16, 0x83, 0x0b, 0x89, 0x95, 0x2c, 0x51, 0x89, 0x87,
+	0x36, 0xc0, 0xd5, 0x0b, 0x98, 0x68, 0xe2, 0x09, 0x47, 0x22, 0xd1, 0xcb, 0xd2, 0x8d, 0xba, 0x35,
+	0xb2, 0x75, 0xac, 0x1d, 0xb0, 0x6f, 0xe1, 0xa7, 0xf0, 0x6b, 0x78, 0xf5, 0xc8, 0xd1, 0x93, 0x31,
+	0x70, 0xf3, 0x53, 0x98, 0x75, 0x05, 0x96, 0xa0, 0xfe, 0x4f, 0xd0, 0xe7, 0x7d, 0xdf, 0x5f, 0xdf,
+	0xe7, 0xe9, 0x20, 0x52, 0x45, 0xca, 0x24, 0x59, 0xe7, 0x2c, 0x2b, 0x3c, 0xb9, 0x0d, 0x0a, 0x4f,
+	0x2a, 0xfa, 0x99, 0x65, 0x12, 0xa7, 0x99, 0x50, 0xc2, 0xba, 0xa3, 0xeb, 0xbd, 0x87, 0xa1, 0x08,
+	0x85, 0x56, 0x48, 0xf9, 0xaf, 0x2a, 0xf6, 0x5e, 0x04, 0x42, 0xc6, 0x42, 0x12, 0x9f, 0x4a, 0x56,
+	0x21, 0xc8, 0x66, 0xe8, 0x33, 0x45, 0x87, 0x24, 0xa5, 0x21, 0x4f, 0xa8, 0xe2, 0x22, 0xa9, 0x7a,
+	0x9d, 0xb7, 0xb0, 0xfb, 0xae, 0xec, 0x98, 0x2f, 0x5e, 0x7d, 0x98, 0xeb, 0x2b, 0x5c, 0xb6, 0xce,
+	0x99, 0x54, 0x96, 0x0d, 0xef, 0xd2, 0xe5, 0x32, 0x63, 0x52, 0xda, 0xa0, 0x0f, 0x06, 0x6d, 0xf7,
+	0x74, 0xb4, 0xba, 0xb0, 0x15, 0x31, 0x1e, 0x46, 0xca, 0xbe, 0xa5, 0x0b, 0xe6, 0xe4, 0x2c, 0xe0,
+	0xa3, 0x2b, 0x96, 0x4c, 0x45, 0x22, 0xd9, 0x7f, 0x60, 0x0e, 0x6c, 0xd1, 0x58, 0xe4, 0x89, 0x81,
+	0x4d, 0xe1, 0xef, 0x9f, 0x4f, 0x8c, 0xe2, 0x9a, 0x5f, 0xe7, 0x1b, 0xb8, 0x22, 0xcb, 0xd3, 0x9a,
+	0x97, 0x65, 0x40, 0x7d, 0x19, 0xeb, 0x35, 0x84, 0x17, 0xb3, 0x9a, 0xdd, 0x19, 0x3d, 0xc3, 0x55,
+	0x32, 0xb8, 0x4c, 0x06, 0xeb, 0x64, 0xb0, 0x49, 0x06, 0xcf, 0x68, 0xc8, 0x0c, 0xd3, 0xad, 0x4d,
+	0x5a, 0x4f, 0xe1, 0x03, 0xb3, 0xaa, 0x97, 0x66, 0xec, 0x13, 0xdf, 0xd9, 0x4d, 0x7d, 0xcf, 0x7d,
+	0xa3, 0xce, 0xb4, 0x68, 0x3d, 0x86, 0x30, 0xe6, 0x89, 0x67, 0xac, 0xdc, 0xd6, 0x2d, 0xed, 0x98,
+	0x27, 0x93, 0xca, 0xc1, 0x57, 0x00, 0xed, 0x6b, 0x07, 0x26, 0x9c, 0x09, 0xbc, 0x57, 0x7f, 0x62,
+	0x1b, 0xf4, 0x9b, 0x83, 0xce, 0x08, 0x61, 0xfd, 0xc6, 0xf8, 0x1f, 0x91, 0xba, 0x9d, 0x72, 0xc6,
+	0xa0, 0xac, 0x37, 0x7f, 0x71, 0xfb, 0xfc, 0x46, 0xb7, 0x86, 0x54, 0x1b, 0x9d, 0xbe, 0xff, 0x7e,
+	0x40, 0x60, 0x7f, 0x40, 0xe0, 0xd7, 0x01, 0x81, 0x2f, 0x47, 0xd4, 0xd8, 0x1f, 0x51, 0xe3, 0xc7,
+	0x11, 0x35, 0x3e, 0xbe, 0x0c, 0xb9, 0x8a, 0x72, 0x1f, 0x07, 0x22, 0x26, 0x72, 0xcb, 0x55, 0x10,
+	0xad, 0x0a, 0xfd, 0x0d, 0x05, 0x62, 0x75, 0x16, 0x12, 0xb1, 0x64, 0x64, 0x33, 0x26, 0xbb, 0xb3,
+	0x42, 0xb4, 0x0b, 0xbf, 0xa5, 0x5b, 0xc7, 0x7f, 0x06, 0x00, 0x30, 0x9e, 0x28, 0xf2, 0xd9, 0x02,
+	0x00, 0x00,
 }
 
 func (m *QuerySWCYStakerRequest) Marshal() (dAtA []byte, err error) {
@@ -341,6 +384,32 @@ func (m *QuerySWCYStakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinAmount) > 0 {
+		i -= len(m.MinAmount)
+		copy(dAtA[i:], m.MinAmount)
+		i = encodeVarintQuerySwcyStakers(dAtA, i, uint64(len(m.MinAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintQuerySwcyStakers(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerySwcyStakers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -371,6 +440,18 @@ func (m *QuerySWCYStakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerySwcyStakers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.SwcyStakers) > 0 {
 		for iNdEx := len(m.SwcyStakers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -443,6 +524,18 @@ func (m *QuerySWCYStakersRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuerySwcyStakers(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerySwcyStakers(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuerySwcyStakers(uint64(l))
+	}
+	l = len(m.MinAmount)
+	if l > 0 {
+		n += 1 + l + sovQuerySwcyStakers(uint64(l))
+	}
 	return n
 }
 
@@ -458,6 +551,10 @@ func (m *QuerySWCYStakersResponse) Size() (n int) {
 			n += 1 + l + sovQuerySwcyStakers(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerySwcyStakers(uint64(l))
+	}
 	return n
 }
 
@@ -756,6 +853,106 @@ func (m *QuerySWCYStakersRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwcyStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwcyStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwcyStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerySwcyStakers(dAtA[iNdEx:])
@@ -840,6 +1037,42 @@ func (m *QuerySWCYStakersResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwcyStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwcyStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerySwcyStakers(dAtA[iNdEx:])
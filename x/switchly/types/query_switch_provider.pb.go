@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -176,7 +177,12 @@ func (m *QuerySWITCHProviderResponse) GetLastWithdrawHeight() int64 {
 }
 
 type QuerySWITCHProvidersRequest struct {
-	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Height     string             `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return providers with a switch address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,3,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// only return providers with at least this many pool units
+	MinUnits string `protobuf:"bytes,4,opt,name=min_units,json=minUnits,proto3" json:"min_units,omitempty"`
 }
 
 func (m *QuerySWITCHProvidersRequest) Reset()         { *m = QuerySWITCHProvidersRequest{} }
@@ -219,8 +225,30 @@ func (m *QuerySWITCHProvidersRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QuerySWITCHProvidersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QuerySWITCHProvidersRequest) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *QuerySWITCHProvidersRequest) GetMinUnits() string {
+	if m != nil {
+		return m.MinUnits
+	}
+	return ""
+}
+
 type QuerySWITCHProvidersResponse struct {
-	Providers []*QuerySWITCHProviderResponse `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	Providers  []*QuerySWITCHProviderResponse `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	Pagination *query.PageResponse            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QuerySWITCHProvidersResponse) Reset()         { *m = QuerySWITCHProvidersResponse{} }
@@ -263,6 +291,13 @@ func (m *QuerySWITCHProvidersResponse) GetProviders() []*QuerySWITCHProviderResp
 	return nil
 }
 
+func (m *QuerySWITCHProvidersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySWITCHProviderRequest)(nil), "types.QuerySWITCHProviderRequest")
 	proto.RegisterType((*QuerySWITCHProviderResponse)(nil), "types.QuerySWITCHProviderResponse")
@@ -273,35 +308,42 @@ func init() {
 func init() { proto.RegisterFile("types/query_switch_provider.proto", fileDescriptor_d3339fde0318541b) }
 
 var fileDescriptor_d3339fde0318541b = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcf, 0x8f, 0x93, 0x40,
-	0x14, 0x2e, 0x62, 0x5b, 0x3b, 0x66, 0xd7, 0x38, 0xbb, 0x51, 0x5c, 0x0d, 0xac, 0x9c, 0x7a, 0x82,
-	0xc4, 0x8d, 0x07, 0x93, 0x3d, 0xb8, 0x68, 0xe2, 0xea, 0xc1, 0xe8, 0x68, 0xdc, 0xc4, 0x0b, 0xd2,
-	0x32, 0x01, 0x12, 0xca, 0x50, 0x66, 0x68, 0xed, 0x7f, 0xe1, 0xff, 0xe4, 0xc5, 0x63, 0x8f, 0x9e,
-	0x88, 0x69, 0x6f, 0xfc, 0x15, 0x66, 0x7e, 0x50, 0xd2, 0x86, 0xec, 0xa5, 0xd3, 0xef, 0xfb, 0xde,
-	0xf7, 0xde, 0x0b, 0xef, 0x03, 0xcf, 0xd9, 0x2a, 0xc7, 0xd4, 0x9d, 0x97, 0xb8, 0x58, 0xf9, 0x74,
-	0x99, 0xb0, 0x69, 0xec, 0xe7, 0x05, 0x59, 0x24, 0x21, 0x2e, 0x9c, 0xbc, 0x20, 0x8c, 0xc0, 0xbe,
-	0x28, 0x39, 0x3b, 0x8d, 0x48, 0x44, 0x04, 0xe3, 0xf2, 0x7f, 0x52, 0xb4, 0x3f, 0x82, 0xb3, 0xcf,
-	0xdc, 0xfb, 0xe5, 0xe6, 0xfd, 0xd7, 0x37, 0xd7, 0x9f, 0x94, 0x13, 0xe1, 0x79, 0x89, 0x29, 0x83,
-	0x06, 0x18, 0x06, 0x61, 0x58, 0x60, 0x4a, 0x0d, 0xed, 0x5c, 0x1b, 0x8f, 0x50, 0x03, 0xe1, 0x23,
-	0x30, 0x88, 0x71, 0x12, 0xc5, 0xcc, 0xb8, 0x23, 0x04, 0x85, 0xec, 0xdf, 0x3a, 0x78, 0xda, 0xd9,
-	0x90, 0xe6, 0x24, 0xa3, 0x18, 0xbe, 0x02, 0xc7, 0x6a, 0xcb, 0xbd, 0xc6, 0x1e, 0xac, 0x2b, 0xeb,
-	0x40, 0x41, 0x47, 0x12, 0x5f, 0xa9, 0x91, 0x16, 0xe8, 0x97, 0x59, 0xc2, 0xa8, 0x9c, 0xe8, 0x8d,
-	0xea, 0xca, 0x92, 0x04, 0x92, 0x0f, 0x2f, 0x58, 0x04, 0x69, 0x89, 0x0d, 0xbd, 0x2d, 0x10, 0x04,
-	0x92, 0x0f, 0x7c, 0x02, 0xf4, 0x3c, 0x4b, 0x8d, 0xbb, 0x42, 0x1e, 0xd6, 0x95, 0xc5, 0x21, 0xe2,
-	0x3f, 0x7c, 0xaf, 0x10, 0xe7, 0x84, 0x26, 0xcc, 0x0f, 0x66, 0xa4, 0xcc, 0x98, 0xd1, 0x6f, 0xf7,
-	0xda, 0x57, 0xd0, 0x91, 0xc2, 0x57, 0x02, 0xc2, 0x4b, 0xf0, 0x60, 0x99, 0xb0, 0x38, 0x2c, 0x82,
-	0x65, 0xe3, 0x1d, 0x08, 0xef, 0x49, 0x5d, 0x59, 0x87, 0x12, 0x3a, 0x6e, 0x08, 0xe5, 0x7e, 0x07,
-	0x4e, 0xd2, 0x80, 0x32, 0xbf, 0x99, 0xa1, 0xbe, 0xea, 0xf0, 0x5c, 0x1b, 0xeb, 0xde, 0xe3, 0xba,
-	0xb2, 0xba, 0x64, 0xf4, 0x90, 0x93, 0x6f, 0x25, 0x77, 0x2d, 0x28, 0xf8, 0x01, 0x9c, 0x8a, 0xca,
-	0xdd, 0x40, 0xd5, 0xe9, 0x9e, 0xe8, 0x64, 0xd4, 0x95, 0xd5, 0xa9, 0x23, 0xc8, 0xd9, 0x1b, 0x45,
-	0xca, 0x5e, 0xf6, 0xcb, 0xce, 0x23, 0xd2, 0x26, 0x16, 0xed, 0xf1, 0xb5, 0xbd, 0xe3, 0xff, 0x00,
-	0xcf, 0xba, 0x6d, 0xea, 0xf8, 0xaf, 0xc1, 0xa8, 0xc9, 0x26, 0xbf, 0xbb, 0x3e, 0xbe, 0xff, 0xc2,
-	0x76, 0x44, 0x3a, 0x9d, 0x5b, 0x32, 0x83, 0x5a, 0x93, 0xf7, 0xed, 0xcf, 0xc6, 0xd4, 0xd6, 0x1b,
-	0x53, 0xfb, 0xb7, 0x31, 0xb5, 0x5f, 0x5b, 0xb3, 0xb7, 0xde, 0x9a, 0xbd, 0xbf, 0x5b, 0xb3, 0xf7,
-	0xfd, 0x32, 0x4a, 0x58, 0x5c, 0x4e, 0x9c, 0x29, 0x99, 0xb9, 0x32, 0x37, 0xe9, 0x4a, 0x44, 0x7c,
-	0x4a, 0xd2, 0x1d, 0x91, 0x91, 0x10, 0xbb, 0x8b, 0x0b, 0xf7, 0xe7, 0x8e, 0x71, 0xc5, 0xf8, 0xc9,
-	0x40, 0x94, 0x5e, 0xfc, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x60, 0x49, 0x09, 0x65, 0x4f, 0x03, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0x09, 0x4d, 0x9a, 0xad, 0x12, 0xc4, 0xb6, 0x02, 0x93, 0x22, 0xbb, 0x44, 0x02, 0x22,
+	0x0e, 0xb6, 0xda, 0x9e, 0x90, 0x7a, 0xa0, 0x01, 0xd1, 0xc2, 0x01, 0x85, 0xe5, 0xa7, 0x12, 0x17,
+	0xcb, 0x89, 0x17, 0x67, 0xa5, 0xc4, 0xeb, 0x7a, 0xd7, 0x49, 0xf3, 0x16, 0xbc, 0x06, 0x8f, 0x81,
+	0xb8, 0x70, 0xec, 0x91, 0x93, 0x85, 0x92, 0x9b, 0x9f, 0x02, 0xed, 0x8f, 0x93, 0xb4, 0xb2, 0xe0,
+	0x12, 0x67, 0xbe, 0x6f, 0xe6, 0x1b, 0x8f, 0xe7, 0x1b, 0xf0, 0x88, 0xcf, 0x62, 0xcc, 0xdc, 0x8b,
+	0x14, 0x27, 0x33, 0x8f, 0x4d, 0x09, 0x1f, 0x0c, 0xbd, 0x38, 0xa1, 0x13, 0x12, 0xe0, 0xc4, 0x89,
+	0x13, 0xca, 0x29, 0xdc, 0x94, 0x29, 0xad, 0xdd, 0x90, 0x86, 0x54, 0x22, 0xae, 0xf8, 0xa7, 0xc8,
+	0xd6, 0xb3, 0x01, 0x65, 0x63, 0xca, 0xdc, 0xbe, 0xcf, 0xb0, 0x52, 0x71, 0x27, 0x07, 0x7d, 0xcc,
+	0xfd, 0x03, 0x37, 0xf6, 0x43, 0x12, 0xf9, 0x9c, 0xd0, 0x48, 0xe5, 0xb6, 0xdf, 0x81, 0xd6, 0x7b,
+	0x91, 0xf1, 0xe1, 0xfc, 0xcd, 0xc7, 0x97, 0x67, 0x3d, 0xdd, 0x05, 0xe1, 0x8b, 0x14, 0x33, 0x0e,
+	0x4d, 0x50, 0xf3, 0x83, 0x20, 0xc1, 0x8c, 0x99, 0xc6, 0xbe, 0xd1, 0xa9, 0xa3, 0x22, 0x84, 0xf7,
+	0x40, 0x75, 0x88, 0x49, 0x38, 0xe4, 0xe6, 0x2d, 0x49, 0xe8, 0xa8, 0xfd, 0xb3, 0x02, 0xf6, 0x4a,
+	0x05, 0x59, 0x4c, 0x23, 0x86, 0xe1, 0x73, 0xd0, 0xd4, 0x13, 0x5d, 0x13, 0xee, 0xc2, 0x3c, 0xb3,
+	0x6f, 0x30, 0xa8, 0xa1, 0xe2, 0x13, 0xdd, 0xd2, 0x06, 0x9b, 0x69, 0x44, 0x38, 0x53, 0x1d, 0xbb,
+	0xf5, 0x3c, 0xb3, 0x15, 0x80, 0xd4, 0x43, 0x24, 0x4c, 0xfc, 0x51, 0x8a, 0xcd, 0xca, 0x2a, 0x41,
+	0x02, 0x48, 0x3d, 0xe0, 0x03, 0x50, 0x89, 0xa3, 0x91, 0x79, 0x5b, 0xd2, 0xb5, 0x3c, 0xb3, 0x45,
+	0x88, 0xc4, 0x8f, 0x78, 0xaf, 0x00, 0xc7, 0x94, 0x11, 0xee, 0xf9, 0x63, 0x9a, 0x46, 0xdc, 0xdc,
+	0x5c, 0xbd, 0xd7, 0x75, 0x06, 0x35, 0x74, 0x7c, 0x22, 0x43, 0x78, 0x0c, 0xee, 0x4c, 0x09, 0x1f,
+	0x06, 0x89, 0x3f, 0x2d, 0x6a, 0xab, 0xb2, 0x76, 0x27, 0xcf, 0xec, 0x9b, 0x14, 0x6a, 0x16, 0x80,
+	0xae, 0x3e, 0x05, 0x3b, 0x23, 0x9f, 0x71, 0xaf, 0xe8, 0xa1, 0xbf, 0x6a, 0x6d, 0xdf, 0xe8, 0x54,
+	0xba, 0xf7, 0xf3, 0xcc, 0x2e, 0xa3, 0xd1, 0x5d, 0x01, 0xbe, 0x52, 0xd8, 0x99, 0x84, 0xe0, 0x5b,
+	0xb0, 0x2b, 0x33, 0x97, 0x0d, 0xb5, 0xd2, 0x96, 0x54, 0x32, 0xf3, 0xcc, 0x2e, 0xe5, 0x11, 0x14,
+	0xe8, 0xb9, 0x06, 0x95, 0x56, 0xfb, 0x87, 0x51, 0xba, 0x45, 0x56, 0xf8, 0x62, 0xb5, 0x7d, 0x63,
+	0x7d, 0xfb, 0xf0, 0x35, 0x00, 0x2b, 0x87, 0xc9, 0x3d, 0x6d, 0x1f, 0x3e, 0x71, 0x94, 0x1d, 0x1d,
+	0x61, 0x47, 0x47, 0xda, 0xd1, 0xd1, 0x76, 0x74, 0x7a, 0x7e, 0x88, 0xb5, 0x26, 0x5a, 0xab, 0x84,
+	0x8f, 0x41, 0x53, 0x9b, 0xc0, 0x8b, 0x13, 0xfc, 0x95, 0x5c, 0xaa, 0x95, 0xa2, 0x86, 0x46, 0x7b,
+	0x12, 0x84, 0x7b, 0xa0, 0x3e, 0x26, 0x91, 0xa7, 0x5c, 0x21, 0xb7, 0x8a, 0xb6, 0xc6, 0x24, 0xfa,
+	0x24, 0xe2, 0xf6, 0x77, 0x03, 0x3c, 0x2c, 0x9f, 0x41, 0x5b, 0xf1, 0x05, 0xa8, 0x17, 0x57, 0x25,
+	0x5c, 0x58, 0xe9, 0x6c, 0x1f, 0xb6, 0x1d, 0x79, 0x57, 0xce, 0x3f, 0x1c, 0x8c, 0x56, 0x45, 0xf0,
+	0xb4, 0x64, 0xdc, 0xa7, 0xff, 0x1d, 0x57, 0xeb, 0xac, 0x95, 0x76, 0x3f, 0xff, 0x9a, 0x5b, 0xc6,
+	0xd5, 0xdc, 0x32, 0xfe, 0xcc, 0x2d, 0xe3, 0xdb, 0xc2, 0xda, 0xb8, 0x5a, 0x58, 0x1b, 0xbf, 0x17,
+	0xd6, 0xc6, 0x97, 0xe3, 0x90, 0xf0, 0x61, 0xda, 0x77, 0x06, 0x74, 0xec, 0xaa, 0x73, 0x18, 0xcd,
+	0xe4, 0xe5, 0x0e, 0xe8, 0x68, 0x09, 0x44, 0x34, 0xc0, 0xee, 0xe4, 0xc8, 0xbd, 0x5c, 0x22, 0xae,
+	0x9c, 0xa3, 0x5f, 0x95, 0xa9, 0x47, 0x7f, 0x07, 0x00, 0x79, 0xe7, 0x6f, 0x7c, 0x52, 0x04, 0x00,
 	0x00,
 }
 
@@ -437,6 +479,32 @@ func (m *QuerySWITCHProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinUnits) > 0 {
+		i -= len(m.MinUnits)
+		copy(dAtA[i:], m.MinUnits)
+		i = encodeVarintQuerySwitchProvider(dAtA, i, uint64(len(m.MinUnits)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintQuerySwitchProvider(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerySwitchProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -467,6 +535,18 @@ func (m *QuerySWITCHProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerySwitchProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Providers) > 0 {
 		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -561,6 +641,18 @@ func (m *QuerySWITCHProvidersRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuerySwitchProvider(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerySwitchProvider(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuerySwitchProvider(uint64(l))
+	}
+	l = len(m.MinUnits)
+	if l > 0 {
+		n += 1 + l + sovQuerySwitchProvider(uint64(l))
+	}
 	return n
 }
 
@@ -576,6 +668,10 @@ func (m *QuerySWITCHProvidersResponse) Size() (n int) {
 			n += 1 + l + sovQuerySwitchProvider(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerySwitchProvider(uint64(l))
+	}
 	return n
 }
 
@@ -1040,6 +1136,106 @@ func (m *QuerySWITCHProvidersRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwitchProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwitchProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwitchProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerySwitchProvider(dAtA[iNdEx:])
@@ -1124,6 +1320,42 @@ func (m *QuerySWITCHProvidersResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerySwitchProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerySwitchProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerySwitchProvider(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -157,8 +158,13 @@ func (m *QueryTradeAccountResponse) GetLastWithdrawHeight() int64 {
 }
 
 type QueryTradeAccountsRequest struct {
-	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset      string             `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Height     string             `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return trade accounts with an owner address starting with the prefix
+	AddressPrefix string `protobuf:"bytes,4,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// only return trade accounts with at least this many units
+	MinUnits string `protobuf:"bytes,5,opt,name=min_units,json=minUnits,proto3" json:"min_units,omitempty"`
 }
 
 func (m *QueryTradeAccountsRequest) Reset()         { *m = QueryTradeAccountsRequest{} }
@@ -208,8 +214,30 @@ func (m *QueryTradeAccountsRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QueryTradeAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryTradeAccountsRequest) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *QueryTradeAccountsRequest) GetMinUnits() string {
+	if m != nil {
+		return m.MinUnits
+	}
+	return ""
+}
+
 type QueryTradeAccountsResponse struct {
 	TradeAccounts []*QueryTradeAccountResponse `protobuf:"bytes,1,rep,name=trade_accounts,json=tradeAccounts,proto3" json:"trade_accounts,omitempty"`
+	Pagination    *query.PageResponse          `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryTradeAccountsResponse) Reset()         { *m = QueryTradeAccountsResponse{} }
@@ -252,6 +280,13 @@ func (m *QueryTradeAccountsResponse) GetTradeAccounts() []*QueryTradeAccountResp
 	return nil
 }
 
+func (m *QueryTradeAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryTradeAccountRequest)(nil), "types.QueryTradeAccountRequest")
 	proto.RegisterType((*QueryTradeAccountResponse)(nil), "types.QueryTradeAccountResponse")
@@ -262,31 +297,39 @@ func init() {
 func init() { proto.RegisterFile("types/query_trade_account.proto", fileDescriptor_c0892fac21581ae0) }
 
 var fileDescriptor_c0892fac21581ae0 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x4e, 0xf2, 0x40,
-	0x14, 0xc5, 0x29, 0x7c, 0xf0, 0x85, 0x31, 0x68, 0x32, 0x12, 0x53, 0x59, 0xb4, 0x84, 0x15, 0xab,
-	0x36, 0x91, 0xa5, 0x6e, 0x60, 0xe3, 0x9f, 0xb8, 0x71, 0x62, 0x34, 0x71, 0xd3, 0x0c, 0x9d, 0x49,
-	0xdb, 0x04, 0x3b, 0xd0, 0x99, 0x5a, 0x79, 0x02, 0xb7, 0x3e, 0x96, 0x4b, 0x96, 0xae, 0x88, 0x81,
-	0x1d, 0x4f, 0x61, 0x66, 0xa6, 0x45, 0x31, 0x65, 0x75, 0xb9, 0xbf, 0x7b, 0xee, 0xc9, 0x70, 0x6e,
-	0x81, 0x2d, 0xe6, 0x53, 0xca, 0xdd, 0x59, 0x4a, 0x93, 0xb9, 0x27, 0x12, 0x4c, 0xa8, 0x87, 0x7d,
-	0x9f, 0xa5, 0xb1, 0x70, 0xa6, 0x09, 0x13, 0x0c, 0xd6, 0x95, 0xa0, 0xd3, 0x0e, 0x58, 0xc0, 0x14,
-	0x71, 0xe5, 0x2f, 0x3d, 0xec, 0xdd, 0x02, 0xf3, 0x4e, 0x6e, 0xde, 0xcb, 0xc5, 0xa1, 0xde, 0x43,
-	0x74, 0x96, 0x52, 0x2e, 0xa0, 0x09, 0xfe, 0x63, 0x42, 0x12, 0xca, 0xb9, 0x69, 0x74, 0x8d, 0x7e,
-	0x13, 0x15, 0x2d, 0x3c, 0x01, 0x8d, 0x90, 0x46, 0x41, 0x28, 0xcc, 0xaa, 0x1a, 0xe4, 0x5d, 0xef,
-	0xad, 0x0a, 0x4e, 0x4b, 0xec, 0xf8, 0x94, 0xc5, 0x9c, 0x42, 0x1b, 0xd4, 0x31, 0xe7, 0x54, 0x68,
-	0xb7, 0x51, 0x73, 0xb3, 0xb4, 0x35, 0x40, 0xba, 0x48, 0x41, 0x1a, 0x47, 0x82, 0x6b, 0x57, 0x2d,
-	0x50, 0x00, 0xe9, 0x22, 0x05, 0x2c, 0x8b, 0x69, 0x62, 0xd6, 0x7e, 0x04, 0x0a, 0x20, 0x5d, 0xe0,
-	0x39, 0x38, 0x9a, 0x60, 0x2e, 0x3c, 0x4c, 0x88, 0x97, 0xbf, 0xf0, 0x5f, 0xd7, 0xe8, 0xd7, 0x46,
-	0xc7, 0x9b, 0xa5, 0xfd, 0x77, 0x84, 0x5a, 0x12, 0x0c, 0x09, 0xb9, 0x52, 0x2d, 0xbc, 0x01, 0x6d,
-	0xa5, 0xc8, 0x22, 0x11, 0x92, 0x04, 0x67, 0x85, 0x43, 0x5d, 0x39, 0x98, 0x9b, 0xa5, 0x5d, 0x3a,
-	0x47, 0x50, 0xd2, 0xc7, 0x1c, 0x6a, 0xaf, 0xde, 0x75, 0x49, 0x10, 0xbc, 0x08, 0xb6, 0xbd, 0x13,
-	0x44, 0xf1, 0xef, 0xf7, 0x85, 0x4a, 0x41, 0xa7, 0xcc, 0x2a, 0x0f, 0xf5, 0x12, 0x1c, 0xee, 0x1c,
-	0x5d, 0xde, 0xaa, 0xd6, 0x3f, 0x38, 0xeb, 0x3a, 0xea, 0xec, 0xce, 0xde, 0x73, 0xa0, 0x96, 0xf8,
-	0x6d, 0x38, 0x7a, 0xf8, 0x58, 0x59, 0xc6, 0x62, 0x65, 0x19, 0x5f, 0x2b, 0xcb, 0x78, 0x5f, 0x5b,
-	0x95, 0xc5, 0xda, 0xaa, 0x7c, 0xae, 0xad, 0xca, 0xd3, 0x45, 0x10, 0x89, 0x30, 0x1d, 0x3b, 0x3e,
-	0x7b, 0x76, 0x79, 0x16, 0x09, 0x3f, 0x9c, 0xcc, 0xd5, 0xd7, 0xe3, 0xb3, 0xc9, 0x16, 0xc4, 0x8c,
-	0x50, 0xf7, 0x65, 0xe0, 0xbe, 0x6e, 0x89, 0xab, 0x1e, 0x30, 0x6e, 0x28, 0xe9, 0xe0, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0x21, 0x61, 0x9e, 0x38, 0xa8, 0x02, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x93, 0x2f, 0xfd, 0xc8, 0x54, 0x29, 0xd2, 0x10, 0x21, 0x13, 0x24, 0x3b, 0x8a, 0x04,
+	0x44, 0x2c, 0x6c, 0xb5, 0x5d, 0xc2, 0xa6, 0x59, 0x50, 0x84, 0x58, 0x94, 0x11, 0x3f, 0x12, 0x1b,
+	0x6b, 0x62, 0x0f, 0xf6, 0x48, 0x89, 0xc7, 0xf5, 0x8c, 0x9b, 0xe6, 0x09, 0xd8, 0xf2, 0x24, 0x3c,
+	0x07, 0xcb, 0x6e, 0x90, 0x58, 0x45, 0x28, 0xd9, 0xe5, 0x29, 0xd0, 0xdc, 0x99, 0x34, 0x09, 0x4a,
+	0xc5, 0x6a, 0x72, 0xcf, 0x3d, 0x73, 0x26, 0xe7, 0x9e, 0x6b, 0xe4, 0xab, 0x59, 0xc1, 0x64, 0x78,
+	0x59, 0xb1, 0x72, 0x16, 0xa9, 0x92, 0x26, 0x2c, 0xa2, 0x71, 0x2c, 0xaa, 0x5c, 0x05, 0x45, 0x29,
+	0x94, 0xc0, 0x4d, 0x20, 0x74, 0x3b, 0xa9, 0x48, 0x05, 0x20, 0xa1, 0xfe, 0x65, 0x9a, 0xdd, 0xe7,
+	0xb1, 0x90, 0x13, 0x21, 0xc3, 0x11, 0x95, 0xcc, 0x68, 0x84, 0x57, 0xc7, 0x23, 0xa6, 0xe8, 0x71,
+	0x58, 0xd0, 0x94, 0xe7, 0x54, 0x71, 0x91, 0x1b, 0x6e, 0xff, 0x2d, 0x72, 0xdf, 0x69, 0xc6, 0x7b,
+	0xfd, 0xc8, 0x99, 0x79, 0x83, 0xb0, 0xcb, 0x8a, 0x49, 0x85, 0x5d, 0xf4, 0x3f, 0x4d, 0x92, 0x92,
+	0x49, 0xe9, 0x3a, 0x3d, 0x67, 0xd0, 0x22, 0xeb, 0x12, 0x3f, 0x44, 0x07, 0x19, 0xe3, 0x69, 0xa6,
+	0xdc, 0x3a, 0x34, 0x6c, 0xd5, 0xff, 0x5a, 0x47, 0x8f, 0xf6, 0xc8, 0xc9, 0x42, 0xe4, 0x92, 0x61,
+	0x1f, 0x35, 0xa9, 0x94, 0x4c, 0x19, 0xb5, 0x61, 0x6b, 0x35, 0xf7, 0x0d, 0x40, 0xcc, 0xa1, 0x09,
+	0x55, 0xce, 0x95, 0x74, 0xeb, 0x1b, 0x02, 0x00, 0xc4, 0x1c, 0x9a, 0x20, 0xa6, 0x39, 0x2b, 0xdd,
+	0xc6, 0x86, 0x00, 0x00, 0x31, 0x07, 0x7e, 0x81, 0xee, 0x8f, 0xa9, 0x54, 0x11, 0x4d, 0x92, 0xc8,
+	0xfe, 0xc3, 0xff, 0x7a, 0xce, 0xa0, 0x31, 0x7c, 0xb0, 0x9a, 0xfb, 0x7f, 0xb7, 0x48, 0x5b, 0x03,
+	0x67, 0x49, 0xf2, 0x1a, 0x4a, 0xfc, 0x06, 0x75, 0x80, 0x31, 0xe5, 0x2a, 0x4b, 0x4a, 0x3a, 0x5d,
+	0x2b, 0x34, 0x41, 0xc1, 0x5d, 0xcd, 0xfd, 0xbd, 0x7d, 0x82, 0x35, 0xfa, 0xc9, 0x82, 0x46, 0xab,
+	0xff, 0xd3, 0xd9, 0x33, 0x09, 0xb9, 0x9e, 0x6c, 0x67, 0x67, 0x12, 0x6b, 0xfb, 0x77, 0x4c, 0x15,
+	0xbf, 0x42, 0x68, 0x93, 0x1b, 0x58, 0x3f, 0x3c, 0x79, 0x1a, 0x98, 0x90, 0x03, 0x1d, 0x72, 0x00,
+	0x21, 0x07, 0x36, 0xe4, 0xe0, 0x82, 0xa6, 0xcc, 0xbe, 0x44, 0xb6, 0x6e, 0xe2, 0x27, 0xe8, 0xc8,
+	0x06, 0x18, 0x15, 0x25, 0xfb, 0xc2, 0xaf, 0x61, 0x36, 0x2d, 0xd2, 0xb6, 0xe8, 0x05, 0x80, 0xf8,
+	0x31, 0x6a, 0x4d, 0x78, 0x1e, 0x99, 0x24, 0x9a, 0xc0, 0xb8, 0x37, 0xe1, 0xf9, 0x07, 0x5d, 0xf7,
+	0xbf, 0x3b, 0xa8, 0xbb, 0xcf, 0x97, 0x8d, 0xf8, 0x1c, 0x1d, 0xed, 0xac, 0xab, 0xde, 0x9c, 0xc6,
+	0xe0, 0xf0, 0xa4, 0x17, 0xc0, 0xc2, 0x06, 0x77, 0x2e, 0x07, 0x69, 0xab, 0x6d, 0x41, 0x7c, 0xbe,
+	0xe3, 0xb9, 0x0e, 0x9e, 0x9f, 0xfd, 0xd3, 0xb3, 0xd5, 0xda, 0xba, 0x3a, 0xfc, 0xf8, 0x63, 0xe1,
+	0x39, 0x37, 0x0b, 0xcf, 0xf9, 0xbd, 0xf0, 0x9c, 0x6f, 0x4b, 0xaf, 0x76, 0xb3, 0xf4, 0x6a, 0xbf,
+	0x96, 0x5e, 0xed, 0xf3, 0xcb, 0x94, 0xab, 0xac, 0x1a, 0x05, 0xb1, 0x98, 0x84, 0x72, 0xca, 0x55,
+	0x9c, 0x8d, 0x67, 0xf0, 0x51, 0xc4, 0x62, 0x7c, 0x0b, 0xe4, 0x22, 0x61, 0xe1, 0xd5, 0x69, 0x78,
+	0x7d, 0x8b, 0x84, 0xe0, 0x64, 0x74, 0x00, 0xd4, 0xd3, 0x3f, 0x03, 0x00, 0x46, 0xc6, 0x58, 0x93,
+	0xab, 0x03, 0x00, 0x00,
 }
 
 func (m *QueryTradeAccountRequest) Marshal() (dAtA []byte, err error) {
@@ -400,6 +443,32 @@ func (m *QueryTradeAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinUnits) > 0 {
+		i -= len(m.MinUnits)
+		copy(dAtA[i:], m.MinUnits)
+		i = encodeVarintQueryTradeAccount(dAtA, i, uint64(len(m.MinUnits)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddressPrefix) > 0 {
+		i -= len(m.AddressPrefix)
+		copy(dAtA[i:], m.AddressPrefix)
+		i = encodeVarintQueryTradeAccount(dAtA, i, uint64(len(m.AddressPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryTradeAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -437,6 +506,18 @@ func (m *QueryTradeAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryTradeAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.TradeAccounts) > 0 {
 		for iNdEx := len(m.TradeAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -523,6 +604,18 @@ func (m *QueryTradeAccountsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryTradeAccount(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryTradeAccount(uint64(l))
+	}
+	l = len(m.AddressPrefix)
+	if l > 0 {
+		n += 1 + l + sovQueryTradeAccount(uint64(l))
+	}
+	l = len(m.MinUnits)
+	if l > 0 {
+		n += 1 + l + sovQueryTradeAccount(uint64(l))
+	}
 	return n
 }
 
@@ -538,6 +631,10 @@ func (m *QueryTradeAccountsResponse) Size() (n int) {
 			n += 1 + l + sovQueryTradeAccount(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryTradeAccount(uint64(l))
+	}
 	return n
 }
 
@@ -938,6 +1035,106 @@ func (m *QueryTradeAccountsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryTradeAccount(dAtA[iNdEx:])
@@ -1022,6 +1219,42 @@ func (m *QueryTradeAccountsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryTradeAccount(dAtA[iNdEx:])
@@ -96,6 +96,8 @@ type QueryVaultResponse struct {
 	// the EdDSA (ed25519) group key for chains that use ed25519 accounts (e.g. Stellar); empty for
 	// ECDSA-only vaults.
 	Ed25519PubKey string `protobuf:"bytes,15,opt,name=ed25519_pub_key,json=ed25519PubKey,proto3" json:"ed25519_pub_key,omitempty"`
+	// number of resharing churns that handed the vault key to a new membership
+	MembershipEpoch int64 `protobuf:"varint,16,opt,name=membership_epoch,json=membershipEpoch,proto3" json:"membership_epoch,omitempty"`
 }
 
 func (m *QueryVaultResponse) Reset()         { *m = QueryVaultResponse{} }
@@ -236,6 +238,13 @@ func (m *QueryVaultResponse) GetEd25519PubKey() string {
 	return ""
 }
 
+func (m *QueryVaultResponse) GetMembershipEpoch() int64 {
+	if m != nil {
+		return m.MembershipEpoch
+	}
+	return 0
+}
+
 type QueryAsgardVaultsRequest struct {
 	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
 }
@@ -591,53 +600,54 @@ func init() {
 func init() { proto.RegisterFile("types/query_vault.proto", fileDescriptor_941f4868303210e7) }
 
 var fileDescriptor_941f4868303210e7 = []byte{
-	// 731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x52, 0x13, 0x4b,
-	0x14, 0xce, 0x24, 0x24, 0x21, 0x27, 0x09, 0x3f, 0x0d, 0xf7, 0xd2, 0xb0, 0xc8, 0xe4, 0x4e, 0x5d,
-	0xad, 0x94, 0x8b, 0xa4, 0x04, 0x29, 0x45, 0x2d, 0x4b, 0x82, 0x0b, 0x29, 0x37, 0x38, 0x52, 0x2c,
-	0x74, 0x91, 0x9a, 0x99, 0x34, 0xc9, 0x14, 0xc9, 0x74, 0x98, 0xee, 0xc1, 0xc4, 0x87, 0xb0, 0x7c,
-	0x02, 0x1f, 0xc0, 0x27, 0x61, 0xc9, 0xd2, 0x55, 0xb4, 0x60, 0x97, 0xa7, 0xb0, 0xfa, 0x67, 0x92,
-	0x01, 0xb1, 0x2c, 0x56, 0x7d, 0xfa, 0x3b, 0xdf, 0x39, 0x7d, 0xce, 0xe9, 0xaf, 0x1b, 0xd6, 0xf8,
-	0x68, 0x40, 0x58, 0xe3, 0x34, 0x22, 0xe1, 0xa8, 0x75, 0xe6, 0x44, 0x3d, 0x5e, 0x1f, 0x84, 0x94,
-	0x53, 0x94, 0x95, 0x8e, 0x8d, 0xd5, 0x0e, 0xed, 0x50, 0x89, 0x34, 0x84, 0xa5, 0x9c, 0x1b, 0x2b,
-	0x1e, 0xed, 0xf7, 0x69, 0xd0, 0x50, 0x8b, 0x02, 0xad, 0x57, 0xb0, 0xfc, 0x56, 0xa4, 0x39, 0x12,
-	0x59, 0x6c, 0x72, 0x1a, 0x11, 0xc6, 0xd1, 0x1a, 0xe4, 0x07, 0x91, 0xdb, 0x3a, 0x21, 0x23, 0x6c,
-	0x54, 0x8d, 0x5a, 0xc1, 0xce, 0x0d, 0x22, 0xf7, 0x0d, 0x19, 0xa1, 0x7f, 0x21, 0xd7, 0x25, 0x7e,
-	0xa7, 0xcb, 0x71, 0x5a, 0xe1, 0x6a, 0x67, 0x7d, 0xcd, 0x02, 0x4a, 0xa6, 0x61, 0x03, 0x1a, 0x30,
-	0x82, 0xfe, 0x83, 0x92, 0xdb, 0xa3, 0xde, 0x49, 0x4b, 0x07, 0x89, 0x64, 0x19, 0xbb, 0x28, 0xb1,
-	0xd7, 0x12, 0x4a, 0x1e, 0x95, 0xbe, 0x76, 0x54, 0x1f, 0xb2, 0x1e, 0xf5, 0x03, 0x86, 0x33, 0xd5,
-	0x4c, 0xad, 0xb8, 0x59, 0xaa, 0xeb, 0xb2, 0xf7, 0xa8, 0x1f, 0x34, 0xf7, 0xcf, 0xc7, 0x66, 0x6a,
-	0x32, 0x36, 0x15, 0xe5, 0xdb, 0x0f, 0xf3, 0x49, 0xc7, 0xe7, 0xdd, 0xc8, 0x15, 0xa4, 0x06, 0xfb,
-	0xe8, 0x73, 0xaf, 0xdb, 0x1b, 0xc9, 0x06, 0x3d, 0xda, 0x9b, 0x02, 0x01, 0x6d, 0x93, 0xc6, 0xd9,
-	0x56, 0x23, 0x91, 0x89, 0xd9, 0x2a, 0x05, 0x42, 0x30, 0x27, 0x66, 0x87, 0xe7, 0x64, 0x11, 0xd2,
-	0x46, 0x16, 0xe4, 0x18, 0x77, 0x78, 0xc4, 0x70, 0x56, 0xa0, 0x4d, 0x98, 0x8c, 0x4d, 0x8d, 0xd8,
-	0x7a, 0x15, 0x2d, 0x2a, 0xab, 0xc5, 0xfc, 0xc0, 0x23, 0x38, 0xa7, 0x5a, 0x54, 0xd8, 0x3b, 0x01,
-	0xa1, 0x0a, 0x40, 0x9f, 0xf4, 0x5d, 0x12, 0xb2, 0xae, 0x3f, 0xc0, 0xf9, 0x6a, 0xa6, 0x56, 0xb0,
-	0x13, 0x88, 0x18, 0xaa, 0xd7, 0x75, 0x44, 0xab, 0xf3, 0xd2, 0xa7, 0x77, 0xa8, 0x06, 0x4b, 0x7e,
-	0xe0, 0xd2, 0x28, 0x68, 0xb7, 0xf8, 0xb0, 0xe5, 0xd1, 0x28, 0xe0, 0xb8, 0x20, 0xd3, 0x2f, 0x68,
-	0xfc, 0x70, 0xb8, 0x27, 0x50, 0xf4, 0x00, 0x96, 0x69, 0xc4, 0x6f, 0x50, 0x41, 0x52, 0x17, 0x63,
-	0x47, 0xcc, 0x7d, 0x0c, 0x78, 0x40, 0x82, 0xb6, 0x1f, 0x74, 0x04, 0x35, 0x79, 0x3d, 0x0c, 0x17,
-	0xab, 0x99, 0x5a, 0xc6, 0xfe, 0x47, 0xfb, 0x0f, 0x87, 0xcd, 0xd9, 0x45, 0x31, 0xb4, 0x03, 0xf9,
-	0x90, 0x46, 0x9c, 0x84, 0x0c, 0x97, 0xe4, 0x95, 0xa0, 0xba, 0x54, 0x5b, 0x5d, 0xdd, 0xb9, 0x74,
-	0x35, 0x8b, 0x93, 0xb1, 0x19, 0xd3, 0xec, 0xd8, 0x40, 0x2f, 0xa1, 0xe0, 0xb4, 0xdb, 0x21, 0x61,
-	0x8c, 0x30, 0x5c, 0x96, 0xc1, 0x2b, 0xc9, 0xe0, 0x5d, 0xe5, 0x6c, 0x96, 0x27, 0x63, 0x73, 0xc6,
-	0xb4, 0x67, 0xa6, 0x98, 0xd1, 0x71, 0x48, 0x3f, 0x91, 0x00, 0x2f, 0xa8, 0x19, 0xa9, 0x1d, 0xba,
-	0x0f, 0x8b, 0xa4, 0xbd, 0xb9, 0xbd, 0xfd, 0x70, 0xa7, 0x15, 0xcb, 0x68, 0x51, 0xde, 0x60, 0x59,
-	0xc3, 0x07, 0x52, 0x4d, 0xd6, 0x26, 0x60, 0xa9, 0xcf, 0x5d, 0xd6, 0x71, 0xc2, 0xb6, 0x3c, 0x94,
-	0xc5, 0x6a, 0x9f, 0x89, 0xda, 0xb8, 0x26, 0xea, 0x0f, 0xb0, 0x7e, 0x4b, 0x8c, 0x96, 0xf6, 0x0b,
-	0x28, 0x3b, 0x12, 0x57, 0xef, 0x8f, 0x61, 0x43, 0xb6, 0xb5, 0xae, 0xdb, 0xfa, 0xfd, 0x31, 0xd8,
-	0x25, 0x27, 0x91, 0xc7, 0xda, 0xd2, 0xc9, 0xd5, 0xf6, 0x20, 0x72, 0x4f, 0xc8, 0xe8, 0xaf, 0x15,
-	0x7d, 0x36, 0x60, 0xe3, 0xb6, 0x28, 0x5d, 0xd3, 0x23, 0xc8, 0xa9, 0x33, 0x74, 0x31, 0x4b, 0xc9,
-	0x19, 0xef, 0x07, 0xc7, 0x54, 0x29, 0x58, 0x71, 0x6c, 0xbd, 0xa2, 0xa7, 0x30, 0xef, 0x07, 0x8e,
-	0xc7, 0xfd, 0x33, 0x82, 0xd3, 0x7f, 0x88, 0x2b, 0x4d, 0xc6, 0xe6, 0x94, 0x65, 0x4f, 0x2d, 0xab,
-	0x07, 0x85, 0x29, 0x09, 0xfd, 0x7f, 0xe3, 0xd7, 0x50, 0x62, 0xd0, 0xd0, 0xf4, 0x5d, 0x27, 0x64,
-	0x94, 0xbe, 0x9b, 0x8c, 0xac, 0x67, 0x50, 0x4c, 0x90, 0xd0, 0x2a, 0x64, 0xe5, 0x4b, 0xd1, 0x43,
-	0x52, 0x1b, 0x31, 0x3b, 0xc5, 0x8f, 0xff, 0x13, 0xb5, 0xb3, 0x8e, 0xa0, 0x94, 0xd4, 0x1a, 0x32,
-	0xaf, 0x45, 0x37, 0x0b, 0xf2, 0x37, 0x11, 0x40, 0x9c, 0xe8, 0x1e, 0xe4, 0xb5, 0xfe, 0x54, 0x26,
-	0x55, 0x94, 0x86, 0xec, 0xd8, 0x68, 0x1e, 0x9d, 0x5f, 0x56, 0x8c, 0x8b, 0xcb, 0x8a, 0xf1, 0xf3,
-	0xb2, 0x62, 0x7c, 0xb9, 0xaa, 0xa4, 0x2e, 0xae, 0x2a, 0xa9, 0xef, 0x57, 0x95, 0xd4, 0xfb, 0xe7,
-	0x77, 0xf9, 0x93, 0x86, 0x53, 0xa4, 0x21, 0xc7, 0xe1, 0xe6, 0x24, 0x75, 0xeb, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x34, 0x38, 0xec, 0x8a, 0xec, 0x05, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0x13, 0x92, 0x90, 0x97, 0x04, 0xc2, 0xc0, 0x2e, 0x03, 0x87, 0x38, 0x6b, 0xed, 0xae,
+	0xb2, 0x7b, 0x48, 0xb4, 0xb0, 0x68, 0x97, 0xb6, 0xaa, 0x4a, 0x68, 0xa5, 0xa2, 0x5e, 0xa8, 0x8b,
+	0x38, 0xb4, 0x07, 0xcb, 0x76, 0x86, 0xc4, 0x22, 0xf1, 0x18, 0x8f, 0x4d, 0x93, 0x7e, 0x88, 0xaa,
+	0x9f, 0xa3, 0x9f, 0x84, 0xde, 0x38, 0xf6, 0x94, 0x56, 0x70, 0xcb, 0xa7, 0xa8, 0xe6, 0x8f, 0x13,
+	0x43, 0xa9, 0x2a, 0x4e, 0x7e, 0xef, 0xf7, 0x7e, 0xef, 0xf9, 0xcd, 0x7b, 0xbf, 0x19, 0x58, 0x8f,
+	0xc6, 0x01, 0x61, 0xed, 0xb3, 0x98, 0x84, 0x63, 0xeb, 0xdc, 0x8e, 0x07, 0x51, 0x2b, 0x08, 0x69,
+	0x44, 0x51, 0x5e, 0x04, 0x36, 0xd7, 0x7a, 0xb4, 0x47, 0x05, 0xd2, 0xe6, 0x96, 0x0c, 0x6e, 0xae,
+	0xba, 0x74, 0x38, 0xa4, 0x7e, 0x5b, 0x7e, 0x24, 0x68, 0x3c, 0x85, 0x95, 0x97, 0xbc, 0xcc, 0x31,
+	0xaf, 0x62, 0x92, 0xb3, 0x98, 0xb0, 0x08, 0xad, 0x43, 0x31, 0x88, 0x1d, 0xeb, 0x94, 0x8c, 0xb1,
+	0xd6, 0xd0, 0x9a, 0x25, 0xb3, 0x10, 0xc4, 0xce, 0x0b, 0x32, 0x46, 0xbf, 0x42, 0xa1, 0x4f, 0xbc,
+	0x5e, 0x3f, 0xc2, 0x59, 0x89, 0x4b, 0xcf, 0xf8, 0x94, 0x07, 0x94, 0x2e, 0xc3, 0x02, 0xea, 0x33,
+	0x82, 0x7e, 0x83, 0x8a, 0x33, 0xa0, 0xee, 0xa9, 0xa5, 0x92, 0x78, 0xb1, 0x9c, 0x59, 0x16, 0xd8,
+	0x73, 0x01, 0xa5, 0x7f, 0x95, 0xbd, 0xf1, 0xab, 0x21, 0xe4, 0x5d, 0xea, 0xf9, 0x0c, 0xe7, 0x1a,
+	0xb9, 0x66, 0x79, 0xab, 0xd2, 0x52, 0x6d, 0xef, 0x53, 0xcf, 0xef, 0x1c, 0x5c, 0x4c, 0xf4, 0xcc,
+	0x74, 0xa2, 0x4b, 0xca, 0xc7, 0x2f, 0xfa, 0xff, 0x3d, 0x2f, 0xea, 0xc7, 0x0e, 0x27, 0xb5, 0xd9,
+	0x5b, 0x2f, 0x72, 0xfb, 0x83, 0xb1, 0x38, 0xa0, 0x4b, 0x07, 0x33, 0xc0, 0xa7, 0x5d, 0xd2, 0x3e,
+	0xdf, 0x6e, 0xa7, 0x2a, 0x31, 0x53, 0x96, 0x40, 0x08, 0x16, 0xf8, 0xec, 0xf0, 0x82, 0x68, 0x42,
+	0xd8, 0xc8, 0x80, 0x02, 0x8b, 0xec, 0x28, 0x66, 0x38, 0xcf, 0xd1, 0x0e, 0x4c, 0x27, 0xba, 0x42,
+	0x4c, 0xf5, 0xe5, 0x47, 0x94, 0x96, 0xc5, 0x3c, 0xdf, 0x25, 0xb8, 0x20, 0x8f, 0x28, 0xb1, 0x57,
+	0x1c, 0x42, 0x75, 0x80, 0x21, 0x19, 0x3a, 0x24, 0x64, 0x7d, 0x2f, 0xc0, 0xc5, 0x46, 0xae, 0x59,
+	0x32, 0x53, 0x08, 0x1f, 0xaa, 0xdb, 0xb7, 0xf9, 0x51, 0x17, 0x45, 0x4c, 0x79, 0xa8, 0x09, 0x35,
+	0xcf, 0x77, 0x68, 0xec, 0x77, 0xad, 0x68, 0x64, 0xb9, 0x34, 0xf6, 0x23, 0x5c, 0x12, 0xe5, 0x97,
+	0x14, 0x7e, 0x34, 0xda, 0xe7, 0x28, 0xfa, 0x1b, 0x56, 0x68, 0x1c, 0xdd, 0xa2, 0x82, 0xa0, 0x2e,
+	0x27, 0x81, 0x84, 0xfb, 0x1f, 0xe0, 0x80, 0xf8, 0x5d, 0xcf, 0xef, 0x71, 0x6a, 0x7a, 0x3d, 0x0c,
+	0x97, 0x1b, 0xb9, 0x66, 0xce, 0xfc, 0x45, 0xc5, 0x8f, 0x46, 0x9d, 0xf9, 0xa2, 0x18, 0xda, 0x85,
+	0x62, 0x48, 0xe3, 0x88, 0x84, 0x0c, 0x57, 0xc4, 0x4a, 0x50, 0x4b, 0xa8, 0xad, 0x25, 0x77, 0x2e,
+	0x42, 0x9d, 0xf2, 0x74, 0xa2, 0x27, 0x34, 0x33, 0x31, 0xd0, 0x13, 0x28, 0xd9, 0xdd, 0x6e, 0x48,
+	0x18, 0x23, 0x0c, 0x57, 0x45, 0xf2, 0x6a, 0x3a, 0x79, 0x4f, 0x06, 0x3b, 0xd5, 0xe9, 0x44, 0x9f,
+	0x33, 0xcd, 0xb9, 0xc9, 0x67, 0x74, 0x12, 0xd2, 0x77, 0xc4, 0xc7, 0x4b, 0x72, 0x46, 0xd2, 0x43,
+	0x7f, 0xc2, 0x32, 0xe9, 0x6e, 0xed, 0xec, 0xfc, 0xb3, 0x6b, 0x25, 0x32, 0x5a, 0x16, 0x1b, 0xac,
+	0x2a, 0xf8, 0x50, 0xaa, 0xe9, 0x2f, 0xa8, 0xcd, 0x27, 0x6e, 0x91, 0x80, 0xba, 0x7d, 0x5c, 0x93,
+	0x03, 0x9a, 0xe3, 0xcf, 0x38, 0x6c, 0x6c, 0x01, 0x16, 0x52, 0xde, 0x63, 0x3d, 0x3b, 0xec, 0x8a,
+	0xfe, 0x58, 0x72, 0x31, 0xe6, 0xfa, 0xd7, 0x6e, 0xe8, 0xff, 0x0d, 0x6c, 0xdc, 0x91, 0xa3, 0x6e,
+	0xc1, 0x63, 0xa8, 0xda, 0x02, 0x97, 0x57, 0x95, 0x61, 0x4d, 0x4c, 0x60, 0x43, 0x4d, 0xe0, 0xfb,
+	0x7b, 0x63, 0x56, 0xec, 0x54, 0x1d, 0x63, 0x5b, 0x15, 0x97, 0xee, 0x61, 0xec, 0x9c, 0x92, 0xf1,
+	0x4f, 0x3b, 0x7a, 0xaf, 0xc1, 0xe6, 0x5d, 0x59, 0xaa, 0xa7, 0x7f, 0xa1, 0x20, 0xff, 0xa1, 0x9a,
+	0xa9, 0xa5, 0xd7, 0x71, 0xe0, 0x9f, 0x50, 0x29, 0x76, 0xc9, 0x31, 0xd5, 0x17, 0x3d, 0x80, 0x45,
+	0xcf, 0xb7, 0xdd, 0xc8, 0x3b, 0x27, 0x38, 0xfb, 0x83, 0xbc, 0xca, 0x74, 0xa2, 0xcf, 0x58, 0xe6,
+	0xcc, 0x32, 0x06, 0x50, 0x9a, 0x91, 0xd0, 0xef, 0xb7, 0x1e, 0x18, 0xa9, 0x1b, 0x05, 0xcd, 0x9e,
+	0x80, 0x94, 0xe2, 0xb2, 0xf7, 0x53, 0x9c, 0xf1, 0x10, 0xca, 0x29, 0x12, 0x5a, 0x83, 0xbc, 0xb8,
+	0x54, 0x6a, 0x48, 0xd2, 0xe1, 0xb3, 0x93, 0xfc, 0xe4, 0xe9, 0x91, 0x9e, 0x71, 0x0c, 0x95, 0xb4,
+	0x2c, 0x91, 0x7e, 0x23, 0xbb, 0x53, 0x12, 0x0f, 0x0f, 0x07, 0x92, 0x42, 0x7f, 0x40, 0x51, 0x49,
+	0x55, 0x56, 0x92, 0x4d, 0x29, 0xc8, 0x4c, 0x8c, 0xce, 0xf1, 0xc5, 0x55, 0x5d, 0xbb, 0xbc, 0xaa,
+	0x6b, 0x5f, 0xaf, 0xea, 0xda, 0x87, 0xeb, 0x7a, 0xe6, 0xf2, 0xba, 0x9e, 0xf9, 0x7c, 0x5d, 0xcf,
+	0xbc, 0x7e, 0x74, 0x9f, 0xe7, 0x6b, 0x34, 0x43, 0xda, 0x62, 0x1c, 0x4e, 0x41, 0x50, 0xb7, 0xbf,
+	0x0d, 0x00, 0x98, 0x92, 0xdc, 0x80, 0x17, 0x06, 0x00, 0x00,
 }
 
 func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
@@ -697,6 +707,13 @@ func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MembershipEpoch != 0 {
+		i = encodeVarintQueryVault(dAtA, i, uint64(m.MembershipEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.Ed25519PubKey) > 0 {
 		i -= len(m.Ed25519PubKey)
 		copy(dAtA[i:], m.Ed25519PubKey)
@@ -1207,6 +1224,9 @@ func (m *QueryVaultResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryVault(uint64(l))
 	}
+	if m.MembershipEpoch != 0 {
+		n += 2 + sovQueryVault(uint64(m.MembershipEpoch))
+	}
 	return n
 }
 
@@ -1952,6 +1972,25 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Ed25519PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipEpoch", wireType)
+			}
+			m.MembershipEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MembershipEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryVault(dAtA[iNdEx:])
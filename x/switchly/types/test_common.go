@@ -2,6 +2,7 @@
 package types
 
 import (
+	"crypto/ed25519"
 	"math/rand"
 	"os"
 	"path"
@@ -203,6 +204,14 @@ func GetRandomVault() Vault {
 	return NewVault(32, VaultStatus_ActiveVault, VaultType_AsgardVault, GetRandomPubKey(), common.Chains{common.ETHChain, common.DOGEChain, common.BTCChain}.Strings(), []ChainContract{})
 }
 
+// GetRandomEd25519PubKey return a random ed25519 common.PubKey, as reported for an
+// EdDSA keygen, for test purpose
+func GetRandomEd25519PubKey() common.PubKey {
+	pub, _, _ := ed25519.GenerateKey(nil)
+	pk, _ := common.NewPubKeyFromEd25519(pub)
+	return pk
+}
+
 func GetRandomPubKey() common.PubKey {
 	r := rand.New(rand.NewSource(time.Now().UnixNano())) // #nosec G404
 	accts := simtypes.RandomAccounts(r, 1)
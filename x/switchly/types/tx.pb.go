@@ -73,61 +73,64 @@ func init() {
 func init() { proto.RegisterFile("types/tx.proto", fileDescriptor_991dda0e66fd389c) }
 
 var fileDescriptor_991dda0e66fd389c = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xc7, 0x13, 0x55, 0x81, 0x30, 0x6d, 0x13, 0x98, 0xa6, 0x4d, 0x31, 0xc2, 0x94, 0x8a, 0x8f,
-	0x86, 0x8f, 0x35, 0x49, 0x84, 0x10, 0x2a, 0x12, 0x4a, 0x96, 0x54, 0x8a, 0xaa, 0x6d, 0x96, 0x7a,
-	0x1b, 0x09, 0x84, 0x64, 0xcd, 0xda, 0xa7, 0x5e, 0x53, 0xdb, 0xe3, 0xce, 0x8c, 0x9d, 0xdd, 0x3b,
-	0x1e, 0x81, 0x47, 0xe1, 0x31, 0xb8, 0xec, 0x25, 0x97, 0x90, 0x5c, 0xf0, 0x1a, 0xc8, 0x9f, 0x33,
-	0xbb, 0x33, 0x65, 0xf7, 0x66, 0x57, 0xfe, 0xff, 0xfe, 0xff, 0x33, 0x73, 0x76, 0xce, 0x58, 0x8b,
-	0xb6, 0xc4, 0x2c, 0x03, 0xee, 0x88, 0x69, 0x2f, 0x63, 0x54, 0x50, 0xbc, 0x51, 0x3d, 0x5b, 0xbb,
-	0x3e, 0xe5, 0x09, 0xe5, 0x4e, 0xc2, 0x43, 0xa7, 0xd8, 0x2f, 0xbf, 0x6a, 0x6e, 0xdd, 0xaa, 0xfd,
-	0x09, 0x0f, 0xbd, 0x31, 0x49, 0x1b, 0x71, 0x57, 0x8a, 0x01, 0x64, 0x94, 0x47, 0xa2, 0x01, 0x77,
-	0x24, 0x00, 0xc6, 0x88, 0x20, 0x8d, 0x7e, 0x5b, 0xea, 0x49, 0x94, 0x44, 0xac, 0x91, 0xdf, 0x93,
-	0x72, 0x0a, 0xe2, 0x82, 0xb2, 0x17, 0xde, 0x73, 0x80, 0x06, 0xde, 0x53, 0x20, 0x0d, 0xc0, 0xcb,
-	0x48, 0xce, 0xc1, 0xf3, 0x27, 0x24, 0x6a, 0xb7, 0xf1, 0xbe, 0x74, 0xd0, 0x31, 0x07, 0x56, 0x40,
-	0xe0, 0x89, 0x69, 0x87, 0x6d, 0x23, 0xa6, 0x79, 0xbb, 0xd9, 0xfb, 0x46, 0xee, 0xbd, 0xcc, 0x29,
-	0xcb, 0x93, 0xc6, 0xb3, 0x23, 0x3d, 0x1c, 0xd2, 0x40, 0xaf, 0xcc, 0x41, 0x78, 0x51, 0xe6, 0x91,
-	0x20, 0x60, 0xc0, 0xb9, 0xbe, 0xb1, 0x92, 0x57, 0xdb, 0x7f, 0x01, 0xb3, 0x16, 0xdf, 0x55, 0x30,
-	0x8d, 0x0b, 0x48, 0xfd, 0x99, 0xde, 0xb3, 0xe0, 0xbc, 0xca, 0x44, 0x61, 0xea, 0x3d, 0x27, 0x51,
-	0xac, 0x67, 0x4b, 0x47, 0x46, 0x69, 0xac, 0x1f, 0x4a, 0x01, 0x8c, 0x47, 0xd4, 0x70, 0x5a, 0x79,
-	0x16, 0x32, 0x12, 0x80, 0xe1, 0x54, 0x48, 0x94, 0xb6, 0xbf, 0xcb, 0xbb, 0xe5, 0x2c, 0x5c, 0x10,
-	0x9e, 0x38, 0xd5, 0x47, 0xb1, 0xdf, 0x4d, 0xcb, 0x7d, 0x84, 0x36, 0x07, 0x3c, 0x3c, 0x49, 0x32,
-	0x31, 0x3b, 0xf8, 0x67, 0x1b, 0x5d, 0x1b, 0xf0, 0x10, 0x7f, 0x8c, 0xae, 0x1d, 0x93, 0x14, 0xdf,
-	0xec, 0x55, 0xd5, 0x7a, 0x03, 0x1e, 0x1e, 0x93, 0xd4, 0xda, 0x96, 0x8f, 0x95, 0x1d, 0x7f, 0x89,
-	0xde, 0xfc, 0xa1, 0x9e, 0x15, 0xfc, 0x8e, 0x64, 0x8d, 0xa4, 0xdb, 0x1d, 0xb4, 0x79, 0x52, 0x4d,
-	0xd0, 0x68, 0x8a, 0xb1, 0x02, 0x1b, 0x4d, 0x0f, 0x3c, 0x44, 0x5b, 0x2d, 0xfc, 0xb1, 0x3a, 0x41,
-	0x7c, 0x57, 0x8f, 0xd5, 0x44, 0x0f, 0xef, 0xa1, 0x8d, 0x41, 0x39, 0x97, 0x58, 0x21, 0x95, 0xa0,
-	0x5b, 0x0f, 0x11, 0x7a, 0x52, 0xcf, 0xea, 0x23, 0x00, 0xbc, 0x23, 0xb1, 0x54, 0xf5, 0xd0, 0xf7,
-	0xe8, 0x6d, 0x89, 0x9b, 0xed, 0x59, 0xa6, 0xe8, 0xeb, 0x36, 0xf8, 0x10, 0x6d, 0x3d, 0xa1, 0x01,
-	0x0c, 0xcb, 0x3b, 0xd0, 0x2f, 0xaf, 0x80, 0xda, 0xdd, 0x3c, 0xd1, 0xc3, 0xdf, 0xa0, 0x1b, 0x67,
-	0xcd, 0x80, 0x8f, 0xa6, 0xa7, 0x29, 0xbe, 0x23, 0x0d, 0xaa, 0xae, 0x07, 0xbf, 0x45, 0x37, 0xa5,
-	0xe1, 0x2c, 0x17, 0x78, 0xd7, 0x94, 0x3c, 0xcb, 0x85, 0xb1, 0x63, 0xe9, 0xd0, 0x3b, 0x5e, 0x64,
-	0x7a, 0x81, 0xcf, 0xd1, 0xe6, 0x68, 0x42, 0x99, 0x0b, 0x69, 0x80, 0xb7, 0x24, 0x2c, 0x9f, 0x8d,
-	0x1d, 0xba, 0x20, 0x4e, 0x87, 0x47, 0xf5, 0x35, 0x54, 0x3b, 0x54, 0x75, 0x3d, 0xf8, 0x35, 0xba,
-	0xee, 0x82, 0x28, 0x7f, 0xc0, 0xc7, 0x30, 0xe3, 0xf8, 0xf6, 0x5c, 0xae, 0x95, 0x8d, 0xd3, 0xe9,
-	0x36, 0x37, 0x57, 0x9d, 0xce, 0x56, 0x33, 0x9e, 0x5f, 0x0b, 0xf5, 0xe9, 0x9c, 0x27, 0xc6, 0xf0,
-	0x88, 0xf3, 0xc7, 0xf5, 0xcb, 0xe0, 0x11, 0x89, 0x62, 0x35, 0x3c, 0x4f, 0x8c, 0xf7, 0x6e, 0xc4,
-	0xf9, 0x90, 0xd2, 0x58, 0xbd, 0x77, 0x8d, 0x64, 0x1c, 0x6f, 0x17, 0xc4, 0x79, 0xfd, 0x02, 0x51,
-	0xc7, 0x5b, 0xaa, 0xc6, 0x0d, 0x0e, 0x19, 0xcd, 0x28, 0x87, 0x67, 0xf5, 0x0b, 0x46, 0xdd, 0xe0,
-	0x3c, 0x31, 0x86, 0x8f, 0xb2, 0x8c, 0xd1, 0xc2, 0x14, 0x9e, 0x27, 0xc6, 0x09, 0x7d, 0x0a, 0xbf,
-	0x82, 0x2f, 0xda, 0xac, 0x32, 0xa1, 0x73, 0x40, 0x8f, 0xba, 0xe8, 0x2d, 0x57, 0x50, 0x06, 0x7d,
-	0x1a, 0x00, 0xb6, 0x7b, 0xed, 0x4b, 0xaf, 0x57, 0x7d, 0x14, 0xfb, 0x55, 0xcf, 0x2d, 0xb7, 0x3e,
-	0xf9, 0x7f, 0xfe, 0x14, 0x78, 0x46, 0x53, 0x0e, 0xf8, 0x25, 0xba, 0x75, 0x9a, 0x72, 0x41, 0x52,
-	0x11, 0x11, 0x01, 0x7d, 0x9a, 0x0a, 0x46, 0x7c, 0x81, 0x1f, 0x18, 0xe3, 0x06, 0xa7, 0xf5, 0xd5,
-	0xaa, 0xce, 0x6e, 0x49, 0x81, 0x76, 0x0c, 0xf8, 0x00, 0xef, 0xad, 0x5a, 0xe9, 0xc0, 0xda, 0x5f,
-	0xd9, 0xda, 0xad, 0x0a, 0x68, 0xfb, 0x64, 0x0a, 0x7e, 0xae, 0x34, 0xf9, 0x91, 0xb1, 0xca, 0x82,
-	0xcb, 0xfa, 0x62, 0x15, 0x97, 0xba, 0xcc, 0x20, 0x0a, 0x19, 0x59, 0xba, 0xcc, 0x82, 0xeb, 0x35,
-	0xcb, 0x2c, 0xb8, 0xba, 0x65, 0x7e, 0x41, 0x37, 0xdc, 0x3c, 0xa0, 0xdd, 0x1a, 0x1f, 0x9a, 0x8f,
-	0x5b, 0xb1, 0x58, 0x7b, 0x4b, 0x2d, 0x5d, 0xf5, 0x9f, 0xd0, 0xf5, 0x67, 0x59, 0x40, 0x04, 0x1c,
-	0x05, 0x49, 0x94, 0xe2, 0x7b, 0xc6, 0xa4, 0xe2, 0xb0, 0x1e, 0x2c, 0x73, 0x74, 0xa5, 0xcf, 0x11,
-	0xea, 0xc7, 0x40, 0x58, 0x5d, 0xf9, 0x03, 0x63, 0x4e, 0x1a, 0xac, 0x4f, 0x97, 0x18, 0xda, 0xba,
-	0xd6, 0xc6, 0x6f, 0xff, 0xfe, 0xf1, 0xd9, 0xfa, 0xf1, 0xf9, 0x9f, 0x97, 0xf6, 0xfa, 0xab, 0x4b,
-	0x7b, 0xfd, 0xef, 0x4b, 0x7b, 0xfd, 0xf7, 0x2b, 0x7b, 0xed, 0xd5, 0x95, 0xbd, 0xf6, 0xd7, 0x95,
-	0xbd, 0xf6, 0xf3, 0x77, 0x61, 0x24, 0x26, 0xf9, 0xb8, 0xe7, 0xd3, 0xc4, 0xe1, 0x17, 0x91, 0xf0,
-	0x27, 0xf1, 0xac, 0xfa, 0x8f, 0xe0, 0xd3, 0xb8, 0x13, 0xca, 0x3f, 0x3e, 0x4e, 0x71, 0xe8, 0x4c,
-	0x3b, 0xc5, 0xa9, 0x2e, 0xe1, 0xf8, 0x8d, 0xca, 0x7a, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x89, 0x1f, 0x37, 0xc3, 0x8e, 0x0a, 0x00, 0x00,
+	// 905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xdd, 0x6e, 0x1c, 0x35,
+	0x14, 0xc7, 0x13, 0x55, 0x81, 0xe0, 0xb6, 0x69, 0x71, 0xd3, 0xa6, 0x0c, 0x62, 0x69, 0x2b, 0x3e,
+	0x1a, 0x28, 0x3b, 0x24, 0x11, 0x42, 0xa8, 0x48, 0x28, 0x49, 0x53, 0x29, 0xaa, 0xb6, 0x0d, 0x9d,
+	0x6d, 0x24, 0x10, 0xd2, 0xc8, 0x3b, 0x73, 0x32, 0x31, 0x99, 0xb1, 0xa7, 0xb6, 0x67, 0xb3, 0x7b,
+	0xc7, 0x23, 0xf0, 0x28, 0x3c, 0x06, 0x97, 0xbd, 0xe4, 0x06, 0x09, 0x25, 0x17, 0xbc, 0x06, 0x9a,
+	0x4f, 0x7b, 0x63, 0xd3, 0xec, 0xcd, 0xae, 0xe6, 0xfc, 0xfe, 0x7f, 0xdb, 0x67, 0xce, 0x39, 0xd6,
+	0xa0, 0x15, 0x35, 0xcd, 0x41, 0xfa, 0x6a, 0xd2, 0xcf, 0x05, 0x57, 0x1c, 0x2f, 0x55, 0xcf, 0xde,
+	0x5a, 0xc4, 0x65, 0xc6, 0xa5, 0x9f, 0xc9, 0xc4, 0x1f, 0x6f, 0x94, 0x7f, 0x35, 0xf7, 0x6e, 0xd5,
+	0xfa, 0x4c, 0x26, 0xe1, 0x88, 0xb0, 0x26, 0xb8, 0xa6, 0x83, 0x31, 0xe4, 0x5c, 0x52, 0xd5, 0x80,
+	0x9e, 0x06, 0x10, 0xc7, 0x92, 0x84, 0x23, 0x12, 0x9d, 0x1c, 0xd1, 0x34, 0x6d, 0xf8, 0x1d, 0x83,
+	0x0b, 0x41, 0x14, 0x69, 0xe2, 0xb7, 0x75, 0x3c, 0xa3, 0x19, 0x15, 0x4d, 0xf8, 0x43, 0x1d, 0x66,
+	0xa0, 0x4e, 0xb9, 0x38, 0x09, 0x8f, 0x00, 0x1a, 0x78, 0xcf, 0x80, 0x3c, 0x86, 0x30, 0x27, 0x85,
+	0x84, 0x30, 0x3a, 0x26, 0xb4, 0x3d, 0xe6, 0x47, 0x5a, 0xc1, 0x47, 0x12, 0xc4, 0x18, 0xe2, 0x50,
+	0x4d, 0x28, 0xb3, 0x0f, 0x6b, 0x60, 0x5e, 0xb4, 0xc9, 0x3c, 0x70, 0xf2, 0xf0, 0x75, 0xc1, 0x45,
+	0x91, 0x35, 0x9a, 0x55, 0xad, 0x91, 0xc0, 0x62, 0x7b, 0x65, 0x09, 0x2a, 0xa4, 0x79, 0x48, 0xe2,
+	0x58, 0x80, 0x94, 0xf6, 0xc1, 0x4a, 0x5e, 0x1d, 0xff, 0x04, 0xa6, 0x2d, 0xbe, 0x6b, 0x60, 0x9e,
+	0x8e, 0x81, 0x45, 0x53, 0x3b, 0x67, 0x25, 0x65, 0xe5, 0xa1, 0x09, 0x0b, 0x8f, 0x08, 0x4d, 0x6d,
+	0x6f, 0xa9, 0xc8, 0x39, 0x6f, 0xc9, 0x7d, 0x4d, 0x8a, 0x3c, 0x26, 0x0a, 0x42, 0x92, 0x24, 0x02,
+	0x12, 0xa2, 0xb8, 0xb0, 0xeb, 0x3a, 0x06, 0x21, 0x29, 0x77, 0x14, 0xbc, 0xc8, 0x13, 0x41, 0x62,
+	0x70, 0x14, 0x8e, 0x50, 0xd6, 0xbe, 0xba, 0x0f, 0xca, 0x76, 0x3a, 0x25, 0x32, 0xf3, 0xab, 0x9f,
+	0xf1, 0x46, 0xd7, 0x70, 0x0f, 0x10, 0x5a, 0x1e, 0xc8, 0x64, 0x2f, 0xcb, 0xd5, 0x74, 0xf3, 0xef,
+	0x9b, 0xe8, 0xca, 0x40, 0x26, 0xf8, 0x53, 0x74, 0x65, 0x87, 0x30, 0x7c, 0xbd, 0x5f, 0xad, 0xd6,
+	0x1f, 0xc8, 0x64, 0x87, 0x30, 0xef, 0x86, 0x7e, 0xac, 0xe4, 0xf8, 0x2b, 0xf4, 0xee, 0x93, 0xba,
+	0xdd, 0xf0, 0xfb, 0x9a, 0x35, 0x21, 0x5b, 0xfe, 0x1d, 0xba, 0xbe, 0x17, 0x3f, 0x09, 0xb6, 0x77,
+	0x9a, 0x1e, 0xc4, 0x6b, 0x86, 0xc2, 0x04, 0xb6, 0xd5, 0x47, 0xcb, 0x7b, 0x55, 0x7f, 0x0e, 0x27,
+	0x18, 0x1b, 0xb0, 0x89, 0xd9, 0x86, 0xc7, 0x68, 0xa5, 0x85, 0x3f, 0x56, 0xfd, 0x81, 0xef, 0xda,
+	0xb6, 0x9a, 0xd8, 0xe6, 0x75, 0xb4, 0x34, 0x28, 0xbb, 0x1e, 0x1b, 0xa4, 0x0a, 0xd8, 0xd2, 0x2d,
+	0x84, 0x9e, 0xd7, 0x93, 0xf0, 0x14, 0x00, 0xaf, 0x6a, 0xac, 0xa3, 0xb6, 0xe9, 0x07, 0x74, 0x53,
+	0xe3, 0xe6, 0x78, 0x9e, 0xcb, 0xfa, 0x7f, 0x07, 0x7c, 0x8c, 0x56, 0x9e, 0xf3, 0x18, 0x0e, 0xca,
+	0x09, 0xdb, 0x2d, 0x07, 0xcc, 0xcc, 0x6e, 0x96, 0xd8, 0xe6, 0x6f, 0xd1, 0xb5, 0x17, 0xcd, 0xf8,
+	0x0c, 0x27, 0xfb, 0x0c, 0xdf, 0xd1, 0x02, 0x33, 0xee, 0xac, 0x9f, 0x16, 0xbc, 0x28, 0x94, 0x59,
+	0xbf, 0x19, 0xe0, 0xcc, 0x58, 0x2b, 0xec, 0x8c, 0x2f, 0x32, 0x7b, 0x81, 0x2f, 0xd1, 0xf2, 0xf0,
+	0x98, 0x8b, 0x00, 0x58, 0x8c, 0x57, 0x34, 0x2c, 0x9f, 0x9d, 0x19, 0x06, 0xa0, 0xf6, 0x0f, 0xb6,
+	0xeb, 0x21, 0x37, 0x33, 0x34, 0xe3, 0xb6, 0xf1, 0x1b, 0x74, 0x35, 0x00, 0x55, 0xbe, 0xc0, 0x67,
+	0x30, 0x95, 0xf8, 0xf6, 0x8c, 0xaf, 0x0d, 0x3b, 0xbb, 0x33, 0x68, 0xee, 0x05, 0xb3, 0x3b, 0xdb,
+	0x98, 0xb3, 0x7e, 0x2d, 0xb4, 0xbb, 0x73, 0x96, 0x38, 0xcd, 0x43, 0x29, 0x9f, 0xd5, 0x57, 0xcd,
+	0x53, 0x42, 0x53, 0xd3, 0x3c, 0x4b, 0x9c, 0x23, 0x3b, 0x94, 0xf2, 0x80, 0xf3, 0xd4, 0x1c, 0xd9,
+	0x26, 0xe4, 0xac, 0xdb, 0xab, 0xea, 0x6e, 0xda, 0xee, 0xae, 0x26, 0xb3, 0x6e, 0x17, 0x99, 0x73,
+	0x3e, 0x02, 0x50, 0x87, 0xf5, 0xe5, 0x65, 0xce, 0x87, 0x8e, 0x3a, 0x33, 0x3c, 0x10, 0x3c, 0xe7,
+	0x12, 0x5e, 0xd5, 0x97, 0x9b, 0x99, 0xe1, 0x2c, 0x71, 0x9a, 0xb7, 0xf3, 0x5c, 0xf0, 0xb1, 0xcb,
+	0x3c, 0x4b, 0x9c, 0x2d, 0xfe, 0x12, 0x7e, 0x85, 0x48, 0xb5, 0x5e, 0xa3, 0xc5, 0x67, 0x80, 0x6d,
+	0x0d, 0xd0, 0x7b, 0x81, 0xe2, 0x02, 0x76, 0x79, 0x0c, 0xb8, 0xd7, 0x6f, 0x2f, 0xdc, 0x7e, 0xf5,
+	0x33, 0xde, 0xa8, 0x72, 0x6e, 0xb9, 0xf7, 0xd9, 0xdb, 0xf9, 0x4b, 0x90, 0x39, 0x67, 0x12, 0xf0,
+	0x6b, 0x74, 0x6b, 0x9f, 0x49, 0x45, 0x98, 0xa2, 0x44, 0xc1, 0x2e, 0x67, 0x4a, 0x90, 0x48, 0xe1,
+	0x87, 0x4e, 0xbb, 0x43, 0xe9, 0x7d, 0x3d, 0xaf, 0xb2, 0xdb, 0x52, 0xa1, 0x55, 0x07, 0xde, 0xc4,
+	0xeb, 0xf3, 0xae, 0xb4, 0xe9, 0x6d, 0xcc, 0x2d, 0xed, 0x76, 0x05, 0x74, 0x63, 0x6f, 0x02, 0x51,
+	0x61, 0x24, 0xf9, 0x89, 0x73, 0x95, 0x0b, 0x2a, 0xef, 0xd1, 0x3c, 0x2a, 0x73, 0x9b, 0x01, 0x4d,
+	0x04, 0xb9, 0x74, 0x9b, 0x0b, 0x2a, 0xef, 0xd1, 0x3c, 0xaa, 0x6e, 0x9b, 0x5f, 0xd0, 0xb5, 0xa0,
+	0x88, 0x79, 0xb7, 0xc7, 0x7d, 0x77, 0xb9, 0x0d, 0x89, 0xb7, 0x7e, 0xa9, 0xa4, 0x5b, 0xfd, 0x27,
+	0x74, 0xb5, 0x19, 0xbc, 0x38, 0xa3, 0x0c, 0xdf, 0x73, 0x3a, 0x0d, 0x85, 0xf7, 0xf0, 0x32, 0x45,
+	0xb7, 0xf4, 0x21, 0x42, 0xbb, 0x29, 0x10, 0x51, 0xaf, 0xfc, 0xb1, 0xd3, 0xa7, 0x05, 0xde, 0xe7,
+	0x97, 0x08, 0xda, 0x75, 0xbd, 0xa5, 0xdf, 0xfe, 0xfd, 0xe3, 0x8b, 0xc5, 0x9d, 0xc3, 0x3f, 0xcf,
+	0x7a, 0x8b, 0x6f, 0xce, 0x7a, 0x8b, 0xff, 0x9c, 0xf5, 0x16, 0x7f, 0x3f, 0xef, 0x2d, 0xbc, 0x39,
+	0xef, 0x2d, 0xfc, 0x75, 0xde, 0x5b, 0xf8, 0xf9, 0xfb, 0x84, 0xaa, 0xe3, 0x62, 0xd4, 0x8f, 0x78,
+	0xe6, 0xcb, 0x53, 0xaa, 0xa2, 0xe3, 0x74, 0x5a, 0x7d, 0x9f, 0x44, 0x3c, 0xed, 0x02, 0xe5, 0x77,
+	0x99, 0x3f, 0xde, 0xf2, 0x27, 0x5d, 0xc4, 0xaf, 0x86, 0x70, 0xf4, 0x4e, 0x25, 0xdd, 0xfa, 0x6f,
+	0x00, 0xa2, 0xcb, 0x55, 0xde, 0x4d, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -144,6 +147,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Ban(ctx context.Context, in *MsgBan, opts ...grpc.CallOption) (*MsgEmpty, error)
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgEmpty, error)
+	EdDSABackfill(ctx context.Context, in *MsgEdDSABackfill, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ErrataTx(ctx context.Context, in *MsgErrataTx, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ErrataTxQuorum(ctx context.Context, in *MsgErrataTxQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	Mimir(ctx context.Context, in *MsgMimir, opts ...grpc.CallOption) (*MsgEmpty, error)
@@ -160,6 +164,7 @@ type MsgClient interface {
 	SolvencyQuorum(ctx context.Context, in *MsgSolvencyQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	TssKeysignFail(ctx context.Context, in *MsgTssKeysignFail, opts ...grpc.CallOption) (*MsgEmpty, error)
 	TssPool(ctx context.Context, in *MsgTssPool, opts ...grpc.CallOption) (*MsgEmpty, error)
+	UpdateAggregator(ctx context.Context, in *MsgUpdateAggregator, opts ...grpc.CallOption) (*MsgEmpty, error)
 	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ProposeUpgrade(ctx context.Context, in *MsgProposeUpgrade, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ApproveUpgrade(ctx context.Context, in *MsgApproveUpgrade, opts ...grpc.CallOption) (*MsgEmpty, error)
@@ -201,6 +206,15 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) EdDSABackfill(ctx context.Context, in *MsgEdDSABackfill, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, "/types.Msg/EdDSABackfill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ErrataTx(ctx context.Context, in *MsgErrataTx, opts ...grpc.CallOption) (*MsgEmpty, error) {
 	out := new(MsgEmpty)
 	err := c.cc.Invoke(ctx, "/types.Msg/ErrataTx", in, out, opts...)
@@ -345,6 +359,15 @@ func (c *msgClient) TssPool(ctx context.Context, in *MsgTssPool, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) UpdateAggregator(ctx context.Context, in *MsgUpdateAggregator, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, "/types.Msg/UpdateAggregator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgEmpty, error) {
 	out := new(MsgEmpty)
 	err := c.cc.Invoke(ctx, "/types.Msg/SetVersion", in, out, opts...)
@@ -457,6 +480,7 @@ func (c *msgClient) ClearAdmin(ctx context.Context, in *types.MsgClearAdmin, opt
 type MsgServer interface {
 	Ban(context.Context, *MsgBan) (*MsgEmpty, error)
 	Deposit(context.Context, *MsgDeposit) (*MsgEmpty, error)
+	EdDSABackfill(context.Context, *MsgEdDSABackfill) (*MsgEmpty, error)
 	ErrataTx(context.Context, *MsgErrataTx) (*MsgEmpty, error)
 	ErrataTxQuorum(context.Context, *MsgErrataTxQuorum) (*MsgEmpty, error)
 	Mimir(context.Context, *MsgMimir) (*MsgEmpty, error)
@@ -473,6 +497,7 @@ type MsgServer interface {
 	SolvencyQuorum(context.Context, *MsgSolvencyQuorum) (*MsgEmpty, error)
 	TssKeysignFail(context.Context, *MsgTssKeysignFail) (*MsgEmpty, error)
 	TssPool(context.Context, *MsgTssPool) (*MsgEmpty, error)
+	UpdateAggregator(context.Context, *MsgUpdateAggregator) (*MsgEmpty, error)
 	SetVersion(context.Context, *MsgSetVersion) (*MsgEmpty, error)
 	ProposeUpgrade(context.Context, *MsgProposeUpgrade) (*MsgEmpty, error)
 	ApproveUpgrade(context.Context, *MsgApproveUpgrade) (*MsgEmpty, error)
@@ -498,6 +523,9 @@ func (*UnimplementedMsgServer) Ban(ctx context.Context, req *MsgBan) (*MsgEmpty,
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (*UnimplementedMsgServer) EdDSABackfill(ctx context.Context, req *MsgEdDSABackfill) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EdDSABackfill not implemented")
+}
 func (*UnimplementedMsgServer) ErrataTx(ctx context.Context, req *MsgErrataTx) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ErrataTx not implemented")
 }
@@ -546,6 +574,9 @@ func (*UnimplementedMsgServer) TssKeysignFail(ctx context.Context, req *MsgTssKe
 func (*UnimplementedMsgServer) TssPool(ctx context.Context, req *MsgTssPool) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TssPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAggregator(ctx context.Context, req *MsgUpdateAggregator) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAggregator not implemented")
+}
 func (*UnimplementedMsgServer) SetVersion(ctx context.Context, req *MsgSetVersion) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
 }
@@ -623,6 +654,24 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EdDSABackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEdDSABackfill)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EdDSABackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Msg/EdDSABackfill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EdDSABackfill(ctx, req.(*MsgEdDSABackfill))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ErrataTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgErrataTx)
 	if err := dec(in); err != nil {
@@ -911,6 +960,24 @@ func _Msg_TssPool_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAggregator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAggregator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAggregator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Msg/UpdateAggregator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAggregator(ctx, req.(*MsgUpdateAggregator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetVersion)
 	if err := dec(in); err != nil {
@@ -1127,6 +1194,7 @@ func _Msg_ClearAdmin_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1139,6 +1207,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "EdDSABackfill",
+			Handler:    _Msg_EdDSABackfill_Handler,
+		},
 		{
 			MethodName: "ErrataTx",
 			Handler:    _Msg_ErrataTx_Handler,
@@ -1203,6 +1275,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TssPool",
 			Handler:    _Msg_TssPool_Handler,
 		},
+		{
+			MethodName: "UpdateAggregator",
+			Handler:    _Msg_UpdateAggregator_Handler,
+		},
 		{
 			MethodName: "SetVersion",
 			Handler:    _Msg_SetVersion_Handler,
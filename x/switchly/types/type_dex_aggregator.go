@@ -0,0 +1,156 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// MaxDexAggregatorVersionLength is the maximum length of the version tag of an aggregator
+const MaxDexAggregatorVersionLength = 32
+
+// NewDexAggregator create a new instance of DexAggregator
+func NewDexAggregator(chain common.Chain, address string, gasLimit uint64, enabled bool, version string) DexAggregator {
+	return DexAggregator{
+		Chain:    chain,
+		Address:  address,
+		GasLimit: gasLimit,
+		Enabled:  enabled,
+		Version:  version,
+	}
+}
+
+// Valid return an error if the aggregator is not a contract of an external chain
+func (m *DexAggregator) Valid() error {
+	if m.Chain.IsEmpty() {
+		return errors.New("chain cannot be empty")
+	}
+	if m.Chain.IsSWITCHLYChain() {
+		return errors.New("aggregator cannot be on SWITCHLYChain")
+	}
+	if len(m.Address) == 0 {
+		return errors.New("address cannot be empty")
+	}
+	addr, err := common.NewAddress(m.Address)
+	if err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if !addr.IsChain(m.Chain) {
+		return fmt.Errorf("address %s is not a %s address", m.Address, m.Chain)
+	}
+	if m.GasLimit == 0 {
+		return errors.New("gas limit cannot be zero")
+	}
+	if len(m.Version) > MaxDexAggregatorVersionLength {
+		return fmt.Errorf("version cannot exceed %d characters", MaxDexAggregatorVersionLength)
+	}
+	return nil
+}
+
+// IsEmpty return true when the address of the aggregator is empty
+func (m *DexAggregator) IsEmpty() bool {
+	return len(m.Address) == 0
+}
+
+// Key return the store key of the aggregator, EVM addresses are case-insensitive
+func (m *DexAggregator) Key() string {
+	if m.Chain.IsEVM() {
+		return fmt.Sprintf("%s/%s", m.Chain, strings.ToLower(m.Address))
+	}
+	return fmt.Sprintf("%s/%s", m.Chain, m.Address)
+}
+
+func (m *DexAggregator) String() string {
+	return fmt.Sprintf("%s %s (gas limit: %d, enabled: %t, version: %s)", m.Chain, m.Address, m.GasLimit, m.Enabled, m.Version)
+}
+
+// Matches return true when the aggregator is on the given chain, and its address ends
+// with the given suffix (memos may reference aggregators by a short notation)
+func (m *DexAggregator) Matches(chain common.Chain, suffix string) bool {
+	return m.Chain.Equals(chain) && strings.HasSuffix(m.Address, suffix)
+}
+
+// Equals return true when both aggregators have the same settings
+func (m *DexAggregator) Equals(agg DexAggregator) bool {
+	return m.Key() == agg.Key() &&
+		m.GasLimit == agg.GasLimit &&
+		m.Enabled == agg.Enabled &&
+		m.Version == agg.Version
+}
+
+// NewDexAggregatorVoter create a new instance of DexAggregatorVoter
+func NewDexAggregatorVoter(aggregator DexAggregator, remove bool) DexAggregatorVoter {
+	return DexAggregatorVoter{
+		Aggregator: aggregator,
+		Remove:     remove,
+	}
+}
+
+// Valid return an error if the proposed aggregator is not valid
+func (m *DexAggregatorVoter) Valid() error {
+	if m.Remove {
+		if m.Aggregator.Chain.IsEmpty() || m.Aggregator.IsEmpty() {
+			return errors.New("aggregator to remove cannot be empty")
+		}
+	} else if err := m.Aggregator.Valid(); err != nil {
+		return err
+	}
+	if m.BlockHeight < 0 {
+		return errors.New("block height cannot be less than zero")
+	}
+	return nil
+}
+
+// String return the id of the proposal, nodes voting for the same settings of an
+// aggregator vote for the same proposal
+func (m *DexAggregatorVoter) String() string {
+	proposal := fmt.Sprintf("%s/%d/%t/%s/%t", m.Aggregator.Key(), m.Aggregator.GasLimit, m.Aggregator.Enabled, m.Aggregator.Version, m.Remove)
+	hash := sha256.Sum256([]byte(proposal))
+	return hex.EncodeToString(hash[:])
+}
+
+// HasSigned - check if given address has signed
+func (m *DexAggregatorVoter) HasSigned(signer cosmos.AccAddress) bool {
+	for _, sign := range m.GetSigners() {
+		if sign.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign add the given signer to the signer list
+func (m *DexAggregatorVoter) Sign(signer cosmos.AccAddress) {
+	if !m.HasSigned(signer) {
+		m.Signers = append(m.Signers, signer.String())
+	}
+}
+
+func (m *DexAggregatorVoter) GetSigners() []cosmos.AccAddress {
+	signers := make([]cosmos.AccAddress, 0)
+	for _, str := range m.Signers {
+		signer, err := cosmos.AccAddressFromBech32(str)
+		if err != nil {
+			continue
+		}
+		signers = append(signers, signer)
+	}
+	return signers
+}
+
+// HasConsensus return true if a supermajority of the given active node
+// accounts have signed off the DexAggregatorVoter
+func (m *DexAggregatorVoter) HasConsensus(nodeAccounts NodeAccounts) bool {
+	var count int
+	for _, signer := range m.GetSigners() {
+		if nodeAccounts.IsNodeKeys(signer) {
+			count++
+		}
+	}
+	return HasSuperMajority(count, len(nodeAccounts))
+}
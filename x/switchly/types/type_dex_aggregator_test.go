@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+)
+
+type DexAggregatorSuite struct{}
+
+var _ = Suite(&DexAggregatorSuite{})
+
+func (s DexAggregatorSuite) TestDexAggregator(c *C) {
+	agg := DexAggregator{}
+	c.Check(agg.Valid(), NotNil)
+	c.Check(agg.IsEmpty(), Equals, true)
+
+	addr := "0x69800327b38A4CeF30367Dec3f64c2f2386f3848"
+	agg = NewDexAggregator(common.ETHChain, addr, 400_000, true, "v2")
+	c.Check(agg.Valid(), IsNil)
+	c.Check(agg.IsEmpty(), Equals, false)
+	c.Check(agg.Key(), Equals, "ETH/"+strings.ToLower(addr))
+	c.Check(agg.Matches(common.ETHChain, "2f2386f3848"), Equals, true)
+	c.Check(agg.Matches(common.AVAXChain, "2f2386f3848"), Equals, false)
+	c.Check(agg.Matches(common.ETHChain, "whatever"), Equals, false)
+
+	// EVM addresses are case-insensitive
+	lower := NewDexAggregator(common.ETHChain, strings.ToLower(addr), 400_000, true, "v2")
+	c.Check(agg.Equals(lower), Equals, true)
+	lower.GasLimit = 500_000
+	c.Check(agg.Equals(lower), Equals, false)
+
+	agg.Chain = common.SWITCHLYChain
+	c.Check(agg.Valid(), NotNil)
+	agg.Chain = common.BTCChain
+	c.Check(agg.Valid(), NotNil)
+	agg.Chain = common.ETHChain
+	agg.Address = "bogus"
+	c.Check(agg.Valid(), NotNil)
+	agg.Address = addr
+	agg.GasLimit = 0
+	c.Check(agg.Valid(), NotNil)
+	agg.GasLimit = 400_000
+	agg.Version = strings.Repeat("v", MaxDexAggregatorVersionLength+1)
+	c.Check(agg.Valid(), NotNil)
+}
+
+func (s DexAggregatorSuite) TestVoter(c *C) {
+	agg := NewDexAggregator(common.ETHChain, GetRandomETHAddress().String(), 400_000, true, "")
+	voter := NewDexAggregatorVoter(agg, false)
+	c.Check(voter.Valid(), IsNil)
+
+	// the proposal id depends on the proposed settings
+	same := NewDexAggregatorVoter(agg, false)
+	c.Check(voter.String(), Equals, same.String())
+	remove := NewDexAggregatorVoter(agg, true)
+	c.Check(voter.String(), Not(Equals), remove.String())
+	disabled := agg
+	disabled.Enabled = false
+	other := NewDexAggregatorVoter(disabled, false)
+	c.Check(voter.String(), Not(Equals), other.String())
+
+	// removal only requires the aggregator to be referenced
+	remove = NewDexAggregatorVoter(DexAggregator{Chain: common.ETHChain, Address: agg.Address}, true)
+	c.Check(remove.Valid(), IsNil)
+	remove = NewDexAggregatorVoter(DexAggregator{Chain: common.ETHChain}, true)
+	c.Check(remove.Valid(), NotNil)
+	other = NewDexAggregatorVoter(DexAggregator{Chain: common.ETHChain, Address: agg.Address}, false)
+	c.Check(other.Valid(), NotNil)
+
+	addr := GetRandomBech32Addr()
+	c.Check(voter.HasSigned(addr), Equals, false)
+	voter.Sign(addr)
+	c.Check(voter.HasSigned(addr), Equals, true)
+	voter.Sign(addr)
+	c.Check(voter.Signers, HasLen, 1)
+
+	nodes := NodeAccounts{
+		GetRandomValidatorNode(NodeStatus_Active),
+		GetRandomValidatorNode(NodeStatus_Active),
+		GetRandomValidatorNode(NodeStatus_Active),
+		GetRandomValidatorNode(NodeStatus_Active),
+	}
+
+	c.Check(voter.HasConsensus(nodes), Equals, false)
+	voter.Sign(nodes[0].NodeAddress)
+	voter.Sign(nodes[1].NodeAddress)
+	c.Check(voter.HasConsensus(nodes), Equals, false)
+	voter.Sign(nodes[2].NodeAddress)
+	c.Check(voter.HasConsensus(nodes), Equals, true)
+}
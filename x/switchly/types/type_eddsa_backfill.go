@@ -0,0 +1,74 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
+)
+
+// NewEdDSABackfillVoter create a new instance of EdDSABackfillVoter
+func NewEdDSABackfillVoter(poolPubKey common.PubKey) EdDSABackfillVoter {
+	return EdDSABackfillVoter{PoolPubKey: poolPubKey}
+}
+
+// Valid return an error if the vault pubkey to backfill is empty
+func (m *EdDSABackfillVoter) Valid() error {
+	if m.PoolPubKey.IsEmpty() {
+		return errors.New("pool pubkey is empty")
+	}
+	if m.BlockHeight <= 0 {
+		return errors.New("block height cannot be equal to or less than zero")
+	}
+	return nil
+}
+
+// IsEmpty return true when the pool pubkey is empty
+func (m *EdDSABackfillVoter) IsEmpty() bool {
+	return m.PoolPubKey.IsEmpty()
+}
+
+func (m *EdDSABackfillVoter) String() string {
+	return m.PoolPubKey.String()
+}
+
+// HasSigned - check if given address has signed
+func (m *EdDSABackfillVoter) HasSigned(signer cosmos.AccAddress) bool {
+	for _, sign := range m.GetSigners() {
+		if sign.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign add the given signer to the signer list
+func (m *EdDSABackfillVoter) Sign(signer cosmos.AccAddress) {
+	if !m.HasSigned(signer) {
+		m.Signers = append(m.Signers, signer.String())
+	}
+}
+
+func (m *EdDSABackfillVoter) GetSigners() []cosmos.AccAddress {
+	signers := make([]cosmos.AccAddress, 0)
+	for _, str := range m.Signers {
+		signer, err := cosmos.AccAddressFromBech32(str)
+		if err != nil {
+			continue
+		}
+		signers = append(signers, signer)
+	}
+	return signers
+}
+
+// HasConsensus return true if a supermajority of the given active node
+// accounts have signed off the EdDSABackfillVoter
+func (m *EdDSABackfillVoter) HasConsensus(nodeAccounts NodeAccounts) bool {
+	var count int
+	for _, signer := range m.GetSigners() {
+		if nodeAccounts.IsNodeKeys(signer) {
+			count++
+		}
+	}
+	return HasSuperMajority(count, len(nodeAccounts))
+}
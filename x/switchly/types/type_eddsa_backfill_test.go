@@ -0,0 +1,45 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type EdDSABackfillVoterSuite struct{}
+
+var _ = Suite(&EdDSABackfillVoterSuite{})
+
+func (s EdDSABackfillVoterSuite) TestVoter(c *C) {
+	voter := EdDSABackfillVoter{}
+	c.Check(voter.Valid(), NotNil)
+	c.Check(voter.IsEmpty(), Equals, true)
+
+	pk := GetRandomPubKey()
+	voter = NewEdDSABackfillVoter(pk)
+	c.Check(voter.Valid(), NotNil)
+	voter.BlockHeight = 12
+
+	c.Check(voter.Valid(), IsNil)
+	c.Check(voter.IsEmpty(), Equals, false)
+	c.Check(voter.String(), Equals, pk.String())
+
+	addr := GetRandomBech32Addr()
+	c.Check(voter.HasSigned(addr), Equals, false)
+	voter.Sign(addr)
+	c.Check(voter.HasSigned(addr), Equals, true)
+	voter.Sign(addr)
+	c.Check(voter.Signers, HasLen, 1)
+
+	nodes := NodeAccounts{
+		GetRandomValidatorNode(NodeStatus_Active),
+		GetRandomValidatorNode(NodeStatus_Active),
+		GetRandomValidatorNode(NodeStatus_Active),
+		GetRandomValidatorNode(NodeStatus_Active),
+	}
+
+	c.Check(voter.HasConsensus(nodes), Equals, false)
+	voter.Sign(nodes[0].NodeAddress)
+	voter.Sign(nodes[1].NodeAddress)
+	c.Check(voter.HasConsensus(nodes), Equals, false)
+	voter.Sign(nodes[2].NodeAddress)
+	c.Check(voter.HasConsensus(nodes), Equals, true)
+}
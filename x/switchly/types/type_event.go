@@ -29,6 +29,7 @@ const (
 	ScheduledOutboundEventType    = "scheduled_outbound"
 	SecurityEventType             = "security"
 	SetMimirEventType             = "set_mimir"
+	DexAggregatorEventType        = "dex_aggregator"
 	SetNodeMimirEventType         = "set_node_mimir"
 	SlashEventType                = "slash"
 	SlashPointEventType           = "slash_points"
@@ -36,6 +37,7 @@ const (
 	SwapEventType                 = "swap"
 	AffiliateFeeEventType         = "affiliate_fee"
 	LimitSwapEventType            = "limit_swap"
+	ModifyLimitSwapEventType      = "modify_limit_swap"
 	MintBurnType                  = "mint_burn"
 	SWITCHNameEventType           = "switchlyname"
 	LoanOpenEventType             = "loan_open"
@@ -97,6 +99,36 @@ func (m *EventLimitSwap) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventModifyLimitSwap create a new modify limit swap event
+func NewEventModifyLimitSwap(from common.Address, source, target common.Coin, modifiedTargetAmount cosmos.Uint, txid, swapTxID common.TxID) *EventModifyLimitSwap {
+	return &EventModifyLimitSwap{
+		From:                 from,
+		Source:               source,
+		Target:               target,
+		ModifiedTargetAmount: modifiedTargetAmount,
+		TxID:                 txid,
+		SwapTxID:             swapTxID,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (m *EventModifyLimitSwap) Type() string {
+	return ModifyLimitSwapEventType
+}
+
+// Events convert EventModifyLimitSwap to key value pairs used in cosmos
+func (m *EventModifyLimitSwap) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("from", m.From.String()),
+		cosmos.NewAttribute("source", m.Source.String()),
+		cosmos.NewAttribute("target", m.Target.String()),
+		cosmos.NewAttribute("modified_target_amount", m.ModifiedTargetAmount.String()),
+		cosmos.NewAttribute("txid", m.TxID.String()),
+		cosmos.NewAttribute("swap_txid", m.SwapTxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventSwap create a new swap event
 func NewEventSwap(pool common.Asset, swapTarget, fee, swapSlip, liquidityFeeInSWITCH cosmos.Uint, inTx common.Tx, emitAsset common.Coin, synthUnits cosmos.Uint) *EventSwap {
 	return &EventSwap{
@@ -1149,6 +1181,37 @@ func (m *EventSetMimir) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventDexAggregator create a new instance of EventDexAggregator, action is "set" or
+// "remove"
+func NewEventDexAggregator(action string, agg DexAggregator) *EventDexAggregator {
+	return &EventDexAggregator{
+		Action:   action,
+		Chain:    agg.Chain,
+		Address:  agg.Address,
+		GasLimit: agg.GasLimit,
+		Enabled:  agg.Enabled,
+		Version:  agg.Version,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventDexAggregator) Type() string {
+	return DexAggregatorEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventDexAggregator) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("action", m.Action),
+		cosmos.NewAttribute("chain", m.Chain.String()),
+		cosmos.NewAttribute("address", m.Address),
+		cosmos.NewAttribute("gas_limit", strconv.FormatUint(m.GasLimit, 10)),
+		cosmos.NewAttribute("enabled", strconv.FormatBool(m.Enabled)),
+		cosmos.NewAttribute("version", m.Version),
+	)
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventMintBurn create a new instance of EventMintBurn
 func NewEventMintBurn(t MintBurnSupplyType, denom string, amt cosmos.Uint, reason string) *EventMintBurn {
 	return &EventMintBurn{
@@ -2883,6 +2883,167 @@ func (m *EventSWCYUnstake) GetAddress() github_com_switchlyprotocol_switchlynode
 	return ""
 }
 
+type EventModifyLimitSwap struct {
+	From                 github_com_switchlyprotocol_switchlynode_v3_common.Address `protobuf:"bytes,1,opt,name=from,proto3,casttype=github.com/switchlyprotocol/switchlynode/v3/common.Address" json:"from,omitempty"`
+	Source               common.Coin                                                `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
+	Target               common.Coin                                                `protobuf:"bytes,3,opt,name=target,proto3" json:"target"`
+	ModifiedTargetAmount cosmossdk_io_math.Uint                                     `protobuf:"bytes,4,opt,name=modified_target_amount,json=modifiedTargetAmount,proto3,customtype=cosmossdk.io/math.Uint" json:"modified_target_amount"`
+	TxID                 github_com_switchlyprotocol_switchlynode_v3_common.TxID    `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3,casttype=github.com/switchlyprotocol/switchlynode/v3/common.TxID" json:"tx_id,omitempty"`
+	SwapTxID             github_com_switchlyprotocol_switchlynode_v3_common.TxID    `protobuf:"bytes,6,opt,name=swap_tx_id,json=swapTxId,proto3,casttype=github.com/switchlyprotocol/switchlynode/v3/common.TxID" json:"swap_tx_id,omitempty"`
+}
+
+func (m *EventModifyLimitSwap) Reset()         { *m = EventModifyLimitSwap{} }
+func (m *EventModifyLimitSwap) String() string { return proto.CompactTextString(m) }
+func (*EventModifyLimitSwap) ProtoMessage()    {}
+func (*EventModifyLimitSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a149b429e0dcd819, []int{47}
+}
+func (m *EventModifyLimitSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventModifyLimitSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventModifyLimitSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventModifyLimitSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventModifyLimitSwap.Merge(m, src)
+}
+func (m *EventModifyLimitSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventModifyLimitSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventModifyLimitSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventModifyLimitSwap proto.InternalMessageInfo
+
+func (m *EventModifyLimitSwap) GetFrom() github_com_switchlyprotocol_switchlynode_v3_common.Address {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventModifyLimitSwap) GetSource() common.Coin {
+	if m != nil {
+		return m.Source
+	}
+	return common.Coin{}
+}
+
+func (m *EventModifyLimitSwap) GetTarget() common.Coin {
+	if m != nil {
+		return m.Target
+	}
+	return common.Coin{}
+}
+
+func (m *EventModifyLimitSwap) GetTxID() github_com_switchlyprotocol_switchlynode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *EventModifyLimitSwap) GetSwapTxID() github_com_switchlyprotocol_switchlynode_v3_common.TxID {
+	if m != nil {
+		return m.SwapTxID
+	}
+	return ""
+}
+
+type EventDexAggregator struct {
+	Action   string                                                   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Chain    github_com_switchlyprotocol_switchlynode_v3_common.Chain `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/switchlyprotocol/switchlynode/v3/common.Chain" json:"chain,omitempty"`
+	Address  string                                                   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	GasLimit uint64                                                   `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	Enabled  bool                                                     `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Version  string                                                   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *EventDexAggregator) Reset()         { *m = EventDexAggregator{} }
+func (m *EventDexAggregator) String() string { return proto.CompactTextString(m) }
+func (*EventDexAggregator) ProtoMessage()    {}
+func (*EventDexAggregator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a149b429e0dcd819, []int{48}
+}
+func (m *EventDexAggregator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDexAggregator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDexAggregator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDexAggregator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDexAggregator.Merge(m, src)
+}
+func (m *EventDexAggregator) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDexAggregator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDexAggregator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDexAggregator proto.InternalMessageInfo
+
+func (m *EventDexAggregator) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *EventDexAggregator) GetChain() github_com_switchlyprotocol_switchlynode_v3_common.Chain {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *EventDexAggregator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventDexAggregator) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EventDexAggregator) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EventDexAggregator) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("types.PendingLiquidityType", PendingLiquidityType_name, PendingLiquidityType_value)
 	proto.RegisterEnum("types.BondType", BondType_name, BondType_value)
@@ -2934,198 +3095,208 @@ func init() {
 	proto.RegisterType((*EventSWCYClaim)(nil), "types.EventSWCYClaim")
 	proto.RegisterType((*EventSWCYStake)(nil), "types.EventSWCYStake")
 	proto.RegisterType((*EventSWCYUnstake)(nil), "types.EventSWCYUnstake")
+	proto.RegisterType((*EventModifyLimitSwap)(nil), "types.EventModifyLimitSwap")
+	proto.RegisterType((*EventDexAggregator)(nil), "types.EventDexAggregator")
 }
 
 func init() { proto.RegisterFile("types/type_events.proto", fileDescriptor_a149b429e0dcd819) }
 
 var fileDescriptor_a149b429e0dcd819 = []byte{
-	// 2964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x72, 0x49, 0x91, 0x7c, 0xa4, 0x2c, 0x6a, 0xac, 0xd8, 0x4a, 0x82, 0x48, 0xce, 0x26,
-	0x05, 0x14, 0x23, 0x96, 0x6a, 0xa7, 0xb5, 0x9b, 0x3a, 0x1f, 0x25, 0x25, 0xdb, 0x51, 0x63, 0x27,
-	0xca, 0x4a, 0x8e, 0xd3, 0x02, 0xc5, 0x62, 0xb9, 0x3b, 0x22, 0x07, 0xe6, 0xee, 0x32, 0x3b, 0xb3,
-	0x12, 0x99, 0x63, 0x81, 0xa2, 0x3d, 0x15, 0x45, 0x0f, 0x05, 0x8a, 0x06, 0x28, 0x9a, 0x06, 0x45,
-	0x90, 0x3f, 0xa0, 0x87, 0x5e, 0x0a, 0x14, 0x3d, 0x04, 0xed, 0x25, 0x87, 0x1e, 0x8a, 0x02, 0x55,
-	0x02, 0xe5, 0x50, 0xa0, 0xfd, 0x03, 0x0a, 0xf8, 0x50, 0x14, 0xf3, 0xb1, 0x4b, 0x52, 0x8c, 0xe4,
-	0x95, 0x44, 0xaa, 0x49, 0xe1, 0x8b, 0xb8, 0xf3, 0xf1, 0xde, 0xbc, 0x99, 0xf7, 0x7b, 0x6f, 0xde,
-	0xbc, 0x19, 0xc1, 0x39, 0xd6, 0x6d, 0x63, 0xba, 0xc4, 0xff, 0x5a, 0x78, 0x0b, 0xfb, 0x8c, 0x2e,
-	0xb6, 0xc3, 0x80, 0x05, 0x28, 0x27, 0x1a, 0x1e, 0x3b, 0xe3, 0x04, 0x9e, 0x17, 0xf8, 0x4b, 0xf2,
-	0x47, 0xb6, 0x3d, 0xf6, 0x48, 0x1f, 0x51, 0x3b, 0x08, 0x5a, 0xaa, 0xfa, 0xe9, 0xbe, 0xea, 0x10,
-	0x53, 0x1c, 0x6e, 0x61, 0xcb, 0x09, 0x7c, 0x16, 0x92, 0x7a, 0xc4, 0x82, 0x50, 0xf5, 0xea, 0x1f,
-	0x91, 0x75, 0xac, 0x20, 0x62, 0xaa, 0x61, 0xa6, 0x11, 0x34, 0x02, 0xf1, 0xb9, 0xc4, 0xbf, 0x64,
-	0xad, 0xf1, 0x41, 0x06, 0xf2, 0x6b, 0x41, 0xd0, 0xba, 0x1d, 0xb8, 0x08, 0x43, 0xce, 0xa6, 0x14,
-	0xb3, 0x59, 0xed, 0xbc, 0xb6, 0x50, 0xba, 0x3c, 0xb9, 0xa8, 0xa4, 0xaa, 0xf2, 0xca, 0xda, 0xb7,
-	0x3e, 0xda, 0x99, 0x3f, 0xf5, 0xb7, 0x9d, 0xf9, 0x6f, 0x34, 0x08, 0x6b, 0x46, 0x75, 0xde, 0xb8,
-	0x44, 0xb7, 0x09, 0x73, 0x9a, 0xad, 0xae, 0xe0, 0xe5, 0x04, 0xad, 0xa4, 0xc2, 0x0f, 0x5c, 0xbc,
-	0xb4, 0xf5, 0xdc, 0x52, 0x3f, 0x07, 0x53, 0x72, 0x47, 0x2f, 0x02, 0xc8, 0x6e, 0x96, 0xed, 0xb1,
-	0xd9, 0xcc, 0x79, 0x6d, 0xa1, 0x58, 0x9b, 0x53, 0xcc, 0xcf, 0x3a, 0x01, 0xf5, 0x02, 0x4a, 0xdd,
-	0x7b, 0x8b, 0x24, 0x58, 0xf2, 0x6c, 0xd6, 0x5c, 0xbc, 0x43, 0x7c, 0x66, 0x16, 0x25, 0x45, 0xd5,
-	0x63, 0xe8, 0x89, 0x1e, 0xb9, 0xeb, 0xce, 0xea, 0xe7, 0xb5, 0x85, 0x42, 0xd2, 0xec, 0xba, 0xe8,
-	0x1a, 0x14, 0xc5, 0x30, 0x82, 0x79, 0x36, 0x15, 0xf3, 0x82, 0x20, 0xe0, 0xbc, 0x1f, 0x4f, 0x88,
-	0x5d, 0x77, 0x36, 0x27, 0x58, 0xab, 0x46, 0xd7, 0x35, 0xfe, 0xa0, 0xc1, 0xe9, 0xeb, 0x5c, 0x87,
-	0xb7, 0x88, 0x47, 0xd8, 0xfa, 0xb6, 0xdd, 0x46, 0x17, 0x60, 0x82, 0x06, 0x51, 0xe8, 0x60, 0xb5,
-	0x64, 0xe5, 0x78, 0xc9, 0x96, 0x03, 0xe2, 0xd7, 0xb2, 0x7c, 0x5c, 0x53, 0xf5, 0xe0, 0x7d, 0x99,
-	0x1d, 0x36, 0xb0, 0x9c, 0xf2, 0x3e, 0x7d, 0x65, 0x0f, 0xf4, 0x16, 0xe4, 0x58, 0xc7, 0x22, 0x72,
-	0x7a, 0xc5, 0xda, 0xf2, 0xee, 0xce, 0x7c, 0x76, 0xa3, 0xb3, 0xba, 0x72, 0x7f, 0x67, 0xfe, 0xea,
-	0x11, 0x96, 0x9f, 0x93, 0x9a, 0x59, 0xd6, 0x59, 0x75, 0x8d, 0x7f, 0xe9, 0x80, 0xc4, 0x24, 0xd6,
-	0x59, 0x88, 0x6d, 0x8f, 0xf8, 0x0d, 0x31, 0x91, 0x64, 0x40, 0x6d, 0xc4, 0x03, 0xa2, 0xc7, 0xa0,
-	0x40, 0x7c, 0x86, 0xc3, 0x2d, 0xbb, 0x25, 0x26, 0x9e, 0x35, 0x93, 0x32, 0x6f, 0x7b, 0x3b, 0xb2,
-	0x7d, 0x46, 0x58, 0x57, 0xcc, 0x34, 0x6b, 0x26, 0x65, 0x34, 0x03, 0x39, 0x27, 0x88, 0x7c, 0xa9,
-	0xc3, 0xac, 0x29, 0x0b, 0x68, 0x1e, 0x4a, 0x2d, 0x9b, 0x32, 0xab, 0x89, 0x49, 0xa3, 0xc9, 0x84,
-	0x8a, 0x74, 0x13, 0x78, 0xd5, 0x2b, 0xa2, 0x06, 0x55, 0xa1, 0xcc, 0x42, 0xdb, 0xc5, 0x96, 0x5a,
-	0xeb, 0x89, 0x54, 0x08, 0x28, 0x09, 0x9a, 0x0d, 0xb9, 0xf8, 0xcf, 0x42, 0xde, 0xc5, 0xed, 0x80,
-	0x12, 0x36, 0x9b, 0xdf, 0x57, 0x53, 0x71, 0x17, 0x64, 0x40, 0x86, 0xf8, 0xb3, 0x85, 0x7d, 0x3b,
-	0x66, 0x88, 0x8f, 0x9e, 0x06, 0x3d, 0x88, 0xd8, 0x6c, 0x71, 0xdf, 0x4e, 0xbc, 0x19, 0x3d, 0x09,
-	0xe5, 0x4d, 0x9b, 0xb4, 0xb0, 0x6b, 0xd1, 0x6d, 0xbb, 0x4d, 0x67, 0xe1, 0xbc, 0xbe, 0x90, 0x35,
-	0x4b, 0xb2, 0x8e, 0x6b, 0x89, 0xa2, 0x45, 0x38, 0xd3, 0xd7, 0xc5, 0x0a, 0xb1, 0x4d, 0x03, 0x9f,
-	0xce, 0x96, 0xce, 0xeb, 0x0b, 0x45, 0x73, 0xba, 0xd7, 0xd3, 0x94, 0x0d, 0xc6, 0xbf, 0x73, 0x50,
-	0x94, 0xda, 0xe6, 0x4a, 0x76, 0x20, 0xcb, 0xdd, 0xc9, 0xb8, 0xcc, 0x5b, 0x30, 0x47, 0x2f, 0x43,
-	0x49, 0xc8, 0xd6, 0x87, 0xf5, 0x07, 0xaf, 0x3f, 0x70, 0x12, 0xb5, 0xfc, 0xd7, 0xa0, 0x28, 0x18,
-	0xd0, 0x16, 0x69, 0x2b, 0xfc, 0x3f, 0xd0, 0x80, 0x39, 0xc1, 0x7a, 0x8b, 0xb4, 0xd1, 0x32, 0x4c,
-	0xb6, 0xc8, 0xdb, 0x11, 0x71, 0x09, 0xeb, 0x5a, 0x9b, 0x18, 0xa7, 0xf4, 0x00, 0xe5, 0x84, 0xe8,
-	0x06, 0xc6, 0xe8, 0x0e, 0x9c, 0x1b, 0x60, 0x62, 0x11, 0xdf, 0x92, 0x33, 0x17, 0x80, 0x7b, 0x30,
-	0xbb, 0x99, 0x7e, 0x76, 0xab, 0xfe, 0xba, 0xa0, 0x45, 0x5f, 0x81, 0x1c, 0xf1, 0x2d, 0xd6, 0x11,
-	0x98, 0x2c, 0x5d, 0x86, 0xc5, 0xc4, 0x58, 0x14, 0x0a, 0xb2, 0xc4, 0xdf, 0xe8, 0xa0, 0x67, 0x20,
-	0x1f, 0x44, 0xcc, 0x62, 0x1d, 0xaa, 0xe0, 0x37, 0xdc, 0x71, 0x22, 0x88, 0xd8, 0x46, 0x87, 0xa2,
-	0x4b, 0x00, 0xd8, 0x23, 0xcc, 0x92, 0x5e, 0x7b, 0x7f, 0x0c, 0x16, 0x79, 0x2f, 0xa1, 0x24, 0xa1,
-	0x9e, 0xae, 0xcf, 0x9a, 0x56, 0xe4, 0x13, 0x46, 0x05, 0x24, 0xd3, 0xa8, 0x87, 0x93, 0xdc, 0xe1,
-	0x14, 0xe8, 0x0a, 0x9c, 0xa3, 0xb1, 0xeb, 0x90, 0x28, 0x4c, 0x4c, 0x18, 0x84, 0xa5, 0x3e, 0x42,
-	0xfb, 0x3d, 0xcb, 0x1b, 0xb1, 0x3d, 0x7f, 0x15, 0x66, 0xf6, 0xd0, 0x49, 0xf3, 0x2e, 0x09, 0x22,
-	0x34, 0x40, 0xb4, 0x2c, 0x6c, 0xfd, 0x1a, 0x14, 0x39, 0xa2, 0x24, 0x10, 0xca, 0xe9, 0x80, 0xc0,
-	0x09, 0x38, 0x10, 0x8c, 0x1f, 0x67, 0x61, 0x5a, 0x20, 0xbf, 0xba, 0xb9, 0x49, 0x5a, 0xc4, 0x66,
-	0x98, 0x6b, 0x76, 0x7c, 0x6e, 0x0e, 0x41, 0xd6, 0xc3, 0x5e, 0x20, 0xf1, 0x6e, 0x8a, 0x6f, 0x64,
-	0x40, 0x39, 0x21, 0xb4, 0x3d, 0x2c, 0xc1, 0x6c, 0x0e, 0xd4, 0x21, 0x0c, 0xa7, 0x7b, 0xbb, 0x59,
-	0x88, 0x29, 0x55, 0x88, 0x7d, 0xe9, 0xfe, 0xce, 0xfc, 0x37, 0x8f, 0x62, 0x8a, 0x92, 0x8b, 0x39,
-	0x99, 0xec, 0x88, 0xbc, 0xd8, 0xdb, 0xda, 0x73, 0x63, 0xdd, 0xda, 0xab, 0x50, 0x6e, 0x84, 0x01,
-	0xa5, 0x96, 0xed, 0x09, 0xe5, 0xa6, 0xf4, 0xbe, 0x82, 0xa6, 0x2a, 0x48, 0xd0, 0x39, 0xc8, 0x73,
-	0x93, 0xab, 0xb7, 0x25, 0xfc, 0xb3, 0xe6, 0xc4, 0x26, 0xc6, 0xb5, 0x36, 0xe5, 0x61, 0x03, 0x6f,
-	0x50, 0x9c, 0x0b, 0xe9, 0xc2, 0x86, 0x4d, 0x8c, 0x25, 0x5f, 0xe3, 0x9f, 0xb9, 0x18, 0x10, 0xae,
-	0x7b, 0x2b, 0x36, 0xcf, 0x93, 0x71, 0x89, 0xd7, 0xe1, 0x74, 0x3b, 0x0c, 0xb6, 0x88, 0x8b, 0x43,
-	0x65, 0x76, 0xe9, 0xbc, 0xe2, 0x64, 0x4c, 0x25, 0x2d, 0x6f, 0x18, 0x2a, 0xfa, 0x38, 0xa0, 0xb2,
-	0x0c, 0x93, 0x49, 0x78, 0x96, 0x6c, 0xc0, 0x29, 0x5c, 0x68, 0x1c, 0xa1, 0x09, 0x2d, 0x56, 0xa1,
-	0x1c, 0x47, 0x61, 0x82, 0x47, 0x3a, 0xbf, 0x59, 0x52, 0x81, 0x98, 0x60, 0x71, 0x2f, 0xb6, 0x1e,
-	0x4b, 0x9a, 0xac, 0xc4, 0xd2, 0xea, 0xee, 0xce, 0x3c, 0xac, 0xdf, 0x5d, 0xdd, 0x58, 0x7e, 0xe5,
-	0xb8, 0x86, 0xab, 0xc2, 0xc8, 0x0d, 0x6e, 0xbe, 0x4d, 0x90, 0x63, 0xab, 0xb1, 0xf2, 0x62, 0xac,
-	0x57, 0x76, 0x77, 0xe6, 0x8b, 0x42, 0x91, 0xc7, 0x1d, 0x4a, 0x46, 0x95, 0x62, 0x24, 0x07, 0x26,
-	0x93, 0x10, 0x53, 0x28, 0xb1, 0x30, 0x12, 0x25, 0x96, 0xe3, 0x30, 0x95, 0x97, 0x8c, 0x8f, 0x74,
-	0x98, 0x14, 0x60, 0xbf, 0x4b, 0x58, 0xd3, 0x0d, 0xed, 0xed, 0x2f, 0x15, 0xd0, 0x9f, 0x84, 0x72,
-	0xdd, 0xa6, 0x84, 0x5a, 0xed, 0x80, 0xf8, 0x4c, 0xc2, 0x5c, 0x37, 0x4b, 0xa2, 0x6e, 0x4d, 0x54,
-	0xa1, 0x2a, 0x0f, 0xd4, 0xbb, 0x9e, 0x87, 0x59, 0xd8, 0x15, 0x00, 0x2d, 0xd7, 0x9e, 0x52, 0x83,
-	0x3c, 0x3e, 0x3c, 0xc8, 0x2d, 0xdc, 0xb0, 0x9d, 0xee, 0x0a, 0x76, 0xcc, 0x1e, 0x55, 0x6f, 0x3b,
-	0xce, 0x1d, 0xb8, 0x1d, 0xbf, 0x38, 0xb0, 0xc7, 0xa6, 0x73, 0x68, 0x83, 0xfb, 0xad, 0x20, 0x57,
-	0xf1, 0x43, 0x3e, 0xdd, 0x7e, 0x8b, 0xc5, 0x01, 0x83, 0x53, 0x18, 0xff, 0xc8, 0xc1, 0x23, 0x42,
-	0x95, 0x6b, 0xd8, 0x77, 0x89, 0xdf, 0x38, 0x61, 0xdf, 0xf5, 0x12, 0x94, 0xdb, 0x72, 0x60, 0x8b,
-	0x1f, 0x29, 0x85, 0x42, 0x4f, 0x5f, 0x7e, 0x7c, 0x51, 0x9c, 0x32, 0x17, 0xf7, 0xca, 0xb4, 0xd1,
-	0x6d, 0x63, 0xb3, 0xa4, 0x08, 0x78, 0xe1, 0x4b, 0xe5, 0xb4, 0x86, 0x4c, 0x33, 0x37, 0x7a, 0xd3,
-	0x1c, 0xf2, 0x8c, 0x13, 0xc7, 0xf7, 0x8c, 0xf9, 0x13, 0xf4, 0x8c, 0x85, 0xb1, 0x79, 0x46, 0xe3,
-	0xe7, 0x1a, 0x94, 0x04, 0xd2, 0x57, 0x02, 0xdf, 0x66, 0xf8, 0x64, 0xf0, 0x9d, 0x78, 0x81, 0xcc,
-	0x41, 0x5e, 0xc0, 0xf8, 0x85, 0xa6, 0x0e, 0x52, 0x6b, 0x9c, 0xe8, 0x44, 0x24, 0x7b, 0x06, 0x26,
-	0xd6, 0x99, 0xcd, 0x22, 0xaa, 0x6c, 0x6e, 0x3a, 0xb6, 0x39, 0x1e, 0xe2, 0x8a, 0x06, 0x53, 0x75,
-	0x30, 0x7e, 0xa4, 0xc9, 0x24, 0x4e, 0xd5, 0x63, 0x27, 0x95, 0xc4, 0x39, 0x0b, 0x13, 0x0a, 0xc0,
-	0x19, 0xe1, 0x9d, 0x55, 0xc9, 0xf8, 0x7b, 0x06, 0xca, 0x62, 0xa1, 0x4c, 0xbc, 0x6d, 0x87, 0x2e,
-	0xe5, 0x0e, 0xb0, 0x1e, 0xf8, 0xae, 0x15, 0x8a, 0xb2, 0x0a, 0xbc, 0x1f, 0xe8, 0x00, 0x39, 0x89,
-	0xe4, 0x80, 0xae, 0x42, 0x59, 0x1c, 0x03, 0x24, 0x03, 0xbe, 0x1a, 0xfa, 0x42, 0xe9, 0xf2, 0xe9,
-	0xbe, 0xd5, 0xa8, 0x7a, 0x4c, 0x29, 0xab, 0xc4, 0x7b, 0xc6, 0x23, 0xdf, 0x80, 0x29, 0x17, 0x6f,
-	0x59, 0x9b, 0x51, 0x6f, 0xf4, 0x74, 0xc7, 0xc9, 0x49, 0x17, 0x6f, 0xdd, 0x88, 0x12, 0x01, 0x5e,
-	0x86, 0x12, 0xf1, 0x9d, 0xc0, 0xc3, 0x56, 0x3d, 0x0a, 0xfd, 0x94, 0x9e, 0x05, 0x24, 0x49, 0x2d,
-	0x0a, 0x7d, 0xf4, 0x6d, 0x98, 0xa6, 0xdb, 0x4e, 0xd7, 0xa2, 0xcc, 0xbe, 0x87, 0x63, 0x51, 0xd2,
-	0x45, 0x44, 0x53, 0x9c, 0x70, 0x9d, 0xd3, 0x49, 0x61, 0x8c, 0x1f, 0xc4, 0x46, 0x62, 0x62, 0x3e,
-	0x31, 0x7e, 0xee, 0x70, 0x02, 0x57, 0xe6, 0x9f, 0x26, 0x4d, 0xf1, 0xcd, 0x75, 0x23, 0x33, 0x03,
-	0xea, 0x34, 0xa2, 0x4a, 0x3d, 0xac, 0xeb, 0x07, 0xee, 0x78, 0x4f, 0x81, 0x1e, 0x9f, 0x9c, 0x4b,
-	0x97, 0x4b, 0x71, 0xa7, 0x1b, 0x18, 0xc7, 0xc9, 0x8a, 0x4d, 0x8c, 0x8d, 0x3f, 0x66, 0x94, 0x41,
-	0xd4, 0x02, 0xdf, 0x45, 0x57, 0x12, 0x34, 0xa4, 0xd3, 0xaf, 0xea, 0x8d, 0x9e, 0x85, 0xa2, 0x00,
-	0x47, 0xdf, 0xd6, 0x32, 0xa5, 0x14, 0xcb, 0xf9, 0x8a, 0xed, 0xa4, 0x50, 0x57, 0x5f, 0x5c, 0x7e,
-	0xee, 0x84, 0xfc, 0xfd, 0xe5, 0x67, 0x9d, 0x55, 0x1f, 0x6d, 0x40, 0x99, 0x23, 0x77, 0xe0, 0x40,
-	0x55, 0xae, 0x5d, 0xba, 0xbf, 0x33, 0x7f, 0xb1, 0x0f, 0xf5, 0x52, 0x32, 0xf5, 0x73, 0x91, 0xba,
-	0xf7, 0x96, 0xe4, 0xb8, 0x55, 0xc7, 0x89, 0x1d, 0x77, 0x89, 0xb3, 0x89, 0xfd, 0xf6, 0x06, 0x94,
-	0x85, 0xa8, 0xfd, 0x7b, 0xc3, 0xd1, 0xb8, 0x72, 0x36, 0x71, 0xa0, 0xf6, 0xfd, 0x0c, 0xe4, 0x6f,
-	0xda, 0x54, 0x78, 0x95, 0x2f, 0x47, 0xfa, 0x75, 0x20, 0xbf, 0xaa, 0x1f, 0x32, 0xbf, 0x3a, 0x90,
-	0xd4, 0xd3, 0x55, 0x52, 0xcf, 0xb8, 0x02, 0x05, 0x01, 0xa5, 0x9b, 0x36, 0x45, 0x17, 0x20, 0xc7,
-	0x6d, 0x98, 0xce, 0x6a, 0x03, 0x66, 0xae, 0xd6, 0x48, 0xe9, 0x59, 0x76, 0x31, 0x7e, 0xa8, 0x25,
-	0xbe, 0x46, 0x64, 0xc3, 0xd1, 0x1a, 0x9c, 0xf9, 0x9c, 0xc4, 0xb8, 0x5a, 0xcf, 0x47, 0x15, 0x2b,
-	0xd5, 0x79, 0xb9, 0xd7, 0x41, 0x71, 0x45, 0xe1, 0x50, 0x4b, 0xda, 0xed, 0xe1, 0x26, 0x9c, 0x95,
-	0x69, 0x36, 0xa7, 0x89, 0xdd, 0xa8, 0x85, 0xdd, 0xd7, 0x23, 0x56, 0x0f, 0xb8, 0x7d, 0x5e, 0x84,
-	0x09, 0x99, 0xcd, 0x51, 0x52, 0x54, 0x94, 0x14, 0x1b, 0x9d, 0xd7, 0x23, 0xb6, 0xca, 0xb0, 0x17,
-	0x4f, 0x49, 0xa4, 0x74, 0x8c, 0x65, 0x15, 0xb7, 0xaf, 0x63, 0x27, 0x0a, 0x79, 0x90, 0x57, 0x01,
-	0xdd, 0xa3, 0x0d, 0x69, 0x56, 0x26, 0xff, 0x44, 0xe7, 0x21, 0x73, 0x80, 0x3c, 0x19, 0xd6, 0x31,
-	0x3e, 0xd4, 0x00, 0x24, 0x97, 0x96, 0x4d, 0x9b, 0x27, 0xb3, 0x5b, 0x5d, 0x85, 0x32, 0xe5, 0xa3,
-	0x59, 0xc9, 0xae, 0x70, 0x80, 0x97, 0x16, 0x3d, 0xd5, 0xb9, 0xfc, 0x97, 0xb1, 0x43, 0xbb, 0x1e,
-	0x86, 0x36, 0xb3, 0xc7, 0x98, 0xa2, 0xb9, 0x12, 0x43, 0x6b, 0x58, 0xb6, 0xdb, 0x81, 0x5b, 0xab,
-	0x70, 0xd9, 0x3e, 0xfc, 0x64, 0xbe, 0xa0, 0x2a, 0x68, 0x0c, 0xb3, 0x3f, 0x69, 0x0a, 0x9f, 0xe3,
-	0xcd, 0x20, 0x29, 0xb7, 0x9b, 0x39, 0xc8, 0xed, 0xee, 0x4d, 0xdf, 0xe9, 0x87, 0x4d, 0xdf, 0x19,
-	0x3f, 0xd5, 0x14, 0xc2, 0x12, 0x84, 0x5a, 0x50, 0x10, 0x10, 0xef, 0x4d, 0xea, 0xfa, 0xee, 0xce,
-	0xfc, 0xc4, 0xaa, 0x7f, 0xdc, 0x69, 0x4d, 0x70, 0xd3, 0x58, 0x75, 0x53, 0x00, 0xf6, 0x57, 0x9a,
-	0x3a, 0xe3, 0x6c, 0x50, 0xfa, 0x2a, 0xee, 0x36, 0xb0, 0xbf, 0x1e, 0x39, 0x0e, 0xf7, 0xba, 0x6f,
-	0x42, 0xbe, 0x1d, 0xd5, 0xad, 0x7b, 0xb8, 0xab, 0x64, 0x7b, 0xf1, 0xfe, 0xce, 0xfc, 0xf3, 0x47,
-	0x90, 0x68, 0x2d, 0xaa, 0xbf, 0x8a, 0xbb, 0xe6, 0x44, 0x5b, 0xfc, 0xa2, 0x59, 0xc8, 0x7b, 0xd8,
-	0xab, 0xe3, 0x50, 0xa2, 0xa1, 0x68, 0xc6, 0x45, 0xbe, 0x79, 0xaa, 0xcb, 0x05, 0x79, 0xec, 0x54,
-	0x25, 0xe3, 0x37, 0x43, 0x32, 0xde, 0xb0, 0x49, 0x2b, 0x0a, 0x31, 0x9a, 0x07, 0x91, 0xa3, 0x57,
-	0xd9, 0x78, 0x65, 0xaa, 0xc0, 0xab, 0x64, 0x1a, 0x1e, 0x3d, 0x01, 0x40, 0x28, 0xd7, 0x98, 0x63,
-	0x53, 0xe9, 0x71, 0x0b, 0x66, 0x91, 0xd0, 0x3b, 0xb2, 0x82, 0xd3, 0xd7, 0x5b, 0xb6, 0x87, 0x2d,
-	0x2e, 0x32, 0xd7, 0x29, 0x97, 0x07, 0x44, 0xd5, 0x6b, 0xbc, 0x86, 0x7b, 0xcd, 0x90, 0xab, 0x4a,
-	0x86, 0x1e, 0xa6, 0x2c, 0xf4, 0x09, 0x9a, 0x1b, 0x10, 0xf4, 0x5d, 0x0d, 0x66, 0x06, 0x05, 0xbd,
-	0x8d, 0x59, 0x48, 0x9c, 0xb1, 0xad, 0xe5, 0xb3, 0x80, 0x3c, 0xec, 0x12, 0xdb, 0xb7, 0xdc, 0x28,
-	0xb4, 0x19, 0x09, 0x7c, 0xcb, 0xa3, 0x2a, 0x2c, 0xac, 0xc8, 0x96, 0x15, 0xd5, 0x70, 0x9b, 0x72,
-	0x7b, 0xef, 0x5f, 0x47, 0x4a, 0x1a, 0xb1, 0x7c, 0xe3, 0x33, 0xad, 0xc3, 0x49, 0xf8, 0x9e, 0x06,
-	0x53, 0x3d, 0xf7, 0x29, 0x12, 0x0e, 0x43, 0x31, 0x85, 0x36, 0x92, 0x98, 0xe2, 0xc9, 0xd8, 0x69,
-	0xaa, 0x44, 0x87, 0x94, 0x48, 0xba, 0x47, 0x95, 0xe8, 0xe8, 0xc5, 0x72, 0x7a, 0x7f, 0x2c, 0x67,
-	0x34, 0xe1, 0x5c, 0x72, 0x1e, 0xa9, 0xd9, 0x2d, 0xdb, 0x77, 0xf0, 0x72, 0xd3, 0xf6, 0x1b, 0xd8,
-	0x45, 0x5f, 0x07, 0x11, 0x06, 0x5b, 0x8e, 0x28, 0x2b, 0xb7, 0xbf, 0xd7, 0xdb, 0x49, 0xe3, 0x03,
-	0xde, 0x51, 0xd2, 0xed, 0x17, 0x35, 0x1a, 0x1f, 0xc4, 0x1e, 0xe3, 0x36, 0xf1, 0x99, 0x88, 0x67,
-	0x2f, 0xc1, 0x04, 0x8d, 0xda, 0xed, 0x96, 0xc4, 0xd1, 0xe9, 0x64, 0x67, 0x8d, 0x3b, 0xac, 0x8b,
-	0x46, 0x11, 0xbc, 0xa9, 0x8e, 0x1c, 0xc2, 0x2e, 0xf6, 0x03, 0x4f, 0xf1, 0x96, 0x85, 0xbe, 0xb0,
-	0x51, 0x3f, 0x54, 0xd8, 0xd8, 0x13, 0x35, 0x3b, 0x20, 0xea, 0x27, 0x3a, 0xcc, 0x4a, 0x6c, 0x85,
-	0xb6, 0x8b, 0xab, 0x8e, 0x08, 0x2f, 0x56, 0xd4, 0x45, 0xdd, 0x51, 0x63, 0xd4, 0x24, 0x2c, 0xcb,
-	0x8c, 0x35, 0x2c, 0x1b, 0x4a, 0x3e, 0xe8, 0x63, 0x48, 0x3e, 0x9c, 0xd0, 0x6d, 0x43, 0x62, 0xc9,
-	0xb9, 0x51, 0x5f, 0x5f, 0x7f, 0xaa, 0xc3, 0xa3, 0x43, 0x1a, 0x4e, 0x92, 0x9c, 0x0f, 0x55, 0xfc,
-	0x7f, 0xa0, 0xe2, 0x5f, 0xc7, 0x46, 0x2c, 0x62, 0x60, 0xec, 0x8a, 0x25, 0x3c, 0xae, 0x11, 0x3f,
-	0x73, 0xa0, 0x86, 0x55, 0x08, 0xfe, 0x50, 0x4b, 0xe9, 0xb5, 0xf4, 0x7e, 0x6c, 0x88, 0xfd, 0x5a,
-	0x3a, 0xb6, 0x21, 0x3e, 0x54, 0xd3, 0x88, 0xd5, 0xf4, 0xfb, 0x4c, 0x7c, 0x32, 0x15, 0x5d, 0xf9,
-	0xee, 0x1f, 0x9b, 0xd2, 0x5b, 0x43, 0x73, 0x3b, 0x72, 0x50, 0xf3, 0xa0, 0x64, 0x7c, 0xe6, 0x08,
-	0xc9, 0xf8, 0xaf, 0x41, 0xee, 0x30, 0x67, 0x1c, 0xd9, 0x19, 0xad, 0xc5, 0x2b, 0x29, 0xf5, 0x74,
-	0xed, 0xf8, 0x2b, 0xf8, 0xe7, 0xac, 0x8a, 0xb4, 0x7a, 0x2b, 0x98, 0xc0, 0x7c, 0x7c, 0x4b, 0xb8,
-	0xf7, 0x0a, 0x2c, 0x33, 0x7c, 0x05, 0x36, 0xb4, 0xca, 0xfa, 0x71, 0x56, 0x39, 0x7b, 0xa4, 0x55,
-	0xce, 0x8d, 0x68, 0x95, 0xd1, 0x22, 0x9c, 0xb1, 0xe3, 0x87, 0x1a, 0x96, 0x9a, 0x39, 0xa3, 0xe2,
-	0x72, 0x44, 0x37, 0xa7, 0x93, 0xa6, 0x9a, 0x98, 0x3f, 0xa3, 0x68, 0x15, 0x2a, 0xbd, 0xfe, 0x6a,
-	0xfe, 0xe9, 0xee, 0xd6, 0xa6, 0x12, 0xba, 0xe4, 0x36, 0x65, 0xba, 0x8f, 0xd5, 0x48, 0x2f, 0x65,
-	0x7b, 0x32, 0xc6, 0xf9, 0xbe, 0x77, 0x73, 0x2a, 0x98, 0xbe, 0x15, 0xd8, 0xfe, 0xeb, 0x6d, 0xec,
-	0xa3, 0x37, 0x60, 0xc6, 0x09, 0x5a, 0x2d, 0x9b, 0xe1, 0xd0, 0x6e, 0x59, 0xea, 0x55, 0x19, 0x4e,
-	0x9b, 0x28, 0x3f, 0xd3, 0xa3, 0x5d, 0x89, 0x49, 0xd1, 0x3b, 0x50, 0xe9, 0x63, 0x39, 0xd6, 0xc8,
-	0x66, 0xaa, 0x37, 0x90, 0xbc, 0xef, 0xbc, 0x0b, 0xe7, 0x7a, 0x55, 0xe4, 0x1d, 0x79, 0xd8, 0x12,
-	0x47, 0xab, 0x94, 0xf8, 0x3c, 0x3b, 0x44, 0x6e, 0xf2, 0xbf, 0xe8, 0x65, 0x28, 0xb9, 0xb8, 0xce,
-	0x2c, 0x42, 0x69, 0x84, 0xdd, 0xb4, 0x59, 0x78, 0x4e, 0xb2, 0x2a, 0x28, 0xd0, 0x06, 0xe4, 0x82,
-	0x6d, 0x1f, 0x87, 0x23, 0xba, 0xd5, 0x93, 0xcc, 0x50, 0x08, 0x65, 0xf9, 0xd2, 0xad, 0xef, 0x82,
-	0x78, 0x0c, 0xeb, 0x5c, 0x92, 0x83, 0xc8, 0x35, 0x4e, 0xb6, 0x8b, 0xfc, 0xa8, 0xb7, 0x8b, 0xbf,
-	0xc4, 0xaf, 0x43, 0x39, 0x3c, 0x4d, 0xdc, 0xb6, 0xbb, 0x1e, 0xf6, 0xd9, 0x1e, 0x8c, 0x6e, 0x2b,
-	0xf7, 0xe7, 0x1f, 0x1e, 0xa3, 0xb1, 0xe7, 0xf4, 0xff, 0xa7, 0x18, 0x8d, 0xa1, 0x14, 0xe2, 0xb6,
-	0x4d, 0xd2, 0x5e, 0x0a, 0x09, 0x28, 0x99, 0x82, 0xa2, 0x07, 0xa5, 0xec, 0x28, 0xa1, 0x34, 0x3e,
-	0xb5, 0xfe, 0x4e, 0x8f, 0x33, 0x1a, 0xe2, 0x7a, 0xf8, 0x35, 0xdb, 0xc3, 0x08, 0x41, 0x56, 0x3c,
-	0x4a, 0x93, 0xe9, 0x2a, 0xf1, 0x8d, 0x4c, 0xc8, 0x39, 0x4d, 0x9b, 0xa8, 0x0c, 0x40, 0xed, 0x85,
-	0xfb, 0x47, 0x5b, 0xf6, 0x65, 0xce, 0xc3, 0x94, 0xac, 0xd0, 0x5b, 0x90, 0x1f, 0x6d, 0x84, 0x16,
-	0xb3, 0xe3, 0x7b, 0x40, 0x88, 0x1b, 0x84, 0x32, 0x95, 0xd2, 0x49, 0xff, 0xdc, 0x73, 0xaa, 0x9f,
-	0xee, 0x06, 0xc6, 0xe8, 0x79, 0x28, 0x88, 0x6b, 0x42, 0xdb, 0x4b, 0xfb, 0x54, 0x29, 0xcf, 0xfb,
-	0x57, 0x3d, 0x91, 0x8b, 0xc0, 0x9d, 0x36, 0x09, 0xb1, 0xda, 0xac, 0x54, 0x09, 0xdd, 0x8c, 0x31,
-	0x92, 0x3f, 0x6a, 0x48, 0x20, 0xe9, 0x8d, 0xab, 0xc9, 0x95, 0x00, 0xbb, 0x4d, 0x3c, 0x12, 0xa2,
-	0x0a, 0xe8, 0x49, 0x0e, 0xcf, 0xe4, 0x9f, 0x68, 0x06, 0x72, 0x5b, 0x76, 0x2b, 0xc2, 0x71, 0x76,
-	0x45, 0x14, 0x8c, 0x3b, 0xea, 0xc1, 0xdb, 0x3a, 0x66, 0xaf, 0x05, 0x2e, 0x3e, 0x14, 0x31, 0x9a,
-	0xdd, 0xa3, 0xb6, 0x64, 0xd9, 0x8d, 0x05, 0x75, 0xe9, 0xf2, 0x26, 0x0e, 0x29, 0x09, 0x7c, 0xde,
-	0x73, 0x4b, 0x7e, 0x2a, 0xae, 0x71, 0xd1, 0xf8, 0x99, 0xae, 0x52, 0xfb, 0xea, 0x01, 0xec, 0xc3,
-	0x53, 0xc1, 0x17, 0xe4, 0x54, 0xf0, 0xdb, 0x38, 0x07, 0xbb, 0x7e, 0x77, 0xf9, 0x3b, 0x2b, 0xdc,
-	0x12, 0x48, 0x3d, 0xe2, 0xb6, 0xf0, 0x05, 0x3f, 0x14, 0x18, 0xff, 0xc9, 0xa8, 0x7f, 0xc1, 0xe0,
-	0x82, 0x2f, 0xb7, 0x6c, 0xe2, 0x7d, 0x8e, 0x32, 0xb4, 0x71, 0x28, 0x43, 0x3c, 0x6b, 0x77, 0xba,
-	0x87, 0x13, 0x1e, 0x38, 0x89, 0x0a, 0x33, 0xbf, 0x07, 0xd0, 0xba, 0x34, 0x62, 0x58, 0x16, 0x5b,
-	0x97, 0x86, 0x1e, 0xf8, 0x66, 0xc7, 0x99, 0xc2, 0x32, 0xde, 0xd3, 0xfa, 0x14, 0x20, 0xde, 0x25,
-	0xf4, 0x3b, 0x78, 0x6d, 0xb4, 0x0e, 0xfe, 0xca, 0xc0, 0x1b, 0x93, 0xd4, 0xfe, 0xc2, 0x78, 0x5f,
-	0x83, 0x4a, 0x22, 0xe4, 0x1d, 0x9f, 0x7e, 0x31, 0xc5, 0xbc, 0x70, 0x11, 0x66, 0x3e, 0xef, 0xfd,
-	0x1c, 0xca, 0x83, 0x6e, 0xbb, 0x6e, 0xe5, 0x14, 0x2a, 0x43, 0x21, 0x8e, 0xb5, 0x2a, 0xda, 0x85,
-	0x5b, 0x50, 0x88, 0xdf, 0x44, 0xa0, 0x49, 0xf5, 0x6e, 0x82, 0x47, 0x23, 0x95, 0x53, 0x68, 0x1a,
-	0x26, 0xd5, 0x1b, 0x1b, 0x16, 0x85, 0x3e, 0x76, 0x2b, 0x1a, 0x9a, 0x1a, 0x78, 0x76, 0x53, 0xc9,
-	0x24, 0x24, 0x4e, 0x40, 0x59, 0x45, 0xbf, 0xb0, 0x00, 0x68, 0x38, 0x5d, 0x8f, 0x0a, 0x90, 0xf5,
-	0x88, 0xcf, 0x2a, 0xa7, 0xf8, 0x57, 0x3d, 0x0a, 0xfd, 0x8a, 0x56, 0x7b, 0xf3, 0xa3, 0xdd, 0x39,
-	0xed, 0xe3, 0xdd, 0x39, 0xed, 0xd3, 0xdd, 0x39, 0xed, 0x27, 0x9f, 0xcd, 0x9d, 0xfa, 0xf8, 0xb3,
-	0xb9, 0x53, 0x7f, 0xfd, 0x6c, 0xee, 0xd4, 0x77, 0x5f, 0x38, 0xcc, 0xea, 0x75, 0x92, 0x1a, 0xe9,
-	0x2a, 0xea, 0x13, 0xa2, 0xeb, 0x73, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x38, 0x9a, 0xde,
-	0x23, 0x37, 0x00, 0x00,
+	// 3100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xd5, 0x5e, 0x2e, 0x7f, 0x3e, 0x52, 0x16, 0x35, 0x56, 0x2c, 0x26, 0xc1, 0x27, 0x39, 0x9b, 0x7c,
+	0x80, 0x62, 0xc4, 0xd2, 0x67, 0xe7, 0xfb, 0xec, 0x2f, 0x75, 0x7e, 0x54, 0x94, 0x6c, 0x47, 0x8d,
+	0x95, 0x38, 0x2b, 0x39, 0x4e, 0x0b, 0x14, 0x8b, 0xe5, 0xee, 0x88, 0x1a, 0x98, 0xbb, 0xcb, 0xec,
+	0xcc, 0x4a, 0x64, 0x8e, 0x05, 0x8a, 0xf6, 0x54, 0x14, 0x3d, 0x14, 0x28, 0x1a, 0x20, 0x68, 0x1b,
+	0x14, 0x41, 0xfe, 0x80, 0x1e, 0x7a, 0x29, 0x50, 0xf4, 0x10, 0xb4, 0x97, 0x1c, 0x7a, 0x68, 0x0b,
+	0x54, 0x09, 0x94, 0x43, 0x81, 0xe6, 0x0f, 0x28, 0xe0, 0x43, 0x51, 0xcc, 0x8f, 0x5d, 0x92, 0x62,
+	0xa4, 0xac, 0x24, 0x52, 0x4d, 0x0a, 0x5f, 0x24, 0xce, 0xcc, 0x7b, 0x6f, 0xde, 0xbc, 0x5f, 0xf3,
+	0xe6, 0xcd, 0x2c, 0xcc, 0xb0, 0x6e, 0x1b, 0xd3, 0x45, 0xfe, 0xd7, 0xc2, 0xdb, 0xd8, 0x67, 0x74,
+	0xa1, 0x1d, 0x06, 0x2c, 0x40, 0x39, 0x31, 0xf0, 0xd8, 0x39, 0x27, 0xf0, 0xbc, 0xc0, 0x5f, 0x94,
+	0xff, 0xe4, 0xd8, 0x63, 0x8f, 0xf4, 0x21, 0xb5, 0x83, 0xa0, 0xa5, 0xba, 0x9f, 0xea, 0xeb, 0x0e,
+	0x31, 0xc5, 0xe1, 0x36, 0xb6, 0x9c, 0xc0, 0x67, 0x21, 0x69, 0x44, 0x2c, 0x08, 0x15, 0x54, 0xff,
+	0x8c, 0xac, 0x63, 0x05, 0x11, 0x53, 0x03, 0xd3, 0xcd, 0xa0, 0x19, 0x88, 0x9f, 0x8b, 0xfc, 0x97,
+	0xec, 0x35, 0xde, 0xcf, 0x40, 0xe1, 0x4e, 0x10, 0xb4, 0xd6, 0x02, 0x17, 0x61, 0xc8, 0xd9, 0x94,
+	0x62, 0x56, 0xd3, 0x2e, 0x68, 0xf3, 0xe5, 0x2b, 0x13, 0x0b, 0x8a, 0xab, 0x25, 0xde, 0x59, 0xff,
+	0xfa, 0x87, 0xbb, 0x73, 0x67, 0xfe, 0xb2, 0x3b, 0xf7, 0xff, 0x4d, 0xc2, 0xb6, 0xa2, 0x06, 0x1f,
+	0x5c, 0xa4, 0x3b, 0x84, 0x39, 0x5b, 0xad, 0xae, 0xa0, 0xe5, 0x04, 0xad, 0xa4, 0xc3, 0x0f, 0x5c,
+	0xbc, 0xb8, 0xfd, 0xec, 0x62, 0x3f, 0x05, 0x53, 0x52, 0x47, 0x2f, 0x00, 0x48, 0x30, 0xcb, 0xf6,
+	0x58, 0x2d, 0x73, 0x41, 0x9b, 0x2f, 0xd5, 0x67, 0x15, 0xf1, 0xf3, 0x4e, 0x40, 0xbd, 0x80, 0x52,
+	0xf7, 0xfe, 0x02, 0x09, 0x16, 0x3d, 0x9b, 0x6d, 0x2d, 0xdc, 0x25, 0x3e, 0x33, 0x4b, 0x12, 0x63,
+	0xc9, 0x63, 0xe8, 0xbf, 0x7a, 0xe8, 0xae, 0x5b, 0xd3, 0x2f, 0x68, 0xf3, 0xc5, 0x64, 0xd8, 0x75,
+	0xd1, 0x75, 0x28, 0x89, 0x69, 0x04, 0xf1, 0x6c, 0x2a, 0xe2, 0x45, 0x81, 0xc0, 0x69, 0x3f, 0x9e,
+	0x20, 0xbb, 0x6e, 0x2d, 0x27, 0x48, 0xab, 0x41, 0xd7, 0x35, 0x7e, 0xab, 0xc1, 0xd9, 0x1b, 0x5c,
+	0x87, 0xb7, 0x89, 0x47, 0xd8, 0xfa, 0x8e, 0xdd, 0x46, 0x17, 0x21, 0x4f, 0x83, 0x28, 0x74, 0xb0,
+	0x12, 0x59, 0x25, 0x16, 0xd9, 0x72, 0x40, 0xfc, 0x7a, 0x96, 0xcf, 0x6b, 0x2a, 0x08, 0x0e, 0xcb,
+	0xec, 0xb0, 0x89, 0xe5, 0x92, 0x0f, 0x80, 0x95, 0x10, 0xe8, 0x4d, 0xc8, 0xb1, 0x8e, 0x45, 0xe4,
+	0xf2, 0x4a, 0xf5, 0xe5, 0xbd, 0xdd, 0xb9, 0xec, 0x46, 0x67, 0x75, 0xe5, 0xc1, 0xee, 0xdc, 0xb5,
+	0x63, 0x88, 0x9f, 0xa3, 0x9a, 0x59, 0xd6, 0x59, 0x75, 0x8d, 0xcf, 0x74, 0x40, 0x62, 0x11, 0xeb,
+	0x2c, 0xc4, 0xb6, 0x47, 0xfc, 0xa6, 0x58, 0x48, 0x32, 0xa1, 0x36, 0xe2, 0x09, 0xd1, 0x63, 0x50,
+	0x24, 0x3e, 0xc3, 0xe1, 0xb6, 0xdd, 0x12, 0x0b, 0xcf, 0x9a, 0x49, 0x9b, 0x8f, 0xbd, 0x15, 0xd9,
+	0x3e, 0x23, 0xac, 0x2b, 0x56, 0x9a, 0x35, 0x93, 0x36, 0x9a, 0x86, 0x9c, 0x13, 0x44, 0xbe, 0xd4,
+	0x61, 0xd6, 0x94, 0x0d, 0x34, 0x07, 0xe5, 0x96, 0x4d, 0x99, 0xb5, 0x85, 0x49, 0x73, 0x8b, 0x09,
+	0x15, 0xe9, 0x26, 0xf0, 0xae, 0x97, 0x45, 0x0f, 0x5a, 0x82, 0x0a, 0x0b, 0x6d, 0x17, 0x5b, 0x4a,
+	0xd6, 0xf9, 0x54, 0x16, 0x50, 0x16, 0x38, 0x1b, 0x52, 0xf8, 0xcf, 0x40, 0xc1, 0xc5, 0xed, 0x80,
+	0x12, 0x56, 0x2b, 0x1c, 0xa8, 0xa9, 0x18, 0x04, 0x19, 0x90, 0x21, 0x7e, 0xad, 0x78, 0x20, 0x60,
+	0x86, 0xf8, 0xe8, 0x29, 0xd0, 0x83, 0x88, 0xd5, 0x4a, 0x07, 0x02, 0xf1, 0x61, 0xf4, 0x04, 0x54,
+	0x36, 0x6d, 0xd2, 0xc2, 0xae, 0x45, 0x77, 0xec, 0x36, 0xad, 0xc1, 0x05, 0x7d, 0x3e, 0x6b, 0x96,
+	0x65, 0x1f, 0xd7, 0x12, 0x45, 0x0b, 0x70, 0xae, 0x0f, 0xc4, 0x0a, 0xb1, 0x4d, 0x03, 0x9f, 0xd6,
+	0xca, 0x17, 0xf4, 0xf9, 0x92, 0x39, 0xd5, 0x83, 0x34, 0xe5, 0x80, 0xf1, 0x8f, 0x1c, 0x94, 0xa4,
+	0xb6, 0xb9, 0x92, 0x1d, 0xc8, 0xf2, 0x70, 0x32, 0x2e, 0xf7, 0x16, 0xc4, 0xd1, 0x4b, 0x50, 0x16,
+	0xbc, 0xf5, 0xd9, 0xfa, 0x17, 0xcb, 0x1f, 0x38, 0x8a, 0x12, 0xff, 0x75, 0x28, 0x09, 0x02, 0xb4,
+	0x45, 0xda, 0x35, 0x3d, 0x15, 0x7a, 0x91, 0x23, 0xac, 0xb7, 0x48, 0x1b, 0x2d, 0xc3, 0x44, 0x8b,
+	0xbc, 0x15, 0x11, 0x97, 0xb0, 0xae, 0xb5, 0x89, 0x71, 0xca, 0x08, 0x50, 0x49, 0x90, 0x6e, 0x62,
+	0x8c, 0xee, 0xc2, 0xcc, 0x00, 0x11, 0x8b, 0xf8, 0x96, 0x5c, 0x79, 0x2d, 0x97, 0x8a, 0xdc, 0x74,
+	0x3f, 0xb9, 0x55, 0x7f, 0x5d, 0xe0, 0xa2, 0xff, 0x86, 0x1c, 0xf1, 0x2d, 0xd6, 0x11, 0x36, 0x59,
+	0xbe, 0x02, 0x0b, 0x89, 0xb3, 0x28, 0x2b, 0xc8, 0x12, 0x7f, 0xa3, 0x83, 0x9e, 0x86, 0x42, 0x10,
+	0x31, 0x8b, 0x75, 0x68, 0xad, 0x70, 0x00, 0x60, 0x3e, 0x88, 0xd8, 0x46, 0x87, 0xa2, 0xcb, 0x00,
+	0xd8, 0x23, 0xcc, 0x92, 0x51, 0xfb, 0x60, 0x1b, 0x2c, 0x71, 0x28, 0xa1, 0x24, 0xa1, 0x9e, 0xae,
+	0xcf, 0xb6, 0xac, 0xc8, 0x27, 0x8c, 0xd6, 0x4a, 0xa9, 0xd6, 0x03, 0x02, 0xe5, 0x2e, 0xc7, 0x40,
+	0x57, 0x61, 0x86, 0xc6, 0xa1, 0x43, 0x5a, 0x61, 0xe2, 0xc2, 0x20, 0x3c, 0xf5, 0x11, 0xda, 0x1f,
+	0x59, 0x5e, 0x8f, 0xfd, 0xf9, 0x7f, 0x60, 0x7a, 0x1f, 0x9e, 0x74, 0xef, 0xb2, 0x40, 0x42, 0x03,
+	0x48, 0xcb, 0xc2, 0xd7, 0xaf, 0x43, 0x89, 0x5b, 0x94, 0x34, 0x84, 0x4a, 0x3a, 0x43, 0xe0, 0x08,
+	0xdc, 0x10, 0x8c, 0x1f, 0x64, 0x61, 0x4a, 0x58, 0xfe, 0xd2, 0xe6, 0x26, 0x69, 0x11, 0x9b, 0x61,
+	0xae, 0xd9, 0xf1, 0x85, 0x39, 0x04, 0x59, 0x0f, 0x7b, 0x81, 0xb4, 0x77, 0x53, 0xfc, 0x46, 0x06,
+	0x54, 0x12, 0x44, 0xdb, 0xc3, 0xd2, 0x98, 0xcd, 0x81, 0x3e, 0x84, 0xe1, 0x6c, 0x6f, 0x37, 0x0b,
+	0x31, 0xa5, 0xca, 0x62, 0x5f, 0x7c, 0xb0, 0x3b, 0xf7, 0xb5, 0xe3, 0xb8, 0xa2, 0xa4, 0x62, 0x4e,
+	0x24, 0x3b, 0x22, 0x6f, 0xf6, 0xb6, 0xf6, 0xdc, 0x58, 0xb7, 0xf6, 0x25, 0xa8, 0x34, 0xc3, 0x80,
+	0x52, 0xcb, 0xf6, 0x84, 0x72, 0x53, 0x46, 0x5f, 0x81, 0xb3, 0x24, 0x50, 0xd0, 0x0c, 0x14, 0xb8,
+	0xcb, 0x35, 0xda, 0xd2, 0xfc, 0xb3, 0x66, 0x7e, 0x13, 0xe3, 0x7a, 0x9b, 0xf2, 0xb4, 0x81, 0x0f,
+	0x28, 0xca, 0xc5, 0x74, 0x69, 0xc3, 0x26, 0xc6, 0x92, 0xae, 0xf1, 0xf7, 0x5c, 0x6c, 0x10, 0xae,
+	0x7b, 0x3b, 0x76, 0xcf, 0xd3, 0x09, 0x89, 0x37, 0xe0, 0x6c, 0x3b, 0x0c, 0xb6, 0x89, 0x8b, 0x43,
+	0xe5, 0x76, 0xe9, 0xa2, 0xe2, 0x44, 0x8c, 0x25, 0x3d, 0x6f, 0xd8, 0x54, 0xf4, 0x71, 0x98, 0xca,
+	0x32, 0x4c, 0xc4, 0xd3, 0x78, 0xc9, 0x06, 0x9c, 0x22, 0x84, 0x2a, 0x2a, 0x52, 0x8b, 0x4b, 0x50,
+	0x89, 0xb3, 0x30, 0x41, 0x23, 0x5d, 0xdc, 0x2c, 0xab, 0x44, 0x4c, 0x90, 0xb8, 0x1f, 0x7b, 0x8f,
+	0x25, 0x5d, 0x56, 0xda, 0xd2, 0xea, 0xde, 0xee, 0x1c, 0xac, 0xdf, 0x5b, 0xdd, 0x58, 0x7e, 0xf9,
+	0xa4, 0x8e, 0xab, 0xd2, 0xc8, 0x0d, 0xee, 0xbe, 0x5b, 0x20, 0xe7, 0x56, 0x73, 0x15, 0xc4, 0x5c,
+	0x2f, 0xef, 0xed, 0xce, 0x95, 0x84, 0x22, 0x4f, 0x3a, 0x95, 0xcc, 0x2a, 0xc5, 0x4c, 0x0e, 0x4c,
+	0x24, 0x29, 0xa6, 0x50, 0x62, 0x71, 0x24, 0x4a, 0xac, 0xc4, 0x69, 0x2a, 0x6f, 0x19, 0x1f, 0xea,
+	0x30, 0x21, 0x8c, 0xfd, 0x1e, 0x61, 0x5b, 0x6e, 0x68, 0xef, 0x7c, 0xa5, 0x0c, 0xfd, 0x09, 0xa8,
+	0x34, 0x6c, 0x4a, 0xa8, 0xd5, 0x0e, 0x88, 0xcf, 0xa4, 0x99, 0xeb, 0x66, 0x59, 0xf4, 0xdd, 0x11,
+	0x5d, 0x68, 0x89, 0x27, 0xea, 0x5d, 0xcf, 0xc3, 0x2c, 0xec, 0x0a, 0x03, 0xad, 0xd4, 0x9f, 0x54,
+	0x93, 0x3c, 0x3e, 0x3c, 0xc9, 0x6d, 0xdc, 0xb4, 0x9d, 0xee, 0x0a, 0x76, 0xcc, 0x1e, 0x56, 0x6f,
+	0x3b, 0xce, 0x1d, 0xba, 0x1d, 0xbf, 0x30, 0xb0, 0xc7, 0xa6, 0x0b, 0x68, 0x83, 0xfb, 0xad, 0x40,
+	0x57, 0xf9, 0x43, 0x21, 0xdd, 0x7e, 0x8b, 0xc5, 0x01, 0x83, 0x63, 0x18, 0x7f, 0xcb, 0xc1, 0x23,
+	0x42, 0x95, 0x77, 0xb0, 0xef, 0x12, 0xbf, 0x79, 0xca, 0xb1, 0xeb, 0x45, 0xa8, 0xb4, 0xe5, 0xc4,
+	0x16, 0x3f, 0x52, 0x0a, 0x85, 0x9e, 0xbd, 0xf2, 0xf8, 0x02, 0x6f, 0xd0, 0x85, 0xfd, 0x3c, 0x6d,
+	0x74, 0xdb, 0xd8, 0x2c, 0x2b, 0x04, 0xde, 0xf8, 0x4a, 0x05, 0xad, 0x21, 0xd7, 0xcc, 0x8d, 0xde,
+	0x35, 0x87, 0x22, 0x63, 0xfe, 0xe4, 0x91, 0xb1, 0x70, 0x8a, 0x91, 0xb1, 0x38, 0xb6, 0xc8, 0x68,
+	0xfc, 0x44, 0x83, 0xb2, 0xb0, 0xf4, 0x95, 0xc0, 0xb7, 0x19, 0x3e, 0x1d, 0xfb, 0x4e, 0xa2, 0x40,
+	0xe6, 0xb0, 0x28, 0x60, 0xfc, 0x54, 0x53, 0x07, 0x29, 0x5e, 0x2b, 0x39, 0x1d, 0xce, 0x9e, 0x86,
+	0xfc, 0x3a, 0xb3, 0x59, 0x44, 0x95, 0xcf, 0x4d, 0xc5, 0x3e, 0xc7, 0x53, 0x5c, 0x31, 0x60, 0x2a,
+	0x00, 0xe3, 0xfb, 0x9a, 0x2c, 0xe2, 0xf0, 0x12, 0xc6, 0x29, 0x15, 0x71, 0xce, 0x43, 0x5e, 0x19,
+	0x70, 0x46, 0x44, 0x67, 0xd5, 0x32, 0xfe, 0x9a, 0x81, 0x8a, 0x10, 0x94, 0x89, 0x77, 0xec, 0xd0,
+	0xa5, 0x3c, 0x00, 0x36, 0x02, 0xdf, 0xb5, 0x42, 0xd1, 0xae, 0x69, 0xa9, 0xcc, 0x1d, 0x38, 0x8a,
+	0xa4, 0x80, 0xae, 0x41, 0x45, 0x1c, 0x03, 0x24, 0x01, 0x2e, 0x0d, 0x7d, 0xbe, 0x7c, 0xe5, 0x6c,
+	0x9f, 0x34, 0x96, 0x3c, 0xa6, 0x94, 0x55, 0xe6, 0x90, 0xf1, 0xcc, 0x37, 0x61, 0xd2, 0xc5, 0xdb,
+	0xd6, 0x66, 0xd4, 0x9b, 0x3d, 0xdd, 0x71, 0x72, 0xc2, 0xc5, 0xdb, 0x37, 0xa3, 0x84, 0x81, 0x97,
+	0xa0, 0x4c, 0x7c, 0x27, 0xf0, 0xb0, 0xd5, 0x88, 0x42, 0x3f, 0x65, 0x64, 0x01, 0x89, 0x52, 0x8f,
+	0x42, 0x1f, 0x7d, 0x03, 0xa6, 0xe8, 0x8e, 0xd3, 0xb5, 0x28, 0xb3, 0xef, 0xe3, 0x98, 0x95, 0x74,
+	0x19, 0xd1, 0x24, 0x47, 0x5c, 0xe7, 0x78, 0x92, 0x19, 0xe3, 0xbb, 0xb1, 0x93, 0x98, 0x98, 0x2f,
+	0x8c, 0x9f, 0x3b, 0x9c, 0xc0, 0x95, 0xf5, 0xa7, 0x09, 0x53, 0xfc, 0xe6, 0xba, 0x91, 0x95, 0x01,
+	0x75, 0x1a, 0x51, 0xad, 0x9e, 0xad, 0xeb, 0x87, 0xee, 0x78, 0x4f, 0x82, 0x1e, 0x9f, 0x9c, 0xcb,
+	0x57, 0xca, 0x31, 0xd0, 0x4d, 0x8c, 0xe3, 0x62, 0xc5, 0x26, 0xc6, 0xc6, 0xef, 0x32, 0xca, 0x21,
+	0xea, 0x81, 0xef, 0xa2, 0xab, 0x89, 0x35, 0xa4, 0xd3, 0xaf, 0x82, 0x46, 0xcf, 0x40, 0x49, 0x18,
+	0x47, 0xdf, 0xd6, 0x32, 0xa9, 0x14, 0xcb, 0xe9, 0x8a, 0xed, 0xa4, 0xd8, 0x50, 0xbf, 0x38, 0xff,
+	0x3c, 0x08, 0xf9, 0x07, 0xf3, 0xcf, 0x3a, 0xab, 0x3e, 0xda, 0x80, 0x0a, 0xb7, 0xdc, 0x81, 0x03,
+	0x55, 0xa5, 0x7e, 0xf9, 0xc1, 0xee, 0xdc, 0xa5, 0x3e, 0xab, 0x97, 0x9c, 0xa9, 0x7f, 0x97, 0xa8,
+	0x7b, 0x7f, 0x51, 0xce, 0xbb, 0xe4, 0x38, 0x71, 0xe0, 0x2e, 0x73, 0x32, 0xaa, 0xc1, 0xa9, 0x0a,
+	0x56, 0xfb, 0xf7, 0x86, 0xe3, 0x51, 0xe5, 0x64, 0x54, 0xc3, 0xf8, 0x4e, 0x06, 0x0a, 0xb7, 0x6c,
+	0x2a, 0xa2, 0xca, 0x57, 0xa3, 0xfc, 0x3a, 0x50, 0x5f, 0xd5, 0x8f, 0x58, 0x5f, 0x1d, 0x28, 0xea,
+	0xe9, 0xaa, 0xa8, 0x67, 0x5c, 0x85, 0xa2, 0x30, 0xa5, 0x5b, 0x36, 0x45, 0x17, 0x21, 0xc7, 0x7d,
+	0x98, 0xd6, 0xb4, 0x01, 0x37, 0x57, 0x32, 0x52, 0x7a, 0x96, 0x20, 0xc6, 0xf7, 0xb4, 0x24, 0xd6,
+	0x88, 0x6a, 0x38, 0xba, 0x03, 0xe7, 0x3e, 0xa7, 0x30, 0xae, 0xe4, 0xf9, 0xa8, 0x22, 0xa5, 0x80,
+	0x97, 0x7b, 0x00, 0x8a, 0x2a, 0x0a, 0x87, 0x46, 0xd2, 0x6e, 0x0f, 0xb7, 0xe0, 0xbc, 0x2c, 0xb3,
+	0x39, 0x5b, 0xd8, 0x8d, 0x5a, 0xd8, 0x7d, 0x2d, 0x62, 0x8d, 0x80, 0xfb, 0xe7, 0x25, 0xc8, 0xcb,
+	0x6a, 0x8e, 0xe2, 0xa2, 0xaa, 0xb8, 0xd8, 0xe8, 0xbc, 0x16, 0xb1, 0x55, 0x86, 0xbd, 0x78, 0x49,
+	0xa2, 0xa4, 0x63, 0x2c, 0xab, 0xbc, 0x7d, 0x1d, 0x3b, 0x51, 0xc8, 0x93, 0xbc, 0x2a, 0xe8, 0x1e,
+	0x6d, 0x4a, 0xb7, 0x32, 0xf9, 0x4f, 0x74, 0x01, 0x32, 0x87, 0xf0, 0x93, 0x61, 0x1d, 0xe3, 0x03,
+	0x0d, 0x40, 0x52, 0x69, 0xd9, 0x74, 0xeb, 0x74, 0x76, 0xab, 0x6b, 0x50, 0xa1, 0x7c, 0x36, 0x2b,
+	0xd9, 0x15, 0x0e, 0x89, 0xd2, 0x02, 0x52, 0x9d, 0xcb, 0xdf, 0x8d, 0x03, 0xda, 0x8d, 0x30, 0xb4,
+	0x99, 0x3d, 0xc6, 0x12, 0xcd, 0xd5, 0xd8, 0xb4, 0x86, 0x79, 0x5b, 0x0b, 0xdc, 0x7a, 0x95, 0xf3,
+	0xf6, 0xc1, 0xc7, 0x73, 0x45, 0xd5, 0x41, 0x63, 0x33, 0xfb, 0xbd, 0xa6, 0xec, 0x73, 0xbc, 0x15,
+	0x24, 0x15, 0x76, 0x33, 0x87, 0x85, 0xdd, 0xfd, 0xe5, 0x3b, 0xfd, 0xa8, 0xe5, 0x3b, 0xe3, 0x47,
+	0x9a, 0xb2, 0xb0, 0xc4, 0x42, 0x2d, 0x5e, 0xa0, 0xb7, 0xfa, 0x17, 0x75, 0x63, 0x6f, 0x77, 0x2e,
+	0xbf, 0xea, 0x9f, 0x74, 0x59, 0x79, 0xee, 0x1a, 0xab, 0x6e, 0x0a, 0x83, 0xfd, 0x99, 0xa6, 0xce,
+	0x38, 0x1b, 0x94, 0xbe, 0x82, 0xbb, 0x4d, 0xec, 0xaf, 0x47, 0x8e, 0xc3, 0xa3, 0xee, 0x1b, 0x50,
+	0x68, 0x47, 0x0d, 0xeb, 0x3e, 0xee, 0x2a, 0xde, 0x5e, 0x78, 0xb0, 0x3b, 0xf7, 0xdc, 0x31, 0x38,
+	0xba, 0x13, 0x35, 0x5e, 0xc1, 0x5d, 0x33, 0xdf, 0x16, 0xff, 0x51, 0x0d, 0x0a, 0x1e, 0xf6, 0x1a,
+	0x38, 0x94, 0xd6, 0x50, 0x32, 0xe3, 0x26, 0xdf, 0x3c, 0xd5, 0xe5, 0x82, 0x3c, 0x76, 0xaa, 0x96,
+	0xf1, 0xcb, 0x21, 0x1e, 0x6f, 0xda, 0xa4, 0x15, 0x85, 0x98, 0xdf, 0x49, 0xf0, 0xca, 0xbb, 0xaa,
+	0xc6, 0x2b, 0x57, 0x05, 0xde, 0x25, 0xcb, 0xf0, 0xfc, 0xc6, 0x8a, 0x50, 0xae, 0x31, 0xc7, 0xa6,
+	0x32, 0xe2, 0x16, 0xcd, 0x12, 0xa1, 0x77, 0x65, 0x07, 0xc7, 0x6f, 0xb4, 0x6c, 0x0f, 0x5b, 0x9c,
+	0x65, 0xae, 0x53, 0xce, 0x0f, 0x88, 0xae, 0x57, 0x79, 0x0f, 0x8f, 0x9a, 0x21, 0x57, 0x95, 0x4c,
+	0x3d, 0x4c, 0xd9, 0xe8, 0x63, 0x34, 0x37, 0xc0, 0xe8, 0x3b, 0x1a, 0x4c, 0x0f, 0x32, 0xba, 0x86,
+	0x59, 0x48, 0x9c, 0xb1, 0xc9, 0xf2, 0x19, 0x40, 0x1e, 0x76, 0x89, 0xed, 0x5b, 0x6e, 0x14, 0xda,
+	0x8c, 0x04, 0xbe, 0xe5, 0x51, 0x95, 0x16, 0x56, 0xe5, 0xc8, 0x8a, 0x1a, 0x58, 0xa3, 0xc6, 0xbb,
+	0x83, 0x72, 0xa4, 0xa4, 0x19, 0xf3, 0x37, 0x3e, 0xd7, 0x3a, 0x1a, 0x87, 0x3f, 0xd7, 0x60, 0xb2,
+	0x17, 0x3e, 0x45, 0xc1, 0x61, 0x28, 0xa7, 0xd0, 0x46, 0x92, 0x53, 0x3c, 0x11, 0x07, 0x4d, 0x55,
+	0xe8, 0x90, 0x1c, 0x95, 0x69, 0x32, 0x2f, 0xed, 0xcb, 0xe5, 0xf4, 0xfe, 0x5c, 0xce, 0xd8, 0x82,
+	0x99, 0xe4, 0x3c, 0x52, 0xb7, 0x5b, 0xb6, 0xef, 0xe0, 0xe5, 0x2d, 0xdb, 0x6f, 0x62, 0x17, 0xfd,
+	0x1f, 0x88, 0x34, 0xd8, 0x72, 0x44, 0x5b, 0x85, 0xfd, 0xfd, 0xd1, 0x4e, 0x3a, 0x1f, 0x70, 0x40,
+	0x89, 0x77, 0x50, 0xd6, 0x68, 0xbc, 0x1f, 0x47, 0x8c, 0x35, 0xe2, 0x33, 0x91, 0xcf, 0x5e, 0x86,
+	0x3c, 0x8d, 0xda, 0xed, 0x96, 0xb4, 0xa3, 0xb3, 0xc9, 0xce, 0x1a, 0x03, 0xac, 0x8b, 0x41, 0x91,
+	0xbc, 0x29, 0x40, 0x6e, 0xc2, 0x2e, 0xf6, 0x03, 0x4f, 0xd1, 0x96, 0x8d, 0xbe, 0xb4, 0x51, 0x3f,
+	0x52, 0xda, 0xd8, 0x63, 0x35, 0x3b, 0xc0, 0xea, 0xc7, 0x3a, 0xd4, 0xa4, 0x6d, 0xf1, 0xeb, 0xbc,
+	0x25, 0x47, 0xa4, 0x17, 0x2b, 0xea, 0xa2, 0xee, 0xb8, 0x39, 0x6a, 0x92, 0x96, 0x65, 0xc6, 0x9a,
+	0x96, 0x0d, 0x15, 0x1f, 0xf4, 0x31, 0x14, 0x1f, 0x4e, 0xe9, 0xb6, 0x21, 0xf1, 0xe4, 0xdc, 0xa8,
+	0xaf, 0xaf, 0x3f, 0xd1, 0xe1, 0xd1, 0x21, 0x0d, 0x27, 0x45, 0xce, 0x87, 0x2a, 0xfe, 0x0f, 0x50,
+	0xf1, 0x2f, 0x62, 0x27, 0x16, 0x39, 0x30, 0x76, 0x85, 0x08, 0x4f, 0xea, 0xc4, 0x4f, 0x1f, 0xaa,
+	0x61, 0x95, 0x82, 0x3f, 0xd4, 0x52, 0x7a, 0x2d, 0xbd, 0x17, 0x3b, 0x62, 0xbf, 0x96, 0x4e, 0xec,
+	0x88, 0x0f, 0xd5, 0x34, 0x62, 0x35, 0xfd, 0x26, 0x13, 0x9f, 0x4c, 0x05, 0x28, 0xdf, 0xfd, 0x63,
+	0x57, 0x7a, 0x73, 0x68, 0x6d, 0xc7, 0x4e, 0x6a, 0xbe, 0xa8, 0x18, 0x9f, 0x39, 0x46, 0x31, 0xfe,
+	0x7f, 0x21, 0x77, 0x94, 0x33, 0x8e, 0x04, 0x46, 0x77, 0x62, 0x49, 0x4a, 0x3d, 0x5d, 0x3f, 0xb9,
+	0x04, 0xff, 0x90, 0x85, 0x99, 0x7d, 0x12, 0x4c, 0xcc, 0x7c, 0x7c, 0x22, 0xdc, 0x7f, 0x05, 0x96,
+	0x19, 0xbe, 0x02, 0x1b, 0x92, 0xb2, 0x7e, 0x12, 0x29, 0x67, 0x8f, 0x25, 0xe5, 0xdc, 0x88, 0xa4,
+	0xcc, 0x1f, 0x36, 0xd9, 0xf1, 0x43, 0x0d, 0x4b, 0xad, 0x9c, 0x51, 0x71, 0x39, 0xa2, 0x9b, 0x53,
+	0xc9, 0x50, 0x5d, 0xac, 0x9f, 0x51, 0xb4, 0x0a, 0xd5, 0x1e, 0xbc, 0x5a, 0x7f, 0xba, 0xbb, 0xb5,
+	0xc9, 0x04, 0x2f, 0xb9, 0x4d, 0x99, 0xea, 0x23, 0x35, 0xd2, 0x4b, 0xd9, 0x1e, 0x8f, 0xaa, 0xc7,
+	0x78, 0x27, 0xa7, 0x92, 0xe9, 0xdb, 0x81, 0xed, 0xbf, 0xd6, 0xc6, 0x3e, 0x7a, 0x1d, 0xa6, 0x9d,
+	0xa0, 0xd5, 0xb2, 0x19, 0x0e, 0xed, 0x96, 0xa5, 0x5e, 0x95, 0xe1, 0xb4, 0x85, 0xf2, 0x73, 0x3d,
+	0xdc, 0x95, 0x18, 0x15, 0xbd, 0x0d, 0xd5, 0x3e, 0x92, 0x63, 0xcd, 0x6c, 0x26, 0x7b, 0x13, 0x89,
+	0x0e, 0x74, 0x0f, 0x66, 0x7a, 0x5d, 0xe4, 0x6d, 0x79, 0xd8, 0x12, 0x47, 0xab, 0x94, 0xf6, 0x79,
+	0x7e, 0x08, 0xdd, 0xe4, 0x7f, 0x79, 0xe5, 0xc3, 0xc5, 0x0d, 0x66, 0x11, 0x4a, 0x23, 0xec, 0xa6,
+	0xad, 0xc2, 0x73, 0x94, 0x55, 0x81, 0x81, 0x36, 0x20, 0x17, 0xec, 0xf8, 0x38, 0x1c, 0xd1, 0xad,
+	0x9e, 0x24, 0x86, 0x42, 0xa8, 0xc8, 0x97, 0x6e, 0x7d, 0x17, 0xc4, 0x63, 0x90, 0x73, 0x59, 0x4e,
+	0x22, 0x65, 0x9c, 0x6c, 0x17, 0x85, 0x51, 0x6f, 0x17, 0x7f, 0x8c, 0x5f, 0x87, 0x72, 0xf3, 0x34,
+	0x71, 0xdb, 0xee, 0x7a, 0xd8, 0x67, 0xfb, 0x6c, 0x74, 0x47, 0x85, 0x3f, 0xff, 0xe8, 0x36, 0x1a,
+	0x47, 0x4e, 0xff, 0xdf, 0x6a, 0xa3, 0xb1, 0x29, 0x85, 0xb8, 0x6d, 0x93, 0xb4, 0x97, 0x42, 0xc2,
+	0x94, 0x4c, 0x81, 0xd1, 0x33, 0xa5, 0xec, 0x28, 0x4d, 0x69, 0x7c, 0x6a, 0xfd, 0xb5, 0x1e, 0x57,
+	0x34, 0xc4, 0xf5, 0xf0, 0xab, 0xfc, 0xe1, 0x19, 0x82, 0xac, 0x78, 0x94, 0x26, 0xcb, 0x55, 0xe2,
+	0x37, 0x32, 0x21, 0xe7, 0x6c, 0xd9, 0x44, 0x55, 0x00, 0xea, 0xcf, 0x3f, 0x38, 0x9e, 0xd8, 0x97,
+	0x39, 0x0d, 0x53, 0x92, 0x42, 0x6f, 0x42, 0x61, 0xb4, 0x19, 0x5a, 0x4c, 0x8e, 0xef, 0x01, 0x21,
+	0x6e, 0x12, 0xca, 0x54, 0x49, 0x27, 0xfd, 0x73, 0xcf, 0xc9, 0x7e, 0x3c, 0x5e, 0xd5, 0x7d, 0x0e,
+	0x8a, 0xe2, 0x9a, 0x90, 0xdf, 0x69, 0xa4, 0xbb, 0x98, 0x2b, 0x70, 0x78, 0x7e, 0xa5, 0x71, 0x1e,
+	0xf2, 0xb8, 0xd3, 0x26, 0x21, 0x56, 0x9b, 0x95, 0x6a, 0xa1, 0x5b, 0xb1, 0x8d, 0x14, 0x8e, 0x9b,
+	0x12, 0x48, 0x7c, 0xe3, 0x5a, 0x72, 0x25, 0xc0, 0xd6, 0x88, 0x47, 0x42, 0x7e, 0x25, 0x90, 0xd4,
+	0xf0, 0x4c, 0xfe, 0x93, 0x57, 0x57, 0xb6, 0xed, 0x56, 0x84, 0xe3, 0xea, 0x8a, 0x68, 0x18, 0x77,
+	0xd5, 0x83, 0xb7, 0x75, 0xcc, 0x78, 0x1d, 0xf1, 0x48, 0xc8, 0xbc, 0x40, 0x3a, 0xa0, 0xb6, 0x44,
+	0xec, 0xc6, 0xbc, 0xba, 0x74, 0x79, 0x03, 0x87, 0x94, 0x04, 0x3e, 0x87, 0xdc, 0x96, 0x3f, 0x15,
+	0xd5, 0xb8, 0x69, 0xfc, 0x58, 0x87, 0x72, 0x5f, 0xea, 0xf4, 0xf0, 0x54, 0xf0, 0x65, 0x39, 0x15,
+	0xfc, 0x2a, 0xae, 0xc1, 0xae, 0xdf, 0x5b, 0xfe, 0xe6, 0x0a, 0xa1, 0xf2, 0xba, 0x8b, 0x2b, 0xf3,
+	0xcb, 0x7d, 0x28, 0x30, 0xfe, 0x99, 0x81, 0xb3, 0x09, 0xe3, 0xcb, 0x2d, 0x9b, 0x78, 0x08, 0x7f,
+	0x2e, 0xc7, 0x23, 0x57, 0x86, 0x78, 0xd6, 0xee, 0x74, 0x8f, 0xc6, 0x3c, 0x70, 0x14, 0x95, 0x66,
+	0x7e, 0x1b, 0xa0, 0x75, 0x79, 0xc4, 0x66, 0x59, 0x6a, 0x5d, 0x1e, 0x7a, 0xe0, 0x9b, 0x1d, 0x67,
+	0x09, 0x8b, 0xd7, 0xc6, 0x7b, 0x0a, 0x10, 0xef, 0x12, 0xfa, 0x03, 0xbc, 0x36, 0xda, 0x00, 0x7f,
+	0x15, 0xf2, 0x47, 0x12, 0xb7, 0x82, 0x36, 0xde, 0xd3, 0xa0, 0x9a, 0x30, 0x79, 0xd7, 0xa7, 0x5f,
+	0x52, 0x36, 0xff, 0xac, 0xab, 0x8b, 0x9a, 0xb5, 0xc0, 0x25, 0x9b, 0xdd, 0xde, 0x57, 0x45, 0x26,
+	0x64, 0x37, 0xc3, 0xc0, 0x1b, 0x11, 0x9f, 0x82, 0x56, 0xdf, 0x97, 0x4a, 0x99, 0x23, 0x7c, 0xa9,
+	0xa4, 0x7f, 0xe1, 0x97, 0x4a, 0x1b, 0x70, 0xde, 0xe3, 0xec, 0x13, 0xec, 0x5a, 0x71, 0x22, 0x7c,
+	0x94, 0x17, 0x78, 0xd3, 0x31, 0xb6, 0xfc, 0xfa, 0x43, 0x39, 0xcb, 0xd8, 0x42, 0x1f, 0xc2, 0x00,
+	0xf2, 0xf3, 0x94, 0xbe, 0x37, 0xc5, 0xb7, 0xf6, 0x76, 0xe7, 0x8a, 0x5c, 0xf2, 0x27, 0x9d, 0x42,
+	0x7c, 0x87, 0x22, 0xde, 0xb2, 0x7d, 0xa6, 0xa9, 0x44, 0x7a, 0x05, 0x77, 0x96, 0x9a, 0xcd, 0x10,
+	0x37, 0x6d, 0x16, 0x84, 0xe2, 0xd5, 0x94, 0xc3, 0x7a, 0x5b, 0xa5, 0x6a, 0x8d, 0x25, 0xf1, 0x3a,
+	0x70, 0x07, 0xe7, 0x5f, 0xb9, 0x35, 0x6d, 0x6a, 0xb5, 0xb8, 0xc1, 0xa9, 0xcf, 0xab, 0x8a, 0x4d,
+	0x9b, 0x0a, 0x03, 0xe4, 0x68, 0xd8, 0xb7, 0x1b, 0x2d, 0x1c, 0x7f, 0x00, 0x17, 0x37, 0xfb, 0x37,
+	0xfa, 0xfc, 0xc0, 0x46, 0x7f, 0xf1, 0x12, 0x4c, 0x7f, 0xde, 0x4b, 0x50, 0x54, 0x00, 0xdd, 0x76,
+	0xdd, 0xea, 0x19, 0x54, 0x81, 0x62, 0x7c, 0x6a, 0xa8, 0x6a, 0x17, 0x6f, 0x43, 0x31, 0x7e, 0xdd,
+	0x83, 0x26, 0xd4, 0x0b, 0x20, 0x9e, 0x57, 0x57, 0xcf, 0xa0, 0x29, 0x98, 0x10, 0xcd, 0x10, 0xb3,
+	0x28, 0xf4, 0xb1, 0x5b, 0xd5, 0xd0, 0xe4, 0xc0, 0x03, 0xb2, 0x6a, 0x26, 0x41, 0x71, 0x02, 0xca,
+	0xaa, 0xfa, 0xc5, 0x79, 0x40, 0xc3, 0x17, 0x4f, 0xa8, 0x08, 0x59, 0x8f, 0xf8, 0xac, 0x7a, 0x86,
+	0xff, 0xe2, 0xef, 0xb6, 0xaa, 0x5a, 0xfd, 0x8d, 0x0f, 0xf7, 0x66, 0xb5, 0x8f, 0xf6, 0x66, 0xb5,
+	0x4f, 0xf6, 0x66, 0xb5, 0x1f, 0x7e, 0x3a, 0x7b, 0xe6, 0xa3, 0x4f, 0x67, 0xcf, 0xfc, 0xe9, 0xd3,
+	0xd9, 0x33, 0xdf, 0x7a, 0xfe, 0x28, 0xc2, 0xee, 0x24, 0x3d, 0x72, 0xd3, 0x6b, 0xe4, 0x05, 0xe8,
+	0xb3, 0xff, 0x1a, 0x00, 0x5e, 0x88, 0xb9, 0xd1, 0xed, 0x39, 0x00, 0x00,
 }
 
 func (m *PoolMod) Marshal() (dAtA []byte, err error) {
@@ -5845,6 +6016,146 @@ func (m *EventSWCYUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventModifyLimitSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventModifyLimitSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventModifyLimitSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapTxID) > 0 {
+		i -= len(m.SwapTxID)
+		copy(dAtA[i:], m.SwapTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.SwapTxID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ModifiedTargetAmount.Size()
+		i -= size
+		if _, err := m.ModifiedTargetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDexAggregator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDexAggregator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDexAggregator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypeEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypeEvents(v)
 	base := offset
@@ -6866,26 +7177,84 @@ func (m *EventSWCYUnstake) Size() (n int) {
 	return n
 }
 
-func sovTypeEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypeEvents(x uint64) (n int) {
-	return sovTypeEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventModifyLimitSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Target.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.ModifiedTargetAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.SwapTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
 }
-func (m *PoolMod) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *EventDexAggregator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypeEvents(uint64(m.GasLimit))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func sovTypeEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeEvents(x uint64) (n int) {
+	return sovTypeEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolMod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -15300,6 +15669,469 @@ func (m *EventSWCYUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventModifyLimitSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventModifyLimitSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventModifyLimitSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = github_com_switchlyprotocol_switchlynode_v3_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedTargetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModifiedTargetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = github_com_switchlyprotocol_switchlynode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapTxID = github_com_switchlyprotocol_switchlynode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDexAggregator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDexAggregator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDexAggregator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_switchlyprotocol_switchlynode_v3_common.Chain(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypeEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -50,6 +50,10 @@ func getKeygenTypeFromString(t string) KeygenType {
 	switch {
 	case strings.EqualFold(t, "asgardKeygen"):
 		return KeygenType_AsgardKeygen
+	case strings.EqualFold(t, "eddsaKeygen"):
+		return KeygenType_EdDSAKeygen
+	case strings.EqualFold(t, "reshareKeygen"):
+		return KeygenType_ReshareKeygen
 	default:
 		return KeygenType_UnknownKeygen
 	}
@@ -87,6 +91,30 @@ func NewKeygen(height int64, members []string, keygenType KeygenType) (Keygen, e
 	}, nil
 }
 
+// NewEdDSAKeygen create a new EdDSA-only keygen among the members of an existing
+// vault, whose resulting ed25519 group key is attached to that vault
+func NewEdDSAKeygen(height int64, members []string, vaultPubKey common.PubKey) (Keygen, error) {
+	keygen, err := NewKeygen(height, members, KeygenType_EdDSAKeygen)
+	if err != nil {
+		return Keygen{}, err
+	}
+	keygen.PoolPubKey = vaultPubKey
+	return keygen, nil
+}
+
+// NewReshareKeygen create a new keygen that hands the key shares of an existing vault from
+// its current members over to the new members, the vault keeps its pub key
+func NewReshareKeygen(height int64, members, oldMembers []string, vaultPubKey common.PubKey) (Keygen, error) {
+	keygen, err := NewKeygen(height, members, KeygenType_ReshareKeygen)
+	if err != nil {
+		return Keygen{}, err
+	}
+	sort.Strings(oldMembers)
+	keygen.OldMembers = oldMembers
+	keygen.PoolPubKey = vaultPubKey
+	return keygen, nil
+}
+
 // getKeygenID will create ID based on the pub keys
 func getKeygenID(height int64, members []string, keygenType KeygenType) (common.TxID, error) {
 	sb := strings.Builder{}
@@ -116,6 +144,25 @@ func (m *Keygen) GetMembers() common.PubKeys {
 	return pubkeys
 }
 
+// GetOldMembers returns the current vault members of a reshare keygen
+func (m *Keygen) GetOldMembers() common.PubKeys {
+	pubkeys := make(common.PubKeys, 0)
+	for _, pk := range m.OldMembers {
+		pk, err := common.NewPubKey(pk)
+		if err != nil {
+			continue
+		}
+		pubkeys = append(pubkeys, pk)
+	}
+	return pubkeys
+}
+
+// Involves returns whether the given node takes part in the keygen, as a member or as a
+// current vault member of a reshare keygen
+func (m *Keygen) Involves(pk common.PubKey) bool {
+	return m.GetMembers().Contains(pk) || m.GetOldMembers().Contains(pk)
+}
+
 // IsEmpty check whether there are any keys in the keygen
 func (m *Keygen) IsEmpty() bool {
 	return len(m.Members) == 0 || len(m.ID) == 0
@@ -126,6 +173,20 @@ func (m *Keygen) Valid() error {
 	if m.Type == KeygenType_UnknownKeygen {
 		return errors.New("unknown keygen")
 	}
+	if m.Type == KeygenType_EdDSAKeygen && m.PoolPubKey.IsEmpty() {
+		return errors.New("eddsa keygen must reference a vault")
+	}
+	if m.Type == KeygenType_ReshareKeygen {
+		if m.PoolPubKey.IsEmpty() {
+			return errors.New("reshare keygen must reference a vault")
+		}
+		if len(m.OldMembers) == 0 {
+			return errors.New("reshare keygen must have the current vault members")
+		}
+		if err := m.GetOldMembers().Valid(); err != nil {
+			return err
+		}
+	}
 	return m.GetMembers().Valid()
 }
 
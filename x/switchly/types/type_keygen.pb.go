@@ -29,16 +29,22 @@ type KeygenType int32
 const (
 	KeygenType_UnknownKeygen KeygenType = 0
 	KeygenType_AsgardKeygen  KeygenType = 1
+	KeygenType_EdDSAKeygen   KeygenType = 2
+	KeygenType_ReshareKeygen KeygenType = 3
 )
 
 var KeygenType_name = map[int32]string{
 	0: "UnknownKeygen",
 	1: "AsgardKeygen",
+	2: "EdDSAKeygen",
+	3: "ReshareKeygen",
 }
 
 var KeygenType_value = map[string]int32{
 	"UnknownKeygen": 0,
 	"AsgardKeygen":  1,
+	"EdDSAKeygen":   2,
+	"ReshareKeygen": 3,
 }
 
 func (x KeygenType) String() string {
@@ -53,6 +59,11 @@ type Keygen struct {
 	ID      github_com_switchlyprotocol_switchlynode_v3_common.TxID `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/switchlyprotocol/switchlynode/v3/common.TxID" json:"id,omitempty"`
 	Type    KeygenType                                              `protobuf:"varint,2,opt,name=type,proto3,enum=types.KeygenType" json:"type,omitempty"`
 	Members []string                                                `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// the existing vault an EdDSAKeygen attaches its ed25519 group key to, or a ReshareKeygen
+	// hands over to the new members
+	PoolPubKey github_com_switchlyprotocol_switchlynode_v3_common.PubKey `protobuf:"bytes,4,opt,name=pool_pub_key,json=poolPubKey,proto3,casttype=github.com/switchlyprotocol/switchlynode/v3/common.PubKey" json:"pool_pub_key,omitempty"`
+	// the current members of the vault a ReshareKeygen hands over
+	OldMembers []string `protobuf:"bytes,5,rep,name=old_members,json=oldMembers,proto3" json:"old_members,omitempty"`
 }
 
 func (m *Keygen) Reset()      { *m = Keygen{} }
@@ -133,29 +144,33 @@ func init() {
 func init() { proto.RegisterFile("types/type_keygen.proto", fileDescriptor_32c2c7fafe5b6426) }
 
 var fileDescriptor_32c2c7fafe5b6426 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2f, 0xa9, 0x2c, 0x48,
-	0x2d, 0xd6, 0x07, 0x91, 0xf1, 0xd9, 0xa9, 0x95, 0xe9, 0xa9, 0x79, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0xac, 0x60, 0x09, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0x88, 0x3e, 0x88, 0x05,
-	0x91, 0x54, 0x9a, 0xc3, 0xc8, 0xc5, 0xe6, 0x0d, 0x56, 0x2d, 0x14, 0xc8, 0xc5, 0x94, 0x99, 0x22,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0xe4, 0xf8, 0xe8, 0x9e, 0x3c, 0x93, 0xa7, 0xcb, 0xaf, 0x7b,
-	0xf2, 0xe6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc5, 0xe5, 0x99,
-	0x25, 0xc9, 0x19, 0x39, 0x95, 0x60, 0xad, 0xc9, 0xf9, 0x39, 0x70, 0x81, 0xbc, 0xfc, 0x94, 0x54,
-	0xfd, 0x32, 0x63, 0xfd, 0xe4, 0xfc, 0xdc, 0xdc, 0xfc, 0x3c, 0xbd, 0x90, 0x0a, 0x4f, 0x97, 0x20,
-	0xa6, 0xcc, 0x14, 0x21, 0x55, 0x2e, 0x16, 0x90, 0xe5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46,
-	0x82, 0x7a, 0x60, 0x97, 0xe8, 0x41, 0xec, 0x0b, 0xa9, 0x2c, 0x48, 0x0d, 0x02, 0x4b, 0x0b, 0x49,
-	0x70, 0xb1, 0xe7, 0xa6, 0xe6, 0x26, 0xa5, 0x16, 0x15, 0x4b, 0x30, 0x2b, 0x30, 0x6b, 0x70, 0x06,
-	0xc1, 0xb8, 0x4a, 0xf1, 0x5c, 0xdc, 0x10, 0xd5, 0x4e, 0x39, 0xf9, 0xc9, 0xd9, 0x42, 0x62, 0x5c,
-	0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x60, 0x67, 0x32, 0x07, 0x41, 0x79, 0x42, 0x16, 0x5c,
-	0xec, 0x10, 0x2f, 0x17, 0x4b, 0xb0, 0x28, 0x30, 0x6b, 0x70, 0x1b, 0xf1, 0xa2, 0x58, 0xe5, 0xc4,
-	0x7f, 0xe2, 0x9e, 0x3c, 0xc3, 0xab, 0x7b, 0xf2, 0x30, 0x55, 0x41, 0x30, 0x86, 0x96, 0x21, 0x17,
-	0x17, 0xc2, 0x39, 0x42, 0x82, 0x5c, 0xbc, 0xa1, 0x79, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x10, 0x41,
-	0x01, 0x06, 0x21, 0x01, 0x2e, 0x1e, 0xc7, 0xe2, 0xf4, 0xc4, 0xa2, 0x14, 0xa8, 0x08, 0xa3, 0x53,
-	0xd2, 0x89, 0x87, 0x72, 0x0c, 0x37, 0x1e, 0xca, 0x31, 0x34, 0x3c, 0x92, 0x63, 0x38, 0xf1, 0x48,
-	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x1b, 0x52, 0xc2, 0xad, 0x02, 0x2e, 0x02, 0x8e,
-	0xbf, 0xe2, 0x24, 0x36, 0xb0, 0x52, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x3b, 0xcb,
-	0xcf, 0xd5, 0x01, 0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x6f, 0x9b, 0x40,
+	0x14, 0xc7, 0x0f, 0x70, 0x1c, 0xe5, 0x91, 0x34, 0xc9, 0xa9, 0x6a, 0x51, 0x87, 0x03, 0x45, 0xaa,
+	0x84, 0x3a, 0x80, 0x94, 0x0c, 0x6d, 0xa5, 0x76, 0x30, 0x72, 0x87, 0x28, 0xaa, 0xd4, 0xd2, 0x64,
+	0xe9, 0x82, 0x02, 0x9c, 0x00, 0x19, 0x38, 0x04, 0xb8, 0x31, 0x5b, 0x3f, 0x42, 0x3f, 0x41, 0x3f,
+	0x8f, 0x47, 0x8f, 0x9e, 0x50, 0x8d, 0xb7, 0x7e, 0x04, 0x4f, 0x15, 0x07, 0xb8, 0xea, 0xd8, 0x2c,
+	0xe8, 0xdd, 0xef, 0xbd, 0xe3, 0xff, 0x7f, 0x7f, 0x1d, 0x3c, 0x2f, 0xab, 0x8c, 0x16, 0x66, 0xfb,
+	0x75, 0x66, 0xb4, 0x0a, 0x68, 0x6a, 0x64, 0x39, 0x2b, 0x19, 0x3e, 0xe0, 0x8d, 0x17, 0x4f, 0x03,
+	0x16, 0x30, 0x4e, 0xcc, 0xb6, 0xea, 0x9a, 0x17, 0x3f, 0x45, 0x18, 0xdf, 0xf0, 0x69, 0xfc, 0x19,
+	0xc4, 0xc8, 0x57, 0x04, 0x4d, 0xd0, 0x8f, 0xac, 0x49, 0x53, 0xab, 0xe2, 0xf5, 0x74, 0x57, 0xab,
+	0xaf, 0x83, 0xa8, 0x0c, 0xe7, 0xae, 0xe1, 0xb1, 0xc4, 0x2c, 0x1e, 0xa2, 0xd2, 0x0b, 0xe3, 0x8a,
+	0x5f, 0xf5, 0x58, 0xbc, 0x07, 0x29, 0xf3, 0xa9, 0xf9, 0xed, 0xca, 0xf4, 0x58, 0x92, 0xb0, 0xd4,
+	0xb8, 0x5d, 0x5c, 0x4f, 0x6d, 0x31, 0xf2, 0xf1, 0x4b, 0x18, 0xb5, 0xe2, 0x8a, 0xa8, 0x09, 0xfa,
+	0x93, 0xcb, 0x73, 0x83, 0x3b, 0x31, 0x3a, 0xbd, 0xdb, 0x2a, 0xa3, 0x36, 0x6f, 0x63, 0x05, 0x0e,
+	0x13, 0x9a, 0xb8, 0x34, 0x2f, 0x14, 0x49, 0x93, 0xf4, 0x23, 0x7b, 0x38, 0x62, 0x07, 0x8e, 0x33,
+	0xc6, 0x62, 0x27, 0x9b, 0xbb, 0xed, 0x52, 0xca, 0x88, 0xbb, 0x7b, 0xbf, 0xab, 0xd5, 0xb7, 0x8f,
+	0xf0, 0xf5, 0x69, 0xee, 0xde, 0xd0, 0xca, 0x86, 0xf6, 0x97, 0x5d, 0x8d, 0x55, 0x90, 0x59, 0xec,
+	0x3b, 0x83, 0xfc, 0x01, 0x97, 0x07, 0x16, 0xfb, 0x1f, 0x3b, 0x72, 0xe1, 0x80, 0xdc, 0xf9, 0xb5,
+	0x62, 0xe6, 0xcd, 0xf0, 0x33, 0x18, 0x87, 0x34, 0x0a, 0xc2, 0x92, 0x07, 0x25, 0xd9, 0xfd, 0x09,
+	0xbf, 0x81, 0xc3, 0x2e, 0xf4, 0x42, 0x19, 0x69, 0x92, 0x2e, 0x5f, 0x9e, 0xfc, 0xb3, 0xac, 0x75,
+	0xba, 0xac, 0x55, 0xf4, 0xbb, 0x56, 0x87, 0x29, 0x7b, 0x28, 0x5e, 0xdd, 0x01, 0xfc, 0x0d, 0x04,
+	0x9f, 0xc3, 0xc9, 0x5d, 0x3a, 0x4b, 0xd9, 0x43, 0xda, 0xc1, 0x33, 0x84, 0xcf, 0xe0, 0x78, 0x52,
+	0x04, 0xf7, 0xb9, 0xdf, 0x13, 0x01, 0x9f, 0x82, 0xfc, 0xc1, 0x9f, 0x7e, 0x99, 0xf4, 0x40, 0x6c,
+	0x6f, 0xd9, 0xb4, 0x08, 0xef, 0x73, 0xda, 0x23, 0xc9, 0x72, 0x97, 0x1b, 0x82, 0xd6, 0x1b, 0x82,
+	0xbe, 0x37, 0x04, 0x2d, 0x1b, 0x22, 0xac, 0x1a, 0x22, 0xfc, 0x6a, 0x88, 0xf0, 0x63, 0x4b, 0xd0,
+	0x6a, 0x4b, 0xd0, 0x7a, 0x4b, 0xd0, 0xd7, 0x77, 0xff, 0x93, 0xe2, 0x62, 0x4f, 0xf8, 0x2b, 0x2b,
+	0xdc, 0x31, 0x1f, 0xbd, 0xfa, 0x33, 0x00, 0x92, 0x74, 0x82, 0xd5, 0x7b, 0x02, 0x00, 0x00,
 }
 
 func (m *Keygen) Marshal() (dAtA []byte, err error) {
@@ -178,6 +193,22 @@ func (m *Keygen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OldMembers) > 0 {
+		for iNdEx := len(m.OldMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OldMembers[iNdEx])
+			copy(dAtA[i:], m.OldMembers[iNdEx])
+			i = encodeVarintTypeKeygen(dAtA, i, uint64(len(m.OldMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PoolPubKey) > 0 {
+		i -= len(m.PoolPubKey)
+		copy(dAtA[i:], m.PoolPubKey)
+		i = encodeVarintTypeKeygen(dAtA, i, uint64(len(m.PoolPubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Members) > 0 {
 		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Members[iNdEx])
@@ -274,6 +305,16 @@ func (m *Keygen) Size() (n int) {
 			n += 1 + l + sovTypeKeygen(uint64(l))
 		}
 	}
+	l = len(m.PoolPubKey)
+	if l > 0 {
+		n += 1 + l + sovTypeKeygen(uint64(l))
+	}
+	if len(m.OldMembers) > 0 {
+		for _, s := range m.OldMembers {
+			l = len(s)
+			n += 1 + l + sovTypeKeygen(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -413,6 +454,70 @@ func (m *Keygen) Unmarshal(dAtA []byte) error {
 			}
 			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeKeygen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolPubKey = github_com_switchlyprotocol_switchlynode_v3_common.PubKey(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeKeygen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldMembers = append(m.OldMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeKeygen(dAtA[iNdEx:])
@@ -31,6 +31,36 @@ func (s *KeygenSuite) TestKeygen(c *C) {
 	c.Log(keygen.String())
 }
 
+func (s *KeygenSuite) TestReshareKeygen(c *C) {
+	var members []string
+	for i := 0; i < 4; i++ {
+		members = append(members, GetRandomPubKey().String())
+	}
+	leaving := GetRandomPubKey()
+	oldMembers := append([]string{leaving.String()}, members[:3]...)
+	vaultPubKey := GetRandomPubKey()
+	keygen, err := NewReshareKeygen(1, members, oldMembers, vaultPubKey)
+	c.Assert(err, IsNil)
+	c.Check(keygen.Type, Equals, KeygenType_ReshareKeygen)
+	c.Check(keygen.PoolPubKey.Equals(vaultPubKey), Equals, true)
+	c.Check(keygen.Valid(), IsNil)
+	c.Check(keygen.Involves(leaving), Equals, true)
+	c.Check(keygen.GetMembers().Contains(leaving), Equals, false)
+	c.Check(keygen.Involves(GetRandomPubKey()), Equals, false)
+
+	buf, err := json.Marshal(keygen.Type)
+	c.Assert(err, IsNil)
+	var kt KeygenType
+	c.Assert(json.Unmarshal(buf, &kt), IsNil)
+	c.Check(kt, Equals, KeygenType_ReshareKeygen)
+
+	keygen.OldMembers = nil
+	c.Check(keygen.Valid(), NotNil)
+	keygen.OldMembers = oldMembers
+	keygen.PoolPubKey = ""
+	c.Check(keygen.Valid(), NotNil)
+}
+
 func (s *KeygenSuite) TestGetKeygenID(c *C) {
 	var members []string
 	for i := 0; i < 4; i++ {
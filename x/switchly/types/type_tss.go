@@ -62,15 +62,22 @@ func (m *TssVoter) HasSigned(signer cosmos.AccAddress) bool {
 	return false
 }
 
-// Sign this voter with given signer address
-func (m *TssVoter) Sign(signer cosmos.AccAddress, chains []string, secp256k1Signature string) bool {
+// Sign this voter with given signer address. The reported ed25519 group key is
+// recorded alongside the signer, empty when the signer has no EdDSA result.
+func (m *TssVoter) Sign(signer cosmos.AccAddress, chains []string, secp256k1Signature string, ed25519PubKey common.PubKey) bool {
 	if m.HasSigned(signer) {
 		return false
 	}
 	for _, pk := range m.GetPubKeys() {
 		addr, err := pk.GetThorAddress()
 		if addr.Equals(signer) && err == nil {
+			// keep the reported keys index-aligned with signers recorded before
+			// the tally existed
+			for len(m.Ed25519PubKeys) < len(m.Signers) {
+				m.Ed25519PubKeys = append(m.Ed25519PubKeys, "")
+			}
 			m.Signers = append(m.Signers, signer.String())
+			m.Ed25519PubKeys = append(m.Ed25519PubKeys, ed25519PubKey.String())
 			m.Chains = append(m.Chains, chains...)
 			if len(secp256k1Signature) > 0 {
 				m.Secp256K1Signatures = append(m.Secp256K1Signatures, secp256k1Signature)
@@ -81,6 +88,68 @@ func (m *TssVoter) Sign(signer cosmos.AccAddress, chains []string, secp256k1Sign
 	return false
 }
 
+// ReportedEd25519PubKey returns the ed25519 group key the given signer reported
+func (m *TssVoter) ReportedEd25519PubKey(signer cosmos.AccAddress) common.PubKey {
+	for i, s := range m.Signers {
+		if s == signer.String() && i < len(m.Ed25519PubKeys) {
+			return common.PubKey(m.Ed25519PubKeys[i])
+		}
+	}
+	return common.EmptyPubKey
+}
+
+// HasEd25519PubKeys return true when any signer has reported an ed25519 group key,
+// which means the EdDSA ceremony ran for at least part of the membership
+func (m *TssVoter) HasEd25519PubKeys() bool {
+	for _, pk := range m.Ed25519PubKeys {
+		if len(pk) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ConsensusEd25519PubKey tallies the ed25519 group keys reported by the signers
+// and returns the one reported by a supermajority of all members. The tally is
+// independent from the keygen consensus, so members failing the EdDSA ceremony
+// never block the ECDSA vault.
+func (m *TssVoter) ConsensusEd25519PubKey() (common.PubKey, bool) {
+	counts := make(map[string]int)
+	for _, pk := range m.Ed25519PubKeys {
+		if len(pk) == 0 {
+			continue
+		}
+		counts[pk]++
+	}
+
+	// analyze-ignore(map-iteration)
+	for pk, count := range counts {
+		if HasSuperMajority(count, len(m.PubKeys)) {
+			return common.PubKey(pk), true
+		}
+	}
+
+	return common.EmptyPubKey, false
+}
+
+// Ed25519Dissenters returns the signers that reported an ed25519 group key other
+// than the given one. Signers that reported none are not dissenters, their EdDSA
+// ceremony failed or did not run and the failure is blamed separately.
+func (m *TssVoter) Ed25519Dissenters(pk common.PubKey) []cosmos.AccAddress {
+	addrs := make([]cosmos.AccAddress, 0)
+	for i, s := range m.Signers {
+		if i >= len(m.Ed25519PubKeys) || len(m.Ed25519PubKeys[i]) == 0 || pk.Equals(common.PubKey(m.Ed25519PubKeys[i])) {
+			continue
+		}
+		addr, err := cosmos.AccAddressFromBech32(s)
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
 // ConsensusChains - get a list of chains that have 2/3rds majority
 func (m *TssVoter) ConsensusChains() common.Chains {
 	chainCount := make(map[common.Chain]int)
@@ -33,6 +33,13 @@ type TssVoter struct {
 	Signers                      []string                                                  `protobuf:"bytes,6,rep,name=signers,proto3" json:"signers,omitempty"`
 	MajorityConsensusBlockHeight int64                                                     `protobuf:"varint,7,opt,name=majority_consensus_block_height,json=majorityConsensusBlockHeight,proto3" json:"majority_consensus_block_height,omitempty"`
 	Secp256K1Signatures          []string                                                  `protobuf:"bytes,8,rep,name=secp256k1_signatures,json=secp256k1Signatures,proto3" json:"secp256k1_signatures,omitempty"`
+	// ed25519 group keys reported by each signer, index-aligned with signers. An
+	// empty entry means the signer failed (or did not run) the EdDSA ceremony.
+	Ed25519PubKeys              []string `protobuf:"bytes,9,rep,name=ed25519_pub_keys,json=ed25519PubKeys,proto3" json:"ed25519_pub_keys,omitempty"`
+	Ed25519ConsensusBlockHeight int64    `protobuf:"varint,10,opt,name=ed25519_consensus_block_height,json=ed25519ConsensusBlockHeight,proto3" json:"ed25519_consensus_block_height,omitempty"`
+	// height the ed25519 tally was settled at, once all members reported or the
+	// keygen timed out
+	Ed25519SettledHeight int64 `protobuf:"varint,11,opt,name=ed25519_settled_height,json=ed25519SettledHeight,proto3" json:"ed25519_settled_height,omitempty"`
 }
 
 func (m *TssVoter) Reset()      { *m = TssVoter{} }
@@ -74,31 +81,34 @@ func init() {
 func init() { proto.RegisterFile("types/type_tss.proto", fileDescriptor_20a5d6b0c0644fdf) }
 
 var fileDescriptor_20a5d6b0c0644fdf = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0xae, 0xd3, 0x30,
-	0x18, 0x85, 0x93, 0x94, 0xa6, 0xad, 0xe9, 0x64, 0xaa, 0xca, 0x20, 0xe4, 0x16, 0xa6, 0x4e, 0x8d,
-	0x4a, 0x05, 0x12, 0x12, 0x2c, 0x05, 0x24, 0x10, 0x0b, 0x0a, 0x88, 0x81, 0xc5, 0x6a, 0x5c, 0x2b,
-	0x31, 0x4d, 0xe2, 0x28, 0xbf, 0x03, 0x64, 0xe3, 0x11, 0x78, 0xac, 0x8e, 0x1d, 0x3b, 0x55, 0x34,
-	0x9d, 0xef, 0x0b, 0xdc, 0xe9, 0x2a, 0x6e, 0x1b, 0xdd, 0x3b, 0xde, 0xc5, 0xfa, 0xff, 0xef, 0x1c,
-	0x9f, 0x23, 0xd9, 0x68, 0xa0, 0xcb, 0x4c, 0x80, 0x57, 0x9f, 0x4c, 0x03, 0x4c, 0xb3, 0x5c, 0x69,
-	0x85, 0xdb, 0x86, 0x3e, 0x19, 0x84, 0x2a, 0x54, 0x86, 0x78, 0xf5, 0x74, 0x12, 0x9f, 0x5f, 0x39,
-	0xa8, 0xfb, 0x0d, 0xe0, 0xbb, 0xd2, 0x22, 0xc7, 0x43, 0xe4, 0xc8, 0x15, 0xb1, 0xc7, 0xf6, 0xa4,
-	0xb7, 0x70, 0xab, 0xfd, 0xc8, 0xf9, 0xf4, 0xde, 0x77, 0xe4, 0x0a, 0x33, 0xd4, 0xcf, 0x94, 0x8a,
-	0x59, 0x56, 0x04, 0x6c, 0x2d, 0x4a, 0xe2, 0x18, 0xc7, 0xdb, 0xeb, 0xfd, 0xe8, 0x75, 0x28, 0x75,
-	0x54, 0x04, 0x53, 0xae, 0x12, 0x0f, 0x7e, 0x4b, 0xcd, 0xa3, 0xb8, 0x34, 0xc1, 0x5c, 0xc5, 0x0d,
-	0x48, 0xd5, 0x4a, 0x78, 0xbf, 0xe6, 0x1e, 0x57, 0x49, 0xa2, 0xd2, 0xe9, 0x97, 0x22, 0xf8, 0x2c,
-	0x4a, 0x1f, 0xd5, 0x91, 0xa7, 0x19, 0x3f, 0x46, 0xdd, 0x73, 0x36, 0x90, 0xd6, 0xb8, 0x35, 0xe9,
-	0xf9, 0x9d, 0xcc, 0x28, 0x80, 0x9f, 0xa1, 0x7e, 0x10, 0x2b, 0xbe, 0x66, 0x91, 0x90, 0x61, 0xa4,
-	0xc9, 0x83, 0xb1, 0x3d, 0x69, 0xf9, 0x0f, 0x0d, 0xfb, 0x68, 0x10, 0x1e, 0x22, 0x97, 0x47, 0x4b,
-	0x99, 0x02, 0x69, 0x9b, 0xbb, 0xe7, 0x0d, 0x13, 0xd4, 0x01, 0x19, 0xa6, 0x22, 0x07, 0xe2, 0x9e,
-	0x42, 0xcf, 0x2b, 0xfe, 0x80, 0x46, 0xc9, 0xf2, 0xa7, 0xca, 0xa5, 0x2e, 0x19, 0x57, 0x29, 0x88,
-	0x14, 0x0a, 0x60, 0x77, 0x7a, 0x3a, 0xa6, 0xe7, 0xe9, 0xc5, 0xf6, 0xee, 0xe2, 0x5a, 0xdc, 0x2a,
-	0x9e, 0xa1, 0x01, 0x08, 0x9e, 0xbd, 0x78, 0xf9, 0x6a, 0x3d, 0x63, 0x75, 0xf6, 0x52, 0x17, 0xb9,
-	0x00, 0xd2, 0x35, 0x6d, 0x8f, 0x1a, 0xed, 0x6b, 0x23, 0x2d, 0x82, 0xcd, 0x81, 0x5a, 0xbb, 0x03,
-	0xb5, 0xfe, 0x56, 0xd4, 0xda, 0x54, 0xd4, 0xde, 0x56, 0xd4, 0xfe, 0x5f, 0x51, 0xfb, 0xdf, 0x91,
-	0x5a, 0xdb, 0x23, 0xb5, 0x76, 0x47, 0x6a, 0xfd, 0x78, 0x73, 0x9f, 0x67, 0xfd, 0xd3, 0x10, 0xf3,
-	0xf3, 0x10, 0xb8, 0xc6, 0x3a, 0xbf, 0x09, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x80, 0x3e, 0x55, 0x0f,
-	0x02, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4b, 0x6f, 0xd3, 0x30,
+	0x1c, 0x4f, 0xda, 0xad, 0x0f, 0x6f, 0x42, 0xc8, 0x54, 0x95, 0x79, 0xc8, 0x2d, 0x9c, 0x7a, 0x6a,
+	0xd4, 0x8d, 0x22, 0x4d, 0x82, 0x4b, 0x07, 0x12, 0x88, 0xcb, 0xd4, 0x21, 0x0e, 0x5c, 0xac, 0xc6,
+	0xb1, 0x12, 0xd3, 0x24, 0x8e, 0xf2, 0x77, 0x80, 0xdc, 0xf8, 0x08, 0x88, 0x4f, 0xb5, 0xe3, 0x8e,
+	0x3b, 0x4d, 0x34, 0xfd, 0x16, 0x9c, 0x50, 0x9c, 0x87, 0x40, 0x82, 0x03, 0x97, 0xc8, 0xfe, 0x3d,
+	0xff, 0x7f, 0xc5, 0x68, 0xa4, 0xf3, 0x44, 0x80, 0x53, 0x7e, 0x99, 0x06, 0x98, 0x27, 0xa9, 0xd2,
+	0x0a, 0x1f, 0x1a, 0xf4, 0xc1, 0xc8, 0x57, 0xbe, 0x32, 0x88, 0x53, 0x9e, 0x2a, 0xf2, 0xc9, 0xf7,
+	0x03, 0x34, 0x78, 0x07, 0xf0, 0x5e, 0x69, 0x91, 0xe2, 0x31, 0xea, 0x48, 0x8f, 0xd8, 0x53, 0x7b,
+	0x36, 0x5c, 0xf5, 0x8a, 0xdb, 0x49, 0xe7, 0xcd, 0xcb, 0x75, 0x47, 0x7a, 0x98, 0xa1, 0xe3, 0x44,
+	0xa9, 0x90, 0x25, 0x99, 0xcb, 0xb6, 0x22, 0x27, 0x1d, 0xa3, 0x78, 0xf1, 0xf3, 0x76, 0x72, 0xe6,
+	0x4b, 0x1d, 0x64, 0xee, 0x9c, 0xab, 0xc8, 0x81, 0xcf, 0x52, 0xf3, 0x20, 0xcc, 0x4d, 0x30, 0x57,
+	0x61, 0x0b, 0xc4, 0xca, 0x13, 0xce, 0xa7, 0x53, 0x87, 0xab, 0x28, 0x52, 0xf1, 0xfc, 0x22, 0x73,
+	0xdf, 0x8a, 0x7c, 0x8d, 0xca, 0xc8, 0xea, 0x8c, 0xef, 0xa3, 0x41, 0x9d, 0x0d, 0xa4, 0x3b, 0xed,
+	0xce, 0x86, 0xeb, 0x7e, 0x62, 0x18, 0xc0, 0x8f, 0xd1, 0xb1, 0x1b, 0x2a, 0xbe, 0x65, 0x81, 0x90,
+	0x7e, 0xa0, 0xc9, 0xc1, 0xd4, 0x9e, 0x75, 0xd7, 0x47, 0x06, 0x7b, 0x6d, 0x20, 0x3c, 0x46, 0x3d,
+	0x1e, 0x6c, 0x64, 0x0c, 0xe4, 0xd0, 0x78, 0xeb, 0x1b, 0x26, 0xa8, 0x0f, 0xd2, 0x8f, 0x45, 0x0a,
+	0xa4, 0x57, 0x85, 0xd6, 0x57, 0xfc, 0x0a, 0x4d, 0xa2, 0xcd, 0x47, 0x95, 0x4a, 0x9d, 0x33, 0xae,
+	0x62, 0x10, 0x31, 0x64, 0xc0, 0xfe, 0xe8, 0xe9, 0x9b, 0x9e, 0x47, 0x8d, 0xec, 0xbc, 0x51, 0xad,
+	0x7e, 0x2b, 0x5e, 0xa0, 0x11, 0x08, 0x9e, 0x9c, 0x2c, 0x9f, 0x6d, 0x17, 0xac, 0xcc, 0xde, 0xe8,
+	0x2c, 0x15, 0x40, 0x06, 0xa6, 0xed, 0x5e, 0xcb, 0x5d, 0xb6, 0x14, 0x9e, 0xa1, 0xbb, 0xc2, 0x3b,
+	0x59, 0x2e, 0x17, 0x67, 0xac, 0xdd, 0x78, 0x68, 0xe4, 0x77, 0x6a, 0xfc, 0xa2, 0x5e, 0xfc, 0x1c,
+	0xd1, 0x46, 0xf9, 0x8f, 0x11, 0x91, 0x19, 0xf1, 0x61, 0xad, 0xfa, 0xeb, 0x84, 0x4f, 0xd1, 0xb8,
+	0x09, 0x01, 0xa1, 0x75, 0x28, 0xbc, 0xc6, 0x7c, 0x64, 0xcc, 0xa3, 0x9a, 0xbd, 0xac, 0xc8, 0xca,
+	0xb5, 0x72, 0xaf, 0x76, 0xd4, 0xba, 0xd9, 0x51, 0xeb, 0x6b, 0x41, 0xad, 0xab, 0x82, 0xda, 0xd7,
+	0x05, 0xb5, 0x7f, 0x14, 0xd4, 0xfe, 0xb6, 0xa7, 0xd6, 0xf5, 0x9e, 0x5a, 0x37, 0x7b, 0x6a, 0x7d,
+	0x78, 0xfe, 0x3f, 0xff, 0xfe, 0x4b, 0x8b, 0x98, 0xe7, 0x09, 0x6e, 0xcf, 0x48, 0x4f, 0x7f, 0x0d,
+	0x00, 0x0d, 0xae, 0x67, 0xca, 0xb4, 0x02, 0x00, 0x00,
 }
 
 func (m *TssVoter) Marshal() (dAtA []byte, err error) {
@@ -121,6 +131,25 @@ func (m *TssVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Ed25519SettledHeight != 0 {
+		i = encodeVarintTypeTss(dAtA, i, uint64(m.Ed25519SettledHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Ed25519ConsensusBlockHeight != 0 {
+		i = encodeVarintTypeTss(dAtA, i, uint64(m.Ed25519ConsensusBlockHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Ed25519PubKeys) > 0 {
+		for iNdEx := len(m.Ed25519PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ed25519PubKeys[iNdEx])
+			copy(dAtA[i:], m.Ed25519PubKeys[iNdEx])
+			i = encodeVarintTypeTss(dAtA, i, uint64(len(m.Ed25519PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Secp256K1Signatures) > 0 {
 		for iNdEx := len(m.Secp256K1Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Secp256K1Signatures[iNdEx])
@@ -239,6 +268,18 @@ func (m *TssVoter) Size() (n int) {
 			n += 1 + l + sovTypeTss(uint64(l))
 		}
 	}
+	if len(m.Ed25519PubKeys) > 0 {
+		for _, s := range m.Ed25519PubKeys {
+			l = len(s)
+			n += 1 + l + sovTypeTss(uint64(l))
+		}
+	}
+	if m.Ed25519ConsensusBlockHeight != 0 {
+		n += 1 + sovTypeTss(uint64(m.Ed25519ConsensusBlockHeight))
+	}
+	if m.Ed25519SettledHeight != 0 {
+		n += 1 + sovTypeTss(uint64(m.Ed25519SettledHeight))
+	}
 	return n
 }
 
@@ -507,6 +548,76 @@ func (m *TssVoter) Unmarshal(dAtA []byte) error {
 			}
 			m.Secp256K1Signatures = append(m.Secp256K1Signatures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519PubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ed25519PubKeys = append(m.Ed25519PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519ConsensusBlockHeight", wireType)
+			}
+			m.Ed25519ConsensusBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ed25519ConsensusBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519SettledHeight", wireType)
+			}
+			m.Ed25519SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ed25519SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeTss(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"github.com/switchlyprotocol/switchlynode/v3/common"
+	"github.com/switchlyprotocol/switchlynode/v3/common/cosmos"
 )
 
 type TypeTssSuite struct{}
@@ -31,10 +32,10 @@ func (s *TypeTssSuite) TestVoter(c *C) {
 	addr, err := common.PubKey(pks[0]).GetThorAddress()
 	c.Assert(err, IsNil)
 	c.Check(tss.HasSigned(addr), Equals, false)
-	tss.Sign(addr, chains, "foo")
+	tss.Sign(addr, chains, "foo", common.EmptyPubKey)
 	c.Check(tss.Signers, HasLen, 1)
 	c.Check(tss.HasSigned(addr), Equals, true)
-	tss.Sign(addr, chains, "foo") // ensure signing twice doesn't duplicate
+	tss.Sign(addr, chains, "foo", common.EmptyPubKey) // ensure signing twice doesn't duplicate
 	c.Check(tss.Signers, HasLen, 1)
 	c.Check(tss.Chains, HasLen, 2)
 	c.Check(tss.Secp256K1Signatures, HasLen, 1)
@@ -42,7 +43,7 @@ func (s *TypeTssSuite) TestVoter(c *C) {
 	c.Check(tss.HasConsensus(), Equals, false)
 	addr, err = common.PubKey(pks[1]).GetThorAddress()
 	c.Assert(err, IsNil)
-	tss.Sign(addr, chains, "")
+	tss.Sign(addr, chains, "", common.EmptyPubKey)
 	c.Check(tss.HasConsensus(), Equals, true)
 	v1 := NewTssVoter("", nil, common.EmptyPubKey)
 	c.Check(v1.IsEmpty(), Equals, true)
@@ -90,7 +91,7 @@ func (s *TypeTssSuite) TestConsensusCheckSignature(c *C) {
 		c.Check(tss.HasCompleteConsensus(), Equals, false)
 		addr, err := common.PubKey(member).GetThorAddress()
 		c.Assert(err, IsNil)
-		tss.Sign(addr, []string{common.ETHChain.String()}, "foo")
+		tss.Sign(addr, []string{common.ETHChain.String()}, "foo", common.EmptyPubKey)
 	}
 	c.Check(tss.HasCompleteConsensus(), Equals, true)
 	sig, ok := tss.ConsensusCheckSignature()
@@ -112,7 +113,7 @@ func (s *TypeTssSuite) TestConsensusCheckSignature(c *C) {
 			signature = "foo"
 		}
 
-		tss.Sign(addr, []string{common.ETHChain.String()}, signature)
+		tss.Sign(addr, []string{common.ETHChain.String()}, signature, common.EmptyPubKey)
 	}
 	c.Check(tss.HasCompleteConsensus(), Equals, true)
 	sig, ok = tss.ConsensusCheckSignature()
@@ -134,7 +135,7 @@ func (s *TypeTssSuite) TestConsensusCheckSignature(c *C) {
 			signature = "foo"
 		}
 
-		tss.Sign(addr, []string{common.ETHChain.String()}, signature)
+		tss.Sign(addr, []string{common.ETHChain.String()}, signature, common.EmptyPubKey)
 	}
 	c.Check(tss.HasCompleteConsensus(), Equals, true)
 	sig, ok = tss.ConsensusCheckSignature()
@@ -156,7 +157,7 @@ func (s *TypeTssSuite) TestConsensusCheckSignature(c *C) {
 			signature = "bar"
 		}
 
-		tss.Sign(addr, []string{common.ETHChain.String()}, signature)
+		tss.Sign(addr, []string{common.ETHChain.String()}, signature, common.EmptyPubKey)
 	}
 	c.Check(tss.HasCompleteConsensus(), Equals, true)
 	sig, ok = tss.ConsensusCheckSignature()
@@ -172,7 +173,7 @@ func (s *TypeTssSuite) TestConsensusCheckSignature(c *C) {
 		c.Check(ok, Equals, false)
 		addr, err := common.PubKey(member).GetThorAddress()
 		c.Assert(err, IsNil)
-		tss.Sign(addr, []string{common.ETHChain.String()}, "")
+		tss.Sign(addr, []string{common.ETHChain.String()}, "", common.EmptyPubKey)
 	}
 	c.Check(tss.HasCompleteConsensus(), Equals, true)
 	sig, ok = tss.ConsensusCheckSignature()
@@ -188,10 +189,88 @@ func (s *TypeTssSuite) TestConsensusCheckSignature(c *C) {
 		c.Check(ok, Equals, false)
 		addr, err := common.PubKey(member).GetThorAddress()
 		c.Assert(err, IsNil)
-		tss.Sign(addr, []string{common.ETHChain.String()}, strconv.Itoa(i))
+		tss.Sign(addr, []string{common.ETHChain.String()}, strconv.Itoa(i), common.EmptyPubKey)
 	}
 	c.Check(tss.HasCompleteConsensus(), Equals, true)
 	sig, ok = tss.ConsensusCheckSignature()
 	c.Check(ok, Equals, false)
 	c.Check(sig, Equals, "")
 }
+
+func (s *TypeTssSuite) TestConsensusEd25519PubKey(c *C) {
+	members := []string{
+		GetRandomPubKey().String(), GetRandomPubKey().String(),
+		GetRandomPubKey().String(), GetRandomPubKey().String(),
+	}
+	signers := make([]cosmos.AccAddress, len(members))
+	for i, member := range members {
+		addr, err := common.PubKey(member).GetThorAddress()
+		c.Assert(err, IsNil)
+		signers[i] = addr
+	}
+	edpk := GetRandomEd25519PubKey()
+	other := GetRandomEd25519PubKey()
+
+	// no keys reported
+	tss := NewTssVoter("foo", members, GetRandomPubKey())
+	for _, signer := range signers {
+		tss.Sign(signer, nil, "", common.EmptyPubKey)
+	}
+	c.Check(tss.HasEd25519PubKeys(), Equals, false)
+	_, ok := tss.ConsensusEd25519PubKey()
+	c.Check(ok, Equals, false)
+
+	// 3/4 agree, the last reports a different key
+	tss = NewTssVoter("foo", members, GetRandomPubKey())
+	for i, signer := range signers[:3] {
+		_, ok = tss.ConsensusEd25519PubKey()
+		c.Check(ok, Equals, false)
+		tss.Sign(signer, nil, "", edpk)
+		c.Check(tss.ReportedEd25519PubKey(signer).Equals(edpk), Equals, true, Commentf("%d", i))
+	}
+	c.Check(tss.HasEd25519PubKeys(), Equals, true)
+	pk, ok := tss.ConsensusEd25519PubKey()
+	c.Check(ok, Equals, true)
+	c.Check(pk.Equals(edpk), Equals, true)
+	c.Check(tss.Ed25519Dissenters(pk), HasLen, 0)
+	c.Check(tss.ReportedEd25519PubKey(signers[3]).IsEmpty(), Equals, true)
+
+	tss.Sign(signers[3], nil, "", other)
+	c.Check(tss.ReportedEd25519PubKey(signers[3]).Equals(other), Equals, true)
+	dissenters := tss.Ed25519Dissenters(pk)
+	c.Assert(dissenters, HasLen, 1)
+	c.Check(dissenters[0].Equals(signers[3]), Equals, true)
+
+	// signers without a key are not dissenters
+	tss = NewTssVoter("foo", members, GetRandomPubKey())
+	for i, signer := range signers {
+		reported := edpk
+		if i == 0 {
+			reported = common.EmptyPubKey
+		}
+		tss.Sign(signer, nil, "", reported)
+	}
+	pk, ok = tss.ConsensusEd25519PubKey()
+	c.Check(ok, Equals, true)
+	c.Check(tss.Ed25519Dissenters(pk), HasLen, 0)
+
+	// 2/4 split, no supermajority
+	tss = NewTssVoter("foo", members, GetRandomPubKey())
+	for i, signer := range signers {
+		reported := edpk
+		if i%2 == 0 {
+			reported = other
+		}
+		tss.Sign(signer, nil, "", reported)
+	}
+	c.Check(tss.HasEd25519PubKeys(), Equals, true)
+	_, ok = tss.ConsensusEd25519PubKey()
+	c.Check(ok, Equals, false)
+
+	// signers recorded before the tally existed stay aligned
+	tss = NewTssVoter("foo", members, GetRandomPubKey())
+	tss.Signers = []string{signers[0].String()}
+	tss.Sign(signers[1], nil, "", edpk)
+	c.Check(tss.Ed25519PubKeys, DeepEquals, []string{"", edpk.String()})
+	c.Check(tss.ReportedEd25519PubKey(signers[1]).Equals(edpk), Equals, true)
+}
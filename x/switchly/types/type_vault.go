@@ -78,17 +78,23 @@ func (m Vault) IsEmpty() bool {
 }
 
 // PubKeyForChain returns the vault public key whose signature scheme matches the chain: the ed25519
-// group key for Stellar (which uses ed25519 accounts), the secp256k1 key for every other chain. Falls
-// back to the secp256k1 key when no ed25519 key is set (legacy/ECDSA-only vaults), preserving the
-// previous behaviour. Address derivation and outbound source addresses must go through this so a
-// Stellar vault's address comes from its real ed25519 group key.
+// group key for chains with ed25519 accounts (Stellar, Solana), the secp256k1 key for every other
+// chain. Falls back to the secp256k1 key when no ed25519 key is set (legacy/ECDSA-only vaults),
+// preserving the previous behaviour. Address derivation and outbound source addresses must go through
+// this so a Stellar or Solana vault's address comes from its real ed25519 group key.
 func (m Vault) PubKeyForChain(chain common.Chain) common.PubKey {
-	if chain.Equals(common.StellarChain) && !m.Ed25519PubKey.IsEmpty() {
+	if chain.IsEd25519() && !m.Ed25519PubKey.IsEmpty() {
 		return m.Ed25519PubKey
 	}
 	return m.PubKey
 }
 
+// HasEd25519PubKey returns true when the vault holds a real ed25519 group key, rather than none or
+// the secp256k1 key mirrored into the slot by an ECDSA-only keygen
+func (m Vault) HasEd25519PubKey() bool {
+	return !m.Ed25519PubKey.IsEmpty() && !m.Ed25519PubKey.Equals(m.PubKey)
+}
+
 // Contains check whether the given pubkey is party of the originally node who create this vault
 func (m Vault) Contains(pubkey common.PubKey) bool {
 	return m.GetMembership().Contains(pubkey)
@@ -113,6 +119,14 @@ func (m *Vault) UpdateStatus(s VaultStatus, height int64) {
 	m.StatusSince = height
 }
 
+// Reshare hands the vault over to the given membership after a resharing churn, the
+// pub key and funds stay the same while the membership epoch moves on
+func (m *Vault) Reshare(membership []string, height int64) {
+	m.Membership = membership
+	m.MembershipEpoch++
+	m.UpdateStatus(VaultStatus_ActiveVault, height)
+}
+
 // Valid check whether Vault has all necessary values
 func (m Vault) Valid() error {
 	if m.PubKey.IsEmpty() {
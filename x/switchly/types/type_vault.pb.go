@@ -99,6 +99,9 @@ type Vault struct {
 	// (secp256k1). Empty on legacy/ECDSA-only vaults. GetAddress(StellarChain) derives the Stellar
 	// address from this key when present (otherwise the secp256k1 placeholder).
 	Ed25519PubKey github_com_switchlyprotocol_switchlynode_v3_common.PubKey `protobuf:"bytes,24,opt,name=ed25519_pub_key,json=ed25519PubKey,proto3,casttype=github.com/switchlyprotocol/switchlynode/v3/common.PubKey" json:"ed25519_pub_key,omitempty"`
+	// number of resharing churns that handed the vault key to a new membership, 0 until the
+	// first one
+	MembershipEpoch int64 `protobuf:"varint,25,opt,name=membership_epoch,json=membershipEpoch,proto3" json:"membership_epoch,omitempty"`
 }
 
 func (m *Vault) Reset()         { *m = Vault{} }
@@ -143,44 +146,45 @@ func init() {
 func init() { proto.RegisterFile("types/type_vault.proto", fileDescriptor_1cbfd6546a57244a) }
 
 var fileDescriptor_1cbfd6546a57244a = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x93, 0x65, 0xed, 0x7e, 0x75, 0xf7, 0xa7, 0xf3, 0x6f, 0x0c, 0x6b, 0x87, 0x34, 0x20,
-	0x0e, 0x51, 0x0f, 0xa9, 0xd8, 0x98, 0x60, 0x12, 0x48, 0xd0, 0x5e, 0x98, 0xb8, 0xa0, 0x6c, 0xec,
-	0xb0, 0x4b, 0x94, 0x38, 0x26, 0xb1, 0xd6, 0xda, 0x51, 0xec, 0x6c, 0x2d, 0xaf, 0x82, 0xd7, 0xc1,
-	0x2b, 0xd9, 0x71, 0x47, 0xb8, 0x0c, 0xd8, 0xde, 0x05, 0x27, 0x64, 0x3b, 0x1d, 0x11, 0x37, 0x10,
-	0x97, 0xc6, 0xcf, 0xe7, 0xf9, 0xfa, 0xb1, 0x9f, 0xe7, 0xeb, 0x82, 0x6d, 0x39, 0x2f, 0x88, 0x18,
-	0xaa, 0xdf, 0xe8, 0x3c, 0xae, 0x26, 0x32, 0x28, 0x4a, 0x2e, 0x39, 0x6c, 0x69, 0xbe, 0xf3, 0x3f,
-	0xe6, 0xd3, 0x29, 0x67, 0x43, 0xf3, 0x31, 0xb9, 0x9d, 0x7e, 0x63, 0x0f, 0xce, 0x63, 0xca, 0x22,
-	0xcc, 0x99, 0x2c, 0x63, 0x5c, 0x6f, 0xde, 0xd9, 0xca, 0x78, 0xc6, 0xf5, 0x72, 0xa8, 0x56, 0x86,
-	0x3e, 0xfc, 0xd2, 0x02, 0xad, 0x13, 0x75, 0x04, 0x7c, 0x00, 0x56, 0x93, 0x09, 0xc7, 0x67, 0x51,
-	0x4e, 0x68, 0x96, 0x4b, 0x64, 0x7b, 0xb6, 0xef, 0x84, 0x5d, 0xcd, 0x5e, 0x6b, 0x04, 0x4f, 0xc0,
-	0x4a, 0x51, 0x25, 0xd1, 0x19, 0x99, 0xa3, 0x25, 0xcf, 0xf6, 0x3b, 0xa3, 0x17, 0x3f, 0xae, 0xfb,
-	0x07, 0x19, 0x95, 0x79, 0x95, 0x04, 0x98, 0x4f, 0x87, 0xe2, 0x82, 0x4a, 0x9c, 0x4f, 0xe6, 0xba,
-	0x36, 0xe6, 0x93, 0x3b, 0xc0, 0x78, 0x4a, 0x86, 0xe7, 0x7b, 0x8b, 0x2b, 0xbf, 0xad, 0x92, 0x37,
-	0x64, 0x1e, 0xb6, 0x0b, 0xfd, 0x85, 0x29, 0x68, 0x61, 0x4e, 0x99, 0x40, 0x8e, 0xe7, 0xf8, 0xdd,
-	0xdd, 0xd5, 0xa0, 0x96, 0x8d, 0x39, 0x65, 0xa3, 0x97, 0x97, 0xd7, 0x7d, 0xeb, 0xd3, 0xd7, 0xfe,
-	0xb3, 0xbf, 0x38, 0x47, 0x15, 0x10, 0xa1, 0x29, 0x0e, 0x1f, 0x81, 0x65, 0x35, 0x1d, 0xb4, 0xec,
-	0xd9, 0xfe, 0xfa, 0x6e, 0x2f, 0xd0, 0x03, 0x0b, 0x74, 0xf3, 0xc7, 0xf3, 0x82, 0x84, 0x3a, 0x0b,
-	0x07, 0xa0, 0x2d, 0x64, 0x2c, 0x2b, 0x81, 0x5a, 0x5a, 0x07, 0x9b, 0xba, 0x23, 0x9d, 0x09, 0x6b,
-	0x85, 0x1a, 0x99, 0x59, 0x45, 0x82, 0x32, 0x4c, 0x50, 0xdb, 0x8c, 0xcc, 0xb0, 0x23, 0x85, 0xa0,
-	0x0b, 0xc0, 0x94, 0x4c, 0x13, 0x52, 0x8a, 0x9c, 0x16, 0x68, 0xc5, 0x73, 0xfc, 0x4e, 0xd8, 0x20,
-	0x70, 0x1b, 0xb4, 0xb5, 0x5b, 0x02, 0xfd, 0xa7, 0x73, 0x75, 0x04, 0x7d, 0xd0, 0xa3, 0x2c, 0xe1,
-	0x15, 0x4b, 0x23, 0x39, 0x8b, 0x30, 0xaf, 0x98, 0x44, 0x1d, 0x5d, 0x7e, 0xbd, 0xe6, 0xc7, 0xb3,
-	0xb1, 0xa2, 0x70, 0x00, 0x36, 0x79, 0x25, 0x7f, 0x93, 0x02, 0x2d, 0xdd, 0x58, 0x24, 0x16, 0xda,
-	0xa7, 0x00, 0x15, 0x84, 0xa5, 0x94, 0x65, 0x4a, 0xda, 0xb4, 0x5b, 0xa0, 0xae, 0xe7, 0xf8, 0x4e,
-	0x78, 0xaf, 0xce, 0x1f, 0xcf, 0x46, 0xbf, 0x8c, 0x17, 0xf0, 0x09, 0x58, 0x29, 0x79, 0x25, 0x49,
-	0x29, 0xd0, 0xb6, 0xf6, 0x68, 0xab, 0x1e, 0xcb, 0x58, 0x5d, 0x77, 0x5c, 0xbf, 0xb4, 0xd1, 0xb2,
-	0xf2, 0x2a, 0x5c, 0x48, 0x55, 0x73, 0xef, 0x4b, 0xfe, 0x81, 0x30, 0x74, 0xdf, 0x34, 0x67, 0x22,
-	0x48, 0xc0, 0x06, 0x49, 0x77, 0xf7, 0xf7, 0x1f, 0x1f, 0x44, 0x8b, 0xf7, 0x84, 0xfe, 0xc5, 0x7b,
-	0x5a, 0xab, 0xab, 0x9a, 0x70, 0x10, 0x80, 0xce, 0x9d, 0xbb, 0xb0, 0x07, 0x56, 0xdf, 0xb1, 0x33,
-	0xc6, 0x2f, 0x98, 0x66, 0x3d, 0x0b, 0x6e, 0x80, 0xee, 0x2b, 0x91, 0xc5, 0x65, 0x6a, 0x80, 0x3d,
-	0x38, 0x02, 0xdd, 0x86, 0xcb, 0x70, 0x13, 0xac, 0x1d, 0xb2, 0x18, 0x4b, 0x7a, 0x4e, 0x9a, 0x5b,
-	0x1a, 0xc0, 0x56, 0x9a, 0x90, 0x48, 0x5a, 0x52, 0x96, 0x19, 0xb4, 0x04, 0xd7, 0x40, 0xe7, 0x90,
-	0x51, 0x69, 0x42, 0x67, 0x74, 0x7a, 0xf9, 0xdd, 0xb5, 0x2e, 0x6f, 0x5c, 0xfb, 0xea, 0xc6, 0xb5,
-	0xbf, 0xdd, 0xb8, 0xf6, 0xc7, 0x5b, 0xd7, 0xba, 0xba, 0x75, 0xad, 0xcf, 0xb7, 0xae, 0x75, 0xfa,
-	0xfc, 0x4f, 0x9a, 0x9d, 0xdd, 0x11, 0xfd, 0x37, 0x17, 0x49, 0x5b, 0x4b, 0xf7, 0x7e, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0xff, 0x97, 0x05, 0x30, 0x04, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0x65, 0xed, 0x7e, 0x75, 0xf7, 0xa7, 0xf3, 0x6f, 0x0c, 0xb3, 0x43, 0x1a, 0x10,
+	0x87, 0xd0, 0x43, 0x2a, 0x36, 0x26, 0x98, 0x04, 0x12, 0xb4, 0x42, 0x62, 0xe2, 0x82, 0xb2, 0xb1,
+	0xc3, 0x2e, 0x51, 0xe2, 0x98, 0xc4, 0x5a, 0x6b, 0x47, 0xb1, 0xb3, 0xb5, 0x9c, 0x79, 0x01, 0xbc,
+	0x0e, 0x5e, 0xc9, 0x8e, 0x3b, 0x72, 0x1a, 0xb0, 0xbd, 0x0b, 0x4e, 0xc8, 0x76, 0xba, 0x45, 0xdc,
+	0x40, 0x5c, 0x1a, 0x3f, 0x9f, 0xe7, 0xeb, 0xc7, 0xf6, 0xf7, 0x79, 0x54, 0xb0, 0x29, 0x67, 0x39,
+	0x11, 0x03, 0xf5, 0x1b, 0x9e, 0x46, 0xe5, 0x58, 0xfa, 0x79, 0xc1, 0x25, 0x87, 0x4d, 0xcd, 0xb7,
+	0xfe, 0xc7, 0x7c, 0x32, 0xe1, 0x6c, 0x60, 0x3e, 0x26, 0xb7, 0xd5, 0xab, 0xed, 0xc1, 0x59, 0x44,
+	0x59, 0x88, 0x39, 0x93, 0x45, 0x84, 0xab, 0xcd, 0x5b, 0x1b, 0x29, 0x4f, 0xb9, 0x5e, 0x0e, 0xd4,
+	0xca, 0xd0, 0x07, 0x9f, 0x5a, 0xa0, 0x79, 0xa4, 0x8e, 0x80, 0xf7, 0xc1, 0x72, 0x3c, 0xe6, 0xf8,
+	0x24, 0xcc, 0x08, 0x4d, 0x33, 0x89, 0x2c, 0xd7, 0xf2, 0xec, 0xa0, 0xa3, 0xd9, 0x1b, 0x8d, 0xe0,
+	0x11, 0x58, 0xca, 0xcb, 0x38, 0x3c, 0x21, 0x33, 0xb4, 0xe0, 0x5a, 0x5e, 0x7b, 0xf8, 0xe2, 0xe7,
+	0x65, 0x6f, 0x2f, 0xa5, 0x32, 0x2b, 0x63, 0x1f, 0xf3, 0xc9, 0x40, 0x9c, 0x51, 0x89, 0xb3, 0xf1,
+	0x4c, 0xd7, 0xc6, 0x7c, 0x7c, 0x03, 0x18, 0x4f, 0xc8, 0xe0, 0x74, 0x67, 0x7e, 0xe5, 0x77, 0x65,
+	0xfc, 0x96, 0xcc, 0x82, 0x56, 0xae, 0xbf, 0x30, 0x01, 0x4d, 0xcc, 0x29, 0x13, 0xc8, 0x76, 0x6d,
+	0xaf, 0xb3, 0xbd, 0xec, 0x57, 0xb2, 0x11, 0xa7, 0x6c, 0xf8, 0xf2, 0xfc, 0xb2, 0xd7, 0xf8, 0xf2,
+	0xad, 0xf7, 0xec, 0x2f, 0xce, 0x51, 0x05, 0x44, 0x60, 0x8a, 0xc3, 0x87, 0x60, 0x51, 0xb9, 0x83,
+	0x16, 0x5d, 0xcb, 0x5b, 0xdd, 0xee, 0xfa, 0x2a, 0x10, 0xbe, 0x7e, 0xfc, 0xe1, 0x2c, 0x27, 0x81,
+	0xce, 0xc2, 0x3e, 0x68, 0x09, 0x19, 0xc9, 0x52, 0xa0, 0xa6, 0xd6, 0xc1, 0xba, 0xee, 0x40, 0x67,
+	0x82, 0x4a, 0xa1, 0x2c, 0x33, 0xab, 0x50, 0x50, 0x86, 0x09, 0x6a, 0x19, 0xcb, 0x0c, 0x3b, 0x50,
+	0x08, 0x3a, 0x00, 0x4c, 0xc8, 0x24, 0x26, 0x85, 0xc8, 0x68, 0x8e, 0x96, 0x5c, 0xdb, 0x6b, 0x07,
+	0x35, 0x02, 0x37, 0x41, 0x4b, 0x77, 0x4b, 0xa0, 0xff, 0x74, 0xae, 0x8a, 0xa0, 0x07, 0xba, 0x94,
+	0xc5, 0xbc, 0x64, 0x49, 0x28, 0xa7, 0x21, 0xe6, 0x25, 0x93, 0xa8, 0xad, 0xcb, 0xaf, 0x56, 0xfc,
+	0x70, 0x3a, 0x52, 0x14, 0xf6, 0xc1, 0x3a, 0x2f, 0xe5, 0x6f, 0x52, 0xa0, 0xa5, 0x6b, 0xf3, 0xc4,
+	0x5c, 0xfb, 0x14, 0xa0, 0x9c, 0xb0, 0x84, 0xb2, 0x54, 0x49, 0xeb, 0xed, 0x16, 0xa8, 0xe3, 0xda,
+	0x9e, 0x1d, 0xdc, 0xa9, 0xf2, 0x87, 0xd3, 0xe1, 0x6d, 0xe3, 0x05, 0x7c, 0x02, 0x96, 0x0a, 0x5e,
+	0x4a, 0x52, 0x08, 0xb4, 0xa9, 0x7b, 0xb4, 0x51, 0xd9, 0x32, 0x52, 0xd7, 0x1d, 0x55, 0x93, 0x36,
+	0x5c, 0x54, 0xbd, 0x0a, 0xe6, 0x52, 0xf5, 0xb8, 0x0f, 0x05, 0xff, 0x48, 0x18, 0xba, 0x6b, 0x1e,
+	0x67, 0x22, 0x48, 0xc0, 0x1a, 0x49, 0xb6, 0x77, 0x77, 0x1f, 0xef, 0x85, 0xf3, 0x79, 0x42, 0xff,
+	0x62, 0x9e, 0x56, 0xaa, 0xaa, 0x26, 0x84, 0x8f, 0x40, 0xf7, 0xd6, 0xe9, 0x90, 0xe4, 0x1c, 0x67,
+	0xe8, 0x9e, 0x31, 0xe6, 0x96, 0xbf, 0x56, 0xb8, 0xef, 0x83, 0xf6, 0xcd, 0x20, 0xc0, 0x2e, 0x58,
+	0x7e, 0xcf, 0x4e, 0x18, 0x3f, 0x63, 0x9a, 0x75, 0x1b, 0x70, 0x0d, 0x74, 0x5e, 0x89, 0x34, 0x2a,
+	0x12, 0x03, 0xac, 0xfe, 0x01, 0xe8, 0xd4, 0x06, 0x02, 0xae, 0x83, 0x95, 0x7d, 0x16, 0x61, 0x49,
+	0x4f, 0x49, 0x7d, 0x4b, 0x0d, 0x58, 0x4a, 0x13, 0x10, 0x49, 0x0b, 0xca, 0x52, 0x83, 0x16, 0xe0,
+	0x0a, 0x68, 0xef, 0x33, 0x2a, 0x4d, 0x68, 0x0f, 0x8f, 0xcf, 0x7f, 0x38, 0x8d, 0xf3, 0x2b, 0xc7,
+	0xba, 0xb8, 0x72, 0xac, 0xef, 0x57, 0x8e, 0xf5, 0xf9, 0xda, 0x69, 0x5c, 0x5c, 0x3b, 0x8d, 0xaf,
+	0xd7, 0x4e, 0xe3, 0xf8, 0xf9, 0x9f, 0xf8, 0x32, 0xbd, 0x21, 0xfa, 0x1f, 0x41, 0xc4, 0x2d, 0x2d,
+	0xdd, 0xf9, 0x35, 0x00, 0x79, 0x37, 0x8e, 0xa6, 0x5b, 0x04, 0x00, 0x00,
 }
 
 func (m *Vault) Marshal() (dAtA []byte, err error) {
@@ -203,6 +207,13 @@ func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MembershipEpoch != 0 {
+		i = encodeVarintTypeVault(dAtA, i, uint64(m.MembershipEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
 	if len(m.Ed25519PubKey) > 0 {
 		i -= len(m.Ed25519PubKey)
 		copy(dAtA[i:], m.Ed25519PubKey)
@@ -410,6 +421,9 @@ func (m *Vault) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovTypeVault(uint64(l))
 	}
+	if m.MembershipEpoch != 0 {
+		n += 2 + sovTypeVault(uint64(m.MembershipEpoch))
+	}
 	return n
 }
 
@@ -866,6 +880,25 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			}
 			m.Ed25519PubKey = github_com_switchlyprotocol_switchlynode_v3_common.PubKey(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipEpoch", wireType)
+			}
+			m.MembershipEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MembershipEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeVault(dAtA[iNdEx:])
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/switchlyprotocol/switchlynode/v3/common"
+)
+
+// NewVaultMembershipEpoch create a new record of the membership a resharing churn handed
+// the vault key to, epoch zero is the membership of the keygen of the vault
+func NewVaultMembershipEpoch(pk common.PubKey, epoch, height int64, membership []string) VaultMembershipEpoch {
+	return VaultMembershipEpoch{
+		PubKey:      pk,
+		Epoch:       epoch,
+		BlockHeight: height,
+		Membership:  membership,
+	}
+}
+
+// Valid return an error if the vault pub key or the membership is missing, or the epoch is negative
+func (m *VaultMembershipEpoch) Valid() error {
+	if m.PubKey.IsEmpty() {
+		return errors.New("pub key is empty")
+	}
+	if m.Epoch < 0 {
+		return errors.New("epoch cannot be less than zero")
+	}
+	if len(m.Membership) == 0 {
+		return errors.New("membership is empty")
+	}
+	return nil
+}
+
+// Key returns the key of the record, the epochs of a vault share its pub key as prefix
+func (m *VaultMembershipEpoch) Key() string {
+	return fmt.Sprintf("%s/%d", m.PubKey, m.Epoch)
+}
+
+func (m *VaultMembershipEpoch) String() string {
+	return fmt.Sprintf("vault:%s epoch:%d height:%d membership:%v", m.PubKey, m.Epoch, m.BlockHeight, m.Membership)
+}
@@ -113,6 +113,22 @@ func (s *VaultSuite) TestPendingTxBlockHeights(c *C) {
 	c.Assert(vault.LenPendingTxBlockHeights(1002, 300), Equals, 0)
 }
 
+func (s *VaultSuite) TestReshare(c *C) {
+	pk := GetRandomPubKey()
+	vault := NewVault(12, VaultStatus_ActiveVault, VaultType_AsgardVault, pk, common.Chains{common.ETHChain}.Strings(), []ChainContract{})
+	vault.Membership = []string{GetRandomPubKey().String(), GetRandomPubKey().String()}
+	vault.AddFunds(common.NewCoins(common.NewCoin(common.ETHAsset, cosmos.NewUint(100))))
+
+	membership := []string{GetRandomPubKey().String(), GetRandomPubKey().String(), GetRandomPubKey().String()}
+	vault.Reshare(membership, 20)
+	c.Check(vault.PubKey.Equals(pk), Equals, true)
+	c.Check(vault.Membership, DeepEquals, membership)
+	c.Check(vault.MembershipEpoch, Equals, int64(1))
+	c.Check(vault.StatusSince, Equals, int64(20))
+	c.Check(vault.IsActive(), Equals, true)
+	c.Check(vault.HasFunds(), Equals, true)
+}
+
 func (s *VaultSuite) TestVaultSort(c *C) {
 	vault := NewVault(1024, VaultStatus_ActiveVault, VaultType_AsgardVault, GetRandomPubKey(),
 		common.Chains{